@@ -0,0 +1,13 @@
+# HTTP Load Balancers Data Source Example
+# Lists HTTP Load Balancers in a namespace
+
+# List all HTTP load balancers labelled for the public edge
+data "f5xc_http_loadbalancers" "example" {
+  namespace      = "shared"
+  label_selector = "edge=public"
+}
+
+# Example: Map load balancer names to their UIDs
+# output "http_loadbalancer_uids" {
+#   value = { for lb in data.f5xc_http_loadbalancers.example.items : lb.name => lb.uid }
+# }
@@ -0,0 +1,12 @@
+# Namespaces Data Source Example
+# Lists Namespaces in the tenant
+
+# List namespaces created for application teams
+data "f5xc_namespaces" "example" {
+  name_regex = "^app-"
+}
+
+# Example: Use the namespace names in other resources
+# output "app_namespaces" {
+#   value = data.f5xc_namespaces.example.names
+# }
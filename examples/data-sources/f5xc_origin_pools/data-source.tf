@@ -0,0 +1,14 @@
+# Origin Pools Data Source Example
+# Lists Origin Pools in a namespace, filtered by labels and name
+
+# List origin pools owned by another team's stack
+data "f5xc_origin_pools" "example" {
+  namespace      = "shared"
+  label_selector = "team=payments,env in (prod, staging)"
+  name_regex     = "^payments-"
+}
+
+# Example: Reference every matching pool by name
+# output "origin_pool_names" {
+#   value = data.f5xc_origin_pools.example.names
+# }
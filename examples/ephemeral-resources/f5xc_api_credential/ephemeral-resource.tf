@@ -0,0 +1,15 @@
+# API Credential Ephemeral Resource Example
+# Issues an API token for the duration of a Terraform run. The token is
+# revoked when Terraform closes the ephemeral resource.
+
+ephemeral "f5xc_api_credential" "ci" {
+  type            = "API_TOKEN"
+  expiration_days = 1
+}
+
+# Use the short-lived token to configure a provider for a child tenant
+provider "f5xc" {
+  alias     = "child"
+  api_url   = "https://child.console.ves.volterra.io"
+  api_token = ephemeral.f5xc_api_credential.ci.data
+}
@@ -0,0 +1,19 @@
+# Blindfold Ephemeral Resource Example
+# Seals a secret read from an external secret store without storing the
+# plaintext or the sealed value in the plan or state.
+
+ephemeral "aws_secretsmanager_secret_version" "db_password" {
+  secret_id = "prod/db-password"
+}
+
+ephemeral "f5xc_blindfold" "db_password" {
+  plaintext   = ephemeral.aws_secretsmanager_secret_version.db_password.secret_string
+  policy_name = "ves-io-allow-volterra"
+  namespace   = "shared"
+}
+
+# Pass the sealed value to other ephemeral or write-only arguments
+output "sealed_db_password" {
+  value     = ephemeral.f5xc_blindfold.db_password.sealed
+  ephemeral = true
+}
@@ -0,0 +1,26 @@
+# Kubeconfig Ephemeral Resource Example
+# Issues a kubeconfig for the duration of a Terraform run. The kubeconfig is
+# revoked when Terraform closes the ephemeral resource.
+
+# Kubeconfig for a site, through the global controller
+ephemeral "f5xc_kubeconfig" "site" {
+  site = "my-voltstack-site"
+}
+
+# Kubeconfig for a virtual K8s cluster
+ephemeral "f5xc_kubeconfig" "vk8s" {
+  virtual_k8s_name      = "my-vk8s"
+  virtual_k8s_namespace = "my-namespace"
+}
+
+# Configure the Kubernetes provider from the kubeconfig without writing it to disk
+locals {
+  vk8s = yamldecode(ephemeral.f5xc_kubeconfig.vk8s.kubeconfig)
+}
+
+provider "kubernetes" {
+  host                   = local.vk8s.clusters[0].cluster.server
+  cluster_ca_certificate = base64decode(local.vk8s.clusters[0].cluster["certificate-authority-data"])
+  client_certificate     = base64decode(local.vk8s.users[0].user["client-certificate-data"])
+  client_key             = base64decode(local.vk8s.users[0].user["client-key-data"])
+}
@@ -102,6 +102,8 @@ resource "f5xc_origin_pool" "this" {
     no_mtls {}
 
     volterra_trusted_ca {}
+
+    default_session_key_caching {}
   }
 
   healthcheck {
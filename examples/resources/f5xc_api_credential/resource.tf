@@ -1,26 +1,30 @@
 # API Credential Resource Example
-# Manages request specification. in F5 Distributed Cloud.
+# Issues an API token, API certificate or kubeconfig in F5 Distributed Cloud.
 
-# Basic API Credential configuration
+# API token that is replaced 14 days before it expires
 resource "f5xc_api_credential" "example" {
   name      = "example-api-credential"
   namespace = "system"
 
-  labels = {
-    environment = "production"
-    managed_by  = "terraform"
-  }
-
-  annotations = {
-    "owner" = "platform-team"
-  }
+  type               = "API_TOKEN"
+  expiration_days    = 90
+  rotate_before_days = 14
+}
 
-  # API Credential configuration
-  api_credential_type = "API_CERTIFICATE"
+# API certificate protected by a password
+variable "api_certificate_password" {
+  type      = string
+  sensitive = true
+}
 
-  # Expiration settings
-  expiration_timestamp = "2025-12-31T23:59:59Z"
+resource "f5xc_api_credential" "certificate" {
+  name     = "example-api-certificate"
+  type     = "API_CERTIFICATE"
+  password = var.api_certificate_password
+}
 
-  # Active state
-  active = true
+# The credential material is only available from the resource that issued it
+output "api_token" {
+  value     = f5xc_api_credential.example.data
+  sensitive = true
 }
@@ -1,31 +1,50 @@
 # Child Tenant Resource Example
 # Manages child_tenant config instance. Name of the object is the name of the child tenant to be created. in F5 Distributed Cloud.
 
-# Basic Child Tenant configuration
+# Child tenant created from a tenant profile
 resource "f5xc_child_tenant" "example" {
   name      = "example-child-tenant"
-  namespace = "staging"
+  namespace = "system"
 
-  labels = {
-    environment = "production"
-    managed_by  = "terraform"
-  }
+  company_name = "Example Customer"
+  domain       = "example-customer"
 
-  annotations = {
-    "owner" = "platform-team"
+  tenant_profile {
+    name      = f5xc_tenant_profile.example.name
+    namespace = "system"
   }
 
-  # Resource-specific configuration
-  # Object reference. This type establishes a direct referenc...
-  child_tenant_manager {
-    # Configure child_tenant_manager settings
+  customer_info {
+    email      = "admin@example.com"
+    first_name = "Example"
+    last_name  = "Admin"
   }
-  # Contact. Instance of one single contact that can be used ...
+
   contact_detail {
-    # Configure contact_detail settings
-  }
-  # Customer Info. Optional details for the new child tenant
-  customer_info {
-    # Configure customer_info settings
+    contact_type = "MAILING"
+    address1     = "801 5th Ave"
+    city         = "Seattle"
+    state        = "Washington"
+    state_code   = "WA"
+    country      = "US"
+    zip_code     = "98104"
   }
 }
+
+# Aliased provider that manages objects inside the child tenant.
+# The API token must be issued in the child tenant.
+variable "child_tenant_api_token" {
+  type      = string
+  sensitive = true
+}
+
+provider "f5xc" {
+  alias     = "child"
+  api_url   = f5xc_child_tenant.example.tenant_url
+  api_token = var.child_tenant_api_token
+}
+
+resource "f5xc_namespace" "child" {
+  provider = f5xc.child
+  name     = "example-namespace"
+}
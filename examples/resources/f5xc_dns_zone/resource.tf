@@ -25,7 +25,21 @@ resource "f5xc_dns_zone" "example" {
       ttl     = 86400
       neg_ttl = 1800
     }
-    default_rr_set_group {}
+    # Record sets in the default set group
+    default_rr_set_group {
+      ttl = 3600
+      a_record {
+        name   = "www"
+        values = ["192.0.2.10"]
+      }
+    }
+    default_rr_set_group {
+      ttl = 3600
+      cname_record {
+        name  = "app"
+        value = "www.example.com"
+      }
+    }
     default_soa_parameters {}
     dnssec_mode {
       disable {}
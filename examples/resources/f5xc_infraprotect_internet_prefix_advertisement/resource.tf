@@ -15,17 +15,11 @@ resource "f5xc_infraprotect_internet_prefix_advertisement" "example" {
     "owner" = "platform-team"
   }
 
-  # Resource-specific configuration
-  # [OneOf: activation_announce, activation_withdraw] Enable ...
-  activation_announce {
-    # Configure activation_announce settings
-  }
-  # Enable this option
-  activation_withdraw {
-    # Configure activation_withdraw settings
-  }
-  # [OneOf: expiration_never, expiration_timestamp] Enable th...
-  expiration_never {
-    # Configure expiration_never settings
-  }
+  prefix = "203.0.113.0/24"
+
+  # Announce or withdraw the advertisement with
+  # f5xc_infraprotect_internet_prefix_advertisement_activation
+
+  # [OneOf: expiration_never, expiration_timestamp]
+  expiration_never {}
 }
@@ -0,0 +1,16 @@
+# Infraprotect Internet Prefix Advertisement Activation Resource Example
+# Announces or withdraws a DDoS transit Internet Prefix in F5 Distributed Cloud.
+
+# Announce the prefix and wait until it is reported as announced
+resource "f5xc_infraprotect_internet_prefix_advertisement_activation" "example" {
+  name      = f5xc_infraprotect_internet_prefix_advertisement.example.name
+  namespace = f5xc_infraprotect_internet_prefix_advertisement.example.namespace
+
+  # Set to false to withdraw the prefix
+  announce = true
+
+  timeouts {
+    create = "20m"
+    update = "20m"
+  }
+}
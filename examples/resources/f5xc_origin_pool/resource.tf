@@ -72,6 +72,10 @@ resource "f5xc_origin_pool" "example" {
     // One of the arguments from this list "skip_server_verification use_server_verification volterra_trusted_ca" must be set
 
     volterra_trusted_ca {}
+
+    // One of the arguments from this list "default_session_key_caching disable_session_key_caching max_session_keys" must be set
+
+    default_session_key_caching {}
   }
 
   // Health check configuration
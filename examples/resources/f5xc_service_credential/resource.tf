@@ -0,0 +1,45 @@
+# Service Credential Resource Example
+# Issues an API token, API certificate or kubeconfig for a service user in F5 Distributed Cloud.
+
+# API token of a CI pipeline that is replaced 14 days before it expires
+resource "f5xc_service_credential" "example" {
+  name      = "example-service-credential"
+  namespace = "system"
+
+  type               = "SERVICE_API_TOKEN"
+  expiration_days    = 90
+  rotate_before_days = 14
+
+  # Roles of the service user, changed in place
+  namespace_roles = [
+    {
+      namespace = "system"
+      role      = "ves-io-monitor-role"
+    },
+    {
+      namespace = "staging"
+      role      = "ves-io-admin-role"
+    },
+  ]
+}
+
+# API certificate of a service user in an existing user group
+variable "service_certificate_password" {
+  type      = string
+  sensitive = true
+}
+
+resource "f5xc_service_credential" "certificate" {
+  name             = "example-service-certificate"
+  type             = "SERVICE_API_CERTIFICATE"
+  user_group_names = ["automation"]
+
+  password         = var.service_certificate_password
+  password_version = 1
+}
+
+# The credential material is only available from the resource that issued it
+output "service_api_token" {
+  value     = f5xc_service_credential.example.data
+  sensitive = true
+}
@@ -0,0 +1,23 @@
+# Site Deployment Resource Example
+# Deploys the infrastructure of a cloud site in F5 Distributed Cloud.
+
+# Run the terraform apply of the site and wait until the site is online.
+# Leave wait_for_state of f5xc_aws_vpc_site unset, the site only comes online
+# after this deployment.
+resource "f5xc_site_deployment" "example" {
+  namespace = f5xc_aws_vpc_site.example.namespace
+  site_type = "aws_vpc_site"
+  site_name = f5xc_aws_vpc_site.example.name
+
+  wait_for_state = true
+
+  # Run the apply again when the site configuration changes
+  triggers = {
+    instance_type = f5xc_aws_vpc_site.example.instance_type
+  }
+
+  timeouts {
+    create = "45m"
+    delete = "45m"
+  }
+}
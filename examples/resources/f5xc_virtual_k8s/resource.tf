@@ -31,3 +31,18 @@ resource "f5xc_virtual_k8s" "example" {
     namespace = "staging"
   }
 }
+
+# Kubeconfig for the virtual K8s cluster, replaced 7 days before it expires
+resource "f5xc_api_credential" "example_kubeconfig" {
+  name                  = "example-virtual-k8s-kubeconfig"
+  type                  = "KUBE_CONFIG"
+  virtual_k8s_name      = f5xc_virtual_k8s.example.name
+  virtual_k8s_namespace = f5xc_virtual_k8s.example.namespace
+  expiration_days       = 30
+  rotate_before_days    = 7
+}
+
+output "kubeconfig" {
+  value     = f5xc_api_credential.example_kubeconfig.data
+  sensitive = true
+}
@@ -388,7 +388,7 @@ func CheckNamespaceDestroyed(s *terraform.State) error {
 
 			if err != nil {
 				// Check if it's a "not found" error - success!
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break // Resource is deleted
 				}
 				// Some other error occurred
@@ -563,7 +563,7 @@ func CheckHealthcheckDestroyed(s *terraform.State) error {
 
 			if err != nil {
 				// Check if it's a "not found" error - success!
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break // Resource is deleted
 				}
 				// Some other error occurred
@@ -737,7 +737,7 @@ func CheckIPPrefixSetDestroyed(s *terraform.State) error {
 			cancel()
 
 			if err != nil {
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break
 				}
 				return fmt.Errorf("unexpected error checking ip_prefix_set %s: %w", name, err)
@@ -853,7 +853,7 @@ func CheckBGPAsnSetDestroyed(s *terraform.State) error {
 			cancel()
 
 			if err != nil {
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break
 				}
 				return fmt.Errorf("unexpected error checking bgp_asn_set %s: %w", name, err)
@@ -972,7 +972,7 @@ func CheckPolicerDestroyed(s *terraform.State) error {
 			cancel()
 
 			if err != nil {
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break
 				}
 				return fmt.Errorf("unexpected error checking policer %s: %w", name, err)
@@ -1091,7 +1091,7 @@ func CheckGeoLocationSetDestroyed(s *terraform.State) error {
 			cancel()
 
 			if err != nil {
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break
 				}
 				return fmt.Errorf("unexpected error checking geo_location_set %s: %w", name, err)
@@ -1210,7 +1210,7 @@ func CheckDataGroupDestroyed(s *terraform.State) error {
 			cancel()
 
 			if err != nil {
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break
 				}
 				return fmt.Errorf("unexpected error checking data_group %s: %w", name, err)
@@ -1329,7 +1329,7 @@ func CheckDataTypeDestroyed(s *terraform.State) error {
 			cancel()
 
 			if err != nil {
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break
 				}
 				return fmt.Errorf("unexpected error checking data_type %s: %w", name, err)
@@ -1448,7 +1448,7 @@ func CheckFilterSetDestroyed(s *terraform.State) error {
 			cancel()
 
 			if err != nil {
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break
 				}
 				return fmt.Errorf("unexpected error checking filter_set %s: %w", name, err)
@@ -1567,7 +1567,7 @@ func CheckForwardingClassDestroyed(s *terraform.State) error {
 			cancel()
 
 			if err != nil {
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break
 				}
 				return fmt.Errorf("unexpected error checking forwarding_class %s: %w", name, err)
@@ -2465,7 +2465,7 @@ func CheckAppFirewallDestroyed(s *terraform.State) error {
 			cancel()
 
 			if err != nil {
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break
 				}
 				return fmt.Errorf("unexpected error checking app_firewall %s: %w", name, err)
@@ -2580,7 +2580,7 @@ func CheckOriginPoolDestroyed(s *terraform.State) error {
 			cancel()
 
 			if err != nil {
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break
 				}
 				return fmt.Errorf("unexpected error checking origin_pool %s: %w", name, err)
@@ -2695,7 +2695,7 @@ func CheckServicePolicyDestroyed(s *terraform.State) error {
 			cancel()
 
 			if err != nil {
-				if strings.Contains(err.Error(), "404") || strings.Contains(err.Error(), "not found") || strings.Contains(err.Error(), "NOT_FOUND") {
+				if isNotFoundError(err) {
 					break
 				}
 				return fmt.Errorf("unexpected error checking service_policy %s: %w", name, err)
@@ -52,6 +52,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 const (
@@ -212,7 +213,7 @@ func sweepNamespaces(_ string) error {
 
 	log.Printf("[INFO] Sweeping namespaces with prefix %q or %q", TestResourcePrefix, LegacyTestPrefix)
 
-	resp, err := c.ListNamespaces(ctx)
+	resp, err := c.ListNamespaces(ctx, "", client.ListOptions{})
 	if err != nil {
 		return fmt.Errorf("error listing namespaces: %w", err)
 	}
@@ -239,9 +240,7 @@ func sweepNamespaces(_ string) error {
 
 		if err != nil {
 			// Check for "not found" errors which indicate already deleted
-			if strings.Contains(err.Error(), "404") ||
-				strings.Contains(err.Error(), "not found") ||
-				strings.Contains(err.Error(), "NOT_FOUND") {
+			if isNotFoundError(err) {
 				log.Printf("[INFO] Namespace %s already deleted", name)
 				continue
 			}
@@ -287,7 +286,7 @@ func sweepHTTPLoadbalancers(_ string) error {
 	swept := 0
 
 	for _, ns := range namespaces {
-		resp, err := c.ListHTTPLoadBalancers(ctx, ns)
+		resp, err := c.ListHTTPLoadBalancers(ctx, ns, client.ListOptions{})
 		if err != nil {
 			// Skip namespaces that don't exist or have errors
 			continue
@@ -352,7 +351,7 @@ func sweepOriginPools(_ string) error {
 	swept := 0
 
 	for _, ns := range namespaces {
-		resp, err := c.ListOriginPools(ctx, ns)
+		resp, err := c.ListOriginPools(ctx, ns, client.ListOptions{})
 		if err != nil {
 			continue
 		}
@@ -416,7 +415,7 @@ func sweepHealthchecks(_ string) error {
 	swept := 0
 
 	for _, ns := range namespaces {
-		resp, err := c.ListHealthchecks(ctx, ns)
+		resp, err := c.ListHealthchecks(ctx, ns, client.ListOptions{})
 		if err != nil {
 			continue
 		}
@@ -480,7 +479,7 @@ func sweepAppFirewalls(_ string) error {
 	swept := 0
 
 	for _, ns := range namespaces {
-		resp, err := c.ListAppFirewalls(ctx, ns)
+		resp, err := c.ListAppFirewalls(ctx, ns, client.ListOptions{})
 		if err != nil {
 			continue
 		}
@@ -544,7 +543,7 @@ func sweepServicePolicies(_ string) error {
 	swept := 0
 
 	for _, ns := range namespaces {
-		resp, err := c.ListServicePolicies(ctx, ns)
+		resp, err := c.ListServicePolicies(ctx, ns, client.ListOptions{})
 		if err != nil {
 			continue
 		}
@@ -608,7 +607,7 @@ func sweepIPPrefixSets(_ string) error {
 	swept := 0
 
 	for _, ns := range namespaces {
-		resp, err := c.ListIPPrefixSets(ctx, ns)
+		resp, err := c.ListIPPrefixSets(ctx, ns, client.ListOptions{})
 		if err != nil {
 			continue
 		}
@@ -672,7 +671,7 @@ func sweepRateLimiters(_ string) error {
 	swept := 0
 
 	for _, ns := range namespaces {
-		resp, err := c.ListRateLimiters(ctx, ns)
+		resp, err := c.ListRateLimiters(ctx, ns, client.ListOptions{})
 		if err != nil {
 			continue
 		}
@@ -736,7 +735,7 @@ func sweepUserIdentifications(_ string) error {
 	swept := 0
 
 	for _, ns := range namespaces {
-		resp, err := c.ListUserIdentifications(ctx, ns)
+		resp, err := c.ListUserIdentifications(ctx, ns, client.ListOptions{})
 		if err != nil {
 			continue
 		}
@@ -800,7 +799,7 @@ func sweepMaliciousUserMitigations(_ string) error {
 	swept := 0
 
 	for _, ns := range namespaces {
-		resp, err := c.ListMaliciousUserMitigations(ctx, ns)
+		resp, err := c.ListMaliciousUserMitigations(ctx, ns, client.ListOptions{})
 		if err != nil {
 			continue
 		}
@@ -844,7 +843,7 @@ func sweepMaliciousUserMitigations(_ string) error {
 
 // getTestNamespaces returns a list of namespace names that match test patterns.
 func getTestNamespaces(ctx context.Context, c *client.Client) ([]string, error) {
-	resp, err := c.ListNamespaces(ctx)
+	resp, err := c.ListNamespaces(ctx, "", client.ListOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -861,11 +860,5 @@ func getTestNamespaces(ctx context.Context, c *client.Client) ([]string, error)
 
 // isNotFoundError checks if an error indicates the resource was not found.
 func isNotFoundError(err error) bool {
-	if err == nil {
-		return false
-	}
-	errStr := err.Error()
-	return strings.Contains(errStr, "404") ||
-		strings.Contains(errStr, "not found") ||
-		strings.Contains(errStr, "NOT_FOUND")
+	return f5xcerrors.IsNotFound(err)
 }
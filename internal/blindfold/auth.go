@@ -5,14 +5,13 @@ package blindfold
 
 import (
 	"crypto/tls"
-	"crypto/x509"
-	"encoding/pem"
 	"fmt"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
-	"golang.org/x/crypto/pkcs12"
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
 )
 
 // AuthConfig holds configuration for F5XC API authentication.
@@ -25,6 +24,17 @@ type AuthConfig struct {
 	P12Password string
 	// BaseURL is the F5XC API base URL.
 	BaseURL string
+	// HTTPProxy is the URL of the HTTP proxy. When empty, the proxy is taken
+	// from the HTTPS_PROXY and NO_PROXY environment variables.
+	HTTPProxy string
+	// CACertFile is the path to a PEM-encoded CA bundle for verifying the API server.
+	CACertFile string
+	// InsecureSkipVerify disables verification of the API server certificate.
+	InsecureSkipVerify bool
+	// Transport is the HTTP transport of the provider client. When set, it
+	// is used instead of HTTPProxy, CACertFile and InsecureSkipVerify, so
+	// that the transport settings of the provider configuration apply.
+	Transport *http.Transport
 }
 
 // AuthMethod represents the authentication method being used.
@@ -70,6 +80,10 @@ const (
 	EnvAPIToken    = "F5XC_API_TOKEN"
 	EnvP12File     = "F5XC_P12_FILE"
 	EnvP12Password = "F5XC_P12_PASSWORD" // pragma: allowlist secret
+
+	EnvHTTPProxy          = "F5XC_HTTP_PROXY"
+	EnvCACert             = "F5XC_CACERT"
+	EnvInsecureSkipVerify = "F5XC_INSECURE_SKIP_VERIFY"
 )
 
 // DefaultAPIURL is the default F5XC API URL.
@@ -86,12 +100,22 @@ func GetAuthConfigFromEnv() (*AuthConfig, error) {
 		P12File:     os.Getenv(EnvP12File),
 		P12Password: os.Getenv(EnvP12Password),
 		BaseURL:     os.Getenv(EnvAPIURL),
+		HTTPProxy:   os.Getenv(EnvHTTPProxy),
+		CACertFile:  os.Getenv(EnvCACert),
 	}
 
 	if config.BaseURL == "" {
 		config.BaseURL = DefaultAPIURL
 	}
 
+	if s := os.Getenv(EnvInsecureSkipVerify); s != "" {
+		skip, err := strconv.ParseBool(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s must be true or false, got: %s", EnvInsecureSkipVerify, s)
+		}
+		config.InsecureSkipVerify = skip
+	}
+
 	// Validate that at least one auth method is configured
 	if config.APIToken == "" && config.P12File == "" {
 		return nil, fmt.Errorf(
@@ -119,11 +143,12 @@ func CreateAuthenticatedClient(config *AuthConfig) (*AuthResult, error) {
 
 	// Priority 1: API Token authentication
 	if config.APIToken != "" {
-		client := &http.Client{
-			Timeout: DefaultTimeout,
+		httpClient, err := createHTTPClient(config, nil)
+		if err != nil {
+			return nil, err
 		}
 		return &AuthResult{
-			Client:  client,
+			Client:  httpClient,
 			Method:  AuthMethodToken,
 			BaseURL: config.BaseURL,
 			Token:   config.APIToken,
@@ -132,12 +157,16 @@ func CreateAuthenticatedClient(config *AuthConfig) (*AuthResult, error) {
 
 	// Priority 2: P12 Certificate authentication
 	if config.P12File != "" {
-		client, err := createHTTPClientFromP12(config.P12File, config.P12Password)
+		tlsConfig, err := client.LoadP12Certificate(config.P12File, config.P12Password)
 		if err != nil {
 			return nil, fmt.Errorf("failed to create P12 authenticated client: %w", err)
 		}
+		httpClient, err := createHTTPClient(config, tlsConfig)
+		if err != nil {
+			return nil, err
+		}
 		return &AuthResult{
-			Client:  client,
+			Client:  httpClient,
 			Method:  AuthMethodP12,
 			BaseURL: config.BaseURL,
 		}, nil
@@ -149,74 +178,51 @@ func CreateAuthenticatedClient(config *AuthConfig) (*AuthResult, error) {
 	)
 }
 
-// createHTTPClientFromP12 creates an HTTP client configured with P12 certificate authentication.
-// This implementation handles P12 files with certificate chains (multiple certificates).
-func createHTTPClientFromP12(p12File, password string) (*http.Client, error) {
-	p12Data, err := os.ReadFile(p12File)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read P12 file %q: %w", p12File, err)
-	}
-
-	// Use ToPEM to handle P12 files with certificate chains
-	blocks, err := pkcs12.ToPEM(p12Data, password)
-	if err != nil {
-		return nil, fmt.Errorf("failed to decode P12 file: %w", err)
-	}
-
-	var pemKey, pemCert []byte
-	var caCerts []*x509.Certificate
-
-	for _, block := range blocks {
-		switch block.Type {
-		case "PRIVATE KEY":
-			pemKey = append(pemKey, pem.EncodeToMemory(block)...)
-		case "CERTIFICATE":
-			cert, err := x509.ParseCertificate(block.Bytes)
-			if err != nil {
-				return nil, fmt.Errorf("failed to parse certificate: %w", err)
-			}
-			// Distinguish between CA certificates and client certificate
-			if cert.IsCA {
-				caCerts = append(caCerts, cert)
-			} else {
-				pemCert = append(pemCert, pem.EncodeToMemory(block)...)
-			}
-		}
-	}
-
-	if len(pemKey) == 0 || len(pemCert) == 0 {
-		return nil, fmt.Errorf("P12 file missing required key or certificate")
-	}
-
-	tlsCert, err := tls.X509KeyPair(pemCert, pemKey)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create X509 key pair: %w", err)
+// createHTTPClient creates an HTTP client with the transport settings of the
+// configuration, using the same transport as the provider client.
+func createHTTPClient(config *AuthConfig, tlsConfig *tls.Config) (*http.Client, error) {
+	if config.Transport != nil {
+		return &http.Client{
+			Timeout:   DefaultTimeout,
+			Transport: providerTransport(config.Transport, tlsConfig),
+		}, nil
 	}
 
-	// Create CA pool starting with system CAs, then add P12 CAs
-	caCertPool, err := x509.SystemCertPool()
+	transport, err := client.NewTransport(client.TransportConfig{
+		TLSConfig:          tlsConfig,
+		ProxyURL:           config.HTTPProxy,
+		CACertFile:         config.CACertFile,
+		InsecureSkipVerify: config.InsecureSkipVerify,
+	})
 	if err != nil {
-		// Fall back to empty pool if system certs unavailable
-		caCertPool = x509.NewCertPool()
+		return nil, fmt.Errorf("failed to create HTTP transport: %w", err)
 	}
-	for _, caCert := range caCerts {
-		caCertPool.AddCert(caCert)
-	}
-
-	transport := &http.Transport{
-		TLSClientConfig: &tls.Config{
-			Certificates: []tls.Certificate{tlsCert},
-			RootCAs:      caCertPool,
-			MinVersion:   tls.VersionTLS12,
-		},
-	}
-
 	return &http.Client{
 		Timeout:   DefaultTimeout,
 		Transport: transport,
 	}, nil
 }
 
+// providerTransport returns a copy of the transport of the provider client.
+// With P12 authentication, the certificate of tlsConfig replaces the one of
+// the provider client, while the CAs and TLS verification of the provider
+// configuration are kept.
+func providerTransport(transport *http.Transport, tlsConfig *tls.Config) *http.Transport {
+	t := transport.Clone()
+	if tlsConfig == nil {
+		return t
+	}
+	merged := tlsConfig.Clone()
+	if t.TLSClientConfig != nil {
+		if t.TLSClientConfig.RootCAs != nil {
+			merged.RootCAs = t.TLSClientConfig.RootCAs
+		}
+		merged.InsecureSkipVerify = t.TLSClientConfig.InsecureSkipVerify
+	}
+	t.TLSClientConfig = merged
+	return t
+}
+
 // SetAuthorizationHeader sets the appropriate authorization header on the request
 // based on the authentication method. For token auth, it adds the Bearer token.
 // For P12 auth, no header is needed as the certificate is used at the TLS layer.
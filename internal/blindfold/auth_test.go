@@ -3,6 +3,7 @@
 package blindfold
 
 import (
+	"crypto/tls"
 	"net/http"
 	"os"
 	"path/filepath"
@@ -204,6 +205,41 @@ func TestCreateAuthenticatedClient_P12(t *testing.T) {
 	})
 }
 
+func TestCreateAuthenticatedClient_ProviderTransport(t *testing.T) {
+	transport := &http.Transport{TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12, InsecureSkipVerify: true}}
+	config := &AuthConfig{
+		APIToken:  "test-token",
+		BaseURL:   "https://test.example.com/api",
+		HTTPProxy: "not a URL",
+		Transport: transport,
+	}
+
+	// The settings of the provider transport replace those of the environment
+	result, err := CreateAuthenticatedClient(config)
+	if err != nil {
+		t.Fatalf("CreateAuthenticatedClient() error = %v", err)
+	}
+	got, ok := result.Client.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("Transport = %T, want *http.Transport", result.Client.Transport)
+	}
+	if got == transport {
+		t.Error("Transport is the provider transport, want a copy")
+	}
+	if !got.TLSClientConfig.InsecureSkipVerify {
+		t.Error("InsecureSkipVerify of the provider transport was not kept")
+	}
+
+	// With P12 authentication the certificate is added to the provider settings
+	merged := providerTransport(transport, &tls.Config{Certificates: []tls.Certificate{{}}})
+	if len(merged.TLSClientConfig.Certificates) != 1 || !merged.TLSClientConfig.InsecureSkipVerify {
+		t.Errorf("TLSClientConfig = %+v, want the P12 certificate with the provider settings", merged.TLSClientConfig)
+	}
+	if len(transport.TLSClientConfig.Certificates) != 0 {
+		t.Error("providerTransport() modified the provider transport")
+	}
+}
+
 func TestAuthResult_SetAuthorizationHeader(t *testing.T) {
 	tests := []struct {
 		name       string
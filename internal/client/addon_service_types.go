@@ -14,6 +14,9 @@ import (
 type AddonService struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // AddonServiceDetails represents detailed addon service information
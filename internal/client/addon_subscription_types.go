@@ -12,6 +12,9 @@ import (
 type AddonSubscription struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAddonSubscription creates a new AddonSubscription
@@ -43,3 +46,9 @@ func (c *Client) DeleteAddonSubscription(ctx context.Context, namespace, name st
 	path := fmt.Sprintf("/api/web/namespaces/%s/addon_subscriptions/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAddonSubscriptions lists AddonSubscription objects
+func (c *Client) ListAddonSubscriptions(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/web/namespaces/%s/addon_subscriptions", namespace)
+	return c.List(ctx, path, opts)
+}
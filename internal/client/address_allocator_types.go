@@ -12,6 +12,9 @@ import (
 type AddressAllocator struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAddressAllocator creates a new AddressAllocator
@@ -43,3 +46,9 @@ func (c *Client) DeleteAddressAllocator(ctx context.Context, namespace, name str
 	path := fmt.Sprintf("/api/config/namespaces/%s/address_allocators/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAddressAllocators lists AddressAllocator objects
+func (c *Client) ListAddressAllocators(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/address_allocators", namespace)
+	return c.List(ctx, path, opts)
+}
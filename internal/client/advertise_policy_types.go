@@ -12,6 +12,9 @@ import (
 type AdvertisePolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAdvertisePolicy creates a new AdvertisePolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteAdvertisePolicy(ctx context.Context, namespace, name stri
 	path := fmt.Sprintf("/api/config/namespaces/%s/advertise_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAdvertisePolicies lists AdvertisePolicy objects
+func (c *Client) ListAdvertisePolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/advertise_policys", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type AlertPolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAlertPolicy creates a new AlertPolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteAlertPolicy(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/alert_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAlertPolicies lists AlertPolicy objects
+func (c *Client) ListAlertPolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/alert_policys", namespace)
+	return c.List(ctx, path, opts)
+}
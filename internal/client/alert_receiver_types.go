@@ -12,6 +12,9 @@ import (
 type AlertReceiver struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAlertReceiver creates a new AlertReceiver
@@ -43,3 +46,9 @@ func (c *Client) DeleteAlertReceiver(ctx context.Context, namespace, name string
 	path := fmt.Sprintf("/api/config/namespaces/%s/alert_receivers/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAlertReceivers lists AlertReceiver objects
+func (c *Client) ListAlertReceivers(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/alert_receivers", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type AllowedTenant struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAllowedTenant creates a new AllowedTenant
@@ -43,3 +46,9 @@ func (c *Client) DeleteAllowedTenant(ctx context.Context, namespace, name string
 	path := fmt.Sprintf("/api/web/namespaces/%s/allowed_tenants/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAllowedTenants lists AllowedTenant objects
+func (c *Client) ListAllowedTenants(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/web/namespaces/%s/allowed_tenants", namespace)
+	return c.List(ctx, path, opts)
+}
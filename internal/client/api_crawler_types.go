@@ -12,6 +12,9 @@ import (
 type APICrawler struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAPICrawler creates a new APICrawler
@@ -43,3 +46,9 @@ func (c *Client) DeleteAPICrawler(ctx context.Context, namespace, name string) e
 	path := fmt.Sprintf("/api/config/namespaces/%s/api_crawlers/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAPICrawlers lists APICrawler objects
+func (c *Client) ListAPICrawlers(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/api_crawlers", namespace)
+	return c.List(ctx, path, opts)
+}
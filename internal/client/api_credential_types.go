@@ -1,5 +1,8 @@
-// Code generated by generate-all-schemas.go. DO NOT EDIT.
-// Source: F5 XC OpenAPI specification
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// API credential client types for F5 XC
+// API credentials use custom create and revoke endpoints instead of the
+// standard object CRUD API, and return their secret material only once.
 
 package client
 
@@ -8,38 +11,196 @@ import (
 	"fmt"
 )
 
-// APICredential represents a F5XC APICredential
+// APICredential represents a F5XC API credential
 type APICredential struct {
-	Metadata Metadata               `json:"metadata"`
-	Spec     map[string]interface{} `json:"spec"`
+	Metadata Metadata          `json:"metadata"`
+	Spec     APICredentialSpec `json:"spec"`
+}
+
+// APICredentialSpec holds the global specification of an API credential
+type APICredentialSpec struct {
+	GCSpec APICredentialGlobalSpec `json:"gc_spec"`
+}
+
+// APICredentialGlobalSpec describes the state of an issued API credential
+type APICredentialGlobalSpec struct {
+	Active               bool   `json:"active,omitempty"`
+	Type                 string `json:"type,omitempty"`
+	CreatedTimestamp     string `json:"created_timestamp,omitempty"`
+	ExpirationTimestamp  string `json:"expiration_timestamp,omitempty"`
+	CertificateSerialNum string `json:"certificate_serial_num,omitempty"`
+	VirtualK8sName       string `json:"virtual_k8s_name,omitempty"`
+	VirtualK8sNamespace  string `json:"virtual_k8s_namespace,omitempty"`
 }
 
-// CreateAPICredential creates a new APICredential
-func (c *Client) CreateAPICredential(ctx context.Context, resource *APICredential) (*APICredential, error) {
-	var result APICredential
-	path := fmt.Sprintf("/api/web/namespaces/%s/revoke/api_credentials", resource.Metadata.Namespace)
-	err := c.Post(ctx, path, resource, &result)
+// APICredentialCreateRequest is the request body of CreateAPICredential
+type APICredentialCreateRequest struct {
+	Name           string                  `json:"name"`
+	Namespace      string                  `json:"namespace"`
+	ExpirationDays int64                   `json:"expiration_days,omitempty"`
+	Spec           APICredentialCreateSpec `json:"spec"`
+}
+
+// APICredentialCreateSpec selects the credential type and its parameters
+type APICredentialCreateSpec struct {
+	Type                string                       `json:"type"`
+	Password            string                       `json:"password,omitempty"`
+	VirtualK8sName      string                       `json:"virtual_k8s_name,omitempty"`
+	VirtualK8sNamespace string                       `json:"virtual_k8s_namespace,omitempty"`
+	SiteKubeconfig      *APICredentialSiteKubeconfig `json:"site_kubeconfig,omitempty"`
+}
+
+// APICredentialSiteKubeconfig selects the site of a SITE_GLOBAL_KUBE_CONFIG
+// credential
+type APICredentialSiteKubeconfig struct {
+	Site string `json:"site"`
+}
+
+// APICredentialCreateResponse carries the credential material, which the API
+// returns only in the create response
+type APICredentialCreateResponse struct {
+	Name                string `json:"name,omitempty"`
+	Active              bool   `json:"active,omitempty"`
+	Data                string `json:"data,omitempty"`
+	ExpirationTimestamp string `json:"expiration_timestamp,omitempty"`
+}
+
+// CreateAPICredential issues a new API credential
+func (c *Client) CreateAPICredential(ctx context.Context, req *APICredentialCreateRequest) (*APICredentialCreateResponse, error) {
+	var result APICredentialCreateResponse
+	path := fmt.Sprintf("/api/web/namespaces/%s/api_credentials", req.Namespace)
+	err := c.Post(ctx, path, req, &result)
 	return &result, err
 }
 
-// GetAPICredential retrieves a APICredential
+// GetAPICredential retrieves an APICredential
 func (c *Client) GetAPICredential(ctx context.Context, namespace, name string) (*APICredential, error) {
-	var result APICredential
-	path := fmt.Sprintf("/api/web/namespaces/%s/revoke/api_credentials/%s", namespace, name)
+	var result struct {
+		Object APICredential `json:"object"`
+	}
+	path := fmt.Sprintf("/api/web/namespaces/%s/api_credentials/%s", namespace, name)
 	err := c.Get(ctx, path, &result)
+	return &result.Object, err
+}
+
+// RevokeAPICredential revokes an API credential, which deletes it
+func (c *Client) RevokeAPICredential(ctx context.Context, namespace, name string) error {
+	path := fmt.Sprintf("/api/web/namespaces/%s/revoke/api_credentials", namespace)
+	body := map[string]string{
+		"name":      name,
+		"namespace": namespace,
+	}
+	return c.Post(ctx, path, body, nil)
+}
+
+// Service credential types issue credentials for a service user, which is
+// created together with the credential and holds its own roles
+const (
+	ServiceCredentialTypeAPIToken             = "SERVICE_API_TOKEN"
+	ServiceCredentialTypeAPICertificate       = "SERVICE_API_CERTIFICATE"
+	ServiceCredentialTypeKubeconfig           = "SERVICE_KUBE_CONFIG"
+	ServiceCredentialTypeSiteGlobalKubeconfig = "SERVICE_SITE_GLOBAL_KUBE_CONFIG"
+)
+
+// NamespaceRole assigns a role in a namespace. The namespace "*" applies the
+// role to all namespaces.
+type NamespaceRole struct {
+	Namespace string `json:"namespace"`
+	Role      string `json:"role"`
+}
+
+// NamespaceAccess lists the roles of a service credential by namespace
+type NamespaceAccess struct {
+	NamespaceRoleMap map[string]RoleList `json:"namespace_role_map"`
+}
+
+// RoleList is a list of role names
+type RoleList struct {
+	Names []string `json:"names"`
+}
+
+// ServiceCredentialCreateRequest is the request body of
+// CreateServiceCredential. Exactly one of the credential blocks is set,
+// matching the type.
+type ServiceCredentialCreateRequest struct {
+	Name           string                           `json:"name"`
+	Namespace      string                           `json:"namespace"`
+	Type           string                           `json:"type"`
+	ExpirationDays int64                            `json:"expiration_days,omitempty"`
+	NamespaceRoles []NamespaceRole                  `json:"namespace_roles,omitempty"`
+	UserGroupNames []string                         `json:"user_group_names,omitempty"`
+	APIToken       *struct{}                        `json:"api_token,omitempty"`
+	APICertificate *ServiceCredentialAPICertificate `json:"api_certificate,omitempty"`
+	Vk8sKubeconfig *ServiceCredentialVk8sKubeconfig `json:"vk8s_kubeconfig,omitempty"`
+	SiteKubeconfig *APICredentialSiteKubeconfig     `json:"site_kubeconfig,omitempty"`
+}
+
+// ServiceCredentialAPICertificate holds the password of the P12 bundle of a
+// SERVICE_API_CERTIFICATE credential
+type ServiceCredentialAPICertificate struct {
+	Password string `json:"password,omitempty"`
+}
+
+// ServiceCredentialVk8sKubeconfig selects the virtual K8s cluster of a
+// SERVICE_KUBE_CONFIG credential
+type ServiceCredentialVk8sKubeconfig struct {
+	Vk8sClusterName string `json:"vk8s_cluster_name,omitempty"`
+	Vk8sNamespace   string `json:"vk8s_namespace,omitempty"`
+}
+
+// ServiceCredential describes an issued service credential
+type ServiceCredential struct {
+	Name            string           `json:"name,omitempty"`
+	Namespace       string           `json:"namespace,omitempty"`
+	Type            string           `json:"type,omitempty"`
+	Active          bool             `json:"active,omitempty"`
+	CreateTimestamp string           `json:"create_timestamp,omitempty"`
+	ExpiryTimestamp string           `json:"expiry_timestamp,omitempty"`
+	UserEmail       string           `json:"user_email,omitempty"`
+	NamespaceAccess *NamespaceAccess `json:"namespace_access,omitempty"`
+	UserGroupNames  []string         `json:"user_group_names,omitempty"`
+}
+
+// ServiceCredentialReplaceRequest is the request body of
+// ReplaceServiceCredential, which replaces the roles and user groups of a
+// service credential
+type ServiceCredentialReplaceRequest struct {
+	Name            string           `json:"name"`
+	Namespace       string           `json:"namespace"`
+	NamespaceAccess *NamespaceAccess `json:"namespace_access,omitempty"`
+	UserGroupNames  []string         `json:"user_group_names"`
+}
+
+// CreateServiceCredential issues a new service credential
+func (c *Client) CreateServiceCredential(ctx context.Context, req *ServiceCredentialCreateRequest) (*APICredentialCreateResponse, error) {
+	var result APICredentialCreateResponse
+	path := fmt.Sprintf("/api/web/namespaces/%s/service_credentials", req.Namespace)
+	err := c.Post(ctx, path, req, &result)
 	return &result, err
 }
 
-// UpdateAPICredential updates a APICredential
-func (c *Client) UpdateAPICredential(ctx context.Context, resource *APICredential) (*APICredential, error) {
-	var result APICredential
-	path := fmt.Sprintf("/api/web/namespaces/%s/revoke/api_credentials/%s", resource.Metadata.Namespace, resource.Metadata.Name)
-	err := c.Put(ctx, path, resource, &result)
+// GetServiceCredential retrieves a service credential
+func (c *Client) GetServiceCredential(ctx context.Context, namespace, name string) (*ServiceCredential, error) {
+	var result ServiceCredential
+	path := fmt.Sprintf("/api/web/namespaces/%s/service_credentials/%s", namespace, name)
+	err := c.Get(ctx, path, &result)
 	return &result, err
 }
 
-// DeleteAPICredential deletes a APICredential
-func (c *Client) DeleteAPICredential(ctx context.Context, namespace, name string) error {
-	path := fmt.Sprintf("/api/web/namespaces/%s/revoke/api_credentials/%s", namespace, name)
-	return c.Delete(ctx, path)
+// ReplaceServiceCredential replaces the roles and user groups of a service
+// credential. The credential material is not changed.
+func (c *Client) ReplaceServiceCredential(ctx context.Context, req *ServiceCredentialReplaceRequest) error {
+	path := fmt.Sprintf("/api/web/namespaces/%s/service_credentials/%s", req.Namespace, req.Name)
+	return c.Put(ctx, path, req, nil)
+}
+
+// RevokeServiceCredential revokes a service credential, which deletes it
+// together with its service user
+func (c *Client) RevokeServiceCredential(ctx context.Context, namespace, name string) error {
+	path := fmt.Sprintf("/api/web/namespaces/%s/revoke/service_credentials", namespace)
+	body := map[string]string{
+		"name":      name,
+		"namespace": namespace,
+	}
+	return c.Post(ctx, path, body, nil)
 }
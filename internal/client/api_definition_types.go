@@ -12,6 +12,9 @@ import (
 type APIDefinition struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAPIDefinition creates a new APIDefinition
@@ -43,3 +46,9 @@ func (c *Client) DeleteAPIDefinition(ctx context.Context, namespace, name string
 	path := fmt.Sprintf("/api/config/namespaces/%s/api_definitions/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAPIDefinitions lists APIDefinition objects
+func (c *Client) ListAPIDefinitions(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/api_definitions", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type APIDiscovery struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAPIDiscovery creates a new APIDiscovery
@@ -43,3 +46,9 @@ func (c *Client) DeleteAPIDiscovery(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/api_discoverys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAPIDiscoveries lists APIDiscovery objects
+func (c *Client) ListAPIDiscoveries(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/api_discoverys", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type APITesting struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAPITesting creates a new APITesting
@@ -43,3 +46,9 @@ func (c *Client) DeleteAPITesting(ctx context.Context, namespace, name string) e
 	path := fmt.Sprintf("/api/config/namespaces/%s/api_testings/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAPITestings lists APITesting objects
+func (c *Client) ListAPITestings(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/api_testings", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type APM struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAPM creates a new APM
@@ -43,3 +46,9 @@ func (c *Client) DeleteAPM(ctx context.Context, namespace, name string) error {
 	path := fmt.Sprintf("/api/config/namespaces/%s/apms/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAPMs lists APM objects
+func (c *Client) ListAPMs(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/apms", namespace)
+	return c.List(ctx, path, opts)
+}
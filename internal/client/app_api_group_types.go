@@ -12,6 +12,9 @@ import (
 type AppAPIGroup struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAppAPIGroup creates a new AppAPIGroup
@@ -43,3 +46,9 @@ func (c *Client) DeleteAppAPIGroup(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/app_api_groups/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAppAPIGroups lists AppAPIGroup objects
+func (c *Client) ListAppAPIGroups(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/app_api_groups", namespace)
+	return c.List(ctx, path, opts)
+}
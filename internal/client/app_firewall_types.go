@@ -12,6 +12,9 @@ import (
 type AppFirewall struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAppFirewall creates a new AppFirewall
@@ -43,3 +46,9 @@ func (c *Client) DeleteAppFirewall(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/app_firewalls/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAppFirewalls lists AppFirewall objects
+func (c *Client) ListAppFirewalls(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/app_firewalls", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type AppSetting struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAppSetting creates a new AppSetting
@@ -43,3 +46,9 @@ func (c *Client) DeleteAppSetting(ctx context.Context, namespace, name string) e
 	path := fmt.Sprintf("/api/config/namespaces/%s/app_settings/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAppSettings lists AppSetting objects
+func (c *Client) ListAppSettings(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/app_settings", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type AppType struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAppType creates a new AppType
@@ -43,3 +46,9 @@ func (c *Client) DeleteAppType(ctx context.Context, namespace, name string) erro
 	path := fmt.Sprintf("/api/config/namespaces/%s/app_types/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAppTypes lists AppType objects
+func (c *Client) ListAppTypes(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/app_types", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type Authentication struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAuthentication creates a new Authentication
@@ -43,3 +46,9 @@ func (c *Client) DeleteAuthentication(ctx context.Context, namespace, name strin
 	path := fmt.Sprintf("/api/config/namespaces/%s/authentications/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAuthentications lists Authentication objects
+func (c *Client) ListAuthentications(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/authentications", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type AWSTGWSite struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAWSTGWSite creates a new AWSTGWSite
@@ -43,3 +46,9 @@ func (c *Client) DeleteAWSTGWSite(ctx context.Context, namespace, name string) e
 	path := fmt.Sprintf("/api/config/namespaces/%s/aws_tgw_sites/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAWSTGWSites lists AWSTGWSite objects
+func (c *Client) ListAWSTGWSites(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/aws_tgw_sites", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type AWSVPCSite struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAWSVPCSite creates a new AWSVPCSite
@@ -43,3 +46,9 @@ func (c *Client) DeleteAWSVPCSite(ctx context.Context, namespace, name string) e
 	path := fmt.Sprintf("/api/config/namespaces/%s/aws_vpc_sites/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAWSVPCSites lists AWSVPCSite objects
+func (c *Client) ListAWSVPCSites(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/aws_vpc_sites", namespace)
+	return c.List(ctx, path, opts)
+}
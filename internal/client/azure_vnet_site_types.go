@@ -12,6 +12,9 @@ import (
 type AzureVNETSite struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateAzureVNETSite creates a new AzureVNETSite
@@ -43,3 +46,9 @@ func (c *Client) DeleteAzureVNETSite(ctx context.Context, namespace, name string
 	path := fmt.Sprintf("/api/config/namespaces/%s/azure_vnet_sites/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListAzureVNETSites lists AzureVNETSite objects
+func (c *Client) ListAzureVNETSites(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/azure_vnet_sites", namespace)
+	return c.List(ctx, path, opts)
+}
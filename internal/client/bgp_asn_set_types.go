@@ -12,6 +12,9 @@ import (
 type BGPAsnSet struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateBGPAsnSet creates a new BGPAsnSet
@@ -43,3 +46,9 @@ func (c *Client) DeleteBGPAsnSet(ctx context.Context, namespace, name string) er
 	path := fmt.Sprintf("/api/config/namespaces/%s/bgp_asn_sets/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListBGPAsnSets lists BGPAsnSet objects
+func (c *Client) ListBGPAsnSets(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/bgp_asn_sets", namespace)
+	return c.List(ctx, path, opts)
+}
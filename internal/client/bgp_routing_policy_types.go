@@ -12,6 +12,9 @@ import (
 type BGPRoutingPolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateBGPRoutingPolicy creates a new BGPRoutingPolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteBGPRoutingPolicy(ctx context.Context, namespace, name str
 	path := fmt.Sprintf("/api/config/namespaces/%s/bgp_routing_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListBGPRoutingPolicies lists BGPRoutingPolicy objects
+func (c *Client) ListBGPRoutingPolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/bgp_routing_policys", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type BGP struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateBGP creates a new BGP
@@ -43,3 +46,9 @@ func (c *Client) DeleteBGP(ctx context.Context, namespace, name string) error {
 	path := fmt.Sprintf("/api/config/namespaces/%s/bgps/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListBGPs lists BGP objects
+func (c *Client) ListBGPs(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/bgps", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type BotDefenseAppInfrastructure struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateBotDefenseAppInfrastructure creates a new BotDefenseAppInfrastructure
@@ -43,3 +46,9 @@ func (c *Client) DeleteBotDefenseAppInfrastructure(ctx context.Context, namespac
 	path := fmt.Sprintf("/api/config/namespaces/%s/bot_defense_app_infrastructures/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListBotDefenseAppInfrastructures lists BotDefenseAppInfrastructure objects
+func (c *Client) ListBotDefenseAppInfrastructures(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/bot_defense_app_infrastructures", namespace)
+	return c.List(ctx, path, opts)
+}
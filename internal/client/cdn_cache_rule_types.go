@@ -12,6 +12,9 @@ import (
 type CDNCacheRule struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateCDNCacheRule creates a new CDNCacheRule
@@ -43,3 +46,9 @@ func (c *Client) DeleteCDNCacheRule(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/cdn_cache_rules/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListCDNCacheRules lists CDNCacheRule objects
+func (c *Client) ListCDNCacheRules(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/cdn_cache_rules", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type CDNLoadBalancer struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateCDNLoadBalancer creates a new CDNLoadBalancer
@@ -43,3 +46,9 @@ func (c *Client) DeleteCDNLoadBalancer(ctx context.Context, namespace, name stri
 	path := fmt.Sprintf("/api/config/namespaces/%s/cdn_loadbalancers/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListCDNLoadBalancers lists CDNLoadBalancer objects
+func (c *Client) ListCDNLoadBalancers(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/cdn_loadbalancers", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type CertificateChain struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateCertificateChain creates a new CertificateChain
@@ -43,3 +46,9 @@ func (c *Client) DeleteCertificateChain(ctx context.Context, namespace, name str
 	path := fmt.Sprintf("/api/config/namespaces/%s/certificate_chains/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListCertificateChains lists CertificateChain objects
+func (c *Client) ListCertificateChains(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/certificate_chains", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type Certificate struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateCertificate creates a new Certificate
@@ -43,3 +46,9 @@ func (c *Client) DeleteCertificate(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/certificates/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListCertificates lists Certificate objects
+func (c *Client) ListCertificates(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/certificates", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type ChildTenantManager struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateChildTenantManager creates a new ChildTenantManager
@@ -43,3 +46,9 @@ func (c *Client) DeleteChildTenantManager(ctx context.Context, namespace, name s
 	path := fmt.Sprintf("/api/web/namespaces/%s/child_tenant_managers/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListChildTenantManagers lists ChildTenantManager objects
+func (c *Client) ListChildTenantManagers(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/web/namespaces/%s/child_tenant_managers", namespace)
+	return c.List(ctx, path, opts)
+}
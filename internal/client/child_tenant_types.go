@@ -12,6 +12,9 @@ import (
 type ChildTenant struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateChildTenant creates a new ChildTenant
@@ -43,3 +46,9 @@ func (c *Client) DeleteChildTenant(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/web/namespaces/%s/child_tenants/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListChildTenants lists ChildTenant objects
+func (c *Client) ListChildTenants(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/web/namespaces/%s/child_tenants", namespace)
+	return c.List(ctx, path, opts)
+}
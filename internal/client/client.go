@@ -23,11 +23,10 @@ import (
 
 // Client configuration defaults
 const (
-	DefaultTimeout        = 30 * time.Second
-	DefaultMaxRetries     = 3
-	DefaultRetryWaitMin   = 1 * time.Second
-	DefaultRetryWaitMax   = 30 * time.Second
-	DefaultRateLimitDelay = 60 * time.Second
+	DefaultTimeout      = 30 * time.Second
+	DefaultMaxRetries   = 3
+	DefaultRetryWaitMin = 1 * time.Second
+	DefaultRetryWaitMax = 30 * time.Second
 )
 
 // AuthType represents the authentication method used by the client
@@ -49,6 +48,31 @@ type Client struct {
 	MaxRetries   int
 	RetryWaitMin time.Duration
 	RetryWaitMax time.Duration
+
+	// limiter limits the request rate of the client and holds back all
+	// requests after a rate limited response
+	limiter *rateLimiter
+
+	// headers are added to every request
+	headers map[string]string
+
+	// DefaultLabels and DefaultAnnotations are merged into the labels and
+	// annotations of every object that resources create or update. Values
+	// set on the resource take precedence.
+	DefaultLabels      map[string]string
+	DefaultAnnotations map[string]string
+
+	// DefaultNamespace is the namespace of objects whose resource does not
+	// set one
+	DefaultNamespace string
+
+	// SubscriptionTier is the subscription tier of the tenant, when known.
+	// It is only used for plan-time checks and never sent to the API.
+	SubscriptionTier string
+
+	// err is the error of an option that could not be applied. Requests of
+	// the client fail with it.
+	err error
 }
 
 // ClientOption allows customizing the client
@@ -76,6 +100,36 @@ func WithRetryWait(min, max time.Duration) ClientOption {
 	}
 }
 
+// WithRateLimit limits the client to rps requests per second with bursts of
+// up to burst requests. An rps of 0 removes the limit.
+func WithRateLimit(rps float64, burst int) ClientOption {
+	return func(c *Client) {
+		c.limiter = newRateLimiter(rps, burst)
+	}
+}
+
+// WithDefaultLabels sets the labels merged into every object
+func WithDefaultLabels(labels map[string]string) ClientOption {
+	return func(c *Client) {
+		c.DefaultLabels = labels
+	}
+}
+
+// WithDefaultAnnotations sets the annotations merged into every object
+func WithDefaultAnnotations(annotations map[string]string) ClientOption {
+	return func(c *Client) {
+		c.DefaultAnnotations = annotations
+	}
+}
+
+// WithDefaultNamespace sets the namespace of objects whose resource does not
+// set one
+func WithDefaultNamespace(namespace string) ClientOption {
+	return func(c *Client) {
+		c.DefaultNamespace = namespace
+	}
+}
+
 // WithHTTPClient sets a custom HTTP client (useful for testing with mock servers)
 func WithHTTPClient(httpClient *http.Client) ClientOption {
 	return func(c *Client) {
@@ -83,12 +137,18 @@ func WithHTTPClient(httpClient *http.Client) ClientOption {
 	}
 }
 
-// WithTLSConfig sets a custom TLS configuration on the HTTP client
+// WithTLSConfig sets a custom TLS configuration on the HTTP client. The rest
+// of the transport, such as the proxy, is kept. A custom round tripper given
+// with WithHTTPClient cannot take the TLS configuration, so the client
+// constructors and all requests of the client fail instead.
 func WithTLSConfig(tlsConfig *tls.Config) ClientOption {
 	return func(c *Client) {
-		c.HTTPClient.Transport = &http.Transport{
-			TLSClientConfig: tlsConfig,
+		t := c.transport()
+		if t == nil {
+			c.err = fmt.Errorf("cannot set the TLS configuration: the HTTP client uses the round tripper %T instead of an *http.Transport", c.HTTPClient.Transport)
+			return
 		}
+		t.TLSClientConfig = tlsConfig
 		c.AuthType = AuthTypeCertificate
 	}
 }
@@ -169,13 +229,9 @@ func LoadCertificateKeyPair(certFile, keyFile, caFile string) (*tls.Config, erro
 	}
 
 	if caFile != "" {
-		caCert, err := os.ReadFile(caFile)
+		caCertPool, err := LoadCACertPool(caFile)
 		if err != nil {
-			return nil, fmt.Errorf("failed to read CA certificate: %w", err)
-		}
-		caCertPool := x509.NewCertPool()
-		if !caCertPool.AppendCertsFromPEM(caCert) {
-			return nil, fmt.Errorf("failed to parse CA certificate")
+			return nil, err
 		}
 		tlsConfig.RootCAs = caCertPool
 	}
@@ -192,8 +248,10 @@ func NewClient(baseURL, apiToken string, opts ...ClientOption) *Client {
 		MaxRetries:   DefaultMaxRetries,
 		RetryWaitMin: DefaultRetryWaitMin,
 		RetryWaitMax: DefaultRetryWaitMax,
+		limiter:      newRateLimiter(0, 1),
 		HTTPClient: &http.Client{
-			Timeout: DefaultTimeout,
+			Timeout:   DefaultTimeout,
+			Transport: newTransport(nil),
 		},
 	}
 
@@ -217,17 +275,19 @@ func NewClientWithP12(baseURL, p12File, p12Password string, opts ...ClientOption
 		MaxRetries:   DefaultMaxRetries,
 		RetryWaitMin: DefaultRetryWaitMin,
 		RetryWaitMax: DefaultRetryWaitMax,
+		limiter:      newRateLimiter(0, 1),
 		HTTPClient: &http.Client{
-			Timeout: DefaultTimeout,
-			Transport: &http.Transport{
-				TLSClientConfig: tlsConfig,
-			},
+			Timeout:   DefaultTimeout,
+			Transport: newTransport(tlsConfig),
 		},
 	}
 
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.err != nil {
+		return nil, c.err
+	}
 
 	return c, nil
 }
@@ -245,17 +305,19 @@ func NewClientWithCert(baseURL, certFile, keyFile, caFile string, opts ...Client
 		MaxRetries:   DefaultMaxRetries,
 		RetryWaitMin: DefaultRetryWaitMin,
 		RetryWaitMax: DefaultRetryWaitMax,
+		limiter:      newRateLimiter(0, 1),
 		HTTPClient: &http.Client{
-			Timeout: DefaultTimeout,
-			Transport: &http.Transport{
-				TLSClientConfig: tlsConfig,
-			},
+			Timeout:   DefaultTimeout,
+			Transport: newTransport(tlsConfig),
 		},
 	}
 
 	for _, opt := range opts {
 		opt(c)
 	}
+	if c.err != nil {
+		return nil, c.err
+	}
 
 	return c, nil
 }
@@ -271,6 +333,17 @@ type Metadata struct {
 	UID         string            `json:"uid,omitempty"`
 }
 
+// SystemMetadata represents the system-managed metadata of an object. It is
+// returned by the API but never sent with create or replace requests.
+type SystemMetadata struct {
+	UID                   string `json:"uid,omitempty"`
+	CreationTimestamp     string `json:"creation_timestamp,omitempty"`
+	ModificationTimestamp string `json:"modification_timestamp,omitempty"`
+	CreatorClass          string `json:"creator_class,omitempty"`
+	CreatorID             string `json:"creator_id,omitempty"`
+	Tenant                string `json:"tenant,omitempty"`
+}
+
 // isRetryableStatus returns true if the HTTP status code indicates a retryable error
 func isRetryableStatus(statusCode int) bool {
 	switch statusCode {
@@ -305,13 +378,17 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 		}
 	}
 
+	if c.err != nil {
+		return nil, c.err
+	}
+
 	url := c.BaseURL + path
 	var lastErr error
 
 	for attempt := 0; attempt <= c.MaxRetries; attempt++ {
-		// Check context before making request
-		if ctx.Err() != nil {
-			return nil, f5xcerrors.NewTimeoutError("request", method+" "+path, ctx.Err())
+		// Wait for the rate limiter, which also checks the context
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, f5xcerrors.NewTimeoutError("request", method+" "+path, err)
 		}
 
 		var reqBody io.Reader
@@ -324,6 +401,10 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 			return nil, f5xcerrors.WrapError(err, "request", "create")
 		}
 
+		for k, v := range c.headers {
+			req.Header.Set(k, v)
+		}
+
 		// Only set Authorization header for token-based authentication
 		// Certificate-based authentication uses TLS client certificates instead
 		if c.AuthType == AuthTypeToken && c.APIToken != "" {
@@ -340,7 +421,7 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 				select {
 				case <-ctx.Done():
 					return nil, f5xcerrors.NewTimeoutError("request", method+" "+path, ctx.Err())
-				case <-time.After(c.calculateBackoff(attempt)):
+				case <-time.After(jitter(c.calculateBackoff(attempt))):
 					continue
 				}
 			}
@@ -367,10 +448,15 @@ func (c *Client) doRequest(ctx context.Context, method, path string, body interf
 			return nil, apiErr
 		}
 
-		// Calculate backoff (use longer delay for rate limiting)
-		backoff := c.calculateBackoff(attempt)
+		// Calculate backoff, honoring the delay requested by the server. Like
+		// any other wait it ends early when the context is done.
+		backoff := jitter(c.calculateBackoff(attempt))
+		if delay, ok := retryAfter(resp.Header, time.Now()); ok {
+			backoff = delay
+		}
 		if resp.StatusCode == http.StatusTooManyRequests {
-			backoff = DefaultRateLimitDelay
+			// Hold back the other requests of the client as well
+			c.limiter.Pause(backoff)
 		}
 
 		select {
@@ -390,10 +476,7 @@ func (c *Client) Get(ctx context.Context, path string, result interface{}) error
 	if err != nil {
 		return err
 	}
-	if result != nil && len(body) > 0 {
-		return json.Unmarshal(body, result)
-	}
-	return nil
+	return decodeResponse(body, path, result)
 }
 
 // Post performs a POST request
@@ -402,10 +485,7 @@ func (c *Client) Post(ctx context.Context, path string, data, result interface{}
 	if err != nil {
 		return err
 	}
-	if result != nil && len(body) > 0 {
-		return json.Unmarshal(body, result)
-	}
-	return nil
+	return decodeResponse(body, path, result)
 }
 
 // Put performs a PUT request
@@ -414,8 +494,16 @@ func (c *Client) Put(ctx context.Context, path string, data, result interface{})
 	if err != nil {
 		return err
 	}
-	if result != nil && len(body) > 0 {
-		return json.Unmarshal(body, result)
+	return decodeResponse(body, path, result)
+}
+
+// decodeResponse unmarshals a response body into result
+func decodeResponse(body []byte, path string, result interface{}) error {
+	if result == nil || len(body) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(body, result); err != nil {
+		return f5xcerrors.WrapError(err, path, "unmarshal")
 	}
 	return nil
 }
@@ -425,3 +513,48 @@ func (c *Client) Delete(ctx context.Context, path string) error {
 	_, err := c.doRequest(ctx, http.MethodDelete, path, nil)
 	return err
 }
+
+// AuthenticatedHTTPClient returns an HTTP client that authenticates requests
+// the same way as the client, for APIs called outside of doRequest such as
+// the blindfold secret management endpoints
+func (c *Client) AuthenticatedHTTPClient() *http.Client {
+	var token string
+	if c.AuthType == AuthTypeToken {
+		token = c.APIToken
+	}
+	if token == "" && len(c.headers) == 0 {
+		return c.HTTPClient
+	}
+	transport := c.HTTPClient.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &http.Client{
+		Timeout: c.HTTPClient.Timeout,
+		Transport: &apiTokenTransport{
+			token:     token,
+			headers:   c.headers,
+			transport: transport,
+		},
+	}
+}
+
+// apiTokenTransport is an http.RoundTripper that adds API token
+// authentication and the extra headers of the client
+type apiTokenTransport struct {
+	token     string
+	headers   map[string]string
+	transport http.RoundTripper
+}
+
+func (t *apiTokenTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// Clone the request to avoid modifying the original
+	reqClone := req.Clone(req.Context())
+	for k, v := range t.headers {
+		reqClone.Header.Set(k, v)
+	}
+	if t.token != "" {
+		reqClone.Header.Set("Authorization", "APIToken "+t.token)
+	}
+	return t.transport.RoundTrip(reqClone)
+}
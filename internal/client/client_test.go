@@ -11,6 +11,8 @@ import (
 	"path/filepath"
 	"testing"
 	"time"
+
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 // =============================================================================
@@ -283,6 +285,9 @@ func TestGetNotFound(t *testing.T) {
 	if err == nil {
 		t.Fatal("Expected error, got nil")
 	}
+	if !f5xcerrors.IsNotFound(err) {
+		t.Errorf("Expected IsNotFound(%v) to be true", err)
+	}
 }
 
 func TestPostSuccess(t *testing.T) {
@@ -613,6 +618,42 @@ func TestAuthTypeToken(t *testing.T) {
 	}
 }
 
+func TestAuthenticatedHTTPClient(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		auth := r.Header.Get("Authorization")
+		if auth != "APIToken my-secret-token" {
+			t.Errorf("Authorization header = %s, want APIToken my-secret-token", auth)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "my-secret-token")
+	httpClient := client.AuthenticatedHTTPClient()
+	if httpClient.Timeout != client.HTTPClient.Timeout {
+		t.Errorf("Timeout = %v, want %v", httpClient.Timeout, client.HTTPClient.Timeout)
+	}
+
+	req, err := http.NewRequest(http.MethodGet, server.URL+"/test", nil)
+	if err != nil {
+		t.Fatalf("NewRequest() error = %v", err)
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		t.Fatalf("Do() error = %v", err)
+	}
+	_ = resp.Body.Close()
+	if req.Header.Get("Authorization") != "" {
+		t.Error("AuthenticatedHTTPClient() modified the original request")
+	}
+
+	// Certificate authentication is part of the TLS configuration of the client
+	certClient := &Client{AuthType: AuthTypeCertificate, HTTPClient: &http.Client{}}
+	if certClient.AuthenticatedHTTPClient() != certClient.HTTPClient {
+		t.Error("AuthenticatedHTTPClient() should return the HTTP client for certificate authentication")
+	}
+}
+
 // =============================================================================
 // Tests for Metadata struct
 // =============================================================================
@@ -712,21 +753,16 @@ func TestDefaultConstants(t *testing.T) {
 	if DefaultRetryWaitMax != 30*time.Second {
 		t.Errorf("DefaultRetryWaitMax = %v, want 30s", DefaultRetryWaitMax)
 	}
-	if DefaultRateLimitDelay != 60*time.Second {
-		t.Errorf("DefaultRateLimitDelay = %v, want 60s", DefaultRateLimitDelay)
-	}
 }
 
 // =============================================================================
 // Tests for rate limit handling
 // =============================================================================
 
-func TestRateLimitHandlingUsesLongerDelay(t *testing.T) {
+func TestRateLimitHandlingRetries(t *testing.T) {
 	// This test verifies that 429 responses are recognized as retryable
-	// and that the client attempts to retry them.
-	// Note: The actual DefaultRateLimitDelay (60s) is used for 429 responses,
-	// which is too long for unit tests. We use a short context timeout to
-	// verify the retry behavior without waiting the full 60 seconds.
+	// and that the client attempts to retry them. Without a Retry-After
+	// header they use the normal exponential backoff.
 
 	attemptCount := 0
 
@@ -742,7 +778,7 @@ func TestRateLimitHandlingUsesLongerDelay(t *testing.T) {
 		WithRetryWait(1*time.Millisecond, 10*time.Millisecond),
 	)
 
-	// Use a short context timeout to avoid waiting 60 seconds
+	// Use a short context timeout to bound the retries
 	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
 	defer cancel()
 
@@ -763,3 +799,97 @@ func TestRateLimitHandlingUsesLongerDelay(t *testing.T) {
 		t.Error("429 should be recognized as retryable status")
 	}
 }
+
+// =============================================================================
+// Tests for List()
+// =============================================================================
+
+func TestListLabelSelectorAndNormalization(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/config/namespaces/shared/origin_pools" {
+			t.Errorf("path = %s, want /api/config/namespaces/shared/origin_pools", r.URL.Path)
+		}
+		if got := r.URL.Query().Get("label_filter"); got != "app in (web, api)" {
+			t.Errorf("label_filter = %q, want %q", got, "app in (web, api)")
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"items": [
+			{"name": "pool-a", "namespace": "shared", "tenant": "acme", "uid": "uid-a", "labels": {"app": "web"}, "disabled": true},
+			{"metadata": {"name": "pool-b", "namespace": "shared"}, "spec": {"port": 443}}
+		]}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.ListOriginPools(context.Background(), "shared", ListOptions{LabelSelector: "app in (web, api)"})
+	if err != nil {
+		t.Fatalf("ListOriginPools() error = %v", err)
+	}
+	if len(result.Items) != 2 {
+		t.Fatalf("len(Items) = %d, want 2", len(result.Items))
+	}
+
+	flat := result.Items[0]
+	if flat.Metadata.Name != "pool-a" || flat.Metadata.Namespace != "shared" || flat.Metadata.UID != "uid-a" {
+		t.Errorf("flat item metadata not normalized: %+v", flat.Metadata)
+	}
+	if flat.Metadata.Labels["app"] != "web" || !flat.Metadata.Disable || flat.Tenant != "acme" {
+		t.Errorf("flat item fields not normalized: %+v", flat)
+	}
+
+	full := result.Items[1]
+	if full.Metadata.Name != "pool-b" || full.Spec["port"] != float64(443) {
+		t.Errorf("full item not decoded: %+v", full)
+	}
+}
+
+func TestListWithoutLabelSelector(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.RawQuery != "" {
+			t.Errorf("RawQuery = %q, want empty", r.URL.RawQuery)
+		}
+		w.Write([]byte(`{"items": []}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.ListNamespaces(context.Background(), "", ListOptions{})
+	if err != nil {
+		t.Fatalf("ListNamespaces() error = %v", err)
+	}
+	if len(result.Items) != 0 {
+		t.Errorf("len(Items) = %d, want 0", len(result.Items))
+	}
+}
+
+func TestListFollowsNextPage(t *testing.T) {
+	var starts []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := r.URL.Query().Get("page_start")
+		starts = append(starts, start)
+		if got := r.URL.Query().Get("label_filter"); got != "app=web" {
+			t.Errorf("label_filter = %q on page %q, want app=web", got, start)
+		}
+		switch start {
+		case "":
+			w.Write([]byte(`{"items": [{"name": "pool-a"}], "next_page": "pool-b"}`))
+		case "pool-b":
+			w.Write([]byte(`{"items": [{"name": "pool-b"}], "next_page": ""}`))
+		default:
+			t.Errorf("unexpected page_start %q", start)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token")
+	result, err := client.ListOriginPools(context.Background(), "shared", ListOptions{LabelSelector: "app=web"})
+	if err != nil {
+		t.Fatalf("ListOriginPools() error = %v", err)
+	}
+	if len(starts) != 2 {
+		t.Errorf("requested pages %q, want 2 pages", starts)
+	}
+	if len(result.Items) != 2 || result.Items[1].Metadata.Name != "pool-b" {
+		t.Errorf("Items = %+v, want pool-a and pool-b", result.Items)
+	}
+}
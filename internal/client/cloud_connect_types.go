@@ -12,6 +12,9 @@ import (
 type CloudConnect struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateCloudConnect creates a new CloudConnect
@@ -47,3 +50,10 @@ func (c *Client) DeleteCloudConnect(ctx context.Context, namespace, name string)
 	_ = namespace // Namespace not required in API path for this resource
 	return c.Delete(ctx, path)
 }
+
+// ListCloudConnects lists CloudConnect objects
+func (c *Client) ListCloudConnects(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := "/api/data/namespaces/system/top/cloud_connects"
+	_ = namespace // Namespace not required in API path for this resource
+	return c.List(ctx, path, opts)
+}
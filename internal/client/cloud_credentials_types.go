@@ -12,6 +12,9 @@ import (
 type CloudCredentials struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateCloudCredentials creates a new CloudCredentials
@@ -43,3 +46,9 @@ func (c *Client) DeleteCloudCredentials(ctx context.Context, namespace, name str
 	path := fmt.Sprintf("/api/config/namespaces/%s/cloud_credentialss/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListCloudCredentialsList lists CloudCredentials objects
+func (c *Client) ListCloudCredentialsList(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/cloud_credentialss", namespace)
+	return c.List(ctx, path, opts)
+}
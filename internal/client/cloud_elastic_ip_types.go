@@ -12,6 +12,9 @@ import (
 type CloudElasticIP struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateCloudElasticIP creates a new CloudElasticIP
@@ -43,3 +46,9 @@ func (c *Client) DeleteCloudElasticIP(ctx context.Context, namespace, name strin
 	path := fmt.Sprintf("/api/config/namespaces/%s/cloud_elastic_ips/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListCloudElasticIPs lists CloudElasticIP objects
+func (c *Client) ListCloudElasticIPs(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/cloud_elastic_ips", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type CloudLink struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateCloudLink creates a new CloudLink
@@ -43,3 +46,9 @@ func (c *Client) DeleteCloudLink(ctx context.Context, namespace, name string) er
 	path := fmt.Sprintf("/api/config/namespaces/%s/cloud_links/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListCloudLinks lists CloudLink objects
+func (c *Client) ListCloudLinks(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/cloud_links", namespace)
+	return c.List(ctx, path, opts)
+}
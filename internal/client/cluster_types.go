@@ -12,6 +12,9 @@ import (
 type Cluster struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateCluster creates a new Cluster
@@ -43,3 +46,9 @@ func (c *Client) DeleteCluster(ctx context.Context, namespace, name string) erro
 	path := fmt.Sprintf("/api/config/namespaces/%s/clusters/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListClusters lists Cluster objects
+func (c *Client) ListClusters(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/clusters", namespace)
+	return c.List(ctx, path, opts)
+}
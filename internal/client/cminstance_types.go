@@ -12,6 +12,9 @@ import (
 type Cminstance struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateCminstance creates a new Cminstance
@@ -43,3 +46,9 @@ func (c *Client) DeleteCminstance(ctx context.Context, namespace, name string) e
 	path := fmt.Sprintf("/api/config/namespaces/%s/cminstances/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListCminstances lists Cminstance objects
+func (c *Client) ListCminstances(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/cminstances", namespace)
+	return c.List(ctx, path, opts)
+}
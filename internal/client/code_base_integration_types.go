@@ -12,6 +12,9 @@ import (
 type CodeBaseIntegration struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateCodeBaseIntegration creates a new CodeBaseIntegration
@@ -43,3 +46,9 @@ func (c *Client) DeleteCodeBaseIntegration(ctx context.Context, namespace, name
 	path := fmt.Sprintf("/api/config/namespaces/%s/code_base_integrations/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListCodeBaseIntegrations lists CodeBaseIntegration objects
+func (c *Client) ListCodeBaseIntegrations(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/code_base_integrations", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type ContainerRegistry struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateContainerRegistry creates a new ContainerRegistry
@@ -43,3 +46,9 @@ func (c *Client) DeleteContainerRegistry(ctx context.Context, namespace, name st
 	path := fmt.Sprintf("/api/config/namespaces/%s/container_registrys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListContainerRegistries lists ContainerRegistry objects
+func (c *Client) ListContainerRegistries(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/container_registrys", namespace)
+	return c.List(ctx, path, opts)
+}
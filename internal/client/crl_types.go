@@ -12,6 +12,9 @@ import (
 type CRL struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateCRL creates a new CRL
@@ -43,3 +46,9 @@ func (c *Client) DeleteCRL(ctx context.Context, namespace, name string) error {
 	path := fmt.Sprintf("/api/config/namespaces/%s/crls/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListCRLs lists CRL objects
+func (c *Client) ListCRLs(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/crls", namespace)
+	return c.List(ctx, path, opts)
+}
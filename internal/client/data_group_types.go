@@ -12,6 +12,9 @@ import (
 type DataGroup struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateDataGroup creates a new DataGroup
@@ -43,3 +46,9 @@ func (c *Client) DeleteDataGroup(ctx context.Context, namespace, name string) er
 	path := fmt.Sprintf("/api/config/namespaces/%s/data_groups/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListDataGroups lists DataGroup objects
+func (c *Client) ListDataGroups(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/data_groups", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type DataType struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateDataType creates a new DataType
@@ -43,3 +46,9 @@ func (c *Client) DeleteDataType(ctx context.Context, namespace, name string) err
 	path := fmt.Sprintf("/api/config/namespaces/%s/data_types/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListDataTypes lists DataType objects
+func (c *Client) ListDataTypes(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/data_types", namespace)
+	return c.List(ctx, path, opts)
+}
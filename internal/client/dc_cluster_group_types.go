@@ -12,6 +12,9 @@ import (
 type DcClusterGroup struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateDcClusterGroup creates a new DcClusterGroup
@@ -43,3 +46,9 @@ func (c *Client) DeleteDcClusterGroup(ctx context.Context, namespace, name strin
 	path := fmt.Sprintf("/api/config/namespaces/%s/dc_cluster_groups/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListDcClusterGroups lists DcClusterGroup objects
+func (c *Client) ListDcClusterGroups(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/dc_cluster_groups", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type Discovery struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateDiscovery creates a new Discovery
@@ -43,3 +46,9 @@ func (c *Client) DeleteDiscovery(ctx context.Context, namespace, name string) er
 	path := fmt.Sprintf("/api/config/namespaces/%s/discoverys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListDiscoveries lists Discovery objects
+func (c *Client) ListDiscoveries(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/discoverys", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type DNSComplianceChecks struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateDNSComplianceChecks creates a new DNSComplianceChecks
@@ -43,3 +46,9 @@ func (c *Client) DeleteDNSComplianceChecks(ctx context.Context, namespace, name
 	path := fmt.Sprintf("/api/config/namespaces/%s/dns_compliance_checkss/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListDNSComplianceChecksList lists DNSComplianceChecks objects
+func (c *Client) ListDNSComplianceChecksList(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/dns_compliance_checkss", namespace)
+	return c.List(ctx, path, opts)
+}
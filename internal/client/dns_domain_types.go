@@ -12,6 +12,9 @@ import (
 type DNSDomain struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateDNSDomain creates a new DNSDomain
@@ -43,3 +46,9 @@ func (c *Client) DeleteDNSDomain(ctx context.Context, namespace, name string) er
 	path := fmt.Sprintf("/api/config/namespaces/%s/dns_domains/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListDNSDomains lists DNSDomain objects
+func (c *Client) ListDNSDomains(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/dns_domains", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type DNSLBHealthCheck struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateDNSLBHealthCheck creates a new DNSLBHealthCheck
@@ -43,3 +46,9 @@ func (c *Client) DeleteDNSLBHealthCheck(ctx context.Context, namespace, name str
 	path := fmt.Sprintf("/api/config/dns/namespaces/%s/dns_lb_health_checks/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListDNSLBHealthChecks lists DNSLBHealthCheck objects
+func (c *Client) ListDNSLBHealthChecks(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/dns/namespaces/%s/dns_lb_health_checks", namespace)
+	return c.List(ctx, path, opts)
+}
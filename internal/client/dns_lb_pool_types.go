@@ -12,6 +12,9 @@ import (
 type DNSLBPool struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateDNSLBPool creates a new DNSLBPool
@@ -43,3 +46,9 @@ func (c *Client) DeleteDNSLBPool(ctx context.Context, namespace, name string) er
 	path := fmt.Sprintf("/api/config/dns/namespaces/%s/dns_lb_pools/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListDNSLBPools lists DNSLBPool objects
+func (c *Client) ListDNSLBPools(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/dns/namespaces/%s/dns_lb_pools", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type DNSLoadBalancer struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateDNSLoadBalancer creates a new DNSLoadBalancer
@@ -43,3 +46,9 @@ func (c *Client) DeleteDNSLoadBalancer(ctx context.Context, namespace, name stri
 	path := fmt.Sprintf("/api/config/dns/namespaces/%s/dns_load_balancers/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListDNSLoadBalancers lists DNSLoadBalancer objects
+func (c *Client) ListDNSLoadBalancers(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/dns/namespaces/%s/dns_load_balancers", namespace)
+	return c.List(ctx, path, opts)
+}
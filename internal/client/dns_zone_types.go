@@ -12,6 +12,9 @@ import (
 type DNSZone struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateDNSZone creates a new DNSZone
@@ -43,3 +46,9 @@ func (c *Client) DeleteDNSZone(ctx context.Context, namespace, name string) erro
 	path := fmt.Sprintf("/api/config/dns/namespaces/%s/dns_zones/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListDNSZones lists DNSZone objects
+func (c *Client) ListDNSZones(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/dns/namespaces/%s/dns_zones", namespace)
+	return c.List(ctx, path, opts)
+}
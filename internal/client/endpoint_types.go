@@ -12,6 +12,9 @@ import (
 type Endpoint struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateEndpoint creates a new Endpoint
@@ -43,3 +46,9 @@ func (c *Client) DeleteEndpoint(ctx context.Context, namespace, name string) err
 	path := fmt.Sprintf("/api/config/namespaces/%s/endpoints/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListEndpoints lists Endpoint objects
+func (c *Client) ListEndpoints(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/endpoints", namespace)
+	return c.List(ctx, path, opts)
+}
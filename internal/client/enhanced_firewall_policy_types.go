@@ -12,6 +12,9 @@ import (
 type EnhancedFirewallPolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateEnhancedFirewallPolicy creates a new EnhancedFirewallPolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteEnhancedFirewallPolicy(ctx context.Context, namespace, na
 	path := fmt.Sprintf("/api/config/namespaces/%s/enhanced_firewall_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListEnhancedFirewallPolicies lists EnhancedFirewallPolicy objects
+func (c *Client) ListEnhancedFirewallPolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/enhanced_firewall_policys", namespace)
+	return c.List(ctx, path, opts)
+}
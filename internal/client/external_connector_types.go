@@ -12,6 +12,9 @@ import (
 type ExternalConnector struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateExternalConnector creates a new ExternalConnector
@@ -43,3 +46,9 @@ func (c *Client) DeleteExternalConnector(ctx context.Context, namespace, name st
 	path := fmt.Sprintf("/api/config/namespaces/%s/external_connectors/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListExternalConnectors lists ExternalConnector objects
+func (c *Client) ListExternalConnectors(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/external_connectors", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type FastACLRule struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateFastACLRule creates a new FastACLRule
@@ -43,3 +46,9 @@ func (c *Client) DeleteFastACLRule(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/fast_acl_rules/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListFastACLRules lists FastACLRule objects
+func (c *Client) ListFastACLRules(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/fast_acl_rules", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type FastACL struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateFastACL creates a new FastACL
@@ -43,3 +46,9 @@ func (c *Client) DeleteFastACL(ctx context.Context, namespace, name string) erro
 	path := fmt.Sprintf("/api/config/namespaces/%s/fast_acls/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListFastACLs lists FastACL objects
+func (c *Client) ListFastACLs(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/fast_acls", namespace)
+	return c.List(ctx, path, opts)
+}
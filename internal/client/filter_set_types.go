@@ -12,6 +12,9 @@ import (
 type FilterSet struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateFilterSet creates a new FilterSet
@@ -43,3 +46,9 @@ func (c *Client) DeleteFilterSet(ctx context.Context, namespace, name string) er
 	path := fmt.Sprintf("/api/config/namespaces/%s/filter_sets/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListFilterSets lists FilterSet objects
+func (c *Client) ListFilterSets(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/filter_sets", namespace)
+	return c.List(ctx, path, opts)
+}
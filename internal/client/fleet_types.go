@@ -12,6 +12,9 @@ import (
 type Fleet struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateFleet creates a new Fleet
@@ -43,3 +46,9 @@ func (c *Client) DeleteFleet(ctx context.Context, namespace, name string) error
 	path := fmt.Sprintf("/api/config/namespaces/%s/fleets/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListFleets lists Fleet objects
+func (c *Client) ListFleets(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/fleets", namespace)
+	return c.List(ctx, path, opts)
+}
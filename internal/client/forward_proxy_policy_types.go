@@ -12,6 +12,9 @@ import (
 type ForwardProxyPolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateForwardProxyPolicy creates a new ForwardProxyPolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteForwardProxyPolicy(ctx context.Context, namespace, name s
 	path := fmt.Sprintf("/api/config/namespaces/%s/forward_proxy_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListForwardProxyPolicies lists ForwardProxyPolicy objects
+func (c *Client) ListForwardProxyPolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/forward_proxy_policys", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type ForwardingClass struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateForwardingClass creates a new ForwardingClass
@@ -43,3 +46,9 @@ func (c *Client) DeleteForwardingClass(ctx context.Context, namespace, name stri
 	path := fmt.Sprintf("/api/config/namespaces/%s/forwarding_classs/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListForwardingClasses lists ForwardingClass objects
+func (c *Client) ListForwardingClasses(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/forwarding_classs", namespace)
+	return c.List(ctx, path, opts)
+}
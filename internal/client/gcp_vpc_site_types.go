@@ -12,6 +12,9 @@ import (
 type GCPVPCSite struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateGCPVPCSite creates a new GCPVPCSite
@@ -43,3 +46,9 @@ func (c *Client) DeleteGCPVPCSite(ctx context.Context, namespace, name string) e
 	path := fmt.Sprintf("/api/config/namespaces/%s/gcp_vpc_sites/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListGCPVPCSites lists GCPVPCSite objects
+func (c *Client) ListGCPVPCSites(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/gcp_vpc_sites", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type GlobalLogReceiver struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateGlobalLogReceiver creates a new GlobalLogReceiver
@@ -43,3 +46,9 @@ func (c *Client) DeleteGlobalLogReceiver(ctx context.Context, namespace, name st
 	path := fmt.Sprintf("/api/config/namespaces/%s/global_log_receivers/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListGlobalLogReceivers lists GlobalLogReceiver objects
+func (c *Client) ListGlobalLogReceivers(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/global_log_receivers", namespace)
+	return c.List(ctx, path, opts)
+}
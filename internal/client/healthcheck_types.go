@@ -12,6 +12,9 @@ import (
 type Healthcheck struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateHealthcheck creates a new Healthcheck
@@ -43,3 +46,9 @@ func (c *Client) DeleteHealthcheck(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/healthchecks/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListHealthchecks lists Healthcheck objects
+func (c *Client) ListHealthchecks(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/healthchecks", namespace)
+	return c.List(ctx, path, opts)
+}
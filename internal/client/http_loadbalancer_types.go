@@ -12,6 +12,9 @@ import (
 type HTTPLoadBalancer struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateHTTPLoadBalancer creates a new HTTPLoadBalancer
@@ -43,3 +46,9 @@ func (c *Client) DeleteHTTPLoadBalancer(ctx context.Context, namespace, name str
 	path := fmt.Sprintf("/api/config/namespaces/%s/http_loadbalancers/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListHTTPLoadBalancers lists HTTPLoadBalancer objects
+func (c *Client) ListHTTPLoadBalancers(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/http_loadbalancers", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type Ike1 struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateIke1 creates a new Ike1
@@ -43,3 +46,9 @@ func (c *Client) DeleteIke1(ctx context.Context, namespace, name string) error {
 	path := fmt.Sprintf("/api/config/namespaces/%s/ike1s/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListIke1s lists Ike1 objects
+func (c *Client) ListIke1s(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/ike1s", namespace)
+	return c.List(ctx, path, opts)
+}
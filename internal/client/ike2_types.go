@@ -12,6 +12,9 @@ import (
 type Ike2 struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateIke2 creates a new Ike2
@@ -43,3 +46,9 @@ func (c *Client) DeleteIke2(ctx context.Context, namespace, name string) error {
 	path := fmt.Sprintf("/api/config/namespaces/%s/ike2s/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListIke2s lists Ike2 objects
+func (c *Client) ListIke2s(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/ike2s", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type IKEPhase1Profile struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateIKEPhase1Profile creates a new IKEPhase1Profile
@@ -43,3 +46,9 @@ func (c *Client) DeleteIKEPhase1Profile(ctx context.Context, namespace, name str
 	path := fmt.Sprintf("/api/config/namespaces/%s/ike_phase1_profiles/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListIKEPhase1Profiles lists IKEPhase1Profile objects
+func (c *Client) ListIKEPhase1Profiles(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/ike_phase1_profiles", namespace)
+	return c.List(ctx, path, opts)
+}
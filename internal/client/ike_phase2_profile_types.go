@@ -12,6 +12,9 @@ import (
 type IKEPhase2Profile struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateIKEPhase2Profile creates a new IKEPhase2Profile
@@ -43,3 +46,9 @@ func (c *Client) DeleteIKEPhase2Profile(ctx context.Context, namespace, name str
 	path := fmt.Sprintf("/api/config/namespaces/%s/ike_phase2_profiles/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListIKEPhase2Profiles lists IKEPhase2Profile objects
+func (c *Client) ListIKEPhase2Profiles(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/ike_phase2_profiles", namespace)
+	return c.List(ctx, path, opts)
+}
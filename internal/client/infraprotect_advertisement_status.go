@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// Infraprotect internet prefix advertisement activation for F5 XC
+// Announcing and withdrawing a prefix uses a dedicated toggle endpoint, and
+// the resulting state is only visible in the status conditions of the object.
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Condition is a condition reported by a component of the system in the
+// status of a configuration object
+type Condition struct {
+	Type           string `json:"type,omitempty"`
+	Status         string `json:"status,omitempty"`
+	Reason         string `json:"reason,omitempty"`
+	ServiceName    string `json:"service_name,omitempty"`
+	Hostname       string `json:"hostname,omitempty"`
+	LastUpdateTime string `json:"last_update_time,omitempty"`
+}
+
+// StatusObject is the most recently observed status of a configuration object
+type StatusObject struct {
+	Conditions []Condition `json:"conditions,omitempty"`
+}
+
+// InfraprotectInternetPrefixAdvertisementStatus is the activation state of an
+// internet prefix advertisement together with its reported status
+type InfraprotectInternetPrefixAdvertisementStatus struct {
+	Spec struct {
+		ActivationAnnounce *struct{} `json:"activation_announce,omitempty"`
+		ActivationWithdraw *struct{} `json:"activation_withdraw,omitempty"`
+	} `json:"spec"`
+	Status []StatusObject `json:"status,omitempty"`
+}
+
+// Announced reports whether the advertisement is configured to be announced
+func (s *InfraprotectInternetPrefixAdvertisementStatus) Announced() bool {
+	return s.Spec.ActivationAnnounce != nil
+}
+
+// Conditions returns the conditions of all status objects
+func (s *InfraprotectInternetPrefixAdvertisementStatus) Conditions() []Condition {
+	var conditions []Condition
+	for _, status := range s.Status {
+		conditions = append(conditions, status.Conditions...)
+	}
+	return conditions
+}
+
+// GetInfraprotectInternetPrefixAdvertisementStatus retrieves the activation
+// state and status conditions of an internet prefix advertisement
+func (c *Client) GetInfraprotectInternetPrefixAdvertisementStatus(ctx context.Context, namespace, name string) (*InfraprotectInternetPrefixAdvertisementStatus, error) {
+	var result InfraprotectInternetPrefixAdvertisementStatus
+	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_internet_prefix_advertisements/%s", namespace, name)
+	err := c.Get(ctx, path, &result)
+	return &result, err
+}
+
+// UpdateInfraprotectInternetPrefixAdvertisementStatus announces or withdraws
+// an internet prefix advertisement
+func (c *Client) UpdateInfraprotectInternetPrefixAdvertisementStatus(ctx context.Context, namespace, name string, announce bool) error {
+	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_internet_prefix_advertisements/update-advertisement-status", namespace)
+	body := map[string]interface{}{
+		"name":      name,
+		"namespace": namespace,
+	}
+	if announce {
+		body["activation_announce"] = struct{}{}
+	} else {
+		body["activation_withdraw"] = struct{}{}
+	}
+	return c.Post(ctx, path, body, nil)
+}
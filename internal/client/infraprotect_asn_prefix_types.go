@@ -12,6 +12,9 @@ import (
 type InfraprotectAsnPrefix struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateInfraprotectAsnPrefix creates a new InfraprotectAsnPrefix
@@ -43,3 +46,9 @@ func (c *Client) DeleteInfraprotectAsnPrefix(ctx context.Context, namespace, nam
 	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_asn_prefixs/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListInfraprotectAsnPrefixes lists InfraprotectAsnPrefix objects
+func (c *Client) ListInfraprotectAsnPrefixes(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_asn_prefixs", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type InfraprotectAsn struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateInfraprotectAsn creates a new InfraprotectAsn
@@ -43,3 +46,9 @@ func (c *Client) DeleteInfraprotectAsn(ctx context.Context, namespace, name stri
 	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_asns/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListInfraprotectAsns lists InfraprotectAsn objects
+func (c *Client) ListInfraprotectAsns(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_asns", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type InfraprotectDenyListRule struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateInfraprotectDenyListRule creates a new InfraprotectDenyListRule
@@ -43,3 +46,9 @@ func (c *Client) DeleteInfraprotectDenyListRule(ctx context.Context, namespace,
 	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_deny_list_rules/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListInfraprotectDenyListRules lists InfraprotectDenyListRule objects
+func (c *Client) ListInfraprotectDenyListRules(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_deny_list_rules", namespace)
+	return c.List(ctx, path, opts)
+}
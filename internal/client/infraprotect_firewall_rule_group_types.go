@@ -12,6 +12,9 @@ import (
 type InfraprotectFirewallRuleGroup struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateInfraprotectFirewallRuleGroup creates a new InfraprotectFirewallRuleGroup
@@ -43,3 +46,9 @@ func (c *Client) DeleteInfraprotectFirewallRuleGroup(ctx context.Context, namesp
 	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_firewall_rule_groups/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListInfraprotectFirewallRuleGroups lists InfraprotectFirewallRuleGroup objects
+func (c *Client) ListInfraprotectFirewallRuleGroups(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_firewall_rule_groups", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type InfraprotectFirewallRule struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateInfraprotectFirewallRule creates a new InfraprotectFirewallRule
@@ -43,3 +46,9 @@ func (c *Client) DeleteInfraprotectFirewallRule(ctx context.Context, namespace,
 	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_firewall_rules/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListInfraprotectFirewallRules lists InfraprotectFirewallRule objects
+func (c *Client) ListInfraprotectFirewallRules(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_firewall_rules", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type InfraprotectInternetPrefixAdvertisement struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateInfraprotectInternetPrefixAdvertisement creates a new InfraprotectInternetPrefixAdvertisement
@@ -43,3 +46,9 @@ func (c *Client) DeleteInfraprotectInternetPrefixAdvertisement(ctx context.Conte
 	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_internet_prefix_advertisements/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListInfraprotectInternetPrefixAdvertisements lists InfraprotectInternetPrefixAdvertisement objects
+func (c *Client) ListInfraprotectInternetPrefixAdvertisements(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_internet_prefix_advertisements", namespace)
+	return c.List(ctx, path, opts)
+}
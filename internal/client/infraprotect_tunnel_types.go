@@ -12,6 +12,9 @@ import (
 type InfraprotectTunnel struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateInfraprotectTunnel creates a new InfraprotectTunnel
@@ -43,3 +46,9 @@ func (c *Client) DeleteInfraprotectTunnel(ctx context.Context, namespace, name s
 	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_tunnels/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListInfraprotectTunnels lists InfraprotectTunnel objects
+func (c *Client) ListInfraprotectTunnels(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/infraprotect/namespaces/%s/infraprotect_tunnels", namespace)
+	return c.List(ctx, path, opts)
+}
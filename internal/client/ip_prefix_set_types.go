@@ -12,6 +12,9 @@ import (
 type IPPrefixSet struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateIPPrefixSet creates a new IPPrefixSet
@@ -43,3 +46,9 @@ func (c *Client) DeleteIPPrefixSet(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/ip_prefix_sets/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListIPPrefixSets lists IPPrefixSet objects
+func (c *Client) ListIPPrefixSets(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/ip_prefix_sets", namespace)
+	return c.List(ctx, path, opts)
+}
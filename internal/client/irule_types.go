@@ -12,6 +12,9 @@ import (
 type Irule struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateIrule creates a new Irule
@@ -43,3 +46,9 @@ func (c *Client) DeleteIrule(ctx context.Context, namespace, name string) error
 	path := fmt.Sprintf("/api/config/namespaces/%s/irules/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListIrules lists Irule objects
+func (c *Client) ListIrules(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/irules", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type K8SClusterRoleBinding struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateK8SClusterRoleBinding creates a new K8SClusterRoleBinding
@@ -43,3 +46,9 @@ func (c *Client) DeleteK8SClusterRoleBinding(ctx context.Context, namespace, nam
 	path := fmt.Sprintf("/api/config/namespaces/%s/k8s_cluster_role_bindings/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListK8SClusterRoleBindings lists K8SClusterRoleBinding objects
+func (c *Client) ListK8SClusterRoleBindings(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/k8s_cluster_role_bindings", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type K8SClusterRole struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateK8SClusterRole creates a new K8SClusterRole
@@ -43,3 +46,9 @@ func (c *Client) DeleteK8SClusterRole(ctx context.Context, namespace, name strin
 	path := fmt.Sprintf("/api/config/namespaces/%s/k8s_cluster_roles/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListK8SClusterRoles lists K8SClusterRole objects
+func (c *Client) ListK8SClusterRoles(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/k8s_cluster_roles", namespace)
+	return c.List(ctx, path, opts)
+}
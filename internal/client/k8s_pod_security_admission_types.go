@@ -12,6 +12,9 @@ import (
 type K8SPodSecurityAdmission struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateK8SPodSecurityAdmission creates a new K8SPodSecurityAdmission
@@ -43,3 +46,9 @@ func (c *Client) DeleteK8SPodSecurityAdmission(ctx context.Context, namespace, n
 	path := fmt.Sprintf("/api/config/namespaces/%s/k8s_pod_security_admissions/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListK8SPodSecurityAdmissions lists K8SPodSecurityAdmission objects
+func (c *Client) ListK8SPodSecurityAdmissions(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/k8s_pod_security_admissions", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type K8SPodSecurityPolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateK8SPodSecurityPolicy creates a new K8SPodSecurityPolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteK8SPodSecurityPolicy(ctx context.Context, namespace, name
 	path := fmt.Sprintf("/api/config/namespaces/%s/k8s_pod_security_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListK8SPodSecurityPolicies lists K8SPodSecurityPolicy objects
+func (c *Client) ListK8SPodSecurityPolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/k8s_pod_security_policys", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -4,94 +4,98 @@ package client
 
 import (
 	"context"
+	"net/url"
 )
 
-// ListResponse represents the response from a list API call
-type ListResponse struct {
-	Items []struct {
-		Metadata Metadata               `json:"metadata"`
-		Spec     map[string]interface{} `json:"spec,omitempty"`
-	} `json:"items"`
-}
-
-// ListNamespaces retrieves all namespaces from the F5 XC API
-func (c *Client) ListNamespaces(ctx context.Context) (*ListResponse, error) {
-	var result ListResponse
-	path := "/api/web/namespaces"
-	err := c.Get(ctx, path, &result)
-	return &result, err
-}
-
-// ListHTTPLoadBalancers retrieves all HTTP load balancers in a namespace
-func (c *Client) ListHTTPLoadBalancers(ctx context.Context, namespace string) (*ListResponse, error) {
-	var result ListResponse
-	path := "/api/config/namespaces/" + namespace + "/http_loadbalancers"
-	err := c.Get(ctx, path, &result)
-	return &result, err
-}
-
-// ListOriginPools retrieves all origin pools in a namespace
-func (c *Client) ListOriginPools(ctx context.Context, namespace string) (*ListResponse, error) {
-	var result ListResponse
-	path := "/api/config/namespaces/" + namespace + "/origin_pools"
-	err := c.Get(ctx, path, &result)
-	return &result, err
+// ListOptions filters the objects returned by a list API call
+type ListOptions struct {
+	// LabelSelector is sent as the label_filter query parameter and uses the
+	// Kubernetes label selector syntax, e.g. "app=web,env in (prod, staging)".
+	LabelSelector string
 }
 
-// ListHealthchecks retrieves all healthchecks in a namespace
-func (c *Client) ListHealthchecks(ctx context.Context, namespace string) (*ListResponse, error) {
-	var result ListResponse
-	path := "/api/config/namespaces/" + namespace + "/healthchecks"
-	err := c.Get(ctx, path, &result)
-	return &result, err
+// ListItem is a single object returned by a list API call.
+// The API returns the key fields at the top level of each item; Metadata and
+// Spec are only populated when the full object is reported. List normalizes
+// both shapes so callers can always read Metadata.
+type ListItem struct {
+	Metadata    Metadata               `json:"metadata"`
+	Spec        map[string]interface{} `json:"spec,omitempty"`
+	Name        string                 `json:"name,omitempty"`
+	Namespace   string                 `json:"namespace,omitempty"`
+	Tenant      string                 `json:"tenant,omitempty"`
+	UID         string                 `json:"uid,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Disabled    bool                   `json:"disabled,omitempty"`
+	Labels      map[string]string      `json:"labels,omitempty"`
+	Annotations map[string]string      `json:"annotations,omitempty"`
 }
 
-// ListAppFirewalls retrieves all app firewalls in a namespace
-func (c *Client) ListAppFirewalls(ctx context.Context, namespace string) (*ListResponse, error) {
-	var result ListResponse
-	path := "/api/config/namespaces/" + namespace + "/app_firewalls"
-	err := c.Get(ctx, path, &result)
-	return &result, err
+// ListResponse represents the response from a list API call
+type ListResponse struct {
+	Items []ListItem `json:"items"`
+	// NextPage is the page_start of the next page of list APIs that
+	// paginate. It is empty on the last page.
+	NextPage string `json:"next_page,omitempty"`
 }
 
-// ListServicePolicies retrieves all service policies in a namespace
-func (c *Client) ListServicePolicies(ctx context.Context, namespace string) (*ListResponse, error) {
-	var result ListResponse
-	path := "/api/config/namespaces/" + namespace + "/service_policys"
-	err := c.Get(ctx, path, &result)
-	return &result, err
-}
+// List retrieves all objects at a list endpoint, e.g.
+// /api/config/namespaces/{namespace}/origin_pools. List APIs that paginate
+// are followed page by page; all others return every object at once.
+func (c *Client) List(ctx context.Context, path string, opts ListOptions) (*ListResponse, error) {
+	query := url.Values{}
+	if opts.LabelSelector != "" {
+		query.Set("label_filter", opts.LabelSelector)
+	}
 
-// ListIPPrefixSets retrieves all IP prefix sets in a namespace
-func (c *Client) ListIPPrefixSets(ctx context.Context, namespace string) (*ListResponse, error) {
 	var result ListResponse
-	path := "/api/config/namespaces/" + namespace + "/ip_prefix_sets"
-	err := c.Get(ctx, path, &result)
-	return &result, err
-}
+	for {
+		pagePath := path
+		if len(query) > 0 {
+			pagePath += "?" + query.Encode()
+		}
 
-// ListRateLimiters retrieves all rate limiters in a namespace
-func (c *Client) ListRateLimiters(ctx context.Context, namespace string) (*ListResponse, error) {
-	var result ListResponse
-	path := "/api/config/namespaces/" + namespace + "/rate_limiters"
-	err := c.Get(ctx, path, &result)
-	return &result, err
-}
+		var page ListResponse
+		if err := c.Get(ctx, pagePath, &page); err != nil {
+			return &result, err
+		}
+		for i := range page.Items {
+			page.Items[i].normalize()
+		}
+		result.Items = append(result.Items, page.Items...)
 
-// ListUserIdentifications retrieves all user identifications in a namespace
-func (c *Client) ListUserIdentifications(ctx context.Context, namespace string) (*ListResponse, error) {
-	var result ListResponse
-	path := "/api/config/namespaces/" + namespace + "/user_identifications"
-	err := c.Get(ctx, path, &result)
-	return &result, err
+		// Guard against APIs that report the page just read as the next one
+		if page.NextPage == "" || page.NextPage == query.Get("page_start") {
+			return &result, nil
+		}
+		query.Set("page_start", page.NextPage)
+	}
 }
 
-// ListMaliciousUserMitigations retrieves all malicious user mitigations in a namespace
-func (c *Client) ListMaliciousUserMitigations(ctx context.Context, namespace string) (*ListResponse, error) {
-	var result ListResponse
-	path := "/api/config/namespaces/" + namespace + "/malicious_user_mitigations"
-	err := c.Get(ctx, path, &result)
-	return &result, err
+// normalize copies the top-level key fields into Metadata where Metadata
+// was not reported by the API.
+func (item *ListItem) normalize() {
+	if item.Metadata.Name == "" {
+		item.Metadata.Name = item.Name
+	}
+	if item.Metadata.Namespace == "" {
+		item.Metadata.Namespace = item.Namespace
+	}
+	if item.Metadata.UID == "" {
+		item.Metadata.UID = item.UID
+	}
+	if item.Metadata.Description == "" {
+		item.Metadata.Description = item.Description
+	}
+	if len(item.Metadata.Labels) == 0 {
+		item.Metadata.Labels = item.Labels
+	}
+	if len(item.Metadata.Annotations) == 0 {
+		item.Metadata.Annotations = item.Annotations
+	}
+	if !item.Metadata.Disable {
+		item.Metadata.Disable = item.Disabled
+	}
 }
 
 // CascadeDeleteNamespace deletes a namespace and all its contained resources.
@@ -12,6 +12,9 @@ import (
 type LogReceiver struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateLogReceiver creates a new LogReceiver
@@ -43,3 +46,9 @@ func (c *Client) DeleteLogReceiver(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/log_receivers/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListLogReceivers lists LogReceiver objects
+func (c *Client) ListLogReceivers(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/log_receivers", namespace)
+	return c.List(ctx, path, opts)
+}
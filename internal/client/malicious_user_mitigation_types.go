@@ -12,6 +12,9 @@ import (
 type MaliciousUserMitigation struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateMaliciousUserMitigation creates a new MaliciousUserMitigation
@@ -43,3 +46,9 @@ func (c *Client) DeleteMaliciousUserMitigation(ctx context.Context, namespace, n
 	path := fmt.Sprintf("/api/config/namespaces/%s/malicious_user_mitigations/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListMaliciousUserMitigations lists MaliciousUserMitigation objects
+func (c *Client) ListMaliciousUserMitigations(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/malicious_user_mitigations", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,13 +12,15 @@ import (
 type ManagedTenant struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateManagedTenant creates a new ManagedTenant
 func (c *Client) CreateManagedTenant(ctx context.Context, resource *ManagedTenant) (*ManagedTenant, error) {
 	var result ManagedTenant
-	path := "/api/web/namespaces/system/support-tenant/managed_tenants"
-	_ = resource.Metadata.Namespace // Namespace not required in API path for this resource
+	path := fmt.Sprintf("/api/web/namespaces/%s/managed_tenants", resource.Metadata.Namespace)
 	err := c.Post(ctx, path, resource, &result)
 	return &result, err
 }
@@ -26,8 +28,7 @@ func (c *Client) CreateManagedTenant(ctx context.Context, resource *ManagedTenan
 // GetManagedTenant retrieves a ManagedTenant
 func (c *Client) GetManagedTenant(ctx context.Context, namespace, name string) (*ManagedTenant, error) {
 	var result ManagedTenant
-	path := fmt.Sprintf("/api/web/namespaces/system/support-tenant/managed_tenants/%s", name)
-	_ = namespace // Namespace not required in API path for this resource
+	path := fmt.Sprintf("/api/web/namespaces/%s/managed_tenants/%s", namespace, name)
 	err := c.Get(ctx, path, &result)
 	return &result, err
 }
@@ -35,15 +36,19 @@ func (c *Client) GetManagedTenant(ctx context.Context, namespace, name string) (
 // UpdateManagedTenant updates a ManagedTenant
 func (c *Client) UpdateManagedTenant(ctx context.Context, resource *ManagedTenant) (*ManagedTenant, error) {
 	var result ManagedTenant
-	path := fmt.Sprintf("/api/web/namespaces/system/support-tenant/managed_tenants/%s", resource.Metadata.Name)
-	_ = resource.Metadata.Namespace // Namespace not required in API path for this resource
+	path := fmt.Sprintf("/api/web/namespaces/%s/managed_tenants/%s", resource.Metadata.Namespace, resource.Metadata.Name)
 	err := c.Put(ctx, path, resource, &result)
 	return &result, err
 }
 
 // DeleteManagedTenant deletes a ManagedTenant
 func (c *Client) DeleteManagedTenant(ctx context.Context, namespace, name string) error {
-	path := fmt.Sprintf("/api/web/namespaces/system/support-tenant/managed_tenants/%s", name)
-	_ = namespace // Namespace not required in API path for this resource
+	path := fmt.Sprintf("/api/web/namespaces/%s/managed_tenants/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListManagedTenants lists ManagedTenant objects
+func (c *Client) ListManagedTenants(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/web/namespaces/%s/managed_tenants", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type Namespace struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateNamespace creates a new Namespace
@@ -47,3 +50,10 @@ func (c *Client) DeleteNamespace(ctx context.Context, namespace, name string) er
 	_ = namespace // Namespace not required in API path for this resource
 	return c.Delete(ctx, path)
 }
+
+// ListNamespaces lists Namespace objects
+func (c *Client) ListNamespaces(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := "/api/web/namespaces"
+	_ = namespace // Namespace not required in API path for this resource
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type NATPolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateNATPolicy creates a new NATPolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteNATPolicy(ctx context.Context, namespace, name string) er
 	path := fmt.Sprintf("/api/config/namespaces/%s/nat_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListNATPolicies lists NATPolicy objects
+func (c *Client) ListNATPolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/nat_policys", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type NetworkConnector struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateNetworkConnector creates a new NetworkConnector
@@ -43,3 +46,9 @@ func (c *Client) DeleteNetworkConnector(ctx context.Context, namespace, name str
 	path := fmt.Sprintf("/api/config/namespaces/%s/network_connectors/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListNetworkConnectors lists NetworkConnector objects
+func (c *Client) ListNetworkConnectors(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/network_connectors", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type NetworkFirewall struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateNetworkFirewall creates a new NetworkFirewall
@@ -43,3 +46,9 @@ func (c *Client) DeleteNetworkFirewall(ctx context.Context, namespace, name stri
 	path := fmt.Sprintf("/api/config/namespaces/%s/network_firewalls/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListNetworkFirewalls lists NetworkFirewall objects
+func (c *Client) ListNetworkFirewalls(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/network_firewalls", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type NetworkInterface struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateNetworkInterface creates a new NetworkInterface
@@ -43,3 +46,9 @@ func (c *Client) DeleteNetworkInterface(ctx context.Context, namespace, name str
 	path := fmt.Sprintf("/api/config/namespaces/%s/network_interfaces/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListNetworkInterfaces lists NetworkInterface objects
+func (c *Client) ListNetworkInterfaces(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/network_interfaces", namespace)
+	return c.List(ctx, path, opts)
+}
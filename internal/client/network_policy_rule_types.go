@@ -12,6 +12,9 @@ import (
 type NetworkPolicyRule struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateNetworkPolicyRule creates a new NetworkPolicyRule
@@ -43,3 +46,9 @@ func (c *Client) DeleteNetworkPolicyRule(ctx context.Context, namespace, name st
 	path := fmt.Sprintf("/api/config/namespaces/%s/network_policy_rules/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListNetworkPolicyRules lists NetworkPolicyRule objects
+func (c *Client) ListNetworkPolicyRules(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/network_policy_rules", namespace)
+	return c.List(ctx, path, opts)
+}
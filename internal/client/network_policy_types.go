@@ -12,6 +12,9 @@ import (
 type NetworkPolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateNetworkPolicy creates a new NetworkPolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteNetworkPolicy(ctx context.Context, namespace, name string
 	path := fmt.Sprintf("/api/config/namespaces/%s/network_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListNetworkPolicies lists NetworkPolicy objects
+func (c *Client) ListNetworkPolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/network_policys", namespace)
+	return c.List(ctx, path, opts)
+}
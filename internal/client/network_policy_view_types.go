@@ -12,6 +12,9 @@ import (
 type NetworkPolicyView struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateNetworkPolicyView creates a new NetworkPolicyView
@@ -43,3 +46,9 @@ func (c *Client) DeleteNetworkPolicyView(ctx context.Context, namespace, name st
 	path := fmt.Sprintf("/api/config/namespaces/%s/network_policy_views/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListNetworkPolicyViews lists NetworkPolicyView objects
+func (c *Client) ListNetworkPolicyViews(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/network_policy_views", namespace)
+	return c.List(ctx, path, opts)
+}
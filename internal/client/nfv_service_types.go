@@ -12,6 +12,9 @@ import (
 type NfvService struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateNfvService creates a new NfvService
@@ -43,3 +46,9 @@ func (c *Client) DeleteNfvService(ctx context.Context, namespace, name string) e
 	path := fmt.Sprintf("/api/config/namespaces/%s/nfv_services/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListNfvServices lists NfvService objects
+func (c *Client) ListNfvServices(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/nfv_services", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type NginxServiceDiscovery struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateNginxServiceDiscovery creates a new NginxServiceDiscovery
@@ -43,3 +46,9 @@ func (c *Client) DeleteNginxServiceDiscovery(ctx context.Context, namespace, nam
 	path := fmt.Sprintf("/api/config/namespaces/%s/nginx_service_discoverys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListNginxServiceDiscoveries lists NginxServiceDiscovery objects
+func (c *Client) ListNginxServiceDiscoveries(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/nginx_service_discoverys", namespace)
+	return c.List(ctx, path, opts)
+}
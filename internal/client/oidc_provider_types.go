@@ -12,6 +12,9 @@ import (
 type OIDCProvider struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateOIDCProvider creates a new OIDCProvider
@@ -41,5 +44,12 @@ func (c *Client) UpdateOIDCProvider(ctx context.Context, resource *OIDCProvider)
 // DeleteOIDCProvider deletes a OIDCProvider
 func (c *Client) DeleteOIDCProvider(ctx context.Context, namespace, name string) error {
 	path := fmt.Sprintf("/api/web/custom/namespaces/%s/oidc_providers/%s", namespace, name)
-	return c.Delete(ctx, path)
+	// The API deletes with a POST to the item's delete endpoint
+	return c.Post(ctx, path+"/delete", struct{}{}, nil)
+}
+
+// ListOIDCProviders lists OIDCProvider objects
+func (c *Client) ListOIDCProviders(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/web/custom/namespaces/%s/oidc_providers", namespace)
+	return c.List(ctx, path, opts)
 }
@@ -12,6 +12,9 @@ import (
 type OriginPool struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateOriginPool creates a new OriginPool
@@ -43,3 +46,9 @@ func (c *Client) DeleteOriginPool(ctx context.Context, namespace, name string) e
 	path := fmt.Sprintf("/api/config/namespaces/%s/origin_pools/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListOriginPools lists OriginPool objects
+func (c *Client) ListOriginPools(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/origin_pools", namespace)
+	return c.List(ctx, path, opts)
+}
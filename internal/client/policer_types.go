@@ -12,6 +12,9 @@ import (
 type Policer struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreatePolicer creates a new Policer
@@ -43,3 +46,9 @@ func (c *Client) DeletePolicer(ctx context.Context, namespace, name string) erro
 	path := fmt.Sprintf("/api/config/namespaces/%s/policers/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListPolicers lists Policer objects
+func (c *Client) ListPolicers(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/policers", namespace)
+	return c.List(ctx, path, opts)
+}
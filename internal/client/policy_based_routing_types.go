@@ -12,6 +12,9 @@ import (
 type PolicyBasedRouting struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreatePolicyBasedRouting creates a new PolicyBasedRouting
@@ -43,3 +46,9 @@ func (c *Client) DeletePolicyBasedRouting(ctx context.Context, namespace, name s
 	path := fmt.Sprintf("/api/config/namespaces/%s/policy_based_routings/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListPolicyBasedRoutings lists PolicyBasedRouting objects
+func (c *Client) ListPolicyBasedRoutings(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/policy_based_routings", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type ProtocolInspection struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateProtocolInspection creates a new ProtocolInspection
@@ -43,3 +46,9 @@ func (c *Client) DeleteProtocolInspection(ctx context.Context, namespace, name s
 	path := fmt.Sprintf("/api/config/namespaces/%s/protocol_inspections/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListProtocolInspections lists ProtocolInspection objects
+func (c *Client) ListProtocolInspections(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/protocol_inspections", namespace)
+	return c.List(ctx, path, opts)
+}
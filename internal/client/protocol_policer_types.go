@@ -12,6 +12,9 @@ import (
 type ProtocolPolicer struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateProtocolPolicer creates a new ProtocolPolicer
@@ -43,3 +46,9 @@ func (c *Client) DeleteProtocolPolicer(ctx context.Context, namespace, name stri
 	path := fmt.Sprintf("/api/config/namespaces/%s/protocol_policers/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListProtocolPolicers lists ProtocolPolicer objects
+func (c *Client) ListProtocolPolicers(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/protocol_policers", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type Proxy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateProxy creates a new Proxy
@@ -43,3 +46,9 @@ func (c *Client) DeleteProxy(ctx context.Context, namespace, name string) error
 	path := fmt.Sprintf("/api/config/namespaces/%s/proxys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListProxies lists Proxy objects
+func (c *Client) ListProxies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/proxys", namespace)
+	return c.List(ctx, path, opts)
+}
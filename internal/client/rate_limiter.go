@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// Client-wide rate limiting for F5 XC API requests
+// All resources of a provider share one client, so a token bucket on the
+// client limits the request rate of the whole provider, and a rate limited
+// response pauses every request instead of only the one that received it.
+
+package client
+
+import (
+	"context"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket that allows rps requests per second with
+// bursts of up to burst requests. A rateLimiter with rps 0 does not limit
+// the request rate, but can still be paused.
+type rateLimiter struct {
+	mu          sync.Mutex
+	rps         float64
+	burst       float64
+	tokens      float64
+	last        time.Time
+	pausedUntil time.Time
+}
+
+// newRateLimiter returns a rate limiter with a full bucket
+func newRateLimiter(rps float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rps:    rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// reserve takes a token and returns how long to wait before using it
+func (l *rateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	var wait time.Duration
+	if now.Before(l.pausedUntil) {
+		wait = l.pausedUntil.Sub(now)
+	}
+	if l.rps <= 0 {
+		return wait
+	}
+
+	l.tokens += now.Sub(l.last).Seconds() * l.rps
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+	l.tokens--
+	if l.tokens < 0 {
+		if tokenWait := time.Duration(-l.tokens / l.rps * float64(time.Second)); tokenWait > wait {
+			wait = tokenWait
+		}
+	}
+	return wait
+}
+
+// Wait blocks until a request is allowed or ctx is done. A nil rateLimiter
+// allows every request.
+func (l *rateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return ctx.Err()
+	}
+	wait := l.reserve(time.Now())
+	if wait <= 0 {
+		return ctx.Err()
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// Pause holds back all requests for d, e.g. after a rate limited response
+func (l *rateLimiter) Pause(d time.Duration) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if until := time.Now().Add(d); until.After(l.pausedUntil) {
+		l.pausedUntil = until
+	}
+}
+
+// retryAfter returns the delay requested by the Retry-After header of a
+// response, given either in seconds or as an HTTP date
+func retryAfter(header http.Header, now time.Time) (time.Duration, bool) {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		if d := date.Sub(now); d > 0 {
+			return d, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// jitter returns a random duration between half of d and d, so that
+// requests retried at the same time spread out
+func jitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	half := d / 2
+	return half + rand.N(d-half+1)
+}
@@ -12,6 +12,9 @@ import (
 type RateLimiterPolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateRateLimiterPolicy creates a new RateLimiterPolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteRateLimiterPolicy(ctx context.Context, namespace, name st
 	path := fmt.Sprintf("/api/config/namespaces/%s/rate_limiter_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListRateLimiterPolicies lists RateLimiterPolicy objects
+func (c *Client) ListRateLimiterPolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/rate_limiter_policys", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -0,0 +1,273 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
+)
+
+// =============================================================================
+// Tests for rateLimiter
+// =============================================================================
+
+func TestRateLimiterReserve(t *testing.T) {
+	start := time.Now()
+	limiter := newRateLimiter(2, 2)
+	limiter.last = start
+
+	// The burst is available immediately
+	for i := 0; i < 2; i++ {
+		if wait := limiter.reserve(start); wait != 0 {
+			t.Fatalf("reserve() burst request %d wait = %v, want 0", i, wait)
+		}
+	}
+
+	// The next requests wait for one token each at 2 per second
+	if wait := limiter.reserve(start); wait != 500*time.Millisecond {
+		t.Errorf("reserve() wait = %v, want 500ms", wait)
+	}
+	if wait := limiter.reserve(start); wait != time.Second {
+		t.Errorf("reserve() wait = %v, want 1s", wait)
+	}
+
+	// Tokens are added over time up to the burst
+	limiter = newRateLimiter(2, 2)
+	limiter.last = start
+	limiter.tokens = 0
+	if wait := limiter.reserve(start.Add(10 * time.Second)); wait != 0 {
+		t.Errorf("reserve() after refill wait = %v, want 0", wait)
+	}
+	if limiter.tokens != 1 {
+		t.Errorf("tokens = %v, want 1 (burst minus the reserved token)", limiter.tokens)
+	}
+}
+
+func TestRateLimiterUnlimited(t *testing.T) {
+	limiter := newRateLimiter(0, 1)
+	now := time.Now()
+	for i := 0; i < 100; i++ {
+		if wait := limiter.reserve(now); wait != 0 {
+			t.Fatalf("reserve() request %d wait = %v, want 0 without a rate limit", i, wait)
+		}
+	}
+}
+
+func TestRateLimiterPause(t *testing.T) {
+	limiter := newRateLimiter(0, 1)
+	limiter.Pause(time.Minute)
+
+	wait := limiter.reserve(time.Now())
+	if wait <= 59*time.Second || wait > time.Minute {
+		t.Errorf("reserve() wait = %v, want about 1m while paused", wait)
+	}
+
+	// A shorter pause does not shorten the current one
+	limiter.Pause(time.Second)
+	if wait := limiter.reserve(time.Now()); wait <= 59*time.Second {
+		t.Errorf("reserve() wait = %v, want the longer pause to be kept", wait)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := limiter.Wait(ctx); err == nil {
+		t.Error("Wait() expected error when the context is done while paused")
+	}
+}
+
+func TestRateLimiterNil(t *testing.T) {
+	var limiter *rateLimiter
+	limiter.Pause(time.Minute)
+	if err := limiter.Wait(context.Background()); err != nil {
+		t.Errorf("Wait() error = %v, want nil", err)
+	}
+}
+
+// =============================================================================
+// Tests for retryAfter() and jitter()
+// =============================================================================
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{"missing", "", 0, false},
+		{"seconds", "120", 2 * time.Minute, true},
+		{"zero seconds", "0", 0, true},
+		{"negative seconds", "-1", 0, false},
+		{"http date", "Thu, 01 Jan 2026 12:00:30 GMT", 30 * time.Second, true},
+		{"past http date", "Thu, 01 Jan 2026 11:00:00 GMT", 0, true},
+		{"invalid", "soon", 0, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			if tt.value != "" {
+				header.Set("Retry-After", tt.value)
+			}
+			got, ok := retryAfter(header, now)
+			if got != tt.want || ok != tt.wantOK {
+				t.Errorf("retryAfter(%q) = (%v, %v), want (%v, %v)", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestJitter(t *testing.T) {
+	for i := 0; i < 100; i++ {
+		got := jitter(10 * time.Second)
+		if got < 5*time.Second || got > 10*time.Second {
+			t.Fatalf("jitter(10s) = %v, want between 5s and 10s", got)
+		}
+	}
+	if got := jitter(0); got != 0 {
+		t.Errorf("jitter(0) = %v, want 0", got)
+	}
+}
+
+// =============================================================================
+// Tests for rate limit handling in doRequest()
+// =============================================================================
+
+func TestRateLimitHonorsRetryAfter(t *testing.T) {
+	attemptCount := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attemptCount++
+		if attemptCount == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "123", "name": "success"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token",
+		WithMaxRetries(3),
+		WithRetryWait(1*time.Millisecond, 10*time.Millisecond),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	var result testResponse
+	if err := client.Get(ctx, "/test", &result); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if attemptCount != 2 {
+		t.Errorf("attemptCount = %d, want 2", attemptCount)
+	}
+}
+
+func TestRetryAfterIsNotLimitedToRetryWaitMax(t *testing.T) {
+	attemptCount := 0
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		attemptCount++
+		if attemptCount == 1 {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+		w.Write([]byte(`{"id": "123", "name": "success"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token",
+		WithMaxRetries(3),
+		WithRetryWait(1*time.Millisecond, 10*time.Millisecond),
+	)
+
+	// The client waits the second requested instead of RetryWaitMax
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	start := time.Now()
+	var result testResponse
+	if err := client.Get(ctx, "/test", &result); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("Get() returned after %v, want at least the 1s requested by Retry-After", elapsed)
+	}
+	if attemptCount != 2 {
+		t.Errorf("attemptCount = %d, want 2", attemptCount)
+	}
+}
+
+func TestRetryAfterIsBoundedByContext(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "86400")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token", WithMaxRetries(3))
+
+	// The wait for the day requested ends with the context
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	err := client.Get(ctx, "/test", nil)
+	var apiErr *f5xcerrors.F5XCError
+	if !errors.As(err, &apiErr) || apiErr.Code != f5xcerrors.ErrCodeTimeout {
+		t.Errorf("Get() error = %v, want a timeout error", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("Get() returned after %v, want it to return when the context is done", elapsed)
+	}
+}
+
+func TestRateLimitPausesOtherRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/limited" {
+			w.Header().Set("Retry-After", "60")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	client := NewClient(server.URL, "test-token", WithMaxRetries(1))
+
+	// The rate limited request pauses the client until its context is done
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := client.Get(ctx, "/limited", nil); err == nil {
+		t.Fatal("Get() expected error for rate limited request")
+	}
+
+	// Other requests of the same client are held back by the pause
+	ctx, cancel = context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := client.Get(ctx, "/other", nil); err == nil {
+		t.Error("Get() expected error while the client is paused")
+	}
+}
+
+func TestWithRateLimit(t *testing.T) {
+	client := NewClient("https://example.com", "token", WithRateLimit(5, 10))
+	if client.limiter == nil {
+		t.Fatal("limiter is nil")
+	}
+	if client.limiter.rps != 5 || client.limiter.burst != 10 {
+		t.Errorf("limiter = %v rps burst %v, want 5 rps burst 10", client.limiter.rps, client.limiter.burst)
+	}
+}
@@ -12,6 +12,9 @@ import (
 type RateLimiter struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateRateLimiter creates a new RateLimiter
@@ -43,3 +46,9 @@ func (c *Client) DeleteRateLimiter(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/rate_limiters/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListRateLimiters lists RateLimiter objects
+func (c *Client) ListRateLimiters(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/rate_limiters", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type Role struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateRole creates a new Role
@@ -43,3 +46,9 @@ func (c *Client) DeleteRole(ctx context.Context, namespace, name string) error {
 	path := fmt.Sprintf("/api/web/namespaces/%s/roles/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListRoles lists Role objects
+func (c *Client) ListRoles(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/web/namespaces/%s/roles", namespace)
+	return c.List(ctx, path, opts)
+}
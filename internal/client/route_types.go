@@ -12,6 +12,9 @@ import (
 type Route struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateRoute creates a new Route
@@ -43,3 +46,9 @@ func (c *Client) DeleteRoute(ctx context.Context, namespace, name string) error
 	path := fmt.Sprintf("/api/config/namespaces/%s/routes/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListRoutes lists Route objects
+func (c *Client) ListRoutes(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/routes", namespace)
+	return c.List(ctx, path, opts)
+}
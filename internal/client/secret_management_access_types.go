@@ -12,6 +12,9 @@ import (
 type SecretManagementAccess struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateSecretManagementAccess creates a new SecretManagementAccess
@@ -43,3 +46,9 @@ func (c *Client) DeleteSecretManagementAccess(ctx context.Context, namespace, na
 	path := fmt.Sprintf("/api/config/namespaces/%s/secret_management_accesss/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListSecretManagementAccesses lists SecretManagementAccess objects
+func (c *Client) ListSecretManagementAccesses(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/secret_management_accesss", namespace)
+	return c.List(ctx, path, opts)
+}
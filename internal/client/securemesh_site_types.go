@@ -12,6 +12,9 @@ import (
 type SecuremeshSite struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateSecuremeshSite creates a new SecuremeshSite
@@ -43,3 +46,9 @@ func (c *Client) DeleteSecuremeshSite(ctx context.Context, namespace, name strin
 	path := fmt.Sprintf("/api/config/namespaces/%s/securemesh_sites/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListSecuremeshSites lists SecuremeshSite objects
+func (c *Client) ListSecuremeshSites(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/securemesh_sites", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type Segment struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateSegment creates a new Segment
@@ -43,3 +46,9 @@ func (c *Client) DeleteSegment(ctx context.Context, namespace, name string) erro
 	path := fmt.Sprintf("/api/config/namespaces/%s/segments/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListSegments lists Segment objects
+func (c *Client) ListSegments(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/segments", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type SensitiveDataPolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateSensitiveDataPolicy creates a new SensitiveDataPolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteSensitiveDataPolicy(ctx context.Context, namespace, name
 	path := fmt.Sprintf("/api/config/namespaces/%s/sensitive_data_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListSensitiveDataPolicies lists SensitiveDataPolicy objects
+func (c *Client) ListSensitiveDataPolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/sensitive_data_policys", namespace)
+	return c.List(ctx, path, opts)
+}
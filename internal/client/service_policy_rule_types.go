@@ -12,6 +12,9 @@ import (
 type ServicePolicyRule struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateServicePolicyRule creates a new ServicePolicyRule
@@ -43,3 +46,9 @@ func (c *Client) DeleteServicePolicyRule(ctx context.Context, namespace, name st
 	path := fmt.Sprintf("/api/config/namespaces/%s/service_policy_rules/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListServicePolicyRules lists ServicePolicyRule objects
+func (c *Client) ListServicePolicyRules(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/service_policy_rules", namespace)
+	return c.List(ctx, path, opts)
+}
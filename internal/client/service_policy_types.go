@@ -12,6 +12,9 @@ import (
 type ServicePolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateServicePolicy creates a new ServicePolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteServicePolicy(ctx context.Context, namespace, name string
 	path := fmt.Sprintf("/api/config/namespaces/%s/service_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListServicePolicies lists ServicePolicy objects
+func (c *Client) ListServicePolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/service_policys", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type SiteMeshGroup struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateSiteMeshGroup creates a new SiteMeshGroup
@@ -43,3 +46,9 @@ func (c *Client) DeleteSiteMeshGroup(ctx context.Context, namespace, name string
 	path := fmt.Sprintf("/api/config/namespaces/%s/site_mesh_groups/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListSiteMeshGroups lists SiteMeshGroup objects
+func (c *Client) ListSiteMeshGroups(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/site_mesh_groups", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// Site terraform actions for F5 XC
+// Cloud sites deploy their infrastructure in AWS, Azure and GCP by running
+// terraform on behalf of the tenant. A run is started through a custom API
+// and its progress is only visible in the terraform parameters status.
+
+package client
+
+import (
+	"context"
+	"fmt"
+)
+
+// Site terraform run actions
+const (
+	SiteTerraformActionApply   = "APPLY"
+	SiteTerraformActionDestroy = "DESTROY"
+)
+
+// SiteTerraformApplyStatus is the status of the latest apply or destroy run
+// of a site
+type SiteTerraformApplyStatus struct {
+	ApplyState            string `json:"apply_state,omitempty"`
+	DestroyState          string `json:"destroy_state,omitempty"`
+	InfraState            string `json:"infra_state,omitempty"`
+	ErrorOutput           string `json:"error_output,omitempty"`
+	SuggestedAction       string `json:"suggested_action,omitempty"`
+	TfOutput              string `json:"tf_output,omitempty"`
+	TfStdout              string `json:"tf_stdout,omitempty"`
+	ContainerVersion      string `json:"container_version,omitempty"`
+	ModificationTimestamp string `json:"modification_timestamp,omitempty"`
+}
+
+// SiteTerraformStatus is the terraform status of a site
+type SiteTerraformStatus struct {
+	Status struct {
+		ApplyStatus *SiteTerraformApplyStatus `json:"apply_status,omitempty"`
+	} `json:"status"`
+}
+
+// RunSiteTerraform starts a terraform run of the given action for a site.
+// siteType is the kind of the site, e.g. aws_vpc_site.
+func (c *Client) RunSiteTerraform(ctx context.Context, namespace, siteType, name, action string) error {
+	path := fmt.Sprintf("/api/terraform/namespaces/%s/terraform/%s/%s/run", namespace, siteType, name)
+	body := map[string]interface{}{
+		"action":    action,
+		"namespace": namespace,
+		"view_kind": siteType,
+		"view_name": name,
+	}
+	return c.Post(ctx, path, body, nil)
+}
+
+// GetSiteTerraformStatus retrieves the status of the latest terraform runs
+// of a site
+func (c *Client) GetSiteTerraformStatus(ctx context.Context, namespace, siteType, name string) (*SiteTerraformStatus, error) {
+	var result SiteTerraformStatus
+	path := fmt.Sprintf("/api/config/namespaces/%s/terraform_parameters/%s/%s/status", namespace, siteType, name)
+	err := c.Get(ctx, path, &result)
+	return &result, err
+}
+
+// GetSiteSpec retrieves the spec of a site of the given kind, e.g.
+// aws_vpc_site
+func (c *Client) GetSiteSpec(ctx context.Context, namespace, siteType, name string) (map[string]interface{}, error) {
+	var result struct {
+		Spec map[string]interface{} `json:"spec"`
+	}
+	path := fmt.Sprintf("/api/config/namespaces/%s/%ss/%s", namespace, siteType, name)
+	err := c.Get(ctx, path, &result)
+	return result.Spec, err
+}
@@ -12,6 +12,9 @@ import (
 type Site struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateSite creates a new Site
@@ -43,3 +46,9 @@ func (c *Client) DeleteSite(ctx context.Context, namespace, name string) error {
 	path := fmt.Sprintf("/api/config/namespaces/%s/sites/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListSites lists Site objects
+func (c *Client) ListSites(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/sites", namespace)
+	return c.List(ctx, path, opts)
+}
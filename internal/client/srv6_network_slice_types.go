@@ -12,6 +12,9 @@ import (
 type Srv6NetworkSlice struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateSrv6NetworkSlice creates a new Srv6NetworkSlice
@@ -43,3 +46,9 @@ func (c *Client) DeleteSrv6NetworkSlice(ctx context.Context, namespace, name str
 	path := fmt.Sprintf("/api/config/namespaces/%s/srv6_network_slices/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListSrv6NetworkSlices lists Srv6NetworkSlice objects
+func (c *Client) ListSrv6NetworkSlices(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/srv6_network_slices", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type Subnet struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateSubnet creates a new Subnet
@@ -43,3 +46,9 @@ func (c *Client) DeleteSubnet(ctx context.Context, namespace, name string) error
 	path := fmt.Sprintf("/api/config/namespaces/%s/subnets/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListSubnets lists Subnet objects
+func (c *Client) ListSubnets(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/subnets", namespace)
+	return c.List(ctx, path, opts)
+}
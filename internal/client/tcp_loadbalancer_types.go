@@ -12,6 +12,9 @@ import (
 type TCPLoadBalancer struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateTCPLoadBalancer creates a new TCPLoadBalancer
@@ -43,3 +46,9 @@ func (c *Client) DeleteTCPLoadBalancer(ctx context.Context, namespace, name stri
 	path := fmt.Sprintf("/api/config/namespaces/%s/tcp_loadbalancers/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListTCPLoadBalancers lists TCPLoadBalancer objects
+func (c *Client) ListTCPLoadBalancers(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/tcp_loadbalancers", namespace)
+	return c.List(ctx, path, opts)
+}
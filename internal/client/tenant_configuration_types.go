@@ -12,6 +12,9 @@ import (
 type TenantConfiguration struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateTenantConfiguration creates a new TenantConfiguration
@@ -43,3 +46,9 @@ func (c *Client) DeleteTenantConfiguration(ctx context.Context, namespace, name
 	path := fmt.Sprintf("/api/config/namespaces/%s/tenant_configurations/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListTenantConfigurations lists TenantConfiguration objects
+func (c *Client) ListTenantConfigurations(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/tenant_configurations", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type TenantProfile struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateTenantProfile creates a new TenantProfile
@@ -43,3 +46,9 @@ func (c *Client) DeleteTenantProfile(ctx context.Context, namespace, name string
 	path := fmt.Sprintf("/api/web/namespaces/%s/tenant_profiles/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListTenantProfiles lists TenantProfile objects
+func (c *Client) ListTenantProfiles(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/web/namespaces/%s/tenant_profiles", namespace)
+	return c.List(ctx, path, opts)
+}
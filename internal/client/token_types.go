@@ -12,6 +12,9 @@ import (
 type Token struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateToken creates a new Token
@@ -43,3 +46,9 @@ func (c *Client) DeleteToken(ctx context.Context, namespace, name string) error
 	path := fmt.Sprintf("/api/register/namespaces/%s/tokens/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListTokens lists Token objects
+func (c *Client) ListTokens(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/register/namespaces/%s/tokens", namespace)
+	return c.List(ctx, path, opts)
+}
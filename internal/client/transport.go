@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// HTTP transport settings for F5 XC API requests
+// Every authentication mode of the client, and the blindfold functions that
+// call the API outside of the client, build their transport with
+// NewTransport so that proxy, CA and TLS verification settings apply the same
+// way everywhere. The transport starts from http.DefaultTransport, so the
+// HTTPS_PROXY and NO_PROXY environment variables keep working when a client
+// certificate is configured.
+
+package client
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+// TransportConfig holds the settings of the HTTP transport
+type TransportConfig struct {
+	// TLSConfig holds the client certificate, if any. It is cloned, not
+	// modified.
+	TLSConfig *tls.Config
+	// ProxyURL is the URL of the HTTP proxy. When empty, the proxy is taken
+	// from the HTTPS_PROXY and NO_PROXY environment variables.
+	ProxyURL string
+	// CACertFile is the path to a PEM-encoded CA bundle that replaces the
+	// system CAs for verifying the API server
+	CACertFile string
+	// InsecureSkipVerify disables verification of the API server certificate
+	InsecureSkipVerify bool
+}
+
+// NewTransport returns an HTTP transport with the defaults of
+// http.DefaultTransport and the given settings applied
+func NewTransport(config TransportConfig) (*http.Transport, error) {
+	t := newTransport(config.TLSConfig)
+
+	if config.ProxyURL != "" {
+		proxyURL, err := ParseProxyURL(config.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		t.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if config.CACertFile != "" {
+		pool, err := LoadCACertPool(config.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		t.TLSClientConfig.RootCAs = pool
+	}
+
+	if config.InsecureSkipVerify {
+		t.TLSClientConfig.InsecureSkipVerify = true
+	}
+
+	return t, nil
+}
+
+// newTransport returns a clone of http.DefaultTransport using tlsConfig
+func newTransport(tlsConfig *tls.Config) *http.Transport {
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	if tlsConfig != nil {
+		t.TLSClientConfig = tlsConfig.Clone()
+	} else {
+		t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	return t
+}
+
+// ParseProxyURL parses the URL of an HTTP proxy
+func ParseProxyURL(proxy string) (*url.URL, error) {
+	proxyURL, err := url.Parse(proxy)
+	if err != nil || proxyURL.Scheme == "" || proxyURL.Host == "" {
+		return nil, fmt.Errorf("invalid proxy URL %q: must be an absolute URL such as http://proxy.example.com:3128", proxy)
+	}
+	switch proxyURL.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("invalid proxy URL %q: scheme must be http, https or socks5", proxy)
+	}
+	return proxyURL, nil
+}
+
+// LoadCACertPool loads a PEM-encoded CA bundle
+func LoadCACertPool(caFile string) (*x509.CertPool, error) {
+	caCert, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate: %w", err)
+	}
+	caCertPool := x509.NewCertPool()
+	if !caCertPool.AppendCertsFromPEM(caCert) {
+		return nil, fmt.Errorf("failed to parse CA certificate")
+	}
+	return caCertPool, nil
+}
+
+// transport returns a copy of the HTTP transport of the client for options
+// to modify, creating one when the client uses the default transport. The
+// HTTP client and transport given with WithHTTPClient are copied, not
+// modified. It returns nil when the client was given an HTTP client with a
+// custom round tripper, which is then left unchanged.
+func (c *Client) transport() *http.Transport {
+	var t *http.Transport
+	switch transport := c.HTTPClient.Transport.(type) {
+	case nil:
+		t = newTransport(nil)
+	case *http.Transport:
+		t = transport.Clone()
+	default:
+		return nil
+	}
+	if t.TLSClientConfig == nil {
+		t.TLSClientConfig = &tls.Config{MinVersion: tls.VersionTLS12}
+	}
+	httpClient := *c.HTTPClient
+	httpClient.Transport = t
+	c.HTTPClient = &httpClient
+	return t
+}
+
+// WithProxy sends requests through the HTTP proxy at proxyURL instead of
+// the proxy from the environment
+func WithProxy(proxyURL *url.URL) ClientOption {
+	return func(c *Client) {
+		if t := c.transport(); t != nil {
+			t.Proxy = http.ProxyURL(proxyURL)
+		}
+	}
+}
+
+// WithRootCAs verifies the API server against the CAs in pool instead of the
+// system CAs
+func WithRootCAs(pool *x509.CertPool) ClientOption {
+	return func(c *Client) {
+		if t := c.transport(); t != nil {
+			t.TLSClientConfig.RootCAs = pool
+		}
+	}
+}
+
+// WithInsecureSkipVerify disables verification of the API server
+// certificate. It is meant for lab tenants with self-signed certificates.
+func WithInsecureSkipVerify(skip bool) ClientOption {
+	return func(c *Client) {
+		if t := c.transport(); t != nil {
+			t.TLSClientConfig.InsecureSkipVerify = skip
+		}
+	}
+}
+
+// WithHeaders adds headers to every request of the client. They cannot
+// override the Authorization, Content-Type and Accept headers set by the
+// client.
+func WithHeaders(headers map[string]string) ClientOption {
+	return func(c *Client) {
+		c.headers = headers
+	}
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// writeServerCA writes the certificate of a TLS test server to a PEM file
+func writeServerCA(t *testing.T, server *httptest.Server) string {
+	t.Helper()
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, data, 0o600); err != nil {
+		t.Fatalf("WriteFile() error = %v", err)
+	}
+	return caFile
+}
+
+// =============================================================================
+// Tests for NewTransport()
+// =============================================================================
+
+func TestNewTransport(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		transport, err := NewTransport(TransportConfig{})
+		if err != nil {
+			t.Fatalf("NewTransport() error = %v", err)
+		}
+		if transport.Proxy == nil {
+			t.Error("Proxy is nil, want the proxy from the environment")
+		}
+		if transport.TLSClientConfig.MinVersion != tls.VersionTLS12 {
+			t.Errorf("MinVersion = %x, want TLS 1.2", transport.TLSClientConfig.MinVersion)
+		}
+	})
+
+	t.Run("proxy", func(t *testing.T) {
+		transport, err := NewTransport(TransportConfig{ProxyURL: "http://proxy.example.com:3128"})
+		if err != nil {
+			t.Fatalf("NewTransport() error = %v", err)
+		}
+		req, _ := http.NewRequest(http.MethodGet, "https://console.ves.volterra.io", nil)
+		proxyURL, err := transport.Proxy(req)
+		if err != nil || proxyURL == nil || proxyURL.Host != "proxy.example.com:3128" {
+			t.Errorf("Proxy() = %v, %v, want proxy.example.com:3128", proxyURL, err)
+		}
+	})
+
+	t.Run("client certificate is kept", func(t *testing.T) {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS13, Certificates: []tls.Certificate{{}}}
+		transport, err := NewTransport(TransportConfig{TLSConfig: tlsConfig, InsecureSkipVerify: true})
+		if err != nil {
+			t.Fatalf("NewTransport() error = %v", err)
+		}
+		if len(transport.TLSClientConfig.Certificates) != 1 || !transport.TLSClientConfig.InsecureSkipVerify {
+			t.Error("TLSClientConfig does not hold the client certificate and InsecureSkipVerify")
+		}
+		if tlsConfig.InsecureSkipVerify {
+			t.Error("NewTransport() modified the given TLS configuration")
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, config := range []TransportConfig{
+			{ProxyURL: "proxy.example.com"},
+			{ProxyURL: "ftp://proxy.example.com"},
+			{CACertFile: "/nonexistent/ca.pem"},
+		} {
+			if _, err := NewTransport(config); err == nil {
+				t.Errorf("NewTransport(%+v) expected error", config)
+			}
+		}
+	})
+}
+
+// =============================================================================
+// Tests for transport client options
+// =============================================================================
+
+func TestClientTransport(t *testing.T) {
+	for _, c := range []*Client{
+		NewClient("https://example.com", "token"),
+		NewClient("https://example.com", "token", WithTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12})),
+	} {
+		transport, ok := c.HTTPClient.Transport.(*http.Transport)
+		if !ok {
+			t.Fatalf("Transport = %T, want *http.Transport", c.HTTPClient.Transport)
+		}
+		// The proxy from the environment is kept in every authentication mode
+		if transport.Proxy == nil {
+			t.Errorf("Proxy is nil for auth type %v", c.AuthType)
+		}
+	}
+}
+
+func TestWithRootCAs(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	// The test server certificate is not trusted by default
+	untrusted := NewClient(server.URL, "token", WithMaxRetries(0))
+	if err := untrusted.Get(context.Background(), "/test", nil); err == nil {
+		t.Error("Get() expected certificate error without the CA")
+	}
+
+	pool, err := LoadCACertPool(writeServerCA(t, server))
+	if err != nil {
+		t.Fatalf("LoadCACertPool() error = %v", err)
+	}
+	trusted := NewClient(server.URL, "token", WithRootCAs(pool))
+	if err := trusted.Get(context.Background(), "/test", nil); err != nil {
+		t.Errorf("Get() error = %v with the CA", err)
+	}
+
+	insecure := NewClient(server.URL, "token", WithInsecureSkipVerify(true))
+	if err := insecure.Get(context.Background(), "/test", nil); err != nil {
+		t.Errorf("Get() error = %v with insecure_skip_verify", err)
+	}
+}
+
+func TestWithHeaders(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Proxy-Token"); got != "abc" {
+			t.Errorf("X-Proxy-Token = %q, want abc", got)
+		}
+		if got := r.Header.Get("Authorization"); got != "APIToken token" {
+			t.Errorf("Authorization = %q, want the client token", got)
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	headers := map[string]string{"X-Proxy-Token": "abc", "Authorization": "Bearer other"}
+	c := NewClient(server.URL, "token", WithHeaders(headers))
+	if err := c.Get(context.Background(), "/test", nil); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	resp, err := c.AuthenticatedHTTPClient().Get(server.URL + "/test")
+	if err != nil {
+		t.Fatalf("AuthenticatedHTTPClient().Get() error = %v", err)
+	}
+	_ = resp.Body.Close()
+}
+
+func TestWithHTTPClientCustomTransport(t *testing.T) {
+	custom := &http.Client{Transport: &apiTokenTransport{transport: http.DefaultTransport}}
+	c := NewClient("https://example.com", "token", WithHTTPClient(custom), WithInsecureSkipVerify(true))
+	if _, ok := c.HTTPClient.Transport.(*apiTokenTransport); !ok {
+		t.Error("transport options replaced a custom round tripper")
+	}
+}
+
+func TestWithHTTPClientTransportNotModified(t *testing.T) {
+	transport := &http.Transport{TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12}}
+	custom := &http.Client{Transport: transport}
+	c := NewClient("https://example.com", "token", WithHTTPClient(custom), WithInsecureSkipVerify(true))
+
+	if custom.Transport != transport || transport.TLSClientConfig.InsecureSkipVerify {
+		t.Error("transport options modified the HTTP client given with WithHTTPClient")
+	}
+	got, ok := c.HTTPClient.Transport.(*http.Transport)
+	if !ok || got.TLSClientConfig == nil || !got.TLSClientConfig.InsecureSkipVerify {
+		t.Error("transport options were not applied to the copy of the transport")
+	}
+}
+
+func TestWithTLSConfigCustomTransport(t *testing.T) {
+	custom := &http.Client{Transport: &apiTokenTransport{transport: http.DefaultTransport}}
+	c := NewClient("https://example.com", "token", WithHTTPClient(custom), WithTLSConfig(&tls.Config{MinVersion: tls.VersionTLS12}))
+
+	if _, ok := c.HTTPClient.Transport.(*apiTokenTransport); !ok {
+		t.Error("WithTLSConfig() replaced a custom round tripper")
+	}
+	if err := c.Get(context.Background(), "/test", nil); err == nil {
+		t.Error("Get() expected error for a TLS configuration that was not applied")
+	}
+}
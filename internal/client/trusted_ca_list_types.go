@@ -12,6 +12,9 @@ import (
 type TrustedCAList struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateTrustedCAList creates a new TrustedCAList
@@ -43,3 +46,9 @@ func (c *Client) DeleteTrustedCAList(ctx context.Context, namespace, name string
 	path := fmt.Sprintf("/api/config/namespaces/%s/trusted_ca_lists/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListTrustedCALists lists TrustedCAList objects
+func (c *Client) ListTrustedCALists(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/trusted_ca_lists", namespace)
+	return c.List(ctx, path, opts)
+}
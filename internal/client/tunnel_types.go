@@ -12,6 +12,9 @@ import (
 type Tunnel struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateTunnel creates a new Tunnel
@@ -43,3 +46,9 @@ func (c *Client) DeleteTunnel(ctx context.Context, namespace, name string) error
 	path := fmt.Sprintf("/api/config/namespaces/%s/tunnels/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListTunnels lists Tunnel objects
+func (c *Client) ListTunnels(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/tunnels", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type UDPLoadBalancer struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateUDPLoadBalancer creates a new UDPLoadBalancer
@@ -43,3 +46,9 @@ func (c *Client) DeleteUDPLoadBalancer(ctx context.Context, namespace, name stri
 	path := fmt.Sprintf("/api/config/namespaces/%s/udp_loadbalancers/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListUDPLoadBalancers lists UDPLoadBalancer objects
+func (c *Client) ListUDPLoadBalancers(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/udp_loadbalancers", namespace)
+	return c.List(ctx, path, opts)
+}
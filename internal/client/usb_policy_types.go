@@ -12,6 +12,9 @@ import (
 type UsbPolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateUsbPolicy creates a new UsbPolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteUsbPolicy(ctx context.Context, namespace, name string) er
 	path := fmt.Sprintf("/api/config/namespaces/%s/usb_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListUsbPolicies lists UsbPolicy objects
+func (c *Client) ListUsbPolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/usb_policys", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type UserIdentification struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateUserIdentification creates a new UserIdentification
@@ -43,3 +46,9 @@ func (c *Client) DeleteUserIdentification(ctx context.Context, namespace, name s
 	path := fmt.Sprintf("/api/config/namespaces/%s/user_identifications/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListUserIdentifications lists UserIdentification objects
+func (c *Client) ListUserIdentifications(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/user_identifications", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -12,6 +12,9 @@ import (
 type VirtualHost struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateVirtualHost creates a new VirtualHost
@@ -43,3 +46,9 @@ func (c *Client) DeleteVirtualHost(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/virtual_hosts/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListVirtualHosts lists VirtualHost objects
+func (c *Client) ListVirtualHosts(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/virtual_hosts", namespace)
+	return c.List(ctx, path, opts)
+}
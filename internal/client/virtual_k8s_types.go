@@ -12,6 +12,9 @@ import (
 type VirtualK8S struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateVirtualK8S creates a new VirtualK8S
@@ -43,3 +46,9 @@ func (c *Client) DeleteVirtualK8S(ctx context.Context, namespace, name string) e
 	path := fmt.Sprintf("/api/config/namespaces/%s/virtual_k8ss/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListVirtualK8SList lists VirtualK8S objects
+func (c *Client) ListVirtualK8SList(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/virtual_k8ss", namespace)
+	return c.List(ctx, path, opts)
+}
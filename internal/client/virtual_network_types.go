@@ -12,6 +12,9 @@ import (
 type VirtualNetwork struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateVirtualNetwork creates a new VirtualNetwork
@@ -43,3 +46,9 @@ func (c *Client) DeleteVirtualNetwork(ctx context.Context, namespace, name strin
 	path := fmt.Sprintf("/api/config/namespaces/%s/virtual_networks/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListVirtualNetworks lists VirtualNetwork objects
+func (c *Client) ListVirtualNetworks(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/virtual_networks", namespace)
+	return c.List(ctx, path, opts)
+}
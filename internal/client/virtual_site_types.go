@@ -12,6 +12,9 @@ import (
 type VirtualSite struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateVirtualSite creates a new VirtualSite
@@ -43,3 +46,9 @@ func (c *Client) DeleteVirtualSite(ctx context.Context, namespace, name string)
 	path := fmt.Sprintf("/api/config/namespaces/%s/virtual_sites/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListVirtualSites lists VirtualSite objects
+func (c *Client) ListVirtualSites(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/virtual_sites", namespace)
+	return c.List(ctx, path, opts)
+}
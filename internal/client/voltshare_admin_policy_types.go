@@ -12,6 +12,9 @@ import (
 type VoltshareAdminPolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateVoltshareAdminPolicy creates a new VoltshareAdminPolicy
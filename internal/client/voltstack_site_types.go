@@ -12,6 +12,9 @@ import (
 type VoltstackSite struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateVoltstackSite creates a new VoltstackSite
@@ -43,3 +46,9 @@ func (c *Client) DeleteVoltstackSite(ctx context.Context, namespace, name string
 	path := fmt.Sprintf("/api/config/namespaces/%s/voltstack_sites/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListVoltstackSites lists VoltstackSite objects
+func (c *Client) ListVoltstackSites(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/voltstack_sites", namespace)
+	return c.List(ctx, path, opts)
+}
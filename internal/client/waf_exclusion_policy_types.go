@@ -12,6 +12,9 @@ import (
 type WAFExclusionPolicy struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateWAFExclusionPolicy creates a new WAFExclusionPolicy
@@ -43,3 +46,9 @@ func (c *Client) DeleteWAFExclusionPolicy(ctx context.Context, namespace, name s
 	path := fmt.Sprintf("/api/config/namespaces/%s/waf_exclusion_policys/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListWAFExclusionPolicies lists WAFExclusionPolicy objects
+func (c *Client) ListWAFExclusionPolicies(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/waf_exclusion_policys", namespace)
+	return c.List(ctx, path, opts)
+}
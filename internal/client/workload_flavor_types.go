@@ -12,6 +12,9 @@ import (
 type WorkloadFlavor struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateWorkloadFlavor creates a new WorkloadFlavor
@@ -43,3 +46,9 @@ func (c *Client) DeleteWorkloadFlavor(ctx context.Context, namespace, name strin
 	path := fmt.Sprintf("/api/config/namespaces/%s/workload_flavors/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListWorkloadFlavors lists WorkloadFlavor objects
+func (c *Client) ListWorkloadFlavors(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/workload_flavors", namespace)
+	return c.List(ctx, path, opts)
+}
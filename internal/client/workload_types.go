@@ -12,6 +12,9 @@ import (
 type Workload struct {
 	Metadata Metadata               `json:"metadata"`
 	Spec     map[string]interface{} `json:"spec"`
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata `json:"system_metadata,omitempty"`
 }
 
 // CreateWorkload creates a new Workload
@@ -43,3 +46,9 @@ func (c *Client) DeleteWorkload(ctx context.Context, namespace, name string) err
 	path := fmt.Sprintf("/api/config/namespaces/%s/workloads/%s", namespace, name)
 	return c.Delete(ctx, path)
 }
+
+// ListWorkloads lists Workload objects
+func (c *Client) ListWorkloads(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+	path := fmt.Sprintf("/api/config/namespaces/%s/workloads", namespace)
+	return c.List(ctx, path, opts)
+}
@@ -6,6 +6,7 @@ package errors
 
 import (
 	"encoding/json"
+	stderrors "errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -20,15 +21,16 @@ type ErrorCode string
 
 const (
 	// API errors
-	ErrCodeNotFound     ErrorCode = "NOT_FOUND"
-	ErrCodeUnauthorized ErrorCode = "UNAUTHORIZED"
-	ErrCodeForbidden    ErrorCode = "FORBIDDEN"
-	ErrCodeConflict     ErrorCode = "CONFLICT"
-	ErrCodeRateLimit    ErrorCode = "RATE_LIMIT"
-	ErrCodeServerError  ErrorCode = "SERVER_ERROR"
-	ErrCodeBadRequest   ErrorCode = "BAD_REQUEST"
-	ErrCodeTimeout      ErrorCode = "TIMEOUT"
-	ErrCodeNetworkError ErrorCode = "NETWORK_ERROR"
+	ErrCodeNotFound       ErrorCode = "NOT_FOUND"
+	ErrCodeUnauthorized   ErrorCode = "UNAUTHORIZED"
+	ErrCodeForbidden      ErrorCode = "FORBIDDEN"
+	ErrCodeConflict       ErrorCode = "CONFLICT"
+	ErrCodeRateLimit      ErrorCode = "RATE_LIMIT"
+	ErrCodeServerError    ErrorCode = "SERVER_ERROR"
+	ErrCodeBadRequest     ErrorCode = "BAD_REQUEST"
+	ErrCodeTimeout        ErrorCode = "TIMEOUT"
+	ErrCodeNetworkError   ErrorCode = "NETWORK_ERROR"
+	ErrCodeNotImplemented ErrorCode = "NOT_IMPLEMENTED"
 
 	// Resource errors
 	ErrCodeValidation    ErrorCode = "VALIDATION"
@@ -89,6 +91,43 @@ func (e *F5XCError) IsNotFound() bool {
 	return e.Code == ErrCodeNotFound
 }
 
+// IsConflict returns true if the resource already exists or was modified concurrently
+func (e *F5XCError) IsConflict() bool {
+	return e.Code == ErrCodeConflict
+}
+
+// IsNotImplemented returns true if the API does not support the operation
+func (e *F5XCError) IsNotImplemented() bool {
+	return e.Code == ErrCodeNotImplemented
+}
+
+// IsNotFound returns true if err or any error it wraps is a not found F5XCError
+func IsNotFound(err error) bool {
+	f5xcErr, ok := As(err)
+	return ok && f5xcErr.IsNotFound()
+}
+
+// IsConflict returns true if err or any error it wraps is a conflict F5XCError
+func IsConflict(err error) bool {
+	f5xcErr, ok := As(err)
+	return ok && f5xcErr.IsConflict()
+}
+
+// IsNotImplemented returns true if err or any error it wraps is a not implemented F5XCError
+func IsNotImplemented(err error) bool {
+	f5xcErr, ok := As(err)
+	return ok && f5xcErr.IsNotImplemented()
+}
+
+// As returns the first F5XCError in err's chain
+func As(err error) (*F5XCError, bool) {
+	var f5xcErr *F5XCError
+	if stderrors.As(err, &f5xcErr) {
+		return f5xcErr, true
+	}
+	return nil, false
+}
+
 // APIErrorResponse represents the F5 XC API error response structure
 type APIErrorResponse struct {
 	Code    string `json:"code"`
@@ -129,6 +168,9 @@ func NewAPIError(statusCode int, body []byte, resource, operation string) *F5XCE
 	case http.StatusBadRequest:
 		err.Code = ErrCodeBadRequest
 		err.Message = "Invalid request parameters"
+	case http.StatusNotImplemented:
+		err.Code = ErrCodeNotImplemented
+		err.Message = "Operation not supported by the F5 XC API"
 	default:
 		if statusCode >= 500 {
 			err.Code = ErrCodeServerError
@@ -216,11 +258,37 @@ func NewConfigurationError(message string) *F5XCError {
 
 // DiagnosticHelpers provides methods to add errors to diagnostics
 
-// AddError adds a structured error to diagnostics
+// AddError adds a structured error to diagnostics. The detail includes the
+// API error code and the remediation steps for the error code, if known.
 func AddError(diags *diag.Diagnostics, err *F5XCError) {
+	diags.AddError(errorSummary(err), errorDetail(err))
+}
+
+// errorSummary returns e.g. "Unable to create origin_pool" for errors wrapped
+// with an operation, otherwise e.g. "Not Found Error"
+func errorSummary(err *F5XCError) string {
+	if err.Operation != "" && err.Resource != "" {
+		return fmt.Sprintf("Unable to %s %s", err.Operation, err.Resource)
+	}
 	caser := cases.Title(language.English)
-	summary := fmt.Sprintf("%s Error", caser.String(string(err.Code)))
-	diags.AddError(summary, err.Error())
+	return fmt.Sprintf("%s Error", caser.String(strings.ReplaceAll(string(err.Code), "_", " ")))
+}
+
+func errorDetail(err *F5XCError) string {
+	var sb strings.Builder
+	sb.WriteString(err.Error())
+
+	if apiCode, ok := err.Details["api_code"]; ok {
+		sb.WriteString(fmt.Sprintf("\n\nAPI error code: %v", apiCode))
+	}
+	if steps := remediation(err); len(steps) > 0 {
+		sb.WriteString("\n\nRemediation:")
+		for _, step := range steps {
+			sb.WriteString("\n  - " + step)
+		}
+	}
+
+	return sb.String()
 }
 
 // AddWarning adds a warning to diagnostics
@@ -237,7 +305,7 @@ func AddAttributeError(diags *diag.Diagnostics, path, summary, detail string) {
 func CreateDiagnostic(operation, resourceType string, err error) diag.Diagnostics {
 	var diags diag.Diagnostics
 
-	if f5xcErr, ok := err.(*F5XCError); ok {
+	if f5xcErr, ok := As(err); ok {
 		AddError(&diags, f5xcErr)
 	} else {
 		diags.AddError(
@@ -251,7 +319,7 @@ func CreateDiagnostic(operation, resourceType string, err error) diag.Diagnostic
 
 // WrapError wraps an error with additional context
 func WrapError(err error, resource, operation string) *F5XCError {
-	if f5xcErr, ok := err.(*F5XCError); ok {
+	if f5xcErr, ok := As(err); ok {
 		f5xcErr.Resource = resource
 		f5xcErr.Operation = operation
 		return f5xcErr
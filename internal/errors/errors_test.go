@@ -5,6 +5,7 @@ package errors
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"testing"
@@ -146,6 +147,41 @@ func TestF5XCErrorIsNotFound(t *testing.T) {
 	}
 }
 
+func TestErrorCodeHelpers(t *testing.T) {
+	notFound := NewAPIError(http.StatusNotFound, nil, "origin_pool", "read")
+	conflict := NewAPIError(http.StatusConflict, nil, "origin_pool", "create")
+	notImplemented := NewAPIError(http.StatusNotImplemented, nil, "namespace", "delete")
+
+	tests := []struct {
+		name           string
+		err            error
+		notFound       bool
+		conflict       bool
+		notImplemented bool
+	}{
+		{"not found", notFound, true, false, false},
+		{"wrapped not found", fmt.Errorf("reading: %w", notFound), true, false, false},
+		{"conflict", conflict, false, true, false},
+		{"not implemented", notImplemented, false, false, true},
+		{"message containing 404", errors.New("origin pool web-404 NOT_FOUND 501"), false, false, false},
+		{"nil", nil, false, false, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsNotFound(tt.err); got != tt.notFound {
+				t.Errorf("IsNotFound() = %v, expected %v", got, tt.notFound)
+			}
+			if got := IsConflict(tt.err); got != tt.conflict {
+				t.Errorf("IsConflict() = %v, expected %v", got, tt.conflict)
+			}
+			if got := IsNotImplemented(tt.err); got != tt.notImplemented {
+				t.Errorf("IsNotImplemented() = %v, expected %v", got, tt.notImplemented)
+			}
+		})
+	}
+}
+
 func TestNewAPIError(t *testing.T) {
 	tests := []struct {
 		name         string
@@ -211,6 +247,14 @@ func TestNewAPIError(t *testing.T) {
 			operation:    "read",
 			expectedCode: ErrCodeServerError,
 		},
+		{
+			name:         "not implemented",
+			statusCode:   http.StatusNotImplemented,
+			body:         nil,
+			resource:     "namespace",
+			operation:    "delete",
+			expectedCode: ErrCodeNotImplemented,
+		},
 		{
 			name:         "with JSON body",
 			statusCode:   http.StatusBadRequest,
@@ -326,6 +370,53 @@ func TestAddError(t *testing.T) {
 	}
 }
 
+func TestAddErrorDetail(t *testing.T) {
+	var diags diag.Diagnostics
+	body := []byte(`{"code": "ALREADY_EXISTS", "message": "object already exists"}`)
+	err := WrapError(NewAPIError(http.StatusConflict, body, "/api/config/namespaces/shared/origin_pools", "POST"), "origin_pool", "create")
+
+	AddError(&diags, err)
+
+	if summary := diags.Errors()[0].Summary(); summary != "Unable to create origin_pool" {
+		t.Errorf("AddError() summary = %q", summary)
+	}
+	detail := diags.Errors()[0].Detail()
+	for _, want := range []string{"object already exists", "API error code: ALREADY_EXISTS", "Remediation:", "terraform import"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("AddError() detail missing %q:\n%s", want, detail)
+		}
+	}
+}
+
+func TestAddErrorRemediationByStatus(t *testing.T) {
+	var diags diag.Diagnostics
+
+	// BAD_REQUEST has no entry of its own; the 400 status matches VALIDATION_ERROR
+	AddError(&diags, NewAPIError(http.StatusBadRequest, nil, "", ""))
+
+	if summary := diags.Errors()[0].Summary(); summary != "Bad Request Error" {
+		t.Errorf("AddError() summary = %q", summary)
+	}
+	if !strings.Contains(diags.Errors()[0].Detail(), "Remediation:") {
+		t.Errorf("AddError() detail missing remediation:\n%s", diags.Errors()[0].Detail())
+	}
+}
+
+func TestErrorPatterns(t *testing.T) {
+	for code, pattern := range errorPatterns {
+		if len(pattern.Remediation) == 0 {
+			t.Errorf("error code %s has no remediation steps", code)
+		}
+		// Remediation steps are shown to Terraform users, who cannot call
+		// the tools of the MCP server
+		for _, step := range pattern.Remediation {
+			if strings.Contains(step, "f5xc_terraform_") {
+				t.Errorf("remediation step of %s refers to an MCP tool: %s", code, step)
+			}
+		}
+	}
+}
+
 func TestAddWarning(t *testing.T) {
 	var diags diag.Diagnostics
 
@@ -452,6 +543,7 @@ func TestErrorCodeConstants(t *testing.T) {
 		ErrCodeBadRequest,
 		ErrCodeTimeout,
 		ErrCodeNetworkError,
+		ErrCodeNotImplemented,
 		ErrCodeValidation,
 		ErrCodeStateRead,
 		ErrCodeStateWrite,
@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package errors
+
+// errorPattern describes the remediation of an API error
+type errorPattern struct {
+	Status      int
+	Remediation []string
+}
+
+// errorPatterns are the remediation steps shown with API errors, by error
+// code. Codes without an ErrorCode constant are matched by their HTTP status.
+// The MCP server keeps its own patterns in tools/metadata/error-patterns.json,
+// whose steps refer to its tools instead of provider settings.
+var errorPatterns = map[string]errorPattern{
+	"NOT_FOUND": {
+		Status: 404,
+		Remediation: []string{
+			"Run 'terraform refresh' to sync state",
+			"Check resource exists in F5XC console",
+			"Verify namespace is correct",
+			"Remove resource from state: terraform state rm <resource>",
+		},
+	},
+	"CONFLICT": {
+		Status: 409,
+		Remediation: []string{
+			"Import existing resource: terraform import <resource> <id>",
+			"Use a different name",
+			"Refresh state and retry: terraform refresh",
+		},
+	},
+	"UNAUTHORIZED": {
+		Status: 401,
+		Remediation: []string{
+			"Rotate API token in F5XC console",
+			"Check F5XC_API_TOKEN environment variable",
+			"Verify P12 certificate is valid and not expired",
+			"Regenerate credentials and update provider config",
+		},
+	},
+	"FORBIDDEN": {
+		Status: 403,
+		Remediation: []string{
+			"Check user/service account permissions in F5XC",
+			"Verify namespace access rights",
+			"Check that the subscription tier of the tenant includes the feature",
+			"Request elevated permissions from admin",
+		},
+	},
+	"RATE_LIMIT": {
+		Status: 429,
+		Remediation: []string{
+			"Reduce parallelism: terraform apply -parallelism=5",
+			"Limit the request rate: provider { rate_limit_rps = 5 }",
+			"Allow more retries: provider { max_retries = 5 }",
+			"Split large operations into batches",
+		},
+	},
+	"VALIDATION_ERROR": {
+		Status: 400,
+		Remediation: []string{
+			"Check the field values against the resource documentation",
+			"Check that all required fields are set",
+			"Check that enum fields use one of the documented values",
+			"Set only one field of each group of mutually exclusive fields",
+		},
+	},
+	"INTERNAL_ERROR": {
+		Status: 500,
+		Remediation: []string{
+			"Run terraform apply again once the API recovers",
+			"Check F5XC status page for incidents",
+			"Contact F5 support if persistent",
+		},
+	},
+	"SERVICE_UNAVAILABLE": {
+		Status: 503,
+		Remediation: []string{
+			"Run terraform apply again once the API is available",
+			"Check F5XC status page",
+			"Try again during off-peak hours",
+		},
+	},
+	"TIMEOUT": {
+		Status: 0,
+		Remediation: []string{
+			"Check network connectivity to F5XC API",
+			"Increase the request timeout: provider { request_timeout = \"5m\" }",
+			"Verify firewall allows HTTPS to *.volterra.io",
+			"Check for VPN or proxy issues",
+		},
+	},
+}
+
+// remediation returns the remediation steps for err, matched by error code
+// and otherwise by HTTP status code
+func remediation(err *F5XCError) []string {
+	if pattern, ok := errorPatterns[string(err.Code)]; ok {
+		return pattern.Remediation
+	}
+	if err.StatusCode == 0 {
+		return nil
+	}
+	for _, pattern := range errorPatterns {
+		if pattern.Status == err.StatusCode {
+			return pattern.Remediation
+		}
+	}
+	return nil
+}
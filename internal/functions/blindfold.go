@@ -19,13 +19,24 @@ var _ function.Function = &BlindfoldFunction{}
 
 // BlindfoldFunction implements the blindfold() provider function.
 // It encrypts base64-encoded plaintext using F5XC Secret Management.
-type BlindfoldFunction struct{}
+type BlindfoldFunction struct {
+	// transport returns the HTTP transport of the configured provider
+	// client, or nil when the provider is not configured
+	transport func() *http.Transport
+}
 
 // NewBlindfoldFunction creates a new blindfold function instance.
 func NewBlindfoldFunction() function.Function {
 	return &BlindfoldFunction{}
 }
 
+// NewBlindfoldFunctionWithTransport creates a new blindfold function
+// instance that sends its API requests through the transport returned by
+// transport.
+func NewBlindfoldFunctionWithTransport(transport func() *http.Transport) function.Function {
+	return &BlindfoldFunction{transport: transport}
+}
+
 // Metadata returns the function name.
 func (f *BlindfoldFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
 	resp.Name = "blindfold"
@@ -126,6 +137,9 @@ func (f *BlindfoldFunction) Run(ctx context.Context, req function.RunRequest, re
 		)
 		return
 	}
+	if f.transport != nil {
+		authConfig.Transport = f.transport()
+	}
 
 	// Create authenticated HTTP client (supports both token and P12 auth)
 	authResult, err := blindfold.CreateAuthenticatedClient(authConfig)
@@ -143,7 +157,7 @@ func (f *BlindfoldFunction) Run(ctx context.Context, req function.RunRequest, re
 			Timeout: 30 * time.Second,
 			Transport: &bearerTokenTransport{
 				token:     authResult.Token,
-				transport: http.DefaultTransport,
+				transport: authResult.Client.Transport,
 			},
 		}
 	}
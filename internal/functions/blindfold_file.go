@@ -20,13 +20,24 @@ var _ function.Function = &BlindfoldFileFunction{}
 
 // BlindfoldFileFunction implements the blindfold_file() provider function.
 // It reads a file and encrypts its contents using F5XC Secret Management.
-type BlindfoldFileFunction struct{}
+type BlindfoldFileFunction struct {
+	// transport returns the HTTP transport of the configured provider
+	// client, or nil when the provider is not configured
+	transport func() *http.Transport
+}
 
 // NewBlindfoldFileFunction creates a new blindfold_file function instance.
 func NewBlindfoldFileFunction() function.Function {
 	return &BlindfoldFileFunction{}
 }
 
+// NewBlindfoldFileFunctionWithTransport creates a new blindfold_file function
+// instance that sends its API requests through the transport returned by
+// transport.
+func NewBlindfoldFileFunctionWithTransport(transport func() *http.Transport) function.Function {
+	return &BlindfoldFileFunction{transport: transport}
+}
+
 // Metadata returns the function name.
 func (f *BlindfoldFileFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
 	resp.Name = "blindfold_file"
@@ -132,6 +143,9 @@ func (f *BlindfoldFileFunction) Run(ctx context.Context, req function.RunRequest
 		)
 		return
 	}
+	if f.transport != nil {
+		authConfig.Transport = f.transport()
+	}
 
 	// Create authenticated HTTP client (supports both token and P12 auth)
 	authResult, err := blindfold.CreateAuthenticatedClient(authConfig)
@@ -149,7 +163,7 @@ func (f *BlindfoldFileFunction) Run(ctx context.Context, req function.RunRequest
 			Timeout: 30 * time.Second,
 			Transport: &bearerTokenTransport{
 				token:     authResult.Token,
-				transport: http.DefaultTransport,
+				transport: authResult.Client.Transport,
 			},
 		}
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -103,7 +104,7 @@ func (d *AddonServiceActivationStatusDataSource) Read(ctx context.Context, req d
 	// Get activation status
 	status, err := d.client.GetAddonServiceActivationStatus(ctx, data.AddonService.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read addon service activation status: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "addon_service_activation_status", "read"))
 		return
 	}
 
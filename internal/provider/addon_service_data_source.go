@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -121,7 +122,7 @@ func (d *AddonServiceDataSource) Read(ctx context.Context, req datasource.ReadRe
 	// Get detailed addon service information using the custom API
 	details, err := d.client.GetAddonServiceDetails(ctx, data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read addon service details: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "addon_service", "read"))
 		return
 	}
 
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,20 @@ type AddonSubscriptionDataSource struct {
 	client *client.Client
 }
 
+// AddonSubscriptionDataSourceModel mirrors AddonSubscriptionResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type AddonSubscriptionDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata         types.Object                                  `tfsdk:"system_metadata"`
+	Name                   types.String                                  `tfsdk:"name"`
+	Namespace              types.String                                  `tfsdk:"namespace"`
+	Annotations            types.Map                                     `tfsdk:"annotations"`
+	Description            types.String                                  `tfsdk:"description"`
+	Disable                types.Bool                                    `tfsdk:"disable"`
+	Labels                 types.Map                                     `tfsdk:"labels"`
+	ID                     types.String                                  `tfsdk:"id"`
+	Status                 types.String                                  `tfsdk:"status"`
+	AddonService           *AddonSubscriptionAddonServiceModel           `tfsdk:"addon_service"`
+	NotificationPreference *AddonSubscriptionNotificationPreferenceModel `tfsdk:"notification_preference"`
 }
 
 func (d *AddonSubscriptionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +47,7 @@ func (d *AddonSubscriptionDataSource) Metadata(ctx context.Context, req datasour
 }
 
 func (d *AddonSubscriptionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages new Addon Subscription with Addon Subscription State in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the AddonSubscription.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the AddonSubscription exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the AddonSubscription.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewAddonSubscriptionResource())
 }
 
 func (d *AddonSubscriptionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +69,25 @@ func (d *AddonSubscriptionDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
-	resource, err := d.client.GetAddonSubscription(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetAddonSubscription(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AddonSubscription: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "addon_subscription", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +101,8 @@ func (d *AddonSubscriptionDataSource) Read(ctx context.Context, req datasource.R
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +111,42 @@ func (d *AddonSubscriptionDataSource) Read(ctx context.Context, req datasource.R
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["addon_service"].(map[string]interface{}); ok && (isImport || data.AddonService != nil) {
+		data.AddonService = &AddonSubscriptionAddonServiceModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["notification_preference"].(map[string]interface{}); ok && isImport && data.NotificationPreference == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NotificationPreference = &AddonSubscriptionNotificationPreferenceModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["status"].(string); ok && v != "" {
+		data.Status = types.StringValue(v)
+	} else {
+		data.Status = types.StringNull()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// addon_subscription_resource_hooks.go - Manually maintained hooks called by
+// the generated addon subscription resource. An addon service is activated
+// asynchronously once it is subscribed, and addons activated by F5 SRE stay
+// pending until they are approved.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
+)
+
+// Addon service activation states reported by the activation status API
+const (
+	addonServiceStateSubscribed = "AS_SUBSCRIBED"
+	addonServiceStatePending    = "AS_PENDING"
+	addonServiceStateError      = "AS_ERROR"
+)
+
+// subscriptionTierRanks orders the subscription tiers from lowest to highest
+var subscriptionTierRanks = map[string]int{
+	"NO_TIER":  0,
+	"BASIC":    1,
+	"STANDARD": 2,
+	"ADVANCED": 3,
+	"PREMIUM":  4,
+}
+
+// validatePlan refuses to create a subscription to an addon service that
+// requires a higher tier than the subscription_tier set on the provider
+func (r *AddonSubscriptionResource) validatePlan(ctx context.Context, plan *AddonSubscriptionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if r.client == nil || r.client.SubscriptionTier == "" || plan.AddonService == nil {
+		return diags
+	}
+	if plan.AddonService.Name.IsUnknown() || plan.AddonService.Name.IsNull() {
+		return diags
+	}
+
+	addonService := plan.AddonService.Name.ValueString()
+	if requiredTier, ok := addonServiceTierAllowed(addonService, r.client.SubscriptionTier); !ok {
+		diags.AddAttributeError(
+			path.Root("addon_service").AtName("name"),
+			"Addon Service Not Available",
+			fmt.Sprintf("The addon service %q requires the %s subscription tier, but the tenant has the %s tier. "+
+				"Upgrade the tenant subscription or update subscription_tier in the provider configuration.",
+				addonService, requiredTier, r.client.SubscriptionTier),
+		)
+	}
+	return diags
+}
+
+// waitForReady waits until the addon service is active. An addon service
+// activated by F5 SRE stays pending until it is approved, so a pending
+// activation of such an addon is reported as a warning instead of waited on.
+func (r *AddonSubscriptionResource) waitForReady(ctx context.Context, data *AddonSubscriptionResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if data.AddonService == nil || data.AddonService.Name.ValueString() == "" {
+		return diags
+	}
+	addonService := data.AddonService.Name.ValueString()
+
+	managed := true
+	details, err := r.client.GetAddonServiceDetails(ctx, addonService)
+	if err != nil {
+		tflog.Warn(ctx, "Failed to read addon service details, treating it as SRE-managed", map[string]interface{}{
+			"addon_service": addonService,
+			"error":         err.Error(),
+		})
+	} else {
+		managed = isManagedAddonService(details.SelfActivation, details.PartiallyManagedActivation, details.ManagedActivation)
+	}
+
+	target := []string{addonServiceStateSubscribed}
+	if managed {
+		target = append(target, addonServiceStatePending)
+	}
+	refresh := func(ctx context.Context) (string, error) {
+		status, err := r.client.GetAddonServiceActivationStatus(ctx, addonService)
+		if err != nil {
+			return "", err
+		}
+		return status.State, nil
+	}
+
+	state, err := waitForState(ctx, refresh, target, []string{addonServiceStateError}, defaultPollInterval)
+	if err != nil {
+		f5xcerrors.AddError(&diags, f5xcerrors.WrapError(err, "addon_subscription", "create"))
+		return diags
+	}
+	if state == addonServiceStatePending {
+		diags.AddWarning(
+			"Addon Service Activation Pending",
+			fmt.Sprintf("The addon service %q is activated by F5 and its activation is pending. "+
+				"Use the f5xc_addon_service_activation_status data source to check when it is subscribed.", addonService),
+		)
+	}
+	return diags
+}
+
+// addonServiceTierAllowed reports whether an addon service can be activated
+// by a tenant with the given subscription tier, along with the tier the addon
+// service requires. Addon services with an unknown tier are always allowed.
+func addonServiceTierAllowed(addonService, tenantTier string) (string, bool) {
+	requiredTier, ok := addonServiceTiers[addonService]
+	if !ok {
+		return "", true
+	}
+	requiredRank, ok := subscriptionTierRanks[requiredTier]
+	if !ok {
+		return requiredTier, true
+	}
+	return requiredTier, requiredRank <= subscriptionTierRanks[tenantTier]
+}
+
+// isManagedAddonService reports whether an addon service is activated by F5
+// SRE rather than by the tenant, based on the activation types it supports
+func isManagedAddonService(self, partiallyManaged, managed map[string]interface{}) bool {
+	return self == nil && (partiallyManaged != nil || managed != nil)
+}
@@ -0,0 +1,58 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import "testing"
+
+func TestAddonServiceTierAllowed(t *testing.T) {
+	tests := []struct {
+		name         string
+		addonService string
+		tenantTier   string
+		expectedTier string
+		allowed      bool
+	}{
+		{"standard addon on standard tenant", "f5xc-bot-defense-standard", "STANDARD", "STANDARD", true},
+		{"standard addon on advanced tenant", "f5xc-bot-defense-standard", "ADVANCED", "STANDARD", true},
+		{"standard addon on basic tenant", "f5xc-bot-defense-standard", "BASIC", "STANDARD", false},
+		{"advanced addon on standard tenant", "f5xc-waap-advanced", "STANDARD", "ADVANCED", false},
+		{"advanced addon on premium tenant", "f5xc-waap-advanced", "PREMIUM", "ADVANCED", true},
+		{"addon without tier", "client-side-defense", "BASIC", "NO_TIER", true},
+		{"unknown addon", "addon_observability", "BASIC", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tier, allowed := addonServiceTierAllowed(tt.addonService, tt.tenantTier)
+			if tier != tt.expectedTier || allowed != tt.allowed {
+				t.Errorf("addonServiceTierAllowed(%q, %q) = (%q, %v), want (%q, %v)",
+					tt.addonService, tt.tenantTier, tier, allowed, tt.expectedTier, tt.allowed)
+			}
+		})
+	}
+}
+
+func TestIsManagedAddonService(t *testing.T) {
+	activation := map[string]interface{}{}
+	tests := []struct {
+		name             string
+		self             map[string]interface{}
+		partiallyManaged map[string]interface{}
+		managed          map[string]interface{}
+		expected         bool
+	}{
+		{"self activation", activation, nil, nil, false},
+		{"self and managed activation", activation, nil, activation, false},
+		{"managed activation", nil, nil, activation, true},
+		{"partially managed activation", nil, activation, nil, true},
+		{"no activation type", nil, nil, nil, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if result := isManagedAddonService(tt.self, tt.partiallyManaged, tt.managed); result != tt.expected {
+				t.Errorf("isManagedAddonService() = %v, want %v", result, tt.expected)
+			}
+		})
+	}
+}
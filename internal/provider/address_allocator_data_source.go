@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,20 @@ type AddressAllocatorDataSource struct {
 	client *client.Client
 }
 
+// AddressAllocatorDataSourceModel mirrors AddressAllocatorResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type AddressAllocatorDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata          types.Object                                  `tfsdk:"system_metadata"`
+	Name                    types.String                                  `tfsdk:"name"`
+	Namespace               types.String                                  `tfsdk:"namespace"`
+	AddressPool             types.List                                    `tfsdk:"address_pool"`
+	Annotations             types.Map                                     `tfsdk:"annotations"`
+	Description             types.String                                  `tfsdk:"description"`
+	Disable                 types.Bool                                    `tfsdk:"disable"`
+	Labels                  types.Map                                     `tfsdk:"labels"`
+	ID                      types.String                                  `tfsdk:"id"`
+	Mode                    types.String                                  `tfsdk:"mode"`
+	AddressAllocationScheme *AddressAllocatorAddressAllocationSchemeModel `tfsdk:"address_allocation_scheme"`
 }
 
 func (d *AddressAllocatorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +47,7 @@ func (d *AddressAllocatorDataSource) Metadata(ctx context.Context, req datasourc
 }
 
 func (d *AddressAllocatorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages Address Allocator will create an address allocator object in 'system' namespace of the user. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the AddressAllocator.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the AddressAllocator exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the AddressAllocator.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewAddressAllocatorResource())
 }
 
 func (d *AddressAllocatorDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +69,25 @@ func (d *AddressAllocatorDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	resource, err := d.client.GetAddressAllocator(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetAddressAllocator(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AddressAllocator: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "address_allocator", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +101,8 @@ func (d *AddressAllocatorDataSource) Read(ctx context.Context, req datasource.Re
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +111,72 @@ func (d *AddressAllocatorDataSource) Read(ctx context.Context, req datasource.Re
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["address_allocation_scheme"].(map[string]interface{}); ok && (isImport || data.AddressAllocationScheme != nil) {
+		data.AddressAllocationScheme = &AddressAllocatorAddressAllocationSchemeModel{
+			AllocationUnit: func() types.Int64 {
+				if !isImport && data.AddressAllocationScheme != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.AddressAllocationScheme.AllocationUnit
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["allocation_unit"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			LocalInterfaceAddressOffset: func() types.Int64 {
+				if !isImport && data.AddressAllocationScheme != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.AddressAllocationScheme.LocalInterfaceAddressOffset
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["local_interface_address_offset"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			LocalInterfaceAddressType: func() types.String {
+				if v, ok := blockData["local_interface_address_type"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if v, ok := apiResource.Spec["address_pool"].([]interface{}); ok && len(v) > 0 {
+		var address_poolList []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				address_poolList = append(address_poolList, s)
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.StringType, address_poolList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.AddressPool = listVal
+		}
+	} else {
+		data.AddressPool = types.ListNull(types.StringType)
+	}
+	if v, ok := apiResource.Spec["mode"].(string); ok && v != "" {
+		data.Mode = types.StringValue(v)
+	} else {
+		data.Mode = types.StringNull()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
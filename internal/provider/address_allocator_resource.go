@@ -6,11 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,17 +20,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &AddressAllocatorResource{}
-	_ resource.ResourceWithConfigure      = &AddressAllocatorResource{}
-	_ resource.ResourceWithImportState    = &AddressAllocatorResource{}
-	_ resource.ResourceWithModifyPlan     = &AddressAllocatorResource{}
-	_ resource.ResourceWithValidateConfig = &AddressAllocatorResource{}
+	_ resource.Resource                     = &AddressAllocatorResource{}
+	_ resource.ResourceWithConfigure        = &AddressAllocatorResource{}
+	_ resource.ResourceWithImportState      = &AddressAllocatorResource{}
+	_ resource.ResourceWithIdentity         = &AddressAllocatorResource{}
+	_ resource.ResourceWithMoveState        = &AddressAllocatorResource{}
+	_ resource.ResourceWithModifyPlan       = &AddressAllocatorResource{}
+	_ resource.ResourceWithValidateConfig   = &AddressAllocatorResource{}
+	_ resource.ResourceWithConfigValidators = &AddressAllocatorResource{}
+	_ listableResource                      = &AddressAllocatorResource{}
 )
 
 func NewAddressAllocatorResource() resource.Resource {
@@ -70,6 +75,8 @@ type AddressAllocatorResourceModel struct {
 	ID                      types.String                                  `tfsdk:"id"`
 	Mode                    types.String                                  `tfsdk:"mode"`
 	Timeouts                timeouts.Value                                `tfsdk:"timeouts"`
+	SystemMetadata          types.Object                                  `tfsdk:"system_metadata"`
+	LabelsAll               types.Map                                     `tfsdk:"labels_all"`
 	AddressAllocationScheme *AddressAllocatorAddressAllocationSchemeModel `tfsdk:"address_allocation_scheme"`
 }
 
@@ -92,9 +99,11 @@ func (r *AddressAllocatorResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Address Allocator will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Address Allocator will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -105,6 +114,11 @@ func (r *AddressAllocatorResource) Schema(ctx context.Context, req resource.Sche
 				MarkdownDescription: "Address pool from which the allocator carves out subnets or addresses to its clients.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+				},
 			},
 			"annotations": schema.MapAttribute{
 				MarkdownDescription: "Annotations is an unstructured key value map stored with a resource that may be set by external tools to store and retrieve arbitrary metadata.",
@@ -139,6 +153,8 @@ func (r *AddressAllocatorResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -153,10 +169,18 @@ func (r *AddressAllocatorResource) Schema(ctx context.Context, req resource.Sche
 					"allocation_unit": schema.Int64Attribute{
 						MarkdownDescription: "Prefix length indicating the size of each allocated subnet. For example, if this is specified as 30, subnets of /30 will be allocated from the given address pool.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+							int64validator.AtMost(32),
+						},
 					},
 					"local_interface_address_offset": schema.Int64Attribute{
 						MarkdownDescription: "Used to derive address for the local interface from the allocated subnet. If Local Interface Address Type is set to 'Offset from beginning of Subnet', this offset value is added to the allocated subnet and used as the local interface address. For example, if the allocated subnet is..",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+							int64validator.AtMost(32),
+						},
 					},
 					"local_interface_address_type": schema.StringAttribute{
 						MarkdownDescription: "[Enum: LOCAL_INTERFACE_ADDRESS_OFFSET_FROM_SUBNET_BEGIN|LOCAL_INTERFACE_ADDRESS_OFFSET_FROM_SUBNET_END|LOCAL_INTERFACE_ADDRESS_FROM_PREFIX] Dictates how local interface address is derived from the allocated subnet Use Nth address of the allocated subnet as the local interface address, N being the Local Interface Address Offset. For example, if the allocated subnet is 169.254.0.0/30, Local Interface Address Offset is set to 2 and.. Possible values are `LOCAL_INTERFACE_ADDRESS_OFFSET_FROM_SUBNET_BEGIN`, `LOCAL_INTERFACE_ADDRESS_OFFSET_FROM_SUBNET_END`, `LOCAL_INTERFACE_ADDRESS_FROM_PREFIX`. Defaults to `LOCAL_INTERFACE_ADDRESS_OFFSET_FROM_SUBNET_BEGIN`.",
@@ -168,6 +192,11 @@ func (r *AddressAllocatorResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *AddressAllocatorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *AddressAllocatorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -192,6 +221,12 @@ func (r *AddressAllocatorResource) ValidateConfig(ctx context.Context, req resou
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *AddressAllocatorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return nil
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *AddressAllocatorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -202,7 +237,10 @@ func (r *AddressAllocatorResource) ModifyPlan(ctx context.Context, req resource.
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "address_allocator", "", req.Config, &resp.Plan)...)
 		var plan AddressAllocatorResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -259,6 +297,7 @@ func (r *AddressAllocatorResource) Create(ctx context.Context, req resource.Crea
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -268,6 +307,7 @@ func (r *AddressAllocatorResource) Create(ctx context.Context, req resource.Crea
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AddressAllocationScheme != nil {
@@ -296,11 +336,15 @@ func (r *AddressAllocatorResource) Create(ctx context.Context, req resource.Crea
 
 	apiResource, err := r.client.CreateAddressAllocator(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create AddressAllocator: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "address_allocator", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -392,7 +436,7 @@ func (r *AddressAllocatorResource) Read(ctx context.Context, req resource.ReadRe
 	apiResource, err := r.client.GetAddressAllocator(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "AddressAllocator not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -400,11 +444,12 @@ func (r *AddressAllocatorResource) Read(ctx context.Context, req resource.ReadRe
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AddressAllocator: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "address_allocator", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -415,31 +460,13 @@ func (r *AddressAllocatorResource) Read(ctx context.Context, req resource.ReadRe
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -447,6 +474,7 @@ func (r *AddressAllocatorResource) Read(ctx context.Context, req resource.ReadRe
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["address_allocation_scheme"].(map[string]interface{}); ok && (isImport || data.AddressAllocationScheme != nil) {
 		data.AddressAllocationScheme = &AddressAllocatorAddressAllocationSchemeModel{
@@ -550,6 +578,7 @@ func (r *AddressAllocatorResource) Update(ctx context.Context, req resource.Upda
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -559,6 +588,7 @@ func (r *AddressAllocatorResource) Update(ctx context.Context, req resource.Upda
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AddressAllocationScheme != nil {
@@ -587,7 +617,7 @@ func (r *AddressAllocatorResource) Update(ctx context.Context, req resource.Upda
 
 	_, err := r.client.UpdateAddressAllocator(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update AddressAllocator: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "address_allocator", "update"))
 		return
 	}
 
@@ -598,9 +628,12 @@ func (r *AddressAllocatorResource) Update(ctx context.Context, req resource.Upda
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetAddressAllocator(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AddressAllocator after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "address_allocator", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["mode"].(string); ok && v != "" {
@@ -699,7 +732,7 @@ func (r *AddressAllocatorResource) Delete(ctx context.Context, req resource.Dele
 	err := r.client.DeleteAddressAllocator(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "AddressAllocator already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -708,37 +741,33 @@ func (r *AddressAllocatorResource) Delete(ctx context.Context, req resource.Dele
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "AddressAllocator delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete AddressAllocator: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "address_allocator", "delete"))
 		return
 	}
 }
 
 func (r *AddressAllocatorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *AddressAllocatorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("address_allocator", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *AddressAllocatorResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListAddressAllocators(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *AddressAllocatorResource) requiredNamespace() string {
+	return ""
 }
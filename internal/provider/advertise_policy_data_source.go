@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,25 @@ type AdvertisePolicyDataSource struct {
 	client *client.Client
 }
 
+// AdvertisePolicyDataSourceModel mirrors AdvertisePolicyResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type AdvertisePolicyDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object                       `tfsdk:"system_metadata"`
+	Name           types.String                       `tfsdk:"name"`
+	Namespace      types.String                       `tfsdk:"namespace"`
+	Annotations    types.Map                          `tfsdk:"annotations"`
+	Description    types.String                       `tfsdk:"description"`
+	Disable        types.Bool                         `tfsdk:"disable"`
+	Labels         types.Map                          `tfsdk:"labels"`
+	ID             types.String                       `tfsdk:"id"`
+	Address        types.String                       `tfsdk:"address"`
+	Port           types.Int64                        `tfsdk:"port"`
+	PortRanges     types.String                       `tfsdk:"port_ranges"`
+	Protocol       types.String                       `tfsdk:"protocol"`
+	SkipXffAppend  types.Bool                         `tfsdk:"skip_xff_append"`
+	PublicIP       types.List                         `tfsdk:"public_ip"`
+	TLSParameters  *AdvertisePolicyTLSParametersModel `tfsdk:"tls_parameters"`
+	Where          *AdvertisePolicyWhereModel         `tfsdk:"where"`
 }
 
 func (d *AdvertisePolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +52,7 @@ func (d *AdvertisePolicyDataSource) Metadata(ctx context.Context, req datasource
 }
 
 func (d *AdvertisePolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Advertise Policy resource in F5 Distributed Cloud for advertise_policy object controls how and where a service represented by a given virtual_host object is advertised to consumers. configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the AdvertisePolicy.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the AdvertisePolicy exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the AdvertisePolicy.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewAdvertisePolicyResource())
 }
 
 func (d *AdvertisePolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +74,25 @@ func (d *AdvertisePolicyDataSource) Read(ctx context.Context, req datasource.Rea
 		return
 	}
 
-	resource, err := d.client.GetAdvertisePolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetAdvertisePolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AdvertisePolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "advertise_policy", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +106,8 @@ func (d *AdvertisePolicyDataSource) Read(ctx context.Context, req datasource.Rea
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +116,190 @@ func (d *AdvertisePolicyDataSource) Read(ctx context.Context, req datasource.Rea
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if listData, ok := apiResource.Spec["public_ip"].([]interface{}); ok && len(listData) > 0 {
+		var public_ipList []AdvertisePolicyPublicIPModel
+		var existingPublicIPItems []AdvertisePolicyPublicIPModel
+		if !data.PublicIP.IsNull() && !data.PublicIP.IsUnknown() {
+			data.PublicIP.ElementsAs(ctx, &existingPublicIPItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				public_ipList = append(public_ipList, AdvertisePolicyPublicIPModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AdvertisePolicyPublicIPModelAttrTypes}, public_ipList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.PublicIP = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.PublicIP = types.ListNull(types.ObjectType{AttrTypes: AdvertisePolicyPublicIPModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["tls_parameters"].(map[string]interface{}); ok && (isImport || data.TLSParameters != nil) {
+		data.TLSParameters = &AdvertisePolicyTLSParametersModel{
+			ClientCertificateOptional: func() *AdvertisePolicyEmptyModel {
+				if !isImport && data.TLSParameters != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.TLSParameters.ClientCertificateOptional
+				}
+				// Import case: read from API
+				if _, ok := blockData["client_certificate_optional"].(map[string]interface{}); ok {
+					return &AdvertisePolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			ClientCertificateRequired: func() *AdvertisePolicyEmptyModel {
+				if !isImport && data.TLSParameters != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.TLSParameters.ClientCertificateRequired
+				}
+				// Import case: read from API
+				if _, ok := blockData["client_certificate_required"].(map[string]interface{}); ok {
+					return &AdvertisePolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			CommonParams: func() *AdvertisePolicyTLSParametersCommonParamsModel {
+				if !isImport && data.TLSParameters != nil && data.TLSParameters.CommonParams != nil {
+					// Normal Read: preserve existing state value
+					return data.TLSParameters.CommonParams
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["common_params"].(map[string]interface{}); ok {
+					return &AdvertisePolicyTLSParametersCommonParamsModel{
+						CipherSuites: func() types.List {
+							if v, ok := nestedBlockData["cipher_suites"].([]interface{}); ok && len(v) > 0 {
+								var items []string
+								for _, item := range v {
+									if s, ok := item.(string); ok {
+										items = append(items, s)
+									}
+								}
+								listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+								return listVal
+							}
+							return types.ListNull(types.StringType)
+						}(),
+						MaximumProtocolVersion: func() types.String {
+							if v, ok := nestedBlockData["maximum_protocol_version"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						MinimumProtocolVersion: func() types.String {
+							if v, ok := nestedBlockData["minimum_protocol_version"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			NoClientCertificate: func() *AdvertisePolicyEmptyModel {
+				if !isImport && data.TLSParameters != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.TLSParameters.NoClientCertificate
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_client_certificate"].(map[string]interface{}); ok {
+					return &AdvertisePolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			XfccHeaderElements: func() types.List {
+				if v, ok := blockData["xfcc_header_elements"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["where"].(map[string]interface{}); ok && isImport && data.Where == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Where = &AdvertisePolicyWhereModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["address"].(string); ok && v != "" {
+		data.Address = types.StringValue(v)
+	} else {
+		data.Address = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["port"].(float64); ok {
+		data.Port = types.Int64Value(int64(v))
+	} else {
+		data.Port = types.Int64Null()
+	}
+	if v, ok := apiResource.Spec["port_ranges"].(string); ok && v != "" {
+		data.PortRanges = types.StringValue(v)
+	} else {
+		data.PortRanges = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["protocol"].(string); ok && v != "" {
+		data.Protocol = types.StringValue(v)
+	} else {
+		data.Protocol = types.StringNull()
+	}
+	// Top-level Optional bool: preserve prior state to avoid API default drift
+	if !isImport && !data.SkipXffAppend.IsNull() && !data.SkipXffAppend.IsUnknown() {
+		// Normal Read: preserve existing state value (do nothing)
+	} else {
+		// Import case, null state, or unknown (after Create): read from API
+		if v, ok := apiResource.Spec["skip_xff_append"].(bool); ok {
+			data.SkipXffAppend = types.BoolValue(v)
+		} else {
+			data.SkipXffAppend = types.BoolNull()
+		}
+	}
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewAdvertisePolicyResource(), &data)...)
 }
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,17 +24,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &AdvertisePolicyResource{}
-	_ resource.ResourceWithConfigure      = &AdvertisePolicyResource{}
-	_ resource.ResourceWithImportState    = &AdvertisePolicyResource{}
-	_ resource.ResourceWithModifyPlan     = &AdvertisePolicyResource{}
-	_ resource.ResourceWithValidateConfig = &AdvertisePolicyResource{}
+	_ resource.Resource                     = &AdvertisePolicyResource{}
+	_ resource.ResourceWithConfigure        = &AdvertisePolicyResource{}
+	_ resource.ResourceWithImportState      = &AdvertisePolicyResource{}
+	_ resource.ResourceWithIdentity         = &AdvertisePolicyResource{}
+	_ resource.ResourceWithMoveState        = &AdvertisePolicyResource{}
+	_ resource.ResourceWithModifyPlan       = &AdvertisePolicyResource{}
+	_ resource.ResourceWithValidateConfig   = &AdvertisePolicyResource{}
+	_ resource.ResourceWithConfigValidators = &AdvertisePolicyResource{}
+	_ listableResource                      = &AdvertisePolicyResource{}
 )
 
 func NewAdvertisePolicyResource() resource.Resource {
@@ -159,14 +166,16 @@ var AdvertisePolicyTLSParametersCommonParamsTLSCertificatesPrivateKeyBlindfoldSe
 
 // AdvertisePolicyTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type AdvertisePolicyTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // AdvertisePolicyTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for AdvertisePolicyTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModel
 var AdvertisePolicyTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // AdvertisePolicyTLSParametersCommonParamsValidationParamsModel represents validation_params block
@@ -324,22 +333,24 @@ var AdvertisePolicyWhereVirtualSiteRefModelAttrTypes = map[string]attr.Type{
 }
 
 type AdvertisePolicyResourceModel struct {
-	Name          types.String                       `tfsdk:"name"`
-	Namespace     types.String                       `tfsdk:"namespace"`
-	Annotations   types.Map                          `tfsdk:"annotations"`
-	Description   types.String                       `tfsdk:"description"`
-	Disable       types.Bool                         `tfsdk:"disable"`
-	Labels        types.Map                          `tfsdk:"labels"`
-	ID            types.String                       `tfsdk:"id"`
-	Address       types.String                       `tfsdk:"address"`
-	Port          types.Int64                        `tfsdk:"port"`
-	PortRanges    types.String                       `tfsdk:"port_ranges"`
-	Protocol      types.String                       `tfsdk:"protocol"`
-	SkipXffAppend types.Bool                         `tfsdk:"skip_xff_append"`
-	Timeouts      timeouts.Value                     `tfsdk:"timeouts"`
-	PublicIP      types.List                         `tfsdk:"public_ip"`
-	TLSParameters *AdvertisePolicyTLSParametersModel `tfsdk:"tls_parameters"`
-	Where         *AdvertisePolicyWhereModel         `tfsdk:"where"`
+	Name           types.String                       `tfsdk:"name"`
+	Namespace      types.String                       `tfsdk:"namespace"`
+	Annotations    types.Map                          `tfsdk:"annotations"`
+	Description    types.String                       `tfsdk:"description"`
+	Disable        types.Bool                         `tfsdk:"disable"`
+	Labels         types.Map                          `tfsdk:"labels"`
+	ID             types.String                       `tfsdk:"id"`
+	Address        types.String                       `tfsdk:"address"`
+	Port           types.Int64                        `tfsdk:"port"`
+	PortRanges     types.String                       `tfsdk:"port_ranges"`
+	Protocol       types.String                       `tfsdk:"protocol"`
+	SkipXffAppend  types.Bool                         `tfsdk:"skip_xff_append"`
+	Timeouts       timeouts.Value                     `tfsdk:"timeouts"`
+	SystemMetadata types.Object                       `tfsdk:"system_metadata"`
+	LabelsAll      types.Map                          `tfsdk:"labels_all"`
+	PublicIP       types.List                         `tfsdk:"public_ip"`
+	TLSParameters  *AdvertisePolicyTLSParametersModel `tfsdk:"tls_parameters"`
+	Where          *AdvertisePolicyWhereModel         `tfsdk:"where"`
 }
 
 func (r *AdvertisePolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -361,9 +372,11 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Advertise Policy will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Advertise Policy will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -402,6 +415,9 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					validators.IPValidator(),
+				},
 			},
 			"port": schema.Int64Attribute{
 				MarkdownDescription: "[OneOf: port, port_ranges] Port to advertise.",
@@ -410,6 +426,9 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(65535),
+				},
 			},
 			"port_ranges": schema.StringAttribute{
 				MarkdownDescription: "A string containing a comma separated list of port ranges. Each port range consists of a single port or two ports separated by '-'.",
@@ -418,6 +437,10 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(512),
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
 			},
 			"protocol": schema.StringAttribute{
 				MarkdownDescription: "Protocol. Protocol to advertise.",
@@ -435,6 +458,8 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -493,6 +518,9 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 						MarkdownDescription: "[Enum: XFCC_NONE|XFCC_CERT|XFCC_CHAIN|XFCC_SUBJECT|XFCC_URI|XFCC_DNS] X-Forwarded-Client-Cert header elements to be set in an mTLS enabled connections. If none are defined, the header will not be added. Possible values are `XFCC_NONE`, `XFCC_CERT`, `XFCC_CHAIN`, `XFCC_SUBJECT`, `XFCC_URI`, `XFCC_DNS`. Defaults to `XFCC_NONE`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.UniqueValues(),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -509,6 +537,9 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 								MarkdownDescription: "The following list specifies the supported cipher suite TLS_AES_128_GCM_SHA256 TLS_AES_256_GCM_SHA384 TLS_CHACHA20_POLY1305_SHA256 TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256 TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384 TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256 TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256..",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.UniqueValues(),
+								},
 							},
 							"maximum_protocol_version": schema.StringAttribute{
 								MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -527,6 +558,10 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -541,6 +576,11 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -576,7 +616,16 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -599,6 +648,9 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Inline Root CA Certificate.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
 									},
 									"verify_subject_alt_names": schema.ListAttribute{
 										MarkdownDescription: "List of acceptable Subject Alt Names/CN in the peer's certificate. When skip_hostname_verification is false and verify_subject_alt_names is empty, the hostname of the peer will be used for matching against SAN/CN of peer's certificate.",
@@ -613,6 +665,9 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 										Blocks: map[string]schema.Block{
 											"trusted_ca_list": schema.ListNestedBlock{
 												MarkdownDescription: "Root CA Certificate Reference. Reference to Root CA Certificate.",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(1),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"kind": schema.StringAttribute{
@@ -686,6 +741,9 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 							},
 							"ref": schema.ListNestedBlock{
 								MarkdownDescription: "Reference. A site direct reference .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -735,6 +793,9 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 						Blocks: map[string]schema.Block{
 							"ref": schema.ListNestedBlock{
 								MarkdownDescription: "Virtual network direct reference .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -795,6 +856,9 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 							},
 							"ref": schema.ListNestedBlock{
 								MarkdownDescription: "Virtual_site direct reference .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -844,6 +908,11 @@ func (r *AdvertisePolicyResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *AdvertisePolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *AdvertisePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -868,6 +937,21 @@ func (r *AdvertisePolicyResource) ValidateConfig(ctx context.Context, req resour
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *AdvertisePolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "port_choice", "port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters"), "client_certificate_verify_choice", "client_certificate_optional", "client_certificate_required", "no_client_certificate"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters").AtName("common_params").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters").AtName("common_params").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters").AtName("common_params").AtName("validation_params"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("where"), "ref_or_selector", "site", "virtual_network", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("where").AtName("site"), "internet_vip_choice", "disable_internet_vip", "enable_internet_vip"),
+		validators.OneOfGroup(path.MatchRoot("where").AtName("virtual_site"), "internet_vip_choice", "disable_internet_vip", "enable_internet_vip"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *AdvertisePolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -878,7 +962,10 @@ func (r *AdvertisePolicyResource) ModifyPlan(ctx context.Context, req resource.M
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "advertise_policy", "", req.Config, &resp.Plan)...)
 		var plan AdvertisePolicyResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -896,7 +983,8 @@ func (r *AdvertisePolicyResource) ModifyPlan(ctx context.Context, req resource.M
 
 func (r *AdvertisePolicyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data AdvertisePolicyResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -935,6 +1023,7 @@ func (r *AdvertisePolicyResource) Create(ctx context.Context, req resource.Creat
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -944,6 +1033,7 @@ func (r *AdvertisePolicyResource) Create(ctx context.Context, req resource.Creat
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.PublicIP.IsNull() && !data.PublicIP.IsUnknown() {
@@ -990,6 +1080,27 @@ func (r *AdvertisePolicyResource) Create(ctx context.Context, req resource.Creat
 			if !data.TLSParameters.CommonParams.MinimumProtocolVersion.IsNull() && !data.TLSParameters.CommonParams.MinimumProtocolVersion.IsUnknown() {
 				common_paramsNestedMap["minimum_protocol_version"] = data.TLSParameters.CommonParams.MinimumProtocolVersion.ValueString()
 			}
+			if data.TLSParameters.CommonParams.ValidationParams != nil {
+				validation_paramsLevel3Map := make(map[string]interface{})
+				if !data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
+					validation_paramsLevel3Map["skip_hostname_verification"] = data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.ValueBool()
+				}
+				if data.TLSParameters.CommonParams.ValidationParams.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					validation_paramsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.IsUnknown() {
+					validation_paramsLevel3Map["trusted_ca_url"] = data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.ValueString()
+				}
+				if !data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.IsUnknown() {
+					var verify_subject_alt_namesLevel3Items []string
+					diags := data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.ElementsAs(ctx, &verify_subject_alt_namesLevel3Items, false)
+					if !diags.HasError() {
+						validation_paramsLevel3Map["verify_subject_alt_names"] = verify_subject_alt_namesLevel3Items
+					}
+				}
+				common_paramsNestedMap["validation_params"] = validation_paramsLevel3Map
+			}
 			tls_parametersMap["common_params"] = common_paramsNestedMap
 		}
 		if data.TLSParameters.NoClientCertificate != nil {
@@ -1008,6 +1119,12 @@ func (r *AdvertisePolicyResource) Create(ctx context.Context, req resource.Creat
 		whereMap := make(map[string]interface{})
 		if data.Where.Site != nil {
 			siteNestedMap := make(map[string]interface{})
+			if data.Where.Site.DisableInternetVIP != nil {
+				siteNestedMap["disable_internet_vip"] = map[string]interface{}{}
+			}
+			if data.Where.Site.EnableInternetVIP != nil {
+				siteNestedMap["enable_internet_vip"] = map[string]interface{}{}
+			}
 			if !data.Where.Site.NetworkType.IsNull() && !data.Where.Site.NetworkType.IsUnknown() {
 				siteNestedMap["network_type"] = data.Where.Site.NetworkType.ValueString()
 			}
@@ -1019,6 +1136,12 @@ func (r *AdvertisePolicyResource) Create(ctx context.Context, req resource.Creat
 		}
 		if data.Where.VirtualSite != nil {
 			virtual_siteNestedMap := make(map[string]interface{})
+			if data.Where.VirtualSite.DisableInternetVIP != nil {
+				virtual_siteNestedMap["disable_internet_vip"] = map[string]interface{}{}
+			}
+			if data.Where.VirtualSite.EnableInternetVIP != nil {
+				virtual_siteNestedMap["enable_internet_vip"] = map[string]interface{}{}
+			}
 			if !data.Where.VirtualSite.NetworkType.IsNull() && !data.Where.VirtualSite.NetworkType.IsUnknown() {
 				virtual_siteNestedMap["network_type"] = data.Where.VirtualSite.NetworkType.ValueString()
 			}
@@ -1044,11 +1167,15 @@ func (r *AdvertisePolicyResource) Create(ctx context.Context, req resource.Creat
 
 	apiResource, err := r.client.CreateAdvertisePolicy(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create AdvertisePolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "advertise_policy", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1256,7 +1383,7 @@ func (r *AdvertisePolicyResource) Read(ctx context.Context, req resource.ReadReq
 	apiResource, err := r.client.GetAdvertisePolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "AdvertisePolicy not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1264,11 +1391,12 @@ func (r *AdvertisePolicyResource) Read(ctx context.Context, req resource.ReadReq
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AdvertisePolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "advertise_policy", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1279,31 +1407,13 @@ func (r *AdvertisePolicyResource) Read(ctx context.Context, req resource.ReadReq
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1311,6 +1421,7 @@ func (r *AdvertisePolicyResource) Read(ctx context.Context, req resource.ReadReq
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if listData, ok := apiResource.Spec["public_ip"].([]interface{}); ok && len(listData) > 0 {
 		var public_ipList []AdvertisePolicyPublicIPModel
@@ -1496,7 +1607,8 @@ func (r *AdvertisePolicyResource) Read(ctx context.Context, req resource.ReadReq
 
 func (r *AdvertisePolicyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data AdvertisePolicyResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1530,6 +1642,7 @@ func (r *AdvertisePolicyResource) Update(ctx context.Context, req resource.Updat
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1539,6 +1652,7 @@ func (r *AdvertisePolicyResource) Update(ctx context.Context, req resource.Updat
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.PublicIP.IsNull() && !data.PublicIP.IsUnknown() {
@@ -1585,6 +1699,27 @@ func (r *AdvertisePolicyResource) Update(ctx context.Context, req resource.Updat
 			if !data.TLSParameters.CommonParams.MinimumProtocolVersion.IsNull() && !data.TLSParameters.CommonParams.MinimumProtocolVersion.IsUnknown() {
 				common_paramsNestedMap["minimum_protocol_version"] = data.TLSParameters.CommonParams.MinimumProtocolVersion.ValueString()
 			}
+			if data.TLSParameters.CommonParams.ValidationParams != nil {
+				validation_paramsLevel3Map := make(map[string]interface{})
+				if !data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
+					validation_paramsLevel3Map["skip_hostname_verification"] = data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.ValueBool()
+				}
+				if data.TLSParameters.CommonParams.ValidationParams.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					validation_paramsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.IsUnknown() {
+					validation_paramsLevel3Map["trusted_ca_url"] = data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.ValueString()
+				}
+				if !data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.IsUnknown() {
+					var verify_subject_alt_namesLevel3Items []string
+					diags := data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.ElementsAs(ctx, &verify_subject_alt_namesLevel3Items, false)
+					if !diags.HasError() {
+						validation_paramsLevel3Map["verify_subject_alt_names"] = verify_subject_alt_namesLevel3Items
+					}
+				}
+				common_paramsNestedMap["validation_params"] = validation_paramsLevel3Map
+			}
 			tls_parametersMap["common_params"] = common_paramsNestedMap
 		}
 		if data.TLSParameters.NoClientCertificate != nil {
@@ -1603,6 +1738,12 @@ func (r *AdvertisePolicyResource) Update(ctx context.Context, req resource.Updat
 		whereMap := make(map[string]interface{})
 		if data.Where.Site != nil {
 			siteNestedMap := make(map[string]interface{})
+			if data.Where.Site.DisableInternetVIP != nil {
+				siteNestedMap["disable_internet_vip"] = map[string]interface{}{}
+			}
+			if data.Where.Site.EnableInternetVIP != nil {
+				siteNestedMap["enable_internet_vip"] = map[string]interface{}{}
+			}
 			if !data.Where.Site.NetworkType.IsNull() && !data.Where.Site.NetworkType.IsUnknown() {
 				siteNestedMap["network_type"] = data.Where.Site.NetworkType.ValueString()
 			}
@@ -1614,6 +1755,12 @@ func (r *AdvertisePolicyResource) Update(ctx context.Context, req resource.Updat
 		}
 		if data.Where.VirtualSite != nil {
 			virtual_siteNestedMap := make(map[string]interface{})
+			if data.Where.VirtualSite.DisableInternetVIP != nil {
+				virtual_siteNestedMap["disable_internet_vip"] = map[string]interface{}{}
+			}
+			if data.Where.VirtualSite.EnableInternetVIP != nil {
+				virtual_siteNestedMap["enable_internet_vip"] = map[string]interface{}{}
+			}
 			if !data.Where.VirtualSite.NetworkType.IsNull() && !data.Where.VirtualSite.NetworkType.IsUnknown() {
 				virtual_siteNestedMap["network_type"] = data.Where.VirtualSite.NetworkType.ValueString()
 			}
@@ -1639,7 +1786,7 @@ func (r *AdvertisePolicyResource) Update(ctx context.Context, req resource.Updat
 
 	_, err := r.client.UpdateAdvertisePolicy(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update AdvertisePolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "advertise_policy", "update"))
 		return
 	}
 
@@ -1650,9 +1797,12 @@ func (r *AdvertisePolicyResource) Update(ctx context.Context, req resource.Updat
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetAdvertisePolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AdvertisePolicy after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "advertise_policy", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["address"].(string); ok && v != "" {
@@ -1895,7 +2045,7 @@ func (r *AdvertisePolicyResource) Delete(ctx context.Context, req resource.Delet
 	err := r.client.DeleteAdvertisePolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "AdvertisePolicy already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1904,37 +2054,33 @@ func (r *AdvertisePolicyResource) Delete(ctx context.Context, req resource.Delet
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "AdvertisePolicy delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete AdvertisePolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "advertise_policy", "delete"))
 		return
 	}
 }
 
 func (r *AdvertisePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *AdvertisePolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("advertise_policy", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *AdvertisePolicyResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListAdvertisePolicies(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *AdvertisePolicyResource) requiredNamespace() string {
+	return ""
 }
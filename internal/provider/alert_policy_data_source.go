@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,20 @@ type AlertPolicyDataSource struct {
 	client *client.Client
 }
 
+// AlertPolicyDataSourceModel mirrors AlertPolicyResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type AlertPolicyDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata         types.Object                            `tfsdk:"system_metadata"`
+	Name                   types.String                            `tfsdk:"name"`
+	Namespace              types.String                            `tfsdk:"namespace"`
+	Annotations            types.Map                               `tfsdk:"annotations"`
+	Description            types.String                            `tfsdk:"description"`
+	Disable                types.Bool                              `tfsdk:"disable"`
+	Labels                 types.Map                               `tfsdk:"labels"`
+	ID                     types.String                            `tfsdk:"id"`
+	NotificationParameters *AlertPolicyNotificationParametersModel `tfsdk:"notification_parameters"`
+	Receivers              types.List                              `tfsdk:"receivers"`
+	Routes                 types.List                              `tfsdk:"routes"`
 }
 
 func (d *AlertPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +47,7 @@ func (d *AlertPolicyDataSource) Metadata(ctx context.Context, req datasource.Met
 }
 
 func (d *AlertPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages new Alert Policy Object. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the AlertPolicy.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the AlertPolicy exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the AlertPolicy.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewAlertPolicyResource())
 }
 
 func (d *AlertPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +69,25 @@ func (d *AlertPolicyDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	resource, err := d.client.GetAlertPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetAlertPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AlertPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "alert_policy", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +101,8 @@ func (d *AlertPolicyDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +111,293 @@ func (d *AlertPolicyDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["notification_parameters"].(map[string]interface{}); ok && (isImport || data.NotificationParameters != nil) {
+		data.NotificationParameters = &AlertPolicyNotificationParametersModel{
+			Custom: func() *AlertPolicyNotificationParametersCustomModel {
+				if !isImport && data.NotificationParameters != nil && data.NotificationParameters.Custom != nil {
+					// Normal Read: preserve existing state value
+					return data.NotificationParameters.Custom
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["custom"].(map[string]interface{}); ok {
+					return &AlertPolicyNotificationParametersCustomModel{
+						Labels: func() types.List {
+							if v, ok := nestedBlockData["labels"].([]interface{}); ok && len(v) > 0 {
+								var items []string
+								for _, item := range v {
+									if s, ok := item.(string); ok {
+										items = append(items, s)
+									}
+								}
+								listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+								return listVal
+							}
+							return types.ListNull(types.StringType)
+						}(),
+					}
+				}
+				return nil
+			}(),
+			Default: func() *AlertPolicyEmptyModel {
+				if !isImport && data.NotificationParameters != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.NotificationParameters.Default
+				}
+				// Import case: read from API
+				if _, ok := blockData["default"].(map[string]interface{}); ok {
+					return &AlertPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			GroupInterval: func() types.String {
+				if v, ok := blockData["group_interval"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			GroupWait: func() types.String {
+				if v, ok := blockData["group_wait"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Individual: func() *AlertPolicyEmptyModel {
+				if !isImport && data.NotificationParameters != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.NotificationParameters.Individual
+				}
+				// Import case: read from API
+				if _, ok := blockData["individual"].(map[string]interface{}); ok {
+					return &AlertPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			RepeatInterval: func() types.String {
+				if v, ok := blockData["repeat_interval"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			VesIoGroup: func() *AlertPolicyEmptyModel {
+				if !isImport && data.NotificationParameters != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.NotificationParameters.VesIoGroup
+				}
+				// Import case: read from API
+				if _, ok := blockData["ves_io_group"].(map[string]interface{}); ok {
+					return &AlertPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+		}
+	}
+	if listData, ok := apiResource.Spec["receivers"].([]interface{}); ok && len(listData) > 0 {
+		var receiversList []AlertPolicyReceiversModel
+		var existingReceiversItems []AlertPolicyReceiversModel
+		if !data.Receivers.IsNull() && !data.Receivers.IsUnknown() {
+			data.Receivers.ElementsAs(ctx, &existingReceiversItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				receiversList = append(receiversList, AlertPolicyReceiversModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AlertPolicyReceiversModelAttrTypes}, receiversList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.Receivers = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.Receivers = types.ListNull(types.ObjectType{AttrTypes: AlertPolicyReceiversModelAttrTypes})
+	}
+	if listData, ok := apiResource.Spec["routes"].([]interface{}); ok && len(listData) > 0 {
+		var routesList []AlertPolicyRoutesModel
+		var existingRoutesItems []AlertPolicyRoutesModel
+		if !data.Routes.IsNull() && !data.Routes.IsUnknown() {
+			data.Routes.ElementsAs(ctx, &existingRoutesItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				routesList = append(routesList, AlertPolicyRoutesModel{
+					Alertname: func() types.String {
+						if v, ok := itemMap["alertname"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					AlertnameRegex: func() types.String {
+						if v, ok := itemMap["alertname_regex"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Any: func() *AlertPolicyEmptyModel {
+						if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].Any != nil {
+							return &AlertPolicyEmptyModel{}
+						}
+						return nil
+					}(),
+					Custom: func() *AlertPolicyRoutesCustomModel {
+						if _, ok := itemMap["custom"].(map[string]interface{}); ok {
+							return &AlertPolicyRoutesCustomModel{
+								Alertlabel: func() *AlertPolicyEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].Custom != nil && existingRoutesItems[listIdx].Custom.Alertlabel != nil {
+										return &AlertPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+							}
+						}
+						return nil
+					}(),
+					DontSend: func() *AlertPolicyEmptyModel {
+						if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].DontSend != nil {
+							return &AlertPolicyEmptyModel{}
+						}
+						return nil
+					}(),
+					Group: func() *AlertPolicyRoutesGroupModel {
+						if nestedMap, ok := itemMap["group"].(map[string]interface{}); ok {
+							return &AlertPolicyRoutesGroupModel{
+								Groups: func() types.List {
+									if v, ok := nestedMap["groups"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+							}
+						}
+						return nil
+					}(),
+					NotificationParameters: func() *AlertPolicyRoutesNotificationParametersModel {
+						if nestedMap, ok := itemMap["notification_parameters"].(map[string]interface{}); ok {
+							return &AlertPolicyRoutesNotificationParametersModel{
+								Default: func() *AlertPolicyEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].NotificationParameters != nil && existingRoutesItems[listIdx].NotificationParameters.Default != nil {
+										return &AlertPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								GroupInterval: func() types.String {
+									if v, ok := nestedMap["group_interval"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								GroupWait: func() types.String {
+									if v, ok := nestedMap["group_wait"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Individual: func() *AlertPolicyEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].NotificationParameters != nil && existingRoutesItems[listIdx].NotificationParameters.Individual != nil {
+										return &AlertPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								RepeatInterval: func() types.String {
+									if v, ok := nestedMap["repeat_interval"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								VesIoGroup: func() *AlertPolicyEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].NotificationParameters != nil && existingRoutesItems[listIdx].NotificationParameters.VesIoGroup != nil {
+										return &AlertPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+							}
+						}
+						return nil
+					}(),
+					Send: func() *AlertPolicyEmptyModel {
+						if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].Send != nil {
+							return &AlertPolicyEmptyModel{}
+						}
+						return nil
+					}(),
+					Severity: func() *AlertPolicyRoutesSeverityModel {
+						if nestedMap, ok := itemMap["severity"].(map[string]interface{}); ok {
+							return &AlertPolicyRoutesSeverityModel{
+								Severities: func() types.List {
+									if v, ok := nestedMap["severities"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+							}
+						}
+						return nil
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AlertPolicyRoutesModelAttrTypes}, routesList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.Routes = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.Routes = types.ListNull(types.ObjectType{AttrTypes: AlertPolicyRoutesModelAttrTypes})
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
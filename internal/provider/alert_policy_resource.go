@@ -6,9 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +20,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &AlertPolicyResource{}
-	_ resource.ResourceWithConfigure      = &AlertPolicyResource{}
-	_ resource.ResourceWithImportState    = &AlertPolicyResource{}
-	_ resource.ResourceWithModifyPlan     = &AlertPolicyResource{}
-	_ resource.ResourceWithValidateConfig = &AlertPolicyResource{}
+	_ resource.Resource                     = &AlertPolicyResource{}
+	_ resource.ResourceWithConfigure        = &AlertPolicyResource{}
+	_ resource.ResourceWithImportState      = &AlertPolicyResource{}
+	_ resource.ResourceWithIdentity         = &AlertPolicyResource{}
+	_ resource.ResourceWithMoveState        = &AlertPolicyResource{}
+	_ resource.ResourceWithModifyPlan       = &AlertPolicyResource{}
+	_ resource.ResourceWithValidateConfig   = &AlertPolicyResource{}
+	_ resource.ResourceWithConfigValidators = &AlertPolicyResource{}
+	_ listableResource                      = &AlertPolicyResource{}
 )
 
 func NewAlertPolicyResource() resource.Resource {
@@ -234,6 +239,8 @@ type AlertPolicyResourceModel struct {
 	Labels                 types.Map                               `tfsdk:"labels"`
 	ID                     types.String                            `tfsdk:"id"`
 	Timeouts               timeouts.Value                          `tfsdk:"timeouts"`
+	SystemMetadata         types.Object                            `tfsdk:"system_metadata"`
+	LabelsAll              types.Map                               `tfsdk:"labels_all"`
 	NotificationParameters *AlertPolicyNotificationParametersModel `tfsdk:"notification_parameters"`
 	Receivers              types.List                              `tfsdk:"receivers"`
 	Routes                 types.List                              `tfsdk:"routes"`
@@ -258,9 +265,11 @@ func (r *AlertPolicyResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Alert Policy will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Alert Policy will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -292,6 +301,8 @@ func (r *AlertPolicyResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -324,6 +335,10 @@ func (r *AlertPolicyResource) Schema(ctx context.Context, req resource.SchemaReq
 								MarkdownDescription: "Name of labels to group/aggregate the alerts.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(5),
+									listvalidator.UniqueValues(),
+								},
 							},
 						},
 					},
@@ -340,6 +355,10 @@ func (r *AlertPolicyResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"receivers": schema.ListNestedBlock{
 				MarkdownDescription: "List of Alert Receivers where the alerts will be sent .",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(4),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"kind": schema.StringAttribute{
@@ -383,6 +402,10 @@ func (r *AlertPolicyResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"routes": schema.ListNestedBlock{
 				MarkdownDescription: "Set of routes to match the incoming alert. The routes are evaluated in the specified order and terminates on the first match.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(16),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"alertname": schema.StringAttribute{
@@ -483,6 +506,10 @@ func (r *AlertPolicyResource) Schema(ctx context.Context, req resource.SchemaReq
 											MarkdownDescription: "Name of labels to group/aggregate the alerts.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(5),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 								},
@@ -517,6 +544,11 @@ func (r *AlertPolicyResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *AlertPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *AlertPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -541,6 +573,20 @@ func (r *AlertPolicyResource) ValidateConfig(ctx context.Context, req resource.V
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *AlertPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("notification_parameters"), "group_by", "custom", "default", "individual", "ves_io_group"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex(), "action", "dont_send", "send"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex(), "matcher", "alertname", "alertname_regex", "any", "custom", "group", "severity"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("custom").AtName("alertname"), "matcher_type", "exact_match", "regex_match"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("custom").AtName("group"), "matcher_type", "exact_match", "regex_match"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("custom").AtName("severity"), "matcher_type", "exact_match", "regex_match"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("notification_parameters"), "group_by", "custom", "default", "individual", "ves_io_group"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *AlertPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -551,7 +597,10 @@ func (r *AlertPolicyResource) ModifyPlan(ctx context.Context, req resource.Modif
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "alert_policy", "", req.Config, &resp.Plan)...)
 		var plan AlertPolicyResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -608,6 +657,7 @@ func (r *AlertPolicyResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -617,6 +667,7 @@ func (r *AlertPolicyResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.NotificationParameters != nil {
@@ -796,11 +847,15 @@ func (r *AlertPolicyResource) Create(ctx context.Context, req resource.CreateReq
 
 	apiResource, err := r.client.CreateAlertPolicy(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create AlertPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "alert_policy", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1113,7 +1168,7 @@ func (r *AlertPolicyResource) Read(ctx context.Context, req resource.ReadRequest
 	apiResource, err := r.client.GetAlertPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "AlertPolicy not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1121,11 +1176,12 @@ func (r *AlertPolicyResource) Read(ctx context.Context, req resource.ReadRequest
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AlertPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "alert_policy", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1136,31 +1192,13 @@ func (r *AlertPolicyResource) Read(ctx context.Context, req resource.ReadRequest
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1168,6 +1206,7 @@ func (r *AlertPolicyResource) Read(ctx context.Context, req resource.ReadRequest
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["notification_parameters"].(map[string]interface{}); ok && (isImport || data.NotificationParameters != nil) {
 		data.NotificationParameters = &AlertPolicyNotificationParametersModel{
@@ -1492,6 +1531,7 @@ func (r *AlertPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1501,6 +1541,7 @@ func (r *AlertPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.NotificationParameters != nil {
@@ -1680,7 +1721,7 @@ func (r *AlertPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 
 	_, err := r.client.UpdateAlertPolicy(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update AlertPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "alert_policy", "update"))
 		return
 	}
 
@@ -1691,9 +1732,12 @@ func (r *AlertPolicyResource) Update(ctx context.Context, req resource.UpdateReq
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetAlertPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AlertPolicy after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "alert_policy", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -2006,7 +2050,7 @@ func (r *AlertPolicyResource) Delete(ctx context.Context, req resource.DeleteReq
 	err := r.client.DeleteAlertPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "AlertPolicy already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -2015,37 +2059,33 @@ func (r *AlertPolicyResource) Delete(ctx context.Context, req resource.DeleteReq
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "AlertPolicy delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete AlertPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "alert_policy", "delete"))
 		return
 	}
 }
 
 func (r *AlertPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *AlertPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("alert_policy", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *AlertPolicyResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListAlertPolicies(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *AlertPolicyResource) requiredNamespace() string {
+	return ""
 }
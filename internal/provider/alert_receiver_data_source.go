@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,23 @@ type AlertReceiverDataSource struct {
 	client *client.Client
 }
 
+// AlertReceiverDataSourceModel mirrors AlertReceiverResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type AlertReceiverDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object                 `tfsdk:"system_metadata"`
+	Name           types.String                 `tfsdk:"name"`
+	Namespace      types.String                 `tfsdk:"namespace"`
+	Annotations    types.Map                    `tfsdk:"annotations"`
+	Description    types.String                 `tfsdk:"description"`
+	Disable        types.Bool                   `tfsdk:"disable"`
+	Labels         types.Map                    `tfsdk:"labels"`
+	ID             types.String                 `tfsdk:"id"`
+	Email          *AlertReceiverEmailModel     `tfsdk:"email"`
+	Opsgenie       *AlertReceiverOpsgenieModel  `tfsdk:"opsgenie"`
+	Pagerduty      *AlertReceiverPagerdutyModel `tfsdk:"pagerduty"`
+	Slack          *AlertReceiverSlackModel     `tfsdk:"slack"`
+	Sms            *AlertReceiverSmsModel       `tfsdk:"sms"`
+	Webhook        *AlertReceiverWebhookModel   `tfsdk:"webhook"`
 }
 
 func (d *AlertReceiverDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +50,7 @@ func (d *AlertReceiverDataSource) Metadata(ctx context.Context, req datasource.M
 }
 
 func (d *AlertReceiverDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages new Alert Receiver object. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the AlertReceiver.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the AlertReceiver exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the AlertReceiver.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewAlertReceiverResource())
 }
 
 func (d *AlertReceiverDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +72,25 @@ func (d *AlertReceiverDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	resource, err := d.client.GetAlertReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetAlertReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AlertReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "alert_receiver", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +104,8 @@ func (d *AlertReceiverDataSource) Read(ctx context.Context, req datasource.ReadR
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +114,100 @@ func (d *AlertReceiverDataSource) Read(ctx context.Context, req datasource.ReadR
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["email"].(map[string]interface{}); ok && (isImport || data.Email != nil) {
+		data.Email = &AlertReceiverEmailModel{
+			Email: func() types.String {
+				if v, ok := blockData["email"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["opsgenie"].(map[string]interface{}); ok && (isImport || data.Opsgenie != nil) {
+		data.Opsgenie = &AlertReceiverOpsgenieModel{
+			APIKey: func() *AlertReceiverOpsgenieAPIKeyModel {
+				if !isImport && data.Opsgenie != nil && data.Opsgenie.APIKey != nil {
+					// Normal Read: preserve existing state value
+					return data.Opsgenie.APIKey
+				}
+				// Import case: read from API
+				if _, ok := blockData["api_key"].(map[string]interface{}); ok {
+					return &AlertReceiverOpsgenieAPIKeyModel{}
+				}
+				return nil
+			}(),
+			URL: func() types.String {
+				if v, ok := blockData["url"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["pagerduty"].(map[string]interface{}); ok && (isImport || data.Pagerduty != nil) {
+		data.Pagerduty = &AlertReceiverPagerdutyModel{
+			RoutingKey: func() *AlertReceiverPagerdutyRoutingKeyModel {
+				if !isImport && data.Pagerduty != nil && data.Pagerduty.RoutingKey != nil {
+					// Normal Read: preserve existing state value
+					return data.Pagerduty.RoutingKey
+				}
+				// Import case: read from API
+				if _, ok := blockData["routing_key"].(map[string]interface{}); ok {
+					return &AlertReceiverPagerdutyRoutingKeyModel{}
+				}
+				return nil
+			}(),
+			URL: func() types.String {
+				if v, ok := blockData["url"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["slack"].(map[string]interface{}); ok && (isImport || data.Slack != nil) {
+		data.Slack = &AlertReceiverSlackModel{
+			Channel: func() types.String {
+				if v, ok := blockData["channel"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			URL: func() *AlertReceiverSlackURLModel {
+				if !isImport && data.Slack != nil && data.Slack.URL != nil {
+					// Normal Read: preserve existing state value
+					return data.Slack.URL
+				}
+				// Import case: read from API
+				if _, ok := blockData["url"].(map[string]interface{}); ok {
+					return &AlertReceiverSlackURLModel{}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["sms"].(map[string]interface{}); ok && (isImport || data.Sms != nil) {
+		data.Sms = &AlertReceiverSmsModel{
+			ContactNumber: func() types.String {
+				if v, ok := blockData["contact_number"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["webhook"].(map[string]interface{}); ok && isImport && data.Webhook == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Webhook = &AlertReceiverWebhookModel{}
+	}
+	// Normal Read: preserve existing state value
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewAlertReceiverResource(), &data)...)
 }
@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &AlertReceiverResource{}
-	_ resource.ResourceWithConfigure      = &AlertReceiverResource{}
-	_ resource.ResourceWithImportState    = &AlertReceiverResource{}
-	_ resource.ResourceWithModifyPlan     = &AlertReceiverResource{}
-	_ resource.ResourceWithValidateConfig = &AlertReceiverResource{}
+	_ resource.Resource                     = &AlertReceiverResource{}
+	_ resource.ResourceWithConfigure        = &AlertReceiverResource{}
+	_ resource.ResourceWithImportState      = &AlertReceiverResource{}
+	_ resource.ResourceWithIdentity         = &AlertReceiverResource{}
+	_ resource.ResourceWithMoveState        = &AlertReceiverResource{}
+	_ resource.ResourceWithModifyPlan       = &AlertReceiverResource{}
+	_ resource.ResourceWithValidateConfig   = &AlertReceiverResource{}
+	_ resource.ResourceWithConfigValidators = &AlertReceiverResource{}
+	_ listableResource                      = &AlertReceiverResource{}
 )
 
 func NewAlertReceiverResource() resource.Resource {
@@ -95,14 +101,16 @@ var AlertReceiverOpsgenieAPIKeyBlindfoldSecretInfoModelAttrTypes = map[string]at
 
 // AlertReceiverOpsgenieAPIKeyClearSecretInfoModel represents clear_secret_info block
 type AlertReceiverOpsgenieAPIKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // AlertReceiverOpsgenieAPIKeyClearSecretInfoModelAttrTypes defines the attribute types for AlertReceiverOpsgenieAPIKeyClearSecretInfoModel
 var AlertReceiverOpsgenieAPIKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // AlertReceiverPagerdutyModel represents pagerduty block
@@ -145,14 +153,16 @@ var AlertReceiverPagerdutyRoutingKeyBlindfoldSecretInfoModelAttrTypes = map[stri
 
 // AlertReceiverPagerdutyRoutingKeyClearSecretInfoModel represents clear_secret_info block
 type AlertReceiverPagerdutyRoutingKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // AlertReceiverPagerdutyRoutingKeyClearSecretInfoModelAttrTypes defines the attribute types for AlertReceiverPagerdutyRoutingKeyClearSecretInfoModel
 var AlertReceiverPagerdutyRoutingKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // AlertReceiverSlackModel represents slack block
@@ -195,14 +205,16 @@ var AlertReceiverSlackURLBlindfoldSecretInfoModelAttrTypes = map[string]attr.Typ
 
 // AlertReceiverSlackURLClearSecretInfoModel represents clear_secret_info block
 type AlertReceiverSlackURLClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // AlertReceiverSlackURLClearSecretInfoModelAttrTypes defines the attribute types for AlertReceiverSlackURLClearSecretInfoModel
 var AlertReceiverSlackURLClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // AlertReceiverSmsModel represents sms block
@@ -289,14 +301,16 @@ var AlertReceiverWebhookHTTPConfigAuthTokenTokenBlindfoldSecretInfoModelAttrType
 
 // AlertReceiverWebhookHTTPConfigAuthTokenTokenClearSecretInfoModel represents clear_secret_info block
 type AlertReceiverWebhookHTTPConfigAuthTokenTokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // AlertReceiverWebhookHTTPConfigAuthTokenTokenClearSecretInfoModelAttrTypes defines the attribute types for AlertReceiverWebhookHTTPConfigAuthTokenTokenClearSecretInfoModel
 var AlertReceiverWebhookHTTPConfigAuthTokenTokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // AlertReceiverWebhookHTTPConfigBasicAuthModel represents basic_auth block
@@ -339,14 +353,16 @@ var AlertReceiverWebhookHTTPConfigBasicAuthPasswordBlindfoldSecretInfoModelAttrT
 
 // AlertReceiverWebhookHTTPConfigBasicAuthPasswordClearSecretInfoModel represents clear_secret_info block
 type AlertReceiverWebhookHTTPConfigBasicAuthPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // AlertReceiverWebhookHTTPConfigBasicAuthPasswordClearSecretInfoModelAttrTypes defines the attribute types for AlertReceiverWebhookHTTPConfigBasicAuthPasswordClearSecretInfoModel
 var AlertReceiverWebhookHTTPConfigBasicAuthPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // AlertReceiverWebhookHTTPConfigClientCertObjModel represents client_cert_obj block
@@ -463,31 +479,35 @@ var AlertReceiverWebhookURLBlindfoldSecretInfoModelAttrTypes = map[string]attr.T
 
 // AlertReceiverWebhookURLClearSecretInfoModel represents clear_secret_info block
 type AlertReceiverWebhookURLClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // AlertReceiverWebhookURLClearSecretInfoModelAttrTypes defines the attribute types for AlertReceiverWebhookURLClearSecretInfoModel
 var AlertReceiverWebhookURLClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 type AlertReceiverResourceModel struct {
-	Name        types.String                 `tfsdk:"name"`
-	Namespace   types.String                 `tfsdk:"namespace"`
-	Annotations types.Map                    `tfsdk:"annotations"`
-	Description types.String                 `tfsdk:"description"`
-	Disable     types.Bool                   `tfsdk:"disable"`
-	Labels      types.Map                    `tfsdk:"labels"`
-	ID          types.String                 `tfsdk:"id"`
-	Timeouts    timeouts.Value               `tfsdk:"timeouts"`
-	Email       *AlertReceiverEmailModel     `tfsdk:"email"`
-	Opsgenie    *AlertReceiverOpsgenieModel  `tfsdk:"opsgenie"`
-	Pagerduty   *AlertReceiverPagerdutyModel `tfsdk:"pagerduty"`
-	Slack       *AlertReceiverSlackModel     `tfsdk:"slack"`
-	Sms         *AlertReceiverSmsModel       `tfsdk:"sms"`
-	Webhook     *AlertReceiverWebhookModel   `tfsdk:"webhook"`
+	Name           types.String                 `tfsdk:"name"`
+	Namespace      types.String                 `tfsdk:"namespace"`
+	Annotations    types.Map                    `tfsdk:"annotations"`
+	Description    types.String                 `tfsdk:"description"`
+	Disable        types.Bool                   `tfsdk:"disable"`
+	Labels         types.Map                    `tfsdk:"labels"`
+	ID             types.String                 `tfsdk:"id"`
+	Timeouts       timeouts.Value               `tfsdk:"timeouts"`
+	SystemMetadata types.Object                 `tfsdk:"system_metadata"`
+	LabelsAll      types.Map                    `tfsdk:"labels_all"`
+	Email          *AlertReceiverEmailModel     `tfsdk:"email"`
+	Opsgenie       *AlertReceiverOpsgenieModel  `tfsdk:"opsgenie"`
+	Pagerduty      *AlertReceiverPagerdutyModel `tfsdk:"pagerduty"`
+	Slack          *AlertReceiverSlackModel     `tfsdk:"slack"`
+	Sms            *AlertReceiverSmsModel       `tfsdk:"sms"`
+	Webhook        *AlertReceiverWebhookModel   `tfsdk:"webhook"`
 }
 
 func (r *AlertReceiverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -509,9 +529,11 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Alert Receiver will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Alert Receiver will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -543,6 +565,8 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -557,6 +581,9 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 					"email": schema.StringAttribute{
 						MarkdownDescription: "Email. Email ID of the user.",
 						Optional:            true,
+						Validators: []validator.String{
+							validators.EmailValidator(),
+						},
 					},
 				},
 			},
@@ -598,7 +625,16 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -645,7 +681,16 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -660,6 +705,9 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 					"channel": schema.StringAttribute{
 						MarkdownDescription: "Channel or user to send notifications to .",
 						Optional:            true,
+						Validators: []validator.String{
+							validators.PatternValidator("^[a-z0-9-_]{1,80}$"),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -692,7 +740,16 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -760,7 +817,16 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -775,6 +841,9 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 									"user_name": schema.StringAttribute{
 										MarkdownDescription: "HTTP Basic Auth User Name .",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(64),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -807,7 +876,16 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -822,6 +900,9 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 								Blocks: map[string]schema.Block{
 									"use_tls_obj": schema.ListNestedBlock{
 										MarkdownDescription: "Certificate Object. Reference to client certificate object.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"kind": schema.StringAttribute{
@@ -885,6 +966,10 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 									"sni": schema.StringAttribute{
 										MarkdownDescription: "SNI value to be used.",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.HostnameValidator(),
+											stringvalidator.UTF8LengthAtMost(256),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -901,6 +986,9 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 												Blocks: map[string]schema.Block{
 													"trusted_ca": schema.ListNestedBlock{
 														MarkdownDescription: "Certificate Object. Reference to client certificate object.",
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+														},
 														NestedObject: schema.NestedBlockObject{
 															Attributes: map[string]schema.Attribute{
 																"kind": schema.StringAttribute{
@@ -982,7 +1070,16 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -995,6 +1092,11 @@ func (r *AlertReceiverResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *AlertReceiverResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *AlertReceiverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -1019,6 +1121,24 @@ func (r *AlertReceiverResource) ValidateConfig(ctx context.Context, req resource
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *AlertReceiverResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "receiver", "email", "opsgenie", "pagerduty", "slack", "sms", "webhook"),
+		validators.OneOfGroup(path.MatchRoot("opsgenie").AtName("api_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("pagerduty").AtName("routing_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("slack").AtName("url"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("webhook").AtName("http_config"), "auth_choice", "auth_token", "basic_auth", "client_cert_obj", "no_authorization"),
+		validators.OneOfGroup(path.MatchRoot("webhook").AtName("http_config"), "tls_choice", "no_tls", "use_tls"),
+		validators.OneOfGroup(path.MatchRoot("webhook").AtName("http_config").AtName("auth_token").AtName("token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("webhook").AtName("http_config").AtName("basic_auth").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("webhook").AtName("http_config").AtName("use_tls"), "server_validation_choice", "use_server_verification", "volterra_trusted_ca"),
+		validators.OneOfGroup(path.MatchRoot("webhook").AtName("http_config").AtName("use_tls"), "sni_choice", "disable_sni", "sni"),
+		validators.OneOfGroup(path.MatchRoot("webhook").AtName("url"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *AlertReceiverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -1029,7 +1149,10 @@ func (r *AlertReceiverResource) ModifyPlan(ctx context.Context, req resource.Mod
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "alert_receiver", "", req.Config, &resp.Plan)...)
 		var plan AlertReceiverResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -1047,7 +1170,8 @@ func (r *AlertReceiverResource) ModifyPlan(ctx context.Context, req resource.Mod
 
 func (r *AlertReceiverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data AlertReceiverResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1086,6 +1210,7 @@ func (r *AlertReceiverResource) Create(ctx context.Context, req resource.CreateR
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1095,6 +1220,7 @@ func (r *AlertReceiverResource) Create(ctx context.Context, req resource.CreateR
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.Email != nil {
@@ -1108,6 +1234,29 @@ func (r *AlertReceiverResource) Create(ctx context.Context, req resource.CreateR
 		opsgenieMap := make(map[string]interface{})
 		if data.Opsgenie.APIKey != nil {
 			api_keyNestedMap := make(map[string]interface{})
+			if data.Opsgenie.APIKey.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Opsgenie.APIKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Opsgenie.APIKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.Opsgenie.APIKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.Opsgenie.APIKey.BlindfoldSecretInfo.Location.IsNull() && !data.Opsgenie.APIKey.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.Opsgenie.APIKey.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.Opsgenie.APIKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Opsgenie.APIKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.Opsgenie.APIKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				api_keyNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.Opsgenie.APIKey.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Opsgenie.APIKey.ClearSecretInfo.Provider.IsNull() && !data.Opsgenie.APIKey.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.Opsgenie.APIKey.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.Opsgenie.APIKey.ClearSecretInfo.URL.IsNull() && !data.Opsgenie.APIKey.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.Opsgenie.APIKey.ClearSecretInfo.URL.ValueString()
+				}
+				api_keyNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			opsgenieMap["api_key"] = api_keyNestedMap
 		}
 		if !data.Opsgenie.URL.IsNull() && !data.Opsgenie.URL.IsUnknown() {
@@ -1119,6 +1268,29 @@ func (r *AlertReceiverResource) Create(ctx context.Context, req resource.CreateR
 		pagerdutyMap := make(map[string]interface{})
 		if data.Pagerduty.RoutingKey != nil {
 			routing_keyNestedMap := make(map[string]interface{})
+			if data.Pagerduty.RoutingKey.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Pagerduty.RoutingKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Pagerduty.RoutingKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.Pagerduty.RoutingKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.Pagerduty.RoutingKey.BlindfoldSecretInfo.Location.IsNull() && !data.Pagerduty.RoutingKey.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.Pagerduty.RoutingKey.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.Pagerduty.RoutingKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Pagerduty.RoutingKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.Pagerduty.RoutingKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				routing_keyNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.Pagerduty.RoutingKey.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Pagerduty.RoutingKey.ClearSecretInfo.Provider.IsNull() && !data.Pagerduty.RoutingKey.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.Pagerduty.RoutingKey.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.Pagerduty.RoutingKey.ClearSecretInfo.URL.IsNull() && !data.Pagerduty.RoutingKey.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.Pagerduty.RoutingKey.ClearSecretInfo.URL.ValueString()
+				}
+				routing_keyNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			pagerdutyMap["routing_key"] = routing_keyNestedMap
 		}
 		if !data.Pagerduty.URL.IsNull() && !data.Pagerduty.URL.IsUnknown() {
@@ -1133,6 +1305,29 @@ func (r *AlertReceiverResource) Create(ctx context.Context, req resource.CreateR
 		}
 		if data.Slack.URL != nil {
 			urlNestedMap := make(map[string]interface{})
+			if data.Slack.URL.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Slack.URL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Slack.URL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.Slack.URL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.Slack.URL.BlindfoldSecretInfo.Location.IsNull() && !data.Slack.URL.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.Slack.URL.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.Slack.URL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Slack.URL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.Slack.URL.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				urlNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.Slack.URL.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Slack.URL.ClearSecretInfo.Provider.IsNull() && !data.Slack.URL.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.Slack.URL.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.Slack.URL.ClearSecretInfo.URL.IsNull() && !data.Slack.URL.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.Slack.URL.ClearSecretInfo.URL.ValueString()
+				}
+				urlNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			slackMap["url"] = urlNestedMap
 		}
 		createReq.Spec["slack"] = slackMap
@@ -1148,16 +1343,141 @@ func (r *AlertReceiverResource) Create(ctx context.Context, req resource.CreateR
 		webhookMap := make(map[string]interface{})
 		if data.Webhook.HTTPConfig != nil {
 			http_configNestedMap := make(map[string]interface{})
+			if data.Webhook.HTTPConfig.AuthToken != nil {
+				auth_tokenLevel3Map := make(map[string]interface{})
+				if data.Webhook.HTTPConfig.AuthToken.Token != nil {
+					tokenLevel4Map := make(map[string]interface{})
+					if data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.Location.IsNull() && !data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						tokenLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo.Provider.IsNull() && !data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo.URL.IsNull() && !data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo.URL.ValueString()
+						}
+						tokenLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					auth_tokenLevel3Map["token"] = tokenLevel4Map
+				}
+				http_configNestedMap["auth_token"] = auth_tokenLevel3Map
+			}
+			if data.Webhook.HTTPConfig.BasicAuth != nil {
+				basic_authLevel3Map := make(map[string]interface{})
+				if data.Webhook.HTTPConfig.BasicAuth.Password != nil {
+					passwordLevel4Map := make(map[string]interface{})
+					if data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.Location.IsNull() && !data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						passwordLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo.Provider.IsNull() && !data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo.URL.IsNull() && !data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo.URL.ValueString()
+						}
+						passwordLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					basic_authLevel3Map["password"] = passwordLevel4Map
+				}
+				if !data.Webhook.HTTPConfig.BasicAuth.UserName.IsNull() && !data.Webhook.HTTPConfig.BasicAuth.UserName.IsUnknown() {
+					basic_authLevel3Map["user_name"] = data.Webhook.HTTPConfig.BasicAuth.UserName.ValueString()
+				}
+				http_configNestedMap["basic_auth"] = basic_authLevel3Map
+			}
+			if data.Webhook.HTTPConfig.ClientCertObj != nil {
+				client_cert_objLevel3Map := make(map[string]interface{})
+				http_configNestedMap["client_cert_obj"] = client_cert_objLevel3Map
+			}
 			if !data.Webhook.HTTPConfig.EnableHttp2.IsNull() && !data.Webhook.HTTPConfig.EnableHttp2.IsUnknown() {
 				http_configNestedMap["enable_http2"] = data.Webhook.HTTPConfig.EnableHttp2.ValueBool()
 			}
 			if !data.Webhook.HTTPConfig.FollowRedirects.IsNull() && !data.Webhook.HTTPConfig.FollowRedirects.IsUnknown() {
 				http_configNestedMap["follow_redirects"] = data.Webhook.HTTPConfig.FollowRedirects.ValueBool()
 			}
+			if data.Webhook.HTTPConfig.NoAuthorization != nil {
+				http_configNestedMap["no_authorization"] = map[string]interface{}{}
+			}
+			if data.Webhook.HTTPConfig.NoTLS != nil {
+				http_configNestedMap["no_tls"] = map[string]interface{}{}
+			}
+			if data.Webhook.HTTPConfig.UseTLS != nil {
+				use_tlsLevel3Map := make(map[string]interface{})
+				if data.Webhook.HTTPConfig.UseTLS.DisableSni != nil {
+					use_tlsLevel3Map["disable_sni"] = map[string]interface{}{}
+				}
+				if !data.Webhook.HTTPConfig.UseTLS.MaxVersion.IsNull() && !data.Webhook.HTTPConfig.UseTLS.MaxVersion.IsUnknown() {
+					use_tlsLevel3Map["max_version"] = data.Webhook.HTTPConfig.UseTLS.MaxVersion.ValueString()
+				}
+				if !data.Webhook.HTTPConfig.UseTLS.MinVersion.IsNull() && !data.Webhook.HTTPConfig.UseTLS.MinVersion.IsUnknown() {
+					use_tlsLevel3Map["min_version"] = data.Webhook.HTTPConfig.UseTLS.MinVersion.ValueString()
+				}
+				if !data.Webhook.HTTPConfig.UseTLS.Sni.IsNull() && !data.Webhook.HTTPConfig.UseTLS.Sni.IsUnknown() {
+					use_tlsLevel3Map["sni"] = data.Webhook.HTTPConfig.UseTLS.Sni.ValueString()
+				}
+				if data.Webhook.HTTPConfig.UseTLS.UseServerVerification != nil {
+					use_server_verificationLevel4Map := make(map[string]interface{})
+					if data.Webhook.HTTPConfig.UseTLS.UseServerVerification.CACertObj != nil {
+						ca_cert_objLevel5Map := make(map[string]interface{})
+						use_server_verificationLevel4Map["ca_cert_obj"] = ca_cert_objLevel5Map
+					}
+					use_tlsLevel3Map["use_server_verification"] = use_server_verificationLevel4Map
+				}
+				if data.Webhook.HTTPConfig.UseTLS.VolterraTrustedCA != nil {
+					use_tlsLevel3Map["volterra_trusted_ca"] = map[string]interface{}{}
+				}
+				http_configNestedMap["use_tls"] = use_tlsLevel3Map
+			}
 			webhookMap["http_config"] = http_configNestedMap
 		}
 		if data.Webhook.URL != nil {
 			urlNestedMap := make(map[string]interface{})
+			if data.Webhook.URL.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Webhook.URL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Webhook.URL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.Webhook.URL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.Webhook.URL.BlindfoldSecretInfo.Location.IsNull() && !data.Webhook.URL.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.Webhook.URL.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.Webhook.URL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Webhook.URL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.Webhook.URL.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				urlNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.Webhook.URL.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Webhook.URL.ClearSecretInfo.Provider.IsNull() && !data.Webhook.URL.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.Webhook.URL.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.Webhook.URL.ClearSecretInfo.URL.IsNull() && !data.Webhook.URL.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.Webhook.URL.ClearSecretInfo.URL.ValueString()
+				}
+				urlNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			webhookMap["url"] = urlNestedMap
 		}
 		createReq.Spec["webhook"] = webhookMap
@@ -1165,11 +1485,15 @@ func (r *AlertReceiverResource) Create(ctx context.Context, req resource.CreateR
 
 	apiResource, err := r.client.CreateAlertReceiver(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create AlertReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "alert_receiver", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1287,7 +1611,7 @@ func (r *AlertReceiverResource) Read(ctx context.Context, req resource.ReadReque
 	apiResource, err := r.client.GetAlertReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "AlertReceiver not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1295,11 +1619,12 @@ func (r *AlertReceiverResource) Read(ctx context.Context, req resource.ReadReque
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AlertReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "alert_receiver", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1310,31 +1635,13 @@ func (r *AlertReceiverResource) Read(ctx context.Context, req resource.ReadReque
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1342,6 +1649,7 @@ func (r *AlertReceiverResource) Read(ctx context.Context, req resource.ReadReque
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["email"].(map[string]interface{}); ok && (isImport || data.Email != nil) {
 		data.Email = &AlertReceiverEmailModel{
@@ -1437,7 +1745,8 @@ func (r *AlertReceiverResource) Read(ctx context.Context, req resource.ReadReque
 
 func (r *AlertReceiverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data AlertReceiverResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1471,6 +1780,7 @@ func (r *AlertReceiverResource) Update(ctx context.Context, req resource.UpdateR
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1480,6 +1790,7 @@ func (r *AlertReceiverResource) Update(ctx context.Context, req resource.UpdateR
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.Email != nil {
@@ -1493,6 +1804,29 @@ func (r *AlertReceiverResource) Update(ctx context.Context, req resource.UpdateR
 		opsgenieMap := make(map[string]interface{})
 		if data.Opsgenie.APIKey != nil {
 			api_keyNestedMap := make(map[string]interface{})
+			if data.Opsgenie.APIKey.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Opsgenie.APIKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Opsgenie.APIKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.Opsgenie.APIKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.Opsgenie.APIKey.BlindfoldSecretInfo.Location.IsNull() && !data.Opsgenie.APIKey.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.Opsgenie.APIKey.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.Opsgenie.APIKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Opsgenie.APIKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.Opsgenie.APIKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				api_keyNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.Opsgenie.APIKey.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Opsgenie.APIKey.ClearSecretInfo.Provider.IsNull() && !data.Opsgenie.APIKey.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.Opsgenie.APIKey.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.Opsgenie.APIKey.ClearSecretInfo.URL.IsNull() && !data.Opsgenie.APIKey.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.Opsgenie.APIKey.ClearSecretInfo.URL.ValueString()
+				}
+				api_keyNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			opsgenieMap["api_key"] = api_keyNestedMap
 		}
 		if !data.Opsgenie.URL.IsNull() && !data.Opsgenie.URL.IsUnknown() {
@@ -1504,6 +1838,29 @@ func (r *AlertReceiverResource) Update(ctx context.Context, req resource.UpdateR
 		pagerdutyMap := make(map[string]interface{})
 		if data.Pagerduty.RoutingKey != nil {
 			routing_keyNestedMap := make(map[string]interface{})
+			if data.Pagerduty.RoutingKey.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Pagerduty.RoutingKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Pagerduty.RoutingKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.Pagerduty.RoutingKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.Pagerduty.RoutingKey.BlindfoldSecretInfo.Location.IsNull() && !data.Pagerduty.RoutingKey.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.Pagerduty.RoutingKey.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.Pagerduty.RoutingKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Pagerduty.RoutingKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.Pagerduty.RoutingKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				routing_keyNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.Pagerduty.RoutingKey.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Pagerduty.RoutingKey.ClearSecretInfo.Provider.IsNull() && !data.Pagerduty.RoutingKey.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.Pagerduty.RoutingKey.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.Pagerduty.RoutingKey.ClearSecretInfo.URL.IsNull() && !data.Pagerduty.RoutingKey.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.Pagerduty.RoutingKey.ClearSecretInfo.URL.ValueString()
+				}
+				routing_keyNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			pagerdutyMap["routing_key"] = routing_keyNestedMap
 		}
 		if !data.Pagerduty.URL.IsNull() && !data.Pagerduty.URL.IsUnknown() {
@@ -1518,6 +1875,29 @@ func (r *AlertReceiverResource) Update(ctx context.Context, req resource.UpdateR
 		}
 		if data.Slack.URL != nil {
 			urlNestedMap := make(map[string]interface{})
+			if data.Slack.URL.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Slack.URL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Slack.URL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.Slack.URL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.Slack.URL.BlindfoldSecretInfo.Location.IsNull() && !data.Slack.URL.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.Slack.URL.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.Slack.URL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Slack.URL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.Slack.URL.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				urlNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.Slack.URL.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Slack.URL.ClearSecretInfo.Provider.IsNull() && !data.Slack.URL.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.Slack.URL.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.Slack.URL.ClearSecretInfo.URL.IsNull() && !data.Slack.URL.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.Slack.URL.ClearSecretInfo.URL.ValueString()
+				}
+				urlNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			slackMap["url"] = urlNestedMap
 		}
 		apiResource.Spec["slack"] = slackMap
@@ -1533,16 +1913,141 @@ func (r *AlertReceiverResource) Update(ctx context.Context, req resource.UpdateR
 		webhookMap := make(map[string]interface{})
 		if data.Webhook.HTTPConfig != nil {
 			http_configNestedMap := make(map[string]interface{})
+			if data.Webhook.HTTPConfig.AuthToken != nil {
+				auth_tokenLevel3Map := make(map[string]interface{})
+				if data.Webhook.HTTPConfig.AuthToken.Token != nil {
+					tokenLevel4Map := make(map[string]interface{})
+					if data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.Location.IsNull() && !data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.Webhook.HTTPConfig.AuthToken.Token.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						tokenLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo.Provider.IsNull() && !data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo.URL.IsNull() && !data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.Webhook.HTTPConfig.AuthToken.Token.ClearSecretInfo.URL.ValueString()
+						}
+						tokenLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					auth_tokenLevel3Map["token"] = tokenLevel4Map
+				}
+				http_configNestedMap["auth_token"] = auth_tokenLevel3Map
+			}
+			if data.Webhook.HTTPConfig.BasicAuth != nil {
+				basic_authLevel3Map := make(map[string]interface{})
+				if data.Webhook.HTTPConfig.BasicAuth.Password != nil {
+					passwordLevel4Map := make(map[string]interface{})
+					if data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.Location.IsNull() && !data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.Webhook.HTTPConfig.BasicAuth.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						passwordLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo.Provider.IsNull() && !data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo.URL.IsNull() && !data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.Webhook.HTTPConfig.BasicAuth.Password.ClearSecretInfo.URL.ValueString()
+						}
+						passwordLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					basic_authLevel3Map["password"] = passwordLevel4Map
+				}
+				if !data.Webhook.HTTPConfig.BasicAuth.UserName.IsNull() && !data.Webhook.HTTPConfig.BasicAuth.UserName.IsUnknown() {
+					basic_authLevel3Map["user_name"] = data.Webhook.HTTPConfig.BasicAuth.UserName.ValueString()
+				}
+				http_configNestedMap["basic_auth"] = basic_authLevel3Map
+			}
+			if data.Webhook.HTTPConfig.ClientCertObj != nil {
+				client_cert_objLevel3Map := make(map[string]interface{})
+				http_configNestedMap["client_cert_obj"] = client_cert_objLevel3Map
+			}
 			if !data.Webhook.HTTPConfig.EnableHttp2.IsNull() && !data.Webhook.HTTPConfig.EnableHttp2.IsUnknown() {
 				http_configNestedMap["enable_http2"] = data.Webhook.HTTPConfig.EnableHttp2.ValueBool()
 			}
 			if !data.Webhook.HTTPConfig.FollowRedirects.IsNull() && !data.Webhook.HTTPConfig.FollowRedirects.IsUnknown() {
 				http_configNestedMap["follow_redirects"] = data.Webhook.HTTPConfig.FollowRedirects.ValueBool()
 			}
+			if data.Webhook.HTTPConfig.NoAuthorization != nil {
+				http_configNestedMap["no_authorization"] = map[string]interface{}{}
+			}
+			if data.Webhook.HTTPConfig.NoTLS != nil {
+				http_configNestedMap["no_tls"] = map[string]interface{}{}
+			}
+			if data.Webhook.HTTPConfig.UseTLS != nil {
+				use_tlsLevel3Map := make(map[string]interface{})
+				if data.Webhook.HTTPConfig.UseTLS.DisableSni != nil {
+					use_tlsLevel3Map["disable_sni"] = map[string]interface{}{}
+				}
+				if !data.Webhook.HTTPConfig.UseTLS.MaxVersion.IsNull() && !data.Webhook.HTTPConfig.UseTLS.MaxVersion.IsUnknown() {
+					use_tlsLevel3Map["max_version"] = data.Webhook.HTTPConfig.UseTLS.MaxVersion.ValueString()
+				}
+				if !data.Webhook.HTTPConfig.UseTLS.MinVersion.IsNull() && !data.Webhook.HTTPConfig.UseTLS.MinVersion.IsUnknown() {
+					use_tlsLevel3Map["min_version"] = data.Webhook.HTTPConfig.UseTLS.MinVersion.ValueString()
+				}
+				if !data.Webhook.HTTPConfig.UseTLS.Sni.IsNull() && !data.Webhook.HTTPConfig.UseTLS.Sni.IsUnknown() {
+					use_tlsLevel3Map["sni"] = data.Webhook.HTTPConfig.UseTLS.Sni.ValueString()
+				}
+				if data.Webhook.HTTPConfig.UseTLS.UseServerVerification != nil {
+					use_server_verificationLevel4Map := make(map[string]interface{})
+					if data.Webhook.HTTPConfig.UseTLS.UseServerVerification.CACertObj != nil {
+						ca_cert_objLevel5Map := make(map[string]interface{})
+						use_server_verificationLevel4Map["ca_cert_obj"] = ca_cert_objLevel5Map
+					}
+					use_tlsLevel3Map["use_server_verification"] = use_server_verificationLevel4Map
+				}
+				if data.Webhook.HTTPConfig.UseTLS.VolterraTrustedCA != nil {
+					use_tlsLevel3Map["volterra_trusted_ca"] = map[string]interface{}{}
+				}
+				http_configNestedMap["use_tls"] = use_tlsLevel3Map
+			}
 			webhookMap["http_config"] = http_configNestedMap
 		}
 		if data.Webhook.URL != nil {
 			urlNestedMap := make(map[string]interface{})
+			if data.Webhook.URL.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Webhook.URL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Webhook.URL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.Webhook.URL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.Webhook.URL.BlindfoldSecretInfo.Location.IsNull() && !data.Webhook.URL.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.Webhook.URL.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.Webhook.URL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Webhook.URL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.Webhook.URL.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				urlNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.Webhook.URL.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.Webhook.URL.ClearSecretInfo.Provider.IsNull() && !data.Webhook.URL.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.Webhook.URL.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.Webhook.URL.ClearSecretInfo.URL.IsNull() && !data.Webhook.URL.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.Webhook.URL.ClearSecretInfo.URL.ValueString()
+				}
+				urlNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			webhookMap["url"] = urlNestedMap
 		}
 		apiResource.Spec["webhook"] = webhookMap
@@ -1550,7 +2055,7 @@ func (r *AlertReceiverResource) Update(ctx context.Context, req resource.UpdateR
 
 	_, err := r.client.UpdateAlertReceiver(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update AlertReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "alert_receiver", "update"))
 		return
 	}
 
@@ -1561,9 +2066,12 @@ func (r *AlertReceiverResource) Update(ctx context.Context, req resource.UpdateR
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetAlertReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AlertReceiver after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "alert_receiver", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1681,7 +2189,7 @@ func (r *AlertReceiverResource) Delete(ctx context.Context, req resource.DeleteR
 	err := r.client.DeleteAlertReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "AlertReceiver already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1690,37 +2198,33 @@ func (r *AlertReceiverResource) Delete(ctx context.Context, req resource.DeleteR
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "AlertReceiver delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete AlertReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "alert_receiver", "delete"))
 		return
 	}
 }
 
 func (r *AlertReceiverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *AlertReceiverResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("alert_receiver", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *AlertReceiverResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListAlertReceivers(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *AlertReceiverResource) requiredNamespace() string {
+	return ""
 }
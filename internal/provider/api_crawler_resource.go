@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &APICrawlerResource{}
-	_ resource.ResourceWithConfigure      = &APICrawlerResource{}
-	_ resource.ResourceWithImportState    = &APICrawlerResource{}
-	_ resource.ResourceWithModifyPlan     = &APICrawlerResource{}
-	_ resource.ResourceWithValidateConfig = &APICrawlerResource{}
+	_ resource.Resource                     = &APICrawlerResource{}
+	_ resource.ResourceWithConfigure        = &APICrawlerResource{}
+	_ resource.ResourceWithImportState      = &APICrawlerResource{}
+	_ resource.ResourceWithIdentity         = &APICrawlerResource{}
+	_ resource.ResourceWithMoveState        = &APICrawlerResource{}
+	_ resource.ResourceWithModifyPlan       = &APICrawlerResource{}
+	_ resource.ResourceWithValidateConfig   = &APICrawlerResource{}
+	_ resource.ResourceWithConfigValidators = &APICrawlerResource{}
+	_ listableResource                      = &APICrawlerResource{}
 )
 
 func NewAPICrawlerResource() resource.Resource {
@@ -97,26 +103,30 @@ var APICrawlerDomainsSimpleLoginPasswordBlindfoldSecretInfoModelAttrTypes = map[
 
 // APICrawlerDomainsSimpleLoginPasswordClearSecretInfoModel represents clear_secret_info block
 type APICrawlerDomainsSimpleLoginPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // APICrawlerDomainsSimpleLoginPasswordClearSecretInfoModelAttrTypes defines the attribute types for APICrawlerDomainsSimpleLoginPasswordClearSecretInfoModel
 var APICrawlerDomainsSimpleLoginPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 type APICrawlerResourceModel struct {
-	Name        types.String   `tfsdk:"name"`
-	Namespace   types.String   `tfsdk:"namespace"`
-	Annotations types.Map      `tfsdk:"annotations"`
-	Description types.String   `tfsdk:"description"`
-	Disable     types.Bool     `tfsdk:"disable"`
-	Labels      types.Map      `tfsdk:"labels"`
-	ID          types.String   `tfsdk:"id"`
-	Timeouts    timeouts.Value `tfsdk:"timeouts"`
-	Domains     types.List     `tfsdk:"domains"`
+	Name           types.String   `tfsdk:"name"`
+	Namespace      types.String   `tfsdk:"namespace"`
+	Annotations    types.Map      `tfsdk:"annotations"`
+	Description    types.String   `tfsdk:"description"`
+	Disable        types.Bool     `tfsdk:"disable"`
+	Labels         types.Map      `tfsdk:"labels"`
+	ID             types.String   `tfsdk:"id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	SystemMetadata types.Object   `tfsdk:"system_metadata"`
+	LabelsAll      types.Map      `tfsdk:"labels_all"`
+	Domains        types.List     `tfsdk:"domains"`
 }
 
 func (r *APICrawlerResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -138,9 +148,11 @@ func (r *APICrawlerResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the API Crawler will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the API Crawler will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -172,6 +184,8 @@ func (r *APICrawlerResource) Schema(ctx context.Context, req resource.SchemaRequ
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -182,11 +196,18 @@ func (r *APICrawlerResource) Schema(ctx context.Context, req resource.SchemaRequ
 			}),
 			"domains": schema.ListNestedBlock{
 				MarkdownDescription: "API Crawler Configuration .",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"domain": schema.StringAttribute{
 							MarkdownDescription: "Select the domain to execute API Crawling with given credentials.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -196,6 +217,9 @@ func (r *APICrawlerResource) Schema(ctx context.Context, req resource.SchemaRequ
 								"user": schema.StringAttribute{
 									MarkdownDescription: "Enter the username to assign credentials for the selected domain to crawl.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(64),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -228,7 +252,16 @@ func (r *APICrawlerResource) Schema(ctx context.Context, req resource.SchemaRequ
 													Optional:            true,
 												},
 												"url": schema.StringAttribute{
-													MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+													MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+													Optional:            true,
+													Sensitive:           true,
+													WriteOnly:           true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(131072),
+													},
+												},
+												"url_version": schema.Int64Attribute{
+													MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 													Optional:            true,
 												},
 											},
@@ -244,6 +277,11 @@ func (r *APICrawlerResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *APICrawlerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *APICrawlerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -268,6 +306,14 @@ func (r *APICrawlerResource) ValidateConfig(ctx context.Context, req resource.Va
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *APICrawlerResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("domains").AtAnyListIndex().AtName("simple_login").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *APICrawlerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -278,7 +324,10 @@ func (r *APICrawlerResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "api_crawler", "", req.Config, &resp.Plan)...)
 		var plan APICrawlerResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -296,7 +345,8 @@ func (r *APICrawlerResource) ModifyPlan(ctx context.Context, req resource.Modify
 
 func (r *APICrawlerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data APICrawlerResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -335,6 +385,7 @@ func (r *APICrawlerResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -344,6 +395,7 @@ func (r *APICrawlerResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Domains.IsNull() && !data.Domains.IsUnknown() {
@@ -361,6 +413,29 @@ func (r *APICrawlerResource) Create(ctx context.Context, req resource.CreateRequ
 					simple_loginNestedMap := make(map[string]interface{})
 					if item.SimpleLogin.Password != nil {
 						passwordDeepMap := make(map[string]interface{})
+						if item.SimpleLogin.Password.BlindfoldSecretInfo != nil {
+							blindfold_secret_infoLevel4Map := make(map[string]interface{})
+							if !item.SimpleLogin.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !item.SimpleLogin.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+								blindfold_secret_infoLevel4Map["decryption_provider"] = item.SimpleLogin.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+							}
+							if !item.SimpleLogin.Password.BlindfoldSecretInfo.Location.IsNull() && !item.SimpleLogin.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+								blindfold_secret_infoLevel4Map["location"] = item.SimpleLogin.Password.BlindfoldSecretInfo.Location.ValueString()
+							}
+							if !item.SimpleLogin.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !item.SimpleLogin.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+								blindfold_secret_infoLevel4Map["store_provider"] = item.SimpleLogin.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+							}
+							passwordDeepMap["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+						}
+						if item.SimpleLogin.Password.ClearSecretInfo != nil {
+							clear_secret_infoLevel4Map := make(map[string]interface{})
+							if !item.SimpleLogin.Password.ClearSecretInfo.Provider.IsNull() && !item.SimpleLogin.Password.ClearSecretInfo.Provider.IsUnknown() {
+								clear_secret_infoLevel4Map["provider"] = item.SimpleLogin.Password.ClearSecretInfo.Provider.ValueString()
+							}
+							if !item.SimpleLogin.Password.ClearSecretInfo.URL.IsNull() && !item.SimpleLogin.Password.ClearSecretInfo.URL.IsUnknown() {
+								clear_secret_infoLevel4Map["url"] = item.SimpleLogin.Password.ClearSecretInfo.URL.ValueString()
+							}
+							passwordDeepMap["clear_secret_info"] = clear_secret_infoLevel4Map
+						}
 						simple_loginNestedMap["password"] = passwordDeepMap
 					}
 					if !item.SimpleLogin.User.IsNull() && !item.SimpleLogin.User.IsUnknown() {
@@ -376,11 +451,15 @@ func (r *APICrawlerResource) Create(ctx context.Context, req resource.CreateRequ
 
 	apiResource, err := r.client.CreateAPICrawler(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create APICrawler: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_crawler", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -451,7 +530,7 @@ func (r *APICrawlerResource) Read(ctx context.Context, req resource.ReadRequest,
 	apiResource, err := r.client.GetAPICrawler(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "APICrawler not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -459,11 +538,12 @@ func (r *APICrawlerResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read APICrawler: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_crawler", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -474,31 +554,13 @@ func (r *APICrawlerResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -506,6 +568,7 @@ func (r *APICrawlerResource) Read(ctx context.Context, req resource.ReadRequest,
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if listData, ok := apiResource.Spec["domains"].([]interface{}); ok && len(listData) > 0 {
 		var domainsList []APICrawlerDomainsModel
@@ -554,7 +617,8 @@ func (r *APICrawlerResource) Read(ctx context.Context, req resource.ReadRequest,
 
 func (r *APICrawlerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data APICrawlerResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -588,6 +652,7 @@ func (r *APICrawlerResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -597,6 +662,7 @@ func (r *APICrawlerResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Domains.IsNull() && !data.Domains.IsUnknown() {
@@ -614,6 +680,29 @@ func (r *APICrawlerResource) Update(ctx context.Context, req resource.UpdateRequ
 					simple_loginNestedMap := make(map[string]interface{})
 					if item.SimpleLogin.Password != nil {
 						passwordDeepMap := make(map[string]interface{})
+						if item.SimpleLogin.Password.BlindfoldSecretInfo != nil {
+							blindfold_secret_infoLevel4Map := make(map[string]interface{})
+							if !item.SimpleLogin.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !item.SimpleLogin.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+								blindfold_secret_infoLevel4Map["decryption_provider"] = item.SimpleLogin.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+							}
+							if !item.SimpleLogin.Password.BlindfoldSecretInfo.Location.IsNull() && !item.SimpleLogin.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+								blindfold_secret_infoLevel4Map["location"] = item.SimpleLogin.Password.BlindfoldSecretInfo.Location.ValueString()
+							}
+							if !item.SimpleLogin.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !item.SimpleLogin.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+								blindfold_secret_infoLevel4Map["store_provider"] = item.SimpleLogin.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+							}
+							passwordDeepMap["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+						}
+						if item.SimpleLogin.Password.ClearSecretInfo != nil {
+							clear_secret_infoLevel4Map := make(map[string]interface{})
+							if !item.SimpleLogin.Password.ClearSecretInfo.Provider.IsNull() && !item.SimpleLogin.Password.ClearSecretInfo.Provider.IsUnknown() {
+								clear_secret_infoLevel4Map["provider"] = item.SimpleLogin.Password.ClearSecretInfo.Provider.ValueString()
+							}
+							if !item.SimpleLogin.Password.ClearSecretInfo.URL.IsNull() && !item.SimpleLogin.Password.ClearSecretInfo.URL.IsUnknown() {
+								clear_secret_infoLevel4Map["url"] = item.SimpleLogin.Password.ClearSecretInfo.URL.ValueString()
+							}
+							passwordDeepMap["clear_secret_info"] = clear_secret_infoLevel4Map
+						}
 						simple_loginNestedMap["password"] = passwordDeepMap
 					}
 					if !item.SimpleLogin.User.IsNull() && !item.SimpleLogin.User.IsUnknown() {
@@ -629,7 +718,7 @@ func (r *APICrawlerResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	_, err := r.client.UpdateAPICrawler(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update APICrawler: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_crawler", "update"))
 		return
 	}
 
@@ -640,9 +729,12 @@ func (r *APICrawlerResource) Update(ctx context.Context, req resource.UpdateRequ
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetAPICrawler(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read APICrawler after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "api_crawler", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -713,7 +805,7 @@ func (r *APICrawlerResource) Delete(ctx context.Context, req resource.DeleteRequ
 	err := r.client.DeleteAPICrawler(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "APICrawler already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -722,37 +814,33 @@ func (r *APICrawlerResource) Delete(ctx context.Context, req resource.DeleteRequ
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "APICrawler delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete APICrawler: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_crawler", "delete"))
 		return
 	}
 }
 
 func (r *APICrawlerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *APICrawlerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("api_crawler", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *APICrawlerResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListAPICrawlers(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *APICrawlerResource) requiredNamespace() string {
+	return ""
 }
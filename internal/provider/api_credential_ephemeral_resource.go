@@ -0,0 +1,250 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// API Credential Ephemeral Resource for F5 XC
+// Issues a short-lived API token or API certificate that only lives for the
+// duration of a Terraform run. The credential is revoked when Terraform
+// closes the ephemeral resource, so it never needs to be stored in state.
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
+	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
+)
+
+// apiCredentialPrivateKey is the private data key holding the credential to
+// revoke on close
+const apiCredentialPrivateKey = "api_credential"
+
+// ephemeralAPICredentialTypes are the credential types issued by the
+// f5xc_api_credential ephemeral resource. Kubeconfigs are issued by
+// f5xc_kubeconfig.
+var ephemeralAPICredentialTypes = []string{"API_TOKEN", "API_CERTIFICATE"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &APICredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &APICredentialEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose     = &APICredentialEphemeralResource{}
+)
+
+func NewAPICredentialEphemeralResource() ephemeral.EphemeralResource {
+	return &APICredentialEphemeralResource{}
+}
+
+type APICredentialEphemeralResource struct {
+	client *client.Client
+}
+
+type APICredentialEphemeralResourceModel struct {
+	Name                types.String `tfsdk:"name"`
+	Type                types.String `tfsdk:"type"`
+	Password            types.String `tfsdk:"password"`
+	ExpirationDays      types.Int64  `tfsdk:"expiration_days"`
+	ExpirationTimestamp types.String `tfsdk:"expiration_timestamp"`
+	Data                types.String `tfsdk:"data"`
+}
+
+// apiCredentialPrivateData identifies the credential issued by Open
+type apiCredentialPrivateData struct {
+	Name      string `json:"name"`
+	Namespace string `json:"namespace"`
+}
+
+func (r *APICredentialEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_credential"
+}
+
+func (r *APICredentialEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Issues a short-lived API token or API certificate in F5 Distributed Cloud without storing it in the plan or state.
+
+The credential is issued every time Terraform opens the ephemeral resource and revoked when Terraform closes it,
+at the end of the plan or apply. Use it to configure other providers or write-only arguments. Requires Terraform 1.10 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the API credential. Defaults to a random name, so runs do not collide.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the credential. Possible values: `API_TOKEN`, `API_CERTIFICATE`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(ephemeralAPICredentialTypes...),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password protecting the P12 bundle of an `API_CERTIFICATE` credential.",
+				Optional:            true,
+				Sensitive:           true,
+			},
+			"expiration_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days the credential is valid for if it is not revoked. Defaults to `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"expiration_timestamp": schema.StringAttribute{
+				MarkdownDescription: "Time at which the credential expires, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"data": schema.StringAttribute{
+				MarkdownDescription: "Credential material: the API token or the base64 encoded P12 bundle.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *APICredentialEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *APICredentialEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data APICredentialEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, name, diags := openAPICredential(ctx, r.client, resp, data.Name.ValueString(), data.ExpirationDays.ValueInt64(), client.APICredentialCreateSpec{
+		Type:     data.Type.ValueString(),
+		Password: data.Password.ValueString(),
+	})
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Name = types.StringValue(name)
+	data.ExpirationTimestamp = types.StringValue(created.ExpirationTimestamp)
+	data.Data = types.StringValue(created.Data)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *APICredentialEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	resp.Diagnostics.Append(closeAPICredential(ctx, r.client, req)...)
+}
+
+// openAPICredential issues an API credential in the system namespace, named
+// name or a random name if name is empty, and records it in the private data
+// of resp so that closeAPICredential can revoke it. It returns the create
+// response and the name of the credential.
+func openAPICredential(ctx context.Context, c *client.Client, resp *ephemeral.OpenResponse, name string, expirationDays int64, spec client.APICredentialCreateSpec) (*client.APICredentialCreateResponse, string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if c == nil {
+		diags.AddError("Unconfigured Provider", "The provider must be configured before issuing credentials.")
+		return nil, "", diags
+	}
+
+	if name == "" {
+		var err error
+		name, err = randomAPICredentialName()
+		if err != nil {
+			diags.AddError("Failed to Generate Credential Name", err.Error())
+			return nil, "", diags
+		}
+	}
+	if expirationDays == 0 {
+		expirationDays = 1
+	}
+
+	tflog.Debug(ctx, "Issuing ephemeral api_credential", map[string]interface{}{
+		"name": name,
+		"type": spec.Type,
+	})
+
+	created, err := c.CreateAPICredential(ctx, &client.APICredentialCreateRequest{
+		Name:           name,
+		Namespace:      "system",
+		ExpirationDays: expirationDays,
+		Spec:           spec,
+	})
+	if err != nil {
+		f5xcerrors.AddError(&diags, f5xcerrors.WrapError(err, "api_credential", "create"))
+		return nil, "", diags
+	}
+
+	private, err := json.Marshal(apiCredentialPrivateData{Name: name, Namespace: "system"})
+	if err != nil {
+		diags.AddError("Failed to Encode Private Data", err.Error())
+		return nil, "", diags
+	}
+	diags.Append(resp.Private.SetKey(ctx, apiCredentialPrivateKey, private)...)
+
+	return created, name, diags
+}
+
+// closeAPICredential revokes the credential recorded by openAPICredential.
+// A credential that is already gone is not an error.
+func closeAPICredential(ctx context.Context, c *client.Client, req ephemeral.CloseRequest) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	private, getDiags := req.Private.GetKey(ctx, apiCredentialPrivateKey)
+	diags.Append(getDiags...)
+	if diags.HasError() || private == nil || c == nil {
+		return diags
+	}
+
+	var credential apiCredentialPrivateData
+	if err := json.Unmarshal(private, &credential); err != nil {
+		diags.AddError("Failed to Decode Private Data", err.Error())
+		return diags
+	}
+
+	err := c.RevokeAPICredential(ctx, credential.Namespace, credential.Name)
+	if err != nil {
+		if f5xcerrors.IsNotFound(err) {
+			tflog.Warn(ctx, "Ephemeral api_credential already revoked", map[string]interface{}{
+				"name": credential.Name,
+			})
+			return diags
+		}
+		f5xcerrors.AddError(&diags, f5xcerrors.WrapError(err, "api_credential", "delete"))
+	}
+	return diags
+}
+
+// randomAPICredentialName returns a random API credential name. API
+// credential names are limited to 16 characters.
+func randomAPICredentialName() (string, error) {
+	suffix := make([]byte, 6)
+	if _, err := rand.Read(suffix); err != nil {
+		return "", fmt.Errorf("failed to generate credential name: %w", err)
+	}
+	return "tf-" + hex.EncodeToString(suffix), nil
+}
@@ -0,0 +1,444 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// API Credential Resource for F5 XC
+// Issues API certificates, API tokens and kubeconfigs. The API returns the
+// credential material only in the create response and has no update
+// operation, so every change replaces the credential.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
+	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
+	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
+)
+
+// apiCredentialTypes are the credential types a user can issue for themselves.
+// Service credentials are issued by f5xc_service_credential.
+var apiCredentialTypes = []string{"API_CERTIFICATE", "API_TOKEN", "KUBE_CONFIG", "SITE_GLOBAL_KUBE_CONFIG"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &APICredentialResource{}
+	_ resource.ResourceWithConfigure   = &APICredentialResource{}
+	_ resource.ResourceWithImportState = &APICredentialResource{}
+	_ resource.ResourceWithModifyPlan  = &APICredentialResource{}
+)
+
+func NewAPICredentialResource() resource.Resource {
+	return &APICredentialResource{}
+}
+
+type APICredentialResource struct {
+	client *client.Client
+}
+
+type APICredentialResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	Namespace           types.String   `tfsdk:"namespace"`
+	Type                types.String   `tfsdk:"type"`
+	Password            types.String   `tfsdk:"password"`
+	PasswordVersion     types.Int64    `tfsdk:"password_version"`
+	VirtualK8sName      types.String   `tfsdk:"virtual_k8s_name"`
+	VirtualK8sNamespace types.String   `tfsdk:"virtual_k8s_namespace"`
+	ExpirationDays      types.Int64    `tfsdk:"expiration_days"`
+	RotateBeforeDays    types.Int64    `tfsdk:"rotate_before_days"`
+	Active              types.Bool     `tfsdk:"active"`
+	ExpirationTimestamp types.String   `tfsdk:"expiration_timestamp"`
+	Data                types.String   `tfsdk:"data"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *APICredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_api_credential"
+}
+
+func (r *APICredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages an API credential (API certificate, API token or kubeconfig) in F5 Distributed Cloud.
+
+The credential material is only returned when the credential is created and is stored in the sensitive ` + "`data`" + ` attribute.
+It is not available after import. Changing any argument revokes the credential and issues a new one.
+
+Set ` + "`rotate_before_days`" + ` to replace the credential automatically once it is that close to its expiration.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the API credential.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the API credential. API credentials always belong to the `system` namespace.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("system"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("system"),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the credential. Possible values: `API_CERTIFICATE`, `API_TOKEN`, `KUBE_CONFIG`, `SITE_GLOBAL_KUBE_CONFIG`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(apiCredentialTypes...),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password protecting the P12 bundle of an `API_CERTIFICATE` credential. " + credentialPasswordDescription,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `password`. Change it to issue a new credential with a new value of the write-only `password`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"virtual_k8s_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the virtual K8s cluster of a `KUBE_CONFIG` credential.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"virtual_k8s_namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the virtual K8s cluster of a `KUBE_CONFIG` credential.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expiration_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days the credential is valid for. Defaults to the tenant's credential policy.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rotate_before_days": schema.Int64Attribute{
+				MarkdownDescription: "Replace the credential during the next plan once it expires within this many days.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the credential is active.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_timestamp": schema.StringAttribute{
+				MarkdownDescription: "Time at which the credential expires, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data": schema.StringAttribute{
+				MarkdownDescription: "Credential material returned when the credential is created: the API token, the base64 encoded P12 bundle or the kubeconfig.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *APICredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. It replaces the
+// credential once it is within rotate_before_days of its expiration.
+func (r *APICredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Resource Destruction",
+			"This will revoke the API credential in F5 Distributed Cloud.",
+		)
+		return
+	}
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state APICredentialResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planCredentialRotation(ctx, resp, state.Name.ValueString(), state.ExpirationTimestamp.ValueString(), plan.RotateBeforeDays)
+}
+
+// credentialPasswordDescription explains the write-only password of the API
+// and service credential resources
+const credentialPasswordDescription = "Write-only, requires Terraform 1.11 or later: the value is never stored in state. " +
+	"Change `password_version` to issue the credential again with a new password."
+
+// planCredentialRotation replaces a credential expiring at expiration once it
+// is within rotateBeforeDays of its expiration. It is shared by the API and
+// service credential resources, which have the same computed attributes.
+func planCredentialRotation(ctx context.Context, resp *resource.ModifyPlanResponse, name, expiration string, rotateBeforeDays types.Int64) {
+	if rotateBeforeDays.IsNull() || rotateBeforeDays.IsUnknown() {
+		return
+	}
+	if !apiCredentialNeedsRotation(expiration, rotateBeforeDays.ValueInt64(), time.Now()) {
+		return
+	}
+
+	tflog.Info(ctx, "Credential is due for rotation, planning replacement", map[string]interface{}{
+		"name":                 name,
+		"expiration_timestamp": expiration,
+	})
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("expiration_timestamp"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("data"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("active"), types.BoolUnknown())...)
+	resp.RequiresReplace = append(resp.RequiresReplace, path.Root("expiration_timestamp"))
+}
+
+// apiCredentialNeedsRotation reports whether a credential expiring at
+// expiration is within rotateBeforeDays of now. Unparseable or empty
+// timestamps never trigger a rotation.
+func apiCredentialNeedsRotation(expiration string, rotateBeforeDays int64, now time.Time) bool {
+	if expiration == "" {
+		return false
+	}
+	expiresAt, err := time.Parse(time.RFC3339, expiration)
+	if err != nil {
+		return false
+	}
+	return !now.AddDate(0, 0, int(rotateBeforeDays)).Before(expiresAt)
+}
+
+func (r *APICredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data APICredentialResourceModel
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, inttimeouts.DefaultCreate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	tflog.Debug(ctx, "Creating api_credential", map[string]interface{}{
+		"name":      data.Name.ValueString(),
+		"namespace": data.Namespace.ValueString(),
+		"type":      data.Type.ValueString(),
+	})
+
+	createReq := &client.APICredentialCreateRequest{
+		Name:           data.Name.ValueString(),
+		Namespace:      data.Namespace.ValueString(),
+		ExpirationDays: data.ExpirationDays.ValueInt64(),
+		Spec: client.APICredentialCreateSpec{
+			Type:                data.Type.ValueString(),
+			Password:            data.Password.ValueString(),
+			VirtualK8sName:      data.VirtualK8sName.ValueString(),
+			VirtualK8sNamespace: data.VirtualK8sNamespace.ValueString(),
+		},
+	}
+
+	created, err := r.client.CreateAPICredential(ctx, createReq)
+	if err != nil {
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_credential", "create"))
+		return
+	}
+
+	data.ID = types.StringValue(data.Name.ValueString())
+	data.Active = types.BoolValue(created.Active)
+	data.ExpirationTimestamp = types.StringValue(created.ExpirationTimestamp)
+	data.Data = types.StringValue(created.Data)
+
+	tflog.Trace(ctx, "created APICredential resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APICredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data APICredentialResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, inttimeouts.DefaultRead)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	apiResource, err := r.client.GetAPICredential(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// Check if the credential was revoked outside Terraform
+		if f5xcerrors.IsNotFound(err) {
+			tflog.Warn(ctx, "APICredential not found, removing from state", map[string]interface{}{
+				"name":      data.Name.ValueString(),
+				"namespace": data.Namespace.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_credential", "read"))
+		return
+	}
+
+	spec := apiResource.Spec.GCSpec
+	data.ID = types.StringValue(data.Name.ValueString())
+	data.Active = types.BoolValue(spec.Active)
+	if spec.Type != "" {
+		data.Type = types.StringValue(spec.Type)
+	}
+	if spec.ExpirationTimestamp != "" {
+		data.ExpirationTimestamp = types.StringValue(spec.ExpirationTimestamp)
+	}
+	if spec.VirtualK8sName != "" {
+		data.VirtualK8sName = types.StringValue(spec.VirtualK8sName)
+	}
+	if spec.VirtualK8sNamespace != "" {
+		data.VirtualK8sNamespace = types.StringValue(spec.VirtualK8sNamespace)
+	}
+	// The credential material cannot be read back, so data keeps its state value
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update only stores arguments that do not affect the issued credential,
+// such as rotate_before_days and timeouts. All other arguments force replacement.
+func (r *APICredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data APICredentialResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *APICredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data APICredentialResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, inttimeouts.DefaultDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	err := r.client.RevokeAPICredential(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// If the credential is already gone, consider deletion successful (idempotent delete)
+		if f5xcerrors.IsNotFound(err) {
+			tflog.Warn(ctx, "APICredential already revoked, removing from state", map[string]interface{}{
+				"name":      data.Name.ValueString(),
+				"namespace": data.Namespace.ValueString(),
+			})
+			return
+		}
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_credential", "delete"))
+		return
+	}
+}
+
+func (r *APICredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: name or namespace/name
+	namespace, name := "system", req.ID
+	if parts := strings.Split(req.ID, "/"); len(parts) == 2 {
+		namespace, name = parts[0], parts[1]
+	}
+	if namespace == "" || name == "" || strings.Contains(name, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: name or namespace/name, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+}
@@ -37,7 +37,7 @@ func TestAccAPICredentialResource_basic(t *testing.T) {
 				ResourceName:            resourceName,
 				ImportState:             true,
 				ImportStateVerify:       true,
-				ImportStateVerifyIgnore: []string{"timeouts", "password"},
+				ImportStateVerifyIgnore: []string{"timeouts", "password", "data"},
 				ImportStateIdFunc:       testAccAPICredentialImportStateIdFunc(resourceName),
 			},
 		},
@@ -0,0 +1,36 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"testing"
+	"time"
+)
+
+func TestAPICredentialNeedsRotation(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name             string
+		expiration       string
+		rotateBeforeDays int64
+		expected         bool
+	}{
+		{"far from expiry", "2026-06-01T00:00:00Z", 30, false},
+		{"inside rotation window", "2026-03-20T00:00:00Z", 30, true},
+		{"exactly at window start", "2026-03-31T12:00:00Z", 30, true},
+		{"already expired", "2026-02-01T00:00:00Z", 0, true},
+		{"zero days before expiry", "2026-03-02T00:00:00Z", 0, false},
+		{"empty timestamp", "", 30, false},
+		{"unparseable timestamp", "next week", 30, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := apiCredentialNeedsRotation(tt.expiration, tt.rotateBeforeDays, now)
+			if result != tt.expected {
+				t.Errorf("apiCredentialNeedsRotation(%q, %d) = %v, want %v", tt.expiration, tt.rotateBeforeDays, result, tt.expected)
+			}
+		})
+	}
+}
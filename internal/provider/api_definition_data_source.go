@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,23 @@ type APIDefinitionDataSource struct {
 	client *client.Client
 }
 
+// APIDefinitionDataSourceModel mirrors APIDefinitionResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type APIDefinitionDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata            types.Object             `tfsdk:"system_metadata"`
+	Name                      types.String             `tfsdk:"name"`
+	Namespace                 types.String             `tfsdk:"namespace"`
+	Annotations               types.Map                `tfsdk:"annotations"`
+	Description               types.String             `tfsdk:"description"`
+	Disable                   types.Bool               `tfsdk:"disable"`
+	Labels                    types.Map                `tfsdk:"labels"`
+	SwaggerSpecs              types.List               `tfsdk:"swagger_specs"`
+	ID                        types.String             `tfsdk:"id"`
+	APIInventoryExclusionList types.List               `tfsdk:"api_inventory_exclusion_list"`
+	APIInventoryInclusionList types.List               `tfsdk:"api_inventory_inclusion_list"`
+	MixedSchemaOrigin         *APIDefinitionEmptyModel `tfsdk:"mixed_schema_origin"`
+	NonAPIEndpoints           types.List               `tfsdk:"non_api_endpoints"`
+	StrictSchemaOrigin        *APIDefinitionEmptyModel `tfsdk:"strict_schema_origin"`
 }
 
 func (d *APIDefinitionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +50,7 @@ func (d *APIDefinitionDataSource) Metadata(ctx context.Context, req datasource.M
 }
 
 func (d *APIDefinitionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages API Definition. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the APIDefinition.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the APIDefinition exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the APIDefinition.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewAPIDefinitionResource())
 }
 
 func (d *APIDefinitionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +72,25 @@ func (d *APIDefinitionDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	resource, err := d.client.GetAPIDefinition(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetAPIDefinition(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read APIDefinition: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_definition", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +104,8 @@ func (d *APIDefinitionDataSource) Read(ctx context.Context, req datasource.ReadR
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +114,137 @@ func (d *APIDefinitionDataSource) Read(ctx context.Context, req datasource.ReadR
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if listData, ok := apiResource.Spec["api_inventory_exclusion_list"].([]interface{}); ok && len(listData) > 0 {
+		var api_inventory_exclusion_listList []APIDefinitionAPIInventoryExclusionListModel
+		var existingAPIInventoryExclusionListItems []APIDefinitionAPIInventoryExclusionListModel
+		if !data.APIInventoryExclusionList.IsNull() && !data.APIInventoryExclusionList.IsUnknown() {
+			data.APIInventoryExclusionList.ElementsAs(ctx, &existingAPIInventoryExclusionListItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				api_inventory_exclusion_listList = append(api_inventory_exclusion_listList, APIDefinitionAPIInventoryExclusionListModel{
+					Method: func() types.String {
+						if v, ok := itemMap["method"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Path: func() types.String {
+						if v, ok := itemMap["path"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: APIDefinitionAPIInventoryExclusionListModelAttrTypes}, api_inventory_exclusion_listList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.APIInventoryExclusionList = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.APIInventoryExclusionList = types.ListNull(types.ObjectType{AttrTypes: APIDefinitionAPIInventoryExclusionListModelAttrTypes})
+	}
+	if listData, ok := apiResource.Spec["api_inventory_inclusion_list"].([]interface{}); ok && len(listData) > 0 {
+		var api_inventory_inclusion_listList []APIDefinitionAPIInventoryInclusionListModel
+		var existingAPIInventoryInclusionListItems []APIDefinitionAPIInventoryInclusionListModel
+		if !data.APIInventoryInclusionList.IsNull() && !data.APIInventoryInclusionList.IsUnknown() {
+			data.APIInventoryInclusionList.ElementsAs(ctx, &existingAPIInventoryInclusionListItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				api_inventory_inclusion_listList = append(api_inventory_inclusion_listList, APIDefinitionAPIInventoryInclusionListModel{
+					Method: func() types.String {
+						if v, ok := itemMap["method"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Path: func() types.String {
+						if v, ok := itemMap["path"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: APIDefinitionAPIInventoryInclusionListModelAttrTypes}, api_inventory_inclusion_listList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.APIInventoryInclusionList = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.APIInventoryInclusionList = types.ListNull(types.ObjectType{AttrTypes: APIDefinitionAPIInventoryInclusionListModelAttrTypes})
+	}
+	if _, ok := apiResource.Spec["mixed_schema_origin"].(map[string]interface{}); ok && isImport && data.MixedSchemaOrigin == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.MixedSchemaOrigin = &APIDefinitionEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if listData, ok := apiResource.Spec["non_api_endpoints"].([]interface{}); ok && len(listData) > 0 {
+		var non_api_endpointsList []APIDefinitionNonAPIEndpointsModel
+		var existingNonAPIEndpointsItems []APIDefinitionNonAPIEndpointsModel
+		if !data.NonAPIEndpoints.IsNull() && !data.NonAPIEndpoints.IsUnknown() {
+			data.NonAPIEndpoints.ElementsAs(ctx, &existingNonAPIEndpointsItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				non_api_endpointsList = append(non_api_endpointsList, APIDefinitionNonAPIEndpointsModel{
+					Method: func() types.String {
+						if v, ok := itemMap["method"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Path: func() types.String {
+						if v, ok := itemMap["path"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: APIDefinitionNonAPIEndpointsModelAttrTypes}, non_api_endpointsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.NonAPIEndpoints = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.NonAPIEndpoints = types.ListNull(types.ObjectType{AttrTypes: APIDefinitionNonAPIEndpointsModelAttrTypes})
+	}
+	if _, ok := apiResource.Spec["strict_schema_origin"].(map[string]interface{}); ok && isImport && data.StrictSchemaOrigin == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.StrictSchemaOrigin = &APIDefinitionEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["swagger_specs"].([]interface{}); ok && len(v) > 0 {
+		var swagger_specsList []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				swagger_specsList = append(swagger_specsList, s)
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.StringType, swagger_specsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.SwaggerSpecs = listVal
+		}
+	} else {
+		data.SwaggerSpecs = types.ListNull(types.StringType)
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
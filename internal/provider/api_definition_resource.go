@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &APIDefinitionResource{}
-	_ resource.ResourceWithConfigure      = &APIDefinitionResource{}
-	_ resource.ResourceWithImportState    = &APIDefinitionResource{}
-	_ resource.ResourceWithModifyPlan     = &APIDefinitionResource{}
-	_ resource.ResourceWithValidateConfig = &APIDefinitionResource{}
+	_ resource.Resource                     = &APIDefinitionResource{}
+	_ resource.ResourceWithConfigure        = &APIDefinitionResource{}
+	_ resource.ResourceWithImportState      = &APIDefinitionResource{}
+	_ resource.ResourceWithIdentity         = &APIDefinitionResource{}
+	_ resource.ResourceWithMoveState        = &APIDefinitionResource{}
+	_ resource.ResourceWithModifyPlan       = &APIDefinitionResource{}
+	_ resource.ResourceWithValidateConfig   = &APIDefinitionResource{}
+	_ resource.ResourceWithConfigValidators = &APIDefinitionResource{}
+	_ listableResource                      = &APIDefinitionResource{}
 )
 
 func NewAPIDefinitionResource() resource.Resource {
@@ -91,6 +97,8 @@ type APIDefinitionResourceModel struct {
 	SwaggerSpecs              types.List               `tfsdk:"swagger_specs"`
 	ID                        types.String             `tfsdk:"id"`
 	Timeouts                  timeouts.Value           `tfsdk:"timeouts"`
+	SystemMetadata            types.Object             `tfsdk:"system_metadata"`
+	LabelsAll                 types.Map                `tfsdk:"labels_all"`
 	APIInventoryExclusionList types.List               `tfsdk:"api_inventory_exclusion_list"`
 	APIInventoryInclusionList types.List               `tfsdk:"api_inventory_inclusion_list"`
 	MixedSchemaOrigin         *APIDefinitionEmptyModel `tfsdk:"mixed_schema_origin"`
@@ -117,9 +125,11 @@ func (r *APIDefinitionResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the API Definition will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the API Definition will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -148,6 +158,11 @@ func (r *APIDefinitionResource) Schema(ctx context.Context, req resource.SchemaR
 				MarkdownDescription: "Define your application API by single or multiple OpenAPI files. 1. Upload your OpenAPI files via Web App & API Protection-> Files-> Swagger Files. 2. Select from the list of uploaded files. Defaults to `[]`. Server applies default when omitted.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(20),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(512), validators.PatternValidator("/api/object_store/namespaces/([a-z]([-a-z0-9]*[a-z0-9])?)/stored_objects/swagger/([a-z]([-a-z0-9]*[a-z0-9])?)/(v|V)[0-9]+(-[0-9]{2}){3}$")),
+				},
 			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier for the resource.",
@@ -156,6 +171,8 @@ func (r *APIDefinitionResource) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -166,6 +183,10 @@ func (r *APIDefinitionResource) Schema(ctx context.Context, req resource.SchemaR
 			}),
 			"api_inventory_exclusion_list": schema.ListNestedBlock{
 				MarkdownDescription: "List of API Endpoints excluded from the API Inventory.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1000),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"method": schema.StringAttribute{
@@ -175,12 +196,20 @@ func (r *APIDefinitionResource) Schema(ctx context.Context, req resource.SchemaR
 						"path": schema.StringAttribute{
 							MarkdownDescription: "Endpoint path, as specified in OpenAPI, including parameters. The path should comply with RFC 3986 and may have parameters according to OpenAPI specification .",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(1024),
+								stringvalidator.LengthAtLeast(1),
+							},
 						},
 					},
 				},
 			},
 			"api_inventory_inclusion_list": schema.ListNestedBlock{
 				MarkdownDescription: "List of API Endpoints included in the API Inventory. Typically, discovered API endpoints are added to the API Inventory using this list.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1000),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"method": schema.StringAttribute{
@@ -190,6 +219,10 @@ func (r *APIDefinitionResource) Schema(ctx context.Context, req resource.SchemaR
 						"path": schema.StringAttribute{
 							MarkdownDescription: "Endpoint path, as specified in OpenAPI, including parameters. The path should comply with RFC 3986 and may have parameters according to OpenAPI specification .",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(1024),
+								stringvalidator.LengthAtLeast(1),
+							},
 						},
 					},
 				},
@@ -199,6 +232,10 @@ func (r *APIDefinitionResource) Schema(ctx context.Context, req resource.SchemaR
 			},
 			"non_api_endpoints": schema.ListNestedBlock{
 				MarkdownDescription: "API Discovery Exclusion List. List of Non-API Endpoints.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1000),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"method": schema.StringAttribute{
@@ -208,6 +245,10 @@ func (r *APIDefinitionResource) Schema(ctx context.Context, req resource.SchemaR
 						"path": schema.StringAttribute{
 							MarkdownDescription: "Endpoint path, as specified in OpenAPI, including parameters. The path should comply with RFC 3986 and may have parameters according to OpenAPI specification .",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(1024),
+								stringvalidator.LengthAtLeast(1),
+							},
 						},
 					},
 				},
@@ -219,6 +260,11 @@ func (r *APIDefinitionResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *APIDefinitionResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *APIDefinitionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -243,6 +289,14 @@ func (r *APIDefinitionResource) ValidateConfig(ctx context.Context, req resource
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *APIDefinitionResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "schema_updates_strategy", "mixed_schema_origin", "strict_schema_origin"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *APIDefinitionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -253,7 +307,10 @@ func (r *APIDefinitionResource) ModifyPlan(ctx context.Context, req resource.Mod
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "api_definition", "", req.Config, &resp.Plan)...)
 		var plan APIDefinitionResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -310,6 +367,7 @@ func (r *APIDefinitionResource) Create(ctx context.Context, req resource.CreateR
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -319,6 +377,7 @@ func (r *APIDefinitionResource) Create(ctx context.Context, req resource.CreateR
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.APIInventoryExclusionList.IsNull() && !data.APIInventoryExclusionList.IsUnknown() {
@@ -396,11 +455,15 @@ func (r *APIDefinitionResource) Create(ctx context.Context, req resource.CreateR
 
 	apiResource, err := r.client.CreateAPIDefinition(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create APIDefinition: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_definition", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -557,7 +620,7 @@ func (r *APIDefinitionResource) Read(ctx context.Context, req resource.ReadReque
 	apiResource, err := r.client.GetAPIDefinition(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "APIDefinition not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -565,11 +628,12 @@ func (r *APIDefinitionResource) Read(ctx context.Context, req resource.ReadReque
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read APIDefinition: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_definition", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -580,31 +644,13 @@ func (r *APIDefinitionResource) Read(ctx context.Context, req resource.ReadReque
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -612,6 +658,7 @@ func (r *APIDefinitionResource) Read(ctx context.Context, req resource.ReadReque
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if listData, ok := apiResource.Spec["api_inventory_exclusion_list"].([]interface{}); ok && len(listData) > 0 {
 		var api_inventory_exclusion_listList []APIDefinitionAPIInventoryExclusionListModel
@@ -780,6 +827,7 @@ func (r *APIDefinitionResource) Update(ctx context.Context, req resource.UpdateR
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -789,6 +837,7 @@ func (r *APIDefinitionResource) Update(ctx context.Context, req resource.UpdateR
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.APIInventoryExclusionList.IsNull() && !data.APIInventoryExclusionList.IsUnknown() {
@@ -866,7 +915,7 @@ func (r *APIDefinitionResource) Update(ctx context.Context, req resource.UpdateR
 
 	_, err := r.client.UpdateAPIDefinition(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update APIDefinition: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_definition", "update"))
 		return
 	}
 
@@ -877,9 +926,12 @@ func (r *APIDefinitionResource) Update(ctx context.Context, req resource.UpdateR
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetAPIDefinition(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read APIDefinition after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "api_definition", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1036,7 +1088,7 @@ func (r *APIDefinitionResource) Delete(ctx context.Context, req resource.DeleteR
 	err := r.client.DeleteAPIDefinition(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "APIDefinition already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1045,37 +1097,33 @@ func (r *APIDefinitionResource) Delete(ctx context.Context, req resource.DeleteR
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "APIDefinition delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete APIDefinition: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_definition", "delete"))
 		return
 	}
 }
 
 func (r *APIDefinitionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *APIDefinitionResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("api_definition", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *APIDefinitionResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListAPIDefinitions(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *APIDefinitionResource) requiredNamespace() string {
+	return ""
 }
@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &APITestingResource{}
-	_ resource.ResourceWithConfigure      = &APITestingResource{}
-	_ resource.ResourceWithImportState    = &APITestingResource{}
-	_ resource.ResourceWithModifyPlan     = &APITestingResource{}
-	_ resource.ResourceWithValidateConfig = &APITestingResource{}
+	_ resource.Resource                     = &APITestingResource{}
+	_ resource.ResourceWithConfigure        = &APITestingResource{}
+	_ resource.ResourceWithImportState      = &APITestingResource{}
+	_ resource.ResourceWithIdentity         = &APITestingResource{}
+	_ resource.ResourceWithMoveState        = &APITestingResource{}
+	_ resource.ResourceWithModifyPlan       = &APITestingResource{}
+	_ resource.ResourceWithValidateConfig   = &APITestingResource{}
+	_ resource.ResourceWithConfigValidators = &APITestingResource{}
+	_ listableResource                      = &APITestingResource{}
 )
 
 func NewAPITestingResource() resource.Resource {
@@ -121,14 +127,16 @@ var APITestingDomainsCredentialsAPIKeyValueBlindfoldSecretInfoModelAttrTypes = m
 
 // APITestingDomainsCredentialsAPIKeyValueClearSecretInfoModel represents clear_secret_info block
 type APITestingDomainsCredentialsAPIKeyValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // APITestingDomainsCredentialsAPIKeyValueClearSecretInfoModelAttrTypes defines the attribute types for APITestingDomainsCredentialsAPIKeyValueClearSecretInfoModel
 var APITestingDomainsCredentialsAPIKeyValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // APITestingDomainsCredentialsBasicAuthModel represents basic_auth block
@@ -171,14 +179,16 @@ var APITestingDomainsCredentialsBasicAuthPasswordBlindfoldSecretInfoModelAttrTyp
 
 // APITestingDomainsCredentialsBasicAuthPasswordClearSecretInfoModel represents clear_secret_info block
 type APITestingDomainsCredentialsBasicAuthPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // APITestingDomainsCredentialsBasicAuthPasswordClearSecretInfoModelAttrTypes defines the attribute types for APITestingDomainsCredentialsBasicAuthPasswordClearSecretInfoModel
 var APITestingDomainsCredentialsBasicAuthPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // APITestingDomainsCredentialsBearerTokenModel represents bearer_token block
@@ -219,14 +229,16 @@ var APITestingDomainsCredentialsBearerTokenTokenBlindfoldSecretInfoModelAttrType
 
 // APITestingDomainsCredentialsBearerTokenTokenClearSecretInfoModel represents clear_secret_info block
 type APITestingDomainsCredentialsBearerTokenTokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // APITestingDomainsCredentialsBearerTokenTokenClearSecretInfoModelAttrTypes defines the attribute types for APITestingDomainsCredentialsBearerTokenTokenClearSecretInfoModel
 var APITestingDomainsCredentialsBearerTokenTokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // APITestingDomainsCredentialsLoginEndpointModel represents login_endpoint block
@@ -273,14 +285,16 @@ var APITestingDomainsCredentialsLoginEndpointJSONPayloadBlindfoldSecretInfoModel
 
 // APITestingDomainsCredentialsLoginEndpointJSONPayloadClearSecretInfoModel represents clear_secret_info block
 type APITestingDomainsCredentialsLoginEndpointJSONPayloadClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // APITestingDomainsCredentialsLoginEndpointJSONPayloadClearSecretInfoModelAttrTypes defines the attribute types for APITestingDomainsCredentialsLoginEndpointJSONPayloadClearSecretInfoModel
 var APITestingDomainsCredentialsLoginEndpointJSONPayloadClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 type APITestingResourceModel struct {
@@ -293,6 +307,8 @@ type APITestingResourceModel struct {
 	ID                types.String          `tfsdk:"id"`
 	CustomHeaderValue types.String          `tfsdk:"custom_header_value"`
 	Timeouts          timeouts.Value        `tfsdk:"timeouts"`
+	SystemMetadata    types.Object          `tfsdk:"system_metadata"`
+	LabelsAll         types.Map             `tfsdk:"labels_all"`
 	Domains           types.List            `tfsdk:"domains"`
 	EveryDay          *APITestingEmptyModel `tfsdk:"every_day"`
 	EveryMonth        *APITestingEmptyModel `tfsdk:"every_month"`
@@ -318,9 +334,11 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the API Testing will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the API Testing will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -359,7 +377,12 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(128),
+				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -370,6 +393,10 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 			}),
 			"domains": schema.ListNestedBlock{
 				MarkdownDescription: "Add and configure testing domains and credentials .",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"allow_destructive_methods": schema.BoolAttribute{
@@ -379,6 +406,9 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 						"domain": schema.StringAttribute{
 							MarkdownDescription: "Add your testing environment domain. Be aware that running tests on a production domain can impact live applications, as API testing cannot distinguish between production and testing environments.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -389,6 +419,9 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 									"credential_name": schema.StringAttribute{
 										MarkdownDescription: "Enter a unique name for the credentials used in API testing .",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(64),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -401,6 +434,9 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 											"key": schema.StringAttribute{
 												MarkdownDescription: "Key.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.UTF8LengthAtMost(128),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
@@ -433,7 +469,16 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 																Optional:            true,
 															},
 															"url": schema.StringAttribute{
-																MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																Optional:            true,
+																Sensitive:           true,
+																WriteOnly:           true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(131072),
+																},
+															},
+															"url_version": schema.Int64Attribute{
+																MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																Optional:            true,
 															},
 														},
@@ -448,6 +493,9 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 											"user": schema.StringAttribute{
 												MarkdownDescription: "User.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.UTF8LengthAtMost(64),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
@@ -480,7 +528,16 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 																Optional:            true,
 															},
 															"url": schema.StringAttribute{
-																MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																Optional:            true,
+																Sensitive:           true,
+																WriteOnly:           true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(131072),
+																},
+															},
+															"url_version": schema.Int64Attribute{
+																MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																Optional:            true,
 															},
 														},
@@ -522,7 +579,16 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 																Optional:            true,
 															},
 															"url": schema.StringAttribute{
-																MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																Optional:            true,
+																Sensitive:           true,
+																WriteOnly:           true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(131072),
+																},
+															},
+															"url_version": schema.Int64Attribute{
+																MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																Optional:            true,
 															},
 														},
@@ -541,6 +607,9 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 											"path": schema.StringAttribute{
 												MarkdownDescription: "Path.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.UTF8LengthAtMost(1024),
+												},
 											},
 											"token_response_key": schema.StringAttribute{
 												MarkdownDescription: "Token Response Key. .",
@@ -577,7 +646,16 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 																Optional:            true,
 															},
 															"url": schema.StringAttribute{
-																MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																Optional:            true,
+																Sensitive:           true,
+																WriteOnly:           true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(131072),
+																},
+															},
+															"url_version": schema.Int64Attribute{
+																MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																Optional:            true,
 															},
 														},
@@ -608,6 +686,11 @@ func (r *APITestingResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *APITestingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *APITestingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -632,6 +715,20 @@ func (r *APITestingResource) ValidateConfig(ctx context.Context, req resource.Va
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *APITestingResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "frequency_choice", "every_day", "every_month", "every_week"),
+		validators.OneOfGroup(path.MatchRoot("domains").AtAnyListIndex().AtName("credentials").AtAnyListIndex(), "credentials_choice", "api_key", "basic_auth", "bearer_token", "login_endpoint"),
+		validators.OneOfGroup(path.MatchRoot("domains").AtAnyListIndex().AtName("credentials").AtAnyListIndex(), "role_choice", "admin", "standard"),
+		validators.OneOfGroup(path.MatchRoot("domains").AtAnyListIndex().AtName("credentials").AtAnyListIndex().AtName("api_key").AtName("value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("domains").AtAnyListIndex().AtName("credentials").AtAnyListIndex().AtName("basic_auth").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("domains").AtAnyListIndex().AtName("credentials").AtAnyListIndex().AtName("bearer_token").AtName("token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("domains").AtAnyListIndex().AtName("credentials").AtAnyListIndex().AtName("login_endpoint").AtName("json_payload"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *APITestingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -642,7 +739,10 @@ func (r *APITestingResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "api_testing", "", req.Config, &resp.Plan)...)
 		var plan APITestingResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -660,7 +760,8 @@ func (r *APITestingResource) ModifyPlan(ctx context.Context, req resource.Modify
 
 func (r *APITestingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data APITestingResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -699,6 +800,7 @@ func (r *APITestingResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -708,6 +810,7 @@ func (r *APITestingResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Domains.IsNull() && !data.Domains.IsUnknown() {
@@ -758,11 +861,15 @@ func (r *APITestingResource) Create(ctx context.Context, req resource.CreateRequ
 
 	apiResource, err := r.client.CreateAPITesting(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create APITesting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_testing", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -865,7 +972,7 @@ func (r *APITestingResource) Read(ctx context.Context, req resource.ReadRequest,
 	apiResource, err := r.client.GetAPITesting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "APITesting not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -873,11 +980,12 @@ func (r *APITestingResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read APITesting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_testing", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -888,31 +996,13 @@ func (r *APITestingResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -920,6 +1010,7 @@ func (r *APITestingResource) Read(ctx context.Context, req resource.ReadRequest,
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if listData, ok := apiResource.Spec["domains"].([]interface{}); ok && len(listData) > 0 {
 		var domainsList []APITestingDomainsModel
@@ -1000,7 +1091,8 @@ func (r *APITestingResource) Read(ctx context.Context, req resource.ReadRequest,
 
 func (r *APITestingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data APITestingResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1034,6 +1126,7 @@ func (r *APITestingResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1043,6 +1136,7 @@ func (r *APITestingResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Domains.IsNull() && !data.Domains.IsUnknown() {
@@ -1093,7 +1187,7 @@ func (r *APITestingResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	_, err := r.client.UpdateAPITesting(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update APITesting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_testing", "update"))
 		return
 	}
 
@@ -1104,9 +1198,12 @@ func (r *APITestingResource) Update(ctx context.Context, req resource.UpdateRequ
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetAPITesting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read APITesting after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "api_testing", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["custom_header_value"].(string); ok && v != "" {
@@ -1216,7 +1313,7 @@ func (r *APITestingResource) Delete(ctx context.Context, req resource.DeleteRequ
 	err := r.client.DeleteAPITesting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "APITesting already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1225,37 +1322,33 @@ func (r *APITestingResource) Delete(ctx context.Context, req resource.DeleteRequ
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "APITesting delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete APITesting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "api_testing", "delete"))
 		return
 	}
 }
 
 func (r *APITestingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *APITestingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("api_testing", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *APITestingResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListAPITestings(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *APITestingResource) requiredNamespace() string {
+	return ""
 }
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &APMResource{}
-	_ resource.ResourceWithConfigure      = &APMResource{}
-	_ resource.ResourceWithImportState    = &APMResource{}
-	_ resource.ResourceWithModifyPlan     = &APMResource{}
-	_ resource.ResourceWithValidateConfig = &APMResource{}
+	_ resource.Resource                     = &APMResource{}
+	_ resource.ResourceWithConfigure        = &APMResource{}
+	_ resource.ResourceWithImportState      = &APMResource{}
+	_ resource.ResourceWithIdentity         = &APMResource{}
+	_ resource.ResourceWithMoveState        = &APMResource{}
+	_ resource.ResourceWithModifyPlan       = &APMResource{}
+	_ resource.ResourceWithValidateConfig   = &APMResource{}
+	_ resource.ResourceWithConfigValidators = &APMResource{}
+	_ listableResource                      = &APMResource{}
 )
 
 func NewAPMResource() resource.Resource {
@@ -107,14 +114,16 @@ var APMAWSSiteTypeChoiceAPMAWSSiteAdminPasswordBlindfoldSecretInfoModelAttrTypes
 
 // APMAWSSiteTypeChoiceAPMAWSSiteAdminPasswordClearSecretInfoModel represents clear_secret_info block
 type APMAWSSiteTypeChoiceAPMAWSSiteAdminPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // APMAWSSiteTypeChoiceAPMAWSSiteAdminPasswordClearSecretInfoModelAttrTypes defines the attribute types for APMAWSSiteTypeChoiceAPMAWSSiteAdminPasswordClearSecretInfoModel
 var APMAWSSiteTypeChoiceAPMAWSSiteAdminPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // APMAWSSiteTypeChoiceAPMAWSSiteAWSTGWSiteModel represents aws_tgw_site block
@@ -307,14 +316,16 @@ var APMBaremetalSiteTypeChoiceF5BareMetalSiteAdminPasswordBlindfoldSecretInfoMod
 
 // APMBaremetalSiteTypeChoiceF5BareMetalSiteAdminPasswordClearSecretInfoModel represents clear_secret_info block
 type APMBaremetalSiteTypeChoiceF5BareMetalSiteAdminPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // APMBaremetalSiteTypeChoiceF5BareMetalSiteAdminPasswordClearSecretInfoModelAttrTypes defines the attribute types for APMBaremetalSiteTypeChoiceF5BareMetalSiteAdminPasswordClearSecretInfoModel
 var APMBaremetalSiteTypeChoiceF5BareMetalSiteAdminPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // APMBaremetalSiteTypeChoiceF5BareMetalSiteBareMetalSiteModel represents bare_metal_site block
@@ -377,14 +388,16 @@ var APMBaremetalSiteTypeChoiceF5BareMetalSiteBigiqInstancePasswordBlindfoldSecre
 
 // APMBaremetalSiteTypeChoiceF5BareMetalSiteBigiqInstancePasswordClearSecretInfoModel represents clear_secret_info block
 type APMBaremetalSiteTypeChoiceF5BareMetalSiteBigiqInstancePasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // APMBaremetalSiteTypeChoiceF5BareMetalSiteBigiqInstancePasswordClearSecretInfoModelAttrTypes defines the attribute types for APMBaremetalSiteTypeChoiceF5BareMetalSiteBigiqInstancePasswordClearSecretInfoModel
 var APMBaremetalSiteTypeChoiceF5BareMetalSiteBigiqInstancePasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // APMBaremetalSiteTypeChoiceF5BareMetalSiteNodesModel represents nodes block
@@ -585,14 +598,16 @@ var APMHTTPSManagementAdvertiseOnSLIVIPTLSCertificatesPrivateKeyBlindfoldSecretI
 
 // APMHTTPSManagementAdvertiseOnSLIVIPTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type APMHTTPSManagementAdvertiseOnSLIVIPTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // APMHTTPSManagementAdvertiseOnSLIVIPTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for APMHTTPSManagementAdvertiseOnSLIVIPTLSCertificatesPrivateKeyClearSecretInfoModel
 var APMHTTPSManagementAdvertiseOnSLIVIPTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // APMHTTPSManagementAdvertiseOnSLIVIPTLSConfigModel represents tls_config block
@@ -759,14 +774,16 @@ var APMHTTPSManagementAdvertiseOnSloInternetVIPTLSCertificatesPrivateKeyBlindfol
 
 // APMHTTPSManagementAdvertiseOnSloInternetVIPTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type APMHTTPSManagementAdvertiseOnSloInternetVIPTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // APMHTTPSManagementAdvertiseOnSloInternetVIPTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for APMHTTPSManagementAdvertiseOnSloInternetVIPTLSCertificatesPrivateKeyClearSecretInfoModel
 var APMHTTPSManagementAdvertiseOnSloInternetVIPTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // APMHTTPSManagementAdvertiseOnSloInternetVIPTLSConfigModel represents tls_config block
@@ -933,14 +950,16 @@ var APMHTTPSManagementAdvertiseOnSloSLITLSCertificatesPrivateKeyBlindfoldSecretI
 
 // APMHTTPSManagementAdvertiseOnSloSLITLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type APMHTTPSManagementAdvertiseOnSloSLITLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // APMHTTPSManagementAdvertiseOnSloSLITLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for APMHTTPSManagementAdvertiseOnSloSLITLSCertificatesPrivateKeyClearSecretInfoModel
 var APMHTTPSManagementAdvertiseOnSloSLITLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // APMHTTPSManagementAdvertiseOnSloSLITLSConfigModel represents tls_config block
@@ -1107,14 +1126,16 @@ var APMHTTPSManagementAdvertiseOnSloVIPTLSCertificatesPrivateKeyBlindfoldSecretI
 
 // APMHTTPSManagementAdvertiseOnSloVIPTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type APMHTTPSManagementAdvertiseOnSloVIPTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // APMHTTPSManagementAdvertiseOnSloVIPTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for APMHTTPSManagementAdvertiseOnSloVIPTLSCertificatesPrivateKeyClearSecretInfoModel
 var APMHTTPSManagementAdvertiseOnSloVIPTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // APMHTTPSManagementAdvertiseOnSloVIPTLSConfigModel represents tls_config block
@@ -1216,6 +1237,8 @@ type APMResourceModel struct {
 	Labels                  types.Map                        `tfsdk:"labels"`
 	ID                      types.String                     `tfsdk:"id"`
 	Timeouts                timeouts.Value                   `tfsdk:"timeouts"`
+	SystemMetadata          types.Object                     `tfsdk:"system_metadata"`
+	LabelsAll               types.Map                        `tfsdk:"labels_all"`
 	AWSSiteTypeChoice       *APMAWSSiteTypeChoiceModel       `tfsdk:"aws_site_type_choice"`
 	BaremetalSiteTypeChoice *APMBaremetalSiteTypeChoiceModel `tfsdk:"baremetal_site_type_choice"`
 	HTTPSManagement         *APMHTTPSManagementModel         `tfsdk:"https_management"`
@@ -1240,9 +1263,11 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the APM will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the APM will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -1274,6 +1299,8 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -1292,10 +1319,17 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							"admin_username": schema.StringAttribute{
 								MarkdownDescription: "Admin Username for BIG-IP .",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(256),
+								},
 							},
 							"ssh_key": schema.StringAttribute{
 								MarkdownDescription: "Public SSH key for accessing the BIG-IP nodes.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(8192),
+									stringvalidator.UTF8LengthAtLeast(1),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -1328,7 +1362,16 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -1345,6 +1388,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1353,6 +1400,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1361,6 +1411,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -1372,6 +1425,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 									"configured_vip": schema.StringAttribute{
 										MarkdownDescription: "Enter IP address for the default VIP.",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.IPValidator(),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1391,6 +1447,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												MarkdownDescription: "List of port ranges. Each range is a single port or a pair of start and end ports e.g. 8080-8192 .",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(128),
+												},
 											},
 										},
 									},
@@ -1401,6 +1460,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												MarkdownDescription: "List of port ranges. Each range is a single port or a pair of start and end ports e.g. 8080-8192 .",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(128),
+												},
 											},
 										},
 									},
@@ -1426,19 +1488,33 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							},
 							"nodes": schema.ListNestedBlock{
 								MarkdownDescription: "Specify how and where the service nodes are spawned .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(2),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"aws_az_name": schema.StringAttribute{
 											MarkdownDescription: "The AWS Availability Zone must be consistent with the AWS Region chosen. Please select an AZ in the same Region as your TGW Site .",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.PatternValidator("^([a-z]{2})-([a-z0-9]{4,20})-([a-z0-9]{2})$"),
+											},
 										},
 										"node_name": schema.StringAttribute{
 											MarkdownDescription: "Node Name will be used to assign as hostname to the service .",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.HostnameValidator(),
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 										"tunnel_prefix": schema.StringAttribute{
 											MarkdownDescription: "Enter IP prefix for the tunnel, it has to be /30.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4PrefixValidator(),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -1451,6 +1527,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												"existing_subnet_id": schema.StringAttribute{
 													MarkdownDescription: "Information about existing subnet ID.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(64),
+														validators.PatternValidator("^(subnet-)([a-z0-9]{8}|[a-z0-9]{17})$"),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -1460,6 +1540,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 														"ipv4": schema.StringAttribute{
 															MarkdownDescription: "IPv4 subnet prefix for this subnet .",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.IPv4PrefixValidator(),
+															},
 														},
 													},
 												},
@@ -1500,6 +1583,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							"admin_username": schema.StringAttribute{
 								MarkdownDescription: "Admin Username for BIG-IP .",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(256),
+								},
 							},
 							"public_download_url": schema.StringAttribute{
 								MarkdownDescription: "Public URL where BIG-IP VE image (qcow2) is hosted .",
@@ -1508,6 +1594,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							"ssh_key": schema.StringAttribute{
 								MarkdownDescription: "Public SSH key for accessing the BIG-IP nodes.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(8192),
+									stringvalidator.UTF8LengthAtLeast(1),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -1540,7 +1630,16 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -1553,6 +1652,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1561,6 +1664,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1569,6 +1675,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -1578,18 +1687,33 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 									"license_pool_name": schema.StringAttribute{
 										MarkdownDescription: "Name of Utility Pool on BIG-IQ .",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(256),
+											stringvalidator.UTF8LengthAtLeast(1),
+										},
 									},
 									"license_server_ip": schema.StringAttribute{
 										MarkdownDescription: "IP Address from the TCP Load Balancer which is configured to communicate with License Server .",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.IPValidator(),
+										},
 									},
 									"sku_name": schema.StringAttribute{
 										MarkdownDescription: "License offering name aka SKU name .",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(256),
+											stringvalidator.UTF8LengthAtLeast(1),
+										},
 									},
 									"username": schema.StringAttribute{
 										MarkdownDescription: "User Name used to access BIG-IQ to activate the license .",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(256),
+											stringvalidator.UTF8LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1622,7 +1746,16 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -1633,6 +1766,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							},
 							"nodes": schema.ListNestedBlock{
 								MarkdownDescription: "Specify how and where the service nodes are spawned .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"bm_node_memory_size": schema.StringAttribute{
@@ -1646,6 +1782,11 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 										"node_name": schema.StringAttribute{
 											MarkdownDescription: "Node Name will be used to assign as hostname to the service .",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.HostnameValidator(),
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -1655,10 +1796,16 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												"network_gateway": schema.StringAttribute{
 													MarkdownDescription: "Configuration parameter for network gateway.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.IPv4Validator(),
+													},
 												},
 												"network_self_ip": schema.StringAttribute{
 													MarkdownDescription: "Self IP. Self IP CIDR .",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.IPv4PrefixValidator(),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -1668,6 +1815,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 														"name": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(128),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 														"namespace": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1676,6 +1827,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 														"tenant": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1684,6 +1838,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 													},
 												},
@@ -1695,10 +1852,16 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												"network_gateway": schema.StringAttribute{
 													MarkdownDescription: "Configuration parameter for network gateway.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.IPv4Validator(),
+													},
 												},
 												"network_self_ip": schema.StringAttribute{
 													MarkdownDescription: "Self IP. Self IP CIDR .",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.IPv4PrefixValidator(),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -1708,6 +1871,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 														"name": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(128),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 														"namespace": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1716,6 +1883,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 														"tenant": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1724,6 +1894,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 													},
 												},
@@ -1742,10 +1915,17 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 					"domain_suffix": schema.StringAttribute{
 						MarkdownDescription: "Domain suffix will be used along with node name to form URL to access node management .",
 						Optional:            true,
+						Validators: []validator.String{
+							validators.HostnameValidator(),
+						},
 					},
 					"https_port": schema.Int64Attribute{
 						MarkdownDescription: "Enter TCP port number.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(65535),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1759,6 +1939,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1767,6 +1951,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1775,6 +1962,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -1792,11 +1982,18 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							},
 							"tls_certificates": schema.ListNestedBlock{
 								MarkdownDescription: "Users can add one or more certificates that share the same set of domains. For example, domain.com and *.domain.com - but use different signature algorithms .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -1811,6 +2008,11 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -1846,7 +2048,16 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -1870,6 +2081,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -1902,6 +2116,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1911,6 +2129,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1919,6 +2141,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1927,6 +2152,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -1939,6 +2167,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1947,6 +2179,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1955,6 +2190,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -1984,11 +2222,18 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							},
 							"tls_certificates": schema.ListNestedBlock{
 								MarkdownDescription: "Users can add one or more certificates that share the same set of domains. For example, domain.com and *.domain.com - but use different signature algorithms .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -2003,6 +2248,11 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -2038,7 +2288,16 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -2062,6 +2321,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -2094,6 +2356,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -2103,6 +2369,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2111,6 +2381,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2119,6 +2392,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -2131,6 +2407,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2139,6 +2419,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2147,6 +2430,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -2176,11 +2462,18 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							},
 							"tls_certificates": schema.ListNestedBlock{
 								MarkdownDescription: "Users can add one or more certificates that share the same set of domains. For example, domain.com and *.domain.com - but use different signature algorithms .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -2195,6 +2488,11 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -2230,7 +2528,16 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -2254,6 +2561,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -2286,6 +2596,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -2295,6 +2609,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2303,6 +2621,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2311,6 +2632,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -2323,6 +2647,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2331,6 +2659,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2339,6 +2670,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -2368,11 +2702,18 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 							},
 							"tls_certificates": schema.ListNestedBlock{
 								MarkdownDescription: "Users can add one or more certificates that share the same set of domains. For example, domain.com and *.domain.com - but use different signature algorithms .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -2387,6 +2728,11 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -2422,7 +2768,16 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -2446,6 +2801,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -2478,6 +2836,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -2487,6 +2849,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2495,6 +2861,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2503,6 +2872,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -2515,6 +2887,10 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2523,6 +2899,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2531,6 +2910,9 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -2560,6 +2942,11 @@ func (r *APMResource) Schema(ctx context.Context, req resource.SchemaRequest, re
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *APMResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *APMResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -2584,6 +2971,55 @@ func (r *APMResource) ValidateConfig(ctx context.Context, req resource.ValidateC
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *APMResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "site_type_choice", "aws_site_type_choice", "baremetal_site_type_choice"),
+		validators.OneOfGroup(path.MatchRoot("aws_site_type_choice").AtName("apm_aws_site").AtName("admin_password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("aws_site_type_choice").AtName("apm_aws_site").AtName("endpoint_service"), "external_vip_choice", "advertise_on_slo_ip", "advertise_on_slo_ip_external", "disable_advertise_on_slo_ip"),
+		validators.OneOfGroup(path.MatchRoot("aws_site_type_choice").AtName("apm_aws_site").AtName("endpoint_service"), "inside_vip_choice", "automatic_vip", "configured_vip"),
+		validators.OneOfGroup(path.MatchRoot("aws_site_type_choice").AtName("apm_aws_site").AtName("endpoint_service"), "tcp_port_choice", "custom_tcp_ports", "default_tcp_ports", "http_port", "https_port", "no_tcp_ports"),
+		validators.OneOfGroup(path.MatchRoot("aws_site_type_choice").AtName("apm_aws_site").AtName("endpoint_service"), "udp_port_choice", "custom_udp_ports", "no_udp_ports"),
+		validators.OneOfGroup(path.MatchRoot("aws_site_type_choice").AtName("apm_aws_site").AtName("nodes").AtAnyListIndex(), "mgmt_subnet_choice", "mgmt_subnet", "reserved_mgmt_subnet"),
+		validators.OneOfGroup(path.MatchRoot("aws_site_type_choice").AtName("apm_aws_site").AtName("nodes").AtAnyListIndex(), "tunnel_prefix_choice", "automatic_prefix", "tunnel_prefix"),
+		validators.OneOfGroup(path.MatchRoot("aws_site_type_choice").AtName("apm_aws_site").AtName("nodes").AtAnyListIndex().AtName("mgmt_subnet"), "choice", "existing_subnet_id", "subnet_param"),
+		validators.OneOfGroup(path.MatchRoot("aws_site_type_choice").AtName("market_place_image"), "ami_choice", "best_plus_pay_g200_mbps", "best_plus_payg_1gbps"),
+		validators.OneOfGroup(path.MatchRoot("baremetal_site_type_choice").AtName("f5_bare_metal_site").AtName("admin_password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("baremetal_site_type_choice").AtName("f5_bare_metal_site").AtName("bigiq_instance").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("https_management"), "advertise_choice", "advertise_on_internet", "advertise_on_internet_default_vip", "advertise_on_sli_vip", "advertise_on_slo_internet_vip", "advertise_on_slo_sli", "advertise_on_slo_vip"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https_management"), "port_choice", "default_https_port", "https_port"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *APMResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -2594,7 +3030,10 @@ func (r *APMResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReq
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "apm", "", req.Config, &resp.Plan)...)
 		var plan APMResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -2612,7 +3051,8 @@ func (r *APMResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanReq
 
 func (r *APMResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data APMResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2651,6 +3091,7 @@ func (r *APMResource) Create(ctx context.Context, req resource.CreateRequest, re
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -2660,22 +3101,132 @@ func (r *APMResource) Create(ctx context.Context, req resource.CreateRequest, re
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AWSSiteTypeChoice != nil {
 		aws_site_type_choiceMap := make(map[string]interface{})
 		if data.AWSSiteTypeChoice.APMAWSSite != nil {
 			apm_aws_siteNestedMap := make(map[string]interface{})
+			if data.AWSSiteTypeChoice.APMAWSSite.AdminPassword != nil {
+				admin_passwordLevel3Map := make(map[string]interface{})
+				if data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.Location.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					admin_passwordLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo.Provider.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo.URL.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo.URL.ValueString()
+					}
+					admin_passwordLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				apm_aws_siteNestedMap["admin_password"] = admin_passwordLevel3Map
+			}
 			if !data.AWSSiteTypeChoice.APMAWSSite.AdminUsername.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AdminUsername.IsUnknown() {
 				apm_aws_siteNestedMap["admin_username"] = data.AWSSiteTypeChoice.APMAWSSite.AdminUsername.ValueString()
 			}
+			if data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite != nil {
+				aws_tgw_siteLevel3Map := make(map[string]interface{})
+				if data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite != nil {
+					aws_tgw_siteLevel4Map := make(map[string]interface{})
+					if !data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Name.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Name.IsUnknown() {
+						aws_tgw_siteLevel4Map["name"] = data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Name.ValueString()
+					}
+					if !data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Namespace.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Namespace.IsUnknown() {
+						aws_tgw_siteLevel4Map["namespace"] = data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Namespace.ValueString()
+					}
+					if !data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Tenant.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Tenant.IsUnknown() {
+						aws_tgw_siteLevel4Map["tenant"] = data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Tenant.ValueString()
+					}
+					aws_tgw_siteLevel3Map["aws_tgw_site"] = aws_tgw_siteLevel4Map
+				}
+				apm_aws_siteNestedMap["aws_tgw_site"] = aws_tgw_siteLevel3Map
+			}
+			if data.AWSSiteTypeChoice.APMAWSSite.EndpointService != nil {
+				endpoint_serviceLevel3Map := make(map[string]interface{})
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.AdvertiseOnSloIP != nil {
+					endpoint_serviceLevel3Map["advertise_on_slo_ip"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.AdvertiseOnSloIPExternal != nil {
+					endpoint_serviceLevel3Map["advertise_on_slo_ip_external"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.AutomaticVIP != nil {
+					endpoint_serviceLevel3Map["automatic_vip"] = map[string]interface{}{}
+				}
+				if !data.AWSSiteTypeChoice.APMAWSSite.EndpointService.ConfiguredVIP.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.EndpointService.ConfiguredVIP.IsUnknown() {
+					endpoint_serviceLevel3Map["configured_vip"] = data.AWSSiteTypeChoice.APMAWSSite.EndpointService.ConfiguredVIP.ValueString()
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomTCPPorts != nil {
+					custom_tcp_portsLevel4Map := make(map[string]interface{})
+					if !data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomTCPPorts.Ports.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomTCPPorts.Ports.IsUnknown() {
+						var portsLevel4Items []string
+						diags := data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomTCPPorts.Ports.ElementsAs(ctx, &portsLevel4Items, false)
+						if !diags.HasError() {
+							custom_tcp_portsLevel4Map["ports"] = portsLevel4Items
+						}
+					}
+					endpoint_serviceLevel3Map["custom_tcp_ports"] = custom_tcp_portsLevel4Map
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomUDPPorts != nil {
+					custom_udp_portsLevel4Map := make(map[string]interface{})
+					if !data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomUDPPorts.Ports.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomUDPPorts.Ports.IsUnknown() {
+						var portsLevel4Items []string
+						diags := data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomUDPPorts.Ports.ElementsAs(ctx, &portsLevel4Items, false)
+						if !diags.HasError() {
+							custom_udp_portsLevel4Map["ports"] = portsLevel4Items
+						}
+					}
+					endpoint_serviceLevel3Map["custom_udp_ports"] = custom_udp_portsLevel4Map
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.DefaultTCPPorts != nil {
+					endpoint_serviceLevel3Map["default_tcp_ports"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.DisableAdvertiseOnSloIP != nil {
+					endpoint_serviceLevel3Map["disable_advertise_on_slo_ip"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.HTTPPort != nil {
+					endpoint_serviceLevel3Map["http_port"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.HTTPSPort != nil {
+					endpoint_serviceLevel3Map["https_port"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.NoTCPPorts != nil {
+					endpoint_serviceLevel3Map["no_tcp_ports"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.NoUDPPorts != nil {
+					endpoint_serviceLevel3Map["no_udp_ports"] = map[string]interface{}{}
+				}
+				apm_aws_siteNestedMap["endpoint_service"] = endpoint_serviceLevel3Map
+			}
 			if !data.AWSSiteTypeChoice.APMAWSSite.SSHKey.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.SSHKey.IsUnknown() {
 				apm_aws_siteNestedMap["ssh_key"] = data.AWSSiteTypeChoice.APMAWSSite.SSHKey.ValueString()
 			}
+			if data.AWSSiteTypeChoice.APMAWSSite.Tags != nil {
+				apm_aws_siteNestedMap["tags"] = map[string]interface{}{}
+			}
 			aws_site_type_choiceMap["apm_aws_site"] = apm_aws_siteNestedMap
 		}
 		if data.AWSSiteTypeChoice.MarketPlaceImage != nil {
 			market_place_imageNestedMap := make(map[string]interface{})
+			if data.AWSSiteTypeChoice.MarketPlaceImage.Bestpluspayg200mbps != nil {
+				market_place_imageNestedMap["BestPlusPayG200Mbps"] = map[string]interface{}{}
+			}
+			if data.AWSSiteTypeChoice.MarketPlaceImage.BestPlusPayg1gbps != nil {
+				market_place_imageNestedMap["best_plus_payg_1gbps"] = map[string]interface{}{}
+			}
 			aws_site_type_choiceMap["market_place_image"] = market_place_imageNestedMap
 		}
 		createReq.Spec["aws_site_type_choice"] = aws_site_type_choiceMap
@@ -2684,9 +3235,92 @@ func (r *APMResource) Create(ctx context.Context, req resource.CreateRequest, re
 		baremetal_site_type_choiceMap := make(map[string]interface{})
 		if data.BaremetalSiteTypeChoice.F5BareMetalSite != nil {
 			f5_bare_metal_siteNestedMap := make(map[string]interface{})
+			if data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword != nil {
+				admin_passwordLevel3Map := make(map[string]interface{})
+				if data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.Location.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					admin_passwordLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo.Provider.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo.URL.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo.URL.ValueString()
+					}
+					admin_passwordLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				f5_bare_metal_siteNestedMap["admin_password"] = admin_passwordLevel3Map
+			}
 			if !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminUsername.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminUsername.IsUnknown() {
 				f5_bare_metal_siteNestedMap["admin_username"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminUsername.ValueString()
 			}
+			if data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite != nil {
+				bare_metal_siteLevel3Map := make(map[string]interface{})
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Name.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Name.IsUnknown() {
+					bare_metal_siteLevel3Map["name"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Name.ValueString()
+				}
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Namespace.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Namespace.IsUnknown() {
+					bare_metal_siteLevel3Map["namespace"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Namespace.ValueString()
+				}
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Tenant.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Tenant.IsUnknown() {
+					bare_metal_siteLevel3Map["tenant"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Tenant.ValueString()
+				}
+				f5_bare_metal_siteNestedMap["bare_metal_site"] = bare_metal_siteLevel3Map
+			}
+			if data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance != nil {
+				bigiq_instanceLevel3Map := make(map[string]interface{})
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.LicensePoolName.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.LicensePoolName.IsUnknown() {
+					bigiq_instanceLevel3Map["license_pool_name"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.LicensePoolName.ValueString()
+				}
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.LicenseServerIP.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.LicenseServerIP.IsUnknown() {
+					bigiq_instanceLevel3Map["license_server_ip"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.LicenseServerIP.ValueString()
+				}
+				if data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password != nil {
+					passwordLevel4Map := make(map[string]interface{})
+					if data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.Location.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						passwordLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo.Provider.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo.URL.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo.URL.ValueString()
+						}
+						passwordLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					bigiq_instanceLevel3Map["password"] = passwordLevel4Map
+				}
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.SkuName.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.SkuName.IsUnknown() {
+					bigiq_instanceLevel3Map["sku_name"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.SkuName.ValueString()
+				}
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Username.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Username.IsUnknown() {
+					bigiq_instanceLevel3Map["username"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Username.ValueString()
+				}
+				f5_bare_metal_siteNestedMap["bigiq_instance"] = bigiq_instanceLevel3Map
+			}
 			if !data.BaremetalSiteTypeChoice.F5BareMetalSite.PublicDownloadURL.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.PublicDownloadURL.IsUnknown() {
 				f5_bare_metal_siteNestedMap["public_download_url"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.PublicDownloadURL.ValueString()
 			}
@@ -2701,6 +3335,19 @@ func (r *APMResource) Create(ctx context.Context, req resource.CreateRequest, re
 		https_managementMap := make(map[string]interface{})
 		if data.HTTPSManagement.AdvertiseOnInternet != nil {
 			advertise_on_internetNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnInternet.PublicIP != nil {
+				public_ipLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Name.IsUnknown() {
+					public_ipLevel3Map["name"] = data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Name.ValueString()
+				}
+				if !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Namespace.IsUnknown() {
+					public_ipLevel3Map["namespace"] = data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Namespace.ValueString()
+				}
+				if !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Tenant.IsUnknown() {
+					public_ipLevel3Map["tenant"] = data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Tenant.ValueString()
+				}
+				advertise_on_internetNestedMap["public_ip"] = public_ipLevel3Map
+			}
 			https_managementMap["advertise_on_internet"] = advertise_on_internetNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnInternetDefaultVIP != nil {
@@ -2708,18 +3355,362 @@ func (r *APMResource) Create(ctx context.Context, req resource.CreateRequest, re
 		}
 		if data.HTTPSManagement.AdvertiseOnSLIVIP != nil {
 			advertise_on_sli_vipNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSLIVIP.NoMtls != nil {
+				advertise_on_sli_vipNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_sli_vipNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_sli_vipNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_sli_vip"] = advertise_on_sli_vipNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnSloInternetVIP != nil {
 			advertise_on_slo_internet_vipNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSloInternetVIP.NoMtls != nil {
+				advertise_on_slo_internet_vipNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_slo_internet_vipNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_slo_internet_vipNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_slo_internet_vip"] = advertise_on_slo_internet_vipNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnSloSLI != nil {
 			advertise_on_slo_sliNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSloSLI.NoMtls != nil {
+				advertise_on_slo_sliNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_slo_sliNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_slo_sliNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_slo_sli"] = advertise_on_slo_sliNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnSloVIP != nil {
 			advertise_on_slo_vipNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSloVIP.NoMtls != nil {
+				advertise_on_slo_vipNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_slo_vipNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_slo_vipNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_slo_vip"] = advertise_on_slo_vipNestedMap
 		}
 		if data.HTTPSManagement.DefaultHTTPSPort != nil {
@@ -2736,11 +3727,15 @@ func (r *APMResource) Create(ctx context.Context, req resource.CreateRequest, re
 
 	apiResource, err := r.client.CreateAPM(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create APM: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "apm", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -2885,7 +3880,7 @@ func (r *APMResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	apiResource, err := r.client.GetAPM(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "APM not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -2893,11 +3888,12 @@ func (r *APMResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read APM: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "apm", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -2908,31 +3904,13 @@ func (r *APMResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -2940,6 +3918,7 @@ func (r *APMResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["aws_site_type_choice"].(map[string]interface{}); ok && isImport && data.AWSSiteTypeChoice == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -3062,7 +4041,8 @@ func (r *APMResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 
 func (r *APMResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data APMResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -3096,6 +4076,7 @@ func (r *APMResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -3105,22 +4086,132 @@ func (r *APMResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AWSSiteTypeChoice != nil {
 		aws_site_type_choiceMap := make(map[string]interface{})
 		if data.AWSSiteTypeChoice.APMAWSSite != nil {
 			apm_aws_siteNestedMap := make(map[string]interface{})
+			if data.AWSSiteTypeChoice.APMAWSSite.AdminPassword != nil {
+				admin_passwordLevel3Map := make(map[string]interface{})
+				if data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.Location.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					admin_passwordLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo.Provider.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo.URL.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.AWSSiteTypeChoice.APMAWSSite.AdminPassword.ClearSecretInfo.URL.ValueString()
+					}
+					admin_passwordLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				apm_aws_siteNestedMap["admin_password"] = admin_passwordLevel3Map
+			}
 			if !data.AWSSiteTypeChoice.APMAWSSite.AdminUsername.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AdminUsername.IsUnknown() {
 				apm_aws_siteNestedMap["admin_username"] = data.AWSSiteTypeChoice.APMAWSSite.AdminUsername.ValueString()
 			}
+			if data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite != nil {
+				aws_tgw_siteLevel3Map := make(map[string]interface{})
+				if data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite != nil {
+					aws_tgw_siteLevel4Map := make(map[string]interface{})
+					if !data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Name.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Name.IsUnknown() {
+						aws_tgw_siteLevel4Map["name"] = data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Name.ValueString()
+					}
+					if !data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Namespace.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Namespace.IsUnknown() {
+						aws_tgw_siteLevel4Map["namespace"] = data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Namespace.ValueString()
+					}
+					if !data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Tenant.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Tenant.IsUnknown() {
+						aws_tgw_siteLevel4Map["tenant"] = data.AWSSiteTypeChoice.APMAWSSite.AWSTGWSite.AWSTGWSite.Tenant.ValueString()
+					}
+					aws_tgw_siteLevel3Map["aws_tgw_site"] = aws_tgw_siteLevel4Map
+				}
+				apm_aws_siteNestedMap["aws_tgw_site"] = aws_tgw_siteLevel3Map
+			}
+			if data.AWSSiteTypeChoice.APMAWSSite.EndpointService != nil {
+				endpoint_serviceLevel3Map := make(map[string]interface{})
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.AdvertiseOnSloIP != nil {
+					endpoint_serviceLevel3Map["advertise_on_slo_ip"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.AdvertiseOnSloIPExternal != nil {
+					endpoint_serviceLevel3Map["advertise_on_slo_ip_external"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.AutomaticVIP != nil {
+					endpoint_serviceLevel3Map["automatic_vip"] = map[string]interface{}{}
+				}
+				if !data.AWSSiteTypeChoice.APMAWSSite.EndpointService.ConfiguredVIP.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.EndpointService.ConfiguredVIP.IsUnknown() {
+					endpoint_serviceLevel3Map["configured_vip"] = data.AWSSiteTypeChoice.APMAWSSite.EndpointService.ConfiguredVIP.ValueString()
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomTCPPorts != nil {
+					custom_tcp_portsLevel4Map := make(map[string]interface{})
+					if !data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomTCPPorts.Ports.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomTCPPorts.Ports.IsUnknown() {
+						var portsLevel4Items []string
+						diags := data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomTCPPorts.Ports.ElementsAs(ctx, &portsLevel4Items, false)
+						if !diags.HasError() {
+							custom_tcp_portsLevel4Map["ports"] = portsLevel4Items
+						}
+					}
+					endpoint_serviceLevel3Map["custom_tcp_ports"] = custom_tcp_portsLevel4Map
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomUDPPorts != nil {
+					custom_udp_portsLevel4Map := make(map[string]interface{})
+					if !data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomUDPPorts.Ports.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomUDPPorts.Ports.IsUnknown() {
+						var portsLevel4Items []string
+						diags := data.AWSSiteTypeChoice.APMAWSSite.EndpointService.CustomUDPPorts.Ports.ElementsAs(ctx, &portsLevel4Items, false)
+						if !diags.HasError() {
+							custom_udp_portsLevel4Map["ports"] = portsLevel4Items
+						}
+					}
+					endpoint_serviceLevel3Map["custom_udp_ports"] = custom_udp_portsLevel4Map
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.DefaultTCPPorts != nil {
+					endpoint_serviceLevel3Map["default_tcp_ports"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.DisableAdvertiseOnSloIP != nil {
+					endpoint_serviceLevel3Map["disable_advertise_on_slo_ip"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.HTTPPort != nil {
+					endpoint_serviceLevel3Map["http_port"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.HTTPSPort != nil {
+					endpoint_serviceLevel3Map["https_port"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.NoTCPPorts != nil {
+					endpoint_serviceLevel3Map["no_tcp_ports"] = map[string]interface{}{}
+				}
+				if data.AWSSiteTypeChoice.APMAWSSite.EndpointService.NoUDPPorts != nil {
+					endpoint_serviceLevel3Map["no_udp_ports"] = map[string]interface{}{}
+				}
+				apm_aws_siteNestedMap["endpoint_service"] = endpoint_serviceLevel3Map
+			}
 			if !data.AWSSiteTypeChoice.APMAWSSite.SSHKey.IsNull() && !data.AWSSiteTypeChoice.APMAWSSite.SSHKey.IsUnknown() {
 				apm_aws_siteNestedMap["ssh_key"] = data.AWSSiteTypeChoice.APMAWSSite.SSHKey.ValueString()
 			}
+			if data.AWSSiteTypeChoice.APMAWSSite.Tags != nil {
+				apm_aws_siteNestedMap["tags"] = map[string]interface{}{}
+			}
 			aws_site_type_choiceMap["apm_aws_site"] = apm_aws_siteNestedMap
 		}
 		if data.AWSSiteTypeChoice.MarketPlaceImage != nil {
 			market_place_imageNestedMap := make(map[string]interface{})
+			if data.AWSSiteTypeChoice.MarketPlaceImage.Bestpluspayg200mbps != nil {
+				market_place_imageNestedMap["BestPlusPayG200Mbps"] = map[string]interface{}{}
+			}
+			if data.AWSSiteTypeChoice.MarketPlaceImage.BestPlusPayg1gbps != nil {
+				market_place_imageNestedMap["best_plus_payg_1gbps"] = map[string]interface{}{}
+			}
 			aws_site_type_choiceMap["market_place_image"] = market_place_imageNestedMap
 		}
 		apiResource.Spec["aws_site_type_choice"] = aws_site_type_choiceMap
@@ -3129,9 +4220,92 @@ func (r *APMResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		baremetal_site_type_choiceMap := make(map[string]interface{})
 		if data.BaremetalSiteTypeChoice.F5BareMetalSite != nil {
 			f5_bare_metal_siteNestedMap := make(map[string]interface{})
+			if data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword != nil {
+				admin_passwordLevel3Map := make(map[string]interface{})
+				if data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.Location.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					admin_passwordLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo.Provider.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo.URL.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminPassword.ClearSecretInfo.URL.ValueString()
+					}
+					admin_passwordLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				f5_bare_metal_siteNestedMap["admin_password"] = admin_passwordLevel3Map
+			}
 			if !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminUsername.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminUsername.IsUnknown() {
 				f5_bare_metal_siteNestedMap["admin_username"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.AdminUsername.ValueString()
 			}
+			if data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite != nil {
+				bare_metal_siteLevel3Map := make(map[string]interface{})
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Name.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Name.IsUnknown() {
+					bare_metal_siteLevel3Map["name"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Name.ValueString()
+				}
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Namespace.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Namespace.IsUnknown() {
+					bare_metal_siteLevel3Map["namespace"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Namespace.ValueString()
+				}
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Tenant.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Tenant.IsUnknown() {
+					bare_metal_siteLevel3Map["tenant"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BareMetalSite.Tenant.ValueString()
+				}
+				f5_bare_metal_siteNestedMap["bare_metal_site"] = bare_metal_siteLevel3Map
+			}
+			if data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance != nil {
+				bigiq_instanceLevel3Map := make(map[string]interface{})
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.LicensePoolName.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.LicensePoolName.IsUnknown() {
+					bigiq_instanceLevel3Map["license_pool_name"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.LicensePoolName.ValueString()
+				}
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.LicenseServerIP.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.LicenseServerIP.IsUnknown() {
+					bigiq_instanceLevel3Map["license_server_ip"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.LicenseServerIP.ValueString()
+				}
+				if data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password != nil {
+					passwordLevel4Map := make(map[string]interface{})
+					if data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.Location.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						passwordLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo.Provider.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo.URL.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Password.ClearSecretInfo.URL.ValueString()
+						}
+						passwordLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					bigiq_instanceLevel3Map["password"] = passwordLevel4Map
+				}
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.SkuName.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.SkuName.IsUnknown() {
+					bigiq_instanceLevel3Map["sku_name"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.SkuName.ValueString()
+				}
+				if !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Username.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Username.IsUnknown() {
+					bigiq_instanceLevel3Map["username"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.BigiqInstance.Username.ValueString()
+				}
+				f5_bare_metal_siteNestedMap["bigiq_instance"] = bigiq_instanceLevel3Map
+			}
 			if !data.BaremetalSiteTypeChoice.F5BareMetalSite.PublicDownloadURL.IsNull() && !data.BaremetalSiteTypeChoice.F5BareMetalSite.PublicDownloadURL.IsUnknown() {
 				f5_bare_metal_siteNestedMap["public_download_url"] = data.BaremetalSiteTypeChoice.F5BareMetalSite.PublicDownloadURL.ValueString()
 			}
@@ -3146,6 +4320,19 @@ func (r *APMResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		https_managementMap := make(map[string]interface{})
 		if data.HTTPSManagement.AdvertiseOnInternet != nil {
 			advertise_on_internetNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnInternet.PublicIP != nil {
+				public_ipLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Name.IsUnknown() {
+					public_ipLevel3Map["name"] = data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Name.ValueString()
+				}
+				if !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Namespace.IsUnknown() {
+					public_ipLevel3Map["namespace"] = data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Namespace.ValueString()
+				}
+				if !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Tenant.IsUnknown() {
+					public_ipLevel3Map["tenant"] = data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Tenant.ValueString()
+				}
+				advertise_on_internetNestedMap["public_ip"] = public_ipLevel3Map
+			}
 			https_managementMap["advertise_on_internet"] = advertise_on_internetNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnInternetDefaultVIP != nil {
@@ -3153,18 +4340,362 @@ func (r *APMResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		}
 		if data.HTTPSManagement.AdvertiseOnSLIVIP != nil {
 			advertise_on_sli_vipNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSLIVIP.NoMtls != nil {
+				advertise_on_sli_vipNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_sli_vipNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_sli_vipNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_sli_vip"] = advertise_on_sli_vipNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnSloInternetVIP != nil {
 			advertise_on_slo_internet_vipNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSloInternetVIP.NoMtls != nil {
+				advertise_on_slo_internet_vipNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_slo_internet_vipNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_slo_internet_vipNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_slo_internet_vip"] = advertise_on_slo_internet_vipNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnSloSLI != nil {
 			advertise_on_slo_sliNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSloSLI.NoMtls != nil {
+				advertise_on_slo_sliNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_slo_sliNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_slo_sliNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_slo_sli"] = advertise_on_slo_sliNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnSloVIP != nil {
 			advertise_on_slo_vipNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSloVIP.NoMtls != nil {
+				advertise_on_slo_vipNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_slo_vipNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_slo_vipNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_slo_vip"] = advertise_on_slo_vipNestedMap
 		}
 		if data.HTTPSManagement.DefaultHTTPSPort != nil {
@@ -3181,7 +4712,7 @@ func (r *APMResource) Update(ctx context.Context, req resource.UpdateRequest, re
 
 	_, err := r.client.UpdateAPM(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update APM: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "apm", "update"))
 		return
 	}
 
@@ -3192,9 +4723,12 @@ func (r *APMResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetAPM(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read APM after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "apm", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -3339,7 +4873,7 @@ func (r *APMResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	err := r.client.DeleteAPM(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "APM already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -3348,37 +4882,33 @@ func (r *APMResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "APM delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete APM: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "apm", "delete"))
 		return
 	}
 }
 
 func (r *APMResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *APMResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("apm", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *APMResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListAPMs(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *APMResource) requiredNamespace() string {
+	return ""
 }
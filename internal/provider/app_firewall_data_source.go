@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,31 @@ type AppFirewallDataSource struct {
 	client *client.Client
 }
 
+// AppFirewallDataSourceModel mirrors AppFirewallResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type AppFirewallDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata           types.Object                          `tfsdk:"system_metadata"`
+	Name                     types.String                          `tfsdk:"name"`
+	Namespace                types.String                          `tfsdk:"namespace"`
+	Annotations              types.Map                             `tfsdk:"annotations"`
+	Description              types.String                          `tfsdk:"description"`
+	Disable                  types.Bool                            `tfsdk:"disable"`
+	Labels                   types.Map                             `tfsdk:"labels"`
+	ID                       types.String                          `tfsdk:"id"`
+	AiRiskBasedBlocking      *AppFirewallAiRiskBasedBlockingModel  `tfsdk:"ai_risk_based_blocking"`
+	AllowAllResponseCodes    *AppFirewallEmptyModel                `tfsdk:"allow_all_response_codes"`
+	AllowedResponseCodes     *AppFirewallAllowedResponseCodesModel `tfsdk:"allowed_response_codes"`
+	Blocking                 *AppFirewallEmptyModel                `tfsdk:"blocking"`
+	BlockingPage             *AppFirewallBlockingPageModel         `tfsdk:"blocking_page"`
+	BotProtectionSetting     *AppFirewallBotProtectionSettingModel `tfsdk:"bot_protection_setting"`
+	CustomAnonymization      *AppFirewallCustomAnonymizationModel  `tfsdk:"custom_anonymization"`
+	DefaultAnonymization     *AppFirewallEmptyModel                `tfsdk:"default_anonymization"`
+	DefaultBotSetting        *AppFirewallEmptyModel                `tfsdk:"default_bot_setting"`
+	DefaultDetectionSettings *AppFirewallEmptyModel                `tfsdk:"default_detection_settings"`
+	DetectionSettings        *AppFirewallDetectionSettingsModel    `tfsdk:"detection_settings"`
+	DisableAnonymization     *AppFirewallEmptyModel                `tfsdk:"disable_anonymization"`
+	Monitoring               *AppFirewallEmptyModel                `tfsdk:"monitoring"`
+	UseDefaultBlockingPage   *AppFirewallEmptyModel                `tfsdk:"use_default_blocking_page"`
 }
 
 func (d *AppFirewallDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +58,7 @@ func (d *AppFirewallDataSource) Metadata(ctx context.Context, req datasource.Met
 }
 
 func (d *AppFirewallDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages Application Firewall. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the AppFirewall.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the AppFirewall exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the AppFirewall.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewAppFirewallResource())
 }
 
 func (d *AppFirewallDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +80,25 @@ func (d *AppFirewallDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	resource, err := d.client.GetAppFirewall(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetAppFirewall(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AppFirewall: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "app_firewall", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +112,8 @@ func (d *AppFirewallDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +122,188 @@ func (d *AppFirewallDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["ai_risk_based_blocking"].(map[string]interface{}); ok && (isImport || data.AiRiskBasedBlocking != nil) {
+		data.AiRiskBasedBlocking = &AppFirewallAiRiskBasedBlockingModel{
+			HighRiskAction: func() types.String {
+				if v, ok := blockData["high_risk_action"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			LowRiskAction: func() types.String {
+				if v, ok := blockData["low_risk_action"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			MediumRiskAction: func() types.String {
+				if v, ok := blockData["medium_risk_action"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["allow_all_response_codes"].(map[string]interface{}); ok && isImport && data.AllowAllResponseCodes == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AllowAllResponseCodes = &AppFirewallEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["allowed_response_codes"].(map[string]interface{}); ok && (isImport || data.AllowedResponseCodes != nil) {
+		data.AllowedResponseCodes = &AppFirewallAllowedResponseCodesModel{
+			ResponseCode: func() types.List {
+				if v, ok := blockData["response_code"].([]interface{}); ok && len(v) > 0 {
+					var items []int64
+					for _, item := range v {
+						if n, ok := item.(float64); ok {
+							items = append(items, int64(n))
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.Int64Type, items)
+					return listVal
+				}
+				return types.ListNull(types.Int64Type)
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["blocking"].(map[string]interface{}); ok && isImport && data.Blocking == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Blocking = &AppFirewallEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["blocking_page"].(map[string]interface{}); ok && (isImport || data.BlockingPage != nil) {
+		data.BlockingPage = &AppFirewallBlockingPageModel{
+			BlockingPage: func() types.String {
+				if v, ok := blockData["blocking_page"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ResponseCode: func() types.String {
+				if v, ok := blockData["response_code"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["bot_protection_setting"].(map[string]interface{}); ok && (isImport || data.BotProtectionSetting != nil) {
+		data.BotProtectionSetting = &AppFirewallBotProtectionSettingModel{
+			GoodBotAction: func() types.String {
+				if v, ok := blockData["good_bot_action"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			MaliciousBotAction: func() types.String {
+				if v, ok := blockData["malicious_bot_action"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			SuspiciousBotAction: func() types.String {
+				if v, ok := blockData["suspicious_bot_action"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["custom_anonymization"].(map[string]interface{}); ok && (isImport || data.CustomAnonymization != nil) {
+		data.CustomAnonymization = &AppFirewallCustomAnonymizationModel{
+			AnonymizationConfig: func() []AppFirewallCustomAnonymizationAnonymizationConfigModel {
+				if listData, ok := blockData["anonymization_config"].([]interface{}); ok && len(listData) > 0 {
+					var result []AppFirewallCustomAnonymizationAnonymizationConfigModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, AppFirewallCustomAnonymizationAnonymizationConfigModel{
+								Cookie: func() *AppFirewallCustomAnonymizationAnonymizationConfigCookieModel {
+									if deepMap, ok := itemMap["cookie"].(map[string]interface{}); ok {
+										return &AppFirewallCustomAnonymizationAnonymizationConfigCookieModel{
+											CookieName: func() types.String {
+												if v, ok := deepMap["cookie_name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								HTTPHeader: func() *AppFirewallCustomAnonymizationAnonymizationConfigHTTPHeaderModel {
+									if deepMap, ok := itemMap["http_header"].(map[string]interface{}); ok {
+										return &AppFirewallCustomAnonymizationAnonymizationConfigHTTPHeaderModel{
+											HeaderName: func() types.String {
+												if v, ok := deepMap["header_name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								QueryParameter: func() *AppFirewallCustomAnonymizationAnonymizationConfigQueryParameterModel {
+									if deepMap, ok := itemMap["query_parameter"].(map[string]interface{}); ok {
+										return &AppFirewallCustomAnonymizationAnonymizationConfigQueryParameterModel{
+											QueryParamName: func() types.String {
+												if v, ok := deepMap["query_param_name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["default_anonymization"].(map[string]interface{}); ok && isImport && data.DefaultAnonymization == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DefaultAnonymization = &AppFirewallEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["default_bot_setting"].(map[string]interface{}); ok && isImport && data.DefaultBotSetting == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DefaultBotSetting = &AppFirewallEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["default_detection_settings"].(map[string]interface{}); ok && isImport && data.DefaultDetectionSettings == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DefaultDetectionSettings = &AppFirewallEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["detection_settings"].(map[string]interface{}); ok && isImport && data.DetectionSettings == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DetectionSettings = &AppFirewallDetectionSettingsModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_anonymization"].(map[string]interface{}); ok && isImport && data.DisableAnonymization == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableAnonymization = &AppFirewallEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["monitoring"].(map[string]interface{}); ok && isImport && data.Monitoring == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Monitoring = &AppFirewallEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["use_default_blocking_page"].(map[string]interface{}); ok && isImport && data.UseDefaultBlockingPage == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.UseDefaultBlockingPage = &AppFirewallEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &AppFirewallResource{}
-	_ resource.ResourceWithConfigure      = &AppFirewallResource{}
-	_ resource.ResourceWithImportState    = &AppFirewallResource{}
-	_ resource.ResourceWithModifyPlan     = &AppFirewallResource{}
-	_ resource.ResourceWithValidateConfig = &AppFirewallResource{}
+	_ resource.Resource                     = &AppFirewallResource{}
+	_ resource.ResourceWithConfigure        = &AppFirewallResource{}
+	_ resource.ResourceWithImportState      = &AppFirewallResource{}
+	_ resource.ResourceWithIdentity         = &AppFirewallResource{}
+	_ resource.ResourceWithMoveState        = &AppFirewallResource{}
+	_ resource.ResourceWithModifyPlan       = &AppFirewallResource{}
+	_ resource.ResourceWithValidateConfig   = &AppFirewallResource{}
+	_ resource.ResourceWithConfigValidators = &AppFirewallResource{}
+	_ listableResource                      = &AppFirewallResource{}
 )
 
 func NewAppFirewallResource() resource.Resource {
@@ -262,6 +269,8 @@ type AppFirewallResourceModel struct {
 	Labels                   types.Map                             `tfsdk:"labels"`
 	ID                       types.String                          `tfsdk:"id"`
 	Timeouts                 timeouts.Value                        `tfsdk:"timeouts"`
+	SystemMetadata           types.Object                          `tfsdk:"system_metadata"`
+	LabelsAll                types.Map                             `tfsdk:"labels_all"`
 	AiRiskBasedBlocking      *AppFirewallAiRiskBasedBlockingModel  `tfsdk:"ai_risk_based_blocking"`
 	AllowAllResponseCodes    *AppFirewallEmptyModel                `tfsdk:"allow_all_response_codes"`
 	AllowedResponseCodes     *AppFirewallAllowedResponseCodesModel `tfsdk:"allowed_response_codes"`
@@ -297,9 +306,11 @@ func (r *AppFirewallResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the App Firewall will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the App Firewall will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -331,6 +342,8 @@ func (r *AppFirewallResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -366,6 +379,12 @@ func (r *AppFirewallResource) Schema(ctx context.Context, req resource.SchemaReq
 						MarkdownDescription: "List of HTTP response status codes that are allowed .",
 						Optional:            true,
 						ElementType:         types.Int64Type,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(48),
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueInt64sAre(int64validator.AtLeast(100), int64validator.AtMost(999)),
+						},
 					},
 				},
 			},
@@ -378,6 +397,9 @@ func (r *AppFirewallResource) Schema(ctx context.Context, req resource.SchemaReq
 					"blocking_page": schema.StringAttribute{
 						MarkdownDescription: "Define the content of the response page (e.g., an HTML document or a JSON object), use the {{request_id}} placeholder to provide users with a unique identifier to be able to trace the blocked request in the logs. The maximum allowed size of response body is 4096 bytes after base64 encoding..",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(4096),
+						},
 					},
 					"response_code": schema.StringAttribute{
 						MarkdownDescription: "[Enum: EmptyStatusCode|Continue|OK|Created|Accepted|NonAuthoritativeInformation|NoContent|ResetContent|PartialContent|MultiStatus|AlreadyReported|IMUsed|MultipleChoices|MovedPermanently|Found|SeeOther|NotModified|UseProxy|TemporaryRedirect|PermanentRedirect|BadRequest|Unauthorized|PaymentRequired|Forbidden|NotFound|MethodNotAllowed|NotAcceptable|ProxyAuthenticationRequired|RequestTimeout|Conflict|Gone|LengthRequired|PreconditionFailed|PayloadTooLarge|URITooLong|UnsupportedMediaType|RangeNotSatisfiable|ExpectationFailed|MisdirectedRequest|UnprocessableEntity|Locked|FailedDependency|UpgradeRequired|PreconditionRequired|TooManyRequests|RequestHeaderFieldsTooLarge|InternalServerError|NotImplemented|BadGateway|ServiceUnavailable|GatewayTimeout|HTTPVersionNotSupported|VariantAlsoNegotiates|InsufficientStorage|LoopDetected|NotExtended|NetworkAuthenticationRequired] HTTP response status codes EmptyStatusCode response codes means it is not specified Continue status code OK status code Created status code Accepted status code Non Authoritative Information status code No Content status code Reset Content status code Partial Content status code Multi Status.. Possible values are `EmptyStatusCode`, `Continue`, `OK`, `Created`, `Accepted`, `NonAuthoritativeInformation`, `NoContent`, `ResetContent`, `PartialContent`, `MultiStatus`, `AlreadyReported`, `IMUsed`, `MultipleChoices`, `MovedPermanently`, `Found`, `SeeOther`, `NotModified`, `UseProxy`, `TemporaryRedirect`, `PermanentRedirect`, `BadRequest`, `Unauthorized`, `PaymentRequired`, `Forbidden`, `NotFound`, `MethodNotAllowed`, `NotAcceptable`, `ProxyAuthenticationRequired`, `RequestTimeout`, `Conflict`, `Gone`, `LengthRequired`, `PreconditionFailed`, `PayloadTooLarge`, `URITooLong`, `UnsupportedMediaType`, `RangeNotSatisfiable`, `ExpectationFailed`, `MisdirectedRequest`, `UnprocessableEntity`, `Locked`, `FailedDependency`, `UpgradeRequired`, `PreconditionRequired`, `TooManyRequests`, `RequestHeaderFieldsTooLarge`, `InternalServerError`, `NotImplemented`, `BadGateway`, `ServiceUnavailable`, `GatewayTimeout`, `HTTPVersionNotSupported`, `VariantAlsoNegotiates`, `InsufficientStorage`, `LoopDetected`, `NotExtended`, `NetworkAuthenticationRequired`. Defaults to `EmptyStatusCode`.",
@@ -408,6 +430,9 @@ func (r *AppFirewallResource) Schema(ctx context.Context, req resource.SchemaReq
 				Blocks: map[string]schema.Block{
 					"anonymization_config": schema.ListNestedBlock{
 						MarkdownDescription: "List of HTTP headers, cookies and query parameters whose values will be masked .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(64),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{},
 							Blocks: map[string]schema.Block{
@@ -417,6 +442,9 @@ func (r *AppFirewallResource) Schema(ctx context.Context, req resource.SchemaReq
 										"cookie_name": schema.StringAttribute{
 											MarkdownDescription: "Masks the cookie value. The setting does not mask the cookie name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 									},
 								},
@@ -435,6 +463,9 @@ func (r *AppFirewallResource) Schema(ctx context.Context, req resource.SchemaReq
 										"query_param_name": schema.StringAttribute{
 											MarkdownDescription: "Masks the query parameter value. The setting does not mask the query parameter name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 									},
 								},
@@ -505,6 +536,10 @@ func (r *AppFirewallResource) Schema(ctx context.Context, req resource.SchemaReq
 										MarkdownDescription: "[Enum: ATTACK_TYPE_NONE|ATTACK_TYPE_NON_BROWSER_CLIENT|ATTACK_TYPE_OTHER_APPLICATION_ATTACKS|ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE|ATTACK_TYPE_DETECTION_EVASION|ATTACK_TYPE_VULNERABILITY_SCAN|ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY|ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS|ATTACK_TYPE_BUFFER_OVERFLOW|ATTACK_TYPE_PREDICTABLE_RESOURCE_LOCATION|ATTACK_TYPE_INFORMATION_LEAKAGE|ATTACK_TYPE_DIRECTORY_INDEXING|ATTACK_TYPE_PATH_TRAVERSAL|ATTACK_TYPE_XPATH_INJECTION|ATTACK_TYPE_LDAP_INJECTION|ATTACK_TYPE_SERVER_SIDE_CODE_INJECTION|ATTACK_TYPE_COMMAND_EXECUTION|ATTACK_TYPE_SQL_INJECTION|ATTACK_TYPE_CROSS_SITE_SCRIPTING|ATTACK_TYPE_DENIAL_OF_SERVICE|ATTACK_TYPE_HTTP_PARSER_ATTACK|ATTACK_TYPE_SESSION_HIJACKING|ATTACK_TYPE_HTTP_RESPONSE_SPLITTING|ATTACK_TYPE_FORCEFUL_BROWSING|ATTACK_TYPE_REMOTE_FILE_INCLUDE|ATTACK_TYPE_MALICIOUS_FILE_UPLOAD|ATTACK_TYPE_GRAPHQL_PARSER_ATTACK] List of Attack Types that will be ignored and not trigger a detection . Possible values are `ATTACK_TYPE_NONE`, `ATTACK_TYPE_NON_BROWSER_CLIENT`, `ATTACK_TYPE_OTHER_APPLICATION_ATTACKS`, `ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE`, `ATTACK_TYPE_DETECTION_EVASION`, `ATTACK_TYPE_VULNERABILITY_SCAN`, `ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY`, `ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS`, `ATTACK_TYPE_BUFFER_OVERFLOW`, `ATTACK_TYPE_PREDICTABLE_RESOURCE_LOCATION`, `ATTACK_TYPE_INFORMATION_LEAKAGE`, `ATTACK_TYPE_DIRECTORY_INDEXING`, `ATTACK_TYPE_PATH_TRAVERSAL`, `ATTACK_TYPE_XPATH_INJECTION`, `ATTACK_TYPE_LDAP_INJECTION`, `ATTACK_TYPE_SERVER_SIDE_CODE_INJECTION`, `ATTACK_TYPE_COMMAND_EXECUTION`, `ATTACK_TYPE_SQL_INJECTION`, `ATTACK_TYPE_CROSS_SITE_SCRIPTING`, `ATTACK_TYPE_DENIAL_OF_SERVICE`, `ATTACK_TYPE_HTTP_PARSER_ATTACK`, `ATTACK_TYPE_SESSION_HIJACKING`, `ATTACK_TYPE_HTTP_RESPONSE_SPLITTING`, `ATTACK_TYPE_FORCEFUL_BROWSING`, `ATTACK_TYPE_REMOTE_FILE_INCLUDE`, `ATTACK_TYPE_MALICIOUS_FILE_UPLOAD`, `ATTACK_TYPE_GRAPHQL_PARSER_ATTACK`. Defaults to `ATTACK_TYPE_NONE`.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(22),
+											listvalidator.UniqueValues(),
+										},
 									},
 								},
 							},
@@ -528,6 +563,10 @@ func (r *AppFirewallResource) Schema(ctx context.Context, req resource.SchemaReq
 							"staging_period": schema.Int64Attribute{
 								MarkdownDescription: "Define staging period in days. The default staging period is 7 days and the max supported staging period is 20 days.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(20),
+								},
 							},
 						},
 					},
@@ -537,6 +576,10 @@ func (r *AppFirewallResource) Schema(ctx context.Context, req resource.SchemaReq
 							"staging_period": schema.Int64Attribute{
 								MarkdownDescription: "Define staging period in days. The default staging period is 7 days and the max supported staging period is 20 days.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(20),
+								},
 							},
 						},
 					},
@@ -547,6 +590,10 @@ func (r *AppFirewallResource) Schema(ctx context.Context, req resource.SchemaReq
 								MarkdownDescription: "[Enum: VIOL_NONE|VIOL_FILETYPE|VIOL_METHOD|VIOL_MANDATORY_HEADER|VIOL_HTTP_RESPONSE_STATUS|VIOL_REQUEST_MAX_LENGTH|VIOL_FILE_UPLOAD|VIOL_FILE_UPLOAD_IN_BODY|VIOL_XML_MALFORMED|VIOL_JSON_MALFORMED|VIOL_ASM_COOKIE_MODIFIED|VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS|VIOL_HTTP_PROTOCOL_BAD_HOST_HEADER_VALUE|VIOL_HTTP_PROTOCOL_UNPARSABLE_REQUEST_CONTENT|VIOL_HTTP_PROTOCOL_NULL_IN_REQUEST|VIOL_HTTP_PROTOCOL_BAD_HTTP_VERSION|VIOL_HTTP_PROTOCOL_SEVERAL_CONTENT_LENGTH_HEADERS|VIOL_EVASION_DIRECTORY_TRAVERSALS|VIOL_MALFORMED_REQUEST|VIOL_EVASION_MULTIPLE_DECODING|VIOL_DATA_GUARD|VIOL_EVASION_APACHE_WHITESPACE|VIOL_COOKIE_MODIFIED|VIOL_EVASION_IIS_UNICODE_CODEPOINTS|VIOL_EVASION_IIS_BACKSLASHES|VIOL_EVASION_PERCENT_U_DECODING|VIOL_EVASION_BARE_BYTE_DECODING|VIOL_EVASION_BAD_UNESCAPE|VIOL_HTTP_PROTOCOL_BODY_IN_GET_OR_HEAD_REQUEST|VIOL_ENCODING|VIOL_COOKIE_MALFORMED|VIOL_GRAPHQL_FORMAT|VIOL_GRAPHQL_MALFORMED|VIOL_GRAPHQL_INTROSPECTION_QUERY] List of violations to be excluded . Possible values are `VIOL_NONE`, `VIOL_FILETYPE`, `VIOL_METHOD`, `VIOL_MANDATORY_HEADER`, `VIOL_HTTP_RESPONSE_STATUS`, `VIOL_REQUEST_MAX_LENGTH`, `VIOL_FILE_UPLOAD`, `VIOL_FILE_UPLOAD_IN_BODY`, `VIOL_XML_MALFORMED`, `VIOL_JSON_MALFORMED`, `VIOL_ASM_COOKIE_MODIFIED`, `VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS`, `VIOL_HTTP_PROTOCOL_BAD_HOST_HEADER_VALUE`, `VIOL_HTTP_PROTOCOL_UNPARSABLE_REQUEST_CONTENT`, `VIOL_HTTP_PROTOCOL_NULL_IN_REQUEST`, `VIOL_HTTP_PROTOCOL_BAD_HTTP_VERSION`, `VIOL_HTTP_PROTOCOL_SEVERAL_CONTENT_LENGTH_HEADERS`, `VIOL_EVASION_DIRECTORY_TRAVERSALS`, `VIOL_MALFORMED_REQUEST`, `VIOL_EVASION_MULTIPLE_DECODING`, `VIOL_DATA_GUARD`, `VIOL_EVASION_APACHE_WHITESPACE`, `VIOL_COOKIE_MODIFIED`, `VIOL_EVASION_IIS_UNICODE_CODEPOINTS`, `VIOL_EVASION_IIS_BACKSLASHES`, `VIOL_EVASION_PERCENT_U_DECODING`, `VIOL_EVASION_BARE_BYTE_DECODING`, `VIOL_EVASION_BAD_UNESCAPE`, `VIOL_HTTP_PROTOCOL_BODY_IN_GET_OR_HEAD_REQUEST`, `VIOL_ENCODING`, `VIOL_COOKIE_MALFORMED`, `VIOL_GRAPHQL_FORMAT`, `VIOL_GRAPHQL_MALFORMED`, `VIOL_GRAPHQL_INTROSPECTION_QUERY`. Defaults to `VIOL_NONE`.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(40),
+									listvalidator.UniqueValues(),
+								},
 							},
 						},
 					},
@@ -565,6 +612,11 @@ func (r *AppFirewallResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *AppFirewallResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *AppFirewallResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -589,6 +641,27 @@ func (r *AppFirewallResource) ValidateConfig(ctx context.Context, req resource.V
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *AppFirewallResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "allowed_response_codes_choice", "allow_all_response_codes", "allowed_response_codes"),
+		validators.OneOfGroup(path.MatchRelative(), "anonymization_setting", "custom_anonymization", "default_anonymization", "disable_anonymization"),
+		validators.OneOfGroup(path.MatchRelative(), "blocking_page_choice", "blocking_page", "use_default_blocking_page"),
+		validators.OneOfGroup(path.MatchRelative(), "bot_protection_choice", "bot_protection_setting", "default_bot_setting"),
+		validators.OneOfGroup(path.MatchRelative(), "detection_setting_choice", "ai_risk_based_blocking", "default_detection_settings", "detection_settings"),
+		validators.OneOfGroup(path.MatchRelative(), "enforcement_mode_choice", "blocking", "monitoring"),
+		validators.OneOfGroup(path.MatchRoot("custom_anonymization").AtName("anonymization_config").AtAnyListIndex(), "anonymization_choice", "cookie", "http_header", "query_parameter"),
+		validators.OneOfGroup(path.MatchRoot("detection_settings"), "bot_protection_choice", "bot_protection_setting", "default_bot_setting"),
+		validators.OneOfGroup(path.MatchRoot("detection_settings"), "false_positive_suppression", "disable_suppression", "enable_suppression"),
+		validators.OneOfGroup(path.MatchRoot("detection_settings"), "signatures_staging_settings", "disable_staging", "stage_new_and_updated_signatures", "stage_new_signatures"),
+		validators.OneOfGroup(path.MatchRoot("detection_settings"), "threat_campaign_choice", "disable_threat_campaigns", "enable_threat_campaigns"),
+		validators.OneOfGroup(path.MatchRoot("detection_settings"), "violation_detection_setting", "default_violation_settings", "violation_settings"),
+		validators.OneOfGroup(path.MatchRoot("detection_settings").AtName("signature_selection_setting"), "attack_type_setting", "attack_type_settings", "default_attack_type_settings"),
+		validators.OneOfGroup(path.MatchRoot("detection_settings").AtName("signature_selection_setting"), "signature_selection_by_accuracy", "high_medium_accuracy_signatures", "high_medium_low_accuracy_signatures", "only_high_accuracy_signatures"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *AppFirewallResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -599,7 +672,10 @@ func (r *AppFirewallResource) ModifyPlan(ctx context.Context, req resource.Modif
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "app_firewall", "", req.Config, &resp.Plan)...)
 		var plan AppFirewallResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -656,6 +732,7 @@ func (r *AppFirewallResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -665,6 +742,7 @@ func (r *AppFirewallResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AiRiskBasedBlocking != nil {
@@ -805,6 +883,29 @@ func (r *AppFirewallResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		if data.DetectionSettings.SignatureSelectionSetting != nil {
 			signature_selection_settingNestedMap := make(map[string]interface{})
+			if data.DetectionSettings.SignatureSelectionSetting.AttackTypeSettings != nil {
+				attack_type_settingsLevel3Map := make(map[string]interface{})
+				if !data.DetectionSettings.SignatureSelectionSetting.AttackTypeSettings.DisabledAttackTypes.IsNull() && !data.DetectionSettings.SignatureSelectionSetting.AttackTypeSettings.DisabledAttackTypes.IsUnknown() {
+					var disabled_attack_typesLevel3Items []string
+					diags := data.DetectionSettings.SignatureSelectionSetting.AttackTypeSettings.DisabledAttackTypes.ElementsAs(ctx, &disabled_attack_typesLevel3Items, false)
+					if !diags.HasError() {
+						attack_type_settingsLevel3Map["disabled_attack_types"] = disabled_attack_typesLevel3Items
+					}
+				}
+				signature_selection_settingNestedMap["attack_type_settings"] = attack_type_settingsLevel3Map
+			}
+			if data.DetectionSettings.SignatureSelectionSetting.DefaultAttackTypeSettings != nil {
+				signature_selection_settingNestedMap["default_attack_type_settings"] = map[string]interface{}{}
+			}
+			if data.DetectionSettings.SignatureSelectionSetting.HighMediumAccuracySignatures != nil {
+				signature_selection_settingNestedMap["high_medium_accuracy_signatures"] = map[string]interface{}{}
+			}
+			if data.DetectionSettings.SignatureSelectionSetting.HighMediumLowAccuracySignatures != nil {
+				signature_selection_settingNestedMap["high_medium_low_accuracy_signatures"] = map[string]interface{}{}
+			}
+			if data.DetectionSettings.SignatureSelectionSetting.OnlyHighAccuracySignatures != nil {
+				signature_selection_settingNestedMap["only_high_accuracy_signatures"] = map[string]interface{}{}
+			}
 			detection_settingsMap["signature_selection_setting"] = signature_selection_settingNestedMap
 		}
 		if data.DetectionSettings.StageNewAndUpdatedSignatures != nil {
@@ -842,11 +943,15 @@ func (r *AppFirewallResource) Create(ctx context.Context, req resource.CreateReq
 
 	apiResource, err := r.client.CreateAppFirewall(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create AppFirewall: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "app_firewall", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1054,7 +1159,7 @@ func (r *AppFirewallResource) Read(ctx context.Context, req resource.ReadRequest
 	apiResource, err := r.client.GetAppFirewall(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "AppFirewall not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1062,11 +1167,12 @@ func (r *AppFirewallResource) Read(ctx context.Context, req resource.ReadRequest
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AppFirewall: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "app_firewall", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1077,31 +1183,13 @@ func (r *AppFirewallResource) Read(ctx context.Context, req resource.ReadRequest
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1109,6 +1197,7 @@ func (r *AppFirewallResource) Read(ctx context.Context, req resource.ReadRequest
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["ai_risk_based_blocking"].(map[string]interface{}); ok && (isImport || data.AiRiskBasedBlocking != nil) {
 		data.AiRiskBasedBlocking = &AppFirewallAiRiskBasedBlockingModel{
@@ -1328,6 +1417,7 @@ func (r *AppFirewallResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1337,6 +1427,7 @@ func (r *AppFirewallResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AiRiskBasedBlocking != nil {
@@ -1477,6 +1568,29 @@ func (r *AppFirewallResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		if data.DetectionSettings.SignatureSelectionSetting != nil {
 			signature_selection_settingNestedMap := make(map[string]interface{})
+			if data.DetectionSettings.SignatureSelectionSetting.AttackTypeSettings != nil {
+				attack_type_settingsLevel3Map := make(map[string]interface{})
+				if !data.DetectionSettings.SignatureSelectionSetting.AttackTypeSettings.DisabledAttackTypes.IsNull() && !data.DetectionSettings.SignatureSelectionSetting.AttackTypeSettings.DisabledAttackTypes.IsUnknown() {
+					var disabled_attack_typesLevel3Items []string
+					diags := data.DetectionSettings.SignatureSelectionSetting.AttackTypeSettings.DisabledAttackTypes.ElementsAs(ctx, &disabled_attack_typesLevel3Items, false)
+					if !diags.HasError() {
+						attack_type_settingsLevel3Map["disabled_attack_types"] = disabled_attack_typesLevel3Items
+					}
+				}
+				signature_selection_settingNestedMap["attack_type_settings"] = attack_type_settingsLevel3Map
+			}
+			if data.DetectionSettings.SignatureSelectionSetting.DefaultAttackTypeSettings != nil {
+				signature_selection_settingNestedMap["default_attack_type_settings"] = map[string]interface{}{}
+			}
+			if data.DetectionSettings.SignatureSelectionSetting.HighMediumAccuracySignatures != nil {
+				signature_selection_settingNestedMap["high_medium_accuracy_signatures"] = map[string]interface{}{}
+			}
+			if data.DetectionSettings.SignatureSelectionSetting.HighMediumLowAccuracySignatures != nil {
+				signature_selection_settingNestedMap["high_medium_low_accuracy_signatures"] = map[string]interface{}{}
+			}
+			if data.DetectionSettings.SignatureSelectionSetting.OnlyHighAccuracySignatures != nil {
+				signature_selection_settingNestedMap["only_high_accuracy_signatures"] = map[string]interface{}{}
+			}
 			detection_settingsMap["signature_selection_setting"] = signature_selection_settingNestedMap
 		}
 		if data.DetectionSettings.StageNewAndUpdatedSignatures != nil {
@@ -1514,7 +1628,7 @@ func (r *AppFirewallResource) Update(ctx context.Context, req resource.UpdateReq
 
 	_, err := r.client.UpdateAppFirewall(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update AppFirewall: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "app_firewall", "update"))
 		return
 	}
 
@@ -1525,9 +1639,12 @@ func (r *AppFirewallResource) Update(ctx context.Context, req resource.UpdateReq
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetAppFirewall(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AppFirewall after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "app_firewall", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1735,7 +1852,7 @@ func (r *AppFirewallResource) Delete(ctx context.Context, req resource.DeleteReq
 	err := r.client.DeleteAppFirewall(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "AppFirewall already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1744,37 +1861,33 @@ func (r *AppFirewallResource) Delete(ctx context.Context, req resource.DeleteReq
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "AppFirewall delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete AppFirewall: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "app_firewall", "delete"))
 		return
 	}
 }
 
 func (r *AppFirewallResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *AppFirewallResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("app_firewall", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *AppFirewallResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListAppFirewalls(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *AppFirewallResource) requiredNamespace() string {
+	return ""
 }
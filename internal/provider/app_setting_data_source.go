@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,18 @@ type AppSettingDataSource struct {
 	client *client.Client
 }
 
+// AppSettingDataSourceModel mirrors AppSettingResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type AppSettingDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata  types.Object `tfsdk:"system_metadata"`
+	Name            types.String `tfsdk:"name"`
+	Namespace       types.String `tfsdk:"namespace"`
+	Annotations     types.Map    `tfsdk:"annotations"`
+	Description     types.String `tfsdk:"description"`
+	Disable         types.Bool   `tfsdk:"disable"`
+	Labels          types.Map    `tfsdk:"labels"`
+	ID              types.String `tfsdk:"id"`
+	AppTypeSettings types.List   `tfsdk:"app_type_settings"`
 }
 
 func (d *AppSettingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +45,7 @@ func (d *AppSettingDataSource) Metadata(ctx context.Context, req datasource.Meta
 }
 
 func (d *AppSettingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages App setting configuration in namespace metadata.namespace. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the AppSetting.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the AppSetting exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the AppSetting.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewAppSettingResource())
 }
 
 func (d *AppSettingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +67,25 @@ func (d *AppSettingDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	resource, err := d.client.GetAppSetting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetAppSetting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AppSetting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "app_setting", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +99,8 @@ func (d *AppSettingDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +109,125 @@ func (d *AppSettingDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if listData, ok := apiResource.Spec["app_type_settings"].([]interface{}); ok && len(listData) > 0 {
+		var app_type_settingsList []AppSettingAppTypeSettingsModel
+		var existingAppTypeSettingsItems []AppSettingAppTypeSettingsModel
+		if !data.AppTypeSettings.IsNull() && !data.AppTypeSettings.IsUnknown() {
+			data.AppTypeSettings.ElementsAs(ctx, &existingAppTypeSettingsItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				app_type_settingsList = append(app_type_settingsList, AppSettingAppTypeSettingsModel{
+					AppTypeRef: func() []AppSettingAppTypeSettingsAppTypeRefModel {
+						if nestedListData, ok := itemMap["app_type_ref"].([]interface{}); ok && len(nestedListData) > 0 {
+							var result []AppSettingAppTypeSettingsAppTypeRefModel
+							for _, nestedItem := range nestedListData {
+								if nestedItemMap, ok := nestedItem.(map[string]interface{}); ok {
+									result = append(result, AppSettingAppTypeSettingsAppTypeRefModel{
+										Kind: func() types.String {
+											if v, ok := nestedItemMap["kind"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+										Name: func() types.String {
+											if v, ok := nestedItemMap["name"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+										Namespace: func() types.String {
+											if v, ok := nestedItemMap["namespace"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+										Tenant: func() types.String {
+											if v, ok := nestedItemMap["tenant"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+										Uid: func() types.String {
+											if v, ok := nestedItemMap["uid"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+									})
+								}
+							}
+							return result
+						}
+						return nil
+					}(),
+					BusinessLogicMarkupSetting: func() *AppSettingAppTypeSettingsBusinessLogicMarkupSettingModel {
+						if _, ok := itemMap["business_logic_markup_setting"].(map[string]interface{}); ok {
+							return &AppSettingAppTypeSettingsBusinessLogicMarkupSettingModel{
+								Disable: func() *AppSettingEmptyModel {
+									if !isImport && len(existingAppTypeSettingsItems) > listIdx && existingAppTypeSettingsItems[listIdx].BusinessLogicMarkupSetting != nil && existingAppTypeSettingsItems[listIdx].BusinessLogicMarkupSetting.Disable != nil {
+										return &AppSettingEmptyModel{}
+									}
+									return nil
+								}(),
+								Enable: func() *AppSettingEmptyModel {
+									if !isImport && len(existingAppTypeSettingsItems) > listIdx && existingAppTypeSettingsItems[listIdx].BusinessLogicMarkupSetting != nil && existingAppTypeSettingsItems[listIdx].BusinessLogicMarkupSetting.Enable != nil {
+										return &AppSettingEmptyModel{}
+									}
+									return nil
+								}(),
+							}
+						}
+						return nil
+					}(),
+					TimeseriesAnalysesSetting: func() *AppSettingAppTypeSettingsTimeseriesAnalysesSettingModel {
+						if _, ok := itemMap["timeseries_analyses_setting"].(map[string]interface{}); ok {
+							return &AppSettingAppTypeSettingsTimeseriesAnalysesSettingModel{}
+						}
+						return nil
+					}(),
+					UserBehaviorAnalysisSetting: func() *AppSettingAppTypeSettingsUserBehaviorAnalysisSettingModel {
+						if _, ok := itemMap["user_behavior_analysis_setting"].(map[string]interface{}); ok {
+							return &AppSettingAppTypeSettingsUserBehaviorAnalysisSettingModel{
+								DisableDetection: func() *AppSettingEmptyModel {
+									if !isImport && len(existingAppTypeSettingsItems) > listIdx && existingAppTypeSettingsItems[listIdx].UserBehaviorAnalysisSetting != nil && existingAppTypeSettingsItems[listIdx].UserBehaviorAnalysisSetting.DisableDetection != nil {
+										return &AppSettingEmptyModel{}
+									}
+									return nil
+								}(),
+								DisableLearning: func() *AppSettingEmptyModel {
+									if !isImport && len(existingAppTypeSettingsItems) > listIdx && existingAppTypeSettingsItems[listIdx].UserBehaviorAnalysisSetting != nil && existingAppTypeSettingsItems[listIdx].UserBehaviorAnalysisSetting.DisableLearning != nil {
+										return &AppSettingEmptyModel{}
+									}
+									return nil
+								}(),
+								EnableLearning: func() *AppSettingEmptyModel {
+									if !isImport && len(existingAppTypeSettingsItems) > listIdx && existingAppTypeSettingsItems[listIdx].UserBehaviorAnalysisSetting != nil && existingAppTypeSettingsItems[listIdx].UserBehaviorAnalysisSetting.EnableLearning != nil {
+										return &AppSettingEmptyModel{}
+									}
+									return nil
+								}(),
+							}
+						}
+						return nil
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: AppSettingAppTypeSettingsModelAttrTypes}, app_type_settingsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.AppTypeSettings = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.AppTypeSettings = types.ListNull(types.ObjectType{AttrTypes: AppSettingAppTypeSettingsModelAttrTypes})
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
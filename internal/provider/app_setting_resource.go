@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &AppSettingResource{}
-	_ resource.ResourceWithConfigure      = &AppSettingResource{}
-	_ resource.ResourceWithImportState    = &AppSettingResource{}
-	_ resource.ResourceWithModifyPlan     = &AppSettingResource{}
-	_ resource.ResourceWithValidateConfig = &AppSettingResource{}
+	_ resource.Resource                     = &AppSettingResource{}
+	_ resource.ResourceWithConfigure        = &AppSettingResource{}
+	_ resource.ResourceWithImportState      = &AppSettingResource{}
+	_ resource.ResourceWithIdentity         = &AppSettingResource{}
+	_ resource.ResourceWithMoveState        = &AppSettingResource{}
+	_ resource.ResourceWithModifyPlan       = &AppSettingResource{}
+	_ resource.ResourceWithValidateConfig   = &AppSettingResource{}
+	_ resource.ResourceWithConfigValidators = &AppSettingResource{}
+	_ listableResource                      = &AppSettingResource{}
 )
 
 func NewAppSettingResource() resource.Resource {
@@ -226,6 +232,8 @@ type AppSettingResourceModel struct {
 	Labels          types.Map      `tfsdk:"labels"`
 	ID              types.String   `tfsdk:"id"`
 	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	SystemMetadata  types.Object   `tfsdk:"system_metadata"`
+	LabelsAll       types.Map      `tfsdk:"labels_all"`
 	AppTypeSettings types.List     `tfsdk:"app_type_settings"`
 }
 
@@ -248,9 +256,11 @@ func (r *AppSettingResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the App Setting will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the App Setting will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -282,6 +292,8 @@ func (r *AppSettingResource) Schema(ctx context.Context, req resource.SchemaRequ
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -292,11 +304,18 @@ func (r *AppSettingResource) Schema(ctx context.Context, req resource.SchemaRequ
 			}),
 			"app_type_settings": schema.ListNestedBlock{
 				MarkdownDescription: "List of settings to enable for each AppType, given instance of AppType Exist in this Namespace .",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(16),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{},
 					Blocks: map[string]schema.Block{
 						"app_type_ref": schema.ListNestedBlock{
 							MarkdownDescription: "The AppType of App instance in current Namespace. Associating an AppType reference, will enable analysis on this instance's generated data .",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
 									"kind": schema.StringAttribute{
@@ -362,6 +381,9 @@ func (r *AppSettingResource) Schema(ctx context.Context, req resource.SchemaRequ
 												MarkdownDescription: "[Enum: NO_METRICS|REQUEST_RATE|ERROR_RATE|LATENCY|THROUGHPUT] Choose one or more metrics to be included in the detection logic. Possible values are `NO_METRICS`, `REQUEST_RATE`, `ERROR_RATE`, `LATENCY`, `THROUGHPUT`. Defaults to `NO_METRICS`.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"metrics_source": schema.StringAttribute{
 												MarkdownDescription: "[Enum: NONE|NODES|EDGES|VIRTUAL_HOSTS] Supported sources from which Metrics can be analyzed All edges in the service mesh graph. Metrics are analyzed separately between all source and destination service combinations. Possible values are `NONE`, `NODES`, `EDGES`, `VIRTUAL_HOSTS`.",
@@ -388,6 +410,10 @@ func (r *AppSettingResource) Schema(ctx context.Context, req resource.SchemaRequ
 										"cooling_off_period": schema.Int64Attribute{
 											MarkdownDescription: "Malicious user detection assigns a threat level to each user based on their activity. Once a threat level is assigned, the system continues tracking activity from this user and if no further malicious activity is seen, it gradually reduces the threat assesment to lower levels..",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(5),
+												int64validator.AtMost(120),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -427,6 +453,9 @@ func (r *AppSettingResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"login_failures_threshold": schema.Int64Attribute{
 													MarkdownDescription: "The number of failed logins beyond which the system will flag this user as malicious .",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+													},
 												},
 											},
 										},
@@ -436,6 +465,9 @@ func (r *AppSettingResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"forbidden_requests_threshold": schema.Int64Attribute{
 													MarkdownDescription: "The number of forbidden requests beyond which the system will flag this user as malicious .",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+													},
 												},
 											},
 										},
@@ -463,6 +495,10 @@ func (r *AppSettingResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"nonexistent_requests_threshold": schema.Int64Attribute{
 													MarkdownDescription: "The percentage of non-existent requests beyond which the system will flag this user as malicious .",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(100),
+													},
 												},
 											},
 										},
@@ -486,6 +522,11 @@ func (r *AppSettingResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *AppSettingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *AppSettingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -510,6 +551,25 @@ func (r *AppSettingResource) ValidateConfig(ctx context.Context, req resource.Va
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *AppSettingResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("app_type_settings").AtAnyListIndex().AtName("business_logic_markup_setting"), "learn_from_namespace", "disable", "enable"),
+		validators.OneOfGroup(path.MatchRoot("app_type_settings").AtAnyListIndex().AtName("user_behavior_analysis_setting"), "learn_from_namespace", "disable_learning", "enable_learning"),
+		validators.OneOfGroup(path.MatchRoot("app_type_settings").AtAnyListIndex().AtName("user_behavior_analysis_setting"), "malicious_user_detection", "disable_detection", "enable_detection"),
+		validators.OneOfGroup(path.MatchRoot("app_type_settings").AtAnyListIndex().AtName("user_behavior_analysis_setting").AtName("enable_detection"), "bola_activity_choice", "bola_detection_automatic", "exclude_bola_detection"),
+		validators.OneOfGroup(path.MatchRoot("app_type_settings").AtAnyListIndex().AtName("user_behavior_analysis_setting").AtName("enable_detection"), "bot_defense_activity_choice", "exclude_bot_defense_activity", "include_bot_defense_activity"),
+		validators.OneOfGroup(path.MatchRoot("app_type_settings").AtAnyListIndex().AtName("user_behavior_analysis_setting").AtName("enable_detection"), "failed_login_activity_choice", "exclude_failed_login_activity", "include_failed_login_activity"),
+		validators.OneOfGroup(path.MatchRoot("app_type_settings").AtAnyListIndex().AtName("user_behavior_analysis_setting").AtName("enable_detection"), "forbidden_activity_choice", "exclude_forbidden_activity", "include_forbidden_activity"),
+		validators.OneOfGroup(path.MatchRoot("app_type_settings").AtAnyListIndex().AtName("user_behavior_analysis_setting").AtName("enable_detection"), "ip_reputation_choice", "exclude_ip_reputation", "include_ip_reputation"),
+		validators.OneOfGroup(path.MatchRoot("app_type_settings").AtAnyListIndex().AtName("user_behavior_analysis_setting").AtName("enable_detection"), "non_existent_url_activity_choice", "exclude_non_existent_url_activity", "include_non_existent_url_activity_automatic", "include_non_existent_url_activity_custom"),
+		validators.OneOfGroup(path.MatchRoot("app_type_settings").AtAnyListIndex().AtName("user_behavior_analysis_setting").AtName("enable_detection"), "rate_limit_choice", "exclude_rate_limit", "include_rate_limit"),
+		validators.OneOfGroup(path.MatchRoot("app_type_settings").AtAnyListIndex().AtName("user_behavior_analysis_setting").AtName("enable_detection"), "waf_activity_choice", "exclude_waf_activity", "include_waf_activity"),
+		validators.OneOfGroup(path.MatchRoot("app_type_settings").AtAnyListIndex().AtName("user_behavior_analysis_setting").AtName("enable_detection").AtName("include_non_existent_url_activity_automatic"), "sensitivity", "high", "low", "medium"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *AppSettingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -520,7 +580,10 @@ func (r *AppSettingResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "app_setting", "", req.Config, &resp.Plan)...)
 		var plan AppSettingResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -577,6 +640,7 @@ func (r *AppSettingResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -586,6 +650,7 @@ func (r *AppSettingResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.AppTypeSettings.IsNull() && !data.AppTypeSettings.IsUnknown() {
@@ -687,9 +752,43 @@ func (r *AppSettingResource) Create(ctx context.Context, req resource.CreateRequ
 						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeBotDefenseActivity != nil {
 							enable_detectionDeepMap["include_bot_defense_activity"] = map[string]interface{}{}
 						}
+						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeFailedLoginActivity != nil {
+							include_failed_login_activityLevel4Map := make(map[string]interface{})
+							if !item.UserBehaviorAnalysisSetting.EnableDetection.IncludeFailedLoginActivity.LoginFailuresThreshold.IsNull() && !item.UserBehaviorAnalysisSetting.EnableDetection.IncludeFailedLoginActivity.LoginFailuresThreshold.IsUnknown() {
+								include_failed_login_activityLevel4Map["login_failures_threshold"] = item.UserBehaviorAnalysisSetting.EnableDetection.IncludeFailedLoginActivity.LoginFailuresThreshold.ValueInt64()
+							}
+							enable_detectionDeepMap["include_failed_login_activity"] = include_failed_login_activityLevel4Map
+						}
+						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeForbiddenActivity != nil {
+							include_forbidden_activityLevel4Map := make(map[string]interface{})
+							if !item.UserBehaviorAnalysisSetting.EnableDetection.IncludeForbiddenActivity.ForbiddenRequestsThreshold.IsNull() && !item.UserBehaviorAnalysisSetting.EnableDetection.IncludeForbiddenActivity.ForbiddenRequestsThreshold.IsUnknown() {
+								include_forbidden_activityLevel4Map["forbidden_requests_threshold"] = item.UserBehaviorAnalysisSetting.EnableDetection.IncludeForbiddenActivity.ForbiddenRequestsThreshold.ValueInt64()
+							}
+							enable_detectionDeepMap["include_forbidden_activity"] = include_forbidden_activityLevel4Map
+						}
 						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeIPReputation != nil {
 							enable_detectionDeepMap["include_ip_reputation"] = map[string]interface{}{}
 						}
+						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityAutomatic != nil {
+							include_non_existent_url_activity_automaticLevel4Map := make(map[string]interface{})
+							if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityAutomatic.High != nil {
+								include_non_existent_url_activity_automaticLevel4Map["high"] = map[string]interface{}{}
+							}
+							if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityAutomatic.Low != nil {
+								include_non_existent_url_activity_automaticLevel4Map["low"] = map[string]interface{}{}
+							}
+							if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityAutomatic.Medium != nil {
+								include_non_existent_url_activity_automaticLevel4Map["medium"] = map[string]interface{}{}
+							}
+							enable_detectionDeepMap["include_non_existent_url_activity_automatic"] = include_non_existent_url_activity_automaticLevel4Map
+						}
+						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityCustom != nil {
+							include_non_existent_url_activity_customLevel4Map := make(map[string]interface{})
+							if !item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityCustom.NonexistentRequestsThreshold.IsNull() && !item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityCustom.NonexistentRequestsThreshold.IsUnknown() {
+								include_non_existent_url_activity_customLevel4Map["nonexistent_requests_threshold"] = item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityCustom.NonexistentRequestsThreshold.ValueInt64()
+							}
+							enable_detectionDeepMap["include_non_existent_url_activity_custom"] = include_non_existent_url_activity_customLevel4Map
+						}
 						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeRateLimit != nil {
 							enable_detectionDeepMap["include_rate_limit"] = map[string]interface{}{}
 						}
@@ -711,11 +810,15 @@ func (r *AppSettingResource) Create(ctx context.Context, req resource.CreateRequ
 
 	apiResource, err := r.client.CreateAppSetting(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create AppSetting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "app_setting", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -860,7 +963,7 @@ func (r *AppSettingResource) Read(ctx context.Context, req resource.ReadRequest,
 	apiResource, err := r.client.GetAppSetting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "AppSetting not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -868,11 +971,12 @@ func (r *AppSettingResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AppSetting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "app_setting", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -883,31 +987,13 @@ func (r *AppSettingResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -915,6 +1001,7 @@ func (r *AppSettingResource) Read(ctx context.Context, req resource.ReadRequest,
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if listData, ok := apiResource.Spec["app_type_settings"].([]interface{}); ok && len(listData) > 0 {
 		var app_type_settingsList []AppSettingAppTypeSettingsModel
@@ -1071,6 +1158,7 @@ func (r *AppSettingResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1080,6 +1168,7 @@ func (r *AppSettingResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.AppTypeSettings.IsNull() && !data.AppTypeSettings.IsUnknown() {
@@ -1181,9 +1270,43 @@ func (r *AppSettingResource) Update(ctx context.Context, req resource.UpdateRequ
 						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeBotDefenseActivity != nil {
 							enable_detectionDeepMap["include_bot_defense_activity"] = map[string]interface{}{}
 						}
+						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeFailedLoginActivity != nil {
+							include_failed_login_activityLevel4Map := make(map[string]interface{})
+							if !item.UserBehaviorAnalysisSetting.EnableDetection.IncludeFailedLoginActivity.LoginFailuresThreshold.IsNull() && !item.UserBehaviorAnalysisSetting.EnableDetection.IncludeFailedLoginActivity.LoginFailuresThreshold.IsUnknown() {
+								include_failed_login_activityLevel4Map["login_failures_threshold"] = item.UserBehaviorAnalysisSetting.EnableDetection.IncludeFailedLoginActivity.LoginFailuresThreshold.ValueInt64()
+							}
+							enable_detectionDeepMap["include_failed_login_activity"] = include_failed_login_activityLevel4Map
+						}
+						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeForbiddenActivity != nil {
+							include_forbidden_activityLevel4Map := make(map[string]interface{})
+							if !item.UserBehaviorAnalysisSetting.EnableDetection.IncludeForbiddenActivity.ForbiddenRequestsThreshold.IsNull() && !item.UserBehaviorAnalysisSetting.EnableDetection.IncludeForbiddenActivity.ForbiddenRequestsThreshold.IsUnknown() {
+								include_forbidden_activityLevel4Map["forbidden_requests_threshold"] = item.UserBehaviorAnalysisSetting.EnableDetection.IncludeForbiddenActivity.ForbiddenRequestsThreshold.ValueInt64()
+							}
+							enable_detectionDeepMap["include_forbidden_activity"] = include_forbidden_activityLevel4Map
+						}
 						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeIPReputation != nil {
 							enable_detectionDeepMap["include_ip_reputation"] = map[string]interface{}{}
 						}
+						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityAutomatic != nil {
+							include_non_existent_url_activity_automaticLevel4Map := make(map[string]interface{})
+							if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityAutomatic.High != nil {
+								include_non_existent_url_activity_automaticLevel4Map["high"] = map[string]interface{}{}
+							}
+							if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityAutomatic.Low != nil {
+								include_non_existent_url_activity_automaticLevel4Map["low"] = map[string]interface{}{}
+							}
+							if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityAutomatic.Medium != nil {
+								include_non_existent_url_activity_automaticLevel4Map["medium"] = map[string]interface{}{}
+							}
+							enable_detectionDeepMap["include_non_existent_url_activity_automatic"] = include_non_existent_url_activity_automaticLevel4Map
+						}
+						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityCustom != nil {
+							include_non_existent_url_activity_customLevel4Map := make(map[string]interface{})
+							if !item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityCustom.NonexistentRequestsThreshold.IsNull() && !item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityCustom.NonexistentRequestsThreshold.IsUnknown() {
+								include_non_existent_url_activity_customLevel4Map["nonexistent_requests_threshold"] = item.UserBehaviorAnalysisSetting.EnableDetection.IncludeNonExistentURLActivityCustom.NonexistentRequestsThreshold.ValueInt64()
+							}
+							enable_detectionDeepMap["include_non_existent_url_activity_custom"] = include_non_existent_url_activity_customLevel4Map
+						}
 						if item.UserBehaviorAnalysisSetting.EnableDetection.IncludeRateLimit != nil {
 							enable_detectionDeepMap["include_rate_limit"] = map[string]interface{}{}
 						}
@@ -1205,7 +1328,7 @@ func (r *AppSettingResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	_, err := r.client.UpdateAppSetting(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update AppSetting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "app_setting", "update"))
 		return
 	}
 
@@ -1216,9 +1339,12 @@ func (r *AppSettingResource) Update(ctx context.Context, req resource.UpdateRequ
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetAppSetting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read AppSetting after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "app_setting", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1363,7 +1489,7 @@ func (r *AppSettingResource) Delete(ctx context.Context, req resource.DeleteRequ
 	err := r.client.DeleteAppSetting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "AppSetting already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1372,37 +1498,33 @@ func (r *AppSettingResource) Delete(ctx context.Context, req resource.DeleteRequ
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "AppSetting delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete AppSetting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "app_setting", "delete"))
 		return
 	}
 }
 
 func (r *AppSettingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *AppSettingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("app_setting", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *AppSettingResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListAppSettings(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *AppSettingResource) requiredNamespace() string {
+	return ""
 }
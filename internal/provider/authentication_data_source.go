@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,19 @@ type AuthenticationDataSource struct {
 	client *client.Client
 }
 
+// AuthenticationDataSourceModel mirrors AuthenticationResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type AuthenticationDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object                     `tfsdk:"system_metadata"`
+	Name           types.String                     `tfsdk:"name"`
+	Namespace      types.String                     `tfsdk:"namespace"`
+	Annotations    types.Map                        `tfsdk:"annotations"`
+	Description    types.String                     `tfsdk:"description"`
+	Disable        types.Bool                       `tfsdk:"disable"`
+	Labels         types.Map                        `tfsdk:"labels"`
+	ID             types.String                     `tfsdk:"id"`
+	CookieParams   *AuthenticationCookieParamsModel `tfsdk:"cookie_params"`
+	OIDCAuth       *AuthenticationOIDCAuthModel     `tfsdk:"oidc_auth"`
 }
 
 func (d *AuthenticationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +46,7 @@ func (d *AuthenticationDataSource) Metadata(ctx context.Context, req datasource.
 }
 
 func (d *AuthenticationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Authentication resource in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the Authentication.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Authentication exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the Authentication.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewAuthenticationResource())
 }
 
 func (d *AuthenticationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +68,25 @@ func (d *AuthenticationDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	resource, err := d.client.GetAuthentication(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetAuthentication(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Authentication: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "authentication", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +100,8 @@ func (d *AuthenticationDataSource) Read(ctx context.Context, req datasource.Read
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +110,157 @@ func (d *AuthenticationDataSource) Read(ctx context.Context, req datasource.Read
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["cookie_params"].(map[string]interface{}); ok && (isImport || data.CookieParams != nil) {
+		data.CookieParams = &AuthenticationCookieParamsModel{
+			AuthHMAC: func() *AuthenticationCookieParamsAuthHMACModel {
+				if !isImport && data.CookieParams != nil && data.CookieParams.AuthHMAC != nil {
+					// Normal Read: preserve existing state value
+					return data.CookieParams.AuthHMAC
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["auth_hmac"].(map[string]interface{}); ok {
+					return &AuthenticationCookieParamsAuthHMACModel{
+						PrimKeyExpiry: func() types.String {
+							if v, ok := nestedBlockData["prim_key_expiry"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						SecKeyExpiry: func() types.String {
+							if v, ok := nestedBlockData["sec_key_expiry"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			CookieExpiry: func() types.Int64 {
+				if !isImport && data.CookieParams != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.CookieParams.CookieExpiry
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["cookie_expiry"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			CookieRefreshInterval: func() types.Int64 {
+				if !isImport && data.CookieParams != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.CookieParams.CookieRefreshInterval
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["cookie_refresh_interval"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			KmsKeyHMAC: func() *AuthenticationEmptyModel {
+				if !isImport && data.CookieParams != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.CookieParams.KmsKeyHMAC
+				}
+				// Import case: read from API
+				if _, ok := blockData["kms_key_hmac"].(map[string]interface{}); ok {
+					return &AuthenticationEmptyModel{}
+				}
+				return nil
+			}(),
+			SessionExpiry: func() types.Int64 {
+				if !isImport && data.CookieParams != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.CookieParams.SessionExpiry
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["session_expiry"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["oidc_auth"].(map[string]interface{}); ok && (isImport || data.OIDCAuth != nil) {
+		data.OIDCAuth = &AuthenticationOIDCAuthModel{
+			ClientSecret: func() *AuthenticationOIDCAuthClientSecretModel {
+				if !isImport && data.OIDCAuth != nil && data.OIDCAuth.ClientSecret != nil {
+					// Normal Read: preserve existing state value
+					return data.OIDCAuth.ClientSecret
+				}
+				// Import case: read from API
+				if _, ok := blockData["client_secret"].(map[string]interface{}); ok {
+					return &AuthenticationOIDCAuthClientSecretModel{}
+				}
+				return nil
+			}(),
+			OIDCAuthParams: func() *AuthenticationOIDCAuthOIDCAuthParamsModel {
+				if !isImport && data.OIDCAuth != nil && data.OIDCAuth.OIDCAuthParams != nil {
+					// Normal Read: preserve existing state value
+					return data.OIDCAuth.OIDCAuthParams
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["oidc_auth_params"].(map[string]interface{}); ok {
+					return &AuthenticationOIDCAuthOIDCAuthParamsModel{
+						AuthEndpointURL: func() types.String {
+							if v, ok := nestedBlockData["auth_endpoint_url"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						EndSessionEndpointURL: func() types.String {
+							if v, ok := nestedBlockData["end_session_endpoint_url"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						TokenEndpointURL: func() types.String {
+							if v, ok := nestedBlockData["token_endpoint_url"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			OIDCClientID: func() types.String {
+				if v, ok := blockData["oidc_client_id"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			OIDCWellKnownConfigURL: func() types.String {
+				if v, ok := blockData["oidc_well_known_config_url"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewAuthenticationResource(), &data)...)
 }
@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &AuthenticationResource{}
-	_ resource.ResourceWithConfigure      = &AuthenticationResource{}
-	_ resource.ResourceWithImportState    = &AuthenticationResource{}
-	_ resource.ResourceWithModifyPlan     = &AuthenticationResource{}
-	_ resource.ResourceWithValidateConfig = &AuthenticationResource{}
+	_ resource.Resource                     = &AuthenticationResource{}
+	_ resource.ResourceWithConfigure        = &AuthenticationResource{}
+	_ resource.ResourceWithImportState      = &AuthenticationResource{}
+	_ resource.ResourceWithIdentity         = &AuthenticationResource{}
+	_ resource.ResourceWithMoveState        = &AuthenticationResource{}
+	_ resource.ResourceWithModifyPlan       = &AuthenticationResource{}
+	_ resource.ResourceWithValidateConfig   = &AuthenticationResource{}
+	_ resource.ResourceWithConfigValidators = &AuthenticationResource{}
+	_ listableResource                      = &AuthenticationResource{}
 )
 
 func NewAuthenticationResource() resource.Resource {
@@ -107,14 +113,16 @@ var AuthenticationCookieParamsAuthHMACPrimKeyBlindfoldSecretInfoModelAttrTypes =
 
 // AuthenticationCookieParamsAuthHMACPrimKeyClearSecretInfoModel represents clear_secret_info block
 type AuthenticationCookieParamsAuthHMACPrimKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // AuthenticationCookieParamsAuthHMACPrimKeyClearSecretInfoModelAttrTypes defines the attribute types for AuthenticationCookieParamsAuthHMACPrimKeyClearSecretInfoModel
 var AuthenticationCookieParamsAuthHMACPrimKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // AuthenticationCookieParamsAuthHMACSecKeyModel represents sec_key block
@@ -145,14 +153,16 @@ var AuthenticationCookieParamsAuthHMACSecKeyBlindfoldSecretInfoModelAttrTypes =
 
 // AuthenticationCookieParamsAuthHMACSecKeyClearSecretInfoModel represents clear_secret_info block
 type AuthenticationCookieParamsAuthHMACSecKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // AuthenticationCookieParamsAuthHMACSecKeyClearSecretInfoModelAttrTypes defines the attribute types for AuthenticationCookieParamsAuthHMACSecKeyClearSecretInfoModel
 var AuthenticationCookieParamsAuthHMACSecKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // AuthenticationOIDCAuthModel represents oidc_auth block
@@ -199,14 +209,16 @@ var AuthenticationOIDCAuthClientSecretBlindfoldSecretInfoModelAttrTypes = map[st
 
 // AuthenticationOIDCAuthClientSecretClearSecretInfoModel represents clear_secret_info block
 type AuthenticationOIDCAuthClientSecretClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // AuthenticationOIDCAuthClientSecretClearSecretInfoModelAttrTypes defines the attribute types for AuthenticationOIDCAuthClientSecretClearSecretInfoModel
 var AuthenticationOIDCAuthClientSecretClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // AuthenticationOIDCAuthOIDCAuthParamsModel represents oidc_auth_params block
@@ -224,16 +236,18 @@ var AuthenticationOIDCAuthOIDCAuthParamsModelAttrTypes = map[string]attr.Type{
 }
 
 type AuthenticationResourceModel struct {
-	Name         types.String                     `tfsdk:"name"`
-	Namespace    types.String                     `tfsdk:"namespace"`
-	Annotations  types.Map                        `tfsdk:"annotations"`
-	Description  types.String                     `tfsdk:"description"`
-	Disable      types.Bool                       `tfsdk:"disable"`
-	Labels       types.Map                        `tfsdk:"labels"`
-	ID           types.String                     `tfsdk:"id"`
-	Timeouts     timeouts.Value                   `tfsdk:"timeouts"`
-	CookieParams *AuthenticationCookieParamsModel `tfsdk:"cookie_params"`
-	OIDCAuth     *AuthenticationOIDCAuthModel     `tfsdk:"oidc_auth"`
+	Name           types.String                     `tfsdk:"name"`
+	Namespace      types.String                     `tfsdk:"namespace"`
+	Annotations    types.Map                        `tfsdk:"annotations"`
+	Description    types.String                     `tfsdk:"description"`
+	Disable        types.Bool                       `tfsdk:"disable"`
+	Labels         types.Map                        `tfsdk:"labels"`
+	ID             types.String                     `tfsdk:"id"`
+	Timeouts       timeouts.Value                   `tfsdk:"timeouts"`
+	SystemMetadata types.Object                     `tfsdk:"system_metadata"`
+	LabelsAll      types.Map                        `tfsdk:"labels_all"`
+	CookieParams   *AuthenticationCookieParamsModel `tfsdk:"cookie_params"`
+	OIDCAuth       *AuthenticationOIDCAuthModel     `tfsdk:"oidc_auth"`
 }
 
 func (r *AuthenticationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -255,9 +269,11 @@ func (r *AuthenticationResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Authentication will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Authentication will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -289,6 +305,8 @@ func (r *AuthenticationResource) Schema(ctx context.Context, req resource.Schema
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -303,14 +321,23 @@ func (r *AuthenticationResource) Schema(ctx context.Context, req resource.Schema
 					"cookie_expiry": schema.Int64Attribute{
 						MarkdownDescription: "Specifies in seconds max duration of the allocated cookie. This maps to “Max-Age” attribute in the session cookie. This will act as an expiry duration on the client side after which client will not be setting the cookie as part of the request.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(86400),
+						},
 					},
 					"cookie_refresh_interval": schema.Int64Attribute{
 						MarkdownDescription: "Specifies in seconds refresh interval for session cookie. This is used to keep the active user active and reduce RE-login. When an incoming cookie's session expiry is still valid, and time to expire falls behind this interval, RE-issue a cookie with new expiry and with the same original session..",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(86400),
+						},
 					},
 					"session_expiry": schema.Int64Attribute{
 						MarkdownDescription: "Specifies in seconds max lifetime of an authenticated session after which the user will be forced to login again. Default session expiry is 86400 seconds(24 hours).",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(1296000),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -356,7 +383,16 @@ func (r *AuthenticationResource) Schema(ctx context.Context, req resource.Schema
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -392,7 +428,16 @@ func (r *AuthenticationResource) Schema(ctx context.Context, req resource.Schema
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -412,10 +457,18 @@ func (r *AuthenticationResource) Schema(ctx context.Context, req resource.Schema
 					"oidc_client_id": schema.StringAttribute{
 						MarkdownDescription: "Client ID used while sending the Authorization Request to OIDC server .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(256),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 					"oidc_well_known_config_url": schema.StringAttribute{
 						MarkdownDescription: "An OIDC well-known configuration URL that will be used to fetch authentication related endpoints.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(128),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -448,7 +501,16 @@ func (r *AuthenticationResource) Schema(ctx context.Context, req resource.Schema
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -461,14 +523,26 @@ func (r *AuthenticationResource) Schema(ctx context.Context, req resource.Schema
 							"auth_endpoint_url": schema.StringAttribute{
 								MarkdownDescription: "URL of the authorization server's authorization endpoint.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(128),
+									stringvalidator.UTF8LengthAtLeast(1),
+								},
 							},
 							"end_session_endpoint_url": schema.StringAttribute{
 								MarkdownDescription: "URL of the authorization server's Logout endpoint.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(128),
+									stringvalidator.UTF8LengthAtLeast(1),
+								},
 							},
 							"token_endpoint_url": schema.StringAttribute{
 								MarkdownDescription: "URL of the authorization server's Token endpoint.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(128),
+									stringvalidator.UTF8LengthAtLeast(1),
+								},
 							},
 						},
 					},
@@ -478,6 +552,11 @@ func (r *AuthenticationResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *AuthenticationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *AuthenticationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -502,6 +581,18 @@ func (r *AuthenticationResource) ValidateConfig(ctx context.Context, req resourc
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *AuthenticationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("cookie_params"), "secret_choice", "auth_hmac", "kms_key_hmac"),
+		validators.OneOfGroup(path.MatchRoot("cookie_params").AtName("auth_hmac").AtName("prim_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("cookie_params").AtName("auth_hmac").AtName("sec_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("oidc_auth"), "auth_params_choice", "oidc_auth_params", "oidc_well_known_config_url"),
+		validators.OneOfGroup(path.MatchRoot("oidc_auth").AtName("client_secret"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *AuthenticationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -512,7 +603,10 @@ func (r *AuthenticationResource) ModifyPlan(ctx context.Context, req resource.Mo
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "authentication", "", req.Config, &resp.Plan)...)
 		var plan AuthenticationResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -530,7 +624,8 @@ func (r *AuthenticationResource) ModifyPlan(ctx context.Context, req resource.Mo
 
 func (r *AuthenticationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data AuthenticationResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -569,6 +664,7 @@ func (r *AuthenticationResource) Create(ctx context.Context, req resource.Create
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -578,15 +674,70 @@ func (r *AuthenticationResource) Create(ctx context.Context, req resource.Create
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.CookieParams != nil {
 		cookie_paramsMap := make(map[string]interface{})
 		if data.CookieParams.AuthHMAC != nil {
 			auth_hmacNestedMap := make(map[string]interface{})
+			if data.CookieParams.AuthHMAC.PrimKey != nil {
+				prim_keyLevel3Map := make(map[string]interface{})
+				if data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.Location.IsNull() && !data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					prim_keyLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.Provider.IsNull() && !data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.URL.IsNull() && !data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.URL.ValueString()
+					}
+					prim_keyLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				auth_hmacNestedMap["prim_key"] = prim_keyLevel3Map
+			}
 			if !data.CookieParams.AuthHMAC.PrimKeyExpiry.IsNull() && !data.CookieParams.AuthHMAC.PrimKeyExpiry.IsUnknown() {
 				auth_hmacNestedMap["prim_key_expiry"] = data.CookieParams.AuthHMAC.PrimKeyExpiry.ValueString()
 			}
+			if data.CookieParams.AuthHMAC.SecKey != nil {
+				sec_keyLevel3Map := make(map[string]interface{})
+				if data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.Location.IsNull() && !data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					sec_keyLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.Provider.IsNull() && !data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.URL.IsNull() && !data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.URL.ValueString()
+					}
+					sec_keyLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				auth_hmacNestedMap["sec_key"] = sec_keyLevel3Map
+			}
 			if !data.CookieParams.AuthHMAC.SecKeyExpiry.IsNull() && !data.CookieParams.AuthHMAC.SecKeyExpiry.IsUnknown() {
 				auth_hmacNestedMap["sec_key_expiry"] = data.CookieParams.AuthHMAC.SecKeyExpiry.ValueString()
 			}
@@ -610,6 +761,29 @@ func (r *AuthenticationResource) Create(ctx context.Context, req resource.Create
 		oidc_authMap := make(map[string]interface{})
 		if data.OIDCAuth.ClientSecret != nil {
 			client_secretNestedMap := make(map[string]interface{})
+			if data.OIDCAuth.ClientSecret.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.Location.IsNull() && !data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				client_secretNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.OIDCAuth.ClientSecret.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.OIDCAuth.ClientSecret.ClearSecretInfo.Provider.IsNull() && !data.OIDCAuth.ClientSecret.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.OIDCAuth.ClientSecret.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.OIDCAuth.ClientSecret.ClearSecretInfo.URL.IsNull() && !data.OIDCAuth.ClientSecret.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.OIDCAuth.ClientSecret.ClearSecretInfo.URL.ValueString()
+				}
+				client_secretNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			oidc_authMap["client_secret"] = client_secretNestedMap
 		}
 		if data.OIDCAuth.OIDCAuthParams != nil {
@@ -636,11 +810,15 @@ func (r *AuthenticationResource) Create(ctx context.Context, req resource.Create
 
 	apiResource, err := r.client.CreateAuthentication(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Authentication: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "authentication", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -815,7 +993,7 @@ func (r *AuthenticationResource) Read(ctx context.Context, req resource.ReadRequ
 	apiResource, err := r.client.GetAuthentication(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Authentication not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -823,11 +1001,12 @@ func (r *AuthenticationResource) Read(ctx context.Context, req resource.ReadRequ
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Authentication: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "authentication", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -838,31 +1017,13 @@ func (r *AuthenticationResource) Read(ctx context.Context, req resource.ReadRequ
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -870,6 +1031,7 @@ func (r *AuthenticationResource) Read(ctx context.Context, req resource.ReadRequ
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["cookie_params"].(map[string]interface{}); ok && (isImport || data.CookieParams != nil) {
 		data.CookieParams = &AuthenticationCookieParamsModel{
@@ -1022,7 +1184,8 @@ func (r *AuthenticationResource) Read(ctx context.Context, req resource.ReadRequ
 
 func (r *AuthenticationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data AuthenticationResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1056,6 +1219,7 @@ func (r *AuthenticationResource) Update(ctx context.Context, req resource.Update
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1065,15 +1229,70 @@ func (r *AuthenticationResource) Update(ctx context.Context, req resource.Update
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.CookieParams != nil {
 		cookie_paramsMap := make(map[string]interface{})
 		if data.CookieParams.AuthHMAC != nil {
 			auth_hmacNestedMap := make(map[string]interface{})
+			if data.CookieParams.AuthHMAC.PrimKey != nil {
+				prim_keyLevel3Map := make(map[string]interface{})
+				if data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.Location.IsNull() && !data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					prim_keyLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.Provider.IsNull() && !data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.URL.IsNull() && !data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.URL.ValueString()
+					}
+					prim_keyLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				auth_hmacNestedMap["prim_key"] = prim_keyLevel3Map
+			}
 			if !data.CookieParams.AuthHMAC.PrimKeyExpiry.IsNull() && !data.CookieParams.AuthHMAC.PrimKeyExpiry.IsUnknown() {
 				auth_hmacNestedMap["prim_key_expiry"] = data.CookieParams.AuthHMAC.PrimKeyExpiry.ValueString()
 			}
+			if data.CookieParams.AuthHMAC.SecKey != nil {
+				sec_keyLevel3Map := make(map[string]interface{})
+				if data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.Location.IsNull() && !data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					sec_keyLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.Provider.IsNull() && !data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.URL.IsNull() && !data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.URL.ValueString()
+					}
+					sec_keyLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				auth_hmacNestedMap["sec_key"] = sec_keyLevel3Map
+			}
 			if !data.CookieParams.AuthHMAC.SecKeyExpiry.IsNull() && !data.CookieParams.AuthHMAC.SecKeyExpiry.IsUnknown() {
 				auth_hmacNestedMap["sec_key_expiry"] = data.CookieParams.AuthHMAC.SecKeyExpiry.ValueString()
 			}
@@ -1097,6 +1316,29 @@ func (r *AuthenticationResource) Update(ctx context.Context, req resource.Update
 		oidc_authMap := make(map[string]interface{})
 		if data.OIDCAuth.ClientSecret != nil {
 			client_secretNestedMap := make(map[string]interface{})
+			if data.OIDCAuth.ClientSecret.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.Location.IsNull() && !data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.OIDCAuth.ClientSecret.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				client_secretNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.OIDCAuth.ClientSecret.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.OIDCAuth.ClientSecret.ClearSecretInfo.Provider.IsNull() && !data.OIDCAuth.ClientSecret.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.OIDCAuth.ClientSecret.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.OIDCAuth.ClientSecret.ClearSecretInfo.URL.IsNull() && !data.OIDCAuth.ClientSecret.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.OIDCAuth.ClientSecret.ClearSecretInfo.URL.ValueString()
+				}
+				client_secretNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			oidc_authMap["client_secret"] = client_secretNestedMap
 		}
 		if data.OIDCAuth.OIDCAuthParams != nil {
@@ -1123,7 +1365,7 @@ func (r *AuthenticationResource) Update(ctx context.Context, req resource.Update
 
 	_, err := r.client.UpdateAuthentication(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Authentication: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "authentication", "update"))
 		return
 	}
 
@@ -1134,9 +1376,12 @@ func (r *AuthenticationResource) Update(ctx context.Context, req resource.Update
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetAuthentication(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Authentication after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "authentication", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1311,7 +1556,7 @@ func (r *AuthenticationResource) Delete(ctx context.Context, req resource.Delete
 	err := r.client.DeleteAuthentication(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Authentication already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1320,37 +1565,33 @@ func (r *AuthenticationResource) Delete(ctx context.Context, req resource.Delete
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "Authentication delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Authentication: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "authentication", "delete"))
 		return
 	}
 }
 
 func (r *AuthenticationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *AuthenticationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("authentication", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *AuthenticationResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListAuthentications(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *AuthenticationResource) requiredNamespace() string {
+	return ""
 }
@@ -6,10 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -19,17 +18,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &BGPAsnSetResource{}
-	_ resource.ResourceWithConfigure      = &BGPAsnSetResource{}
-	_ resource.ResourceWithImportState    = &BGPAsnSetResource{}
-	_ resource.ResourceWithModifyPlan     = &BGPAsnSetResource{}
-	_ resource.ResourceWithValidateConfig = &BGPAsnSetResource{}
+	_ resource.Resource                     = &BGPAsnSetResource{}
+	_ resource.ResourceWithConfigure        = &BGPAsnSetResource{}
+	_ resource.ResourceWithImportState      = &BGPAsnSetResource{}
+	_ resource.ResourceWithIdentity         = &BGPAsnSetResource{}
+	_ resource.ResourceWithMoveState        = &BGPAsnSetResource{}
+	_ resource.ResourceWithModifyPlan       = &BGPAsnSetResource{}
+	_ resource.ResourceWithValidateConfig   = &BGPAsnSetResource{}
+	_ resource.ResourceWithConfigValidators = &BGPAsnSetResource{}
+	_ listableResource                      = &BGPAsnSetResource{}
 )
 
 func NewBGPAsnSetResource() resource.Resource {
@@ -41,15 +45,17 @@ type BGPAsnSetResource struct {
 }
 
 type BGPAsnSetResourceModel struct {
-	Name        types.String   `tfsdk:"name"`
-	Namespace   types.String   `tfsdk:"namespace"`
-	Annotations types.Map      `tfsdk:"annotations"`
-	AsNumbers   types.List     `tfsdk:"as_numbers"`
-	Description types.String   `tfsdk:"description"`
-	Disable     types.Bool     `tfsdk:"disable"`
-	Labels      types.Map      `tfsdk:"labels"`
-	ID          types.String   `tfsdk:"id"`
-	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	Name           types.String   `tfsdk:"name"`
+	Namespace      types.String   `tfsdk:"namespace"`
+	Annotations    types.Map      `tfsdk:"annotations"`
+	AsNumbers      types.List     `tfsdk:"as_numbers"`
+	Description    types.String   `tfsdk:"description"`
+	Disable        types.Bool     `tfsdk:"disable"`
+	Labels         types.Map      `tfsdk:"labels"`
+	ID             types.String   `tfsdk:"id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	SystemMetadata types.Object   `tfsdk:"system_metadata"`
+	LabelsAll      types.Map      `tfsdk:"labels_all"`
 }
 
 func (r *BGPAsnSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -71,9 +77,11 @@ func (r *BGPAsnSetResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the BGP Asn Set will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the BGP Asn Set will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -89,6 +97,11 @@ func (r *BGPAsnSetResource) Schema(ctx context.Context, req resource.SchemaReque
 				MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create whitelists or blacklists for use in network policy or service policy.",
 				Optional:            true,
 				ElementType:         types.Int64Type,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(256),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Human readable description for the object.",
@@ -110,6 +123,8 @@ func (r *BGPAsnSetResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -122,6 +137,11 @@ func (r *BGPAsnSetResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *BGPAsnSetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *BGPAsnSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -146,6 +166,12 @@ func (r *BGPAsnSetResource) ValidateConfig(ctx context.Context, req resource.Val
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *BGPAsnSetResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return nil
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *BGPAsnSetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -156,7 +182,10 @@ func (r *BGPAsnSetResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "bgp_asn_set", "system", req.Config, &resp.Plan)...)
 		var plan BGPAsnSetResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -213,6 +242,7 @@ func (r *BGPAsnSetResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -222,6 +252,7 @@ func (r *BGPAsnSetResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.AsNumbers.IsNull() && !data.AsNumbers.IsUnknown() {
@@ -234,11 +265,15 @@ func (r *BGPAsnSetResource) Create(ctx context.Context, req resource.CreateReque
 
 	apiResource, err := r.client.CreateBGPAsnSet(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create BGPAsnSet: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bgp_asn_set", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -283,7 +318,7 @@ func (r *BGPAsnSetResource) Read(ctx context.Context, req resource.ReadRequest,
 	apiResource, err := r.client.GetBGPAsnSet(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "BGPAsnSet not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -291,11 +326,12 @@ func (r *BGPAsnSetResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read BGPAsnSet: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bgp_asn_set", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -306,31 +342,13 @@ func (r *BGPAsnSetResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -338,6 +356,7 @@ func (r *BGPAsnSetResource) Read(ctx context.Context, req resource.ReadRequest,
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if v, ok := apiResource.Spec["as_numbers"].([]interface{}); ok && len(v) > 0 {
 		var as_numbersList []int64
@@ -394,6 +413,7 @@ func (r *BGPAsnSetResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -403,6 +423,7 @@ func (r *BGPAsnSetResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.AsNumbers.IsNull() && !data.AsNumbers.IsUnknown() {
@@ -415,7 +436,7 @@ func (r *BGPAsnSetResource) Update(ctx context.Context, req resource.UpdateReque
 
 	_, err := r.client.UpdateBGPAsnSet(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update BGPAsnSet: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bgp_asn_set", "update"))
 		return
 	}
 
@@ -426,9 +447,12 @@ func (r *BGPAsnSetResource) Update(ctx context.Context, req resource.UpdateReque
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetBGPAsnSet(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read BGPAsnSet after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "bgp_asn_set", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -473,7 +497,7 @@ func (r *BGPAsnSetResource) Delete(ctx context.Context, req resource.DeleteReque
 	err := r.client.DeleteBGPAsnSet(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "BGPAsnSet already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -482,37 +506,33 @@ func (r *BGPAsnSetResource) Delete(ctx context.Context, req resource.DeleteReque
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "BGPAsnSet delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete BGPAsnSet: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bgp_asn_set", "delete"))
 		return
 	}
 }
 
 func (r *BGPAsnSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *BGPAsnSetResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("bgp_asn_set", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *BGPAsnSetResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListBGPAsnSets(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *BGPAsnSetResource) requiredNamespace() string {
+	return "system"
 }
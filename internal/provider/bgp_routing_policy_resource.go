@@ -6,9 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +20,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &BGPRoutingPolicyResource{}
-	_ resource.ResourceWithConfigure      = &BGPRoutingPolicyResource{}
-	_ resource.ResourceWithImportState    = &BGPRoutingPolicyResource{}
-	_ resource.ResourceWithModifyPlan     = &BGPRoutingPolicyResource{}
-	_ resource.ResourceWithValidateConfig = &BGPRoutingPolicyResource{}
+	_ resource.Resource                     = &BGPRoutingPolicyResource{}
+	_ resource.ResourceWithConfigure        = &BGPRoutingPolicyResource{}
+	_ resource.ResourceWithImportState      = &BGPRoutingPolicyResource{}
+	_ resource.ResourceWithIdentity         = &BGPRoutingPolicyResource{}
+	_ resource.ResourceWithMoveState        = &BGPRoutingPolicyResource{}
+	_ resource.ResourceWithModifyPlan       = &BGPRoutingPolicyResource{}
+	_ resource.ResourceWithValidateConfig   = &BGPRoutingPolicyResource{}
+	_ resource.ResourceWithConfigValidators = &BGPRoutingPolicyResource{}
+	_ listableResource                      = &BGPRoutingPolicyResource{}
 )
 
 func NewBGPRoutingPolicyResource() resource.Resource {
@@ -140,15 +145,17 @@ var BGPRoutingPolicyRulesMatchIPPrefixesPrefixesModelAttrTypes = map[string]attr
 }
 
 type BGPRoutingPolicyResourceModel struct {
-	Name        types.String   `tfsdk:"name"`
-	Namespace   types.String   `tfsdk:"namespace"`
-	Annotations types.Map      `tfsdk:"annotations"`
-	Description types.String   `tfsdk:"description"`
-	Disable     types.Bool     `tfsdk:"disable"`
-	Labels      types.Map      `tfsdk:"labels"`
-	ID          types.String   `tfsdk:"id"`
-	Timeouts    timeouts.Value `tfsdk:"timeouts"`
-	Rules       types.List     `tfsdk:"rules"`
+	Name           types.String   `tfsdk:"name"`
+	Namespace      types.String   `tfsdk:"namespace"`
+	Annotations    types.Map      `tfsdk:"annotations"`
+	Description    types.String   `tfsdk:"description"`
+	Disable        types.Bool     `tfsdk:"disable"`
+	Labels         types.Map      `tfsdk:"labels"`
+	ID             types.String   `tfsdk:"id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	SystemMetadata types.Object   `tfsdk:"system_metadata"`
+	LabelsAll      types.Map      `tfsdk:"labels_all"`
+	Rules          types.List     `tfsdk:"rules"`
 }
 
 func (r *BGPRoutingPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -170,9 +177,11 @@ func (r *BGPRoutingPolicyResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the BGP Routing Policy will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the BGP Routing Policy will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -204,6 +213,8 @@ func (r *BGPRoutingPolicyResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -214,6 +225,10 @@ func (r *BGPRoutingPolicyResource) Schema(ctx context.Context, req resource.Sche
 			}),
 			"rules": schema.ListNestedBlock{
 				MarkdownDescription: "BGP Routing policy is composed of one or more rules. Note that the order of rules is critical as rules are applied top to bottom.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(16),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{},
 					Blocks: map[string]schema.Block{
@@ -247,6 +262,11 @@ func (r *BGPRoutingPolicyResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "Unordered set of RFC 1997 defined 4-byte community, first 16 bits being ASN and lower 16 bits being value .",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(8),
+												listvalidator.SizeAtLeast(1),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 								},
@@ -271,6 +291,11 @@ func (r *BGPRoutingPolicyResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "Unordered set of RFC 1997 defined 4-byte community, first 16 bits being ASN and lower 16 bits being value .",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(8),
+												listvalidator.SizeAtLeast(1),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 								},
@@ -280,11 +305,17 @@ func (r *BGPRoutingPolicyResource) Schema(ctx context.Context, req resource.Sche
 									Blocks: map[string]schema.Block{
 										"prefixes": schema.ListNestedBlock{
 											MarkdownDescription: "Prefix list. List of IP prefix .",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(8),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"ip_prefixes": schema.StringAttribute{
 														MarkdownDescription: "IP Prefix. IP prefix to match on BGP route.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.IPPrefixValidator(),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -311,6 +342,11 @@ func (r *BGPRoutingPolicyResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *BGPRoutingPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *BGPRoutingPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -335,6 +371,16 @@ func (r *BGPRoutingPolicyResource) ValidateConfig(ctx context.Context, req resou
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *BGPRoutingPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("action"), "action_type", "aggregate", "allow", "as_path", "community", "deny", "local_preference", "metric"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("match"), "type_of_match", "as_path", "community", "ip_prefixes"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("match").AtName("ip_prefixes").AtName("prefixes").AtAnyListIndex(), "prefix_length_match", "equal_or_longer_than", "exact_match", "longer_than"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *BGPRoutingPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -345,7 +391,10 @@ func (r *BGPRoutingPolicyResource) ModifyPlan(ctx context.Context, req resource.
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "bgp_routing_policy", "system", req.Config, &resp.Plan)...)
 		var plan BGPRoutingPolicyResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -402,6 +451,7 @@ func (r *BGPRoutingPolicyResource) Create(ctx context.Context, req resource.Crea
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -411,6 +461,7 @@ func (r *BGPRoutingPolicyResource) Create(ctx context.Context, req resource.Crea
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Rules.IsNull() && !data.Rules.IsUnknown() {
@@ -484,11 +535,15 @@ func (r *BGPRoutingPolicyResource) Create(ctx context.Context, req resource.Crea
 
 	apiResource, err := r.client.CreateBGPRoutingPolicy(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create BGPRoutingPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bgp_routing_policy", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -596,7 +651,7 @@ func (r *BGPRoutingPolicyResource) Read(ctx context.Context, req resource.ReadRe
 	apiResource, err := r.client.GetBGPRoutingPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "BGPRoutingPolicy not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -604,11 +659,12 @@ func (r *BGPRoutingPolicyResource) Read(ctx context.Context, req resource.ReadRe
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read BGPRoutingPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bgp_routing_policy", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -619,31 +675,13 @@ func (r *BGPRoutingPolicyResource) Read(ctx context.Context, req resource.ReadRe
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -651,6 +689,7 @@ func (r *BGPRoutingPolicyResource) Read(ctx context.Context, req resource.ReadRe
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if listData, ok := apiResource.Spec["rules"].([]interface{}); ok && len(listData) > 0 {
 		var rulesList []BGPRoutingPolicyRulesModel
@@ -770,6 +809,7 @@ func (r *BGPRoutingPolicyResource) Update(ctx context.Context, req resource.Upda
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -779,6 +819,7 @@ func (r *BGPRoutingPolicyResource) Update(ctx context.Context, req resource.Upda
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Rules.IsNull() && !data.Rules.IsUnknown() {
@@ -852,7 +893,7 @@ func (r *BGPRoutingPolicyResource) Update(ctx context.Context, req resource.Upda
 
 	_, err := r.client.UpdateBGPRoutingPolicy(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update BGPRoutingPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bgp_routing_policy", "update"))
 		return
 	}
 
@@ -863,9 +904,12 @@ func (r *BGPRoutingPolicyResource) Update(ctx context.Context, req resource.Upda
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetBGPRoutingPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read BGPRoutingPolicy after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "bgp_routing_policy", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -973,7 +1017,7 @@ func (r *BGPRoutingPolicyResource) Delete(ctx context.Context, req resource.Dele
 	err := r.client.DeleteBGPRoutingPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "BGPRoutingPolicy already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -982,37 +1026,33 @@ func (r *BGPRoutingPolicyResource) Delete(ctx context.Context, req resource.Dele
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "BGPRoutingPolicy delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete BGPRoutingPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bgp_routing_policy", "delete"))
 		return
 	}
 }
 
 func (r *BGPRoutingPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *BGPRoutingPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("bgp_routing_policy", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *BGPRoutingPolicyResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListBGPRoutingPolicies(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *BGPRoutingPolicyResource) requiredNamespace() string {
+	return "system"
 }
@@ -0,0 +1,115 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// Blindfold Ephemeral Resource for F5 XC
+// Seals a secret with F5XC Secret Management without persisting either the
+// plaintext or the sealed value, for secrets read from an external secret
+// store and passed on to write-only or ephemeral arguments.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource              = &BlindfoldEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure = &BlindfoldEphemeralResource{}
+)
+
+func NewBlindfoldEphemeralResource() ephemeral.EphemeralResource {
+	return &BlindfoldEphemeralResource{}
+}
+
+type BlindfoldEphemeralResource struct {
+	client *client.Client
+}
+
+type BlindfoldEphemeralResourceModel struct {
+	Plaintext        types.String `tfsdk:"plaintext"`
+	PolicyName       types.String `tfsdk:"policy_name"`
+	Namespace        types.String `tfsdk:"namespace"`
+	Sealed           types.String `tfsdk:"sealed"`
+	PublicKeyVersion types.Int64  `tfsdk:"public_key_version"`
+}
+
+func (r *BlindfoldEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blindfold"
+}
+
+func (r *BlindfoldEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Seals a secret with F5 Distributed Cloud Secret Management (blindfold) without storing it in the plan or state.
+
+Use it to seal values read from an external secret store, such as a Vault or AWS Secrets Manager ephemeral resource,
+and pass ` + "`sealed`" + ` to write-only arguments or provider configuration. Sealing happens locally; the plaintext
+is never sent to F5XC. Requires Terraform 1.10 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"plaintext": schema.StringAttribute{
+				MarkdownDescription: "Secret to seal. Unlike `provider::f5xc::blindfold`, the value is not base64 encoded.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"policy_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the secret policy that controls which clients can decrypt the secret.",
+				Required:            true,
+				Validators:          validators.RequiredNameValidators(),
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the secret policy. Common values: `shared`, `system`.",
+				Required:            true,
+				Validators:          validators.RequiredNamespaceValidators(),
+			},
+			"sealed": schema.StringAttribute{
+				MarkdownDescription: "Sealed secret in the `string:///` format expected by `blindfold_secret_info.location`.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"public_key_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the F5XC public key the secret is sealed with.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *BlindfoldEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *BlindfoldEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data BlindfoldEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sealed, keyVersion, err := blindfoldSeal(ctx, r.client, []byte(data.Plaintext.ValueString()), data.Namespace.ValueString(), data.PolicyName.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Failed to Seal Secret", err.Error())
+		return
+	}
+
+	data.Sealed = types.StringValue(sealed)
+	data.PublicKeyVersion = types.Int64Value(int64(keyVersion))
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHashBlindfoldPlaintext(t *testing.T) {
+	first, err := hashBlindfoldPlaintext("secret")
+	if err != nil {
+		t.Fatalf("hashBlindfoldPlaintext() error = %v", err)
+	}
+	second, err := hashBlindfoldPlaintext("secret")
+	if err != nil {
+		t.Fatalf("hashBlindfoldPlaintext() error = %v", err)
+	}
+
+	if first == second {
+		t.Error("hashes of the same plaintext should use different salts")
+	}
+	if strings.Contains(first, "secret") {
+		t.Error("hash should not contain the plaintext")
+	}
+	salt, _, found := strings.Cut(first, ":")
+	if !found || len(salt) != 2*blindfoldSaltSize {
+		t.Errorf("hash %q should start with a %d byte hex salt", first, blindfoldSaltSize)
+	}
+}
+
+func TestBlindfoldPlaintextMatches(t *testing.T) {
+	stored, err := hashBlindfoldPlaintext("secret")
+	if err != nil {
+		t.Fatalf("hashBlindfoldPlaintext() error = %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		plaintext string
+		stored    string
+		expected  bool
+	}{
+		{"same plaintext", "secret", stored, true},
+		{"changed plaintext", "secret2", stored, false},
+		{"empty plaintext", "", stored, false},
+		{"empty hash", "secret", "", false},
+		{"missing separator", "secret", "abcdef", false},
+		{"invalid salt", "secret", "zz:abcdef", false},
+		{"tampered hash", "secret", stored[:len(stored)-1] + "0", stored[len(stored)-1] == '0'},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := blindfoldPlaintextMatches(tt.plaintext, tt.stored)
+			if result != tt.expected {
+				t.Errorf("blindfoldPlaintextMatches(%q, %q) = %v, want %v", tt.plaintext, tt.stored, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,306 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// Blindfolded Secret Resource for F5 XC
+// Seals a secret with F5XC Secret Management once and keeps the sealed value
+// stable across plans. The blindfold functions seal with fresh randomness on
+// every evaluation, which shows a diff on every plan; this resource only
+// re-seals when the plaintext, the secret policy or the public key changes.
+
+package provider
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/blindfold"
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
+)
+
+// blindfoldSaltSize is the size in bytes of the salt of the plaintext hash
+const blindfoldSaltSize = 16
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource               = &BlindfoldedSecretResource{}
+	_ resource.ResourceWithConfigure  = &BlindfoldedSecretResource{}
+	_ resource.ResourceWithModifyPlan = &BlindfoldedSecretResource{}
+)
+
+func NewBlindfoldedSecretResource() resource.Resource {
+	return &BlindfoldedSecretResource{}
+}
+
+type BlindfoldedSecretResource struct {
+	client *client.Client
+}
+
+type BlindfoldedSecretResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	Plaintext        types.String `tfsdk:"plaintext"`
+	PolicyName       types.String `tfsdk:"policy_name"`
+	Namespace        types.String `tfsdk:"namespace"`
+	Sealed           types.String `tfsdk:"sealed"`
+	PlaintextHash    types.String `tfsdk:"plaintext_hash"`
+	PublicKeyVersion types.Int64  `tfsdk:"public_key_version"`
+}
+
+func (r *BlindfoldedSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blindfolded_secret"
+}
+
+func (r *BlindfoldedSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Seals a secret with F5 Distributed Cloud Secret Management (blindfold) and keeps the sealed value stable.
+
+Unlike ` + "`provider::f5xc::blindfold`" + `, which seals with fresh randomness on every plan, this resource seals once and
+only seals again when the plaintext, the secret policy or the F5XC public key version changes.
+Use ` + "`sealed`" + ` as the ` + "`blindfold_secret_info.location`" + ` of other resources.
+
+The plaintext is a write-only argument and requires Terraform 1.11 or later. Only the sealed value and a salted
+SHA-256 hash of the plaintext are stored in state. Sealing happens locally; the plaintext is never sent to F5XC.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"plaintext": schema.StringAttribute{
+				MarkdownDescription: "Secret to seal. Unlike `provider::f5xc::blindfold`, the value is not base64 encoded.",
+				Required:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"policy_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the secret policy that controls which clients can decrypt the secret.",
+				Required:            true,
+				Validators:          validators.RequiredNameValidators(),
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the secret policy. Common values: `shared`, `system`.",
+				Required:            true,
+				Validators:          validators.RequiredNamespaceValidators(),
+			},
+			"sealed": schema.StringAttribute{
+				MarkdownDescription: "Sealed secret in the `string:///` format expected by `blindfold_secret_info.location`.",
+				Computed:            true,
+			},
+			"plaintext_hash": schema.StringAttribute{
+				MarkdownDescription: "Salted SHA-256 hash of the plaintext, used to detect plaintext changes.",
+				Computed:            true,
+			},
+			"public_key_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of the F5XC public key the secret is sealed with.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *BlindfoldedSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. The plaintext is
+// write-only, so Terraform cannot detect its changes. The plan compares it
+// with the stored hash instead and checks whether F5XC rotated its public key.
+func (r *BlindfoldedSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plaintext types.String
+	var state BlindfoldedSecretResourceModel
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("plaintext"), &plaintext)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	reason := ""
+	switch {
+	case plaintext.IsUnknown():
+		reason = "plaintext is not known until apply"
+	case !blindfoldPlaintextMatches(plaintext.ValueString(), state.PlaintextHash.ValueString()):
+		reason = "plaintext changed"
+	case r.client != nil:
+		pubKey, err := blindfold.GetPublicKey(ctx, r.client.AuthenticatedHTTPClient(), r.client.BaseURL)
+		if err != nil {
+			// Keep the current seal rather than failing the plan
+			tflog.Warn(ctx, "Failed to check the F5XC public key version", map[string]interface{}{
+				"error": err.Error(),
+			})
+			break
+		}
+		if int64(pubKey.KeyVersion) != state.PublicKeyVersion.ValueInt64() {
+			reason = fmt.Sprintf("public key version changed from %d to %d", state.PublicKeyVersion.ValueInt64(), pubKey.KeyVersion)
+		}
+	}
+	if reason == "" {
+		return
+	}
+
+	tflog.Info(ctx, "Planning to seal blindfolded secret again", map[string]interface{}{
+		"reason": reason,
+	})
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("sealed"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("plaintext_hash"), types.StringUnknown())...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("public_key_version"), types.Int64Unknown())...)
+}
+
+// seal seals the configured plaintext and stores the result in data
+func (r *BlindfoldedSecretResource) seal(ctx context.Context, config tfsdkConfig, data *BlindfoldedSecretResourceModel) error {
+	var plaintext types.String
+	if diags := config.GetAttribute(ctx, path.Root("plaintext"), &plaintext); diags.HasError() {
+		return fmt.Errorf("failed to read plaintext from configuration")
+	}
+
+	sealed, keyVersion, err := blindfoldSeal(ctx, r.client, []byte(plaintext.ValueString()), data.Namespace.ValueString(), data.PolicyName.ValueString())
+	if err != nil {
+		return err
+	}
+	hash, err := hashBlindfoldPlaintext(plaintext.ValueString())
+	if err != nil {
+		return err
+	}
+
+	data.ID = types.StringValue(fmt.Sprintf("%s/%s", data.Namespace.ValueString(), data.PolicyName.ValueString()))
+	data.Sealed = types.StringValue(sealed)
+	data.PlaintextHash = types.StringValue(hash)
+	data.PublicKeyVersion = types.Int64Value(int64(keyVersion))
+	return nil
+}
+
+func (r *BlindfoldedSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data BlindfoldedSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.seal(ctx, req.Config, &data); err != nil {
+		resp.Diagnostics.AddError("Failed to Seal Secret", err.Error())
+		return
+	}
+
+	tflog.Trace(ctx, "created BlindfoldedSecret resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Read keeps the state as is. The sealed value is computed locally and has
+// no remote object to refresh from.
+func (r *BlindfoldedSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data BlindfoldedSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *BlindfoldedSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data BlindfoldedSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.seal(ctx, req.Config, &data); err != nil {
+		resp.Diagnostics.AddError("Failed to Seal Secret", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete only removes the resource from state. Sealed secrets are not
+// stored in F5XC.
+func (r *BlindfoldedSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+// tfsdkConfig is the part of tfsdk.Config used to read write-only arguments
+type tfsdkConfig interface {
+	GetAttribute(ctx context.Context, p path.Path, target interface{}) diag.Diagnostics
+}
+
+// blindfoldSeal seals plaintext under the secret policy namespace/policyName
+// with the current F5XC public key, authenticating with the provider client.
+// It returns the sealed secret and the version of the public key used.
+func blindfoldSeal(ctx context.Context, c *client.Client, plaintext []byte, namespace, policyName string) (string, int, error) {
+	if c == nil {
+		return "", 0, fmt.Errorf("the provider is not configured")
+	}
+	httpClient := c.AuthenticatedHTTPClient()
+
+	pubKey, err := blindfold.GetPublicKey(ctx, httpClient, c.BaseURL)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to fetch public key from F5XC: %w", err)
+	}
+	policy, err := blindfold.GetSecretPolicyDocument(ctx, httpClient, c.BaseURL, namespace, policyName)
+	if err != nil {
+		return "", 0, fmt.Errorf("failed to fetch secret policy %q in namespace %q: %w", policyName, namespace, err)
+	}
+	sealed, err := blindfold.Seal(plaintext, pubKey, policy)
+	if err != nil {
+		return "", 0, fmt.Errorf("encryption failed: %w", err)
+	}
+	return sealed, pubKey.KeyVersion, nil
+}
+
+// hashBlindfoldPlaintext returns a salted SHA-256 hash of plaintext in the
+// format <salt hex>:<hash hex>
+func hashBlindfoldPlaintext(plaintext string) (string, error) {
+	salt := make([]byte, blindfoldSaltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return "", fmt.Errorf("failed to generate salt: %w", err)
+	}
+	return saltedBlindfoldHash(salt, plaintext), nil
+}
+
+func saltedBlindfoldHash(salt []byte, plaintext string) string {
+	sum := sha256.Sum256(append(append([]byte{}, salt...), plaintext...))
+	return hex.EncodeToString(salt) + ":" + hex.EncodeToString(sum[:])
+}
+
+// blindfoldPlaintextMatches reports whether plaintext has the stored salted
+// hash. A malformed hash never matches.
+func blindfoldPlaintextMatches(plaintext, stored string) bool {
+	saltHex, _, found := strings.Cut(stored, ":")
+	if !found {
+		return false
+	}
+	salt, err := hex.DecodeString(saltHex)
+	if err != nil {
+		return false
+	}
+	return subtle.ConstantTimeCompare([]byte(saltedBlindfoldHash(salt, plaintext)), []byte(stored)) == 1
+}
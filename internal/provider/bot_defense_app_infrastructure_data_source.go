@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,21 @@ type BotDefenseAppInfrastructureDataSource struct {
 	client *client.Client
 }
 
+// BotDefenseAppInfrastructureDataSourceModel mirrors BotDefenseAppInfrastructureResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type BotDefenseAppInfrastructureDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata   types.Object                                      `tfsdk:"system_metadata"`
+	Name             types.String                                      `tfsdk:"name"`
+	Namespace        types.String                                      `tfsdk:"namespace"`
+	Annotations      types.Map                                         `tfsdk:"annotations"`
+	Description      types.String                                      `tfsdk:"description"`
+	Disable          types.Bool                                        `tfsdk:"disable"`
+	Labels           types.Map                                         `tfsdk:"labels"`
+	ID               types.String                                      `tfsdk:"id"`
+	EnvironmentType  types.String                                      `tfsdk:"environment_type"`
+	TrafficType      types.String                                      `tfsdk:"traffic_type"`
+	CloudHosted      *BotDefenseAppInfrastructureCloudHostedModel      `tfsdk:"cloud_hosted"`
+	DataCenterHosted *BotDefenseAppInfrastructureDataCenterHostedModel `tfsdk:"data_center_hosted"`
 }
 
 func (d *BotDefenseAppInfrastructureDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +48,7 @@ func (d *BotDefenseAppInfrastructureDataSource) Metadata(ctx context.Context, re
 }
 
 func (d *BotDefenseAppInfrastructureDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages Bot Defense App Infrastructure in a given namespace. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the BotDefenseAppInfrastructure.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the BotDefenseAppInfrastructure exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the BotDefenseAppInfrastructure.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewBotDefenseAppInfrastructureResource())
 }
 
 func (d *BotDefenseAppInfrastructureDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +70,25 @@ func (d *BotDefenseAppInfrastructureDataSource) Read(ctx context.Context, req da
 		return
 	}
 
-	resource, err := d.client.GetBotDefenseAppInfrastructure(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetBotDefenseAppInfrastructure(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read BotDefenseAppInfrastructure: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bot_defense_app_infrastructure", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +102,8 @@ func (d *BotDefenseAppInfrastructureDataSource) Read(ctx context.Context, req da
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +112,164 @@ func (d *BotDefenseAppInfrastructureDataSource) Read(ctx context.Context, req da
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["cloud_hosted"].(map[string]interface{}); ok && (isImport || data.CloudHosted != nil) {
+		data.CloudHosted = &BotDefenseAppInfrastructureCloudHostedModel{
+			Egress: func() []BotDefenseAppInfrastructureCloudHostedEgressModel {
+				if listData, ok := blockData["egress"].([]interface{}); ok && len(listData) > 0 {
+					var result []BotDefenseAppInfrastructureCloudHostedEgressModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, BotDefenseAppInfrastructureCloudHostedEgressModel{
+								IPAddress: func() types.String {
+									if v, ok := itemMap["ip_address"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Location: func() types.String {
+									if v, ok := itemMap["location"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			InfraHostName: func() types.String {
+				if v, ok := blockData["infra_host_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Ingress: func() []BotDefenseAppInfrastructureCloudHostedIngressModel {
+				if listData, ok := blockData["ingress"].([]interface{}); ok && len(listData) > 0 {
+					var result []BotDefenseAppInfrastructureCloudHostedIngressModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, BotDefenseAppInfrastructureCloudHostedIngressModel{
+								HostName: func() types.String {
+									if v, ok := itemMap["host_name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								IPAddress: func() types.String {
+									if v, ok := itemMap["ip_address"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Location: func() types.String {
+									if v, ok := itemMap["location"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			Region: func() types.String {
+				if v, ok := blockData["region"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["data_center_hosted"].(map[string]interface{}); ok && (isImport || data.DataCenterHosted != nil) {
+		data.DataCenterHosted = &BotDefenseAppInfrastructureDataCenterHostedModel{
+			Egress: func() []BotDefenseAppInfrastructureDataCenterHostedEgressModel {
+				if listData, ok := blockData["egress"].([]interface{}); ok && len(listData) > 0 {
+					var result []BotDefenseAppInfrastructureDataCenterHostedEgressModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, BotDefenseAppInfrastructureDataCenterHostedEgressModel{
+								IPAddress: func() types.String {
+									if v, ok := itemMap["ip_address"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Location: func() types.String {
+									if v, ok := itemMap["location"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			InfraHostName: func() types.String {
+				if v, ok := blockData["infra_host_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Ingress: func() []BotDefenseAppInfrastructureDataCenterHostedIngressModel {
+				if listData, ok := blockData["ingress"].([]interface{}); ok && len(listData) > 0 {
+					var result []BotDefenseAppInfrastructureDataCenterHostedIngressModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, BotDefenseAppInfrastructureDataCenterHostedIngressModel{
+								HostName: func() types.String {
+									if v, ok := itemMap["host_name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								IPAddress: func() types.String {
+									if v, ok := itemMap["ip_address"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Location: func() types.String {
+									if v, ok := itemMap["location"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			Region: func() types.String {
+				if v, ok := blockData["region"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if v, ok := apiResource.Spec["environment_type"].(string); ok && v != "" {
+		data.EnvironmentType = types.StringValue(v)
+	} else {
+		data.EnvironmentType = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["traffic_type"].(string); ok && v != "" {
+		data.TrafficType = types.StringValue(v)
+	} else {
+		data.TrafficType = types.StringNull()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
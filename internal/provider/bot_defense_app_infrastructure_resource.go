@@ -6,9 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +20,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &BotDefenseAppInfrastructureResource{}
-	_ resource.ResourceWithConfigure      = &BotDefenseAppInfrastructureResource{}
-	_ resource.ResourceWithImportState    = &BotDefenseAppInfrastructureResource{}
-	_ resource.ResourceWithModifyPlan     = &BotDefenseAppInfrastructureResource{}
-	_ resource.ResourceWithValidateConfig = &BotDefenseAppInfrastructureResource{}
+	_ resource.Resource                     = &BotDefenseAppInfrastructureResource{}
+	_ resource.ResourceWithConfigure        = &BotDefenseAppInfrastructureResource{}
+	_ resource.ResourceWithImportState      = &BotDefenseAppInfrastructureResource{}
+	_ resource.ResourceWithIdentity         = &BotDefenseAppInfrastructureResource{}
+	_ resource.ResourceWithMoveState        = &BotDefenseAppInfrastructureResource{}
+	_ resource.ResourceWithModifyPlan       = &BotDefenseAppInfrastructureResource{}
+	_ resource.ResourceWithValidateConfig   = &BotDefenseAppInfrastructureResource{}
+	_ resource.ResourceWithConfigValidators = &BotDefenseAppInfrastructureResource{}
+	_ listableResource                      = &BotDefenseAppInfrastructureResource{}
 )
 
 func NewBotDefenseAppInfrastructureResource() resource.Resource {
@@ -140,6 +145,8 @@ type BotDefenseAppInfrastructureResourceModel struct {
 	EnvironmentType  types.String                                      `tfsdk:"environment_type"`
 	TrafficType      types.String                                      `tfsdk:"traffic_type"`
 	Timeouts         timeouts.Value                                    `tfsdk:"timeouts"`
+	SystemMetadata   types.Object                                      `tfsdk:"system_metadata"`
+	LabelsAll        types.Map                                         `tfsdk:"labels_all"`
 	CloudHosted      *BotDefenseAppInfrastructureCloudHostedModel      `tfsdk:"cloud_hosted"`
 	DataCenterHosted *BotDefenseAppInfrastructureDataCenterHostedModel `tfsdk:"data_center_hosted"`
 }
@@ -163,9 +170,11 @@ func (r *BotDefenseAppInfrastructureResource) Schema(ctx context.Context, req re
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Bot Defense App Infrastructure will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Bot Defense App Infrastructure will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -213,6 +222,8 @@ func (r *BotDefenseAppInfrastructureResource) Schema(ctx context.Context, req re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -227,6 +238,9 @@ func (r *BotDefenseAppInfrastructureResource) Schema(ctx context.Context, req re
 					"infra_host_name": schema.StringAttribute{
 						MarkdownDescription: "Infra Host Name. Infra Host Name .",
 						Optional:            true,
+						Validators: []validator.String{
+							validators.HostnameValidator(),
+						},
 					},
 					"region": schema.StringAttribute{
 						MarkdownDescription: "[Enum: US|EU|ASIA] Defines a selection for Bot Defense Advanced region - US: US US region - EU: EU European Union region - ASIA: ASIA Asia region. Possible values are `US`, `EU`, `ASIA`. Defaults to `US`.",
@@ -241,6 +255,9 @@ func (r *BotDefenseAppInfrastructureResource) Schema(ctx context.Context, req re
 								"ip_address": schema.StringAttribute{
 									MarkdownDescription: "IP Address. Egress IP address .",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.IPValidator(),
+									},
 								},
 								"location": schema.StringAttribute{
 									MarkdownDescription: "[Enum: AWS_AP_NORTHEAST_1|AWS_AP_NORTHEAST_3|AWS_AP_SOUTH_1|AWS_AP_SOUTH_2|AWS_AP_SOUTHEAST_1|AWS_AP_SOUTHEAST_2|AWS_AP_SOUTHEAST_3|AWS_EU_CENTRAL_1|AWS_EU_NORTH_1|AWS_EU_WEST_1|AWS_ME_SOUTH_1|AWS_SA_EAST_1|AWS_US_EAST_1|AWS_US_EAST_2|AWS_US_WEST_1|AWS_US_WEST_2|GCP_ASIA_EAST_1|GCP_ASIA_EAST_2|GCP_ASIA_NORTHEAST_1|GCP_ASIA_NORTHEAST_2|GCP_ASIA_NORTHEAST_3|GCP_ASIA_SOUTH_1|GCP_ASIA_SOUTHEAST_1|GCP_ASIA_SOUTHEAST_2|GCP_AUSTRALIA_SOUTHEAST_1|GCP_EUROPE_WEST_1|GCP_EUROPE_WEST_2|GCP_EUROPE_WEST_3|GCP_NORTHAMERICA_NORTHEAST_1|GCP_NORTHAMERICA_NORTHEAST_2|GCP_SOUTHAMERICA_EAST_1|GCP_SOUTHAMERICA_WEST_1|GCP_US_CENTRAL_1|GCP_US_EAST_1|GCP_US_EAST_4|GCP_US_WEST_1|GCP_US_WEST_2] Region location AWS_AP_NORTHEAST_1 AWS_AP_NORTHEAST_3 AWS_AP_SOUTH_1 AWS_AP_SOUTH_2 AWS_AP_SOUTHEAST_1 AWS_AP_SOUTHEAST_2 AWS_AP_SOUTHEAST_3 AWS_EU_CENTRAL_1 AWS_EU_NORTH_1 AWS_EU_WEST_1 AWS_ME_SOUTH_1 AWS_SA_EAST_1 AWS_US_EAST_1 AWS_US_EAST_2 AWS_US_WEST_1 AWS_US_WEST_2 GCP_ASIA_EAST_1.. Possible values are `AWS_AP_NORTHEAST_1`, `AWS_AP_NORTHEAST_3`, `AWS_AP_SOUTH_1`, `AWS_AP_SOUTH_2`, `AWS_AP_SOUTHEAST_1`, `AWS_AP_SOUTHEAST_2`, `AWS_AP_SOUTHEAST_3`, `AWS_EU_CENTRAL_1`, `AWS_EU_NORTH_1`, `AWS_EU_WEST_1`, `AWS_ME_SOUTH_1`, `AWS_SA_EAST_1`, `AWS_US_EAST_1`, `AWS_US_EAST_2`, `AWS_US_WEST_1`, `AWS_US_WEST_2`, `GCP_ASIA_EAST_1`, `GCP_ASIA_EAST_2`, `GCP_ASIA_NORTHEAST_1`, `GCP_ASIA_NORTHEAST_2`, `GCP_ASIA_NORTHEAST_3`, `GCP_ASIA_SOUTH_1`, `GCP_ASIA_SOUTHEAST_1`, `GCP_ASIA_SOUTHEAST_2`, `GCP_AUSTRALIA_SOUTHEAST_1`, `GCP_EUROPE_WEST_1`, `GCP_EUROPE_WEST_2`, `GCP_EUROPE_WEST_3`, `GCP_NORTHAMERICA_NORTHEAST_1`, `GCP_NORTHAMERICA_NORTHEAST_2`, `GCP_SOUTHAMERICA_EAST_1`, `GCP_SOUTHAMERICA_WEST_1`, `GCP_US_CENTRAL_1`, `GCP_US_EAST_1`, `GCP_US_EAST_4`, `GCP_US_WEST_1`, `GCP_US_WEST_2`. Defaults to `AWS_AP_NORTHEAST_1`.",
@@ -251,15 +268,24 @@ func (r *BotDefenseAppInfrastructureResource) Schema(ctx context.Context, req re
 					},
 					"ingress": schema.ListNestedBlock{
 						MarkdownDescription: "Ingress. Ingress .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(3),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"host_name": schema.StringAttribute{
 									MarkdownDescription: "Ingress Host Name.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+									},
 								},
 								"ip_address": schema.StringAttribute{
 									MarkdownDescription: "Ingress IP Address.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.IPValidator(),
+									},
 								},
 								"location": schema.StringAttribute{
 									MarkdownDescription: "[Enum: AWS_AP_NORTHEAST_1|AWS_AP_NORTHEAST_3|AWS_AP_SOUTH_1|AWS_AP_SOUTH_2|AWS_AP_SOUTHEAST_1|AWS_AP_SOUTHEAST_2|AWS_AP_SOUTHEAST_3|AWS_EU_CENTRAL_1|AWS_EU_NORTH_1|AWS_EU_WEST_1|AWS_ME_SOUTH_1|AWS_SA_EAST_1|AWS_US_EAST_1|AWS_US_EAST_2|AWS_US_WEST_1|AWS_US_WEST_2|GCP_ASIA_EAST_1|GCP_ASIA_EAST_2|GCP_ASIA_NORTHEAST_1|GCP_ASIA_NORTHEAST_2|GCP_ASIA_NORTHEAST_3|GCP_ASIA_SOUTH_1|GCP_ASIA_SOUTHEAST_1|GCP_ASIA_SOUTHEAST_2|GCP_AUSTRALIA_SOUTHEAST_1|GCP_EUROPE_WEST_1|GCP_EUROPE_WEST_2|GCP_EUROPE_WEST_3|GCP_NORTHAMERICA_NORTHEAST_1|GCP_NORTHAMERICA_NORTHEAST_2|GCP_SOUTHAMERICA_EAST_1|GCP_SOUTHAMERICA_WEST_1|GCP_US_CENTRAL_1|GCP_US_EAST_1|GCP_US_EAST_4|GCP_US_WEST_1|GCP_US_WEST_2] Region location AWS_AP_NORTHEAST_1 AWS_AP_NORTHEAST_3 AWS_AP_SOUTH_1 AWS_AP_SOUTH_2 AWS_AP_SOUTHEAST_1 AWS_AP_SOUTHEAST_2 AWS_AP_SOUTHEAST_3 AWS_EU_CENTRAL_1 AWS_EU_NORTH_1 AWS_EU_WEST_1 AWS_ME_SOUTH_1 AWS_SA_EAST_1 AWS_US_EAST_1 AWS_US_EAST_2 AWS_US_WEST_1 AWS_US_WEST_2 GCP_ASIA_EAST_1.. Possible values are `AWS_AP_NORTHEAST_1`, `AWS_AP_NORTHEAST_3`, `AWS_AP_SOUTH_1`, `AWS_AP_SOUTH_2`, `AWS_AP_SOUTHEAST_1`, `AWS_AP_SOUTHEAST_2`, `AWS_AP_SOUTHEAST_3`, `AWS_EU_CENTRAL_1`, `AWS_EU_NORTH_1`, `AWS_EU_WEST_1`, `AWS_ME_SOUTH_1`, `AWS_SA_EAST_1`, `AWS_US_EAST_1`, `AWS_US_EAST_2`, `AWS_US_WEST_1`, `AWS_US_WEST_2`, `GCP_ASIA_EAST_1`, `GCP_ASIA_EAST_2`, `GCP_ASIA_NORTHEAST_1`, `GCP_ASIA_NORTHEAST_2`, `GCP_ASIA_NORTHEAST_3`, `GCP_ASIA_SOUTH_1`, `GCP_ASIA_SOUTHEAST_1`, `GCP_ASIA_SOUTHEAST_2`, `GCP_AUSTRALIA_SOUTHEAST_1`, `GCP_EUROPE_WEST_1`, `GCP_EUROPE_WEST_2`, `GCP_EUROPE_WEST_3`, `GCP_NORTHAMERICA_NORTHEAST_1`, `GCP_NORTHAMERICA_NORTHEAST_2`, `GCP_SOUTHAMERICA_EAST_1`, `GCP_SOUTHAMERICA_WEST_1`, `GCP_US_CENTRAL_1`, `GCP_US_EAST_1`, `GCP_US_EAST_4`, `GCP_US_WEST_1`, `GCP_US_WEST_2`. Defaults to `AWS_AP_NORTHEAST_1`.",
@@ -276,6 +302,9 @@ func (r *BotDefenseAppInfrastructureResource) Schema(ctx context.Context, req re
 					"infra_host_name": schema.StringAttribute{
 						MarkdownDescription: "Infra Host Name. Infra Host Name .",
 						Optional:            true,
+						Validators: []validator.String{
+							validators.HostnameValidator(),
+						},
 					},
 					"region": schema.StringAttribute{
 						MarkdownDescription: "[Enum: US|EU|ASIA] Defines a selection for Bot Defense Advanced region - US: US US region - EU: EU European Union region - ASIA: ASIA Asia region. Possible values are `US`, `EU`, `ASIA`. Defaults to `US`.",
@@ -290,6 +319,9 @@ func (r *BotDefenseAppInfrastructureResource) Schema(ctx context.Context, req re
 								"ip_address": schema.StringAttribute{
 									MarkdownDescription: "IP Address. Egress IP address .",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.IPValidator(),
+									},
 								},
 								"location": schema.StringAttribute{
 									MarkdownDescription: "[Enum: AWS_AP_NORTHEAST_1|AWS_AP_NORTHEAST_3|AWS_AP_SOUTH_1|AWS_AP_SOUTH_2|AWS_AP_SOUTHEAST_1|AWS_AP_SOUTHEAST_2|AWS_AP_SOUTHEAST_3|AWS_EU_CENTRAL_1|AWS_EU_NORTH_1|AWS_EU_WEST_1|AWS_ME_SOUTH_1|AWS_SA_EAST_1|AWS_US_EAST_1|AWS_US_EAST_2|AWS_US_WEST_1|AWS_US_WEST_2|GCP_ASIA_EAST_1|GCP_ASIA_EAST_2|GCP_ASIA_NORTHEAST_1|GCP_ASIA_NORTHEAST_2|GCP_ASIA_NORTHEAST_3|GCP_ASIA_SOUTH_1|GCP_ASIA_SOUTHEAST_1|GCP_ASIA_SOUTHEAST_2|GCP_AUSTRALIA_SOUTHEAST_1|GCP_EUROPE_WEST_1|GCP_EUROPE_WEST_2|GCP_EUROPE_WEST_3|GCP_NORTHAMERICA_NORTHEAST_1|GCP_NORTHAMERICA_NORTHEAST_2|GCP_SOUTHAMERICA_EAST_1|GCP_SOUTHAMERICA_WEST_1|GCP_US_CENTRAL_1|GCP_US_EAST_1|GCP_US_EAST_4|GCP_US_WEST_1|GCP_US_WEST_2] Region location AWS_AP_NORTHEAST_1 AWS_AP_NORTHEAST_3 AWS_AP_SOUTH_1 AWS_AP_SOUTH_2 AWS_AP_SOUTHEAST_1 AWS_AP_SOUTHEAST_2 AWS_AP_SOUTHEAST_3 AWS_EU_CENTRAL_1 AWS_EU_NORTH_1 AWS_EU_WEST_1 AWS_ME_SOUTH_1 AWS_SA_EAST_1 AWS_US_EAST_1 AWS_US_EAST_2 AWS_US_WEST_1 AWS_US_WEST_2 GCP_ASIA_EAST_1.. Possible values are `AWS_AP_NORTHEAST_1`, `AWS_AP_NORTHEAST_3`, `AWS_AP_SOUTH_1`, `AWS_AP_SOUTH_2`, `AWS_AP_SOUTHEAST_1`, `AWS_AP_SOUTHEAST_2`, `AWS_AP_SOUTHEAST_3`, `AWS_EU_CENTRAL_1`, `AWS_EU_NORTH_1`, `AWS_EU_WEST_1`, `AWS_ME_SOUTH_1`, `AWS_SA_EAST_1`, `AWS_US_EAST_1`, `AWS_US_EAST_2`, `AWS_US_WEST_1`, `AWS_US_WEST_2`, `GCP_ASIA_EAST_1`, `GCP_ASIA_EAST_2`, `GCP_ASIA_NORTHEAST_1`, `GCP_ASIA_NORTHEAST_2`, `GCP_ASIA_NORTHEAST_3`, `GCP_ASIA_SOUTH_1`, `GCP_ASIA_SOUTHEAST_1`, `GCP_ASIA_SOUTHEAST_2`, `GCP_AUSTRALIA_SOUTHEAST_1`, `GCP_EUROPE_WEST_1`, `GCP_EUROPE_WEST_2`, `GCP_EUROPE_WEST_3`, `GCP_NORTHAMERICA_NORTHEAST_1`, `GCP_NORTHAMERICA_NORTHEAST_2`, `GCP_SOUTHAMERICA_EAST_1`, `GCP_SOUTHAMERICA_WEST_1`, `GCP_US_CENTRAL_1`, `GCP_US_EAST_1`, `GCP_US_EAST_4`, `GCP_US_WEST_1`, `GCP_US_WEST_2`. Defaults to `AWS_AP_NORTHEAST_1`.",
@@ -300,15 +332,24 @@ func (r *BotDefenseAppInfrastructureResource) Schema(ctx context.Context, req re
 					},
 					"ingress": schema.ListNestedBlock{
 						MarkdownDescription: "Ingress. Ingress .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(3),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"host_name": schema.StringAttribute{
 									MarkdownDescription: "Ingress Host Name.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+									},
 								},
 								"ip_address": schema.StringAttribute{
 									MarkdownDescription: "Ingress IP Address.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.IPValidator(),
+									},
 								},
 								"location": schema.StringAttribute{
 									MarkdownDescription: "[Enum: AWS_AP_NORTHEAST_1|AWS_AP_NORTHEAST_3|AWS_AP_SOUTH_1|AWS_AP_SOUTH_2|AWS_AP_SOUTHEAST_1|AWS_AP_SOUTHEAST_2|AWS_AP_SOUTHEAST_3|AWS_EU_CENTRAL_1|AWS_EU_NORTH_1|AWS_EU_WEST_1|AWS_ME_SOUTH_1|AWS_SA_EAST_1|AWS_US_EAST_1|AWS_US_EAST_2|AWS_US_WEST_1|AWS_US_WEST_2|GCP_ASIA_EAST_1|GCP_ASIA_EAST_2|GCP_ASIA_NORTHEAST_1|GCP_ASIA_NORTHEAST_2|GCP_ASIA_NORTHEAST_3|GCP_ASIA_SOUTH_1|GCP_ASIA_SOUTHEAST_1|GCP_ASIA_SOUTHEAST_2|GCP_AUSTRALIA_SOUTHEAST_1|GCP_EUROPE_WEST_1|GCP_EUROPE_WEST_2|GCP_EUROPE_WEST_3|GCP_NORTHAMERICA_NORTHEAST_1|GCP_NORTHAMERICA_NORTHEAST_2|GCP_SOUTHAMERICA_EAST_1|GCP_SOUTHAMERICA_WEST_1|GCP_US_CENTRAL_1|GCP_US_EAST_1|GCP_US_EAST_4|GCP_US_WEST_1|GCP_US_WEST_2] Region location AWS_AP_NORTHEAST_1 AWS_AP_NORTHEAST_3 AWS_AP_SOUTH_1 AWS_AP_SOUTH_2 AWS_AP_SOUTHEAST_1 AWS_AP_SOUTHEAST_2 AWS_AP_SOUTHEAST_3 AWS_EU_CENTRAL_1 AWS_EU_NORTH_1 AWS_EU_WEST_1 AWS_ME_SOUTH_1 AWS_SA_EAST_1 AWS_US_EAST_1 AWS_US_EAST_2 AWS_US_WEST_1 AWS_US_WEST_2 GCP_ASIA_EAST_1.. Possible values are `AWS_AP_NORTHEAST_1`, `AWS_AP_NORTHEAST_3`, `AWS_AP_SOUTH_1`, `AWS_AP_SOUTH_2`, `AWS_AP_SOUTHEAST_1`, `AWS_AP_SOUTHEAST_2`, `AWS_AP_SOUTHEAST_3`, `AWS_EU_CENTRAL_1`, `AWS_EU_NORTH_1`, `AWS_EU_WEST_1`, `AWS_ME_SOUTH_1`, `AWS_SA_EAST_1`, `AWS_US_EAST_1`, `AWS_US_EAST_2`, `AWS_US_WEST_1`, `AWS_US_WEST_2`, `GCP_ASIA_EAST_1`, `GCP_ASIA_EAST_2`, `GCP_ASIA_NORTHEAST_1`, `GCP_ASIA_NORTHEAST_2`, `GCP_ASIA_NORTHEAST_3`, `GCP_ASIA_SOUTH_1`, `GCP_ASIA_SOUTHEAST_1`, `GCP_ASIA_SOUTHEAST_2`, `GCP_AUSTRALIA_SOUTHEAST_1`, `GCP_EUROPE_WEST_1`, `GCP_EUROPE_WEST_2`, `GCP_EUROPE_WEST_3`, `GCP_NORTHAMERICA_NORTHEAST_1`, `GCP_NORTHAMERICA_NORTHEAST_2`, `GCP_SOUTHAMERICA_EAST_1`, `GCP_SOUTHAMERICA_WEST_1`, `GCP_US_CENTRAL_1`, `GCP_US_EAST_1`, `GCP_US_EAST_4`, `GCP_US_WEST_1`, `GCP_US_WEST_2`. Defaults to `AWS_AP_NORTHEAST_1`.",
@@ -323,6 +364,11 @@ func (r *BotDefenseAppInfrastructureResource) Schema(ctx context.Context, req re
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *BotDefenseAppInfrastructureResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *BotDefenseAppInfrastructureResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -347,6 +393,16 @@ func (r *BotDefenseAppInfrastructureResource) ValidateConfig(ctx context.Context
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *BotDefenseAppInfrastructureResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "infra_choice", "cloud_hosted", "data_center_hosted"),
+		validators.OneOfGroup(path.MatchRoot("cloud_hosted").AtName("ingress").AtAnyListIndex(), "type_choice", "host_name", "ip_address"),
+		validators.OneOfGroup(path.MatchRoot("data_center_hosted").AtName("ingress").AtAnyListIndex(), "type_choice", "host_name", "ip_address"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *BotDefenseAppInfrastructureResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -357,7 +413,10 @@ func (r *BotDefenseAppInfrastructureResource) ModifyPlan(ctx context.Context, re
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "bot_defense_app_infrastructure", "", req.Config, &resp.Plan)...)
 		var plan BotDefenseAppInfrastructureResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -414,6 +473,7 @@ func (r *BotDefenseAppInfrastructureResource) Create(ctx context.Context, req re
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -423,6 +483,7 @@ func (r *BotDefenseAppInfrastructureResource) Create(ctx context.Context, req re
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.CloudHosted != nil {
@@ -516,11 +577,15 @@ func (r *BotDefenseAppInfrastructureResource) Create(ctx context.Context, req re
 
 	apiResource, err := r.client.CreateBotDefenseAppInfrastructure(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create BotDefenseAppInfrastructure: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bot_defense_app_infrastructure", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -704,7 +769,7 @@ func (r *BotDefenseAppInfrastructureResource) Read(ctx context.Context, req reso
 	apiResource, err := r.client.GetBotDefenseAppInfrastructure(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "BotDefenseAppInfrastructure not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -712,11 +777,12 @@ func (r *BotDefenseAppInfrastructureResource) Read(ctx context.Context, req reso
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read BotDefenseAppInfrastructure: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bot_defense_app_infrastructure", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -727,31 +793,13 @@ func (r *BotDefenseAppInfrastructureResource) Read(ctx context.Context, req reso
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -759,6 +807,7 @@ func (r *BotDefenseAppInfrastructureResource) Read(ctx context.Context, req reso
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["cloud_hosted"].(map[string]interface{}); ok && (isImport || data.CloudHosted != nil) {
 		data.CloudHosted = &BotDefenseAppInfrastructureCloudHostedModel{
@@ -954,6 +1003,7 @@ func (r *BotDefenseAppInfrastructureResource) Update(ctx context.Context, req re
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -963,6 +1013,7 @@ func (r *BotDefenseAppInfrastructureResource) Update(ctx context.Context, req re
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.CloudHosted != nil {
@@ -1056,7 +1107,7 @@ func (r *BotDefenseAppInfrastructureResource) Update(ctx context.Context, req re
 
 	_, err := r.client.UpdateBotDefenseAppInfrastructure(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update BotDefenseAppInfrastructure: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bot_defense_app_infrastructure", "update"))
 		return
 	}
 
@@ -1067,9 +1118,12 @@ func (r *BotDefenseAppInfrastructureResource) Update(ctx context.Context, req re
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetBotDefenseAppInfrastructure(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read BotDefenseAppInfrastructure after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "bot_defense_app_infrastructure", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["environment_type"].(string); ok && v != "" {
@@ -1267,7 +1321,7 @@ func (r *BotDefenseAppInfrastructureResource) Delete(ctx context.Context, req re
 	err := r.client.DeleteBotDefenseAppInfrastructure(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "BotDefenseAppInfrastructure already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1276,37 +1330,33 @@ func (r *BotDefenseAppInfrastructureResource) Delete(ctx context.Context, req re
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "BotDefenseAppInfrastructure delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete BotDefenseAppInfrastructure: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "bot_defense_app_infrastructure", "delete"))
 		return
 	}
 }
 
 func (r *BotDefenseAppInfrastructureResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *BotDefenseAppInfrastructureResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("bot_defense_app_infrastructure", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *BotDefenseAppInfrastructureResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListBotDefenseAppInfrastructures(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *BotDefenseAppInfrastructureResource) requiredNamespace() string {
+	return ""
 }
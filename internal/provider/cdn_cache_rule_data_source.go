@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,18 @@ type CDNCacheRuleDataSource struct {
 	client *client.Client
 }
 
+// CDNCacheRuleDataSourceModel mirrors CDNCacheRuleResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type CDNCacheRuleDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object                 `tfsdk:"system_metadata"`
+	Name           types.String                 `tfsdk:"name"`
+	Namespace      types.String                 `tfsdk:"namespace"`
+	Annotations    types.Map                    `tfsdk:"annotations"`
+	Description    types.String                 `tfsdk:"description"`
+	Disable        types.Bool                   `tfsdk:"disable"`
+	Labels         types.Map                    `tfsdk:"labels"`
+	ID             types.String                 `tfsdk:"id"`
+	CacheRules     *CDNCacheRuleCacheRulesModel `tfsdk:"cache_rules"`
 }
 
 func (d *CDNCacheRuleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +45,7 @@ func (d *CDNCacheRuleDataSource) Metadata(ctx context.Context, req datasource.Me
 }
 
 func (d *CDNCacheRuleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a CDN Cache Rule resource in F5 Distributed Cloud for cdn loadbalancer specification. configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the CDNCacheRule.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the CDNCacheRule exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the CDNCacheRule.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewCDNCacheRuleResource())
 }
 
 func (d *CDNCacheRuleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +67,25 @@ func (d *CDNCacheRuleDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	resource, err := d.client.GetCDNCacheRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetCDNCacheRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CDNCacheRule: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cdn_cache_rule", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +99,8 @@ func (d *CDNCacheRuleDataSource) Read(ctx context.Context, req datasource.ReadRe
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +109,62 @@ func (d *CDNCacheRuleDataSource) Read(ctx context.Context, req datasource.ReadRe
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["cache_rules"].(map[string]interface{}); ok && (isImport || data.CacheRules != nil) {
+		data.CacheRules = &CDNCacheRuleCacheRulesModel{
+			CacheBypass: func() *CDNCacheRuleEmptyModel {
+				if !isImport && data.CacheRules != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.CacheRules.CacheBypass
+				}
+				// Import case: read from API
+				if _, ok := blockData["cache_bypass"].(map[string]interface{}); ok {
+					return &CDNCacheRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			EligibleForCache: func() *CDNCacheRuleCacheRulesEligibleForCacheModel {
+				if !isImport && data.CacheRules != nil && data.CacheRules.EligibleForCache != nil {
+					// Normal Read: preserve existing state value
+					return data.CacheRules.EligibleForCache
+				}
+				// Import case: read from API
+				if _, ok := blockData["eligible_for_cache"].(map[string]interface{}); ok {
+					return &CDNCacheRuleCacheRulesEligibleForCacheModel{}
+				}
+				return nil
+			}(),
+			RuleExpressionList: func() []CDNCacheRuleCacheRulesRuleExpressionListModel {
+				if listData, ok := blockData["rule_expression_list"].([]interface{}); ok && len(listData) > 0 {
+					var result []CDNCacheRuleCacheRulesRuleExpressionListModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, CDNCacheRuleCacheRulesRuleExpressionListModel{
+								ExpressionName: func() types.String {
+									if v, ok := itemMap["expression_name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			RuleName: func() types.String {
+				if v, ok := blockData["rule_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &CDNCacheRuleResource{}
-	_ resource.ResourceWithConfigure      = &CDNCacheRuleResource{}
-	_ resource.ResourceWithImportState    = &CDNCacheRuleResource{}
-	_ resource.ResourceWithModifyPlan     = &CDNCacheRuleResource{}
-	_ resource.ResourceWithValidateConfig = &CDNCacheRuleResource{}
+	_ resource.Resource                     = &CDNCacheRuleResource{}
+	_ resource.ResourceWithConfigure        = &CDNCacheRuleResource{}
+	_ resource.ResourceWithImportState      = &CDNCacheRuleResource{}
+	_ resource.ResourceWithIdentity         = &CDNCacheRuleResource{}
+	_ resource.ResourceWithMoveState        = &CDNCacheRuleResource{}
+	_ resource.ResourceWithModifyPlan       = &CDNCacheRuleResource{}
+	_ resource.ResourceWithValidateConfig   = &CDNCacheRuleResource{}
+	_ resource.ResourceWithConfigValidators = &CDNCacheRuleResource{}
+	_ listableResource                      = &CDNCacheRuleResource{}
 )
 
 func NewCDNCacheRuleResource() resource.Resource {
@@ -280,15 +286,17 @@ var CDNCacheRuleCacheRulesRuleExpressionListCacheRuleExpressionQueryParametersOp
 }
 
 type CDNCacheRuleResourceModel struct {
-	Name        types.String                 `tfsdk:"name"`
-	Namespace   types.String                 `tfsdk:"namespace"`
-	Annotations types.Map                    `tfsdk:"annotations"`
-	Description types.String                 `tfsdk:"description"`
-	Disable     types.Bool                   `tfsdk:"disable"`
-	Labels      types.Map                    `tfsdk:"labels"`
-	ID          types.String                 `tfsdk:"id"`
-	Timeouts    timeouts.Value               `tfsdk:"timeouts"`
-	CacheRules  *CDNCacheRuleCacheRulesModel `tfsdk:"cache_rules"`
+	Name           types.String                 `tfsdk:"name"`
+	Namespace      types.String                 `tfsdk:"namespace"`
+	Annotations    types.Map                    `tfsdk:"annotations"`
+	Description    types.String                 `tfsdk:"description"`
+	Disable        types.Bool                   `tfsdk:"disable"`
+	Labels         types.Map                    `tfsdk:"labels"`
+	ID             types.String                 `tfsdk:"id"`
+	Timeouts       timeouts.Value               `tfsdk:"timeouts"`
+	SystemMetadata types.Object                 `tfsdk:"system_metadata"`
+	LabelsAll      types.Map                    `tfsdk:"labels_all"`
+	CacheRules     *CDNCacheRuleCacheRulesModel `tfsdk:"cache_rules"`
 }
 
 func (r *CDNCacheRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -310,9 +318,11 @@ func (r *CDNCacheRuleResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the CDN Cache Rule will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the CDN Cache Rule will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -344,6 +354,8 @@ func (r *CDNCacheRuleResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -358,6 +370,9 @@ func (r *CDNCacheRuleResource) Schema(ctx context.Context, req resource.SchemaRe
 					"rule_name": schema.StringAttribute{
 						MarkdownDescription: "Rule Name. Name of the Cache Rule .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(128),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -406,21 +421,33 @@ func (r *CDNCacheRuleResource) Schema(ctx context.Context, req resource.SchemaRe
 					},
 					"rule_expression_list": schema.ListNestedBlock{
 						MarkdownDescription: "Expressions are evaluated in the order in which they are specified. The evaluation stops when the first rule match occurs. .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(8),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"expression_name": schema.StringAttribute{
 									MarkdownDescription: "Name of the Expressions items that are ANDed .",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
 								"cache_rule_expression": schema.ListNestedBlock{
 									MarkdownDescription: "The Cache Rule Expression Terms that are ANDed .",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(8),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{},
 										Blocks: map[string]schema.Block{
 											"cache_headers": schema.ListNestedBlock{
 												MarkdownDescription: "Configure cache rule headers to match the criteria.",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(8),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"name": schema.StringAttribute{
@@ -463,6 +490,10 @@ func (r *CDNCacheRuleResource) Schema(ctx context.Context, req resource.SchemaRe
 																"match_regex": schema.StringAttribute{
 																	MarkdownDescription: "Field matches PCRE 1 compliant regular expression.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtMost(256),
+																		stringvalidator.UTF8LengthAtLeast(1),
+																	},
 																},
 																"startswith": schema.StringAttribute{
 																	MarkdownDescription: "Field must start with.",
@@ -475,11 +506,17 @@ func (r *CDNCacheRuleResource) Schema(ctx context.Context, req resource.SchemaRe
 											},
 											"cookie_matcher": schema.ListNestedBlock{
 												MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(8),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Case-sensitive cookie name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -517,6 +554,10 @@ func (r *CDNCacheRuleResource) Schema(ctx context.Context, req resource.SchemaRe
 																"match_regex": schema.StringAttribute{
 																	MarkdownDescription: "Field matches PCRE 1 compliant regular expression.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtMost(256),
+																		stringvalidator.UTF8LengthAtLeast(1),
+																	},
 																},
 																"startswith": schema.StringAttribute{
 																	MarkdownDescription: "Field must start with.",
@@ -565,6 +606,10 @@ func (r *CDNCacheRuleResource) Schema(ctx context.Context, req resource.SchemaRe
 															"match_regex": schema.StringAttribute{
 																MarkdownDescription: "Field matches PCRE 1 compliant regular expression.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.UTF8LengthAtMost(256),
+																	stringvalidator.UTF8LengthAtLeast(1),
+																},
 															},
 															"startswith": schema.StringAttribute{
 																MarkdownDescription: "Field must start with.",
@@ -576,11 +621,18 @@ func (r *CDNCacheRuleResource) Schema(ctx context.Context, req resource.SchemaRe
 											},
 											"query_parameters": schema.ListNestedBlock{
 												MarkdownDescription: "Query Parameters. List of (key, value) query parameters.",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(8),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"key": schema.StringAttribute{
 															MarkdownDescription: "Query parameter key In the above example, assignee_username is the key .",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -618,6 +670,10 @@ func (r *CDNCacheRuleResource) Schema(ctx context.Context, req resource.SchemaRe
 																"match_regex": schema.StringAttribute{
 																	MarkdownDescription: "Field matches PCRE 1 compliant regular expression.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtMost(256),
+																		stringvalidator.UTF8LengthAtLeast(1),
+																	},
 																},
 																"startswith": schema.StringAttribute{
 																	MarkdownDescription: "Field must start with.",
@@ -640,6 +696,11 @@ func (r *CDNCacheRuleResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *CDNCacheRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *CDNCacheRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -664,6 +725,19 @@ func (r *CDNCacheRuleResource) ValidateConfig(ctx context.Context, req resource.
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *CDNCacheRuleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("cache_rules"), "cache_actions", "cache_bypass", "eligible_for_cache"),
+		validators.OneOfGroup(path.MatchRoot("cache_rules").AtName("eligible_for_cache"), "eligible_for_cache", "scheme_proxy_host_request_uri", "scheme_proxy_host_uri"),
+		validators.OneOfGroup(path.MatchRoot("cache_rules").AtName("rule_expression_list").AtAnyListIndex().AtName("cache_rule_expression").AtAnyListIndex().AtName("cache_headers").AtAnyListIndex().AtName("operator"), "cache_operator", "contains", "does_not_contain", "does_not_end_with", "does_not_equal", "does_not_start_with", "endswith", "equals", "match_regex", "startswith"),
+		validators.OneOfGroup(path.MatchRoot("cache_rules").AtName("rule_expression_list").AtAnyListIndex().AtName("cache_rule_expression").AtAnyListIndex().AtName("cookie_matcher").AtAnyListIndex().AtName("operator"), "cache_operator", "contains", "does_not_contain", "does_not_end_with", "does_not_equal", "does_not_start_with", "endswith", "equals", "match_regex", "startswith"),
+		validators.OneOfGroup(path.MatchRoot("cache_rules").AtName("rule_expression_list").AtAnyListIndex().AtName("cache_rule_expression").AtAnyListIndex().AtName("path_match").AtName("operator"), "cache_operator", "contains", "does_not_contain", "does_not_end_with", "does_not_equal", "does_not_start_with", "endswith", "equals", "match_regex", "startswith"),
+		validators.OneOfGroup(path.MatchRoot("cache_rules").AtName("rule_expression_list").AtAnyListIndex().AtName("cache_rule_expression").AtAnyListIndex().AtName("query_parameters").AtAnyListIndex().AtName("operator"), "cache_operator", "contains", "does_not_contain", "does_not_end_with", "does_not_equal", "does_not_start_with", "endswith", "equals", "match_regex", "startswith"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *CDNCacheRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -674,7 +748,10 @@ func (r *CDNCacheRuleResource) ModifyPlan(ctx context.Context, req resource.Modi
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "cdn_cache_rule", "", req.Config, &resp.Plan)...)
 		var plan CDNCacheRuleResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -731,6 +808,7 @@ func (r *CDNCacheRuleResource) Create(ctx context.Context, req resource.CreateRe
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -740,6 +818,7 @@ func (r *CDNCacheRuleResource) Create(ctx context.Context, req resource.CreateRe
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.CacheRules != nil {
@@ -749,6 +828,32 @@ func (r *CDNCacheRuleResource) Create(ctx context.Context, req resource.CreateRe
 		}
 		if data.CacheRules.EligibleForCache != nil {
 			eligible_for_cacheNestedMap := make(map[string]interface{})
+			if data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI != nil {
+				scheme_proxy_host_request_uriLevel3Map := make(map[string]interface{})
+				if !data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.CacheOverride.IsNull() && !data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.CacheOverride.IsUnknown() {
+					scheme_proxy_host_request_uriLevel3Map["cache_override"] = data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.CacheOverride.ValueBool()
+				}
+				if !data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.CacheTTL.IsNull() && !data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.CacheTTL.IsUnknown() {
+					scheme_proxy_host_request_uriLevel3Map["cache_ttl"] = data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.CacheTTL.ValueString()
+				}
+				if !data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.IgnoreResponseCookie.IsNull() && !data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.IgnoreResponseCookie.IsUnknown() {
+					scheme_proxy_host_request_uriLevel3Map["ignore_response_cookie"] = data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.IgnoreResponseCookie.ValueBool()
+				}
+				eligible_for_cacheNestedMap["scheme_proxy_host_request_uri"] = scheme_proxy_host_request_uriLevel3Map
+			}
+			if data.CacheRules.EligibleForCache.SchemeProxyHostURI != nil {
+				scheme_proxy_host_uriLevel3Map := make(map[string]interface{})
+				if !data.CacheRules.EligibleForCache.SchemeProxyHostURI.CacheOverride.IsNull() && !data.CacheRules.EligibleForCache.SchemeProxyHostURI.CacheOverride.IsUnknown() {
+					scheme_proxy_host_uriLevel3Map["cache_override"] = data.CacheRules.EligibleForCache.SchemeProxyHostURI.CacheOverride.ValueBool()
+				}
+				if !data.CacheRules.EligibleForCache.SchemeProxyHostURI.CacheTTL.IsNull() && !data.CacheRules.EligibleForCache.SchemeProxyHostURI.CacheTTL.IsUnknown() {
+					scheme_proxy_host_uriLevel3Map["cache_ttl"] = data.CacheRules.EligibleForCache.SchemeProxyHostURI.CacheTTL.ValueString()
+				}
+				if !data.CacheRules.EligibleForCache.SchemeProxyHostURI.IgnoreResponseCookie.IsNull() && !data.CacheRules.EligibleForCache.SchemeProxyHostURI.IgnoreResponseCookie.IsUnknown() {
+					scheme_proxy_host_uriLevel3Map["ignore_response_cookie"] = data.CacheRules.EligibleForCache.SchemeProxyHostURI.IgnoreResponseCookie.ValueBool()
+				}
+				eligible_for_cacheNestedMap["scheme_proxy_host_uri"] = scheme_proxy_host_uriLevel3Map
+			}
 			cache_rulesMap["eligible_for_cache"] = eligible_for_cacheNestedMap
 		}
 		if len(data.CacheRules.RuleExpressionList) > 0 {
@@ -770,11 +875,15 @@ func (r *CDNCacheRuleResource) Create(ctx context.Context, req resource.CreateRe
 
 	apiResource, err := r.client.CreateCDNCacheRule(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create CDNCacheRule: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cdn_cache_rule", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -856,7 +965,7 @@ func (r *CDNCacheRuleResource) Read(ctx context.Context, req resource.ReadReques
 	apiResource, err := r.client.GetCDNCacheRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "CDNCacheRule not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -864,11 +973,12 @@ func (r *CDNCacheRuleResource) Read(ctx context.Context, req resource.ReadReques
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CDNCacheRule: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cdn_cache_rule", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -879,31 +989,13 @@ func (r *CDNCacheRuleResource) Read(ctx context.Context, req resource.ReadReques
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -911,6 +1003,7 @@ func (r *CDNCacheRuleResource) Read(ctx context.Context, req resource.ReadReques
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["cache_rules"].(map[string]interface{}); ok && (isImport || data.CacheRules != nil) {
 		data.CacheRules = &CDNCacheRuleCacheRulesModel{
@@ -1004,6 +1097,7 @@ func (r *CDNCacheRuleResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1013,6 +1107,7 @@ func (r *CDNCacheRuleResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.CacheRules != nil {
@@ -1022,6 +1117,32 @@ func (r *CDNCacheRuleResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		if data.CacheRules.EligibleForCache != nil {
 			eligible_for_cacheNestedMap := make(map[string]interface{})
+			if data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI != nil {
+				scheme_proxy_host_request_uriLevel3Map := make(map[string]interface{})
+				if !data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.CacheOverride.IsNull() && !data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.CacheOverride.IsUnknown() {
+					scheme_proxy_host_request_uriLevel3Map["cache_override"] = data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.CacheOverride.ValueBool()
+				}
+				if !data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.CacheTTL.IsNull() && !data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.CacheTTL.IsUnknown() {
+					scheme_proxy_host_request_uriLevel3Map["cache_ttl"] = data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.CacheTTL.ValueString()
+				}
+				if !data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.IgnoreResponseCookie.IsNull() && !data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.IgnoreResponseCookie.IsUnknown() {
+					scheme_proxy_host_request_uriLevel3Map["ignore_response_cookie"] = data.CacheRules.EligibleForCache.SchemeProxyHostRequestURI.IgnoreResponseCookie.ValueBool()
+				}
+				eligible_for_cacheNestedMap["scheme_proxy_host_request_uri"] = scheme_proxy_host_request_uriLevel3Map
+			}
+			if data.CacheRules.EligibleForCache.SchemeProxyHostURI != nil {
+				scheme_proxy_host_uriLevel3Map := make(map[string]interface{})
+				if !data.CacheRules.EligibleForCache.SchemeProxyHostURI.CacheOverride.IsNull() && !data.CacheRules.EligibleForCache.SchemeProxyHostURI.CacheOverride.IsUnknown() {
+					scheme_proxy_host_uriLevel3Map["cache_override"] = data.CacheRules.EligibleForCache.SchemeProxyHostURI.CacheOverride.ValueBool()
+				}
+				if !data.CacheRules.EligibleForCache.SchemeProxyHostURI.CacheTTL.IsNull() && !data.CacheRules.EligibleForCache.SchemeProxyHostURI.CacheTTL.IsUnknown() {
+					scheme_proxy_host_uriLevel3Map["cache_ttl"] = data.CacheRules.EligibleForCache.SchemeProxyHostURI.CacheTTL.ValueString()
+				}
+				if !data.CacheRules.EligibleForCache.SchemeProxyHostURI.IgnoreResponseCookie.IsNull() && !data.CacheRules.EligibleForCache.SchemeProxyHostURI.IgnoreResponseCookie.IsUnknown() {
+					scheme_proxy_host_uriLevel3Map["ignore_response_cookie"] = data.CacheRules.EligibleForCache.SchemeProxyHostURI.IgnoreResponseCookie.ValueBool()
+				}
+				eligible_for_cacheNestedMap["scheme_proxy_host_uri"] = scheme_proxy_host_uriLevel3Map
+			}
 			cache_rulesMap["eligible_for_cache"] = eligible_for_cacheNestedMap
 		}
 		if len(data.CacheRules.RuleExpressionList) > 0 {
@@ -1043,7 +1164,7 @@ func (r *CDNCacheRuleResource) Update(ctx context.Context, req resource.UpdateRe
 
 	_, err := r.client.UpdateCDNCacheRule(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update CDNCacheRule: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cdn_cache_rule", "update"))
 		return
 	}
 
@@ -1054,9 +1175,12 @@ func (r *CDNCacheRuleResource) Update(ctx context.Context, req resource.UpdateRe
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetCDNCacheRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CDNCacheRule after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "cdn_cache_rule", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1138,7 +1262,7 @@ func (r *CDNCacheRuleResource) Delete(ctx context.Context, req resource.DeleteRe
 	err := r.client.DeleteCDNCacheRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "CDNCacheRule already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1147,37 +1271,33 @@ func (r *CDNCacheRuleResource) Delete(ctx context.Context, req resource.DeleteRe
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "CDNCacheRule delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete CDNCacheRule: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cdn_cache_rule", "delete"))
 		return
 	}
 }
 
 func (r *CDNCacheRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *CDNCacheRuleResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("cdn_cache_rule", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *CDNCacheRuleResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListCDNCacheRules(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *CDNCacheRuleResource) requiredNamespace() string {
+	return ""
 }
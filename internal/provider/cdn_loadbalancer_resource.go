@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &CDNLoadBalancerResource{}
-	_ resource.ResourceWithConfigure      = &CDNLoadBalancerResource{}
-	_ resource.ResourceWithImportState    = &CDNLoadBalancerResource{}
-	_ resource.ResourceWithModifyPlan     = &CDNLoadBalancerResource{}
-	_ resource.ResourceWithValidateConfig = &CDNLoadBalancerResource{}
+	_ resource.Resource                     = &CDNLoadBalancerResource{}
+	_ resource.ResourceWithConfigure        = &CDNLoadBalancerResource{}
+	_ resource.ResourceWithImportState      = &CDNLoadBalancerResource{}
+	_ resource.ResourceWithIdentity         = &CDNLoadBalancerResource{}
+	_ resource.ResourceWithMoveState        = &CDNLoadBalancerResource{}
+	_ resource.ResourceWithModifyPlan       = &CDNLoadBalancerResource{}
+	_ resource.ResourceWithValidateConfig   = &CDNLoadBalancerResource{}
+	_ resource.ResourceWithConfigValidators = &CDNLoadBalancerResource{}
+	_ listableResource                      = &CDNLoadBalancerResource{}
 )
 
 func NewCDNLoadBalancerResource() resource.Resource {
@@ -2917,14 +2924,16 @@ var CDNLoadBalancerEnableAPIDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLogi
 
 // CDNLoadBalancerEnableAPIDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModel represents clear_secret_info block
 type CDNLoadBalancerEnableAPIDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CDNLoadBalancerEnableAPIDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModelAttrTypes defines the attribute types for CDNLoadBalancerEnableAPIDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModel
 var CDNLoadBalancerEnableAPIDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CDNLoadBalancerEnableAPIDiscoveryAPIDiscoveryFromCodeScanModel represents api_discovery_from_code_scan block
@@ -3367,14 +3376,16 @@ var CDNLoadBalancerHTTPSTLSCertOptionsTLSInlineParamsTLSCertificatesPrivateKeyBl
 
 // CDNLoadBalancerHTTPSTLSCertOptionsTLSInlineParamsTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type CDNLoadBalancerHTTPSTLSCertOptionsTLSInlineParamsTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CDNLoadBalancerHTTPSTLSCertOptionsTLSInlineParamsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for CDNLoadBalancerHTTPSTLSCertOptionsTLSInlineParamsTLSCertificatesPrivateKeyClearSecretInfoModel
 var CDNLoadBalancerHTTPSTLSCertOptionsTLSInlineParamsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CDNLoadBalancerHTTPSTLSCertOptionsTLSInlineParamsTLSConfigModel represents tls_config block
@@ -3859,14 +3870,16 @@ var CDNLoadBalancerOriginPoolUseTLSUseMtlsTLSCertificatesPrivateKeyBlindfoldSecr
 
 // CDNLoadBalancerOriginPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type CDNLoadBalancerOriginPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CDNLoadBalancerOriginPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for CDNLoadBalancerOriginPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModel
 var CDNLoadBalancerOriginPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CDNLoadBalancerOriginPoolUseTLSUseMtlsObjModel represents use_mtls_obj block
@@ -3983,14 +3996,16 @@ var CDNLoadBalancerOtherSettingsHeaderOptionsRequestHeadersToAddSecretValueBlind
 
 // CDNLoadBalancerOtherSettingsHeaderOptionsRequestHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type CDNLoadBalancerOtherSettingsHeaderOptionsRequestHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CDNLoadBalancerOtherSettingsHeaderOptionsRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for CDNLoadBalancerOtherSettingsHeaderOptionsRequestHeadersToAddSecretValueClearSecretInfoModel
 var CDNLoadBalancerOtherSettingsHeaderOptionsRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CDNLoadBalancerOtherSettingsHeaderOptionsResponseHeadersToAddModel represents response_headers_to_add block
@@ -4037,14 +4052,16 @@ var CDNLoadBalancerOtherSettingsHeaderOptionsResponseHeadersToAddSecretValueBlin
 
 // CDNLoadBalancerOtherSettingsHeaderOptionsResponseHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type CDNLoadBalancerOtherSettingsHeaderOptionsResponseHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CDNLoadBalancerOtherSettingsHeaderOptionsResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for CDNLoadBalancerOtherSettingsHeaderOptionsResponseHeadersToAddSecretValueClearSecretInfoModel
 var CDNLoadBalancerOtherSettingsHeaderOptionsResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CDNLoadBalancerOtherSettingsLoggingOptionsModel represents logging_options block
@@ -4993,6 +5010,8 @@ type CDNLoadBalancerResourceModel struct {
 	Labels                        types.Map                                    `tfsdk:"labels"`
 	ID                            types.String                                 `tfsdk:"id"`
 	Timeouts                      timeouts.Value                               `tfsdk:"timeouts"`
+	SystemMetadata                types.Object                                 `tfsdk:"system_metadata"`
+	LabelsAll                     types.Map                                    `tfsdk:"labels_all"`
 	ActiveServicePolicies         *CDNLoadBalancerActiveServicePoliciesModel   `tfsdk:"active_service_policies"`
 	APIRateLimit                  *CDNLoadBalancerAPIRateLimitModel            `tfsdk:"api_rate_limit"`
 	APISpecification              *CDNLoadBalancerAPISpecificationModel        `tfsdk:"api_specification"`
@@ -5066,9 +5085,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the CDN Load Balancer will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the CDN Load Balancer will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -5092,6 +5113,12 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "List of fully qualified domain names. The CDN Distribution will be setup for these FQDN name(s). [This can be a domain or a sub-domain] .",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(validators.HostnameValidator(), stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1), validators.PatternValidator("[\\.]+[A-Za-z]+")),
+				},
 			},
 			"labels": schema.MapAttribute{
 				MarkdownDescription: "Labels is a user defined key value map that can be attached to resources for organization and filtering.",
@@ -5105,6 +5132,8 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -5119,11 +5148,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				Blocks: map[string]schema.Block{
 					"policies": schema.ListNestedBlock{
 						MarkdownDescription: "Service Policies is a sequential engine where policies (and rules within the policy) are evaluated one after the other. It's important to define the correct order (policies evaluated from top to bottom in the list) for service policies, to GET the intended result. For each request, its..",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -5132,6 +5168,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -5140,6 +5179,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -5152,15 +5194,24 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				Blocks: map[string]schema.Block{
 					"api_endpoint_rules": schema.ListNestedBlock{
 						MarkdownDescription: "Sets of rules for a specific endpoints. Order is matter as it uses first match policy. For creating rule that contain a whole domain or group of endpoints, please use the server URL rules above.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(20),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"api_endpoint_path": schema.StringAttribute{
 									MarkdownDescription: "The endpoint (path) of the request.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(1024),
+									},
 								},
 								"specific_domain": schema.StringAttribute{
 									MarkdownDescription: "The rule will apply for a specific domain.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -5178,6 +5229,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] List of methods values to match against. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 								},
@@ -5198,6 +5253,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 													Optional:            true,
 													ElementType:         types.Int64Type,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -5207,6 +5267,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											Blocks: map[string]schema.Block{
 												"asn_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to bgp_asn_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -5257,6 +5320,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -5271,6 +5338,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											Blocks: map[string]schema.Block{
 												"prefix_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to ip_prefix_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -5325,6 +5395,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -5335,6 +5410,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "[Enum: SPAM_SOURCES|WINDOWS_EXPLOITS|WEB_ATTACKS|BOTNETS|SCANNERS|REPUTATION|PHISHING|PROXY|MOBILE_THREATS|TOR_PROXY|DENIAL_OF_SERVICE|NETWORK] The IP threat categories is obtained from the list and is used to auto-generate equivalent label selection expressions . Possible values are `SPAM_SOURCES`, `WINDOWS_EXPLOITS`, `WEB_ATTACKS`, `BOTNETS`, `SCANNERS`, `REPUTATION`, `PHISHING`, `PROXY`, `MOBILE_THREATS`, `TOR_PROXY`, `DENIAL_OF_SERVICE`, `NETWORK`. Defaults to `SPAM_SOURCES`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -5345,16 +5424,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 												"exact_values": schema.ListAttribute{
 													MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 												"excluded_values": schema.ListAttribute{
 													MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 											},
 										},
@@ -5366,6 +5459,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"threshold": schema.Int64Attribute{
 											MarkdownDescription: "The total number of allowed requests for 1 unit (e.g. SECOND/MINUTE/HOUR etc.) of the specified period.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+												int64validator.AtMost(8192),
+											},
 										},
 										"unit": schema.StringAttribute{
 											MarkdownDescription: "[Enum: SECOND|MINUTE|HOUR] Unit for the period per which the rate limit is applied. - SECOND: Second Rate limit period unit is seconds - MINUTE: Minute Rate limit period unit is minutes - HOUR: Hour Rate limit period unit is hours - DAY: Day Rate limit period unit is days. Possible values are `SECOND`, `MINUTE`, `HOUR`. Defaults to `SECOND`.",
@@ -5379,6 +5476,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -5387,6 +5488,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -5395,6 +5499,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -5409,6 +5516,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -5417,6 +5528,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -5425,6 +5539,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -5434,6 +5551,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									Blocks: map[string]schema.Block{
 										"cookie_matchers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -5443,6 +5563,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive cookie name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -5459,16 +5582,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -5477,6 +5614,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										},
 										"headers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -5486,6 +5626,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-insensitive HTTP header name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -5502,16 +5645,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -5520,6 +5677,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										},
 										"jwt_claims": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various JWT claims that need to match. The criteria for matching each JWT claim are described in individual JWTClaimMatcherType instances. The actual JWT claims values are extracted from the JWT payload as a list of strings.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -5529,6 +5689,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"name": schema.StringAttribute{
 														MarkdownDescription: "JWT Claim Name. JWT claim name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -5545,16 +5708,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -5563,6 +5740,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										},
 										"query_params": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -5572,6 +5752,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"key": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -5588,16 +5771,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -5615,15 +5812,24 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						Blocks: map[string]schema.Block{
 							"bypass_rate_limiting_rules": schema.ListNestedBlock{
 								MarkdownDescription: "Category defines rules per URL or API group. If request matches any of these rules, skip Rate Limiting.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(20),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"base_path": schema.StringAttribute{
 											MarkdownDescription: "The base path which this validation applies to.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"specific_domain": schema.StringAttribute{
 											MarkdownDescription: "The rule will apply for a specific domain. For",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -5640,10 +5846,17 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] Methods. Methods to be matched. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 												"path": schema.StringAttribute{
 													MarkdownDescription: "Path. Path to be matched .",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(1024),
+													},
 												},
 											},
 										},
@@ -5654,6 +5867,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "API Groups. .",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -5674,6 +5892,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 															Optional:            true,
 															ElementType:         types.Int64Type,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -5683,6 +5906,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													Blocks: map[string]schema.Block{
 														"asn_sets": schema.ListNestedBlock{
 															MarkdownDescription: "List of references to bgp_asn_set objects.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"kind": schema.StringAttribute{
@@ -5733,6 +5959,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(1),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+															},
 														},
 													},
 												},
@@ -5747,6 +5977,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													Blocks: map[string]schema.Block{
 														"prefix_sets": schema.ListNestedBlock{
 															MarkdownDescription: "List of references to ip_prefix_set objects.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"kind": schema.StringAttribute{
@@ -5801,6 +6034,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(128),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 													},
 												},
@@ -5811,6 +6049,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "[Enum: SPAM_SOURCES|WINDOWS_EXPLOITS|WEB_ATTACKS|BOTNETS|SCANNERS|REPUTATION|PHISHING|PROXY|MOBILE_THREATS|TOR_PROXY|DENIAL_OF_SERVICE|NETWORK] The IP threat categories is obtained from the list and is used to auto-generate equivalent label selection expressions . Possible values are `SPAM_SOURCES`, `WINDOWS_EXPLOITS`, `WEB_ATTACKS`, `BOTNETS`, `SCANNERS`, `REPUTATION`, `PHISHING`, `PROXY`, `MOBILE_THREATS`, `TOR_PROXY`, `DENIAL_OF_SERVICE`, `NETWORK`. Defaults to `SPAM_SOURCES`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(32),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -5821,16 +6063,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+															},
 														},
 														"exact_values": schema.ListAttribute{
 															MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+															},
 														},
 														"excluded_values": schema.ListAttribute{
 															MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(32),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+															},
 														},
 													},
 												},
@@ -5842,6 +6098,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											Blocks: map[string]schema.Block{
 												"cookie_matchers": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -5851,6 +6110,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															"name": schema.StringAttribute{
 																MarkdownDescription: "Case-sensitive cookie name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -5867,16 +6129,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -5885,6 +6161,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												},
 												"headers": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -5894,6 +6173,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															"name": schema.StringAttribute{
 																MarkdownDescription: "Case-insensitive HTTP header name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -5910,16 +6192,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -5928,6 +6224,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												},
 												"jwt_claims": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for various JWT claims that need to match. The criteria for matching each JWT claim are described in individual JWTClaimMatcherType instances. The actual JWT claims values are extracted from the JWT payload as a list of strings.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -5937,6 +6236,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															"name": schema.StringAttribute{
 																MarkdownDescription: "JWT Claim Name. JWT claim name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -5953,16 +6255,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -5971,6 +6287,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												},
 												"query_params": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -5980,6 +6299,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															"key": schema.StringAttribute{
 																MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -5996,16 +6318,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -6025,11 +6361,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						Blocks: map[string]schema.Block{
 							"rate_limiter_allowed_prefixes": schema.ListNestedBlock{
 								MarkdownDescription: "References to ip_prefix_set objects. Requests from source IP addresses that are covered by one of the allowed IP Prefixes are not subjected to rate limiting.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(4),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -6038,6 +6381,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -6046,6 +6392,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -6059,6 +6408,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+								},
 							},
 						},
 					},
@@ -6067,19 +6421,31 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 					},
 					"server_url_rules": schema.ListNestedBlock{
 						MarkdownDescription: "Set of rules for entire domain or base path that contain multiple endpoints. Order is matter as it uses first match policy. For matching also specific endpoints you can use the API endpoint rules set bellow.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(20),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"api_group": schema.StringAttribute{
 									MarkdownDescription: "API groups derived from API Definition swaggers. For example oas-all-operations including all paths and methods from the swaggers, oas-base-URLs covering all requests under base-paths from the swaggers. Custom groups can be created if user tags paths or operations with 'x-F5 Distributed..",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 								"base_path": schema.StringAttribute{
 									MarkdownDescription: "Prefix of the request path.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 								"specific_domain": schema.StringAttribute{
 									MarkdownDescription: "The rule will apply for a specific domain.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -6103,6 +6469,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 													Optional:            true,
 													ElementType:         types.Int64Type,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -6112,6 +6483,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											Blocks: map[string]schema.Block{
 												"asn_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to bgp_asn_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -6162,6 +6536,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -6176,6 +6554,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											Blocks: map[string]schema.Block{
 												"prefix_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to ip_prefix_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -6230,6 +6611,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -6240,6 +6626,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "[Enum: SPAM_SOURCES|WINDOWS_EXPLOITS|WEB_ATTACKS|BOTNETS|SCANNERS|REPUTATION|PHISHING|PROXY|MOBILE_THREATS|TOR_PROXY|DENIAL_OF_SERVICE|NETWORK] The IP threat categories is obtained from the list and is used to auto-generate equivalent label selection expressions . Possible values are `SPAM_SOURCES`, `WINDOWS_EXPLOITS`, `WEB_ATTACKS`, `BOTNETS`, `SCANNERS`, `REPUTATION`, `PHISHING`, `PROXY`, `MOBILE_THREATS`, `TOR_PROXY`, `DENIAL_OF_SERVICE`, `NETWORK`. Defaults to `SPAM_SOURCES`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -6250,16 +6640,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 												"exact_values": schema.ListAttribute{
 													MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 												"excluded_values": schema.ListAttribute{
 													MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 											},
 										},
@@ -6271,6 +6675,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"threshold": schema.Int64Attribute{
 											MarkdownDescription: "The total number of allowed requests for 1 unit (e.g. SECOND/MINUTE/HOUR etc.) of the specified period.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+												int64validator.AtMost(8192),
+											},
 										},
 										"unit": schema.StringAttribute{
 											MarkdownDescription: "[Enum: SECOND|MINUTE|HOUR] Unit for the period per which the rate limit is applied. - SECOND: Second Rate limit period unit is seconds - MINUTE: Minute Rate limit period unit is minutes - HOUR: Hour Rate limit period unit is hours - DAY: Day Rate limit period unit is days. Possible values are `SECOND`, `MINUTE`, `HOUR`. Defaults to `SECOND`.",
@@ -6284,6 +6692,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -6292,6 +6704,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -6300,6 +6715,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -6314,6 +6732,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -6322,6 +6744,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -6330,6 +6755,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -6339,6 +6767,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									Blocks: map[string]schema.Block{
 										"cookie_matchers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -6348,6 +6779,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive cookie name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -6364,16 +6798,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -6382,6 +6830,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										},
 										"headers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -6391,6 +6842,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-insensitive HTTP header name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -6407,16 +6861,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -6425,6 +6893,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										},
 										"jwt_claims": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various JWT claims that need to match. The criteria for matching each JWT claim are described in individual JWTClaimMatcherType instances. The actual JWT claims values are extracted from the JWT payload as a list of strings.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -6434,6 +6905,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"name": schema.StringAttribute{
 														MarkdownDescription: "JWT Claim Name. JWT claim name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -6450,16 +6924,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -6468,6 +6956,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										},
 										"query_params": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -6477,6 +6968,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"key": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -6493,16 +6987,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -6526,6 +7034,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -6534,6 +7046,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -6542,6 +7057,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -6562,15 +7080,24 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										Blocks: map[string]schema.Block{
 											"open_api_validation_rules": schema.ListNestedBlock{
 												MarkdownDescription: "Custom Fall Through Rule List. .",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(15),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"api_group": schema.StringAttribute{
 															MarkdownDescription: "The API group which this validation applies to.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+															},
 														},
 														"base_path": schema.StringAttribute{
 															MarkdownDescription: "The base path which this validation applies to.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -6590,10 +7117,17 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																	MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] Methods. Methods to be matched. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 																	Optional:            true,
 																	ElementType:         types.StringType,
+																	Validators: []validator.List{
+																		listvalidator.SizeAtMost(16),
+																		listvalidator.UniqueValues(),
+																	},
 																},
 																"path": schema.StringAttribute{
 																	MarkdownDescription: "Path. Path to be matched .",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtMost(1024),
+																	},
 																},
 															},
 														},
@@ -6603,10 +7137,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																"description_spec": schema.StringAttribute{
 																	MarkdownDescription: "Description. Human readable description.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtMost(256),
+																	},
 																},
 																"name": schema.StringAttribute{
 																	MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtLeast(1),
+																	},
 																},
 															},
 														},
@@ -6661,6 +7201,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												MarkdownDescription: "[Enum: PROPERTY_QUERY_PARAMETERS|PROPERTY_PATH_PARAMETERS|PROPERTY_CONTENT_TYPE|PROPERTY_COOKIE_PARAMETERS|PROPERTY_HTTP_HEADERS|PROPERTY_HTTP_BODY|PROPERTY_SECURITY_SCHEMA|PROPERTY_RESPONSE_CODE] List of properties of the response to validate according to the OpenAPI specification file (a.k.a. Swagger) . Possible values are `PROPERTY_QUERY_PARAMETERS`, `PROPERTY_PATH_PARAMETERS`, `PROPERTY_CONTENT_TYPE`, `PROPERTY_COOKIE_PARAMETERS`, `PROPERTY_HTTP_HEADERS`, `PROPERTY_HTTP_BODY`, `PROPERTY_SECURITY_SCHEMA`, `PROPERTY_RESPONSE_CODE`. Defaults to `PROPERTY_QUERY_PARAMETERS`.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.SizeAtLeast(1),
+													listvalidator.UniqueValues(),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
@@ -6685,6 +7229,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												MarkdownDescription: "[Enum: PROPERTY_QUERY_PARAMETERS|PROPERTY_PATH_PARAMETERS|PROPERTY_CONTENT_TYPE|PROPERTY_COOKIE_PARAMETERS|PROPERTY_HTTP_HEADERS|PROPERTY_HTTP_BODY|PROPERTY_SECURITY_SCHEMA|PROPERTY_RESPONSE_CODE] List of properties of the request to validate according to the OpenAPI specification file (a.k.a. Swagger) . Possible values are `PROPERTY_QUERY_PARAMETERS`, `PROPERTY_PATH_PARAMETERS`, `PROPERTY_CONTENT_TYPE`, `PROPERTY_COOKIE_PARAMETERS`, `PROPERTY_HTTP_HEADERS`, `PROPERTY_HTTP_BODY`, `PROPERTY_SECURITY_SCHEMA`, `PROPERTY_RESPONSE_CODE`. Defaults to `PROPERTY_QUERY_PARAMETERS`.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.SizeAtLeast(1),
+													listvalidator.UniqueValues(),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
@@ -6717,15 +7265,24 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										Blocks: map[string]schema.Block{
 											"open_api_validation_rules": schema.ListNestedBlock{
 												MarkdownDescription: "Custom Fall Through Rule List. .",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(15),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"api_group": schema.StringAttribute{
 															MarkdownDescription: "The API group which this validation applies to.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+															},
 														},
 														"base_path": schema.StringAttribute{
 															MarkdownDescription: "The base path which this validation applies to.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -6745,10 +7302,17 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																	MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] Methods. Methods to be matched. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 																	Optional:            true,
 																	ElementType:         types.StringType,
+																	Validators: []validator.List{
+																		listvalidator.SizeAtMost(16),
+																		listvalidator.UniqueValues(),
+																	},
 																},
 																"path": schema.StringAttribute{
 																	MarkdownDescription: "Path. Path to be matched .",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtMost(1024),
+																	},
 																},
 															},
 														},
@@ -6758,10 +7322,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																"description_spec": schema.StringAttribute{
 																	MarkdownDescription: "Description. Human readable description.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtMost(256),
+																	},
 																},
 																"name": schema.StringAttribute{
 																	MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtLeast(1),
+																	},
 																},
 															},
 														},
@@ -6774,19 +7344,31 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							},
 							"open_api_validation_rules": schema.ListNestedBlock{
 								MarkdownDescription: "Validation List. .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(15),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"api_group": schema.StringAttribute{
 											MarkdownDescription: "The API group which this validation applies to.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"base_path": schema.StringAttribute{
 											MarkdownDescription: "The base path which this validation applies to.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"specific_domain": schema.StringAttribute{
 											MarkdownDescription: "The rule will apply for a specific domain.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -6800,10 +7382,17 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] Methods. Methods to be matched. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 												"path": schema.StringAttribute{
 													MarkdownDescription: "Path. Path to be matched .",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(1024),
+													},
 												},
 											},
 										},
@@ -6813,10 +7402,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -6831,6 +7426,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "[Enum: PROPERTY_QUERY_PARAMETERS|PROPERTY_PATH_PARAMETERS|PROPERTY_CONTENT_TYPE|PROPERTY_COOKIE_PARAMETERS|PROPERTY_HTTP_HEADERS|PROPERTY_HTTP_BODY|PROPERTY_SECURITY_SCHEMA|PROPERTY_RESPONSE_CODE] List of properties of the response to validate according to the OpenAPI specification file (a.k.a. Swagger) . Possible values are `PROPERTY_QUERY_PARAMETERS`, `PROPERTY_PATH_PARAMETERS`, `PROPERTY_CONTENT_TYPE`, `PROPERTY_COOKIE_PARAMETERS`, `PROPERTY_HTTP_HEADERS`, `PROPERTY_HTTP_BODY`, `PROPERTY_SECURITY_SCHEMA`, `PROPERTY_RESPONSE_CODE`. Defaults to `PROPERTY_QUERY_PARAMETERS`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -6855,6 +7454,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "[Enum: PROPERTY_QUERY_PARAMETERS|PROPERTY_PATH_PARAMETERS|PROPERTY_CONTENT_TYPE|PROPERTY_COOKIE_PARAMETERS|PROPERTY_HTTP_HEADERS|PROPERTY_HTTP_BODY|PROPERTY_SECURITY_SCHEMA|PROPERTY_RESPONSE_CODE] List of properties of the request to validate according to the OpenAPI specification file (a.k.a. Swagger) . Possible values are `PROPERTY_QUERY_PARAMETERS`, `PROPERTY_PATH_PARAMETERS`, `PROPERTY_CONTENT_TYPE`, `PROPERTY_COOKIE_PARAMETERS`, `PROPERTY_HTTP_HEADERS`, `PROPERTY_HTTP_BODY`, `PROPERTY_SECURITY_SCHEMA`, `PROPERTY_RESPONSE_CODE`. Defaults to `PROPERTY_QUERY_PARAMETERS`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -6917,6 +7520,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 					"name": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(128),
+							stringvalidator.LengthAtLeast(1),
+						},
 					},
 					"namespace": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -6925,6 +7532,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 					"tenant": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -6933,21 +7543,36 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 				},
 			},
 			"blocked_clients": schema.ListNestedBlock{
 				MarkdownDescription: "Define rules to block IP Prefixes or AS numbers.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(256),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"actions": schema.ListAttribute{
 							MarkdownDescription: "[Enum: SKIP_PROCESSING_WAF|SKIP_PROCESSING_BOT|SKIP_PROCESSING_MUM|SKIP_PROCESSING_IP_REPUTATION|SKIP_PROCESSING_API_PROTECTION|SKIP_PROCESSING_OAS_VALIDATION|SKIP_PROCESSING_DDOS_PROTECTION|SKIP_PROCESSING_THREAT_MESH|SKIP_PROCESSING_MALWARE_PROTECTION] Actions that should be taken when client identifier matches the rule. Possible values are `SKIP_PROCESSING_WAF`, `SKIP_PROCESSING_BOT`, `SKIP_PROCESSING_MUM`, `SKIP_PROCESSING_IP_REPUTATION`, `SKIP_PROCESSING_API_PROTECTION`, `SKIP_PROCESSING_OAS_VALIDATION`, `SKIP_PROCESSING_DDOS_PROTECTION`, `SKIP_PROCESSING_THREAT_MESH`, `SKIP_PROCESSING_MALWARE_PROTECTION`. Defaults to `SKIP_PROCESSING_WAF`.",
 							Optional:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(10),
+								listvalidator.UniqueValues(),
+							},
 						},
 						"as_number": schema.Int64Attribute{
 							MarkdownDescription: "RFC 6793 defined 4-byte AS number.",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+								int64validator.AtMost(401308),
+							},
 						},
 						"expiration_timestamp": schema.StringAttribute{
 							MarkdownDescription: "Specifies expiration_timestamp the RFC 3339 format timestamp at which the containing rule is considered to be logically expired. The rule continues to exist in the configuration but is not applied anymore.",
@@ -6956,14 +7581,23 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						"ip_prefix": schema.StringAttribute{
 							MarkdownDescription: "IPv4 prefix string.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.IPv4PrefixValidator(),
+							},
 						},
 						"ipv6_prefix": schema.StringAttribute{
 							MarkdownDescription: "IPv6 prefix string.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.IPv6PrefixValidator(),
+							},
 						},
 						"user_identifier": schema.StringAttribute{
 							MarkdownDescription: "Identify user based on user identifier. User identifier value needs to be copied from security event.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -6976,11 +7610,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							Blocks: map[string]schema.Block{
 								"headers": schema.ListNestedBlock{
 									MarkdownDescription: "List of HTTP header name and value pairs .",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"exact": schema.StringAttribute{
 												MarkdownDescription: "Header value to match exactly.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"invert_match": schema.BoolAttribute{
 												MarkdownDescription: "Invert the result of the match to detect missing header or non-matching value.",
@@ -6989,6 +7630,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"name": schema.StringAttribute{
 												MarkdownDescription: "Name. Name of the header .",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"presence": schema.BoolAttribute{
 												MarkdownDescription: "If true, check for presence of header.",
@@ -6997,6 +7642,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"regex": schema.StringAttribute{
 												MarkdownDescription: "Regex match of the header value in re2 format.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+													validators.RegexValidator(),
+												},
 											},
 										},
 									},
@@ -7009,10 +7659,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Description. Human readable description.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -7035,6 +7691,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 					"timeout": schema.Int64Attribute{
 						MarkdownDescription: "The timeout for the inference check, in milliseconds.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+							int64validator.AtMost(60000),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -7083,6 +7743,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								Blocks: map[string]schema.Block{
 									"exclude_list": schema.ListNestedBlock{
 										MarkdownDescription: "Optional JavaScript insertions exclude list of domain and path matchers.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{},
 											Blocks: map[string]schema.Block{
@@ -7095,14 +7758,29 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -7112,10 +7790,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"description_spec": schema.StringAttribute{
 															MarkdownDescription: "Description. Human readable description.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -7125,14 +7809,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"path": schema.StringAttribute{
 															MarkdownDescription: "Exact path value to match.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"prefix": schema.StringAttribute{
 															MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"regex": schema.StringAttribute{
 															MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 													},
 												},
@@ -7147,6 +7842,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								Blocks: map[string]schema.Block{
 									"exclude_list": schema.ListNestedBlock{
 										MarkdownDescription: "Optional JavaScript insertions exclude list of domain and path matchers.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{},
 											Blocks: map[string]schema.Block{
@@ -7159,14 +7857,29 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -7176,10 +7889,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"description_spec": schema.StringAttribute{
 															MarkdownDescription: "Description. Human readable description.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -7189,14 +7908,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"path": schema.StringAttribute{
 															MarkdownDescription: "Exact path value to match.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"prefix": schema.StringAttribute{
 															MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"regex": schema.StringAttribute{
 															MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 													},
 												},
@@ -7205,6 +7935,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									},
 									"rules": schema.ListNestedBlock{
 										MarkdownDescription: "Required list of pages to insert Bot Defense client JavaScript.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"javascript_location": schema.StringAttribute{
@@ -7222,14 +7955,29 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -7239,10 +7987,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"description_spec": schema.StringAttribute{
 															MarkdownDescription: "Description. Human readable description.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -7252,14 +8006,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"path": schema.StringAttribute{
 															MarkdownDescription: "Exact path value to match.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"prefix": schema.StringAttribute{
 															MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"regex": schema.StringAttribute{
 															MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 													},
 												},
@@ -7278,11 +8043,17 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										Blocks: map[string]schema.Block{
 											"headers": schema.ListNestedBlock{
 												MarkdownDescription: "Headers that can be used to identify mobile traffic.",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(32),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Case-insensitive HTTP header name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -7299,16 +8070,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																	MarkdownDescription: "List of exact values to match the input against.",
 																	Optional:            true,
 																	ElementType:         types.StringType,
+																	Validators: []validator.List{
+																		listvalidator.SizeAtMost(64),
+																		listvalidator.UniqueValues(),
+																		listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																	},
 																},
 																"regex_values": schema.ListAttribute{
 																	MarkdownDescription: "List of regular expressions to match the input against.",
 																	Optional:            true,
 																	ElementType:         types.StringType,
+																	Validators: []validator.List{
+																		listvalidator.SizeAtMost(16),
+																		listvalidator.UniqueValues(),
+																		listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																	},
 																},
 																"transformers": schema.ListAttribute{
 																	MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																	Optional:            true,
 																	ElementType:         types.StringType,
+																	Validators: []validator.List{
+																		listvalidator.SizeAtMost(9),
+																		listvalidator.UniqueValues(),
+																	},
 																},
 															},
 														},
@@ -7321,12 +8106,20 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							},
 							"protected_app_endpoints": schema.ListNestedBlock{
 								MarkdownDescription: "List of protected endpoints. Limit: Approx '128 endpoints per Load Balancer (LB)' upto 4 LBs, '32 endpoints per LB' after 4 LBs.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"http_methods": schema.ListAttribute{
 											MarkdownDescription: "[Enum: METHOD_ANY|METHOD_GET|METHOD_POST|METHOD_PUT|METHOD_PATCH|METHOD_DELETE|METHOD_GET_DOCUMENT] HTTP Methods. List of HTTP methods. Possible values are `METHOD_ANY`, `METHOD_GET`, `METHOD_POST`, `METHOD_PUT`, `METHOD_PATCH`, `METHOD_DELETE`, `METHOD_GET_DOCUMENT`. Defaults to `METHOD_ANY`.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(5),
+												listvalidator.SizeAtLeast(1),
+												listvalidator.UniqueValues(),
+											},
 										},
 										"protocol": schema.StringAttribute{
 											MarkdownDescription: "[Enum: BOTH|HTTP|HTTPS] SchemeType is used to indicate URL scheme. - BOTH: BOTH URL scheme for HTTPS:// or HTTP://. - HTTP: HTTP URL scheme HTTP:// only. - HTTPS: HTTPS URL scheme HTTPS:// only. Possible values are `BOTH`, `HTTP`, `HTTPS`. Defaults to `BOTH`.",
@@ -7346,14 +8139,29 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"exact_value": schema.StringAttribute{
 													MarkdownDescription: "Exact domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"regex_value": schema.StringAttribute{
 													MarkdownDescription: "Regular Expression value for the domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 												"suffix_value": schema.StringAttribute{
 													MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -7390,16 +8198,27 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																	Blocks: map[string]schema.Block{
 																		"failure_conditions": schema.ListNestedBlock{
 																			MarkdownDescription: "Failure Conditions. Failure Conditions.",
+																			Validators: []validator.List{
+																				listvalidator.SizeAtMost(3),
+																			},
 																			NestedObject: schema.NestedBlockObject{
 																				Attributes: map[string]schema.Attribute{
 																					"name": schema.StringAttribute{
 																						MarkdownDescription: "Header Name. A case-insensitive HTTP header name.",
 																						Optional:            true,
+																						Validators: []validator.String{
+																							stringvalidator.LengthAtMost(256),
+																						},
 																					},
 																					"regex_values": schema.ListAttribute{
 																						MarkdownDescription: "List of regular expressions to match the input against.",
 																						Optional:            true,
 																						ElementType:         types.StringType,
+																						Validators: []validator.List{
+																							listvalidator.SizeAtMost(16),
+																							listvalidator.UniqueValues(),
+																							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																						},
 																					},
 																					"status": schema.StringAttribute{
 																						MarkdownDescription: "[Enum: EmptyStatusCode|Continue|OK|Created|Accepted|NonAuthoritativeInformation|NoContent|ResetContent|PartialContent|MultiStatus|AlreadyReported|IMUsed|MultipleChoices|MovedPermanently|Found|SeeOther|NotModified|UseProxy|TemporaryRedirect|PermanentRedirect|BadRequest|Unauthorized|PaymentRequired|Forbidden|NotFound|MethodNotAllowed|NotAcceptable|ProxyAuthenticationRequired|RequestTimeout|Conflict|Gone|LengthRequired|PreconditionFailed|PayloadTooLarge|URITooLong|UnsupportedMediaType|RangeNotSatisfiable|ExpectationFailed|MisdirectedRequest|UnprocessableEntity|Locked|FailedDependency|UpgradeRequired|PreconditionRequired|TooManyRequests|RequestHeaderFieldsTooLarge|InternalServerError|NotImplemented|BadGateway|ServiceUnavailable|GatewayTimeout|HTTPVersionNotSupported|VariantAlsoNegotiates|InsufficientStorage|LoopDetected|NotExtended|NetworkAuthenticationRequired] HTTP response status codes EmptyStatusCode response codes means it is not specified Continue status code OK status code Created status code Accepted status code Non Authoritative Information status code No Content status code Reset Content status code Partial Content status code Multi Status.. Possible values are `EmptyStatusCode`, `Continue`, `OK`, `Created`, `Accepted`, `NonAuthoritativeInformation`, `NoContent`, `ResetContent`, `PartialContent`, `MultiStatus`, `AlreadyReported`, `IMUsed`, `MultipleChoices`, `MovedPermanently`, `Found`, `SeeOther`, `NotModified`, `UseProxy`, `TemporaryRedirect`, `PermanentRedirect`, `BadRequest`, `Unauthorized`, `PaymentRequired`, `Forbidden`, `NotFound`, `MethodNotAllowed`, `NotAcceptable`, `ProxyAuthenticationRequired`, `RequestTimeout`, `Conflict`, `Gone`, `LengthRequired`, `PreconditionFailed`, `PayloadTooLarge`, `URITooLong`, `UnsupportedMediaType`, `RangeNotSatisfiable`, `ExpectationFailed`, `MisdirectedRequest`, `UnprocessableEntity`, `Locked`, `FailedDependency`, `UpgradeRequired`, `PreconditionRequired`, `TooManyRequests`, `RequestHeaderFieldsTooLarge`, `InternalServerError`, `NotImplemented`, `BadGateway`, `ServiceUnavailable`, `GatewayTimeout`, `HTTPVersionNotSupported`, `VariantAlsoNegotiates`, `InsufficientStorage`, `LoopDetected`, `NotExtended`, `NetworkAuthenticationRequired`. Defaults to `EmptyStatusCode`.",
@@ -7410,16 +8229,27 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																		},
 																		"success_conditions": schema.ListNestedBlock{
 																			MarkdownDescription: "Success Conditions. Success Conditions.",
+																			Validators: []validator.List{
+																				listvalidator.SizeAtMost(3),
+																			},
 																			NestedObject: schema.NestedBlockObject{
 																				Attributes: map[string]schema.Attribute{
 																					"name": schema.StringAttribute{
 																						MarkdownDescription: "Header Name. A case-insensitive HTTP header name.",
 																						Optional:            true,
+																						Validators: []validator.String{
+																							stringvalidator.LengthAtMost(256),
+																						},
 																					},
 																					"regex_values": schema.ListAttribute{
 																						MarkdownDescription: "List of regular expressions to match the input against.",
 																						Optional:            true,
 																						ElementType:         types.StringType,
+																						Validators: []validator.List{
+																							listvalidator.SizeAtMost(16),
+																							listvalidator.UniqueValues(),
+																							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																						},
 																					},
 																					"status": schema.StringAttribute{
 																						MarkdownDescription: "[Enum: EmptyStatusCode|Continue|OK|Created|Accepted|NonAuthoritativeInformation|NoContent|ResetContent|PartialContent|MultiStatus|AlreadyReported|IMUsed|MultipleChoices|MovedPermanently|Found|SeeOther|NotModified|UseProxy|TemporaryRedirect|PermanentRedirect|BadRequest|Unauthorized|PaymentRequired|Forbidden|NotFound|MethodNotAllowed|NotAcceptable|ProxyAuthenticationRequired|RequestTimeout|Conflict|Gone|LengthRequired|PreconditionFailed|PayloadTooLarge|URITooLong|UnsupportedMediaType|RangeNotSatisfiable|ExpectationFailed|MisdirectedRequest|UnprocessableEntity|Locked|FailedDependency|UpgradeRequired|PreconditionRequired|TooManyRequests|RequestHeaderFieldsTooLarge|InternalServerError|NotImplemented|BadGateway|ServiceUnavailable|GatewayTimeout|HTTPVersionNotSupported|VariantAlsoNegotiates|InsufficientStorage|LoopDetected|NotExtended|NetworkAuthenticationRequired] HTTP response status codes EmptyStatusCode response codes means it is not specified Continue status code OK status code Created status code Accepted status code Non Authoritative Information status code No Content status code Reset Content status code Partial Content status code Multi Status.. Possible values are `EmptyStatusCode`, `Continue`, `OK`, `Created`, `Accepted`, `NonAuthoritativeInformation`, `NoContent`, `ResetContent`, `PartialContent`, `MultiStatus`, `AlreadyReported`, `IMUsed`, `MultipleChoices`, `MovedPermanently`, `Found`, `SeeOther`, `NotModified`, `UseProxy`, `TemporaryRedirect`, `PermanentRedirect`, `BadRequest`, `Unauthorized`, `PaymentRequired`, `Forbidden`, `NotFound`, `MethodNotAllowed`, `NotAcceptable`, `ProxyAuthenticationRequired`, `RequestTimeout`, `Conflict`, `Gone`, `LengthRequired`, `PreconditionFailed`, `PayloadTooLarge`, `URITooLong`, `UnsupportedMediaType`, `RangeNotSatisfiable`, `ExpectationFailed`, `MisdirectedRequest`, `UnprocessableEntity`, `Locked`, `FailedDependency`, `UpgradeRequired`, `PreconditionRequired`, `TooManyRequests`, `RequestHeaderFieldsTooLarge`, `InternalServerError`, `NotImplemented`, `BadGateway`, `ServiceUnavailable`, `GatewayTimeout`, `HTTPVersionNotSupported`, `VariantAlsoNegotiates`, `InsufficientStorage`, `LoopDetected`, `NotExtended`, `NetworkAuthenticationRequired`. Defaults to `EmptyStatusCode`.",
@@ -7546,6 +8376,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										},
 										"headers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -7555,6 +8388,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-insensitive HTTP header name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -7571,16 +8407,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -7593,10 +8443,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -7613,6 +8469,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"body": schema.StringAttribute{
 															MarkdownDescription: "Custom body message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(4096),
+															},
 														},
 														"status": schema.StringAttribute{
 															MarkdownDescription: "[Enum: EmptyStatusCode|Continue|OK|Created|Accepted|NonAuthoritativeInformation|NoContent|ResetContent|PartialContent|MultiStatus|AlreadyReported|IMUsed|MultipleChoices|MovedPermanently|Found|SeeOther|NotModified|UseProxy|TemporaryRedirect|PermanentRedirect|BadRequest|Unauthorized|PaymentRequired|Forbidden|NotFound|MethodNotAllowed|NotAcceptable|ProxyAuthenticationRequired|RequestTimeout|Conflict|Gone|LengthRequired|PreconditionFailed|PayloadTooLarge|URITooLong|UnsupportedMediaType|RangeNotSatisfiable|ExpectationFailed|MisdirectedRequest|UnprocessableEntity|Locked|FailedDependency|UpgradeRequired|PreconditionRequired|TooManyRequests|RequestHeaderFieldsTooLarge|InternalServerError|NotImplemented|BadGateway|ServiceUnavailable|GatewayTimeout|HTTPVersionNotSupported|VariantAlsoNegotiates|InsufficientStorage|LoopDetected|NotExtended|NetworkAuthenticationRequired] HTTP response status codes EmptyStatusCode response codes means it is not specified Continue status code OK status code Created status code Accepted status code Non Authoritative Information status code No Content status code Reset Content status code Partial Content status code Multi Status.. Possible values are `EmptyStatusCode`, `Continue`, `OK`, `Created`, `Accepted`, `NonAuthoritativeInformation`, `NoContent`, `ResetContent`, `PartialContent`, `MultiStatus`, `AlreadyReported`, `IMUsed`, `MultipleChoices`, `MovedPermanently`, `Found`, `SeeOther`, `NotModified`, `UseProxy`, `TemporaryRedirect`, `PermanentRedirect`, `BadRequest`, `Unauthorized`, `PaymentRequired`, `Forbidden`, `NotFound`, `MethodNotAllowed`, `NotAcceptable`, `ProxyAuthenticationRequired`, `RequestTimeout`, `Conflict`, `Gone`, `LengthRequired`, `PreconditionFailed`, `PayloadTooLarge`, `URITooLong`, `UnsupportedMediaType`, `RangeNotSatisfiable`, `ExpectationFailed`, `MisdirectedRequest`, `UnprocessableEntity`, `Locked`, `FailedDependency`, `UpgradeRequired`, `PreconditionRequired`, `TooManyRequests`, `RequestHeaderFieldsTooLarge`, `InternalServerError`, `NotImplemented`, `BadGateway`, `ServiceUnavailable`, `GatewayTimeout`, `HTTPVersionNotSupported`, `VariantAlsoNegotiates`, `InsufficientStorage`, `LoopDetected`, `NotExtended`, `NetworkAuthenticationRequired`. Defaults to `EmptyStatusCode`.",
@@ -7630,10 +8489,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																"auto_type_header_name": schema.StringAttribute{
 																	MarkdownDescription: "Case-insensitive HTTP header name.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(256),
+																	},
 																},
 																"inference_header_name": schema.StringAttribute{
 																	MarkdownDescription: "Case-insensitive HTTP header name.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(256),
+																	},
 																},
 															},
 														},
@@ -7662,19 +8527,33 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"path": schema.StringAttribute{
 													MarkdownDescription: "Exact path value to match.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"prefix": schema.StringAttribute{
 													MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"regex": schema.StringAttribute{
 													MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(256),
+														stringvalidator.LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 											},
 										},
 										"query_params": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -7684,6 +8563,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"key": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -7700,16 +8582,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -7744,10 +8640,17 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 					"cookie_expiry": schema.Int64Attribute{
 						MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(86400),
+						},
 					},
 					"custom_page": schema.StringAttribute{
 						MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(65536),
+						},
 					},
 				},
 			},
@@ -7771,6 +8674,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								Blocks: map[string]schema.Block{
 									"exclude_list": schema.ListNestedBlock{
 										MarkdownDescription: "Optional JavaScript insertions exclude list of domain and path matchers.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{},
 											Blocks: map[string]schema.Block{
@@ -7783,14 +8689,29 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -7800,10 +8721,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"description_spec": schema.StringAttribute{
 															MarkdownDescription: "Description. Human readable description.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -7813,14 +8740,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"path": schema.StringAttribute{
 															MarkdownDescription: "Exact path value to match.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"prefix": schema.StringAttribute{
 															MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"regex": schema.StringAttribute{
 															MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 													},
 												},
@@ -7835,6 +8773,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								Blocks: map[string]schema.Block{
 									"exclude_list": schema.ListNestedBlock{
 										MarkdownDescription: "Optional JavaScript insertions exclude list of domain and path matchers.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{},
 											Blocks: map[string]schema.Block{
@@ -7847,14 +8788,29 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -7864,10 +8820,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"description_spec": schema.StringAttribute{
 															MarkdownDescription: "Description. Human readable description.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -7877,14 +8839,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"path": schema.StringAttribute{
 															MarkdownDescription: "Exact path value to match.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"prefix": schema.StringAttribute{
 															MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"regex": schema.StringAttribute{
 															MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 													},
 												},
@@ -7893,6 +8866,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									},
 									"rules": schema.ListNestedBlock{
 										MarkdownDescription: "Required list of pages to insert Client-Side Defense client JavaScript.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{},
 											Blocks: map[string]schema.Block{
@@ -7905,14 +8881,29 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -7922,10 +8913,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"description_spec": schema.StringAttribute{
 															MarkdownDescription: "Description. Human readable description.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -7935,14 +8932,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"path": schema.StringAttribute{
 															MarkdownDescription: "Exact path value to match.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"prefix": schema.StringAttribute{
 															MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"regex": schema.StringAttribute{
 															MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 													},
 												},
@@ -7974,11 +8982,21 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						MarkdownDescription: "Specifies the origins that will be allowed to do CORS requests. An origin is allowed if either allow_origin or allow_origin_regex match.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(128),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1)),
+						},
 					},
 					"allow_origin_regex": schema.ListAttribute{
 						MarkdownDescription: "Specifies regex patterns that match allowed origins. An origin is allowed if either allow_origin or allow_origin_regex match.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+						},
 					},
 					"disabled": schema.BoolAttribute{
 						MarkdownDescription: "Disable the CorsPolicy for a particular route. This is useful when virtual-host has CorsPolicy, but we need to disable it on a specific route. The value of this field is ignored for virtual-host.",
@@ -7991,6 +9009,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 					"maximum_age": schema.Int64Attribute{
 						MarkdownDescription: "Specifies the content for the access-control-max-age header in seconds. This indicates the maximum number of seconds the results can be cached A value of -1 will disable caching. Maximum permitted value is 86400 seconds (24 hours).",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(-1),
+							int64validator.AtMost(86400),
+						},
 					},
 				},
 			},
@@ -8008,6 +9030,12 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								MarkdownDescription: "List of domain names that will be matched to loadbalancer. These domains are not used for SNI match. Wildcard names are supported in the suffix or prefix form.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+									listvalidator.SizeAtLeast(1),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1)),
+								},
 							},
 						},
 					},
@@ -8027,6 +9055,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -8035,6 +9067,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -8043,6 +9078,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -8051,15 +9089,29 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 			},
 			"data_guard_rules": schema.ListNestedBlock{
 				MarkdownDescription: "Data Guard prevents responses from exposing sensitive information by masking the data. The system masks credit card numbers and social security numbers leaked from the application from within the HTTP response with a string of asterisks (*).",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(64),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"exact_value": schema.StringAttribute{
 							MarkdownDescription: "Exact domain name.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.HostnameValidator(),
+								stringvalidator.UTF8LengthAtMost(256),
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
 						},
 						"suffix_value": schema.StringAttribute{
 							MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.HostnameValidator(),
+								stringvalidator.UTF8LengthAtMost(256),
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -8075,10 +9127,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Description. Human readable description.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -8088,14 +9146,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								"path": schema.StringAttribute{
 									MarkdownDescription: "Exact path value to match.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"prefix": schema.StringAttribute{
 									MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"regex": schema.StringAttribute{
 									MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(256),
+										stringvalidator.LengthAtLeast(1),
+										validators.RegexValidator(),
+									},
 								},
 							},
 						},
@@ -8107,6 +9176,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 			},
 			"ddos_mitigation_rules": schema.ListNestedBlock{
 				MarkdownDescription: "Define manual mitigation rules to block L7 DDoS attacks.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(256),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"expiration_timestamp": schema.StringAttribute{
@@ -8125,6 +9198,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									MarkdownDescription: "[Enum: COUNTRY_NONE|COUNTRY_AD|COUNTRY_AE|COUNTRY_AF|COUNTRY_AG|COUNTRY_AI|COUNTRY_AL|COUNTRY_AM|COUNTRY_AN|COUNTRY_AO|COUNTRY_AQ|COUNTRY_AR|COUNTRY_AS|COUNTRY_AT|COUNTRY_AU|COUNTRY_AW|COUNTRY_AX|COUNTRY_AZ|COUNTRY_BA|COUNTRY_BB|COUNTRY_BD|COUNTRY_BE|COUNTRY_BF|COUNTRY_BG|COUNTRY_BH|COUNTRY_BI|COUNTRY_BJ|COUNTRY_BL|COUNTRY_BM|COUNTRY_BN|COUNTRY_BO|COUNTRY_BQ|COUNTRY_BR|COUNTRY_BS|COUNTRY_BT|COUNTRY_BV|COUNTRY_BW|COUNTRY_BY|COUNTRY_BZ|COUNTRY_CA|COUNTRY_CC|COUNTRY_CD|COUNTRY_CF|COUNTRY_CG|COUNTRY_CH|COUNTRY_CI|COUNTRY_CK|COUNTRY_CL|COUNTRY_CM|COUNTRY_CN|COUNTRY_CO|COUNTRY_CR|COUNTRY_CS|COUNTRY_CU|COUNTRY_CV|COUNTRY_CW|COUNTRY_CX|COUNTRY_CY|COUNTRY_CZ|COUNTRY_DE|COUNTRY_DJ|COUNTRY_DK|COUNTRY_DM|COUNTRY_DO|COUNTRY_DZ|COUNTRY_EC|COUNTRY_EE|COUNTRY_EG|COUNTRY_EH|COUNTRY_ER|COUNTRY_ES|COUNTRY_ET|COUNTRY_FI|COUNTRY_FJ|COUNTRY_FK|COUNTRY_FM|COUNTRY_FO|COUNTRY_FR|COUNTRY_GA|COUNTRY_GB|COUNTRY_GD|COUNTRY_GE|COUNTRY_GF|COUNTRY_GG|COUNTRY_GH|COUNTRY_GI|COUNTRY_GL|COUNTRY_GM|COUNTRY_GN|COUNTRY_GP|COUNTRY_GQ|COUNTRY_GR|COUNTRY_GS|COUNTRY_GT|COUNTRY_GU|COUNTRY_GW|COUNTRY_GY|COUNTRY_HK|COUNTRY_HM|COUNTRY_HN|COUNTRY_HR|COUNTRY_HT|COUNTRY_HU|COUNTRY_ID|COUNTRY_IE|COUNTRY_IL|COUNTRY_IM|COUNTRY_IN|COUNTRY_IO|COUNTRY_IQ|COUNTRY_IR|COUNTRY_IS|COUNTRY_IT|COUNTRY_JE|COUNTRY_JM|COUNTRY_JO|COUNTRY_JP|COUNTRY_KE|COUNTRY_KG|COUNTRY_KH|COUNTRY_KI|COUNTRY_KM|COUNTRY_KN|COUNTRY_KP|COUNTRY_KR|COUNTRY_KW|COUNTRY_KY|COUNTRY_KZ|COUNTRY_LA|COUNTRY_LB|COUNTRY_LC|COUNTRY_LI|COUNTRY_LK|COUNTRY_LR|COUNTRY_LS|COUNTRY_LT|COUNTRY_LU|COUNTRY_LV|COUNTRY_LY|COUNTRY_MA|COUNTRY_MC|COUNTRY_MD|COUNTRY_ME|COUNTRY_MF|COUNTRY_MG|COUNTRY_MH|COUNTRY_MK|COUNTRY_ML|COUNTRY_MM|COUNTRY_MN|COUNTRY_MO|COUNTRY_MP|COUNTRY_MQ|COUNTRY_MR|COUNTRY_MS|COUNTRY_MT|COUNTRY_MU|COUNTRY_MV|COUNTRY_MW|COUNTRY_MX|COUNTRY_MY|COUNTRY_MZ|COUNTRY_NA|COUNTRY_NC|COUNTRY_NE|COUNTRY_NF|COUNTRY_NG|COUNTRY_NI|COUNTRY_NL|COUNTRY_NO|COUNTRY_NP|COUNTRY_NR|COUNTRY_NU|COUNTRY_NZ|COUNTRY_OM|COUNTRY_PA|COUNTRY_PE|COUNTRY_PF|COUNTRY_PG|COUNTRY_PH|COUNTRY_PK|COUNTRY_PL|COUNTRY_PM|COUNTRY_PN|COUNTRY_PR|COUNTRY_PS|COUNTRY_PT|COUNTRY_PW|COUNTRY_PY|COUNTRY_QA|COUNTRY_RE|COUNTRY_RO|COUNTRY_RS|COUNTRY_RU|COUNTRY_RW|COUNTRY_SA|COUNTRY_SB|COUNTRY_SC|COUNTRY_SD|COUNTRY_SE|COUNTRY_SG|COUNTRY_SH|COUNTRY_SI|COUNTRY_SJ|COUNTRY_SK|COUNTRY_SL|COUNTRY_SM|COUNTRY_SN|COUNTRY_SO|COUNTRY_SR|COUNTRY_SS|COUNTRY_ST|COUNTRY_SV|COUNTRY_SX|COUNTRY_SY|COUNTRY_SZ|COUNTRY_TC|COUNTRY_TD|COUNTRY_TF|COUNTRY_TG|COUNTRY_TH|COUNTRY_TJ|COUNTRY_TK|COUNTRY_TL|COUNTRY_TM|COUNTRY_TN|COUNTRY_TO|COUNTRY_TR|COUNTRY_TT|COUNTRY_TV|COUNTRY_TW|COUNTRY_TZ|COUNTRY_UA|COUNTRY_UG|COUNTRY_UM|COUNTRY_US|COUNTRY_UY|COUNTRY_UZ|COUNTRY_VA|COUNTRY_VC|COUNTRY_VE|COUNTRY_VG|COUNTRY_VI|COUNTRY_VN|COUNTRY_VU|COUNTRY_WF|COUNTRY_WS|COUNTRY_XK|COUNTRY_XT|COUNTRY_YE|COUNTRY_YT|COUNTRY_ZA|COUNTRY_ZM|COUNTRY_ZW] Sources that are located in one of the countries in the given list. Possible values are `COUNTRY_NONE`, `COUNTRY_AD`, `COUNTRY_AE`, `COUNTRY_AF`, `COUNTRY_AG`, `COUNTRY_AI`, `COUNTRY_AL`, `COUNTRY_AM`, `COUNTRY_AN`, `COUNTRY_AO`, `COUNTRY_AQ`, `COUNTRY_AR`, `COUNTRY_AS`, `COUNTRY_AT`, `COUNTRY_AU`, `COUNTRY_AW`, `COUNTRY_AX`, `COUNTRY_AZ`, `COUNTRY_BA`, `COUNTRY_BB`, `COUNTRY_BD`, `COUNTRY_BE`, `COUNTRY_BF`, `COUNTRY_BG`, `COUNTRY_BH`, `COUNTRY_BI`, `COUNTRY_BJ`, `COUNTRY_BL`, `COUNTRY_BM`, `COUNTRY_BN`, `COUNTRY_BO`, `COUNTRY_BQ`, `COUNTRY_BR`, `COUNTRY_BS`, `COUNTRY_BT`, `COUNTRY_BV`, `COUNTRY_BW`, `COUNTRY_BY`, `COUNTRY_BZ`, `COUNTRY_CA`, `COUNTRY_CC`, `COUNTRY_CD`, `COUNTRY_CF`, `COUNTRY_CG`, `COUNTRY_CH`, `COUNTRY_CI`, `COUNTRY_CK`, `COUNTRY_CL`, `COUNTRY_CM`, `COUNTRY_CN`, `COUNTRY_CO`, `COUNTRY_CR`, `COUNTRY_CS`, `COUNTRY_CU`, `COUNTRY_CV`, `COUNTRY_CW`, `COUNTRY_CX`, `COUNTRY_CY`, `COUNTRY_CZ`, `COUNTRY_DE`, `COUNTRY_DJ`, `COUNTRY_DK`, `COUNTRY_DM`, `COUNTRY_DO`, `COUNTRY_DZ`, `COUNTRY_EC`, `COUNTRY_EE`, `COUNTRY_EG`, `COUNTRY_EH`, `COUNTRY_ER`, `COUNTRY_ES`, `COUNTRY_ET`, `COUNTRY_FI`, `COUNTRY_FJ`, `COUNTRY_FK`, `COUNTRY_FM`, `COUNTRY_FO`, `COUNTRY_FR`, `COUNTRY_GA`, `COUNTRY_GB`, `COUNTRY_GD`, `COUNTRY_GE`, `COUNTRY_GF`, `COUNTRY_GG`, `COUNTRY_GH`, `COUNTRY_GI`, `COUNTRY_GL`, `COUNTRY_GM`, `COUNTRY_GN`, `COUNTRY_GP`, `COUNTRY_GQ`, `COUNTRY_GR`, `COUNTRY_GS`, `COUNTRY_GT`, `COUNTRY_GU`, `COUNTRY_GW`, `COUNTRY_GY`, `COUNTRY_HK`, `COUNTRY_HM`, `COUNTRY_HN`, `COUNTRY_HR`, `COUNTRY_HT`, `COUNTRY_HU`, `COUNTRY_ID`, `COUNTRY_IE`, `COUNTRY_IL`, `COUNTRY_IM`, `COUNTRY_IN`, `COUNTRY_IO`, `COUNTRY_IQ`, `COUNTRY_IR`, `COUNTRY_IS`, `COUNTRY_IT`, `COUNTRY_JE`, `COUNTRY_JM`, `COUNTRY_JO`, `COUNTRY_JP`, `COUNTRY_KE`, `COUNTRY_KG`, `COUNTRY_KH`, `COUNTRY_KI`, `COUNTRY_KM`, `COUNTRY_KN`, `COUNTRY_KP`, `COUNTRY_KR`, `COUNTRY_KW`, `COUNTRY_KY`, `COUNTRY_KZ`, `COUNTRY_LA`, `COUNTRY_LB`, `COUNTRY_LC`, `COUNTRY_LI`, `COUNTRY_LK`, `COUNTRY_LR`, `COUNTRY_LS`, `COUNTRY_LT`, `COUNTRY_LU`, `COUNTRY_LV`, `COUNTRY_LY`, `COUNTRY_MA`, `COUNTRY_MC`, `COUNTRY_MD`, `COUNTRY_ME`, `COUNTRY_MF`, `COUNTRY_MG`, `COUNTRY_MH`, `COUNTRY_MK`, `COUNTRY_ML`, `COUNTRY_MM`, `COUNTRY_MN`, `COUNTRY_MO`, `COUNTRY_MP`, `COUNTRY_MQ`, `COUNTRY_MR`, `COUNTRY_MS`, `COUNTRY_MT`, `COUNTRY_MU`, `COUNTRY_MV`, `COUNTRY_MW`, `COUNTRY_MX`, `COUNTRY_MY`, `COUNTRY_MZ`, `COUNTRY_NA`, `COUNTRY_NC`, `COUNTRY_NE`, `COUNTRY_NF`, `COUNTRY_NG`, `COUNTRY_NI`, `COUNTRY_NL`, `COUNTRY_NO`, `COUNTRY_NP`, `COUNTRY_NR`, `COUNTRY_NU`, `COUNTRY_NZ`, `COUNTRY_OM`, `COUNTRY_PA`, `COUNTRY_PE`, `COUNTRY_PF`, `COUNTRY_PG`, `COUNTRY_PH`, `COUNTRY_PK`, `COUNTRY_PL`, `COUNTRY_PM`, `COUNTRY_PN`, `COUNTRY_PR`, `COUNTRY_PS`, `COUNTRY_PT`, `COUNTRY_PW`, `COUNTRY_PY`, `COUNTRY_QA`, `COUNTRY_RE`, `COUNTRY_RO`, `COUNTRY_RS`, `COUNTRY_RU`, `COUNTRY_RW`, `COUNTRY_SA`, `COUNTRY_SB`, `COUNTRY_SC`, `COUNTRY_SD`, `COUNTRY_SE`, `COUNTRY_SG`, `COUNTRY_SH`, `COUNTRY_SI`, `COUNTRY_SJ`, `COUNTRY_SK`, `COUNTRY_SL`, `COUNTRY_SM`, `COUNTRY_SN`, `COUNTRY_SO`, `COUNTRY_SR`, `COUNTRY_SS`, `COUNTRY_ST`, `COUNTRY_SV`, `COUNTRY_SX`, `COUNTRY_SY`, `COUNTRY_SZ`, `COUNTRY_TC`, `COUNTRY_TD`, `COUNTRY_TF`, `COUNTRY_TG`, `COUNTRY_TH`, `COUNTRY_TJ`, `COUNTRY_TK`, `COUNTRY_TL`, `COUNTRY_TM`, `COUNTRY_TN`, `COUNTRY_TO`, `COUNTRY_TR`, `COUNTRY_TT`, `COUNTRY_TV`, `COUNTRY_TW`, `COUNTRY_TZ`, `COUNTRY_UA`, `COUNTRY_UG`, `COUNTRY_UM`, `COUNTRY_US`, `COUNTRY_UY`, `COUNTRY_UZ`, `COUNTRY_VA`, `COUNTRY_VC`, `COUNTRY_VE`, `COUNTRY_VG`, `COUNTRY_VI`, `COUNTRY_VN`, `COUNTRY_VU`, `COUNTRY_WF`, `COUNTRY_WS`, `COUNTRY_XK`, `COUNTRY_XT`, `COUNTRY_YE`, `COUNTRY_YT`, `COUNTRY_ZA`, `COUNTRY_ZM`, `COUNTRY_ZW`. Defaults to `COUNTRY_NONE`.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(64),
+										listvalidator.UniqueValues(),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -8135,6 +9212,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 											Optional:            true,
 											ElementType:         types.Int64Type,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.SizeAtLeast(1),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 								},
@@ -8145,6 +9227,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											MarkdownDescription: "List of exact JA4 TLS fingerprint to match the input JA4 TLS fingerprint against.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(36, 36)),
+											},
 										},
 									},
 								},
@@ -8155,16 +9242,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+											},
 										},
 										"exact_values": schema.ListAttribute{
 											MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+											},
 										},
 										"excluded_values": schema.ListAttribute{
 											MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(32),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+											},
 										},
 									},
 								},
@@ -8181,6 +9282,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(128),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+									},
 								},
 							},
 						},
@@ -8190,10 +9296,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Description. Human readable description.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -8259,11 +9371,17 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								Blocks: map[string]schema.Block{
 									"domains": schema.ListNestedBlock{
 										MarkdownDescription: "Enter domains and their credentials to allow authenticated API crawling. You can only include domains you own that are associated with this Load Balancer.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"domain": schema.StringAttribute{
 													MarkdownDescription: "Select the domain to execute API Crawling with given credentials.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -8273,6 +9391,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														"user": schema.StringAttribute{
 															MarkdownDescription: "Enter the username to assign credentials for the selected domain to crawl.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(64),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -8305,7 +9426,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																			Optional:            true,
 																		},
 																		"url": schema.StringAttribute{
-																			MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																			MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																			Optional:            true,
+																			Sensitive:           true,
+																			WriteOnly:           true,
+																			Validators: []validator.String{
+																				stringvalidator.LengthAtMost(131072),
+																			},
+																		},
+																		"url_version": schema.Int64Attribute{
+																			MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																			Optional:            true,
 																		},
 																	},
@@ -8330,6 +9460,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						Blocks: map[string]schema.Block{
 							"code_base_integrations": schema.ListNestedBlock{
 								MarkdownDescription: "Select Code Base Integrations. .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(5),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{},
 									Blocks: map[string]schema.Block{
@@ -8342,6 +9475,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -8350,6 +9487,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -8358,6 +9498,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -8386,6 +9529,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -8394,6 +9541,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -8402,6 +9552,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -8419,6 +9572,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"purge_duration_for_inactive_discovered_apis": schema.Int64Attribute{
 								MarkdownDescription: "Inactive discovered API will be deleted after configured duration.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(7),
+								},
 							},
 						},
 					},
@@ -8437,10 +9594,17 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(86400),
+								},
 							},
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 						},
 					},
@@ -8459,14 +9623,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(86400),
+								},
 							},
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 							"js_script_delay": schema.Int64Attribute{
 								MarkdownDescription: "Delay introduced by Javascript, in milliseconds.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1000),
+									int64validator.AtMost(60000),
+								},
 							},
 						},
 					},
@@ -8476,6 +9651,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -8484,6 +9663,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -8492,6 +9674,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -8504,6 +9689,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						MarkdownDescription: "[Enum: SPAM_SOURCES|WINDOWS_EXPLOITS|WEB_ATTACKS|BOTNETS|SCANNERS|REPUTATION|PHISHING|PROXY|MOBILE_THREATS|TOR_PROXY|DENIAL_OF_SERVICE|NETWORK] If the source IP matches on atleast one of the enabled IP threat categories, the request will be denied. Possible values are `SPAM_SOURCES`, `WINDOWS_EXPLOITS`, `WEB_ATTACKS`, `BOTNETS`, `SCANNERS`, `REPUTATION`, `PHISHING`, `PROXY`, `MOBILE_THREATS`, `TOR_PROXY`, `DENIAL_OF_SERVICE`, `NETWORK`. Defaults to `SPAM_SOURCES`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+							listvalidator.UniqueValues(),
+						},
 					},
 				},
 			},
@@ -8515,19 +9704,36 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 			},
 			"graphql_rules": schema.ListNestedBlock{
 				MarkdownDescription: "GraphQL is a query language and server-side runtime for APIs which provides a complete and understandable description of the data in API. GraphQL gives clients the power to ask for exactly what they need, makes it easier to evolve APIs over time, and enables powerful developer tools. Policy..",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(64),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"exact_path": schema.StringAttribute{
 							MarkdownDescription: "Specifies the exact path to GraphQL endpoint. Defaults to `/graphql`.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 						"exact_value": schema.StringAttribute{
 							MarkdownDescription: "Exact domain name.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.HostnameValidator(),
+								stringvalidator.UTF8LengthAtMost(256),
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
 						},
 						"suffix_value": schema.StringAttribute{
 							MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.HostnameValidator(),
+								stringvalidator.UTF8LengthAtMost(256),
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -8540,14 +9746,26 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								"max_batched_queries": schema.Int64Attribute{
 									MarkdownDescription: "Specify maximum number of queries in a single batched request.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(20),
+									},
 								},
 								"max_depth": schema.Int64Attribute{
 									MarkdownDescription: "Specify maximum depth for the GraphQL query.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(20),
+									},
 								},
 								"max_total_length": schema.Int64Attribute{
 									MarkdownDescription: "Specify maximum length in bytes for the GraphQL query.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(16386),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -8565,10 +9783,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Description. Human readable description.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -8591,10 +9815,17 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 					"port": schema.Int64Attribute{
 						MarkdownDescription: "HTTP port to Listen.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(65535),
+						},
 					},
 					"port_ranges": schema.StringAttribute{
 						MarkdownDescription: "A string containing a comma separated list of port ranges. Each port range consists of a single port or two ports separated by '-'.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(512),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 				},
 			},
@@ -8621,11 +9852,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								Blocks: map[string]schema.Block{
 									"certificates": schema.ListNestedBlock{
 										MarkdownDescription: "Select one or more certificates with any domain names.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -8634,6 +9872,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -8642,6 +9883,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -8660,6 +9904,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 														Optional:            true,
 														ElementType:         types.StringType,
+														Validators: []validator.List{
+															listvalidator.UniqueValues(),
+														},
 													},
 													"max_version": schema.StringAttribute{
 														MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -8692,6 +9939,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"trusted_ca_url": schema.StringAttribute{
 												MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
@@ -8701,6 +9952,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"name": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(128),
+															stringvalidator.LengthAtLeast(1),
+														},
 													},
 													"namespace": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -8709,6 +9964,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 													"tenant": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -8717,6 +9975,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 												},
 											},
@@ -8729,6 +9990,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"name": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(128),
+															stringvalidator.LengthAtLeast(1),
+														},
 													},
 													"namespace": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -8737,6 +10002,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 													"tenant": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -8745,6 +10013,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 												},
 											},
@@ -8774,11 +10045,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									},
 									"tls_certificates": schema.ListNestedBlock{
 										MarkdownDescription: "Users can add one or more certificates that share the same set of domains. For example, domain.com and *.domain.com - but use different signature algorithms .",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(16),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"certificate_url": schema.StringAttribute{
 													MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(131072),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Description for the certificate.",
@@ -8793,6 +10071,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -8828,7 +10111,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -8852,6 +10144,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 														Optional:            true,
 														ElementType:         types.StringType,
+														Validators: []validator.List{
+															listvalidator.UniqueValues(),
+														},
 													},
 													"max_version": schema.StringAttribute{
 														MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -8884,6 +10179,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"trusted_ca_url": schema.StringAttribute{
 												MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
@@ -8893,6 +10192,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"name": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(128),
+															stringvalidator.LengthAtLeast(1),
+														},
 													},
 													"namespace": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -8901,6 +10204,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 													"tenant": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -8909,6 +10215,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 												},
 											},
@@ -8921,6 +10230,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													"name": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(128),
+															stringvalidator.LengthAtLeast(1),
+														},
 													},
 													"namespace": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -8929,6 +10242,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 													"tenant": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -8937,6 +10253,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 												},
 											},
@@ -8994,14 +10313,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 					"cookie_expiry": schema.Int64Attribute{
 						MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(86400),
+						},
 					},
 					"custom_page": schema.StringAttribute{
 						MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(65536),
+						},
 					},
 					"js_script_delay": schema.Int64Attribute{
 						MarkdownDescription: "Delay introduced by Javascript, in milliseconds.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1000),
+							int64validator.AtMost(60000),
+						},
 					},
 				},
 			},
@@ -9037,6 +10367,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								MarkdownDescription: "Claim Names. Human-readable name for the resource",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+									listvalidator.UniqueValues(),
+								},
 							},
 						},
 					},
@@ -9056,6 +10390,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										MarkdownDescription: "Values.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(16),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.UniqueValues(),
+										},
 									},
 								},
 							},
@@ -9087,6 +10426,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										MarkdownDescription: "API Groups. .",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+										},
 									},
 								},
 							},
@@ -9097,6 +10441,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										MarkdownDescription: "Prefix Values. .",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(16),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+										},
 									},
 								},
 							},
@@ -9125,14 +10474,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 					"cookie_expiry": schema.Int64Attribute{
 						MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(86400),
+						},
 					},
 					"custom_page": schema.StringAttribute{
 						MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(65536),
+						},
 					},
 					"js_script_delay": schema.Int64Attribute{
 						MarkdownDescription: "Delay introduced by Javascript, in milliseconds.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1000),
+							int64validator.AtMost(60000),
+						},
 					},
 				},
 			},
@@ -9169,11 +10529,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 					},
 					"origin_servers": schema.ListNestedBlock{
 						MarkdownDescription: "List Of Origin Servers. List of original servers .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"port": schema.Int64Attribute{
 									MarkdownDescription: "Origin Server Port. Port the workload can be reached on.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(65535),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -9183,6 +10550,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"ip": schema.StringAttribute{
 											MarkdownDescription: "Public IPv4. Public IPv4 address.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 									},
 								},
@@ -9192,6 +10562,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"dns_name": schema.StringAttribute{
 											MarkdownDescription: "DNS Name. DNS Name .",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.HostnameValidator(),
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 										"refresh_interval": schema.Int64Attribute{
 											MarkdownDescription: "Interval for DNS refresh in seconds. Max value is 7 days as per https://datatracker.ietf.org/doc/HTML/rfc8767.",
@@ -9208,6 +10583,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"dns_name": schema.StringAttribute{
 								MarkdownDescription: "DNS Name. DNS Name .",
 								Optional:            true,
+								Validators: []validator.String{
+									validators.HostnameValidator(),
+									stringvalidator.UTF8LengthAtMost(256),
+									stringvalidator.UTF8LengthAtLeast(1),
+								},
 							},
 							"refresh_interval": schema.Int64Attribute{
 								MarkdownDescription: "Interval for DNS refresh in seconds. Max value is 7 days as per https://datatracker.ietf.org/doc/HTML/rfc8767.",
@@ -9221,10 +10601,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"max_session_keys": schema.Int64Attribute{
 								MarkdownDescription: "Number of session keys that are cached.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(2),
+									int64validator.AtMost(64),
+								},
 							},
 							"sni": schema.StringAttribute{
 								MarkdownDescription: "SNI value to be used.",
 								Optional:            true,
+								Validators: []validator.String{
+									validators.HostnameValidator(),
+									stringvalidator.UTF8LengthAtMost(256),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -9254,6 +10642,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -9285,11 +10676,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								Blocks: map[string]schema.Block{
 									"tls_certificates": schema.ListNestedBlock{
 										MarkdownDescription: "MTLS Client Certificate. MTLS Client Certificate .",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"certificate_url": schema.StringAttribute{
 													MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(131072),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Description for the certificate.",
@@ -9304,6 +10702,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -9339,7 +10742,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -9360,6 +10772,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -9368,6 +10784,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -9376,6 +10795,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -9385,6 +10807,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Origin Pool for verification of server's certificate.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -9394,6 +10820,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -9402,6 +10832,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -9410,6 +10843,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -9438,16 +10874,29 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								MarkdownDescription: "List of keys of Headers to be removed from the HTTP request being sent towards upstream.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.PatternValidator("^[0-9A-Za-z_\\-\\.]+$")),
+								},
 							},
 							"response_headers_to_remove": schema.ListAttribute{
 								MarkdownDescription: "List of keys of Headers to be removed from the HTTP response being sent towards downstream.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(validators.PatternValidator("^[0-9A-Za-z_\\-\\.]+$")),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
 							"request_headers_to_add": schema.ListNestedBlock{
 								MarkdownDescription: "Headers are key-value pairs to be added to HTTP request being routed towards upstream. Headers specified at this level are applied after headers from matched Route are applied.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"append": schema.BoolAttribute{
@@ -9457,10 +10906,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name. Name of the HTTP header.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"value": schema.StringAttribute{
 											MarkdownDescription: "Value of the HTTP header.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(8096),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -9493,7 +10948,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -9505,6 +10969,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							},
 							"response_headers_to_add": schema.ListNestedBlock{
 								MarkdownDescription: "Headers are key-value pairs to be added to HTTP response being sent towards downstream. Headers specified at this level are applied after headers from matched Route are applied.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"append": schema.BoolAttribute{
@@ -9514,10 +10981,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name. Name of the HTTP header.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"value": schema.StringAttribute{
 											MarkdownDescription: "Value of the HTTP header.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(8096),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -9550,7 +11023,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -9573,6 +11055,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										MarkdownDescription: "Headers. List of headers.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(64),
+											listvalidator.UniqueValues(),
+										},
 									},
 								},
 							},
@@ -9583,6 +11069,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										MarkdownDescription: "Headers. List of headers.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(64),
+											listvalidator.UniqueValues(),
+										},
 									},
 								},
 							},
@@ -9606,10 +11096,17 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(86400),
+								},
 							},
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 						},
 					},
@@ -9631,14 +11128,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(86400),
+								},
 							},
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 							"js_script_delay": schema.Int64Attribute{
 								MarkdownDescription: "Delay introduced by Javascript, in milliseconds.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1000),
+									int64validator.AtMost(60000),
+								},
 							},
 						},
 					},
@@ -9648,6 +11156,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -9656,6 +11168,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -9664,6 +11179,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -9676,6 +11194,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						Blocks: map[string]schema.Block{
 							"rules": schema.ListNestedBlock{
 								MarkdownDescription: "Rules that specify the match conditions and challenge type to be launched. When a challenge type is selected to be always enabled, these rules can be used to disable challenge or launch a different challenge for requests that match the specified conditions.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(64),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{},
 									Blocks: map[string]schema.Block{
@@ -9685,10 +11206,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -9712,6 +11239,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												},
 												"arg_matchers": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for all POST args that need to be matched. The criteria for matching each arg are described in individual instances of ArgMatcherType. The actual arg values are extracted from the request API as a list of strings for each arg selector name.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -9721,6 +11251,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															"name": schema.StringAttribute{
 																MarkdownDescription: "Case-sensitive JSON path in the HTTP request body.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -9737,16 +11270,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -9760,6 +11307,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 															Optional:            true,
 															ElementType:         types.Int64Type,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -9769,6 +11321,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													Blocks: map[string]schema.Block{
 														"asn_sets": schema.ListNestedBlock{
 															MarkdownDescription: "List of references to bgp_asn_set objects.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"kind": schema.StringAttribute{
@@ -9819,16 +11374,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "List of exact values to match the input against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(64),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 														"regex_values": schema.ListAttribute{
 															MarkdownDescription: "List of regular expressions to match the input against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+															},
 														},
 														"transformers": schema.ListAttribute{
 															MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(9),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -9839,11 +11408,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(1),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+															},
 														},
 													},
 												},
 												"cookie_matchers": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -9853,6 +11429,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															"name": schema.StringAttribute{
 																MarkdownDescription: "Case-sensitive cookie name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -9869,16 +11448,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -9895,11 +11488,21 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "List of exact values to match the input against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(64),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 														"regex_values": schema.ListAttribute{
 															MarkdownDescription: "List of regular expressions to match the input against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+															},
 														},
 													},
 												},
@@ -9911,6 +11514,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												},
 												"headers": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -9920,6 +11526,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															"name": schema.StringAttribute{
 																MarkdownDescription: "Case-insensitive HTTP header name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -9936,16 +11545,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -9963,6 +11586,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] List of methods values to match against. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -9977,6 +11604,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													Blocks: map[string]schema.Block{
 														"prefix_sets": schema.ListNestedBlock{
 															MarkdownDescription: "List of references to ip_prefix_set objects.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"kind": schema.StringAttribute{
@@ -10031,6 +11661,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(128),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 													},
 												},
@@ -10041,6 +11676,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "List of exact path values to match the input HTTP path against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 														"invert_matcher": schema.BoolAttribute{
 															MarkdownDescription: "Invert Path Matcher. Invert the match result.",
@@ -10050,26 +11690,48 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "List of path prefix values to match the input HTTP path against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 														"regex_values": schema.ListAttribute{
 															MarkdownDescription: "List of regular expressions to match the input HTTP path against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+															},
 														},
 														"suffix_values": schema.ListAttribute{
 															MarkdownDescription: "List of path suffix values to match the input HTTP path against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(64),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(64), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 														"transformers": schema.ListAttribute{
 															MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(9),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
 												"query_params": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -10079,6 +11741,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															"key": schema.StringAttribute{
 																MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -10095,16 +11760,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -10118,16 +11797,30 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+															},
 														},
 														"exact_values": schema.ListAttribute{
 															MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+															},
 														},
 														"excluded_values": schema.ListAttribute{
 															MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(32),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+															},
 														},
 													},
 												},
@@ -10144,6 +11837,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type . Currently supported URL schemes is . For scheme, message needs to be encoded in Base64 format. You can specify this message as base64 encoded plain text message e.g. 'Blocked.' or it can be HTML paragraph or a body string encoded as base64 string E.g. '<p> Blocked..",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 						},
 					},
@@ -10151,15 +11847,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 			},
 			"protected_cookies": schema.ListNestedBlock{
 				MarkdownDescription: "Allows setting attributes (SameSite, Secure, and HttpOnly) on cookies in responses. Cookie Tampering Protection prevents attackers from modifying the value of session cookies. For Cookie Tampering Protection, enabling a web app firewall (WAF) is a prerequisite.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(16),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"max_age_value": schema.Int64Attribute{
 							MarkdownDescription: "Add max age attribute.",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtMost(34560000),
+							},
 						},
 						"name": schema.StringAttribute{
 							MarkdownDescription: "Cookie Name. Name of the Cookie .",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -10209,11 +11915,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						Blocks: map[string]schema.Block{
 							"rate_limiter_allowed_prefixes": schema.ListNestedBlock{
 								MarkdownDescription: "References to ip_prefix_set objects. Requests from source IP addresses that are covered by one of the allowed IP Prefixes are not subjected to rate limiting.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(4),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -10222,6 +11935,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -10230,6 +11946,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -10243,6 +11962,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+								},
 							},
 						},
 					},
@@ -10258,11 +11982,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						Blocks: map[string]schema.Block{
 							"policies": schema.ListNestedBlock{
 								MarkdownDescription: "Ordered list of rate limiter policies.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -10271,6 +12002,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -10279,6 +12013,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -10291,14 +12028,25 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"burst_multiplier": schema.Int64Attribute{
 								MarkdownDescription: "The maximum burst of requests to accommodate, expressed as a multiple of the rate.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(100),
+								},
 							},
 							"period_multiplier": schema.Int64Attribute{
 								MarkdownDescription: "Setting, combined with Per Period units, provides a duration.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
 							},
 							"total_number": schema.Int64Attribute{
 								MarkdownDescription: "The total number of allowed requests per rate-limiting period.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(8192),
+								},
 							},
 							"unit": schema.StringAttribute{
 								MarkdownDescription: "[Enum: SECOND|MINUTE|HOUR] Unit for the period per which the rate limit is applied. - SECOND: Second Rate limit period unit is seconds - MINUTE: Minute Rate limit period unit is minutes - HOUR: Hour Rate limit period unit is hours - DAY: Day Rate limit period unit is days. Possible values are `SECOND`, `MINUTE`, `HOUR`. Defaults to `SECOND`.",
@@ -10316,6 +12064,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"duration": schema.Int64Attribute{
 												MarkdownDescription: "Duration. Configuration parameter for duration",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtLeast(1),
+													int64validator.AtMost(48),
+												},
 											},
 										},
 									},
@@ -10325,6 +12077,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"duration": schema.Int64Attribute{
 												MarkdownDescription: "Duration. Configuration parameter for duration",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtLeast(1),
+													int64validator.AtMost(60),
+												},
 											},
 										},
 									},
@@ -10334,6 +12090,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"duration": schema.Int64Attribute{
 												MarkdownDescription: "Duration. Configuration parameter for duration",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtLeast(1),
+													int64validator.AtMost(300),
+												},
 											},
 										},
 									},
@@ -10362,6 +12122,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -10370,6 +12134,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -10378,6 +12145,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -10392,10 +12162,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 					"request_headers_timeout": schema.Int64Attribute{
 						MarkdownDescription: "The amount of time the client has to send only the headers on the request stream before the stream is cancelled. The  milliseconds. This setting provides protection against Slowloris attacks. Defaults to `10000`.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(2000),
+							int64validator.AtMost(30000),
+						},
 					},
 					"request_timeout": schema.Int64Attribute{
 						MarkdownDescription: ".",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(2000),
+							int64validator.AtMost(300000),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -10409,16 +12187,28 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 			},
 			"trusted_clients": schema.ListNestedBlock{
 				MarkdownDescription: "Define rules to skip processing of one or more features such as WAF, Bot Defense etc.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(256),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"actions": schema.ListAttribute{
 							MarkdownDescription: "[Enum: SKIP_PROCESSING_WAF|SKIP_PROCESSING_BOT|SKIP_PROCESSING_MUM|SKIP_PROCESSING_IP_REPUTATION|SKIP_PROCESSING_API_PROTECTION|SKIP_PROCESSING_OAS_VALIDATION|SKIP_PROCESSING_DDOS_PROTECTION|SKIP_PROCESSING_THREAT_MESH|SKIP_PROCESSING_MALWARE_PROTECTION] Actions that should be taken when client identifier matches the rule. Possible values are `SKIP_PROCESSING_WAF`, `SKIP_PROCESSING_BOT`, `SKIP_PROCESSING_MUM`, `SKIP_PROCESSING_IP_REPUTATION`, `SKIP_PROCESSING_API_PROTECTION`, `SKIP_PROCESSING_OAS_VALIDATION`, `SKIP_PROCESSING_DDOS_PROTECTION`, `SKIP_PROCESSING_THREAT_MESH`, `SKIP_PROCESSING_MALWARE_PROTECTION`. Defaults to `SKIP_PROCESSING_WAF`.",
 							Optional:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(10),
+								listvalidator.UniqueValues(),
+							},
 						},
 						"as_number": schema.Int64Attribute{
 							MarkdownDescription: "RFC 6793 defined 4-byte AS number.",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+								int64validator.AtMost(401308),
+							},
 						},
 						"expiration_timestamp": schema.StringAttribute{
 							MarkdownDescription: "Specifies expiration_timestamp the RFC 3339 format timestamp at which the containing rule is considered to be logically expired. The rule continues to exist in the configuration but is not applied anymore.",
@@ -10427,14 +12217,23 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						"ip_prefix": schema.StringAttribute{
 							MarkdownDescription: "IPv4 prefix string.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.IPv4PrefixValidator(),
+							},
 						},
 						"ipv6_prefix": schema.StringAttribute{
 							MarkdownDescription: "IPv6 prefix string.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.IPv6PrefixValidator(),
+							},
 						},
 						"user_identifier": schema.StringAttribute{
 							MarkdownDescription: "Identify user based on user identifier. User identifier value needs to be copied from security event.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -10447,11 +12246,18 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							Blocks: map[string]schema.Block{
 								"headers": schema.ListNestedBlock{
 									MarkdownDescription: "List of HTTP header name and value pairs .",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"exact": schema.StringAttribute{
 												MarkdownDescription: "Header value to match exactly.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"invert_match": schema.BoolAttribute{
 												MarkdownDescription: "Invert the result of the match to detect missing header or non-matching value.",
@@ -10460,6 +12266,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"name": schema.StringAttribute{
 												MarkdownDescription: "Name. Name of the header .",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"presence": schema.BoolAttribute{
 												MarkdownDescription: "If true, check for presence of header.",
@@ -10468,6 +12278,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"regex": schema.StringAttribute{
 												MarkdownDescription: "Regex match of the header value in re2 format.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+													validators.RegexValidator(),
+												},
 											},
 										},
 									},
@@ -10480,10 +12295,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Description. Human readable description.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -10505,6 +12326,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 					"name": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(128),
+							stringvalidator.LengthAtLeast(1),
+						},
 					},
 					"namespace": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -10513,6 +12338,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 					"tenant": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -10521,6 +12349,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 				},
 			},
@@ -10534,11 +12365,19 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						Blocks: map[string]schema.Block{
 							"rules": schema.ListNestedBlock{
 								MarkdownDescription: "Ordered list of WAF Exclusions specific to this Load Balancer.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(256),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"exact_value": schema.StringAttribute{
 											MarkdownDescription: "Exact domain name.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.HostnameValidator(),
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 										"expiration_timestamp": schema.StringAttribute{
 											MarkdownDescription: "Specifies expiration_timestamp the RFC 3339 format timestamp at which the containing rule is considered to be logically expired. The rule continues to exist in the configuration but is not applied anymore.",
@@ -10548,18 +12387,34 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] Methods. Methods to be matched. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+											},
 										},
 										"path_prefix": schema.StringAttribute{
 											MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths).",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"path_regex": schema.StringAttribute{
 											MarkdownDescription: "Define the regex for the path. For example, the regex ^/.*$ will match on all paths.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(256),
+												validators.RegexValidator(),
+											},
 										},
 										"suffix_value": schema.StringAttribute{
 											MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.HostnameValidator(),
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -10575,6 +12430,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											Blocks: map[string]schema.Block{
 												"exclude_attack_type_contexts": schema.ListNestedBlock{
 													MarkdownDescription: "Attack Types to be excluded for the defined match criteria.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(64),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"context": schema.StringAttribute{
@@ -10584,6 +12442,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															"context_name": schema.StringAttribute{
 																MarkdownDescription: "Relevant only for contexts: Header, Cookie and Parameter. Name of the Context that the WAF Exclusion Rules will check. Wildcard matching can be used by prefixing or suffixing the context name with an wildcard asterisk (*).",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.UTF8LengthAtMost(128),
+																},
 															},
 															"exclude_attack_type": schema.StringAttribute{
 																MarkdownDescription: "[Enum: ATTACK_TYPE_NONE|ATTACK_TYPE_NON_BROWSER_CLIENT|ATTACK_TYPE_OTHER_APPLICATION_ATTACKS|ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE|ATTACK_TYPE_DETECTION_EVASION|ATTACK_TYPE_VULNERABILITY_SCAN|ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY|ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS|ATTACK_TYPE_BUFFER_OVERFLOW|ATTACK_TYPE_PREDICTABLE_RESOURCE_LOCATION|ATTACK_TYPE_INFORMATION_LEAKAGE|ATTACK_TYPE_DIRECTORY_INDEXING|ATTACK_TYPE_PATH_TRAVERSAL|ATTACK_TYPE_XPATH_INJECTION|ATTACK_TYPE_LDAP_INJECTION|ATTACK_TYPE_SERVER_SIDE_CODE_INJECTION|ATTACK_TYPE_COMMAND_EXECUTION|ATTACK_TYPE_SQL_INJECTION|ATTACK_TYPE_CROSS_SITE_SCRIPTING|ATTACK_TYPE_DENIAL_OF_SERVICE|ATTACK_TYPE_HTTP_PARSER_ATTACK|ATTACK_TYPE_SESSION_HIJACKING|ATTACK_TYPE_HTTP_RESPONSE_SPLITTING|ATTACK_TYPE_FORCEFUL_BROWSING|ATTACK_TYPE_REMOTE_FILE_INCLUDE|ATTACK_TYPE_MALICIOUS_FILE_UPLOAD|ATTACK_TYPE_GRAPHQL_PARSER_ATTACK] List of all Attack Types ATTACK_TYPE_NONE ATTACK_TYPE_NON_BROWSER_CLIENT ATTACK_TYPE_OTHER_APPLICATION_ATTACKS ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE ATTACK_TYPE_DETECTION_EVASION ATTACK_TYPE_VULNERABILITY_SCAN ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS.. Possible values are `ATTACK_TYPE_NONE`, `ATTACK_TYPE_NON_BROWSER_CLIENT`, `ATTACK_TYPE_OTHER_APPLICATION_ATTACKS`, `ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE`, `ATTACK_TYPE_DETECTION_EVASION`, `ATTACK_TYPE_VULNERABILITY_SCAN`, `ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY`, `ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS`, `ATTACK_TYPE_BUFFER_OVERFLOW`, `ATTACK_TYPE_PREDICTABLE_RESOURCE_LOCATION`, `ATTACK_TYPE_INFORMATION_LEAKAGE`, `ATTACK_TYPE_DIRECTORY_INDEXING`, `ATTACK_TYPE_PATH_TRAVERSAL`, `ATTACK_TYPE_XPATH_INJECTION`, `ATTACK_TYPE_LDAP_INJECTION`, `ATTACK_TYPE_SERVER_SIDE_CODE_INJECTION`, `ATTACK_TYPE_COMMAND_EXECUTION`, `ATTACK_TYPE_SQL_INJECTION`, `ATTACK_TYPE_CROSS_SITE_SCRIPTING`, `ATTACK_TYPE_DENIAL_OF_SERVICE`, `ATTACK_TYPE_HTTP_PARSER_ATTACK`, `ATTACK_TYPE_SESSION_HIJACKING`, `ATTACK_TYPE_HTTP_RESPONSE_SPLITTING`, `ATTACK_TYPE_FORCEFUL_BROWSING`, `ATTACK_TYPE_REMOTE_FILE_INCLUDE`, `ATTACK_TYPE_MALICIOUS_FILE_UPLOAD`, `ATTACK_TYPE_GRAPHQL_PARSER_ATTACK`. Defaults to `ATTACK_TYPE_NONE`.",
@@ -10594,6 +12455,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												},
 												"exclude_bot_name_contexts": schema.ListNestedBlock{
 													MarkdownDescription: "Bot Names to be excluded for the defined match criteria.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(64),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"bot_name": schema.StringAttribute{
@@ -10605,6 +12469,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												},
 												"exclude_signature_contexts": schema.ListNestedBlock{
 													MarkdownDescription: "Signature IDs to be excluded for the defined match criteria.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1024),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"context": schema.StringAttribute{
@@ -10614,16 +12481,26 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															"context_name": schema.StringAttribute{
 																MarkdownDescription: "Relevant only for contexts: Header, Cookie and Parameter. Name of the Context that the WAF Exclusion Rules will check. Wildcard matching can be used by prefixing or suffixing the context name with an wildcard asterisk (*).",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.UTF8LengthAtMost(128),
+																},
 															},
 															"signature_id": schema.Int64Attribute{
 																MarkdownDescription: "The allowed values for signature ID are 0 and in the range of 200000001-299999999. 0 implies that all signatures will be excluded for the specified context.",
 																Optional:            true,
+																Validators: []validator.Int64{
+																	int64validator.AtLeast(0),
+																	int64validator.AtMost(299999999),
+																},
 															},
 														},
 													},
 												},
 												"exclude_violation_contexts": schema.ListNestedBlock{
 													MarkdownDescription: "Violations to be excluded for the defined match criteria.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(64),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"context": schema.StringAttribute{
@@ -10633,6 +12510,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															"context_name": schema.StringAttribute{
 																MarkdownDescription: "Relevant only for contexts: Header, Cookie and Parameter. Name of the Context that the WAF Exclusion Rules will check. Wildcard matching can be used by prefixing or suffixing the context name with an wildcard asterisk (*).",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.UTF8LengthAtMost(128),
+																},
 															},
 															"exclude_violation": schema.StringAttribute{
 																MarkdownDescription: "[Enum: VIOL_NONE|VIOL_FILETYPE|VIOL_METHOD|VIOL_MANDATORY_HEADER|VIOL_HTTP_RESPONSE_STATUS|VIOL_REQUEST_MAX_LENGTH|VIOL_FILE_UPLOAD|VIOL_FILE_UPLOAD_IN_BODY|VIOL_XML_MALFORMED|VIOL_JSON_MALFORMED|VIOL_ASM_COOKIE_MODIFIED|VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS|VIOL_HTTP_PROTOCOL_BAD_HOST_HEADER_VALUE|VIOL_HTTP_PROTOCOL_UNPARSABLE_REQUEST_CONTENT|VIOL_HTTP_PROTOCOL_NULL_IN_REQUEST|VIOL_HTTP_PROTOCOL_BAD_HTTP_VERSION|VIOL_HTTP_PROTOCOL_SEVERAL_CONTENT_LENGTH_HEADERS|VIOL_EVASION_DIRECTORY_TRAVERSALS|VIOL_MALFORMED_REQUEST|VIOL_EVASION_MULTIPLE_DECODING|VIOL_DATA_GUARD|VIOL_EVASION_APACHE_WHITESPACE|VIOL_COOKIE_MODIFIED|VIOL_EVASION_IIS_UNICODE_CODEPOINTS|VIOL_EVASION_IIS_BACKSLASHES|VIOL_EVASION_PERCENT_U_DECODING|VIOL_EVASION_BARE_BYTE_DECODING|VIOL_EVASION_BAD_UNESCAPE|VIOL_HTTP_PROTOCOL_BODY_IN_GET_OR_HEAD_REQUEST|VIOL_ENCODING|VIOL_COOKIE_MALFORMED|VIOL_GRAPHQL_FORMAT|VIOL_GRAPHQL_MALFORMED|VIOL_GRAPHQL_INTROSPECTION_QUERY] List of all supported Violation Types VIOL_NONE VIOL_FILETYPE VIOL_METHOD VIOL_MANDATORY_HEADER VIOL_HTTP_RESPONSE_STATUS VIOL_REQUEST_MAX_LENGTH VIOL_FILE_UPLOAD VIOL_FILE_UPLOAD_IN_BODY VIOL_XML_MALFORMED VIOL_JSON_MALFORMED VIOL_ASM_COOKIE_MODIFIED VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS.. Possible values are `VIOL_NONE`, `VIOL_FILETYPE`, `VIOL_METHOD`, `VIOL_MANDATORY_HEADER`, `VIOL_HTTP_RESPONSE_STATUS`, `VIOL_REQUEST_MAX_LENGTH`, `VIOL_FILE_UPLOAD`, `VIOL_FILE_UPLOAD_IN_BODY`, `VIOL_XML_MALFORMED`, `VIOL_JSON_MALFORMED`, `VIOL_ASM_COOKIE_MODIFIED`, `VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS`, `VIOL_HTTP_PROTOCOL_BAD_HOST_HEADER_VALUE`, `VIOL_HTTP_PROTOCOL_UNPARSABLE_REQUEST_CONTENT`, `VIOL_HTTP_PROTOCOL_NULL_IN_REQUEST`, `VIOL_HTTP_PROTOCOL_BAD_HTTP_VERSION`, `VIOL_HTTP_PROTOCOL_SEVERAL_CONTENT_LENGTH_HEADERS`, `VIOL_EVASION_DIRECTORY_TRAVERSALS`, `VIOL_MALFORMED_REQUEST`, `VIOL_EVASION_MULTIPLE_DECODING`, `VIOL_DATA_GUARD`, `VIOL_EVASION_APACHE_WHITESPACE`, `VIOL_COOKIE_MODIFIED`, `VIOL_EVASION_IIS_UNICODE_CODEPOINTS`, `VIOL_EVASION_IIS_BACKSLASHES`, `VIOL_EVASION_PERCENT_U_DECODING`, `VIOL_EVASION_BARE_BYTE_DECODING`, `VIOL_EVASION_BAD_UNESCAPE`, `VIOL_HTTP_PROTOCOL_BODY_IN_GET_OR_HEAD_REQUEST`, `VIOL_ENCODING`, `VIOL_COOKIE_MALFORMED`, `VIOL_GRAPHQL_FORMAT`, `VIOL_GRAPHQL_MALFORMED`, `VIOL_GRAPHQL_INTROSPECTION_QUERY`. Defaults to `VIOL_NONE`.",
@@ -10649,10 +12529,16 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -10670,6 +12556,10 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -10678,6 +12568,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -10686,6 +12579,9 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -10695,6 +12591,11 @@ func (r *CDNLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *CDNLoadBalancerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *CDNLoadBalancerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -10719,6 +12620,204 @@ func (r *CDNLoadBalancerResource) ValidateConfig(ctx context.Context, req resour
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *CDNLoadBalancerResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "api_definition_choice", "api_specification", "disable_api_definition"),
+		validators.OneOfGroup(path.MatchRelative(), "api_discovery_choice", "disable_api_discovery", "enable_api_discovery"),
+		validators.OneOfGroup(path.MatchRelative(), "challenge_type", "captcha_challenge", "enable_challenge", "js_challenge", "no_challenge", "policy_based_challenge"),
+		validators.OneOfGroup(path.MatchRelative(), "client_side_defense_choice", "client_side_defense", "disable_client_side_defense"),
+		validators.OneOfGroup(path.MatchRelative(), "ip_reputation_choice", "disable_ip_reputation", "enable_ip_reputation"),
+		validators.OneOfGroup(path.MatchRelative(), "l7_ddos_auto_mitigation_action", "l7_ddos_action_block", "l7_ddos_action_default", "l7_ddos_action_js_challenge"),
+		validators.OneOfGroup(path.MatchRelative(), "loadbalancer_type", "http", "https", "https_auto_cert"),
+		validators.OneOfGroup(path.MatchRelative(), "malicious_user_detection_choice", "disable_malicious_user_detection", "enable_malicious_user_detection"),
+		validators.OneOfGroup(path.MatchRelative(), "rate_limit_choice", "api_rate_limit", "disable_rate_limit", "rate_limit"),
+		validators.OneOfGroup(path.MatchRelative(), "sensitive_data_policy_choice", "default_sensitive_data_policy", "sensitive_data_policy"),
+		validators.OneOfGroup(path.MatchRelative(), "service_policy_choice", "active_service_policies", "no_service_policies", "service_policies_from_namespace"),
+		validators.OneOfGroup(path.MatchRelative(), "slow_ddos_mitigation_choice", "slow_ddos_mitigation", "system_default_timeouts"),
+		validators.OneOfGroup(path.MatchRelative(), "threat_mesh_choice", "disable_threat_mesh", "enable_threat_mesh"),
+		validators.OneOfGroup(path.MatchRelative(), "user_id_choice", "user_id_client_ip", "user_identification"),
+		validators.OneOfGroup(path.MatchRelative(), "waf_choice", "app_firewall", "disable_waf"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit"), "ip_allowed_list_choice", "bypass_rate_limiting_rules", "custom_ip_allowed_list", "ip_allowed_list", "no_ip_allowed_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex(), "domain_choice", "any_domain", "specific_domain"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex(), "rate_limiter_choice", "inline_rate_limiter", "ref_rate_limiter"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("client_matcher"), "client_choice", "any_client", "client_selector", "ip_threat_category_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("client_matcher"), "ip_asn_choice", "any_ip", "asn_list", "asn_matcher", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("inline_rate_limiter"), "count_by_choice", "ref_user_id", "use_http_lb_user_id"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("request_matcher").AtName("cookie_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("request_matcher").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("request_matcher").AtName("jwt_claims").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("request_matcher").AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex(), "destination_type", "any_url", "api_endpoint", "api_groups", "base_path"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex(), "domain_choice", "any_domain", "specific_domain"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex().AtName("client_matcher"), "client_choice", "any_client", "client_selector", "ip_threat_category_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex().AtName("client_matcher"), "ip_asn_choice", "any_ip", "asn_list", "asn_matcher", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex().AtName("request_matcher").AtName("cookie_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex().AtName("request_matcher").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex().AtName("request_matcher").AtName("jwt_claims").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex().AtName("request_matcher").AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex(), "domain_choice", "any_domain", "specific_domain"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex(), "rate_limiter_choice", "inline_rate_limiter", "ref_rate_limiter"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("client_matcher"), "client_choice", "any_client", "client_selector", "ip_threat_category_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("client_matcher"), "ip_asn_choice", "any_ip", "asn_list", "asn_matcher", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("inline_rate_limiter"), "count_by_choice", "ref_user_id", "use_http_lb_user_id"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("request_matcher").AtName("cookie_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("request_matcher").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("request_matcher").AtName("jwt_claims").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("request_matcher").AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_specification"), "validation_target_choice", "validation_all_spec_endpoints", "validation_custom_list", "validation_disabled"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("fall_through_mode"), "fall_through_mode_choice", "fall_through_mode_allow", "fall_through_mode_custom"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("fall_through_mode").AtName("fall_through_mode_custom").AtName("open_api_validation_rules").AtAnyListIndex(), "action_choice", "action_block", "action_report", "action_skip"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("fall_through_mode").AtName("fall_through_mode_custom").AtName("open_api_validation_rules").AtAnyListIndex(), "condition_type_choice", "api_endpoint", "api_group", "base_path"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("settings"), "oversized_body_choice", "oversized_body_fail_validation", "oversized_body_skip_validation"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("settings"), "property_validation_settings_choice", "property_validation_settings_custom", "property_validation_settings_default"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("settings").AtName("property_validation_settings_custom").AtName("query_parameters"), "additional_parameters_choice", "allow_additional_parameters", "disallow_additional_parameters"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("validation_mode"), "response_validation_mode_choice", "response_validation_mode_active", "skip_response_validation"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("validation_mode"), "validation_mode_choice", "skip_validation", "validation_mode_active"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("validation_mode").AtName("response_validation_mode_active"), "validation_enforcement_type", "enforcement_block", "enforcement_report"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("validation_mode").AtName("validation_mode_active"), "validation_enforcement_type", "enforcement_block", "enforcement_report"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("fall_through_mode"), "fall_through_mode_choice", "fall_through_mode_allow", "fall_through_mode_custom"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("fall_through_mode").AtName("fall_through_mode_custom").AtName("open_api_validation_rules").AtAnyListIndex(), "action_choice", "action_block", "action_report", "action_skip"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("fall_through_mode").AtName("fall_through_mode_custom").AtName("open_api_validation_rules").AtAnyListIndex(), "condition_type_choice", "api_endpoint", "api_group", "base_path"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("open_api_validation_rules").AtAnyListIndex(), "condition_type_choice", "api_endpoint", "api_group", "base_path"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("open_api_validation_rules").AtAnyListIndex(), "domain_choice", "any_domain", "specific_domain"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("open_api_validation_rules").AtAnyListIndex().AtName("validation_mode"), "response_validation_mode_choice", "response_validation_mode_active", "skip_response_validation"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("open_api_validation_rules").AtAnyListIndex().AtName("validation_mode"), "validation_mode_choice", "skip_validation", "validation_mode_active"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("open_api_validation_rules").AtAnyListIndex().AtName("validation_mode").AtName("response_validation_mode_active"), "validation_enforcement_type", "enforcement_block", "enforcement_report"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("open_api_validation_rules").AtAnyListIndex().AtName("validation_mode").AtName("validation_mode_active"), "validation_enforcement_type", "enforcement_block", "enforcement_report"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("settings"), "oversized_body_choice", "oversized_body_fail_validation", "oversized_body_skip_validation"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("settings"), "property_validation_settings_choice", "property_validation_settings_custom", "property_validation_settings_default"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("settings").AtName("property_validation_settings_custom").AtName("query_parameters"), "additional_parameters_choice", "allow_additional_parameters", "disallow_additional_parameters"),
+		validators.OneOfGroup(path.MatchRoot("blocked_clients").AtAnyListIndex(), "action_choice", "bot_skip_processing", "skip_processing", "waf_skip_processing"),
+		validators.RequiredOneOfGroup(path.MatchRoot("blocked_clients").AtAnyListIndex(), "client_source_choice", "as_number", "http_header", "ip_prefix", "ipv6_prefix", "user_identifier"),
+		validators.OneOfGroup(path.MatchRoot("blocked_clients").AtAnyListIndex().AtName("http_header").AtName("headers").AtAnyListIndex(), "value_match", "exact", "presence", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense"), "cors_support_choice", "disable_cors_support", "enable_cors_support"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy"), "java_script_choice", "disable_js_insert", "js_insert_all_pages", "js_insert_all_pages_except", "js_insertion_rules"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy"), "mobile_sdk_choice", "disable_mobile_sdk", "mobile_sdk_config"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("mobile_sdk_config").AtName("mobile_identifier").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex(), "app_traffic_type_choice", "mobile", "web", "web_mobile"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex(), "flow_label_choice", "flow_label", "undefined_flow_label"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex(), "goodbot_choice", "allow_good_bots", "mitigate_good_bots"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label"), "flow_label_choice", "account_management", "authentication", "financial_services", "flight", "profile_management", "search", "shopping_gift_cards"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("account_management"), "label_choice", "create", "password_reset"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("authentication"), "label_choice", "login", "login_mfa", "login_partner", "logout", "token_refresh"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("authentication").AtName("login"), "transaction_result_choice", "disable_transaction_result", "transaction_result"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("financial_services"), "label_choice", "apply", "money_transfer"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("profile_management"), "label_choice", "create", "update", "view"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("search"), "label_choice", "flight_search", "product_search", "reservation_search", "room_search"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("shopping_gift_cards"), "label_choice", "gift_card_make_purchase_with_gift_card", "gift_card_validation", "shop_add_to_cart", "shop_checkout", "shop_choose_seat", "shop_enter_drawing_submission", "shop_make_payment", "shop_order", "shop_price_inquiry", "shop_promo_code_validation", "shop_purchase_gift_card", "shop_update_quantity"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("mitigation"), "action_type", "block", "flag", "redirect"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("mitigation").AtName("flag"), "send_headers_choice", "append_headers", "no_headers"),
+		validators.RequiredOneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy"), "java_script_choice", "disable_js_insert", "js_insert_all_pages", "js_insert_all_pages_except", "js_insertion_rules"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("csrf_policy"), "allowed_domains", "all_load_balancer_domains", "custom_domain_list", "disabled"),
+		validators.OneOfGroup(path.MatchRoot("data_guard_rules").AtAnyListIndex(), "action_choice", "apply_data_guard", "skip_data_guard"),
+		validators.OneOfGroup(path.MatchRoot("data_guard_rules").AtAnyListIndex(), "domain_choice", "any_domain", "exact_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("data_guard_rules").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("ddos_mitigation_rules").AtAnyListIndex(), "mitigation_choice", "ddos_client_source", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("default_cache_action"), "cache_actions", "cache_disabled", "cache_ttl_default", "cache_ttl_override"),
+		validators.OneOfGroup(path.MatchRoot("enable_api_discovery"), "api_discovery_settings_choice", "custom_api_auth_discovery", "default_api_auth_discovery"),
+		validators.OneOfGroup(path.MatchRoot("enable_api_discovery"), "learn_from_redirect_traffic", "disable_learn_from_redirect_traffic", "enable_learn_from_redirect_traffic"),
+		validators.OneOfGroup(path.MatchRoot("enable_api_discovery").AtName("api_crawler"), "api_crawler", "api_crawler_config", "disable_api_crawler"),
+		validators.OneOfGroup(path.MatchRoot("enable_api_discovery").AtName("api_crawler").AtName("api_crawler_config").AtName("domains").AtAnyListIndex().AtName("simple_login").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("enable_api_discovery").AtName("api_discovery_from_code_scan").AtName("code_base_integrations").AtAnyListIndex(), "api_repos_choice", "all_repos", "selected_repos"),
+		validators.OneOfGroup(path.MatchRoot("enable_challenge"), "captcha_challenge_parameters_choice", "captcha_challenge_parameters", "default_captcha_challenge_parameters"),
+		validators.OneOfGroup(path.MatchRoot("enable_challenge"), "js_challenge_parameters_choice", "default_js_challenge_parameters", "js_challenge_parameters"),
+		validators.OneOfGroup(path.MatchRoot("enable_challenge"), "malicious_user_mitigation_choice", "default_mitigation_settings", "malicious_user_mitigation"),
+		validators.OneOfGroup(path.MatchRoot("graphql_rules").AtAnyListIndex(), "domain_choice", "any_domain", "exact_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("graphql_rules").AtAnyListIndex(), "method_choice", "method_get", "method_post"),
+		validators.OneOfGroup(path.MatchRoot("graphql_rules").AtAnyListIndex().AtName("graphql_settings"), "allow_introspection_queries_choice", "disable_introspection", "enable_introspection"),
+		validators.OneOfGroup(path.MatchRoot("http"), "port_choice", "port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_options"), "tls_certificates_choice", "tls_cert_params", "tls_inline_params"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_options").AtName("tls_cert_params"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_options").AtName("tls_cert_params").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_options").AtName("tls_cert_params").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https").AtName("tls_cert_options").AtName("tls_cert_params").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_options").AtName("tls_cert_params").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_options").AtName("tls_inline_params"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_options").AtName("tls_inline_params").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_options").AtName("tls_inline_params").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_options").AtName("tls_inline_params").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_options").AtName("tls_inline_params").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https").AtName("tls_cert_options").AtName("tls_inline_params").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_options").AtName("tls_inline_params").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("https_auto_cert").AtName("tls_config"), "choice", "tls_11_plus", "tls_12_plus"),
+		validators.OneOfGroup(path.MatchRoot("jwt_validation").AtName("action"), "action_choice", "block", "report"),
+		validators.OneOfGroup(path.MatchRoot("jwt_validation").AtName("reserved_claims"), "audience_validation", "audience", "audience_disable"),
+		validators.OneOfGroup(path.MatchRoot("jwt_validation").AtName("reserved_claims"), "issuer_validation", "issuer", "issuer_disable"),
+		validators.OneOfGroup(path.MatchRoot("jwt_validation").AtName("reserved_claims"), "validate_period", "validate_period_disable", "validate_period_enable"),
+		validators.OneOfGroup(path.MatchRoot("jwt_validation").AtName("target"), "target", "all_endpoint", "api_groups", "base_paths"),
+		validators.OneOfGroup(path.MatchRoot("origin_pool"), "tls_choice", "no_tls", "use_tls"),
+		validators.OneOfGroup(path.MatchRoot("origin_pool").AtName("origin_servers").AtAnyListIndex(), "choice", "public_ip", "public_name"),
+		validators.RequiredOneOfGroup(path.MatchRoot("origin_pool").AtName("use_tls"), "max_session_keys_type", "default_session_key_caching", "disable_session_key_caching", "max_session_keys"),
+		validators.OneOfGroup(path.MatchRoot("origin_pool").AtName("use_tls"), "mtls_choice", "no_mtls", "use_mtls", "use_mtls_obj"),
+		validators.OneOfGroup(path.MatchRoot("origin_pool").AtName("use_tls"), "server_validation_choice", "skip_server_verification", "use_server_verification", "volterra_trusted_ca"),
+		validators.OneOfGroup(path.MatchRoot("origin_pool").AtName("use_tls"), "sni_choice", "disable_sni", "sni", "use_host_header_as_sni"),
+		validators.OneOfGroup(path.MatchRoot("origin_pool").AtName("use_tls").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("origin_pool").AtName("use_tls").AtName("use_mtls").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("origin_pool").AtName("use_tls").AtName("use_mtls").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.RequiredOneOfGroup(path.MatchRoot("origin_pool").AtName("use_tls").AtName("use_server_verification"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("other_settings").AtName("header_options").AtName("request_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("other_settings").AtName("header_options").AtName("request_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("other_settings").AtName("header_options").AtName("response_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("other_settings").AtName("header_options").AtName("response_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge"), "captcha_challenge_parameters_choice", "captcha_challenge_parameters", "default_captcha_challenge_parameters"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge"), "challenge_choice", "always_enable_captcha_challenge", "always_enable_js_challenge", "no_challenge"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge"), "js_challenge_parameters_choice", "default_js_challenge_parameters", "js_challenge_parameters"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge"), "malicious_user_mitigation_choice", "default_mitigation_settings", "malicious_user_mitigation"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge"), "temporary_blocking_parameters_choice", "default_temporary_blocking_parameters", "temporary_user_blocking"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec"), "asn_choice", "any_asn", "asn_list", "asn_matcher"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec"), "challenge_action", "disable_challenge", "enable_captcha_challenge", "enable_javascript_challenge"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec"), "client_choice", "any_client", "client_selector"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec"), "ip_choice", "any_ip", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("arg_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("cookie_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("protected_cookies").AtAnyListIndex(), "cookie_tampering", "disable_tampering_protection", "enable_tampering_protection"),
+		validators.OneOfGroup(path.MatchRoot("protected_cookies").AtAnyListIndex(), "httponly", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("protected_cookies").AtAnyListIndex(), "max_age", "ignore_max_age", "max_age_value"),
+		validators.OneOfGroup(path.MatchRoot("protected_cookies").AtAnyListIndex(), "samesite", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("protected_cookies").AtAnyListIndex(), "secure", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("rate_limit"), "ip_allowed_list_choice", "custom_ip_allowed_list", "ip_allowed_list", "no_ip_allowed_list"),
+		validators.OneOfGroup(path.MatchRoot("rate_limit"), "policy_choice", "no_policies", "policies"),
+		validators.OneOfGroup(path.MatchRoot("rate_limit").AtName("rate_limiter"), "action_choice", "action_block", "disabled"),
+		validators.OneOfGroup(path.MatchRoot("rate_limit").AtName("rate_limiter"), "algorithm", "leaky_bucket", "token_bucket"),
+		validators.OneOfGroup(path.MatchRoot("rate_limit").AtName("rate_limiter").AtName("action_block"), "block_duration_choice", "hours", "minutes", "seconds"),
+		validators.RequiredOneOfGroup(path.MatchRoot("slow_ddos_mitigation"), "request_timeout_choice", "disable_request_timeout", "request_timeout"),
+		validators.OneOfGroup(path.MatchRoot("trusted_clients").AtAnyListIndex(), "action_choice", "bot_skip_processing", "skip_processing", "waf_skip_processing"),
+		validators.RequiredOneOfGroup(path.MatchRoot("trusted_clients").AtAnyListIndex(), "client_source_choice", "as_number", "http_header", "ip_prefix", "ipv6_prefix", "user_identifier"),
+		validators.OneOfGroup(path.MatchRoot("trusted_clients").AtAnyListIndex().AtName("http_header").AtName("headers").AtAnyListIndex(), "value_match", "exact", "presence", "regex"),
+		validators.OneOfGroup(path.MatchRoot("waf_exclusion"), "waf_exclusion_choice", "waf_exclusion_inline_rules", "waf_exclusion_policy"),
+		validators.OneOfGroup(path.MatchRoot("waf_exclusion").AtName("waf_exclusion_inline_rules").AtName("rules").AtAnyListIndex(), "domain_choice", "any_domain", "exact_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("waf_exclusion").AtName("waf_exclusion_inline_rules").AtName("rules").AtAnyListIndex(), "path_choice", "any_path", "path_prefix", "path_regex"),
+		validators.OneOfGroup(path.MatchRoot("waf_exclusion").AtName("waf_exclusion_inline_rules").AtName("rules").AtAnyListIndex(), "waf_advanced_configuration", "app_firewall_detection_control", "waf_skip_processing"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *CDNLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -10729,7 +12828,10 @@ func (r *CDNLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.M
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "cdn_loadbalancer", "", req.Config, &resp.Plan)...)
 		var plan CDNLoadBalancerResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -10747,7 +12849,8 @@ func (r *CDNLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.M
 
 func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data CDNLoadBalancerResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -10786,6 +12889,7 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -10795,6 +12899,7 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.ActiveServicePolicies != nil {
@@ -10845,10 +12950,106 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 					if listItem.ClientMatcher.AnyIP != nil {
 						client_matcherDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.ClientMatcher.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.AsnList.AsNumbers.IsNull() && !listItem.ClientMatcher.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.ClientMatcher.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						client_matcherDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.ClientMatcher.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						client_matcherDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.ClientSelector.Expressions.IsNull() && !listItem.ClientMatcher.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.ClientMatcher.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						client_matcherDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.ClientMatcher.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsNull() && !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.ClientMatcher.IPMatcher.InvertMatcher.ValueBool()
+						}
+						client_matcherDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsNull() && !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.ClientMatcher.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsNull() && !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.ClientMatcher.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.ClientMatcher.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.ClientMatcher.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						client_matcherDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
 					listItemMap["client_matcher"] = client_matcherDeepMap
 				}
 				if listItem.InlineRateLimiter != nil {
 					inline_rate_limiterDeepMap := make(map[string]interface{})
+					if listItem.InlineRateLimiter.RefUserID != nil {
+						ref_user_idLevel4Map := make(map[string]interface{})
+						if !listItem.InlineRateLimiter.RefUserID.Name.IsNull() && !listItem.InlineRateLimiter.RefUserID.Name.IsUnknown() {
+							ref_user_idLevel4Map["name"] = listItem.InlineRateLimiter.RefUserID.Name.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Namespace.IsNull() && !listItem.InlineRateLimiter.RefUserID.Namespace.IsUnknown() {
+							ref_user_idLevel4Map["namespace"] = listItem.InlineRateLimiter.RefUserID.Namespace.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Tenant.IsNull() && !listItem.InlineRateLimiter.RefUserID.Tenant.IsUnknown() {
+							ref_user_idLevel4Map["tenant"] = listItem.InlineRateLimiter.RefUserID.Tenant.ValueString()
+						}
+						inline_rate_limiterDeepMap["ref_user_id"] = ref_user_idLevel4Map
+					}
 					if !listItem.InlineRateLimiter.Threshold.IsNull() && !listItem.InlineRateLimiter.Threshold.IsUnknown() {
 						inline_rate_limiterDeepMap["threshold"] = listItem.InlineRateLimiter.Threshold.ValueInt64()
 					}
@@ -10920,10 +13121,106 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 					if listItem.ClientMatcher.AnyIP != nil {
 						client_matcherDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.ClientMatcher.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.AsnList.AsNumbers.IsNull() && !listItem.ClientMatcher.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.ClientMatcher.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						client_matcherDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.ClientMatcher.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						client_matcherDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.ClientSelector.Expressions.IsNull() && !listItem.ClientMatcher.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.ClientMatcher.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						client_matcherDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.ClientMatcher.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsNull() && !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.ClientMatcher.IPMatcher.InvertMatcher.ValueBool()
+						}
+						client_matcherDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsNull() && !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.ClientMatcher.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsNull() && !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.ClientMatcher.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.ClientMatcher.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.ClientMatcher.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						client_matcherDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
 					listItemMap["client_matcher"] = client_matcherDeepMap
 				}
 				if listItem.InlineRateLimiter != nil {
 					inline_rate_limiterDeepMap := make(map[string]interface{})
+					if listItem.InlineRateLimiter.RefUserID != nil {
+						ref_user_idLevel4Map := make(map[string]interface{})
+						if !listItem.InlineRateLimiter.RefUserID.Name.IsNull() && !listItem.InlineRateLimiter.RefUserID.Name.IsUnknown() {
+							ref_user_idLevel4Map["name"] = listItem.InlineRateLimiter.RefUserID.Name.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Namespace.IsNull() && !listItem.InlineRateLimiter.RefUserID.Namespace.IsUnknown() {
+							ref_user_idLevel4Map["namespace"] = listItem.InlineRateLimiter.RefUserID.Namespace.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Tenant.IsNull() && !listItem.InlineRateLimiter.RefUserID.Tenant.IsUnknown() {
+							ref_user_idLevel4Map["tenant"] = listItem.InlineRateLimiter.RefUserID.Tenant.ValueString()
+						}
+						inline_rate_limiterDeepMap["ref_user_id"] = ref_user_idLevel4Map
+					}
 					if !listItem.InlineRateLimiter.Threshold.IsNull() && !listItem.InlineRateLimiter.Threshold.IsUnknown() {
 						inline_rate_limiterDeepMap["threshold"] = listItem.InlineRateLimiter.Threshold.ValueInt64()
 					}
@@ -10978,10 +13275,130 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		if data.APISpecification.ValidationAllSpecEndpoints != nil {
 			validation_all_spec_endpointsNestedMap := make(map[string]interface{})
+			if data.APISpecification.ValidationAllSpecEndpoints.FallThroughMode != nil {
+				fall_through_modeLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationAllSpecEndpoints.FallThroughMode.FallThroughModeAllow != nil {
+					fall_through_modeLevel3Map["fall_through_mode_allow"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.FallThroughMode.FallThroughModeCustom != nil {
+					fall_through_mode_customLevel4Map := make(map[string]interface{})
+					fall_through_modeLevel3Map["fall_through_mode_custom"] = fall_through_mode_customLevel4Map
+				}
+				validation_all_spec_endpointsNestedMap["fall_through_mode"] = fall_through_modeLevel3Map
+			}
+			if data.APISpecification.ValidationAllSpecEndpoints.Settings != nil {
+				settingsLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.OversizedBodyFailValidation != nil {
+					settingsLevel3Map["oversized_body_fail_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.OversizedBodySkipValidation != nil {
+					settingsLevel3Map["oversized_body_skip_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom != nil {
+					property_validation_settings_customLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom.Queryparameters != nil {
+						query_parametersLevel5Map := make(map[string]interface{})
+						if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom.Queryparameters.AllowAdditionalParameters != nil {
+							query_parametersLevel5Map["allow_additional_parameters"] = map[string]interface{}{}
+						}
+						if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom.Queryparameters.DisallowAdditionalParameters != nil {
+							query_parametersLevel5Map["disallow_additional_parameters"] = map[string]interface{}{}
+						}
+						property_validation_settings_customLevel4Map["queryParameters"] = query_parametersLevel5Map
+					}
+					settingsLevel3Map["property_validation_settings_custom"] = property_validation_settings_customLevel4Map
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsDefault != nil {
+					settingsLevel3Map["property_validation_settings_default"] = map[string]interface{}{}
+				}
+				validation_all_spec_endpointsNestedMap["settings"] = settingsLevel3Map
+			}
+			if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode != nil {
+				validation_modeLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive != nil {
+					response_validation_mode_activeLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.EnforcementBlock != nil {
+						response_validation_mode_activeLevel4Map["enforcement_block"] = map[string]interface{}{}
+					}
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.EnforcementReport != nil {
+						response_validation_mode_activeLevel4Map["enforcement_report"] = map[string]interface{}{}
+					}
+					if !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.ResponseValidationProperties.IsNull() && !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.ResponseValidationProperties.IsUnknown() {
+						var response_validation_propertiesLevel4Items []string
+						diags := data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.ResponseValidationProperties.ElementsAs(ctx, &response_validation_propertiesLevel4Items, false)
+						if !diags.HasError() {
+							response_validation_mode_activeLevel4Map["response_validation_properties"] = response_validation_propertiesLevel4Items
+						}
+					}
+					validation_modeLevel3Map["response_validation_mode_active"] = response_validation_mode_activeLevel4Map
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.SkipResponseValidation != nil {
+					validation_modeLevel3Map["skip_response_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.SkipValidation != nil {
+					validation_modeLevel3Map["skip_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive != nil {
+					validation_mode_activeLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.EnforcementBlock != nil {
+						validation_mode_activeLevel4Map["enforcement_block"] = map[string]interface{}{}
+					}
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.EnforcementReport != nil {
+						validation_mode_activeLevel4Map["enforcement_report"] = map[string]interface{}{}
+					}
+					if !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.RequestValidationProperties.IsNull() && !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.RequestValidationProperties.IsUnknown() {
+						var request_validation_propertiesLevel4Items []string
+						diags := data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.RequestValidationProperties.ElementsAs(ctx, &request_validation_propertiesLevel4Items, false)
+						if !diags.HasError() {
+							validation_mode_activeLevel4Map["request_validation_properties"] = request_validation_propertiesLevel4Items
+						}
+					}
+					validation_modeLevel3Map["validation_mode_active"] = validation_mode_activeLevel4Map
+				}
+				validation_all_spec_endpointsNestedMap["validation_mode"] = validation_modeLevel3Map
+			}
 			api_specificationMap["validation_all_spec_endpoints"] = validation_all_spec_endpointsNestedMap
 		}
 		if data.APISpecification.ValidationCustomList != nil {
 			validation_custom_listNestedMap := make(map[string]interface{})
+			if data.APISpecification.ValidationCustomList.FallThroughMode != nil {
+				fall_through_modeLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationCustomList.FallThroughMode.FallThroughModeAllow != nil {
+					fall_through_modeLevel3Map["fall_through_mode_allow"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationCustomList.FallThroughMode.FallThroughModeCustom != nil {
+					fall_through_mode_customLevel4Map := make(map[string]interface{})
+					fall_through_modeLevel3Map["fall_through_mode_custom"] = fall_through_mode_customLevel4Map
+				}
+				validation_custom_listNestedMap["fall_through_mode"] = fall_through_modeLevel3Map
+			}
+			if data.APISpecification.ValidationCustomList.Settings != nil {
+				settingsLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationCustomList.Settings.OversizedBodyFailValidation != nil {
+					settingsLevel3Map["oversized_body_fail_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationCustomList.Settings.OversizedBodySkipValidation != nil {
+					settingsLevel3Map["oversized_body_skip_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom != nil {
+					property_validation_settings_customLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom.Queryparameters != nil {
+						query_parametersLevel5Map := make(map[string]interface{})
+						if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom.Queryparameters.AllowAdditionalParameters != nil {
+							query_parametersLevel5Map["allow_additional_parameters"] = map[string]interface{}{}
+						}
+						if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom.Queryparameters.DisallowAdditionalParameters != nil {
+							query_parametersLevel5Map["disallow_additional_parameters"] = map[string]interface{}{}
+						}
+						property_validation_settings_customLevel4Map["queryParameters"] = query_parametersLevel5Map
+					}
+					settingsLevel3Map["property_validation_settings_custom"] = property_validation_settings_customLevel4Map
+				}
+				if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsDefault != nil {
+					settingsLevel3Map["property_validation_settings_default"] = map[string]interface{}{}
+				}
+				validation_custom_listNestedMap["settings"] = settingsLevel3Map
+			}
 			api_specificationMap["validation_custom_list"] = validation_custom_listNestedMap
 		}
 		if data.APISpecification.ValidationDisabled != nil {
@@ -11086,12 +13503,44 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		if data.BotDefense.Policy != nil {
 			policyNestedMap := make(map[string]interface{})
+			if data.BotDefense.Policy.DisableJsInsert != nil {
+				policyNestedMap["disable_js_insert"] = map[string]interface{}{}
+			}
+			if data.BotDefense.Policy.DisableMobileSdk != nil {
+				policyNestedMap["disable_mobile_sdk"] = map[string]interface{}{}
+			}
 			if !data.BotDefense.Policy.JavascriptMode.IsNull() && !data.BotDefense.Policy.JavascriptMode.IsUnknown() {
 				policyNestedMap["javascript_mode"] = data.BotDefense.Policy.JavascriptMode.ValueString()
 			}
 			if !data.BotDefense.Policy.JsDownloadPath.IsNull() && !data.BotDefense.Policy.JsDownloadPath.IsUnknown() {
 				policyNestedMap["js_download_path"] = data.BotDefense.Policy.JsDownloadPath.ValueString()
 			}
+			if data.BotDefense.Policy.JsInsertAllPages != nil {
+				js_insert_all_pagesLevel3Map := make(map[string]interface{})
+				if !data.BotDefense.Policy.JsInsertAllPages.JavascriptLocation.IsNull() && !data.BotDefense.Policy.JsInsertAllPages.JavascriptLocation.IsUnknown() {
+					js_insert_all_pagesLevel3Map["javascript_location"] = data.BotDefense.Policy.JsInsertAllPages.JavascriptLocation.ValueString()
+				}
+				policyNestedMap["js_insert_all_pages"] = js_insert_all_pagesLevel3Map
+			}
+			if data.BotDefense.Policy.JsInsertAllPagesExcept != nil {
+				js_insert_all_pages_exceptLevel3Map := make(map[string]interface{})
+				if !data.BotDefense.Policy.JsInsertAllPagesExcept.JavascriptLocation.IsNull() && !data.BotDefense.Policy.JsInsertAllPagesExcept.JavascriptLocation.IsUnknown() {
+					js_insert_all_pages_exceptLevel3Map["javascript_location"] = data.BotDefense.Policy.JsInsertAllPagesExcept.JavascriptLocation.ValueString()
+				}
+				policyNestedMap["js_insert_all_pages_except"] = js_insert_all_pages_exceptLevel3Map
+			}
+			if data.BotDefense.Policy.JsInsertionRules != nil {
+				js_insertion_rulesLevel3Map := make(map[string]interface{})
+				policyNestedMap["js_insertion_rules"] = js_insertion_rulesLevel3Map
+			}
+			if data.BotDefense.Policy.MobileSdkConfig != nil {
+				mobile_sdk_configLevel3Map := make(map[string]interface{})
+				if data.BotDefense.Policy.MobileSdkConfig.MobileIdentifier != nil {
+					mobile_identifierLevel4Map := make(map[string]interface{})
+					mobile_sdk_configLevel3Map["mobile_identifier"] = mobile_identifierLevel4Map
+				}
+				policyNestedMap["mobile_sdk_config"] = mobile_sdk_configLevel3Map
+			}
 			bot_defenseMap["policy"] = policyNestedMap
 		}
 		if !data.BotDefense.RegionalEndpoint.IsNull() && !data.BotDefense.RegionalEndpoint.IsUnknown() {
@@ -11116,6 +13565,20 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		client_side_defenseMap := make(map[string]interface{})
 		if data.ClientSideDefense.Policy != nil {
 			policyNestedMap := make(map[string]interface{})
+			if data.ClientSideDefense.Policy.DisableJsInsert != nil {
+				policyNestedMap["disable_js_insert"] = map[string]interface{}{}
+			}
+			if data.ClientSideDefense.Policy.JsInsertAllPages != nil {
+				policyNestedMap["js_insert_all_pages"] = map[string]interface{}{}
+			}
+			if data.ClientSideDefense.Policy.JsInsertAllPagesExcept != nil {
+				js_insert_all_pages_exceptLevel3Map := make(map[string]interface{})
+				policyNestedMap["js_insert_all_pages_except"] = js_insert_all_pages_exceptLevel3Map
+			}
+			if data.ClientSideDefense.Policy.JsInsertionRules != nil {
+				js_insertion_rulesLevel3Map := make(map[string]interface{})
+				policyNestedMap["js_insertion_rules"] = js_insertion_rulesLevel3Map
+			}
 			client_side_defenseMap["policy"] = policyNestedMap
 		}
 		createReq.Spec["client_side_defense"] = client_side_defenseMap
@@ -11396,6 +13859,13 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		enable_api_discoveryMap := make(map[string]interface{})
 		if data.EnableAPIDiscovery.APICrawler != nil {
 			api_crawlerNestedMap := make(map[string]interface{})
+			if data.EnableAPIDiscovery.APICrawler.APICrawlerConfig != nil {
+				api_crawler_configLevel3Map := make(map[string]interface{})
+				api_crawlerNestedMap["api_crawler_config"] = api_crawler_configLevel3Map
+			}
+			if data.EnableAPIDiscovery.APICrawler.DisableAPICrawler != nil {
+				api_crawlerNestedMap["disable_api_crawler"] = map[string]interface{}{}
+			}
 			enable_api_discoveryMap["api_crawler"] = api_crawlerNestedMap
 		}
 		if data.EnableAPIDiscovery.APIDiscoveryFromCodeScan != nil {
@@ -11404,6 +13874,19 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		if data.EnableAPIDiscovery.CustomAPIAuthDiscovery != nil {
 			custom_api_auth_discoveryNestedMap := make(map[string]interface{})
+			if data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef != nil {
+				api_discovery_refLevel3Map := make(map[string]interface{})
+				if !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.IsNull() && !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.IsUnknown() {
+					api_discovery_refLevel3Map["name"] = data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.ValueString()
+				}
+				if !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.IsNull() && !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.IsUnknown() {
+					api_discovery_refLevel3Map["namespace"] = data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.ValueString()
+				}
+				if !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.IsNull() && !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.IsUnknown() {
+					api_discovery_refLevel3Map["tenant"] = data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.ValueString()
+				}
+				custom_api_auth_discoveryNestedMap["api_discovery_ref"] = api_discovery_refLevel3Map
+			}
 			enable_api_discoveryMap["custom_api_auth_discovery"] = custom_api_auth_discoveryNestedMap
 		}
 		if data.EnableAPIDiscovery.DefaultAPIAuthDiscovery != nil {
@@ -11575,6 +14058,186 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		if data.HTTPS.TLSCertOptions != nil {
 			tls_cert_optionsNestedMap := make(map[string]interface{})
+			if data.HTTPS.TLSCertOptions.TLSCertParams != nil {
+				tls_cert_paramsLevel3Map := make(map[string]interface{})
+				if data.HTTPS.TLSCertOptions.TLSCertParams.NoMtls != nil {
+					tls_cert_paramsLevel3Map["no_mtls"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig != nil {
+					tls_configLevel4Map := make(map[string]interface{})
+					if data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity != nil {
+						custom_securityLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+							var cipher_suitesLevel5Items []string
+							diags := data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel5Items, false)
+							if !diags.HasError() {
+								custom_securityLevel5Map["cipher_suites"] = cipher_suitesLevel5Items
+							}
+						}
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+							custom_securityLevel5Map["max_version"] = data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+							custom_securityLevel5Map["min_version"] = data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.ValueString()
+						}
+						tls_configLevel4Map["custom_security"] = custom_securityLevel5Map
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.DefaultSecurity != nil {
+						tls_configLevel4Map["default_security"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.LowSecurity != nil {
+						tls_configLevel4Map["low_security"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.MediumSecurity != nil {
+						tls_configLevel4Map["medium_security"] = map[string]interface{}{}
+					}
+					tls_cert_paramsLevel3Map["tls_config"] = tls_configLevel4Map
+				}
+				if data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls != nil {
+					use_mtlsLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.ClientCertificateOptional.IsUnknown() {
+						use_mtlsLevel4Map["client_certificate_optional"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.ClientCertificateOptional.ValueBool()
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL != nil {
+						crlLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Name.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Name.IsUnknown() {
+							crlLevel5Map["name"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Name.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Namespace.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Namespace.IsUnknown() {
+							crlLevel5Map["namespace"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Namespace.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Tenant.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Tenant.IsUnknown() {
+							crlLevel5Map["tenant"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Tenant.ValueString()
+						}
+						use_mtlsLevel4Map["crl"] = crlLevel5Map
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.NoCRL != nil {
+						use_mtlsLevel4Map["no_crl"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA != nil {
+						trusted_caLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Name.IsUnknown() {
+							trusted_caLevel5Map["name"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Name.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Namespace.IsUnknown() {
+							trusted_caLevel5Map["namespace"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Namespace.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Tenant.IsUnknown() {
+							trusted_caLevel5Map["tenant"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Tenant.ValueString()
+						}
+						use_mtlsLevel4Map["trusted_ca"] = trusted_caLevel5Map
+					}
+					if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCAURL.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCAURL.IsUnknown() {
+						use_mtlsLevel4Map["trusted_ca_url"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCAURL.ValueString()
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.XfccDisabled != nil {
+						use_mtlsLevel4Map["xfcc_disabled"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.XfccOptions != nil {
+						xfcc_optionsLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+							var xfcc_header_elementsLevel5Items []string
+							diags := data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel5Items, false)
+							if !diags.HasError() {
+								xfcc_optionsLevel5Map["xfcc_header_elements"] = xfcc_header_elementsLevel5Items
+							}
+						}
+						use_mtlsLevel4Map["xfcc_options"] = xfcc_optionsLevel5Map
+					}
+					tls_cert_paramsLevel3Map["use_mtls"] = use_mtlsLevel4Map
+				}
+				tls_cert_optionsNestedMap["tls_cert_params"] = tls_cert_paramsLevel3Map
+			}
+			if data.HTTPS.TLSCertOptions.TLSInlineParams != nil {
+				tls_inline_paramsLevel3Map := make(map[string]interface{})
+				if data.HTTPS.TLSCertOptions.TLSInlineParams.NoMtls != nil {
+					tls_inline_paramsLevel3Map["no_mtls"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig != nil {
+					tls_configLevel4Map := make(map[string]interface{})
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity != nil {
+						custom_securityLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+							var cipher_suitesLevel5Items []string
+							diags := data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel5Items, false)
+							if !diags.HasError() {
+								custom_securityLevel5Map["cipher_suites"] = cipher_suitesLevel5Items
+							}
+						}
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+							custom_securityLevel5Map["max_version"] = data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+							custom_securityLevel5Map["min_version"] = data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.MinVersion.ValueString()
+						}
+						tls_configLevel4Map["custom_security"] = custom_securityLevel5Map
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.DefaultSecurity != nil {
+						tls_configLevel4Map["default_security"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.LowSecurity != nil {
+						tls_configLevel4Map["low_security"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.MediumSecurity != nil {
+						tls_configLevel4Map["medium_security"] = map[string]interface{}{}
+					}
+					tls_inline_paramsLevel3Map["tls_config"] = tls_configLevel4Map
+				}
+				if data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls != nil {
+					use_mtlsLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.ClientCertificateOptional.IsUnknown() {
+						use_mtlsLevel4Map["client_certificate_optional"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.ClientCertificateOptional.ValueBool()
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL != nil {
+						crlLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Name.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Name.IsUnknown() {
+							crlLevel5Map["name"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Name.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Namespace.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Namespace.IsUnknown() {
+							crlLevel5Map["namespace"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Namespace.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Tenant.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Tenant.IsUnknown() {
+							crlLevel5Map["tenant"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Tenant.ValueString()
+						}
+						use_mtlsLevel4Map["crl"] = crlLevel5Map
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.NoCRL != nil {
+						use_mtlsLevel4Map["no_crl"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA != nil {
+						trusted_caLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Name.IsUnknown() {
+							trusted_caLevel5Map["name"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Name.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Namespace.IsUnknown() {
+							trusted_caLevel5Map["namespace"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Namespace.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Tenant.IsUnknown() {
+							trusted_caLevel5Map["tenant"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Tenant.ValueString()
+						}
+						use_mtlsLevel4Map["trusted_ca"] = trusted_caLevel5Map
+					}
+					if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCAURL.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCAURL.IsUnknown() {
+						use_mtlsLevel4Map["trusted_ca_url"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCAURL.ValueString()
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.XfccDisabled != nil {
+						use_mtlsLevel4Map["xfcc_disabled"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.XfccOptions != nil {
+						xfcc_optionsLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+							var xfcc_header_elementsLevel5Items []string
+							diags := data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel5Items, false)
+							if !diags.HasError() {
+								xfcc_optionsLevel5Map["xfcc_header_elements"] = xfcc_header_elementsLevel5Items
+							}
+						}
+						use_mtlsLevel4Map["xfcc_options"] = xfcc_optionsLevel5Map
+					}
+					tls_inline_paramsLevel3Map["use_mtls"] = use_mtlsLevel4Map
+				}
+				tls_cert_optionsNestedMap["tls_inline_params"] = tls_inline_paramsLevel3Map
+			}
 			httpsMap["tls_cert_options"] = tls_cert_optionsNestedMap
 		}
 		createReq.Spec["https"] = httpsMap
@@ -11589,6 +14252,12 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		if data.HTTPSAutoCert.TLSConfig != nil {
 			tls_configNestedMap := make(map[string]interface{})
+			if data.HTTPSAutoCert.TLSConfig.TLS11Plus != nil {
+				tls_configNestedMap["tls_11_plus"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.TLSConfig.TLS12Plus != nil {
+				tls_configNestedMap["tls_12_plus"] = map[string]interface{}{}
+			}
 			https_auto_certMap["tls_config"] = tls_configNestedMap
 		}
 		createReq.Spec["https_auto_cert"] = https_auto_certMap
@@ -11610,6 +14279,12 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		jwt_validationMap := make(map[string]interface{})
 		if data.JWTValidation.Action != nil {
 			actionNestedMap := make(map[string]interface{})
+			if data.JWTValidation.Action.Block != nil {
+				actionNestedMap["block"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.Action.Report != nil {
+				actionNestedMap["report"] = map[string]interface{}{}
+			}
 			jwt_validationMap["action"] = actionNestedMap
 		}
 		if data.JWTValidation.JwksConfig != nil {
@@ -11625,17 +14300,68 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		if data.JWTValidation.ReservedClaims != nil {
 			reserved_claimsNestedMap := make(map[string]interface{})
+			if data.JWTValidation.ReservedClaims.Audience != nil {
+				audienceLevel3Map := make(map[string]interface{})
+				if !data.JWTValidation.ReservedClaims.Audience.Audiences.IsNull() && !data.JWTValidation.ReservedClaims.Audience.Audiences.IsUnknown() {
+					var audiencesLevel3Items []string
+					diags := data.JWTValidation.ReservedClaims.Audience.Audiences.ElementsAs(ctx, &audiencesLevel3Items, false)
+					if !diags.HasError() {
+						audienceLevel3Map["audiences"] = audiencesLevel3Items
+					}
+				}
+				reserved_claimsNestedMap["audience"] = audienceLevel3Map
+			}
+			if data.JWTValidation.ReservedClaims.AudienceDisable != nil {
+				reserved_claimsNestedMap["audience_disable"] = map[string]interface{}{}
+			}
 			if !data.JWTValidation.ReservedClaims.Issuer.IsNull() && !data.JWTValidation.ReservedClaims.Issuer.IsUnknown() {
 				reserved_claimsNestedMap["issuer"] = data.JWTValidation.ReservedClaims.Issuer.ValueString()
 			}
+			if data.JWTValidation.ReservedClaims.IssuerDisable != nil {
+				reserved_claimsNestedMap["issuer_disable"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.ReservedClaims.ValidatePeriodDisable != nil {
+				reserved_claimsNestedMap["validate_period_disable"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.ReservedClaims.ValidatePeriodEnable != nil {
+				reserved_claimsNestedMap["validate_period_enable"] = map[string]interface{}{}
+			}
 			jwt_validationMap["reserved_claims"] = reserved_claimsNestedMap
 		}
 		if data.JWTValidation.Target != nil {
 			targetNestedMap := make(map[string]interface{})
+			if data.JWTValidation.Target.AllEndpoint != nil {
+				targetNestedMap["all_endpoint"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.Target.APIGroups != nil {
+				api_groupsLevel3Map := make(map[string]interface{})
+				if !data.JWTValidation.Target.APIGroups.APIGroups.IsNull() && !data.JWTValidation.Target.APIGroups.APIGroups.IsUnknown() {
+					var api_groupsLevel3Items []string
+					diags := data.JWTValidation.Target.APIGroups.APIGroups.ElementsAs(ctx, &api_groupsLevel3Items, false)
+					if !diags.HasError() {
+						api_groupsLevel3Map["api_groups"] = api_groupsLevel3Items
+					}
+				}
+				targetNestedMap["api_groups"] = api_groupsLevel3Map
+			}
+			if data.JWTValidation.Target.BasePaths != nil {
+				base_pathsLevel3Map := make(map[string]interface{})
+				if !data.JWTValidation.Target.BasePaths.BasePaths.IsNull() && !data.JWTValidation.Target.BasePaths.BasePaths.IsUnknown() {
+					var base_pathsLevel3Items []string
+					diags := data.JWTValidation.Target.BasePaths.BasePaths.ElementsAs(ctx, &base_pathsLevel3Items, false)
+					if !diags.HasError() {
+						base_pathsLevel3Map["base_paths"] = base_pathsLevel3Items
+					}
+				}
+				targetNestedMap["base_paths"] = base_pathsLevel3Map
+			}
 			jwt_validationMap["target"] = targetNestedMap
 		}
 		if data.JWTValidation.TokenLocation != nil {
 			token_locationNestedMap := make(map[string]interface{})
+			if data.JWTValidation.TokenLocation.BearerToken != nil {
+				token_locationNestedMap["bearer_token"] = map[string]interface{}{}
+			}
 			jwt_validationMap["token_location"] = token_locationNestedMap
 		}
 		createReq.Spec["jwt_validation"] = jwt_validationMap
@@ -11727,12 +14453,100 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		if data.OriginPool.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.OriginPool.UseTLS.DefaultSessionKeyCaching != nil {
+				use_tlsNestedMap["default_session_key_caching"] = map[string]interface{}{}
+			}
+			if data.OriginPool.UseTLS.DisableSessionKeyCaching != nil {
+				use_tlsNestedMap["disable_session_key_caching"] = map[string]interface{}{}
+			}
+			if data.OriginPool.UseTLS.DisableSni != nil {
+				use_tlsNestedMap["disable_sni"] = map[string]interface{}{}
+			}
 			if !data.OriginPool.UseTLS.MaxSessionKeys.IsNull() && !data.OriginPool.UseTLS.MaxSessionKeys.IsUnknown() {
 				use_tlsNestedMap["max_session_keys"] = data.OriginPool.UseTLS.MaxSessionKeys.ValueInt64()
 			}
+			if data.OriginPool.UseTLS.NoMtls != nil {
+				use_tlsNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.OriginPool.UseTLS.SkipServerVerification != nil {
+				use_tlsNestedMap["skip_server_verification"] = map[string]interface{}{}
+			}
 			if !data.OriginPool.UseTLS.Sni.IsNull() && !data.OriginPool.UseTLS.Sni.IsUnknown() {
 				use_tlsNestedMap["sni"] = data.OriginPool.UseTLS.Sni.ValueString()
 			}
+			if data.OriginPool.UseTLS.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.OriginPool.UseTLS.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.OriginPool.UseTLS.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.OriginPool.UseTLS.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.OriginPool.UseTLS.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.OriginPool.UseTLS.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.OriginPool.UseTLS.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.OriginPool.UseTLS.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.OriginPool.UseTLS.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.OriginPool.UseTLS.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.OriginPool.UseTLS.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.OriginPool.UseTLS.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.OriginPool.UseTLS.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.OriginPool.UseTLS.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				use_tlsNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.OriginPool.UseTLS.UseHostHeaderAsSni != nil {
+				use_tlsNestedMap["use_host_header_as_sni"] = map[string]interface{}{}
+			}
+			if data.OriginPool.UseTLS.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				use_tlsNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
+			if data.OriginPool.UseTLS.UseMtlsObj != nil {
+				use_mtls_objLevel3Map := make(map[string]interface{})
+				if !data.OriginPool.UseTLS.UseMtlsObj.Name.IsNull() && !data.OriginPool.UseTLS.UseMtlsObj.Name.IsUnknown() {
+					use_mtls_objLevel3Map["name"] = data.OriginPool.UseTLS.UseMtlsObj.Name.ValueString()
+				}
+				if !data.OriginPool.UseTLS.UseMtlsObj.Namespace.IsNull() && !data.OriginPool.UseTLS.UseMtlsObj.Namespace.IsUnknown() {
+					use_mtls_objLevel3Map["namespace"] = data.OriginPool.UseTLS.UseMtlsObj.Namespace.ValueString()
+				}
+				if !data.OriginPool.UseTLS.UseMtlsObj.Tenant.IsNull() && !data.OriginPool.UseTLS.UseMtlsObj.Tenant.IsUnknown() {
+					use_mtls_objLevel3Map["tenant"] = data.OriginPool.UseTLS.UseMtlsObj.Tenant.ValueString()
+				}
+				use_tlsNestedMap["use_mtls_obj"] = use_mtls_objLevel3Map
+			}
+			if data.OriginPool.UseTLS.UseServerVerification != nil {
+				use_server_verificationLevel3Map := make(map[string]interface{})
+				if data.OriginPool.UseTLS.UseServerVerification.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Name.IsNull() && !data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Name.ValueString()
+					}
+					if !data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Namespace.IsNull() && !data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Namespace.ValueString()
+					}
+					if !data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Tenant.IsNull() && !data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Tenant.ValueString()
+					}
+					use_server_verificationLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.OriginPool.UseTLS.UseServerVerification.TrustedCAURL.IsNull() && !data.OriginPool.UseTLS.UseServerVerification.TrustedCAURL.IsUnknown() {
+					use_server_verificationLevel3Map["trusted_ca_url"] = data.OriginPool.UseTLS.UseServerVerification.TrustedCAURL.ValueString()
+				}
+				use_tlsNestedMap["use_server_verification"] = use_server_verificationLevel3Map
+			}
+			if data.OriginPool.UseTLS.VolterraTrustedCA != nil {
+				use_tlsNestedMap["volterra_trusted_ca"] = map[string]interface{}{}
+			}
 			origin_poolMap["use_tls"] = use_tlsNestedMap
 		}
 		createReq.Spec["origin_pool"] = origin_poolMap
@@ -11748,6 +14562,28 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		if data.OtherSettings.LoggingOptions != nil {
 			logging_optionsNestedMap := make(map[string]interface{})
+			if data.OtherSettings.LoggingOptions.ClientLogOptions != nil {
+				client_log_optionsLevel3Map := make(map[string]interface{})
+				if !data.OtherSettings.LoggingOptions.ClientLogOptions.HeaderList.IsNull() && !data.OtherSettings.LoggingOptions.ClientLogOptions.HeaderList.IsUnknown() {
+					var header_listLevel3Items []string
+					diags := data.OtherSettings.LoggingOptions.ClientLogOptions.HeaderList.ElementsAs(ctx, &header_listLevel3Items, false)
+					if !diags.HasError() {
+						client_log_optionsLevel3Map["header_list"] = header_listLevel3Items
+					}
+				}
+				logging_optionsNestedMap["client_log_options"] = client_log_optionsLevel3Map
+			}
+			if data.OtherSettings.LoggingOptions.OriginLogOptions != nil {
+				origin_log_optionsLevel3Map := make(map[string]interface{})
+				if !data.OtherSettings.LoggingOptions.OriginLogOptions.HeaderList.IsNull() && !data.OtherSettings.LoggingOptions.OriginLogOptions.HeaderList.IsUnknown() {
+					var header_listLevel3Items []string
+					diags := data.OtherSettings.LoggingOptions.OriginLogOptions.HeaderList.ElementsAs(ctx, &header_listLevel3Items, false)
+					if !diags.HasError() {
+						origin_log_optionsLevel3Map["header_list"] = header_listLevel3Items
+					}
+				}
+				logging_optionsNestedMap["origin_log_options"] = origin_log_optionsLevel3Map
+			}
 			other_settingsMap["logging_options"] = logging_optionsNestedMap
 		}
 		createReq.Spec["other_settings"] = other_settingsMap
@@ -11898,12 +14734,46 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		if data.RateLimit.RateLimiter != nil {
 			rate_limiterNestedMap := make(map[string]interface{})
+			if data.RateLimit.RateLimiter.ActionBlock != nil {
+				action_blockLevel3Map := make(map[string]interface{})
+				if data.RateLimit.RateLimiter.ActionBlock.Hours != nil {
+					hoursLevel4Map := make(map[string]interface{})
+					if !data.RateLimit.RateLimiter.ActionBlock.Hours.Duration.IsNull() && !data.RateLimit.RateLimiter.ActionBlock.Hours.Duration.IsUnknown() {
+						hoursLevel4Map["duration"] = data.RateLimit.RateLimiter.ActionBlock.Hours.Duration.ValueInt64()
+					}
+					action_blockLevel3Map["hours"] = hoursLevel4Map
+				}
+				if data.RateLimit.RateLimiter.ActionBlock.Minutes != nil {
+					minutesLevel4Map := make(map[string]interface{})
+					if !data.RateLimit.RateLimiter.ActionBlock.Minutes.Duration.IsNull() && !data.RateLimit.RateLimiter.ActionBlock.Minutes.Duration.IsUnknown() {
+						minutesLevel4Map["duration"] = data.RateLimit.RateLimiter.ActionBlock.Minutes.Duration.ValueInt64()
+					}
+					action_blockLevel3Map["minutes"] = minutesLevel4Map
+				}
+				if data.RateLimit.RateLimiter.ActionBlock.Seconds != nil {
+					secondsLevel4Map := make(map[string]interface{})
+					if !data.RateLimit.RateLimiter.ActionBlock.Seconds.Duration.IsNull() && !data.RateLimit.RateLimiter.ActionBlock.Seconds.Duration.IsUnknown() {
+						secondsLevel4Map["duration"] = data.RateLimit.RateLimiter.ActionBlock.Seconds.Duration.ValueInt64()
+					}
+					action_blockLevel3Map["seconds"] = secondsLevel4Map
+				}
+				rate_limiterNestedMap["action_block"] = action_blockLevel3Map
+			}
 			if !data.RateLimit.RateLimiter.BurstMultiplier.IsNull() && !data.RateLimit.RateLimiter.BurstMultiplier.IsUnknown() {
 				rate_limiterNestedMap["burst_multiplier"] = data.RateLimit.RateLimiter.BurstMultiplier.ValueInt64()
 			}
+			if data.RateLimit.RateLimiter.Disabled != nil {
+				rate_limiterNestedMap["disabled"] = map[string]interface{}{}
+			}
+			if data.RateLimit.RateLimiter.LeakyBucket != nil {
+				rate_limiterNestedMap["leaky_bucket"] = map[string]interface{}{}
+			}
 			if !data.RateLimit.RateLimiter.PeriodMultiplier.IsNull() && !data.RateLimit.RateLimiter.PeriodMultiplier.IsUnknown() {
 				rate_limiterNestedMap["period_multiplier"] = data.RateLimit.RateLimiter.PeriodMultiplier.ValueInt64()
 			}
+			if data.RateLimit.RateLimiter.TokenBucket != nil {
+				rate_limiterNestedMap["token_bucket"] = map[string]interface{}{}
+			}
 			if !data.RateLimit.RateLimiter.TotalNumber.IsNull() && !data.RateLimit.RateLimiter.TotalNumber.IsUnknown() {
 				rate_limiterNestedMap["total_number"] = data.RateLimit.RateLimiter.TotalNumber.ValueInt64()
 			}
@@ -12067,11 +14937,15 @@ func (r *CDNLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 
 	apiResource, err := r.client.CreateCDNLoadBalancer(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create CDNLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cdn_loadbalancer", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -14012,7 +16886,7 @@ func (r *CDNLoadBalancerResource) Read(ctx context.Context, req resource.ReadReq
 	apiResource, err := r.client.GetCDNLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "CDNLoadBalancer not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -14020,53 +16894,37 @@ func (r *CDNLoadBalancerResource) Read(ctx context.Context, req resource.ReadReq
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CDNLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cdn_loadbalancer", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
-	// Read description from metadata
-	if apiResource.Metadata.Description != "" {
-		data.Description = types.StringValue(apiResource.Metadata.Description)
-	} else {
-		data.Description = types.StringNull()
-	}
-
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
+	// Read description from metadata
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
-		data.Annotations = types.MapNull(types.StringType)
+		data.Description = types.StringNull()
 	}
 
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
+
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
 	isImport := false
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["active_service_policies"].(map[string]interface{}); ok && (isImport || data.ActiveServicePolicies != nil) {
 		data.ActiveServicePolicies = &CDNLoadBalancerActiveServicePoliciesModel{
@@ -15985,7 +18843,8 @@ func (r *CDNLoadBalancerResource) Read(ctx context.Context, req resource.ReadReq
 
 func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data CDNLoadBalancerResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -16019,6 +18878,7 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -16028,6 +18888,7 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.ActiveServicePolicies != nil {
@@ -16078,10 +18939,106 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 					if listItem.ClientMatcher.AnyIP != nil {
 						client_matcherDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.ClientMatcher.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.AsnList.AsNumbers.IsNull() && !listItem.ClientMatcher.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.ClientMatcher.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						client_matcherDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.ClientMatcher.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						client_matcherDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.ClientSelector.Expressions.IsNull() && !listItem.ClientMatcher.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.ClientMatcher.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						client_matcherDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.ClientMatcher.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsNull() && !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.ClientMatcher.IPMatcher.InvertMatcher.ValueBool()
+						}
+						client_matcherDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsNull() && !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.ClientMatcher.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsNull() && !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.ClientMatcher.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.ClientMatcher.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.ClientMatcher.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						client_matcherDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
 					listItemMap["client_matcher"] = client_matcherDeepMap
 				}
 				if listItem.InlineRateLimiter != nil {
 					inline_rate_limiterDeepMap := make(map[string]interface{})
+					if listItem.InlineRateLimiter.RefUserID != nil {
+						ref_user_idLevel4Map := make(map[string]interface{})
+						if !listItem.InlineRateLimiter.RefUserID.Name.IsNull() && !listItem.InlineRateLimiter.RefUserID.Name.IsUnknown() {
+							ref_user_idLevel4Map["name"] = listItem.InlineRateLimiter.RefUserID.Name.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Namespace.IsNull() && !listItem.InlineRateLimiter.RefUserID.Namespace.IsUnknown() {
+							ref_user_idLevel4Map["namespace"] = listItem.InlineRateLimiter.RefUserID.Namespace.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Tenant.IsNull() && !listItem.InlineRateLimiter.RefUserID.Tenant.IsUnknown() {
+							ref_user_idLevel4Map["tenant"] = listItem.InlineRateLimiter.RefUserID.Tenant.ValueString()
+						}
+						inline_rate_limiterDeepMap["ref_user_id"] = ref_user_idLevel4Map
+					}
 					if !listItem.InlineRateLimiter.Threshold.IsNull() && !listItem.InlineRateLimiter.Threshold.IsUnknown() {
 						inline_rate_limiterDeepMap["threshold"] = listItem.InlineRateLimiter.Threshold.ValueInt64()
 					}
@@ -16153,10 +19110,106 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 					if listItem.ClientMatcher.AnyIP != nil {
 						client_matcherDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.ClientMatcher.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.AsnList.AsNumbers.IsNull() && !listItem.ClientMatcher.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.ClientMatcher.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						client_matcherDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.ClientMatcher.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						client_matcherDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.ClientSelector.Expressions.IsNull() && !listItem.ClientMatcher.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.ClientMatcher.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						client_matcherDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.ClientMatcher.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsNull() && !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.ClientMatcher.IPMatcher.InvertMatcher.ValueBool()
+						}
+						client_matcherDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsNull() && !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.ClientMatcher.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsNull() && !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.ClientMatcher.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.ClientMatcher.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.ClientMatcher.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						client_matcherDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
 					listItemMap["client_matcher"] = client_matcherDeepMap
 				}
 				if listItem.InlineRateLimiter != nil {
 					inline_rate_limiterDeepMap := make(map[string]interface{})
+					if listItem.InlineRateLimiter.RefUserID != nil {
+						ref_user_idLevel4Map := make(map[string]interface{})
+						if !listItem.InlineRateLimiter.RefUserID.Name.IsNull() && !listItem.InlineRateLimiter.RefUserID.Name.IsUnknown() {
+							ref_user_idLevel4Map["name"] = listItem.InlineRateLimiter.RefUserID.Name.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Namespace.IsNull() && !listItem.InlineRateLimiter.RefUserID.Namespace.IsUnknown() {
+							ref_user_idLevel4Map["namespace"] = listItem.InlineRateLimiter.RefUserID.Namespace.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Tenant.IsNull() && !listItem.InlineRateLimiter.RefUserID.Tenant.IsUnknown() {
+							ref_user_idLevel4Map["tenant"] = listItem.InlineRateLimiter.RefUserID.Tenant.ValueString()
+						}
+						inline_rate_limiterDeepMap["ref_user_id"] = ref_user_idLevel4Map
+					}
 					if !listItem.InlineRateLimiter.Threshold.IsNull() && !listItem.InlineRateLimiter.Threshold.IsUnknown() {
 						inline_rate_limiterDeepMap["threshold"] = listItem.InlineRateLimiter.Threshold.ValueInt64()
 					}
@@ -16211,10 +19264,130 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		if data.APISpecification.ValidationAllSpecEndpoints != nil {
 			validation_all_spec_endpointsNestedMap := make(map[string]interface{})
+			if data.APISpecification.ValidationAllSpecEndpoints.FallThroughMode != nil {
+				fall_through_modeLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationAllSpecEndpoints.FallThroughMode.FallThroughModeAllow != nil {
+					fall_through_modeLevel3Map["fall_through_mode_allow"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.FallThroughMode.FallThroughModeCustom != nil {
+					fall_through_mode_customLevel4Map := make(map[string]interface{})
+					fall_through_modeLevel3Map["fall_through_mode_custom"] = fall_through_mode_customLevel4Map
+				}
+				validation_all_spec_endpointsNestedMap["fall_through_mode"] = fall_through_modeLevel3Map
+			}
+			if data.APISpecification.ValidationAllSpecEndpoints.Settings != nil {
+				settingsLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.OversizedBodyFailValidation != nil {
+					settingsLevel3Map["oversized_body_fail_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.OversizedBodySkipValidation != nil {
+					settingsLevel3Map["oversized_body_skip_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom != nil {
+					property_validation_settings_customLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom.Queryparameters != nil {
+						query_parametersLevel5Map := make(map[string]interface{})
+						if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom.Queryparameters.AllowAdditionalParameters != nil {
+							query_parametersLevel5Map["allow_additional_parameters"] = map[string]interface{}{}
+						}
+						if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom.Queryparameters.DisallowAdditionalParameters != nil {
+							query_parametersLevel5Map["disallow_additional_parameters"] = map[string]interface{}{}
+						}
+						property_validation_settings_customLevel4Map["queryParameters"] = query_parametersLevel5Map
+					}
+					settingsLevel3Map["property_validation_settings_custom"] = property_validation_settings_customLevel4Map
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsDefault != nil {
+					settingsLevel3Map["property_validation_settings_default"] = map[string]interface{}{}
+				}
+				validation_all_spec_endpointsNestedMap["settings"] = settingsLevel3Map
+			}
+			if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode != nil {
+				validation_modeLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive != nil {
+					response_validation_mode_activeLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.EnforcementBlock != nil {
+						response_validation_mode_activeLevel4Map["enforcement_block"] = map[string]interface{}{}
+					}
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.EnforcementReport != nil {
+						response_validation_mode_activeLevel4Map["enforcement_report"] = map[string]interface{}{}
+					}
+					if !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.ResponseValidationProperties.IsNull() && !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.ResponseValidationProperties.IsUnknown() {
+						var response_validation_propertiesLevel4Items []string
+						diags := data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.ResponseValidationProperties.ElementsAs(ctx, &response_validation_propertiesLevel4Items, false)
+						if !diags.HasError() {
+							response_validation_mode_activeLevel4Map["response_validation_properties"] = response_validation_propertiesLevel4Items
+						}
+					}
+					validation_modeLevel3Map["response_validation_mode_active"] = response_validation_mode_activeLevel4Map
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.SkipResponseValidation != nil {
+					validation_modeLevel3Map["skip_response_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.SkipValidation != nil {
+					validation_modeLevel3Map["skip_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive != nil {
+					validation_mode_activeLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.EnforcementBlock != nil {
+						validation_mode_activeLevel4Map["enforcement_block"] = map[string]interface{}{}
+					}
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.EnforcementReport != nil {
+						validation_mode_activeLevel4Map["enforcement_report"] = map[string]interface{}{}
+					}
+					if !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.RequestValidationProperties.IsNull() && !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.RequestValidationProperties.IsUnknown() {
+						var request_validation_propertiesLevel4Items []string
+						diags := data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.RequestValidationProperties.ElementsAs(ctx, &request_validation_propertiesLevel4Items, false)
+						if !diags.HasError() {
+							validation_mode_activeLevel4Map["request_validation_properties"] = request_validation_propertiesLevel4Items
+						}
+					}
+					validation_modeLevel3Map["validation_mode_active"] = validation_mode_activeLevel4Map
+				}
+				validation_all_spec_endpointsNestedMap["validation_mode"] = validation_modeLevel3Map
+			}
 			api_specificationMap["validation_all_spec_endpoints"] = validation_all_spec_endpointsNestedMap
 		}
 		if data.APISpecification.ValidationCustomList != nil {
 			validation_custom_listNestedMap := make(map[string]interface{})
+			if data.APISpecification.ValidationCustomList.FallThroughMode != nil {
+				fall_through_modeLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationCustomList.FallThroughMode.FallThroughModeAllow != nil {
+					fall_through_modeLevel3Map["fall_through_mode_allow"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationCustomList.FallThroughMode.FallThroughModeCustom != nil {
+					fall_through_mode_customLevel4Map := make(map[string]interface{})
+					fall_through_modeLevel3Map["fall_through_mode_custom"] = fall_through_mode_customLevel4Map
+				}
+				validation_custom_listNestedMap["fall_through_mode"] = fall_through_modeLevel3Map
+			}
+			if data.APISpecification.ValidationCustomList.Settings != nil {
+				settingsLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationCustomList.Settings.OversizedBodyFailValidation != nil {
+					settingsLevel3Map["oversized_body_fail_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationCustomList.Settings.OversizedBodySkipValidation != nil {
+					settingsLevel3Map["oversized_body_skip_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom != nil {
+					property_validation_settings_customLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom.Queryparameters != nil {
+						query_parametersLevel5Map := make(map[string]interface{})
+						if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom.Queryparameters.AllowAdditionalParameters != nil {
+							query_parametersLevel5Map["allow_additional_parameters"] = map[string]interface{}{}
+						}
+						if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom.Queryparameters.DisallowAdditionalParameters != nil {
+							query_parametersLevel5Map["disallow_additional_parameters"] = map[string]interface{}{}
+						}
+						property_validation_settings_customLevel4Map["queryParameters"] = query_parametersLevel5Map
+					}
+					settingsLevel3Map["property_validation_settings_custom"] = property_validation_settings_customLevel4Map
+				}
+				if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsDefault != nil {
+					settingsLevel3Map["property_validation_settings_default"] = map[string]interface{}{}
+				}
+				validation_custom_listNestedMap["settings"] = settingsLevel3Map
+			}
 			api_specificationMap["validation_custom_list"] = validation_custom_listNestedMap
 		}
 		if data.APISpecification.ValidationDisabled != nil {
@@ -16319,12 +19492,44 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		if data.BotDefense.Policy != nil {
 			policyNestedMap := make(map[string]interface{})
+			if data.BotDefense.Policy.DisableJsInsert != nil {
+				policyNestedMap["disable_js_insert"] = map[string]interface{}{}
+			}
+			if data.BotDefense.Policy.DisableMobileSdk != nil {
+				policyNestedMap["disable_mobile_sdk"] = map[string]interface{}{}
+			}
 			if !data.BotDefense.Policy.JavascriptMode.IsNull() && !data.BotDefense.Policy.JavascriptMode.IsUnknown() {
 				policyNestedMap["javascript_mode"] = data.BotDefense.Policy.JavascriptMode.ValueString()
 			}
 			if !data.BotDefense.Policy.JsDownloadPath.IsNull() && !data.BotDefense.Policy.JsDownloadPath.IsUnknown() {
 				policyNestedMap["js_download_path"] = data.BotDefense.Policy.JsDownloadPath.ValueString()
 			}
+			if data.BotDefense.Policy.JsInsertAllPages != nil {
+				js_insert_all_pagesLevel3Map := make(map[string]interface{})
+				if !data.BotDefense.Policy.JsInsertAllPages.JavascriptLocation.IsNull() && !data.BotDefense.Policy.JsInsertAllPages.JavascriptLocation.IsUnknown() {
+					js_insert_all_pagesLevel3Map["javascript_location"] = data.BotDefense.Policy.JsInsertAllPages.JavascriptLocation.ValueString()
+				}
+				policyNestedMap["js_insert_all_pages"] = js_insert_all_pagesLevel3Map
+			}
+			if data.BotDefense.Policy.JsInsertAllPagesExcept != nil {
+				js_insert_all_pages_exceptLevel3Map := make(map[string]interface{})
+				if !data.BotDefense.Policy.JsInsertAllPagesExcept.JavascriptLocation.IsNull() && !data.BotDefense.Policy.JsInsertAllPagesExcept.JavascriptLocation.IsUnknown() {
+					js_insert_all_pages_exceptLevel3Map["javascript_location"] = data.BotDefense.Policy.JsInsertAllPagesExcept.JavascriptLocation.ValueString()
+				}
+				policyNestedMap["js_insert_all_pages_except"] = js_insert_all_pages_exceptLevel3Map
+			}
+			if data.BotDefense.Policy.JsInsertionRules != nil {
+				js_insertion_rulesLevel3Map := make(map[string]interface{})
+				policyNestedMap["js_insertion_rules"] = js_insertion_rulesLevel3Map
+			}
+			if data.BotDefense.Policy.MobileSdkConfig != nil {
+				mobile_sdk_configLevel3Map := make(map[string]interface{})
+				if data.BotDefense.Policy.MobileSdkConfig.MobileIdentifier != nil {
+					mobile_identifierLevel4Map := make(map[string]interface{})
+					mobile_sdk_configLevel3Map["mobile_identifier"] = mobile_identifierLevel4Map
+				}
+				policyNestedMap["mobile_sdk_config"] = mobile_sdk_configLevel3Map
+			}
 			bot_defenseMap["policy"] = policyNestedMap
 		}
 		if !data.BotDefense.RegionalEndpoint.IsNull() && !data.BotDefense.RegionalEndpoint.IsUnknown() {
@@ -16349,6 +19554,20 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		client_side_defenseMap := make(map[string]interface{})
 		if data.ClientSideDefense.Policy != nil {
 			policyNestedMap := make(map[string]interface{})
+			if data.ClientSideDefense.Policy.DisableJsInsert != nil {
+				policyNestedMap["disable_js_insert"] = map[string]interface{}{}
+			}
+			if data.ClientSideDefense.Policy.JsInsertAllPages != nil {
+				policyNestedMap["js_insert_all_pages"] = map[string]interface{}{}
+			}
+			if data.ClientSideDefense.Policy.JsInsertAllPagesExcept != nil {
+				js_insert_all_pages_exceptLevel3Map := make(map[string]interface{})
+				policyNestedMap["js_insert_all_pages_except"] = js_insert_all_pages_exceptLevel3Map
+			}
+			if data.ClientSideDefense.Policy.JsInsertionRules != nil {
+				js_insertion_rulesLevel3Map := make(map[string]interface{})
+				policyNestedMap["js_insertion_rules"] = js_insertion_rulesLevel3Map
+			}
 			client_side_defenseMap["policy"] = policyNestedMap
 		}
 		apiResource.Spec["client_side_defense"] = client_side_defenseMap
@@ -16629,6 +19848,13 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		enable_api_discoveryMap := make(map[string]interface{})
 		if data.EnableAPIDiscovery.APICrawler != nil {
 			api_crawlerNestedMap := make(map[string]interface{})
+			if data.EnableAPIDiscovery.APICrawler.APICrawlerConfig != nil {
+				api_crawler_configLevel3Map := make(map[string]interface{})
+				api_crawlerNestedMap["api_crawler_config"] = api_crawler_configLevel3Map
+			}
+			if data.EnableAPIDiscovery.APICrawler.DisableAPICrawler != nil {
+				api_crawlerNestedMap["disable_api_crawler"] = map[string]interface{}{}
+			}
 			enable_api_discoveryMap["api_crawler"] = api_crawlerNestedMap
 		}
 		if data.EnableAPIDiscovery.APIDiscoveryFromCodeScan != nil {
@@ -16637,6 +19863,19 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		if data.EnableAPIDiscovery.CustomAPIAuthDiscovery != nil {
 			custom_api_auth_discoveryNestedMap := make(map[string]interface{})
+			if data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef != nil {
+				api_discovery_refLevel3Map := make(map[string]interface{})
+				if !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.IsNull() && !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.IsUnknown() {
+					api_discovery_refLevel3Map["name"] = data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.ValueString()
+				}
+				if !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.IsNull() && !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.IsUnknown() {
+					api_discovery_refLevel3Map["namespace"] = data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.ValueString()
+				}
+				if !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.IsNull() && !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.IsUnknown() {
+					api_discovery_refLevel3Map["tenant"] = data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.ValueString()
+				}
+				custom_api_auth_discoveryNestedMap["api_discovery_ref"] = api_discovery_refLevel3Map
+			}
 			enable_api_discoveryMap["custom_api_auth_discovery"] = custom_api_auth_discoveryNestedMap
 		}
 		if data.EnableAPIDiscovery.DefaultAPIAuthDiscovery != nil {
@@ -16808,6 +20047,186 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		if data.HTTPS.TLSCertOptions != nil {
 			tls_cert_optionsNestedMap := make(map[string]interface{})
+			if data.HTTPS.TLSCertOptions.TLSCertParams != nil {
+				tls_cert_paramsLevel3Map := make(map[string]interface{})
+				if data.HTTPS.TLSCertOptions.TLSCertParams.NoMtls != nil {
+					tls_cert_paramsLevel3Map["no_mtls"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig != nil {
+					tls_configLevel4Map := make(map[string]interface{})
+					if data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity != nil {
+						custom_securityLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+							var cipher_suitesLevel5Items []string
+							diags := data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel5Items, false)
+							if !diags.HasError() {
+								custom_securityLevel5Map["cipher_suites"] = cipher_suitesLevel5Items
+							}
+						}
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+							custom_securityLevel5Map["max_version"] = data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+							custom_securityLevel5Map["min_version"] = data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.ValueString()
+						}
+						tls_configLevel4Map["custom_security"] = custom_securityLevel5Map
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.DefaultSecurity != nil {
+						tls_configLevel4Map["default_security"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.LowSecurity != nil {
+						tls_configLevel4Map["low_security"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.TLSConfig.MediumSecurity != nil {
+						tls_configLevel4Map["medium_security"] = map[string]interface{}{}
+					}
+					tls_cert_paramsLevel3Map["tls_config"] = tls_configLevel4Map
+				}
+				if data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls != nil {
+					use_mtlsLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.ClientCertificateOptional.IsUnknown() {
+						use_mtlsLevel4Map["client_certificate_optional"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.ClientCertificateOptional.ValueBool()
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL != nil {
+						crlLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Name.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Name.IsUnknown() {
+							crlLevel5Map["name"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Name.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Namespace.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Namespace.IsUnknown() {
+							crlLevel5Map["namespace"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Namespace.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Tenant.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Tenant.IsUnknown() {
+							crlLevel5Map["tenant"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.CRL.Tenant.ValueString()
+						}
+						use_mtlsLevel4Map["crl"] = crlLevel5Map
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.NoCRL != nil {
+						use_mtlsLevel4Map["no_crl"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA != nil {
+						trusted_caLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Name.IsUnknown() {
+							trusted_caLevel5Map["name"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Name.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Namespace.IsUnknown() {
+							trusted_caLevel5Map["namespace"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Namespace.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Tenant.IsUnknown() {
+							trusted_caLevel5Map["tenant"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCA.Tenant.ValueString()
+						}
+						use_mtlsLevel4Map["trusted_ca"] = trusted_caLevel5Map
+					}
+					if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCAURL.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCAURL.IsUnknown() {
+						use_mtlsLevel4Map["trusted_ca_url"] = data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.TrustedCAURL.ValueString()
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.XfccDisabled != nil {
+						use_mtlsLevel4Map["xfcc_disabled"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.XfccOptions != nil {
+						xfcc_optionsLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+							var xfcc_header_elementsLevel5Items []string
+							diags := data.HTTPS.TLSCertOptions.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel5Items, false)
+							if !diags.HasError() {
+								xfcc_optionsLevel5Map["xfcc_header_elements"] = xfcc_header_elementsLevel5Items
+							}
+						}
+						use_mtlsLevel4Map["xfcc_options"] = xfcc_optionsLevel5Map
+					}
+					tls_cert_paramsLevel3Map["use_mtls"] = use_mtlsLevel4Map
+				}
+				tls_cert_optionsNestedMap["tls_cert_params"] = tls_cert_paramsLevel3Map
+			}
+			if data.HTTPS.TLSCertOptions.TLSInlineParams != nil {
+				tls_inline_paramsLevel3Map := make(map[string]interface{})
+				if data.HTTPS.TLSCertOptions.TLSInlineParams.NoMtls != nil {
+					tls_inline_paramsLevel3Map["no_mtls"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig != nil {
+					tls_configLevel4Map := make(map[string]interface{})
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity != nil {
+						custom_securityLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+							var cipher_suitesLevel5Items []string
+							diags := data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel5Items, false)
+							if !diags.HasError() {
+								custom_securityLevel5Map["cipher_suites"] = cipher_suitesLevel5Items
+							}
+						}
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+							custom_securityLevel5Map["max_version"] = data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+							custom_securityLevel5Map["min_version"] = data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.CustomSecurity.MinVersion.ValueString()
+						}
+						tls_configLevel4Map["custom_security"] = custom_securityLevel5Map
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.DefaultSecurity != nil {
+						tls_configLevel4Map["default_security"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.LowSecurity != nil {
+						tls_configLevel4Map["low_security"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.TLSConfig.MediumSecurity != nil {
+						tls_configLevel4Map["medium_security"] = map[string]interface{}{}
+					}
+					tls_inline_paramsLevel3Map["tls_config"] = tls_configLevel4Map
+				}
+				if data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls != nil {
+					use_mtlsLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.ClientCertificateOptional.IsUnknown() {
+						use_mtlsLevel4Map["client_certificate_optional"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.ClientCertificateOptional.ValueBool()
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL != nil {
+						crlLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Name.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Name.IsUnknown() {
+							crlLevel5Map["name"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Name.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Namespace.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Namespace.IsUnknown() {
+							crlLevel5Map["namespace"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Namespace.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Tenant.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Tenant.IsUnknown() {
+							crlLevel5Map["tenant"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.CRL.Tenant.ValueString()
+						}
+						use_mtlsLevel4Map["crl"] = crlLevel5Map
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.NoCRL != nil {
+						use_mtlsLevel4Map["no_crl"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA != nil {
+						trusted_caLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Name.IsUnknown() {
+							trusted_caLevel5Map["name"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Name.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Namespace.IsUnknown() {
+							trusted_caLevel5Map["namespace"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Namespace.ValueString()
+						}
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Tenant.IsUnknown() {
+							trusted_caLevel5Map["tenant"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCA.Tenant.ValueString()
+						}
+						use_mtlsLevel4Map["trusted_ca"] = trusted_caLevel5Map
+					}
+					if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCAURL.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCAURL.IsUnknown() {
+						use_mtlsLevel4Map["trusted_ca_url"] = data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.TrustedCAURL.ValueString()
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.XfccDisabled != nil {
+						use_mtlsLevel4Map["xfcc_disabled"] = map[string]interface{}{}
+					}
+					if data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.XfccOptions != nil {
+						xfcc_optionsLevel5Map := make(map[string]interface{})
+						if !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+							var xfcc_header_elementsLevel5Items []string
+							diags := data.HTTPS.TLSCertOptions.TLSInlineParams.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel5Items, false)
+							if !diags.HasError() {
+								xfcc_optionsLevel5Map["xfcc_header_elements"] = xfcc_header_elementsLevel5Items
+							}
+						}
+						use_mtlsLevel4Map["xfcc_options"] = xfcc_optionsLevel5Map
+					}
+					tls_inline_paramsLevel3Map["use_mtls"] = use_mtlsLevel4Map
+				}
+				tls_cert_optionsNestedMap["tls_inline_params"] = tls_inline_paramsLevel3Map
+			}
 			httpsMap["tls_cert_options"] = tls_cert_optionsNestedMap
 		}
 		apiResource.Spec["https"] = httpsMap
@@ -16822,6 +20241,12 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		if data.HTTPSAutoCert.TLSConfig != nil {
 			tls_configNestedMap := make(map[string]interface{})
+			if data.HTTPSAutoCert.TLSConfig.TLS11Plus != nil {
+				tls_configNestedMap["tls_11_plus"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.TLSConfig.TLS12Plus != nil {
+				tls_configNestedMap["tls_12_plus"] = map[string]interface{}{}
+			}
 			https_auto_certMap["tls_config"] = tls_configNestedMap
 		}
 		apiResource.Spec["https_auto_cert"] = https_auto_certMap
@@ -16843,6 +20268,12 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		jwt_validationMap := make(map[string]interface{})
 		if data.JWTValidation.Action != nil {
 			actionNestedMap := make(map[string]interface{})
+			if data.JWTValidation.Action.Block != nil {
+				actionNestedMap["block"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.Action.Report != nil {
+				actionNestedMap["report"] = map[string]interface{}{}
+			}
 			jwt_validationMap["action"] = actionNestedMap
 		}
 		if data.JWTValidation.JwksConfig != nil {
@@ -16858,17 +20289,68 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		if data.JWTValidation.ReservedClaims != nil {
 			reserved_claimsNestedMap := make(map[string]interface{})
+			if data.JWTValidation.ReservedClaims.Audience != nil {
+				audienceLevel3Map := make(map[string]interface{})
+				if !data.JWTValidation.ReservedClaims.Audience.Audiences.IsNull() && !data.JWTValidation.ReservedClaims.Audience.Audiences.IsUnknown() {
+					var audiencesLevel3Items []string
+					diags := data.JWTValidation.ReservedClaims.Audience.Audiences.ElementsAs(ctx, &audiencesLevel3Items, false)
+					if !diags.HasError() {
+						audienceLevel3Map["audiences"] = audiencesLevel3Items
+					}
+				}
+				reserved_claimsNestedMap["audience"] = audienceLevel3Map
+			}
+			if data.JWTValidation.ReservedClaims.AudienceDisable != nil {
+				reserved_claimsNestedMap["audience_disable"] = map[string]interface{}{}
+			}
 			if !data.JWTValidation.ReservedClaims.Issuer.IsNull() && !data.JWTValidation.ReservedClaims.Issuer.IsUnknown() {
 				reserved_claimsNestedMap["issuer"] = data.JWTValidation.ReservedClaims.Issuer.ValueString()
 			}
+			if data.JWTValidation.ReservedClaims.IssuerDisable != nil {
+				reserved_claimsNestedMap["issuer_disable"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.ReservedClaims.ValidatePeriodDisable != nil {
+				reserved_claimsNestedMap["validate_period_disable"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.ReservedClaims.ValidatePeriodEnable != nil {
+				reserved_claimsNestedMap["validate_period_enable"] = map[string]interface{}{}
+			}
 			jwt_validationMap["reserved_claims"] = reserved_claimsNestedMap
 		}
 		if data.JWTValidation.Target != nil {
 			targetNestedMap := make(map[string]interface{})
+			if data.JWTValidation.Target.AllEndpoint != nil {
+				targetNestedMap["all_endpoint"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.Target.APIGroups != nil {
+				api_groupsLevel3Map := make(map[string]interface{})
+				if !data.JWTValidation.Target.APIGroups.APIGroups.IsNull() && !data.JWTValidation.Target.APIGroups.APIGroups.IsUnknown() {
+					var api_groupsLevel3Items []string
+					diags := data.JWTValidation.Target.APIGroups.APIGroups.ElementsAs(ctx, &api_groupsLevel3Items, false)
+					if !diags.HasError() {
+						api_groupsLevel3Map["api_groups"] = api_groupsLevel3Items
+					}
+				}
+				targetNestedMap["api_groups"] = api_groupsLevel3Map
+			}
+			if data.JWTValidation.Target.BasePaths != nil {
+				base_pathsLevel3Map := make(map[string]interface{})
+				if !data.JWTValidation.Target.BasePaths.BasePaths.IsNull() && !data.JWTValidation.Target.BasePaths.BasePaths.IsUnknown() {
+					var base_pathsLevel3Items []string
+					diags := data.JWTValidation.Target.BasePaths.BasePaths.ElementsAs(ctx, &base_pathsLevel3Items, false)
+					if !diags.HasError() {
+						base_pathsLevel3Map["base_paths"] = base_pathsLevel3Items
+					}
+				}
+				targetNestedMap["base_paths"] = base_pathsLevel3Map
+			}
 			jwt_validationMap["target"] = targetNestedMap
 		}
 		if data.JWTValidation.TokenLocation != nil {
 			token_locationNestedMap := make(map[string]interface{})
+			if data.JWTValidation.TokenLocation.BearerToken != nil {
+				token_locationNestedMap["bearer_token"] = map[string]interface{}{}
+			}
 			jwt_validationMap["token_location"] = token_locationNestedMap
 		}
 		apiResource.Spec["jwt_validation"] = jwt_validationMap
@@ -16960,12 +20442,100 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		if data.OriginPool.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.OriginPool.UseTLS.DefaultSessionKeyCaching != nil {
+				use_tlsNestedMap["default_session_key_caching"] = map[string]interface{}{}
+			}
+			if data.OriginPool.UseTLS.DisableSessionKeyCaching != nil {
+				use_tlsNestedMap["disable_session_key_caching"] = map[string]interface{}{}
+			}
+			if data.OriginPool.UseTLS.DisableSni != nil {
+				use_tlsNestedMap["disable_sni"] = map[string]interface{}{}
+			}
 			if !data.OriginPool.UseTLS.MaxSessionKeys.IsNull() && !data.OriginPool.UseTLS.MaxSessionKeys.IsUnknown() {
 				use_tlsNestedMap["max_session_keys"] = data.OriginPool.UseTLS.MaxSessionKeys.ValueInt64()
 			}
+			if data.OriginPool.UseTLS.NoMtls != nil {
+				use_tlsNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.OriginPool.UseTLS.SkipServerVerification != nil {
+				use_tlsNestedMap["skip_server_verification"] = map[string]interface{}{}
+			}
 			if !data.OriginPool.UseTLS.Sni.IsNull() && !data.OriginPool.UseTLS.Sni.IsUnknown() {
 				use_tlsNestedMap["sni"] = data.OriginPool.UseTLS.Sni.ValueString()
 			}
+			if data.OriginPool.UseTLS.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.OriginPool.UseTLS.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.OriginPool.UseTLS.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.OriginPool.UseTLS.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.OriginPool.UseTLS.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.OriginPool.UseTLS.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.OriginPool.UseTLS.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.OriginPool.UseTLS.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.OriginPool.UseTLS.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.OriginPool.UseTLS.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.OriginPool.UseTLS.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.OriginPool.UseTLS.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.OriginPool.UseTLS.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.OriginPool.UseTLS.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				use_tlsNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.OriginPool.UseTLS.UseHostHeaderAsSni != nil {
+				use_tlsNestedMap["use_host_header_as_sni"] = map[string]interface{}{}
+			}
+			if data.OriginPool.UseTLS.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				use_tlsNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
+			if data.OriginPool.UseTLS.UseMtlsObj != nil {
+				use_mtls_objLevel3Map := make(map[string]interface{})
+				if !data.OriginPool.UseTLS.UseMtlsObj.Name.IsNull() && !data.OriginPool.UseTLS.UseMtlsObj.Name.IsUnknown() {
+					use_mtls_objLevel3Map["name"] = data.OriginPool.UseTLS.UseMtlsObj.Name.ValueString()
+				}
+				if !data.OriginPool.UseTLS.UseMtlsObj.Namespace.IsNull() && !data.OriginPool.UseTLS.UseMtlsObj.Namespace.IsUnknown() {
+					use_mtls_objLevel3Map["namespace"] = data.OriginPool.UseTLS.UseMtlsObj.Namespace.ValueString()
+				}
+				if !data.OriginPool.UseTLS.UseMtlsObj.Tenant.IsNull() && !data.OriginPool.UseTLS.UseMtlsObj.Tenant.IsUnknown() {
+					use_mtls_objLevel3Map["tenant"] = data.OriginPool.UseTLS.UseMtlsObj.Tenant.ValueString()
+				}
+				use_tlsNestedMap["use_mtls_obj"] = use_mtls_objLevel3Map
+			}
+			if data.OriginPool.UseTLS.UseServerVerification != nil {
+				use_server_verificationLevel3Map := make(map[string]interface{})
+				if data.OriginPool.UseTLS.UseServerVerification.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Name.IsNull() && !data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Name.ValueString()
+					}
+					if !data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Namespace.IsNull() && !data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Namespace.ValueString()
+					}
+					if !data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Tenant.IsNull() && !data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.OriginPool.UseTLS.UseServerVerification.TrustedCA.Tenant.ValueString()
+					}
+					use_server_verificationLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.OriginPool.UseTLS.UseServerVerification.TrustedCAURL.IsNull() && !data.OriginPool.UseTLS.UseServerVerification.TrustedCAURL.IsUnknown() {
+					use_server_verificationLevel3Map["trusted_ca_url"] = data.OriginPool.UseTLS.UseServerVerification.TrustedCAURL.ValueString()
+				}
+				use_tlsNestedMap["use_server_verification"] = use_server_verificationLevel3Map
+			}
+			if data.OriginPool.UseTLS.VolterraTrustedCA != nil {
+				use_tlsNestedMap["volterra_trusted_ca"] = map[string]interface{}{}
+			}
 			origin_poolMap["use_tls"] = use_tlsNestedMap
 		}
 		apiResource.Spec["origin_pool"] = origin_poolMap
@@ -16981,6 +20551,28 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		if data.OtherSettings.LoggingOptions != nil {
 			logging_optionsNestedMap := make(map[string]interface{})
+			if data.OtherSettings.LoggingOptions.ClientLogOptions != nil {
+				client_log_optionsLevel3Map := make(map[string]interface{})
+				if !data.OtherSettings.LoggingOptions.ClientLogOptions.HeaderList.IsNull() && !data.OtherSettings.LoggingOptions.ClientLogOptions.HeaderList.IsUnknown() {
+					var header_listLevel3Items []string
+					diags := data.OtherSettings.LoggingOptions.ClientLogOptions.HeaderList.ElementsAs(ctx, &header_listLevel3Items, false)
+					if !diags.HasError() {
+						client_log_optionsLevel3Map["header_list"] = header_listLevel3Items
+					}
+				}
+				logging_optionsNestedMap["client_log_options"] = client_log_optionsLevel3Map
+			}
+			if data.OtherSettings.LoggingOptions.OriginLogOptions != nil {
+				origin_log_optionsLevel3Map := make(map[string]interface{})
+				if !data.OtherSettings.LoggingOptions.OriginLogOptions.HeaderList.IsNull() && !data.OtherSettings.LoggingOptions.OriginLogOptions.HeaderList.IsUnknown() {
+					var header_listLevel3Items []string
+					diags := data.OtherSettings.LoggingOptions.OriginLogOptions.HeaderList.ElementsAs(ctx, &header_listLevel3Items, false)
+					if !diags.HasError() {
+						origin_log_optionsLevel3Map["header_list"] = header_listLevel3Items
+					}
+				}
+				logging_optionsNestedMap["origin_log_options"] = origin_log_optionsLevel3Map
+			}
 			other_settingsMap["logging_options"] = logging_optionsNestedMap
 		}
 		apiResource.Spec["other_settings"] = other_settingsMap
@@ -17131,12 +20723,46 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		if data.RateLimit.RateLimiter != nil {
 			rate_limiterNestedMap := make(map[string]interface{})
+			if data.RateLimit.RateLimiter.ActionBlock != nil {
+				action_blockLevel3Map := make(map[string]interface{})
+				if data.RateLimit.RateLimiter.ActionBlock.Hours != nil {
+					hoursLevel4Map := make(map[string]interface{})
+					if !data.RateLimit.RateLimiter.ActionBlock.Hours.Duration.IsNull() && !data.RateLimit.RateLimiter.ActionBlock.Hours.Duration.IsUnknown() {
+						hoursLevel4Map["duration"] = data.RateLimit.RateLimiter.ActionBlock.Hours.Duration.ValueInt64()
+					}
+					action_blockLevel3Map["hours"] = hoursLevel4Map
+				}
+				if data.RateLimit.RateLimiter.ActionBlock.Minutes != nil {
+					minutesLevel4Map := make(map[string]interface{})
+					if !data.RateLimit.RateLimiter.ActionBlock.Minutes.Duration.IsNull() && !data.RateLimit.RateLimiter.ActionBlock.Minutes.Duration.IsUnknown() {
+						minutesLevel4Map["duration"] = data.RateLimit.RateLimiter.ActionBlock.Minutes.Duration.ValueInt64()
+					}
+					action_blockLevel3Map["minutes"] = minutesLevel4Map
+				}
+				if data.RateLimit.RateLimiter.ActionBlock.Seconds != nil {
+					secondsLevel4Map := make(map[string]interface{})
+					if !data.RateLimit.RateLimiter.ActionBlock.Seconds.Duration.IsNull() && !data.RateLimit.RateLimiter.ActionBlock.Seconds.Duration.IsUnknown() {
+						secondsLevel4Map["duration"] = data.RateLimit.RateLimiter.ActionBlock.Seconds.Duration.ValueInt64()
+					}
+					action_blockLevel3Map["seconds"] = secondsLevel4Map
+				}
+				rate_limiterNestedMap["action_block"] = action_blockLevel3Map
+			}
 			if !data.RateLimit.RateLimiter.BurstMultiplier.IsNull() && !data.RateLimit.RateLimiter.BurstMultiplier.IsUnknown() {
 				rate_limiterNestedMap["burst_multiplier"] = data.RateLimit.RateLimiter.BurstMultiplier.ValueInt64()
 			}
+			if data.RateLimit.RateLimiter.Disabled != nil {
+				rate_limiterNestedMap["disabled"] = map[string]interface{}{}
+			}
+			if data.RateLimit.RateLimiter.LeakyBucket != nil {
+				rate_limiterNestedMap["leaky_bucket"] = map[string]interface{}{}
+			}
 			if !data.RateLimit.RateLimiter.PeriodMultiplier.IsNull() && !data.RateLimit.RateLimiter.PeriodMultiplier.IsUnknown() {
 				rate_limiterNestedMap["period_multiplier"] = data.RateLimit.RateLimiter.PeriodMultiplier.ValueInt64()
 			}
+			if data.RateLimit.RateLimiter.TokenBucket != nil {
+				rate_limiterNestedMap["token_bucket"] = map[string]interface{}{}
+			}
 			if !data.RateLimit.RateLimiter.TotalNumber.IsNull() && !data.RateLimit.RateLimiter.TotalNumber.IsUnknown() {
 				rate_limiterNestedMap["total_number"] = data.RateLimit.RateLimiter.TotalNumber.ValueInt64()
 			}
@@ -17300,7 +20926,7 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 
 	_, err := r.client.UpdateCDNLoadBalancer(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update CDNLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cdn_loadbalancer", "update"))
 		return
 	}
 
@@ -17311,9 +20937,12 @@ func (r *CDNLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetCDNLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CDNLoadBalancer after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "cdn_loadbalancer", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -19254,7 +22883,7 @@ func (r *CDNLoadBalancerResource) Delete(ctx context.Context, req resource.Delet
 	err := r.client.DeleteCDNLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "CDNLoadBalancer already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -19263,37 +22892,33 @@ func (r *CDNLoadBalancerResource) Delete(ctx context.Context, req resource.Delet
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "CDNLoadBalancer delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete CDNLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cdn_loadbalancer", "delete"))
 		return
 	}
 }
 
 func (r *CDNLoadBalancerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *CDNLoadBalancerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("cdn_loadbalancer", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *CDNLoadBalancerResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListCDNLoadBalancers(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *CDNLoadBalancerResource) requiredNamespace() string {
+	return ""
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,24 @@ type ChildTenantDataSource struct {
 	client *client.Client
 }
 
+// ChildTenantDataSourceModel mirrors ChildTenantResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type ChildTenantDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata     types.Object                        `tfsdk:"system_metadata"`
+	Name               types.String                        `tfsdk:"name"`
+	Namespace          types.String                        `tfsdk:"namespace"`
+	Annotations        types.Map                           `tfsdk:"annotations"`
+	Description        types.String                        `tfsdk:"description"`
+	Disable            types.Bool                          `tfsdk:"disable"`
+	Labels             types.Map                           `tfsdk:"labels"`
+	ID                 types.String                        `tfsdk:"id"`
+	CompanyName        types.String                        `tfsdk:"company_name"`
+	Domain             types.String                        `tfsdk:"domain"`
+	TenantURL          types.String                        `tfsdk:"tenant_url"`
+	ChildTenantManager *ChildTenantChildTenantManagerModel `tfsdk:"child_tenant_manager"`
+	ContactDetail      *ChildTenantContactDetailModel      `tfsdk:"contact_detail"`
+	CustomerInfo       *ChildTenantCustomerInfoModel       `tfsdk:"customer_info"`
+	TenantProfile      *ChildTenantTenantProfileModel      `tfsdk:"tenant_profile"`
 }
 
 func (d *ChildTenantDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +51,7 @@ func (d *ChildTenantDataSource) Metadata(ctx context.Context, req datasource.Met
 }
 
 func (d *ChildTenantDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages child_tenant config instance. Name of the object is the name of the child tenant to be created. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the ChildTenant.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the ChildTenant exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the ChildTenant.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewChildTenantResource())
 }
 
 func (d *ChildTenantDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +73,25 @@ func (d *ChildTenantDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	resource, err := d.client.GetChildTenant(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetChildTenant(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ChildTenant: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "child_tenant", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +105,8 @@ func (d *ChildTenantDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +115,158 @@ func (d *ChildTenantDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["child_tenant_manager"].(map[string]interface{}); ok && (isImport || data.ChildTenantManager != nil) {
+		data.ChildTenantManager = &ChildTenantChildTenantManagerModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["contact_detail"].(map[string]interface{}); ok && (isImport || data.ContactDetail != nil) {
+		data.ContactDetail = &ChildTenantContactDetailModel{
+			Address1: func() types.String {
+				if v, ok := blockData["address1"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Address2: func() types.String {
+				if v, ok := blockData["address2"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			City: func() types.String {
+				if v, ok := blockData["city"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ContactType: func() types.String {
+				if v, ok := blockData["contact_type"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Country: func() types.String {
+				if v, ok := blockData["country"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			County: func() types.String {
+				if v, ok := blockData["county"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			PhoneNumber: func() types.String {
+				if v, ok := blockData["phone_number"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			State: func() types.String {
+				if v, ok := blockData["state"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			StateCode: func() types.String {
+				if v, ok := blockData["state_code"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ZipCode: func() types.String {
+				if v, ok := blockData["zip_code"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["customer_info"].(map[string]interface{}); ok && (isImport || data.CustomerInfo != nil) {
+		data.CustomerInfo = &ChildTenantCustomerInfoModel{
+			AdditionalInfo: func() types.String {
+				if v, ok := blockData["additional_info"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Email: func() types.String {
+				if v, ok := blockData["email"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			FirstName: func() types.String {
+				if v, ok := blockData["first_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			LastName: func() types.String {
+				if v, ok := blockData["last_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["tenant_profile"].(map[string]interface{}); ok && (isImport || data.TenantProfile != nil) {
+		data.TenantProfile = &ChildTenantTenantProfileModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if v, ok := apiResource.Spec["company_name"].(string); ok && v != "" {
+		data.CompanyName = types.StringValue(v)
+	} else {
+		data.CompanyName = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["domain"].(string); ok && v != "" {
+		data.Domain = types.StringValue(v)
+	} else {
+		data.Domain = types.StringNull()
+	}
+
+	d.setExtraAttributes(&data)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,91 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// child_tenant_resource_hooks.go - Manually maintained hooks called by the
+// generated child tenant resource. A child tenant is provisioned
+// asynchronously and is reachable on its own subdomain once it is active.
+
+package provider
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
+)
+
+// Child tenant states reported in the status field of the spec
+const (
+	childTenantStateActive          = "StateActive"
+	childTenantStateCreateFailed    = "StateCreateFailed"
+	childTenantStateConfigureFailed = "StateConfiguringFailed"
+)
+
+// setExtraAttributes sets tenant_url from the provider API URL and the domain
+// of the child tenant
+func (r *ChildTenantResource) setExtraAttributes(data *ChildTenantResourceModel) {
+	tenantURL := ""
+	if r.client != nil {
+		tenantURL = childTenantURL(r.client.BaseURL, data.Domain.ValueString())
+	}
+	data.TenantURL = stringValueOrNull(tenantURL)
+}
+
+// setExtraAttributes sets tenant_url from the provider API URL and the domain
+// of the child tenant
+func (d *ChildTenantDataSource) setExtraAttributes(data *ChildTenantDataSourceModel) {
+	tenantURL := ""
+	if d.client != nil {
+		tenantURL = childTenantURL(d.client.BaseURL, data.Domain.ValueString())
+	}
+	data.TenantURL = stringValueOrNull(tenantURL)
+}
+
+// waitForReady waits until the child tenant is active
+func (r *ChildTenantResource) waitForReady(ctx context.Context, data *ChildTenantResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	refresh := func(ctx context.Context) (string, error) {
+		childTenant, err := r.client.GetChildTenant(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+		if err != nil {
+			return "", err
+		}
+		state, _ := childTenant.Spec["status"].(string)
+		return state, nil
+	}
+
+	_, err := waitForState(ctx, refresh,
+		[]string{childTenantStateActive},
+		[]string{childTenantStateCreateFailed, childTenantStateConfigureFailed},
+		defaultPollInterval)
+	if err != nil {
+		f5xcerrors.AddError(&diags, f5xcerrors.WrapError(err, "child_tenant", "create"))
+	}
+	return diags
+}
+
+// childTenantURL returns the URL of a child tenant by replacing the tenant
+// subdomain of the parent tenant URL with the child tenant domain. For
+// example https://parent.console.ves.volterra.io and domain child return
+// https://child.console.ves.volterra.io. It returns an empty string when
+// either value is empty or the parent URL has no tenant subdomain.
+func childTenantURL(baseURL, domain string) string {
+	if baseURL == "" || domain == "" {
+		return ""
+	}
+	parent, err := url.Parse(baseURL)
+	if err != nil || parent.Hostname() == "" {
+		return ""
+	}
+	_, parentDomain, found := strings.Cut(parent.Hostname(), ".")
+	if !found || !strings.Contains(parentDomain, ".") {
+		return ""
+	}
+	host := domain + "." + parentDomain
+	if port := parent.Port(); port != "" {
+		host += ":" + port
+	}
+	return (&url.URL{Scheme: parent.Scheme, Host: host}).String()
+}
@@ -0,0 +1,32 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import "testing"
+
+func TestChildTenantURL(t *testing.T) {
+	tests := []struct {
+		name     string
+		baseURL  string
+		domain   string
+		expected string
+	}{
+		{"console tenant", "https://parent.console.ves.volterra.io", "child", "https://child.console.ves.volterra.io"},
+		{"staging tenant", "https://parent.staging.volterra.us", "child", "https://child.staging.volterra.us"},
+		{"keeps port", "https://parent.example.com:8443", "child", "https://child.example.com:8443"},
+		{"drops path", "https://parent.console.ves.volterra.io/api", "child", "https://child.console.ves.volterra.io"},
+		{"empty domain", "https://parent.console.ves.volterra.io", "", ""},
+		{"empty base URL", "", "child", ""},
+		{"no tenant subdomain", "https://localhost:8080", "child", ""},
+		{"single parent domain", "https://example.com", "child", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := childTenantURL(tt.baseURL, tt.domain)
+			if result != tt.expected {
+				t.Errorf("childTenantURL(%q, %q) = %q, want %q", tt.baseURL, tt.domain, result, tt.expected)
+			}
+		})
+	}
+}
@@ -0,0 +1,213 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// client_options.go - Manually maintained helpers that turn the retry, rate
+// limit, default label, default namespace and HTTP transport settings of the
+// provider configuration into client options.
+
+package provider
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+// clientOptions returns the client options for the retry, rate limit,
+// default label, default namespace and HTTP transport settings of the
+// provider configuration. Like the other settings, the scalar ones can also
+// be set with an environment variable, which the configuration overrides.
+// The transport settings apply to every authentication mode.
+func clientOptions(config F5XCProviderModel, diags *diag.Diagnostics) []client.ClientOption {
+	var opts []client.ClientOption
+
+	if maxRetries, ok := int64Setting(config.MaxRetries.ValueInt64Pointer(), "max_retries", "F5XC_MAX_RETRIES", diags); ok {
+		if maxRetries < 0 {
+			diags.AddAttributeError(path.Root("max_retries"), "Invalid Max Retries",
+				fmt.Sprintf("max_retries must be at least 0, got: %d", maxRetries))
+		} else {
+			opts = append(opts, client.WithMaxRetries(int(maxRetries)))
+		}
+	}
+
+	retryWaitMin, minSet := durationSetting(config.RetryWaitMin.ValueStringPointer(), "retry_wait_min", "F5XC_RETRY_WAIT_MIN", client.DefaultRetryWaitMin, diags)
+	retryWaitMax, maxSet := durationSetting(config.RetryWaitMax.ValueStringPointer(), "retry_wait_max", "F5XC_RETRY_WAIT_MAX", client.DefaultRetryWaitMax, diags)
+	if minSet || maxSet {
+		if retryWaitMin > retryWaitMax {
+			diags.AddAttributeError(path.Root("retry_wait_min"), "Invalid Retry Wait",
+				fmt.Sprintf("retry_wait_min (%s) must not be greater than retry_wait_max (%s)", retryWaitMin, retryWaitMax))
+		} else {
+			opts = append(opts, client.WithRetryWait(retryWaitMin, retryWaitMax))
+		}
+	}
+
+	if rps, ok := float64Setting(config.RateLimitRPS.ValueFloat64Pointer(), "rate_limit_rps", "F5XC_RATE_LIMIT_RPS", diags); ok {
+		if rps < 0 {
+			diags.AddAttributeError(path.Root("rate_limit_rps"), "Invalid Rate Limit",
+				fmt.Sprintf("rate_limit_rps must be at least 0, got: %g", rps))
+		} else if rps > 0 {
+			// Allow bursts of one second worth of requests
+			opts = append(opts, client.WithRateLimit(rps, int(math.Ceil(rps))))
+		}
+	}
+
+	if timeout, ok := durationSetting(config.RequestTimeout.ValueStringPointer(), "request_timeout", "F5XC_REQUEST_TIMEOUT", client.DefaultTimeout, diags); ok {
+		if timeout == 0 {
+			diags.AddAttributeError(path.Root("request_timeout"), "Invalid Request Timeout",
+				"request_timeout must be greater than 0")
+		} else {
+			opts = append(opts, client.WithTimeout(timeout))
+		}
+	}
+
+	if proxy, ok := stringSetting(config.HTTPProxy.ValueStringPointer(), "F5XC_HTTP_PROXY"); ok {
+		proxyURL, err := client.ParseProxyURL(proxy)
+		if err != nil {
+			diags.AddAttributeError(path.Root("http_proxy"), "Invalid HTTP Proxy", err.Error())
+		} else {
+			opts = append(opts, client.WithProxy(proxyURL))
+		}
+	}
+
+	if caFile, ok := stringSetting(config.APICACert.ValueStringPointer(), "F5XC_CACERT"); ok {
+		pool, err := client.LoadCACertPool(caFile)
+		if err != nil {
+			diags.AddAttributeError(path.Root("api_ca_cert"), "Invalid CA Certificate",
+				fmt.Sprintf("Could not load the CA certificate %s: %s", caFile, err))
+		} else {
+			opts = append(opts, client.WithRootCAs(pool))
+		}
+	}
+
+	if skip, ok := boolSetting(config.InsecureSkipVerify.ValueBoolPointer(), "insecure_skip_verify", "F5XC_INSECURE_SKIP_VERIFY", diags); ok && skip {
+		opts = append(opts, client.WithInsecureSkipVerify(true))
+	}
+
+	if namespace, ok := stringSetting(config.DefaultNamespace.ValueStringPointer(), "F5XC_DEFAULT_NAMESPACE"); ok {
+		opts = append(opts, client.WithDefaultNamespace(namespace))
+	}
+
+	if headers := stringMapSetting(config.HTTPHeaders); len(headers) > 0 {
+		opts = append(opts, client.WithHeaders(headers))
+	}
+
+	if labels := stringMapSetting(config.DefaultLabels); len(labels) > 0 {
+		opts = append(opts, client.WithDefaultLabels(labels))
+	}
+
+	if annotations := stringMapSetting(config.DefaultAnnotations); len(annotations) > 0 {
+		opts = append(opts, client.WithDefaultAnnotations(annotations))
+	}
+
+	return opts
+}
+
+// stringMapSetting returns the configured map of strings without null
+// values, or nil when it is not configured
+func stringMapSetting(value types.Map) map[string]string {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	m := make(map[string]string, len(value.Elements()))
+	for k, v := range value.Elements() {
+		if s, ok := v.(types.String); ok && !s.IsNull() && !s.IsUnknown() {
+			m[k] = s.ValueString()
+		}
+	}
+	return m
+}
+
+// stringSetting returns the configured value, or the value of the
+// environment variable when it is not configured
+func stringSetting(value *string, env string) (string, bool) {
+	if value != nil {
+		return *value, *value != ""
+	}
+	s := os.Getenv(env)
+	return s, s != ""
+}
+
+// boolSetting returns the configured value, or the value of the environment
+// variable when it is not configured
+func boolSetting(value *bool, attribute, env string, diags *diag.Diagnostics) (bool, bool) {
+	if value != nil {
+		return *value, true
+	}
+	s := os.Getenv(env)
+	if s == "" {
+		return false, false
+	}
+	v, err := strconv.ParseBool(s)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attribute), "Invalid Environment Variable",
+			fmt.Sprintf("%s must be true or false, got: %s", env, s))
+		return false, false
+	}
+	return v, true
+}
+
+// int64Setting returns the configured value, or the value of the environment
+// variable when it is not configured
+func int64Setting(value *int64, attribute, env string, diags *diag.Diagnostics) (int64, bool) {
+	if value != nil {
+		return *value, true
+	}
+	s := os.Getenv(env)
+	if s == "" {
+		return 0, false
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attribute), "Invalid Environment Variable",
+			fmt.Sprintf("%s must be an integer, got: %s", env, s))
+		return 0, false
+	}
+	return v, true
+}
+
+// float64Setting returns the configured value, or the value of the
+// environment variable when it is not configured
+func float64Setting(value *float64, attribute, env string, diags *diag.Diagnostics) (float64, bool) {
+	if value != nil {
+		return *value, true
+	}
+	s := os.Getenv(env)
+	if s == "" {
+		return 0, false
+	}
+	v, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attribute), "Invalid Environment Variable",
+			fmt.Sprintf("%s must be a number, got: %s", env, s))
+		return 0, false
+	}
+	return v, true
+}
+
+// durationSetting returns the configured duration, or the duration in the
+// environment variable when it is not configured, or def when neither is set
+func durationSetting(value *string, attribute, env string, def time.Duration, diags *diag.Diagnostics) (time.Duration, bool) {
+	s := os.Getenv(env)
+	source := env
+	if value != nil {
+		s = *value
+		source = attribute
+	}
+	if s == "" {
+		return def, false
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < 0 {
+		diags.AddAttributeError(path.Root(attribute), "Invalid Duration",
+			fmt.Sprintf("%s must be a non-negative duration such as 500ms or 30s, got: %s", source, s))
+		return def, false
+	}
+	return d, true
+}
@@ -0,0 +1,152 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+func TestClientOptions(t *testing.T) {
+	nullConfig := F5XCProviderModel{
+		MaxRetries:   types.Int64Null(),
+		RetryWaitMin: types.StringNull(),
+		RetryWaitMax: types.StringNull(),
+		RateLimitRPS: types.Float64Null(),
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		var diags diag.Diagnostics
+		if opts := clientOptions(nullConfig, &diags); len(opts) != 0 || diags.HasError() {
+			t.Errorf("clientOptions() = %d options, diagnostics %v, want none", len(opts), diags)
+		}
+	})
+
+	t.Run("configuration", func(t *testing.T) {
+		config := F5XCProviderModel{
+			MaxRetries:   types.Int64Value(5),
+			RetryWaitMin: types.StringValue("500ms"),
+			RetryWaitMax: types.StringValue("10s"),
+			RateLimitRPS: types.Float64Value(2.5),
+		}
+		var diags diag.Diagnostics
+		c := client.NewClient("https://example.com", "token", clientOptions(config, &diags)...)
+		if diags.HasError() {
+			t.Fatalf("clientOptions() diagnostics: %v", diags)
+		}
+		if c.MaxRetries != 5 {
+			t.Errorf("MaxRetries = %d, want 5", c.MaxRetries)
+		}
+		if c.RetryWaitMin.String() != "500ms" || c.RetryWaitMax.String() != "10s" {
+			t.Errorf("retry wait = %s..%s, want 500ms..10s", c.RetryWaitMin, c.RetryWaitMax)
+		}
+	})
+
+	t.Run("environment", func(t *testing.T) {
+		t.Setenv("F5XC_MAX_RETRIES", "7")
+		t.Setenv("F5XC_RETRY_WAIT_MAX", "1m")
+		config := nullConfig
+		config.MaxRetries = types.Int64Value(2)
+
+		var diags diag.Diagnostics
+		c := client.NewClient("https://example.com", "token", clientOptions(config, &diags)...)
+		if diags.HasError() {
+			t.Fatalf("clientOptions() diagnostics: %v", diags)
+		}
+		if c.MaxRetries != 2 {
+			t.Errorf("MaxRetries = %d, want the configured 2 over the environment", c.MaxRetries)
+		}
+		if c.RetryWaitMin != client.DefaultRetryWaitMin || c.RetryWaitMax.String() != "1m0s" {
+			t.Errorf("retry wait = %s..%s, want default minimum and 1m maximum", c.RetryWaitMin, c.RetryWaitMax)
+		}
+	})
+
+	t.Run("transport", func(t *testing.T) {
+		t.Setenv("F5XC_INSECURE_SKIP_VERIFY", "true")
+		config := nullConfig
+		config.RequestTimeout = types.StringValue("2m")
+		config.HTTPProxy = types.StringValue("http://proxy.example.com:3128")
+		config.HTTPHeaders = types.MapValueMust(types.StringType, map[string]attr.Value{
+			"X-Proxy-Token": types.StringValue("abc"),
+		})
+
+		var diags diag.Diagnostics
+		c := client.NewClient("https://example.com", "token", clientOptions(config, &diags)...)
+		if diags.HasError() {
+			t.Fatalf("clientOptions() diagnostics: %v", diags)
+		}
+		if c.HTTPClient.Timeout.String() != "2m0s" {
+			t.Errorf("Timeout = %s, want 2m", c.HTTPClient.Timeout)
+		}
+		transport, ok := c.HTTPClient.Transport.(*http.Transport)
+		if !ok {
+			t.Fatalf("Transport = %T, want *http.Transport", c.HTTPClient.Transport)
+		}
+		if !transport.TLSClientConfig.InsecureSkipVerify {
+			t.Error("InsecureSkipVerify = false, want true from the environment")
+		}
+		req, _ := http.NewRequest(http.MethodGet, "https://example.com", nil)
+		if proxyURL, _ := transport.Proxy(req); proxyURL == nil || proxyURL.Host != "proxy.example.com:3128" {
+			t.Errorf("Proxy() = %v, want proxy.example.com:3128", proxyURL)
+		}
+	})
+
+	t.Run("defaults", func(t *testing.T) {
+		t.Setenv("F5XC_DEFAULT_NAMESPACE", "staging")
+		config := nullConfig
+		config.DefaultLabels = types.MapValueMust(types.StringType, map[string]attr.Value{
+			"managed-by": types.StringValue("terraform"),
+			"team":       types.StringValue("netops"),
+		})
+
+		var diags diag.Diagnostics
+		c := client.NewClient("https://example.com", "token", clientOptions(config, &diags)...)
+		if diags.HasError() {
+			t.Fatalf("clientOptions() diagnostics: %v", diags)
+		}
+		if len(c.DefaultLabels) != 2 || c.DefaultLabels["team"] != "netops" {
+			t.Errorf("DefaultLabels = %v, want the configured labels", c.DefaultLabels)
+		}
+		if c.DefaultAnnotations != nil {
+			t.Errorf("DefaultAnnotations = %v, want nil when not configured", c.DefaultAnnotations)
+		}
+		if c.DefaultNamespace != "staging" {
+			t.Errorf("DefaultNamespace = %q, want staging from the environment", c.DefaultNamespace)
+		}
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			config F5XCProviderModel
+			env    map[string]string
+		}{
+			{"duration", F5XCProviderModel{MaxRetries: types.Int64Null(), RetryWaitMin: types.StringValue("soon"), RetryWaitMax: types.StringNull(), RateLimitRPS: types.Float64Null()}, nil},
+			{"min above max", F5XCProviderModel{MaxRetries: types.Int64Null(), RetryWaitMin: types.StringValue("1m"), RetryWaitMax: types.StringValue("1s"), RateLimitRPS: types.Float64Null()}, nil},
+			{"max retries environment", nullConfig, map[string]string{"F5XC_MAX_RETRIES": "many"}},
+			{"rate limit environment", nullConfig, map[string]string{"F5XC_RATE_LIMIT_RPS": "-1"}},
+			{"request timeout", F5XCProviderModel{RequestTimeout: types.StringValue("0s")}, nil},
+			{"http proxy", F5XCProviderModel{HTTPProxy: types.StringValue("proxy.example.com")}, nil},
+			{"ca certificate", F5XCProviderModel{APICACert: types.StringValue("/nonexistent/ca.pem")}, nil},
+			{"insecure skip verify environment", nullConfig, map[string]string{"F5XC_INSECURE_SKIP_VERIFY": "maybe"}},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				for k, v := range tt.env {
+					t.Setenv(k, v)
+				}
+				var diags diag.Diagnostics
+				clientOptions(tt.config, &diags)
+				if !diags.HasError() {
+					t.Error("clientOptions() expected error")
+				}
+			})
+		}
+	})
+}
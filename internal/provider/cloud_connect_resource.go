@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,21 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &CloudConnectResource{}
-	_ resource.ResourceWithConfigure      = &CloudConnectResource{}
-	_ resource.ResourceWithImportState    = &CloudConnectResource{}
-	_ resource.ResourceWithModifyPlan     = &CloudConnectResource{}
-	_ resource.ResourceWithValidateConfig = &CloudConnectResource{}
+	_ resource.Resource                     = &CloudConnectResource{}
+	_ resource.ResourceWithConfigure        = &CloudConnectResource{}
+	_ resource.ResourceWithImportState      = &CloudConnectResource{}
+	_ resource.ResourceWithIdentity         = &CloudConnectResource{}
+	_ resource.ResourceWithMoveState        = &CloudConnectResource{}
+	_ resource.ResourceWithModifyPlan       = &CloudConnectResource{}
+	_ resource.ResourceWithValidateConfig   = &CloudConnectResource{}
+	_ resource.ResourceWithConfigValidators = &CloudConnectResource{}
 )
 
 func NewCloudConnectResource() resource.Resource {
@@ -274,17 +279,19 @@ var CloudConnectSegmentModelAttrTypes = map[string]attr.Type{
 }
 
 type CloudConnectResourceModel struct {
-	Name          types.String                    `tfsdk:"name"`
-	Namespace     types.String                    `tfsdk:"namespace"`
-	Annotations   types.Map                       `tfsdk:"annotations"`
-	Description   types.String                    `tfsdk:"description"`
-	Disable       types.Bool                      `tfsdk:"disable"`
-	Labels        types.Map                       `tfsdk:"labels"`
-	ID            types.String                    `tfsdk:"id"`
-	Timeouts      timeouts.Value                  `tfsdk:"timeouts"`
-	AWSTGWSite    *CloudConnectAWSTGWSiteModel    `tfsdk:"aws_tgw_site"`
-	AzureVNETSite *CloudConnectAzureVNETSiteModel `tfsdk:"azure_vnet_site"`
-	Segment       *CloudConnectSegmentModel       `tfsdk:"segment"`
+	Name           types.String                    `tfsdk:"name"`
+	Namespace      types.String                    `tfsdk:"namespace"`
+	Annotations    types.Map                       `tfsdk:"annotations"`
+	Description    types.String                    `tfsdk:"description"`
+	Disable        types.Bool                      `tfsdk:"disable"`
+	Labels         types.Map                       `tfsdk:"labels"`
+	ID             types.String                    `tfsdk:"id"`
+	Timeouts       timeouts.Value                  `tfsdk:"timeouts"`
+	SystemMetadata types.Object                    `tfsdk:"system_metadata"`
+	LabelsAll      types.Map                       `tfsdk:"labels_all"`
+	AWSTGWSite     *CloudConnectAWSTGWSiteModel    `tfsdk:"aws_tgw_site"`
+	AzureVNETSite  *CloudConnectAzureVNETSiteModel `tfsdk:"azure_vnet_site"`
+	Segment        *CloudConnectSegmentModel       `tfsdk:"segment"`
 }
 
 func (r *CloudConnectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -306,9 +313,11 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Cloud Connect will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Cloud Connect will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -340,6 +349,8 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -358,6 +369,10 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -366,6 +381,9 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -374,6 +392,9 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -383,6 +404,10 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -391,6 +416,9 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -399,6 +427,9 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -408,11 +439,18 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 						Blocks: map[string]schema.Block{
 							"vpc_list": schema.ListNestedBlock{
 								MarkdownDescription: "VPC List. Collection of items or values",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"vpc_id": schema.StringAttribute{
 											MarkdownDescription: "Enter the VPC ID of the VPC to be attached .",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(64),
+												validators.PatternValidator("^(vpc-)([a-z0-9]{8}|[a-z0-9]{17})$"),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -422,16 +460,29 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 											Blocks: map[string]schema.Block{
 												"route_tables": schema.ListNestedBlock{
 													MarkdownDescription: "List of route tables. Route Tables .",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(200),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"route_table_id": schema.StringAttribute{
 																MarkdownDescription: "Route table ID. Route table ID.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.UTF8LengthAtMost(64),
+																	validators.PatternValidator("^(rtb-)([a-z0-9]{8}|[a-z0-9]{17})$"),
+																},
 															},
 															"static_routes": schema.ListAttribute{
 																MarkdownDescription: "Static Routes. List of Static Routes .",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(50),
+																	listvalidator.SizeAtLeast(1),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+																},
 															},
 														},
 													},
@@ -452,6 +503,9 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 															MarkdownDescription: "Route table ID. Route table ID.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -480,6 +534,10 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -488,6 +546,9 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -496,6 +557,9 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -505,15 +569,24 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 						Blocks: map[string]schema.Block{
 							"vnet_list": schema.ListNestedBlock{
 								MarkdownDescription: "VNet List. Collection of items or values",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"subscription_id": schema.StringAttribute{
 											MarkdownDescription: "Enter the Subscription ID of the VNet to be attached .",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(64),
+											},
 										},
 										"vnet_id": schema.StringAttribute{
 											MarkdownDescription: "Enter the VNet ID of the VNet to be attached in format /<resource-group-name>/<VNet-name> .",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -523,16 +596,29 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 											Blocks: map[string]schema.Block{
 												"route_tables": schema.ListNestedBlock{
 													MarkdownDescription: "Route Tables with static routes .",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(200),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"route_table_id": schema.StringAttribute{
 																MarkdownDescription: "Route table ID in the format /<resource-group-name>/<route-table-name>.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.UTF8LengthAtMost(256),
+																	validators.PatternValidator("^\\/[-\\w\\._\\(\\)]+\\/[a-zA-Z0-9][a-zA-Z0-9-._]+[a-zA-Z0-9_]$"),
+																},
 															},
 															"static_routes": schema.ListAttribute{
 																MarkdownDescription: "Static Routes. List of Static Routes .",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(50),
+																	listvalidator.SizeAtLeast(1),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+																},
 															},
 														},
 													},
@@ -553,6 +639,9 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 															MarkdownDescription: "Route table ID in the format /<resource-group-name>/<route-table-name>.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -577,6 +666,10 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 					"name": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(128),
+							stringvalidator.LengthAtLeast(1),
+						},
 					},
 					"namespace": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -585,6 +678,9 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 					"tenant": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -593,6 +689,9 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 				},
 			},
@@ -600,6 +699,11 @@ func (r *CloudConnectResource) Schema(ctx context.Context, req resource.SchemaRe
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *CloudConnectResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *CloudConnectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -624,6 +728,18 @@ func (r *CloudConnectResource) ValidateConfig(ctx context.Context, req resource.
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *CloudConnectResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "cloud", "aws_tgw_site", "azure_vnet_site"),
+		validators.OneOfGroup(path.MatchRoot("aws_tgw_site").AtName("vpc_attachments").AtName("vpc_list").AtAnyListIndex(), "routing_choice", "custom_routing", "default_route", "manual_routing"),
+		validators.OneOfGroup(path.MatchRoot("aws_tgw_site").AtName("vpc_attachments").AtName("vpc_list").AtAnyListIndex().AtName("default_route"), "default_route_choice", "all_route_tables", "selective_route_tables"),
+		validators.OneOfGroup(path.MatchRoot("azure_vnet_site").AtName("vnet_attachments").AtName("vnet_list").AtAnyListIndex(), "routing_choice", "custom_routing", "default_route", "manual_routing"),
+		validators.OneOfGroup(path.MatchRoot("azure_vnet_site").AtName("vnet_attachments").AtName("vnet_list").AtAnyListIndex().AtName("default_route"), "default_route_choice", "all_route_tables", "selective_route_tables"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *CloudConnectResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -634,7 +750,10 @@ func (r *CloudConnectResource) ModifyPlan(ctx context.Context, req resource.Modi
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "cloud_connect", "system", req.Config, &resp.Plan)...)
 		var plan CloudConnectResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -691,6 +810,7 @@ func (r *CloudConnectResource) Create(ctx context.Context, req resource.CreateRe
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -700,6 +820,7 @@ func (r *CloudConnectResource) Create(ctx context.Context, req resource.CreateRe
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AWSTGWSite != nil {
@@ -773,14 +894,18 @@ func (r *CloudConnectResource) Create(ctx context.Context, req resource.CreateRe
 
 	apiResource, err := r.client.CreateCloudConnect(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create CloudConnect: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_connect", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
 	// For resources without namespace in API path, namespace is computed from API response
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
+
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
 	isImport := false // Create is never an import
@@ -841,7 +966,7 @@ func (r *CloudConnectResource) Read(ctx context.Context, req resource.ReadReques
 	apiResource, err := r.client.GetCloudConnect(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "CloudConnect not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -849,11 +974,12 @@ func (r *CloudConnectResource) Read(ctx context.Context, req resource.ReadReques
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CloudConnect: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_connect", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -864,31 +990,13 @@ func (r *CloudConnectResource) Read(ctx context.Context, req resource.ReadReques
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -896,6 +1004,7 @@ func (r *CloudConnectResource) Read(ctx context.Context, req resource.ReadReques
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["aws_tgw_site"].(map[string]interface{}); ok && isImport && data.AWSTGWSite == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -969,6 +1078,7 @@ func (r *CloudConnectResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -978,6 +1088,7 @@ func (r *CloudConnectResource) Update(ctx context.Context, req resource.UpdateRe
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AWSTGWSite != nil {
@@ -1051,7 +1162,7 @@ func (r *CloudConnectResource) Update(ctx context.Context, req resource.UpdateRe
 
 	_, err := r.client.UpdateCloudConnect(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update CloudConnect: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_connect", "update"))
 		return
 	}
 
@@ -1062,9 +1173,12 @@ func (r *CloudConnectResource) Update(ctx context.Context, req resource.UpdateRe
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetCloudConnect(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CloudConnect after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "cloud_connect", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1126,7 +1240,7 @@ func (r *CloudConnectResource) Delete(ctx context.Context, req resource.DeleteRe
 	err := r.client.DeleteCloudConnect(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "CloudConnect already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1135,35 +1249,23 @@ func (r *CloudConnectResource) Delete(ctx context.Context, req resource.DeleteRe
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "CloudConnect delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete CloudConnect: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_connect", "delete"))
 		return
 	}
 }
 
 func (r *CloudConnectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: name (no namespace for this resource type)
-	name := req.ID
-	if name == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			"Expected import ID to be the resource name, got empty string",
-		)
-		return
-	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), "")...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+	importResourceState(ctx, r.client, false, "system", req, resp)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *CloudConnectResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("cloud_connect", false)}
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,22 @@ type CloudCredentialsDataSource struct {
 	client *client.Client
 }
 
+// CloudCredentialsDataSourceModel mirrors CloudCredentialsResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type CloudCredentialsDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata      types.Object                              `tfsdk:"system_metadata"`
+	Name                types.String                              `tfsdk:"name"`
+	Namespace           types.String                              `tfsdk:"namespace"`
+	Annotations         types.Map                                 `tfsdk:"annotations"`
+	Description         types.String                              `tfsdk:"description"`
+	Disable             types.Bool                                `tfsdk:"disable"`
+	Labels              types.Map                                 `tfsdk:"labels"`
+	ID                  types.String                              `tfsdk:"id"`
+	AWSAssumeRole       *CloudCredentialsAWSAssumeRoleModel       `tfsdk:"aws_assume_role"`
+	AWSSecretKey        *CloudCredentialsAWSSecretKeyModel        `tfsdk:"aws_secret_key"`
+	AzureClientSecret   *CloudCredentialsAzureClientSecretModel   `tfsdk:"azure_client_secret"`
+	AzurePfxCertificate *CloudCredentialsAzurePfxCertificateModel `tfsdk:"azure_pfx_certificate"`
+	GCPCredFile         *CloudCredentialsGCPCredFileModel         `tfsdk:"gcp_cred_file"`
 }
 
 func (d *CloudCredentialsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +49,7 @@ func (d *CloudCredentialsDataSource) Metadata(ctx context.Context, req datasourc
 }
 
 func (d *CloudCredentialsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Cloud Credentials resource in F5 Distributed Cloud for api to create cloud_credentials object. configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the CloudCredentials.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the CloudCredentials exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the CloudCredentials.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewCloudCredentialsResource())
 }
 
 func (d *CloudCredentialsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +71,25 @@ func (d *CloudCredentialsDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	resource, err := d.client.GetCloudCredentials(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetCloudCredentials(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CloudCredentials: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_credentials", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +103,8 @@ func (d *CloudCredentialsDataSource) Read(ctx context.Context, req datasource.Re
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +113,184 @@ func (d *CloudCredentialsDataSource) Read(ctx context.Context, req datasource.Re
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["aws_assume_role"].(map[string]interface{}); ok && (isImport || data.AWSAssumeRole != nil) {
+		data.AWSAssumeRole = &CloudCredentialsAWSAssumeRoleModel{
+			CustomExternalID: func() types.String {
+				if v, ok := blockData["custom_external_id"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			DurationSeconds: func() types.Int64 {
+				if !isImport && data.AWSAssumeRole != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.AWSAssumeRole.DurationSeconds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["duration_seconds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			ExternalIDIsOptional: func() *CloudCredentialsEmptyModel {
+				if !isImport && data.AWSAssumeRole != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.AWSAssumeRole.ExternalIDIsOptional
+				}
+				// Import case: read from API
+				if _, ok := blockData["external_id_is_optional"].(map[string]interface{}); ok {
+					return &CloudCredentialsEmptyModel{}
+				}
+				return nil
+			}(),
+			ExternalIDIsTenantID: func() *CloudCredentialsEmptyModel {
+				if !isImport && data.AWSAssumeRole != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.AWSAssumeRole.ExternalIDIsTenantID
+				}
+				// Import case: read from API
+				if _, ok := blockData["external_id_is_tenant_id"].(map[string]interface{}); ok {
+					return &CloudCredentialsEmptyModel{}
+				}
+				return nil
+			}(),
+			RoleArn: func() types.String {
+				if v, ok := blockData["role_arn"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			SessionName: func() types.String {
+				if v, ok := blockData["session_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			SessionTags: func() *CloudCredentialsEmptyModel {
+				if !isImport && data.AWSAssumeRole != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.AWSAssumeRole.SessionTags
+				}
+				// Import case: read from API
+				if _, ok := blockData["session_tags"].(map[string]interface{}); ok {
+					return &CloudCredentialsEmptyModel{}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["aws_secret_key"].(map[string]interface{}); ok && (isImport || data.AWSSecretKey != nil) {
+		data.AWSSecretKey = &CloudCredentialsAWSSecretKeyModel{
+			AccessKey: func() types.String {
+				if v, ok := blockData["access_key"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			SecretKey: func() *CloudCredentialsAWSSecretKeySecretKeyModel {
+				if !isImport && data.AWSSecretKey != nil && data.AWSSecretKey.SecretKey != nil {
+					// Normal Read: preserve existing state value
+					return data.AWSSecretKey.SecretKey
+				}
+				// Import case: read from API
+				if _, ok := blockData["secret_key"].(map[string]interface{}); ok {
+					return &CloudCredentialsAWSSecretKeySecretKeyModel{}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["azure_client_secret"].(map[string]interface{}); ok && (isImport || data.AzureClientSecret != nil) {
+		data.AzureClientSecret = &CloudCredentialsAzureClientSecretModel{
+			ClientID: func() types.String {
+				if v, ok := blockData["client_id"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ClientSecret: func() *CloudCredentialsAzureClientSecretClientSecretModel {
+				if !isImport && data.AzureClientSecret != nil && data.AzureClientSecret.ClientSecret != nil {
+					// Normal Read: preserve existing state value
+					return data.AzureClientSecret.ClientSecret
+				}
+				// Import case: read from API
+				if _, ok := blockData["client_secret"].(map[string]interface{}); ok {
+					return &CloudCredentialsAzureClientSecretClientSecretModel{}
+				}
+				return nil
+			}(),
+			SubscriptionID: func() types.String {
+				if v, ok := blockData["subscription_id"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			TenantID: func() types.String {
+				if v, ok := blockData["tenant_id"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["azure_pfx_certificate"].(map[string]interface{}); ok && (isImport || data.AzurePfxCertificate != nil) {
+		data.AzurePfxCertificate = &CloudCredentialsAzurePfxCertificateModel{
+			CertificateURL: func() types.String {
+				if v, ok := blockData["certificate_url"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ClientID: func() types.String {
+				if v, ok := blockData["client_id"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Password: func() *CloudCredentialsAzurePfxCertificatePasswordModel {
+				if !isImport && data.AzurePfxCertificate != nil && data.AzurePfxCertificate.Password != nil {
+					// Normal Read: preserve existing state value
+					return data.AzurePfxCertificate.Password
+				}
+				// Import case: read from API
+				if _, ok := blockData["password"].(map[string]interface{}); ok {
+					return &CloudCredentialsAzurePfxCertificatePasswordModel{}
+				}
+				return nil
+			}(),
+			SubscriptionID: func() types.String {
+				if v, ok := blockData["subscription_id"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			TenantID: func() types.String {
+				if v, ok := blockData["tenant_id"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["gcp_cred_file"].(map[string]interface{}); ok && isImport && data.GCPCredFile == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.GCPCredFile = &CloudCredentialsGCPCredFileModel{}
+	}
+	// Normal Read: preserve existing state value
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewCloudCredentialsResource(), &data)...)
 }
@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &CloudCredentialsResource{}
-	_ resource.ResourceWithConfigure      = &CloudCredentialsResource{}
-	_ resource.ResourceWithImportState    = &CloudCredentialsResource{}
-	_ resource.ResourceWithModifyPlan     = &CloudCredentialsResource{}
-	_ resource.ResourceWithValidateConfig = &CloudCredentialsResource{}
+	_ resource.Resource                     = &CloudCredentialsResource{}
+	_ resource.ResourceWithConfigure        = &CloudCredentialsResource{}
+	_ resource.ResourceWithImportState      = &CloudCredentialsResource{}
+	_ resource.ResourceWithIdentity         = &CloudCredentialsResource{}
+	_ resource.ResourceWithMoveState        = &CloudCredentialsResource{}
+	_ resource.ResourceWithModifyPlan       = &CloudCredentialsResource{}
+	_ resource.ResourceWithValidateConfig   = &CloudCredentialsResource{}
+	_ resource.ResourceWithConfigValidators = &CloudCredentialsResource{}
+	_ listableResource                      = &CloudCredentialsResource{}
 )
 
 func NewCloudCredentialsResource() resource.Resource {
@@ -107,14 +113,16 @@ var CloudCredentialsAWSSecretKeySecretKeyBlindfoldSecretInfoModelAttrTypes = map
 
 // CloudCredentialsAWSSecretKeySecretKeyClearSecretInfoModel represents clear_secret_info block
 type CloudCredentialsAWSSecretKeySecretKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CloudCredentialsAWSSecretKeySecretKeyClearSecretInfoModelAttrTypes defines the attribute types for CloudCredentialsAWSSecretKeySecretKeyClearSecretInfoModel
 var CloudCredentialsAWSSecretKeySecretKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CloudCredentialsAzureClientSecretModel represents azure_client_secret block
@@ -161,14 +169,16 @@ var CloudCredentialsAzureClientSecretClientSecretBlindfoldSecretInfoModelAttrTyp
 
 // CloudCredentialsAzureClientSecretClientSecretClearSecretInfoModel represents clear_secret_info block
 type CloudCredentialsAzureClientSecretClientSecretClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CloudCredentialsAzureClientSecretClientSecretClearSecretInfoModelAttrTypes defines the attribute types for CloudCredentialsAzureClientSecretClientSecretClearSecretInfoModel
 var CloudCredentialsAzureClientSecretClientSecretClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CloudCredentialsAzurePfxCertificateModel represents azure_pfx_certificate block
@@ -217,14 +227,16 @@ var CloudCredentialsAzurePfxCertificatePasswordBlindfoldSecretInfoModelAttrTypes
 
 // CloudCredentialsAzurePfxCertificatePasswordClearSecretInfoModel represents clear_secret_info block
 type CloudCredentialsAzurePfxCertificatePasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CloudCredentialsAzurePfxCertificatePasswordClearSecretInfoModelAttrTypes defines the attribute types for CloudCredentialsAzurePfxCertificatePasswordClearSecretInfoModel
 var CloudCredentialsAzurePfxCertificatePasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CloudCredentialsGCPCredFileModel represents gcp_cred_file block
@@ -265,14 +277,16 @@ var CloudCredentialsGCPCredFileCredentialFileBlindfoldSecretInfoModelAttrTypes =
 
 // CloudCredentialsGCPCredFileCredentialFileClearSecretInfoModel represents clear_secret_info block
 type CloudCredentialsGCPCredFileCredentialFileClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CloudCredentialsGCPCredFileCredentialFileClearSecretInfoModelAttrTypes defines the attribute types for CloudCredentialsGCPCredFileCredentialFileClearSecretInfoModel
 var CloudCredentialsGCPCredFileCredentialFileClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 type CloudCredentialsResourceModel struct {
@@ -284,6 +298,8 @@ type CloudCredentialsResourceModel struct {
 	Labels              types.Map                                 `tfsdk:"labels"`
 	ID                  types.String                              `tfsdk:"id"`
 	Timeouts            timeouts.Value                            `tfsdk:"timeouts"`
+	SystemMetadata      types.Object                              `tfsdk:"system_metadata"`
+	LabelsAll           types.Map                                 `tfsdk:"labels_all"`
 	AWSAssumeRole       *CloudCredentialsAWSAssumeRoleModel       `tfsdk:"aws_assume_role"`
 	AWSSecretKey        *CloudCredentialsAWSSecretKeyModel        `tfsdk:"aws_secret_key"`
 	AzureClientSecret   *CloudCredentialsAzureClientSecretModel   `tfsdk:"azure_client_secret"`
@@ -310,9 +326,11 @@ func (r *CloudCredentialsResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Cloud Credentials will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Cloud Credentials will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -344,6 +362,8 @@ func (r *CloudCredentialsResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -358,18 +378,36 @@ func (r *CloudCredentialsResource) Schema(ctx context.Context, req resource.Sche
 					"custom_external_id": schema.StringAttribute{
 						MarkdownDescription: "External ID is Custom ID.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+							stringvalidator.UTF8LengthAtLeast(2),
+						},
 					},
 					"duration_seconds": schema.Int64Attribute{
 						MarkdownDescription: "The duration, in seconds of the role session.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(3600),
+							int64validator.AtMost(43200),
+						},
 					},
 					"role_arn": schema.StringAttribute{
 						MarkdownDescription: "IAM Role ARN to assume the role .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(2048),
+							stringvalidator.UTF8LengthAtLeast(20),
+							validators.PatternValidator("^(arn:aws:iam::)([0-9]{12}:role/.*)$"),
+						},
 					},
 					"session_name": schema.StringAttribute{
 						MarkdownDescription: "Use the role session name to uniquely identify a session, which will be used for deploy, monitor from F5XC console .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+							stringvalidator.UTF8LengthAtLeast(2),
+							validators.PatternValidator("[\\w+=,.@-]*"),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -390,6 +428,9 @@ func (r *CloudCredentialsResource) Schema(ctx context.Context, req resource.Sche
 					"access_key": schema.StringAttribute{
 						MarkdownDescription: "Access key ID for your AWS account .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(128),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -422,7 +463,16 @@ func (r *CloudCredentialsResource) Schema(ctx context.Context, req resource.Sche
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -437,14 +487,23 @@ func (r *CloudCredentialsResource) Schema(ctx context.Context, req resource.Sche
 					"client_id": schema.StringAttribute{
 						MarkdownDescription: "Client ID for your Azure service principal .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+						},
 					},
 					"subscription_id": schema.StringAttribute{
 						MarkdownDescription: "Subscription ID for your Azure service principal .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+						},
 					},
 					"tenant_id": schema.StringAttribute{
 						MarkdownDescription: "Tenant ID for your Azure service principal .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -477,7 +536,16 @@ func (r *CloudCredentialsResource) Schema(ctx context.Context, req resource.Sche
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -492,18 +560,30 @@ func (r *CloudCredentialsResource) Schema(ctx context.Context, req resource.Sche
 					"certificate_url": schema.StringAttribute{
 						MarkdownDescription: "URL for Client Certificate in '.pfx' or '.p12' whose certificate is linked to service principal object Certificate URL can contain client certificate in string:///<Base64 of certificate> format. Here <Base64 of certificate> is base64 of '.pfx' or '.p12' binary file .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(8192),
+						},
 					},
 					"client_id": schema.StringAttribute{
 						MarkdownDescription: "Client ID for your Azure service principal .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+						},
 					},
 					"subscription_id": schema.StringAttribute{
 						MarkdownDescription: "Subscription ID for your Azure service principal .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+						},
 					},
 					"tenant_id": schema.StringAttribute{
 						MarkdownDescription: "Tenant ID for your Azure service principal .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -536,7 +616,16 @@ func (r *CloudCredentialsResource) Schema(ctx context.Context, req resource.Sche
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -578,7 +667,16 @@ func (r *CloudCredentialsResource) Schema(ctx context.Context, req resource.Sche
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -591,6 +689,11 @@ func (r *CloudCredentialsResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *CloudCredentialsResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *CloudCredentialsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -615,6 +718,19 @@ func (r *CloudCredentialsResource) ValidateConfig(ctx context.Context, req resou
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *CloudCredentialsResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "cloud", "aws_assume_role", "aws_secret_key", "azure_client_secret", "azure_pfx_certificate", "gcp_cred_file"),
+		validators.OneOfGroup(path.MatchRoot("aws_assume_role"), "external_id", "custom_external_id", "external_id_is_optional", "external_id_is_tenant_id"),
+		validators.OneOfGroup(path.MatchRoot("aws_secret_key").AtName("secret_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("azure_client_secret").AtName("client_secret"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("azure_pfx_certificate").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("gcp_cred_file").AtName("credential_file"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *CloudCredentialsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -625,7 +741,10 @@ func (r *CloudCredentialsResource) ModifyPlan(ctx context.Context, req resource.
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "cloud_credentials", "system", req.Config, &resp.Plan)...)
 		var plan CloudCredentialsResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -643,7 +762,8 @@ func (r *CloudCredentialsResource) ModifyPlan(ctx context.Context, req resource.
 
 func (r *CloudCredentialsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data CloudCredentialsResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -682,6 +802,7 @@ func (r *CloudCredentialsResource) Create(ctx context.Context, req resource.Crea
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -691,6 +812,7 @@ func (r *CloudCredentialsResource) Create(ctx context.Context, req resource.Crea
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AWSAssumeRole != nil {
@@ -725,6 +847,29 @@ func (r *CloudCredentialsResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.AWSSecretKey.SecretKey != nil {
 			secret_keyNestedMap := make(map[string]interface{})
+			if data.AWSSecretKey.SecretKey.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.Location.IsNull() && !data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				secret_keyNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.AWSSecretKey.SecretKey.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AWSSecretKey.SecretKey.ClearSecretInfo.Provider.IsNull() && !data.AWSSecretKey.SecretKey.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.AWSSecretKey.SecretKey.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.AWSSecretKey.SecretKey.ClearSecretInfo.URL.IsNull() && !data.AWSSecretKey.SecretKey.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.AWSSecretKey.SecretKey.ClearSecretInfo.URL.ValueString()
+				}
+				secret_keyNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			aws_secret_keyMap["secret_key"] = secret_keyNestedMap
 		}
 		createReq.Spec["aws_secret_key"] = aws_secret_keyMap
@@ -736,6 +881,29 @@ func (r *CloudCredentialsResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.AzureClientSecret.ClientSecret != nil {
 			client_secretNestedMap := make(map[string]interface{})
+			if data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.Location.IsNull() && !data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				client_secretNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.AzureClientSecret.ClientSecret.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzureClientSecret.ClientSecret.ClearSecretInfo.Provider.IsNull() && !data.AzureClientSecret.ClientSecret.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.AzureClientSecret.ClientSecret.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.AzureClientSecret.ClientSecret.ClearSecretInfo.URL.IsNull() && !data.AzureClientSecret.ClientSecret.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.AzureClientSecret.ClientSecret.ClearSecretInfo.URL.ValueString()
+				}
+				client_secretNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			azure_client_secretMap["client_secret"] = client_secretNestedMap
 		}
 		if !data.AzureClientSecret.SubscriptionID.IsNull() && !data.AzureClientSecret.SubscriptionID.IsUnknown() {
@@ -756,6 +924,29 @@ func (r *CloudCredentialsResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.AzurePfxCertificate.Password != nil {
 			passwordNestedMap := make(map[string]interface{})
+			if data.AzurePfxCertificate.Password.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzurePfxCertificate.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AzurePfxCertificate.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.AzurePfxCertificate.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.AzurePfxCertificate.Password.BlindfoldSecretInfo.Location.IsNull() && !data.AzurePfxCertificate.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.AzurePfxCertificate.Password.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.AzurePfxCertificate.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AzurePfxCertificate.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.AzurePfxCertificate.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				passwordNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.AzurePfxCertificate.Password.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzurePfxCertificate.Password.ClearSecretInfo.Provider.IsNull() && !data.AzurePfxCertificate.Password.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.AzurePfxCertificate.Password.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.AzurePfxCertificate.Password.ClearSecretInfo.URL.IsNull() && !data.AzurePfxCertificate.Password.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.AzurePfxCertificate.Password.ClearSecretInfo.URL.ValueString()
+				}
+				passwordNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			azure_pfx_certificateMap["password"] = passwordNestedMap
 		}
 		if !data.AzurePfxCertificate.SubscriptionID.IsNull() && !data.AzurePfxCertificate.SubscriptionID.IsUnknown() {
@@ -770,6 +961,29 @@ func (r *CloudCredentialsResource) Create(ctx context.Context, req resource.Crea
 		gcp_cred_fileMap := make(map[string]interface{})
 		if data.GCPCredFile.CredentialFile != nil {
 			credential_fileNestedMap := make(map[string]interface{})
+			if data.GCPCredFile.CredentialFile.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.Location.IsNull() && !data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				credential_fileNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.GCPCredFile.CredentialFile.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.GCPCredFile.CredentialFile.ClearSecretInfo.Provider.IsNull() && !data.GCPCredFile.CredentialFile.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.GCPCredFile.CredentialFile.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.GCPCredFile.CredentialFile.ClearSecretInfo.URL.IsNull() && !data.GCPCredFile.CredentialFile.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.GCPCredFile.CredentialFile.ClearSecretInfo.URL.ValueString()
+				}
+				credential_fileNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			gcp_cred_fileMap["credential_file"] = credential_fileNestedMap
 		}
 		createReq.Spec["gcp_cred_file"] = gcp_cred_fileMap
@@ -777,11 +991,15 @@ func (r *CloudCredentialsResource) Create(ctx context.Context, req resource.Crea
 
 	apiResource, err := r.client.CreateCloudCredentials(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create CloudCredentials: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_credentials", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -983,7 +1201,7 @@ func (r *CloudCredentialsResource) Read(ctx context.Context, req resource.ReadRe
 	apiResource, err := r.client.GetCloudCredentials(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "CloudCredentials not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -991,11 +1209,12 @@ func (r *CloudCredentialsResource) Read(ctx context.Context, req resource.ReadRe
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CloudCredentials: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_credentials", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1006,31 +1225,13 @@ func (r *CloudCredentialsResource) Read(ctx context.Context, req resource.ReadRe
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1038,6 +1239,7 @@ func (r *CloudCredentialsResource) Read(ctx context.Context, req resource.ReadRe
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["aws_assume_role"].(map[string]interface{}); ok && (isImport || data.AWSAssumeRole != nil) {
 		data.AWSAssumeRole = &CloudCredentialsAWSAssumeRoleModel{
@@ -1217,7 +1419,8 @@ func (r *CloudCredentialsResource) Read(ctx context.Context, req resource.ReadRe
 
 func (r *CloudCredentialsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data CloudCredentialsResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1251,6 +1454,7 @@ func (r *CloudCredentialsResource) Update(ctx context.Context, req resource.Upda
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1260,6 +1464,7 @@ func (r *CloudCredentialsResource) Update(ctx context.Context, req resource.Upda
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AWSAssumeRole != nil {
@@ -1294,6 +1499,29 @@ func (r *CloudCredentialsResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.AWSSecretKey.SecretKey != nil {
 			secret_keyNestedMap := make(map[string]interface{})
+			if data.AWSSecretKey.SecretKey.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.Location.IsNull() && !data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.AWSSecretKey.SecretKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				secret_keyNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.AWSSecretKey.SecretKey.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AWSSecretKey.SecretKey.ClearSecretInfo.Provider.IsNull() && !data.AWSSecretKey.SecretKey.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.AWSSecretKey.SecretKey.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.AWSSecretKey.SecretKey.ClearSecretInfo.URL.IsNull() && !data.AWSSecretKey.SecretKey.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.AWSSecretKey.SecretKey.ClearSecretInfo.URL.ValueString()
+				}
+				secret_keyNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			aws_secret_keyMap["secret_key"] = secret_keyNestedMap
 		}
 		apiResource.Spec["aws_secret_key"] = aws_secret_keyMap
@@ -1305,6 +1533,29 @@ func (r *CloudCredentialsResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.AzureClientSecret.ClientSecret != nil {
 			client_secretNestedMap := make(map[string]interface{})
+			if data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.Location.IsNull() && !data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.AzureClientSecret.ClientSecret.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				client_secretNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.AzureClientSecret.ClientSecret.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzureClientSecret.ClientSecret.ClearSecretInfo.Provider.IsNull() && !data.AzureClientSecret.ClientSecret.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.AzureClientSecret.ClientSecret.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.AzureClientSecret.ClientSecret.ClearSecretInfo.URL.IsNull() && !data.AzureClientSecret.ClientSecret.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.AzureClientSecret.ClientSecret.ClearSecretInfo.URL.ValueString()
+				}
+				client_secretNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			azure_client_secretMap["client_secret"] = client_secretNestedMap
 		}
 		if !data.AzureClientSecret.SubscriptionID.IsNull() && !data.AzureClientSecret.SubscriptionID.IsUnknown() {
@@ -1325,6 +1576,29 @@ func (r *CloudCredentialsResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.AzurePfxCertificate.Password != nil {
 			passwordNestedMap := make(map[string]interface{})
+			if data.AzurePfxCertificate.Password.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzurePfxCertificate.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AzurePfxCertificate.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.AzurePfxCertificate.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.AzurePfxCertificate.Password.BlindfoldSecretInfo.Location.IsNull() && !data.AzurePfxCertificate.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.AzurePfxCertificate.Password.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.AzurePfxCertificate.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AzurePfxCertificate.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.AzurePfxCertificate.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				passwordNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.AzurePfxCertificate.Password.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzurePfxCertificate.Password.ClearSecretInfo.Provider.IsNull() && !data.AzurePfxCertificate.Password.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.AzurePfxCertificate.Password.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.AzurePfxCertificate.Password.ClearSecretInfo.URL.IsNull() && !data.AzurePfxCertificate.Password.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.AzurePfxCertificate.Password.ClearSecretInfo.URL.ValueString()
+				}
+				passwordNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			azure_pfx_certificateMap["password"] = passwordNestedMap
 		}
 		if !data.AzurePfxCertificate.SubscriptionID.IsNull() && !data.AzurePfxCertificate.SubscriptionID.IsUnknown() {
@@ -1339,6 +1613,29 @@ func (r *CloudCredentialsResource) Update(ctx context.Context, req resource.Upda
 		gcp_cred_fileMap := make(map[string]interface{})
 		if data.GCPCredFile.CredentialFile != nil {
 			credential_fileNestedMap := make(map[string]interface{})
+			if data.GCPCredFile.CredentialFile.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.Location.IsNull() && !data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.GCPCredFile.CredentialFile.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				credential_fileNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.GCPCredFile.CredentialFile.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.GCPCredFile.CredentialFile.ClearSecretInfo.Provider.IsNull() && !data.GCPCredFile.CredentialFile.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.GCPCredFile.CredentialFile.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.GCPCredFile.CredentialFile.ClearSecretInfo.URL.IsNull() && !data.GCPCredFile.CredentialFile.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.GCPCredFile.CredentialFile.ClearSecretInfo.URL.ValueString()
+				}
+				credential_fileNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			gcp_cred_fileMap["credential_file"] = credential_fileNestedMap
 		}
 		apiResource.Spec["gcp_cred_file"] = gcp_cred_fileMap
@@ -1346,7 +1643,7 @@ func (r *CloudCredentialsResource) Update(ctx context.Context, req resource.Upda
 
 	_, err := r.client.UpdateCloudCredentials(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update CloudCredentials: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_credentials", "update"))
 		return
 	}
 
@@ -1357,9 +1654,12 @@ func (r *CloudCredentialsResource) Update(ctx context.Context, req resource.Upda
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetCloudCredentials(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CloudCredentials after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "cloud_credentials", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1561,7 +1861,7 @@ func (r *CloudCredentialsResource) Delete(ctx context.Context, req resource.Dele
 	err := r.client.DeleteCloudCredentials(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "CloudCredentials already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1570,37 +1870,33 @@ func (r *CloudCredentialsResource) Delete(ctx context.Context, req resource.Dele
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "CloudCredentials delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete CloudCredentials: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_credentials", "delete"))
 		return
 	}
 }
 
 func (r *CloudCredentialsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *CloudCredentialsResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("cloud_credentials", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *CloudCredentialsResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListCloudCredentialsList(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *CloudCredentialsResource) requiredNamespace() string {
+	return "system"
 }
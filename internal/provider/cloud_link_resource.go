@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &CloudLinkResource{}
-	_ resource.ResourceWithConfigure      = &CloudLinkResource{}
-	_ resource.ResourceWithImportState    = &CloudLinkResource{}
-	_ resource.ResourceWithModifyPlan     = &CloudLinkResource{}
-	_ resource.ResourceWithValidateConfig = &CloudLinkResource{}
+	_ resource.Resource                     = &CloudLinkResource{}
+	_ resource.ResourceWithConfigure        = &CloudLinkResource{}
+	_ resource.ResourceWithImportState      = &CloudLinkResource{}
+	_ resource.ResourceWithIdentity         = &CloudLinkResource{}
+	_ resource.ResourceWithMoveState        = &CloudLinkResource{}
+	_ resource.ResourceWithModifyPlan       = &CloudLinkResource{}
+	_ resource.ResourceWithValidateConfig   = &CloudLinkResource{}
+	_ resource.ResourceWithConfigValidators = &CloudLinkResource{}
+	_ listableResource                      = &CloudLinkResource{}
 )
 
 func NewCloudLinkResource() resource.Resource {
@@ -141,14 +148,16 @@ var CloudLinkAWSByocConnectionsAuthKeyBlindfoldSecretInfoModelAttrTypes = map[st
 
 // CloudLinkAWSByocConnectionsAuthKeyClearSecretInfoModel represents clear_secret_info block
 type CloudLinkAWSByocConnectionsAuthKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CloudLinkAWSByocConnectionsAuthKeyClearSecretInfoModelAttrTypes defines the attribute types for CloudLinkAWSByocConnectionsAuthKeyClearSecretInfoModel
 var CloudLinkAWSByocConnectionsAuthKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CloudLinkAWSByocConnectionsIpv4Model represents ipv4 block
@@ -252,18 +261,20 @@ var CloudLinkGCPGCPCredModelAttrTypes = map[string]attr.Type{
 }
 
 type CloudLinkResourceModel struct {
-	Name        types.String           `tfsdk:"name"`
-	Namespace   types.String           `tfsdk:"namespace"`
-	Annotations types.Map              `tfsdk:"annotations"`
-	Description types.String           `tfsdk:"description"`
-	Disable     types.Bool             `tfsdk:"disable"`
-	Labels      types.Map              `tfsdk:"labels"`
-	ID          types.String           `tfsdk:"id"`
-	Timeouts    timeouts.Value         `tfsdk:"timeouts"`
-	AWS         *CloudLinkAWSModel     `tfsdk:"aws"`
-	Disabled    *CloudLinkEmptyModel   `tfsdk:"disabled"`
-	Enabled     *CloudLinkEnabledModel `tfsdk:"enabled"`
-	GCP         *CloudLinkGCPModel     `tfsdk:"gcp"`
+	Name           types.String           `tfsdk:"name"`
+	Namespace      types.String           `tfsdk:"namespace"`
+	Annotations    types.Map              `tfsdk:"annotations"`
+	Description    types.String           `tfsdk:"description"`
+	Disable        types.Bool             `tfsdk:"disable"`
+	Labels         types.Map              `tfsdk:"labels"`
+	ID             types.String           `tfsdk:"id"`
+	Timeouts       timeouts.Value         `tfsdk:"timeouts"`
+	SystemMetadata types.Object           `tfsdk:"system_metadata"`
+	LabelsAll      types.Map              `tfsdk:"labels_all"`
+	AWS            *CloudLinkAWSModel     `tfsdk:"aws"`
+	Disabled       *CloudLinkEmptyModel   `tfsdk:"disabled"`
+	Enabled        *CloudLinkEnabledModel `tfsdk:"enabled"`
+	GCP            *CloudLinkGCPModel     `tfsdk:"gcp"`
 }
 
 func (r *CloudLinkResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -285,9 +296,11 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Cloud Link will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Cloud Link will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -319,6 +332,8 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -342,6 +357,10 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -350,6 +369,9 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -358,6 +380,9 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -367,15 +392,26 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 						Blocks: map[string]schema.Block{
 							"connections": schema.ListNestedBlock{
 								MarkdownDescription: "List of Bring You Own Connections. These AWS Direct Connect connections are not managed by F5XC but will be used for connecting sites and REs.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(10),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"bgp_asn": schema.Int64Attribute{
 											MarkdownDescription: "The Border Gateway Protocol (BGP) Autonomous System Number (ASN) of your on-premises router for the new virtual interface to be configured on AWS.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+												int64validator.AtMost(2147483647),
+											},
 										},
 										"connection_id": schema.StringAttribute{
 											MarkdownDescription: "ID of the existing AWS Direct Connect Connection .",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(64),
+												validators.PatternValidator("^(dxcon-)([a-z0-9]{8}|[a-z0-9]{17})$"),
+											},
 										},
 										"region": schema.StringAttribute{
 											MarkdownDescription: "Region where the connection is setup .",
@@ -384,6 +420,9 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 										"user_assigned_name": schema.StringAttribute{
 											MarkdownDescription: "User is managing the AWS resource name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"virtual_interface_type": schema.StringAttribute{
 											MarkdownDescription: "[Enum: PRIVATE] Defines the type of virtual interface that needs to be configured on AWS - PRIVATE: Private A private virtual interface should be used to access an Amazon VPC using private IP addresses. - TRANSIT: Transit A transit virtual interface is a VLAN that transports traffic from a Direct Connect.. The only possible value is `PRIVATE`. Defaults to `PRIVATE`.",
@@ -392,6 +431,10 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 										"vlan": schema.Int64Attribute{
 											MarkdownDescription: "Virtual Local Area Network number for the new virtual interface to be configured on the AWS. This tag is required for any traffic traversing the AWS Direct Connect connection .",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+												int64validator.AtMost(4094),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -424,7 +467,16 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -437,10 +489,16 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 												"aws_router_peer_address": schema.StringAttribute{
 													MarkdownDescription: "The BGP peer IP configured on the AWS endpoint .",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.IPv4PrefixValidator(),
+													},
 												},
 												"router_peer_address": schema.StringAttribute{
 													MarkdownDescription: "The BGP peer IP configured on your (customer) endpoint .",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.IPv4PrefixValidator(),
+													},
 												},
 											},
 										},
@@ -450,10 +508,16 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -479,6 +543,9 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 					"cloudlink_network_name": schema.StringAttribute{
 						MarkdownDescription: "Establish private connectivity with the F5 Distributed Cloud Global Network using a Private ADN network. To provision a Private ADN network, please contact F5 Distributed Cloud support.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 				},
 			},
@@ -492,15 +559,26 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 						Blocks: map[string]schema.Block{
 							"connections": schema.ListNestedBlock{
 								MarkdownDescription: "Each 'Bring Your Own Connection' represents a virtual connection that the customer has provisioned in the Cloud (.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(10),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"interconnect_attachment_name": schema.StringAttribute{
 											MarkdownDescription: "Name of already-existing GCP Cloud Interconnect Attachment .",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(63),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 										"project": schema.StringAttribute{
 											MarkdownDescription: "Specify a GCP Project for the interconnect attachment.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(30),
+												stringvalidator.UTF8LengthAtLeast(4),
+											},
 										},
 										"region": schema.StringAttribute{
 											MarkdownDescription: "GCP Region in which the GCP Cloud Interconnect attachment is configured .",
@@ -514,10 +592,16 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -535,6 +619,10 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -543,6 +631,9 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -551,6 +642,9 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -560,6 +654,11 @@ func (r *CloudLinkResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *CloudLinkResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *CloudLinkResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -584,6 +683,18 @@ func (r *CloudLinkResource) ValidateConfig(ctx context.Context, req resource.Val
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *CloudLinkResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "cloud_provider", "aws", "gcp"),
+		validators.OneOfGroup(path.MatchRelative(), "enable_connection_to_re_choice", "disabled", "enabled"),
+		validators.OneOfGroup(path.MatchRoot("aws").AtName("byoc").AtName("connections").AtAnyListIndex(), "resource_name_choice", "system_generated_name", "user_assigned_name"),
+		validators.OneOfGroup(path.MatchRoot("aws").AtName("byoc").AtName("connections").AtAnyListIndex().AtName("auth_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("gcp").AtName("byoc").AtName("connections").AtAnyListIndex(), "project_choice", "project", "same_as_credential"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *CloudLinkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -594,7 +705,10 @@ func (r *CloudLinkResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "cloud_link", "system", req.Config, &resp.Plan)...)
 		var plan CloudLinkResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -612,7 +726,8 @@ func (r *CloudLinkResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 
 func (r *CloudLinkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data CloudLinkResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -651,6 +766,7 @@ func (r *CloudLinkResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -660,6 +776,7 @@ func (r *CloudLinkResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AWS != nil {
@@ -721,11 +838,15 @@ func (r *CloudLinkResource) Create(ctx context.Context, req resource.CreateReque
 
 	apiResource, err := r.client.CreateCloudLink(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create CloudLink: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_link", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -836,7 +957,7 @@ func (r *CloudLinkResource) Read(ctx context.Context, req resource.ReadRequest,
 	apiResource, err := r.client.GetCloudLink(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "CloudLink not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -844,11 +965,12 @@ func (r *CloudLinkResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CloudLink: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_link", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -859,31 +981,13 @@ func (r *CloudLinkResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -891,6 +995,7 @@ func (r *CloudLinkResource) Read(ctx context.Context, req resource.ReadRequest,
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["aws"].(map[string]interface{}); ok && (isImport || data.AWS != nil) {
 		data.AWS = &CloudLinkAWSModel{
@@ -979,7 +1084,8 @@ func (r *CloudLinkResource) Read(ctx context.Context, req resource.ReadRequest,
 
 func (r *CloudLinkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data CloudLinkResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1013,6 +1119,7 @@ func (r *CloudLinkResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1022,6 +1129,7 @@ func (r *CloudLinkResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AWS != nil {
@@ -1083,7 +1191,7 @@ func (r *CloudLinkResource) Update(ctx context.Context, req resource.UpdateReque
 
 	_, err := r.client.UpdateCloudLink(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update CloudLink: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_link", "update"))
 		return
 	}
 
@@ -1094,9 +1202,12 @@ func (r *CloudLinkResource) Update(ctx context.Context, req resource.UpdateReque
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetCloudLink(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CloudLink after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "cloud_link", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1207,7 +1318,7 @@ func (r *CloudLinkResource) Delete(ctx context.Context, req resource.DeleteReque
 	err := r.client.DeleteCloudLink(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "CloudLink already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1216,37 +1327,33 @@ func (r *CloudLinkResource) Delete(ctx context.Context, req resource.DeleteReque
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "CloudLink delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete CloudLink: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cloud_link", "delete"))
 		return
 	}
 }
 
 func (r *CloudLinkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *CloudLinkResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("cloud_link", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *CloudLinkResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListCloudLinks(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *CloudLinkResource) requiredNamespace() string {
+	return "system"
 }
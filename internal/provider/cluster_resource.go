@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,17 +23,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &ClusterResource{}
-	_ resource.ResourceWithConfigure      = &ClusterResource{}
-	_ resource.ResourceWithImportState    = &ClusterResource{}
-	_ resource.ResourceWithModifyPlan     = &ClusterResource{}
-	_ resource.ResourceWithValidateConfig = &ClusterResource{}
+	_ resource.Resource                     = &ClusterResource{}
+	_ resource.ResourceWithConfigure        = &ClusterResource{}
+	_ resource.ResourceWithImportState      = &ClusterResource{}
+	_ resource.ResourceWithIdentity         = &ClusterResource{}
+	_ resource.ResourceWithMoveState        = &ClusterResource{}
+	_ resource.ResourceWithModifyPlan       = &ClusterResource{}
+	_ resource.ResourceWithValidateConfig   = &ClusterResource{}
+	_ resource.ResourceWithConfigValidators = &ClusterResource{}
+	_ listableResource                      = &ClusterResource{}
 )
 
 func NewClusterResource() resource.Resource {
@@ -344,14 +351,16 @@ var ClusterTLSParametersCommonParamsTLSCertificatesPrivateKeyBlindfoldSecretInfo
 
 // ClusterTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type ClusterTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ClusterTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for ClusterTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModel
 var ClusterTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ClusterTLSParametersCommonParamsValidationParamsModel represents validation_params block
@@ -425,6 +434,8 @@ type ClusterResourceModel struct {
 	LoadBalancerAlgorithm     types.String                           `tfsdk:"loadbalancer_algorithm"`
 	PanicThreshold            types.Int64                            `tfsdk:"panic_threshold"`
 	Timeouts                  timeouts.Value                         `tfsdk:"timeouts"`
+	SystemMetadata            types.Object                           `tfsdk:"system_metadata"`
+	LabelsAll                 types.Map                              `tfsdk:"labels_all"`
 	AutoHTTPConfig            *ClusterEmptyModel                     `tfsdk:"auto_http_config"`
 	CircuitBreaker            *ClusterCircuitBreakerModel            `tfsdk:"circuit_breaker"`
 	DefaultSubset             *ClusterEmptyModel                     `tfsdk:"default_subset"`
@@ -461,9 +472,11 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Cluster will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Cluster will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -502,6 +515,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(600000),
+				},
 			},
 			"endpoint_selection": schema.StringAttribute{
 				MarkdownDescription: "[Enum: DISTRIBUTED|LOCAL_ONLY|LOCAL_PREFERRED] Policy for selection of endpoints from local site/remote site/both Consider both remote and local endpoints for load balancing LOCAL_ONLY: Consider only local endpoints for load balancing Enable this policy to load balance ONLY among locally discovered endpoints Prefer the local endpoints for.. Possible values are `DISTRIBUTED`, `LOCAL_ONLY`, `LOCAL_PREFERRED`. Defaults to `DISTRIBUTED`.",
@@ -526,6 +542,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(600000),
+				},
 			},
 			"loadbalancer_algorithm": schema.StringAttribute{
 				MarkdownDescription: "[Enum: ROUND_ROBIN|LEAST_REQUEST|RING_HASH|RANDOM|LB_OVERRIDE] Different load balancing algorithms supported When a connection to a endpoint in an upstream cluster is required, the load balancer uses loadbalancer_algorithm to determine which host is selected. - ROUND_ROBIN: ROUND_ROBIN Policy in which each healthy/available upstream endpoint is selected in.. Possible values are `ROUND_ROBIN`, `LEAST_REQUEST`, `RING_HASH`, `RANDOM`, `LB_OVERRIDE`. Defaults to `ROUND_ROBIN`.",
@@ -542,7 +561,12 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(100),
+				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -560,14 +584,23 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 					"connection_limit": schema.Int64Attribute{
 						MarkdownDescription: "The maximum number of connections that loadbalancer will establish to all hosts in an upstream cluster. In practice this is only applicable to TCP and HTTP/1.1 clusters since HTTP/2 uses a single connection to each host. Remove endpoint out of load balancing decision, if number of connections..",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(32768),
+						},
 					},
 					"max_requests": schema.Int64Attribute{
 						MarkdownDescription: "The maximum number of requests that can be outstanding to all hosts in a cluster at any given time. In practice this is applicable to HTTP/2 clusters since HTTP/1.1 clusters are governed by the maximum connections (connection_limit). Remove endpoint out of load balancing decision, if requests..",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(32768),
+						},
 					},
 					"pending_requests": schema.Int64Attribute{
 						MarkdownDescription: "The maximum number of requests that will be queued while waiting for a ready connection pool connection. Since HTTP/2 requests are sent over a single connection, this circuit breaker only comes into play as the initial connection is created, as requests will be multiplexed immediately..",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(32768),
+						},
 					},
 					"priority": schema.StringAttribute{
 						MarkdownDescription: "[Enum: DEFAULT|HIGH] Priority routing for each request. Different connection pools are used based on the priority selected for the request. Also, circuit-breaker configuration at destination cluster is chosen based on selected priority. Possible values are `DEFAULT`, `HIGH`. Defaults to `DEFAULT`.",
@@ -576,6 +609,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 					"retries": schema.Int64Attribute{
 						MarkdownDescription: "The maximum number of retries that can be outstanding to all hosts in a cluster at any given time. Remove endpoint out of load balancing decision, if retries for request exceed this count.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(32768),
+						},
 					},
 				},
 			},
@@ -587,18 +623,30 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"endpoint_subsets": schema.ListNestedBlock{
 				MarkdownDescription: "Configure endpoint groups based on metadata labels for traffic routing. Supports weighted distribution and session affinity across labeled endpoints.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"keys": schema.ListAttribute{
 							MarkdownDescription: "List of keys that define a cluster subset class.",
 							Optional:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(16),
+								listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+							},
 						},
 					},
 				},
 			},
 			"endpoints": schema.ListNestedBlock{
 				MarkdownDescription: "List of endpoints for this cluster.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"kind": schema.StringAttribute{
@@ -642,6 +690,10 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"health_checks": schema.ListNestedBlock{
 				MarkdownDescription: "Health check configuration for backend monitoring.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(4),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"kind": schema.StringAttribute{
@@ -725,22 +777,37 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 					"base_ejection_time": schema.Int64Attribute{
 						MarkdownDescription: "The base time that a host is ejected for. The real time is equal to the base time multiplied by the number of times the host has been ejected. This causes hosts to GET ejected for longer periods if they continue to fail.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(1800000),
+						},
 					},
 					"consecutive_5xx": schema.Int64Attribute{
 						MarkdownDescription: "If an upstream endpoint returns some number of consecutive 5xx, it will be ejected. Note that in this case a 5xx means an actual 5xx respond code, or an event that would cause the HTTP router to return one on the upstream’s behalf(reset, connection failure, etc.) consecutive_5xx indicates the..",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(1024),
+						},
 					},
 					"consecutive_gateway_failure": schema.Int64Attribute{
 						MarkdownDescription: "If an upstream endpoint returns some number of consecutive “gateway errors” (502, 503 or 504 status code), it will be ejected. Note that this includes events that would cause the HTTP router to return one of these status codes on the upstream’s behalf (reset, connection failure, etc.)..",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(1024),
+						},
 					},
 					"interval": schema.Int64Attribute{
 						MarkdownDescription: "The time interval between ejection analysis sweeps. This can result in both new ejections as well as endpoints being returned to service. Defaults to `10000ms`.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(600000),
+						},
 					},
 					"max_ejection_percent": schema.Int64Attribute{
 						MarkdownDescription: "The maximum % of an upstream cluster that can be ejected due to outlier detection.  but will eject at least one host regardless of the value. Defaults to `10%`.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(100),
+						},
 					},
 				},
 			},
@@ -756,10 +823,18 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 					"max_session_keys": schema.Int64Attribute{
 						MarkdownDescription: "Number of session keys that are cached.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(2),
+							int64validator.AtMost(64),
+						},
 					},
 					"sni": schema.StringAttribute{
 						MarkdownDescription: "SNI value to be used.",
 						Optional:            true,
+						Validators: []validator.String{
+							validators.HostnameValidator(),
+							stringvalidator.UTF8LengthAtMost(256),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -770,6 +845,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 								MarkdownDescription: "The following list specifies the supported cipher suite TLS_AES_128_GCM_SHA256 TLS_AES_256_GCM_SHA384 TLS_CHACHA20_POLY1305_SHA256 TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256 TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384 TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256 TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256..",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.UniqueValues(),
+								},
 							},
 							"maximum_protocol_version": schema.StringAttribute{
 								MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -834,6 +912,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Inline Root CA Certificate.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
 									},
 									"verify_subject_alt_names": schema.ListAttribute{
 										MarkdownDescription: "List of acceptable Subject Alt Names/CN in the peer's certificate. When skip_hostname_verification is false and verify_subject_alt_names is empty, the hostname of the peer will be used for matching against SAN/CN of peer's certificate.",
@@ -848,6 +929,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 										Blocks: map[string]schema.Block{
 											"trusted_ca_list": schema.ListNestedBlock{
 												MarkdownDescription: "Root CA Certificate Reference. Reference to Root CA Certificate.",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(1),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"kind": schema.StringAttribute{
@@ -902,6 +986,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 								MarkdownDescription: "The following list specifies the supported cipher suite TLS_AES_128_GCM_SHA256 TLS_AES_256_GCM_SHA384 TLS_CHACHA20_POLY1305_SHA256 TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256 TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384 TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256 TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256..",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.UniqueValues(),
+								},
 							},
 							"maximum_protocol_version": schema.StringAttribute{
 								MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -920,6 +1007,10 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -934,6 +1025,11 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -969,7 +1065,16 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -992,6 +1097,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Inline Root CA Certificate.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
 									},
 									"verify_subject_alt_names": schema.ListAttribute{
 										MarkdownDescription: "List of acceptable Subject Alt Names/CN in the peer's certificate. When skip_hostname_verification is false and verify_subject_alt_names is empty, the hostname of the peer will be used for matching against SAN/CN of peer's certificate.",
@@ -1006,6 +1114,9 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 										Blocks: map[string]schema.Block{
 											"trusted_ca_list": schema.ListNestedBlock{
 												MarkdownDescription: "Root CA Certificate Reference. Reference to Root CA Certificate.",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(1),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"kind": schema.StringAttribute{
@@ -1083,6 +1194,11 @@ func (r *ClusterResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *ClusterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *ClusterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -1107,6 +1223,25 @@ func (r *ClusterResource) ValidateConfig(ctx context.Context, req resource.Valid
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *ClusterResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "http_protocol_type", "auto_http_config", "http1_config", "http2_options"),
+		validators.OneOfGroup(path.MatchRelative(), "panic_threshold_type", "no_panic_threshold", "panic_threshold"),
+		validators.OneOfGroup(path.MatchRelative(), "proxy_protocol_type", "disable_proxy_protocol", "proxy_protocol_v1", "proxy_protocol_v2"),
+		validators.OneOfGroup(path.MatchRoot("http1_config").AtName("header_transformation"), "header_transformation_choice", "default_header_transformation", "legacy_header_transformation", "preserve_case_header_transformation", "proper_case_header_transformation"),
+		validators.RequiredOneOfGroup(path.MatchRoot("tls_parameters"), "max_session_keys_type", "default_session_key_caching", "disable_session_key_caching", "max_session_keys"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters"), "sni_choice", "disable_sni", "sni", "use_host_header_as_sni"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters"), "tls_params_choice", "cert_params", "common_params"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters").AtName("cert_params").AtName("validation_params"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters").AtName("common_params").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters").AtName("common_params").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters").AtName("common_params").AtName("validation_params"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("upstream_conn_pool_reuse_type"), "map_downstream_to_upstream_conn_pool_type", "disable_conn_pool_reuse", "enable_conn_pool_reuse"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *ClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -1117,7 +1252,10 @@ func (r *ClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "cluster", "system", req.Config, &resp.Plan)...)
 		var plan ClusterResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -1135,7 +1273,8 @@ func (r *ClusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 
 func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ClusterResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1174,6 +1313,7 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1183,6 +1323,7 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AutoHTTPConfig != nil {
@@ -1289,6 +1430,18 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 		http1_configMap := make(map[string]interface{})
 		if data.Http1Config.HeaderTransformation != nil {
 			header_transformationNestedMap := make(map[string]interface{})
+			if data.Http1Config.HeaderTransformation.DefaultHeaderTransformation != nil {
+				header_transformationNestedMap["default_header_transformation"] = map[string]interface{}{}
+			}
+			if data.Http1Config.HeaderTransformation.LegacyHeaderTransformation != nil {
+				header_transformationNestedMap["legacy_header_transformation"] = map[string]interface{}{}
+			}
+			if data.Http1Config.HeaderTransformation.PreserveCaseHeaderTransformation != nil {
+				header_transformationNestedMap["preserve_case_header_transformation"] = map[string]interface{}{}
+			}
+			if data.Http1Config.HeaderTransformation.ProperCaseHeaderTransformation != nil {
+				header_transformationNestedMap["proper_case_header_transformation"] = map[string]interface{}{}
+			}
 			http1_configMap["header_transformation"] = header_transformationNestedMap
 		}
 		createReq.Spec["http1_config"] = http1_configMap
@@ -1341,6 +1494,27 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 			if !data.TLSParameters.CertParams.MinimumProtocolVersion.IsNull() && !data.TLSParameters.CertParams.MinimumProtocolVersion.IsUnknown() {
 				cert_paramsNestedMap["minimum_protocol_version"] = data.TLSParameters.CertParams.MinimumProtocolVersion.ValueString()
 			}
+			if data.TLSParameters.CertParams.ValidationParams != nil {
+				validation_paramsLevel3Map := make(map[string]interface{})
+				if !data.TLSParameters.CertParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.TLSParameters.CertParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
+					validation_paramsLevel3Map["skip_hostname_verification"] = data.TLSParameters.CertParams.ValidationParams.SkipHostnameVerification.ValueBool()
+				}
+				if data.TLSParameters.CertParams.ValidationParams.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					validation_paramsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.TLSParameters.CertParams.ValidationParams.TrustedCAURL.IsNull() && !data.TLSParameters.CertParams.ValidationParams.TrustedCAURL.IsUnknown() {
+					validation_paramsLevel3Map["trusted_ca_url"] = data.TLSParameters.CertParams.ValidationParams.TrustedCAURL.ValueString()
+				}
+				if !data.TLSParameters.CertParams.ValidationParams.VerifySubjectAltNames.IsNull() && !data.TLSParameters.CertParams.ValidationParams.VerifySubjectAltNames.IsUnknown() {
+					var verify_subject_alt_namesLevel3Items []string
+					diags := data.TLSParameters.CertParams.ValidationParams.VerifySubjectAltNames.ElementsAs(ctx, &verify_subject_alt_namesLevel3Items, false)
+					if !diags.HasError() {
+						validation_paramsLevel3Map["verify_subject_alt_names"] = verify_subject_alt_namesLevel3Items
+					}
+				}
+				cert_paramsNestedMap["validation_params"] = validation_paramsLevel3Map
+			}
 			tls_parametersMap["cert_params"] = cert_paramsNestedMap
 		}
 		if data.TLSParameters.CommonParams != nil {
@@ -1351,6 +1525,27 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 			if !data.TLSParameters.CommonParams.MinimumProtocolVersion.IsNull() && !data.TLSParameters.CommonParams.MinimumProtocolVersion.IsUnknown() {
 				common_paramsNestedMap["minimum_protocol_version"] = data.TLSParameters.CommonParams.MinimumProtocolVersion.ValueString()
 			}
+			if data.TLSParameters.CommonParams.ValidationParams != nil {
+				validation_paramsLevel3Map := make(map[string]interface{})
+				if !data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
+					validation_paramsLevel3Map["skip_hostname_verification"] = data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.ValueBool()
+				}
+				if data.TLSParameters.CommonParams.ValidationParams.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					validation_paramsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.IsUnknown() {
+					validation_paramsLevel3Map["trusted_ca_url"] = data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.ValueString()
+				}
+				if !data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.IsUnknown() {
+					var verify_subject_alt_namesLevel3Items []string
+					diags := data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.ElementsAs(ctx, &verify_subject_alt_namesLevel3Items, false)
+					if !diags.HasError() {
+						validation_paramsLevel3Map["verify_subject_alt_names"] = verify_subject_alt_namesLevel3Items
+					}
+				}
+				common_paramsNestedMap["validation_params"] = validation_paramsLevel3Map
+			}
 			tls_parametersMap["common_params"] = common_paramsNestedMap
 		}
 		if data.TLSParameters.DefaultSessionKeyCaching != nil {
@@ -1404,11 +1599,15 @@ func (r *ClusterResource) Create(ctx context.Context, req resource.CreateRequest
 
 	apiResource, err := r.client.CreateCluster(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Cluster: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cluster", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1973,7 +2172,7 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	apiResource, err := r.client.GetCluster(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Cluster not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1981,11 +2180,12 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Cluster: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cluster", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1996,31 +2196,13 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -2028,6 +2210,7 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["auto_http_config"].(map[string]interface{}); ok && isImport && data.AutoHTTPConfig == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -2570,7 +2753,8 @@ func (r *ClusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data ClusterResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2604,6 +2788,7 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -2613,6 +2798,7 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AutoHTTPConfig != nil {
@@ -2719,6 +2905,18 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		http1_configMap := make(map[string]interface{})
 		if data.Http1Config.HeaderTransformation != nil {
 			header_transformationNestedMap := make(map[string]interface{})
+			if data.Http1Config.HeaderTransformation.DefaultHeaderTransformation != nil {
+				header_transformationNestedMap["default_header_transformation"] = map[string]interface{}{}
+			}
+			if data.Http1Config.HeaderTransformation.LegacyHeaderTransformation != nil {
+				header_transformationNestedMap["legacy_header_transformation"] = map[string]interface{}{}
+			}
+			if data.Http1Config.HeaderTransformation.PreserveCaseHeaderTransformation != nil {
+				header_transformationNestedMap["preserve_case_header_transformation"] = map[string]interface{}{}
+			}
+			if data.Http1Config.HeaderTransformation.ProperCaseHeaderTransformation != nil {
+				header_transformationNestedMap["proper_case_header_transformation"] = map[string]interface{}{}
+			}
 			http1_configMap["header_transformation"] = header_transformationNestedMap
 		}
 		apiResource.Spec["http1_config"] = http1_configMap
@@ -2771,6 +2969,27 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 			if !data.TLSParameters.CertParams.MinimumProtocolVersion.IsNull() && !data.TLSParameters.CertParams.MinimumProtocolVersion.IsUnknown() {
 				cert_paramsNestedMap["minimum_protocol_version"] = data.TLSParameters.CertParams.MinimumProtocolVersion.ValueString()
 			}
+			if data.TLSParameters.CertParams.ValidationParams != nil {
+				validation_paramsLevel3Map := make(map[string]interface{})
+				if !data.TLSParameters.CertParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.TLSParameters.CertParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
+					validation_paramsLevel3Map["skip_hostname_verification"] = data.TLSParameters.CertParams.ValidationParams.SkipHostnameVerification.ValueBool()
+				}
+				if data.TLSParameters.CertParams.ValidationParams.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					validation_paramsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.TLSParameters.CertParams.ValidationParams.TrustedCAURL.IsNull() && !data.TLSParameters.CertParams.ValidationParams.TrustedCAURL.IsUnknown() {
+					validation_paramsLevel3Map["trusted_ca_url"] = data.TLSParameters.CertParams.ValidationParams.TrustedCAURL.ValueString()
+				}
+				if !data.TLSParameters.CertParams.ValidationParams.VerifySubjectAltNames.IsNull() && !data.TLSParameters.CertParams.ValidationParams.VerifySubjectAltNames.IsUnknown() {
+					var verify_subject_alt_namesLevel3Items []string
+					diags := data.TLSParameters.CertParams.ValidationParams.VerifySubjectAltNames.ElementsAs(ctx, &verify_subject_alt_namesLevel3Items, false)
+					if !diags.HasError() {
+						validation_paramsLevel3Map["verify_subject_alt_names"] = verify_subject_alt_namesLevel3Items
+					}
+				}
+				cert_paramsNestedMap["validation_params"] = validation_paramsLevel3Map
+			}
 			tls_parametersMap["cert_params"] = cert_paramsNestedMap
 		}
 		if data.TLSParameters.CommonParams != nil {
@@ -2781,6 +3000,27 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 			if !data.TLSParameters.CommonParams.MinimumProtocolVersion.IsNull() && !data.TLSParameters.CommonParams.MinimumProtocolVersion.IsUnknown() {
 				common_paramsNestedMap["minimum_protocol_version"] = data.TLSParameters.CommonParams.MinimumProtocolVersion.ValueString()
 			}
+			if data.TLSParameters.CommonParams.ValidationParams != nil {
+				validation_paramsLevel3Map := make(map[string]interface{})
+				if !data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
+					validation_paramsLevel3Map["skip_hostname_verification"] = data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.ValueBool()
+				}
+				if data.TLSParameters.CommonParams.ValidationParams.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					validation_paramsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.IsUnknown() {
+					validation_paramsLevel3Map["trusted_ca_url"] = data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.ValueString()
+				}
+				if !data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.IsUnknown() {
+					var verify_subject_alt_namesLevel3Items []string
+					diags := data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.ElementsAs(ctx, &verify_subject_alt_namesLevel3Items, false)
+					if !diags.HasError() {
+						validation_paramsLevel3Map["verify_subject_alt_names"] = verify_subject_alt_namesLevel3Items
+					}
+				}
+				common_paramsNestedMap["validation_params"] = validation_paramsLevel3Map
+			}
 			tls_parametersMap["common_params"] = common_paramsNestedMap
 		}
 		if data.TLSParameters.DefaultSessionKeyCaching != nil {
@@ -2834,7 +3074,7 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	_, err := r.client.UpdateCluster(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Cluster: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cluster", "update"))
 		return
 	}
 
@@ -2845,9 +3085,12 @@ func (r *ClusterResource) Update(ctx context.Context, req resource.UpdateRequest
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetCluster(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Cluster after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "cluster", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["connection_timeout"].(float64); ok {
@@ -3454,7 +3697,7 @@ func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 	err := r.client.DeleteCluster(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Cluster already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -3463,37 +3706,33 @@ func (r *ClusterResource) Delete(ctx context.Context, req resource.DeleteRequest
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "Cluster delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Cluster: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "cluster", "delete"))
 		return
 	}
 }
 
 func (r *ClusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *ClusterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("cluster", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *ClusterResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListClusters(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *ClusterResource) requiredNamespace() string {
+	return "system"
 }
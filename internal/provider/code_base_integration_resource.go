@@ -6,9 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +20,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &CodeBaseIntegrationResource{}
-	_ resource.ResourceWithConfigure      = &CodeBaseIntegrationResource{}
-	_ resource.ResourceWithImportState    = &CodeBaseIntegrationResource{}
-	_ resource.ResourceWithModifyPlan     = &CodeBaseIntegrationResource{}
-	_ resource.ResourceWithValidateConfig = &CodeBaseIntegrationResource{}
+	_ resource.Resource                     = &CodeBaseIntegrationResource{}
+	_ resource.ResourceWithConfigure        = &CodeBaseIntegrationResource{}
+	_ resource.ResourceWithImportState      = &CodeBaseIntegrationResource{}
+	_ resource.ResourceWithIdentity         = &CodeBaseIntegrationResource{}
+	_ resource.ResourceWithMoveState        = &CodeBaseIntegrationResource{}
+	_ resource.ResourceWithModifyPlan       = &CodeBaseIntegrationResource{}
+	_ resource.ResourceWithValidateConfig   = &CodeBaseIntegrationResource{}
+	_ resource.ResourceWithConfigValidators = &CodeBaseIntegrationResource{}
+	_ listableResource                      = &CodeBaseIntegrationResource{}
 )
 
 func NewCodeBaseIntegrationResource() resource.Resource {
@@ -105,14 +110,16 @@ var CodeBaseIntegrationCodeBaseIntegrationAzureReposAccessTokenBlindfoldSecretIn
 
 // CodeBaseIntegrationCodeBaseIntegrationAzureReposAccessTokenClearSecretInfoModel represents clear_secret_info block
 type CodeBaseIntegrationCodeBaseIntegrationAzureReposAccessTokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationAzureReposAccessTokenClearSecretInfoModelAttrTypes defines the attribute types for CodeBaseIntegrationCodeBaseIntegrationAzureReposAccessTokenClearSecretInfoModel
 var CodeBaseIntegrationCodeBaseIntegrationAzureReposAccessTokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationBitbucketModel represents bitbucket block
@@ -155,14 +162,16 @@ var CodeBaseIntegrationCodeBaseIntegrationBitbucketPasswdBlindfoldSecretInfoMode
 
 // CodeBaseIntegrationCodeBaseIntegrationBitbucketPasswdClearSecretInfoModel represents clear_secret_info block
 type CodeBaseIntegrationCodeBaseIntegrationBitbucketPasswdClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationBitbucketPasswdClearSecretInfoModelAttrTypes defines the attribute types for CodeBaseIntegrationCodeBaseIntegrationBitbucketPasswdClearSecretInfoModel
 var CodeBaseIntegrationCodeBaseIntegrationBitbucketPasswdClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationBitbucketServerModel represents bitbucket_server block
@@ -209,14 +218,16 @@ var CodeBaseIntegrationCodeBaseIntegrationBitbucketServerPasswdBlindfoldSecretIn
 
 // CodeBaseIntegrationCodeBaseIntegrationBitbucketServerPasswdClearSecretInfoModel represents clear_secret_info block
 type CodeBaseIntegrationCodeBaseIntegrationBitbucketServerPasswdClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationBitbucketServerPasswdClearSecretInfoModelAttrTypes defines the attribute types for CodeBaseIntegrationCodeBaseIntegrationBitbucketServerPasswdClearSecretInfoModel
 var CodeBaseIntegrationCodeBaseIntegrationBitbucketServerPasswdClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationGithubModel represents github block
@@ -261,14 +272,16 @@ var CodeBaseIntegrationCodeBaseIntegrationGithubAccessTokenBlindfoldSecretInfoMo
 
 // CodeBaseIntegrationCodeBaseIntegrationGithubAccessTokenClearSecretInfoModel represents clear_secret_info block
 type CodeBaseIntegrationCodeBaseIntegrationGithubAccessTokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationGithubAccessTokenClearSecretInfoModelAttrTypes defines the attribute types for CodeBaseIntegrationCodeBaseIntegrationGithubAccessTokenClearSecretInfoModel
 var CodeBaseIntegrationCodeBaseIntegrationGithubAccessTokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationGithubEnterpriseModel represents github_enterprise block
@@ -313,14 +326,16 @@ var CodeBaseIntegrationCodeBaseIntegrationGithubEnterpriseAccessTokenBlindfoldSe
 
 // CodeBaseIntegrationCodeBaseIntegrationGithubEnterpriseAccessTokenClearSecretInfoModel represents clear_secret_info block
 type CodeBaseIntegrationCodeBaseIntegrationGithubEnterpriseAccessTokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationGithubEnterpriseAccessTokenClearSecretInfoModelAttrTypes defines the attribute types for CodeBaseIntegrationCodeBaseIntegrationGithubEnterpriseAccessTokenClearSecretInfoModel
 var CodeBaseIntegrationCodeBaseIntegrationGithubEnterpriseAccessTokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationGitlabModel represents gitlab block
@@ -361,14 +376,16 @@ var CodeBaseIntegrationCodeBaseIntegrationGitlabAccessTokenBlindfoldSecretInfoMo
 
 // CodeBaseIntegrationCodeBaseIntegrationGitlabAccessTokenClearSecretInfoModel represents clear_secret_info block
 type CodeBaseIntegrationCodeBaseIntegrationGitlabAccessTokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationGitlabAccessTokenClearSecretInfoModelAttrTypes defines the attribute types for CodeBaseIntegrationCodeBaseIntegrationGitlabAccessTokenClearSecretInfoModel
 var CodeBaseIntegrationCodeBaseIntegrationGitlabAccessTokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationGitlabEnterpriseModel represents gitlab_enterprise block
@@ -411,14 +428,16 @@ var CodeBaseIntegrationCodeBaseIntegrationGitlabEnterpriseAccessTokenBlindfoldSe
 
 // CodeBaseIntegrationCodeBaseIntegrationGitlabEnterpriseAccessTokenClearSecretInfoModel represents clear_secret_info block
 type CodeBaseIntegrationCodeBaseIntegrationGitlabEnterpriseAccessTokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // CodeBaseIntegrationCodeBaseIntegrationGitlabEnterpriseAccessTokenClearSecretInfoModelAttrTypes defines the attribute types for CodeBaseIntegrationCodeBaseIntegrationGitlabEnterpriseAccessTokenClearSecretInfoModel
 var CodeBaseIntegrationCodeBaseIntegrationGitlabEnterpriseAccessTokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 type CodeBaseIntegrationResourceModel struct {
@@ -430,6 +449,8 @@ type CodeBaseIntegrationResourceModel struct {
 	Labels              types.Map                                    `tfsdk:"labels"`
 	ID                  types.String                                 `tfsdk:"id"`
 	Timeouts            timeouts.Value                               `tfsdk:"timeouts"`
+	SystemMetadata      types.Object                                 `tfsdk:"system_metadata"`
+	LabelsAll           types.Map                                    `tfsdk:"labels_all"`
 	CodeBaseIntegration *CodeBaseIntegrationCodeBaseIntegrationModel `tfsdk:"code_base_integration"`
 }
 
@@ -452,9 +473,11 @@ func (r *CodeBaseIntegrationResource) Schema(ctx context.Context, req resource.S
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Code Base Integration will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Code Base Integration will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -486,6 +509,8 @@ func (r *CodeBaseIntegrationResource) Schema(ctx context.Context, req resource.S
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -531,7 +556,16 @@ func (r *CodeBaseIntegrationResource) Schema(ctx context.Context, req resource.S
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -578,7 +612,16 @@ func (r *CodeBaseIntegrationResource) Schema(ctx context.Context, req resource.S
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -633,7 +676,16 @@ func (r *CodeBaseIntegrationResource) Schema(ctx context.Context, req resource.S
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -684,7 +736,16 @@ func (r *CodeBaseIntegrationResource) Schema(ctx context.Context, req resource.S
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -735,7 +796,16 @@ func (r *CodeBaseIntegrationResource) Schema(ctx context.Context, req resource.S
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -777,7 +847,16 @@ func (r *CodeBaseIntegrationResource) Schema(ctx context.Context, req resource.S
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -824,7 +903,16 @@ func (r *CodeBaseIntegrationResource) Schema(ctx context.Context, req resource.S
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -839,6 +927,11 @@ func (r *CodeBaseIntegrationResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *CodeBaseIntegrationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *CodeBaseIntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -863,6 +956,21 @@ func (r *CodeBaseIntegrationResource) ValidateConfig(ctx context.Context, req re
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *CodeBaseIntegrationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("code_base_integration"), "type", "azure_repos", "bitbucket", "bitbucket_server", "github", "github_enterprise", "gitlab", "gitlab_enterprise"),
+		validators.OneOfGroup(path.MatchRoot("code_base_integration").AtName("azure_repos").AtName("access_token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("code_base_integration").AtName("bitbucket").AtName("passwd"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("code_base_integration").AtName("bitbucket_server").AtName("passwd"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("code_base_integration").AtName("github").AtName("access_token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("code_base_integration").AtName("github_enterprise").AtName("access_token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("code_base_integration").AtName("gitlab").AtName("access_token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("code_base_integration").AtName("gitlab_enterprise").AtName("access_token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *CodeBaseIntegrationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -873,7 +981,10 @@ func (r *CodeBaseIntegrationResource) ModifyPlan(ctx context.Context, req resour
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "code_base_integration", "", req.Config, &resp.Plan)...)
 		var plan CodeBaseIntegrationResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -891,7 +1002,8 @@ func (r *CodeBaseIntegrationResource) ModifyPlan(ctx context.Context, req resour
 
 func (r *CodeBaseIntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data CodeBaseIntegrationResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -930,6 +1042,7 @@ func (r *CodeBaseIntegrationResource) Create(ctx context.Context, req resource.C
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -939,16 +1052,71 @@ func (r *CodeBaseIntegrationResource) Create(ctx context.Context, req resource.C
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.CodeBaseIntegration != nil {
 		code_base_integrationMap := make(map[string]interface{})
 		if data.CodeBaseIntegration.AzureRepos != nil {
 			azure_reposNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.AzureRepos.AccessToken != nil {
+				access_tokenLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					access_tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo.URL.ValueString()
+					}
+					access_tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				azure_reposNestedMap["access_token"] = access_tokenLevel3Map
+			}
 			code_base_integrationMap["azure_repos"] = azure_reposNestedMap
 		}
 		if data.CodeBaseIntegration.Bitbucket != nil {
 			bitbucketNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.Bitbucket.Passwd != nil {
+				passwdLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					passwdLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo.URL.ValueString()
+					}
+					passwdLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				bitbucketNestedMap["passwd"] = passwdLevel3Map
+			}
 			if !data.CodeBaseIntegration.Bitbucket.Username.IsNull() && !data.CodeBaseIntegration.Bitbucket.Username.IsUnknown() {
 				bitbucketNestedMap["username"] = data.CodeBaseIntegration.Bitbucket.Username.ValueString()
 			}
@@ -956,6 +1124,33 @@ func (r *CodeBaseIntegrationResource) Create(ctx context.Context, req resource.C
 		}
 		if data.CodeBaseIntegration.BitbucketServer != nil {
 			bitbucket_serverNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.BitbucketServer.Passwd != nil {
+				passwdLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					passwdLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo.URL.ValueString()
+					}
+					passwdLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				bitbucket_serverNestedMap["passwd"] = passwdLevel3Map
+			}
 			if !data.CodeBaseIntegration.BitbucketServer.URL.IsNull() && !data.CodeBaseIntegration.BitbucketServer.URL.IsUnknown() {
 				bitbucket_serverNestedMap["url"] = data.CodeBaseIntegration.BitbucketServer.URL.ValueString()
 			}
@@ -969,6 +1164,33 @@ func (r *CodeBaseIntegrationResource) Create(ctx context.Context, req resource.C
 		}
 		if data.CodeBaseIntegration.Github != nil {
 			githubNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.Github.AccessToken != nil {
+				access_tokenLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					access_tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo.URL.ValueString()
+					}
+					access_tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				githubNestedMap["access_token"] = access_tokenLevel3Map
+			}
 			if !data.CodeBaseIntegration.Github.Username.IsNull() && !data.CodeBaseIntegration.Github.Username.IsUnknown() {
 				githubNestedMap["username"] = data.CodeBaseIntegration.Github.Username.ValueString()
 			}
@@ -979,6 +1201,33 @@ func (r *CodeBaseIntegrationResource) Create(ctx context.Context, req resource.C
 		}
 		if data.CodeBaseIntegration.GithubEnterprise != nil {
 			github_enterpriseNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.GithubEnterprise.AccessToken != nil {
+				access_tokenLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					access_tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo.URL.ValueString()
+					}
+					access_tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				github_enterpriseNestedMap["access_token"] = access_tokenLevel3Map
+			}
 			if !data.CodeBaseIntegration.GithubEnterprise.Hostname.IsNull() && !data.CodeBaseIntegration.GithubEnterprise.Hostname.IsUnknown() {
 				github_enterpriseNestedMap["hostname"] = data.CodeBaseIntegration.GithubEnterprise.Hostname.ValueString()
 			}
@@ -989,10 +1238,64 @@ func (r *CodeBaseIntegrationResource) Create(ctx context.Context, req resource.C
 		}
 		if data.CodeBaseIntegration.Gitlab != nil {
 			gitlabNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.Gitlab.AccessToken != nil {
+				access_tokenLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					access_tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo.URL.ValueString()
+					}
+					access_tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				gitlabNestedMap["access_token"] = access_tokenLevel3Map
+			}
 			code_base_integrationMap["gitlab"] = gitlabNestedMap
 		}
 		if data.CodeBaseIntegration.GitlabEnterprise != nil {
 			gitlab_enterpriseNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.GitlabEnterprise.AccessToken != nil {
+				access_tokenLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					access_tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo.URL.ValueString()
+					}
+					access_tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				gitlab_enterpriseNestedMap["access_token"] = access_tokenLevel3Map
+			}
 			if !data.CodeBaseIntegration.GitlabEnterprise.URL.IsNull() && !data.CodeBaseIntegration.GitlabEnterprise.URL.IsUnknown() {
 				gitlab_enterpriseNestedMap["url"] = data.CodeBaseIntegration.GitlabEnterprise.URL.ValueString()
 			}
@@ -1003,11 +1306,15 @@ func (r *CodeBaseIntegrationResource) Create(ctx context.Context, req resource.C
 
 	apiResource, err := r.client.CreateCodeBaseIntegration(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create CodeBaseIntegration: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "code_base_integration", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1042,7 +1349,7 @@ func (r *CodeBaseIntegrationResource) Read(ctx context.Context, req resource.Rea
 	apiResource, err := r.client.GetCodeBaseIntegration(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "CodeBaseIntegration not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1050,11 +1357,12 @@ func (r *CodeBaseIntegrationResource) Read(ctx context.Context, req resource.Rea
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CodeBaseIntegration: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "code_base_integration", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1065,31 +1373,13 @@ func (r *CodeBaseIntegrationResource) Read(ctx context.Context, req resource.Rea
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1097,6 +1387,7 @@ func (r *CodeBaseIntegrationResource) Read(ctx context.Context, req resource.Rea
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["code_base_integration"].(map[string]interface{}); ok && isImport && data.CodeBaseIntegration == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -1109,7 +1400,8 @@ func (r *CodeBaseIntegrationResource) Read(ctx context.Context, req resource.Rea
 
 func (r *CodeBaseIntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data CodeBaseIntegrationResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1143,6 +1435,7 @@ func (r *CodeBaseIntegrationResource) Update(ctx context.Context, req resource.U
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1152,16 +1445,71 @@ func (r *CodeBaseIntegrationResource) Update(ctx context.Context, req resource.U
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.CodeBaseIntegration != nil {
 		code_base_integrationMap := make(map[string]interface{})
 		if data.CodeBaseIntegration.AzureRepos != nil {
 			azure_reposNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.AzureRepos.AccessToken != nil {
+				access_tokenLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.AzureRepos.AccessToken.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					access_tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.AzureRepos.AccessToken.ClearSecretInfo.URL.ValueString()
+					}
+					access_tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				azure_reposNestedMap["access_token"] = access_tokenLevel3Map
+			}
 			code_base_integrationMap["azure_repos"] = azure_reposNestedMap
 		}
 		if data.CodeBaseIntegration.Bitbucket != nil {
 			bitbucketNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.Bitbucket.Passwd != nil {
+				passwdLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.Bitbucket.Passwd.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					passwdLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.Bitbucket.Passwd.ClearSecretInfo.URL.ValueString()
+					}
+					passwdLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				bitbucketNestedMap["passwd"] = passwdLevel3Map
+			}
 			if !data.CodeBaseIntegration.Bitbucket.Username.IsNull() && !data.CodeBaseIntegration.Bitbucket.Username.IsUnknown() {
 				bitbucketNestedMap["username"] = data.CodeBaseIntegration.Bitbucket.Username.ValueString()
 			}
@@ -1169,6 +1517,33 @@ func (r *CodeBaseIntegrationResource) Update(ctx context.Context, req resource.U
 		}
 		if data.CodeBaseIntegration.BitbucketServer != nil {
 			bitbucket_serverNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.BitbucketServer.Passwd != nil {
+				passwdLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.BitbucketServer.Passwd.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					passwdLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.BitbucketServer.Passwd.ClearSecretInfo.URL.ValueString()
+					}
+					passwdLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				bitbucket_serverNestedMap["passwd"] = passwdLevel3Map
+			}
 			if !data.CodeBaseIntegration.BitbucketServer.URL.IsNull() && !data.CodeBaseIntegration.BitbucketServer.URL.IsUnknown() {
 				bitbucket_serverNestedMap["url"] = data.CodeBaseIntegration.BitbucketServer.URL.ValueString()
 			}
@@ -1182,6 +1557,33 @@ func (r *CodeBaseIntegrationResource) Update(ctx context.Context, req resource.U
 		}
 		if data.CodeBaseIntegration.Github != nil {
 			githubNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.Github.AccessToken != nil {
+				access_tokenLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.Github.AccessToken.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					access_tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.Github.AccessToken.ClearSecretInfo.URL.ValueString()
+					}
+					access_tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				githubNestedMap["access_token"] = access_tokenLevel3Map
+			}
 			if !data.CodeBaseIntegration.Github.Username.IsNull() && !data.CodeBaseIntegration.Github.Username.IsUnknown() {
 				githubNestedMap["username"] = data.CodeBaseIntegration.Github.Username.ValueString()
 			}
@@ -1192,6 +1594,33 @@ func (r *CodeBaseIntegrationResource) Update(ctx context.Context, req resource.U
 		}
 		if data.CodeBaseIntegration.GithubEnterprise != nil {
 			github_enterpriseNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.GithubEnterprise.AccessToken != nil {
+				access_tokenLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.GithubEnterprise.AccessToken.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					access_tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.GithubEnterprise.AccessToken.ClearSecretInfo.URL.ValueString()
+					}
+					access_tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				github_enterpriseNestedMap["access_token"] = access_tokenLevel3Map
+			}
 			if !data.CodeBaseIntegration.GithubEnterprise.Hostname.IsNull() && !data.CodeBaseIntegration.GithubEnterprise.Hostname.IsUnknown() {
 				github_enterpriseNestedMap["hostname"] = data.CodeBaseIntegration.GithubEnterprise.Hostname.ValueString()
 			}
@@ -1202,10 +1631,64 @@ func (r *CodeBaseIntegrationResource) Update(ctx context.Context, req resource.U
 		}
 		if data.CodeBaseIntegration.Gitlab != nil {
 			gitlabNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.Gitlab.AccessToken != nil {
+				access_tokenLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.Gitlab.AccessToken.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					access_tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.Gitlab.AccessToken.ClearSecretInfo.URL.ValueString()
+					}
+					access_tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				gitlabNestedMap["access_token"] = access_tokenLevel3Map
+			}
 			code_base_integrationMap["gitlab"] = gitlabNestedMap
 		}
 		if data.CodeBaseIntegration.GitlabEnterprise != nil {
 			gitlab_enterpriseNestedMap := make(map[string]interface{})
+			if data.CodeBaseIntegration.GitlabEnterprise.AccessToken != nil {
+				access_tokenLevel3Map := make(map[string]interface{})
+				if data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.Location.IsNull() && !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.CodeBaseIntegration.GitlabEnterprise.AccessToken.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					access_tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo.Provider.IsNull() && !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo.URL.IsNull() && !data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.CodeBaseIntegration.GitlabEnterprise.AccessToken.ClearSecretInfo.URL.ValueString()
+					}
+					access_tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				gitlab_enterpriseNestedMap["access_token"] = access_tokenLevel3Map
+			}
 			if !data.CodeBaseIntegration.GitlabEnterprise.URL.IsNull() && !data.CodeBaseIntegration.GitlabEnterprise.URL.IsUnknown() {
 				gitlab_enterpriseNestedMap["url"] = data.CodeBaseIntegration.GitlabEnterprise.URL.ValueString()
 			}
@@ -1216,7 +1699,7 @@ func (r *CodeBaseIntegrationResource) Update(ctx context.Context, req resource.U
 
 	_, err := r.client.UpdateCodeBaseIntegration(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update CodeBaseIntegration: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "code_base_integration", "update"))
 		return
 	}
 
@@ -1227,9 +1710,12 @@ func (r *CodeBaseIntegrationResource) Update(ctx context.Context, req resource.U
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetCodeBaseIntegration(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CodeBaseIntegration after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "code_base_integration", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1264,7 +1750,7 @@ func (r *CodeBaseIntegrationResource) Delete(ctx context.Context, req resource.D
 	err := r.client.DeleteCodeBaseIntegration(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "CodeBaseIntegration already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1273,37 +1759,33 @@ func (r *CodeBaseIntegrationResource) Delete(ctx context.Context, req resource.D
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "CodeBaseIntegration delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete CodeBaseIntegration: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "code_base_integration", "delete"))
 		return
 	}
 }
 
 func (r *CodeBaseIntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *CodeBaseIntegrationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("code_base_integration", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *CodeBaseIntegrationResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListCodeBaseIntegrations(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *CodeBaseIntegrationResource) requiredNamespace() string {
+	return ""
 }
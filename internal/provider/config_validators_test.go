@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// emptyConfig returns a configuration with every top-level attribute and block null.
+func emptyConfig(ctx context.Context, r resource.Resource) tfsdk.Config {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	objectType := resp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+	}
+	return tfsdk.Config{Schema: resp.Schema, Raw: tftypes.NewValue(objectType, values)}
+}
+
+// TestConfigValidatorsMatchSchemas verifies the generated oneof validators only
+// reference attributes that exist in their resource schema. The empty
+// configuration leaves required top-level groups unset, which is reported as
+// missing configuration rather than a path error.
+func TestConfigValidatorsMatchSchemas(t *testing.T) {
+	ctx := context.Background()
+
+	for _, newResource := range (&F5XCProvider{}).Resources(ctx) {
+		r := newResource()
+		withValidators, ok := r.(resource.ResourceWithConfigValidators)
+		if !ok {
+			continue
+		}
+
+		var meta resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "f5xc"}, &meta)
+
+		t.Run(meta.TypeName, func(t *testing.T) {
+			req := resource.ValidateConfigRequest{Config: emptyConfig(ctx, r)}
+			for _, v := range withValidators.ConfigValidators(ctx) {
+				var resp resource.ValidateConfigResponse
+				v.ValidateResource(ctx, req, &resp)
+				for _, d := range resp.Diagnostics.Errors() {
+					if d.Summary() != "Missing Configuration" {
+						t.Errorf("%s: %v", v.Description(ctx), resp.Diagnostics)
+						break
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestOriginPoolConfigValidatorsConflict(t *testing.T) {
+	ctx := context.Background()
+	r := NewOriginPoolResource()
+	empty := emptyConfig(ctx, r)
+
+	plan := tfsdk.Plan{Schema: empty.Schema, Raw: empty.Raw}
+	diags := plan.SetAttribute(ctx, path.Root("port"), int64(443))
+	diags.Append(plan.SetAttribute(ctx, path.Root("automatic_port"), &OriginPoolEmptyModel{})...)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	config := tfsdk.Config{Schema: plan.Schema, Raw: plan.Raw}
+
+	var resp resource.ValidateConfigResponse
+	for _, v := range r.(resource.ResourceWithConfigValidators).ConfigValidators(ctx) {
+		v.ValidateResource(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+	}
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("expected one conflict for port and automatic_port, got: %v", resp.Diagnostics)
+	}
+}
+
+func TestOriginPoolConfigValidatorsRequired(t *testing.T) {
+	ctx := context.Background()
+	r := NewOriginPoolResource()
+	config := emptyConfig(ctx, r)
+
+	var resp resource.ValidateConfigResponse
+	for _, v := range r.(resource.ResourceWithConfigValidators).ConfigValidators(ctx) {
+		v.ValidateResource(ctx, resource.ValidateConfigRequest{Config: config}, &resp)
+	}
+	if resp.Diagnostics.ErrorsCount() != 1 || resp.Diagnostics.Errors()[0].Summary() != "Missing Configuration" {
+		t.Fatalf("expected one missing port choice, got: %v", resp.Diagnostics)
+	}
+}
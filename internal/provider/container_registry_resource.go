@@ -6,9 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +20,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &ContainerRegistryResource{}
-	_ resource.ResourceWithConfigure      = &ContainerRegistryResource{}
-	_ resource.ResourceWithImportState    = &ContainerRegistryResource{}
-	_ resource.ResourceWithModifyPlan     = &ContainerRegistryResource{}
-	_ resource.ResourceWithValidateConfig = &ContainerRegistryResource{}
+	_ resource.Resource                     = &ContainerRegistryResource{}
+	_ resource.ResourceWithConfigure        = &ContainerRegistryResource{}
+	_ resource.ResourceWithImportState      = &ContainerRegistryResource{}
+	_ resource.ResourceWithIdentity         = &ContainerRegistryResource{}
+	_ resource.ResourceWithMoveState        = &ContainerRegistryResource{}
+	_ resource.ResourceWithModifyPlan       = &ContainerRegistryResource{}
+	_ resource.ResourceWithValidateConfig   = &ContainerRegistryResource{}
+	_ resource.ResourceWithConfigValidators = &ContainerRegistryResource{}
+	_ listableResource                      = &ContainerRegistryResource{}
 )
 
 func NewContainerRegistryResource() resource.Resource {
@@ -73,29 +78,33 @@ var ContainerRegistryPasswordBlindfoldSecretInfoModelAttrTypes = map[string]attr
 
 // ContainerRegistryPasswordClearSecretInfoModel represents clear_secret_info block
 type ContainerRegistryPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ContainerRegistryPasswordClearSecretInfoModelAttrTypes defines the attribute types for ContainerRegistryPasswordClearSecretInfoModel
 var ContainerRegistryPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 type ContainerRegistryResourceModel struct {
-	Name        types.String                    `tfsdk:"name"`
-	Namespace   types.String                    `tfsdk:"namespace"`
-	Annotations types.Map                       `tfsdk:"annotations"`
-	Description types.String                    `tfsdk:"description"`
-	Disable     types.Bool                      `tfsdk:"disable"`
-	Labels      types.Map                       `tfsdk:"labels"`
-	ID          types.String                    `tfsdk:"id"`
-	Email       types.String                    `tfsdk:"email"`
-	Registry    types.String                    `tfsdk:"registry"`
-	UserName    types.String                    `tfsdk:"user_name"`
-	Timeouts    timeouts.Value                  `tfsdk:"timeouts"`
-	Password    *ContainerRegistryPasswordModel `tfsdk:"password"`
+	Name           types.String                    `tfsdk:"name"`
+	Namespace      types.String                    `tfsdk:"namespace"`
+	Annotations    types.Map                       `tfsdk:"annotations"`
+	Description    types.String                    `tfsdk:"description"`
+	Disable        types.Bool                      `tfsdk:"disable"`
+	Labels         types.Map                       `tfsdk:"labels"`
+	ID             types.String                    `tfsdk:"id"`
+	Email          types.String                    `tfsdk:"email"`
+	Registry       types.String                    `tfsdk:"registry"`
+	UserName       types.String                    `tfsdk:"user_name"`
+	Timeouts       timeouts.Value                  `tfsdk:"timeouts"`
+	SystemMetadata types.Object                    `tfsdk:"system_metadata"`
+	LabelsAll      types.Map                       `tfsdk:"labels_all"`
+	Password       *ContainerRegistryPasswordModel `tfsdk:"password"`
 }
 
 func (r *ContainerRegistryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -117,9 +126,11 @@ func (r *ContainerRegistryResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Container Registry will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Container Registry will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -158,6 +169,9 @@ func (r *ContainerRegistryResource) Schema(ctx context.Context, req resource.Sch
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					validators.EmailValidator(),
+				},
 			},
 			"registry": schema.StringAttribute{
 				MarkdownDescription: "Fully qualified name of the registry login server .",
@@ -166,6 +180,9 @@ func (r *ContainerRegistryResource) Schema(ctx context.Context, req resource.Sch
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					validators.HostnameValidator(),
+				},
 			},
 			"user_name": schema.StringAttribute{
 				MarkdownDescription: "Username used to access the registry .",
@@ -174,7 +191,12 @@ func (r *ContainerRegistryResource) Schema(ctx context.Context, req resource.Sch
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(128),
+				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -212,7 +234,16 @@ func (r *ContainerRegistryResource) Schema(ctx context.Context, req resource.Sch
 								Optional:            true,
 							},
 							"url": schema.StringAttribute{
-								MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+								MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+								Optional:            true,
+								Sensitive:           true,
+								WriteOnly:           true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+								},
+							},
+							"url_version": schema.Int64Attribute{
+								MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 								Optional:            true,
 							},
 						},
@@ -223,6 +254,11 @@ func (r *ContainerRegistryResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *ContainerRegistryResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *ContainerRegistryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -247,6 +283,14 @@ func (r *ContainerRegistryResource) ValidateConfig(ctx context.Context, req reso
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *ContainerRegistryResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *ContainerRegistryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -257,7 +301,10 @@ func (r *ContainerRegistryResource) ModifyPlan(ctx context.Context, req resource
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "container_registry", "", req.Config, &resp.Plan)...)
 		var plan ContainerRegistryResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -275,7 +322,8 @@ func (r *ContainerRegistryResource) ModifyPlan(ctx context.Context, req resource
 
 func (r *ContainerRegistryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ContainerRegistryResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -314,6 +362,7 @@ func (r *ContainerRegistryResource) Create(ctx context.Context, req resource.Cre
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -323,6 +372,7 @@ func (r *ContainerRegistryResource) Create(ctx context.Context, req resource.Cre
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.Password != nil {
@@ -364,11 +414,15 @@ func (r *ContainerRegistryResource) Create(ctx context.Context, req resource.Cre
 
 	apiResource, err := r.client.CreateContainerRegistry(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ContainerRegistry: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "container_registry", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -418,7 +472,7 @@ func (r *ContainerRegistryResource) Read(ctx context.Context, req resource.ReadR
 	apiResource, err := r.client.GetContainerRegistry(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "ContainerRegistry not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -426,11 +480,12 @@ func (r *ContainerRegistryResource) Read(ctx context.Context, req resource.ReadR
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ContainerRegistry: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "container_registry", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -441,31 +496,13 @@ func (r *ContainerRegistryResource) Read(ctx context.Context, req resource.ReadR
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -473,6 +510,7 @@ func (r *ContainerRegistryResource) Read(ctx context.Context, req resource.ReadR
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["password"].(map[string]interface{}); ok && isImport && data.Password == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -500,7 +538,8 @@ func (r *ContainerRegistryResource) Read(ctx context.Context, req resource.ReadR
 
 func (r *ContainerRegistryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data ContainerRegistryResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -534,6 +573,7 @@ func (r *ContainerRegistryResource) Update(ctx context.Context, req resource.Upd
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -543,6 +583,7 @@ func (r *ContainerRegistryResource) Update(ctx context.Context, req resource.Upd
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.Password != nil {
@@ -584,7 +625,7 @@ func (r *ContainerRegistryResource) Update(ctx context.Context, req resource.Upd
 
 	_, err := r.client.UpdateContainerRegistry(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ContainerRegistry: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "container_registry", "update"))
 		return
 	}
 
@@ -595,9 +636,12 @@ func (r *ContainerRegistryResource) Update(ctx context.Context, req resource.Upd
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetContainerRegistry(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ContainerRegistry after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "container_registry", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["email"].(string); ok && v != "" {
@@ -668,7 +712,7 @@ func (r *ContainerRegistryResource) Delete(ctx context.Context, req resource.Del
 	err := r.client.DeleteContainerRegistry(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "ContainerRegistry already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -677,37 +721,33 @@ func (r *ContainerRegistryResource) Delete(ctx context.Context, req resource.Del
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "ContainerRegistry delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ContainerRegistry: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "container_registry", "delete"))
 		return
 	}
 }
 
 func (r *ContainerRegistryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *ContainerRegistryResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("container_registry", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *ContainerRegistryResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListContainerRegistries(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *ContainerRegistryResource) requiredNamespace() string {
+	return ""
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,22 @@ type CRLDataSource struct {
 	client *client.Client
 }
 
+// CRLDataSourceModel mirrors CRLResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type CRLDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata  types.Object        `tfsdk:"system_metadata"`
+	Name            types.String        `tfsdk:"name"`
+	Namespace       types.String        `tfsdk:"namespace"`
+	Annotations     types.Map           `tfsdk:"annotations"`
+	Description     types.String        `tfsdk:"description"`
+	Disable         types.Bool          `tfsdk:"disable"`
+	Labels          types.Map           `tfsdk:"labels"`
+	ID              types.String        `tfsdk:"id"`
+	RefreshInterval types.Int64         `tfsdk:"refresh_interval"`
+	ServerAddress   types.String        `tfsdk:"server_address"`
+	ServerPort      types.Int64         `tfsdk:"server_port"`
+	Timeout         types.Int64         `tfsdk:"timeout"`
+	HTTPAccess      *CRLHTTPAccessModel `tfsdk:"http_access"`
 }
 
 func (d *CRLDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +49,7 @@ func (d *CRLDataSource) Metadata(ctx context.Context, req datasource.MetadataReq
 }
 
 func (d *CRLDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a CRL resource in F5 Distributed Cloud for api to create crl object. configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the CRL.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the CRL exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the CRL.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewCRLResource())
 }
 
 func (d *CRLDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +71,25 @@ func (d *CRLDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		return
 	}
 
-	resource, err := d.client.GetCRL(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetCRL(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read CRL: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "crl", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +103,8 @@ func (d *CRLDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +113,40 @@ func (d *CRLDataSource) Read(ctx context.Context, req datasource.ReadRequest, re
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["http_access"].(map[string]interface{}); ok && (isImport || data.HTTPAccess != nil) {
+		data.HTTPAccess = &CRLHTTPAccessModel{
+			Path: func() types.String {
+				if v, ok := blockData["path"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if v, ok := apiResource.Spec["refresh_interval"].(float64); ok {
+		data.RefreshInterval = types.Int64Value(int64(v))
+	} else {
+		data.RefreshInterval = types.Int64Null()
+	}
+	if v, ok := apiResource.Spec["server_address"].(string); ok && v != "" {
+		data.ServerAddress = types.StringValue(v)
+	} else {
+		data.ServerAddress = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["server_port"].(float64); ok {
+		data.ServerPort = types.Int64Value(int64(v))
+	} else {
+		data.ServerPort = types.Int64Null()
+	}
+	if v, ok := apiResource.Spec["timeout"].(float64); ok {
+		data.Timeout = types.Int64Value(int64(v))
+	} else {
+		data.Timeout = types.Int64Null()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,20 @@ type DataGroupDataSource struct {
 	client *client.Client
 }
 
+// DataGroupDataSourceModel mirrors DataGroupResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type DataGroupDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object                  `tfsdk:"system_metadata"`
+	Name           types.String                  `tfsdk:"name"`
+	Namespace      types.String                  `tfsdk:"namespace"`
+	Annotations    types.Map                     `tfsdk:"annotations"`
+	Description    types.String                  `tfsdk:"description"`
+	Disable        types.Bool                    `tfsdk:"disable"`
+	Labels         types.Map                     `tfsdk:"labels"`
+	ID             types.String                  `tfsdk:"id"`
+	AddressRecords *DataGroupAddressRecordsModel `tfsdk:"address_records"`
+	IntegerRecords *DataGroupIntegerRecordsModel `tfsdk:"integer_records"`
+	StringRecords  *DataGroupStringRecordsModel  `tfsdk:"string_records"`
 }
 
 func (d *DataGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +47,7 @@ func (d *DataGroupDataSource) Metadata(ctx context.Context, req datasource.Metad
 }
 
 func (d *DataGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages data group in a given namespace. If one already exists it will give an error. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the DataGroup.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the DataGroup exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the DataGroup.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewDataGroupResource())
 }
 
 func (d *DataGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +69,25 @@ func (d *DataGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	resource, err := d.client.GetDataGroup(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetDataGroup(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DataGroup: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "data_group", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +101,8 @@ func (d *DataGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +111,25 @@ func (d *DataGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["address_records"].(map[string]interface{}); ok && isImport && data.AddressRecords == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AddressRecords = &DataGroupAddressRecordsModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["integer_records"].(map[string]interface{}); ok && isImport && data.IntegerRecords == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.IntegerRecords = &DataGroupIntegerRecordsModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["string_records"].(map[string]interface{}); ok && isImport && data.StringRecords == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.StringRecords = &DataGroupStringRecordsModel{}
+	}
+	// Normal Read: preserve existing state value
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,397 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// data_source_schema.go - Manually maintained helpers for data source schemas.
+// This file is NOT auto-generated. Generated data sources derive their schema
+// from the matching resource schema so both always expose the same object spec.
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	rschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// dataSourceLookupAttributes are the attributes a data source is configured
+// with to locate the object. Every other attribute is computed.
+var dataSourceLookupAttributes = map[string]bool{
+	"name":      true,
+	"namespace": true,
+}
+
+// resourceOnlyAttributes are resource attributes that only concern how the
+// resource manages its object, such as waiting for it or the labels merged
+// from default_labels. They are left out of data source schemas.
+var resourceOnlyAttributes = map[string]bool{
+	"labels_all":     true,
+	"wait_for_state": true,
+}
+
+// dataSourceSchemaFromResource converts a resource schema into the equivalent
+// data source schema. Lookup attributes stay required, everything else becomes
+// computed, nested blocks become computed nested attributes, and the timeouts
+// block and resource-only attributes are dropped. Write-only attributes become
+// computed attributes read from the API, and their version companions are
+// dropped.
+func dataSourceSchemaFromResource(ctx context.Context, r resource.Resource) dsschema.Schema {
+	return convertResourceSchema(ctx, r, false)
+}
+
+// dataSourceModelSchema returns the data source schema that still has the
+// version companions of write-only attributes. Its object types match the
+// resource model, so the same nested model structs can be used to populate
+// data source state.
+func dataSourceModelSchema(ctx context.Context, r resource.Resource) dsschema.Schema {
+	return convertResourceSchema(ctx, r, true)
+}
+
+// convertResourceSchema converts a resource schema into a data source schema,
+// with or without the version companions of write-only attributes.
+func convertResourceSchema(ctx context.Context, r resource.Resource, withWriteOnlyVersions bool) dsschema.Schema {
+	var resp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resp)
+
+	attributes := make(map[string]dsschema.Attribute, len(resp.Schema.Attributes)+len(resp.Schema.Blocks))
+	for name, attr := range resp.Schema.Attributes {
+		if resourceOnlyAttributes[name] {
+			continue
+		}
+		if !withWriteOnlyVersions && isWriteOnlyVersion(name, resp.Schema.Attributes) {
+			continue
+		}
+		if dataSourceLookupAttributes[name] {
+			if s, ok := attr.(rschema.StringAttribute); ok {
+				attributes[name] = dsschema.StringAttribute{
+					MarkdownDescription: s.MarkdownDescription,
+					Description:         s.Description,
+					Required:            true,
+					Validators:          s.Validators,
+				}
+				continue
+			}
+		}
+		if converted := convertAttribute(attr, withWriteOnlyVersions); converted != nil {
+			attributes[name] = converted
+		}
+	}
+	for name, block := range resp.Schema.Blocks {
+		if name == "timeouts" {
+			continue
+		}
+		if converted := convertBlock(block, withWriteOnlyVersions); converted != nil {
+			attributes[name] = converted
+		}
+	}
+
+	return dsschema.Schema{
+		MarkdownDescription: resp.Schema.MarkdownDescription,
+		Description:         resp.Schema.Description,
+		DeprecationMessage:  resp.Schema.DeprecationMessage,
+		Attributes:          attributes,
+	}
+}
+
+// isWriteOnlyVersion returns true if the attribute is the version companion
+// of a write-only attribute in attrs.
+func isWriteOnlyVersion(name string, attrs map[string]rschema.Attribute) bool {
+	secret, ok := strings.CutSuffix(name, "_version")
+	if !ok {
+		return false
+	}
+	s, ok := attrs[secret].(rschema.StringAttribute)
+	return ok && s.WriteOnly
+}
+
+// withoutWriteOnlyNote removes the note on how to update a write-only
+// attribute from its description, since the data source only reads it.
+func withoutWriteOnlyNote(description string) string {
+	if i := strings.Index(description, "Write-only, requires Terraform"); i >= 0 {
+		return strings.TrimSpace(description[:i])
+	}
+	return description
+}
+
+// convertAttributes converts a set of resource attributes and blocks into
+// computed data source attributes.
+func convertAttributes(attrs map[string]rschema.Attribute, blocks map[string]rschema.Block, withWriteOnlyVersions bool) map[string]dsschema.Attribute {
+	result := make(map[string]dsschema.Attribute, len(attrs)+len(blocks))
+	for name, attr := range attrs {
+		if !withWriteOnlyVersions && isWriteOnlyVersion(name, attrs) {
+			continue
+		}
+		if converted := convertAttribute(attr, withWriteOnlyVersions); converted != nil {
+			result[name] = converted
+		}
+	}
+	for name, block := range blocks {
+		if converted := convertBlock(block, withWriteOnlyVersions); converted != nil {
+			result[name] = converted
+		}
+	}
+	return result
+}
+
+// dataSourceAttribute converts a single resource attribute into a computed data
+// source attribute. Unsupported attribute types return nil.
+func dataSourceAttribute(attr rschema.Attribute) dsschema.Attribute {
+	return convertAttribute(attr, false)
+}
+
+// convertAttribute converts a single resource attribute into a computed data
+// source attribute, with or without the version companions of nested
+// write-only attributes. Unsupported attribute types return nil.
+func convertAttribute(attr rschema.Attribute, withWriteOnlyVersions bool) dsschema.Attribute {
+	switch a := attr.(type) {
+	case rschema.StringAttribute:
+		if a.WriteOnly {
+			return dsschema.StringAttribute{
+				MarkdownDescription: withoutWriteOnlyNote(a.MarkdownDescription),
+				Description:         withoutWriteOnlyNote(a.Description),
+				Sensitive:           true,
+				Computed:            true,
+			}
+		}
+		return dsschema.StringAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}
+	case rschema.BoolAttribute:
+		return dsschema.BoolAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}
+	case rschema.Int64Attribute:
+		return dsschema.Int64Attribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}
+	case rschema.Float64Attribute:
+		return dsschema.Float64Attribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}
+	case rschema.NumberAttribute:
+		return dsschema.NumberAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			Computed:            true,
+		}
+	case rschema.ListAttribute:
+		return dsschema.ListAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			ElementType:         a.ElementType,
+			Computed:            true,
+		}
+	case rschema.SetAttribute:
+		return dsschema.SetAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			ElementType:         a.ElementType,
+			Computed:            true,
+		}
+	case rschema.MapAttribute:
+		return dsschema.MapAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			ElementType:         a.ElementType,
+			Computed:            true,
+		}
+	case rschema.ObjectAttribute:
+		return dsschema.ObjectAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			AttributeTypes:      a.AttributeTypes,
+			Computed:            true,
+		}
+	case rschema.SingleNestedAttribute:
+		return dsschema.SingleNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			Attributes:          convertAttributes(a.Attributes, nil, withWriteOnlyVersions),
+			Computed:            true,
+		}
+	case rschema.ListNestedAttribute:
+		return dsschema.ListNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			NestedObject: dsschema.NestedAttributeObject{
+				Attributes: convertAttributes(a.NestedObject.Attributes, nil, withWriteOnlyVersions),
+			},
+			Computed: true,
+		}
+	case rschema.SetNestedAttribute:
+		return dsschema.SetNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			NestedObject: dsschema.NestedAttributeObject{
+				Attributes: convertAttributes(a.NestedObject.Attributes, nil, withWriteOnlyVersions),
+			},
+			Computed: true,
+		}
+	case rschema.MapNestedAttribute:
+		return dsschema.MapNestedAttribute{
+			MarkdownDescription: a.MarkdownDescription,
+			Description:         a.Description,
+			Sensitive:           a.Sensitive,
+			NestedObject: dsschema.NestedAttributeObject{
+				Attributes: convertAttributes(a.NestedObject.Attributes, nil, withWriteOnlyVersions),
+			},
+			Computed: true,
+		}
+	}
+	return nil
+}
+
+// convertBlock converts a resource block into a computed nested data source
+// attribute. Data source blocks cannot be computed, so nested blocks are
+// always exposed as nested attributes with the same object type.
+func convertBlock(block rschema.Block, withWriteOnlyVersions bool) dsschema.Attribute {
+	switch b := block.(type) {
+	case rschema.SingleNestedBlock:
+		return dsschema.SingleNestedAttribute{
+			MarkdownDescription: b.MarkdownDescription,
+			Description:         b.Description,
+			Attributes:          convertAttributes(b.Attributes, b.Blocks, withWriteOnlyVersions),
+			Computed:            true,
+		}
+	case rschema.ListNestedBlock:
+		return dsschema.ListNestedAttribute{
+			MarkdownDescription: b.MarkdownDescription,
+			Description:         b.Description,
+			NestedObject: dsschema.NestedAttributeObject{
+				Attributes: convertAttributes(b.NestedObject.Attributes, b.NestedObject.Blocks, withWriteOnlyVersions),
+			},
+			Computed: true,
+		}
+	case rschema.SetNestedBlock:
+		return dsschema.SetNestedAttribute{
+			MarkdownDescription: b.MarkdownDescription,
+			Description:         b.Description,
+			NestedObject: dsschema.NestedAttributeObject{
+				Attributes: convertAttributes(b.NestedObject.Attributes, b.NestedObject.Blocks, withWriteOnlyVersions),
+			},
+			Computed: true,
+		}
+	}
+	return nil
+}
+
+// setDataSourceState sets the state of a data source from a model that uses
+// the nested model types of the resource. Those also hold the version
+// companions of write-only attributes, so the model is converted with
+// dataSourceModelSchema and the companions are dropped afterwards.
+func setDataSourceState(ctx context.Context, state *tfsdk.State, r resource.Resource, model interface{}) diag.Diagnostics {
+	modelSchema := dataSourceModelSchema(ctx, r)
+	modelState := tfsdk.State{
+		Schema: modelSchema,
+		Raw:    tftypes.NewValue(modelSchema.Type().TerraformType(ctx), nil),
+	}
+	diags := modelState.Set(ctx, model)
+	if diags.HasError() {
+		return diags
+	}
+
+	raw, err := valueWithType(modelState.Raw, state.Schema.Type().TerraformType(ctx))
+	if err != nil {
+		diags.AddError("Data Source State Conversion Error", err.Error())
+		return diags
+	}
+	state.Raw = raw
+	return diags
+}
+
+// valueWithType converts v to typ, which has the same shape as the type of v
+// except that its objects may have fewer attributes. Object attributes that
+// typ does not have are dropped.
+func valueWithType(v tftypes.Value, typ tftypes.Type) (tftypes.Value, error) {
+	if v.IsNull() {
+		return tftypes.NewValue(typ, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(typ, tftypes.UnknownValue), nil
+	}
+
+	switch t := typ.(type) {
+	case tftypes.Object:
+		var attrs map[string]tftypes.Value
+		if err := v.As(&attrs); err != nil {
+			return tftypes.Value{}, err
+		}
+		result := make(map[string]tftypes.Value, len(t.AttributeTypes))
+		for name, attrType := range t.AttributeTypes {
+			converted, err := valueWithType(attrs[name], attrType)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			result[name] = converted
+		}
+		return tftypes.NewValue(t, result), nil
+	case tftypes.List:
+		elems, err := elementsWithType(v, t.ElementType)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		return tftypes.NewValue(t, elems), nil
+	case tftypes.Set:
+		elems, err := elementsWithType(v, t.ElementType)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+		return tftypes.NewValue(t, elems), nil
+	case tftypes.Map:
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return tftypes.Value{}, err
+		}
+		result := make(map[string]tftypes.Value, len(elems))
+		for key, elem := range elems {
+			converted, err := valueWithType(elem, t.ElementType)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+			result[key] = converted
+		}
+		return tftypes.NewValue(t, result), nil
+	}
+	return v, nil
+}
+
+// elementsWithType converts the elements of a list or set value to typ
+func elementsWithType(v tftypes.Value, typ tftypes.Type) ([]tftypes.Value, error) {
+	var elems []tftypes.Value
+	if err := v.As(&elems); err != nil {
+		return nil, err
+	}
+	result := make([]tftypes.Value, 0, len(elems))
+	for _, elem := range elems {
+		converted, err := valueWithType(elem, typ)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, converted)
+	}
+	return result, nil
+}
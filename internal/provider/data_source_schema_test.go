@@ -0,0 +1,240 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	dsschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+func TestDataSourceSchemaFromResource(t *testing.T) {
+	ctx := context.Background()
+	s := dataSourceSchemaFromResource(ctx, NewOriginPoolResource())
+
+	for _, name := range []string{"name", "namespace"} {
+		attr, ok := s.Attributes[name].(dsschema.StringAttribute)
+		if !ok {
+			t.Fatalf("expected %s to be a string attribute, got %T", name, s.Attributes[name])
+		}
+		if !attr.Required || attr.Computed {
+			t.Errorf("expected %s to be required and not computed", name)
+		}
+	}
+
+	if _, ok := s.Attributes["timeouts"]; ok {
+		t.Error("expected timeouts block to be dropped")
+	}
+
+	servers, ok := s.Attributes["origin_servers"].(dsschema.ListNestedAttribute)
+	if !ok {
+		t.Fatalf("expected origin_servers to be a list nested attribute, got %T", s.Attributes["origin_servers"])
+	}
+	if !servers.Computed {
+		t.Error("expected origin_servers to be computed")
+	}
+	if _, ok := servers.NestedObject.Attributes["public_ip"].(dsschema.SingleNestedAttribute); !ok {
+		t.Errorf("expected nested block public_ip to become a single nested attribute, got %T", servers.NestedObject.Attributes["public_ip"])
+	}
+
+	if _, ok := s.Attributes["use_tls"].(dsschema.SingleNestedAttribute); !ok {
+		t.Errorf("expected use_tls to be a single nested attribute, got %T", s.Attributes["use_tls"])
+	}
+}
+
+func TestDataSourceSchemaWriteOnlyAttributes(t *testing.T) {
+	ctx := context.Background()
+	s := dataSourceSchemaFromResource(ctx, NewOIDCProviderResource())
+
+	spec, ok := s.Attributes["azure_oidc_spec_type"].(dsschema.SingleNestedAttribute)
+	if !ok {
+		t.Fatalf("expected azure_oidc_spec_type to be a single nested attribute, got %T", s.Attributes["azure_oidc_spec_type"])
+	}
+	secret, ok := spec.Attributes["client_secret"].(dsschema.StringAttribute)
+	if !ok {
+		t.Fatalf("expected client_secret to be a string attribute, got %T", spec.Attributes["client_secret"])
+	}
+	if !secret.Computed || !secret.Sensitive {
+		t.Error("expected client_secret to be computed and sensitive")
+	}
+	if strings.Contains(secret.MarkdownDescription, "Write-only") {
+		t.Errorf("expected client_secret description without the write-only note, got %q", secret.MarkdownDescription)
+	}
+	if _, ok := spec.Attributes["client_secret_version"]; ok {
+		t.Error("expected client_secret_version to be dropped")
+	}
+
+	modelSpec := dataSourceModelSchema(ctx, NewOIDCProviderResource()).Attributes["azure_oidc_spec_type"].(dsschema.SingleNestedAttribute)
+	if _, ok := modelSpec.Attributes["client_secret_version"]; !ok {
+		t.Error("expected client_secret_version in the model schema")
+	}
+}
+
+func TestDataSourceReadsWriteOnlyAttributes(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"metadata": {"name": "example", "namespace": "example"}, "spec": {"azure_oidc_spec_type": {"client_id": "id", "client_secret": "secret"}}}`))
+	}))
+	defer server.Close()
+
+	d := NewOIDCProviderDataSource().(*OIDCProviderDataSource)
+	d.client = client.NewClient(server.URL, "token")
+
+	var schemaResp datasource.SchemaResponse
+	d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+	req := datasource.ReadRequest{Config: minimalDataSourceConfig(ctx, schemaResp.Schema)}
+	resp := datasource.ReadResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+	}}
+	d.Read(ctx, req, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read() diagnostics = %v", resp.Diagnostics)
+	}
+
+	var secret types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("azure_oidc_spec_type").AtName("client_secret"), &secret)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("GetAttribute() diagnostics = %v", resp.Diagnostics)
+	}
+	if secret.ValueString() != "secret" {
+		t.Errorf("expected client_secret %q, got %q", "secret", secret.ValueString())
+	}
+}
+
+// TestDataSourceSchemasMatchResources verifies the model schema of every
+// generated data source has the same attribute types as its resource, so the
+// shared nested model structs can populate data source state.
+func TestDataSourceSchemasMatchResources(t *testing.T) {
+	ctx := context.Background()
+	p := &F5XCProvider{}
+
+	resources := make(map[string]resource.Resource)
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		var resp resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "f5xc"}, &resp)
+		resources[resp.TypeName] = r
+	}
+
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+		var meta datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "f5xc"}, &meta)
+
+		r, ok := resources[meta.TypeName]
+		if !ok {
+			continue
+		}
+
+		t.Run(meta.TypeName, func(t *testing.T) {
+			var dsResp datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &dsResp)
+			if diags := dsResp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("invalid data source schema: %v", diags)
+			}
+
+			var rResp resource.SchemaResponse
+			r.Schema(ctx, resource.SchemaRequest{}, &rResp)
+			modelSchema := dataSourceModelSchema(ctx, r)
+
+			for name, attr := range rResp.Schema.Attributes {
+				dsAttr, ok := modelSchema.Attributes[name]
+				if resourceOnlyAttributes[name] {
+					if ok {
+						t.Errorf("resource-only attribute %s in data source", name)
+					}
+					continue
+				}
+				if !ok {
+					t.Errorf("attribute %s missing from data source", name)
+					continue
+				}
+				if !dsAttr.GetType().Equal(attr.GetType()) {
+					t.Errorf("attribute %s type mismatch: %s != %s", name, dsAttr.GetType(), attr.GetType())
+				}
+			}
+			for name, block := range rResp.Schema.Blocks {
+				if name == "timeouts" {
+					continue
+				}
+				dsAttr, ok := modelSchema.Attributes[name]
+				if !ok {
+					t.Errorf("block %s missing from data source", name)
+					continue
+				}
+				if !dsAttr.GetType().Equal(block.Type()) {
+					t.Errorf("block %s type mismatch: %s != %s", name, dsAttr.GetType(), block.Type())
+				}
+			}
+		})
+	}
+}
+
+// minimalDataSourceConfig returns a configuration of a data source schema
+// with its required string attributes set and everything else null
+func minimalDataSourceConfig(ctx context.Context, s dsschema.Schema) tfsdk.Config {
+	objectType := s.Type().TerraformType(ctx).(tftypes.Object)
+	values := make(map[string]tftypes.Value, len(objectType.AttributeTypes))
+	for name, attrType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attrType, nil)
+		if attr, ok := s.Attributes[name]; ok && attr.IsRequired() && attrType.Is(tftypes.String) {
+			values[name] = tftypes.NewValue(tftypes.String, "example")
+		}
+	}
+	return tfsdk.Config{Schema: s, Raw: tftypes.NewValue(objectType, values)}
+}
+
+// TestDataSourcesRead runs Read of every data source against an API that
+// returns an empty object, so that every attribute of the data source schema
+// must have a field in the data source model.
+func TestDataSourcesRead(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"metadata": {"name": "example", "namespace": "example"}, "spec": {}, "items": []}`))
+	}))
+	defer server.Close()
+
+	p := &F5XCProvider{}
+	for _, newDataSource := range p.DataSources(ctx) {
+		d := newDataSource()
+		var meta datasource.MetadataResponse
+		d.Metadata(ctx, datasource.MetadataRequest{ProviderTypeName: "f5xc"}, &meta)
+
+		t.Run(meta.TypeName, func(t *testing.T) {
+			if configurable, ok := d.(datasource.DataSourceWithConfigure); ok {
+				var configureResp datasource.ConfigureResponse
+				configurable.Configure(ctx, datasource.ConfigureRequest{ProviderData: client.NewClient(server.URL, "token")}, &configureResp)
+				if configureResp.Diagnostics.HasError() {
+					t.Fatalf("Configure() diagnostics = %v", configureResp.Diagnostics)
+				}
+			}
+
+			var schemaResp datasource.SchemaResponse
+			d.Schema(ctx, datasource.SchemaRequest{}, &schemaResp)
+			req := datasource.ReadRequest{Config: minimalDataSourceConfig(ctx, schemaResp.Schema)}
+			resp := datasource.ReadResponse{State: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			}}
+			d.Read(ctx, req, &resp)
+			if resp.Diagnostics.HasError() {
+				t.Errorf("Read() diagnostics = %v", resp.Diagnostics)
+			}
+		})
+	}
+}
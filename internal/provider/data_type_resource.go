@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &DataTypeResource{}
-	_ resource.ResourceWithConfigure      = &DataTypeResource{}
-	_ resource.ResourceWithImportState    = &DataTypeResource{}
-	_ resource.ResourceWithModifyPlan     = &DataTypeResource{}
-	_ resource.ResourceWithValidateConfig = &DataTypeResource{}
+	_ resource.Resource                     = &DataTypeResource{}
+	_ resource.ResourceWithConfigure        = &DataTypeResource{}
+	_ resource.ResourceWithImportState      = &DataTypeResource{}
+	_ resource.ResourceWithIdentity         = &DataTypeResource{}
+	_ resource.ResourceWithMoveState        = &DataTypeResource{}
+	_ resource.ResourceWithModifyPlan       = &DataTypeResource{}
+	_ resource.ResourceWithValidateConfig   = &DataTypeResource{}
+	_ resource.ResourceWithConfigValidators = &DataTypeResource{}
+	_ listableResource                      = &DataTypeResource{}
 )
 
 func NewDataTypeResource() resource.Resource {
@@ -180,6 +186,8 @@ type DataTypeResourceModel struct {
 	IsPII           types.Bool     `tfsdk:"is_pii"`
 	IsSensitiveData types.Bool     `tfsdk:"is_sensitive_data"`
 	Timeouts        timeouts.Value `tfsdk:"timeouts"`
+	SystemMetadata  types.Object   `tfsdk:"system_metadata"`
+	LabelsAll       types.Map      `tfsdk:"labels_all"`
 	Rules           types.List     `tfsdk:"rules"`
 }
 
@@ -202,9 +210,11 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Data Type will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Data Type will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -220,6 +230,10 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 				MarkdownDescription: "[Enum: GDPR|CCPA|PIPEDA|LGPD|DPA_UK|PDPA_SG|APPI|HIPAA|CPRA_2023|CPA_CO|SOC2|PCI_DSS|ISO_IEC_27001|ISO_IEC_27701|EPRIVACY_DIRECTIVE|GLBA|SOX] Choose applicable compliance frameworks such as GDPR, PCI/DSS, or CCPA to ensure the platform identifies whether vulnerabilities in API endpoints handling this data type may cause a compliance breach. Possible values are `GDPR`, `CCPA`, `PIPEDA`, `LGPD`, `DPA_UK`, `PDPA_SG`, `APPI`, `HIPAA`, `CPRA_2023`, `CPA_CO`, `SOC2`, `PCI_DSS`, `ISO_IEC_27001`, `ISO_IEC_27701`, `EPRIVACY_DIRECTIVE`, `GLBA`, `SOX`.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(17),
+					listvalidator.UniqueValues(),
+				},
 			},
 			"description": schema.StringAttribute{
 				MarkdownDescription: "Human readable description for the object.",
@@ -257,6 +271,8 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -267,6 +283,10 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 			}),
 			"rules": schema.ListNestedBlock{
 				MarkdownDescription: "Configure key/value or regex match rules to enable the platform to detect this custom data type in the API request or response .",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(100),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{},
 					Blocks: map[string]schema.Block{
@@ -276,10 +296,17 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 								"regex_value": schema.StringAttribute{
 									MarkdownDescription: "Search for values matching this regular expression.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(1024),
+										validators.RegexValidator(),
+									},
 								},
 								"substring_value": schema.StringAttribute{
 									MarkdownDescription: "Search for values that include this substring.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(1024),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -290,6 +317,10 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 											MarkdownDescription: "List of exact values to match.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(1024)),
+											},
 										},
 									},
 								},
@@ -305,10 +336,17 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 										"regex_value": schema.StringAttribute{
 											MarkdownDescription: "Search for values matching this regular expression.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(1024),
+												validators.RegexValidator(),
+											},
 										},
 										"substring_value": schema.StringAttribute{
 											MarkdownDescription: "Search for values that include this substring.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(1024),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -319,6 +357,10 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 													MarkdownDescription: "List of exact values to match.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(1024)),
+													},
 												},
 											},
 										},
@@ -330,10 +372,17 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 										"regex_value": schema.StringAttribute{
 											MarkdownDescription: "Search for values matching this regular expression.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(1024),
+												validators.RegexValidator(),
+											},
 										},
 										"substring_value": schema.StringAttribute{
 											MarkdownDescription: "Search for values that include this substring.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(1024),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -344,6 +393,10 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 													MarkdownDescription: "List of exact values to match.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(1024)),
+													},
 												},
 											},
 										},
@@ -357,10 +410,17 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 								"regex_value": schema.StringAttribute{
 									MarkdownDescription: "Search for values matching this regular expression.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(1024),
+										validators.RegexValidator(),
+									},
 								},
 								"substring_value": schema.StringAttribute{
 									MarkdownDescription: "Search for values that include this substring.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(1024),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -371,6 +431,10 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 											MarkdownDescription: "List of exact values to match.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(1024)),
+											},
 										},
 									},
 								},
@@ -383,6 +447,11 @@ func (r *DataTypeResource) Schema(ctx context.Context, req resource.SchemaReques
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *DataTypeResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *DataTypeResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -407,6 +476,18 @@ func (r *DataTypeResource) ValidateConfig(ctx context.Context, req resource.Vali
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *DataTypeResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex(), "pattern_choice", "key_pattern", "key_value_pattern", "value_pattern"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("key_pattern"), "type_choice", "exact_values", "regex_value", "substring_value"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("key_value_pattern").AtName("key_pattern"), "type_choice", "exact_values", "regex_value", "substring_value"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("key_value_pattern").AtName("value_pattern"), "type_choice", "exact_values", "regex_value", "substring_value"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("value_pattern"), "type_choice", "exact_values", "regex_value", "substring_value"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *DataTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -417,7 +498,10 @@ func (r *DataTypeResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "data_type", "", req.Config, &resp.Plan)...)
 		var plan DataTypeResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -474,6 +558,7 @@ func (r *DataTypeResource) Create(ctx context.Context, req resource.CreateReques
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -483,6 +568,7 @@ func (r *DataTypeResource) Create(ctx context.Context, req resource.CreateReques
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Compliances.IsNull() && !data.Compliances.IsUnknown() {
@@ -525,6 +611,17 @@ func (r *DataTypeResource) Create(ctx context.Context, req resource.CreateReques
 					key_value_patternNestedMap := make(map[string]interface{})
 					if item.KeyValuePattern.KeyPattern != nil {
 						key_patternDeepMap := make(map[string]interface{})
+						if item.KeyValuePattern.KeyPattern.ExactValues != nil {
+							exact_valuesLevel4Map := make(map[string]interface{})
+							if !item.KeyValuePattern.KeyPattern.ExactValues.ExactValues.IsNull() && !item.KeyValuePattern.KeyPattern.ExactValues.ExactValues.IsUnknown() {
+								var exact_valuesLevel4Items []string
+								diags := item.KeyValuePattern.KeyPattern.ExactValues.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+								if !diags.HasError() {
+									exact_valuesLevel4Map["exact_values"] = exact_valuesLevel4Items
+								}
+							}
+							key_patternDeepMap["exact_values"] = exact_valuesLevel4Map
+						}
 						if !item.KeyValuePattern.KeyPattern.RegexValue.IsNull() && !item.KeyValuePattern.KeyPattern.RegexValue.IsUnknown() {
 							key_patternDeepMap["regex_value"] = item.KeyValuePattern.KeyPattern.RegexValue.ValueString()
 						}
@@ -535,6 +632,17 @@ func (r *DataTypeResource) Create(ctx context.Context, req resource.CreateReques
 					}
 					if item.KeyValuePattern.ValuePattern != nil {
 						value_patternDeepMap := make(map[string]interface{})
+						if item.KeyValuePattern.ValuePattern.ExactValues != nil {
+							exact_valuesLevel4Map := make(map[string]interface{})
+							if !item.KeyValuePattern.ValuePattern.ExactValues.ExactValues.IsNull() && !item.KeyValuePattern.ValuePattern.ExactValues.ExactValues.IsUnknown() {
+								var exact_valuesLevel4Items []string
+								diags := item.KeyValuePattern.ValuePattern.ExactValues.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+								if !diags.HasError() {
+									exact_valuesLevel4Map["exact_values"] = exact_valuesLevel4Items
+								}
+							}
+							value_patternDeepMap["exact_values"] = exact_valuesLevel4Map
+						}
 						if !item.KeyValuePattern.ValuePattern.RegexValue.IsNull() && !item.KeyValuePattern.ValuePattern.RegexValue.IsUnknown() {
 							value_patternDeepMap["regex_value"] = item.KeyValuePattern.ValuePattern.RegexValue.ValueString()
 						}
@@ -580,11 +688,15 @@ func (r *DataTypeResource) Create(ctx context.Context, req resource.CreateReques
 
 	apiResource, err := r.client.CreateDataType(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DataType: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "data_type", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -717,7 +829,7 @@ func (r *DataTypeResource) Read(ctx context.Context, req resource.ReadRequest, r
 	apiResource, err := r.client.GetDataType(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "DataType not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -725,11 +837,12 @@ func (r *DataTypeResource) Read(ctx context.Context, req resource.ReadRequest, r
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DataType: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "data_type", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -740,31 +853,13 @@ func (r *DataTypeResource) Read(ctx context.Context, req resource.ReadRequest, r
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -772,6 +867,7 @@ func (r *DataTypeResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if v, ok := apiResource.Spec["compliances"].([]interface{}); ok && len(v) > 0 {
 		var compliancesList []string
@@ -916,6 +1012,7 @@ func (r *DataTypeResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -925,6 +1022,7 @@ func (r *DataTypeResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Compliances.IsNull() && !data.Compliances.IsUnknown() {
@@ -967,6 +1065,17 @@ func (r *DataTypeResource) Update(ctx context.Context, req resource.UpdateReques
 					key_value_patternNestedMap := make(map[string]interface{})
 					if item.KeyValuePattern.KeyPattern != nil {
 						key_patternDeepMap := make(map[string]interface{})
+						if item.KeyValuePattern.KeyPattern.ExactValues != nil {
+							exact_valuesLevel4Map := make(map[string]interface{})
+							if !item.KeyValuePattern.KeyPattern.ExactValues.ExactValues.IsNull() && !item.KeyValuePattern.KeyPattern.ExactValues.ExactValues.IsUnknown() {
+								var exact_valuesLevel4Items []string
+								diags := item.KeyValuePattern.KeyPattern.ExactValues.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+								if !diags.HasError() {
+									exact_valuesLevel4Map["exact_values"] = exact_valuesLevel4Items
+								}
+							}
+							key_patternDeepMap["exact_values"] = exact_valuesLevel4Map
+						}
 						if !item.KeyValuePattern.KeyPattern.RegexValue.IsNull() && !item.KeyValuePattern.KeyPattern.RegexValue.IsUnknown() {
 							key_patternDeepMap["regex_value"] = item.KeyValuePattern.KeyPattern.RegexValue.ValueString()
 						}
@@ -977,6 +1086,17 @@ func (r *DataTypeResource) Update(ctx context.Context, req resource.UpdateReques
 					}
 					if item.KeyValuePattern.ValuePattern != nil {
 						value_patternDeepMap := make(map[string]interface{})
+						if item.KeyValuePattern.ValuePattern.ExactValues != nil {
+							exact_valuesLevel4Map := make(map[string]interface{})
+							if !item.KeyValuePattern.ValuePattern.ExactValues.ExactValues.IsNull() && !item.KeyValuePattern.ValuePattern.ExactValues.ExactValues.IsUnknown() {
+								var exact_valuesLevel4Items []string
+								diags := item.KeyValuePattern.ValuePattern.ExactValues.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+								if !diags.HasError() {
+									exact_valuesLevel4Map["exact_values"] = exact_valuesLevel4Items
+								}
+							}
+							value_patternDeepMap["exact_values"] = exact_valuesLevel4Map
+						}
 						if !item.KeyValuePattern.ValuePattern.RegexValue.IsNull() && !item.KeyValuePattern.ValuePattern.RegexValue.IsUnknown() {
 							value_patternDeepMap["regex_value"] = item.KeyValuePattern.ValuePattern.RegexValue.ValueString()
 						}
@@ -1022,7 +1142,7 @@ func (r *DataTypeResource) Update(ctx context.Context, req resource.UpdateReques
 
 	_, err := r.client.UpdateDataType(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DataType: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "data_type", "update"))
 		return
 	}
 
@@ -1033,9 +1153,12 @@ func (r *DataTypeResource) Update(ctx context.Context, req resource.UpdateReques
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetDataType(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DataType after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "data_type", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["is_pii"].(bool); ok {
@@ -1182,7 +1305,7 @@ func (r *DataTypeResource) Delete(ctx context.Context, req resource.DeleteReques
 	err := r.client.DeleteDataType(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "DataType already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1191,37 +1314,33 @@ func (r *DataTypeResource) Delete(ctx context.Context, req resource.DeleteReques
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "DataType delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DataType: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "data_type", "delete"))
 		return
 	}
 }
 
 func (r *DataTypeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *DataTypeResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("data_type", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *DataTypeResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListDataTypes(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *DataTypeResource) requiredNamespace() string {
+	return ""
 }
@@ -6,7 +6,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -20,17 +19,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &DcClusterGroupResource{}
-	_ resource.ResourceWithConfigure      = &DcClusterGroupResource{}
-	_ resource.ResourceWithImportState    = &DcClusterGroupResource{}
-	_ resource.ResourceWithModifyPlan     = &DcClusterGroupResource{}
-	_ resource.ResourceWithValidateConfig = &DcClusterGroupResource{}
+	_ resource.Resource                     = &DcClusterGroupResource{}
+	_ resource.ResourceWithConfigure        = &DcClusterGroupResource{}
+	_ resource.ResourceWithImportState      = &DcClusterGroupResource{}
+	_ resource.ResourceWithIdentity         = &DcClusterGroupResource{}
+	_ resource.ResourceWithMoveState        = &DcClusterGroupResource{}
+	_ resource.ResourceWithModifyPlan       = &DcClusterGroupResource{}
+	_ resource.ResourceWithValidateConfig   = &DcClusterGroupResource{}
+	_ resource.ResourceWithConfigValidators = &DcClusterGroupResource{}
+	_ listableResource                      = &DcClusterGroupResource{}
 )
 
 func NewDcClusterGroupResource() resource.Resource {
@@ -58,15 +62,17 @@ var DcClusterGroupTypeModelAttrTypes = map[string]attr.Type{
 }
 
 type DcClusterGroupResourceModel struct {
-	Name        types.String             `tfsdk:"name"`
-	Namespace   types.String             `tfsdk:"namespace"`
-	Annotations types.Map                `tfsdk:"annotations"`
-	Description types.String             `tfsdk:"description"`
-	Disable     types.Bool               `tfsdk:"disable"`
-	Labels      types.Map                `tfsdk:"labels"`
-	ID          types.String             `tfsdk:"id"`
-	Timeouts    timeouts.Value           `tfsdk:"timeouts"`
-	Type        *DcClusterGroupTypeModel `tfsdk:"type"`
+	Name           types.String             `tfsdk:"name"`
+	Namespace      types.String             `tfsdk:"namespace"`
+	Annotations    types.Map                `tfsdk:"annotations"`
+	Description    types.String             `tfsdk:"description"`
+	Disable        types.Bool               `tfsdk:"disable"`
+	Labels         types.Map                `tfsdk:"labels"`
+	ID             types.String             `tfsdk:"id"`
+	Timeouts       timeouts.Value           `tfsdk:"timeouts"`
+	SystemMetadata types.Object             `tfsdk:"system_metadata"`
+	LabelsAll      types.Map                `tfsdk:"labels_all"`
+	Type           *DcClusterGroupTypeModel `tfsdk:"type"`
 }
 
 func (r *DcClusterGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -88,9 +94,11 @@ func (r *DcClusterGroupResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Dc Cluster Group will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Dc Cluster Group will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -122,6 +130,8 @@ func (r *DcClusterGroupResource) Schema(ctx context.Context, req resource.Schema
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -146,6 +156,11 @@ func (r *DcClusterGroupResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *DcClusterGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *DcClusterGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -170,6 +185,14 @@ func (r *DcClusterGroupResource) ValidateConfig(ctx context.Context, req resourc
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *DcClusterGroupResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("type"), "dc_cluster_group_mesh_choice", "control_and_data_plane_mesh", "data_plane_mesh"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *DcClusterGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -180,7 +203,10 @@ func (r *DcClusterGroupResource) ModifyPlan(ctx context.Context, req resource.Mo
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "dc_cluster_group", "system", req.Config, &resp.Plan)...)
 		var plan DcClusterGroupResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -237,6 +263,7 @@ func (r *DcClusterGroupResource) Create(ctx context.Context, req resource.Create
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -246,6 +273,7 @@ func (r *DcClusterGroupResource) Create(ctx context.Context, req resource.Create
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.Type != nil {
@@ -261,11 +289,15 @@ func (r *DcClusterGroupResource) Create(ctx context.Context, req resource.Create
 
 	apiResource, err := r.client.CreateDcClusterGroup(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create DcClusterGroup: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "dc_cluster_group", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -300,7 +332,7 @@ func (r *DcClusterGroupResource) Read(ctx context.Context, req resource.ReadRequ
 	apiResource, err := r.client.GetDcClusterGroup(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "DcClusterGroup not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -308,11 +340,12 @@ func (r *DcClusterGroupResource) Read(ctx context.Context, req resource.ReadRequ
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DcClusterGroup: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "dc_cluster_group", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -323,31 +356,13 @@ func (r *DcClusterGroupResource) Read(ctx context.Context, req resource.ReadRequ
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -355,6 +370,7 @@ func (r *DcClusterGroupResource) Read(ctx context.Context, req resource.ReadRequ
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["type"].(map[string]interface{}); ok && isImport && data.Type == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -401,6 +417,7 @@ func (r *DcClusterGroupResource) Update(ctx context.Context, req resource.Update
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -410,6 +427,7 @@ func (r *DcClusterGroupResource) Update(ctx context.Context, req resource.Update
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.Type != nil {
@@ -425,7 +443,7 @@ func (r *DcClusterGroupResource) Update(ctx context.Context, req resource.Update
 
 	_, err := r.client.UpdateDcClusterGroup(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update DcClusterGroup: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "dc_cluster_group", "update"))
 		return
 	}
 
@@ -436,9 +454,12 @@ func (r *DcClusterGroupResource) Update(ctx context.Context, req resource.Update
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetDcClusterGroup(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DcClusterGroup after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "dc_cluster_group", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -473,7 +494,7 @@ func (r *DcClusterGroupResource) Delete(ctx context.Context, req resource.Delete
 	err := r.client.DeleteDcClusterGroup(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "DcClusterGroup already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -482,37 +503,33 @@ func (r *DcClusterGroupResource) Delete(ctx context.Context, req resource.Delete
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "DcClusterGroup delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete DcClusterGroup: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "dc_cluster_group", "delete"))
 		return
 	}
 }
 
 func (r *DcClusterGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *DcClusterGroupResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("dc_cluster_group", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *DcClusterGroupResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListDcClusterGroups(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *DcClusterGroupResource) requiredNamespace() string {
+	return "system"
 }
@@ -0,0 +1,102 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// default_labels_helpers.go - Manually maintained helpers for the
+// default_labels and default_annotations of the provider configuration.
+// Create and Update merge them into every object, and Read leaves them out of
+// labels and annotations unless the resource sets them itself, so they do not
+// show up as drift. The computed labels_all attribute holds all labels.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+// labelsAllSchemaAttribute returns the schema of the computed labels_all
+// attribute
+func labelsAllSchemaAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		MarkdownDescription: "All labels of the object, including the `default_labels` of the provider.",
+		Computed:            true,
+		ElementType:         types.StringType,
+	}
+}
+
+// mergeDefaults returns the defaults overridden by the values set on the
+// resource, or the values unchanged when there are no defaults
+func mergeDefaults(defaults, values map[string]string) map[string]string {
+	if len(defaults) == 0 {
+		return values
+	}
+	merged := make(map[string]string, len(defaults)+len(values))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range values {
+		merged[k] = v
+	}
+	return merged
+}
+
+// withoutDefaults returns the values read from the API without the keys the
+// provider added from the defaults. Keys that the resource sets itself, as
+// recorded in configured, are kept even when they match a default.
+func withoutDefaults(values, defaults map[string]string, configured types.Map) map[string]string {
+	if len(defaults) == 0 {
+		return values
+	}
+	result := make(map[string]string, len(values))
+	for k, v := range values {
+		if dv, ok := defaults[k]; ok && dv == v {
+			if _, set := configured.Elements()[k]; !set {
+				continue
+			}
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// stringMapOrNull returns the map as a Terraform value, or null when it is
+// empty
+func stringMapOrNull(ctx context.Context, m map[string]string, diags *diag.Diagnostics) types.Map {
+	if len(m) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	value, d := types.MapValueFrom(ctx, types.StringType, m)
+	diags.Append(d...)
+	return value
+}
+
+// planLabelsAll sets the planned labels_all to the planned labels merged
+// with the default labels, so that changing the defaults updates every
+// object. It stays unknown while the labels or the provider are not known.
+func planLabelsAll(ctx context.Context, c *client.Client, plan *tfsdk.Plan) diag.Diagnostics {
+	if c == nil {
+		return nil
+	}
+	var labels types.Map
+	diags := plan.GetAttribute(ctx, path.Root("labels"), &labels)
+	if diags.HasError() || labels.IsUnknown() {
+		return diags
+	}
+	configured := make(map[string]string, len(labels.Elements()))
+	for k, v := range labels.Elements() {
+		s, ok := v.(types.String)
+		if !ok || s.IsUnknown() {
+			return diags
+		}
+		configured[k] = s.ValueString()
+	}
+	labelsAll := stringMapOrNull(ctx, mergeDefaults(c.DefaultLabels, configured), &diags)
+	diags.Append(plan.SetAttribute(ctx, path.Root("labels_all"), labelsAll)...)
+	return diags
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+func TestMergeDefaults(t *testing.T) {
+	defaults := map[string]string{"team": "netops", "managed-by": "terraform"}
+
+	got := mergeDefaults(defaults, map[string]string{"team": "web", "app": "shop"})
+	want := map[string]string{"team": "web", "managed-by": "terraform", "app": "shop"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeDefaults() = %v, want %v", got, want)
+	}
+
+	if got := mergeDefaults(defaults, nil); !reflect.DeepEqual(got, defaults) {
+		t.Errorf("mergeDefaults() without labels = %v, want the defaults", got)
+	}
+	if got := mergeDefaults(nil, nil); got != nil {
+		t.Errorf("mergeDefaults() without defaults = %v, want nil", got)
+	}
+}
+
+func TestWithoutDefaults(t *testing.T) {
+	defaults := map[string]string{"team": "netops", "managed-by": "terraform"}
+	values := map[string]string{"team": "netops", "managed-by": "terraform", "app": "shop"}
+
+	// Keys the provider added are left out
+	got := withoutDefaults(values, defaults, types.MapNull(types.StringType))
+	if want := map[string]string{"app": "shop"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("withoutDefaults() = %v, want %v", got, want)
+	}
+
+	// Keys the resource sets itself are kept, even with the default value
+	configured := types.MapValueMust(types.StringType, map[string]attr.Value{
+		"team": types.StringValue("netops"),
+		"app":  types.StringValue("shop"),
+	})
+	got = withoutDefaults(values, defaults, configured)
+	if want := map[string]string{"team": "netops", "app": "shop"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("withoutDefaults() = %v, want %v", got, want)
+	}
+
+	// Keys changed outside Terraform show up as drift
+	got = withoutDefaults(map[string]string{"team": "other"}, defaults, types.MapNull(types.StringType))
+	if want := map[string]string{"team": "other"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("withoutDefaults() = %v, want %v", got, want)
+	}
+}
+
+func TestStringMapOrNull(t *testing.T) {
+	var diags diag.Diagnostics
+	if v := stringMapOrNull(context.Background(), map[string]string{}, &diags); !v.IsNull() {
+		t.Errorf("stringMapOrNull() = %v for an empty map, want null", v)
+	}
+	v := stringMapOrNull(context.Background(), map[string]string{"team": "netops"}, &diags)
+	if diags.HasError() || len(v.Elements()) != 1 {
+		t.Errorf("stringMapOrNull() = %v, %v, want one element", v, diags)
+	}
+}
+
+func TestPlanLabelsAll(t *testing.T) {
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"labels":     schema.MapAttribute{Optional: true, ElementType: types.StringType},
+			"labels_all": labelsAllSchemaAttribute(),
+		},
+	}
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"labels":     mapType,
+		"labels_all": mapType,
+	}}
+	newPlan := func(labels tftypes.Value) *tfsdk.Plan {
+		return &tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"labels":     labels,
+			"labels_all": tftypes.NewValue(mapType, tftypes.UnknownValue),
+		})}
+	}
+	labelsAll := func(plan *tfsdk.Plan) types.Map {
+		var m types.Map
+		if diags := plan.GetAttribute(ctx, path.Root("labels_all"), &m); diags.HasError() {
+			t.Fatalf("GetAttribute() diagnostics: %v", diags)
+		}
+		return m
+	}
+
+	c := client.NewClient("https://example.com", "token", client.WithDefaultLabels(map[string]string{"team": "netops"}))
+	plan := newPlan(tftypes.NewValue(mapType, map[string]tftypes.Value{
+		"app": tftypes.NewValue(tftypes.String, "shop"),
+	}))
+	if diags := planLabelsAll(ctx, c, plan); diags.HasError() {
+		t.Fatalf("planLabelsAll() diagnostics: %v", diags)
+	}
+	if got := labelsAll(plan).Elements(); len(got) != 2 || got["team"] != types.StringValue("netops") {
+		t.Errorf("labels_all = %v, want the labels merged with the defaults", got)
+	}
+
+	// Unknown labels leave labels_all unknown
+	plan = newPlan(tftypes.NewValue(mapType, tftypes.UnknownValue))
+	planLabelsAll(ctx, c, plan)
+	if !labelsAll(plan).IsUnknown() {
+		t.Error("labels_all is known while the labels are unknown")
+	}
+
+	// Without labels and defaults labels_all is null
+	plan = newPlan(tftypes.NewValue(mapType, nil))
+	planLabelsAll(ctx, client.NewClient("https://example.com", "token"), plan)
+	if !labelsAll(plan).IsNull() {
+		t.Error("labels_all is not null without labels and defaults")
+	}
+}
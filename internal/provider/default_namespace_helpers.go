@@ -0,0 +1,60 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// default_namespace_helpers.go - Manually maintained helpers that select the
+// namespace of new objects whose resource does not set one, and reject
+// namespaces that the type of an object cannot be created in at plan time
+// instead of leaving it to the API.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+// planNamespace sets the planned namespace of a new object. When the
+// resource does not set a namespace, types that only exist in
+// requiredNamespace, such as system, use that namespace and all other types
+// use the default_namespace of the provider. requiredNamespace is empty for
+// types that can be created in any namespace.
+func planNamespace(ctx context.Context, c *client.Client, resourceType, requiredNamespace string, config tfsdk.Config, plan *tfsdk.Plan) diag.Diagnostics {
+	var configured, planned types.String
+	diags := config.GetAttribute(ctx, path.Root("namespace"), &configured)
+	diags.Append(plan.GetAttribute(ctx, path.Root("namespace"), &planned)...)
+	if diags.HasError() || configured.IsUnknown() {
+		return diags
+	}
+
+	if configured.IsNull() {
+		namespace := requiredNamespace
+		if namespace == "" && c != nil {
+			namespace = c.DefaultNamespace
+		}
+		if namespace == "" {
+			// An unconfigured provider is configured again before apply
+			if c != nil {
+				diags.AddAttributeError(path.Root("namespace"), "Missing Namespace",
+					fmt.Sprintf("The %s does not set a namespace. Set namespace on the resource, "+
+						"or default_namespace in the provider configuration.", resourceType))
+			}
+			return diags
+		}
+		planned = types.StringValue(namespace)
+		diags.Append(plan.SetAttribute(ctx, path.Root("namespace"), planned)...)
+	}
+
+	if requiredNamespace != "" && !planned.IsUnknown() && planned.ValueString() != requiredNamespace {
+		diags.AddAttributeError(path.Root("namespace"), "Invalid Namespace",
+			fmt.Sprintf("A %s can only be created in the %q namespace, not in %q. "+
+				"Set namespace = %q, or leave namespace out.",
+				resourceType, requiredNamespace, planned.ValueString(), requiredNamespace))
+	}
+	return diags
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+func TestPlanNamespace(t *testing.T) {
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"namespace": schema.StringAttribute{Optional: true, Computed: true},
+		},
+	}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"namespace": tftypes.String}}
+	withNamespace := func(namespace interface{}) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"namespace": tftypes.NewValue(tftypes.String, namespace),
+		})
+	}
+
+	withDefault := client.NewClient("https://example.com", "token", client.WithDefaultNamespace("staging"))
+	withoutDefault := client.NewClient("https://example.com", "token")
+
+	tests := []struct {
+		name              string
+		client            *client.Client
+		requiredNamespace string
+		configured        interface{}
+		want              types.String
+		wantError         bool
+	}{
+		{"system type", withoutDefault, "system", nil, types.StringValue("system"), false},
+		{"system type ignores the default", withDefault, "system", nil, types.StringValue("system"), false},
+		{"system type in system", withDefault, "system", "system", types.StringValue("system"), false},
+		{"system type in app namespace", withDefault, "system", "staging", types.StringValue("staging"), true},
+		{"default namespace", withDefault, "", nil, types.StringValue("staging"), false},
+		{"configured namespace", withDefault, "", "shared", types.StringValue("shared"), false},
+		{"no default namespace", withoutDefault, "", nil, types.StringUnknown(), true},
+		{"unconfigured provider", nil, "", nil, types.StringUnknown(), false},
+		{"unknown namespace", withDefault, "system", tftypes.UnknownValue, types.StringUnknown(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := tfsdk.Config{Schema: s, Raw: withNamespace(tt.configured)}
+			planned := tt.configured
+			if planned == nil {
+				planned = tftypes.UnknownValue
+			}
+			plan := &tfsdk.Plan{Schema: s, Raw: withNamespace(planned)}
+
+			diags := planNamespace(ctx, tt.client, "virtual_site", tt.requiredNamespace, config, plan)
+			if diags.HasError() != tt.wantError {
+				t.Errorf("planNamespace() diagnostics = %v, want error %t", diags, tt.wantError)
+			}
+
+			var got types.String
+			plan.GetAttribute(ctx, path.Root("namespace"), &got)
+			if !got.Equal(tt.want) {
+				t.Errorf("planned namespace = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,25 @@ type DNSLBPoolDataSource struct {
 	client *client.Client
 }
 
+// DNSLBPoolDataSourceModel mirrors DNSLBPoolResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type DNSLBPoolDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata    types.Object             `tfsdk:"system_metadata"`
+	Name              types.String             `tfsdk:"name"`
+	Namespace         types.String             `tfsdk:"namespace"`
+	Annotations       types.Map                `tfsdk:"annotations"`
+	Description       types.String             `tfsdk:"description"`
+	Disable           types.Bool               `tfsdk:"disable"`
+	Labels            types.Map                `tfsdk:"labels"`
+	ID                types.String             `tfsdk:"id"`
+	LoadBalancingMode types.String             `tfsdk:"load_balancing_mode"`
+	TTL               types.Int64              `tfsdk:"ttl"`
+	APool             *DNSLBPoolAPoolModel     `tfsdk:"a_pool"`
+	AaaaPool          *DNSLBPoolAaaaPoolModel  `tfsdk:"aaaa_pool"`
+	CnamePool         *DNSLBPoolCnamePoolModel `tfsdk:"cname_pool"`
+	MxPool            *DNSLBPoolMxPoolModel    `tfsdk:"mx_pool"`
+	SrvPool           *DNSLBPoolSrvPoolModel   `tfsdk:"srv_pool"`
+	UseRrsetTTL       *DNSLBPoolEmptyModel     `tfsdk:"use_rrset_ttl"`
 }
 
 func (d *DNSLBPoolDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +52,7 @@ func (d *DNSLBPoolDataSource) Metadata(ctx context.Context, req datasource.Metad
 }
 
 func (d *DNSLBPoolDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages DNS Load Balancer Pool in a given namespace. If one already exist it will give a error. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the DNSLBPool.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the DNSLBPool exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the DNSLBPool.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewDNSLBPoolResource())
 }
 
 func (d *DNSLBPoolDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +74,25 @@ func (d *DNSLBPoolDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	resource, err := d.client.GetDNSLBPool(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetDNSLBPool(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNSLBPool: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "dns_lb_pool", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +106,8 @@ func (d *DNSLBPoolDataSource) Read(ctx context.Context, req datasource.ReadReque
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +116,366 @@ func (d *DNSLBPoolDataSource) Read(ctx context.Context, req datasource.ReadReque
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["a_pool"].(map[string]interface{}); ok && (isImport || data.APool != nil) {
+		data.APool = &DNSLBPoolAPoolModel{
+			DisableHealthCheck: func() *DNSLBPoolEmptyModel {
+				if !isImport && data.APool != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.APool.DisableHealthCheck
+				}
+				// Import case: read from API
+				if _, ok := blockData["disable_health_check"].(map[string]interface{}); ok {
+					return &DNSLBPoolEmptyModel{}
+				}
+				return nil
+			}(),
+			HealthCheck: func() *DNSLBPoolAPoolHealthCheckModel {
+				if !isImport && data.APool != nil && data.APool.HealthCheck != nil {
+					// Normal Read: preserve existing state value
+					return data.APool.HealthCheck
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["health_check"].(map[string]interface{}); ok {
+					return &DNSLBPoolAPoolHealthCheckModel{
+						Name: func() types.String {
+							if v, ok := nestedBlockData["name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Namespace: func() types.String {
+							if v, ok := nestedBlockData["namespace"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Tenant: func() types.String {
+							if v, ok := nestedBlockData["tenant"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			MaxAnswers: func() types.Int64 {
+				if !isImport && data.APool != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.APool.MaxAnswers
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_answers"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			Members: func() []DNSLBPoolAPoolMembersModel {
+				if listData, ok := blockData["members"].([]interface{}); ok && len(listData) > 0 {
+					var result []DNSLBPoolAPoolMembersModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, DNSLBPoolAPoolMembersModel{
+								Disable: func() types.Bool {
+									if v, ok := itemMap["disable"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								IPEndpoint: func() types.String {
+									if v, ok := itemMap["ip_endpoint"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Priority: func() types.Int64 {
+									if v, ok := itemMap["priority"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								Ratio: func() types.Int64 {
+									if v, ok := itemMap["ratio"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["aaaa_pool"].(map[string]interface{}); ok && (isImport || data.AaaaPool != nil) {
+		data.AaaaPool = &DNSLBPoolAaaaPoolModel{
+			MaxAnswers: func() types.Int64 {
+				if !isImport && data.AaaaPool != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.AaaaPool.MaxAnswers
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_answers"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			Members: func() []DNSLBPoolAaaaPoolMembersModel {
+				if listData, ok := blockData["members"].([]interface{}); ok && len(listData) > 0 {
+					var result []DNSLBPoolAaaaPoolMembersModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, DNSLBPoolAaaaPoolMembersModel{
+								Disable: func() types.Bool {
+									if v, ok := itemMap["disable"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								IPEndpoint: func() types.String {
+									if v, ok := itemMap["ip_endpoint"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Priority: func() types.Int64 {
+									if v, ok := itemMap["priority"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								Ratio: func() types.Int64 {
+									if v, ok := itemMap["ratio"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["cname_pool"].(map[string]interface{}); ok && (isImport || data.CnamePool != nil) {
+		data.CnamePool = &DNSLBPoolCnamePoolModel{
+			Members: func() []DNSLBPoolCnamePoolMembersModel {
+				if listData, ok := blockData["members"].([]interface{}); ok && len(listData) > 0 {
+					var result []DNSLBPoolCnamePoolMembersModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, DNSLBPoolCnamePoolMembersModel{
+								Domain: func() types.String {
+									if v, ok := itemMap["domain"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								FinalTranslation: func() types.Bool {
+									if v, ok := itemMap["final_translation"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Ratio: func() types.Int64 {
+									if v, ok := itemMap["ratio"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["mx_pool"].(map[string]interface{}); ok && (isImport || data.MxPool != nil) {
+		data.MxPool = &DNSLBPoolMxPoolModel{
+			MaxAnswers: func() types.Int64 {
+				if !isImport && data.MxPool != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.MxPool.MaxAnswers
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_answers"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			Members: func() []DNSLBPoolMxPoolMembersModel {
+				if listData, ok := blockData["members"].([]interface{}); ok && len(listData) > 0 {
+					var result []DNSLBPoolMxPoolMembersModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, DNSLBPoolMxPoolMembersModel{
+								Domain: func() types.String {
+									if v, ok := itemMap["domain"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Priority: func() types.Int64 {
+									if v, ok := itemMap["priority"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								Ratio: func() types.Int64 {
+									if v, ok := itemMap["ratio"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["srv_pool"].(map[string]interface{}); ok && (isImport || data.SrvPool != nil) {
+		data.SrvPool = &DNSLBPoolSrvPoolModel{
+			MaxAnswers: func() types.Int64 {
+				if !isImport && data.SrvPool != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.SrvPool.MaxAnswers
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_answers"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			Members: func() []DNSLBPoolSrvPoolMembersModel {
+				if listData, ok := blockData["members"].([]interface{}); ok && len(listData) > 0 {
+					var result []DNSLBPoolSrvPoolMembersModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, DNSLBPoolSrvPoolMembersModel{
+								FinalTranslation: func() types.Bool {
+									if v, ok := itemMap["final_translation"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Port: func() types.Int64 {
+									if v, ok := itemMap["port"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								Priority: func() types.Int64 {
+									if v, ok := itemMap["priority"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								Ratio: func() types.Int64 {
+									if v, ok := itemMap["ratio"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								Target: func() types.String {
+									if v, ok := itemMap["target"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Weight: func() types.Int64 {
+									if v, ok := itemMap["weight"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["use_rrset_ttl"].(map[string]interface{}); ok && isImport && data.UseRrsetTTL == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.UseRrsetTTL = &DNSLBPoolEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["load_balancing_mode"].(string); ok && v != "" {
+		data.LoadBalancingMode = types.StringValue(v)
+	} else {
+		data.LoadBalancingMode = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["ttl"].(float64); ok {
+		data.TTL = types.Int64Value(int64(v))
+	} else {
+		data.TTL = types.Int64Null()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
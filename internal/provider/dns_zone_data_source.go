@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,19 @@ type DNSZoneDataSource struct {
 	client *client.Client
 }
 
+// DNSZoneDataSourceModel mirrors DNSZoneResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type DNSZoneDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object           `tfsdk:"system_metadata"`
+	Name           types.String           `tfsdk:"name"`
+	Namespace      types.String           `tfsdk:"namespace"`
+	Annotations    types.Map              `tfsdk:"annotations"`
+	Description    types.String           `tfsdk:"description"`
+	Disable        types.Bool             `tfsdk:"disable"`
+	Labels         types.Map              `tfsdk:"labels"`
+	ID             types.String           `tfsdk:"id"`
+	Primary        *DNSZonePrimaryModel   `tfsdk:"primary"`
+	Secondary      *DNSZoneSecondaryModel `tfsdk:"secondary"`
 }
 
 func (d *DNSZoneDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +46,7 @@ func (d *DNSZoneDataSource) Metadata(ctx context.Context, req datasource.Metadat
 }
 
 func (d *DNSZoneDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages DNS Zone in a given namespace. If one already exist it will give a error. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the DNSZone.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the DNSZone exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the DNSZone.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewDNSZoneResource())
 }
 
 func (d *DNSZoneDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +68,25 @@ func (d *DNSZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	resource, err := d.client.GetDNSZone(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetDNSZone(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read DNSZone: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "dns_zone", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +100,8 @@ func (d *DNSZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +110,485 @@ func (d *DNSZoneDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["primary"].(map[string]interface{}); ok && (isImport || data.Primary != nil) {
+		data.Primary = &DNSZonePrimaryModel{
+			AllowHTTPLBManagedRecords: func() types.Bool {
+				if !isImport && data.Primary != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.Primary.AllowHTTPLBManagedRecords
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["allow_http_lb_managed_records"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			DefaultRrSetGroup: func() []DNSZonePrimaryDefaultRrSetGroupModel {
+				if listData, ok := blockData["default_rr_set_group"].([]interface{}); ok && len(listData) > 0 {
+					var result []DNSZonePrimaryDefaultRrSetGroupModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, DNSZonePrimaryDefaultRrSetGroupModel{
+								ARecord: func() *DNSZonePrimaryDefaultRrSetGroupARecordModel {
+									if deepMap, ok := itemMap["a_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupARecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								AaaaRecord: func() *DNSZonePrimaryDefaultRrSetGroupAaaaRecordModel {
+									if deepMap, ok := itemMap["aaaa_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupAaaaRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								AfsdbRecord: func() *DNSZonePrimaryDefaultRrSetGroupAfsdbRecordModel {
+									if deepMap, ok := itemMap["afsdb_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupAfsdbRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								AliasRecord: func() *DNSZonePrimaryDefaultRrSetGroupAliasRecordModel {
+									if deepMap, ok := itemMap["alias_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupAliasRecordModel{
+											Value: func() types.String {
+												if v, ok := deepMap["value"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								CaaRecord: func() *DNSZonePrimaryDefaultRrSetGroupCaaRecordModel {
+									if deepMap, ok := itemMap["caa_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupCaaRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								CdsRecord: func() *DNSZonePrimaryDefaultRrSetGroupCdsRecordModel {
+									if deepMap, ok := itemMap["cds_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupCdsRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								CertRecord: func() *DNSZonePrimaryDefaultRrSetGroupCertRecordModel {
+									if deepMap, ok := itemMap["cert_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupCertRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								CnameRecord: func() *DNSZonePrimaryDefaultRrSetGroupCnameRecordModel {
+									if deepMap, ok := itemMap["cname_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupCnameRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Value: func() types.String {
+												if v, ok := deepMap["value"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								DescriptionSpec: func() types.String {
+									if v, ok := itemMap["description"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								DsRecord: func() *DNSZonePrimaryDefaultRrSetGroupDsRecordModel {
+									if deepMap, ok := itemMap["ds_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupDsRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Eui48Record: func() *DNSZonePrimaryDefaultRrSetGroupEui48RecordModel {
+									if deepMap, ok := itemMap["eui48_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupEui48RecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Value: func() types.String {
+												if v, ok := deepMap["value"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Eui64Record: func() *DNSZonePrimaryDefaultRrSetGroupEui64RecordModel {
+									if deepMap, ok := itemMap["eui64_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupEui64RecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Value: func() types.String {
+												if v, ok := deepMap["value"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								LBRecord: func() *DNSZonePrimaryDefaultRrSetGroupLBRecordModel {
+									if deepMap, ok := itemMap["lb_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupLBRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								LocRecord: func() *DNSZonePrimaryDefaultRrSetGroupLocRecordModel {
+									if deepMap, ok := itemMap["loc_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupLocRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								MxRecord: func() *DNSZonePrimaryDefaultRrSetGroupMxRecordModel {
+									if deepMap, ok := itemMap["mx_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupMxRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								NaptrRecord: func() *DNSZonePrimaryDefaultRrSetGroupNaptrRecordModel {
+									if deepMap, ok := itemMap["naptr_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupNaptrRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								NsRecord: func() *DNSZonePrimaryDefaultRrSetGroupNsRecordModel {
+									if deepMap, ok := itemMap["ns_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupNsRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								PtrRecord: func() *DNSZonePrimaryDefaultRrSetGroupPtrRecordModel {
+									if deepMap, ok := itemMap["ptr_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupPtrRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								SrvRecord: func() *DNSZonePrimaryDefaultRrSetGroupSrvRecordModel {
+									if deepMap, ok := itemMap["srv_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupSrvRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								SshfpRecord: func() *DNSZonePrimaryDefaultRrSetGroupSshfpRecordModel {
+									if deepMap, ok := itemMap["sshfp_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupSshfpRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								TlsaRecord: func() *DNSZonePrimaryDefaultRrSetGroupTlsaRecordModel {
+									if deepMap, ok := itemMap["tlsa_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupTlsaRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								TTL: func() types.Int64 {
+									if v, ok := itemMap["ttl"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								TxtRecord: func() *DNSZonePrimaryDefaultRrSetGroupTxtRecordModel {
+									if deepMap, ok := itemMap["txt_record"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryDefaultRrSetGroupTxtRecordModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			DefaultSoaParameters: func() *DNSZoneEmptyModel {
+				if !isImport && data.Primary != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.Primary.DefaultSoaParameters
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_soa_parameters"].(map[string]interface{}); ok {
+					return &DNSZoneEmptyModel{}
+				}
+				return nil
+			}(),
+			DnssecMode: func() *DNSZonePrimaryDnssecModeModel {
+				if !isImport && data.Primary != nil && data.Primary.DnssecMode != nil {
+					// Normal Read: preserve existing state value
+					return data.Primary.DnssecMode
+				}
+				// Import case: read from API
+				if _, ok := blockData["dnssec_mode"].(map[string]interface{}); ok {
+					return &DNSZonePrimaryDnssecModeModel{}
+				}
+				return nil
+			}(),
+			RrSetGroup: func() []DNSZonePrimaryRrSetGroupModel {
+				if listData, ok := blockData["rr_set_group"].([]interface{}); ok && len(listData) > 0 {
+					var result []DNSZonePrimaryRrSetGroupModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, DNSZonePrimaryRrSetGroupModel{
+								Metadata: func() *DNSZonePrimaryRrSetGroupMetadataModel {
+									if deepMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+										return &DNSZonePrimaryRrSetGroupMetadataModel{
+											DescriptionSpec: func() types.String {
+												if v, ok := deepMap["description"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			SoaParameters: func() *DNSZonePrimarySoaParametersModel {
+				if !isImport && data.Primary != nil && data.Primary.SoaParameters != nil {
+					// Normal Read: preserve existing state value
+					return data.Primary.SoaParameters
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["soa_parameters"].(map[string]interface{}); ok {
+					return &DNSZonePrimarySoaParametersModel{
+						Expire: func() types.Int64 {
+							if v, ok := nestedBlockData["expire"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						NegativeTTL: func() types.Int64 {
+							if v, ok := nestedBlockData["negative_ttl"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						Refresh: func() types.Int64 {
+							if v, ok := nestedBlockData["refresh"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						Retry: func() types.Int64 {
+							if v, ok := nestedBlockData["retry"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						TTL: func() types.Int64 {
+							if v, ok := nestedBlockData["ttl"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["secondary"].(map[string]interface{}); ok && (isImport || data.Secondary != nil) {
+		data.Secondary = &DNSZoneSecondaryModel{
+			PrimaryServers: func() types.List {
+				if v, ok := blockData["primary_servers"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			TsigKeyAlgorithm: func() types.String {
+				if v, ok := blockData["tsig_key_algorithm"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			TsigKeyName: func() types.String {
+				if v, ok := blockData["tsig_key_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			TsigKeyValue: func() *DNSZoneSecondaryTsigKeyValueModel {
+				if !isImport && data.Secondary != nil && data.Secondary.TsigKeyValue != nil {
+					// Normal Read: preserve existing state value
+					return data.Secondary.TsigKeyValue
+				}
+				// Import case: read from API
+				if _, ok := blockData["tsig_key_value"].(map[string]interface{}); ok {
+					return &DNSZoneSecondaryTsigKeyValueModel{}
+				}
+				return nil
+			}(),
+		}
+	}
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewDNSZoneResource(), &data)...)
 }
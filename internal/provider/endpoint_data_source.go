@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,26 @@ type EndpointDataSource struct {
 	client *client.Client
 }
 
+// EndpointDataSourceModel mirrors EndpointResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type EndpointDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata  types.Object                  `tfsdk:"system_metadata"`
+	Name            types.String                  `tfsdk:"name"`
+	Namespace       types.String                  `tfsdk:"namespace"`
+	Annotations     types.Map                     `tfsdk:"annotations"`
+	Description     types.String                  `tfsdk:"description"`
+	Disable         types.Bool                    `tfsdk:"disable"`
+	Labels          types.Map                     `tfsdk:"labels"`
+	ID              types.String                  `tfsdk:"id"`
+	DNSName         types.String                  `tfsdk:"dns_name"`
+	HealthCheckPort types.Int64                   `tfsdk:"health_check_port"`
+	IP              types.String                  `tfsdk:"ip"`
+	Port            types.Int64                   `tfsdk:"port"`
+	Protocol        types.String                  `tfsdk:"protocol"`
+	DNSNameAdvanced *EndpointDNSNameAdvancedModel `tfsdk:"dns_name_advanced"`
+	ServiceInfo     *EndpointServiceInfoModel     `tfsdk:"service_info"`
+	SnatPool        *EndpointSnatPoolModel        `tfsdk:"snat_pool"`
+	Where           *EndpointWhereModel           `tfsdk:"where"`
 }
 
 func (d *EndpointDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +53,7 @@ func (d *EndpointDataSource) Metadata(ctx context.Context, req datasource.Metada
 }
 
 func (d *EndpointDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages endpoint will create the object in the storage backend for namespace metadata.namespace. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the Endpoint.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Endpoint exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the Endpoint.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewEndpointResource())
 }
 
 func (d *EndpointDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +75,25 @@ func (d *EndpointDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	resource, err := d.client.GetEndpoint(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetEndpoint(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Endpoint: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "endpoint", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +107,8 @@ func (d *EndpointDataSource) Read(ctx context.Context, req datasource.ReadReques
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +117,112 @@ func (d *EndpointDataSource) Read(ctx context.Context, req datasource.ReadReques
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["dns_name_advanced"].(map[string]interface{}); ok && (isImport || data.DNSNameAdvanced != nil) {
+		data.DNSNameAdvanced = &EndpointDNSNameAdvancedModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			RefreshInterval: func() types.Int64 {
+				if !isImport && data.DNSNameAdvanced != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.DNSNameAdvanced.RefreshInterval
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["refresh_interval"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["service_info"].(map[string]interface{}); ok && (isImport || data.ServiceInfo != nil) {
+		data.ServiceInfo = &EndpointServiceInfoModel{
+			DiscoveryType: func() types.String {
+				if v, ok := blockData["discovery_type"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ServiceName: func() types.String {
+				if v, ok := blockData["service_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ServiceSelector: func() *EndpointServiceInfoServiceSelectorModel {
+				if !isImport && data.ServiceInfo != nil && data.ServiceInfo.ServiceSelector != nil {
+					// Normal Read: preserve existing state value
+					return data.ServiceInfo.ServiceSelector
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["service_selector"].(map[string]interface{}); ok {
+					return &EndpointServiceInfoServiceSelectorModel{
+						Expressions: func() types.List {
+							if v, ok := nestedBlockData["expressions"].([]interface{}); ok && len(v) > 0 {
+								var items []string
+								for _, item := range v {
+									if s, ok := item.(string); ok {
+										items = append(items, s)
+									}
+								}
+								listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+								return listVal
+							}
+							return types.ListNull(types.StringType)
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["snat_pool"].(map[string]interface{}); ok && isImport && data.SnatPool == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SnatPool = &EndpointSnatPoolModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["where"].(map[string]interface{}); ok && isImport && data.Where == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Where = &EndpointWhereModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["dns_name"].(string); ok && v != "" {
+		data.DNSName = types.StringValue(v)
+	} else {
+		data.DNSName = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["health_check_port"].(float64); ok {
+		data.HealthCheckPort = types.Int64Value(int64(v))
+	} else {
+		data.HealthCheckPort = types.Int64Null()
+	}
+	if v, ok := apiResource.Spec["ip"].(string); ok && v != "" {
+		data.IP = types.StringValue(v)
+	} else {
+		data.IP = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["port"].(float64); ok {
+		data.Port = types.Int64Value(int64(v))
+	} else {
+		data.Port = types.Int64Null()
+	}
+	if v, ok := apiResource.Spec["protocol"].(string); ok && v != "" {
+		data.Protocol = types.StringValue(v)
+	} else {
+		data.Protocol = types.StringNull()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,17 +23,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &EndpointResource{}
-	_ resource.ResourceWithConfigure      = &EndpointResource{}
-	_ resource.ResourceWithImportState    = &EndpointResource{}
-	_ resource.ResourceWithModifyPlan     = &EndpointResource{}
-	_ resource.ResourceWithValidateConfig = &EndpointResource{}
+	_ resource.Resource                     = &EndpointResource{}
+	_ resource.ResourceWithConfigure        = &EndpointResource{}
+	_ resource.ResourceWithImportState      = &EndpointResource{}
+	_ resource.ResourceWithIdentity         = &EndpointResource{}
+	_ resource.ResourceWithMoveState        = &EndpointResource{}
+	_ resource.ResourceWithModifyPlan       = &EndpointResource{}
+	_ resource.ResourceWithValidateConfig   = &EndpointResource{}
+	_ resource.ResourceWithConfigValidators = &EndpointResource{}
+	_ listableResource                      = &EndpointResource{}
 )
 
 func NewEndpointResource() resource.Resource {
@@ -228,6 +235,8 @@ type EndpointResourceModel struct {
 	Port            types.Int64                   `tfsdk:"port"`
 	Protocol        types.String                  `tfsdk:"protocol"`
 	Timeouts        timeouts.Value                `tfsdk:"timeouts"`
+	SystemMetadata  types.Object                  `tfsdk:"system_metadata"`
+	LabelsAll       types.Map                     `tfsdk:"labels_all"`
 	DNSNameAdvanced *EndpointDNSNameAdvancedModel `tfsdk:"dns_name_advanced"`
 	ServiceInfo     *EndpointServiceInfoModel     `tfsdk:"service_info"`
 	SnatPool        *EndpointSnatPoolModel        `tfsdk:"snat_pool"`
@@ -253,9 +262,11 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Endpoint will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Endpoint will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -294,6 +305,10 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					validators.HostnameValidator(),
+					stringvalidator.UTF8LengthAtMost(256),
+				},
 			},
 			"health_check_port": schema.Int64Attribute{
 				MarkdownDescription: "By default the health check port of an endpoint is the same as the endpoint’s port. This option provides an alternative health check port. Setting this with a non-zero value allows an endpoint to have different health check port.",
@@ -302,6 +317,9 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(65535),
+				},
 			},
 			"ip": schema.StringAttribute{
 				MarkdownDescription: "Endpoint is reachable at the given IPv4/IPv6 address.",
@@ -310,6 +328,9 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					validators.IPValidator(),
+				},
 			},
 			"port": schema.Int64Attribute{
 				MarkdownDescription: "Endpoint service is available on this port.",
@@ -318,6 +339,9 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(65535),
+				},
 			},
 			"protocol": schema.StringAttribute{
 				MarkdownDescription: "Protocol. Endpoint protocol. Default is TCP. Both TCP and UDP protocols are supported.",
@@ -327,6 +351,8 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -341,10 +367,18 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 					"name": schema.StringAttribute{
 						MarkdownDescription: "Endpoint's IP address is discovered using DNS name resolution. The name given here is fully qualified domain name.",
 						Optional:            true,
+						Validators: []validator.String{
+							validators.HostnameValidator(),
+							stringvalidator.UTF8LengthAtMost(256),
+						},
 					},
 					"refresh_interval": schema.Int64Attribute{
 						MarkdownDescription: "Interval for DNS refresh in seconds.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(10),
+							int64validator.AtMost(604800),
+						},
 					},
 				},
 			},
@@ -358,6 +392,9 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 					"service_name": schema.StringAttribute{
 						MarkdownDescription: "Name of the service to discover with an optional namespace and cluster identifier. The format is service_name.namespace_name:cluster_identifier for K8s and service_name:cluster_identifier for Consul Endpoint will be discovered in all discovery objects where the..",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(256),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -368,6 +405,10 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 								MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+								},
 							},
 						},
 					},
@@ -387,6 +428,11 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 								MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+								},
 							},
 						},
 					},
@@ -413,6 +459,9 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 							},
 							"ref": schema.ListNestedBlock{
 								MarkdownDescription: "Reference. A site direct reference .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -462,6 +511,9 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 						Blocks: map[string]schema.Block{
 							"ref": schema.ListNestedBlock{
 								MarkdownDescription: "Virtual network direct reference .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -522,6 +574,9 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 							},
 							"ref": schema.ListNestedBlock{
 								MarkdownDescription: "Virtual_site direct reference .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -571,6 +626,11 @@ func (r *EndpointResource) Schema(ctx context.Context, req resource.SchemaReques
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *EndpointResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *EndpointResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -595,6 +655,19 @@ func (r *EndpointResource) ValidateConfig(ctx context.Context, req resource.Vali
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *EndpointResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "endpoint_address", "dns_name", "dns_name_advanced", "ip", "service_info"),
+		validators.OneOfGroup(path.MatchRoot("service_info"), "service_info", "service_name", "service_selector"),
+		validators.OneOfGroup(path.MatchRoot("snat_pool"), "snat_pool_choice", "no_snat_pool", "snat_pool"),
+		validators.OneOfGroup(path.MatchRoot("where"), "ref_or_selector", "site", "virtual_network", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("where").AtName("site"), "internet_vip_choice", "disable_internet_vip", "enable_internet_vip"),
+		validators.OneOfGroup(path.MatchRoot("where").AtName("virtual_site"), "internet_vip_choice", "disable_internet_vip", "enable_internet_vip"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *EndpointResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -605,7 +678,10 @@ func (r *EndpointResource) ModifyPlan(ctx context.Context, req resource.ModifyPl
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "endpoint", "", req.Config, &resp.Plan)...)
 		var plan EndpointResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -662,6 +738,7 @@ func (r *EndpointResource) Create(ctx context.Context, req resource.CreateReques
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -671,6 +748,7 @@ func (r *EndpointResource) Create(ctx context.Context, req resource.CreateReques
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.DNSNameAdvanced != nil {
@@ -712,6 +790,12 @@ func (r *EndpointResource) Create(ctx context.Context, req resource.CreateReques
 		whereMap := make(map[string]interface{})
 		if data.Where.Site != nil {
 			siteNestedMap := make(map[string]interface{})
+			if data.Where.Site.DisableInternetVIP != nil {
+				siteNestedMap["disable_internet_vip"] = map[string]interface{}{}
+			}
+			if data.Where.Site.EnableInternetVIP != nil {
+				siteNestedMap["enable_internet_vip"] = map[string]interface{}{}
+			}
 			if !data.Where.Site.NetworkType.IsNull() && !data.Where.Site.NetworkType.IsUnknown() {
 				siteNestedMap["network_type"] = data.Where.Site.NetworkType.ValueString()
 			}
@@ -723,6 +807,12 @@ func (r *EndpointResource) Create(ctx context.Context, req resource.CreateReques
 		}
 		if data.Where.VirtualSite != nil {
 			virtual_siteNestedMap := make(map[string]interface{})
+			if data.Where.VirtualSite.DisableInternetVIP != nil {
+				virtual_siteNestedMap["disable_internet_vip"] = map[string]interface{}{}
+			}
+			if data.Where.VirtualSite.EnableInternetVIP != nil {
+				virtual_siteNestedMap["enable_internet_vip"] = map[string]interface{}{}
+			}
 			if !data.Where.VirtualSite.NetworkType.IsNull() && !data.Where.VirtualSite.NetworkType.IsUnknown() {
 				virtual_siteNestedMap["network_type"] = data.Where.VirtualSite.NetworkType.ValueString()
 			}
@@ -748,11 +838,15 @@ func (r *EndpointResource) Create(ctx context.Context, req resource.CreateReques
 
 	apiResource, err := r.client.CreateEndpoint(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Endpoint: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "endpoint", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -884,7 +978,7 @@ func (r *EndpointResource) Read(ctx context.Context, req resource.ReadRequest, r
 	apiResource, err := r.client.GetEndpoint(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Endpoint not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -892,11 +986,12 @@ func (r *EndpointResource) Read(ctx context.Context, req resource.ReadRequest, r
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Endpoint: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "endpoint", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -907,31 +1002,13 @@ func (r *EndpointResource) Read(ctx context.Context, req resource.ReadRequest, r
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -939,6 +1016,7 @@ func (r *EndpointResource) Read(ctx context.Context, req resource.ReadRequest, r
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["dns_name_advanced"].(map[string]interface{}); ok && (isImport || data.DNSNameAdvanced != nil) {
 		data.DNSNameAdvanced = &EndpointDNSNameAdvancedModel{
@@ -1082,6 +1160,7 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1091,6 +1170,7 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.DNSNameAdvanced != nil {
@@ -1132,6 +1212,12 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 		whereMap := make(map[string]interface{})
 		if data.Where.Site != nil {
 			siteNestedMap := make(map[string]interface{})
+			if data.Where.Site.DisableInternetVIP != nil {
+				siteNestedMap["disable_internet_vip"] = map[string]interface{}{}
+			}
+			if data.Where.Site.EnableInternetVIP != nil {
+				siteNestedMap["enable_internet_vip"] = map[string]interface{}{}
+			}
 			if !data.Where.Site.NetworkType.IsNull() && !data.Where.Site.NetworkType.IsUnknown() {
 				siteNestedMap["network_type"] = data.Where.Site.NetworkType.ValueString()
 			}
@@ -1143,6 +1229,12 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 		}
 		if data.Where.VirtualSite != nil {
 			virtual_siteNestedMap := make(map[string]interface{})
+			if data.Where.VirtualSite.DisableInternetVIP != nil {
+				virtual_siteNestedMap["disable_internet_vip"] = map[string]interface{}{}
+			}
+			if data.Where.VirtualSite.EnableInternetVIP != nil {
+				virtual_siteNestedMap["enable_internet_vip"] = map[string]interface{}{}
+			}
 			if !data.Where.VirtualSite.NetworkType.IsNull() && !data.Where.VirtualSite.NetworkType.IsUnknown() {
 				virtual_siteNestedMap["network_type"] = data.Where.VirtualSite.NetworkType.ValueString()
 			}
@@ -1168,7 +1260,7 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 
 	_, err := r.client.UpdateEndpoint(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Endpoint: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "endpoint", "update"))
 		return
 	}
 
@@ -1179,9 +1271,12 @@ func (r *EndpointResource) Update(ctx context.Context, req resource.UpdateReques
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetEndpoint(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Endpoint after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "endpoint", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["dns_name"].(string); ok && v != "" {
@@ -1348,7 +1443,7 @@ func (r *EndpointResource) Delete(ctx context.Context, req resource.DeleteReques
 	err := r.client.DeleteEndpoint(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Endpoint already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1357,37 +1452,33 @@ func (r *EndpointResource) Delete(ctx context.Context, req resource.DeleteReques
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "Endpoint delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Endpoint: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "endpoint", "delete"))
 		return
 	}
 }
 
 func (r *EndpointResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *EndpointResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("endpoint", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *EndpointResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListEndpoints(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *EndpointResource) requiredNamespace() string {
+	return ""
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,24 @@ type EnhancedFirewallPolicyDataSource struct {
 	client *client.Client
 }
 
+// EnhancedFirewallPolicyDataSourceModel mirrors EnhancedFirewallPolicyResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type EnhancedFirewallPolicyDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata      types.Object                                    `tfsdk:"system_metadata"`
+	Name                types.String                                    `tfsdk:"name"`
+	Namespace           types.String                                    `tfsdk:"namespace"`
+	Annotations         types.Map                                       `tfsdk:"annotations"`
+	Description         types.String                                    `tfsdk:"description"`
+	Disable             types.Bool                                      `tfsdk:"disable"`
+	Labels              types.Map                                       `tfsdk:"labels"`
+	ID                  types.String                                    `tfsdk:"id"`
+	AllowAll            *EnhancedFirewallPolicyEmptyModel               `tfsdk:"allow_all"`
+	AllowedDestinations *EnhancedFirewallPolicyAllowedDestinationsModel `tfsdk:"allowed_destinations"`
+	AllowedSources      *EnhancedFirewallPolicyAllowedSourcesModel      `tfsdk:"allowed_sources"`
+	DeniedDestinations  *EnhancedFirewallPolicyDeniedDestinationsModel  `tfsdk:"denied_destinations"`
+	DeniedSources       *EnhancedFirewallPolicyDeniedSourcesModel       `tfsdk:"denied_sources"`
+	DenyAll             *EnhancedFirewallPolicyEmptyModel               `tfsdk:"deny_all"`
+	RuleList            *EnhancedFirewallPolicyRuleListModel            `tfsdk:"rule_list"`
 }
 
 func (d *EnhancedFirewallPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +51,7 @@ func (d *EnhancedFirewallPolicyDataSource) Metadata(ctx context.Context, req dat
 }
 
 func (d *EnhancedFirewallPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Enhanced Firewall Policy resource in F5 Distributed Cloud for enhanced firewall policy specification. configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the EnhancedFirewallPolicy.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the EnhancedFirewallPolicy exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the EnhancedFirewallPolicy.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewEnhancedFirewallPolicyResource())
 }
 
 func (d *EnhancedFirewallPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +73,25 @@ func (d *EnhancedFirewallPolicyDataSource) Read(ctx context.Context, req datasou
 		return
 	}
 
-	resource, err := d.client.GetEnhancedFirewallPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetEnhancedFirewallPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read EnhancedFirewallPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "enhanced_firewall_policy", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +105,8 @@ func (d *EnhancedFirewallPolicyDataSource) Read(ctx context.Context, req datasou
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +115,294 @@ func (d *EnhancedFirewallPolicyDataSource) Read(ctx context.Context, req datasou
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["allow_all"].(map[string]interface{}); ok && isImport && data.AllowAll == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AllowAll = &EnhancedFirewallPolicyEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["allowed_destinations"].(map[string]interface{}); ok && (isImport || data.AllowedDestinations != nil) {
+		data.AllowedDestinations = &EnhancedFirewallPolicyAllowedDestinationsModel{
+			Prefix: func() types.List {
+				if v, ok := blockData["prefix"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["allowed_sources"].(map[string]interface{}); ok && (isImport || data.AllowedSources != nil) {
+		data.AllowedSources = &EnhancedFirewallPolicyAllowedSourcesModel{
+			Prefix: func() types.List {
+				if v, ok := blockData["prefix"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["denied_destinations"].(map[string]interface{}); ok && (isImport || data.DeniedDestinations != nil) {
+		data.DeniedDestinations = &EnhancedFirewallPolicyDeniedDestinationsModel{
+			Prefix: func() types.List {
+				if v, ok := blockData["prefix"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["denied_sources"].(map[string]interface{}); ok && (isImport || data.DeniedSources != nil) {
+		data.DeniedSources = &EnhancedFirewallPolicyDeniedSourcesModel{
+			Prefix: func() types.List {
+				if v, ok := blockData["prefix"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["deny_all"].(map[string]interface{}); ok && isImport && data.DenyAll == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DenyAll = &EnhancedFirewallPolicyEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["rule_list"].(map[string]interface{}); ok && (isImport || data.RuleList != nil) {
+		data.RuleList = &EnhancedFirewallPolicyRuleListModel{
+			Rules: func() []EnhancedFirewallPolicyRuleListRulesModel {
+				if listData, ok := blockData["rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []EnhancedFirewallPolicyRuleListRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, EnhancedFirewallPolicyRuleListRulesModel{
+								AdvancedAction: func() *EnhancedFirewallPolicyRuleListRulesAdvancedActionModel {
+									if deepMap, ok := itemMap["advanced_action"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesAdvancedActionModel{
+											Action: func() types.String {
+												if v, ok := deepMap["action"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								AllDestinations: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["all_destinations"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								AllSLIVips: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["all_sli_vips"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								AllSloVips: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["all_slo_vips"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								AllSources: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["all_sources"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								AllTCPTraffic: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["all_tcp_traffic"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								AllTraffic: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["all_traffic"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								AllUDPTraffic: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["all_udp_traffic"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								Allow: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["allow"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								Applications: func() *EnhancedFirewallPolicyRuleListRulesApplicationsModel {
+									if _, ok := itemMap["applications"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesApplicationsModel{}
+									}
+									return nil
+								}(),
+								Deny: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["deny"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								DestinationAWSVPCIds: func() *EnhancedFirewallPolicyRuleListRulesDestinationAWSVPCIdsModel {
+									if _, ok := itemMap["destination_aws_vpc_ids"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesDestinationAWSVPCIdsModel{}
+									}
+									return nil
+								}(),
+								DestinationIPPrefixSet: func() *EnhancedFirewallPolicyRuleListRulesDestinationIPPrefixSetModel {
+									if _, ok := itemMap["destination_ip_prefix_set"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesDestinationIPPrefixSetModel{}
+									}
+									return nil
+								}(),
+								DestinationLabelSelector: func() *EnhancedFirewallPolicyRuleListRulesDestinationLabelSelectorModel {
+									if _, ok := itemMap["destination_label_selector"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesDestinationLabelSelectorModel{}
+									}
+									return nil
+								}(),
+								DestinationPrefixList: func() *EnhancedFirewallPolicyRuleListRulesDestinationPrefixListModel {
+									if _, ok := itemMap["destination_prefix_list"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesDestinationPrefixListModel{}
+									}
+									return nil
+								}(),
+								InsertService: func() *EnhancedFirewallPolicyRuleListRulesInsertServiceModel {
+									if _, ok := itemMap["insert_service"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesInsertServiceModel{}
+									}
+									return nil
+								}(),
+								InsideDestinations: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["inside_destinations"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								InsideSources: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["inside_sources"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								LabelMatcher: func() *EnhancedFirewallPolicyRuleListRulesLabelMatcherModel {
+									if _, ok := itemMap["label_matcher"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesLabelMatcherModel{}
+									}
+									return nil
+								}(),
+								Metadata: func() *EnhancedFirewallPolicyRuleListRulesMetadataModel {
+									if deepMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesMetadataModel{
+											DescriptionSpec: func() types.String {
+												if v, ok := deepMap["description"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								OutsideDestinations: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["outside_destinations"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								OutsideSources: func() *EnhancedFirewallPolicyEmptyModel {
+									if _, ok := itemMap["outside_sources"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								ProtocolPortRange: func() *EnhancedFirewallPolicyRuleListRulesProtocolPortRangeModel {
+									if deepMap, ok := itemMap["protocol_port_range"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesProtocolPortRangeModel{
+											Protocol: func() types.String {
+												if v, ok := deepMap["protocol"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								SourceAWSVPCIds: func() *EnhancedFirewallPolicyRuleListRulesSourceAWSVPCIdsModel {
+									if _, ok := itemMap["source_aws_vpc_ids"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesSourceAWSVPCIdsModel{}
+									}
+									return nil
+								}(),
+								SourceIPPrefixSet: func() *EnhancedFirewallPolicyRuleListRulesSourceIPPrefixSetModel {
+									if _, ok := itemMap["source_ip_prefix_set"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesSourceIPPrefixSetModel{}
+									}
+									return nil
+								}(),
+								SourceLabelSelector: func() *EnhancedFirewallPolicyRuleListRulesSourceLabelSelectorModel {
+									if _, ok := itemMap["source_label_selector"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesSourceLabelSelectorModel{}
+									}
+									return nil
+								}(),
+								SourcePrefixList: func() *EnhancedFirewallPolicyRuleListRulesSourcePrefixListModel {
+									if _, ok := itemMap["source_prefix_list"].(map[string]interface{}); ok {
+										return &EnhancedFirewallPolicyRuleListRulesSourcePrefixListModel{}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
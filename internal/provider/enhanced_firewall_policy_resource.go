@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &EnhancedFirewallPolicyResource{}
-	_ resource.ResourceWithConfigure      = &EnhancedFirewallPolicyResource{}
-	_ resource.ResourceWithImportState    = &EnhancedFirewallPolicyResource{}
-	_ resource.ResourceWithModifyPlan     = &EnhancedFirewallPolicyResource{}
-	_ resource.ResourceWithValidateConfig = &EnhancedFirewallPolicyResource{}
+	_ resource.Resource                     = &EnhancedFirewallPolicyResource{}
+	_ resource.ResourceWithConfigure        = &EnhancedFirewallPolicyResource{}
+	_ resource.ResourceWithImportState      = &EnhancedFirewallPolicyResource{}
+	_ resource.ResourceWithIdentity         = &EnhancedFirewallPolicyResource{}
+	_ resource.ResourceWithMoveState        = &EnhancedFirewallPolicyResource{}
+	_ resource.ResourceWithModifyPlan       = &EnhancedFirewallPolicyResource{}
+	_ resource.ResourceWithValidateConfig   = &EnhancedFirewallPolicyResource{}
+	_ resource.ResourceWithConfigValidators = &EnhancedFirewallPolicyResource{}
+	_ listableResource                      = &EnhancedFirewallPolicyResource{}
 )
 
 func NewEnhancedFirewallPolicyResource() resource.Resource {
@@ -360,6 +366,8 @@ type EnhancedFirewallPolicyResourceModel struct {
 	Labels              types.Map                                       `tfsdk:"labels"`
 	ID                  types.String                                    `tfsdk:"id"`
 	Timeouts            timeouts.Value                                  `tfsdk:"timeouts"`
+	SystemMetadata      types.Object                                    `tfsdk:"system_metadata"`
+	LabelsAll           types.Map                                       `tfsdk:"labels_all"`
 	AllowAll            *EnhancedFirewallPolicyEmptyModel               `tfsdk:"allow_all"`
 	AllowedDestinations *EnhancedFirewallPolicyAllowedDestinationsModel `tfsdk:"allowed_destinations"`
 	AllowedSources      *EnhancedFirewallPolicyAllowedSourcesModel      `tfsdk:"allowed_sources"`
@@ -388,9 +396,11 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Enhanced Firewall Policy will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Enhanced Firewall Policy will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -422,6 +432,8 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -440,6 +452,10 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 						MarkdownDescription: "IP Address prefix in string format. String must contain both prefix and prefix-length.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(256),
+							listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+						},
 					},
 				},
 			},
@@ -450,6 +466,10 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 						MarkdownDescription: "IP Address prefix in string format. String must contain both prefix and prefix-length.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(256),
+							listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+						},
 					},
 				},
 			},
@@ -460,6 +480,10 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 						MarkdownDescription: "IP Address prefix in string format. String must contain both prefix and prefix-length.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(256),
+							listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+						},
 					},
 				},
 			},
@@ -470,6 +494,10 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 						MarkdownDescription: "IP Address prefix in string format. String must contain both prefix and prefix-length.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(256),
+							listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+						},
 					},
 				},
 			},
@@ -482,6 +510,9 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 				Blocks: map[string]schema.Block{
 					"rules": schema.ListNestedBlock{
 						MarkdownDescription: "Ordered List of Enhanced Firewall Policy Rules .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(128),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{},
 							Blocks: map[string]schema.Block{
@@ -538,6 +569,11 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 											MarkdownDescription: "List of VPC Identifiers in AWS .",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(256),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(64), validators.PatternValidator("^(vpc-)([a-z0-9]{8}|[a-z0-9]{17})$")),
+											},
 										},
 									},
 								},
@@ -547,6 +583,9 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 									Blocks: map[string]schema.Block{
 										"ref": schema.ListNestedBlock{
 											MarkdownDescription: "List of references to ip_prefix_set objects.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"kind": schema.StringAttribute{
@@ -597,6 +636,10 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 											MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -607,6 +650,11 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 											MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+											},
 										},
 									},
 								},
@@ -620,6 +668,10 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -628,6 +680,9 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -636,6 +691,9 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -654,6 +712,11 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 											MarkdownDescription: "The list of label key names that have to match.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(64), stringvalidator.UTF8LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -663,10 +726,16 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Human readable description.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 								},
@@ -683,6 +752,9 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 											MarkdownDescription: "List of port ranges. Each range is a single port or a pair of start and end ports e.g. 8080-8192.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+											},
 										},
 										"protocol": schema.StringAttribute{
 											MarkdownDescription: "Protocol in IP packet to be used as match criteria Values are TCP, UDP, and icmp.",
@@ -697,6 +769,11 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 											MarkdownDescription: "List of VPC Identifiers in AWS .",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(256),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(64), validators.PatternValidator("^(vpc-)([a-z0-9]{8}|[a-z0-9]{17})$")),
+											},
 										},
 									},
 								},
@@ -706,6 +783,9 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 									Blocks: map[string]schema.Block{
 										"ref": schema.ListNestedBlock{
 											MarkdownDescription: "List of references to ip_prefix_set objects.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"kind": schema.StringAttribute{
@@ -756,6 +836,10 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 											MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -766,6 +850,11 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 											MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+											},
 										},
 									},
 								},
@@ -778,6 +867,11 @@ func (r *EnhancedFirewallPolicyResource) Schema(ctx context.Context, req resourc
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *EnhancedFirewallPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *EnhancedFirewallPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -802,6 +896,18 @@ func (r *EnhancedFirewallPolicyResource) ValidateConfig(ctx context.Context, req
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *EnhancedFirewallPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "rule_choice", "allow_all", "allowed_destinations", "allowed_sources", "denied_destinations", "denied_sources", "deny_all", "rule_list"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex(), "action_choice", "allow", "deny", "insert_service"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex(), "destination_choice", "all_destinations", "all_sli_vips", "all_slo_vips", "destination_aws_vpc_ids", "destination_ip_prefix_set", "destination_label_selector", "destination_prefix_list", "inside_destinations", "outside_destinations"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex(), "source_choice", "all_sources", "inside_sources", "outside_sources", "source_aws_vpc_ids", "source_ip_prefix_set", "source_label_selector", "source_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex(), "traffic_choice", "all_tcp_traffic", "all_traffic", "all_udp_traffic", "applications", "protocol_port_range"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *EnhancedFirewallPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -812,7 +918,10 @@ func (r *EnhancedFirewallPolicyResource) ModifyPlan(ctx context.Context, req res
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "enhanced_firewall_policy", "", req.Config, &resp.Plan)...)
 		var plan EnhancedFirewallPolicyResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -869,6 +978,7 @@ func (r *EnhancedFirewallPolicyResource) Create(ctx context.Context, req resourc
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -878,6 +988,7 @@ func (r *EnhancedFirewallPolicyResource) Create(ctx context.Context, req resourc
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AllowAll != nil {
@@ -994,6 +1105,19 @@ func (r *EnhancedFirewallPolicyResource) Create(ctx context.Context, req resourc
 				}
 				if listItem.InsertService != nil {
 					insert_serviceDeepMap := make(map[string]interface{})
+					if listItem.InsertService.NfvService != nil {
+						nfv_serviceLevel4Map := make(map[string]interface{})
+						if !listItem.InsertService.NfvService.Name.IsNull() && !listItem.InsertService.NfvService.Name.IsUnknown() {
+							nfv_serviceLevel4Map["name"] = listItem.InsertService.NfvService.Name.ValueString()
+						}
+						if !listItem.InsertService.NfvService.Namespace.IsNull() && !listItem.InsertService.NfvService.Namespace.IsUnknown() {
+							nfv_serviceLevel4Map["namespace"] = listItem.InsertService.NfvService.Namespace.ValueString()
+						}
+						if !listItem.InsertService.NfvService.Tenant.IsNull() && !listItem.InsertService.NfvService.Tenant.IsUnknown() {
+							nfv_serviceLevel4Map["tenant"] = listItem.InsertService.NfvService.Tenant.ValueString()
+						}
+						insert_serviceDeepMap["nfv_service"] = nfv_serviceLevel4Map
+					}
 					listItemMap["insert_service"] = insert_serviceDeepMap
 				}
 				if listItem.InsideDestinations != nil {
@@ -1054,11 +1178,15 @@ func (r *EnhancedFirewallPolicyResource) Create(ctx context.Context, req resourc
 
 	apiResource, err := r.client.CreateEnhancedFirewallPolicy(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create EnhancedFirewallPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "enhanced_firewall_policy", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1372,7 +1500,7 @@ func (r *EnhancedFirewallPolicyResource) Read(ctx context.Context, req resource.
 	apiResource, err := r.client.GetEnhancedFirewallPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "EnhancedFirewallPolicy not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1380,11 +1508,12 @@ func (r *EnhancedFirewallPolicyResource) Read(ctx context.Context, req resource.
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read EnhancedFirewallPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "enhanced_firewall_policy", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1395,31 +1524,13 @@ func (r *EnhancedFirewallPolicyResource) Read(ctx context.Context, req resource.
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1427,6 +1538,7 @@ func (r *EnhancedFirewallPolicyResource) Read(ctx context.Context, req resource.
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["allow_all"].(map[string]interface{}); ok && isImport && data.AllowAll == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -1752,6 +1864,7 @@ func (r *EnhancedFirewallPolicyResource) Update(ctx context.Context, req resourc
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1761,6 +1874,7 @@ func (r *EnhancedFirewallPolicyResource) Update(ctx context.Context, req resourc
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AllowAll != nil {
@@ -1877,6 +1991,19 @@ func (r *EnhancedFirewallPolicyResource) Update(ctx context.Context, req resourc
 				}
 				if listItem.InsertService != nil {
 					insert_serviceDeepMap := make(map[string]interface{})
+					if listItem.InsertService.NfvService != nil {
+						nfv_serviceLevel4Map := make(map[string]interface{})
+						if !listItem.InsertService.NfvService.Name.IsNull() && !listItem.InsertService.NfvService.Name.IsUnknown() {
+							nfv_serviceLevel4Map["name"] = listItem.InsertService.NfvService.Name.ValueString()
+						}
+						if !listItem.InsertService.NfvService.Namespace.IsNull() && !listItem.InsertService.NfvService.Namespace.IsUnknown() {
+							nfv_serviceLevel4Map["namespace"] = listItem.InsertService.NfvService.Namespace.ValueString()
+						}
+						if !listItem.InsertService.NfvService.Tenant.IsNull() && !listItem.InsertService.NfvService.Tenant.IsUnknown() {
+							nfv_serviceLevel4Map["tenant"] = listItem.InsertService.NfvService.Tenant.ValueString()
+						}
+						insert_serviceDeepMap["nfv_service"] = nfv_serviceLevel4Map
+					}
 					listItemMap["insert_service"] = insert_serviceDeepMap
 				}
 				if listItem.InsideDestinations != nil {
@@ -1937,7 +2064,7 @@ func (r *EnhancedFirewallPolicyResource) Update(ctx context.Context, req resourc
 
 	_, err := r.client.UpdateEnhancedFirewallPolicy(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update EnhancedFirewallPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "enhanced_firewall_policy", "update"))
 		return
 	}
 
@@ -1948,9 +2075,12 @@ func (r *EnhancedFirewallPolicyResource) Update(ctx context.Context, req resourc
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetEnhancedFirewallPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read EnhancedFirewallPolicy after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "enhanced_firewall_policy", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -2264,7 +2394,7 @@ func (r *EnhancedFirewallPolicyResource) Delete(ctx context.Context, req resourc
 	err := r.client.DeleteEnhancedFirewallPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "EnhancedFirewallPolicy already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -2273,37 +2403,33 @@ func (r *EnhancedFirewallPolicyResource) Delete(ctx context.Context, req resourc
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "EnhancedFirewallPolicy delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete EnhancedFirewallPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "enhanced_firewall_policy", "delete"))
 		return
 	}
 }
 
 func (r *EnhancedFirewallPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *EnhancedFirewallPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("enhanced_firewall_policy", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *EnhancedFirewallPolicyResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListEnhancedFirewallPolicies(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *EnhancedFirewallPolicyResource) requiredNamespace() string {
+	return ""
 }
@@ -0,0 +1,34 @@
+// This file is MANUALLY MAINTAINED and is NOT auto-generated from OpenAPI specifications.
+// It registers ephemeral resources, whose values are never persisted in the plan
+// or the state.
+//
+// DO NOT DELETE OR MODIFY during code generation. This file is preserved by the
+// generate-all-schemas.go tool.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// Ensure F5XCProvider satisfies the provider.ProviderWithEphemeralResources interface.
+var _ provider.ProviderWithEphemeralResources = &F5XCProvider{}
+
+// EphemeralResources returns the ephemeral resources provided by this provider.
+//
+// Available ephemeral resources:
+//   - f5xc_blindfold: Seals a secret using F5XC Secret Management
+//   - f5xc_api_credential: Issues a short-lived API token or API certificate
+//   - f5xc_kubeconfig: Issues a short-lived kubeconfig for a site or a virtual K8s cluster
+//
+// Ephemeral resources require Terraform 1.10 or later.
+func (p *F5XCProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewAPICredentialEphemeralResource,
+		NewBlindfoldEphemeralResource,
+		NewKubeconfigEphemeralResource,
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestEphemeralResourceSchemas(t *testing.T) {
+	ctx := context.Background()
+	seen := make(map[string]bool)
+
+	for _, newEphemeralResource := range (&F5XCProvider{}).EphemeralResources(ctx) {
+		r := newEphemeralResource()
+		var meta ephemeral.MetadataResponse
+		r.Metadata(ctx, ephemeral.MetadataRequest{ProviderTypeName: "f5xc"}, &meta)
+
+		if seen[meta.TypeName] {
+			t.Errorf("duplicate ephemeral resource %s", meta.TypeName)
+		}
+		seen[meta.TypeName] = true
+
+		t.Run(meta.TypeName, func(t *testing.T) {
+			var resp ephemeral.SchemaResponse
+			r.Schema(ctx, ephemeral.SchemaRequest{}, &resp)
+			if diags := resp.Schema.ValidateImplementation(ctx); diags.HasError() {
+				t.Fatalf("invalid ephemeral resource schema: %v", diags)
+			}
+		})
+	}
+}
+
+func TestKubeconfigCreateSpec(t *testing.T) {
+	site := kubeconfigCreateSpec(KubeconfigEphemeralResourceModel{
+		Site:                types.StringValue("ce1"),
+		VirtualK8sName:      types.StringNull(),
+		VirtualK8sNamespace: types.StringNull(),
+	})
+	if site.Type != "SITE_GLOBAL_KUBE_CONFIG" || site.SiteKubeconfig == nil || site.SiteKubeconfig.Site != "ce1" {
+		t.Errorf("site kubeconfig spec = %+v", site)
+	}
+
+	vk8s := kubeconfigCreateSpec(KubeconfigEphemeralResourceModel{
+		Site:                types.StringNull(),
+		VirtualK8sName:      types.StringValue("vk8s1"),
+		VirtualK8sNamespace: types.StringValue("app"),
+	})
+	if vk8s.Type != "KUBE_CONFIG" || vk8s.VirtualK8sName != "vk8s1" || vk8s.VirtualK8sNamespace != "app" || vk8s.SiteKubeconfig != nil {
+		t.Errorf("virtual K8s kubeconfig spec = %+v", vk8s)
+	}
+}
+
+func TestRandomAPICredentialName(t *testing.T) {
+	first, err := randomAPICredentialName()
+	if err != nil {
+		t.Fatalf("randomAPICredentialName() error = %v", err)
+	}
+	second, err := randomAPICredentialName()
+	if err != nil {
+		t.Fatalf("randomAPICredentialName() error = %v", err)
+	}
+
+	if first == second {
+		t.Error("random names should differ")
+	}
+	if len(first) > 16 || !strings.HasPrefix(first, "tf-") {
+		t.Errorf("randomAPICredentialName() = %q, want tf- prefix and at most 16 characters", first)
+	}
+}
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &ExternalConnectorResource{}
-	_ resource.ResourceWithConfigure      = &ExternalConnectorResource{}
-	_ resource.ResourceWithImportState    = &ExternalConnectorResource{}
-	_ resource.ResourceWithModifyPlan     = &ExternalConnectorResource{}
-	_ resource.ResourceWithValidateConfig = &ExternalConnectorResource{}
+	_ resource.Resource                     = &ExternalConnectorResource{}
+	_ resource.ResourceWithConfigure        = &ExternalConnectorResource{}
+	_ resource.ResourceWithImportState      = &ExternalConnectorResource{}
+	_ resource.ResourceWithIdentity         = &ExternalConnectorResource{}
+	_ resource.ResourceWithMoveState        = &ExternalConnectorResource{}
+	_ resource.ResourceWithModifyPlan       = &ExternalConnectorResource{}
+	_ resource.ResourceWithValidateConfig   = &ExternalConnectorResource{}
+	_ resource.ResourceWithConfigValidators = &ExternalConnectorResource{}
+	_ listableResource                      = &ExternalConnectorResource{}
 )
 
 func NewExternalConnectorResource() resource.Resource {
@@ -254,6 +261,8 @@ type ExternalConnectorResourceModel struct {
 	Labels          types.Map                              `tfsdk:"labels"`
 	ID              types.String                           `tfsdk:"id"`
 	Timeouts        timeouts.Value                         `tfsdk:"timeouts"`
+	SystemMetadata  types.Object                           `tfsdk:"system_metadata"`
+	LabelsAll       types.Map                              `tfsdk:"labels_all"`
 	CESiteReference *ExternalConnectorCESiteReferenceModel `tfsdk:"ce_site_reference"`
 	Ipsec           *ExternalConnectorIpsecModel           `tfsdk:"ipsec"`
 }
@@ -277,9 +286,11 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the External Connector will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the External Connector will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -311,6 +322,8 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -325,6 +338,10 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 					"name": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(128),
+							stringvalidator.LengthAtLeast(1),
+						},
 					},
 					"namespace": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -333,6 +350,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 					"tenant": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -341,6 +361,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 				},
 			},
@@ -366,6 +389,10 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 									"timeout": schema.Int64Attribute{
 										MarkdownDescription: "Keepalive Timer. Operation timeout duration",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+											int64validator.AtMost(5),
+										},
 									},
 								},
 							},
@@ -375,6 +402,10 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -383,6 +414,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -391,6 +425,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -400,6 +437,10 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -408,6 +449,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -416,6 +460,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -435,6 +482,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 											"addr": schema.StringAttribute{
 												MarkdownDescription: "IPv4 Address in string form with dot-decimal notation.",
 												Optional:            true,
+												Validators: []validator.String{
+													validators.IPv4Validator(),
+												},
 											},
 										},
 									},
@@ -444,6 +494,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 											"addr": schema.StringAttribute{
 												MarkdownDescription: "IPv6 Address in form of string. IPv6 address must be specified as hexadecimal numbers separated by ':' The address can be compacted by suppressing zeros e.g. '2001:db8:0:0:0:0:2:1' becomes '2001:db8::2:1' or '2001:db8:0:0:0:2:0:0' becomes '2001:db8::2::'.",
 												Optional:            true,
+												Validators: []validator.String{
+													validators.IPv6Validator(),
+												},
 											},
 										},
 									},
@@ -467,6 +520,10 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 							"tunnel_mtu": schema.Int64Attribute{
 								MarkdownDescription: "The tunnel MTU defines the maximum size of the packet that can be sent through the tunnel without needing to be fragmented .",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(512),
+									int64validator.AtMost(1370),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -476,6 +533,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 									"addr": schema.StringAttribute{
 										MarkdownDescription: "IPv4 Address in string form with dot-decimal notation.",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.IPv4Validator(),
+										},
 									},
 								},
 							},
@@ -485,6 +545,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 								Blocks: map[string]schema.Block{
 									"refs": schema.ListNestedBlock{
 										MarkdownDescription: "Reference to Segment Object .",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"kind": schema.StringAttribute{
@@ -536,6 +599,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 							},
 							"tunnel_eps": schema.ListNestedBlock{
 								MarkdownDescription: "Configure tunnel parameters, local and remote IP addresses .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(4),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"interface": schema.StringAttribute{
@@ -545,6 +611,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 										"local_tunnel_ip": schema.StringAttribute{
 											MarkdownDescription: "For a particular tunnel on a node, specify the local tunnel IP Address i.e. The IP address of the tunnel on the CE node itself and a subnet prefix length .",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4PrefixValidator(),
+											},
 										},
 										"node": schema.StringAttribute{
 											MarkdownDescription: "CE site is composed of multiple nodes. Choose a node that will be part of this external connection.",
@@ -553,6 +622,9 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 										"remote_tunnel_ip": schema.StringAttribute{
 											MarkdownDescription: "For a particular tunnel on a node, specify the remote tunnel IP Address i.e. The IP address of the tunnel on the remote gateway and a subnet prefix length .",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4PrefixValidator(),
+											},
 										},
 									},
 								},
@@ -565,6 +637,11 @@ func (r *ExternalConnectorResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *ExternalConnectorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *ExternalConnectorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -589,6 +666,18 @@ func (r *ExternalConnectorResource) ValidateConfig(ctx context.Context, req reso
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *ExternalConnectorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("ipsec").AtName("ike_parameters"), "dpd_choice", "dpd_disabled", "dpd_keep_alive_timer"),
+		validators.OneOfGroup(path.MatchRoot("ipsec").AtName("ike_parameters"), "mode_choice", "initiator", "responder"),
+		validators.OneOfGroup(path.MatchRoot("ipsec").AtName("ike_parameters"), "remote_ike_id", "rm_hostname", "rm_ip_address", "use_default_remote_ike_id"),
+		validators.OneOfGroup(path.MatchRoot("ipsec").AtName("ike_parameters").AtName("rm_ip_address"), "ver", "ipv4", "ipv6"),
+		validators.OneOfGroup(path.MatchRoot("ipsec").AtName("ipsec_tunnel_parameters"), "tunnel_source_vn", "segment", "site_local_inside_network", "site_local_network"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *ExternalConnectorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -599,7 +688,10 @@ func (r *ExternalConnectorResource) ModifyPlan(ctx context.Context, req resource
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "external_connector", "", req.Config, &resp.Plan)...)
 		var plan ExternalConnectorResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -656,6 +748,7 @@ func (r *ExternalConnectorResource) Create(ctx context.Context, req resource.Cre
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -665,6 +758,7 @@ func (r *ExternalConnectorResource) Create(ctx context.Context, req resource.Cre
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.CESiteReference != nil {
@@ -684,16 +778,99 @@ func (r *ExternalConnectorResource) Create(ctx context.Context, req resource.Cre
 		ipsecMap := make(map[string]interface{})
 		if data.Ipsec.IKEParameters != nil {
 			ike_parametersNestedMap := make(map[string]interface{})
+			if data.Ipsec.IKEParameters.DpdDisabled != nil {
+				ike_parametersNestedMap["dpd_disabled"] = map[string]interface{}{}
+			}
+			if data.Ipsec.IKEParameters.DpdKeepAliveTimer != nil {
+				dpd_keep_alive_timerLevel3Map := make(map[string]interface{})
+				if !data.Ipsec.IKEParameters.DpdKeepAliveTimer.Timeout.IsNull() && !data.Ipsec.IKEParameters.DpdKeepAliveTimer.Timeout.IsUnknown() {
+					dpd_keep_alive_timerLevel3Map["timeout"] = data.Ipsec.IKEParameters.DpdKeepAliveTimer.Timeout.ValueInt64()
+				}
+				ike_parametersNestedMap["dpd_keep_alive_timer"] = dpd_keep_alive_timerLevel3Map
+			}
+			if data.Ipsec.IKEParameters.IKEPhase1Profile != nil {
+				ike_phase1_profileLevel3Map := make(map[string]interface{})
+				if !data.Ipsec.IKEParameters.IKEPhase1Profile.Name.IsNull() && !data.Ipsec.IKEParameters.IKEPhase1Profile.Name.IsUnknown() {
+					ike_phase1_profileLevel3Map["name"] = data.Ipsec.IKEParameters.IKEPhase1Profile.Name.ValueString()
+				}
+				if !data.Ipsec.IKEParameters.IKEPhase1Profile.Namespace.IsNull() && !data.Ipsec.IKEParameters.IKEPhase1Profile.Namespace.IsUnknown() {
+					ike_phase1_profileLevel3Map["namespace"] = data.Ipsec.IKEParameters.IKEPhase1Profile.Namespace.ValueString()
+				}
+				if !data.Ipsec.IKEParameters.IKEPhase1Profile.Tenant.IsNull() && !data.Ipsec.IKEParameters.IKEPhase1Profile.Tenant.IsUnknown() {
+					ike_phase1_profileLevel3Map["tenant"] = data.Ipsec.IKEParameters.IKEPhase1Profile.Tenant.ValueString()
+				}
+				ike_parametersNestedMap["ike_phase1_profile"] = ike_phase1_profileLevel3Map
+			}
+			if data.Ipsec.IKEParameters.IKEPhase2Profile != nil {
+				ike_phase2_profileLevel3Map := make(map[string]interface{})
+				if !data.Ipsec.IKEParameters.IKEPhase2Profile.Name.IsNull() && !data.Ipsec.IKEParameters.IKEPhase2Profile.Name.IsUnknown() {
+					ike_phase2_profileLevel3Map["name"] = data.Ipsec.IKEParameters.IKEPhase2Profile.Name.ValueString()
+				}
+				if !data.Ipsec.IKEParameters.IKEPhase2Profile.Namespace.IsNull() && !data.Ipsec.IKEParameters.IKEPhase2Profile.Namespace.IsUnknown() {
+					ike_phase2_profileLevel3Map["namespace"] = data.Ipsec.IKEParameters.IKEPhase2Profile.Namespace.ValueString()
+				}
+				if !data.Ipsec.IKEParameters.IKEPhase2Profile.Tenant.IsNull() && !data.Ipsec.IKEParameters.IKEPhase2Profile.Tenant.IsUnknown() {
+					ike_phase2_profileLevel3Map["tenant"] = data.Ipsec.IKEParameters.IKEPhase2Profile.Tenant.ValueString()
+				}
+				ike_parametersNestedMap["ike_phase2_profile"] = ike_phase2_profileLevel3Map
+			}
+			if data.Ipsec.IKEParameters.Initiator != nil {
+				ike_parametersNestedMap["initiator"] = map[string]interface{}{}
+			}
+			if data.Ipsec.IKEParameters.Responder != nil {
+				ike_parametersNestedMap["responder"] = map[string]interface{}{}
+			}
 			if !data.Ipsec.IKEParameters.RmHostname.IsNull() && !data.Ipsec.IKEParameters.RmHostname.IsUnknown() {
 				ike_parametersNestedMap["rm_hostname"] = data.Ipsec.IKEParameters.RmHostname.ValueString()
 			}
+			if data.Ipsec.IKEParameters.RmIPAddress != nil {
+				rm_ip_addressLevel3Map := make(map[string]interface{})
+				if data.Ipsec.IKEParameters.RmIPAddress.Ipv4 != nil {
+					ipv4Level4Map := make(map[string]interface{})
+					if !data.Ipsec.IKEParameters.RmIPAddress.Ipv4.Addr.IsNull() && !data.Ipsec.IKEParameters.RmIPAddress.Ipv4.Addr.IsUnknown() {
+						ipv4Level4Map["addr"] = data.Ipsec.IKEParameters.RmIPAddress.Ipv4.Addr.ValueString()
+					}
+					rm_ip_addressLevel3Map["ipv4"] = ipv4Level4Map
+				}
+				if data.Ipsec.IKEParameters.RmIPAddress.Ipv6 != nil {
+					ipv6Level4Map := make(map[string]interface{})
+					if !data.Ipsec.IKEParameters.RmIPAddress.Ipv6.Addr.IsNull() && !data.Ipsec.IKEParameters.RmIPAddress.Ipv6.Addr.IsUnknown() {
+						ipv6Level4Map["addr"] = data.Ipsec.IKEParameters.RmIPAddress.Ipv6.Addr.ValueString()
+					}
+					rm_ip_addressLevel3Map["ipv6"] = ipv6Level4Map
+				}
+				ike_parametersNestedMap["rm_ip_address"] = rm_ip_addressLevel3Map
+			}
+			if data.Ipsec.IKEParameters.UseDefaultLocalIKEID != nil {
+				ike_parametersNestedMap["use_default_local_ike_id"] = map[string]interface{}{}
+			}
+			if data.Ipsec.IKEParameters.UseDefaultRemoteIKEID != nil {
+				ike_parametersNestedMap["use_default_remote_ike_id"] = map[string]interface{}{}
+			}
 			ipsecMap["ike_parameters"] = ike_parametersNestedMap
 		}
 		if data.Ipsec.IpsecTunnelParameters != nil {
 			ipsec_tunnel_parametersNestedMap := make(map[string]interface{})
+			if data.Ipsec.IpsecTunnelParameters.PeerIPAddress != nil {
+				peer_ip_addressLevel3Map := make(map[string]interface{})
+				if !data.Ipsec.IpsecTunnelParameters.PeerIPAddress.Addr.IsNull() && !data.Ipsec.IpsecTunnelParameters.PeerIPAddress.Addr.IsUnknown() {
+					peer_ip_addressLevel3Map["addr"] = data.Ipsec.IpsecTunnelParameters.PeerIPAddress.Addr.ValueString()
+				}
+				ipsec_tunnel_parametersNestedMap["peer_ip_address"] = peer_ip_addressLevel3Map
+			}
 			if !data.Ipsec.IpsecTunnelParameters.Psk.IsNull() && !data.Ipsec.IpsecTunnelParameters.Psk.IsUnknown() {
 				ipsec_tunnel_parametersNestedMap["psk"] = data.Ipsec.IpsecTunnelParameters.Psk.ValueString()
 			}
+			if data.Ipsec.IpsecTunnelParameters.Segment != nil {
+				segmentLevel3Map := make(map[string]interface{})
+				ipsec_tunnel_parametersNestedMap["segment"] = segmentLevel3Map
+			}
+			if data.Ipsec.IpsecTunnelParameters.SiteLocalInsideNetwork != nil {
+				ipsec_tunnel_parametersNestedMap["site_local_inside_network"] = map[string]interface{}{}
+			}
+			if data.Ipsec.IpsecTunnelParameters.SiteLocalNetwork != nil {
+				ipsec_tunnel_parametersNestedMap["site_local_network"] = map[string]interface{}{}
+			}
 			if !data.Ipsec.IpsecTunnelParameters.TunnelMTU.IsNull() && !data.Ipsec.IpsecTunnelParameters.TunnelMTU.IsUnknown() {
 				ipsec_tunnel_parametersNestedMap["tunnel_mtu"] = data.Ipsec.IpsecTunnelParameters.TunnelMTU.ValueInt64()
 			}
@@ -704,11 +881,15 @@ func (r *ExternalConnectorResource) Create(ctx context.Context, req resource.Cre
 
 	apiResource, err := r.client.CreateExternalConnector(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ExternalConnector: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "external_connector", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -765,7 +946,7 @@ func (r *ExternalConnectorResource) Read(ctx context.Context, req resource.ReadR
 	apiResource, err := r.client.GetExternalConnector(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "ExternalConnector not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -773,11 +954,12 @@ func (r *ExternalConnectorResource) Read(ctx context.Context, req resource.ReadR
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ExternalConnector: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "external_connector", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -788,31 +970,13 @@ func (r *ExternalConnectorResource) Read(ctx context.Context, req resource.ReadR
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -820,6 +984,7 @@ func (r *ExternalConnectorResource) Read(ctx context.Context, req resource.ReadR
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["ce_site_reference"].(map[string]interface{}); ok && (isImport || data.CESiteReference != nil) {
 		data.CESiteReference = &ExternalConnectorCESiteReferenceModel{
@@ -888,6 +1053,7 @@ func (r *ExternalConnectorResource) Update(ctx context.Context, req resource.Upd
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -897,6 +1063,7 @@ func (r *ExternalConnectorResource) Update(ctx context.Context, req resource.Upd
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.CESiteReference != nil {
@@ -916,16 +1083,99 @@ func (r *ExternalConnectorResource) Update(ctx context.Context, req resource.Upd
 		ipsecMap := make(map[string]interface{})
 		if data.Ipsec.IKEParameters != nil {
 			ike_parametersNestedMap := make(map[string]interface{})
+			if data.Ipsec.IKEParameters.DpdDisabled != nil {
+				ike_parametersNestedMap["dpd_disabled"] = map[string]interface{}{}
+			}
+			if data.Ipsec.IKEParameters.DpdKeepAliveTimer != nil {
+				dpd_keep_alive_timerLevel3Map := make(map[string]interface{})
+				if !data.Ipsec.IKEParameters.DpdKeepAliveTimer.Timeout.IsNull() && !data.Ipsec.IKEParameters.DpdKeepAliveTimer.Timeout.IsUnknown() {
+					dpd_keep_alive_timerLevel3Map["timeout"] = data.Ipsec.IKEParameters.DpdKeepAliveTimer.Timeout.ValueInt64()
+				}
+				ike_parametersNestedMap["dpd_keep_alive_timer"] = dpd_keep_alive_timerLevel3Map
+			}
+			if data.Ipsec.IKEParameters.IKEPhase1Profile != nil {
+				ike_phase1_profileLevel3Map := make(map[string]interface{})
+				if !data.Ipsec.IKEParameters.IKEPhase1Profile.Name.IsNull() && !data.Ipsec.IKEParameters.IKEPhase1Profile.Name.IsUnknown() {
+					ike_phase1_profileLevel3Map["name"] = data.Ipsec.IKEParameters.IKEPhase1Profile.Name.ValueString()
+				}
+				if !data.Ipsec.IKEParameters.IKEPhase1Profile.Namespace.IsNull() && !data.Ipsec.IKEParameters.IKEPhase1Profile.Namespace.IsUnknown() {
+					ike_phase1_profileLevel3Map["namespace"] = data.Ipsec.IKEParameters.IKEPhase1Profile.Namespace.ValueString()
+				}
+				if !data.Ipsec.IKEParameters.IKEPhase1Profile.Tenant.IsNull() && !data.Ipsec.IKEParameters.IKEPhase1Profile.Tenant.IsUnknown() {
+					ike_phase1_profileLevel3Map["tenant"] = data.Ipsec.IKEParameters.IKEPhase1Profile.Tenant.ValueString()
+				}
+				ike_parametersNestedMap["ike_phase1_profile"] = ike_phase1_profileLevel3Map
+			}
+			if data.Ipsec.IKEParameters.IKEPhase2Profile != nil {
+				ike_phase2_profileLevel3Map := make(map[string]interface{})
+				if !data.Ipsec.IKEParameters.IKEPhase2Profile.Name.IsNull() && !data.Ipsec.IKEParameters.IKEPhase2Profile.Name.IsUnknown() {
+					ike_phase2_profileLevel3Map["name"] = data.Ipsec.IKEParameters.IKEPhase2Profile.Name.ValueString()
+				}
+				if !data.Ipsec.IKEParameters.IKEPhase2Profile.Namespace.IsNull() && !data.Ipsec.IKEParameters.IKEPhase2Profile.Namespace.IsUnknown() {
+					ike_phase2_profileLevel3Map["namespace"] = data.Ipsec.IKEParameters.IKEPhase2Profile.Namespace.ValueString()
+				}
+				if !data.Ipsec.IKEParameters.IKEPhase2Profile.Tenant.IsNull() && !data.Ipsec.IKEParameters.IKEPhase2Profile.Tenant.IsUnknown() {
+					ike_phase2_profileLevel3Map["tenant"] = data.Ipsec.IKEParameters.IKEPhase2Profile.Tenant.ValueString()
+				}
+				ike_parametersNestedMap["ike_phase2_profile"] = ike_phase2_profileLevel3Map
+			}
+			if data.Ipsec.IKEParameters.Initiator != nil {
+				ike_parametersNestedMap["initiator"] = map[string]interface{}{}
+			}
+			if data.Ipsec.IKEParameters.Responder != nil {
+				ike_parametersNestedMap["responder"] = map[string]interface{}{}
+			}
 			if !data.Ipsec.IKEParameters.RmHostname.IsNull() && !data.Ipsec.IKEParameters.RmHostname.IsUnknown() {
 				ike_parametersNestedMap["rm_hostname"] = data.Ipsec.IKEParameters.RmHostname.ValueString()
 			}
+			if data.Ipsec.IKEParameters.RmIPAddress != nil {
+				rm_ip_addressLevel3Map := make(map[string]interface{})
+				if data.Ipsec.IKEParameters.RmIPAddress.Ipv4 != nil {
+					ipv4Level4Map := make(map[string]interface{})
+					if !data.Ipsec.IKEParameters.RmIPAddress.Ipv4.Addr.IsNull() && !data.Ipsec.IKEParameters.RmIPAddress.Ipv4.Addr.IsUnknown() {
+						ipv4Level4Map["addr"] = data.Ipsec.IKEParameters.RmIPAddress.Ipv4.Addr.ValueString()
+					}
+					rm_ip_addressLevel3Map["ipv4"] = ipv4Level4Map
+				}
+				if data.Ipsec.IKEParameters.RmIPAddress.Ipv6 != nil {
+					ipv6Level4Map := make(map[string]interface{})
+					if !data.Ipsec.IKEParameters.RmIPAddress.Ipv6.Addr.IsNull() && !data.Ipsec.IKEParameters.RmIPAddress.Ipv6.Addr.IsUnknown() {
+						ipv6Level4Map["addr"] = data.Ipsec.IKEParameters.RmIPAddress.Ipv6.Addr.ValueString()
+					}
+					rm_ip_addressLevel3Map["ipv6"] = ipv6Level4Map
+				}
+				ike_parametersNestedMap["rm_ip_address"] = rm_ip_addressLevel3Map
+			}
+			if data.Ipsec.IKEParameters.UseDefaultLocalIKEID != nil {
+				ike_parametersNestedMap["use_default_local_ike_id"] = map[string]interface{}{}
+			}
+			if data.Ipsec.IKEParameters.UseDefaultRemoteIKEID != nil {
+				ike_parametersNestedMap["use_default_remote_ike_id"] = map[string]interface{}{}
+			}
 			ipsecMap["ike_parameters"] = ike_parametersNestedMap
 		}
 		if data.Ipsec.IpsecTunnelParameters != nil {
 			ipsec_tunnel_parametersNestedMap := make(map[string]interface{})
+			if data.Ipsec.IpsecTunnelParameters.PeerIPAddress != nil {
+				peer_ip_addressLevel3Map := make(map[string]interface{})
+				if !data.Ipsec.IpsecTunnelParameters.PeerIPAddress.Addr.IsNull() && !data.Ipsec.IpsecTunnelParameters.PeerIPAddress.Addr.IsUnknown() {
+					peer_ip_addressLevel3Map["addr"] = data.Ipsec.IpsecTunnelParameters.PeerIPAddress.Addr.ValueString()
+				}
+				ipsec_tunnel_parametersNestedMap["peer_ip_address"] = peer_ip_addressLevel3Map
+			}
 			if !data.Ipsec.IpsecTunnelParameters.Psk.IsNull() && !data.Ipsec.IpsecTunnelParameters.Psk.IsUnknown() {
 				ipsec_tunnel_parametersNestedMap["psk"] = data.Ipsec.IpsecTunnelParameters.Psk.ValueString()
 			}
+			if data.Ipsec.IpsecTunnelParameters.Segment != nil {
+				segmentLevel3Map := make(map[string]interface{})
+				ipsec_tunnel_parametersNestedMap["segment"] = segmentLevel3Map
+			}
+			if data.Ipsec.IpsecTunnelParameters.SiteLocalInsideNetwork != nil {
+				ipsec_tunnel_parametersNestedMap["site_local_inside_network"] = map[string]interface{}{}
+			}
+			if data.Ipsec.IpsecTunnelParameters.SiteLocalNetwork != nil {
+				ipsec_tunnel_parametersNestedMap["site_local_network"] = map[string]interface{}{}
+			}
 			if !data.Ipsec.IpsecTunnelParameters.TunnelMTU.IsNull() && !data.Ipsec.IpsecTunnelParameters.TunnelMTU.IsUnknown() {
 				ipsec_tunnel_parametersNestedMap["tunnel_mtu"] = data.Ipsec.IpsecTunnelParameters.TunnelMTU.ValueInt64()
 			}
@@ -936,7 +1186,7 @@ func (r *ExternalConnectorResource) Update(ctx context.Context, req resource.Upd
 
 	_, err := r.client.UpdateExternalConnector(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ExternalConnector: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "external_connector", "update"))
 		return
 	}
 
@@ -947,9 +1197,12 @@ func (r *ExternalConnectorResource) Update(ctx context.Context, req resource.Upd
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetExternalConnector(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ExternalConnector after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "external_connector", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1006,7 +1259,7 @@ func (r *ExternalConnectorResource) Delete(ctx context.Context, req resource.Del
 	err := r.client.DeleteExternalConnector(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "ExternalConnector already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1015,37 +1268,33 @@ func (r *ExternalConnectorResource) Delete(ctx context.Context, req resource.Del
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "ExternalConnector delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ExternalConnector: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "external_connector", "delete"))
 		return
 	}
 }
 
 func (r *ExternalConnectorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *ExternalConnectorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("external_connector", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *ExternalConnectorResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListExternalConnectors(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *ExternalConnectorResource) requiredNamespace() string {
+	return ""
 }
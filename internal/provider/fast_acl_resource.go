@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &FastACLResource{}
-	_ resource.ResourceWithConfigure      = &FastACLResource{}
-	_ resource.ResourceWithImportState    = &FastACLResource{}
-	_ resource.ResourceWithModifyPlan     = &FastACLResource{}
-	_ resource.ResourceWithValidateConfig = &FastACLResource{}
+	_ resource.Resource                     = &FastACLResource{}
+	_ resource.ResourceWithConfigure        = &FastACLResource{}
+	_ resource.ResourceWithImportState      = &FastACLResource{}
+	_ resource.ResourceWithIdentity         = &FastACLResource{}
+	_ resource.ResourceWithMoveState        = &FastACLResource{}
+	_ resource.ResourceWithModifyPlan       = &FastACLResource{}
+	_ resource.ResourceWithValidateConfig   = &FastACLResource{}
+	_ resource.ResourceWithConfigValidators = &FastACLResource{}
+	_ listableResource                      = &FastACLResource{}
 )
 
 func NewFastACLResource() resource.Resource {
@@ -168,18 +174,20 @@ var FastACLPrefixModelAttrTypes = map[string]attr.Type{
 }
 
 type FastACLResourceModel struct {
-	Name        types.String             `tfsdk:"name"`
-	Namespace   types.String             `tfsdk:"namespace"`
-	Annotations types.Map                `tfsdk:"annotations"`
-	Description types.String             `tfsdk:"description"`
-	Disable     types.Bool               `tfsdk:"disable"`
-	Labels      types.Map                `tfsdk:"labels"`
-	ID          types.String             `tfsdk:"id"`
-	Timeouts    timeouts.Value           `tfsdk:"timeouts"`
-	Action      *FastACLActionModel      `tfsdk:"action"`
-	IPPrefixSet *FastACLIPPrefixSetModel `tfsdk:"ip_prefix_set"`
-	Port        types.List               `tfsdk:"port"`
-	Prefix      *FastACLPrefixModel      `tfsdk:"prefix"`
+	Name           types.String             `tfsdk:"name"`
+	Namespace      types.String             `tfsdk:"namespace"`
+	Annotations    types.Map                `tfsdk:"annotations"`
+	Description    types.String             `tfsdk:"description"`
+	Disable        types.Bool               `tfsdk:"disable"`
+	Labels         types.Map                `tfsdk:"labels"`
+	ID             types.String             `tfsdk:"id"`
+	Timeouts       timeouts.Value           `tfsdk:"timeouts"`
+	SystemMetadata types.Object             `tfsdk:"system_metadata"`
+	LabelsAll      types.Map                `tfsdk:"labels_all"`
+	Action         *FastACLActionModel      `tfsdk:"action"`
+	IPPrefixSet    *FastACLIPPrefixSetModel `tfsdk:"ip_prefix_set"`
+	Port           types.List               `tfsdk:"port"`
+	Prefix         *FastACLPrefixModel      `tfsdk:"prefix"`
 }
 
 func (r *FastACLResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -201,9 +209,11 @@ func (r *FastACLResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Fast ACL will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Fast ACL will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -235,6 +245,8 @@ func (r *FastACLResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -258,6 +270,9 @@ func (r *FastACLResource) Schema(ctx context.Context, req resource.SchemaRequest
 						Blocks: map[string]schema.Block{
 							"ref": schema.ListNestedBlock{
 								MarkdownDescription: "Reference. A policer direct reference.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -307,6 +322,9 @@ func (r *FastACLResource) Schema(ctx context.Context, req resource.SchemaRequest
 						Blocks: map[string]schema.Block{
 							"ref": schema.ListNestedBlock{
 								MarkdownDescription: "Protocol policer Reference. Reference to protocol policer object.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -358,6 +376,9 @@ func (r *FastACLResource) Schema(ctx context.Context, req resource.SchemaRequest
 				Blocks: map[string]schema.Block{
 					"ref": schema.ListNestedBlock{
 						MarkdownDescription: "List of references to ip_prefix_set objects.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"kind": schema.StringAttribute{
@@ -403,11 +424,19 @@ func (r *FastACLResource) Schema(ctx context.Context, req resource.SchemaRequest
 			},
 			"port": schema.ListNestedBlock{
 				MarkdownDescription: "Source Ports. L4 port numbers to match .",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(128),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"user_defined": schema.Int64Attribute{
 							MarkdownDescription: "Matches the user defined port.",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+								int64validator.AtMost(65535),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -427,6 +456,10 @@ func (r *FastACLResource) Schema(ctx context.Context, req resource.SchemaRequest
 						MarkdownDescription: "IP Address prefix in string format. String must contain both prefix and prefix-length.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(256),
+							listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+						},
 					},
 				},
 			},
@@ -434,6 +467,11 @@ func (r *FastACLResource) Schema(ctx context.Context, req resource.SchemaRequest
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *FastACLResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *FastACLResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -458,6 +496,16 @@ func (r *FastACLResource) ValidateConfig(ctx context.Context, req resource.Valid
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *FastACLResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "source", "ip_prefix_set", "prefix"),
+		validators.OneOfGroup(path.MatchRoot("action"), "action", "policer_action", "protocol_policer_action", "simple_action"),
+		validators.RequiredOneOfGroup(path.MatchRoot("port").AtAnyListIndex(), "port_value_type_choice", "all", "dns", "user_defined"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *FastACLResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -468,7 +516,10 @@ func (r *FastACLResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "fast_acl", "system", req.Config, &resp.Plan)...)
 		var plan FastACLResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -525,6 +576,7 @@ func (r *FastACLResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -534,6 +586,7 @@ func (r *FastACLResource) Create(ctx context.Context, req resource.CreateRequest
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.Action != nil {
@@ -614,11 +667,15 @@ func (r *FastACLResource) Create(ctx context.Context, req resource.CreateRequest
 
 	apiResource, err := r.client.CreateFastACL(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create FastACL: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "fast_acl", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -784,7 +841,7 @@ func (r *FastACLResource) Read(ctx context.Context, req resource.ReadRequest, re
 	apiResource, err := r.client.GetFastACL(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "FastACL not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -792,11 +849,12 @@ func (r *FastACLResource) Read(ctx context.Context, req resource.ReadRequest, re
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read FastACL: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "fast_acl", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -807,31 +865,13 @@ func (r *FastACLResource) Read(ctx context.Context, req resource.ReadRequest, re
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -839,6 +879,7 @@ func (r *FastACLResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["action"].(map[string]interface{}); ok && (isImport || data.Action != nil) {
 		data.Action = &FastACLActionModel{
@@ -1016,6 +1057,7 @@ func (r *FastACLResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1025,6 +1067,7 @@ func (r *FastACLResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.Action != nil {
@@ -1105,7 +1148,7 @@ func (r *FastACLResource) Update(ctx context.Context, req resource.UpdateRequest
 
 	_, err := r.client.UpdateFastACL(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update FastACL: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "fast_acl", "update"))
 		return
 	}
 
@@ -1116,9 +1159,12 @@ func (r *FastACLResource) Update(ctx context.Context, req resource.UpdateRequest
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetFastACL(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read FastACL after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "fast_acl", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1284,7 +1330,7 @@ func (r *FastACLResource) Delete(ctx context.Context, req resource.DeleteRequest
 	err := r.client.DeleteFastACL(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "FastACL already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1293,37 +1339,33 @@ func (r *FastACLResource) Delete(ctx context.Context, req resource.DeleteRequest
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "FastACL delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete FastACL: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "fast_acl", "delete"))
 		return
 	}
 }
 
 func (r *FastACLResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *FastACLResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("fast_acl", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *FastACLResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListFastACLs(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *FastACLResource) requiredNamespace() string {
+	return "system"
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,56 @@ type FleetDataSource struct {
 	client *client.Client
 }
 
+// FleetDataSourceModel mirrors FleetResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type FleetDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata                   types.Object                          `tfsdk:"system_metadata"`
+	Name                             types.String                          `tfsdk:"name"`
+	Namespace                        types.String                          `tfsdk:"namespace"`
+	Annotations                      types.Map                             `tfsdk:"annotations"`
+	Description                      types.String                          `tfsdk:"description"`
+	Disable                          types.Bool                            `tfsdk:"disable"`
+	Labels                           types.Map                             `tfsdk:"labels"`
+	ID                               types.String                          `tfsdk:"id"`
+	EnableDefaultFleetConfigDownload types.Bool                            `tfsdk:"enable_default_fleet_config_download"`
+	FleetLabel                       types.String                          `tfsdk:"fleet_label"`
+	OperatingSystemVersion           types.String                          `tfsdk:"operating_system_version"`
+	VolterraSoftwareVersion          types.String                          `tfsdk:"volterra_software_version"`
+	AllowAllUsb                      *FleetEmptyModel                      `tfsdk:"allow_all_usb"`
+	BlockedServices                  types.List                            `tfsdk:"blocked_services"`
+	BondDeviceList                   *FleetBondDeviceListModel             `tfsdk:"bond_device_list"`
+	DcClusterGroup                   *FleetDcClusterGroupModel             `tfsdk:"dc_cluster_group"`
+	DcClusterGroupInside             *FleetDcClusterGroupInsideModel       `tfsdk:"dc_cluster_group_inside"`
+	DefaultConfig                    *FleetEmptyModel                      `tfsdk:"default_config"`
+	DefaultSriovInterface            *FleetEmptyModel                      `tfsdk:"default_sriov_interface"`
+	DefaultStorageClass              *FleetEmptyModel                      `tfsdk:"default_storage_class"`
+	DenyAllUsb                       *FleetEmptyModel                      `tfsdk:"deny_all_usb"`
+	DeviceList                       *FleetDeviceListModel                 `tfsdk:"device_list"`
+	DisableGPU                       *FleetEmptyModel                      `tfsdk:"disable_gpu"`
+	DisableVM                        *FleetEmptyModel                      `tfsdk:"disable_vm"`
+	EnableGPU                        *FleetEmptyModel                      `tfsdk:"enable_gpu"`
+	EnableVgpu                       *FleetEnableVgpuModel                 `tfsdk:"enable_vgpu"`
+	EnableVM                         *FleetEmptyModel                      `tfsdk:"enable_vm"`
+	InsideVirtualNetwork             types.List                            `tfsdk:"inside_virtual_network"`
+	InterfaceList                    *FleetInterfaceListModel              `tfsdk:"interface_list"`
+	KubernetesUpgradeDrain           *FleetKubernetesUpgradeDrainModel     `tfsdk:"kubernetes_upgrade_drain"`
+	LogReceiver                      *FleetLogReceiverModel                `tfsdk:"log_receiver"`
+	LogsStreamingDisabled            *FleetEmptyModel                      `tfsdk:"logs_streaming_disabled"`
+	NetworkConnectors                types.List                            `tfsdk:"network_connectors"`
+	NetworkFirewall                  types.List                            `tfsdk:"network_firewall"`
+	NoBondDevices                    *FleetEmptyModel                      `tfsdk:"no_bond_devices"`
+	NoDcClusterGroup                 *FleetEmptyModel                      `tfsdk:"no_dc_cluster_group"`
+	NoStorageDevice                  *FleetEmptyModel                      `tfsdk:"no_storage_device"`
+	NoStorageInterfaces              *FleetEmptyModel                      `tfsdk:"no_storage_interfaces"`
+	NoStorageStaticRoutes            *FleetEmptyModel                      `tfsdk:"no_storage_static_routes"`
+	OutsideVirtualNetwork            types.List                            `tfsdk:"outside_virtual_network"`
+	PerformanceEnhancementMode       *FleetPerformanceEnhancementModeModel `tfsdk:"performance_enhancement_mode"`
+	SriovInterfaces                  *FleetSriovInterfacesModel            `tfsdk:"sriov_interfaces"`
+	StorageClassList                 *FleetStorageClassListModel           `tfsdk:"storage_class_list"`
+	StorageDeviceList                *FleetStorageDeviceListModel          `tfsdk:"storage_device_list"`
+	StorageInterfaceList             *FleetStorageInterfaceListModel       `tfsdk:"storage_interface_list"`
+	StorageStaticRoutes              *FleetStorageStaticRoutesModel        `tfsdk:"storage_static_routes"`
+	UsbPolicy                        *FleetUsbPolicyModel                  `tfsdk:"usb_policy"`
 }
 
 func (d *FleetDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +83,7 @@ func (d *FleetDataSource) Metadata(ctx context.Context, req datasource.MetadataR
 }
 
 func (d *FleetDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages fleet will create a fleet object in 'system' namespace of the user. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the Fleet.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Fleet exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the Fleet.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewFleetResource())
 }
 
 func (d *FleetDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +105,25 @@ func (d *FleetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	resource, err := d.client.GetFleet(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetFleet(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Fleet: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "fleet", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +137,8 @@ func (d *FleetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +147,1056 @@ func (d *FleetDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["allow_all_usb"].(map[string]interface{}); ok && isImport && data.AllowAllUsb == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AllowAllUsb = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if listData, ok := apiResource.Spec["blocked_services"].([]interface{}); ok && len(listData) > 0 {
+		var blocked_servicesList []FleetBlockedServicesModel
+		var existingBlockedServicesItems []FleetBlockedServicesModel
+		if !data.BlockedServices.IsNull() && !data.BlockedServices.IsUnknown() {
+			data.BlockedServices.ElementsAs(ctx, &existingBlockedServicesItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				blocked_servicesList = append(blocked_servicesList, FleetBlockedServicesModel{
+					DNS: func() *FleetEmptyModel {
+						if !isImport && len(existingBlockedServicesItems) > listIdx && existingBlockedServicesItems[listIdx].DNS != nil {
+							return &FleetEmptyModel{}
+						}
+						return nil
+					}(),
+					NetworkType: func() types.String {
+						if v, ok := itemMap["network_type"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					SSH: func() *FleetEmptyModel {
+						if !isImport && len(existingBlockedServicesItems) > listIdx && existingBlockedServicesItems[listIdx].SSH != nil {
+							return &FleetEmptyModel{}
+						}
+						return nil
+					}(),
+					WebUserInterface: func() *FleetEmptyModel {
+						if !isImport && len(existingBlockedServicesItems) > listIdx && existingBlockedServicesItems[listIdx].WebUserInterface != nil {
+							return &FleetEmptyModel{}
+						}
+						return nil
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: FleetBlockedServicesModelAttrTypes}, blocked_servicesList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.BlockedServices = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.BlockedServices = types.ListNull(types.ObjectType{AttrTypes: FleetBlockedServicesModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["bond_device_list"].(map[string]interface{}); ok && (isImport || data.BondDeviceList != nil) {
+		data.BondDeviceList = &FleetBondDeviceListModel{
+			BondDevices: func() []FleetBondDeviceListBondDevicesModel {
+				if listData, ok := blockData["bond_devices"].([]interface{}); ok && len(listData) > 0 {
+					var result []FleetBondDeviceListBondDevicesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, FleetBondDeviceListBondDevicesModel{
+								ActiveBackup: func() *FleetEmptyModel {
+									if _, ok := itemMap["active_backup"].(map[string]interface{}); ok {
+										return &FleetEmptyModel{}
+									}
+									return nil
+								}(),
+								Lacp: func() *FleetBondDeviceListBondDevicesLacpModel {
+									if deepMap, ok := itemMap["lacp"].(map[string]interface{}); ok {
+										return &FleetBondDeviceListBondDevicesLacpModel{
+											Rate: func() types.Int64 {
+												if v, ok := deepMap["rate"].(float64); ok {
+													return types.Int64Value(int64(v))
+												}
+												return types.Int64Null()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								LinkPollingInterval: func() types.Int64 {
+									if v, ok := itemMap["link_polling_interval"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								LinkUpDelay: func() types.Int64 {
+									if v, ok := itemMap["link_up_delay"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["dc_cluster_group"].(map[string]interface{}); ok && (isImport || data.DcClusterGroup != nil) {
+		data.DcClusterGroup = &FleetDcClusterGroupModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["dc_cluster_group_inside"].(map[string]interface{}); ok && (isImport || data.DcClusterGroupInside != nil) {
+		data.DcClusterGroupInside = &FleetDcClusterGroupInsideModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["default_config"].(map[string]interface{}); ok && isImport && data.DefaultConfig == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DefaultConfig = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["default_sriov_interface"].(map[string]interface{}); ok && isImport && data.DefaultSriovInterface == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DefaultSriovInterface = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["default_storage_class"].(map[string]interface{}); ok && isImport && data.DefaultStorageClass == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DefaultStorageClass = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["deny_all_usb"].(map[string]interface{}); ok && isImport && data.DenyAllUsb == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DenyAllUsb = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["device_list"].(map[string]interface{}); ok && (isImport || data.DeviceList != nil) {
+		data.DeviceList = &FleetDeviceListModel{
+			Devices: func() []FleetDeviceListDevicesModel {
+				if listData, ok := blockData["devices"].([]interface{}); ok && len(listData) > 0 {
+					var result []FleetDeviceListDevicesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, FleetDeviceListDevicesModel{
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								NetworkDevice: func() *FleetDeviceListDevicesNetworkDeviceModel {
+									if deepMap, ok := itemMap["network_device"].(map[string]interface{}); ok {
+										return &FleetDeviceListDevicesNetworkDeviceModel{
+											Use: func() types.String {
+												if v, ok := deepMap["use"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Owner: func() types.String {
+									if v, ok := itemMap["owner"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["disable_gpu"].(map[string]interface{}); ok && isImport && data.DisableGPU == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableGPU = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_vm"].(map[string]interface{}); ok && isImport && data.DisableVM == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableVM = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["enable_gpu"].(map[string]interface{}); ok && isImport && data.EnableGPU == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.EnableGPU = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["enable_vgpu"].(map[string]interface{}); ok && (isImport || data.EnableVgpu != nil) {
+		data.EnableVgpu = &FleetEnableVgpuModel{
+			FeatureType: func() types.String {
+				if v, ok := blockData["feature_type"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ServerAddress: func() types.String {
+				if v, ok := blockData["server_address"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ServerPort: func() types.Int64 {
+				if !isImport && data.EnableVgpu != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.EnableVgpu.ServerPort
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["server_port"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["enable_vm"].(map[string]interface{}); ok && isImport && data.EnableVM == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.EnableVM = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if listData, ok := apiResource.Spec["inside_virtual_network"].([]interface{}); ok && len(listData) > 0 {
+		var inside_virtual_networkList []FleetInsideVirtualNetworkModel
+		var existingInsideVirtualNetworkItems []FleetInsideVirtualNetworkModel
+		if !data.InsideVirtualNetwork.IsNull() && !data.InsideVirtualNetwork.IsUnknown() {
+			data.InsideVirtualNetwork.ElementsAs(ctx, &existingInsideVirtualNetworkItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				inside_virtual_networkList = append(inside_virtual_networkList, FleetInsideVirtualNetworkModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: FleetInsideVirtualNetworkModelAttrTypes}, inside_virtual_networkList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.InsideVirtualNetwork = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.InsideVirtualNetwork = types.ListNull(types.ObjectType{AttrTypes: FleetInsideVirtualNetworkModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["interface_list"].(map[string]interface{}); ok && (isImport || data.InterfaceList != nil) {
+		data.InterfaceList = &FleetInterfaceListModel{
+			Interfaces: func() []FleetInterfaceListInterfacesModel {
+				if listData, ok := blockData["interfaces"].([]interface{}); ok && len(listData) > 0 {
+					var result []FleetInterfaceListInterfacesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, FleetInterfaceListInterfacesModel{
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := itemMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := itemMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["kubernetes_upgrade_drain"].(map[string]interface{}); ok && isImport && data.KubernetesUpgradeDrain == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.KubernetesUpgradeDrain = &FleetKubernetesUpgradeDrainModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["log_receiver"].(map[string]interface{}); ok && (isImport || data.LogReceiver != nil) {
+		data.LogReceiver = &FleetLogReceiverModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["logs_streaming_disabled"].(map[string]interface{}); ok && isImport && data.LogsStreamingDisabled == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.LogsStreamingDisabled = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if listData, ok := apiResource.Spec["network_connectors"].([]interface{}); ok && len(listData) > 0 {
+		var network_connectorsList []FleetNetworkConnectorsModel
+		var existingNetworkConnectorsItems []FleetNetworkConnectorsModel
+		if !data.NetworkConnectors.IsNull() && !data.NetworkConnectors.IsUnknown() {
+			data.NetworkConnectors.ElementsAs(ctx, &existingNetworkConnectorsItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				network_connectorsList = append(network_connectorsList, FleetNetworkConnectorsModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: FleetNetworkConnectorsModelAttrTypes}, network_connectorsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.NetworkConnectors = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.NetworkConnectors = types.ListNull(types.ObjectType{AttrTypes: FleetNetworkConnectorsModelAttrTypes})
+	}
+	if listData, ok := apiResource.Spec["network_firewall"].([]interface{}); ok && len(listData) > 0 {
+		var network_firewallList []FleetNetworkFirewallModel
+		var existingNetworkFirewallItems []FleetNetworkFirewallModel
+		if !data.NetworkFirewall.IsNull() && !data.NetworkFirewall.IsUnknown() {
+			data.NetworkFirewall.ElementsAs(ctx, &existingNetworkFirewallItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				network_firewallList = append(network_firewallList, FleetNetworkFirewallModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: FleetNetworkFirewallModelAttrTypes}, network_firewallList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.NetworkFirewall = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.NetworkFirewall = types.ListNull(types.ObjectType{AttrTypes: FleetNetworkFirewallModelAttrTypes})
+	}
+	if _, ok := apiResource.Spec["no_bond_devices"].(map[string]interface{}); ok && isImport && data.NoBondDevices == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoBondDevices = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["no_dc_cluster_group"].(map[string]interface{}); ok && isImport && data.NoDcClusterGroup == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoDcClusterGroup = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["no_storage_device"].(map[string]interface{}); ok && isImport && data.NoStorageDevice == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoStorageDevice = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["no_storage_interfaces"].(map[string]interface{}); ok && isImport && data.NoStorageInterfaces == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoStorageInterfaces = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["no_storage_static_routes"].(map[string]interface{}); ok && isImport && data.NoStorageStaticRoutes == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoStorageStaticRoutes = &FleetEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if listData, ok := apiResource.Spec["outside_virtual_network"].([]interface{}); ok && len(listData) > 0 {
+		var outside_virtual_networkList []FleetOutsideVirtualNetworkModel
+		var existingOutsideVirtualNetworkItems []FleetOutsideVirtualNetworkModel
+		if !data.OutsideVirtualNetwork.IsNull() && !data.OutsideVirtualNetwork.IsUnknown() {
+			data.OutsideVirtualNetwork.ElementsAs(ctx, &existingOutsideVirtualNetworkItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				outside_virtual_networkList = append(outside_virtual_networkList, FleetOutsideVirtualNetworkModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: FleetOutsideVirtualNetworkModelAttrTypes}, outside_virtual_networkList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.OutsideVirtualNetwork = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.OutsideVirtualNetwork = types.ListNull(types.ObjectType{AttrTypes: FleetOutsideVirtualNetworkModelAttrTypes})
+	}
+	if _, ok := apiResource.Spec["performance_enhancement_mode"].(map[string]interface{}); ok && isImport && data.PerformanceEnhancementMode == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.PerformanceEnhancementMode = &FleetPerformanceEnhancementModeModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["sriov_interfaces"].(map[string]interface{}); ok && (isImport || data.SriovInterfaces != nil) {
+		data.SriovInterfaces = &FleetSriovInterfacesModel{
+			SriovInterface: func() []FleetSriovInterfacesSriovInterfaceModel {
+				if listData, ok := blockData["sriov_interface"].([]interface{}); ok && len(listData) > 0 {
+					var result []FleetSriovInterfacesSriovInterfaceModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, FleetSriovInterfacesSriovInterfaceModel{
+								InterfaceName: func() types.String {
+									if v, ok := itemMap["interface_name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								NumberOfVfioVfs: func() types.Int64 {
+									if v, ok := itemMap["number_of_vfio_vfs"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								NumberOfVfs: func() types.Int64 {
+									if v, ok := itemMap["number_of_vfs"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["storage_class_list"].(map[string]interface{}); ok && (isImport || data.StorageClassList != nil) {
+		data.StorageClassList = &FleetStorageClassListModel{
+			StorageClasses: func() []FleetStorageClassListStorageClassesModel {
+				if listData, ok := blockData["storage_classes"].([]interface{}); ok && len(listData) > 0 {
+					var result []FleetStorageClassListStorageClassesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, FleetStorageClassListStorageClassesModel{
+								AdvancedStorageParameters: func() *FleetEmptyModel {
+									if _, ok := itemMap["advanced_storage_parameters"].(map[string]interface{}); ok {
+										return &FleetEmptyModel{}
+									}
+									return nil
+								}(),
+								AllowVolumeExpansion: func() types.Bool {
+									if v, ok := itemMap["allow_volume_expansion"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								CustomStorage: func() *FleetStorageClassListStorageClassesCustomStorageModel {
+									if deepMap, ok := itemMap["custom_storage"].(map[string]interface{}); ok {
+										return &FleetStorageClassListStorageClassesCustomStorageModel{
+											Yaml: func() types.String {
+												if v, ok := deepMap["yaml"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								DefaultStorageClass: func() types.Bool {
+									if v, ok := itemMap["default_storage_class"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								DescriptionSpec: func() types.String {
+									if v, ok := itemMap["description"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								HpeStorage: func() *FleetStorageClassListStorageClassesHpeStorageModel {
+									if deepMap, ok := itemMap["hpe_storage"].(map[string]interface{}); ok {
+										return &FleetStorageClassListStorageClassesHpeStorageModel{
+											AllowMutations: func() types.String {
+												if v, ok := deepMap["allow_mutations"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											AllowOverrides: func() types.String {
+												if v, ok := deepMap["allow_overrides"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											DedupeEnabled: func() types.Bool {
+												if v, ok := deepMap["dedupe_enabled"].(bool); ok {
+													return types.BoolValue(v)
+												}
+												return types.BoolNull()
+											}(),
+											DescriptionSpec: func() types.String {
+												if v, ok := deepMap["description"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											DestroyOnDelete: func() types.Bool {
+												if v, ok := deepMap["destroy_on_delete"].(bool); ok {
+													return types.BoolValue(v)
+												}
+												return types.BoolNull()
+											}(),
+											Encrypted: func() types.Bool {
+												if v, ok := deepMap["encrypted"].(bool); ok {
+													return types.BoolValue(v)
+												}
+												return types.BoolNull()
+											}(),
+											Folder: func() types.String {
+												if v, ok := deepMap["folder"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											LimitIops: func() types.String {
+												if v, ok := deepMap["limit_iops"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											LimitMbps: func() types.String {
+												if v, ok := deepMap["limit_mbps"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											PerformancePolicy: func() types.String {
+												if v, ok := deepMap["performance_policy"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Pool: func() types.String {
+												if v, ok := deepMap["pool"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											ProtectionTemplate: func() types.String {
+												if v, ok := deepMap["protection_template"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											SecretName: func() types.String {
+												if v, ok := deepMap["secret_name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											SecretNamespace: func() types.String {
+												if v, ok := deepMap["secret_namespace"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											SyncOnDetach: func() types.Bool {
+												if v, ok := deepMap["sync_on_detach"].(bool); ok {
+													return types.BoolValue(v)
+												}
+												return types.BoolNull()
+											}(),
+											Thick: func() types.Bool {
+												if v, ok := deepMap["thick"].(bool); ok {
+													return types.BoolValue(v)
+												}
+												return types.BoolNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								NetappTrident: func() *FleetStorageClassListStorageClassesNetappTridentModel {
+									if deepMap, ok := itemMap["netapp_trident"].(map[string]interface{}); ok {
+										return &FleetStorageClassListStorageClassesNetappTridentModel{
+											Selector: func() *FleetEmptyModel {
+												if _, ok := deepMap["selector"].(map[string]interface{}); ok {
+													return &FleetEmptyModel{}
+												}
+												return nil
+											}(),
+											StoragePools: func() types.String {
+												if v, ok := deepMap["storage_pools"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								PureServiceOrchestrator: func() *FleetStorageClassListStorageClassesPureServiceOrchestratorModel {
+									if deepMap, ok := itemMap["pure_service_orchestrator"].(map[string]interface{}); ok {
+										return &FleetStorageClassListStorageClassesPureServiceOrchestratorModel{
+											Backend: func() types.String {
+												if v, ok := deepMap["backend"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											BandwidthLimit: func() types.String {
+												if v, ok := deepMap["bandwidth_limit"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											IopsLimit: func() types.Int64 {
+												if v, ok := deepMap["iops_limit"].(float64); ok {
+													return types.Int64Value(int64(v))
+												}
+												return types.Int64Null()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								ReclaimPolicy: func() types.String {
+									if v, ok := itemMap["reclaim_policy"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								StorageClassName: func() types.String {
+									if v, ok := itemMap["storage_class_name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								StorageDevice: func() types.String {
+									if v, ok := itemMap["storage_device"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["storage_device_list"].(map[string]interface{}); ok && (isImport || data.StorageDeviceList != nil) {
+		data.StorageDeviceList = &FleetStorageDeviceListModel{
+			StorageDevices: func() []FleetStorageDeviceListStorageDevicesModel {
+				if listData, ok := blockData["storage_devices"].([]interface{}); ok && len(listData) > 0 {
+					var result []FleetStorageDeviceListStorageDevicesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, FleetStorageDeviceListStorageDevicesModel{
+								AdvancedAdvancedParameters: func() *FleetEmptyModel {
+									if _, ok := itemMap["advanced_advanced_parameters"].(map[string]interface{}); ok {
+										return &FleetEmptyModel{}
+									}
+									return nil
+								}(),
+								CustomStorage: func() *FleetEmptyModel {
+									if _, ok := itemMap["custom_storage"].(map[string]interface{}); ok {
+										return &FleetEmptyModel{}
+									}
+									return nil
+								}(),
+								HpeStorage: func() *FleetStorageDeviceListStorageDevicesHpeStorageModel {
+									if deepMap, ok := itemMap["hpe_storage"].(map[string]interface{}); ok {
+										return &FleetStorageDeviceListStorageDevicesHpeStorageModel{
+											APIServerPort: func() types.Int64 {
+												if v, ok := deepMap["api_server_port"].(float64); ok {
+													return types.Int64Value(int64(v))
+												}
+												return types.Int64Null()
+											}(),
+											IscsiChapUser: func() types.String {
+												if v, ok := deepMap["iscsi_chap_user"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											StorageServerIPAddress: func() types.String {
+												if v, ok := deepMap["storage_server_ip_address"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											StorageServerName: func() types.String {
+												if v, ok := deepMap["storage_server_name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Username: func() types.String {
+												if v, ok := deepMap["username"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								NetappTrident: func() *FleetStorageDeviceListStorageDevicesNetappTridentModel {
+									if _, ok := itemMap["netapp_trident"].(map[string]interface{}); ok {
+										return &FleetStorageDeviceListStorageDevicesNetappTridentModel{}
+									}
+									return nil
+								}(),
+								PureServiceOrchestrator: func() *FleetStorageDeviceListStorageDevicesPureServiceOrchestratorModel {
+									if deepMap, ok := itemMap["pure_service_orchestrator"].(map[string]interface{}); ok {
+										return &FleetStorageDeviceListStorageDevicesPureServiceOrchestratorModel{
+											ClusterID: func() types.String {
+												if v, ok := deepMap["cluster_id"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											EnableStorageTopology: func() types.Bool {
+												if v, ok := deepMap["enable_storage_topology"].(bool); ok {
+													return types.BoolValue(v)
+												}
+												return types.BoolNull()
+											}(),
+											EnableStrictTopology: func() types.Bool {
+												if v, ok := deepMap["enable_strict_topology"].(bool); ok {
+													return types.BoolValue(v)
+												}
+												return types.BoolNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								StorageDevice: func() types.String {
+									if v, ok := itemMap["storage_device"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["storage_interface_list"].(map[string]interface{}); ok && (isImport || data.StorageInterfaceList != nil) {
+		data.StorageInterfaceList = &FleetStorageInterfaceListModel{
+			Interfaces: func() []FleetStorageInterfaceListInterfacesModel {
+				if listData, ok := blockData["interfaces"].([]interface{}); ok && len(listData) > 0 {
+					var result []FleetStorageInterfaceListInterfacesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, FleetStorageInterfaceListInterfacesModel{
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := itemMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := itemMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["storage_static_routes"].(map[string]interface{}); ok && (isImport || data.StorageStaticRoutes != nil) {
+		data.StorageStaticRoutes = &FleetStorageStaticRoutesModel{
+			StorageRoutes: func() []FleetStorageStaticRoutesStorageRoutesModel {
+				if listData, ok := blockData["storage_routes"].([]interface{}); ok && len(listData) > 0 {
+					var result []FleetStorageStaticRoutesStorageRoutesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, FleetStorageStaticRoutesStorageRoutesModel{
+								Labels: func() *FleetEmptyModel {
+									if _, ok := itemMap["labels"].(map[string]interface{}); ok {
+										return &FleetEmptyModel{}
+									}
+									return nil
+								}(),
+								Nexthop: func() *FleetStorageStaticRoutesStorageRoutesNexthopModel {
+									if deepMap, ok := itemMap["nexthop"].(map[string]interface{}); ok {
+										return &FleetStorageStaticRoutesStorageRoutesNexthopModel{
+											Type: func() types.String {
+												if v, ok := deepMap["type"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["usb_policy"].(map[string]interface{}); ok && (isImport || data.UsbPolicy != nil) {
+		data.UsbPolicy = &FleetUsbPolicyModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	// Top-level Optional bool: preserve prior state to avoid API default drift
+	if !isImport && !data.EnableDefaultFleetConfigDownload.IsNull() && !data.EnableDefaultFleetConfigDownload.IsUnknown() {
+		// Normal Read: preserve existing state value (do nothing)
+	} else {
+		// Import case, null state, or unknown (after Create): read from API
+		if v, ok := apiResource.Spec["enable_default_fleet_config_download"].(bool); ok {
+			data.EnableDefaultFleetConfigDownload = types.BoolValue(v)
+		} else {
+			data.EnableDefaultFleetConfigDownload = types.BoolNull()
+		}
+	}
+	if v, ok := apiResource.Spec["fleet_label"].(string); ok && v != "" {
+		data.FleetLabel = types.StringValue(v)
+	} else {
+		data.FleetLabel = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["operating_system_version"].(string); ok && v != "" {
+		data.OperatingSystemVersion = types.StringValue(v)
+	} else {
+		data.OperatingSystemVersion = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["volterra_software_version"].(string); ok && v != "" {
+		data.VolterraSoftwareVersion = types.StringValue(v)
+	} else {
+		data.VolterraSoftwareVersion = types.StringNull()
+	}
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewFleetResource(), &data)...)
 }
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,17 +23,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &FleetResource{}
-	_ resource.ResourceWithConfigure      = &FleetResource{}
-	_ resource.ResourceWithImportState    = &FleetResource{}
-	_ resource.ResourceWithModifyPlan     = &FleetResource{}
-	_ resource.ResourceWithValidateConfig = &FleetResource{}
+	_ resource.Resource                     = &FleetResource{}
+	_ resource.ResourceWithConfigure        = &FleetResource{}
+	_ resource.ResourceWithImportState      = &FleetResource{}
+	_ resource.ResourceWithIdentity         = &FleetResource{}
+	_ resource.ResourceWithMoveState        = &FleetResource{}
+	_ resource.ResourceWithModifyPlan       = &FleetResource{}
+	_ resource.ResourceWithValidateConfig   = &FleetResource{}
+	_ resource.ResourceWithConfigValidators = &FleetResource{}
+	_ listableResource                      = &FleetResource{}
 )
 
 func NewFleetResource() resource.Resource {
@@ -580,14 +587,16 @@ var FleetStorageDeviceListStorageDevicesHpeStorageIscsiChapPasswordBlindfoldSecr
 
 // FleetStorageDeviceListStorageDevicesHpeStorageIscsiChapPasswordClearSecretInfoModel represents clear_secret_info block
 type FleetStorageDeviceListStorageDevicesHpeStorageIscsiChapPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // FleetStorageDeviceListStorageDevicesHpeStorageIscsiChapPasswordClearSecretInfoModelAttrTypes defines the attribute types for FleetStorageDeviceListStorageDevicesHpeStorageIscsiChapPasswordClearSecretInfoModel
 var FleetStorageDeviceListStorageDevicesHpeStorageIscsiChapPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // FleetStorageDeviceListStorageDevicesHpeStoragePasswordModel represents password block
@@ -618,14 +627,16 @@ var FleetStorageDeviceListStorageDevicesHpeStoragePasswordBlindfoldSecretInfoMod
 
 // FleetStorageDeviceListStorageDevicesHpeStoragePasswordClearSecretInfoModel represents clear_secret_info block
 type FleetStorageDeviceListStorageDevicesHpeStoragePasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // FleetStorageDeviceListStorageDevicesHpeStoragePasswordClearSecretInfoModelAttrTypes defines the attribute types for FleetStorageDeviceListStorageDevicesHpeStoragePasswordClearSecretInfoModel
 var FleetStorageDeviceListStorageDevicesHpeStoragePasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentModel represents netapp_trident block
@@ -730,14 +741,16 @@ var FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasClient
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasClientPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasClientPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasClientPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasClientPrivateKeyClearSecretInfoModel
 var FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasClientPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasPasswordModel represents password block
@@ -768,14 +781,16 @@ var FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasPasswo
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasPasswordClearSecretInfoModel represents clear_secret_info block
 type FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasPasswordClearSecretInfoModelAttrTypes defines the attribute types for FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasPasswordClearSecretInfoModel
 var FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapNasStorageModel represents storage block
@@ -938,14 +953,16 @@ var FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanClient
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanClientPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanClientPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanClientPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanClientPrivateKeyClearSecretInfoModel
 var FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanClientPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanPasswordModel represents password block
@@ -976,14 +993,16 @@ var FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanPasswo
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanPasswordClearSecretInfoModel represents clear_secret_info block
 type FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanPasswordClearSecretInfoModelAttrTypes defines the attribute types for FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanPasswordClearSecretInfoModel
 var FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanStorageModel represents storage block
@@ -1078,14 +1097,16 @@ var FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseCha
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseChapChapInitiatorSecretClearSecretInfoModel represents clear_secret_info block
 type FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseChapChapInitiatorSecretClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseChapChapInitiatorSecretClearSecretInfoModelAttrTypes defines the attribute types for FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseChapChapInitiatorSecretClearSecretInfoModel
 var FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseChapChapInitiatorSecretClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseChapChapTargetInitiatorSecretModel represents chap_target_initiator_secret block
@@ -1116,14 +1137,16 @@ var FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseCha
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseChapChapTargetInitiatorSecretClearSecretInfoModel represents clear_secret_info block
 type FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseChapChapTargetInitiatorSecretClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseChapChapTargetInitiatorSecretClearSecretInfoModelAttrTypes defines the attribute types for FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseChapChapTargetInitiatorSecretClearSecretInfoModel
 var FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanUseChapChapTargetInitiatorSecretClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // FleetStorageDeviceListStorageDevicesNetappTridentNetappBackendOntapSanVolumeDefaultsModel represents volume_defaults block
@@ -1254,14 +1277,16 @@ var FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashArrayF
 
 // FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashArrayFlashArraysAPITokenClearSecretInfoModel represents clear_secret_info block
 type FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashArrayFlashArraysAPITokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashArrayFlashArraysAPITokenClearSecretInfoModelAttrTypes defines the attribute types for FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashArrayFlashArraysAPITokenClearSecretInfoModel
 var FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashArrayFlashArraysAPITokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashBladeModel represents flash_blade block
@@ -1326,14 +1351,16 @@ var FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashBladeF
 
 // FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashBladeFlashBladesAPITokenClearSecretInfoModel represents clear_secret_info block
 type FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashBladeFlashBladesAPITokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashBladeFlashBladesAPITokenClearSecretInfoModelAttrTypes defines the attribute types for FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashBladeFlashBladesAPITokenClearSecretInfoModel
 var FleetStorageDeviceListStorageDevicesPureServiceOrchestratorArraysFlashBladeFlashBladesAPITokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // FleetStorageInterfaceListModel represents storage_interface_list block
@@ -1513,6 +1540,8 @@ type FleetResourceModel struct {
 	OperatingSystemVersion           types.String                          `tfsdk:"operating_system_version"`
 	VolterraSoftwareVersion          types.String                          `tfsdk:"volterra_software_version"`
 	Timeouts                         timeouts.Value                        `tfsdk:"timeouts"`
+	SystemMetadata                   types.Object                          `tfsdk:"system_metadata"`
+	LabelsAll                        types.Map                             `tfsdk:"labels_all"`
 	AllowAllUsb                      *FleetEmptyModel                      `tfsdk:"allow_all_usb"`
 	BlockedServices                  types.List                            `tfsdk:"blocked_services"`
 	BondDeviceList                   *FleetBondDeviceListModel             `tfsdk:"bond_device_list"`
@@ -1569,9 +1598,11 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Fleet will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Fleet will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -1626,6 +1657,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(256),
+				},
 			},
 			"volterra_software_version": schema.StringAttribute{
 				MarkdownDescription: "F5XC software version is human readable string matching released set of version components. The given software version is applied to all sites that are member of the fleet. Current software installed can be overridden via site config.",
@@ -1634,7 +1668,12 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(256),
+				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -1648,6 +1687,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"blocked_services": schema.ListNestedBlock{
 				MarkdownDescription: "Disable node local services on this site.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(6),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"network_type": schema.StringAttribute{
@@ -1674,24 +1717,44 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Blocks: map[string]schema.Block{
 					"bond_devices": schema.ListNestedBlock{
 						MarkdownDescription: "Bond Devices. List of bond devices .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(4),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"devices": schema.ListAttribute{
 									MarkdownDescription: "Ethernet devices that will make up this bond .",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(8),
+										listvalidator.SizeAtLeast(1),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(64)),
+									},
 								},
 								"link_polling_interval": schema.Int64Attribute{
 									MarkdownDescription: "Link polling interval in milliseconds .",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(500),
+										int64validator.AtMost(5000),
+									},
 								},
 								"link_up_delay": schema.Int64Attribute{
 									MarkdownDescription: "Milliseconds wait before link is declared up .",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(1000),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name for the Bond. Ex 'bond0' .",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(64),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -1704,6 +1767,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"rate": schema.Int64Attribute{
 											MarkdownDescription: "Interval in seconds to transmit LACP packets.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+												int64validator.AtMost(30),
+											},
 										},
 									},
 								},
@@ -1718,6 +1785,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					"name": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(128),
+							stringvalidator.LengthAtLeast(1),
+						},
 					},
 					"namespace": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1726,6 +1797,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 					"tenant": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1734,6 +1808,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 				},
 			},
@@ -1743,6 +1820,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					"name": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(128),
+							stringvalidator.LengthAtLeast(1),
+						},
 					},
 					"namespace": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1751,6 +1832,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 					"tenant": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1759,6 +1843,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 				},
 			},
@@ -1780,6 +1867,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Blocks: map[string]schema.Block{
 					"devices": schema.ListNestedBlock{
 						MarkdownDescription: "Configuration for all devices in the fleet. Examples of devices are - network interfaces, cameras, scanners etc. Configuration a device is applied on VER node if the VER node is member of this fleet and has an corresponding interface/device.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(8),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
@@ -1803,6 +1893,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									Blocks: map[string]schema.Block{
 										"interface": schema.ListNestedBlock{
 											MarkdownDescription: "Network Interface attributes for the device. User network interface configuration for this network device. Attributes like labels, MTU from the 'interface' are applied to corresponding interface in VER node If network interface refers to a virtual-network, the virtual-netowrk type must be..",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"kind": schema.StringAttribute{
@@ -1874,6 +1967,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					"server_port": schema.Int64Attribute{
 						MarkdownDescription: "License Server Port Number. Set License Server port number.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(65535),
+						},
 					},
 				},
 			},
@@ -1882,6 +1979,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"inside_virtual_network": schema.ListNestedBlock{
 				MarkdownDescription: "Default inside (site local) virtual network for the fleet.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"kind": schema.StringAttribute{
@@ -1929,11 +2030,18 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Blocks: map[string]schema.Block{
 					"interfaces": schema.ListNestedBlock{
 						MarkdownDescription: "Add all interfaces belonging to this fleet .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(256),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1942,6 +2050,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1950,6 +2061,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -1969,10 +2083,18 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							"drain_max_unavailable_node_count": schema.Int64Attribute{
 								MarkdownDescription: "Node Batch Size Count.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(5000),
+								},
 							},
 							"drain_node_timeout": schema.Int64Attribute{
 								MarkdownDescription: "Seconds to wait before initiating upgrade on the next set of nodes. Setting it to 0 will wait indefinitely for all services on nodes to be upgraded gracefully before proceeding to the next set of nodes. (Warning: It may block upgrade if services on a node cannot be gracefully upgraded. It is..",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+									int64validator.AtMost(900),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -1992,6 +2114,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					"name": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(128),
+							stringvalidator.LengthAtLeast(1),
+						},
 					},
 					"namespace": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2000,6 +2126,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 					"tenant": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2008,6 +2137,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 				},
 			},
@@ -2016,6 +2148,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"network_connectors": schema.ListNestedBlock{
 				MarkdownDescription: "Network Connector defines connection between two virtual networks in a given site. Fleet defines one or more such network connectors. The network connectors configuration is applied on all sites that are member of the fleet.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(8),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"kind": schema.StringAttribute{
@@ -2059,6 +2195,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"network_firewall": schema.ListNestedBlock{
 				MarkdownDescription: "Network Firewall defines firewall to be applied for the virtual networks in the fleet. The network firewall configuration is applied on all sites that are member of the fleet. Constraints The Network Firewall is applied on Virtual Networks of type site local network and site local inside network.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"kind": schema.StringAttribute{
@@ -2117,6 +2257,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			},
 			"outside_virtual_network": schema.ListNestedBlock{
 				MarkdownDescription: "Default outside (site local) virtual network for the fleet.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"kind": schema.StringAttribute{
@@ -2210,6 +2354,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Blocks: map[string]schema.Block{
 					"storage_classes": schema.ListNestedBlock{
 						MarkdownDescription: "List of Storage Classes. List of custom storage classes.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(4),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"allow_volume_expansion": schema.BoolAttribute{
@@ -2223,10 +2370,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Storage Class Description. Description for this storage class.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"reclaim_policy": schema.StringAttribute{
 									MarkdownDescription: "Policy configuration for this feature.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(16),
+									},
 								},
 								"storage_class_name": schema.StringAttribute{
 									MarkdownDescription: "Name of the storage class as it will appear in K8s.",
@@ -2235,6 +2388,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								"storage_device": schema.StringAttribute{
 									MarkdownDescription: "Storage device that this class will use. The Device name defined at previous step.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -2247,6 +2404,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"yaml": schema.StringAttribute{
 											MarkdownDescription: "Storage Class YAML. K8s YAML for StorageClass.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(4096),
+											},
 										},
 									},
 								},
@@ -2256,10 +2416,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"allow_mutations": schema.StringAttribute{
 											MarkdownDescription: "Mutation can override specified parameters.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"allow_overrides": schema.StringAttribute{
 											MarkdownDescription: "AllowOverrides. PVC can override specified parameters.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"dedupe_enabled": schema.BoolAttribute{
 											MarkdownDescription: "Indicates that the volume should enable deduplication.",
@@ -2268,6 +2434,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "The SecretName parameter is used to identify name of secret to identify backend storage's auth information.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(512),
+											},
 										},
 										"destroy_on_delete": schema.BoolAttribute{
 											MarkdownDescription: "Indicates the backing Nimble volume (including snapshots) should be destroyed when the PVC is deleted.",
@@ -2280,6 +2449,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"folder": schema.StringAttribute{
 											MarkdownDescription: "The name of the folder in which to place the volume.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"limit_iops": schema.StringAttribute{
 											MarkdownDescription: "LimitIops. The IOPS limit of the volume.",
@@ -2292,22 +2464,37 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"performance_policy": schema.StringAttribute{
 											MarkdownDescription: "Policy configuration for this feature.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"pool": schema.StringAttribute{
 											MarkdownDescription: "The name of the pool in which to place the volume.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"protection_template": schema.StringAttribute{
 											MarkdownDescription: "The name of the performance policy to assign to the volume.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"secret_name": schema.StringAttribute{
 											MarkdownDescription: "The SecretName parameter is used to identify name of secret to identify backend storage's auth information.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"secret_namespace": schema.StringAttribute{
 											MarkdownDescription: "The SecretNamespace parameter is used to identify name of namespace where secret resides.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"sync_on_detach": schema.BoolAttribute{
 											MarkdownDescription: "Indicates that a snapshot of the volume should be synced to the replication partner each time it is detached from a node.",
@@ -2325,6 +2512,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"storage_pools": schema.StringAttribute{
 											MarkdownDescription: "The storagePools parameter is used to further restrict the set of pools that match any specified attributes.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(512),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -2343,6 +2533,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"bandwidth_limit": schema.StringAttribute{
 											MarkdownDescription: "It must be between 1 MB/s and 512 GB/s. Enter the size as a number (bytes must be multiple of 512) or number with a single character unit symbol. Valid unit symbols are K, M, G, representing KiB, MiB, and GiB.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(12),
+											},
 										},
 										"iops_limit": schema.Int64Attribute{
 											MarkdownDescription: "Enable IOPS limitation. It must be between 100 and 100 million. If value is 0, IOPS limit is not defined.",
@@ -2361,11 +2554,17 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Blocks: map[string]schema.Block{
 					"storage_devices": schema.ListNestedBlock{
 						MarkdownDescription: "List of Storage Devices. List of custom storage devices.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(4),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"storage_device": schema.StringAttribute{
 									MarkdownDescription: "Storage device and device unit .",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(64),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -2381,22 +2580,39 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"api_server_port": schema.Int64Attribute{
 											MarkdownDescription: "Storage server Port. Enter Storage Server Port.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+												int64validator.AtMost(65535),
+											},
 										},
 										"iscsi_chap_user": schema.StringAttribute{
 											MarkdownDescription: "Chap Username to connect to the HPE storage.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"storage_server_ip_address": schema.StringAttribute{
 											MarkdownDescription: "Storage Server IP address. Enter storage server IP address.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 										"storage_server_name": schema.StringAttribute{
 											MarkdownDescription: "Storage Server Name. Enter storage server Name.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.HostnameValidator(),
+											},
 										},
 										"username": schema.StringAttribute{
 											MarkdownDescription: "Username to connect to the HPE storage management IP .",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -2429,7 +2645,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -2465,7 +2690,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -2488,18 +2722,32 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"backend_name": schema.StringAttribute{
 													MarkdownDescription: "Configuration of Backend Name. Driver is name + '_' + dataLIF.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(50),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"client_certificate": schema.StringAttribute{
 													MarkdownDescription: "Please Enter Base64-encoded value of client certificate. Used for certificate-based auth.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(8192),
+													},
 												},
 												"data_lif_dns_name": schema.StringAttribute{
 													MarkdownDescription: "Backend Data LIF IP Address's IP address is discovered using DNS name resolution. The name given here is fully qualified domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"data_lif_ip": schema.StringAttribute{
 													MarkdownDescription: "Backend Data LIF IP Address is reachable at the given IP address.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.IPValidator(),
+													},
 												},
 												"limit_aggregate_usage": schema.StringAttribute{
 													MarkdownDescription: "Fail provisioning if usage is above this percentage. Not enforced by default.",
@@ -2512,10 +2760,17 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"management_lif_dns_name": schema.StringAttribute{
 													MarkdownDescription: "Backend Management LIF IP Address's IP address is discovered using DNS name resolution. The name given here is fully qualified domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"management_lif_ip": schema.StringAttribute{
 													MarkdownDescription: "Backend Management LIF IP Address is reachable at the given IP address.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.IPValidator(),
+													},
 												},
 												"nfs_mount_options": schema.StringAttribute{
 													MarkdownDescription: "Comma-separated list of NFS mount OPTIONS. Not enforced by default.",
@@ -2536,14 +2791,25 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"svm": schema.StringAttribute{
 													MarkdownDescription: "Storage virtual machine to use. Derived if an SVM managementLIF is specified.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"trusted_ca_certificate": schema.StringAttribute{
 													MarkdownDescription: "Please Enter Base64-encoded value of trusted CA certificate. Optional. Used for certificate-based auth.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(8192),
+													},
 												},
 												"username": schema.StringAttribute{
 													MarkdownDescription: "Username to connect to the cluster/SVM .",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -2554,6 +2820,11 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 															MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(128),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+															},
 														},
 													},
 												},
@@ -2586,7 +2857,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -2625,7 +2905,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -2634,6 +2923,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												},
 												"storage": schema.ListNestedBlock{
 													MarkdownDescription: "List of Virtual Storage Pool definitions which are referred back by Storage Class label match selection.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(8),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"zone": schema.StringAttribute{
@@ -2651,6 +2943,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	"adaptive_qos_policy": schema.StringAttribute{
 																		MarkdownDescription: "Policy configuration for this feature.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			stringvalidator.UTF8LengthAtMost(128),
+																			stringvalidator.UTF8LengthAtLeast(1),
+																		},
 																	},
 																	"encryption": schema.BoolAttribute{
 																		MarkdownDescription: "Enable Encryption. Enable NetApp volume encryption.",
@@ -2663,6 +2959,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	"qos_policy": schema.StringAttribute{
 																		MarkdownDescription: "Policy configuration for this feature.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			stringvalidator.UTF8LengthAtMost(128),
+																			stringvalidator.UTF8LengthAtLeast(1),
+																		},
 																	},
 																	"security_style": schema.StringAttribute{
 																		MarkdownDescription: "Security Style. Security style for new volumes.",
@@ -2712,6 +3012,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														"adaptive_qos_policy": schema.StringAttribute{
 															MarkdownDescription: "Policy configuration for this feature.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"encryption": schema.BoolAttribute{
 															MarkdownDescription: "Enable Encryption. Enable NetApp volume encryption.",
@@ -2724,6 +3028,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														"qos_policy": schema.StringAttribute{
 															MarkdownDescription: "Policy configuration for this feature.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"security_style": schema.StringAttribute{
 															MarkdownDescription: "Security Style. Security style for new volumes.",
@@ -2772,22 +3080,40 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"client_certificate": schema.StringAttribute{
 													MarkdownDescription: "Please Enter Base64-encoded value of client certificate. Used for certificate-based auth.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(8192),
+													},
 												},
 												"data_lif_dns_name": schema.StringAttribute{
 													MarkdownDescription: "Backend Data LIF IP Address's IP address is discovered using DNS name resolution. The name given here is fully qualified domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"data_lif_ip": schema.StringAttribute{
 													MarkdownDescription: "Backend Data LIF IP Address is reachable at the given IP address.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.IPValidator(),
+													},
 												},
 												"igroup_name": schema.StringAttribute{
 													MarkdownDescription: "Name of the igroup for SAN volumes to use.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"limit_aggregate_usage": schema.Int64Attribute{
 													MarkdownDescription: "Fail provisioning if usage is above this percentage. Not enforced by default.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(0),
+														int64validator.AtMost(100),
+													},
 												},
 												"limit_volume_size": schema.Int64Attribute{
 													MarkdownDescription: "Fail provisioning if requested volume size in GBi is above this value. Not enforced by default.",
@@ -2796,10 +3122,17 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"management_lif_dns_name": schema.StringAttribute{
 													MarkdownDescription: "Backend Management LIF IP Address's IP address is discovered using DNS name resolution. The name given here is fully qualified domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"management_lif_ip": schema.StringAttribute{
 													MarkdownDescription: "Backend Management LIF IP Address is reachable at the given IP address.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.IPValidator(),
+													},
 												},
 												"region": schema.StringAttribute{
 													MarkdownDescription: "Backend Region. Virtual Pool Region.",
@@ -2812,18 +3145,33 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"storage_prefix": schema.StringAttribute{
 													MarkdownDescription: "Prefix used when provisioning new volumes in the SVM. Once set this cannot be updated.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(80),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"svm": schema.StringAttribute{
 													MarkdownDescription: "Storage virtual machine to use. Derived if an SVM managementLIF is specified.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"trusted_ca_certificate": schema.StringAttribute{
 													MarkdownDescription: "Please Enter Base64-encoded value of trusted CA certificate. Optional. Used for certificate-based auth.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(8192),
+													},
 												},
 												"username": schema.StringAttribute{
 													MarkdownDescription: "Username to connect to the cluster/SVM .",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -2856,7 +3204,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -2898,7 +3255,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -2907,6 +3273,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												},
 												"storage": schema.ListNestedBlock{
 													MarkdownDescription: "List of Virtual Storage Pool definitions which are referred back by Storage Class label match selection.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(8),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"zone": schema.StringAttribute{
@@ -2924,6 +3293,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	"adaptive_qos_policy": schema.StringAttribute{
 																		MarkdownDescription: "Policy configuration for this feature.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			stringvalidator.UTF8LengthAtMost(128),
+																			stringvalidator.UTF8LengthAtLeast(1),
+																		},
 																	},
 																	"encryption": schema.BoolAttribute{
 																		MarkdownDescription: "Enable Encryption. Enable NetApp volume encryption.",
@@ -2936,6 +3309,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	"qos_policy": schema.StringAttribute{
 																		MarkdownDescription: "Policy configuration for this feature.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			stringvalidator.UTF8LengthAtMost(128),
+																			stringvalidator.UTF8LengthAtLeast(1),
+																		},
 																	},
 																	"security_style": schema.StringAttribute{
 																		MarkdownDescription: "Security Style. Security style for new volumes.",
@@ -2985,10 +3362,18 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														"chap_target_username": schema.StringAttribute{
 															MarkdownDescription: "Target username. Required if useCHAP=true.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"chap_username": schema.StringAttribute{
 															MarkdownDescription: "Inbound username. Required if useCHAP=true.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -3021,7 +3406,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																			Optional:            true,
 																		},
 																		"url": schema.StringAttribute{
-																			MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																			MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																			Optional:            true,
+																			Sensitive:           true,
+																			WriteOnly:           true,
+																			Validators: []validator.String{
+																				stringvalidator.LengthAtMost(131072),
+																			},
+																		},
+																		"url_version": schema.Int64Attribute{
+																			MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																			Optional:            true,
 																		},
 																	},
@@ -3057,7 +3451,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																			Optional:            true,
 																		},
 																		"url": schema.StringAttribute{
-																			MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																			MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																			Optional:            true,
+																			Sensitive:           true,
+																			WriteOnly:           true,
+																			Validators: []validator.String{
+																				stringvalidator.LengthAtMost(131072),
+																			},
+																		},
+																		"url_version": schema.Int64Attribute{
+																			MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																			Optional:            true,
 																		},
 																	},
@@ -3072,6 +3475,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														"adaptive_qos_policy": schema.StringAttribute{
 															MarkdownDescription: "Policy configuration for this feature.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"encryption": schema.BoolAttribute{
 															MarkdownDescription: "Enable Encryption. Enable NetApp volume encryption.",
@@ -3084,6 +3491,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														"qos_policy": schema.StringAttribute{
 															MarkdownDescription: "Policy configuration for this feature.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"security_style": schema.StringAttribute{
 															MarkdownDescription: "Security Style. Security style for new volumes.",
@@ -3134,6 +3545,11 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"cluster_id": schema.StringAttribute{
 											MarkdownDescription: "ClusterID is added as a prefix for all volumes created by this PSO installation. ClusterID is also used to identify the volumes used by the datastore, pso-db. ClusterID MUST BE UNIQUE for multiple K8s clusters running on top of the same storage arrays.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(22),
+												stringvalidator.UTF8LengthAtLeast(1),
+												validators.PatternValidator("^[a-z0-9_]*$"),
+											},
 										},
 										"enable_storage_topology": schema.BoolAttribute{
 											MarkdownDescription: "Option is to enable/disable the csi topology feature for pso-csi.",
@@ -3155,6 +3571,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														"default_fs_opt": schema.StringAttribute{
 															MarkdownDescription: "Block volume default mkfs OPTIONS. Not recommended to change!",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"default_fs_type": schema.StringAttribute{
 															MarkdownDescription: "Block volume default filesystem type. Not recommended to change!",
@@ -3164,6 +3584,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 															MarkdownDescription: "Block volume default filesystem mount OPTIONS. Not recommended to change!",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+																listvalidator.UniqueValues(),
+															},
 														},
 														"disable_preempt_attachments": schema.BoolAttribute{
 															MarkdownDescription: "Disable Preempt Attachments. Enable/Disable attachment preemption!",
@@ -3172,6 +3596,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														"iscsi_login_timeout": schema.Int64Attribute{
 															MarkdownDescription: "ISCSI login timeout in seconds. Not recommended to change!",
 															Optional:            true,
+															Validators: []validator.Int64{
+																int64validator.AtLeast(1),
+																int64validator.AtMost(100),
+															},
 														},
 														"san_type": schema.StringAttribute{
 															MarkdownDescription: "Block volume access protocol, either ISCSI or FC .",
@@ -3181,15 +3609,25 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													Blocks: map[string]schema.Block{
 														"flash_arrays": schema.ListNestedBlock{
 															MarkdownDescription: "For FlashArrays you must set the 'mgmt_endpoint' and 'api_token' .",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(8),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"mgmt_dns_name": schema.StringAttribute{
 																		MarkdownDescription: "Management Endpoint's IP address is discovered using DNS name resolution. The name given here is fully qualified domain name.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			validators.HostnameValidator(),
+																			stringvalidator.UTF8LengthAtMost(256),
+																		},
 																	},
 																	"mgmt_ip": schema.StringAttribute{
 																		MarkdownDescription: "Management Endpoint is reachable at the given IP address.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			validators.IPValidator(),
+																		},
 																	},
 																},
 																Blocks: map[string]schema.Block{
@@ -3222,7 +3660,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																						Optional:            true,
 																					},
 																					"url": schema.StringAttribute{
-																						MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																						MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																						Optional:            true,
+																						Sensitive:           true,
+																						WriteOnly:           true,
+																						Validators: []validator.String{
+																							stringvalidator.LengthAtMost(131072),
+																						},
+																					},
+																					"url_version": schema.Int64Attribute{
+																						MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																						Optional:            true,
 																					},
 																				},
@@ -3247,28 +3694,49 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														"export_rules": schema.StringAttribute{
 															MarkdownDescription: "NFS Export Rules. NFS Export rules.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(250),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
 														"flash_blades": schema.ListNestedBlock{
 															MarkdownDescription: "For FlashBlades you must set the 'mgmt_endpoint', 'api_token' and nfs_endpoint .",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(8),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"mgmt_dns_name": schema.StringAttribute{
 																		MarkdownDescription: "Management Endpoint's IP address is discovered using DNS name resolution. The name given here is fully qualified domain name.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			validators.HostnameValidator(),
+																			stringvalidator.UTF8LengthAtMost(256),
+																		},
 																	},
 																	"mgmt_ip": schema.StringAttribute{
 																		MarkdownDescription: "Management Endpoint is reachable at the given IP address.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			validators.IPValidator(),
+																		},
 																	},
 																	"nfs_endpoint_dns_name": schema.StringAttribute{
 																		MarkdownDescription: "Endpoint's IP address is discovered using DNS name resolution. The name given here is fully qualified domain name.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			validators.HostnameValidator(),
+																			stringvalidator.UTF8LengthAtMost(256),
+																		},
 																	},
 																	"nfs_endpoint_ip": schema.StringAttribute{
 																		MarkdownDescription: "Endpoint is reachable at the given IP address.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			validators.IPValidator(),
+																		},
 																	},
 																},
 																Blocks: map[string]schema.Block{
@@ -3301,7 +3769,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																						Optional:            true,
 																					},
 																					"url": schema.StringAttribute{
-																						MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																						MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																						Optional:            true,
+																						Sensitive:           true,
+																						WriteOnly:           true,
+																						Validators: []validator.String{
+																							stringvalidator.LengthAtMost(131072),
+																						},
+																					},
+																					"url_version": schema.Int64Attribute{
+																						MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																						Optional:            true,
 																					},
 																				},
@@ -3331,11 +3808,18 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Blocks: map[string]schema.Block{
 					"interfaces": schema.ListNestedBlock{
 						MarkdownDescription: "Add all interfaces belonging to this fleet .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(256),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -3344,6 +3828,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -3352,6 +3839,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -3364,12 +3854,18 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Blocks: map[string]schema.Block{
 					"storage_routes": schema.ListNestedBlock{
 						MarkdownDescription: "List of storage static routes .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(8),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"attrs": schema.ListAttribute{
 									MarkdownDescription: "[Enum: ROUTE_ATTR_NO_OP|ROUTE_ATTR_ADVERTISE|ROUTE_ATTR_INSTALL_HOST|ROUTE_ATTR_INSTALL_FORWARDING|ROUTE_ATTR_MERGE_ONLY] List of route attributes associated with the static route. Possible values are `ROUTE_ATTR_NO_OP`, `ROUTE_ATTR_ADVERTISE`, `ROUTE_ATTR_INSTALL_HOST`, `ROUTE_ATTR_INSTALL_FORWARDING`, `ROUTE_ATTR_MERGE_ONLY`. Defaults to `ROUTE_ATTR_NO_OP`.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(4),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -3387,6 +3883,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									Blocks: map[string]schema.Block{
 										"interface": schema.ListNestedBlock{
 											MarkdownDescription: "Nexthop is network interface when type is 'Network-Interface'.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"kind": schema.StringAttribute{
@@ -3438,6 +3937,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														"addr": schema.StringAttribute{
 															MarkdownDescription: "IPv4 Address in string form with dot-decimal notation.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.IPv4Validator(),
+															},
 														},
 													},
 												},
@@ -3447,6 +3949,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														"addr": schema.StringAttribute{
 															MarkdownDescription: "IPv6 Address in form of string. IPv6 address must be specified as hexadecimal numbers separated by ':' The address can be compacted by suppressing zeros e.g. '2001:db8:0:0:0:0:2:1' becomes '2001:db8::2:1' or '2001:db8:0:0:0:2:0:0' becomes '2001:db8::2::'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.IPv6Validator(),
+															},
 														},
 													},
 												},
@@ -3456,6 +3961,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								},
 								"subnets": schema.ListNestedBlock{
 									MarkdownDescription: "Subnets. List of route prefixes .",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(256),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{},
 										Blocks: map[string]schema.Block{
@@ -3465,10 +3973,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													"plen": schema.Int64Attribute{
 														MarkdownDescription: "Prefix-length of the IPv4 subnet. Must be <= 32.",
 														Optional:            true,
+														Validators: []validator.Int64{
+															int64validator.AtMost(32),
+														},
 													},
 													"prefix": schema.StringAttribute{
 														MarkdownDescription: "Prefix part of the IPv4 subnet in string form with dot-decimal notation.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.IPv4Validator(),
+														},
 													},
 												},
 											},
@@ -3478,10 +3992,16 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													"plen": schema.Int64Attribute{
 														MarkdownDescription: "Prefix length of the IPv6 subnet. Must be <= 128.",
 														Optional:            true,
+														Validators: []validator.Int64{
+															int64validator.AtMost(128),
+														},
 													},
 													"prefix": schema.StringAttribute{
 														MarkdownDescription: "Prefix part of the IPv6 subnet given in form of string. IPv6 address must be specified as hexadecimal numbers separated by ':' e.g. '2001:db8:0:0:0:2:0:0' The address can be compacted by suppressing zeros e.g. '2001:db8::2::'.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.IPv6Validator(),
+														},
 													},
 												},
 											},
@@ -3499,6 +4019,10 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					"name": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(128),
+							stringvalidator.LengthAtLeast(1),
+						},
 					},
 					"namespace": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -3507,6 +4031,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 					"tenant": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -3515,6 +4042,9 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 				},
 			},
@@ -3522,6 +4052,11 @@ func (r *FleetResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *FleetResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *FleetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -3546,6 +4081,58 @@ func (r *FleetResource) ValidateConfig(ctx context.Context, req resource.Validat
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *FleetResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "bond_choice", "bond_device_list", "no_bond_devices"),
+		validators.OneOfGroup(path.MatchRelative(), "dc_cluster_group_choice", "dc_cluster_group", "dc_cluster_group_inside", "no_dc_cluster_group"),
+		validators.OneOfGroup(path.MatchRelative(), "gpu_choice", "disable_gpu", "enable_gpu", "enable_vgpu"),
+		validators.OneOfGroup(path.MatchRelative(), "interface_choice", "default_config", "device_list", "interface_list"),
+		validators.OneOfGroup(path.MatchRelative(), "logs_receiver_choice", "log_receiver", "logs_streaming_disabled"),
+		validators.OneOfGroup(path.MatchRelative(), "sriov_interface_choice", "default_sriov_interface", "sriov_interfaces"),
+		validators.OneOfGroup(path.MatchRelative(), "storage_class_choice", "default_storage_class", "storage_class_list"),
+		validators.OneOfGroup(path.MatchRelative(), "storage_device_choice", "no_storage_device", "storage_device_list"),
+		validators.OneOfGroup(path.MatchRelative(), "storage_interface_choice", "no_storage_interfaces", "storage_interface_list"),
+		validators.OneOfGroup(path.MatchRelative(), "storage_static_routes_choice", "no_storage_static_routes", "storage_static_routes"),
+		validators.OneOfGroup(path.MatchRelative(), "usb_policy_choice", "allow_all_usb", "deny_all_usb", "usb_policy"),
+		validators.OneOfGroup(path.MatchRelative(), "vm_choice", "disable_vm", "enable_vm"),
+		validators.OneOfGroup(path.MatchRoot("blocked_services").AtAnyListIndex(), "blocked_services_value_type_choice", "dns", "ssh", "web_user_interface"),
+		validators.OneOfGroup(path.MatchRoot("bond_device_list").AtName("bond_devices").AtAnyListIndex(), "lacp_choice", "active_backup", "lacp"),
+		validators.OneOfGroup(path.MatchRoot("kubernetes_upgrade_drain"), "kubernetes_upgrade_drain_enable_choice", "disable_upgrade_drain", "enable_upgrade_drain"),
+		validators.OneOfGroup(path.MatchRoot("kubernetes_upgrade_drain").AtName("enable_upgrade_drain"), "vega_upgrade_mode_toggle_choice", "disable_vega_upgrade_mode", "enable_vega_upgrade_mode"),
+		validators.OneOfGroup(path.MatchRoot("performance_enhancement_mode"), "perf_mode_choice", "perf_mode_l3_enhanced", "perf_mode_l7_enhanced"),
+		validators.OneOfGroup(path.MatchRoot("performance_enhancement_mode").AtName("perf_mode_l3_enhanced"), "perf_mode_choice", "jumbo", "no_jumbo"),
+		validators.OneOfGroup(path.MatchRoot("storage_class_list").AtName("storage_classes").AtAnyListIndex(), "device_choice", "custom_storage", "hpe_storage", "netapp_trident", "pure_service_orchestrator"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex(), "device_choice", "custom_storage", "hpe_storage", "netapp_trident", "pure_service_orchestrator"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("hpe_storage").AtName("iscsi_chap_password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("hpe_storage").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident"), "backend_choice", "netapp_backend_ontap_nas", "netapp_backend_ontap_san"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_nas"), "data_lif", "data_lif_dns_name", "data_lif_ip"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_nas"), "management_lif", "management_lif_dns_name", "management_lif_ip"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_nas").AtName("client_private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_nas").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_nas").AtName("storage").AtAnyListIndex().AtName("volume_defaults"), "qos_policy_choice", "adaptive_qos_policy", "no_qos", "qos_policy"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_nas").AtName("volume_defaults"), "qos_policy_choice", "adaptive_qos_policy", "no_qos", "qos_policy"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_san"), "chap_choice", "no_chap", "use_chap"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_san"), "data_lif", "data_lif_dns_name", "data_lif_ip"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_san"), "management_lif", "management_lif_dns_name", "management_lif_ip"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_san").AtName("client_private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_san").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_san").AtName("storage").AtAnyListIndex().AtName("volume_defaults"), "qos_policy_choice", "adaptive_qos_policy", "no_qos", "qos_policy"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_san").AtName("use_chap").AtName("chap_initiator_secret"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_san").AtName("use_chap").AtName("chap_target_initiator_secret"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("netapp_trident").AtName("netapp_backend_ontap_san").AtName("volume_defaults"), "qos_policy_choice", "adaptive_qos_policy", "no_qos", "qos_policy"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("pure_service_orchestrator").AtName("arrays").AtName("flash_array").AtName("flash_arrays").AtAnyListIndex(), "mgmt_endpoint", "mgmt_dns_name", "mgmt_ip"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("pure_service_orchestrator").AtName("arrays").AtName("flash_array").AtName("flash_arrays").AtAnyListIndex().AtName("api_token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("pure_service_orchestrator").AtName("arrays").AtName("flash_blade").AtName("flash_blades").AtAnyListIndex(), "mgmt_endpoint", "mgmt_dns_name", "mgmt_ip"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("pure_service_orchestrator").AtName("arrays").AtName("flash_blade").AtName("flash_blades").AtAnyListIndex(), "nfs_endpoint", "nfs_endpoint_dns_name", "nfs_endpoint_ip"),
+		validators.OneOfGroup(path.MatchRoot("storage_device_list").AtName("storage_devices").AtAnyListIndex().AtName("pure_service_orchestrator").AtName("arrays").AtName("flash_blade").AtName("flash_blades").AtAnyListIndex().AtName("api_token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("storage_static_routes").AtName("storage_routes").AtAnyListIndex().AtName("nexthop").AtName("nexthop_address"), "ver", "ipv4", "ipv6"),
+		validators.OneOfGroup(path.MatchRoot("storage_static_routes").AtName("storage_routes").AtAnyListIndex().AtName("subnets").AtAnyListIndex(), "ver", "ipv4", "ipv6"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *FleetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -3556,7 +4143,10 @@ func (r *FleetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "fleet", "system", req.Config, &resp.Plan)...)
 		var plan FleetResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -3574,7 +4164,8 @@ func (r *FleetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 
 func (r *FleetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data FleetResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -3613,6 +4204,7 @@ func (r *FleetResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -3622,6 +4214,7 @@ func (r *FleetResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AllowAllUsb != nil {
@@ -3836,12 +4429,18 @@ func (r *FleetResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		if data.KubernetesUpgradeDrain.EnableUpgradeDrain != nil {
 			enable_upgrade_drainNestedMap := make(map[string]interface{})
+			if data.KubernetesUpgradeDrain.EnableUpgradeDrain.DisableVegaUpgradeMode != nil {
+				enable_upgrade_drainNestedMap["disable_vega_upgrade_mode"] = map[string]interface{}{}
+			}
 			if !data.KubernetesUpgradeDrain.EnableUpgradeDrain.DrainMaxUnavailableNodeCount.IsNull() && !data.KubernetesUpgradeDrain.EnableUpgradeDrain.DrainMaxUnavailableNodeCount.IsUnknown() {
 				enable_upgrade_drainNestedMap["drain_max_unavailable_node_count"] = data.KubernetesUpgradeDrain.EnableUpgradeDrain.DrainMaxUnavailableNodeCount.ValueInt64()
 			}
 			if !data.KubernetesUpgradeDrain.EnableUpgradeDrain.DrainNodeTimeout.IsNull() && !data.KubernetesUpgradeDrain.EnableUpgradeDrain.DrainNodeTimeout.IsUnknown() {
 				enable_upgrade_drainNestedMap["drain_node_timeout"] = data.KubernetesUpgradeDrain.EnableUpgradeDrain.DrainNodeTimeout.ValueInt64()
 			}
+			if data.KubernetesUpgradeDrain.EnableUpgradeDrain.EnableVegaUpgradeMode != nil {
+				enable_upgrade_drainNestedMap["enable_vega_upgrade_mode"] = map[string]interface{}{}
+			}
 			kubernetes_upgrade_drainMap["enable_upgrade_drain"] = enable_upgrade_drainNestedMap
 		}
 		createReq.Spec["kubernetes_upgrade_drain"] = kubernetes_upgrade_drainMap
@@ -3971,6 +4570,12 @@ func (r *FleetResource) Create(ctx context.Context, req resource.CreateRequest,
 		performance_enhancement_modeMap := make(map[string]interface{})
 		if data.PerformanceEnhancementMode.PerfModeL3Enhanced != nil {
 			perf_mode_l3_enhancedNestedMap := make(map[string]interface{})
+			if data.PerformanceEnhancementMode.PerfModeL3Enhanced.Jumbo != nil {
+				perf_mode_l3_enhancedNestedMap["jumbo"] = map[string]interface{}{}
+			}
+			if data.PerformanceEnhancementMode.PerfModeL3Enhanced.NoJumbo != nil {
+				perf_mode_l3_enhancedNestedMap["no_jumbo"] = map[string]interface{}{}
+			}
 			performance_enhancement_modeMap["perf_mode_l3_enhanced"] = perf_mode_l3_enhancedNestedMap
 		}
 		if data.PerformanceEnhancementMode.PerfModeL7Enhanced != nil {
@@ -4131,9 +4736,63 @@ func (r *FleetResource) Create(ctx context.Context, req resource.CreateRequest,
 					if !listItem.HpeStorage.APIServerPort.IsNull() && !listItem.HpeStorage.APIServerPort.IsUnknown() {
 						hpe_storageDeepMap["api_server_port"] = listItem.HpeStorage.APIServerPort.ValueInt64()
 					}
+					if listItem.HpeStorage.IscsiChapPassword != nil {
+						iscsi_chap_passwordLevel4Map := make(map[string]interface{})
+						if listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo != nil {
+							blindfold_secret_infoLevel5Map := make(map[string]interface{})
+							if !listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+								blindfold_secret_infoLevel5Map["decryption_provider"] = listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+							}
+							if !listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.Location.IsNull() && !listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.Location.IsUnknown() {
+								blindfold_secret_infoLevel5Map["location"] = listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.Location.ValueString()
+							}
+							if !listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+								blindfold_secret_infoLevel5Map["store_provider"] = listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.StoreProvider.ValueString()
+							}
+							iscsi_chap_passwordLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+						}
+						if listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo != nil {
+							clear_secret_infoLevel5Map := make(map[string]interface{})
+							if !listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo.Provider.IsNull() && !listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo.Provider.IsUnknown() {
+								clear_secret_infoLevel5Map["provider"] = listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo.Provider.ValueString()
+							}
+							if !listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo.URL.IsNull() && !listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo.URL.IsUnknown() {
+								clear_secret_infoLevel5Map["url"] = listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo.URL.ValueString()
+							}
+							iscsi_chap_passwordLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+						}
+						hpe_storageDeepMap["iscsi_chap_password"] = iscsi_chap_passwordLevel4Map
+					}
 					if !listItem.HpeStorage.IscsiChapUser.IsNull() && !listItem.HpeStorage.IscsiChapUser.IsUnknown() {
 						hpe_storageDeepMap["iscsi_chap_user"] = listItem.HpeStorage.IscsiChapUser.ValueString()
 					}
+					if listItem.HpeStorage.Password != nil {
+						passwordLevel4Map := make(map[string]interface{})
+						if listItem.HpeStorage.Password.BlindfoldSecretInfo != nil {
+							blindfold_secret_infoLevel5Map := make(map[string]interface{})
+							if !listItem.HpeStorage.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.HpeStorage.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+								blindfold_secret_infoLevel5Map["decryption_provider"] = listItem.HpeStorage.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+							}
+							if !listItem.HpeStorage.Password.BlindfoldSecretInfo.Location.IsNull() && !listItem.HpeStorage.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+								blindfold_secret_infoLevel5Map["location"] = listItem.HpeStorage.Password.BlindfoldSecretInfo.Location.ValueString()
+							}
+							if !listItem.HpeStorage.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.HpeStorage.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+								blindfold_secret_infoLevel5Map["store_provider"] = listItem.HpeStorage.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+							}
+							passwordLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+						}
+						if listItem.HpeStorage.Password.ClearSecretInfo != nil {
+							clear_secret_infoLevel5Map := make(map[string]interface{})
+							if !listItem.HpeStorage.Password.ClearSecretInfo.Provider.IsNull() && !listItem.HpeStorage.Password.ClearSecretInfo.Provider.IsUnknown() {
+								clear_secret_infoLevel5Map["provider"] = listItem.HpeStorage.Password.ClearSecretInfo.Provider.ValueString()
+							}
+							if !listItem.HpeStorage.Password.ClearSecretInfo.URL.IsNull() && !listItem.HpeStorage.Password.ClearSecretInfo.URL.IsUnknown() {
+								clear_secret_infoLevel5Map["url"] = listItem.HpeStorage.Password.ClearSecretInfo.URL.ValueString()
+							}
+							passwordLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+						}
+						hpe_storageDeepMap["password"] = passwordLevel4Map
+					}
 					if !listItem.HpeStorage.StorageServerIPAddress.IsNull() && !listItem.HpeStorage.StorageServerIPAddress.IsUnknown() {
 						hpe_storageDeepMap["storage_server_ip_address"] = listItem.HpeStorage.StorageServerIPAddress.ValueString()
 					}
@@ -4147,10 +4806,426 @@ func (r *FleetResource) Create(ctx context.Context, req resource.CreateRequest,
 				}
 				if listItem.NetappTrident != nil {
 					netapp_tridentDeepMap := make(map[string]interface{})
+					if listItem.NetappTrident.NetappBackendOntapNas != nil {
+						netapp_backend_ontap_nasLevel4Map := make(map[string]interface{})
+						if listItem.NetappTrident.NetappBackendOntapNas.AutoExportCidrs != nil {
+							auto_export_cidrsLevel5Map := make(map[string]interface{})
+							if !listItem.NetappTrident.NetappBackendOntapNas.AutoExportCidrs.Prefixes.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.AutoExportCidrs.Prefixes.IsUnknown() {
+								var prefixesLevel5Items []string
+								diags := listItem.NetappTrident.NetappBackendOntapNas.AutoExportCidrs.Prefixes.ElementsAs(ctx, &prefixesLevel5Items, false)
+								if !diags.HasError() {
+									auto_export_cidrsLevel5Map["prefixes"] = prefixesLevel5Items
+								}
+							}
+							netapp_backend_ontap_nasLevel4Map["auto_export_cidrs"] = auto_export_cidrsLevel5Map
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.AutoExportPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.AutoExportPolicy.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["auto_export_policy"] = listItem.NetappTrident.NetappBackendOntapNas.AutoExportPolicy.ValueBool()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.BackendName.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.BackendName.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["backend_name"] = listItem.NetappTrident.NetappBackendOntapNas.BackendName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.ClientCertificate.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ClientCertificate.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["client_certificate"] = listItem.NetappTrident.NetappBackendOntapNas.ClientCertificate.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey != nil {
+							client_private_keyLevel5Map := make(map[string]interface{})
+							if listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo != nil {
+								blindfold_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["decryption_provider"] = listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.Location.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.Location.IsUnknown() {
+									blindfold_secret_infoLevel6Map["location"] = listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.Location.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["store_provider"] = listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+								}
+								client_private_keyLevel5Map["blindfold_secret_info"] = blindfold_secret_infoLevel6Map
+							}
+							if listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo != nil {
+								clear_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo.Provider.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo.Provider.IsUnknown() {
+									clear_secret_infoLevel6Map["provider"] = listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo.Provider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo.URL.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo.URL.IsUnknown() {
+									clear_secret_infoLevel6Map["url"] = listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo.URL.ValueString()
+								}
+								client_private_keyLevel5Map["clear_secret_info"] = clear_secret_infoLevel6Map
+							}
+							netapp_backend_ontap_nasLevel4Map["client_private_key"] = client_private_keyLevel5Map
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.DataLifDNSName.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.DataLifDNSName.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["data_lif_dns_name"] = listItem.NetappTrident.NetappBackendOntapNas.DataLifDNSName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.DataLifIP.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.DataLifIP.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["data_lif_ip"] = listItem.NetappTrident.NetappBackendOntapNas.DataLifIP.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapNas.Labels != nil {
+							netapp_backend_ontap_nasLevel4Map["labels"] = map[string]interface{}{}
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.LimitAggregateUsage.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.LimitAggregateUsage.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["limit_aggregate_usage"] = listItem.NetappTrident.NetappBackendOntapNas.LimitAggregateUsage.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.LimitVolumeSize.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.LimitVolumeSize.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["limit_volume_size"] = listItem.NetappTrident.NetappBackendOntapNas.LimitVolumeSize.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.ManagementLifDNSName.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ManagementLifDNSName.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["management_lif_dns_name"] = listItem.NetappTrident.NetappBackendOntapNas.ManagementLifDNSName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.ManagementLifIP.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ManagementLifIP.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["management_lif_ip"] = listItem.NetappTrident.NetappBackendOntapNas.ManagementLifIP.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.NfsMountOptions.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.NfsMountOptions.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["nfs_mount_options"] = listItem.NetappTrident.NetappBackendOntapNas.NfsMountOptions.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapNas.Password != nil {
+							passwordLevel5Map := make(map[string]interface{})
+							if listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo != nil {
+								blindfold_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["decryption_provider"] = listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.Location.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+									blindfold_secret_infoLevel6Map["location"] = listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.Location.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["store_provider"] = listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+								}
+								passwordLevel5Map["blindfold_secret_info"] = blindfold_secret_infoLevel6Map
+							}
+							if listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo != nil {
+								clear_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo.Provider.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo.Provider.IsUnknown() {
+									clear_secret_infoLevel6Map["provider"] = listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo.Provider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo.URL.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo.URL.IsUnknown() {
+									clear_secret_infoLevel6Map["url"] = listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo.URL.ValueString()
+								}
+								passwordLevel5Map["clear_secret_info"] = clear_secret_infoLevel6Map
+							}
+							netapp_backend_ontap_nasLevel4Map["password"] = passwordLevel5Map
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.Region.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Region.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["region"] = listItem.NetappTrident.NetappBackendOntapNas.Region.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.StorageDriverName.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.StorageDriverName.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["storage_driver_name"] = listItem.NetappTrident.NetappBackendOntapNas.StorageDriverName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.StoragePrefix.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.StoragePrefix.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["storage_prefix"] = listItem.NetappTrident.NetappBackendOntapNas.StoragePrefix.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.Svm.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Svm.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["svm"] = listItem.NetappTrident.NetappBackendOntapNas.Svm.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.TrustedCACertificate.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.TrustedCACertificate.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["trusted_ca_certificate"] = listItem.NetappTrident.NetappBackendOntapNas.TrustedCACertificate.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.Username.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Username.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["username"] = listItem.NetappTrident.NetappBackendOntapNas.Username.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults != nil {
+							volume_defaultsLevel5Map := make(map[string]interface{})
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.AdaptiveQOSPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.AdaptiveQOSPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["adaptive_qos_policy"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.AdaptiveQOSPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.Encryption.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.Encryption.IsUnknown() {
+								volume_defaultsLevel5Map["encryption"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.Encryption.ValueBool()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.ExportPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.ExportPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["export_policy"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.ExportPolicy.ValueString()
+							}
+							if listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.NoQOS != nil {
+								volume_defaultsLevel5Map["no_qos"] = map[string]interface{}{}
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.QOSPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.QOSPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["qos_policy"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.QOSPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SecurityStyle.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SecurityStyle.IsUnknown() {
+								volume_defaultsLevel5Map["security_style"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SecurityStyle.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotDir.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotDir.IsUnknown() {
+								volume_defaultsLevel5Map["snapshot_dir"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotDir.ValueBool()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["snapshot_policy"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotReserve.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotReserve.IsUnknown() {
+								volume_defaultsLevel5Map["snapshot_reserve"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotReserve.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SpaceReserve.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SpaceReserve.IsUnknown() {
+								volume_defaultsLevel5Map["space_reserve"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SpaceReserve.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SplitOnClone.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SplitOnClone.IsUnknown() {
+								volume_defaultsLevel5Map["split_on_clone"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SplitOnClone.ValueBool()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.TieringPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.TieringPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["tiering_policy"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.TieringPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.UnixPermissions.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.UnixPermissions.IsUnknown() {
+								volume_defaultsLevel5Map["unix_permissions"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.UnixPermissions.ValueInt64()
+							}
+							netapp_backend_ontap_nasLevel4Map["volume_defaults"] = volume_defaultsLevel5Map
+						}
+						netapp_tridentDeepMap["netapp_backend_ontap_nas"] = netapp_backend_ontap_nasLevel4Map
+					}
+					if listItem.NetappTrident.NetappBackendOntapSan != nil {
+						netapp_backend_ontap_sanLevel4Map := make(map[string]interface{})
+						if !listItem.NetappTrident.NetappBackendOntapSan.ClientCertificate.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ClientCertificate.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["client_certificate"] = listItem.NetappTrident.NetappBackendOntapSan.ClientCertificate.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey != nil {
+							client_private_keyLevel5Map := make(map[string]interface{})
+							if listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo != nil {
+								blindfold_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["decryption_provider"] = listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.Location.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.Location.IsUnknown() {
+									blindfold_secret_infoLevel6Map["location"] = listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.Location.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["store_provider"] = listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+								}
+								client_private_keyLevel5Map["blindfold_secret_info"] = blindfold_secret_infoLevel6Map
+							}
+							if listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo != nil {
+								clear_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo.Provider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo.Provider.IsUnknown() {
+									clear_secret_infoLevel6Map["provider"] = listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo.Provider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo.URL.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo.URL.IsUnknown() {
+									clear_secret_infoLevel6Map["url"] = listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo.URL.ValueString()
+								}
+								client_private_keyLevel5Map["clear_secret_info"] = clear_secret_infoLevel6Map
+							}
+							netapp_backend_ontap_sanLevel4Map["client_private_key"] = client_private_keyLevel5Map
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.DataLifDNSName.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.DataLifDNSName.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["data_lif_dns_name"] = listItem.NetappTrident.NetappBackendOntapSan.DataLifDNSName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.DataLifIP.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.DataLifIP.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["data_lif_ip"] = listItem.NetappTrident.NetappBackendOntapSan.DataLifIP.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.IgroupName.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.IgroupName.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["igroup_name"] = listItem.NetappTrident.NetappBackendOntapSan.IgroupName.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapSan.Labels != nil {
+							netapp_backend_ontap_sanLevel4Map["labels"] = map[string]interface{}{}
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.LimitAggregateUsage.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.LimitAggregateUsage.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["limit_aggregate_usage"] = listItem.NetappTrident.NetappBackendOntapSan.LimitAggregateUsage.ValueInt64()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.LimitVolumeSize.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.LimitVolumeSize.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["limit_volume_size"] = listItem.NetappTrident.NetappBackendOntapSan.LimitVolumeSize.ValueInt64()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.ManagementLifDNSName.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ManagementLifDNSName.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["management_lif_dns_name"] = listItem.NetappTrident.NetappBackendOntapSan.ManagementLifDNSName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.ManagementLifIP.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ManagementLifIP.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["management_lif_ip"] = listItem.NetappTrident.NetappBackendOntapSan.ManagementLifIP.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapSan.NoChap != nil {
+							netapp_backend_ontap_sanLevel4Map["no_chap"] = map[string]interface{}{}
+						}
+						if listItem.NetappTrident.NetappBackendOntapSan.Password != nil {
+							passwordLevel5Map := make(map[string]interface{})
+							if listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo != nil {
+								blindfold_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["decryption_provider"] = listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.Location.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+									blindfold_secret_infoLevel6Map["location"] = listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.Location.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["store_provider"] = listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+								}
+								passwordLevel5Map["blindfold_secret_info"] = blindfold_secret_infoLevel6Map
+							}
+							if listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo != nil {
+								clear_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo.Provider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo.Provider.IsUnknown() {
+									clear_secret_infoLevel6Map["provider"] = listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo.Provider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo.URL.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo.URL.IsUnknown() {
+									clear_secret_infoLevel6Map["url"] = listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo.URL.ValueString()
+								}
+								passwordLevel5Map["clear_secret_info"] = clear_secret_infoLevel6Map
+							}
+							netapp_backend_ontap_sanLevel4Map["password"] = passwordLevel5Map
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.Region.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Region.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["region"] = listItem.NetappTrident.NetappBackendOntapSan.Region.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.StorageDriverName.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.StorageDriverName.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["storage_driver_name"] = listItem.NetappTrident.NetappBackendOntapSan.StorageDriverName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.StoragePrefix.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.StoragePrefix.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["storage_prefix"] = listItem.NetappTrident.NetappBackendOntapSan.StoragePrefix.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.Svm.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Svm.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["svm"] = listItem.NetappTrident.NetappBackendOntapSan.Svm.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.TrustedCACertificate.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.TrustedCACertificate.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["trusted_ca_certificate"] = listItem.NetappTrident.NetappBackendOntapSan.TrustedCACertificate.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapSan.UseChap != nil {
+							use_chapLevel5Map := make(map[string]interface{})
+							if listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret != nil {
+								chap_initiator_secretLevel6Map := make(map[string]interface{})
+								if listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo != nil {
+									blindfold_secret_infoLevel7Map := make(map[string]interface{})
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+										blindfold_secret_infoLevel7Map["decryption_provider"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+									}
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.Location.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.Location.IsUnknown() {
+										blindfold_secret_infoLevel7Map["location"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.Location.ValueString()
+									}
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+										blindfold_secret_infoLevel7Map["store_provider"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.StoreProvider.ValueString()
+									}
+									chap_initiator_secretLevel6Map["blindfold_secret_info"] = blindfold_secret_infoLevel7Map
+								}
+								if listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo != nil {
+									clear_secret_infoLevel7Map := make(map[string]interface{})
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo.Provider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo.Provider.IsUnknown() {
+										clear_secret_infoLevel7Map["provider"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo.Provider.ValueString()
+									}
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo.URL.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo.URL.IsUnknown() {
+										clear_secret_infoLevel7Map["url"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo.URL.ValueString()
+									}
+									chap_initiator_secretLevel6Map["clear_secret_info"] = clear_secret_infoLevel7Map
+								}
+								use_chapLevel5Map["chap_initiator_secret"] = chap_initiator_secretLevel6Map
+							}
+							if listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret != nil {
+								chap_target_initiator_secretLevel6Map := make(map[string]interface{})
+								if listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo != nil {
+									blindfold_secret_infoLevel7Map := make(map[string]interface{})
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+										blindfold_secret_infoLevel7Map["decryption_provider"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+									}
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.Location.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.Location.IsUnknown() {
+										blindfold_secret_infoLevel7Map["location"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.Location.ValueString()
+									}
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+										blindfold_secret_infoLevel7Map["store_provider"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.StoreProvider.ValueString()
+									}
+									chap_target_initiator_secretLevel6Map["blindfold_secret_info"] = blindfold_secret_infoLevel7Map
+								}
+								if listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo != nil {
+									clear_secret_infoLevel7Map := make(map[string]interface{})
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo.Provider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo.Provider.IsUnknown() {
+										clear_secret_infoLevel7Map["provider"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo.Provider.ValueString()
+									}
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo.URL.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo.URL.IsUnknown() {
+										clear_secret_infoLevel7Map["url"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo.URL.ValueString()
+									}
+									chap_target_initiator_secretLevel6Map["clear_secret_info"] = clear_secret_infoLevel7Map
+								}
+								use_chapLevel5Map["chap_target_initiator_secret"] = chap_target_initiator_secretLevel6Map
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetUsername.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetUsername.IsUnknown() {
+								use_chapLevel5Map["chap_target_username"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetUsername.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapUsername.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapUsername.IsUnknown() {
+								use_chapLevel5Map["chap_username"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapUsername.ValueString()
+							}
+							netapp_backend_ontap_sanLevel4Map["use_chap"] = use_chapLevel5Map
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.Username.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Username.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["username"] = listItem.NetappTrident.NetappBackendOntapSan.Username.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults != nil {
+							volume_defaultsLevel5Map := make(map[string]interface{})
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.AdaptiveQOSPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.AdaptiveQOSPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["adaptive_qos_policy"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.AdaptiveQOSPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.Encryption.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.Encryption.IsUnknown() {
+								volume_defaultsLevel5Map["encryption"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.Encryption.ValueBool()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.ExportPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.ExportPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["export_policy"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.ExportPolicy.ValueString()
+							}
+							if listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.NoQOS != nil {
+								volume_defaultsLevel5Map["no_qos"] = map[string]interface{}{}
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.QOSPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.QOSPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["qos_policy"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.QOSPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SecurityStyle.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SecurityStyle.IsUnknown() {
+								volume_defaultsLevel5Map["security_style"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SecurityStyle.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotDir.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotDir.IsUnknown() {
+								volume_defaultsLevel5Map["snapshot_dir"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotDir.ValueBool()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["snapshot_policy"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotReserve.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotReserve.IsUnknown() {
+								volume_defaultsLevel5Map["snapshot_reserve"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotReserve.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SpaceReserve.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SpaceReserve.IsUnknown() {
+								volume_defaultsLevel5Map["space_reserve"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SpaceReserve.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SplitOnClone.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SplitOnClone.IsUnknown() {
+								volume_defaultsLevel5Map["split_on_clone"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SplitOnClone.ValueBool()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.TieringPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.TieringPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["tiering_policy"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.TieringPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.UnixPermissions.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.UnixPermissions.IsUnknown() {
+								volume_defaultsLevel5Map["unix_permissions"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.UnixPermissions.ValueInt64()
+							}
+							netapp_backend_ontap_sanLevel4Map["volume_defaults"] = volume_defaultsLevel5Map
+						}
+						netapp_tridentDeepMap["netapp_backend_ontap_san"] = netapp_backend_ontap_sanLevel4Map
+					}
 					listItemMap["netapp_trident"] = netapp_tridentDeepMap
 				}
 				if listItem.PureServiceOrchestrator != nil {
 					pure_service_orchestratorDeepMap := make(map[string]interface{})
+					if listItem.PureServiceOrchestrator.Arrays != nil {
+						arraysLevel4Map := make(map[string]interface{})
+						if listItem.PureServiceOrchestrator.Arrays.FlashArray != nil {
+							flash_arrayLevel5Map := make(map[string]interface{})
+							if !listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultFsOpt.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultFsOpt.IsUnknown() {
+								flash_arrayLevel5Map["default_fs_opt"] = listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultFsOpt.ValueString()
+							}
+							if !listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultFsType.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultFsType.IsUnknown() {
+								flash_arrayLevel5Map["default_fs_type"] = listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultFsType.ValueString()
+							}
+							if !listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultMountOpts.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultMountOpts.IsUnknown() {
+								var default_mount_optsLevel5Items []string
+								diags := listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultMountOpts.ElementsAs(ctx, &default_mount_optsLevel5Items, false)
+								if !diags.HasError() {
+									flash_arrayLevel5Map["default_mount_opts"] = default_mount_optsLevel5Items
+								}
+							}
+							if !listItem.PureServiceOrchestrator.Arrays.FlashArray.DisablePreemptAttachments.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashArray.DisablePreemptAttachments.IsUnknown() {
+								flash_arrayLevel5Map["disable_preempt_attachments"] = listItem.PureServiceOrchestrator.Arrays.FlashArray.DisablePreemptAttachments.ValueBool()
+							}
+							if !listItem.PureServiceOrchestrator.Arrays.FlashArray.IscsiLoginTimeout.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashArray.IscsiLoginTimeout.IsUnknown() {
+								flash_arrayLevel5Map["iscsi_login_timeout"] = listItem.PureServiceOrchestrator.Arrays.FlashArray.IscsiLoginTimeout.ValueInt64()
+							}
+							if !listItem.PureServiceOrchestrator.Arrays.FlashArray.SanType.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashArray.SanType.IsUnknown() {
+								flash_arrayLevel5Map["san_type"] = listItem.PureServiceOrchestrator.Arrays.FlashArray.SanType.ValueString()
+							}
+							arraysLevel4Map["flash_array"] = flash_arrayLevel5Map
+						}
+						if listItem.PureServiceOrchestrator.Arrays.FlashBlade != nil {
+							flash_bladeLevel5Map := make(map[string]interface{})
+							if !listItem.PureServiceOrchestrator.Arrays.FlashBlade.EnableSnapshotDirectory.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashBlade.EnableSnapshotDirectory.IsUnknown() {
+								flash_bladeLevel5Map["enable_snapshot_directory"] = listItem.PureServiceOrchestrator.Arrays.FlashBlade.EnableSnapshotDirectory.ValueBool()
+							}
+							if !listItem.PureServiceOrchestrator.Arrays.FlashBlade.ExportRules.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashBlade.ExportRules.IsUnknown() {
+								flash_bladeLevel5Map["export_rules"] = listItem.PureServiceOrchestrator.Arrays.FlashBlade.ExportRules.ValueString()
+							}
+							arraysLevel4Map["flash_blade"] = flash_bladeLevel5Map
+						}
+						pure_service_orchestratorDeepMap["arrays"] = arraysLevel4Map
+					}
 					if !listItem.PureServiceOrchestrator.ClusterID.IsNull() && !listItem.PureServiceOrchestrator.ClusterID.IsUnknown() {
 						pure_service_orchestratorDeepMap["cluster_id"] = listItem.PureServiceOrchestrator.ClusterID.ValueString()
 					}
@@ -4203,6 +5278,24 @@ func (r *FleetResource) Create(ctx context.Context, req resource.CreateRequest,
 				}
 				if listItem.Nexthop != nil {
 					nexthopDeepMap := make(map[string]interface{})
+					if listItem.Nexthop.NexthopAddress != nil {
+						nexthop_addressLevel4Map := make(map[string]interface{})
+						if listItem.Nexthop.NexthopAddress.Ipv4 != nil {
+							ipv4Level5Map := make(map[string]interface{})
+							if !listItem.Nexthop.NexthopAddress.Ipv4.Addr.IsNull() && !listItem.Nexthop.NexthopAddress.Ipv4.Addr.IsUnknown() {
+								ipv4Level5Map["addr"] = listItem.Nexthop.NexthopAddress.Ipv4.Addr.ValueString()
+							}
+							nexthop_addressLevel4Map["ipv4"] = ipv4Level5Map
+						}
+						if listItem.Nexthop.NexthopAddress.Ipv6 != nil {
+							ipv6Level5Map := make(map[string]interface{})
+							if !listItem.Nexthop.NexthopAddress.Ipv6.Addr.IsNull() && !listItem.Nexthop.NexthopAddress.Ipv6.Addr.IsUnknown() {
+								ipv6Level5Map["addr"] = listItem.Nexthop.NexthopAddress.Ipv6.Addr.ValueString()
+							}
+							nexthop_addressLevel4Map["ipv6"] = ipv6Level5Map
+						}
+						nexthopDeepMap["nexthop_address"] = nexthop_addressLevel4Map
+					}
 					if !listItem.Nexthop.Type.IsNull() && !listItem.Nexthop.Type.IsUnknown() {
 						nexthopDeepMap["type"] = listItem.Nexthop.Type.ValueString()
 					}
@@ -4242,11 +5335,15 @@ func (r *FleetResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	apiResource, err := r.client.CreateFleet(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Fleet: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "fleet", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -5320,7 +6417,7 @@ func (r *FleetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	apiResource, err := r.client.GetFleet(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Fleet not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -5328,11 +6425,12 @@ func (r *FleetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Fleet: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "fleet", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -5343,31 +6441,13 @@ func (r *FleetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -5375,6 +6455,7 @@ func (r *FleetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["allow_all_usb"].(map[string]interface{}); ok && isImport && data.AllowAllUsb == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -6426,7 +7507,8 @@ func (r *FleetResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 func (r *FleetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data FleetResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -6460,6 +7542,7 @@ func (r *FleetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -6469,6 +7552,7 @@ func (r *FleetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AllowAllUsb != nil {
@@ -6683,12 +7767,18 @@ func (r *FleetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		if data.KubernetesUpgradeDrain.EnableUpgradeDrain != nil {
 			enable_upgrade_drainNestedMap := make(map[string]interface{})
+			if data.KubernetesUpgradeDrain.EnableUpgradeDrain.DisableVegaUpgradeMode != nil {
+				enable_upgrade_drainNestedMap["disable_vega_upgrade_mode"] = map[string]interface{}{}
+			}
 			if !data.KubernetesUpgradeDrain.EnableUpgradeDrain.DrainMaxUnavailableNodeCount.IsNull() && !data.KubernetesUpgradeDrain.EnableUpgradeDrain.DrainMaxUnavailableNodeCount.IsUnknown() {
 				enable_upgrade_drainNestedMap["drain_max_unavailable_node_count"] = data.KubernetesUpgradeDrain.EnableUpgradeDrain.DrainMaxUnavailableNodeCount.ValueInt64()
 			}
 			if !data.KubernetesUpgradeDrain.EnableUpgradeDrain.DrainNodeTimeout.IsNull() && !data.KubernetesUpgradeDrain.EnableUpgradeDrain.DrainNodeTimeout.IsUnknown() {
 				enable_upgrade_drainNestedMap["drain_node_timeout"] = data.KubernetesUpgradeDrain.EnableUpgradeDrain.DrainNodeTimeout.ValueInt64()
 			}
+			if data.KubernetesUpgradeDrain.EnableUpgradeDrain.EnableVegaUpgradeMode != nil {
+				enable_upgrade_drainNestedMap["enable_vega_upgrade_mode"] = map[string]interface{}{}
+			}
 			kubernetes_upgrade_drainMap["enable_upgrade_drain"] = enable_upgrade_drainNestedMap
 		}
 		apiResource.Spec["kubernetes_upgrade_drain"] = kubernetes_upgrade_drainMap
@@ -6818,6 +7908,12 @@ func (r *FleetResource) Update(ctx context.Context, req resource.UpdateRequest,
 		performance_enhancement_modeMap := make(map[string]interface{})
 		if data.PerformanceEnhancementMode.PerfModeL3Enhanced != nil {
 			perf_mode_l3_enhancedNestedMap := make(map[string]interface{})
+			if data.PerformanceEnhancementMode.PerfModeL3Enhanced.Jumbo != nil {
+				perf_mode_l3_enhancedNestedMap["jumbo"] = map[string]interface{}{}
+			}
+			if data.PerformanceEnhancementMode.PerfModeL3Enhanced.NoJumbo != nil {
+				perf_mode_l3_enhancedNestedMap["no_jumbo"] = map[string]interface{}{}
+			}
 			performance_enhancement_modeMap["perf_mode_l3_enhanced"] = perf_mode_l3_enhancedNestedMap
 		}
 		if data.PerformanceEnhancementMode.PerfModeL7Enhanced != nil {
@@ -6978,9 +8074,63 @@ func (r *FleetResource) Update(ctx context.Context, req resource.UpdateRequest,
 					if !listItem.HpeStorage.APIServerPort.IsNull() && !listItem.HpeStorage.APIServerPort.IsUnknown() {
 						hpe_storageDeepMap["api_server_port"] = listItem.HpeStorage.APIServerPort.ValueInt64()
 					}
+					if listItem.HpeStorage.IscsiChapPassword != nil {
+						iscsi_chap_passwordLevel4Map := make(map[string]interface{})
+						if listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo != nil {
+							blindfold_secret_infoLevel5Map := make(map[string]interface{})
+							if !listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+								blindfold_secret_infoLevel5Map["decryption_provider"] = listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+							}
+							if !listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.Location.IsNull() && !listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.Location.IsUnknown() {
+								blindfold_secret_infoLevel5Map["location"] = listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.Location.ValueString()
+							}
+							if !listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+								blindfold_secret_infoLevel5Map["store_provider"] = listItem.HpeStorage.IscsiChapPassword.BlindfoldSecretInfo.StoreProvider.ValueString()
+							}
+							iscsi_chap_passwordLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+						}
+						if listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo != nil {
+							clear_secret_infoLevel5Map := make(map[string]interface{})
+							if !listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo.Provider.IsNull() && !listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo.Provider.IsUnknown() {
+								clear_secret_infoLevel5Map["provider"] = listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo.Provider.ValueString()
+							}
+							if !listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo.URL.IsNull() && !listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo.URL.IsUnknown() {
+								clear_secret_infoLevel5Map["url"] = listItem.HpeStorage.IscsiChapPassword.ClearSecretInfo.URL.ValueString()
+							}
+							iscsi_chap_passwordLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+						}
+						hpe_storageDeepMap["iscsi_chap_password"] = iscsi_chap_passwordLevel4Map
+					}
 					if !listItem.HpeStorage.IscsiChapUser.IsNull() && !listItem.HpeStorage.IscsiChapUser.IsUnknown() {
 						hpe_storageDeepMap["iscsi_chap_user"] = listItem.HpeStorage.IscsiChapUser.ValueString()
 					}
+					if listItem.HpeStorage.Password != nil {
+						passwordLevel4Map := make(map[string]interface{})
+						if listItem.HpeStorage.Password.BlindfoldSecretInfo != nil {
+							blindfold_secret_infoLevel5Map := make(map[string]interface{})
+							if !listItem.HpeStorage.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.HpeStorage.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+								blindfold_secret_infoLevel5Map["decryption_provider"] = listItem.HpeStorage.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+							}
+							if !listItem.HpeStorage.Password.BlindfoldSecretInfo.Location.IsNull() && !listItem.HpeStorage.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+								blindfold_secret_infoLevel5Map["location"] = listItem.HpeStorage.Password.BlindfoldSecretInfo.Location.ValueString()
+							}
+							if !listItem.HpeStorage.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.HpeStorage.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+								blindfold_secret_infoLevel5Map["store_provider"] = listItem.HpeStorage.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+							}
+							passwordLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+						}
+						if listItem.HpeStorage.Password.ClearSecretInfo != nil {
+							clear_secret_infoLevel5Map := make(map[string]interface{})
+							if !listItem.HpeStorage.Password.ClearSecretInfo.Provider.IsNull() && !listItem.HpeStorage.Password.ClearSecretInfo.Provider.IsUnknown() {
+								clear_secret_infoLevel5Map["provider"] = listItem.HpeStorage.Password.ClearSecretInfo.Provider.ValueString()
+							}
+							if !listItem.HpeStorage.Password.ClearSecretInfo.URL.IsNull() && !listItem.HpeStorage.Password.ClearSecretInfo.URL.IsUnknown() {
+								clear_secret_infoLevel5Map["url"] = listItem.HpeStorage.Password.ClearSecretInfo.URL.ValueString()
+							}
+							passwordLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+						}
+						hpe_storageDeepMap["password"] = passwordLevel4Map
+					}
 					if !listItem.HpeStorage.StorageServerIPAddress.IsNull() && !listItem.HpeStorage.StorageServerIPAddress.IsUnknown() {
 						hpe_storageDeepMap["storage_server_ip_address"] = listItem.HpeStorage.StorageServerIPAddress.ValueString()
 					}
@@ -6994,10 +8144,426 @@ func (r *FleetResource) Update(ctx context.Context, req resource.UpdateRequest,
 				}
 				if listItem.NetappTrident != nil {
 					netapp_tridentDeepMap := make(map[string]interface{})
+					if listItem.NetappTrident.NetappBackendOntapNas != nil {
+						netapp_backend_ontap_nasLevel4Map := make(map[string]interface{})
+						if listItem.NetappTrident.NetappBackendOntapNas.AutoExportCidrs != nil {
+							auto_export_cidrsLevel5Map := make(map[string]interface{})
+							if !listItem.NetappTrident.NetappBackendOntapNas.AutoExportCidrs.Prefixes.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.AutoExportCidrs.Prefixes.IsUnknown() {
+								var prefixesLevel5Items []string
+								diags := listItem.NetappTrident.NetappBackendOntapNas.AutoExportCidrs.Prefixes.ElementsAs(ctx, &prefixesLevel5Items, false)
+								if !diags.HasError() {
+									auto_export_cidrsLevel5Map["prefixes"] = prefixesLevel5Items
+								}
+							}
+							netapp_backend_ontap_nasLevel4Map["auto_export_cidrs"] = auto_export_cidrsLevel5Map
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.AutoExportPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.AutoExportPolicy.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["auto_export_policy"] = listItem.NetappTrident.NetappBackendOntapNas.AutoExportPolicy.ValueBool()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.BackendName.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.BackendName.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["backend_name"] = listItem.NetappTrident.NetappBackendOntapNas.BackendName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.ClientCertificate.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ClientCertificate.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["client_certificate"] = listItem.NetappTrident.NetappBackendOntapNas.ClientCertificate.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey != nil {
+							client_private_keyLevel5Map := make(map[string]interface{})
+							if listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo != nil {
+								blindfold_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["decryption_provider"] = listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.Location.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.Location.IsUnknown() {
+									blindfold_secret_infoLevel6Map["location"] = listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.Location.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["store_provider"] = listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+								}
+								client_private_keyLevel5Map["blindfold_secret_info"] = blindfold_secret_infoLevel6Map
+							}
+							if listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo != nil {
+								clear_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo.Provider.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo.Provider.IsUnknown() {
+									clear_secret_infoLevel6Map["provider"] = listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo.Provider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo.URL.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo.URL.IsUnknown() {
+									clear_secret_infoLevel6Map["url"] = listItem.NetappTrident.NetappBackendOntapNas.ClientPrivateKey.ClearSecretInfo.URL.ValueString()
+								}
+								client_private_keyLevel5Map["clear_secret_info"] = clear_secret_infoLevel6Map
+							}
+							netapp_backend_ontap_nasLevel4Map["client_private_key"] = client_private_keyLevel5Map
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.DataLifDNSName.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.DataLifDNSName.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["data_lif_dns_name"] = listItem.NetappTrident.NetappBackendOntapNas.DataLifDNSName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.DataLifIP.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.DataLifIP.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["data_lif_ip"] = listItem.NetappTrident.NetappBackendOntapNas.DataLifIP.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapNas.Labels != nil {
+							netapp_backend_ontap_nasLevel4Map["labels"] = map[string]interface{}{}
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.LimitAggregateUsage.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.LimitAggregateUsage.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["limit_aggregate_usage"] = listItem.NetappTrident.NetappBackendOntapNas.LimitAggregateUsage.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.LimitVolumeSize.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.LimitVolumeSize.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["limit_volume_size"] = listItem.NetappTrident.NetappBackendOntapNas.LimitVolumeSize.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.ManagementLifDNSName.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ManagementLifDNSName.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["management_lif_dns_name"] = listItem.NetappTrident.NetappBackendOntapNas.ManagementLifDNSName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.ManagementLifIP.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.ManagementLifIP.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["management_lif_ip"] = listItem.NetappTrident.NetappBackendOntapNas.ManagementLifIP.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.NfsMountOptions.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.NfsMountOptions.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["nfs_mount_options"] = listItem.NetappTrident.NetappBackendOntapNas.NfsMountOptions.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapNas.Password != nil {
+							passwordLevel5Map := make(map[string]interface{})
+							if listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo != nil {
+								blindfold_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["decryption_provider"] = listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.Location.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+									blindfold_secret_infoLevel6Map["location"] = listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.Location.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["store_provider"] = listItem.NetappTrident.NetappBackendOntapNas.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+								}
+								passwordLevel5Map["blindfold_secret_info"] = blindfold_secret_infoLevel6Map
+							}
+							if listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo != nil {
+								clear_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo.Provider.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo.Provider.IsUnknown() {
+									clear_secret_infoLevel6Map["provider"] = listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo.Provider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo.URL.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo.URL.IsUnknown() {
+									clear_secret_infoLevel6Map["url"] = listItem.NetappTrident.NetappBackendOntapNas.Password.ClearSecretInfo.URL.ValueString()
+								}
+								passwordLevel5Map["clear_secret_info"] = clear_secret_infoLevel6Map
+							}
+							netapp_backend_ontap_nasLevel4Map["password"] = passwordLevel5Map
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.Region.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Region.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["region"] = listItem.NetappTrident.NetappBackendOntapNas.Region.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.StorageDriverName.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.StorageDriverName.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["storage_driver_name"] = listItem.NetappTrident.NetappBackendOntapNas.StorageDriverName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.StoragePrefix.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.StoragePrefix.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["storage_prefix"] = listItem.NetappTrident.NetappBackendOntapNas.StoragePrefix.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.Svm.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Svm.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["svm"] = listItem.NetappTrident.NetappBackendOntapNas.Svm.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.TrustedCACertificate.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.TrustedCACertificate.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["trusted_ca_certificate"] = listItem.NetappTrident.NetappBackendOntapNas.TrustedCACertificate.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapNas.Username.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.Username.IsUnknown() {
+							netapp_backend_ontap_nasLevel4Map["username"] = listItem.NetappTrident.NetappBackendOntapNas.Username.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults != nil {
+							volume_defaultsLevel5Map := make(map[string]interface{})
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.AdaptiveQOSPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.AdaptiveQOSPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["adaptive_qos_policy"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.AdaptiveQOSPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.Encryption.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.Encryption.IsUnknown() {
+								volume_defaultsLevel5Map["encryption"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.Encryption.ValueBool()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.ExportPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.ExportPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["export_policy"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.ExportPolicy.ValueString()
+							}
+							if listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.NoQOS != nil {
+								volume_defaultsLevel5Map["no_qos"] = map[string]interface{}{}
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.QOSPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.QOSPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["qos_policy"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.QOSPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SecurityStyle.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SecurityStyle.IsUnknown() {
+								volume_defaultsLevel5Map["security_style"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SecurityStyle.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotDir.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotDir.IsUnknown() {
+								volume_defaultsLevel5Map["snapshot_dir"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotDir.ValueBool()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["snapshot_policy"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotReserve.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotReserve.IsUnknown() {
+								volume_defaultsLevel5Map["snapshot_reserve"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SnapshotReserve.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SpaceReserve.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SpaceReserve.IsUnknown() {
+								volume_defaultsLevel5Map["space_reserve"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SpaceReserve.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SplitOnClone.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SplitOnClone.IsUnknown() {
+								volume_defaultsLevel5Map["split_on_clone"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.SplitOnClone.ValueBool()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.TieringPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.TieringPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["tiering_policy"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.TieringPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.UnixPermissions.IsNull() && !listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.UnixPermissions.IsUnknown() {
+								volume_defaultsLevel5Map["unix_permissions"] = listItem.NetappTrident.NetappBackendOntapNas.VolumeDefaults.UnixPermissions.ValueInt64()
+							}
+							netapp_backend_ontap_nasLevel4Map["volume_defaults"] = volume_defaultsLevel5Map
+						}
+						netapp_tridentDeepMap["netapp_backend_ontap_nas"] = netapp_backend_ontap_nasLevel4Map
+					}
+					if listItem.NetappTrident.NetappBackendOntapSan != nil {
+						netapp_backend_ontap_sanLevel4Map := make(map[string]interface{})
+						if !listItem.NetappTrident.NetappBackendOntapSan.ClientCertificate.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ClientCertificate.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["client_certificate"] = listItem.NetappTrident.NetappBackendOntapSan.ClientCertificate.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey != nil {
+							client_private_keyLevel5Map := make(map[string]interface{})
+							if listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo != nil {
+								blindfold_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["decryption_provider"] = listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.Location.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.Location.IsUnknown() {
+									blindfold_secret_infoLevel6Map["location"] = listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.Location.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["store_provider"] = listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+								}
+								client_private_keyLevel5Map["blindfold_secret_info"] = blindfold_secret_infoLevel6Map
+							}
+							if listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo != nil {
+								clear_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo.Provider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo.Provider.IsUnknown() {
+									clear_secret_infoLevel6Map["provider"] = listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo.Provider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo.URL.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo.URL.IsUnknown() {
+									clear_secret_infoLevel6Map["url"] = listItem.NetappTrident.NetappBackendOntapSan.ClientPrivateKey.ClearSecretInfo.URL.ValueString()
+								}
+								client_private_keyLevel5Map["clear_secret_info"] = clear_secret_infoLevel6Map
+							}
+							netapp_backend_ontap_sanLevel4Map["client_private_key"] = client_private_keyLevel5Map
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.DataLifDNSName.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.DataLifDNSName.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["data_lif_dns_name"] = listItem.NetappTrident.NetappBackendOntapSan.DataLifDNSName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.DataLifIP.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.DataLifIP.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["data_lif_ip"] = listItem.NetappTrident.NetappBackendOntapSan.DataLifIP.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.IgroupName.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.IgroupName.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["igroup_name"] = listItem.NetappTrident.NetappBackendOntapSan.IgroupName.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapSan.Labels != nil {
+							netapp_backend_ontap_sanLevel4Map["labels"] = map[string]interface{}{}
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.LimitAggregateUsage.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.LimitAggregateUsage.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["limit_aggregate_usage"] = listItem.NetappTrident.NetappBackendOntapSan.LimitAggregateUsage.ValueInt64()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.LimitVolumeSize.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.LimitVolumeSize.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["limit_volume_size"] = listItem.NetappTrident.NetappBackendOntapSan.LimitVolumeSize.ValueInt64()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.ManagementLifDNSName.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ManagementLifDNSName.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["management_lif_dns_name"] = listItem.NetappTrident.NetappBackendOntapSan.ManagementLifDNSName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.ManagementLifIP.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.ManagementLifIP.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["management_lif_ip"] = listItem.NetappTrident.NetappBackendOntapSan.ManagementLifIP.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapSan.NoChap != nil {
+							netapp_backend_ontap_sanLevel4Map["no_chap"] = map[string]interface{}{}
+						}
+						if listItem.NetappTrident.NetappBackendOntapSan.Password != nil {
+							passwordLevel5Map := make(map[string]interface{})
+							if listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo != nil {
+								blindfold_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["decryption_provider"] = listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.Location.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+									blindfold_secret_infoLevel6Map["location"] = listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.Location.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+									blindfold_secret_infoLevel6Map["store_provider"] = listItem.NetappTrident.NetappBackendOntapSan.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+								}
+								passwordLevel5Map["blindfold_secret_info"] = blindfold_secret_infoLevel6Map
+							}
+							if listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo != nil {
+								clear_secret_infoLevel6Map := make(map[string]interface{})
+								if !listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo.Provider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo.Provider.IsUnknown() {
+									clear_secret_infoLevel6Map["provider"] = listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo.Provider.ValueString()
+								}
+								if !listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo.URL.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo.URL.IsUnknown() {
+									clear_secret_infoLevel6Map["url"] = listItem.NetappTrident.NetappBackendOntapSan.Password.ClearSecretInfo.URL.ValueString()
+								}
+								passwordLevel5Map["clear_secret_info"] = clear_secret_infoLevel6Map
+							}
+							netapp_backend_ontap_sanLevel4Map["password"] = passwordLevel5Map
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.Region.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Region.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["region"] = listItem.NetappTrident.NetappBackendOntapSan.Region.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.StorageDriverName.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.StorageDriverName.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["storage_driver_name"] = listItem.NetappTrident.NetappBackendOntapSan.StorageDriverName.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.StoragePrefix.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.StoragePrefix.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["storage_prefix"] = listItem.NetappTrident.NetappBackendOntapSan.StoragePrefix.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.Svm.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Svm.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["svm"] = listItem.NetappTrident.NetappBackendOntapSan.Svm.ValueString()
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.TrustedCACertificate.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.TrustedCACertificate.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["trusted_ca_certificate"] = listItem.NetappTrident.NetappBackendOntapSan.TrustedCACertificate.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapSan.UseChap != nil {
+							use_chapLevel5Map := make(map[string]interface{})
+							if listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret != nil {
+								chap_initiator_secretLevel6Map := make(map[string]interface{})
+								if listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo != nil {
+									blindfold_secret_infoLevel7Map := make(map[string]interface{})
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+										blindfold_secret_infoLevel7Map["decryption_provider"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+									}
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.Location.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.Location.IsUnknown() {
+										blindfold_secret_infoLevel7Map["location"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.Location.ValueString()
+									}
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+										blindfold_secret_infoLevel7Map["store_provider"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.BlindfoldSecretInfo.StoreProvider.ValueString()
+									}
+									chap_initiator_secretLevel6Map["blindfold_secret_info"] = blindfold_secret_infoLevel7Map
+								}
+								if listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo != nil {
+									clear_secret_infoLevel7Map := make(map[string]interface{})
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo.Provider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo.Provider.IsUnknown() {
+										clear_secret_infoLevel7Map["provider"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo.Provider.ValueString()
+									}
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo.URL.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo.URL.IsUnknown() {
+										clear_secret_infoLevel7Map["url"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapInitiatorSecret.ClearSecretInfo.URL.ValueString()
+									}
+									chap_initiator_secretLevel6Map["clear_secret_info"] = clear_secret_infoLevel7Map
+								}
+								use_chapLevel5Map["chap_initiator_secret"] = chap_initiator_secretLevel6Map
+							}
+							if listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret != nil {
+								chap_target_initiator_secretLevel6Map := make(map[string]interface{})
+								if listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo != nil {
+									blindfold_secret_infoLevel7Map := make(map[string]interface{})
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+										blindfold_secret_infoLevel7Map["decryption_provider"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+									}
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.Location.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.Location.IsUnknown() {
+										blindfold_secret_infoLevel7Map["location"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.Location.ValueString()
+									}
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+										blindfold_secret_infoLevel7Map["store_provider"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.BlindfoldSecretInfo.StoreProvider.ValueString()
+									}
+									chap_target_initiator_secretLevel6Map["blindfold_secret_info"] = blindfold_secret_infoLevel7Map
+								}
+								if listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo != nil {
+									clear_secret_infoLevel7Map := make(map[string]interface{})
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo.Provider.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo.Provider.IsUnknown() {
+										clear_secret_infoLevel7Map["provider"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo.Provider.ValueString()
+									}
+									if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo.URL.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo.URL.IsUnknown() {
+										clear_secret_infoLevel7Map["url"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetInitiatorSecret.ClearSecretInfo.URL.ValueString()
+									}
+									chap_target_initiator_secretLevel6Map["clear_secret_info"] = clear_secret_infoLevel7Map
+								}
+								use_chapLevel5Map["chap_target_initiator_secret"] = chap_target_initiator_secretLevel6Map
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetUsername.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetUsername.IsUnknown() {
+								use_chapLevel5Map["chap_target_username"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapTargetUsername.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapUsername.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapUsername.IsUnknown() {
+								use_chapLevel5Map["chap_username"] = listItem.NetappTrident.NetappBackendOntapSan.UseChap.ChapUsername.ValueString()
+							}
+							netapp_backend_ontap_sanLevel4Map["use_chap"] = use_chapLevel5Map
+						}
+						if !listItem.NetappTrident.NetappBackendOntapSan.Username.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.Username.IsUnknown() {
+							netapp_backend_ontap_sanLevel4Map["username"] = listItem.NetappTrident.NetappBackendOntapSan.Username.ValueString()
+						}
+						if listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults != nil {
+							volume_defaultsLevel5Map := make(map[string]interface{})
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.AdaptiveQOSPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.AdaptiveQOSPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["adaptive_qos_policy"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.AdaptiveQOSPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.Encryption.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.Encryption.IsUnknown() {
+								volume_defaultsLevel5Map["encryption"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.Encryption.ValueBool()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.ExportPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.ExportPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["export_policy"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.ExportPolicy.ValueString()
+							}
+							if listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.NoQOS != nil {
+								volume_defaultsLevel5Map["no_qos"] = map[string]interface{}{}
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.QOSPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.QOSPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["qos_policy"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.QOSPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SecurityStyle.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SecurityStyle.IsUnknown() {
+								volume_defaultsLevel5Map["security_style"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SecurityStyle.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotDir.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotDir.IsUnknown() {
+								volume_defaultsLevel5Map["snapshot_dir"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotDir.ValueBool()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["snapshot_policy"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotReserve.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotReserve.IsUnknown() {
+								volume_defaultsLevel5Map["snapshot_reserve"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SnapshotReserve.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SpaceReserve.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SpaceReserve.IsUnknown() {
+								volume_defaultsLevel5Map["space_reserve"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SpaceReserve.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SplitOnClone.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SplitOnClone.IsUnknown() {
+								volume_defaultsLevel5Map["split_on_clone"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.SplitOnClone.ValueBool()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.TieringPolicy.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.TieringPolicy.IsUnknown() {
+								volume_defaultsLevel5Map["tiering_policy"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.TieringPolicy.ValueString()
+							}
+							if !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.UnixPermissions.IsNull() && !listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.UnixPermissions.IsUnknown() {
+								volume_defaultsLevel5Map["unix_permissions"] = listItem.NetappTrident.NetappBackendOntapSan.VolumeDefaults.UnixPermissions.ValueInt64()
+							}
+							netapp_backend_ontap_sanLevel4Map["volume_defaults"] = volume_defaultsLevel5Map
+						}
+						netapp_tridentDeepMap["netapp_backend_ontap_san"] = netapp_backend_ontap_sanLevel4Map
+					}
 					listItemMap["netapp_trident"] = netapp_tridentDeepMap
 				}
 				if listItem.PureServiceOrchestrator != nil {
 					pure_service_orchestratorDeepMap := make(map[string]interface{})
+					if listItem.PureServiceOrchestrator.Arrays != nil {
+						arraysLevel4Map := make(map[string]interface{})
+						if listItem.PureServiceOrchestrator.Arrays.FlashArray != nil {
+							flash_arrayLevel5Map := make(map[string]interface{})
+							if !listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultFsOpt.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultFsOpt.IsUnknown() {
+								flash_arrayLevel5Map["default_fs_opt"] = listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultFsOpt.ValueString()
+							}
+							if !listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultFsType.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultFsType.IsUnknown() {
+								flash_arrayLevel5Map["default_fs_type"] = listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultFsType.ValueString()
+							}
+							if !listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultMountOpts.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultMountOpts.IsUnknown() {
+								var default_mount_optsLevel5Items []string
+								diags := listItem.PureServiceOrchestrator.Arrays.FlashArray.DefaultMountOpts.ElementsAs(ctx, &default_mount_optsLevel5Items, false)
+								if !diags.HasError() {
+									flash_arrayLevel5Map["default_mount_opts"] = default_mount_optsLevel5Items
+								}
+							}
+							if !listItem.PureServiceOrchestrator.Arrays.FlashArray.DisablePreemptAttachments.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashArray.DisablePreemptAttachments.IsUnknown() {
+								flash_arrayLevel5Map["disable_preempt_attachments"] = listItem.PureServiceOrchestrator.Arrays.FlashArray.DisablePreemptAttachments.ValueBool()
+							}
+							if !listItem.PureServiceOrchestrator.Arrays.FlashArray.IscsiLoginTimeout.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashArray.IscsiLoginTimeout.IsUnknown() {
+								flash_arrayLevel5Map["iscsi_login_timeout"] = listItem.PureServiceOrchestrator.Arrays.FlashArray.IscsiLoginTimeout.ValueInt64()
+							}
+							if !listItem.PureServiceOrchestrator.Arrays.FlashArray.SanType.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashArray.SanType.IsUnknown() {
+								flash_arrayLevel5Map["san_type"] = listItem.PureServiceOrchestrator.Arrays.FlashArray.SanType.ValueString()
+							}
+							arraysLevel4Map["flash_array"] = flash_arrayLevel5Map
+						}
+						if listItem.PureServiceOrchestrator.Arrays.FlashBlade != nil {
+							flash_bladeLevel5Map := make(map[string]interface{})
+							if !listItem.PureServiceOrchestrator.Arrays.FlashBlade.EnableSnapshotDirectory.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashBlade.EnableSnapshotDirectory.IsUnknown() {
+								flash_bladeLevel5Map["enable_snapshot_directory"] = listItem.PureServiceOrchestrator.Arrays.FlashBlade.EnableSnapshotDirectory.ValueBool()
+							}
+							if !listItem.PureServiceOrchestrator.Arrays.FlashBlade.ExportRules.IsNull() && !listItem.PureServiceOrchestrator.Arrays.FlashBlade.ExportRules.IsUnknown() {
+								flash_bladeLevel5Map["export_rules"] = listItem.PureServiceOrchestrator.Arrays.FlashBlade.ExportRules.ValueString()
+							}
+							arraysLevel4Map["flash_blade"] = flash_bladeLevel5Map
+						}
+						pure_service_orchestratorDeepMap["arrays"] = arraysLevel4Map
+					}
 					if !listItem.PureServiceOrchestrator.ClusterID.IsNull() && !listItem.PureServiceOrchestrator.ClusterID.IsUnknown() {
 						pure_service_orchestratorDeepMap["cluster_id"] = listItem.PureServiceOrchestrator.ClusterID.ValueString()
 					}
@@ -7050,6 +8616,24 @@ func (r *FleetResource) Update(ctx context.Context, req resource.UpdateRequest,
 				}
 				if listItem.Nexthop != nil {
 					nexthopDeepMap := make(map[string]interface{})
+					if listItem.Nexthop.NexthopAddress != nil {
+						nexthop_addressLevel4Map := make(map[string]interface{})
+						if listItem.Nexthop.NexthopAddress.Ipv4 != nil {
+							ipv4Level5Map := make(map[string]interface{})
+							if !listItem.Nexthop.NexthopAddress.Ipv4.Addr.IsNull() && !listItem.Nexthop.NexthopAddress.Ipv4.Addr.IsUnknown() {
+								ipv4Level5Map["addr"] = listItem.Nexthop.NexthopAddress.Ipv4.Addr.ValueString()
+							}
+							nexthop_addressLevel4Map["ipv4"] = ipv4Level5Map
+						}
+						if listItem.Nexthop.NexthopAddress.Ipv6 != nil {
+							ipv6Level5Map := make(map[string]interface{})
+							if !listItem.Nexthop.NexthopAddress.Ipv6.Addr.IsNull() && !listItem.Nexthop.NexthopAddress.Ipv6.Addr.IsUnknown() {
+								ipv6Level5Map["addr"] = listItem.Nexthop.NexthopAddress.Ipv6.Addr.ValueString()
+							}
+							nexthop_addressLevel4Map["ipv6"] = ipv6Level5Map
+						}
+						nexthopDeepMap["nexthop_address"] = nexthop_addressLevel4Map
+					}
 					if !listItem.Nexthop.Type.IsNull() && !listItem.Nexthop.Type.IsUnknown() {
 						nexthopDeepMap["type"] = listItem.Nexthop.Type.ValueString()
 					}
@@ -7089,7 +8673,7 @@ func (r *FleetResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	_, err := r.client.UpdateFleet(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Fleet: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "fleet", "update"))
 		return
 	}
 
@@ -7100,9 +8684,12 @@ func (r *FleetResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetFleet(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Fleet after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "fleet", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["enable_default_fleet_config_download"].(bool); ok {
@@ -8204,7 +9791,7 @@ func (r *FleetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	err := r.client.DeleteFleet(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Fleet already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -8213,37 +9800,33 @@ func (r *FleetResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "Fleet delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Fleet: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "fleet", "delete"))
 		return
 	}
 }
 
 func (r *FleetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *FleetResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("fleet", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *FleetResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListFleets(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *FleetResource) requiredNamespace() string {
+	return "system"
 }
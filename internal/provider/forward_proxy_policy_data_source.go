@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,25 @@ type ForwardProxyPolicyDataSource struct {
 	client *client.Client
 }
 
+// ForwardProxyPolicyDataSourceModel mirrors ForwardProxyPolicyResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type ForwardProxyPolicyDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata     types.Object                               `tfsdk:"system_metadata"`
+	Name               types.String                               `tfsdk:"name"`
+	Namespace          types.String                               `tfsdk:"namespace"`
+	Annotations        types.Map                                  `tfsdk:"annotations"`
+	Description        types.String                               `tfsdk:"description"`
+	Disable            types.Bool                                 `tfsdk:"disable"`
+	Labels             types.Map                                  `tfsdk:"labels"`
+	ID                 types.String                               `tfsdk:"id"`
+	AllowAll           *ForwardProxyPolicyEmptyModel              `tfsdk:"allow_all"`
+	AllowList          *ForwardProxyPolicyAllowListModel          `tfsdk:"allow_list"`
+	AnyProxy           *ForwardProxyPolicyEmptyModel              `tfsdk:"any_proxy"`
+	DenyList           *ForwardProxyPolicyDenyListModel           `tfsdk:"deny_list"`
+	DrpHTTPConnect     *ForwardProxyPolicyEmptyModel              `tfsdk:"drp_http_connect"`
+	NetworkConnector   *ForwardProxyPolicyNetworkConnectorModel   `tfsdk:"network_connector"`
+	ProxyLabelSelector *ForwardProxyPolicyProxyLabelSelectorModel `tfsdk:"proxy_label_selector"`
+	RuleList           *ForwardProxyPolicyRuleListModel           `tfsdk:"rule_list"`
 }
 
 func (d *ForwardProxyPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +52,7 @@ func (d *ForwardProxyPolicyDataSource) Metadata(ctx context.Context, req datasou
 }
 
 func (d *ForwardProxyPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Forward Proxy Policy resource in F5 Distributed Cloud for forward proxy policy specification. configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the ForwardProxyPolicy.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the ForwardProxyPolicy exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the ForwardProxyPolicy.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewForwardProxyPolicyResource())
 }
 
 func (d *ForwardProxyPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +74,25 @@ func (d *ForwardProxyPolicyDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	resource, err := d.client.GetForwardProxyPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetForwardProxyPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ForwardProxyPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "forward_proxy_policy", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +106,8 @@ func (d *ForwardProxyPolicyDataSource) Read(ctx context.Context, req datasource.
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +116,550 @@ func (d *ForwardProxyPolicyDataSource) Read(ctx context.Context, req datasource.
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["allow_all"].(map[string]interface{}); ok && isImport && data.AllowAll == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AllowAll = &ForwardProxyPolicyEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["allow_list"].(map[string]interface{}); ok && (isImport || data.AllowList != nil) {
+		data.AllowList = &ForwardProxyPolicyAllowListModel{
+			DefaultActionAllow: func() *ForwardProxyPolicyEmptyModel {
+				if !isImport && data.AllowList != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.AllowList.DefaultActionAllow
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_action_allow"].(map[string]interface{}); ok {
+					return &ForwardProxyPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			DefaultActionDeny: func() *ForwardProxyPolicyEmptyModel {
+				if !isImport && data.AllowList != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.AllowList.DefaultActionDeny
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_action_deny"].(map[string]interface{}); ok {
+					return &ForwardProxyPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			DefaultActionNextPolicy: func() *ForwardProxyPolicyEmptyModel {
+				if !isImport && data.AllowList != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.AllowList.DefaultActionNextPolicy
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_action_next_policy"].(map[string]interface{}); ok {
+					return &ForwardProxyPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			DestList: func() []ForwardProxyPolicyAllowListDestListModel {
+				if listData, ok := blockData["dest_list"].([]interface{}); ok && len(listData) > 0 {
+					var result []ForwardProxyPolicyAllowListDestListModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, ForwardProxyPolicyAllowListDestListModel{
+								PortRanges: func() types.String {
+									if v, ok := itemMap["port_ranges"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			HTTPList: func() []ForwardProxyPolicyAllowListHTTPListModel {
+				if listData, ok := blockData["http_list"].([]interface{}); ok && len(listData) > 0 {
+					var result []ForwardProxyPolicyAllowListHTTPListModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, ForwardProxyPolicyAllowListHTTPListModel{
+								AnyPath: func() *ForwardProxyPolicyEmptyModel {
+									if _, ok := itemMap["any_path"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								ExactValue: func() types.String {
+									if v, ok := itemMap["exact_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								PathExactValue: func() types.String {
+									if v, ok := itemMap["path_exact_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								PathPrefixValue: func() types.String {
+									if v, ok := itemMap["path_prefix_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								PathRegexValue: func() types.String {
+									if v, ok := itemMap["path_regex_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								RegexValue: func() types.String {
+									if v, ok := itemMap["regex_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								SuffixValue: func() types.String {
+									if v, ok := itemMap["suffix_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			TLSList: func() []ForwardProxyPolicyAllowListTLSListModel {
+				if listData, ok := blockData["tls_list"].([]interface{}); ok && len(listData) > 0 {
+					var result []ForwardProxyPolicyAllowListTLSListModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, ForwardProxyPolicyAllowListTLSListModel{
+								ExactValue: func() types.String {
+									if v, ok := itemMap["exact_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								RegexValue: func() types.String {
+									if v, ok := itemMap["regex_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								SuffixValue: func() types.String {
+									if v, ok := itemMap["suffix_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["any_proxy"].(map[string]interface{}); ok && isImport && data.AnyProxy == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AnyProxy = &ForwardProxyPolicyEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["deny_list"].(map[string]interface{}); ok && (isImport || data.DenyList != nil) {
+		data.DenyList = &ForwardProxyPolicyDenyListModel{
+			DefaultActionAllow: func() *ForwardProxyPolicyEmptyModel {
+				if !isImport && data.DenyList != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DenyList.DefaultActionAllow
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_action_allow"].(map[string]interface{}); ok {
+					return &ForwardProxyPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			DefaultActionDeny: func() *ForwardProxyPolicyEmptyModel {
+				if !isImport && data.DenyList != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DenyList.DefaultActionDeny
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_action_deny"].(map[string]interface{}); ok {
+					return &ForwardProxyPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			DefaultActionNextPolicy: func() *ForwardProxyPolicyEmptyModel {
+				if !isImport && data.DenyList != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DenyList.DefaultActionNextPolicy
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_action_next_policy"].(map[string]interface{}); ok {
+					return &ForwardProxyPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			DestList: func() []ForwardProxyPolicyDenyListDestListModel {
+				if listData, ok := blockData["dest_list"].([]interface{}); ok && len(listData) > 0 {
+					var result []ForwardProxyPolicyDenyListDestListModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, ForwardProxyPolicyDenyListDestListModel{
+								PortRanges: func() types.String {
+									if v, ok := itemMap["port_ranges"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			HTTPList: func() []ForwardProxyPolicyDenyListHTTPListModel {
+				if listData, ok := blockData["http_list"].([]interface{}); ok && len(listData) > 0 {
+					var result []ForwardProxyPolicyDenyListHTTPListModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, ForwardProxyPolicyDenyListHTTPListModel{
+								AnyPath: func() *ForwardProxyPolicyEmptyModel {
+									if _, ok := itemMap["any_path"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								ExactValue: func() types.String {
+									if v, ok := itemMap["exact_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								PathExactValue: func() types.String {
+									if v, ok := itemMap["path_exact_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								PathPrefixValue: func() types.String {
+									if v, ok := itemMap["path_prefix_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								PathRegexValue: func() types.String {
+									if v, ok := itemMap["path_regex_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								RegexValue: func() types.String {
+									if v, ok := itemMap["regex_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								SuffixValue: func() types.String {
+									if v, ok := itemMap["suffix_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			TLSList: func() []ForwardProxyPolicyDenyListTLSListModel {
+				if listData, ok := blockData["tls_list"].([]interface{}); ok && len(listData) > 0 {
+					var result []ForwardProxyPolicyDenyListTLSListModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, ForwardProxyPolicyDenyListTLSListModel{
+								ExactValue: func() types.String {
+									if v, ok := itemMap["exact_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								RegexValue: func() types.String {
+									if v, ok := itemMap["regex_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								SuffixValue: func() types.String {
+									if v, ok := itemMap["suffix_value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["drp_http_connect"].(map[string]interface{}); ok && isImport && data.DrpHTTPConnect == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DrpHTTPConnect = &ForwardProxyPolicyEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["network_connector"].(map[string]interface{}); ok && (isImport || data.NetworkConnector != nil) {
+		data.NetworkConnector = &ForwardProxyPolicyNetworkConnectorModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["proxy_label_selector"].(map[string]interface{}); ok && (isImport || data.ProxyLabelSelector != nil) {
+		data.ProxyLabelSelector = &ForwardProxyPolicyProxyLabelSelectorModel{
+			Expressions: func() types.List {
+				if v, ok := blockData["expressions"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["rule_list"].(map[string]interface{}); ok && (isImport || data.RuleList != nil) {
+		data.RuleList = &ForwardProxyPolicyRuleListModel{
+			Rules: func() []ForwardProxyPolicyRuleListRulesModel {
+				if listData, ok := blockData["rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []ForwardProxyPolicyRuleListRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, ForwardProxyPolicyRuleListRulesModel{
+								Action: func() types.String {
+									if v, ok := itemMap["action"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								AllDestinations: func() *ForwardProxyPolicyEmptyModel {
+									if _, ok := itemMap["all_destinations"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								AllSources: func() *ForwardProxyPolicyEmptyModel {
+									if _, ok := itemMap["all_sources"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								DstAsnList: func() *ForwardProxyPolicyRuleListRulesDstAsnListModel {
+									if _, ok := itemMap["dst_asn_list"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesDstAsnListModel{}
+									}
+									return nil
+								}(),
+								DstAsnSet: func() *ForwardProxyPolicyRuleListRulesDstAsnSetModel {
+									if deepMap, ok := itemMap["dst_asn_set"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesDstAsnSetModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Namespace: func() types.String {
+												if v, ok := deepMap["namespace"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Tenant: func() types.String {
+												if v, ok := deepMap["tenant"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								DstIPPrefixSet: func() *ForwardProxyPolicyRuleListRulesDstIPPrefixSetModel {
+									if deepMap, ok := itemMap["dst_ip_prefix_set"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesDstIPPrefixSetModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Namespace: func() types.String {
+												if v, ok := deepMap["namespace"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Tenant: func() types.String {
+												if v, ok := deepMap["tenant"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								DstLabelSelector: func() *ForwardProxyPolicyRuleListRulesDstLabelSelectorModel {
+									if _, ok := itemMap["dst_label_selector"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesDstLabelSelectorModel{}
+									}
+									return nil
+								}(),
+								DstPrefixList: func() *ForwardProxyPolicyRuleListRulesDstPrefixListModel {
+									if _, ok := itemMap["dst_prefix_list"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesDstPrefixListModel{}
+									}
+									return nil
+								}(),
+								HTTPList: func() *ForwardProxyPolicyRuleListRulesHTTPListModel {
+									if _, ok := itemMap["http_list"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesHTTPListModel{}
+									}
+									return nil
+								}(),
+								IPPrefixSet: func() *ForwardProxyPolicyRuleListRulesIPPrefixSetModel {
+									if deepMap, ok := itemMap["ip_prefix_set"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesIPPrefixSetModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Namespace: func() types.String {
+												if v, ok := deepMap["namespace"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Tenant: func() types.String {
+												if v, ok := deepMap["tenant"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								LabelSelector: func() *ForwardProxyPolicyRuleListRulesLabelSelectorModel {
+									if _, ok := itemMap["label_selector"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesLabelSelectorModel{}
+									}
+									return nil
+								}(),
+								Metadata: func() *ForwardProxyPolicyRuleListRulesMetadataModel {
+									if deepMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesMetadataModel{
+											DescriptionSpec: func() types.String {
+												if v, ok := deepMap["description"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								NoHTTPConnectPort: func() *ForwardProxyPolicyEmptyModel {
+									if _, ok := itemMap["no_http_connect_port"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								PortMatcher: func() *ForwardProxyPolicyRuleListRulesPortMatcherModel {
+									if deepMap, ok := itemMap["port_matcher"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesPortMatcherModel{
+											InvertMatcher: func() types.Bool {
+												if v, ok := deepMap["invert_matcher"].(bool); ok {
+													return types.BoolValue(v)
+												}
+												return types.BoolNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								PrefixList: func() *ForwardProxyPolicyRuleListRulesPrefixListModel {
+									if _, ok := itemMap["prefix_list"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesPrefixListModel{}
+									}
+									return nil
+								}(),
+								TLSList: func() *ForwardProxyPolicyRuleListRulesTLSListModel {
+									if _, ok := itemMap["tls_list"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesTLSListModel{}
+									}
+									return nil
+								}(),
+								URLCategoryList: func() *ForwardProxyPolicyRuleListRulesURLCategoryListModel {
+									if _, ok := itemMap["url_category_list"].(map[string]interface{}); ok {
+										return &ForwardProxyPolicyRuleListRulesURLCategoryListModel{}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &ForwardProxyPolicyResource{}
-	_ resource.ResourceWithConfigure      = &ForwardProxyPolicyResource{}
-	_ resource.ResourceWithImportState    = &ForwardProxyPolicyResource{}
-	_ resource.ResourceWithModifyPlan     = &ForwardProxyPolicyResource{}
-	_ resource.ResourceWithValidateConfig = &ForwardProxyPolicyResource{}
+	_ resource.Resource                     = &ForwardProxyPolicyResource{}
+	_ resource.ResourceWithConfigure        = &ForwardProxyPolicyResource{}
+	_ resource.ResourceWithImportState      = &ForwardProxyPolicyResource{}
+	_ resource.ResourceWithIdentity         = &ForwardProxyPolicyResource{}
+	_ resource.ResourceWithMoveState        = &ForwardProxyPolicyResource{}
+	_ resource.ResourceWithModifyPlan       = &ForwardProxyPolicyResource{}
+	_ resource.ResourceWithValidateConfig   = &ForwardProxyPolicyResource{}
+	_ resource.ResourceWithConfigValidators = &ForwardProxyPolicyResource{}
+	_ listableResource                      = &ForwardProxyPolicyResource{}
 )
 
 func NewForwardProxyPolicyResource() resource.Resource {
@@ -452,6 +458,8 @@ type ForwardProxyPolicyResourceModel struct {
 	Labels             types.Map                                  `tfsdk:"labels"`
 	ID                 types.String                               `tfsdk:"id"`
 	Timeouts           timeouts.Value                             `tfsdk:"timeouts"`
+	SystemMetadata     types.Object                               `tfsdk:"system_metadata"`
+	LabelsAll          types.Map                                  `tfsdk:"labels_all"`
 	AllowAll           *ForwardProxyPolicyEmptyModel              `tfsdk:"allow_all"`
 	AllowList          *ForwardProxyPolicyAllowListModel          `tfsdk:"allow_list"`
 	AnyProxy           *ForwardProxyPolicyEmptyModel              `tfsdk:"any_proxy"`
@@ -481,9 +489,11 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Forward Proxy Policy will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Forward Proxy Policy will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -515,6 +525,8 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -541,52 +553,100 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 					},
 					"dest_list": schema.ListNestedBlock{
 						MarkdownDescription: "L4 destinations for non-HTTP and non-TLS connections and TLS connections without SNI.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"ipv6_prefixes": schema.ListAttribute{
 									MarkdownDescription: "IPv6 Prefixes. Destination IPv6 prefixes.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(32),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(validators.IPv6PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+									},
 								},
 								"port_ranges": schema.StringAttribute{
 									MarkdownDescription: "String containing a comma separated list of port ranges. Each port range consists of a single port or two ports separated by '-'.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(512),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"prefixes": schema.ListAttribute{
 									MarkdownDescription: "IPv4 Prefixes. Destination IPv4 prefixes.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(32),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+									},
 								},
 							},
 						},
 					},
 					"http_list": schema.ListNestedBlock{
 						MarkdownDescription: "HTTP URLs. URLs for HTTP connections.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"exact_value": schema.StringAttribute{
 									MarkdownDescription: "Exact domain name.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"path_exact_value": schema.StringAttribute{
 									MarkdownDescription: "Exact Path to match.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"path_prefix_value": schema.StringAttribute{
 									MarkdownDescription: "Prefix of Path e.g '/abc/xyz' will match '/abc/xyz/.*'.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"path_regex_value": schema.StringAttribute{
 									MarkdownDescription: "Regular Expression value for the Path to match.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+										validators.RegexValidator(),
+									},
 								},
 								"regex_value": schema.StringAttribute{
 									MarkdownDescription: "Regular Expression value for the domain name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+										validators.RegexValidator(),
+									},
 								},
 								"suffix_value": schema.StringAttribute{
 									MarkdownDescription: "Suffix of domain names e.g 'xyz.com' will match '*.xyz.com'.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -598,19 +658,37 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 					},
 					"tls_list": schema.ListNestedBlock{
 						MarkdownDescription: "TLS Domains. Domains in SNI for TLS connections.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"exact_value": schema.StringAttribute{
 									MarkdownDescription: "Exact domain name.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"regex_value": schema.StringAttribute{
 									MarkdownDescription: "Regular Expression value for the domain name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+										validators.RegexValidator(),
+									},
 								},
 								"suffix_value": schema.StringAttribute{
 									MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -635,52 +713,100 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 					},
 					"dest_list": schema.ListNestedBlock{
 						MarkdownDescription: "L4 destinations for non-HTTP and non-TLS connections and TLS connections without SNI.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"ipv6_prefixes": schema.ListAttribute{
 									MarkdownDescription: "IPv6 Prefixes. Destination IPv6 prefixes.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(32),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(validators.IPv6PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+									},
 								},
 								"port_ranges": schema.StringAttribute{
 									MarkdownDescription: "String containing a comma separated list of port ranges. Each port range consists of a single port or two ports separated by '-'.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(512),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"prefixes": schema.ListAttribute{
 									MarkdownDescription: "IPv4 Prefixes. Destination IPv4 prefixes.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(32),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+									},
 								},
 							},
 						},
 					},
 					"http_list": schema.ListNestedBlock{
 						MarkdownDescription: "HTTP URLs. URLs for HTTP connections.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"exact_value": schema.StringAttribute{
 									MarkdownDescription: "Exact domain name.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"path_exact_value": schema.StringAttribute{
 									MarkdownDescription: "Exact Path to match.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"path_prefix_value": schema.StringAttribute{
 									MarkdownDescription: "Prefix of Path e.g '/abc/xyz' will match '/abc/xyz/.*'.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"path_regex_value": schema.StringAttribute{
 									MarkdownDescription: "Regular Expression value for the Path to match.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+										validators.RegexValidator(),
+									},
 								},
 								"regex_value": schema.StringAttribute{
 									MarkdownDescription: "Regular Expression value for the domain name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+										validators.RegexValidator(),
+									},
 								},
 								"suffix_value": schema.StringAttribute{
 									MarkdownDescription: "Suffix of domain names e.g 'xyz.com' will match '*.xyz.com'.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -692,19 +818,37 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 					},
 					"tls_list": schema.ListNestedBlock{
 						MarkdownDescription: "TLS Domains. Domains in SNI for TLS connections.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"exact_value": schema.StringAttribute{
 									MarkdownDescription: "Exact domain name.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"regex_value": schema.StringAttribute{
 									MarkdownDescription: "Regular Expression value for the domain name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+										validators.RegexValidator(),
+									},
 								},
 								"suffix_value": schema.StringAttribute{
 									MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -720,6 +864,10 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 					"name": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(128),
+							stringvalidator.LengthAtLeast(1),
+						},
 					},
 					"namespace": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -728,6 +876,9 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 					"tenant": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -736,6 +887,9 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 				},
 			},
@@ -746,6 +900,10 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 						MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+						},
 					},
 				},
 			},
@@ -755,6 +913,9 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 				Blocks: map[string]schema.Block{
 					"rules": schema.ListNestedBlock{
 						MarkdownDescription: "Custom Rule List. List of custom rules .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"action": schema.StringAttribute{
@@ -776,6 +937,11 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 											Optional:            true,
 											ElementType:         types.Int64Type,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.SizeAtLeast(1),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 								},
@@ -785,6 +951,10 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -793,6 +963,9 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -801,6 +974,9 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -810,6 +986,10 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -818,6 +998,9 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -826,6 +1009,9 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -836,6 +1022,10 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -846,6 +1036,11 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+											},
 										},
 									},
 								},
@@ -855,31 +1050,62 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 									Blocks: map[string]schema.Block{
 										"http_list": schema.ListNestedBlock{
 											MarkdownDescription: "HTTP URLs. URLs for HTTP connections.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"exact_value": schema.StringAttribute{
 														MarkdownDescription: "Exact domain name.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.HostnameValidator(),
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 													"path_exact_value": schema.StringAttribute{
 														MarkdownDescription: "Exact Path to match.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 													"path_prefix_value": schema.StringAttribute{
 														MarkdownDescription: "Prefix of Path e.g '/abc/xyz' will match '/abc/xyz/.*'.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 													"path_regex_value": schema.StringAttribute{
 														MarkdownDescription: "Regular Expression value for the Path to match.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+															validators.RegexValidator(),
+														},
 													},
 													"regex_value": schema.StringAttribute{
 														MarkdownDescription: "Regular Expression value for the domain name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+															validators.RegexValidator(),
+														},
 													},
 													"suffix_value": schema.StringAttribute{
 														MarkdownDescription: "Suffix of domain names e.g 'xyz.com' will match '*.xyz.com'.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.HostnameValidator(),
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -897,6 +1123,10 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -905,6 +1135,9 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -913,6 +1146,9 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -923,6 +1159,10 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -932,10 +1172,16 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Human readable description.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 								},
@@ -953,6 +1199,10 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											MarkdownDescription: "List of strings, each of which is a single port value or a tuple of start and end port values separated by '-'. The start and end values are considered to be part of the range.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 								},
@@ -963,6 +1213,11 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+											},
 										},
 									},
 								},
@@ -972,19 +1227,37 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 									Blocks: map[string]schema.Block{
 										"tls_list": schema.ListNestedBlock{
 											MarkdownDescription: "TLS Domains. Domains in SNI for TLS connections.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"exact_value": schema.StringAttribute{
 														MarkdownDescription: "Exact domain name.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.HostnameValidator(),
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 													"regex_value": schema.StringAttribute{
 														MarkdownDescription: "Regular Expression value for the domain name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+															validators.RegexValidator(),
+														},
 													},
 													"suffix_value": schema.StringAttribute{
 														MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.HostnameValidator(),
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 												},
 											},
@@ -998,6 +1271,10 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 											MarkdownDescription: "[Enum: UNCATEGORIZED|REAL_ESTATE|COMPUTER_AND_INTERNET_SECURITY|FINANCIAL_SERVICES|BUSINESS_AND_ECONOMY|COMPUTER_AND_INTERNET_INFO|AUCTIONS|SHOPPING|CULT_AND_OCCULT|TRAVEL|ABUSED_DRUGS|ADULT_AND_PORNOGRAPHY|HOME_AND_GARDEN|MILITARY|SOCIAL_NETWORKING|DEAD_SITES|INDIVIDUAL_STOCK_ADVICE_AND_TOOLS|TRAINING_AND_TOOLS|DATING|SEX_EDUCATION|RELIGION|ENTERTAINMENT_AND_ARTS|PERSONAL_SITES_AND_BLOGS|LEGAL|LOCAL_INFORMATION|STREAMING_MEDIA|JOB_SEARCH|GAMBLING|TRANSLATION|REFERENCE_AND_RESEARCH|SHAREWARE_AND_FREEWARE|PEER_TO_PEER|MARIJUANA|HACKING|GAMES|PHILOSOPHY_AND_POLITICAL_ADVOCACY|WEAPONS|PAY_TO_SURF|HUNTING_AND_FISHING|SOCIETY|EDUCATIONAL_INSTITUTIONS|ONLINE_GREETING_CARDS|SPORTS|SWIMSUITS_AND_INTIMATE_APPAREL|QUESTIONABLE|KIDS|HATE_AND_RACISM|PERSONAL_STORAGE|VIOLENCE|KEYLOGGERS_AND_MONITORING|SEARCH_ENGINES|INTERNET_PORTALS|WEB_ADVERTISEMENTS|CHEATING|GROSS|WEB_BASED_EMAIL|MALWARE_SITES|PHISHING_AND_OTHER_FRAUDS|PROXY_AVOIDANCE_AND_ANONYMIZERS|SPYWARE_AND_ADWARE|MUSIC|GOVERNMENT|NUDITY|NEWS_AND_MEDIA|ILLEGAL|CONTENT_DELIVERY_NETWORKS|INTERNET_COMMUNICATIONS|BOT_NETS|ABORTION|HEALTH_AND_MEDICINE|CONFIRMED_SPAM_SOURCES|SPAM_URLS|UNCONFIRMED_SPAM_SOURCES|OPEN_HTTP_PROXIES|DYNAMICALLY_GENERATED_CONTENT|PARKED_DOMAINS|ALCOHOL_AND_TOBACCO|PRIVATE_IP_ADDRESSES|IMAGE_AND_VIDEO_SEARCH|FASHION_AND_BEAUTY|RECREATION_AND_HOBBIES|MOTOR_VEHICLES|WEB_HOSTING] List of URL categories to be selected . Possible values are `UNCATEGORIZED`, `REAL_ESTATE`, `COMPUTER_AND_INTERNET_SECURITY`, `FINANCIAL_SERVICES`, `BUSINESS_AND_ECONOMY`, `COMPUTER_AND_INTERNET_INFO`, `AUCTIONS`, `SHOPPING`, `CULT_AND_OCCULT`, `TRAVEL`, `ABUSED_DRUGS`, `ADULT_AND_PORNOGRAPHY`, `HOME_AND_GARDEN`, `MILITARY`, `SOCIAL_NETWORKING`, `DEAD_SITES`, `INDIVIDUAL_STOCK_ADVICE_AND_TOOLS`, `TRAINING_AND_TOOLS`, `DATING`, `SEX_EDUCATION`, `RELIGION`, `ENTERTAINMENT_AND_ARTS`, `PERSONAL_SITES_AND_BLOGS`, `LEGAL`, `LOCAL_INFORMATION`, `STREAMING_MEDIA`, `JOB_SEARCH`, `GAMBLING`, `TRANSLATION`, `REFERENCE_AND_RESEARCH`, `SHAREWARE_AND_FREEWARE`, `PEER_TO_PEER`, `MARIJUANA`, `HACKING`, `GAMES`, `PHILOSOPHY_AND_POLITICAL_ADVOCACY`, `WEAPONS`, `PAY_TO_SURF`, `HUNTING_AND_FISHING`, `SOCIETY`, `EDUCATIONAL_INSTITUTIONS`, `ONLINE_GREETING_CARDS`, `SPORTS`, `SWIMSUITS_AND_INTIMATE_APPAREL`, `QUESTIONABLE`, `KIDS`, `HATE_AND_RACISM`, `PERSONAL_STORAGE`, `VIOLENCE`, `KEYLOGGERS_AND_MONITORING`, `SEARCH_ENGINES`, `INTERNET_PORTALS`, `WEB_ADVERTISEMENTS`, `CHEATING`, `GROSS`, `WEB_BASED_EMAIL`, `MALWARE_SITES`, `PHISHING_AND_OTHER_FRAUDS`, `PROXY_AVOIDANCE_AND_ANONYMIZERS`, `SPYWARE_AND_ADWARE`, `MUSIC`, `GOVERNMENT`, `NUDITY`, `NEWS_AND_MEDIA`, `ILLEGAL`, `CONTENT_DELIVERY_NETWORKS`, `INTERNET_COMMUNICATIONS`, `BOT_NETS`, `ABORTION`, `HEALTH_AND_MEDICINE`, `CONFIRMED_SPAM_SOURCES`, `SPAM_URLS`, `UNCONFIRMED_SPAM_SOURCES`, `OPEN_HTTP_PROXIES`, `DYNAMICALLY_GENERATED_CONTENT`, `PARKED_DOMAINS`, `ALCOHOL_AND_TOBACCO`, `PRIVATE_IP_ADDRESSES`, `IMAGE_AND_VIDEO_SEARCH`, `FASHION_AND_BEAUTY`, `RECREATION_AND_HOBBIES`, `MOTOR_VEHICLES`, `WEB_HOSTING`. Defaults to `UNCATEGORIZED`.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 								},
@@ -1010,6 +1287,11 @@ func (r *ForwardProxyPolicyResource) Schema(ctx context.Context, req resource.Sc
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *ForwardProxyPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *ForwardProxyPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -1034,6 +1316,29 @@ func (r *ForwardProxyPolicyResource) ValidateConfig(ctx context.Context, req res
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *ForwardProxyPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "proxy_choice", "any_proxy", "drp_http_connect", "network_connector", "proxy_label_selector"),
+		validators.OneOfGroup(path.MatchRelative(), "rule_choice", "allow_all", "allow_list", "deny_list", "rule_list"),
+		validators.OneOfGroup(path.MatchRoot("allow_list"), "default_action_choice", "default_action_allow", "default_action_deny", "default_action_next_policy"),
+		validators.OneOfGroup(path.MatchRoot("allow_list").AtName("http_list").AtAnyListIndex(), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("allow_list").AtName("http_list").AtAnyListIndex(), "path_choice", "any_path", "path_exact_value", "path_prefix_value", "path_regex_value"),
+		validators.OneOfGroup(path.MatchRoot("allow_list").AtName("tls_list").AtAnyListIndex(), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("deny_list"), "default_action_choice", "default_action_allow", "default_action_deny", "default_action_next_policy"),
+		validators.OneOfGroup(path.MatchRoot("deny_list").AtName("http_list").AtAnyListIndex(), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("deny_list").AtName("http_list").AtAnyListIndex(), "path_choice", "any_path", "path_exact_value", "path_prefix_value", "path_regex_value"),
+		validators.OneOfGroup(path.MatchRoot("deny_list").AtName("tls_list").AtAnyListIndex(), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex(), "destination_choice", "all_destinations", "dst_asn_list", "dst_asn_set", "dst_ip_prefix_set", "dst_label_selector", "dst_prefix_list", "http_list", "tls_list", "url_category_list"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex(), "http_connect_choice", "no_http_connect_port", "port_matcher"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex(), "source_choice", "all_sources", "ip_prefix_set", "label_selector", "prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("http_list").AtName("http_list").AtAnyListIndex(), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("http_list").AtName("http_list").AtAnyListIndex(), "path_choice", "any_path", "path_exact_value", "path_prefix_value", "path_regex_value"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("tls_list").AtName("tls_list").AtAnyListIndex(), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *ForwardProxyPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -1044,7 +1349,10 @@ func (r *ForwardProxyPolicyResource) ModifyPlan(ctx context.Context, req resourc
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "forward_proxy_policy", "", req.Config, &resp.Plan)...)
 		var plan ForwardProxyPolicyResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -1101,6 +1409,7 @@ func (r *ForwardProxyPolicyResource) Create(ctx context.Context, req resource.Cr
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1110,6 +1419,7 @@ func (r *ForwardProxyPolicyResource) Create(ctx context.Context, req resource.Cr
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AllowAll != nil {
@@ -1403,11 +1713,15 @@ func (r *ForwardProxyPolicyResource) Create(ctx context.Context, req resource.Cr
 
 	apiResource, err := r.client.CreateForwardProxyPolicy(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ForwardProxyPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "forward_proxy_policy", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1977,7 +2291,7 @@ func (r *ForwardProxyPolicyResource) Read(ctx context.Context, req resource.Read
 	apiResource, err := r.client.GetForwardProxyPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "ForwardProxyPolicy not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1985,11 +2299,12 @@ func (r *ForwardProxyPolicyResource) Read(ctx context.Context, req resource.Read
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ForwardProxyPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "forward_proxy_policy", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -2000,31 +2315,13 @@ func (r *ForwardProxyPolicyResource) Read(ctx context.Context, req resource.Read
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -2032,6 +2329,7 @@ func (r *ForwardProxyPolicyResource) Read(ctx context.Context, req resource.Read
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["allow_all"].(map[string]interface{}); ok && isImport && data.AllowAll == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -2613,6 +2911,7 @@ func (r *ForwardProxyPolicyResource) Update(ctx context.Context, req resource.Up
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -2622,6 +2921,7 @@ func (r *ForwardProxyPolicyResource) Update(ctx context.Context, req resource.Up
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AllowAll != nil {
@@ -2915,7 +3215,7 @@ func (r *ForwardProxyPolicyResource) Update(ctx context.Context, req resource.Up
 
 	_, err := r.client.UpdateForwardProxyPolicy(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ForwardProxyPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "forward_proxy_policy", "update"))
 		return
 	}
 
@@ -2926,9 +3226,12 @@ func (r *ForwardProxyPolicyResource) Update(ctx context.Context, req resource.Up
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetForwardProxyPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ForwardProxyPolicy after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "forward_proxy_policy", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -3498,7 +3801,7 @@ func (r *ForwardProxyPolicyResource) Delete(ctx context.Context, req resource.De
 	err := r.client.DeleteForwardProxyPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "ForwardProxyPolicy already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -3507,37 +3810,33 @@ func (r *ForwardProxyPolicyResource) Delete(ctx context.Context, req resource.De
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "ForwardProxyPolicy delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ForwardProxyPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "forward_proxy_policy", "delete"))
 		return
 	}
 }
 
 func (r *ForwardProxyPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *ForwardProxyPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("forward_proxy_policy", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *ForwardProxyPolicyResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListForwardProxyPolicies(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *ForwardProxyPolicyResource) requiredNamespace() string {
+	return ""
 }
@@ -9,10 +9,13 @@ package provider
 
 import (
 	"context"
+	"net/http"
+	"sync"
 
 	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
 	"github.com/f5xc/terraform-provider-f5xc/internal/functions"
 )
 
@@ -32,7 +35,35 @@ var _ provider.ProviderWithFunctions = &F5XCProvider{}
 //	provider::f5xc::blindfold_file(path, policy_name, namespace)
 func (p *F5XCProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
-		functions.NewBlindfoldFunction,
-		functions.NewBlindfoldFileFunction,
+		func() function.Function {
+			return functions.NewBlindfoldFunctionWithTransport(p.functionTransport.get)
+		},
+		func() function.Function {
+			return functions.NewBlindfoldFileFunctionWithTransport(p.functionTransport.get)
+		},
 	}
 }
+
+// functionTransport holds the HTTP transport of the configured provider
+// client. Provider functions cannot read the provider configuration, so they
+// use this transport when the provider was configured before they are
+// called, and the transport environment variables otherwise.
+type functionTransport struct {
+	mu        sync.RWMutex
+	transport *http.Transport
+}
+
+// set stores the transport of c, unless c uses a custom round tripper
+func (t *functionTransport) set(c *client.Client) {
+	transport, _ := c.HTTPClient.Transport.(*http.Transport)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.transport = transport
+}
+
+// get returns the stored transport, or nil before the provider is configured
+func (t *functionTransport) get() *http.Transport {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return t.transport
+}
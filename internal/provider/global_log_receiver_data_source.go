@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,36 @@ type GlobalLogReceiverDataSource struct {
 	client *client.Client
 }
 
+// GlobalLogReceiverDataSourceModel mirrors GlobalLogReceiverResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type GlobalLogReceiverDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata         types.Object                                  `tfsdk:"system_metadata"`
+	Name                   types.String                                  `tfsdk:"name"`
+	Namespace              types.String                                  `tfsdk:"namespace"`
+	Annotations            types.Map                                     `tfsdk:"annotations"`
+	Description            types.String                                  `tfsdk:"description"`
+	Disable                types.Bool                                    `tfsdk:"disable"`
+	Labels                 types.Map                                     `tfsdk:"labels"`
+	ID                     types.String                                  `tfsdk:"id"`
+	AuditLogs              *GlobalLogReceiverEmptyModel                  `tfsdk:"audit_logs"`
+	AWSCloudWatchReceiver  *GlobalLogReceiverAWSCloudWatchReceiverModel  `tfsdk:"aws_cloud_watch_receiver"`
+	AzureEventHubsReceiver *GlobalLogReceiverAzureEventHubsReceiverModel `tfsdk:"azure_event_hubs_receiver"`
+	AzureReceiver          *GlobalLogReceiverAzureReceiverModel          `tfsdk:"azure_receiver"`
+	DatadogReceiver        *GlobalLogReceiverDatadogReceiverModel        `tfsdk:"datadog_receiver"`
+	DNSLogs                *GlobalLogReceiverEmptyModel                  `tfsdk:"dns_logs"`
+	GCPBucketReceiver      *GlobalLogReceiverGCPBucketReceiverModel      `tfsdk:"gcp_bucket_receiver"`
+	HTTPReceiver           *GlobalLogReceiverHTTPReceiverModel           `tfsdk:"http_receiver"`
+	KafkaReceiver          *GlobalLogReceiverKafkaReceiverModel          `tfsdk:"kafka_receiver"`
+	NewRelicReceiver       *GlobalLogReceiverNewRelicReceiverModel       `tfsdk:"new_relic_receiver"`
+	NsAll                  *GlobalLogReceiverEmptyModel                  `tfsdk:"ns_all"`
+	NsCurrent              *GlobalLogReceiverEmptyModel                  `tfsdk:"ns_current"`
+	NsList                 *GlobalLogReceiverNsListModel                 `tfsdk:"ns_list"`
+	QradarReceiver         *GlobalLogReceiverQradarReceiverModel         `tfsdk:"qradar_receiver"`
+	RequestLogs            *GlobalLogReceiverEmptyModel                  `tfsdk:"request_logs"`
+	S3Receiver             *GlobalLogReceiverS3ReceiverModel             `tfsdk:"s3_receiver"`
+	SecurityEvents         *GlobalLogReceiverEmptyModel                  `tfsdk:"security_events"`
+	SplunkReceiver         *GlobalLogReceiverSplunkReceiverModel         `tfsdk:"splunk_receiver"`
+	SumoLogicReceiver      *GlobalLogReceiverSumoLogicReceiverModel      `tfsdk:"sumo_logic_receiver"`
 }
 
 func (d *GlobalLogReceiverDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +63,7 @@ func (d *GlobalLogReceiverDataSource) Metadata(ctx context.Context, req datasour
 }
 
 func (d *GlobalLogReceiverDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages new Global Log Receiver object. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the GlobalLogReceiver.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the GlobalLogReceiver exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the GlobalLogReceiver.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewGlobalLogReceiverResource())
 }
 
 func (d *GlobalLogReceiverDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +85,25 @@ func (d *GlobalLogReceiverDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
-	resource, err := d.client.GetGlobalLogReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetGlobalLogReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read GlobalLogReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "global_log_receiver", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +117,8 @@ func (d *GlobalLogReceiverDataSource) Read(ctx context.Context, req datasource.R
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +127,960 @@ func (d *GlobalLogReceiverDataSource) Read(ctx context.Context, req datasource.R
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["audit_logs"].(map[string]interface{}); ok && isImport && data.AuditLogs == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AuditLogs = &GlobalLogReceiverEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["aws_cloud_watch_receiver"].(map[string]interface{}); ok && (isImport || data.AWSCloudWatchReceiver != nil) {
+		data.AWSCloudWatchReceiver = &GlobalLogReceiverAWSCloudWatchReceiverModel{
+			AWSCred: func() *GlobalLogReceiverAWSCloudWatchReceiverAWSCredModel {
+				if !isImport && data.AWSCloudWatchReceiver != nil && data.AWSCloudWatchReceiver.AWSCred != nil {
+					// Normal Read: preserve existing state value
+					return data.AWSCloudWatchReceiver.AWSCred
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["aws_cred"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverAWSCloudWatchReceiverAWSCredModel{
+						Name: func() types.String {
+							if v, ok := nestedBlockData["name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Namespace: func() types.String {
+							if v, ok := nestedBlockData["namespace"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Tenant: func() types.String {
+							if v, ok := nestedBlockData["tenant"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			AWSRegion: func() types.String {
+				if v, ok := blockData["aws_region"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Batch: func() *GlobalLogReceiverAWSCloudWatchReceiverBatchModel {
+				if !isImport && data.AWSCloudWatchReceiver != nil && data.AWSCloudWatchReceiver.Batch != nil {
+					// Normal Read: preserve existing state value
+					return data.AWSCloudWatchReceiver.Batch
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["batch"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverAWSCloudWatchReceiverBatchModel{
+						MaxBytes: func() types.Int64 {
+							if v, ok := nestedBlockData["max_bytes"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						MaxEvents: func() types.Int64 {
+							if v, ok := nestedBlockData["max_events"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						TimeoutSeconds: func() types.String {
+							if v, ok := nestedBlockData["timeout_seconds"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			Compression: func() *GlobalLogReceiverAWSCloudWatchReceiverCompressionModel {
+				if !isImport && data.AWSCloudWatchReceiver != nil && data.AWSCloudWatchReceiver.Compression != nil {
+					// Normal Read: preserve existing state value
+					return data.AWSCloudWatchReceiver.Compression
+				}
+				// Import case: read from API
+				if _, ok := blockData["compression"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverAWSCloudWatchReceiverCompressionModel{}
+				}
+				return nil
+			}(),
+			GroupName: func() types.String {
+				if v, ok := blockData["group_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			StreamName: func() types.String {
+				if v, ok := blockData["stream_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["azure_event_hubs_receiver"].(map[string]interface{}); ok && (isImport || data.AzureEventHubsReceiver != nil) {
+		data.AzureEventHubsReceiver = &GlobalLogReceiverAzureEventHubsReceiverModel{
+			ConnectionString: func() *GlobalLogReceiverAzureEventHubsReceiverConnectionStringModel {
+				if !isImport && data.AzureEventHubsReceiver != nil && data.AzureEventHubsReceiver.ConnectionString != nil {
+					// Normal Read: preserve existing state value
+					return data.AzureEventHubsReceiver.ConnectionString
+				}
+				// Import case: read from API
+				if _, ok := blockData["connection_string"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverAzureEventHubsReceiverConnectionStringModel{}
+				}
+				return nil
+			}(),
+			Instance: func() types.String {
+				if v, ok := blockData["instance"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["azure_receiver"].(map[string]interface{}); ok && (isImport || data.AzureReceiver != nil) {
+		data.AzureReceiver = &GlobalLogReceiverAzureReceiverModel{
+			Batch: func() *GlobalLogReceiverAzureReceiverBatchModel {
+				if !isImport && data.AzureReceiver != nil && data.AzureReceiver.Batch != nil {
+					// Normal Read: preserve existing state value
+					return data.AzureReceiver.Batch
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["batch"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverAzureReceiverBatchModel{
+						MaxBytes: func() types.Int64 {
+							if v, ok := nestedBlockData["max_bytes"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						MaxEvents: func() types.Int64 {
+							if v, ok := nestedBlockData["max_events"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						TimeoutSeconds: func() types.String {
+							if v, ok := nestedBlockData["timeout_seconds"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			Compression: func() *GlobalLogReceiverAzureReceiverCompressionModel {
+				if !isImport && data.AzureReceiver != nil && data.AzureReceiver.Compression != nil {
+					// Normal Read: preserve existing state value
+					return data.AzureReceiver.Compression
+				}
+				// Import case: read from API
+				if _, ok := blockData["compression"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverAzureReceiverCompressionModel{}
+				}
+				return nil
+			}(),
+			ConnectionString: func() *GlobalLogReceiverAzureReceiverConnectionStringModel {
+				if !isImport && data.AzureReceiver != nil && data.AzureReceiver.ConnectionString != nil {
+					// Normal Read: preserve existing state value
+					return data.AzureReceiver.ConnectionString
+				}
+				// Import case: read from API
+				if _, ok := blockData["connection_string"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverAzureReceiverConnectionStringModel{}
+				}
+				return nil
+			}(),
+			ContainerName: func() types.String {
+				if v, ok := blockData["container_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			FilenameOptions: func() *GlobalLogReceiverAzureReceiverFilenameOptionsModel {
+				if !isImport && data.AzureReceiver != nil && data.AzureReceiver.FilenameOptions != nil {
+					// Normal Read: preserve existing state value
+					return data.AzureReceiver.FilenameOptions
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["filename_options"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverAzureReceiverFilenameOptionsModel{
+						CustomFolder: func() types.String {
+							if v, ok := nestedBlockData["custom_folder"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["datadog_receiver"].(map[string]interface{}); ok && (isImport || data.DatadogReceiver != nil) {
+		data.DatadogReceiver = &GlobalLogReceiverDatadogReceiverModel{
+			Batch: func() *GlobalLogReceiverDatadogReceiverBatchModel {
+				if !isImport && data.DatadogReceiver != nil && data.DatadogReceiver.Batch != nil {
+					// Normal Read: preserve existing state value
+					return data.DatadogReceiver.Batch
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["batch"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverDatadogReceiverBatchModel{
+						MaxBytes: func() types.Int64 {
+							if v, ok := nestedBlockData["max_bytes"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						MaxEvents: func() types.Int64 {
+							if v, ok := nestedBlockData["max_events"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						TimeoutSeconds: func() types.String {
+							if v, ok := nestedBlockData["timeout_seconds"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			Compression: func() *GlobalLogReceiverDatadogReceiverCompressionModel {
+				if !isImport && data.DatadogReceiver != nil && data.DatadogReceiver.Compression != nil {
+					// Normal Read: preserve existing state value
+					return data.DatadogReceiver.Compression
+				}
+				// Import case: read from API
+				if _, ok := blockData["compression"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverDatadogReceiverCompressionModel{}
+				}
+				return nil
+			}(),
+			DatadogAPIKey: func() *GlobalLogReceiverDatadogReceiverDatadogAPIKeyModel {
+				if !isImport && data.DatadogReceiver != nil && data.DatadogReceiver.DatadogAPIKey != nil {
+					// Normal Read: preserve existing state value
+					return data.DatadogReceiver.DatadogAPIKey
+				}
+				// Import case: read from API
+				if _, ok := blockData["datadog_api_key"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverDatadogReceiverDatadogAPIKeyModel{}
+				}
+				return nil
+			}(),
+			Endpoint: func() types.String {
+				if v, ok := blockData["endpoint"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			NoTLS: func() *GlobalLogReceiverEmptyModel {
+				if !isImport && data.DatadogReceiver != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DatadogReceiver.NoTLS
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_tls"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverEmptyModel{}
+				}
+				return nil
+			}(),
+			Site: func() types.String {
+				if v, ok := blockData["site"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			UseTLS: func() *GlobalLogReceiverDatadogReceiverUseTLSModel {
+				if !isImport && data.DatadogReceiver != nil && data.DatadogReceiver.UseTLS != nil {
+					// Normal Read: preserve existing state value
+					return data.DatadogReceiver.UseTLS
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["use_tls"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverDatadogReceiverUseTLSModel{
+						TrustedCAURL: func() types.String {
+							if v, ok := nestedBlockData["trusted_ca_url"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["dns_logs"].(map[string]interface{}); ok && isImport && data.DNSLogs == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DNSLogs = &GlobalLogReceiverEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["gcp_bucket_receiver"].(map[string]interface{}); ok && (isImport || data.GCPBucketReceiver != nil) {
+		data.GCPBucketReceiver = &GlobalLogReceiverGCPBucketReceiverModel{
+			Batch: func() *GlobalLogReceiverGCPBucketReceiverBatchModel {
+				if !isImport && data.GCPBucketReceiver != nil && data.GCPBucketReceiver.Batch != nil {
+					// Normal Read: preserve existing state value
+					return data.GCPBucketReceiver.Batch
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["batch"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverGCPBucketReceiverBatchModel{
+						MaxBytes: func() types.Int64 {
+							if v, ok := nestedBlockData["max_bytes"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						MaxEvents: func() types.Int64 {
+							if v, ok := nestedBlockData["max_events"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						TimeoutSeconds: func() types.String {
+							if v, ok := nestedBlockData["timeout_seconds"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			Bucket: func() types.String {
+				if v, ok := blockData["bucket"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Compression: func() *GlobalLogReceiverGCPBucketReceiverCompressionModel {
+				if !isImport && data.GCPBucketReceiver != nil && data.GCPBucketReceiver.Compression != nil {
+					// Normal Read: preserve existing state value
+					return data.GCPBucketReceiver.Compression
+				}
+				// Import case: read from API
+				if _, ok := blockData["compression"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverGCPBucketReceiverCompressionModel{}
+				}
+				return nil
+			}(),
+			FilenameOptions: func() *GlobalLogReceiverGCPBucketReceiverFilenameOptionsModel {
+				if !isImport && data.GCPBucketReceiver != nil && data.GCPBucketReceiver.FilenameOptions != nil {
+					// Normal Read: preserve existing state value
+					return data.GCPBucketReceiver.FilenameOptions
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["filename_options"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverGCPBucketReceiverFilenameOptionsModel{
+						CustomFolder: func() types.String {
+							if v, ok := nestedBlockData["custom_folder"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			GCPCred: func() *GlobalLogReceiverGCPBucketReceiverGCPCredModel {
+				if !isImport && data.GCPBucketReceiver != nil && data.GCPBucketReceiver.GCPCred != nil {
+					// Normal Read: preserve existing state value
+					return data.GCPBucketReceiver.GCPCred
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["gcp_cred"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverGCPBucketReceiverGCPCredModel{
+						Name: func() types.String {
+							if v, ok := nestedBlockData["name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Namespace: func() types.String {
+							if v, ok := nestedBlockData["namespace"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Tenant: func() types.String {
+							if v, ok := nestedBlockData["tenant"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["http_receiver"].(map[string]interface{}); ok && (isImport || data.HTTPReceiver != nil) {
+		data.HTTPReceiver = &GlobalLogReceiverHTTPReceiverModel{
+			AuthBasic: func() *GlobalLogReceiverHTTPReceiverAuthBasicModel {
+				if !isImport && data.HTTPReceiver != nil && data.HTTPReceiver.AuthBasic != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPReceiver.AuthBasic
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["auth_basic"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverHTTPReceiverAuthBasicModel{
+						UserName: func() types.String {
+							if v, ok := nestedBlockData["user_name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			AuthNone: func() *GlobalLogReceiverEmptyModel {
+				if !isImport && data.HTTPReceiver != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPReceiver.AuthNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["auth_none"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverEmptyModel{}
+				}
+				return nil
+			}(),
+			AuthToken: func() *GlobalLogReceiverHTTPReceiverAuthTokenModel {
+				if !isImport && data.HTTPReceiver != nil && data.HTTPReceiver.AuthToken != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPReceiver.AuthToken
+				}
+				// Import case: read from API
+				if _, ok := blockData["auth_token"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverHTTPReceiverAuthTokenModel{}
+				}
+				return nil
+			}(),
+			Batch: func() *GlobalLogReceiverHTTPReceiverBatchModel {
+				if !isImport && data.HTTPReceiver != nil && data.HTTPReceiver.Batch != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPReceiver.Batch
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["batch"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverHTTPReceiverBatchModel{
+						MaxBytes: func() types.Int64 {
+							if v, ok := nestedBlockData["max_bytes"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						MaxEvents: func() types.Int64 {
+							if v, ok := nestedBlockData["max_events"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						TimeoutSeconds: func() types.String {
+							if v, ok := nestedBlockData["timeout_seconds"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			Compression: func() *GlobalLogReceiverHTTPReceiverCompressionModel {
+				if !isImport && data.HTTPReceiver != nil && data.HTTPReceiver.Compression != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPReceiver.Compression
+				}
+				// Import case: read from API
+				if _, ok := blockData["compression"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverHTTPReceiverCompressionModel{}
+				}
+				return nil
+			}(),
+			NoTLS: func() *GlobalLogReceiverEmptyModel {
+				if !isImport && data.HTTPReceiver != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPReceiver.NoTLS
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_tls"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverEmptyModel{}
+				}
+				return nil
+			}(),
+			URI: func() types.String {
+				if v, ok := blockData["uri"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			UseTLS: func() *GlobalLogReceiverHTTPReceiverUseTLSModel {
+				if !isImport && data.HTTPReceiver != nil && data.HTTPReceiver.UseTLS != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPReceiver.UseTLS
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["use_tls"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverHTTPReceiverUseTLSModel{
+						TrustedCAURL: func() types.String {
+							if v, ok := nestedBlockData["trusted_ca_url"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["kafka_receiver"].(map[string]interface{}); ok && (isImport || data.KafkaReceiver != nil) {
+		data.KafkaReceiver = &GlobalLogReceiverKafkaReceiverModel{
+			Batch: func() *GlobalLogReceiverKafkaReceiverBatchModel {
+				if !isImport && data.KafkaReceiver != nil && data.KafkaReceiver.Batch != nil {
+					// Normal Read: preserve existing state value
+					return data.KafkaReceiver.Batch
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["batch"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverKafkaReceiverBatchModel{
+						MaxBytes: func() types.Int64 {
+							if v, ok := nestedBlockData["max_bytes"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						MaxEvents: func() types.Int64 {
+							if v, ok := nestedBlockData["max_events"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						TimeoutSeconds: func() types.String {
+							if v, ok := nestedBlockData["timeout_seconds"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			BootstrapServers: func() types.List {
+				if v, ok := blockData["bootstrap_servers"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			Compression: func() *GlobalLogReceiverKafkaReceiverCompressionModel {
+				if !isImport && data.KafkaReceiver != nil && data.KafkaReceiver.Compression != nil {
+					// Normal Read: preserve existing state value
+					return data.KafkaReceiver.Compression
+				}
+				// Import case: read from API
+				if _, ok := blockData["compression"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverKafkaReceiverCompressionModel{}
+				}
+				return nil
+			}(),
+			KafkaTopic: func() types.String {
+				if v, ok := blockData["kafka_topic"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			NoTLS: func() *GlobalLogReceiverEmptyModel {
+				if !isImport && data.KafkaReceiver != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.KafkaReceiver.NoTLS
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_tls"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverEmptyModel{}
+				}
+				return nil
+			}(),
+			UseTLS: func() *GlobalLogReceiverKafkaReceiverUseTLSModel {
+				if !isImport && data.KafkaReceiver != nil && data.KafkaReceiver.UseTLS != nil {
+					// Normal Read: preserve existing state value
+					return data.KafkaReceiver.UseTLS
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["use_tls"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverKafkaReceiverUseTLSModel{
+						TrustedCAURL: func() types.String {
+							if v, ok := nestedBlockData["trusted_ca_url"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["new_relic_receiver"].(map[string]interface{}); ok && isImport && data.NewRelicReceiver == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NewRelicReceiver = &GlobalLogReceiverNewRelicReceiverModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["ns_all"].(map[string]interface{}); ok && isImport && data.NsAll == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NsAll = &GlobalLogReceiverEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["ns_current"].(map[string]interface{}); ok && isImport && data.NsCurrent == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NsCurrent = &GlobalLogReceiverEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["ns_list"].(map[string]interface{}); ok && (isImport || data.NsList != nil) {
+		data.NsList = &GlobalLogReceiverNsListModel{
+			Namespaces: func() types.List {
+				if v, ok := blockData["namespaces"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["qradar_receiver"].(map[string]interface{}); ok && (isImport || data.QradarReceiver != nil) {
+		data.QradarReceiver = &GlobalLogReceiverQradarReceiverModel{
+			Batch: func() *GlobalLogReceiverQradarReceiverBatchModel {
+				if !isImport && data.QradarReceiver != nil && data.QradarReceiver.Batch != nil {
+					// Normal Read: preserve existing state value
+					return data.QradarReceiver.Batch
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["batch"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverQradarReceiverBatchModel{
+						MaxBytes: func() types.Int64 {
+							if v, ok := nestedBlockData["max_bytes"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						MaxEvents: func() types.Int64 {
+							if v, ok := nestedBlockData["max_events"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						TimeoutSeconds: func() types.String {
+							if v, ok := nestedBlockData["timeout_seconds"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			Compression: func() *GlobalLogReceiverQradarReceiverCompressionModel {
+				if !isImport && data.QradarReceiver != nil && data.QradarReceiver.Compression != nil {
+					// Normal Read: preserve existing state value
+					return data.QradarReceiver.Compression
+				}
+				// Import case: read from API
+				if _, ok := blockData["compression"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverQradarReceiverCompressionModel{}
+				}
+				return nil
+			}(),
+			NoTLS: func() *GlobalLogReceiverEmptyModel {
+				if !isImport && data.QradarReceiver != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.QradarReceiver.NoTLS
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_tls"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverEmptyModel{}
+				}
+				return nil
+			}(),
+			URI: func() types.String {
+				if v, ok := blockData["uri"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			UseTLS: func() *GlobalLogReceiverQradarReceiverUseTLSModel {
+				if !isImport && data.QradarReceiver != nil && data.QradarReceiver.UseTLS != nil {
+					// Normal Read: preserve existing state value
+					return data.QradarReceiver.UseTLS
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["use_tls"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverQradarReceiverUseTLSModel{
+						TrustedCAURL: func() types.String {
+							if v, ok := nestedBlockData["trusted_ca_url"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["request_logs"].(map[string]interface{}); ok && isImport && data.RequestLogs == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.RequestLogs = &GlobalLogReceiverEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["s3_receiver"].(map[string]interface{}); ok && (isImport || data.S3Receiver != nil) {
+		data.S3Receiver = &GlobalLogReceiverS3ReceiverModel{
+			AWSCred: func() *GlobalLogReceiverS3ReceiverAWSCredModel {
+				if !isImport && data.S3Receiver != nil && data.S3Receiver.AWSCred != nil {
+					// Normal Read: preserve existing state value
+					return data.S3Receiver.AWSCred
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["aws_cred"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverS3ReceiverAWSCredModel{
+						Name: func() types.String {
+							if v, ok := nestedBlockData["name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Namespace: func() types.String {
+							if v, ok := nestedBlockData["namespace"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Tenant: func() types.String {
+							if v, ok := nestedBlockData["tenant"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			AWSRegion: func() types.String {
+				if v, ok := blockData["aws_region"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Batch: func() *GlobalLogReceiverS3ReceiverBatchModel {
+				if !isImport && data.S3Receiver != nil && data.S3Receiver.Batch != nil {
+					// Normal Read: preserve existing state value
+					return data.S3Receiver.Batch
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["batch"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverS3ReceiverBatchModel{
+						MaxBytes: func() types.Int64 {
+							if v, ok := nestedBlockData["max_bytes"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						MaxEvents: func() types.Int64 {
+							if v, ok := nestedBlockData["max_events"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						TimeoutSeconds: func() types.String {
+							if v, ok := nestedBlockData["timeout_seconds"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			Bucket: func() types.String {
+				if v, ok := blockData["bucket"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Compression: func() *GlobalLogReceiverS3ReceiverCompressionModel {
+				if !isImport && data.S3Receiver != nil && data.S3Receiver.Compression != nil {
+					// Normal Read: preserve existing state value
+					return data.S3Receiver.Compression
+				}
+				// Import case: read from API
+				if _, ok := blockData["compression"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverS3ReceiverCompressionModel{}
+				}
+				return nil
+			}(),
+			FilenameOptions: func() *GlobalLogReceiverS3ReceiverFilenameOptionsModel {
+				if !isImport && data.S3Receiver != nil && data.S3Receiver.FilenameOptions != nil {
+					// Normal Read: preserve existing state value
+					return data.S3Receiver.FilenameOptions
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["filename_options"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverS3ReceiverFilenameOptionsModel{
+						CustomFolder: func() types.String {
+							if v, ok := nestedBlockData["custom_folder"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["security_events"].(map[string]interface{}); ok && isImport && data.SecurityEvents == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SecurityEvents = &GlobalLogReceiverEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["splunk_receiver"].(map[string]interface{}); ok && (isImport || data.SplunkReceiver != nil) {
+		data.SplunkReceiver = &GlobalLogReceiverSplunkReceiverModel{
+			Batch: func() *GlobalLogReceiverSplunkReceiverBatchModel {
+				if !isImport && data.SplunkReceiver != nil && data.SplunkReceiver.Batch != nil {
+					// Normal Read: preserve existing state value
+					return data.SplunkReceiver.Batch
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["batch"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverSplunkReceiverBatchModel{
+						MaxBytes: func() types.Int64 {
+							if v, ok := nestedBlockData["max_bytes"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						MaxEvents: func() types.Int64 {
+							if v, ok := nestedBlockData["max_events"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						TimeoutSeconds: func() types.String {
+							if v, ok := nestedBlockData["timeout_seconds"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			Compression: func() *GlobalLogReceiverSplunkReceiverCompressionModel {
+				if !isImport && data.SplunkReceiver != nil && data.SplunkReceiver.Compression != nil {
+					// Normal Read: preserve existing state value
+					return data.SplunkReceiver.Compression
+				}
+				// Import case: read from API
+				if _, ok := blockData["compression"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverSplunkReceiverCompressionModel{}
+				}
+				return nil
+			}(),
+			Endpoint: func() types.String {
+				if v, ok := blockData["endpoint"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			NoTLS: func() *GlobalLogReceiverEmptyModel {
+				if !isImport && data.SplunkReceiver != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.SplunkReceiver.NoTLS
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_tls"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverEmptyModel{}
+				}
+				return nil
+			}(),
+			SplunkHecToken: func() *GlobalLogReceiverSplunkReceiverSplunkHecTokenModel {
+				if !isImport && data.SplunkReceiver != nil && data.SplunkReceiver.SplunkHecToken != nil {
+					// Normal Read: preserve existing state value
+					return data.SplunkReceiver.SplunkHecToken
+				}
+				// Import case: read from API
+				if _, ok := blockData["splunk_hec_token"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverSplunkReceiverSplunkHecTokenModel{}
+				}
+				return nil
+			}(),
+			UseTLS: func() *GlobalLogReceiverSplunkReceiverUseTLSModel {
+				if !isImport && data.SplunkReceiver != nil && data.SplunkReceiver.UseTLS != nil {
+					// Normal Read: preserve existing state value
+					return data.SplunkReceiver.UseTLS
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["use_tls"].(map[string]interface{}); ok {
+					return &GlobalLogReceiverSplunkReceiverUseTLSModel{
+						TrustedCAURL: func() types.String {
+							if v, ok := nestedBlockData["trusted_ca_url"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["sumo_logic_receiver"].(map[string]interface{}); ok && isImport && data.SumoLogicReceiver == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SumoLogicReceiver = &GlobalLogReceiverSumoLogicReceiverModel{}
+	}
+	// Normal Read: preserve existing state value
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewGlobalLogReceiverResource(), &data)...)
 }
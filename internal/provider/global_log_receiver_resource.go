@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &GlobalLogReceiverResource{}
-	_ resource.ResourceWithConfigure      = &GlobalLogReceiverResource{}
-	_ resource.ResourceWithImportState    = &GlobalLogReceiverResource{}
-	_ resource.ResourceWithModifyPlan     = &GlobalLogReceiverResource{}
-	_ resource.ResourceWithValidateConfig = &GlobalLogReceiverResource{}
+	_ resource.Resource                     = &GlobalLogReceiverResource{}
+	_ resource.ResourceWithConfigure        = &GlobalLogReceiverResource{}
+	_ resource.ResourceWithImportState      = &GlobalLogReceiverResource{}
+	_ resource.ResourceWithIdentity         = &GlobalLogReceiverResource{}
+	_ resource.ResourceWithMoveState        = &GlobalLogReceiverResource{}
+	_ resource.ResourceWithModifyPlan       = &GlobalLogReceiverResource{}
+	_ resource.ResourceWithValidateConfig   = &GlobalLogReceiverResource{}
+	_ resource.ResourceWithConfigValidators = &GlobalLogReceiverResource{}
+	_ listableResource                      = &GlobalLogReceiverResource{}
 )
 
 func NewGlobalLogReceiverResource() resource.Resource {
@@ -155,14 +162,16 @@ var GlobalLogReceiverAzureEventHubsReceiverConnectionStringBlindfoldSecretInfoMo
 
 // GlobalLogReceiverAzureEventHubsReceiverConnectionStringClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverAzureEventHubsReceiverConnectionStringClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverAzureEventHubsReceiverConnectionStringClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverAzureEventHubsReceiverConnectionStringClearSecretInfoModel
 var GlobalLogReceiverAzureEventHubsReceiverConnectionStringClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // GlobalLogReceiverAzureReceiverModel represents azure_receiver block
@@ -245,14 +254,16 @@ var GlobalLogReceiverAzureReceiverConnectionStringBlindfoldSecretInfoModelAttrTy
 
 // GlobalLogReceiverAzureReceiverConnectionStringClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverAzureReceiverConnectionStringClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverAzureReceiverConnectionStringClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverAzureReceiverConnectionStringClearSecretInfoModel
 var GlobalLogReceiverAzureReceiverConnectionStringClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // GlobalLogReceiverAzureReceiverFilenameOptionsModel represents filename_options block
@@ -353,14 +364,16 @@ var GlobalLogReceiverDatadogReceiverDatadogAPIKeyBlindfoldSecretInfoModelAttrTyp
 
 // GlobalLogReceiverDatadogReceiverDatadogAPIKeyClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverDatadogReceiverDatadogAPIKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverDatadogReceiverDatadogAPIKeyClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverDatadogReceiverDatadogAPIKeyClearSecretInfoModel
 var GlobalLogReceiverDatadogReceiverDatadogAPIKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // GlobalLogReceiverDatadogReceiverUseTLSModel represents use_tls block
@@ -427,14 +440,16 @@ var GlobalLogReceiverDatadogReceiverUseTLSMtlsEnableKeyURLBlindfoldSecretInfoMod
 
 // GlobalLogReceiverDatadogReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverDatadogReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverDatadogReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverDatadogReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel
 var GlobalLogReceiverDatadogReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // GlobalLogReceiverGCPBucketReceiverModel represents gcp_bucket_receiver block
@@ -581,14 +596,16 @@ var GlobalLogReceiverHTTPReceiverAuthBasicPasswordBlindfoldSecretInfoModelAttrTy
 
 // GlobalLogReceiverHTTPReceiverAuthBasicPasswordClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverHTTPReceiverAuthBasicPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverHTTPReceiverAuthBasicPasswordClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverHTTPReceiverAuthBasicPasswordClearSecretInfoModel
 var GlobalLogReceiverHTTPReceiverAuthBasicPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // GlobalLogReceiverHTTPReceiverAuthTokenModel represents auth_token block
@@ -629,14 +646,16 @@ var GlobalLogReceiverHTTPReceiverAuthTokenTokenBlindfoldSecretInfoModelAttrTypes
 
 // GlobalLogReceiverHTTPReceiverAuthTokenTokenClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverHTTPReceiverAuthTokenTokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverHTTPReceiverAuthTokenTokenClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverHTTPReceiverAuthTokenTokenClearSecretInfoModel
 var GlobalLogReceiverHTTPReceiverAuthTokenTokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // GlobalLogReceiverHTTPReceiverBatchModel represents batch block
@@ -737,14 +756,16 @@ var GlobalLogReceiverHTTPReceiverUseTLSMtlsEnableKeyURLBlindfoldSecretInfoModelA
 
 // GlobalLogReceiverHTTPReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverHTTPReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverHTTPReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverHTTPReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel
 var GlobalLogReceiverHTTPReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // GlobalLogReceiverKafkaReceiverModel represents kafka_receiver block
@@ -865,14 +886,16 @@ var GlobalLogReceiverKafkaReceiverUseTLSMtlsEnableKeyURLBlindfoldSecretInfoModel
 
 // GlobalLogReceiverKafkaReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverKafkaReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverKafkaReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverKafkaReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel
 var GlobalLogReceiverKafkaReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // GlobalLogReceiverNewRelicReceiverModel represents new_relic_receiver block
@@ -917,14 +940,16 @@ var GlobalLogReceiverNewRelicReceiverAPIKeyBlindfoldSecretInfoModelAttrTypes = m
 
 // GlobalLogReceiverNewRelicReceiverAPIKeyClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverNewRelicReceiverAPIKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverNewRelicReceiverAPIKeyClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverNewRelicReceiverAPIKeyClearSecretInfoModel
 var GlobalLogReceiverNewRelicReceiverAPIKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // GlobalLogReceiverNsListModel represents ns_list block
@@ -1053,14 +1078,16 @@ var GlobalLogReceiverQradarReceiverUseTLSMtlsEnableKeyURLBlindfoldSecretInfoMode
 
 // GlobalLogReceiverQradarReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverQradarReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverQradarReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverQradarReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel
 var GlobalLogReceiverQradarReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // GlobalLogReceiverS3ReceiverModel represents s3_receiver block
@@ -1227,14 +1254,16 @@ var GlobalLogReceiverSplunkReceiverSplunkHecTokenBlindfoldSecretInfoModelAttrTyp
 
 // GlobalLogReceiverSplunkReceiverSplunkHecTokenClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverSplunkReceiverSplunkHecTokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverSplunkReceiverSplunkHecTokenClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverSplunkReceiverSplunkHecTokenClearSecretInfoModel
 var GlobalLogReceiverSplunkReceiverSplunkHecTokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // GlobalLogReceiverSplunkReceiverUseTLSModel represents use_tls block
@@ -1301,14 +1330,16 @@ var GlobalLogReceiverSplunkReceiverUseTLSMtlsEnableKeyURLBlindfoldSecretInfoMode
 
 // GlobalLogReceiverSplunkReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverSplunkReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverSplunkReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverSplunkReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModel
 var GlobalLogReceiverSplunkReceiverUseTLSMtlsEnableKeyURLClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // GlobalLogReceiverSumoLogicReceiverModel represents sumo_logic_receiver block
@@ -1349,14 +1380,16 @@ var GlobalLogReceiverSumoLogicReceiverURLBlindfoldSecretInfoModelAttrTypes = map
 
 // GlobalLogReceiverSumoLogicReceiverURLClearSecretInfoModel represents clear_secret_info block
 type GlobalLogReceiverSumoLogicReceiverURLClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // GlobalLogReceiverSumoLogicReceiverURLClearSecretInfoModelAttrTypes defines the attribute types for GlobalLogReceiverSumoLogicReceiverURLClearSecretInfoModel
 var GlobalLogReceiverSumoLogicReceiverURLClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 type GlobalLogReceiverResourceModel struct {
@@ -1368,6 +1401,8 @@ type GlobalLogReceiverResourceModel struct {
 	Labels                 types.Map                                     `tfsdk:"labels"`
 	ID                     types.String                                  `tfsdk:"id"`
 	Timeouts               timeouts.Value                                `tfsdk:"timeouts"`
+	SystemMetadata         types.Object                                  `tfsdk:"system_metadata"`
+	LabelsAll              types.Map                                     `tfsdk:"labels_all"`
 	AuditLogs              *GlobalLogReceiverEmptyModel                  `tfsdk:"audit_logs"`
 	AWSCloudWatchReceiver  *GlobalLogReceiverAWSCloudWatchReceiverModel  `tfsdk:"aws_cloud_watch_receiver"`
 	AzureEventHubsReceiver *GlobalLogReceiverAzureEventHubsReceiverModel `tfsdk:"azure_event_hubs_receiver"`
@@ -1408,9 +1443,11 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Global Log Receiver will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Global Log Receiver will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -1442,6 +1479,8 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -1463,10 +1502,20 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 					"group_name": schema.StringAttribute{
 						MarkdownDescription: "The group name of the target Cloudwatch Logs stream .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(512),
+							stringvalidator.UTF8LengthAtLeast(3),
+							validators.PatternValidator("^[\\.\\-_/#A-Za-z0-9]+$"),
+						},
 					},
 					"stream_name": schema.StringAttribute{
 						MarkdownDescription: "The stream name of the target Cloudwatch Logs stream. Note that there can only be one writer to a log stream at a time .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(512),
+							stringvalidator.UTF8LengthAtLeast(3),
+							validators.PatternValidator("^[^:*]*$"),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1476,6 +1525,10 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1484,6 +1537,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1492,6 +1548,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -1501,10 +1560,18 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"max_bytes": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after the batch is equal to or larger than this many bytes.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(4096),
+									int64validator.AtMost(10485760),
+								},
 							},
 							"max_events": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after this many log messages are in the batch.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(32),
+									int64validator.AtMost(2000),
+								},
 							},
 							"timeout_seconds": schema.StringAttribute{
 								MarkdownDescription: "Send batch to the endpoint after this many seconds.",
@@ -1546,6 +1613,11 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 					"instance": schema.StringAttribute{
 						MarkdownDescription: "Event Hubs Instance name into which logs should be stored .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(63),
+							stringvalidator.UTF8LengthAtLeast(3),
+							validators.PatternValidator("^[A-Za-z0-9][A-Za-z0-9-]+[A-Za-z0-9]$"),
+						},
 					},
 					"namespace": schema.StringAttribute{
 						MarkdownDescription: "Event Hubs Namespace is namespace with instance into which logs should be stored .",
@@ -1554,6 +1626,11 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(63),
+							stringvalidator.UTF8LengthAtLeast(3),
+							validators.PatternValidator("^[A-Za-z0-9][A-Za-z0-9-]+[A-Za-z0-9]$"),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1586,7 +1663,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -1601,6 +1687,11 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 					"container_name": schema.StringAttribute{
 						MarkdownDescription: "Container Name is the name of the container into which logs should be stored .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(63),
+							stringvalidator.UTF8LengthAtLeast(3),
+							validators.PatternValidator("^[A-Za-z0-9][A-Za-z0-9-]+[A-Za-z0-9]$"),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1610,10 +1701,18 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"max_bytes": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after the batch is equal to or larger than this many bytes.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(4096),
+									int64validator.AtMost(10485760),
+								},
 							},
 							"max_events": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after this many log messages are in the batch.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(32),
+									int64validator.AtMost(2000),
+								},
 							},
 							"timeout_seconds": schema.StringAttribute{
 								MarkdownDescription: "Send batch to the endpoint after this many seconds.",
@@ -1676,7 +1775,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -1689,6 +1797,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"custom_folder": schema.StringAttribute{
 								MarkdownDescription: "Use your own folder name as the name of the folder in the endpoint bucket or file The folder name must match.",
 								Optional:            true,
+								Validators: []validator.String{
+									validators.PatternValidator("^[A-Za-z_][A-Za-z0-9\\-\\._]*$"),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -1721,10 +1832,18 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"max_bytes": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after the batch is equal to or larger than this many bytes.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(4096),
+									int64validator.AtMost(10485760),
+								},
 							},
 							"max_events": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after this many log messages are in the batch.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(32),
+									int64validator.AtMost(2000),
+								},
 							},
 							"timeout_seconds": schema.StringAttribute{
 								MarkdownDescription: "Send batch to the endpoint after this many seconds.",
@@ -1787,7 +1906,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -1803,6 +1931,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"trusted_ca_url": schema.StringAttribute{
 								MarkdownDescription: "The URL or value for trusted Server CA certificate or certificate chain Certificates in PEM format including the PEM headers.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -1827,6 +1958,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 									"certificate": schema.StringAttribute{
 										MarkdownDescription: "Client certificate is PEM-encoded certificate or certificate-chain.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1859,7 +1993,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -1884,6 +2027,11 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 					"bucket": schema.StringAttribute{
 						MarkdownDescription: "GCP Bucket Name. GCP Bucket Name .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(128),
+							stringvalidator.UTF8LengthAtLeast(3),
+							validators.PatternValidator("^[a-z0-9]+[a-z0-9_\\.-]+[a-z0-9]$"),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1893,10 +2041,18 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"max_bytes": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after the batch is equal to or larger than this many bytes.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(4096),
+									int64validator.AtMost(10485760),
+								},
 							},
 							"max_events": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after this many log messages are in the batch.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(32),
+									int64validator.AtMost(2000),
+								},
 							},
 							"timeout_seconds": schema.StringAttribute{
 								MarkdownDescription: "Send batch to the endpoint after this many seconds.",
@@ -1936,6 +2092,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"custom_folder": schema.StringAttribute{
 								MarkdownDescription: "Use your own folder name as the name of the folder in the endpoint bucket or file The folder name must match.",
 								Optional:            true,
+								Validators: []validator.String{
+									validators.PatternValidator("^[A-Za-z_][A-Za-z0-9\\-\\._]*$"),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -1953,6 +2112,10 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1961,6 +2124,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1969,6 +2135,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -1989,6 +2158,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"user_name": schema.StringAttribute{
 								MarkdownDescription: "User Name. HTTP Basic Auth User Name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(64),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -2021,7 +2193,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -2066,7 +2247,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -2081,10 +2271,18 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"max_bytes": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after the batch is equal to or larger than this many bytes.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(4096),
+									int64validator.AtMost(10485760),
+								},
 							},
 							"max_events": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after this many log messages are in the batch.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(32),
+									int64validator.AtMost(2000),
+								},
 							},
 							"timeout_seconds": schema.StringAttribute{
 								MarkdownDescription: "Send batch to the endpoint after this many seconds.",
@@ -2127,6 +2325,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"trusted_ca_url": schema.StringAttribute{
 								MarkdownDescription: "The URL or value for trusted Server CA certificate or certificate chain Certificates in PEM format including the PEM headers.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -2151,6 +2352,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 									"certificate": schema.StringAttribute{
 										MarkdownDescription: "Client certificate is PEM-encoded certificate or certificate-chain.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -2183,7 +2387,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -2206,10 +2419,21 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "List of host:port pairs of the Kafka brokers .",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(8),
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256)),
+						},
 					},
 					"kafka_topic": schema.StringAttribute{
 						MarkdownDescription: "The Kafka topic name to write events to .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(255),
+							stringvalidator.UTF8LengthAtLeast(3),
+							validators.PatternValidator("^[a-zA-Z0-9\\._\\-]+$"),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -2219,10 +2443,18 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"max_bytes": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after the batch is equal to or larger than this many bytes.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(4096),
+									int64validator.AtMost(10485760),
+								},
 							},
 							"max_events": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after this many log messages are in the batch.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(32),
+									int64validator.AtMost(2000),
+								},
 							},
 							"timeout_seconds": schema.StringAttribute{
 								MarkdownDescription: "Send batch to the endpoint after this many seconds.",
@@ -2265,6 +2497,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"trusted_ca_url": schema.StringAttribute{
 								MarkdownDescription: "The URL or value for trusted Server CA certificate or certificate chain Certificates in PEM format including the PEM headers.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -2289,6 +2524,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 									"certificate": schema.StringAttribute{
 										MarkdownDescription: "Client certificate is PEM-encoded certificate or certificate-chain.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -2321,7 +2559,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -2370,7 +2617,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -2398,6 +2654,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "List of namespaces to stream logs for .",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+						},
 					},
 				},
 			},
@@ -2416,10 +2675,18 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"max_bytes": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after the batch is equal to or larger than this many bytes.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(4096),
+									int64validator.AtMost(10485760),
+								},
 							},
 							"max_events": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after this many log messages are in the batch.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(32),
+									int64validator.AtMost(2000),
+								},
 							},
 							"timeout_seconds": schema.StringAttribute{
 								MarkdownDescription: "Send batch to the endpoint after this many seconds.",
@@ -2462,6 +2729,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"trusted_ca_url": schema.StringAttribute{
 								MarkdownDescription: "The URL or value for trusted Server CA certificate or certificate chain Certificates in PEM format including the PEM headers.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -2486,6 +2756,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 									"certificate": schema.StringAttribute{
 										MarkdownDescription: "Client certificate is PEM-encoded certificate or certificate-chain.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -2518,7 +2791,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -2547,6 +2829,11 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 					"bucket": schema.StringAttribute{
 						MarkdownDescription: "S3 Bucket Name. S3 Bucket Name .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(128),
+							stringvalidator.UTF8LengthAtLeast(3),
+							validators.PatternValidator("^[a-z0-9]+[a-z0-9\\.-]+[a-z0-9]$"),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -2556,6 +2843,10 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2564,6 +2855,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2572,6 +2866,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -2581,10 +2878,18 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"max_bytes": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after the batch is equal to or larger than this many bytes.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(4096),
+									int64validator.AtMost(10485760),
+								},
 							},
 							"max_events": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after this many log messages are in the batch.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(32),
+									int64validator.AtMost(2000),
+								},
 							},
 							"timeout_seconds": schema.StringAttribute{
 								MarkdownDescription: "Send batch to the endpoint after this many seconds.",
@@ -2624,6 +2929,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"custom_folder": schema.StringAttribute{
 								MarkdownDescription: "Use your own folder name as the name of the folder in the endpoint bucket or file The folder name must match.",
 								Optional:            true,
+								Validators: []validator.String{
+									validators.PatternValidator("^[A-Za-z_][A-Za-z0-9\\-\\._]*$"),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -2655,10 +2963,18 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"max_bytes": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after the batch is equal to or larger than this many bytes.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(4096),
+									int64validator.AtMost(10485760),
+								},
 							},
 							"max_events": schema.Int64Attribute{
 								MarkdownDescription: "Send batch to endpoint after this many log messages are in the batch.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(32),
+									int64validator.AtMost(2000),
+								},
 							},
 							"timeout_seconds": schema.StringAttribute{
 								MarkdownDescription: "Send batch to the endpoint after this many seconds.",
@@ -2724,7 +3040,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -2737,6 +3062,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 							"trusted_ca_url": schema.StringAttribute{
 								MarkdownDescription: "The URL or value for trusted Server CA certificate or certificate chain Certificates in PEM format including the PEM headers.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -2761,6 +3089,9 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 									"certificate": schema.StringAttribute{
 										MarkdownDescription: "Client certificate is PEM-encoded certificate or certificate-chain.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -2793,7 +3124,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -2842,7 +3182,16 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -2855,6 +3204,11 @@ func (r *GlobalLogReceiverResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *GlobalLogReceiverResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *GlobalLogReceiverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -2879,6 +3233,96 @@ func (r *GlobalLogReceiverResource) ValidateConfig(ctx context.Context, req reso
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *GlobalLogReceiverResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "filter_choice", "ns_all", "ns_current", "ns_list"),
+		validators.OneOfGroup(path.MatchRelative(), "log_type", "audit_logs", "dns_logs", "request_logs", "security_events"),
+		validators.OneOfGroup(path.MatchRelative(), "receiver", "aws_cloud_watch_receiver", "azure_event_hubs_receiver", "azure_receiver", "datadog_receiver", "gcp_bucket_receiver", "http_receiver", "kafka_receiver", "new_relic_receiver", "qradar_receiver", "s3_receiver", "splunk_receiver", "sumo_logic_receiver"),
+		validators.RequiredOneOfGroup(path.MatchRoot("aws_cloud_watch_receiver").AtName("batch"), "batch_bytes", "max_bytes", "max_bytes_disabled"),
+		validators.RequiredOneOfGroup(path.MatchRoot("aws_cloud_watch_receiver").AtName("batch"), "batch_events", "max_events", "max_events_disabled"),
+		validators.OneOfGroup(path.MatchRoot("aws_cloud_watch_receiver").AtName("batch"), "batch_timeout", "timeout_seconds", "timeout_seconds_default"),
+		validators.OneOfGroup(path.MatchRoot("aws_cloud_watch_receiver").AtName("compression"), "compression_choice", "compression_default", "compression_gzip", "compression_none"),
+		validators.OneOfGroup(path.MatchRoot("azure_event_hubs_receiver").AtName("connection_string"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.RequiredOneOfGroup(path.MatchRoot("azure_receiver").AtName("batch"), "batch_bytes", "max_bytes", "max_bytes_disabled"),
+		validators.RequiredOneOfGroup(path.MatchRoot("azure_receiver").AtName("batch"), "batch_events", "max_events", "max_events_disabled"),
+		validators.OneOfGroup(path.MatchRoot("azure_receiver").AtName("batch"), "batch_timeout", "timeout_seconds", "timeout_seconds_default"),
+		validators.OneOfGroup(path.MatchRoot("azure_receiver").AtName("compression"), "compression_choice", "compression_default", "compression_gzip", "compression_none"),
+		validators.OneOfGroup(path.MatchRoot("azure_receiver").AtName("connection_string"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("azure_receiver").AtName("filename_options"), "folder", "custom_folder", "log_type_folder", "no_folder"),
+		validators.OneOfGroup(path.MatchRoot("datadog_receiver"), "endpoint_choice", "endpoint", "site"),
+		validators.OneOfGroup(path.MatchRoot("datadog_receiver"), "tls_choice", "no_tls", "use_tls"),
+		validators.RequiredOneOfGroup(path.MatchRoot("datadog_receiver").AtName("batch"), "batch_bytes", "max_bytes", "max_bytes_disabled"),
+		validators.RequiredOneOfGroup(path.MatchRoot("datadog_receiver").AtName("batch"), "batch_events", "max_events", "max_events_disabled"),
+		validators.OneOfGroup(path.MatchRoot("datadog_receiver").AtName("batch"), "batch_timeout", "timeout_seconds", "timeout_seconds_default"),
+		validators.OneOfGroup(path.MatchRoot("datadog_receiver").AtName("compression"), "compression_choice", "compression_default", "compression_gzip", "compression_none"),
+		validators.OneOfGroup(path.MatchRoot("datadog_receiver").AtName("datadog_api_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("datadog_receiver").AtName("use_tls"), "ca_choice", "no_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("datadog_receiver").AtName("use_tls"), "mtls_choice", "mtls_disabled", "mtls_enable"),
+		validators.OneOfGroup(path.MatchRoot("datadog_receiver").AtName("use_tls"), "verify_certificate", "disable_verify_certificate", "enable_verify_certificate"),
+		validators.OneOfGroup(path.MatchRoot("datadog_receiver").AtName("use_tls"), "verify_hostname", "disable_verify_hostname", "enable_verify_hostname"),
+		validators.OneOfGroup(path.MatchRoot("datadog_receiver").AtName("use_tls").AtName("mtls_enable").AtName("key_url"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.RequiredOneOfGroup(path.MatchRoot("gcp_bucket_receiver").AtName("batch"), "batch_bytes", "max_bytes", "max_bytes_disabled"),
+		validators.RequiredOneOfGroup(path.MatchRoot("gcp_bucket_receiver").AtName("batch"), "batch_events", "max_events", "max_events_disabled"),
+		validators.OneOfGroup(path.MatchRoot("gcp_bucket_receiver").AtName("batch"), "batch_timeout", "timeout_seconds", "timeout_seconds_default"),
+		validators.OneOfGroup(path.MatchRoot("gcp_bucket_receiver").AtName("compression"), "compression_choice", "compression_default", "compression_gzip", "compression_none"),
+		validators.OneOfGroup(path.MatchRoot("gcp_bucket_receiver").AtName("filename_options"), "folder", "custom_folder", "log_type_folder", "no_folder"),
+		validators.OneOfGroup(path.MatchRoot("http_receiver"), "auth_choice", "auth_basic", "auth_none", "auth_token"),
+		validators.OneOfGroup(path.MatchRoot("http_receiver"), "tls_choice", "no_tls", "use_tls"),
+		validators.OneOfGroup(path.MatchRoot("http_receiver").AtName("auth_basic").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("http_receiver").AtName("auth_token").AtName("token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.RequiredOneOfGroup(path.MatchRoot("http_receiver").AtName("batch"), "batch_bytes", "max_bytes", "max_bytes_disabled"),
+		validators.RequiredOneOfGroup(path.MatchRoot("http_receiver").AtName("batch"), "batch_events", "max_events", "max_events_disabled"),
+		validators.OneOfGroup(path.MatchRoot("http_receiver").AtName("batch"), "batch_timeout", "timeout_seconds", "timeout_seconds_default"),
+		validators.OneOfGroup(path.MatchRoot("http_receiver").AtName("compression"), "compression_choice", "compression_default", "compression_gzip", "compression_none"),
+		validators.OneOfGroup(path.MatchRoot("http_receiver").AtName("use_tls"), "ca_choice", "no_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("http_receiver").AtName("use_tls"), "mtls_choice", "mtls_disabled", "mtls_enable"),
+		validators.OneOfGroup(path.MatchRoot("http_receiver").AtName("use_tls"), "verify_certificate", "disable_verify_certificate", "enable_verify_certificate"),
+		validators.OneOfGroup(path.MatchRoot("http_receiver").AtName("use_tls"), "verify_hostname", "disable_verify_hostname", "enable_verify_hostname"),
+		validators.OneOfGroup(path.MatchRoot("http_receiver").AtName("use_tls").AtName("mtls_enable").AtName("key_url"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("kafka_receiver"), "tls_choice", "no_tls", "use_tls"),
+		validators.RequiredOneOfGroup(path.MatchRoot("kafka_receiver").AtName("batch"), "batch_bytes", "max_bytes", "max_bytes_disabled"),
+		validators.RequiredOneOfGroup(path.MatchRoot("kafka_receiver").AtName("batch"), "batch_events", "max_events", "max_events_disabled"),
+		validators.OneOfGroup(path.MatchRoot("kafka_receiver").AtName("batch"), "batch_timeout", "timeout_seconds", "timeout_seconds_default"),
+		validators.OneOfGroup(path.MatchRoot("kafka_receiver").AtName("compression"), "compression_choice", "compression_default", "compression_gzip", "compression_none"),
+		validators.OneOfGroup(path.MatchRoot("kafka_receiver").AtName("use_tls"), "ca_choice", "no_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("kafka_receiver").AtName("use_tls"), "mtls_choice", "mtls_disabled", "mtls_enable"),
+		validators.OneOfGroup(path.MatchRoot("kafka_receiver").AtName("use_tls"), "verify_certificate", "disable_verify_certificate", "enable_verify_certificate"),
+		validators.OneOfGroup(path.MatchRoot("kafka_receiver").AtName("use_tls"), "verify_hostname", "disable_verify_hostname", "enable_verify_hostname"),
+		validators.OneOfGroup(path.MatchRoot("kafka_receiver").AtName("use_tls").AtName("mtls_enable").AtName("key_url"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("new_relic_receiver"), "endpoint_choice", "eu", "us"),
+		validators.OneOfGroup(path.MatchRoot("new_relic_receiver").AtName("api_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("qradar_receiver"), "tls_choice", "no_tls", "use_tls"),
+		validators.RequiredOneOfGroup(path.MatchRoot("qradar_receiver").AtName("batch"), "batch_bytes", "max_bytes", "max_bytes_disabled"),
+		validators.RequiredOneOfGroup(path.MatchRoot("qradar_receiver").AtName("batch"), "batch_events", "max_events", "max_events_disabled"),
+		validators.OneOfGroup(path.MatchRoot("qradar_receiver").AtName("batch"), "batch_timeout", "timeout_seconds", "timeout_seconds_default"),
+		validators.OneOfGroup(path.MatchRoot("qradar_receiver").AtName("compression"), "compression_choice", "compression_default", "compression_gzip", "compression_none"),
+		validators.OneOfGroup(path.MatchRoot("qradar_receiver").AtName("use_tls"), "ca_choice", "no_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("qradar_receiver").AtName("use_tls"), "mtls_choice", "mtls_disabled", "mtls_enable"),
+		validators.OneOfGroup(path.MatchRoot("qradar_receiver").AtName("use_tls"), "verify_certificate", "disable_verify_certificate", "enable_verify_certificate"),
+		validators.OneOfGroup(path.MatchRoot("qradar_receiver").AtName("use_tls"), "verify_hostname", "disable_verify_hostname", "enable_verify_hostname"),
+		validators.OneOfGroup(path.MatchRoot("qradar_receiver").AtName("use_tls").AtName("mtls_enable").AtName("key_url"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.RequiredOneOfGroup(path.MatchRoot("s3_receiver").AtName("batch"), "batch_bytes", "max_bytes", "max_bytes_disabled"),
+		validators.RequiredOneOfGroup(path.MatchRoot("s3_receiver").AtName("batch"), "batch_events", "max_events", "max_events_disabled"),
+		validators.OneOfGroup(path.MatchRoot("s3_receiver").AtName("batch"), "batch_timeout", "timeout_seconds", "timeout_seconds_default"),
+		validators.OneOfGroup(path.MatchRoot("s3_receiver").AtName("compression"), "compression_choice", "compression_default", "compression_gzip", "compression_none"),
+		validators.OneOfGroup(path.MatchRoot("s3_receiver").AtName("filename_options"), "folder", "custom_folder", "log_type_folder", "no_folder"),
+		validators.OneOfGroup(path.MatchRoot("splunk_receiver"), "tls_choice", "no_tls", "use_tls"),
+		validators.RequiredOneOfGroup(path.MatchRoot("splunk_receiver").AtName("batch"), "batch_bytes", "max_bytes", "max_bytes_disabled"),
+		validators.RequiredOneOfGroup(path.MatchRoot("splunk_receiver").AtName("batch"), "batch_events", "max_events", "max_events_disabled"),
+		validators.OneOfGroup(path.MatchRoot("splunk_receiver").AtName("batch"), "batch_timeout", "timeout_seconds", "timeout_seconds_default"),
+		validators.OneOfGroup(path.MatchRoot("splunk_receiver").AtName("compression"), "compression_choice", "compression_default", "compression_gzip", "compression_none"),
+		validators.OneOfGroup(path.MatchRoot("splunk_receiver").AtName("splunk_hec_token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("splunk_receiver").AtName("use_tls"), "ca_choice", "no_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("splunk_receiver").AtName("use_tls"), "mtls_choice", "mtls_disabled", "mtls_enable"),
+		validators.OneOfGroup(path.MatchRoot("splunk_receiver").AtName("use_tls"), "verify_certificate", "disable_verify_certificate", "enable_verify_certificate"),
+		validators.OneOfGroup(path.MatchRoot("splunk_receiver").AtName("use_tls"), "verify_hostname", "disable_verify_hostname", "enable_verify_hostname"),
+		validators.OneOfGroup(path.MatchRoot("splunk_receiver").AtName("use_tls").AtName("mtls_enable").AtName("key_url"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("sumo_logic_receiver").AtName("url"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *GlobalLogReceiverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -2889,7 +3333,10 @@ func (r *GlobalLogReceiverResource) ModifyPlan(ctx context.Context, req resource
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "global_log_receiver", "system", req.Config, &resp.Plan)...)
 		var plan GlobalLogReceiverResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -2907,7 +3354,8 @@ func (r *GlobalLogReceiverResource) ModifyPlan(ctx context.Context, req resource
 
 func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data GlobalLogReceiverResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2946,6 +3394,7 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -2955,6 +3404,7 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AuditLogs != nil {
@@ -2984,16 +3434,34 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 			if !data.AWSCloudWatchReceiver.Batch.MaxBytes.IsNull() && !data.AWSCloudWatchReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.AWSCloudWatchReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.AWSCloudWatchReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.AWSCloudWatchReceiver.Batch.MaxEvents.IsNull() && !data.AWSCloudWatchReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.AWSCloudWatchReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.AWSCloudWatchReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.AWSCloudWatchReceiver.Batch.TimeoutSeconds.IsNull() && !data.AWSCloudWatchReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.AWSCloudWatchReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.AWSCloudWatchReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			aws_cloud_watch_receiverMap["batch"] = batchNestedMap
 		}
 		if data.AWSCloudWatchReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.AWSCloudWatchReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.AWSCloudWatchReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.AWSCloudWatchReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			aws_cloud_watch_receiverMap["compression"] = compressionNestedMap
 		}
 		if !data.AWSCloudWatchReceiver.GroupName.IsNull() && !data.AWSCloudWatchReceiver.GroupName.IsUnknown() {
@@ -3008,6 +3476,29 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		azure_event_hubs_receiverMap := make(map[string]interface{})
 		if data.AzureEventHubsReceiver.ConnectionString != nil {
 			connection_stringNestedMap := make(map[string]interface{})
+			if data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.Location.IsNull() && !data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				connection_stringNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo.Provider.IsNull() && !data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo.URL.IsNull() && !data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo.URL.ValueString()
+				}
+				connection_stringNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			azure_event_hubs_receiverMap["connection_string"] = connection_stringNestedMap
 		}
 		if !data.AzureEventHubsReceiver.Instance.IsNull() && !data.AzureEventHubsReceiver.Instance.IsUnknown() {
@@ -3025,20 +3516,61 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 			if !data.AzureReceiver.Batch.MaxBytes.IsNull() && !data.AzureReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.AzureReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.AzureReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.AzureReceiver.Batch.MaxEvents.IsNull() && !data.AzureReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.AzureReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.AzureReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.AzureReceiver.Batch.TimeoutSeconds.IsNull() && !data.AzureReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.AzureReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.AzureReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			azure_receiverMap["batch"] = batchNestedMap
 		}
 		if data.AzureReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.AzureReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.AzureReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.AzureReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			azure_receiverMap["compression"] = compressionNestedMap
 		}
 		if data.AzureReceiver.ConnectionString != nil {
 			connection_stringNestedMap := make(map[string]interface{})
+			if data.AzureReceiver.ConnectionString.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.Location.IsNull() && !data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				connection_stringNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.AzureReceiver.ConnectionString.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzureReceiver.ConnectionString.ClearSecretInfo.Provider.IsNull() && !data.AzureReceiver.ConnectionString.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.AzureReceiver.ConnectionString.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.AzureReceiver.ConnectionString.ClearSecretInfo.URL.IsNull() && !data.AzureReceiver.ConnectionString.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.AzureReceiver.ConnectionString.ClearSecretInfo.URL.ValueString()
+				}
+				connection_stringNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			azure_receiverMap["connection_string"] = connection_stringNestedMap
 		}
 		if !data.AzureReceiver.ContainerName.IsNull() && !data.AzureReceiver.ContainerName.IsUnknown() {
@@ -3049,6 +3581,12 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 			if !data.AzureReceiver.FilenameOptions.CustomFolder.IsNull() && !data.AzureReceiver.FilenameOptions.CustomFolder.IsUnknown() {
 				filename_optionsNestedMap["custom_folder"] = data.AzureReceiver.FilenameOptions.CustomFolder.ValueString()
 			}
+			if data.AzureReceiver.FilenameOptions.LogTypeFolder != nil {
+				filename_optionsNestedMap["log_type_folder"] = map[string]interface{}{}
+			}
+			if data.AzureReceiver.FilenameOptions.NoFolder != nil {
+				filename_optionsNestedMap["no_folder"] = map[string]interface{}{}
+			}
 			azure_receiverMap["filename_options"] = filename_optionsNestedMap
 		}
 		createReq.Spec["azure_receiver"] = azure_receiverMap
@@ -3060,20 +3598,61 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 			if !data.DatadogReceiver.Batch.MaxBytes.IsNull() && !data.DatadogReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.DatadogReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.DatadogReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.DatadogReceiver.Batch.MaxEvents.IsNull() && !data.DatadogReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.DatadogReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.DatadogReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.DatadogReceiver.Batch.TimeoutSeconds.IsNull() && !data.DatadogReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.DatadogReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.DatadogReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			datadog_receiverMap["batch"] = batchNestedMap
 		}
 		if data.DatadogReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.DatadogReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			datadog_receiverMap["compression"] = compressionNestedMap
 		}
 		if data.DatadogReceiver.DatadogAPIKey != nil {
 			datadog_api_keyNestedMap := make(map[string]interface{})
+			if data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.Location.IsNull() && !data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				datadog_api_keyNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo.Provider.IsNull() && !data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo.URL.IsNull() && !data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo.URL.ValueString()
+				}
+				datadog_api_keyNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			datadog_receiverMap["datadog_api_key"] = datadog_api_keyNestedMap
 		}
 		if !data.DatadogReceiver.Endpoint.IsNull() && !data.DatadogReceiver.Endpoint.IsUnknown() {
@@ -3087,6 +3666,58 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		}
 		if data.DatadogReceiver.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.DatadogReceiver.UseTLS.DisableVerifyCertificate != nil {
+				use_tlsNestedMap["disable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.UseTLS.DisableVerifyHostname != nil {
+				use_tlsNestedMap["disable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.UseTLS.EnableVerifyCertificate != nil {
+				use_tlsNestedMap["enable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.UseTLS.EnableVerifyHostname != nil {
+				use_tlsNestedMap["enable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.UseTLS.MtlsDisabled != nil {
+				use_tlsNestedMap["mtls_disabled"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.UseTLS.MtlsEnable != nil {
+				mtls_enableLevel3Map := make(map[string]interface{})
+				if !data.DatadogReceiver.UseTLS.MtlsEnable.Certificate.IsNull() && !data.DatadogReceiver.UseTLS.MtlsEnable.Certificate.IsUnknown() {
+					mtls_enableLevel3Map["certificate"] = data.DatadogReceiver.UseTLS.MtlsEnable.Certificate.ValueString()
+				}
+				if data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL != nil {
+					key_urlLevel4Map := make(map[string]interface{})
+					if data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsNull() && !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						key_urlLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsNull() && !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsNull() && !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.ValueString()
+						}
+						key_urlLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					mtls_enableLevel3Map["key_url"] = key_urlLevel4Map
+				}
+				use_tlsNestedMap["mtls_enable"] = mtls_enableLevel3Map
+			}
+			if data.DatadogReceiver.UseTLS.NoCA != nil {
+				use_tlsNestedMap["no_ca"] = map[string]interface{}{}
+			}
 			if !data.DatadogReceiver.UseTLS.TrustedCAURL.IsNull() && !data.DatadogReceiver.UseTLS.TrustedCAURL.IsUnknown() {
 				use_tlsNestedMap["trusted_ca_url"] = data.DatadogReceiver.UseTLS.TrustedCAURL.ValueString()
 			}
@@ -3105,12 +3736,21 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 			if !data.GCPBucketReceiver.Batch.MaxBytes.IsNull() && !data.GCPBucketReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.GCPBucketReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.GCPBucketReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.GCPBucketReceiver.Batch.MaxEvents.IsNull() && !data.GCPBucketReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.GCPBucketReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.GCPBucketReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.GCPBucketReceiver.Batch.TimeoutSeconds.IsNull() && !data.GCPBucketReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.GCPBucketReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.GCPBucketReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			gcp_bucket_receiverMap["batch"] = batchNestedMap
 		}
 		if !data.GCPBucketReceiver.Bucket.IsNull() && !data.GCPBucketReceiver.Bucket.IsUnknown() {
@@ -3118,6 +3758,15 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		}
 		if data.GCPBucketReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.GCPBucketReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.GCPBucketReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.GCPBucketReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			gcp_bucket_receiverMap["compression"] = compressionNestedMap
 		}
 		if data.GCPBucketReceiver.FilenameOptions != nil {
@@ -3125,6 +3774,12 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 			if !data.GCPBucketReceiver.FilenameOptions.CustomFolder.IsNull() && !data.GCPBucketReceiver.FilenameOptions.CustomFolder.IsUnknown() {
 				filename_optionsNestedMap["custom_folder"] = data.GCPBucketReceiver.FilenameOptions.CustomFolder.ValueString()
 			}
+			if data.GCPBucketReceiver.FilenameOptions.LogTypeFolder != nil {
+				filename_optionsNestedMap["log_type_folder"] = map[string]interface{}{}
+			}
+			if data.GCPBucketReceiver.FilenameOptions.NoFolder != nil {
+				filename_optionsNestedMap["no_folder"] = map[string]interface{}{}
+			}
 			gcp_bucket_receiverMap["filename_options"] = filename_optionsNestedMap
 		}
 		if data.GCPBucketReceiver.GCPCred != nil {
@@ -3146,6 +3801,33 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		http_receiverMap := make(map[string]interface{})
 		if data.HTTPReceiver.AuthBasic != nil {
 			auth_basicNestedMap := make(map[string]interface{})
+			if data.HTTPReceiver.AuthBasic.Password != nil {
+				passwordLevel3Map := make(map[string]interface{})
+				if data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.Location.IsNull() && !data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					passwordLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo.Provider.IsNull() && !data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo.URL.IsNull() && !data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo.URL.ValueString()
+					}
+					passwordLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				auth_basicNestedMap["password"] = passwordLevel3Map
+			}
 			if !data.HTTPReceiver.AuthBasic.UserName.IsNull() && !data.HTTPReceiver.AuthBasic.UserName.IsUnknown() {
 				auth_basicNestedMap["user_name"] = data.HTTPReceiver.AuthBasic.UserName.ValueString()
 			}
@@ -3156,6 +3838,33 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		}
 		if data.HTTPReceiver.AuthToken != nil {
 			auth_tokenNestedMap := make(map[string]interface{})
+			if data.HTTPReceiver.AuthToken.Token != nil {
+				tokenLevel3Map := make(map[string]interface{})
+				if data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.Location.IsNull() && !data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.HTTPReceiver.AuthToken.Token.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.HTTPReceiver.AuthToken.Token.ClearSecretInfo.Provider.IsNull() && !data.HTTPReceiver.AuthToken.Token.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.HTTPReceiver.AuthToken.Token.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.HTTPReceiver.AuthToken.Token.ClearSecretInfo.URL.IsNull() && !data.HTTPReceiver.AuthToken.Token.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.HTTPReceiver.AuthToken.Token.ClearSecretInfo.URL.ValueString()
+					}
+					tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				auth_tokenNestedMap["token"] = tokenLevel3Map
+			}
 			http_receiverMap["auth_token"] = auth_tokenNestedMap
 		}
 		if data.HTTPReceiver.Batch != nil {
@@ -3163,16 +3872,34 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 			if !data.HTTPReceiver.Batch.MaxBytes.IsNull() && !data.HTTPReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.HTTPReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.HTTPReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.HTTPReceiver.Batch.MaxEvents.IsNull() && !data.HTTPReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.HTTPReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.HTTPReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.HTTPReceiver.Batch.TimeoutSeconds.IsNull() && !data.HTTPReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.HTTPReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.HTTPReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			http_receiverMap["batch"] = batchNestedMap
 		}
 		if data.HTTPReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.HTTPReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			http_receiverMap["compression"] = compressionNestedMap
 		}
 		if data.HTTPReceiver.NoTLS != nil {
@@ -3183,6 +3910,58 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		}
 		if data.HTTPReceiver.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.HTTPReceiver.UseTLS.DisableVerifyCertificate != nil {
+				use_tlsNestedMap["disable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.UseTLS.DisableVerifyHostname != nil {
+				use_tlsNestedMap["disable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.UseTLS.EnableVerifyCertificate != nil {
+				use_tlsNestedMap["enable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.UseTLS.EnableVerifyHostname != nil {
+				use_tlsNestedMap["enable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.UseTLS.MtlsDisabled != nil {
+				use_tlsNestedMap["mtls_disabled"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.UseTLS.MtlsEnable != nil {
+				mtls_enableLevel3Map := make(map[string]interface{})
+				if !data.HTTPReceiver.UseTLS.MtlsEnable.Certificate.IsNull() && !data.HTTPReceiver.UseTLS.MtlsEnable.Certificate.IsUnknown() {
+					mtls_enableLevel3Map["certificate"] = data.HTTPReceiver.UseTLS.MtlsEnable.Certificate.ValueString()
+				}
+				if data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL != nil {
+					key_urlLevel4Map := make(map[string]interface{})
+					if data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsNull() && !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						key_urlLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsNull() && !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsNull() && !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.ValueString()
+						}
+						key_urlLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					mtls_enableLevel3Map["key_url"] = key_urlLevel4Map
+				}
+				use_tlsNestedMap["mtls_enable"] = mtls_enableLevel3Map
+			}
+			if data.HTTPReceiver.UseTLS.NoCA != nil {
+				use_tlsNestedMap["no_ca"] = map[string]interface{}{}
+			}
 			if !data.HTTPReceiver.UseTLS.TrustedCAURL.IsNull() && !data.HTTPReceiver.UseTLS.TrustedCAURL.IsUnknown() {
 				use_tlsNestedMap["trusted_ca_url"] = data.HTTPReceiver.UseTLS.TrustedCAURL.ValueString()
 			}
@@ -3197,12 +3976,21 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 			if !data.KafkaReceiver.Batch.MaxBytes.IsNull() && !data.KafkaReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.KafkaReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.KafkaReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.KafkaReceiver.Batch.MaxEvents.IsNull() && !data.KafkaReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.KafkaReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.KafkaReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.KafkaReceiver.Batch.TimeoutSeconds.IsNull() && !data.KafkaReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.KafkaReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.KafkaReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			kafka_receiverMap["batch"] = batchNestedMap
 		}
 		if !data.KafkaReceiver.BootstrapServers.IsNull() && !data.KafkaReceiver.BootstrapServers.IsUnknown() {
@@ -3214,6 +4002,15 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		}
 		if data.KafkaReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.KafkaReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			kafka_receiverMap["compression"] = compressionNestedMap
 		}
 		if !data.KafkaReceiver.KafkaTopic.IsNull() && !data.KafkaReceiver.KafkaTopic.IsUnknown() {
@@ -3224,6 +4021,58 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		}
 		if data.KafkaReceiver.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.KafkaReceiver.UseTLS.DisableVerifyCertificate != nil {
+				use_tlsNestedMap["disable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.UseTLS.DisableVerifyHostname != nil {
+				use_tlsNestedMap["disable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.UseTLS.EnableVerifyCertificate != nil {
+				use_tlsNestedMap["enable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.UseTLS.EnableVerifyHostname != nil {
+				use_tlsNestedMap["enable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.UseTLS.MtlsDisabled != nil {
+				use_tlsNestedMap["mtls_disabled"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.UseTLS.MtlsEnable != nil {
+				mtls_enableLevel3Map := make(map[string]interface{})
+				if !data.KafkaReceiver.UseTLS.MtlsEnable.Certificate.IsNull() && !data.KafkaReceiver.UseTLS.MtlsEnable.Certificate.IsUnknown() {
+					mtls_enableLevel3Map["certificate"] = data.KafkaReceiver.UseTLS.MtlsEnable.Certificate.ValueString()
+				}
+				if data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL != nil {
+					key_urlLevel4Map := make(map[string]interface{})
+					if data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsNull() && !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						key_urlLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsNull() && !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsNull() && !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.ValueString()
+						}
+						key_urlLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					mtls_enableLevel3Map["key_url"] = key_urlLevel4Map
+				}
+				use_tlsNestedMap["mtls_enable"] = mtls_enableLevel3Map
+			}
+			if data.KafkaReceiver.UseTLS.NoCA != nil {
+				use_tlsNestedMap["no_ca"] = map[string]interface{}{}
+			}
 			if !data.KafkaReceiver.UseTLS.TrustedCAURL.IsNull() && !data.KafkaReceiver.UseTLS.TrustedCAURL.IsUnknown() {
 				use_tlsNestedMap["trusted_ca_url"] = data.KafkaReceiver.UseTLS.TrustedCAURL.ValueString()
 			}
@@ -3235,6 +4084,29 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		new_relic_receiverMap := make(map[string]interface{})
 		if data.NewRelicReceiver.APIKey != nil {
 			api_keyNestedMap := make(map[string]interface{})
+			if data.NewRelicReceiver.APIKey.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.Location.IsNull() && !data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				api_keyNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.NewRelicReceiver.APIKey.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.NewRelicReceiver.APIKey.ClearSecretInfo.Provider.IsNull() && !data.NewRelicReceiver.APIKey.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.NewRelicReceiver.APIKey.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.NewRelicReceiver.APIKey.ClearSecretInfo.URL.IsNull() && !data.NewRelicReceiver.APIKey.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.NewRelicReceiver.APIKey.ClearSecretInfo.URL.ValueString()
+				}
+				api_keyNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			new_relic_receiverMap["api_key"] = api_keyNestedMap
 		}
 		if data.NewRelicReceiver.Eu != nil {
@@ -3271,16 +4143,34 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 			if !data.QradarReceiver.Batch.MaxBytes.IsNull() && !data.QradarReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.QradarReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.QradarReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.QradarReceiver.Batch.MaxEvents.IsNull() && !data.QradarReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.QradarReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.QradarReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.QradarReceiver.Batch.TimeoutSeconds.IsNull() && !data.QradarReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.QradarReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.QradarReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			qradar_receiverMap["batch"] = batchNestedMap
 		}
 		if data.QradarReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.QradarReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			qradar_receiverMap["compression"] = compressionNestedMap
 		}
 		if data.QradarReceiver.NoTLS != nil {
@@ -3291,6 +4181,58 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		}
 		if data.QradarReceiver.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.QradarReceiver.UseTLS.DisableVerifyCertificate != nil {
+				use_tlsNestedMap["disable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.UseTLS.DisableVerifyHostname != nil {
+				use_tlsNestedMap["disable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.UseTLS.EnableVerifyCertificate != nil {
+				use_tlsNestedMap["enable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.UseTLS.EnableVerifyHostname != nil {
+				use_tlsNestedMap["enable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.UseTLS.MtlsDisabled != nil {
+				use_tlsNestedMap["mtls_disabled"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.UseTLS.MtlsEnable != nil {
+				mtls_enableLevel3Map := make(map[string]interface{})
+				if !data.QradarReceiver.UseTLS.MtlsEnable.Certificate.IsNull() && !data.QradarReceiver.UseTLS.MtlsEnable.Certificate.IsUnknown() {
+					mtls_enableLevel3Map["certificate"] = data.QradarReceiver.UseTLS.MtlsEnable.Certificate.ValueString()
+				}
+				if data.QradarReceiver.UseTLS.MtlsEnable.KeyURL != nil {
+					key_urlLevel4Map := make(map[string]interface{})
+					if data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsNull() && !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						key_urlLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsNull() && !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsNull() && !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.ValueString()
+						}
+						key_urlLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					mtls_enableLevel3Map["key_url"] = key_urlLevel4Map
+				}
+				use_tlsNestedMap["mtls_enable"] = mtls_enableLevel3Map
+			}
+			if data.QradarReceiver.UseTLS.NoCA != nil {
+				use_tlsNestedMap["no_ca"] = map[string]interface{}{}
+			}
 			if !data.QradarReceiver.UseTLS.TrustedCAURL.IsNull() && !data.QradarReceiver.UseTLS.TrustedCAURL.IsUnknown() {
 				use_tlsNestedMap["trusted_ca_url"] = data.QradarReceiver.UseTLS.TrustedCAURL.ValueString()
 			}
@@ -3325,12 +4267,21 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 			if !data.S3Receiver.Batch.MaxBytes.IsNull() && !data.S3Receiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.S3Receiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.S3Receiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.S3Receiver.Batch.MaxEvents.IsNull() && !data.S3Receiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.S3Receiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.S3Receiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.S3Receiver.Batch.TimeoutSeconds.IsNull() && !data.S3Receiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.S3Receiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.S3Receiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			s3_receiverMap["batch"] = batchNestedMap
 		}
 		if !data.S3Receiver.Bucket.IsNull() && !data.S3Receiver.Bucket.IsUnknown() {
@@ -3338,6 +4289,15 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		}
 		if data.S3Receiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.S3Receiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.S3Receiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.S3Receiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			s3_receiverMap["compression"] = compressionNestedMap
 		}
 		if data.S3Receiver.FilenameOptions != nil {
@@ -3345,6 +4305,12 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 			if !data.S3Receiver.FilenameOptions.CustomFolder.IsNull() && !data.S3Receiver.FilenameOptions.CustomFolder.IsUnknown() {
 				filename_optionsNestedMap["custom_folder"] = data.S3Receiver.FilenameOptions.CustomFolder.ValueString()
 			}
+			if data.S3Receiver.FilenameOptions.LogTypeFolder != nil {
+				filename_optionsNestedMap["log_type_folder"] = map[string]interface{}{}
+			}
+			if data.S3Receiver.FilenameOptions.NoFolder != nil {
+				filename_optionsNestedMap["no_folder"] = map[string]interface{}{}
+			}
 			s3_receiverMap["filename_options"] = filename_optionsNestedMap
 		}
 		createReq.Spec["s3_receiver"] = s3_receiverMap
@@ -3360,16 +4326,34 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 			if !data.SplunkReceiver.Batch.MaxBytes.IsNull() && !data.SplunkReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.SplunkReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.SplunkReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.SplunkReceiver.Batch.MaxEvents.IsNull() && !data.SplunkReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.SplunkReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.SplunkReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.SplunkReceiver.Batch.TimeoutSeconds.IsNull() && !data.SplunkReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.SplunkReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.SplunkReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			splunk_receiverMap["batch"] = batchNestedMap
 		}
 		if data.SplunkReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.SplunkReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			splunk_receiverMap["compression"] = compressionNestedMap
 		}
 		if !data.SplunkReceiver.Endpoint.IsNull() && !data.SplunkReceiver.Endpoint.IsUnknown() {
@@ -3380,10 +4364,85 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		}
 		if data.SplunkReceiver.SplunkHecToken != nil {
 			splunk_hec_tokenNestedMap := make(map[string]interface{})
+			if data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.Location.IsNull() && !data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				splunk_hec_tokenNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.SplunkReceiver.SplunkHecToken.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.SplunkReceiver.SplunkHecToken.ClearSecretInfo.Provider.IsNull() && !data.SplunkReceiver.SplunkHecToken.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.SplunkReceiver.SplunkHecToken.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.SplunkReceiver.SplunkHecToken.ClearSecretInfo.URL.IsNull() && !data.SplunkReceiver.SplunkHecToken.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.SplunkReceiver.SplunkHecToken.ClearSecretInfo.URL.ValueString()
+				}
+				splunk_hec_tokenNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			splunk_receiverMap["splunk_hec_token"] = splunk_hec_tokenNestedMap
 		}
 		if data.SplunkReceiver.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.SplunkReceiver.UseTLS.DisableVerifyCertificate != nil {
+				use_tlsNestedMap["disable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.UseTLS.DisableVerifyHostname != nil {
+				use_tlsNestedMap["disable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.UseTLS.EnableVerifyCertificate != nil {
+				use_tlsNestedMap["enable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.UseTLS.EnableVerifyHostname != nil {
+				use_tlsNestedMap["enable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.UseTLS.MtlsDisabled != nil {
+				use_tlsNestedMap["mtls_disabled"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.UseTLS.MtlsEnable != nil {
+				mtls_enableLevel3Map := make(map[string]interface{})
+				if !data.SplunkReceiver.UseTLS.MtlsEnable.Certificate.IsNull() && !data.SplunkReceiver.UseTLS.MtlsEnable.Certificate.IsUnknown() {
+					mtls_enableLevel3Map["certificate"] = data.SplunkReceiver.UseTLS.MtlsEnable.Certificate.ValueString()
+				}
+				if data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL != nil {
+					key_urlLevel4Map := make(map[string]interface{})
+					if data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsNull() && !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						key_urlLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsNull() && !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsNull() && !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.ValueString()
+						}
+						key_urlLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					mtls_enableLevel3Map["key_url"] = key_urlLevel4Map
+				}
+				use_tlsNestedMap["mtls_enable"] = mtls_enableLevel3Map
+			}
+			if data.SplunkReceiver.UseTLS.NoCA != nil {
+				use_tlsNestedMap["no_ca"] = map[string]interface{}{}
+			}
 			if !data.SplunkReceiver.UseTLS.TrustedCAURL.IsNull() && !data.SplunkReceiver.UseTLS.TrustedCAURL.IsUnknown() {
 				use_tlsNestedMap["trusted_ca_url"] = data.SplunkReceiver.UseTLS.TrustedCAURL.ValueString()
 			}
@@ -3395,6 +4454,29 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 		sumo_logic_receiverMap := make(map[string]interface{})
 		if data.SumoLogicReceiver.URL != nil {
 			urlNestedMap := make(map[string]interface{})
+			if data.SumoLogicReceiver.URL.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.SumoLogicReceiver.URL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.SumoLogicReceiver.URL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.SumoLogicReceiver.URL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.SumoLogicReceiver.URL.BlindfoldSecretInfo.Location.IsNull() && !data.SumoLogicReceiver.URL.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.SumoLogicReceiver.URL.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.SumoLogicReceiver.URL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.SumoLogicReceiver.URL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.SumoLogicReceiver.URL.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				urlNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.SumoLogicReceiver.URL.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.SumoLogicReceiver.URL.ClearSecretInfo.Provider.IsNull() && !data.SumoLogicReceiver.URL.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.SumoLogicReceiver.URL.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.SumoLogicReceiver.URL.ClearSecretInfo.URL.IsNull() && !data.SumoLogicReceiver.URL.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.SumoLogicReceiver.URL.ClearSecretInfo.URL.ValueString()
+				}
+				urlNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			sumo_logic_receiverMap["url"] = urlNestedMap
 		}
 		createReq.Spec["sumo_logic_receiver"] = sumo_logic_receiverMap
@@ -3402,11 +4484,15 @@ func (r *GlobalLogReceiverResource) Create(ctx context.Context, req resource.Cre
 
 	apiResource, err := r.client.CreateGlobalLogReceiver(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create GlobalLogReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "global_log_receiver", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -4384,7 +5470,7 @@ func (r *GlobalLogReceiverResource) Read(ctx context.Context, req resource.ReadR
 	apiResource, err := r.client.GetGlobalLogReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "GlobalLogReceiver not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -4392,11 +5478,12 @@ func (r *GlobalLogReceiverResource) Read(ctx context.Context, req resource.ReadR
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read GlobalLogReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "global_log_receiver", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -4407,31 +5494,13 @@ func (r *GlobalLogReceiverResource) Read(ctx context.Context, req resource.ReadR
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -4439,6 +5508,7 @@ func (r *GlobalLogReceiverResource) Read(ctx context.Context, req resource.ReadR
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["audit_logs"].(map[string]interface{}); ok && isImport && data.AuditLogs == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -5394,7 +6464,8 @@ func (r *GlobalLogReceiverResource) Read(ctx context.Context, req resource.ReadR
 
 func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data GlobalLogReceiverResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -5428,6 +6499,7 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -5437,6 +6509,7 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AuditLogs != nil {
@@ -5466,16 +6539,34 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 			if !data.AWSCloudWatchReceiver.Batch.MaxBytes.IsNull() && !data.AWSCloudWatchReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.AWSCloudWatchReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.AWSCloudWatchReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.AWSCloudWatchReceiver.Batch.MaxEvents.IsNull() && !data.AWSCloudWatchReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.AWSCloudWatchReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.AWSCloudWatchReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.AWSCloudWatchReceiver.Batch.TimeoutSeconds.IsNull() && !data.AWSCloudWatchReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.AWSCloudWatchReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.AWSCloudWatchReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			aws_cloud_watch_receiverMap["batch"] = batchNestedMap
 		}
 		if data.AWSCloudWatchReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.AWSCloudWatchReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.AWSCloudWatchReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.AWSCloudWatchReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			aws_cloud_watch_receiverMap["compression"] = compressionNestedMap
 		}
 		if !data.AWSCloudWatchReceiver.GroupName.IsNull() && !data.AWSCloudWatchReceiver.GroupName.IsUnknown() {
@@ -5490,6 +6581,29 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		azure_event_hubs_receiverMap := make(map[string]interface{})
 		if data.AzureEventHubsReceiver.ConnectionString != nil {
 			connection_stringNestedMap := make(map[string]interface{})
+			if data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.Location.IsNull() && !data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.AzureEventHubsReceiver.ConnectionString.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				connection_stringNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo.Provider.IsNull() && !data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo.URL.IsNull() && !data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.AzureEventHubsReceiver.ConnectionString.ClearSecretInfo.URL.ValueString()
+				}
+				connection_stringNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			azure_event_hubs_receiverMap["connection_string"] = connection_stringNestedMap
 		}
 		if !data.AzureEventHubsReceiver.Instance.IsNull() && !data.AzureEventHubsReceiver.Instance.IsUnknown() {
@@ -5507,20 +6621,61 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 			if !data.AzureReceiver.Batch.MaxBytes.IsNull() && !data.AzureReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.AzureReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.AzureReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.AzureReceiver.Batch.MaxEvents.IsNull() && !data.AzureReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.AzureReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.AzureReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.AzureReceiver.Batch.TimeoutSeconds.IsNull() && !data.AzureReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.AzureReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.AzureReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			azure_receiverMap["batch"] = batchNestedMap
 		}
 		if data.AzureReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.AzureReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.AzureReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.AzureReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			azure_receiverMap["compression"] = compressionNestedMap
 		}
 		if data.AzureReceiver.ConnectionString != nil {
 			connection_stringNestedMap := make(map[string]interface{})
+			if data.AzureReceiver.ConnectionString.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.Location.IsNull() && !data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.AzureReceiver.ConnectionString.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				connection_stringNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.AzureReceiver.ConnectionString.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.AzureReceiver.ConnectionString.ClearSecretInfo.Provider.IsNull() && !data.AzureReceiver.ConnectionString.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.AzureReceiver.ConnectionString.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.AzureReceiver.ConnectionString.ClearSecretInfo.URL.IsNull() && !data.AzureReceiver.ConnectionString.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.AzureReceiver.ConnectionString.ClearSecretInfo.URL.ValueString()
+				}
+				connection_stringNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			azure_receiverMap["connection_string"] = connection_stringNestedMap
 		}
 		if !data.AzureReceiver.ContainerName.IsNull() && !data.AzureReceiver.ContainerName.IsUnknown() {
@@ -5531,6 +6686,12 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 			if !data.AzureReceiver.FilenameOptions.CustomFolder.IsNull() && !data.AzureReceiver.FilenameOptions.CustomFolder.IsUnknown() {
 				filename_optionsNestedMap["custom_folder"] = data.AzureReceiver.FilenameOptions.CustomFolder.ValueString()
 			}
+			if data.AzureReceiver.FilenameOptions.LogTypeFolder != nil {
+				filename_optionsNestedMap["log_type_folder"] = map[string]interface{}{}
+			}
+			if data.AzureReceiver.FilenameOptions.NoFolder != nil {
+				filename_optionsNestedMap["no_folder"] = map[string]interface{}{}
+			}
 			azure_receiverMap["filename_options"] = filename_optionsNestedMap
 		}
 		apiResource.Spec["azure_receiver"] = azure_receiverMap
@@ -5542,20 +6703,61 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 			if !data.DatadogReceiver.Batch.MaxBytes.IsNull() && !data.DatadogReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.DatadogReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.DatadogReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.DatadogReceiver.Batch.MaxEvents.IsNull() && !data.DatadogReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.DatadogReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.DatadogReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.DatadogReceiver.Batch.TimeoutSeconds.IsNull() && !data.DatadogReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.DatadogReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.DatadogReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			datadog_receiverMap["batch"] = batchNestedMap
 		}
 		if data.DatadogReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.DatadogReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			datadog_receiverMap["compression"] = compressionNestedMap
 		}
 		if data.DatadogReceiver.DatadogAPIKey != nil {
 			datadog_api_keyNestedMap := make(map[string]interface{})
+			if data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.Location.IsNull() && !data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.DatadogReceiver.DatadogAPIKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				datadog_api_keyNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo.Provider.IsNull() && !data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo.URL.IsNull() && !data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.DatadogReceiver.DatadogAPIKey.ClearSecretInfo.URL.ValueString()
+				}
+				datadog_api_keyNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			datadog_receiverMap["datadog_api_key"] = datadog_api_keyNestedMap
 		}
 		if !data.DatadogReceiver.Endpoint.IsNull() && !data.DatadogReceiver.Endpoint.IsUnknown() {
@@ -5569,6 +6771,58 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		}
 		if data.DatadogReceiver.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.DatadogReceiver.UseTLS.DisableVerifyCertificate != nil {
+				use_tlsNestedMap["disable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.UseTLS.DisableVerifyHostname != nil {
+				use_tlsNestedMap["disable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.UseTLS.EnableVerifyCertificate != nil {
+				use_tlsNestedMap["enable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.UseTLS.EnableVerifyHostname != nil {
+				use_tlsNestedMap["enable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.UseTLS.MtlsDisabled != nil {
+				use_tlsNestedMap["mtls_disabled"] = map[string]interface{}{}
+			}
+			if data.DatadogReceiver.UseTLS.MtlsEnable != nil {
+				mtls_enableLevel3Map := make(map[string]interface{})
+				if !data.DatadogReceiver.UseTLS.MtlsEnable.Certificate.IsNull() && !data.DatadogReceiver.UseTLS.MtlsEnable.Certificate.IsUnknown() {
+					mtls_enableLevel3Map["certificate"] = data.DatadogReceiver.UseTLS.MtlsEnable.Certificate.ValueString()
+				}
+				if data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL != nil {
+					key_urlLevel4Map := make(map[string]interface{})
+					if data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsNull() && !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						key_urlLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsNull() && !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsNull() && !data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.DatadogReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.ValueString()
+						}
+						key_urlLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					mtls_enableLevel3Map["key_url"] = key_urlLevel4Map
+				}
+				use_tlsNestedMap["mtls_enable"] = mtls_enableLevel3Map
+			}
+			if data.DatadogReceiver.UseTLS.NoCA != nil {
+				use_tlsNestedMap["no_ca"] = map[string]interface{}{}
+			}
 			if !data.DatadogReceiver.UseTLS.TrustedCAURL.IsNull() && !data.DatadogReceiver.UseTLS.TrustedCAURL.IsUnknown() {
 				use_tlsNestedMap["trusted_ca_url"] = data.DatadogReceiver.UseTLS.TrustedCAURL.ValueString()
 			}
@@ -5587,12 +6841,21 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 			if !data.GCPBucketReceiver.Batch.MaxBytes.IsNull() && !data.GCPBucketReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.GCPBucketReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.GCPBucketReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.GCPBucketReceiver.Batch.MaxEvents.IsNull() && !data.GCPBucketReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.GCPBucketReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.GCPBucketReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.GCPBucketReceiver.Batch.TimeoutSeconds.IsNull() && !data.GCPBucketReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.GCPBucketReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.GCPBucketReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			gcp_bucket_receiverMap["batch"] = batchNestedMap
 		}
 		if !data.GCPBucketReceiver.Bucket.IsNull() && !data.GCPBucketReceiver.Bucket.IsUnknown() {
@@ -5600,6 +6863,15 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		}
 		if data.GCPBucketReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.GCPBucketReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.GCPBucketReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.GCPBucketReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			gcp_bucket_receiverMap["compression"] = compressionNestedMap
 		}
 		if data.GCPBucketReceiver.FilenameOptions != nil {
@@ -5607,6 +6879,12 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 			if !data.GCPBucketReceiver.FilenameOptions.CustomFolder.IsNull() && !data.GCPBucketReceiver.FilenameOptions.CustomFolder.IsUnknown() {
 				filename_optionsNestedMap["custom_folder"] = data.GCPBucketReceiver.FilenameOptions.CustomFolder.ValueString()
 			}
+			if data.GCPBucketReceiver.FilenameOptions.LogTypeFolder != nil {
+				filename_optionsNestedMap["log_type_folder"] = map[string]interface{}{}
+			}
+			if data.GCPBucketReceiver.FilenameOptions.NoFolder != nil {
+				filename_optionsNestedMap["no_folder"] = map[string]interface{}{}
+			}
 			gcp_bucket_receiverMap["filename_options"] = filename_optionsNestedMap
 		}
 		if data.GCPBucketReceiver.GCPCred != nil {
@@ -5628,6 +6906,33 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		http_receiverMap := make(map[string]interface{})
 		if data.HTTPReceiver.AuthBasic != nil {
 			auth_basicNestedMap := make(map[string]interface{})
+			if data.HTTPReceiver.AuthBasic.Password != nil {
+				passwordLevel3Map := make(map[string]interface{})
+				if data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.Location.IsNull() && !data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.HTTPReceiver.AuthBasic.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					passwordLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo.Provider.IsNull() && !data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo.URL.IsNull() && !data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.HTTPReceiver.AuthBasic.Password.ClearSecretInfo.URL.ValueString()
+					}
+					passwordLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				auth_basicNestedMap["password"] = passwordLevel3Map
+			}
 			if !data.HTTPReceiver.AuthBasic.UserName.IsNull() && !data.HTTPReceiver.AuthBasic.UserName.IsUnknown() {
 				auth_basicNestedMap["user_name"] = data.HTTPReceiver.AuthBasic.UserName.ValueString()
 			}
@@ -5638,6 +6943,33 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		}
 		if data.HTTPReceiver.AuthToken != nil {
 			auth_tokenNestedMap := make(map[string]interface{})
+			if data.HTTPReceiver.AuthToken.Token != nil {
+				tokenLevel3Map := make(map[string]interface{})
+				if data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.Location.IsNull() && !data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.HTTPReceiver.AuthToken.Token.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.HTTPReceiver.AuthToken.Token.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.HTTPReceiver.AuthToken.Token.ClearSecretInfo.Provider.IsNull() && !data.HTTPReceiver.AuthToken.Token.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.HTTPReceiver.AuthToken.Token.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.HTTPReceiver.AuthToken.Token.ClearSecretInfo.URL.IsNull() && !data.HTTPReceiver.AuthToken.Token.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.HTTPReceiver.AuthToken.Token.ClearSecretInfo.URL.ValueString()
+					}
+					tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				auth_tokenNestedMap["token"] = tokenLevel3Map
+			}
 			http_receiverMap["auth_token"] = auth_tokenNestedMap
 		}
 		if data.HTTPReceiver.Batch != nil {
@@ -5645,16 +6977,34 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 			if !data.HTTPReceiver.Batch.MaxBytes.IsNull() && !data.HTTPReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.HTTPReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.HTTPReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.HTTPReceiver.Batch.MaxEvents.IsNull() && !data.HTTPReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.HTTPReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.HTTPReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.HTTPReceiver.Batch.TimeoutSeconds.IsNull() && !data.HTTPReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.HTTPReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.HTTPReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			http_receiverMap["batch"] = batchNestedMap
 		}
 		if data.HTTPReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.HTTPReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			http_receiverMap["compression"] = compressionNestedMap
 		}
 		if data.HTTPReceiver.NoTLS != nil {
@@ -5665,6 +7015,58 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		}
 		if data.HTTPReceiver.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.HTTPReceiver.UseTLS.DisableVerifyCertificate != nil {
+				use_tlsNestedMap["disable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.UseTLS.DisableVerifyHostname != nil {
+				use_tlsNestedMap["disable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.UseTLS.EnableVerifyCertificate != nil {
+				use_tlsNestedMap["enable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.UseTLS.EnableVerifyHostname != nil {
+				use_tlsNestedMap["enable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.UseTLS.MtlsDisabled != nil {
+				use_tlsNestedMap["mtls_disabled"] = map[string]interface{}{}
+			}
+			if data.HTTPReceiver.UseTLS.MtlsEnable != nil {
+				mtls_enableLevel3Map := make(map[string]interface{})
+				if !data.HTTPReceiver.UseTLS.MtlsEnable.Certificate.IsNull() && !data.HTTPReceiver.UseTLS.MtlsEnable.Certificate.IsUnknown() {
+					mtls_enableLevel3Map["certificate"] = data.HTTPReceiver.UseTLS.MtlsEnable.Certificate.ValueString()
+				}
+				if data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL != nil {
+					key_urlLevel4Map := make(map[string]interface{})
+					if data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsNull() && !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						key_urlLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsNull() && !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsNull() && !data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.HTTPReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.ValueString()
+						}
+						key_urlLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					mtls_enableLevel3Map["key_url"] = key_urlLevel4Map
+				}
+				use_tlsNestedMap["mtls_enable"] = mtls_enableLevel3Map
+			}
+			if data.HTTPReceiver.UseTLS.NoCA != nil {
+				use_tlsNestedMap["no_ca"] = map[string]interface{}{}
+			}
 			if !data.HTTPReceiver.UseTLS.TrustedCAURL.IsNull() && !data.HTTPReceiver.UseTLS.TrustedCAURL.IsUnknown() {
 				use_tlsNestedMap["trusted_ca_url"] = data.HTTPReceiver.UseTLS.TrustedCAURL.ValueString()
 			}
@@ -5679,12 +7081,21 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 			if !data.KafkaReceiver.Batch.MaxBytes.IsNull() && !data.KafkaReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.KafkaReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.KafkaReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.KafkaReceiver.Batch.MaxEvents.IsNull() && !data.KafkaReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.KafkaReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.KafkaReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.KafkaReceiver.Batch.TimeoutSeconds.IsNull() && !data.KafkaReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.KafkaReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.KafkaReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			kafka_receiverMap["batch"] = batchNestedMap
 		}
 		if !data.KafkaReceiver.BootstrapServers.IsNull() && !data.KafkaReceiver.BootstrapServers.IsUnknown() {
@@ -5696,6 +7107,15 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		}
 		if data.KafkaReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.KafkaReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			kafka_receiverMap["compression"] = compressionNestedMap
 		}
 		if !data.KafkaReceiver.KafkaTopic.IsNull() && !data.KafkaReceiver.KafkaTopic.IsUnknown() {
@@ -5706,6 +7126,58 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		}
 		if data.KafkaReceiver.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.KafkaReceiver.UseTLS.DisableVerifyCertificate != nil {
+				use_tlsNestedMap["disable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.UseTLS.DisableVerifyHostname != nil {
+				use_tlsNestedMap["disable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.UseTLS.EnableVerifyCertificate != nil {
+				use_tlsNestedMap["enable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.UseTLS.EnableVerifyHostname != nil {
+				use_tlsNestedMap["enable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.UseTLS.MtlsDisabled != nil {
+				use_tlsNestedMap["mtls_disabled"] = map[string]interface{}{}
+			}
+			if data.KafkaReceiver.UseTLS.MtlsEnable != nil {
+				mtls_enableLevel3Map := make(map[string]interface{})
+				if !data.KafkaReceiver.UseTLS.MtlsEnable.Certificate.IsNull() && !data.KafkaReceiver.UseTLS.MtlsEnable.Certificate.IsUnknown() {
+					mtls_enableLevel3Map["certificate"] = data.KafkaReceiver.UseTLS.MtlsEnable.Certificate.ValueString()
+				}
+				if data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL != nil {
+					key_urlLevel4Map := make(map[string]interface{})
+					if data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsNull() && !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						key_urlLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsNull() && !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsNull() && !data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.KafkaReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.ValueString()
+						}
+						key_urlLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					mtls_enableLevel3Map["key_url"] = key_urlLevel4Map
+				}
+				use_tlsNestedMap["mtls_enable"] = mtls_enableLevel3Map
+			}
+			if data.KafkaReceiver.UseTLS.NoCA != nil {
+				use_tlsNestedMap["no_ca"] = map[string]interface{}{}
+			}
 			if !data.KafkaReceiver.UseTLS.TrustedCAURL.IsNull() && !data.KafkaReceiver.UseTLS.TrustedCAURL.IsUnknown() {
 				use_tlsNestedMap["trusted_ca_url"] = data.KafkaReceiver.UseTLS.TrustedCAURL.ValueString()
 			}
@@ -5717,6 +7189,29 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		new_relic_receiverMap := make(map[string]interface{})
 		if data.NewRelicReceiver.APIKey != nil {
 			api_keyNestedMap := make(map[string]interface{})
+			if data.NewRelicReceiver.APIKey.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.Location.IsNull() && !data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.NewRelicReceiver.APIKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				api_keyNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.NewRelicReceiver.APIKey.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.NewRelicReceiver.APIKey.ClearSecretInfo.Provider.IsNull() && !data.NewRelicReceiver.APIKey.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.NewRelicReceiver.APIKey.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.NewRelicReceiver.APIKey.ClearSecretInfo.URL.IsNull() && !data.NewRelicReceiver.APIKey.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.NewRelicReceiver.APIKey.ClearSecretInfo.URL.ValueString()
+				}
+				api_keyNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			new_relic_receiverMap["api_key"] = api_keyNestedMap
 		}
 		if data.NewRelicReceiver.Eu != nil {
@@ -5753,16 +7248,34 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 			if !data.QradarReceiver.Batch.MaxBytes.IsNull() && !data.QradarReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.QradarReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.QradarReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.QradarReceiver.Batch.MaxEvents.IsNull() && !data.QradarReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.QradarReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.QradarReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.QradarReceiver.Batch.TimeoutSeconds.IsNull() && !data.QradarReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.QradarReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.QradarReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			qradar_receiverMap["batch"] = batchNestedMap
 		}
 		if data.QradarReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.QradarReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			qradar_receiverMap["compression"] = compressionNestedMap
 		}
 		if data.QradarReceiver.NoTLS != nil {
@@ -5773,6 +7286,58 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		}
 		if data.QradarReceiver.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.QradarReceiver.UseTLS.DisableVerifyCertificate != nil {
+				use_tlsNestedMap["disable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.UseTLS.DisableVerifyHostname != nil {
+				use_tlsNestedMap["disable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.UseTLS.EnableVerifyCertificate != nil {
+				use_tlsNestedMap["enable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.UseTLS.EnableVerifyHostname != nil {
+				use_tlsNestedMap["enable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.UseTLS.MtlsDisabled != nil {
+				use_tlsNestedMap["mtls_disabled"] = map[string]interface{}{}
+			}
+			if data.QradarReceiver.UseTLS.MtlsEnable != nil {
+				mtls_enableLevel3Map := make(map[string]interface{})
+				if !data.QradarReceiver.UseTLS.MtlsEnable.Certificate.IsNull() && !data.QradarReceiver.UseTLS.MtlsEnable.Certificate.IsUnknown() {
+					mtls_enableLevel3Map["certificate"] = data.QradarReceiver.UseTLS.MtlsEnable.Certificate.ValueString()
+				}
+				if data.QradarReceiver.UseTLS.MtlsEnable.KeyURL != nil {
+					key_urlLevel4Map := make(map[string]interface{})
+					if data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsNull() && !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						key_urlLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsNull() && !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsNull() && !data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.QradarReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.ValueString()
+						}
+						key_urlLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					mtls_enableLevel3Map["key_url"] = key_urlLevel4Map
+				}
+				use_tlsNestedMap["mtls_enable"] = mtls_enableLevel3Map
+			}
+			if data.QradarReceiver.UseTLS.NoCA != nil {
+				use_tlsNestedMap["no_ca"] = map[string]interface{}{}
+			}
 			if !data.QradarReceiver.UseTLS.TrustedCAURL.IsNull() && !data.QradarReceiver.UseTLS.TrustedCAURL.IsUnknown() {
 				use_tlsNestedMap["trusted_ca_url"] = data.QradarReceiver.UseTLS.TrustedCAURL.ValueString()
 			}
@@ -5807,12 +7372,21 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 			if !data.S3Receiver.Batch.MaxBytes.IsNull() && !data.S3Receiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.S3Receiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.S3Receiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.S3Receiver.Batch.MaxEvents.IsNull() && !data.S3Receiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.S3Receiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.S3Receiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.S3Receiver.Batch.TimeoutSeconds.IsNull() && !data.S3Receiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.S3Receiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.S3Receiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			s3_receiverMap["batch"] = batchNestedMap
 		}
 		if !data.S3Receiver.Bucket.IsNull() && !data.S3Receiver.Bucket.IsUnknown() {
@@ -5820,6 +7394,15 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		}
 		if data.S3Receiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.S3Receiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.S3Receiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.S3Receiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			s3_receiverMap["compression"] = compressionNestedMap
 		}
 		if data.S3Receiver.FilenameOptions != nil {
@@ -5827,6 +7410,12 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 			if !data.S3Receiver.FilenameOptions.CustomFolder.IsNull() && !data.S3Receiver.FilenameOptions.CustomFolder.IsUnknown() {
 				filename_optionsNestedMap["custom_folder"] = data.S3Receiver.FilenameOptions.CustomFolder.ValueString()
 			}
+			if data.S3Receiver.FilenameOptions.LogTypeFolder != nil {
+				filename_optionsNestedMap["log_type_folder"] = map[string]interface{}{}
+			}
+			if data.S3Receiver.FilenameOptions.NoFolder != nil {
+				filename_optionsNestedMap["no_folder"] = map[string]interface{}{}
+			}
 			s3_receiverMap["filename_options"] = filename_optionsNestedMap
 		}
 		apiResource.Spec["s3_receiver"] = s3_receiverMap
@@ -5842,16 +7431,34 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 			if !data.SplunkReceiver.Batch.MaxBytes.IsNull() && !data.SplunkReceiver.Batch.MaxBytes.IsUnknown() {
 				batchNestedMap["max_bytes"] = data.SplunkReceiver.Batch.MaxBytes.ValueInt64()
 			}
+			if data.SplunkReceiver.Batch.MaxBytesDisabled != nil {
+				batchNestedMap["max_bytes_disabled"] = map[string]interface{}{}
+			}
 			if !data.SplunkReceiver.Batch.MaxEvents.IsNull() && !data.SplunkReceiver.Batch.MaxEvents.IsUnknown() {
 				batchNestedMap["max_events"] = data.SplunkReceiver.Batch.MaxEvents.ValueInt64()
 			}
+			if data.SplunkReceiver.Batch.MaxEventsDisabled != nil {
+				batchNestedMap["max_events_disabled"] = map[string]interface{}{}
+			}
 			if !data.SplunkReceiver.Batch.TimeoutSeconds.IsNull() && !data.SplunkReceiver.Batch.TimeoutSeconds.IsUnknown() {
 				batchNestedMap["timeout_seconds"] = data.SplunkReceiver.Batch.TimeoutSeconds.ValueString()
 			}
+			if data.SplunkReceiver.Batch.TimeoutSecondsDefault != nil {
+				batchNestedMap["timeout_seconds_default"] = map[string]interface{}{}
+			}
 			splunk_receiverMap["batch"] = batchNestedMap
 		}
 		if data.SplunkReceiver.Compression != nil {
 			compressionNestedMap := make(map[string]interface{})
+			if data.SplunkReceiver.Compression.CompressionDefault != nil {
+				compressionNestedMap["compression_default"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.Compression.CompressionGzip != nil {
+				compressionNestedMap["compression_gzip"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.Compression.CompressionNone != nil {
+				compressionNestedMap["compression_none"] = map[string]interface{}{}
+			}
 			splunk_receiverMap["compression"] = compressionNestedMap
 		}
 		if !data.SplunkReceiver.Endpoint.IsNull() && !data.SplunkReceiver.Endpoint.IsUnknown() {
@@ -5862,10 +7469,85 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		}
 		if data.SplunkReceiver.SplunkHecToken != nil {
 			splunk_hec_tokenNestedMap := make(map[string]interface{})
+			if data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.Location.IsNull() && !data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.SplunkReceiver.SplunkHecToken.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				splunk_hec_tokenNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.SplunkReceiver.SplunkHecToken.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.SplunkReceiver.SplunkHecToken.ClearSecretInfo.Provider.IsNull() && !data.SplunkReceiver.SplunkHecToken.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.SplunkReceiver.SplunkHecToken.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.SplunkReceiver.SplunkHecToken.ClearSecretInfo.URL.IsNull() && !data.SplunkReceiver.SplunkHecToken.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.SplunkReceiver.SplunkHecToken.ClearSecretInfo.URL.ValueString()
+				}
+				splunk_hec_tokenNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			splunk_receiverMap["splunk_hec_token"] = splunk_hec_tokenNestedMap
 		}
 		if data.SplunkReceiver.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.SplunkReceiver.UseTLS.DisableVerifyCertificate != nil {
+				use_tlsNestedMap["disable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.UseTLS.DisableVerifyHostname != nil {
+				use_tlsNestedMap["disable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.UseTLS.EnableVerifyCertificate != nil {
+				use_tlsNestedMap["enable_verify_certificate"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.UseTLS.EnableVerifyHostname != nil {
+				use_tlsNestedMap["enable_verify_hostname"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.UseTLS.MtlsDisabled != nil {
+				use_tlsNestedMap["mtls_disabled"] = map[string]interface{}{}
+			}
+			if data.SplunkReceiver.UseTLS.MtlsEnable != nil {
+				mtls_enableLevel3Map := make(map[string]interface{})
+				if !data.SplunkReceiver.UseTLS.MtlsEnable.Certificate.IsNull() && !data.SplunkReceiver.UseTLS.MtlsEnable.Certificate.IsUnknown() {
+					mtls_enableLevel3Map["certificate"] = data.SplunkReceiver.UseTLS.MtlsEnable.Certificate.ValueString()
+				}
+				if data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL != nil {
+					key_urlLevel4Map := make(map[string]interface{})
+					if data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsNull() && !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						key_urlLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsNull() && !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsNull() && !data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.SplunkReceiver.UseTLS.MtlsEnable.KeyURL.ClearSecretInfo.URL.ValueString()
+						}
+						key_urlLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					mtls_enableLevel3Map["key_url"] = key_urlLevel4Map
+				}
+				use_tlsNestedMap["mtls_enable"] = mtls_enableLevel3Map
+			}
+			if data.SplunkReceiver.UseTLS.NoCA != nil {
+				use_tlsNestedMap["no_ca"] = map[string]interface{}{}
+			}
 			if !data.SplunkReceiver.UseTLS.TrustedCAURL.IsNull() && !data.SplunkReceiver.UseTLS.TrustedCAURL.IsUnknown() {
 				use_tlsNestedMap["trusted_ca_url"] = data.SplunkReceiver.UseTLS.TrustedCAURL.ValueString()
 			}
@@ -5877,6 +7559,29 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 		sumo_logic_receiverMap := make(map[string]interface{})
 		if data.SumoLogicReceiver.URL != nil {
 			urlNestedMap := make(map[string]interface{})
+			if data.SumoLogicReceiver.URL.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.SumoLogicReceiver.URL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.SumoLogicReceiver.URL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.SumoLogicReceiver.URL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.SumoLogicReceiver.URL.BlindfoldSecretInfo.Location.IsNull() && !data.SumoLogicReceiver.URL.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.SumoLogicReceiver.URL.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.SumoLogicReceiver.URL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.SumoLogicReceiver.URL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.SumoLogicReceiver.URL.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				urlNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.SumoLogicReceiver.URL.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.SumoLogicReceiver.URL.ClearSecretInfo.Provider.IsNull() && !data.SumoLogicReceiver.URL.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.SumoLogicReceiver.URL.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.SumoLogicReceiver.URL.ClearSecretInfo.URL.IsNull() && !data.SumoLogicReceiver.URL.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.SumoLogicReceiver.URL.ClearSecretInfo.URL.ValueString()
+				}
+				urlNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			sumo_logic_receiverMap["url"] = urlNestedMap
 		}
 		apiResource.Spec["sumo_logic_receiver"] = sumo_logic_receiverMap
@@ -5884,7 +7589,7 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 
 	_, err := r.client.UpdateGlobalLogReceiver(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update GlobalLogReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "global_log_receiver", "update"))
 		return
 	}
 
@@ -5895,9 +7600,12 @@ func (r *GlobalLogReceiverResource) Update(ctx context.Context, req resource.Upd
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetGlobalLogReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read GlobalLogReceiver after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "global_log_receiver", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -6875,7 +8583,7 @@ func (r *GlobalLogReceiverResource) Delete(ctx context.Context, req resource.Del
 	err := r.client.DeleteGlobalLogReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "GlobalLogReceiver already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -6884,37 +8592,33 @@ func (r *GlobalLogReceiverResource) Delete(ctx context.Context, req resource.Del
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "GlobalLogReceiver delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete GlobalLogReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "global_log_receiver", "delete"))
 		return
 	}
 }
 
 func (r *GlobalLogReceiverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *GlobalLogReceiverResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("global_log_receiver", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *GlobalLogReceiverResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListGlobalLogReceivers(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *GlobalLogReceiverResource) requiredNamespace() string {
+	return "system"
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,25 @@ type HealthcheckDataSource struct {
 	client *client.Client
 }
 
+// HealthcheckDataSourceModel mirrors HealthcheckResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type HealthcheckDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata     types.Object                     `tfsdk:"system_metadata"`
+	Name               types.String                     `tfsdk:"name"`
+	Namespace          types.String                     `tfsdk:"namespace"`
+	Annotations        types.Map                        `tfsdk:"annotations"`
+	Description        types.String                     `tfsdk:"description"`
+	Disable            types.Bool                       `tfsdk:"disable"`
+	Labels             types.Map                        `tfsdk:"labels"`
+	ID                 types.String                     `tfsdk:"id"`
+	HealthyThreshold   types.Int64                      `tfsdk:"healthy_threshold"`
+	Interval           types.Int64                      `tfsdk:"interval"`
+	JitterPercent      types.Int64                      `tfsdk:"jitter_percent"`
+	Timeout            types.Int64                      `tfsdk:"timeout"`
+	UnhealthyThreshold types.Int64                      `tfsdk:"unhealthy_threshold"`
+	HTTPHealthCheck    *HealthcheckHTTPHealthCheckModel `tfsdk:"http_health_check"`
+	TCPHealthCheck     *HealthcheckTCPHealthCheckModel  `tfsdk:"tcp_health_check"`
+	UDPICMPHealthCheck *HealthcheckEmptyModel           `tfsdk:"udp_icmp_health_check"`
 }
 
 func (d *HealthcheckDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +52,7 @@ func (d *HealthcheckDataSource) Metadata(ctx context.Context, req datasource.Met
 }
 
 func (d *HealthcheckDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Healthcheck resource in F5 Distributed Cloud for healthcheck object defines method to determine if the given endpoint is healthy. single healthcheck object can be referred to by one or many cluster objects. configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the Healthcheck.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Healthcheck exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the Healthcheck.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewHealthcheckResource())
 }
 
 func (d *HealthcheckDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +74,25 @@ func (d *HealthcheckDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	resource, err := d.client.GetHealthcheck(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetHealthcheck(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Healthcheck: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "healthcheck", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +106,8 @@ func (d *HealthcheckDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +116,138 @@ func (d *HealthcheckDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["http_health_check"].(map[string]interface{}); ok && (isImport || data.HTTPHealthCheck != nil) {
+		data.HTTPHealthCheck = &HealthcheckHTTPHealthCheckModel{
+			ExpectedStatusCodes: func() types.List {
+				if v, ok := blockData["expected_status_codes"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			Headers: func() *HealthcheckEmptyModel {
+				if !isImport && data.HTTPHealthCheck != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPHealthCheck.Headers
+				}
+				// Import case: read from API
+				if _, ok := blockData["headers"].(map[string]interface{}); ok {
+					return &HealthcheckEmptyModel{}
+				}
+				return nil
+			}(),
+			HostHeader: func() types.String {
+				if v, ok := blockData["host_header"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Path: func() types.String {
+				if v, ok := blockData["path"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			RequestHeadersToRemove: func() types.List {
+				if v, ok := blockData["request_headers_to_remove"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			UseHttp2: func() types.Bool {
+				if !isImport && data.HTTPHealthCheck != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.HTTPHealthCheck.UseHttp2
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["use_http2"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			UseOriginServerName: func() *HealthcheckEmptyModel {
+				if !isImport && data.HTTPHealthCheck != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPHealthCheck.UseOriginServerName
+				}
+				// Import case: read from API
+				if _, ok := blockData["use_origin_server_name"].(map[string]interface{}); ok {
+					return &HealthcheckEmptyModel{}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["tcp_health_check"].(map[string]interface{}); ok && (isImport || data.TCPHealthCheck != nil) {
+		data.TCPHealthCheck = &HealthcheckTCPHealthCheckModel{
+			ExpectedResponse: func() types.String {
+				if v, ok := blockData["expected_response"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			SendPayload: func() types.String {
+				if v, ok := blockData["send_payload"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["udp_icmp_health_check"].(map[string]interface{}); ok && isImport && data.UDPICMPHealthCheck == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.UDPICMPHealthCheck = &HealthcheckEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["healthy_threshold"].(float64); ok {
+		data.HealthyThreshold = types.Int64Value(int64(v))
+	} else {
+		data.HealthyThreshold = types.Int64Null()
+	}
+	if v, ok := apiResource.Spec["interval"].(float64); ok {
+		data.Interval = types.Int64Value(int64(v))
+	} else {
+		data.Interval = types.Int64Null()
+	}
+	if v, ok := apiResource.Spec["jitter_percent"].(float64); ok {
+		data.JitterPercent = types.Int64Value(int64(v))
+	} else {
+		data.JitterPercent = types.Int64Null()
+	}
+	if v, ok := apiResource.Spec["timeout"].(float64); ok {
+		data.Timeout = types.Int64Value(int64(v))
+	} else {
+		data.Timeout = types.Int64Null()
+	}
+	if v, ok := apiResource.Spec["unhealthy_threshold"].(float64); ok {
+		data.UnhealthyThreshold = types.Int64Value(int64(v))
+	} else {
+		data.UnhealthyThreshold = types.Int64Null()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
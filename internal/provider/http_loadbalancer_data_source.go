@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,98 @@ type HTTPLoadBalancerDataSource struct {
 	client *client.Client
 }
 
+// HTTPLoadBalancerDataSourceModel mirrors HTTPLoadBalancerResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type HTTPLoadBalancerDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata                types.Object                                       `tfsdk:"system_metadata"`
+	Name                          types.String                                       `tfsdk:"name"`
+	Namespace                     types.String                                       `tfsdk:"namespace"`
+	Annotations                   types.Map                                          `tfsdk:"annotations"`
+	Description                   types.String                                       `tfsdk:"description"`
+	Disable                       types.Bool                                         `tfsdk:"disable"`
+	Domains                       types.List                                         `tfsdk:"domains"`
+	Labels                        types.Map                                          `tfsdk:"labels"`
+	ID                            types.String                                       `tfsdk:"id"`
+	AddLocation                   types.Bool                                         `tfsdk:"add_location"`
+	ActiveServicePolicies         *HTTPLoadBalancerActiveServicePoliciesModel        `tfsdk:"active_service_policies"`
+	AdvertiseCustom               *HTTPLoadBalancerAdvertiseCustomModel              `tfsdk:"advertise_custom"`
+	AdvertiseOnPublic             *HTTPLoadBalancerAdvertiseOnPublicModel            `tfsdk:"advertise_on_public"`
+	AdvertiseOnPublicDefaultVIP   *HTTPLoadBalancerEmptyModel                        `tfsdk:"advertise_on_public_default_vip"`
+	APIProtectionRules            *HTTPLoadBalancerAPIProtectionRulesModel           `tfsdk:"api_protection_rules"`
+	APIRateLimit                  *HTTPLoadBalancerAPIRateLimitModel                 `tfsdk:"api_rate_limit"`
+	APISpecification              *HTTPLoadBalancerAPISpecificationModel             `tfsdk:"api_specification"`
+	APITesting                    *HTTPLoadBalancerAPITestingModel                   `tfsdk:"api_testing"`
+	AppFirewall                   *HTTPLoadBalancerAppFirewallModel                  `tfsdk:"app_firewall"`
+	BlockedClients                types.List                                         `tfsdk:"blocked_clients"`
+	BotDefense                    *HTTPLoadBalancerBotDefenseModel                   `tfsdk:"bot_defense"`
+	BotDefenseAdvanced            *HTTPLoadBalancerBotDefenseAdvancedModel           `tfsdk:"bot_defense_advanced"`
+	CachingPolicy                 *HTTPLoadBalancerCachingPolicyModel                `tfsdk:"caching_policy"`
+	CaptchaChallenge              *HTTPLoadBalancerCaptchaChallengeModel             `tfsdk:"captcha_challenge"`
+	ClientSideDefense             *HTTPLoadBalancerClientSideDefenseModel            `tfsdk:"client_side_defense"`
+	CookieStickiness              *HTTPLoadBalancerCookieStickinessModel             `tfsdk:"cookie_stickiness"`
+	CORSPolicy                    *HTTPLoadBalancerCORSPolicyModel                   `tfsdk:"cors_policy"`
+	CSRFPolicy                    *HTTPLoadBalancerCSRFPolicyModel                   `tfsdk:"csrf_policy"`
+	DataGuardRules                types.List                                         `tfsdk:"data_guard_rules"`
+	DDOSMitigationRules           types.List                                         `tfsdk:"ddos_mitigation_rules"`
+	DefaultPool                   *HTTPLoadBalancerDefaultPoolModel                  `tfsdk:"default_pool"`
+	DefaultPoolList               *HTTPLoadBalancerDefaultPoolListModel              `tfsdk:"default_pool_list"`
+	DefaultRoutePools             types.List                                         `tfsdk:"default_route_pools"`
+	DefaultSensitiveDataPolicy    *HTTPLoadBalancerEmptyModel                        `tfsdk:"default_sensitive_data_policy"`
+	DisableAPIDefinition          *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_api_definition"`
+	DisableAPIDiscovery           *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_api_discovery"`
+	DisableAPITesting             *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_api_testing"`
+	DisableBotDefense             *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_bot_defense"`
+	DisableCaching                *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_caching"`
+	DisableClientSideDefense      *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_client_side_defense"`
+	DisableIPReputation           *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_ip_reputation"`
+	DisableMaliciousUserDetection *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_malicious_user_detection"`
+	DisableMalwareProtection      *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_malware_protection"`
+	DisableRateLimit              *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_rate_limit"`
+	DisableThreatMesh             *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_threat_mesh"`
+	DisableTrustClientIPHeaders   *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_trust_client_ip_headers"`
+	DisableWAF                    *HTTPLoadBalancerEmptyModel                        `tfsdk:"disable_waf"`
+	DoNotAdvertise                *HTTPLoadBalancerEmptyModel                        `tfsdk:"do_not_advertise"`
+	EnableAPIDiscovery            *HTTPLoadBalancerEnableAPIDiscoveryModel           `tfsdk:"enable_api_discovery"`
+	EnableChallenge               *HTTPLoadBalancerEnableChallengeModel              `tfsdk:"enable_challenge"`
+	EnableIPReputation            *HTTPLoadBalancerEnableIPReputationModel           `tfsdk:"enable_ip_reputation"`
+	EnableMaliciousUserDetection  *HTTPLoadBalancerEmptyModel                        `tfsdk:"enable_malicious_user_detection"`
+	EnableThreatMesh              *HTTPLoadBalancerEmptyModel                        `tfsdk:"enable_threat_mesh"`
+	EnableTrustClientIPHeaders    *HTTPLoadBalancerEnableTrustClientIPHeadersModel   `tfsdk:"enable_trust_client_ip_headers"`
+	GraphqlRules                  types.List                                         `tfsdk:"graphql_rules"`
+	HTTP                          *HTTPLoadBalancerHTTPModel                         `tfsdk:"http"`
+	HTTPS                         *HTTPLoadBalancerHTTPSModel                        `tfsdk:"https"`
+	HTTPSAutoCert                 *HTTPLoadBalancerHTTPSAutoCertModel                `tfsdk:"https_auto_cert"`
+	JsChallenge                   *HTTPLoadBalancerJsChallengeModel                  `tfsdk:"js_challenge"`
+	JWTValidation                 *HTTPLoadBalancerJWTValidationModel                `tfsdk:"jwt_validation"`
+	L7DDOSActionBlock             *HTTPLoadBalancerEmptyModel                        `tfsdk:"l7_ddos_action_block"`
+	L7DDOSActionDefault           *HTTPLoadBalancerEmptyModel                        `tfsdk:"l7_ddos_action_default"`
+	L7DDOSActionJsChallenge       *HTTPLoadBalancerL7DDOSActionJsChallengeModel      `tfsdk:"l7_ddos_action_js_challenge"`
+	L7DDOSProtection              *HTTPLoadBalancerL7DDOSProtectionModel             `tfsdk:"l7_ddos_protection"`
+	LeastActive                   *HTTPLoadBalancerEmptyModel                        `tfsdk:"least_active"`
+	MalwareProtectionSettings     *HTTPLoadBalancerMalwareProtectionSettingsModel    `tfsdk:"malware_protection_settings"`
+	MoreOption                    *HTTPLoadBalancerMoreOptionModel                   `tfsdk:"more_option"`
+	MultiLBApp                    *HTTPLoadBalancerEmptyModel                        `tfsdk:"multi_lb_app"`
+	NoChallenge                   *HTTPLoadBalancerEmptyModel                        `tfsdk:"no_challenge"`
+	NoServicePolicies             *HTTPLoadBalancerEmptyModel                        `tfsdk:"no_service_policies"`
+	OriginServerSubsetRuleList    *HTTPLoadBalancerOriginServerSubsetRuleListModel   `tfsdk:"origin_server_subset_rule_list"`
+	PolicyBasedChallenge          *HTTPLoadBalancerPolicyBasedChallengeModel         `tfsdk:"policy_based_challenge"`
+	ProtectedCookies              types.List                                         `tfsdk:"protected_cookies"`
+	Random                        *HTTPLoadBalancerEmptyModel                        `tfsdk:"random"`
+	RateLimit                     *HTTPLoadBalancerRateLimitModel                    `tfsdk:"rate_limit"`
+	RingHash                      *HTTPLoadBalancerRingHashModel                     `tfsdk:"ring_hash"`
+	RoundRobin                    *HTTPLoadBalancerEmptyModel                        `tfsdk:"round_robin"`
+	Routes                        types.List                                         `tfsdk:"routes"`
+	SensitiveDataDisclosureRules  *HTTPLoadBalancerSensitiveDataDisclosureRulesModel `tfsdk:"sensitive_data_disclosure_rules"`
+	SensitiveDataPolicy           *HTTPLoadBalancerSensitiveDataPolicyModel          `tfsdk:"sensitive_data_policy"`
+	ServicePoliciesFromNamespace  *HTTPLoadBalancerEmptyModel                        `tfsdk:"service_policies_from_namespace"`
+	SingleLBApp                   *HTTPLoadBalancerSingleLBAppModel                  `tfsdk:"single_lb_app"`
+	SlowDDOSMitigation            *HTTPLoadBalancerSlowDDOSMitigationModel           `tfsdk:"slow_ddos_mitigation"`
+	SourceIPStickiness            *HTTPLoadBalancerEmptyModel                        `tfsdk:"source_ip_stickiness"`
+	SystemDefaultTimeouts         *HTTPLoadBalancerEmptyModel                        `tfsdk:"system_default_timeouts"`
+	TrustedClients                types.List                                         `tfsdk:"trusted_clients"`
+	UserIDClientIP                *HTTPLoadBalancerEmptyModel                        `tfsdk:"user_id_client_ip"`
+	UserIdentification            *HTTPLoadBalancerUserIdentificationModel           `tfsdk:"user_identification"`
+	WAFExclusion                  *HTTPLoadBalancerWAFExclusionModel                 `tfsdk:"waf_exclusion"`
 }
 
 func (d *HTTPLoadBalancerDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +125,7 @@ func (d *HTTPLoadBalancerDataSource) Metadata(ctx context.Context, req datasourc
 }
 
 func (d *HTTPLoadBalancerDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a HTTP Load Balancer resource in F5 Distributed Cloud for load balancing HTTP/HTTPS traffic with advanced routing and security.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the HTTPLoadBalancer.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the HTTPLoadBalancer exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the HTTPLoadBalancer.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewHTTPLoadBalancerResource())
 }
 
 func (d *HTTPLoadBalancerDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +147,25 @@ func (d *HTTPLoadBalancerDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	resource, err := d.client.GetHTTPLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetHTTPLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HTTPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "http_loadbalancer", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +179,8 @@ func (d *HTTPLoadBalancerDataSource) Read(ctx context.Context, req datasource.Re
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +189,4352 @@ func (d *HTTPLoadBalancerDataSource) Read(ctx context.Context, req datasource.Re
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["active_service_policies"].(map[string]interface{}); ok && (isImport || data.ActiveServicePolicies != nil) {
+		data.ActiveServicePolicies = &HTTPLoadBalancerActiveServicePoliciesModel{
+			Policies: func() []HTTPLoadBalancerActiveServicePoliciesPoliciesModel {
+				if listData, ok := blockData["policies"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerActiveServicePoliciesPoliciesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerActiveServicePoliciesPoliciesModel{
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := itemMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := itemMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["advertise_custom"].(map[string]interface{}); ok && (isImport || data.AdvertiseCustom != nil) {
+		data.AdvertiseCustom = &HTTPLoadBalancerAdvertiseCustomModel{
+			AdvertiseWhere: func() []HTTPLoadBalancerAdvertiseCustomAdvertiseWhereModel {
+				if listData, ok := blockData["advertise_where"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerAdvertiseCustomAdvertiseWhereModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerAdvertiseCustomAdvertiseWhereModel{
+								AdvertiseOnPublic: func() *HTTPLoadBalancerAdvertiseCustomAdvertiseWhereAdvertiseOnPublicModel {
+									if _, ok := itemMap["advertise_on_public"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAdvertiseCustomAdvertiseWhereAdvertiseOnPublicModel{}
+									}
+									return nil
+								}(),
+								Port: func() types.Int64 {
+									if v, ok := itemMap["port"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								PortRanges: func() types.String {
+									if v, ok := itemMap["port_ranges"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Site: func() *HTTPLoadBalancerAdvertiseCustomAdvertiseWhereSiteModel {
+									if deepMap, ok := itemMap["site"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAdvertiseCustomAdvertiseWhereSiteModel{
+											IP: func() types.String {
+												if v, ok := deepMap["ip"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Network: func() types.String {
+												if v, ok := deepMap["network"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								UseDefaultPort: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["use_default_port"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								VirtualNetwork: func() *HTTPLoadBalancerAdvertiseCustomAdvertiseWhereVirtualNetworkModel {
+									if deepMap, ok := itemMap["virtual_network"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAdvertiseCustomAdvertiseWhereVirtualNetworkModel{
+											DefaultV6VIP: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["default_v6_vip"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											DefaultVIP: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["default_vip"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											SpecificV6VIP: func() types.String {
+												if v, ok := deepMap["specific_v6_vip"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											SpecificVIP: func() types.String {
+												if v, ok := deepMap["specific_vip"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								VirtualSite: func() *HTTPLoadBalancerAdvertiseCustomAdvertiseWhereVirtualSiteModel {
+									if deepMap, ok := itemMap["virtual_site"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAdvertiseCustomAdvertiseWhereVirtualSiteModel{
+											Network: func() types.String {
+												if v, ok := deepMap["network"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								VirtualSiteWithVIP: func() *HTTPLoadBalancerAdvertiseCustomAdvertiseWhereVirtualSiteWithVIPModel {
+									if deepMap, ok := itemMap["virtual_site_with_vip"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAdvertiseCustomAdvertiseWhereVirtualSiteWithVIPModel{
+											IP: func() types.String {
+												if v, ok := deepMap["ip"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Network: func() types.String {
+												if v, ok := deepMap["network"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Vk8sService: func() *HTTPLoadBalancerAdvertiseCustomAdvertiseWhereVk8sServiceModel {
+									if _, ok := itemMap["vk8s_service"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAdvertiseCustomAdvertiseWhereVk8sServiceModel{}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["advertise_on_public"].(map[string]interface{}); ok && isImport && data.AdvertiseOnPublic == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AdvertiseOnPublic = &HTTPLoadBalancerAdvertiseOnPublicModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["advertise_on_public_default_vip"].(map[string]interface{}); ok && isImport && data.AdvertiseOnPublicDefaultVIP == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AdvertiseOnPublicDefaultVIP = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["api_protection_rules"].(map[string]interface{}); ok && (isImport || data.APIProtectionRules != nil) {
+		data.APIProtectionRules = &HTTPLoadBalancerAPIProtectionRulesModel{
+			APIEndpointRules: func() []HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesModel {
+				if listData, ok := blockData["api_endpoint_rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesModel{
+								Action: func() *HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesActionModel {
+									if deepMap, ok := itemMap["action"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesActionModel{
+											Allow: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["allow"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											Deny: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["deny"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								AnyDomain: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["any_domain"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								APIEndpointMethod: func() *HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesAPIEndpointMethodModel {
+									if deepMap, ok := itemMap["api_endpoint_method"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesAPIEndpointMethodModel{
+											InvertMatcher: func() types.Bool {
+												if v, ok := deepMap["invert_matcher"].(bool); ok {
+													return types.BoolValue(v)
+												}
+												return types.BoolNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								APIEndpointPath: func() types.String {
+									if v, ok := itemMap["api_endpoint_path"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								ClientMatcher: func() *HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesClientMatcherModel {
+									if deepMap, ok := itemMap["client_matcher"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesClientMatcherModel{
+											AnyClient: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["any_client"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											AnyIP: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["any_ip"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Metadata: func() *HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesMetadataModel {
+									if deepMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesMetadataModel{
+											DescriptionSpec: func() types.String {
+												if v, ok := deepMap["description"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								RequestMatcher: func() *HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesRequestMatcherModel {
+									if _, ok := itemMap["request_matcher"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIProtectionRulesAPIEndpointRulesRequestMatcherModel{}
+									}
+									return nil
+								}(),
+								SpecificDomain: func() types.String {
+									if v, ok := itemMap["specific_domain"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			APIGroupsRules: func() []HTTPLoadBalancerAPIProtectionRulesAPIGroupsRulesModel {
+				if listData, ok := blockData["api_groups_rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerAPIProtectionRulesAPIGroupsRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerAPIProtectionRulesAPIGroupsRulesModel{
+								Action: func() *HTTPLoadBalancerAPIProtectionRulesAPIGroupsRulesActionModel {
+									if deepMap, ok := itemMap["action"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIProtectionRulesAPIGroupsRulesActionModel{
+											Allow: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["allow"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											Deny: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["deny"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								AnyDomain: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["any_domain"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								APIGroup: func() types.String {
+									if v, ok := itemMap["api_group"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								BasePath: func() types.String {
+									if v, ok := itemMap["base_path"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								ClientMatcher: func() *HTTPLoadBalancerAPIProtectionRulesAPIGroupsRulesClientMatcherModel {
+									if deepMap, ok := itemMap["client_matcher"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIProtectionRulesAPIGroupsRulesClientMatcherModel{
+											AnyClient: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["any_client"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											AnyIP: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["any_ip"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Metadata: func() *HTTPLoadBalancerAPIProtectionRulesAPIGroupsRulesMetadataModel {
+									if deepMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIProtectionRulesAPIGroupsRulesMetadataModel{
+											DescriptionSpec: func() types.String {
+												if v, ok := deepMap["description"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								RequestMatcher: func() *HTTPLoadBalancerAPIProtectionRulesAPIGroupsRulesRequestMatcherModel {
+									if _, ok := itemMap["request_matcher"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIProtectionRulesAPIGroupsRulesRequestMatcherModel{}
+									}
+									return nil
+								}(),
+								SpecificDomain: func() types.String {
+									if v, ok := itemMap["specific_domain"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["api_rate_limit"].(map[string]interface{}); ok && (isImport || data.APIRateLimit != nil) {
+		data.APIRateLimit = &HTTPLoadBalancerAPIRateLimitModel{
+			APIEndpointRules: func() []HTTPLoadBalancerAPIRateLimitAPIEndpointRulesModel {
+				if listData, ok := blockData["api_endpoint_rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerAPIRateLimitAPIEndpointRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerAPIRateLimitAPIEndpointRulesModel{
+								AnyDomain: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["any_domain"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								APIEndpointMethod: func() *HTTPLoadBalancerAPIRateLimitAPIEndpointRulesAPIEndpointMethodModel {
+									if deepMap, ok := itemMap["api_endpoint_method"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIRateLimitAPIEndpointRulesAPIEndpointMethodModel{
+											InvertMatcher: func() types.Bool {
+												if v, ok := deepMap["invert_matcher"].(bool); ok {
+													return types.BoolValue(v)
+												}
+												return types.BoolNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								APIEndpointPath: func() types.String {
+									if v, ok := itemMap["api_endpoint_path"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								ClientMatcher: func() *HTTPLoadBalancerAPIRateLimitAPIEndpointRulesClientMatcherModel {
+									if deepMap, ok := itemMap["client_matcher"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIRateLimitAPIEndpointRulesClientMatcherModel{
+											AnyClient: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["any_client"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											AnyIP: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["any_ip"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								InlineRateLimiter: func() *HTTPLoadBalancerAPIRateLimitAPIEndpointRulesInlineRateLimiterModel {
+									if deepMap, ok := itemMap["inline_rate_limiter"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIRateLimitAPIEndpointRulesInlineRateLimiterModel{
+											Threshold: func() types.Int64 {
+												if v, ok := deepMap["threshold"].(float64); ok {
+													return types.Int64Value(int64(v))
+												}
+												return types.Int64Null()
+											}(),
+											Unit: func() types.String {
+												if v, ok := deepMap["unit"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											UseHTTPLBUserID: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["use_http_lb_user_id"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								RefRateLimiter: func() *HTTPLoadBalancerAPIRateLimitAPIEndpointRulesRefRateLimiterModel {
+									if deepMap, ok := itemMap["ref_rate_limiter"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIRateLimitAPIEndpointRulesRefRateLimiterModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Namespace: func() types.String {
+												if v, ok := deepMap["namespace"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Tenant: func() types.String {
+												if v, ok := deepMap["tenant"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								RequestMatcher: func() *HTTPLoadBalancerAPIRateLimitAPIEndpointRulesRequestMatcherModel {
+									if _, ok := itemMap["request_matcher"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIRateLimitAPIEndpointRulesRequestMatcherModel{}
+									}
+									return nil
+								}(),
+								SpecificDomain: func() types.String {
+									if v, ok := itemMap["specific_domain"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			BypassRateLimitingRules: func() *HTTPLoadBalancerAPIRateLimitBypassRateLimitingRulesModel {
+				if !isImport && data.APIRateLimit != nil && data.APIRateLimit.BypassRateLimitingRules != nil {
+					// Normal Read: preserve existing state value
+					return data.APIRateLimit.BypassRateLimitingRules
+				}
+				// Import case: read from API
+				if _, ok := blockData["bypass_rate_limiting_rules"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerAPIRateLimitBypassRateLimitingRulesModel{}
+				}
+				return nil
+			}(),
+			CustomIPAllowedList: func() *HTTPLoadBalancerAPIRateLimitCustomIPAllowedListModel {
+				if !isImport && data.APIRateLimit != nil && data.APIRateLimit.CustomIPAllowedList != nil {
+					// Normal Read: preserve existing state value
+					return data.APIRateLimit.CustomIPAllowedList
+				}
+				// Import case: read from API
+				if _, ok := blockData["custom_ip_allowed_list"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerAPIRateLimitCustomIPAllowedListModel{}
+				}
+				return nil
+			}(),
+			IPAllowedList: func() *HTTPLoadBalancerAPIRateLimitIPAllowedListModel {
+				if !isImport && data.APIRateLimit != nil && data.APIRateLimit.IPAllowedList != nil {
+					// Normal Read: preserve existing state value
+					return data.APIRateLimit.IPAllowedList
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["ip_allowed_list"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerAPIRateLimitIPAllowedListModel{
+						Prefixes: func() types.List {
+							if v, ok := nestedBlockData["prefixes"].([]interface{}); ok && len(v) > 0 {
+								var items []string
+								for _, item := range v {
+									if s, ok := item.(string); ok {
+										items = append(items, s)
+									}
+								}
+								listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+								return listVal
+							}
+							return types.ListNull(types.StringType)
+						}(),
+					}
+				}
+				return nil
+			}(),
+			NoIPAllowedList: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.APIRateLimit != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.APIRateLimit.NoIPAllowedList
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_ip_allowed_list"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			ServerURLRules: func() []HTTPLoadBalancerAPIRateLimitServerURLRulesModel {
+				if listData, ok := blockData["server_url_rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerAPIRateLimitServerURLRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerAPIRateLimitServerURLRulesModel{
+								AnyDomain: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["any_domain"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								APIGroup: func() types.String {
+									if v, ok := itemMap["api_group"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								BasePath: func() types.String {
+									if v, ok := itemMap["base_path"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								ClientMatcher: func() *HTTPLoadBalancerAPIRateLimitServerURLRulesClientMatcherModel {
+									if deepMap, ok := itemMap["client_matcher"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIRateLimitServerURLRulesClientMatcherModel{
+											AnyClient: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["any_client"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											AnyIP: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["any_ip"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								InlineRateLimiter: func() *HTTPLoadBalancerAPIRateLimitServerURLRulesInlineRateLimiterModel {
+									if deepMap, ok := itemMap["inline_rate_limiter"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIRateLimitServerURLRulesInlineRateLimiterModel{
+											Threshold: func() types.Int64 {
+												if v, ok := deepMap["threshold"].(float64); ok {
+													return types.Int64Value(int64(v))
+												}
+												return types.Int64Null()
+											}(),
+											Unit: func() types.String {
+												if v, ok := deepMap["unit"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											UseHTTPLBUserID: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["use_http_lb_user_id"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								RefRateLimiter: func() *HTTPLoadBalancerAPIRateLimitServerURLRulesRefRateLimiterModel {
+									if deepMap, ok := itemMap["ref_rate_limiter"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIRateLimitServerURLRulesRefRateLimiterModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Namespace: func() types.String {
+												if v, ok := deepMap["namespace"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Tenant: func() types.String {
+												if v, ok := deepMap["tenant"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								RequestMatcher: func() *HTTPLoadBalancerAPIRateLimitServerURLRulesRequestMatcherModel {
+									if _, ok := itemMap["request_matcher"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerAPIRateLimitServerURLRulesRequestMatcherModel{}
+									}
+									return nil
+								}(),
+								SpecificDomain: func() types.String {
+									if v, ok := itemMap["specific_domain"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["api_specification"].(map[string]interface{}); ok && isImport && data.APISpecification == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.APISpecification = &HTTPLoadBalancerAPISpecificationModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["api_testing"].(map[string]interface{}); ok && (isImport || data.APITesting != nil) {
+		data.APITesting = &HTTPLoadBalancerAPITestingModel{
+			CustomHeaderValue: func() types.String {
+				if v, ok := blockData["custom_header_value"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Domains: func() []HTTPLoadBalancerAPITestingDomainsModel {
+				if listData, ok := blockData["domains"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerAPITestingDomainsModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerAPITestingDomainsModel{
+								AllowDestructiveMethods: func() types.Bool {
+									if v, ok := itemMap["allow_destructive_methods"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								Domain: func() types.String {
+									if v, ok := itemMap["domain"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			EveryDay: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.APITesting != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.APITesting.EveryDay
+				}
+				// Import case: read from API
+				if _, ok := blockData["every_day"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			EveryMonth: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.APITesting != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.APITesting.EveryMonth
+				}
+				// Import case: read from API
+				if _, ok := blockData["every_month"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			EveryWeek: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.APITesting != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.APITesting.EveryWeek
+				}
+				// Import case: read from API
+				if _, ok := blockData["every_week"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["app_firewall"].(map[string]interface{}); ok && (isImport || data.AppFirewall != nil) {
+		data.AppFirewall = &HTTPLoadBalancerAppFirewallModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if listData, ok := apiResource.Spec["blocked_clients"].([]interface{}); ok && len(listData) > 0 {
+		var blocked_clientsList []HTTPLoadBalancerBlockedClientsModel
+		var existingBlockedClientsItems []HTTPLoadBalancerBlockedClientsModel
+		if !data.BlockedClients.IsNull() && !data.BlockedClients.IsUnknown() {
+			data.BlockedClients.ElementsAs(ctx, &existingBlockedClientsItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				blocked_clientsList = append(blocked_clientsList, HTTPLoadBalancerBlockedClientsModel{
+					Actions: func() types.List {
+						if v, ok := itemMap["actions"].([]interface{}); ok && len(v) > 0 {
+							var items []string
+							for _, item := range v {
+								if s, ok := item.(string); ok {
+									items = append(items, s)
+								}
+							}
+							listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+							return listVal
+						}
+						return types.ListNull(types.StringType)
+					}(),
+					AsNumber: func() types.Int64 {
+						if v, ok := itemMap["as_number"].(float64); ok && v != 0 {
+							return types.Int64Value(int64(v))
+						}
+						return types.Int64Null()
+					}(),
+					BotSkipProcessing: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingBlockedClientsItems) > listIdx && existingBlockedClientsItems[listIdx].BotSkipProcessing != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					ExpirationTimestamp: func() types.String {
+						if v, ok := itemMap["expiration_timestamp"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					HTTPHeader: func() *HTTPLoadBalancerBlockedClientsHTTPHeaderModel {
+						if _, ok := itemMap["http_header"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerBlockedClientsHTTPHeaderModel{}
+						}
+						return nil
+					}(),
+					IPPrefix: func() types.String {
+						if v, ok := itemMap["ip_prefix"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Ipv6Prefix: func() types.String {
+						if v, ok := itemMap["ipv6_prefix"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Metadata: func() *HTTPLoadBalancerBlockedClientsMetadataModel {
+						if nestedMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerBlockedClientsMetadataModel{
+								DescriptionSpec: func() types.String {
+									if v, ok := nestedMap["description"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := nestedMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					SkipProcessing: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingBlockedClientsItems) > listIdx && existingBlockedClientsItems[listIdx].SkipProcessing != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					UserIdentifier: func() types.String {
+						if v, ok := itemMap["user_identifier"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					WAFSkipProcessing: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingBlockedClientsItems) > listIdx && existingBlockedClientsItems[listIdx].WAFSkipProcessing != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: HTTPLoadBalancerBlockedClientsModelAttrTypes}, blocked_clientsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.BlockedClients = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.BlockedClients = types.ListNull(types.ObjectType{AttrTypes: HTTPLoadBalancerBlockedClientsModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["bot_defense"].(map[string]interface{}); ok && (isImport || data.BotDefense != nil) {
+		data.BotDefense = &HTTPLoadBalancerBotDefenseModel{
+			DisableCORSSupport: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.BotDefense != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.BotDefense.DisableCORSSupport
+				}
+				// Import case: read from API
+				if _, ok := blockData["disable_cors_support"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			EnableCORSSupport: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.BotDefense != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.BotDefense.EnableCORSSupport
+				}
+				// Import case: read from API
+				if _, ok := blockData["enable_cors_support"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			Policy: func() *HTTPLoadBalancerBotDefensePolicyModel {
+				if !isImport && data.BotDefense != nil && data.BotDefense.Policy != nil {
+					// Normal Read: preserve existing state value
+					return data.BotDefense.Policy
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["policy"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerBotDefensePolicyModel{
+						JavascriptMode: func() types.String {
+							if v, ok := nestedBlockData["javascript_mode"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						JsDownloadPath: func() types.String {
+							if v, ok := nestedBlockData["js_download_path"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			RegionalEndpoint: func() types.String {
+				if v, ok := blockData["regional_endpoint"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Timeout: func() types.Int64 {
+				if !isImport && data.BotDefense != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.BotDefense.Timeout
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["timeout"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["bot_defense_advanced"].(map[string]interface{}); ok && isImport && data.BotDefenseAdvanced == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.BotDefenseAdvanced = &HTTPLoadBalancerBotDefenseAdvancedModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["caching_policy"].(map[string]interface{}); ok && isImport && data.CachingPolicy == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.CachingPolicy = &HTTPLoadBalancerCachingPolicyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["captcha_challenge"].(map[string]interface{}); ok && (isImport || data.CaptchaChallenge != nil) {
+		data.CaptchaChallenge = &HTTPLoadBalancerCaptchaChallengeModel{
+			CookieExpiry: func() types.Int64 {
+				if !isImport && data.CaptchaChallenge != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.CaptchaChallenge.CookieExpiry
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["cookie_expiry"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			CustomPage: func() types.String {
+				if v, ok := blockData["custom_page"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["client_side_defense"].(map[string]interface{}); ok && isImport && data.ClientSideDefense == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.ClientSideDefense = &HTTPLoadBalancerClientSideDefenseModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["cookie_stickiness"].(map[string]interface{}); ok && (isImport || data.CookieStickiness != nil) {
+		data.CookieStickiness = &HTTPLoadBalancerCookieStickinessModel{
+			AddHttponly: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.CookieStickiness != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.CookieStickiness.AddHttponly
+				}
+				// Import case: read from API
+				if _, ok := blockData["add_httponly"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			AddSecure: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.CookieStickiness != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.CookieStickiness.AddSecure
+				}
+				// Import case: read from API
+				if _, ok := blockData["add_secure"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			IgnoreHttponly: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.CookieStickiness != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.CookieStickiness.IgnoreHttponly
+				}
+				// Import case: read from API
+				if _, ok := blockData["ignore_httponly"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			IgnoreSamesite: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.CookieStickiness != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.CookieStickiness.IgnoreSamesite
+				}
+				// Import case: read from API
+				if _, ok := blockData["ignore_samesite"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			IgnoreSecure: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.CookieStickiness != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.CookieStickiness.IgnoreSecure
+				}
+				// Import case: read from API
+				if _, ok := blockData["ignore_secure"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Path: func() types.String {
+				if v, ok := blockData["path"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			SamesiteLax: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.CookieStickiness != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.CookieStickiness.SamesiteLax
+				}
+				// Import case: read from API
+				if _, ok := blockData["samesite_lax"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			SamesiteNone: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.CookieStickiness != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.CookieStickiness.SamesiteNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["samesite_none"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			SamesiteStrict: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.CookieStickiness != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.CookieStickiness.SamesiteStrict
+				}
+				// Import case: read from API
+				if _, ok := blockData["samesite_strict"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			TTL: func() types.Int64 {
+				if !isImport && data.CookieStickiness != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.CookieStickiness.TTL
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["ttl"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["cors_policy"].(map[string]interface{}); ok && (isImport || data.CORSPolicy != nil) {
+		data.CORSPolicy = &HTTPLoadBalancerCORSPolicyModel{
+			AllowCredentials: func() types.Bool {
+				if !isImport && data.CORSPolicy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.CORSPolicy.AllowCredentials
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["allow_credentials"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			AllowHeaders: func() types.String {
+				if v, ok := blockData["allow_headers"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			AllowMethods: func() types.String {
+				if v, ok := blockData["allow_methods"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			AllowOrigin: func() types.List {
+				if v, ok := blockData["allow_origin"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			AllowOriginRegex: func() types.List {
+				if v, ok := blockData["allow_origin_regex"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			Disabled: func() types.Bool {
+				if !isImport && data.CORSPolicy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.CORSPolicy.Disabled
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["disabled"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			ExposeHeaders: func() types.String {
+				if v, ok := blockData["expose_headers"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			MaximumAge: func() types.Int64 {
+				if !isImport && data.CORSPolicy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.CORSPolicy.MaximumAge
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["maximum_age"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["csrf_policy"].(map[string]interface{}); ok && isImport && data.CSRFPolicy == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.CSRFPolicy = &HTTPLoadBalancerCSRFPolicyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if listData, ok := apiResource.Spec["data_guard_rules"].([]interface{}); ok && len(listData) > 0 {
+		var data_guard_rulesList []HTTPLoadBalancerDataGuardRulesModel
+		var existingDataGuardRulesItems []HTTPLoadBalancerDataGuardRulesModel
+		if !data.DataGuardRules.IsNull() && !data.DataGuardRules.IsUnknown() {
+			data.DataGuardRules.ElementsAs(ctx, &existingDataGuardRulesItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				data_guard_rulesList = append(data_guard_rulesList, HTTPLoadBalancerDataGuardRulesModel{
+					AnyDomain: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingDataGuardRulesItems) > listIdx && existingDataGuardRulesItems[listIdx].AnyDomain != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					ApplyDataGuard: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingDataGuardRulesItems) > listIdx && existingDataGuardRulesItems[listIdx].ApplyDataGuard != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					ExactValue: func() types.String {
+						if v, ok := itemMap["exact_value"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Metadata: func() *HTTPLoadBalancerDataGuardRulesMetadataModel {
+						if nestedMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerDataGuardRulesMetadataModel{
+								DescriptionSpec: func() types.String {
+									if v, ok := nestedMap["description"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := nestedMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					Path: func() *HTTPLoadBalancerDataGuardRulesPathModel {
+						if nestedMap, ok := itemMap["path"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerDataGuardRulesPathModel{
+								Path: func() types.String {
+									if v, ok := nestedMap["path"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Prefix: func() types.String {
+									if v, ok := nestedMap["prefix"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Regex: func() types.String {
+									if v, ok := nestedMap["regex"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					SkipDataGuard: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingDataGuardRulesItems) > listIdx && existingDataGuardRulesItems[listIdx].SkipDataGuard != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					SuffixValue: func() types.String {
+						if v, ok := itemMap["suffix_value"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: HTTPLoadBalancerDataGuardRulesModelAttrTypes}, data_guard_rulesList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.DataGuardRules = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.DataGuardRules = types.ListNull(types.ObjectType{AttrTypes: HTTPLoadBalancerDataGuardRulesModelAttrTypes})
+	}
+	if listData, ok := apiResource.Spec["ddos_mitigation_rules"].([]interface{}); ok && len(listData) > 0 {
+		var ddos_mitigation_rulesList []HTTPLoadBalancerDDOSMitigationRulesModel
+		var existingDDOSMitigationRulesItems []HTTPLoadBalancerDDOSMitigationRulesModel
+		if !data.DDOSMitigationRules.IsNull() && !data.DDOSMitigationRules.IsUnknown() {
+			data.DDOSMitigationRules.ElementsAs(ctx, &existingDDOSMitigationRulesItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				ddos_mitigation_rulesList = append(ddos_mitigation_rulesList, HTTPLoadBalancerDDOSMitigationRulesModel{
+					Block: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingDDOSMitigationRulesItems) > listIdx && existingDDOSMitigationRulesItems[listIdx].Block != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					DDOSClientSource: func() *HTTPLoadBalancerDDOSMitigationRulesDDOSClientSourceModel {
+						if nestedMap, ok := itemMap["ddos_client_source"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerDDOSMitigationRulesDDOSClientSourceModel{
+								CountryList: func() types.List {
+									if v, ok := nestedMap["country_list"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+							}
+						}
+						return nil
+					}(),
+					ExpirationTimestamp: func() types.String {
+						if v, ok := itemMap["expiration_timestamp"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					IPPrefixList: func() *HTTPLoadBalancerDDOSMitigationRulesIPPrefixListModel {
+						if nestedMap, ok := itemMap["ip_prefix_list"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerDDOSMitigationRulesIPPrefixListModel{
+								InvertMatch: func() types.Bool {
+									if v, ok := nestedMap["invert_match"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								IPPrefixes: func() types.List {
+									if v, ok := nestedMap["ip_prefixes"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+							}
+						}
+						return nil
+					}(),
+					Metadata: func() *HTTPLoadBalancerDDOSMitigationRulesMetadataModel {
+						if nestedMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerDDOSMitigationRulesMetadataModel{
+								DescriptionSpec: func() types.String {
+									if v, ok := nestedMap["description"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := nestedMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: HTTPLoadBalancerDDOSMitigationRulesModelAttrTypes}, ddos_mitigation_rulesList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.DDOSMitigationRules = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.DDOSMitigationRules = types.ListNull(types.ObjectType{AttrTypes: HTTPLoadBalancerDDOSMitigationRulesModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["default_pool"].(map[string]interface{}); ok && (isImport || data.DefaultPool != nil) {
+		data.DefaultPool = &HTTPLoadBalancerDefaultPoolModel{
+			AdvancedOptions: func() *HTTPLoadBalancerDefaultPoolAdvancedOptionsModel {
+				if !isImport && data.DefaultPool != nil && data.DefaultPool.AdvancedOptions != nil {
+					// Normal Read: preserve existing state value
+					return data.DefaultPool.AdvancedOptions
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["advanced_options"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerDefaultPoolAdvancedOptionsModel{
+						ConnectionTimeout: func() types.Int64 {
+							if v, ok := nestedBlockData["connection_timeout"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						HTTPIdleTimeout: func() types.Int64 {
+							if v, ok := nestedBlockData["http_idle_timeout"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						PanicThreshold: func() types.Int64 {
+							if v, ok := nestedBlockData["panic_threshold"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			AutomaticPort: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.DefaultPool != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DefaultPool.AutomaticPort
+				}
+				// Import case: read from API
+				if _, ok := blockData["automatic_port"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			EndpointSelection: func() types.String {
+				if v, ok := blockData["endpoint_selection"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			HealthCheckPort: func() types.Int64 {
+				if !isImport && data.DefaultPool != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.DefaultPool.HealthCheckPort
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["health_check_port"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			Healthcheck: func() []HTTPLoadBalancerDefaultPoolHealthcheckModel {
+				if listData, ok := blockData["healthcheck"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerDefaultPoolHealthcheckModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerDefaultPoolHealthcheckModel{
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := itemMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := itemMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			LBPort: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.DefaultPool != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DefaultPool.LBPort
+				}
+				// Import case: read from API
+				if _, ok := blockData["lb_port"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			LoadBalancerAlgorithm: func() types.String {
+				if v, ok := blockData["loadbalancer_algorithm"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			NoTLS: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.DefaultPool != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DefaultPool.NoTLS
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_tls"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			OriginServers: func() []HTTPLoadBalancerDefaultPoolOriginServersModel {
+				if listData, ok := blockData["origin_servers"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerDefaultPoolOriginServersModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerDefaultPoolOriginServersModel{
+								CbipService: func() *HTTPLoadBalancerDefaultPoolOriginServersCbipServiceModel {
+									if deepMap, ok := itemMap["cbip_service"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerDefaultPoolOriginServersCbipServiceModel{
+											ServiceName: func() types.String {
+												if v, ok := deepMap["service_name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								ConsulService: func() *HTTPLoadBalancerDefaultPoolOriginServersConsulServiceModel {
+									if deepMap, ok := itemMap["consul_service"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerDefaultPoolOriginServersConsulServiceModel{
+											InsideNetwork: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["inside_network"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											OutsideNetwork: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["outside_network"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											ServiceName: func() types.String {
+												if v, ok := deepMap["service_name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								CustomEndpointObject: func() *HTTPLoadBalancerDefaultPoolOriginServersCustomEndpointObjectModel {
+									if _, ok := itemMap["custom_endpoint_object"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerDefaultPoolOriginServersCustomEndpointObjectModel{}
+									}
+									return nil
+								}(),
+								K8SService: func() *HTTPLoadBalancerDefaultPoolOriginServersK8SServiceModel {
+									if deepMap, ok := itemMap["k8s_service"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerDefaultPoolOriginServersK8SServiceModel{
+											InsideNetwork: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["inside_network"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											OutsideNetwork: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["outside_network"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											Protocol: func() types.String {
+												if v, ok := deepMap["protocol"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											ServiceName: func() types.String {
+												if v, ok := deepMap["service_name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Vk8sNetworks: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["vk8s_networks"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Labels: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["labels"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								PrivateIP: func() *HTTPLoadBalancerDefaultPoolOriginServersPrivateIPModel {
+									if deepMap, ok := itemMap["private_ip"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerDefaultPoolOriginServersPrivateIPModel{
+											InsideNetwork: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["inside_network"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											IP: func() types.String {
+												if v, ok := deepMap["ip"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											OutsideNetwork: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["outside_network"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								PrivateName: func() *HTTPLoadBalancerDefaultPoolOriginServersPrivateNameModel {
+									if deepMap, ok := itemMap["private_name"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerDefaultPoolOriginServersPrivateNameModel{
+											DNSName: func() types.String {
+												if v, ok := deepMap["dns_name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											InsideNetwork: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["inside_network"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											OutsideNetwork: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["outside_network"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											RefreshInterval: func() types.Int64 {
+												if v, ok := deepMap["refresh_interval"].(float64); ok {
+													return types.Int64Value(int64(v))
+												}
+												return types.Int64Null()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								PublicIP: func() *HTTPLoadBalancerDefaultPoolOriginServersPublicIPModel {
+									if deepMap, ok := itemMap["public_ip"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerDefaultPoolOriginServersPublicIPModel{
+											IP: func() types.String {
+												if v, ok := deepMap["ip"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								PublicName: func() *HTTPLoadBalancerDefaultPoolOriginServersPublicNameModel {
+									if deepMap, ok := itemMap["public_name"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerDefaultPoolOriginServersPublicNameModel{
+											DNSName: func() types.String {
+												if v, ok := deepMap["dns_name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											RefreshInterval: func() types.Int64 {
+												if v, ok := deepMap["refresh_interval"].(float64); ok {
+													return types.Int64Value(int64(v))
+												}
+												return types.Int64Null()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								VnPrivateIP: func() *HTTPLoadBalancerDefaultPoolOriginServersVnPrivateIPModel {
+									if deepMap, ok := itemMap["vn_private_ip"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerDefaultPoolOriginServersVnPrivateIPModel{
+											IP: func() types.String {
+												if v, ok := deepMap["ip"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								VnPrivateName: func() *HTTPLoadBalancerDefaultPoolOriginServersVnPrivateNameModel {
+									if deepMap, ok := itemMap["vn_private_name"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerDefaultPoolOriginServersVnPrivateNameModel{
+											DNSName: func() types.String {
+												if v, ok := deepMap["dns_name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			Port: func() types.Int64 {
+				if !isImport && data.DefaultPool != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.DefaultPool.Port
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["port"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			SameAsEndpointPort: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.DefaultPool != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DefaultPool.SameAsEndpointPort
+				}
+				// Import case: read from API
+				if _, ok := blockData["same_as_endpoint_port"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			UpstreamConnPoolReuseType: func() *HTTPLoadBalancerDefaultPoolUpstreamConnPoolReuseTypeModel {
+				if !isImport && data.DefaultPool != nil && data.DefaultPool.UpstreamConnPoolReuseType != nil {
+					// Normal Read: preserve existing state value
+					return data.DefaultPool.UpstreamConnPoolReuseType
+				}
+				// Import case: read from API
+				if _, ok := blockData["upstream_conn_pool_reuse_type"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerDefaultPoolUpstreamConnPoolReuseTypeModel{}
+				}
+				return nil
+			}(),
+			UseTLS: func() *HTTPLoadBalancerDefaultPoolUseTLSModel {
+				if !isImport && data.DefaultPool != nil && data.DefaultPool.UseTLS != nil {
+					// Normal Read: preserve existing state value
+					return data.DefaultPool.UseTLS
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["use_tls"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerDefaultPoolUseTLSModel{
+						MaxSessionKeys: func() types.Int64 {
+							if v, ok := nestedBlockData["max_session_keys"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						Sni: func() types.String {
+							if v, ok := nestedBlockData["sni"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			ViewInternal: func() *HTTPLoadBalancerDefaultPoolViewInternalModel {
+				if !isImport && data.DefaultPool != nil && data.DefaultPool.ViewInternal != nil {
+					// Normal Read: preserve existing state value
+					return data.DefaultPool.ViewInternal
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["view_internal"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerDefaultPoolViewInternalModel{
+						Name: func() types.String {
+							if v, ok := nestedBlockData["name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Namespace: func() types.String {
+							if v, ok := nestedBlockData["namespace"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Tenant: func() types.String {
+							if v, ok := nestedBlockData["tenant"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["default_pool_list"].(map[string]interface{}); ok && (isImport || data.DefaultPoolList != nil) {
+		data.DefaultPoolList = &HTTPLoadBalancerDefaultPoolListModel{
+			Pools: func() []HTTPLoadBalancerDefaultPoolListPoolsModel {
+				if listData, ok := blockData["pools"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerDefaultPoolListPoolsModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerDefaultPoolListPoolsModel{
+								Cluster: func() *HTTPLoadBalancerDefaultPoolListPoolsClusterModel {
+									if deepMap, ok := itemMap["cluster"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerDefaultPoolListPoolsClusterModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Namespace: func() types.String {
+												if v, ok := deepMap["namespace"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Tenant: func() types.String {
+												if v, ok := deepMap["tenant"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								EndpointSubsets: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["endpoint_subsets"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								Pool: func() *HTTPLoadBalancerDefaultPoolListPoolsPoolModel {
+									if deepMap, ok := itemMap["pool"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerDefaultPoolListPoolsPoolModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Namespace: func() types.String {
+												if v, ok := deepMap["namespace"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Tenant: func() types.String {
+												if v, ok := deepMap["tenant"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Priority: func() types.Int64 {
+									if v, ok := itemMap["priority"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								Weight: func() types.Int64 {
+									if v, ok := itemMap["weight"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if listData, ok := apiResource.Spec["default_route_pools"].([]interface{}); ok && len(listData) > 0 {
+		var default_route_poolsList []HTTPLoadBalancerDefaultRoutePoolsModel
+		var existingDefaultRoutePoolsItems []HTTPLoadBalancerDefaultRoutePoolsModel
+		if !data.DefaultRoutePools.IsNull() && !data.DefaultRoutePools.IsUnknown() {
+			data.DefaultRoutePools.ElementsAs(ctx, &existingDefaultRoutePoolsItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				default_route_poolsList = append(default_route_poolsList, HTTPLoadBalancerDefaultRoutePoolsModel{
+					Cluster: func() *HTTPLoadBalancerDefaultRoutePoolsClusterModel {
+						if nestedMap, ok := itemMap["cluster"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerDefaultRoutePoolsClusterModel{
+								Name: func() types.String {
+									if v, ok := nestedMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := nestedMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := nestedMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					EndpointSubsets: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingDefaultRoutePoolsItems) > listIdx && existingDefaultRoutePoolsItems[listIdx].EndpointSubsets != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					Pool: func() *HTTPLoadBalancerDefaultRoutePoolsPoolModel {
+						if nestedMap, ok := itemMap["pool"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerDefaultRoutePoolsPoolModel{
+								Name: func() types.String {
+									if v, ok := nestedMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := nestedMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := nestedMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					Priority: func() types.Int64 {
+						if v, ok := itemMap["priority"].(float64); ok && v != 0 {
+							return types.Int64Value(int64(v))
+						}
+						return types.Int64Null()
+					}(),
+					Weight: func() types.Int64 {
+						if v, ok := itemMap["weight"].(float64); ok && v != 0 {
+							return types.Int64Value(int64(v))
+						}
+						return types.Int64Null()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: HTTPLoadBalancerDefaultRoutePoolsModelAttrTypes}, default_route_poolsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.DefaultRoutePools = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.DefaultRoutePools = types.ListNull(types.ObjectType{AttrTypes: HTTPLoadBalancerDefaultRoutePoolsModelAttrTypes})
+	}
+	if _, ok := apiResource.Spec["default_sensitive_data_policy"].(map[string]interface{}); ok && isImport && data.DefaultSensitiveDataPolicy == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DefaultSensitiveDataPolicy = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_api_definition"].(map[string]interface{}); ok && isImport && data.DisableAPIDefinition == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableAPIDefinition = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_api_discovery"].(map[string]interface{}); ok && isImport && data.DisableAPIDiscovery == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableAPIDiscovery = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_api_testing"].(map[string]interface{}); ok && isImport && data.DisableAPITesting == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableAPITesting = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_bot_defense"].(map[string]interface{}); ok && isImport && data.DisableBotDefense == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableBotDefense = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_caching"].(map[string]interface{}); ok && isImport && data.DisableCaching == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableCaching = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_client_side_defense"].(map[string]interface{}); ok && isImport && data.DisableClientSideDefense == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableClientSideDefense = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_ip_reputation"].(map[string]interface{}); ok && isImport && data.DisableIPReputation == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableIPReputation = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_malicious_user_detection"].(map[string]interface{}); ok && isImport && data.DisableMaliciousUserDetection == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableMaliciousUserDetection = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_malware_protection"].(map[string]interface{}); ok && isImport && data.DisableMalwareProtection == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableMalwareProtection = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_rate_limit"].(map[string]interface{}); ok && isImport && data.DisableRateLimit == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableRateLimit = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_threat_mesh"].(map[string]interface{}); ok && isImport && data.DisableThreatMesh == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableThreatMesh = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_trust_client_ip_headers"].(map[string]interface{}); ok && isImport && data.DisableTrustClientIPHeaders == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableTrustClientIPHeaders = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_waf"].(map[string]interface{}); ok && isImport && data.DisableWAF == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableWAF = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["do_not_advertise"].(map[string]interface{}); ok && isImport && data.DoNotAdvertise == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DoNotAdvertise = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["domains"].([]interface{}); ok && len(v) > 0 {
+		var domainsList []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				domainsList = append(domainsList, s)
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.StringType, domainsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.Domains = listVal
+		}
+	} else {
+		data.Domains = types.ListNull(types.StringType)
+	}
+	if _, ok := apiResource.Spec["enable_api_discovery"].(map[string]interface{}); ok && isImport && data.EnableAPIDiscovery == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.EnableAPIDiscovery = &HTTPLoadBalancerEnableAPIDiscoveryModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["enable_challenge"].(map[string]interface{}); ok && isImport && data.EnableChallenge == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.EnableChallenge = &HTTPLoadBalancerEnableChallengeModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["enable_ip_reputation"].(map[string]interface{}); ok && (isImport || data.EnableIPReputation != nil) {
+		data.EnableIPReputation = &HTTPLoadBalancerEnableIPReputationModel{
+			IPThreatCategories: func() types.List {
+				if v, ok := blockData["ip_threat_categories"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["enable_malicious_user_detection"].(map[string]interface{}); ok && isImport && data.EnableMaliciousUserDetection == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.EnableMaliciousUserDetection = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["enable_threat_mesh"].(map[string]interface{}); ok && isImport && data.EnableThreatMesh == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.EnableThreatMesh = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["enable_trust_client_ip_headers"].(map[string]interface{}); ok && (isImport || data.EnableTrustClientIPHeaders != nil) {
+		data.EnableTrustClientIPHeaders = &HTTPLoadBalancerEnableTrustClientIPHeadersModel{
+			ClientIPHeaders: func() types.List {
+				if v, ok := blockData["client_ip_headers"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if listData, ok := apiResource.Spec["graphql_rules"].([]interface{}); ok && len(listData) > 0 {
+		var graphql_rulesList []HTTPLoadBalancerGraphqlRulesModel
+		var existingGraphqlRulesItems []HTTPLoadBalancerGraphqlRulesModel
+		if !data.GraphqlRules.IsNull() && !data.GraphqlRules.IsUnknown() {
+			data.GraphqlRules.ElementsAs(ctx, &existingGraphqlRulesItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				graphql_rulesList = append(graphql_rulesList, HTTPLoadBalancerGraphqlRulesModel{
+					AnyDomain: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingGraphqlRulesItems) > listIdx && existingGraphqlRulesItems[listIdx].AnyDomain != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					ExactPath: func() types.String {
+						if v, ok := itemMap["exact_path"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					ExactValue: func() types.String {
+						if v, ok := itemMap["exact_value"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					GraphqlSettings: func() *HTTPLoadBalancerGraphqlRulesGraphqlSettingsModel {
+						if nestedMap, ok := itemMap["graphql_settings"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerGraphqlRulesGraphqlSettingsModel{
+								DisableIntrospection: func() *HTTPLoadBalancerEmptyModel {
+									if !isImport && len(existingGraphqlRulesItems) > listIdx && existingGraphqlRulesItems[listIdx].GraphqlSettings != nil && existingGraphqlRulesItems[listIdx].GraphqlSettings.DisableIntrospection != nil {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								EnableIntrospection: func() *HTTPLoadBalancerEmptyModel {
+									if !isImport && len(existingGraphqlRulesItems) > listIdx && existingGraphqlRulesItems[listIdx].GraphqlSettings != nil && existingGraphqlRulesItems[listIdx].GraphqlSettings.EnableIntrospection != nil {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								MaxBatchedQueries: func() types.Int64 {
+									if v, ok := nestedMap["max_batched_queries"].(float64); ok && v != 0 {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								MaxDepth: func() types.Int64 {
+									if v, ok := nestedMap["max_depth"].(float64); ok && v != 0 {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								MaxTotalLength: func() types.Int64 {
+									if v, ok := nestedMap["max_total_length"].(float64); ok && v != 0 {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					Metadata: func() *HTTPLoadBalancerGraphqlRulesMetadataModel {
+						if nestedMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerGraphqlRulesMetadataModel{
+								DescriptionSpec: func() types.String {
+									if v, ok := nestedMap["description"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := nestedMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					MethodGet: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingGraphqlRulesItems) > listIdx && existingGraphqlRulesItems[listIdx].MethodGet != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					MethodPost: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingGraphqlRulesItems) > listIdx && existingGraphqlRulesItems[listIdx].MethodPost != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					SuffixValue: func() types.String {
+						if v, ok := itemMap["suffix_value"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: HTTPLoadBalancerGraphqlRulesModelAttrTypes}, graphql_rulesList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.GraphqlRules = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.GraphqlRules = types.ListNull(types.ObjectType{AttrTypes: HTTPLoadBalancerGraphqlRulesModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["http"].(map[string]interface{}); ok && (isImport || data.HTTP != nil) {
+		data.HTTP = &HTTPLoadBalancerHTTPModel{
+			DNSVolterraManaged: func() types.Bool {
+				if !isImport && data.HTTP != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.HTTP.DNSVolterraManaged
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["dns_volterra_managed"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			Port: func() types.Int64 {
+				if !isImport && data.HTTP != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.HTTP.Port
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["port"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			PortRanges: func() types.String {
+				if v, ok := blockData["port_ranges"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["https"].(map[string]interface{}); ok && (isImport || data.HTTPS != nil) {
+		data.HTTPS = &HTTPLoadBalancerHTTPSModel{
+			AddHsts: func() types.Bool {
+				if !isImport && data.HTTPS != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.HTTPS.AddHsts
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["add_hsts"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			AppendServerName: func() types.String {
+				if v, ok := blockData["append_server_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			CoalescingOptions: func() *HTTPLoadBalancerHTTPSCoalescingOptionsModel {
+				if !isImport && data.HTTPS != nil && data.HTTPS.CoalescingOptions != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPS.CoalescingOptions
+				}
+				// Import case: read from API
+				if _, ok := blockData["coalescing_options"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerHTTPSCoalescingOptionsModel{}
+				}
+				return nil
+			}(),
+			ConnectionIdleTimeout: func() types.Int64 {
+				if !isImport && data.HTTPS != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.HTTPS.ConnectionIdleTimeout
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["connection_idle_timeout"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			DefaultHeader: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPS != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPS.DefaultHeader
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_header"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			DefaultLoadBalancer: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPS != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPS.DefaultLoadBalancer
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_loadbalancer"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			DisablePathNormalize: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPS != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPS.DisablePathNormalize
+				}
+				// Import case: read from API
+				if _, ok := blockData["disable_path_normalize"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			EnablePathNormalize: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPS != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPS.EnablePathNormalize
+				}
+				// Import case: read from API
+				if _, ok := blockData["enable_path_normalize"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			HTTPProtocolOptions: func() *HTTPLoadBalancerHTTPSHTTPProtocolOptionsModel {
+				if !isImport && data.HTTPS != nil && data.HTTPS.HTTPProtocolOptions != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPS.HTTPProtocolOptions
+				}
+				// Import case: read from API
+				if _, ok := blockData["http_protocol_options"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerHTTPSHTTPProtocolOptionsModel{}
+				}
+				return nil
+			}(),
+			HTTPRedirect: func() types.Bool {
+				if !isImport && data.HTTPS != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.HTTPS.HTTPRedirect
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["http_redirect"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			NonDefaultLoadBalancer: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPS != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPS.NonDefaultLoadBalancer
+				}
+				// Import case: read from API
+				if _, ok := blockData["non_default_loadbalancer"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			PassThrough: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPS != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPS.PassThrough
+				}
+				// Import case: read from API
+				if _, ok := blockData["pass_through"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			Port: func() types.Int64 {
+				if !isImport && data.HTTPS != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.HTTPS.Port
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["port"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			PortRanges: func() types.String {
+				if v, ok := blockData["port_ranges"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ServerName: func() types.String {
+				if v, ok := blockData["server_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			TLSCertParams: func() *HTTPLoadBalancerHTTPSTLSCertParamsModel {
+				if !isImport && data.HTTPS != nil && data.HTTPS.TLSCertParams != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPS.TLSCertParams
+				}
+				// Import case: read from API
+				if _, ok := blockData["tls_cert_params"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerHTTPSTLSCertParamsModel{}
+				}
+				return nil
+			}(),
+			TLSParameters: func() *HTTPLoadBalancerHTTPSTLSParametersModel {
+				if !isImport && data.HTTPS != nil && data.HTTPS.TLSParameters != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPS.TLSParameters
+				}
+				// Import case: read from API
+				if _, ok := blockData["tls_parameters"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerHTTPSTLSParametersModel{}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["https_auto_cert"].(map[string]interface{}); ok && (isImport || data.HTTPSAutoCert != nil) {
+		data.HTTPSAutoCert = &HTTPLoadBalancerHTTPSAutoCertModel{
+			AddHsts: func() types.Bool {
+				if !isImport && data.HTTPSAutoCert != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.HTTPSAutoCert.AddHsts
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["add_hsts"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			AppendServerName: func() types.String {
+				if v, ok := blockData["append_server_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			CoalescingOptions: func() *HTTPLoadBalancerHTTPSAutoCertCoalescingOptionsModel {
+				if !isImport && data.HTTPSAutoCert != nil && data.HTTPSAutoCert.CoalescingOptions != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPSAutoCert.CoalescingOptions
+				}
+				// Import case: read from API
+				if _, ok := blockData["coalescing_options"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerHTTPSAutoCertCoalescingOptionsModel{}
+				}
+				return nil
+			}(),
+			ConnectionIdleTimeout: func() types.Int64 {
+				if !isImport && data.HTTPSAutoCert != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.HTTPSAutoCert.ConnectionIdleTimeout
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["connection_idle_timeout"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			DefaultHeader: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPSAutoCert != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPSAutoCert.DefaultHeader
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_header"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			DefaultLoadBalancer: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPSAutoCert != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPSAutoCert.DefaultLoadBalancer
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_loadbalancer"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			DisablePathNormalize: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPSAutoCert != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPSAutoCert.DisablePathNormalize
+				}
+				// Import case: read from API
+				if _, ok := blockData["disable_path_normalize"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			EnablePathNormalize: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPSAutoCert != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPSAutoCert.EnablePathNormalize
+				}
+				// Import case: read from API
+				if _, ok := blockData["enable_path_normalize"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			HTTPProtocolOptions: func() *HTTPLoadBalancerHTTPSAutoCertHTTPProtocolOptionsModel {
+				if !isImport && data.HTTPSAutoCert != nil && data.HTTPSAutoCert.HTTPProtocolOptions != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPSAutoCert.HTTPProtocolOptions
+				}
+				// Import case: read from API
+				if _, ok := blockData["http_protocol_options"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerHTTPSAutoCertHTTPProtocolOptionsModel{}
+				}
+				return nil
+			}(),
+			HTTPRedirect: func() types.Bool {
+				if !isImport && data.HTTPSAutoCert != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.HTTPSAutoCert.HTTPRedirect
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["http_redirect"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			NoMtls: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPSAutoCert != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPSAutoCert.NoMtls
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_mtls"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			NonDefaultLoadBalancer: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPSAutoCert != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPSAutoCert.NonDefaultLoadBalancer
+				}
+				// Import case: read from API
+				if _, ok := blockData["non_default_loadbalancer"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			PassThrough: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.HTTPSAutoCert != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPSAutoCert.PassThrough
+				}
+				// Import case: read from API
+				if _, ok := blockData["pass_through"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			Port: func() types.Int64 {
+				if !isImport && data.HTTPSAutoCert != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.HTTPSAutoCert.Port
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["port"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			PortRanges: func() types.String {
+				if v, ok := blockData["port_ranges"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ServerName: func() types.String {
+				if v, ok := blockData["server_name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			TLSConfig: func() *HTTPLoadBalancerHTTPSAutoCertTLSConfigModel {
+				if !isImport && data.HTTPSAutoCert != nil && data.HTTPSAutoCert.TLSConfig != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPSAutoCert.TLSConfig
+				}
+				// Import case: read from API
+				if _, ok := blockData["tls_config"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerHTTPSAutoCertTLSConfigModel{}
+				}
+				return nil
+			}(),
+			UseMtls: func() *HTTPLoadBalancerHTTPSAutoCertUseMtlsModel {
+				if !isImport && data.HTTPSAutoCert != nil && data.HTTPSAutoCert.UseMtls != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPSAutoCert.UseMtls
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["use_mtls"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerHTTPSAutoCertUseMtlsModel{
+						ClientCertificateOptional: func() types.Bool {
+							if v, ok := nestedBlockData["client_certificate_optional"].(bool); ok {
+								return types.BoolValue(v)
+							}
+							return types.BoolNull()
+						}(),
+						TrustedCAURL: func() types.String {
+							if v, ok := nestedBlockData["trusted_ca_url"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["js_challenge"].(map[string]interface{}); ok && (isImport || data.JsChallenge != nil) {
+		data.JsChallenge = &HTTPLoadBalancerJsChallengeModel{
+			CookieExpiry: func() types.Int64 {
+				if !isImport && data.JsChallenge != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.JsChallenge.CookieExpiry
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["cookie_expiry"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			CustomPage: func() types.String {
+				if v, ok := blockData["custom_page"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			JsScriptDelay: func() types.Int64 {
+				if !isImport && data.JsChallenge != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.JsChallenge.JsScriptDelay
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["js_script_delay"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["jwt_validation"].(map[string]interface{}); ok && isImport && data.JWTValidation == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.JWTValidation = &HTTPLoadBalancerJWTValidationModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["l7_ddos_action_block"].(map[string]interface{}); ok && isImport && data.L7DDOSActionBlock == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.L7DDOSActionBlock = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["l7_ddos_action_default"].(map[string]interface{}); ok && isImport && data.L7DDOSActionDefault == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.L7DDOSActionDefault = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["l7_ddos_action_js_challenge"].(map[string]interface{}); ok && (isImport || data.L7DDOSActionJsChallenge != nil) {
+		data.L7DDOSActionJsChallenge = &HTTPLoadBalancerL7DDOSActionJsChallengeModel{
+			CookieExpiry: func() types.Int64 {
+				if !isImport && data.L7DDOSActionJsChallenge != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.L7DDOSActionJsChallenge.CookieExpiry
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["cookie_expiry"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			CustomPage: func() types.String {
+				if v, ok := blockData["custom_page"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			JsScriptDelay: func() types.Int64 {
+				if !isImport && data.L7DDOSActionJsChallenge != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.L7DDOSActionJsChallenge.JsScriptDelay
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["js_script_delay"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["l7_ddos_protection"].(map[string]interface{}); ok && (isImport || data.L7DDOSProtection != nil) {
+		data.L7DDOSProtection = &HTTPLoadBalancerL7DDOSProtectionModel{
+			ClientsideActionCaptchaChallenge: func() *HTTPLoadBalancerL7DDOSProtectionClientsideActionCaptchaChallengeModel {
+				if !isImport && data.L7DDOSProtection != nil && data.L7DDOSProtection.ClientsideActionCaptchaChallenge != nil {
+					// Normal Read: preserve existing state value
+					return data.L7DDOSProtection.ClientsideActionCaptchaChallenge
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["clientside_action_captcha_challenge"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerL7DDOSProtectionClientsideActionCaptchaChallengeModel{
+						CookieExpiry: func() types.Int64 {
+							if v, ok := nestedBlockData["cookie_expiry"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						CustomPage: func() types.String {
+							if v, ok := nestedBlockData["custom_page"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			ClientsideActionJsChallenge: func() *HTTPLoadBalancerL7DDOSProtectionClientsideActionJsChallengeModel {
+				if !isImport && data.L7DDOSProtection != nil && data.L7DDOSProtection.ClientsideActionJsChallenge != nil {
+					// Normal Read: preserve existing state value
+					return data.L7DDOSProtection.ClientsideActionJsChallenge
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["clientside_action_js_challenge"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerL7DDOSProtectionClientsideActionJsChallengeModel{
+						CookieExpiry: func() types.Int64 {
+							if v, ok := nestedBlockData["cookie_expiry"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						CustomPage: func() types.String {
+							if v, ok := nestedBlockData["custom_page"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						JsScriptDelay: func() types.Int64 {
+							if v, ok := nestedBlockData["js_script_delay"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			ClientsideActionNone: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.L7DDOSProtection != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.L7DDOSProtection.ClientsideActionNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["clientside_action_none"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			DDOSPolicyCustom: func() *HTTPLoadBalancerL7DDOSProtectionDDOSPolicyCustomModel {
+				if !isImport && data.L7DDOSProtection != nil && data.L7DDOSProtection.DDOSPolicyCustom != nil {
+					// Normal Read: preserve existing state value
+					return data.L7DDOSProtection.DDOSPolicyCustom
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["ddos_policy_custom"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerL7DDOSProtectionDDOSPolicyCustomModel{
+						Name: func() types.String {
+							if v, ok := nestedBlockData["name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Namespace: func() types.String {
+							if v, ok := nestedBlockData["namespace"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Tenant: func() types.String {
+							if v, ok := nestedBlockData["tenant"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			DDOSPolicyNone: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.L7DDOSProtection != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.L7DDOSProtection.DDOSPolicyNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["ddos_policy_none"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			DefaultRpsThreshold: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.L7DDOSProtection != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.L7DDOSProtection.DefaultRpsThreshold
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_rps_threshold"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			MitigationBlock: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.L7DDOSProtection != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.L7DDOSProtection.MitigationBlock
+				}
+				// Import case: read from API
+				if _, ok := blockData["mitigation_block"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			MitigationCaptchaChallenge: func() *HTTPLoadBalancerL7DDOSProtectionMitigationCaptchaChallengeModel {
+				if !isImport && data.L7DDOSProtection != nil && data.L7DDOSProtection.MitigationCaptchaChallenge != nil {
+					// Normal Read: preserve existing state value
+					return data.L7DDOSProtection.MitigationCaptchaChallenge
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["mitigation_captcha_challenge"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerL7DDOSProtectionMitigationCaptchaChallengeModel{
+						CookieExpiry: func() types.Int64 {
+							if v, ok := nestedBlockData["cookie_expiry"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						CustomPage: func() types.String {
+							if v, ok := nestedBlockData["custom_page"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			MitigationJsChallenge: func() *HTTPLoadBalancerL7DDOSProtectionMitigationJsChallengeModel {
+				if !isImport && data.L7DDOSProtection != nil && data.L7DDOSProtection.MitigationJsChallenge != nil {
+					// Normal Read: preserve existing state value
+					return data.L7DDOSProtection.MitigationJsChallenge
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["mitigation_js_challenge"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerL7DDOSProtectionMitigationJsChallengeModel{
+						CookieExpiry: func() types.Int64 {
+							if v, ok := nestedBlockData["cookie_expiry"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						CustomPage: func() types.String {
+							if v, ok := nestedBlockData["custom_page"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						JsScriptDelay: func() types.Int64 {
+							if v, ok := nestedBlockData["js_script_delay"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			RpsThreshold: func() types.Int64 {
+				if !isImport && data.L7DDOSProtection != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.L7DDOSProtection.RpsThreshold
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["rps_threshold"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["least_active"].(map[string]interface{}); ok && isImport && data.LeastActive == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.LeastActive = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["malware_protection_settings"].(map[string]interface{}); ok && (isImport || data.MalwareProtectionSettings != nil) {
+		data.MalwareProtectionSettings = &HTTPLoadBalancerMalwareProtectionSettingsModel{
+			MalwareProtectionRules: func() []HTTPLoadBalancerMalwareProtectionSettingsMalwareProtectionRulesModel {
+				if listData, ok := blockData["malware_protection_rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerMalwareProtectionSettingsMalwareProtectionRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerMalwareProtectionSettingsMalwareProtectionRulesModel{
+								Action: func() *HTTPLoadBalancerMalwareProtectionSettingsMalwareProtectionRulesActionModel {
+									if deepMap, ok := itemMap["action"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerMalwareProtectionSettingsMalwareProtectionRulesActionModel{
+											Block: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["block"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											Report: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["report"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Domain: func() *HTTPLoadBalancerMalwareProtectionSettingsMalwareProtectionRulesDomainModel {
+									if deepMap, ok := itemMap["domain"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerMalwareProtectionSettingsMalwareProtectionRulesDomainModel{
+											AnyDomain: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["any_domain"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Metadata: func() *HTTPLoadBalancerMalwareProtectionSettingsMalwareProtectionRulesMetadataModel {
+									if deepMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerMalwareProtectionSettingsMalwareProtectionRulesMetadataModel{
+											DescriptionSpec: func() types.String {
+												if v, ok := deepMap["description"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Path: func() *HTTPLoadBalancerMalwareProtectionSettingsMalwareProtectionRulesPathModel {
+									if deepMap, ok := itemMap["path"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerMalwareProtectionSettingsMalwareProtectionRulesPathModel{
+											Path: func() types.String {
+												if v, ok := deepMap["path"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Prefix: func() types.String {
+												if v, ok := deepMap["prefix"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Regex: func() types.String {
+												if v, ok := deepMap["regex"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["more_option"].(map[string]interface{}); ok && (isImport || data.MoreOption != nil) {
+		data.MoreOption = &HTTPLoadBalancerMoreOptionModel{
+			BufferPolicy: func() *HTTPLoadBalancerMoreOptionBufferPolicyModel {
+				if !isImport && data.MoreOption != nil && data.MoreOption.BufferPolicy != nil {
+					// Normal Read: preserve existing state value
+					return data.MoreOption.BufferPolicy
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["buffer_policy"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerMoreOptionBufferPolicyModel{
+						Disabled: func() types.Bool {
+							if v, ok := nestedBlockData["disabled"].(bool); ok {
+								return types.BoolValue(v)
+							}
+							return types.BoolNull()
+						}(),
+						MaxRequestBytes: func() types.Int64 {
+							if v, ok := nestedBlockData["max_request_bytes"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			CompressionParams: func() *HTTPLoadBalancerMoreOptionCompressionParamsModel {
+				if !isImport && data.MoreOption != nil && data.MoreOption.CompressionParams != nil {
+					// Normal Read: preserve existing state value
+					return data.MoreOption.CompressionParams
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["compression_params"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerMoreOptionCompressionParamsModel{
+						ContentLength: func() types.Int64 {
+							if v, ok := nestedBlockData["content_length"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						ContentType: func() types.List {
+							if v, ok := nestedBlockData["content_type"].([]interface{}); ok && len(v) > 0 {
+								var items []string
+								for _, item := range v {
+									if s, ok := item.(string); ok {
+										items = append(items, s)
+									}
+								}
+								listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+								return listVal
+							}
+							return types.ListNull(types.StringType)
+						}(),
+						DisableOnEtagHeader: func() types.Bool {
+							if v, ok := nestedBlockData["disable_on_etag_header"].(bool); ok {
+								return types.BoolValue(v)
+							}
+							return types.BoolNull()
+						}(),
+						RemoveAcceptEncodingHeader: func() types.Bool {
+							if v, ok := nestedBlockData["remove_accept_encoding_header"].(bool); ok {
+								return types.BoolValue(v)
+							}
+							return types.BoolNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			CustomErrors: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.MoreOption != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.MoreOption.CustomErrors
+				}
+				// Import case: read from API
+				if _, ok := blockData["custom_errors"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			DisableDefaultErrorPages: func() types.Bool {
+				if !isImport && data.MoreOption != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.MoreOption.DisableDefaultErrorPages
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["disable_default_error_pages"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			DisablePathNormalize: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.MoreOption != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.MoreOption.DisablePathNormalize
+				}
+				// Import case: read from API
+				if _, ok := blockData["disable_path_normalize"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			EnablePathNormalize: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.MoreOption != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.MoreOption.EnablePathNormalize
+				}
+				// Import case: read from API
+				if _, ok := blockData["enable_path_normalize"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			IdleTimeout: func() types.Int64 {
+				if !isImport && data.MoreOption != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.MoreOption.IdleTimeout
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["idle_timeout"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxRequestHeaderSize: func() types.Int64 {
+				if !isImport && data.MoreOption != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.MoreOption.MaxRequestHeaderSize
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_request_header_size"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			RequestCookiesToAdd: func() []HTTPLoadBalancerMoreOptionRequestCookiesToAddModel {
+				if listData, ok := blockData["request_cookies_to_add"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerMoreOptionRequestCookiesToAddModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerMoreOptionRequestCookiesToAddModel{
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Overwrite: func() types.Bool {
+									if v, ok := itemMap["overwrite"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								SecretValue: func() *HTTPLoadBalancerMoreOptionRequestCookiesToAddSecretValueModel {
+									if _, ok := itemMap["secret_value"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerMoreOptionRequestCookiesToAddSecretValueModel{}
+									}
+									return nil
+								}(),
+								Value: func() types.String {
+									if v, ok := itemMap["value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			RequestCookiesToRemove: func() types.List {
+				if v, ok := blockData["request_cookies_to_remove"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			RequestHeadersToAdd: func() []HTTPLoadBalancerMoreOptionRequestHeadersToAddModel {
+				if listData, ok := blockData["request_headers_to_add"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerMoreOptionRequestHeadersToAddModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerMoreOptionRequestHeadersToAddModel{
+								Append: func() types.Bool {
+									if v, ok := itemMap["append"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								SecretValue: func() *HTTPLoadBalancerMoreOptionRequestHeadersToAddSecretValueModel {
+									if _, ok := itemMap["secret_value"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerMoreOptionRequestHeadersToAddSecretValueModel{}
+									}
+									return nil
+								}(),
+								Value: func() types.String {
+									if v, ok := itemMap["value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			RequestHeadersToRemove: func() types.List {
+				if v, ok := blockData["request_headers_to_remove"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			ResponseCookiesToAdd: func() []HTTPLoadBalancerMoreOptionResponseCookiesToAddModel {
+				if listData, ok := blockData["response_cookies_to_add"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerMoreOptionResponseCookiesToAddModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerMoreOptionResponseCookiesToAddModel{
+								AddDomain: func() types.String {
+									if v, ok := itemMap["add_domain"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								AddExpiry: func() types.String {
+									if v, ok := itemMap["add_expiry"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								AddHttponly: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["add_httponly"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								AddPartitioned: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["add_partitioned"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								AddPath: func() types.String {
+									if v, ok := itemMap["add_path"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								AddSecure: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["add_secure"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								IgnoreDomain: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["ignore_domain"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								IgnoreExpiry: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["ignore_expiry"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								IgnoreHttponly: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["ignore_httponly"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								IgnoreMaxAge: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["ignore_max_age"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								IgnorePartitioned: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["ignore_partitioned"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								IgnorePath: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["ignore_path"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								IgnoreSamesite: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["ignore_samesite"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								IgnoreSecure: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["ignore_secure"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								IgnoreValue: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["ignore_value"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								MaxAgeValue: func() types.Int64 {
+									if v, ok := itemMap["max_age_value"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Overwrite: func() types.Bool {
+									if v, ok := itemMap["overwrite"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								SamesiteLax: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["samesite_lax"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								SamesiteNone: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["samesite_none"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								SamesiteStrict: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["samesite_strict"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								SecretValue: func() *HTTPLoadBalancerMoreOptionResponseCookiesToAddSecretValueModel {
+									if _, ok := itemMap["secret_value"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerMoreOptionResponseCookiesToAddSecretValueModel{}
+									}
+									return nil
+								}(),
+								Value: func() types.String {
+									if v, ok := itemMap["value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			ResponseCookiesToRemove: func() types.List {
+				if v, ok := blockData["response_cookies_to_remove"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			ResponseHeadersToAdd: func() []HTTPLoadBalancerMoreOptionResponseHeadersToAddModel {
+				if listData, ok := blockData["response_headers_to_add"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerMoreOptionResponseHeadersToAddModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerMoreOptionResponseHeadersToAddModel{
+								Append: func() types.Bool {
+									if v, ok := itemMap["append"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								SecretValue: func() *HTTPLoadBalancerMoreOptionResponseHeadersToAddSecretValueModel {
+									if _, ok := itemMap["secret_value"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerMoreOptionResponseHeadersToAddSecretValueModel{}
+									}
+									return nil
+								}(),
+								Value: func() types.String {
+									if v, ok := itemMap["value"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			ResponseHeadersToRemove: func() types.List {
+				if v, ok := blockData["response_headers_to_remove"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["multi_lb_app"].(map[string]interface{}); ok && isImport && data.MultiLBApp == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.MultiLBApp = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["no_challenge"].(map[string]interface{}); ok && isImport && data.NoChallenge == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoChallenge = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["no_service_policies"].(map[string]interface{}); ok && isImport && data.NoServicePolicies == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoServicePolicies = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["origin_server_subset_rule_list"].(map[string]interface{}); ok && (isImport || data.OriginServerSubsetRuleList != nil) {
+		data.OriginServerSubsetRuleList = &HTTPLoadBalancerOriginServerSubsetRuleListModel{
+			OriginServerSubsetRules: func() []HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesModel {
+				if listData, ok := blockData["origin_server_subset_rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesModel{
+								AnyAsn: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["any_asn"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								AnyIP: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["any_ip"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								AsnList: func() *HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesAsnListModel {
+									if _, ok := itemMap["asn_list"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesAsnListModel{}
+									}
+									return nil
+								}(),
+								AsnMatcher: func() *HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesAsnMatcherModel {
+									if _, ok := itemMap["asn_matcher"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesAsnMatcherModel{}
+									}
+									return nil
+								}(),
+								ClientSelector: func() *HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesClientSelectorModel {
+									if _, ok := itemMap["client_selector"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesClientSelectorModel{}
+									}
+									return nil
+								}(),
+								IPMatcher: func() *HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesIPMatcherModel {
+									if deepMap, ok := itemMap["ip_matcher"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesIPMatcherModel{
+											InvertMatcher: func() types.Bool {
+												if v, ok := deepMap["invert_matcher"].(bool); ok {
+													return types.BoolValue(v)
+												}
+												return types.BoolNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								IPPrefixList: func() *HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesIPPrefixListModel {
+									if deepMap, ok := itemMap["ip_prefix_list"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesIPPrefixListModel{
+											InvertMatch: func() types.Bool {
+												if v, ok := deepMap["invert_match"].(bool); ok {
+													return types.BoolValue(v)
+												}
+												return types.BoolNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Metadata: func() *HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesMetadataModel {
+									if deepMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerOriginServerSubsetRuleListOriginServerSubsetRulesMetadataModel{
+											DescriptionSpec: func() types.String {
+												if v, ok := deepMap["description"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								None: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["none"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								OriginServerSubsetsAction: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["origin_server_subsets_action"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["policy_based_challenge"].(map[string]interface{}); ok && isImport && data.PolicyBasedChallenge == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.PolicyBasedChallenge = &HTTPLoadBalancerPolicyBasedChallengeModel{}
+	}
+	// Normal Read: preserve existing state value
+	if listData, ok := apiResource.Spec["protected_cookies"].([]interface{}); ok && len(listData) > 0 {
+		var protected_cookiesList []HTTPLoadBalancerProtectedCookiesModel
+		var existingProtectedCookiesItems []HTTPLoadBalancerProtectedCookiesModel
+		if !data.ProtectedCookies.IsNull() && !data.ProtectedCookies.IsUnknown() {
+			data.ProtectedCookies.ElementsAs(ctx, &existingProtectedCookiesItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				protected_cookiesList = append(protected_cookiesList, HTTPLoadBalancerProtectedCookiesModel{
+					AddHttponly: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingProtectedCookiesItems) > listIdx && existingProtectedCookiesItems[listIdx].AddHttponly != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					AddSecure: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingProtectedCookiesItems) > listIdx && existingProtectedCookiesItems[listIdx].AddSecure != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					DisableTamperingProtection: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingProtectedCookiesItems) > listIdx && existingProtectedCookiesItems[listIdx].DisableTamperingProtection != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					EnableTamperingProtection: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingProtectedCookiesItems) > listIdx && existingProtectedCookiesItems[listIdx].EnableTamperingProtection != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnoreHttponly: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingProtectedCookiesItems) > listIdx && existingProtectedCookiesItems[listIdx].IgnoreHttponly != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnoreMaxAge: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingProtectedCookiesItems) > listIdx && existingProtectedCookiesItems[listIdx].IgnoreMaxAge != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnoreSamesite: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingProtectedCookiesItems) > listIdx && existingProtectedCookiesItems[listIdx].IgnoreSamesite != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnoreSecure: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingProtectedCookiesItems) > listIdx && existingProtectedCookiesItems[listIdx].IgnoreSecure != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					MaxAgeValue: func() types.Int64 {
+						if v, ok := itemMap["max_age_value"].(float64); ok && v != 0 {
+							return types.Int64Value(int64(v))
+						}
+						return types.Int64Null()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					SamesiteLax: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingProtectedCookiesItems) > listIdx && existingProtectedCookiesItems[listIdx].SamesiteLax != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					SamesiteNone: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingProtectedCookiesItems) > listIdx && existingProtectedCookiesItems[listIdx].SamesiteNone != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					SamesiteStrict: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingProtectedCookiesItems) > listIdx && existingProtectedCookiesItems[listIdx].SamesiteStrict != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: HTTPLoadBalancerProtectedCookiesModelAttrTypes}, protected_cookiesList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.ProtectedCookies = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.ProtectedCookies = types.ListNull(types.ObjectType{AttrTypes: HTTPLoadBalancerProtectedCookiesModelAttrTypes})
+	}
+	if _, ok := apiResource.Spec["random"].(map[string]interface{}); ok && isImport && data.Random == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Random = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["rate_limit"].(map[string]interface{}); ok && isImport && data.RateLimit == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.RateLimit = &HTTPLoadBalancerRateLimitModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["ring_hash"].(map[string]interface{}); ok && (isImport || data.RingHash != nil) {
+		data.RingHash = &HTTPLoadBalancerRingHashModel{
+			HashPolicy: func() []HTTPLoadBalancerRingHashHashPolicyModel {
+				if listData, ok := blockData["hash_policy"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerRingHashHashPolicyModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerRingHashHashPolicyModel{
+								Cookie: func() *HTTPLoadBalancerRingHashHashPolicyCookieModel {
+									if deepMap, ok := itemMap["cookie"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerRingHashHashPolicyCookieModel{
+											AddHttponly: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["add_httponly"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											AddSecure: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["add_secure"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											IgnoreHttponly: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["ignore_httponly"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											IgnoreSamesite: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["ignore_samesite"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											IgnoreSecure: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["ignore_secure"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Path: func() types.String {
+												if v, ok := deepMap["path"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											SamesiteLax: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["samesite_lax"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											SamesiteNone: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["samesite_none"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											SamesiteStrict: func() *HTTPLoadBalancerEmptyModel {
+												if _, ok := deepMap["samesite_strict"].(map[string]interface{}); ok {
+													return &HTTPLoadBalancerEmptyModel{}
+												}
+												return nil
+											}(),
+											TTL: func() types.Int64 {
+												if v, ok := deepMap["ttl"].(float64); ok {
+													return types.Int64Value(int64(v))
+												}
+												return types.Int64Null()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								HeaderName: func() types.String {
+									if v, ok := itemMap["header_name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								SourceIP: func() types.Bool {
+									if v, ok := itemMap["source_ip"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								Terminal: func() types.Bool {
+									if v, ok := itemMap["terminal"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["round_robin"].(map[string]interface{}); ok && isImport && data.RoundRobin == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.RoundRobin = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if listData, ok := apiResource.Spec["routes"].([]interface{}); ok && len(listData) > 0 {
+		var routesList []HTTPLoadBalancerRoutesModel
+		var existingRoutesItems []HTTPLoadBalancerRoutesModel
+		if !data.Routes.IsNull() && !data.Routes.IsUnknown() {
+			data.Routes.ElementsAs(ctx, &existingRoutesItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				routesList = append(routesList, HTTPLoadBalancerRoutesModel{
+					CustomRouteObject: func() *HTTPLoadBalancerRoutesCustomRouteObjectModel {
+						if _, ok := itemMap["custom_route_object"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerRoutesCustomRouteObjectModel{}
+						}
+						return nil
+					}(),
+					DirectResponseRoute: func() *HTTPLoadBalancerRoutesDirectResponseRouteModel {
+						if nestedMap, ok := itemMap["direct_response_route"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerRoutesDirectResponseRouteModel{
+								HTTPMethod: func() types.String {
+									if v, ok := nestedMap["http_method"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					RedirectRoute: func() *HTTPLoadBalancerRoutesRedirectRouteModel {
+						if nestedMap, ok := itemMap["redirect_route"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerRoutesRedirectRouteModel{
+								HTTPMethod: func() types.String {
+									if v, ok := nestedMap["http_method"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					SimpleRoute: func() *HTTPLoadBalancerRoutesSimpleRouteModel {
+						if nestedMap, ok := itemMap["simple_route"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerRoutesSimpleRouteModel{
+								AutoHostRewrite: func() *HTTPLoadBalancerEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].SimpleRoute != nil && existingRoutesItems[listIdx].SimpleRoute.AutoHostRewrite != nil {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								DisableHostRewrite: func() *HTTPLoadBalancerEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].SimpleRoute != nil && existingRoutesItems[listIdx].SimpleRoute.DisableHostRewrite != nil {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								HostRewrite: func() types.String {
+									if v, ok := nestedMap["host_rewrite"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								HTTPMethod: func() types.String {
+									if v, ok := nestedMap["http_method"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: HTTPLoadBalancerRoutesModelAttrTypes}, routesList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.Routes = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.Routes = types.ListNull(types.ObjectType{AttrTypes: HTTPLoadBalancerRoutesModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["sensitive_data_disclosure_rules"].(map[string]interface{}); ok && (isImport || data.SensitiveDataDisclosureRules != nil) {
+		data.SensitiveDataDisclosureRules = &HTTPLoadBalancerSensitiveDataDisclosureRulesModel{
+			SensitiveDataTypesInResponse: func() []HTTPLoadBalancerSensitiveDataDisclosureRulesSensitiveDataTypesInResponseModel {
+				if listData, ok := blockData["sensitive_data_types_in_response"].([]interface{}); ok && len(listData) > 0 {
+					var result []HTTPLoadBalancerSensitiveDataDisclosureRulesSensitiveDataTypesInResponseModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, HTTPLoadBalancerSensitiveDataDisclosureRulesSensitiveDataTypesInResponseModel{
+								APIEndpoint: func() *HTTPLoadBalancerSensitiveDataDisclosureRulesSensitiveDataTypesInResponseAPIEndpointModel {
+									if deepMap, ok := itemMap["api_endpoint"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerSensitiveDataDisclosureRulesSensitiveDataTypesInResponseAPIEndpointModel{
+											Path: func() types.String {
+												if v, ok := deepMap["path"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								Body: func() *HTTPLoadBalancerSensitiveDataDisclosureRulesSensitiveDataTypesInResponseBodyModel {
+									if _, ok := itemMap["body"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerSensitiveDataDisclosureRulesSensitiveDataTypesInResponseBodyModel{}
+									}
+									return nil
+								}(),
+								Mask: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["mask"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+								Report: func() *HTTPLoadBalancerEmptyModel {
+									if _, ok := itemMap["report"].(map[string]interface{}); ok {
+										return &HTTPLoadBalancerEmptyModel{}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["sensitive_data_policy"].(map[string]interface{}); ok && isImport && data.SensitiveDataPolicy == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SensitiveDataPolicy = &HTTPLoadBalancerSensitiveDataPolicyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["service_policies_from_namespace"].(map[string]interface{}); ok && isImport && data.ServicePoliciesFromNamespace == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.ServicePoliciesFromNamespace = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["single_lb_app"].(map[string]interface{}); ok && isImport && data.SingleLBApp == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SingleLBApp = &HTTPLoadBalancerSingleLBAppModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["slow_ddos_mitigation"].(map[string]interface{}); ok && (isImport || data.SlowDDOSMitigation != nil) {
+		data.SlowDDOSMitigation = &HTTPLoadBalancerSlowDDOSMitigationModel{
+			DisableRequestTimeout: func() *HTTPLoadBalancerEmptyModel {
+				if !isImport && data.SlowDDOSMitigation != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.SlowDDOSMitigation.DisableRequestTimeout
+				}
+				// Import case: read from API
+				if _, ok := blockData["disable_request_timeout"].(map[string]interface{}); ok {
+					return &HTTPLoadBalancerEmptyModel{}
+				}
+				return nil
+			}(),
+			RequestHeadersTimeout: func() types.Int64 {
+				if !isImport && data.SlowDDOSMitigation != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.SlowDDOSMitigation.RequestHeadersTimeout
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["request_headers_timeout"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			RequestTimeout: func() types.Int64 {
+				if !isImport && data.SlowDDOSMitigation != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.SlowDDOSMitigation.RequestTimeout
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["request_timeout"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["source_ip_stickiness"].(map[string]interface{}); ok && isImport && data.SourceIPStickiness == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SourceIPStickiness = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["system_default_timeouts"].(map[string]interface{}); ok && isImport && data.SystemDefaultTimeouts == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SystemDefaultTimeouts = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if listData, ok := apiResource.Spec["trusted_clients"].([]interface{}); ok && len(listData) > 0 {
+		var trusted_clientsList []HTTPLoadBalancerTrustedClientsModel
+		var existingTrustedClientsItems []HTTPLoadBalancerTrustedClientsModel
+		if !data.TrustedClients.IsNull() && !data.TrustedClients.IsUnknown() {
+			data.TrustedClients.ElementsAs(ctx, &existingTrustedClientsItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				trusted_clientsList = append(trusted_clientsList, HTTPLoadBalancerTrustedClientsModel{
+					Actions: func() types.List {
+						if v, ok := itemMap["actions"].([]interface{}); ok && len(v) > 0 {
+							var items []string
+							for _, item := range v {
+								if s, ok := item.(string); ok {
+									items = append(items, s)
+								}
+							}
+							listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+							return listVal
+						}
+						return types.ListNull(types.StringType)
+					}(),
+					AsNumber: func() types.Int64 {
+						if v, ok := itemMap["as_number"].(float64); ok && v != 0 {
+							return types.Int64Value(int64(v))
+						}
+						return types.Int64Null()
+					}(),
+					BotSkipProcessing: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingTrustedClientsItems) > listIdx && existingTrustedClientsItems[listIdx].BotSkipProcessing != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					ExpirationTimestamp: func() types.String {
+						if v, ok := itemMap["expiration_timestamp"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					HTTPHeader: func() *HTTPLoadBalancerTrustedClientsHTTPHeaderModel {
+						if _, ok := itemMap["http_header"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerTrustedClientsHTTPHeaderModel{}
+						}
+						return nil
+					}(),
+					IPPrefix: func() types.String {
+						if v, ok := itemMap["ip_prefix"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Ipv6Prefix: func() types.String {
+						if v, ok := itemMap["ipv6_prefix"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Metadata: func() *HTTPLoadBalancerTrustedClientsMetadataModel {
+						if nestedMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+							return &HTTPLoadBalancerTrustedClientsMetadataModel{
+								DescriptionSpec: func() types.String {
+									if v, ok := nestedMap["description"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := nestedMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					SkipProcessing: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingTrustedClientsItems) > listIdx && existingTrustedClientsItems[listIdx].SkipProcessing != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+					UserIdentifier: func() types.String {
+						if v, ok := itemMap["user_identifier"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					WAFSkipProcessing: func() *HTTPLoadBalancerEmptyModel {
+						if !isImport && len(existingTrustedClientsItems) > listIdx && existingTrustedClientsItems[listIdx].WAFSkipProcessing != nil {
+							return &HTTPLoadBalancerEmptyModel{}
+						}
+						return nil
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: HTTPLoadBalancerTrustedClientsModelAttrTypes}, trusted_clientsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.TrustedClients = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.TrustedClients = types.ListNull(types.ObjectType{AttrTypes: HTTPLoadBalancerTrustedClientsModelAttrTypes})
+	}
+	if _, ok := apiResource.Spec["user_id_client_ip"].(map[string]interface{}); ok && isImport && data.UserIDClientIP == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.UserIDClientIP = &HTTPLoadBalancerEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["user_identification"].(map[string]interface{}); ok && (isImport || data.UserIdentification != nil) {
+		data.UserIdentification = &HTTPLoadBalancerUserIdentificationModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["waf_exclusion"].(map[string]interface{}); ok && isImport && data.WAFExclusion == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.WAFExclusion = &HTTPLoadBalancerWAFExclusionModel{}
+	}
+	// Normal Read: preserve existing state value
+	// Top-level Optional bool: preserve prior state to avoid API default drift
+	if !isImport && !data.AddLocation.IsNull() && !data.AddLocation.IsUnknown() {
+		// Normal Read: preserve existing state value (do nothing)
+	} else {
+		// Import case, null state, or unknown (after Create): read from API
+		if v, ok := apiResource.Spec["add_location"].(bool); ok {
+			data.AddLocation = types.BoolValue(v)
+		} else {
+			data.AddLocation = types.BoolNull()
+		}
+	}
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewHTTPLoadBalancerResource(), &data)...)
 }
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,17 +23,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &HTTPLoadBalancerResource{}
-	_ resource.ResourceWithConfigure      = &HTTPLoadBalancerResource{}
-	_ resource.ResourceWithImportState    = &HTTPLoadBalancerResource{}
-	_ resource.ResourceWithModifyPlan     = &HTTPLoadBalancerResource{}
-	_ resource.ResourceWithValidateConfig = &HTTPLoadBalancerResource{}
+	_ resource.Resource                     = &HTTPLoadBalancerResource{}
+	_ resource.ResourceWithConfigure        = &HTTPLoadBalancerResource{}
+	_ resource.ResourceWithImportState      = &HTTPLoadBalancerResource{}
+	_ resource.ResourceWithIdentity         = &HTTPLoadBalancerResource{}
+	_ resource.ResourceWithMoveState        = &HTTPLoadBalancerResource{}
+	_ resource.ResourceWithModifyPlan       = &HTTPLoadBalancerResource{}
+	_ resource.ResourceWithValidateConfig   = &HTTPLoadBalancerResource{}
+	_ resource.ResourceWithConfigValidators = &HTTPLoadBalancerResource{}
+	_ listableResource                      = &HTTPLoadBalancerResource{}
 )
 
 func NewHTTPLoadBalancerResource() resource.Resource {
@@ -2604,14 +2611,16 @@ var HTTPLoadBalancerAPITestingDomainsCredentialsAPIKeyValueBlindfoldSecretInfoMo
 
 // HTTPLoadBalancerAPITestingDomainsCredentialsAPIKeyValueClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerAPITestingDomainsCredentialsAPIKeyValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerAPITestingDomainsCredentialsAPIKeyValueClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerAPITestingDomainsCredentialsAPIKeyValueClearSecretInfoModel
 var HTTPLoadBalancerAPITestingDomainsCredentialsAPIKeyValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerAPITestingDomainsCredentialsBasicAuthModel represents basic_auth block
@@ -2654,14 +2663,16 @@ var HTTPLoadBalancerAPITestingDomainsCredentialsBasicAuthPasswordBlindfoldSecret
 
 // HTTPLoadBalancerAPITestingDomainsCredentialsBasicAuthPasswordClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerAPITestingDomainsCredentialsBasicAuthPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerAPITestingDomainsCredentialsBasicAuthPasswordClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerAPITestingDomainsCredentialsBasicAuthPasswordClearSecretInfoModel
 var HTTPLoadBalancerAPITestingDomainsCredentialsBasicAuthPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerAPITestingDomainsCredentialsBearerTokenModel represents bearer_token block
@@ -2702,14 +2713,16 @@ var HTTPLoadBalancerAPITestingDomainsCredentialsBearerTokenTokenBlindfoldSecretI
 
 // HTTPLoadBalancerAPITestingDomainsCredentialsBearerTokenTokenClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerAPITestingDomainsCredentialsBearerTokenTokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerAPITestingDomainsCredentialsBearerTokenTokenClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerAPITestingDomainsCredentialsBearerTokenTokenClearSecretInfoModel
 var HTTPLoadBalancerAPITestingDomainsCredentialsBearerTokenTokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerAPITestingDomainsCredentialsLoginEndpointModel represents login_endpoint block
@@ -2756,14 +2769,16 @@ var HTTPLoadBalancerAPITestingDomainsCredentialsLoginEndpointJSONPayloadBlindfol
 
 // HTTPLoadBalancerAPITestingDomainsCredentialsLoginEndpointJSONPayloadClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerAPITestingDomainsCredentialsLoginEndpointJSONPayloadClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerAPITestingDomainsCredentialsLoginEndpointJSONPayloadClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerAPITestingDomainsCredentialsLoginEndpointJSONPayloadClearSecretInfoModel
 var HTTPLoadBalancerAPITestingDomainsCredentialsLoginEndpointJSONPayloadClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerAppFirewallModel represents app_firewall block
@@ -5196,14 +5211,16 @@ var HTTPLoadBalancerDefaultPoolUseTLSUseMtlsTLSCertificatesPrivateKeyBlindfoldSe
 
 // HTTPLoadBalancerDefaultPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerDefaultPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerDefaultPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerDefaultPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModel
 var HTTPLoadBalancerDefaultPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerDefaultPoolUseTLSUseMtlsObjModel represents use_mtls_obj block
@@ -5458,14 +5475,16 @@ var HTTPLoadBalancerEnableAPIDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLog
 
 // HTTPLoadBalancerEnableAPIDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerEnableAPIDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerEnableAPIDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerEnableAPIDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModel
 var HTTPLoadBalancerEnableAPIDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerEnableAPIDiscoveryAPIDiscoveryFromCodeScanModel represents api_discovery_from_code_scan block
@@ -5986,14 +6005,16 @@ var HTTPLoadBalancerHTTPSTLSParametersTLSCertificatesPrivateKeyBlindfoldSecretIn
 
 // HTTPLoadBalancerHTTPSTLSParametersTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerHTTPSTLSParametersTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerHTTPSTLSParametersTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerHTTPSTLSParametersTLSCertificatesPrivateKeyClearSecretInfoModel
 var HTTPLoadBalancerHTTPSTLSParametersTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerHTTPSTLSParametersTLSConfigModel represents tls_config block
@@ -6724,14 +6745,16 @@ var HTTPLoadBalancerMoreOptionRequestCookiesToAddSecretValueBlindfoldSecretInfoM
 
 // HTTPLoadBalancerMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModel
 var HTTPLoadBalancerMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerMoreOptionRequestHeadersToAddModel represents request_headers_to_add block
@@ -6778,14 +6801,16 @@ var HTTPLoadBalancerMoreOptionRequestHeadersToAddSecretValueBlindfoldSecretInfoM
 
 // HTTPLoadBalancerMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModel
 var HTTPLoadBalancerMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerMoreOptionResponseCookiesToAddModel represents response_cookies_to_add block
@@ -6870,14 +6895,16 @@ var HTTPLoadBalancerMoreOptionResponseCookiesToAddSecretValueBlindfoldSecretInfo
 
 // HTTPLoadBalancerMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModel
 var HTTPLoadBalancerMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerMoreOptionResponseHeadersToAddModel represents response_headers_to_add block
@@ -6924,14 +6951,16 @@ var HTTPLoadBalancerMoreOptionResponseHeadersToAddSecretValueBlindfoldSecretInfo
 
 // HTTPLoadBalancerMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModel
 var HTTPLoadBalancerMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerOriginServerSubsetRuleListModel represents origin_server_subset_rule_list block
@@ -8292,14 +8321,16 @@ var HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestCookiesToAddSecretVal
 
 // HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestCookiesToAddSecretValueClearSecretInfoModel
 var HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestHeadersToAddModel represents request_headers_to_add block
@@ -8346,14 +8377,16 @@ var HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestHeadersToAddSecretVal
 
 // HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestHeadersToAddSecretValueClearSecretInfoModel
 var HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseCookiesToAddModel represents response_cookies_to_add block
@@ -8438,14 +8471,16 @@ var HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseCookiesToAddSecretVa
 
 // HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseCookiesToAddSecretValueClearSecretInfoModel
 var HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseHeadersToAddModel represents response_headers_to_add block
@@ -8492,14 +8527,16 @@ var HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseHeadersToAddSecretVa
 
 // HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseHeadersToAddSecretValueClearSecretInfoModel
 var HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerRoutesSimpleRouteAdvancedOptionsRetryPolicyModel represents retry_policy block
@@ -8902,14 +8939,16 @@ var HTTPLoadBalancerSingleLBAppEnableDiscoveryAPICrawlerAPICrawlerConfigDomainsS
 
 // HTTPLoadBalancerSingleLBAppEnableDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModel represents clear_secret_info block
 type HTTPLoadBalancerSingleLBAppEnableDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // HTTPLoadBalancerSingleLBAppEnableDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModelAttrTypes defines the attribute types for HTTPLoadBalancerSingleLBAppEnableDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModel
 var HTTPLoadBalancerSingleLBAppEnableDiscoveryAPICrawlerAPICrawlerConfigDomainsSimpleLoginPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // HTTPLoadBalancerSingleLBAppEnableDiscoveryAPIDiscoveryFromCodeScanModel represents api_discovery_from_code_scan block
@@ -9249,6 +9288,8 @@ type HTTPLoadBalancerResourceModel struct {
 	ID                            types.String                                       `tfsdk:"id"`
 	AddLocation                   types.Bool                                         `tfsdk:"add_location"`
 	Timeouts                      timeouts.Value                                     `tfsdk:"timeouts"`
+	SystemMetadata                types.Object                                       `tfsdk:"system_metadata"`
+	LabelsAll                     types.Map                                          `tfsdk:"labels_all"`
 	ActiveServicePolicies         *HTTPLoadBalancerActiveServicePoliciesModel        `tfsdk:"active_service_policies"`
 	AdvertiseCustom               *HTTPLoadBalancerAdvertiseCustomModel              `tfsdk:"advertise_custom"`
 	AdvertiseOnPublic             *HTTPLoadBalancerAdvertiseOnPublicModel            `tfsdk:"advertise_on_public"`
@@ -9349,9 +9390,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the HTTP Load Balancer will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the HTTP Load Balancer will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -9375,6 +9418,12 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 				MarkdownDescription: "List of Domains (host/authority header) that will be matched to load balancer. Supported Domains and search order: 1. Exact Domain names: www.example.com. 2.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1)),
+				},
 			},
 			"labels": schema.MapAttribute{
 				MarkdownDescription: "Labels is a user defined key value map that can be attached to resources for organization and filtering.",
@@ -9396,6 +9445,8 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					boolplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -9410,11 +9461,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 				Blocks: map[string]schema.Block{
 					"policies": schema.ListNestedBlock{
 						MarkdownDescription: "Service Policies is a sequential engine where policies (and rules within the policy) are evaluated one after the other. It's important to define the correct order (policies evaluated from top to bottom in the list) for service policies, to GET the intended result. For each request, its..",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -9423,6 +9481,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -9431,6 +9492,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -9443,15 +9507,26 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 				Blocks: map[string]schema.Block{
 					"advertise_where": schema.ListNestedBlock{
 						MarkdownDescription: "Where should this load balancer be available .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"port": schema.Int64Attribute{
 									MarkdownDescription: "Port to Listen.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+										int64validator.AtMost(65535),
+									},
 								},
 								"port_ranges": schema.StringAttribute{
 									MarkdownDescription: "A string containing a comma separated list of port ranges. Each port range consists of a single port or two ports separated by '-'.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(512),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -9465,6 +9540,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -9473,6 +9552,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -9481,6 +9563,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -9492,6 +9577,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"ip": schema.StringAttribute{
 											MarkdownDescription: "Use given IP address as VIP on the site.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 										"network": schema.StringAttribute{
 											MarkdownDescription: "[Enum: SITE_NETWORK_INSIDE_AND_OUTSIDE|SITE_NETWORK_INSIDE|SITE_NETWORK_OUTSIDE|SITE_NETWORK_SERVICE|SITE_NETWORK_OUTSIDE_WITH_INTERNET_VIP|SITE_NETWORK_INSIDE_AND_OUTSIDE_WITH_INTERNET_VIP|SITE_NETWORK_IP_FABRIC] Defines network types to be used on site All inside and outside networks. All inside and outside networks with internet VIP support. All inside networks. Possible values are `SITE_NETWORK_INSIDE_AND_OUTSIDE`, `SITE_NETWORK_INSIDE`, `SITE_NETWORK_OUTSIDE`, `SITE_NETWORK_SERVICE`, `SITE_NETWORK_OUTSIDE_WITH_INTERNET_VIP`, `SITE_NETWORK_INSIDE_AND_OUTSIDE_WITH_INTERNET_VIP`, `SITE_NETWORK_IP_FABRIC`. Defaults to `SITE_NETWORK_INSIDE_AND_OUTSIDE`.",
@@ -9505,6 +9593,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -9513,6 +9605,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -9521,6 +9616,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -9535,10 +9633,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"specific_v6_vip": schema.StringAttribute{
 											MarkdownDescription: "Use given IPv6 address as VIP on virtual Network.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv6Validator(),
+											},
 										},
 										"specific_vip": schema.StringAttribute{
 											MarkdownDescription: "Use given IPv4 address as VIP on virtual Network.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -9554,6 +9658,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -9562,6 +9670,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -9570,6 +9681,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -9590,6 +9704,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -9598,6 +9716,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -9606,6 +9727,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -9617,6 +9741,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"ip": schema.StringAttribute{
 											MarkdownDescription: "Use given IP address as VIP on the site.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 										"network": schema.StringAttribute{
 											MarkdownDescription: "[Enum: SITE_NETWORK_SPECIFIED_VIP_OUTSIDE|SITE_NETWORK_SPECIFIED_VIP_INSIDE] Defines network types to be used on virtual-site with specified VIP All outside networks. All inside networks. Possible values are `SITE_NETWORK_SPECIFIED_VIP_OUTSIDE`, `SITE_NETWORK_SPECIFIED_VIP_INSIDE`. Defaults to `SITE_NETWORK_SPECIFIED_VIP_OUTSIDE`.",
@@ -9630,6 +9757,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -9638,6 +9769,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -9646,6 +9780,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -9661,6 +9798,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -9669,6 +9810,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -9677,6 +9821,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -9686,6 +9833,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -9694,6 +9845,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -9702,6 +9856,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -9722,6 +9879,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -9730,6 +9891,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -9738,6 +9902,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -9752,15 +9919,24 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 				Blocks: map[string]schema.Block{
 					"api_endpoint_rules": schema.ListNestedBlock{
 						MarkdownDescription: "Category defines specific rules per API endpoints. If request matches any of these rules, skipping second category rules.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(20),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"api_endpoint_path": schema.StringAttribute{
 									MarkdownDescription: "The endpoint (path) of the request.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(1024),
+									},
 								},
 								"specific_domain": schema.StringAttribute{
 									MarkdownDescription: "The rule will apply for a specific domain. For",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -9790,6 +9966,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] List of methods values to match against. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 								},
@@ -9810,6 +9990,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 													Optional:            true,
 													ElementType:         types.Int64Type,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -9819,6 +10004,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											Blocks: map[string]schema.Block{
 												"asn_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to bgp_asn_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -9869,6 +10057,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -9883,6 +10075,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											Blocks: map[string]schema.Block{
 												"prefix_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to ip_prefix_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -9937,6 +10132,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -9947,6 +10147,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "[Enum: SPAM_SOURCES|WINDOWS_EXPLOITS|WEB_ATTACKS|BOTNETS|SCANNERS|REPUTATION|PHISHING|PROXY|MOBILE_THREATS|TOR_PROXY|DENIAL_OF_SERVICE|NETWORK] The IP threat categories is obtained from the list and is used to auto-generate equivalent label selection expressions . Possible values are `SPAM_SOURCES`, `WINDOWS_EXPLOITS`, `WEB_ATTACKS`, `BOTNETS`, `SCANNERS`, `REPUTATION`, `PHISHING`, `PROXY`, `MOBILE_THREATS`, `TOR_PROXY`, `DENIAL_OF_SERVICE`, `NETWORK`. Defaults to `SPAM_SOURCES`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -9957,16 +10161,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 												"exact_values": schema.ListAttribute{
 													MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 												"excluded_values": schema.ListAttribute{
 													MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 											},
 										},
@@ -9978,10 +10196,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Human readable description.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 								},
@@ -9991,6 +10215,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									Blocks: map[string]schema.Block{
 										"cookie_matchers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -10000,6 +10227,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive cookie name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -10016,16 +10246,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -10034,6 +10278,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"headers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -10043,6 +10290,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-insensitive HTTP header name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -10059,16 +10309,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -10077,6 +10341,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"jwt_claims": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various JWT claims that need to match. The criteria for matching each JWT claim are described in individual JWTClaimMatcherType instances. The actual JWT claims values are extracted from the JWT payload as a list of strings.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -10086,6 +10353,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "JWT Claim Name. JWT claim name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -10102,16 +10372,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -10120,6 +10404,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"query_params": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -10129,6 +10416,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"key": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -10145,16 +10435,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -10168,19 +10472,31 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					},
 					"api_groups_rules": schema.ListNestedBlock{
 						MarkdownDescription: "Category includes rules per API group or Server URL. For API groups, refer to API Definition which includes API groups derived from uploaded swaggers.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(20),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"api_group": schema.StringAttribute{
 									MarkdownDescription: "API groups derived from API Definition swaggers. For example oas-all-operations including all paths and methods from the swaggers, oas-base-URLs covering all requests under base-paths from the swaggers. Custom groups can be created if user tags paths or operations with 'x-F5 Distributed..",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 								"base_path": schema.StringAttribute{
 									MarkdownDescription: "Base Path. Prefix of the request path. For example: /v1 .",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 								"specific_domain": schema.StringAttribute{
 									MarkdownDescription: "The rule will apply for a specific domain. For",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -10216,6 +10532,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 													Optional:            true,
 													ElementType:         types.Int64Type,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -10225,6 +10546,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											Blocks: map[string]schema.Block{
 												"asn_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to bgp_asn_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -10275,6 +10599,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -10289,6 +10617,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											Blocks: map[string]schema.Block{
 												"prefix_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to ip_prefix_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -10343,6 +10674,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -10353,6 +10689,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "[Enum: SPAM_SOURCES|WINDOWS_EXPLOITS|WEB_ATTACKS|BOTNETS|SCANNERS|REPUTATION|PHISHING|PROXY|MOBILE_THREATS|TOR_PROXY|DENIAL_OF_SERVICE|NETWORK] The IP threat categories is obtained from the list and is used to auto-generate equivalent label selection expressions . Possible values are `SPAM_SOURCES`, `WINDOWS_EXPLOITS`, `WEB_ATTACKS`, `BOTNETS`, `SCANNERS`, `REPUTATION`, `PHISHING`, `PROXY`, `MOBILE_THREATS`, `TOR_PROXY`, `DENIAL_OF_SERVICE`, `NETWORK`. Defaults to `SPAM_SOURCES`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -10363,16 +10703,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 												"exact_values": schema.ListAttribute{
 													MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 												"excluded_values": schema.ListAttribute{
 													MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 											},
 										},
@@ -10384,10 +10738,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Human readable description.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 								},
@@ -10397,6 +10757,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									Blocks: map[string]schema.Block{
 										"cookie_matchers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -10406,6 +10769,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive cookie name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -10422,16 +10788,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -10440,6 +10820,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"headers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -10449,6 +10832,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-insensitive HTTP header name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -10465,16 +10851,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -10483,6 +10883,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"jwt_claims": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various JWT claims that need to match. The criteria for matching each JWT claim are described in individual JWTClaimMatcherType instances. The actual JWT claims values are extracted from the JWT payload as a list of strings.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -10492,6 +10895,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "JWT Claim Name. JWT claim name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -10508,16 +10914,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -10526,6 +10946,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"query_params": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -10535,6 +10958,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"key": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -10551,16 +10977,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -10580,15 +11020,24 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 				Blocks: map[string]schema.Block{
 					"api_endpoint_rules": schema.ListNestedBlock{
 						MarkdownDescription: "Sets of rules for a specific endpoints. Order is matter as it uses first match policy. For creating rule that contain a whole domain or group of endpoints, please use the server URL rules above.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(20),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"api_endpoint_path": schema.StringAttribute{
 									MarkdownDescription: "The endpoint (path) of the request.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(1024),
+									},
 								},
 								"specific_domain": schema.StringAttribute{
 									MarkdownDescription: "The rule will apply for a specific domain.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -10606,6 +11055,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] List of methods values to match against. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 								},
@@ -10626,6 +11079,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 													Optional:            true,
 													ElementType:         types.Int64Type,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -10635,6 +11093,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											Blocks: map[string]schema.Block{
 												"asn_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to bgp_asn_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -10685,6 +11146,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -10699,6 +11164,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											Blocks: map[string]schema.Block{
 												"prefix_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to ip_prefix_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -10753,6 +11221,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -10763,6 +11236,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "[Enum: SPAM_SOURCES|WINDOWS_EXPLOITS|WEB_ATTACKS|BOTNETS|SCANNERS|REPUTATION|PHISHING|PROXY|MOBILE_THREATS|TOR_PROXY|DENIAL_OF_SERVICE|NETWORK] The IP threat categories is obtained from the list and is used to auto-generate equivalent label selection expressions . Possible values are `SPAM_SOURCES`, `WINDOWS_EXPLOITS`, `WEB_ATTACKS`, `BOTNETS`, `SCANNERS`, `REPUTATION`, `PHISHING`, `PROXY`, `MOBILE_THREATS`, `TOR_PROXY`, `DENIAL_OF_SERVICE`, `NETWORK`. Defaults to `SPAM_SOURCES`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -10773,16 +11250,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 												"exact_values": schema.ListAttribute{
 													MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 												"excluded_values": schema.ListAttribute{
 													MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 											},
 										},
@@ -10794,6 +11285,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"threshold": schema.Int64Attribute{
 											MarkdownDescription: "The total number of allowed requests for 1 unit (e.g. SECOND/MINUTE/HOUR etc.) of the specified period.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+												int64validator.AtMost(8192),
+											},
 										},
 										"unit": schema.StringAttribute{
 											MarkdownDescription: "[Enum: SECOND|MINUTE|HOUR] Unit for the period per which the rate limit is applied. - SECOND: Second Rate limit period unit is seconds - MINUTE: Minute Rate limit period unit is minutes - HOUR: Hour Rate limit period unit is hours - DAY: Day Rate limit period unit is days. Possible values are `SECOND`, `MINUTE`, `HOUR`. Defaults to `SECOND`.",
@@ -10807,6 +11302,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -10815,6 +11314,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -10823,6 +11325,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -10837,6 +11342,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -10845,6 +11354,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -10853,6 +11365,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -10862,6 +11377,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									Blocks: map[string]schema.Block{
 										"cookie_matchers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -10871,6 +11389,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive cookie name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -10887,16 +11408,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -10905,6 +11440,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"headers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -10914,6 +11452,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-insensitive HTTP header name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -10930,16 +11471,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -10948,6 +11503,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"jwt_claims": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various JWT claims that need to match. The criteria for matching each JWT claim are described in individual JWTClaimMatcherType instances. The actual JWT claims values are extracted from the JWT payload as a list of strings.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -10957,6 +11515,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "JWT Claim Name. JWT claim name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -10973,16 +11534,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -10991,6 +11566,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"query_params": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -11000,6 +11578,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"key": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -11016,16 +11597,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -11043,15 +11638,24 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						Blocks: map[string]schema.Block{
 							"bypass_rate_limiting_rules": schema.ListNestedBlock{
 								MarkdownDescription: "Category defines rules per URL or API group. If request matches any of these rules, skip Rate Limiting.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(20),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"base_path": schema.StringAttribute{
 											MarkdownDescription: "The base path which this validation applies to.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"specific_domain": schema.StringAttribute{
 											MarkdownDescription: "The rule will apply for a specific domain. For",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -11068,10 +11672,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] Methods. Methods to be matched. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 												"path": schema.StringAttribute{
 													MarkdownDescription: "Path. Path to be matched .",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(1024),
+													},
 												},
 											},
 										},
@@ -11082,6 +11693,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "API Groups. .",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -11102,6 +11718,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 															Optional:            true,
 															ElementType:         types.Int64Type,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -11111,6 +11732,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													Blocks: map[string]schema.Block{
 														"asn_sets": schema.ListNestedBlock{
 															MarkdownDescription: "List of references to bgp_asn_set objects.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"kind": schema.StringAttribute{
@@ -11161,6 +11785,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(1),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+															},
 														},
 													},
 												},
@@ -11175,6 +11803,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													Blocks: map[string]schema.Block{
 														"prefix_sets": schema.ListNestedBlock{
 															MarkdownDescription: "List of references to ip_prefix_set objects.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"kind": schema.StringAttribute{
@@ -11229,6 +11860,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(128),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 													},
 												},
@@ -11239,6 +11875,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "[Enum: SPAM_SOURCES|WINDOWS_EXPLOITS|WEB_ATTACKS|BOTNETS|SCANNERS|REPUTATION|PHISHING|PROXY|MOBILE_THREATS|TOR_PROXY|DENIAL_OF_SERVICE|NETWORK] The IP threat categories is obtained from the list and is used to auto-generate equivalent label selection expressions . Possible values are `SPAM_SOURCES`, `WINDOWS_EXPLOITS`, `WEB_ATTACKS`, `BOTNETS`, `SCANNERS`, `REPUTATION`, `PHISHING`, `PROXY`, `MOBILE_THREATS`, `TOR_PROXY`, `DENIAL_OF_SERVICE`, `NETWORK`. Defaults to `SPAM_SOURCES`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(32),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -11249,16 +11889,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+															},
 														},
 														"exact_values": schema.ListAttribute{
 															MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+															},
 														},
 														"excluded_values": schema.ListAttribute{
 															MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(32),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+															},
 														},
 													},
 												},
@@ -11270,6 +11924,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											Blocks: map[string]schema.Block{
 												"cookie_matchers": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -11279,6 +11936,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															"name": schema.StringAttribute{
 																MarkdownDescription: "Case-sensitive cookie name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -11295,16 +11955,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -11313,6 +11987,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												},
 												"headers": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -11322,6 +11999,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															"name": schema.StringAttribute{
 																MarkdownDescription: "Case-insensitive HTTP header name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -11338,16 +12018,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -11356,6 +12050,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												},
 												"jwt_claims": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for various JWT claims that need to match. The criteria for matching each JWT claim are described in individual JWTClaimMatcherType instances. The actual JWT claims values are extracted from the JWT payload as a list of strings.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -11365,6 +12062,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															"name": schema.StringAttribute{
 																MarkdownDescription: "JWT Claim Name. JWT claim name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -11381,16 +12081,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -11399,6 +12113,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												},
 												"query_params": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -11408,6 +12125,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															"key": schema.StringAttribute{
 																MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -11424,16 +12144,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -11453,11 +12187,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						Blocks: map[string]schema.Block{
 							"rate_limiter_allowed_prefixes": schema.ListNestedBlock{
 								MarkdownDescription: "References to ip_prefix_set objects. Requests from source IP addresses that are covered by one of the allowed IP Prefixes are not subjected to rate limiting.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(4),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -11466,6 +12207,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -11474,6 +12218,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -11487,6 +12234,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+								},
 							},
 						},
 					},
@@ -11495,19 +12247,31 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					},
 					"server_url_rules": schema.ListNestedBlock{
 						MarkdownDescription: "Set of rules for entire domain or base path that contain multiple endpoints. Order is matter as it uses first match policy. For matching also specific endpoints you can use the API endpoint rules set bellow.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(20),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"api_group": schema.StringAttribute{
 									MarkdownDescription: "API groups derived from API Definition swaggers. For example oas-all-operations including all paths and methods from the swaggers, oas-base-URLs covering all requests under base-paths from the swaggers. Custom groups can be created if user tags paths or operations with 'x-F5 Distributed..",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 								"base_path": schema.StringAttribute{
 									MarkdownDescription: "Prefix of the request path.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 								"specific_domain": schema.StringAttribute{
 									MarkdownDescription: "The rule will apply for a specific domain.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(128),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -11531,6 +12295,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 													Optional:            true,
 													ElementType:         types.Int64Type,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -11540,6 +12309,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											Blocks: map[string]schema.Block{
 												"asn_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to bgp_asn_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -11590,6 +12362,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -11604,6 +12380,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											Blocks: map[string]schema.Block{
 												"prefix_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to ip_prefix_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -11658,6 +12437,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -11668,6 +12452,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "[Enum: SPAM_SOURCES|WINDOWS_EXPLOITS|WEB_ATTACKS|BOTNETS|SCANNERS|REPUTATION|PHISHING|PROXY|MOBILE_THREATS|TOR_PROXY|DENIAL_OF_SERVICE|NETWORK] The IP threat categories is obtained from the list and is used to auto-generate equivalent label selection expressions . Possible values are `SPAM_SOURCES`, `WINDOWS_EXPLOITS`, `WEB_ATTACKS`, `BOTNETS`, `SCANNERS`, `REPUTATION`, `PHISHING`, `PROXY`, `MOBILE_THREATS`, `TOR_PROXY`, `DENIAL_OF_SERVICE`, `NETWORK`. Defaults to `SPAM_SOURCES`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -11678,16 +12466,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 												"exact_values": schema.ListAttribute{
 													MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 												"excluded_values": schema.ListAttribute{
 													MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 											},
 										},
@@ -11699,6 +12501,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"threshold": schema.Int64Attribute{
 											MarkdownDescription: "The total number of allowed requests for 1 unit (e.g. SECOND/MINUTE/HOUR etc.) of the specified period.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+												int64validator.AtMost(8192),
+											},
 										},
 										"unit": schema.StringAttribute{
 											MarkdownDescription: "[Enum: SECOND|MINUTE|HOUR] Unit for the period per which the rate limit is applied. - SECOND: Second Rate limit period unit is seconds - MINUTE: Minute Rate limit period unit is minutes - HOUR: Hour Rate limit period unit is hours - DAY: Day Rate limit period unit is days. Possible values are `SECOND`, `MINUTE`, `HOUR`. Defaults to `SECOND`.",
@@ -11712,6 +12518,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -11720,6 +12530,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -11728,6 +12541,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -11742,6 +12558,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -11750,6 +12570,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -11758,6 +12581,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -11767,6 +12593,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									Blocks: map[string]schema.Block{
 										"cookie_matchers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -11776,6 +12605,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive cookie name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -11792,16 +12624,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -11810,6 +12656,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"headers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -11819,6 +12668,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-insensitive HTTP header name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -11835,16 +12687,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -11853,6 +12719,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"jwt_claims": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various JWT claims that need to match. The criteria for matching each JWT claim are described in individual JWTClaimMatcherType instances. The actual JWT claims values are extracted from the JWT payload as a list of strings.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -11862,6 +12731,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "JWT Claim Name. JWT claim name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -11878,16 +12750,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -11896,6 +12782,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"query_params": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -11905,6 +12794,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"key": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -11921,16 +12813,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -11954,6 +12860,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -11962,6 +12872,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -11970,6 +12883,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -11990,15 +12906,24 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										Blocks: map[string]schema.Block{
 											"open_api_validation_rules": schema.ListNestedBlock{
 												MarkdownDescription: "Custom Fall Through Rule List. .",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(15),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"api_group": schema.StringAttribute{
 															MarkdownDescription: "The API group which this validation applies to.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+															},
 														},
 														"base_path": schema.StringAttribute{
 															MarkdownDescription: "The base path which this validation applies to.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -12018,10 +12943,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																	MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] Methods. Methods to be matched. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 																	Optional:            true,
 																	ElementType:         types.StringType,
+																	Validators: []validator.List{
+																		listvalidator.SizeAtMost(16),
+																		listvalidator.UniqueValues(),
+																	},
 																},
 																"path": schema.StringAttribute{
 																	MarkdownDescription: "Path. Path to be matched .",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtMost(1024),
+																	},
 																},
 															},
 														},
@@ -12031,10 +12963,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																"description_spec": schema.StringAttribute{
 																	MarkdownDescription: "Description. Human readable description.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtMost(256),
+																	},
 																},
 																"name": schema.StringAttribute{
 																	MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtLeast(1),
+																	},
 																},
 															},
 														},
@@ -12089,6 +13027,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												MarkdownDescription: "[Enum: PROPERTY_QUERY_PARAMETERS|PROPERTY_PATH_PARAMETERS|PROPERTY_CONTENT_TYPE|PROPERTY_COOKIE_PARAMETERS|PROPERTY_HTTP_HEADERS|PROPERTY_HTTP_BODY|PROPERTY_SECURITY_SCHEMA|PROPERTY_RESPONSE_CODE] List of properties of the response to validate according to the OpenAPI specification file (a.k.a. Swagger) . Possible values are `PROPERTY_QUERY_PARAMETERS`, `PROPERTY_PATH_PARAMETERS`, `PROPERTY_CONTENT_TYPE`, `PROPERTY_COOKIE_PARAMETERS`, `PROPERTY_HTTP_HEADERS`, `PROPERTY_HTTP_BODY`, `PROPERTY_SECURITY_SCHEMA`, `PROPERTY_RESPONSE_CODE`. Defaults to `PROPERTY_QUERY_PARAMETERS`.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.SizeAtLeast(1),
+													listvalidator.UniqueValues(),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
@@ -12113,6 +13055,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												MarkdownDescription: "[Enum: PROPERTY_QUERY_PARAMETERS|PROPERTY_PATH_PARAMETERS|PROPERTY_CONTENT_TYPE|PROPERTY_COOKIE_PARAMETERS|PROPERTY_HTTP_HEADERS|PROPERTY_HTTP_BODY|PROPERTY_SECURITY_SCHEMA|PROPERTY_RESPONSE_CODE] List of properties of the request to validate according to the OpenAPI specification file (a.k.a. Swagger) . Possible values are `PROPERTY_QUERY_PARAMETERS`, `PROPERTY_PATH_PARAMETERS`, `PROPERTY_CONTENT_TYPE`, `PROPERTY_COOKIE_PARAMETERS`, `PROPERTY_HTTP_HEADERS`, `PROPERTY_HTTP_BODY`, `PROPERTY_SECURITY_SCHEMA`, `PROPERTY_RESPONSE_CODE`. Defaults to `PROPERTY_QUERY_PARAMETERS`.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.SizeAtLeast(1),
+													listvalidator.UniqueValues(),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
@@ -12145,15 +13091,24 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										Blocks: map[string]schema.Block{
 											"open_api_validation_rules": schema.ListNestedBlock{
 												MarkdownDescription: "Custom Fall Through Rule List. .",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(15),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"api_group": schema.StringAttribute{
 															MarkdownDescription: "The API group which this validation applies to.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+															},
 														},
 														"base_path": schema.StringAttribute{
 															MarkdownDescription: "The base path which this validation applies to.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(128),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -12173,10 +13128,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																	MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] Methods. Methods to be matched. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 																	Optional:            true,
 																	ElementType:         types.StringType,
+																	Validators: []validator.List{
+																		listvalidator.SizeAtMost(16),
+																		listvalidator.UniqueValues(),
+																	},
 																},
 																"path": schema.StringAttribute{
 																	MarkdownDescription: "Path. Path to be matched .",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtMost(1024),
+																	},
 																},
 															},
 														},
@@ -12186,10 +13148,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																"description_spec": schema.StringAttribute{
 																	MarkdownDescription: "Description. Human readable description.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtMost(256),
+																	},
 																},
 																"name": schema.StringAttribute{
 																	MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtLeast(1),
+																	},
 																},
 															},
 														},
@@ -12202,19 +13170,31 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							},
 							"open_api_validation_rules": schema.ListNestedBlock{
 								MarkdownDescription: "Validation List. .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(15),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"api_group": schema.StringAttribute{
 											MarkdownDescription: "The API group which this validation applies to.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"base_path": schema.StringAttribute{
 											MarkdownDescription: "The base path which this validation applies to.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"specific_domain": schema.StringAttribute{
 											MarkdownDescription: "The rule will apply for a specific domain.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -12228,10 +13208,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] Methods. Methods to be matched. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 												"path": schema.StringAttribute{
 													MarkdownDescription: "Path. Path to be matched .",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(1024),
+													},
 												},
 											},
 										},
@@ -12241,10 +13228,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -12259,6 +13252,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "[Enum: PROPERTY_QUERY_PARAMETERS|PROPERTY_PATH_PARAMETERS|PROPERTY_CONTENT_TYPE|PROPERTY_COOKIE_PARAMETERS|PROPERTY_HTTP_HEADERS|PROPERTY_HTTP_BODY|PROPERTY_SECURITY_SCHEMA|PROPERTY_RESPONSE_CODE] List of properties of the response to validate according to the OpenAPI specification file (a.k.a. Swagger) . Possible values are `PROPERTY_QUERY_PARAMETERS`, `PROPERTY_PATH_PARAMETERS`, `PROPERTY_CONTENT_TYPE`, `PROPERTY_COOKIE_PARAMETERS`, `PROPERTY_HTTP_HEADERS`, `PROPERTY_HTTP_BODY`, `PROPERTY_SECURITY_SCHEMA`, `PROPERTY_RESPONSE_CODE`. Defaults to `PROPERTY_QUERY_PARAMETERS`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -12283,6 +13280,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "[Enum: PROPERTY_QUERY_PARAMETERS|PROPERTY_PATH_PARAMETERS|PROPERTY_CONTENT_TYPE|PROPERTY_COOKIE_PARAMETERS|PROPERTY_HTTP_HEADERS|PROPERTY_HTTP_BODY|PROPERTY_SECURITY_SCHEMA|PROPERTY_RESPONSE_CODE] List of properties of the request to validate according to the OpenAPI specification file (a.k.a. Swagger) . Possible values are `PROPERTY_QUERY_PARAMETERS`, `PROPERTY_PATH_PARAMETERS`, `PROPERTY_CONTENT_TYPE`, `PROPERTY_COOKIE_PARAMETERS`, `PROPERTY_HTTP_HEADERS`, `PROPERTY_HTTP_BODY`, `PROPERTY_SECURITY_SCHEMA`, `PROPERTY_RESPONSE_CODE`. Defaults to `PROPERTY_QUERY_PARAMETERS`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -12345,11 +13346,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"custom_header_value": schema.StringAttribute{
 						MarkdownDescription: "Add x-F5-API-testing-identifier header value to prevent security flags on API testing traffic.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(128),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
 					"domains": schema.ListNestedBlock{
 						MarkdownDescription: "Add and configure testing domains and credentials .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"allow_destructive_methods": schema.BoolAttribute{
@@ -12359,6 +13366,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"domain": schema.StringAttribute{
 									MarkdownDescription: "Add your testing environment domain. Be aware that running tests on a production domain can impact live applications, as API testing cannot distinguish between production and testing environments.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -12369,6 +13379,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"credential_name": schema.StringAttribute{
 												MarkdownDescription: "Enter a unique name for the credentials used in API testing .",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.UTF8LengthAtMost(64),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
@@ -12381,6 +13394,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"key": schema.StringAttribute{
 														MarkdownDescription: "Key.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(128),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -12413,7 +13429,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		Optional:            true,
 																	},
 																	"url": schema.StringAttribute{
-																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																		Optional:            true,
+																		Sensitive:           true,
+																		WriteOnly:           true,
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(131072),
+																		},
+																	},
+																	"url_version": schema.Int64Attribute{
+																		MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																		Optional:            true,
 																	},
 																},
@@ -12428,6 +13453,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"user": schema.StringAttribute{
 														MarkdownDescription: "User.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(64),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -12460,7 +13488,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		Optional:            true,
 																	},
 																	"url": schema.StringAttribute{
-																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																		Optional:            true,
+																		Sensitive:           true,
+																		WriteOnly:           true,
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(131072),
+																		},
+																	},
+																	"url_version": schema.Int64Attribute{
+																		MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																		Optional:            true,
 																	},
 																},
@@ -12502,7 +13539,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		Optional:            true,
 																	},
 																	"url": schema.StringAttribute{
-																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																		Optional:            true,
+																		Sensitive:           true,
+																		WriteOnly:           true,
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(131072),
+																		},
+																	},
+																	"url_version": schema.Int64Attribute{
+																		MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																		Optional:            true,
 																	},
 																},
@@ -12521,6 +13567,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"path": schema.StringAttribute{
 														MarkdownDescription: "Path.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(1024),
+														},
 													},
 													"token_response_key": schema.StringAttribute{
 														MarkdownDescription: "Specifies how to handle the API response, extracting authentication tokens.",
@@ -12557,7 +13606,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		Optional:            true,
 																	},
 																	"url": schema.StringAttribute{
-																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																		Optional:            true,
+																		Sensitive:           true,
+																		WriteOnly:           true,
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(131072),
+																		},
+																	},
+																	"url_version": schema.Int64Attribute{
+																		MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																		Optional:            true,
 																	},
 																},
@@ -12592,6 +13650,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"name": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(128),
+							stringvalidator.LengthAtLeast(1),
+						},
 					},
 					"namespace": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -12600,6 +13662,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 					"tenant": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -12608,21 +13673,36 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 				},
 			},
 			"blocked_clients": schema.ListNestedBlock{
 				MarkdownDescription: "Define rules to block IP Prefixes or AS numbers.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(256),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"actions": schema.ListAttribute{
 							MarkdownDescription: "[Enum: SKIP_PROCESSING_WAF|SKIP_PROCESSING_BOT|SKIP_PROCESSING_MUM|SKIP_PROCESSING_IP_REPUTATION|SKIP_PROCESSING_API_PROTECTION|SKIP_PROCESSING_OAS_VALIDATION|SKIP_PROCESSING_DDOS_PROTECTION|SKIP_PROCESSING_THREAT_MESH|SKIP_PROCESSING_MALWARE_PROTECTION] Actions that should be taken when client identifier matches the rule. Possible values are `SKIP_PROCESSING_WAF`, `SKIP_PROCESSING_BOT`, `SKIP_PROCESSING_MUM`, `SKIP_PROCESSING_IP_REPUTATION`, `SKIP_PROCESSING_API_PROTECTION`, `SKIP_PROCESSING_OAS_VALIDATION`, `SKIP_PROCESSING_DDOS_PROTECTION`, `SKIP_PROCESSING_THREAT_MESH`, `SKIP_PROCESSING_MALWARE_PROTECTION`. Defaults to `SKIP_PROCESSING_WAF`.",
 							Optional:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(10),
+								listvalidator.UniqueValues(),
+							},
 						},
 						"as_number": schema.Int64Attribute{
 							MarkdownDescription: "RFC 6793 defined 4-byte AS number.",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+								int64validator.AtMost(401308),
+							},
 						},
 						"expiration_timestamp": schema.StringAttribute{
 							MarkdownDescription: "Specifies expiration_timestamp the RFC 3339 format timestamp at which the containing rule is considered to be logically expired. The rule continues to exist in the configuration but is not applied anymore.",
@@ -12631,14 +13711,23 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						"ip_prefix": schema.StringAttribute{
 							MarkdownDescription: "IPv4 prefix string.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.IPv4PrefixValidator(),
+							},
 						},
 						"ipv6_prefix": schema.StringAttribute{
 							MarkdownDescription: "IPv6 prefix string.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.IPv6PrefixValidator(),
+							},
 						},
 						"user_identifier": schema.StringAttribute{
 							MarkdownDescription: "Identify user based on user identifier. User identifier value needs to be copied from security event.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -12651,11 +13740,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							Blocks: map[string]schema.Block{
 								"headers": schema.ListNestedBlock{
 									MarkdownDescription: "List of HTTP header name and value pairs .",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"exact": schema.StringAttribute{
 												MarkdownDescription: "Header value to match exactly.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"invert_match": schema.BoolAttribute{
 												MarkdownDescription: "Invert the result of the match to detect missing header or non-matching value.",
@@ -12664,6 +13760,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"name": schema.StringAttribute{
 												MarkdownDescription: "Name. Name of the header .",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"presence": schema.BoolAttribute{
 												MarkdownDescription: "If true, check for presence of header.",
@@ -12672,6 +13772,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"regex": schema.StringAttribute{
 												MarkdownDescription: "Regex match of the header value in re2 format.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+													validators.RegexValidator(),
+												},
 											},
 										},
 									},
@@ -12684,10 +13789,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Description. Human readable description.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -12710,6 +13821,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"timeout": schema.Int64Attribute{
 						MarkdownDescription: "The timeout for the inference check, in milliseconds.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+							int64validator.AtMost(60000),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -12758,6 +13873,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								Blocks: map[string]schema.Block{
 									"exclude_list": schema.ListNestedBlock{
 										MarkdownDescription: "Optional JavaScript insertions exclude list of domain and path matchers.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{},
 											Blocks: map[string]schema.Block{
@@ -12770,14 +13888,29 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -12787,10 +13920,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"description_spec": schema.StringAttribute{
 															MarkdownDescription: "Description. Human readable description.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -12800,14 +13939,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"path": schema.StringAttribute{
 															MarkdownDescription: "Exact path value to match.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"prefix": schema.StringAttribute{
 															MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"regex": schema.StringAttribute{
 															MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 													},
 												},
@@ -12822,6 +13972,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								Blocks: map[string]schema.Block{
 									"exclude_list": schema.ListNestedBlock{
 										MarkdownDescription: "Optional JavaScript insertions exclude list of domain and path matchers.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{},
 											Blocks: map[string]schema.Block{
@@ -12834,14 +13987,29 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -12851,10 +14019,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"description_spec": schema.StringAttribute{
 															MarkdownDescription: "Description. Human readable description.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -12864,14 +14038,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"path": schema.StringAttribute{
 															MarkdownDescription: "Exact path value to match.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"prefix": schema.StringAttribute{
 															MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"regex": schema.StringAttribute{
 															MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 													},
 												},
@@ -12880,6 +14065,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									},
 									"rules": schema.ListNestedBlock{
 										MarkdownDescription: "Required list of pages to insert Bot Defense client JavaScript.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"javascript_location": schema.StringAttribute{
@@ -12897,14 +14085,29 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -12914,10 +14117,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"description_spec": schema.StringAttribute{
 															MarkdownDescription: "Description. Human readable description.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -12927,14 +14136,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"path": schema.StringAttribute{
 															MarkdownDescription: "Exact path value to match.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"prefix": schema.StringAttribute{
 															MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"regex": schema.StringAttribute{
 															MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 													},
 												},
@@ -12953,11 +14173,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										Blocks: map[string]schema.Block{
 											"headers": schema.ListNestedBlock{
 												MarkdownDescription: "Headers that can be used to identify mobile traffic.",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(32),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Case-insensitive HTTP header name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -12974,16 +14200,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																	MarkdownDescription: "List of exact values to match the input against.",
 																	Optional:            true,
 																	ElementType:         types.StringType,
+																	Validators: []validator.List{
+																		listvalidator.SizeAtMost(64),
+																		listvalidator.UniqueValues(),
+																		listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																	},
 																},
 																"regex_values": schema.ListAttribute{
 																	MarkdownDescription: "List of regular expressions to match the input against.",
 																	Optional:            true,
 																	ElementType:         types.StringType,
+																	Validators: []validator.List{
+																		listvalidator.SizeAtMost(16),
+																		listvalidator.UniqueValues(),
+																		listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																	},
 																},
 																"transformers": schema.ListAttribute{
 																	MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																	Optional:            true,
 																	ElementType:         types.StringType,
+																	Validators: []validator.List{
+																		listvalidator.SizeAtMost(9),
+																		listvalidator.UniqueValues(),
+																	},
 																},
 															},
 														},
@@ -12996,12 +14236,20 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							},
 							"protected_app_endpoints": schema.ListNestedBlock{
 								MarkdownDescription: "List of protected endpoints. Limit: Approx '128 endpoints per Load Balancer (LB)' upto 4 LBs, '32 endpoints per LB' after 4 LBs.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"http_methods": schema.ListAttribute{
 											MarkdownDescription: "[Enum: METHOD_ANY|METHOD_GET|METHOD_POST|METHOD_PUT|METHOD_PATCH|METHOD_DELETE|METHOD_GET_DOCUMENT] HTTP Methods. List of HTTP methods. Possible values are `METHOD_ANY`, `METHOD_GET`, `METHOD_POST`, `METHOD_PUT`, `METHOD_PATCH`, `METHOD_DELETE`, `METHOD_GET_DOCUMENT`. Defaults to `METHOD_ANY`.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(5),
+												listvalidator.SizeAtLeast(1),
+												listvalidator.UniqueValues(),
+											},
 										},
 										"protocol": schema.StringAttribute{
 											MarkdownDescription: "[Enum: BOTH|HTTP|HTTPS] SchemeType is used to indicate URL scheme. - BOTH: BOTH URL scheme for HTTPS:// or HTTP://. - HTTP: HTTP URL scheme HTTP:// only. - HTTPS: HTTPS URL scheme HTTPS:// only. Possible values are `BOTH`, `HTTP`, `HTTPS`. Defaults to `BOTH`.",
@@ -13021,14 +14269,29 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"exact_value": schema.StringAttribute{
 													MarkdownDescription: "Exact domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"regex_value": schema.StringAttribute{
 													MarkdownDescription: "Regular Expression value for the domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 												"suffix_value": schema.StringAttribute{
 													MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -13065,16 +14328,27 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																	Blocks: map[string]schema.Block{
 																		"failure_conditions": schema.ListNestedBlock{
 																			MarkdownDescription: "Failure Conditions. Failure Conditions.",
+																			Validators: []validator.List{
+																				listvalidator.SizeAtMost(3),
+																			},
 																			NestedObject: schema.NestedBlockObject{
 																				Attributes: map[string]schema.Attribute{
 																					"name": schema.StringAttribute{
 																						MarkdownDescription: "Header Name. A case-insensitive HTTP header name.",
 																						Optional:            true,
+																						Validators: []validator.String{
+																							stringvalidator.LengthAtMost(256),
+																						},
 																					},
 																					"regex_values": schema.ListAttribute{
 																						MarkdownDescription: "List of regular expressions to match the input against.",
 																						Optional:            true,
 																						ElementType:         types.StringType,
+																						Validators: []validator.List{
+																							listvalidator.SizeAtMost(16),
+																							listvalidator.UniqueValues(),
+																							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																						},
 																					},
 																					"status": schema.StringAttribute{
 																						MarkdownDescription: "[Enum: EmptyStatusCode|Continue|OK|Created|Accepted|NonAuthoritativeInformation|NoContent|ResetContent|PartialContent|MultiStatus|AlreadyReported|IMUsed|MultipleChoices|MovedPermanently|Found|SeeOther|NotModified|UseProxy|TemporaryRedirect|PermanentRedirect|BadRequest|Unauthorized|PaymentRequired|Forbidden|NotFound|MethodNotAllowed|NotAcceptable|ProxyAuthenticationRequired|RequestTimeout|Conflict|Gone|LengthRequired|PreconditionFailed|PayloadTooLarge|URITooLong|UnsupportedMediaType|RangeNotSatisfiable|ExpectationFailed|MisdirectedRequest|UnprocessableEntity|Locked|FailedDependency|UpgradeRequired|PreconditionRequired|TooManyRequests|RequestHeaderFieldsTooLarge|InternalServerError|NotImplemented|BadGateway|ServiceUnavailable|GatewayTimeout|HTTPVersionNotSupported|VariantAlsoNegotiates|InsufficientStorage|LoopDetected|NotExtended|NetworkAuthenticationRequired] HTTP response status codes EmptyStatusCode response codes means it is not specified Continue status code OK status code Created status code Accepted status code Non Authoritative Information status code No Content status code Reset Content status code Partial Content status code Multi Status.. Possible values are `EmptyStatusCode`, `Continue`, `OK`, `Created`, `Accepted`, `NonAuthoritativeInformation`, `NoContent`, `ResetContent`, `PartialContent`, `MultiStatus`, `AlreadyReported`, `IMUsed`, `MultipleChoices`, `MovedPermanently`, `Found`, `SeeOther`, `NotModified`, `UseProxy`, `TemporaryRedirect`, `PermanentRedirect`, `BadRequest`, `Unauthorized`, `PaymentRequired`, `Forbidden`, `NotFound`, `MethodNotAllowed`, `NotAcceptable`, `ProxyAuthenticationRequired`, `RequestTimeout`, `Conflict`, `Gone`, `LengthRequired`, `PreconditionFailed`, `PayloadTooLarge`, `URITooLong`, `UnsupportedMediaType`, `RangeNotSatisfiable`, `ExpectationFailed`, `MisdirectedRequest`, `UnprocessableEntity`, `Locked`, `FailedDependency`, `UpgradeRequired`, `PreconditionRequired`, `TooManyRequests`, `RequestHeaderFieldsTooLarge`, `InternalServerError`, `NotImplemented`, `BadGateway`, `ServiceUnavailable`, `GatewayTimeout`, `HTTPVersionNotSupported`, `VariantAlsoNegotiates`, `InsufficientStorage`, `LoopDetected`, `NotExtended`, `NetworkAuthenticationRequired`. Defaults to `EmptyStatusCode`.",
@@ -13085,16 +14359,27 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		},
 																		"success_conditions": schema.ListNestedBlock{
 																			MarkdownDescription: "Success Conditions. Success Conditions.",
+																			Validators: []validator.List{
+																				listvalidator.SizeAtMost(3),
+																			},
 																			NestedObject: schema.NestedBlockObject{
 																				Attributes: map[string]schema.Attribute{
 																					"name": schema.StringAttribute{
 																						MarkdownDescription: "Header Name. A case-insensitive HTTP header name.",
 																						Optional:            true,
+																						Validators: []validator.String{
+																							stringvalidator.LengthAtMost(256),
+																						},
 																					},
 																					"regex_values": schema.ListAttribute{
 																						MarkdownDescription: "List of regular expressions to match the input against.",
 																						Optional:            true,
 																						ElementType:         types.StringType,
+																						Validators: []validator.List{
+																							listvalidator.SizeAtMost(16),
+																							listvalidator.UniqueValues(),
+																							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																						},
 																					},
 																					"status": schema.StringAttribute{
 																						MarkdownDescription: "[Enum: EmptyStatusCode|Continue|OK|Created|Accepted|NonAuthoritativeInformation|NoContent|ResetContent|PartialContent|MultiStatus|AlreadyReported|IMUsed|MultipleChoices|MovedPermanently|Found|SeeOther|NotModified|UseProxy|TemporaryRedirect|PermanentRedirect|BadRequest|Unauthorized|PaymentRequired|Forbidden|NotFound|MethodNotAllowed|NotAcceptable|ProxyAuthenticationRequired|RequestTimeout|Conflict|Gone|LengthRequired|PreconditionFailed|PayloadTooLarge|URITooLong|UnsupportedMediaType|RangeNotSatisfiable|ExpectationFailed|MisdirectedRequest|UnprocessableEntity|Locked|FailedDependency|UpgradeRequired|PreconditionRequired|TooManyRequests|RequestHeaderFieldsTooLarge|InternalServerError|NotImplemented|BadGateway|ServiceUnavailable|GatewayTimeout|HTTPVersionNotSupported|VariantAlsoNegotiates|InsufficientStorage|LoopDetected|NotExtended|NetworkAuthenticationRequired] HTTP response status codes EmptyStatusCode response codes means it is not specified Continue status code OK status code Created status code Accepted status code Non Authoritative Information status code No Content status code Reset Content status code Partial Content status code Multi Status.. Possible values are `EmptyStatusCode`, `Continue`, `OK`, `Created`, `Accepted`, `NonAuthoritativeInformation`, `NoContent`, `ResetContent`, `PartialContent`, `MultiStatus`, `AlreadyReported`, `IMUsed`, `MultipleChoices`, `MovedPermanently`, `Found`, `SeeOther`, `NotModified`, `UseProxy`, `TemporaryRedirect`, `PermanentRedirect`, `BadRequest`, `Unauthorized`, `PaymentRequired`, `Forbidden`, `NotFound`, `MethodNotAllowed`, `NotAcceptable`, `ProxyAuthenticationRequired`, `RequestTimeout`, `Conflict`, `Gone`, `LengthRequired`, `PreconditionFailed`, `PayloadTooLarge`, `URITooLong`, `UnsupportedMediaType`, `RangeNotSatisfiable`, `ExpectationFailed`, `MisdirectedRequest`, `UnprocessableEntity`, `Locked`, `FailedDependency`, `UpgradeRequired`, `PreconditionRequired`, `TooManyRequests`, `RequestHeaderFieldsTooLarge`, `InternalServerError`, `NotImplemented`, `BadGateway`, `ServiceUnavailable`, `GatewayTimeout`, `HTTPVersionNotSupported`, `VariantAlsoNegotiates`, `InsufficientStorage`, `LoopDetected`, `NotExtended`, `NetworkAuthenticationRequired`. Defaults to `EmptyStatusCode`.",
@@ -13221,6 +14506,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"headers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -13230,6 +14518,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-insensitive HTTP header name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -13246,16 +14537,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -13268,10 +14573,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -13288,6 +14599,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"body": schema.StringAttribute{
 															MarkdownDescription: "Custom body message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(4096),
+															},
 														},
 														"status": schema.StringAttribute{
 															MarkdownDescription: "[Enum: EmptyStatusCode|Continue|OK|Created|Accepted|NonAuthoritativeInformation|NoContent|ResetContent|PartialContent|MultiStatus|AlreadyReported|IMUsed|MultipleChoices|MovedPermanently|Found|SeeOther|NotModified|UseProxy|TemporaryRedirect|PermanentRedirect|BadRequest|Unauthorized|PaymentRequired|Forbidden|NotFound|MethodNotAllowed|NotAcceptable|ProxyAuthenticationRequired|RequestTimeout|Conflict|Gone|LengthRequired|PreconditionFailed|PayloadTooLarge|URITooLong|UnsupportedMediaType|RangeNotSatisfiable|ExpectationFailed|MisdirectedRequest|UnprocessableEntity|Locked|FailedDependency|UpgradeRequired|PreconditionRequired|TooManyRequests|RequestHeaderFieldsTooLarge|InternalServerError|NotImplemented|BadGateway|ServiceUnavailable|GatewayTimeout|HTTPVersionNotSupported|VariantAlsoNegotiates|InsufficientStorage|LoopDetected|NotExtended|NetworkAuthenticationRequired] HTTP response status codes EmptyStatusCode response codes means it is not specified Continue status code OK status code Created status code Accepted status code Non Authoritative Information status code No Content status code Reset Content status code Partial Content status code Multi Status.. Possible values are `EmptyStatusCode`, `Continue`, `OK`, `Created`, `Accepted`, `NonAuthoritativeInformation`, `NoContent`, `ResetContent`, `PartialContent`, `MultiStatus`, `AlreadyReported`, `IMUsed`, `MultipleChoices`, `MovedPermanently`, `Found`, `SeeOther`, `NotModified`, `UseProxy`, `TemporaryRedirect`, `PermanentRedirect`, `BadRequest`, `Unauthorized`, `PaymentRequired`, `Forbidden`, `NotFound`, `MethodNotAllowed`, `NotAcceptable`, `ProxyAuthenticationRequired`, `RequestTimeout`, `Conflict`, `Gone`, `LengthRequired`, `PreconditionFailed`, `PayloadTooLarge`, `URITooLong`, `UnsupportedMediaType`, `RangeNotSatisfiable`, `ExpectationFailed`, `MisdirectedRequest`, `UnprocessableEntity`, `Locked`, `FailedDependency`, `UpgradeRequired`, `PreconditionRequired`, `TooManyRequests`, `RequestHeaderFieldsTooLarge`, `InternalServerError`, `NotImplemented`, `BadGateway`, `ServiceUnavailable`, `GatewayTimeout`, `HTTPVersionNotSupported`, `VariantAlsoNegotiates`, `InsufficientStorage`, `LoopDetected`, `NotExtended`, `NetworkAuthenticationRequired`. Defaults to `EmptyStatusCode`.",
@@ -13305,10 +14619,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																"auto_type_header_name": schema.StringAttribute{
 																	MarkdownDescription: "Case-insensitive HTTP header name.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(256),
+																	},
 																},
 																"inference_header_name": schema.StringAttribute{
 																	MarkdownDescription: "Case-insensitive HTTP header name.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(256),
+																	},
 																},
 															},
 														},
@@ -13337,19 +14657,33 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"path": schema.StringAttribute{
 													MarkdownDescription: "Exact path value to match.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"prefix": schema.StringAttribute{
 													MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"regex": schema.StringAttribute{
 													MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(256),
+														stringvalidator.LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 											},
 										},
 										"query_params": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -13359,6 +14693,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"key": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -13375,16 +14712,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -13443,6 +14794,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						Blocks: map[string]schema.Block{
 							"exclude_list": schema.ListNestedBlock{
 								MarkdownDescription: "Optional JavaScript insertions exclude list of domain and path matchers.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{},
 									Blocks: map[string]schema.Block{
@@ -13455,14 +14809,29 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"exact_value": schema.StringAttribute{
 													MarkdownDescription: "Exact domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"regex_value": schema.StringAttribute{
 													MarkdownDescription: "Regular Expression value for the domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 												"suffix_value": schema.StringAttribute{
 													MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -13472,10 +14841,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -13485,14 +14860,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"path": schema.StringAttribute{
 													MarkdownDescription: "Exact path value to match.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"prefix": schema.StringAttribute{
 													MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"regex": schema.StringAttribute{
 													MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(256),
+														stringvalidator.LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 											},
 										},
@@ -13507,6 +14893,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						Blocks: map[string]schema.Block{
 							"exclude_list": schema.ListNestedBlock{
 								MarkdownDescription: "Optional JavaScript insertions exclude list of domain and path matchers.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{},
 									Blocks: map[string]schema.Block{
@@ -13519,14 +14908,29 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"exact_value": schema.StringAttribute{
 													MarkdownDescription: "Exact domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"regex_value": schema.StringAttribute{
 													MarkdownDescription: "Regular Expression value for the domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 												"suffix_value": schema.StringAttribute{
 													MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -13536,10 +14940,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -13549,14 +14959,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"path": schema.StringAttribute{
 													MarkdownDescription: "Exact path value to match.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"prefix": schema.StringAttribute{
 													MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"regex": schema.StringAttribute{
 													MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(256),
+														stringvalidator.LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 											},
 										},
@@ -13565,6 +14986,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							},
 							"rules": schema.ListNestedBlock{
 								MarkdownDescription: "Required list of pages to insert Bot Defense client JavaScript.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"javascript_location": schema.StringAttribute{
@@ -13582,14 +15006,29 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"exact_value": schema.StringAttribute{
 													MarkdownDescription: "Exact domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"regex_value": schema.StringAttribute{
 													MarkdownDescription: "Regular Expression value for the domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 												"suffix_value": schema.StringAttribute{
 													MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -13599,10 +15038,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -13612,14 +15057,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"path": schema.StringAttribute{
 													MarkdownDescription: "Exact path value to match.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"prefix": schema.StringAttribute{
 													MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"regex": schema.StringAttribute{
 													MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(256),
+														stringvalidator.LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 											},
 										},
@@ -13634,6 +15090,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -13642,6 +15102,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -13650,6 +15113,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -13663,11 +15129,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								Blocks: map[string]schema.Block{
 									"headers": schema.ListNestedBlock{
 										MarkdownDescription: "Headers that can be used to identify mobile traffic.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Case-insensitive HTTP header name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(256),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -13684,16 +15156,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "List of exact values to match the input against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(64),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 														"regex_values": schema.ListAttribute{
 															MarkdownDescription: "List of regular expressions to match the input against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+															},
 														},
 														"transformers": schema.ListAttribute{
 															MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(9),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -13710,6 +15196,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -13718,6 +15208,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -13726,6 +15219,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -13746,6 +15242,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -13754,6 +15254,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -13762,6 +15265,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -13794,10 +15300,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"cookie_expiry": schema.Int64Attribute{
 						MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(86400),
+						},
 					},
 					"custom_page": schema.StringAttribute{
 						MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(65536),
+						},
 					},
 				},
 			},
@@ -13821,6 +15334,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								Blocks: map[string]schema.Block{
 									"exclude_list": schema.ListNestedBlock{
 										MarkdownDescription: "Optional JavaScript insertions exclude list of domain and path matchers.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{},
 											Blocks: map[string]schema.Block{
@@ -13833,14 +15349,29 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -13850,10 +15381,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"description_spec": schema.StringAttribute{
 															MarkdownDescription: "Description. Human readable description.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -13863,14 +15400,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"path": schema.StringAttribute{
 															MarkdownDescription: "Exact path value to match.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"prefix": schema.StringAttribute{
 															MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"regex": schema.StringAttribute{
 															MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 													},
 												},
@@ -13885,6 +15433,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								Blocks: map[string]schema.Block{
 									"exclude_list": schema.ListNestedBlock{
 										MarkdownDescription: "Optional JavaScript insertions exclude list of domain and path matchers.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{},
 											Blocks: map[string]schema.Block{
@@ -13897,14 +15448,29 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -13914,10 +15480,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"description_spec": schema.StringAttribute{
 															MarkdownDescription: "Description. Human readable description.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -13927,14 +15499,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"path": schema.StringAttribute{
 															MarkdownDescription: "Exact path value to match.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"prefix": schema.StringAttribute{
 															MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"regex": schema.StringAttribute{
 															MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 													},
 												},
@@ -13943,6 +15526,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									},
 									"rules": schema.ListNestedBlock{
 										MarkdownDescription: "Required list of pages to insert Client-Side Defense client JavaScript.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{},
 											Blocks: map[string]schema.Block{
@@ -13955,14 +15541,29 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -13972,10 +15573,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"description_spec": schema.StringAttribute{
 															MarkdownDescription: "Description. Human readable description.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"name": schema.StringAttribute{
 															MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -13985,14 +15592,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"path": schema.StringAttribute{
 															MarkdownDescription: "Exact path value to match.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"prefix": schema.StringAttribute{
 															MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 														"regex": schema.StringAttribute{
 															MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(256),
+																stringvalidator.LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 													},
 												},
@@ -14011,6 +15629,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"name": schema.StringAttribute{
 						MarkdownDescription: "The name of the cookie that will be used to obtain the hash key. If the cookie is not present and TTL below is not set, no hash will be produced .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(256),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 					"path": schema.StringAttribute{
 						MarkdownDescription: "The name of the path for the cookie. If no path is specified here, no path will be set for the cookie.",
@@ -14067,11 +15689,21 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						MarkdownDescription: "Specifies the origins that will be allowed to do CORS requests. An origin is allowed if either allow_origin or allow_origin_regex match.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(128),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1)),
+						},
 					},
 					"allow_origin_regex": schema.ListAttribute{
 						MarkdownDescription: "Specifies regex patterns that match allowed origins. An origin is allowed if either allow_origin or allow_origin_regex match.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+						},
 					},
 					"disabled": schema.BoolAttribute{
 						MarkdownDescription: "Disable the CorsPolicy for a particular route. This is useful when virtual-host has CorsPolicy, but we need to disable it on a specific route. The value of this field is ignored for virtual-host.",
@@ -14084,6 +15716,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"maximum_age": schema.Int64Attribute{
 						MarkdownDescription: "Specifies the content for the access-control-max-age header in seconds. This indicates the maximum number of seconds the results can be cached A value of -1 will disable caching. Maximum permitted value is 86400 seconds (24 hours).",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(-1),
+							int64validator.AtMost(86400),
+						},
 					},
 				},
 			},
@@ -14101,6 +15737,12 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								MarkdownDescription: "List of domain names that will be matched to loadbalancer. These domains are not used for SNI match. Wildcard names are supported in the suffix or prefix form.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+									listvalidator.SizeAtLeast(1),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1)),
+								},
 							},
 						},
 					},
@@ -14111,15 +15753,29 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"data_guard_rules": schema.ListNestedBlock{
 				MarkdownDescription: "Data Guard prevents responses from exposing sensitive information by masking the data. The system masks credit card numbers and social security numbers leaked from the application from within the HTTP response with a string of asterisks (*).",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(64),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"exact_value": schema.StringAttribute{
 							MarkdownDescription: "Exact domain name.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.HostnameValidator(),
+								stringvalidator.UTF8LengthAtMost(256),
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
 						},
 						"suffix_value": schema.StringAttribute{
 							MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.HostnameValidator(),
+								stringvalidator.UTF8LengthAtMost(256),
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -14135,10 +15791,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Description. Human readable description.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -14148,14 +15810,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"path": schema.StringAttribute{
 									MarkdownDescription: "Exact path value to match.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"prefix": schema.StringAttribute{
 									MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"regex": schema.StringAttribute{
 									MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(256),
+										stringvalidator.LengthAtLeast(1),
+										validators.RegexValidator(),
+									},
 								},
 							},
 						},
@@ -14167,6 +15840,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"ddos_mitigation_rules": schema.ListNestedBlock{
 				MarkdownDescription: "Define manual mitigation rules to block L7 DDoS attacks.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(256),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"expiration_timestamp": schema.StringAttribute{
@@ -14185,6 +15862,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									MarkdownDescription: "[Enum: COUNTRY_NONE|COUNTRY_AD|COUNTRY_AE|COUNTRY_AF|COUNTRY_AG|COUNTRY_AI|COUNTRY_AL|COUNTRY_AM|COUNTRY_AN|COUNTRY_AO|COUNTRY_AQ|COUNTRY_AR|COUNTRY_AS|COUNTRY_AT|COUNTRY_AU|COUNTRY_AW|COUNTRY_AX|COUNTRY_AZ|COUNTRY_BA|COUNTRY_BB|COUNTRY_BD|COUNTRY_BE|COUNTRY_BF|COUNTRY_BG|COUNTRY_BH|COUNTRY_BI|COUNTRY_BJ|COUNTRY_BL|COUNTRY_BM|COUNTRY_BN|COUNTRY_BO|COUNTRY_BQ|COUNTRY_BR|COUNTRY_BS|COUNTRY_BT|COUNTRY_BV|COUNTRY_BW|COUNTRY_BY|COUNTRY_BZ|COUNTRY_CA|COUNTRY_CC|COUNTRY_CD|COUNTRY_CF|COUNTRY_CG|COUNTRY_CH|COUNTRY_CI|COUNTRY_CK|COUNTRY_CL|COUNTRY_CM|COUNTRY_CN|COUNTRY_CO|COUNTRY_CR|COUNTRY_CS|COUNTRY_CU|COUNTRY_CV|COUNTRY_CW|COUNTRY_CX|COUNTRY_CY|COUNTRY_CZ|COUNTRY_DE|COUNTRY_DJ|COUNTRY_DK|COUNTRY_DM|COUNTRY_DO|COUNTRY_DZ|COUNTRY_EC|COUNTRY_EE|COUNTRY_EG|COUNTRY_EH|COUNTRY_ER|COUNTRY_ES|COUNTRY_ET|COUNTRY_FI|COUNTRY_FJ|COUNTRY_FK|COUNTRY_FM|COUNTRY_FO|COUNTRY_FR|COUNTRY_GA|COUNTRY_GB|COUNTRY_GD|COUNTRY_GE|COUNTRY_GF|COUNTRY_GG|COUNTRY_GH|COUNTRY_GI|COUNTRY_GL|COUNTRY_GM|COUNTRY_GN|COUNTRY_GP|COUNTRY_GQ|COUNTRY_GR|COUNTRY_GS|COUNTRY_GT|COUNTRY_GU|COUNTRY_GW|COUNTRY_GY|COUNTRY_HK|COUNTRY_HM|COUNTRY_HN|COUNTRY_HR|COUNTRY_HT|COUNTRY_HU|COUNTRY_ID|COUNTRY_IE|COUNTRY_IL|COUNTRY_IM|COUNTRY_IN|COUNTRY_IO|COUNTRY_IQ|COUNTRY_IR|COUNTRY_IS|COUNTRY_IT|COUNTRY_JE|COUNTRY_JM|COUNTRY_JO|COUNTRY_JP|COUNTRY_KE|COUNTRY_KG|COUNTRY_KH|COUNTRY_KI|COUNTRY_KM|COUNTRY_KN|COUNTRY_KP|COUNTRY_KR|COUNTRY_KW|COUNTRY_KY|COUNTRY_KZ|COUNTRY_LA|COUNTRY_LB|COUNTRY_LC|COUNTRY_LI|COUNTRY_LK|COUNTRY_LR|COUNTRY_LS|COUNTRY_LT|COUNTRY_LU|COUNTRY_LV|COUNTRY_LY|COUNTRY_MA|COUNTRY_MC|COUNTRY_MD|COUNTRY_ME|COUNTRY_MF|COUNTRY_MG|COUNTRY_MH|COUNTRY_MK|COUNTRY_ML|COUNTRY_MM|COUNTRY_MN|COUNTRY_MO|COUNTRY_MP|COUNTRY_MQ|COUNTRY_MR|COUNTRY_MS|COUNTRY_MT|COUNTRY_MU|COUNTRY_MV|COUNTRY_MW|COUNTRY_MX|COUNTRY_MY|COUNTRY_MZ|COUNTRY_NA|COUNTRY_NC|COUNTRY_NE|COUNTRY_NF|COUNTRY_NG|COUNTRY_NI|COUNTRY_NL|COUNTRY_NO|COUNTRY_NP|COUNTRY_NR|COUNTRY_NU|COUNTRY_NZ|COUNTRY_OM|COUNTRY_PA|COUNTRY_PE|COUNTRY_PF|COUNTRY_PG|COUNTRY_PH|COUNTRY_PK|COUNTRY_PL|COUNTRY_PM|COUNTRY_PN|COUNTRY_PR|COUNTRY_PS|COUNTRY_PT|COUNTRY_PW|COUNTRY_PY|COUNTRY_QA|COUNTRY_RE|COUNTRY_RO|COUNTRY_RS|COUNTRY_RU|COUNTRY_RW|COUNTRY_SA|COUNTRY_SB|COUNTRY_SC|COUNTRY_SD|COUNTRY_SE|COUNTRY_SG|COUNTRY_SH|COUNTRY_SI|COUNTRY_SJ|COUNTRY_SK|COUNTRY_SL|COUNTRY_SM|COUNTRY_SN|COUNTRY_SO|COUNTRY_SR|COUNTRY_SS|COUNTRY_ST|COUNTRY_SV|COUNTRY_SX|COUNTRY_SY|COUNTRY_SZ|COUNTRY_TC|COUNTRY_TD|COUNTRY_TF|COUNTRY_TG|COUNTRY_TH|COUNTRY_TJ|COUNTRY_TK|COUNTRY_TL|COUNTRY_TM|COUNTRY_TN|COUNTRY_TO|COUNTRY_TR|COUNTRY_TT|COUNTRY_TV|COUNTRY_TW|COUNTRY_TZ|COUNTRY_UA|COUNTRY_UG|COUNTRY_UM|COUNTRY_US|COUNTRY_UY|COUNTRY_UZ|COUNTRY_VA|COUNTRY_VC|COUNTRY_VE|COUNTRY_VG|COUNTRY_VI|COUNTRY_VN|COUNTRY_VU|COUNTRY_WF|COUNTRY_WS|COUNTRY_XK|COUNTRY_XT|COUNTRY_YE|COUNTRY_YT|COUNTRY_ZA|COUNTRY_ZM|COUNTRY_ZW] Sources that are located in one of the countries in the given list. Possible values are `COUNTRY_NONE`, `COUNTRY_AD`, `COUNTRY_AE`, `COUNTRY_AF`, `COUNTRY_AG`, `COUNTRY_AI`, `COUNTRY_AL`, `COUNTRY_AM`, `COUNTRY_AN`, `COUNTRY_AO`, `COUNTRY_AQ`, `COUNTRY_AR`, `COUNTRY_AS`, `COUNTRY_AT`, `COUNTRY_AU`, `COUNTRY_AW`, `COUNTRY_AX`, `COUNTRY_AZ`, `COUNTRY_BA`, `COUNTRY_BB`, `COUNTRY_BD`, `COUNTRY_BE`, `COUNTRY_BF`, `COUNTRY_BG`, `COUNTRY_BH`, `COUNTRY_BI`, `COUNTRY_BJ`, `COUNTRY_BL`, `COUNTRY_BM`, `COUNTRY_BN`, `COUNTRY_BO`, `COUNTRY_BQ`, `COUNTRY_BR`, `COUNTRY_BS`, `COUNTRY_BT`, `COUNTRY_BV`, `COUNTRY_BW`, `COUNTRY_BY`, `COUNTRY_BZ`, `COUNTRY_CA`, `COUNTRY_CC`, `COUNTRY_CD`, `COUNTRY_CF`, `COUNTRY_CG`, `COUNTRY_CH`, `COUNTRY_CI`, `COUNTRY_CK`, `COUNTRY_CL`, `COUNTRY_CM`, `COUNTRY_CN`, `COUNTRY_CO`, `COUNTRY_CR`, `COUNTRY_CS`, `COUNTRY_CU`, `COUNTRY_CV`, `COUNTRY_CW`, `COUNTRY_CX`, `COUNTRY_CY`, `COUNTRY_CZ`, `COUNTRY_DE`, `COUNTRY_DJ`, `COUNTRY_DK`, `COUNTRY_DM`, `COUNTRY_DO`, `COUNTRY_DZ`, `COUNTRY_EC`, `COUNTRY_EE`, `COUNTRY_EG`, `COUNTRY_EH`, `COUNTRY_ER`, `COUNTRY_ES`, `COUNTRY_ET`, `COUNTRY_FI`, `COUNTRY_FJ`, `COUNTRY_FK`, `COUNTRY_FM`, `COUNTRY_FO`, `COUNTRY_FR`, `COUNTRY_GA`, `COUNTRY_GB`, `COUNTRY_GD`, `COUNTRY_GE`, `COUNTRY_GF`, `COUNTRY_GG`, `COUNTRY_GH`, `COUNTRY_GI`, `COUNTRY_GL`, `COUNTRY_GM`, `COUNTRY_GN`, `COUNTRY_GP`, `COUNTRY_GQ`, `COUNTRY_GR`, `COUNTRY_GS`, `COUNTRY_GT`, `COUNTRY_GU`, `COUNTRY_GW`, `COUNTRY_GY`, `COUNTRY_HK`, `COUNTRY_HM`, `COUNTRY_HN`, `COUNTRY_HR`, `COUNTRY_HT`, `COUNTRY_HU`, `COUNTRY_ID`, `COUNTRY_IE`, `COUNTRY_IL`, `COUNTRY_IM`, `COUNTRY_IN`, `COUNTRY_IO`, `COUNTRY_IQ`, `COUNTRY_IR`, `COUNTRY_IS`, `COUNTRY_IT`, `COUNTRY_JE`, `COUNTRY_JM`, `COUNTRY_JO`, `COUNTRY_JP`, `COUNTRY_KE`, `COUNTRY_KG`, `COUNTRY_KH`, `COUNTRY_KI`, `COUNTRY_KM`, `COUNTRY_KN`, `COUNTRY_KP`, `COUNTRY_KR`, `COUNTRY_KW`, `COUNTRY_KY`, `COUNTRY_KZ`, `COUNTRY_LA`, `COUNTRY_LB`, `COUNTRY_LC`, `COUNTRY_LI`, `COUNTRY_LK`, `COUNTRY_LR`, `COUNTRY_LS`, `COUNTRY_LT`, `COUNTRY_LU`, `COUNTRY_LV`, `COUNTRY_LY`, `COUNTRY_MA`, `COUNTRY_MC`, `COUNTRY_MD`, `COUNTRY_ME`, `COUNTRY_MF`, `COUNTRY_MG`, `COUNTRY_MH`, `COUNTRY_MK`, `COUNTRY_ML`, `COUNTRY_MM`, `COUNTRY_MN`, `COUNTRY_MO`, `COUNTRY_MP`, `COUNTRY_MQ`, `COUNTRY_MR`, `COUNTRY_MS`, `COUNTRY_MT`, `COUNTRY_MU`, `COUNTRY_MV`, `COUNTRY_MW`, `COUNTRY_MX`, `COUNTRY_MY`, `COUNTRY_MZ`, `COUNTRY_NA`, `COUNTRY_NC`, `COUNTRY_NE`, `COUNTRY_NF`, `COUNTRY_NG`, `COUNTRY_NI`, `COUNTRY_NL`, `COUNTRY_NO`, `COUNTRY_NP`, `COUNTRY_NR`, `COUNTRY_NU`, `COUNTRY_NZ`, `COUNTRY_OM`, `COUNTRY_PA`, `COUNTRY_PE`, `COUNTRY_PF`, `COUNTRY_PG`, `COUNTRY_PH`, `COUNTRY_PK`, `COUNTRY_PL`, `COUNTRY_PM`, `COUNTRY_PN`, `COUNTRY_PR`, `COUNTRY_PS`, `COUNTRY_PT`, `COUNTRY_PW`, `COUNTRY_PY`, `COUNTRY_QA`, `COUNTRY_RE`, `COUNTRY_RO`, `COUNTRY_RS`, `COUNTRY_RU`, `COUNTRY_RW`, `COUNTRY_SA`, `COUNTRY_SB`, `COUNTRY_SC`, `COUNTRY_SD`, `COUNTRY_SE`, `COUNTRY_SG`, `COUNTRY_SH`, `COUNTRY_SI`, `COUNTRY_SJ`, `COUNTRY_SK`, `COUNTRY_SL`, `COUNTRY_SM`, `COUNTRY_SN`, `COUNTRY_SO`, `COUNTRY_SR`, `COUNTRY_SS`, `COUNTRY_ST`, `COUNTRY_SV`, `COUNTRY_SX`, `COUNTRY_SY`, `COUNTRY_SZ`, `COUNTRY_TC`, `COUNTRY_TD`, `COUNTRY_TF`, `COUNTRY_TG`, `COUNTRY_TH`, `COUNTRY_TJ`, `COUNTRY_TK`, `COUNTRY_TL`, `COUNTRY_TM`, `COUNTRY_TN`, `COUNTRY_TO`, `COUNTRY_TR`, `COUNTRY_TT`, `COUNTRY_TV`, `COUNTRY_TW`, `COUNTRY_TZ`, `COUNTRY_UA`, `COUNTRY_UG`, `COUNTRY_UM`, `COUNTRY_US`, `COUNTRY_UY`, `COUNTRY_UZ`, `COUNTRY_VA`, `COUNTRY_VC`, `COUNTRY_VE`, `COUNTRY_VG`, `COUNTRY_VI`, `COUNTRY_VN`, `COUNTRY_VU`, `COUNTRY_WF`, `COUNTRY_WS`, `COUNTRY_XK`, `COUNTRY_XT`, `COUNTRY_YE`, `COUNTRY_YT`, `COUNTRY_ZA`, `COUNTRY_ZM`, `COUNTRY_ZW`. Defaults to `COUNTRY_NONE`.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(64),
+										listvalidator.UniqueValues(),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -14195,6 +15876,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 											Optional:            true,
 											ElementType:         types.Int64Type,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.SizeAtLeast(1),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 								},
@@ -14205,6 +15891,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "List of exact JA4 TLS fingerprint to match the input JA4 TLS fingerprint against.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(36, 36)),
+											},
 										},
 									},
 								},
@@ -14215,16 +15906,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+											},
 										},
 										"exact_values": schema.ListAttribute{
 											MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+											},
 										},
 										"excluded_values": schema.ListAttribute{
 											MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(32),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+											},
 										},
 									},
 								},
@@ -14241,6 +15946,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(128),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+									},
 								},
 							},
 						},
@@ -14250,10 +15960,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Description. Human readable description.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -14270,6 +15986,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"health_check_port": schema.Int64Attribute{
 						MarkdownDescription: "Port used for performing health check.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(65535),
+						},
 					},
 					"loadbalancer_algorithm": schema.StringAttribute{
 						MarkdownDescription: "[Enum: ROUND_ROBIN|LEAST_REQUEST|RING_HASH|RANDOM|LB_OVERRIDE] Different load balancing algorithms supported When a connection to a endpoint in an upstream cluster is required, the load balancer uses loadbalancer_algorithm to determine which host is selected. - ROUND_ROBIN: ROUND_ROBIN Policy in which each healthy/available upstream endpoint is selected in.. Possible values are `ROUND_ROBIN`, `LEAST_REQUEST`, `RING_HASH`, `RANDOM`, `LB_OVERRIDE`. Defaults to `ROUND_ROBIN`. Server applies default when omitted.",
@@ -14278,6 +15997,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"port": schema.Int64Attribute{
 						MarkdownDescription: "Endpoint service is available on this port.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(65535),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -14287,14 +16010,23 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"connection_timeout": schema.Int64Attribute{
 								MarkdownDescription: "The timeout for new network connections to endpoints in the cluster. This is specified in milliseconds. The  seconds. Defaults to `2`.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(1800000),
+								},
 							},
 							"http_idle_timeout": schema.Int64Attribute{
 								MarkdownDescription: "The idle timeout for upstream connection pool connections. The idle timeout is defined as the period in which there are no active requests. When the idle timeout is reached the connection will be closed.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(600000),
+								},
 							},
 							"panic_threshold": schema.Int64Attribute{
 								MarkdownDescription: "Configure a threshold (percentage of unhealthy endpoints) below which all endpoints will be considered for load balancing ignoring its health status.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(100),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -14307,14 +16039,23 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									"connection_limit": schema.Int64Attribute{
 										MarkdownDescription: "The maximum number of connections that loadbalancer will establish to all hosts in an upstream cluster. In practice this is only applicable to TCP and HTTP/1.1 clusters since HTTP/2 uses a single connection to each host. Remove endpoint out of load balancing decision, if number of connections..",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(32768),
+										},
 									},
 									"max_requests": schema.Int64Attribute{
 										MarkdownDescription: "The maximum number of requests that can be outstanding to all hosts in a cluster at any given time. In practice this is applicable to HTTP/2 clusters since HTTP/1.1 clusters are governed by the maximum connections (connection_limit). Remove endpoint out of load balancing decision, if requests..",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(32768),
+										},
 									},
 									"pending_requests": schema.Int64Attribute{
 										MarkdownDescription: "The maximum number of requests that will be queued while waiting for a ready connection pool connection. Since HTTP/2 requests are sent over a single connection, this circuit breaker only comes into play as the initial connection is created, as requests will be multiplexed immediately..",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(32768),
+										},
 									},
 									"priority": schema.StringAttribute{
 										MarkdownDescription: "[Enum: DEFAULT|HIGH] Priority routing for each request. Different connection pools are used based on the priority selected for the request. Also, circuit-breaker configuration at destination cluster is chosen based on selected priority. Possible values are `DEFAULT`, `HIGH`. Defaults to `DEFAULT`.",
@@ -14323,6 +16064,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									"retries": schema.Int64Attribute{
 										MarkdownDescription: "The maximum number of retries that can be outstanding to all hosts in a cluster at any given time. Remove endpoint out of load balancing decision, if retries for request exceed this count.",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(32768),
+										},
 									},
 								},
 							},
@@ -14365,12 +16109,19 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									},
 									"endpoint_subsets": schema.ListNestedBlock{
 										MarkdownDescription: "List of subset class. Subsets class is defined using list of keys. Every unique combination of values of these keys form a subset withing the class.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"keys": schema.ListAttribute{
 													MarkdownDescription: "List of keys that define a cluster subset class.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -14422,22 +16173,37 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									"base_ejection_time": schema.Int64Attribute{
 										MarkdownDescription: "The base time that a host is ejected for. The real time is equal to the base time multiplied by the number of times the host has been ejected. This causes hosts to GET ejected for longer periods if they continue to fail.",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(1800000),
+										},
 									},
 									"consecutive_5xx": schema.Int64Attribute{
 										MarkdownDescription: "If an upstream endpoint returns some number of consecutive 5xx, it will be ejected. Note that in this case a 5xx means an actual 5xx respond code, or an event that would cause the HTTP router to return one on the upstream’s behalf(reset, connection failure, etc.) consecutive_5xx indicates the..",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(1024),
+										},
 									},
 									"consecutive_gateway_failure": schema.Int64Attribute{
 										MarkdownDescription: "If an upstream endpoint returns some number of consecutive “gateway errors” (502, 503 or 504 status code), it will be ejected. Note that this includes events that would cause the HTTP router to return one of these status codes on the upstream’s behalf (reset, connection failure, etc.)..",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(1024),
+										},
 									},
 									"interval": schema.Int64Attribute{
 										MarkdownDescription: "The time interval between ejection analysis sweeps. This can result in both new ejections as well as endpoints being returned to service. Defaults to `10000ms`.",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(600000),
+										},
 									},
 									"max_ejection_percent": schema.Int64Attribute{
 										MarkdownDescription: "The maximum % of an upstream cluster that can be ejected due to outlier detection.  but will eject at least one host regardless of the value. Defaults to `10%`.",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(100),
+										},
 									},
 								},
 							},
@@ -14454,11 +16220,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					},
 					"healthcheck": schema.ListNestedBlock{
 						MarkdownDescription: "Reference to healthcheck configuration objects. Defaults to `[]`. Server applies default when omitted.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(4),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -14467,6 +16240,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -14475,6 +16251,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -14487,6 +16266,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					},
 					"origin_servers": schema.ListNestedBlock{
 						MarkdownDescription: "List of origin servers in this pool .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{},
 							Blocks: map[string]schema.Block{
@@ -14524,6 +16306,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"name": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(128),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 														"namespace": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -14532,6 +16318,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 														"tenant": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -14540,6 +16329,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 													},
 												},
@@ -14549,6 +16341,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"name": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(128),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 														"namespace": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -14557,6 +16353,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 														"tenant": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -14565,6 +16364,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 													},
 												},
@@ -14584,6 +16386,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(128),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+															},
 														},
 													},
 												},
@@ -14601,6 +16408,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -14609,6 +16420,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -14617,6 +16431,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -14651,6 +16468,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"name": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(128),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 														"namespace": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -14659,6 +16480,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 														"tenant": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -14667,6 +16491,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 													},
 												},
@@ -14676,6 +16503,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"name": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(128),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 														"namespace": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -14684,6 +16515,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 														"tenant": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -14692,6 +16526,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 													},
 												},
@@ -14711,6 +16548,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(128),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+															},
 														},
 													},
 												},
@@ -14730,6 +16572,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"ip": schema.StringAttribute{
 											MarkdownDescription: "IP. Private IPv4 address.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -14745,6 +16590,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -14753,6 +16602,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -14761,6 +16613,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -14774,6 +16629,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"name": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(128),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 														"namespace": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -14782,6 +16641,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 														"tenant": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -14790,6 +16652,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 													},
 												},
@@ -14799,6 +16664,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"name": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(128),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 														"namespace": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -14807,6 +16676,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 														"tenant": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -14815,6 +16687,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 													},
 												},
@@ -14834,6 +16709,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(128),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+															},
 														},
 													},
 												},
@@ -14866,6 +16746,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -14874,6 +16758,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -14882,6 +16769,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -14895,6 +16785,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"name": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(128),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 														"namespace": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -14903,6 +16797,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 														"tenant": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -14911,6 +16808,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 													},
 												},
@@ -14920,6 +16820,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"name": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(128),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 														"namespace": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -14928,6 +16832,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 														"tenant": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -14936,6 +16843,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 													},
 												},
@@ -14955,6 +16865,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(128),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+															},
 														},
 													},
 												},
@@ -14968,6 +16883,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"ip": schema.StringAttribute{
 											MarkdownDescription: "Public IPv4. Public IPv4 address.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 									},
 								},
@@ -14977,6 +16895,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"dns_name": schema.StringAttribute{
 											MarkdownDescription: "DNS Name. DNS Name .",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.HostnameValidator(),
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 										"refresh_interval": schema.Int64Attribute{
 											MarkdownDescription: "Interval for DNS refresh in seconds. Max value is 7 days as per https://datatracker.ietf.org/doc/HTML/rfc8767.",
@@ -14990,6 +16913,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"ip": schema.StringAttribute{
 											MarkdownDescription: "IPv4. IPv4 address.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -14999,6 +16925,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15007,6 +16937,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15015,6 +16948,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -15035,6 +16971,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15043,6 +16983,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15051,6 +16994,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -15080,10 +17026,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"max_session_keys": schema.Int64Attribute{
 								MarkdownDescription: "Number of session keys that are cached.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(2),
+									int64validator.AtMost(64),
+								},
 							},
 							"sni": schema.StringAttribute{
 								MarkdownDescription: "SNI value to be used.",
 								Optional:            true,
+								Validators: []validator.String{
+									validators.HostnameValidator(),
+									stringvalidator.UTF8LengthAtMost(256),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -15113,6 +17067,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -15144,11 +17101,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								Blocks: map[string]schema.Block{
 									"tls_certificates": schema.ListNestedBlock{
 										MarkdownDescription: "MTLS Client Certificate. MTLS Client Certificate .",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"certificate_url": schema.StringAttribute{
 													MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(131072),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Description for the certificate.",
@@ -15163,6 +17127,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -15198,7 +17167,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -15219,6 +17197,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15227,6 +17209,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15235,6 +17220,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -15244,6 +17232,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Origin Pool for verification of server's certificate.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -15253,6 +17245,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15261,6 +17257,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15269,6 +17268,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -15285,6 +17287,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15293,6 +17299,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15301,6 +17310,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -15312,11 +17324,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 				Blocks: map[string]schema.Block{
 					"pools": schema.ListNestedBlock{
 						MarkdownDescription: "Origin Pools. List of Origin Pools.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(8),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"priority": schema.Int64Attribute{
 									MarkdownDescription: "Priority of this origin pool, valid only with multiple origin pools. Value of 0 will make the pool as lowest priority origin pool Priority of 1 means highest priority and is considered active. When active origin pool is not available, lower priority origin pools are made active as per the..",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtMost(32),
+									},
 								},
 								"weight": schema.Int64Attribute{
 									MarkdownDescription: "Weight of this origin pool, valid only with multiple origin pool. Value of 0 will disable the pool.",
@@ -15330,6 +17348,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15338,6 +17360,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15346,6 +17371,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -15358,6 +17386,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15366,6 +17398,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15374,6 +17409,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -15384,11 +17422,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"default_route_pools": schema.ListNestedBlock{
 				MarkdownDescription: "Origin Pools used when no route is specified (default route).",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(8),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"priority": schema.Int64Attribute{
 							MarkdownDescription: "Priority of this origin pool, valid only with multiple origin pools. Value of 0 will make the pool as lowest priority origin pool Priority of 1 means highest priority and is considered active. When active origin pool is not available, lower priority origin pools are made active as per the..",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtMost(32),
+							},
 						},
 						"weight": schema.Int64Attribute{
 							MarkdownDescription: "Weight of this origin pool, valid only with multiple origin pool. Value of 0 will disable the pool.",
@@ -15402,6 +17447,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15410,6 +17459,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15418,6 +17470,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -15430,6 +17485,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15438,6 +17497,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15446,6 +17508,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -15511,11 +17576,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								Blocks: map[string]schema.Block{
 									"domains": schema.ListNestedBlock{
 										MarkdownDescription: "Enter domains and their credentials to allow authenticated API crawling. You can only include domains you own that are associated with this Load Balancer.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"domain": schema.StringAttribute{
 													MarkdownDescription: "Select the domain to execute API Crawling with given credentials.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -15525,6 +17596,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"user": schema.StringAttribute{
 															MarkdownDescription: "Enter the username to assign credentials for the selected domain to crawl.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(64),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -15557,7 +17631,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																			Optional:            true,
 																		},
 																		"url": schema.StringAttribute{
-																			MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																			MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																			Optional:            true,
+																			Sensitive:           true,
+																			WriteOnly:           true,
+																			Validators: []validator.String{
+																				stringvalidator.LengthAtMost(131072),
+																			},
+																		},
+																		"url_version": schema.Int64Attribute{
+																			MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																			Optional:            true,
 																		},
 																	},
@@ -15582,6 +17665,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						Blocks: map[string]schema.Block{
 							"code_base_integrations": schema.ListNestedBlock{
 								MarkdownDescription: "Select Code Base Integrations. .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(5),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{},
 									Blocks: map[string]schema.Block{
@@ -15594,6 +17680,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15602,6 +17692,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15610,6 +17703,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -15638,6 +17734,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15646,6 +17746,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15654,6 +17757,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -15671,6 +17777,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"purge_duration_for_inactive_discovered_apis": schema.Int64Attribute{
 								MarkdownDescription: "Inactive discovered API will be deleted after configured duration.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(7),
+								},
 							},
 						},
 					},
@@ -15689,10 +17799,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(86400),
+								},
 							},
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 						},
 					},
@@ -15711,14 +17828,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(86400),
+								},
 							},
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 							"js_script_delay": schema.Int64Attribute{
 								MarkdownDescription: "Delay introduced by Javascript, in milliseconds.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1000),
+									int64validator.AtMost(60000),
+								},
 							},
 						},
 					},
@@ -15728,6 +17856,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15736,6 +17868,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15744,6 +17879,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -15756,6 +17894,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						MarkdownDescription: "[Enum: SPAM_SOURCES|WINDOWS_EXPLOITS|WEB_ATTACKS|BOTNETS|SCANNERS|REPUTATION|PHISHING|PROXY|MOBILE_THREATS|TOR_PROXY|DENIAL_OF_SERVICE|NETWORK] If the source IP matches on atleast one of the enabled IP threat categories, the request will be denied. Possible values are `SPAM_SOURCES`, `WINDOWS_EXPLOITS`, `WEB_ATTACKS`, `BOTNETS`, `SCANNERS`, `REPUTATION`, `PHISHING`, `PROXY`, `MOBILE_THREATS`, `TOR_PROXY`, `DENIAL_OF_SERVICE`, `NETWORK`. Defaults to `SPAM_SOURCES`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+							listvalidator.UniqueValues(),
+						},
 					},
 				},
 			},
@@ -15772,24 +17914,47 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						MarkdownDescription: "Define the list of one or more Client IP Headers. Headers will be used in order from top to bottom, meaning if the first header is not present in the request, the system will proceed to check for the second header, and so on, until one of the listed headers is found. If none of the defined..",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(5),
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 				},
 			},
 			"graphql_rules": schema.ListNestedBlock{
 				MarkdownDescription: "GraphQL is a query language and server-side runtime for APIs which provides a complete and understandable description of the data in API. GraphQL gives clients the power to ask for exactly what they need, makes it easier to evolve APIs over time, and enables powerful developer tools. Policy..",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(64),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"exact_path": schema.StringAttribute{
 							MarkdownDescription: "Specifies the exact path to GraphQL endpoint. Defaults to `/graphql`.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 						"exact_value": schema.StringAttribute{
 							MarkdownDescription: "Exact domain name.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.HostnameValidator(),
+								stringvalidator.UTF8LengthAtMost(256),
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
 						},
 						"suffix_value": schema.StringAttribute{
 							MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.HostnameValidator(),
+								stringvalidator.UTF8LengthAtMost(256),
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -15802,14 +17967,26 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"max_batched_queries": schema.Int64Attribute{
 									MarkdownDescription: "Specify maximum number of queries in a single batched request.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(20),
+									},
 								},
 								"max_depth": schema.Int64Attribute{
 									MarkdownDescription: "Specify maximum depth for the GraphQL query.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(20),
+									},
 								},
 								"max_total_length": schema.Int64Attribute{
 									MarkdownDescription: "Specify maximum length in bytes for the GraphQL query.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(16386),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -15827,10 +18004,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Description. Human readable description.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -15853,10 +18036,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"port": schema.Int64Attribute{
 						MarkdownDescription: "HTTP port to Listen.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(65535),
+						},
 					},
 					"port_ranges": schema.StringAttribute{
 						MarkdownDescription: "A string containing a comma separated list of port ranges. Each port range consists of a single port or two ports separated by '-'.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(512),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 				},
 			},
@@ -15870,10 +18060,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"append_server_name": schema.StringAttribute{
 						MarkdownDescription: "Define the header value for the header name “server”. If header value is already present, it is not overwritten and passed as-is.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(8096),
+						},
 					},
 					"connection_idle_timeout": schema.Int64Attribute{
 						MarkdownDescription: "The idle timeout for downstream connections. The idle timeout is defined as the period in which there are no active requests. When the idle timeout is reached the connection will be closed.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(600000),
+						},
 					},
 					"http_redirect": schema.BoolAttribute{
 						MarkdownDescription: "HTTP Redirect to HTTPS. Redirect HTTP traffic to HTTPS.",
@@ -15882,14 +18078,24 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"port": schema.Int64Attribute{
 						MarkdownDescription: "HTTPS port to Listen.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(65535),
+						},
 					},
 					"port_ranges": schema.StringAttribute{
 						MarkdownDescription: "A string containing a comma separated list of port ranges. Each port range consists of a single port or two ports separated by '-'.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(512),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 					"server_name": schema.StringAttribute{
 						MarkdownDescription: "Define the header value for the header name “server”. This will overwrite existing values, if any, for the server header.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(8096),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -15965,11 +18171,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						Blocks: map[string]schema.Block{
 							"certificates": schema.ListNestedBlock{
 								MarkdownDescription: "Select one or more certificates with any domain names.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -15978,6 +18191,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -15986,6 +18202,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -16004,6 +18223,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -16036,6 +18258,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -16045,6 +18271,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -16053,6 +18283,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -16061,6 +18294,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -16073,6 +18309,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -16081,6 +18321,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -16089,6 +18332,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -16118,11 +18364,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							},
 							"tls_certificates": schema.ListNestedBlock{
 								MarkdownDescription: "Users can add one or more certificates that share the same set of domains. For example, domain.com and *.domain.com - but use different signature algorithms .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -16137,6 +18390,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -16172,7 +18430,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -16196,6 +18463,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -16228,6 +18498,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -16237,6 +18511,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -16245,6 +18523,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -16253,6 +18534,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -16265,6 +18549,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -16273,6 +18561,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -16281,6 +18572,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -16313,10 +18607,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"append_server_name": schema.StringAttribute{
 						MarkdownDescription: "Define the header value for the header name “server”. If header value is already present, it is not overwritten and passed as-is.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(8096),
+						},
 					},
 					"connection_idle_timeout": schema.Int64Attribute{
 						MarkdownDescription: "The idle timeout for downstream connections. The idle timeout is defined as the period in which there are no active requests. When the idle timeout is reached the connection will be closed.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(600000),
+						},
 					},
 					"http_redirect": schema.BoolAttribute{
 						MarkdownDescription: "HTTP Redirect to HTTPS. Redirect HTTP traffic to HTTPS.",
@@ -16325,14 +18625,24 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"port": schema.Int64Attribute{
 						MarkdownDescription: "HTTPS port to Listen.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(65535),
+						},
 					},
 					"port_ranges": schema.StringAttribute{
 						MarkdownDescription: "A string containing a comma separated list of port ranges. Each port range consists of a single port or two ports separated by '-'.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(512),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 					"server_name": schema.StringAttribute{
 						MarkdownDescription: "Define the header value for the header name “server”. This will overwrite existing values, if any, for the server header.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(8096),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -16416,6 +18726,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.UniqueValues(),
+										},
 									},
 									"max_version": schema.StringAttribute{
 										MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -16448,6 +18761,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"trusted_ca_url": schema.StringAttribute{
 								MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -16457,6 +18774,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -16465,6 +18786,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -16473,6 +18797,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -16485,6 +18812,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -16493,6 +18824,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -16501,6 +18835,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -16527,14 +18864,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"cookie_expiry": schema.Int64Attribute{
 						MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(86400),
+						},
 					},
 					"custom_page": schema.StringAttribute{
 						MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(65536),
+						},
 					},
 					"js_script_delay": schema.Int64Attribute{
 						MarkdownDescription: "Delay introduced by Javascript, in milliseconds.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1000),
+							int64validator.AtMost(60000),
+						},
 					},
 				},
 			},
@@ -16570,6 +18918,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								MarkdownDescription: "Claim Names. Human-readable name for the resource",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+									listvalidator.UniqueValues(),
+								},
 							},
 						},
 					},
@@ -16589,6 +18941,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										MarkdownDescription: "Values.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(16),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.UniqueValues(),
+										},
 									},
 								},
 							},
@@ -16620,6 +18977,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										MarkdownDescription: "API Groups. .",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+										},
 									},
 								},
 							},
@@ -16630,6 +18992,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										MarkdownDescription: "Prefix Values. .",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(16),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+										},
 									},
 								},
 							},
@@ -16658,14 +19025,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"cookie_expiry": schema.Int64Attribute{
 						MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(86400),
+						},
 					},
 					"custom_page": schema.StringAttribute{
 						MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(65536),
+						},
 					},
 					"js_script_delay": schema.Int64Attribute{
 						MarkdownDescription: "Delay introduced by Javascript, in milliseconds.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1000),
+							int64validator.AtMost(60000),
+						},
 					},
 				},
 			},
@@ -16675,6 +19053,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"rps_threshold": schema.Int64Attribute{
 						MarkdownDescription: "Configure custom RPS threshold.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(20000),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -16684,10 +19066,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(86400),
+								},
 							},
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 						},
 					},
@@ -16697,14 +19086,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(86400),
+								},
 							},
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 							"js_script_delay": schema.Int64Attribute{
 								MarkdownDescription: "Delay introduced by Javascript, in milliseconds.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1000),
+									int64validator.AtMost(60000),
+								},
 							},
 						},
 					},
@@ -16717,6 +19117,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -16725,6 +19129,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -16733,6 +19140,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -16751,10 +19161,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(86400),
+								},
 							},
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 						},
 					},
@@ -16764,14 +19181,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(86400),
+								},
 							},
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 							"js_script_delay": schema.Int64Attribute{
 								MarkdownDescription: "Delay introduced by Javascript, in milliseconds.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1000),
+									int64validator.AtMost(60000),
+								},
 							},
 						},
 					},
@@ -16786,12 +19214,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 				Blocks: map[string]schema.Block{
 					"malware_protection_rules": schema.ListNestedBlock{
 						MarkdownDescription: "Configure the match criteria to trigger Malware Protection Scan .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"http_methods": schema.ListAttribute{
 									MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] HTTP Methods. Methods to be matched. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.UniqueValues(),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -16820,14 +19254,29 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"exact_value": schema.StringAttribute{
 													MarkdownDescription: "Exact domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"regex_value": schema.StringAttribute{
 													MarkdownDescription: "Regular Expression value for the domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 												"suffix_value": schema.StringAttribute{
 													MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -16839,10 +19288,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Human readable description.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 								},
@@ -16852,14 +19307,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"path": schema.StringAttribute{
 											MarkdownDescription: "Exact path value to match.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"prefix": schema.StringAttribute{
 											MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"regex": schema.StringAttribute{
 											MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(256),
+												stringvalidator.LengthAtLeast(1),
+												validators.RegexValidator(),
+											},
 										},
 									},
 								},
@@ -16878,30 +19344,56 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"idle_timeout": schema.Int64Attribute{
 						MarkdownDescription: "The amount of time that a stream can exist without upstream or downstream activity, in milliseconds. The stream is terminated with a HTTP 504 (Gateway Timeout) error code if no upstream response header has been received, otherwise the stream is reset.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(3600000),
+						},
 					},
 					"max_request_header_size": schema.Int64Attribute{
 						MarkdownDescription: "The maximum request header size for downstream connections, in KiB. A HTTP 431 (Request Header Fields Too Large) error code is sent for requests that exceed this size. If multiple load balancers share the same advertise_policy, the highest value configured across all such load balancers is used..",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(96),
+						},
 					},
 					"request_cookies_to_remove": schema.ListAttribute{
 						MarkdownDescription: "List of keys of Cookies to be removed from the HTTP request being sent towards upstream.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 					"request_headers_to_remove": schema.ListAttribute{
 						MarkdownDescription: "List of keys of Headers to be removed from the HTTP request being sent towards upstream.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 					"response_cookies_to_remove": schema.ListAttribute{
 						MarkdownDescription: "List of name of Cookies to be removed from the HTTP response being sent towards downstream. Entire set-cookie header will be removed.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 					"response_headers_to_remove": schema.ListAttribute{
 						MarkdownDescription: "List of keys of Headers to be removed from the HTTP response being sent towards downstream.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -16915,6 +19407,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"max_request_bytes": schema.Int64Attribute{
 								MarkdownDescription: "The maximum request size that the filter will buffer before the connection manager will stop buffering and return a RequestEntityTooLarge (413) response.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(10485760),
+								},
 							},
 						},
 					},
@@ -16924,11 +19419,19 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"content_length": schema.Int64Attribute{
 								MarkdownDescription: "Minimum response length, in bytes, which will trigger compression. The. Defaults to `30`.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(30),
+								},
 							},
 							"content_type": schema.ListAttribute{
 								MarkdownDescription: "Set of strings that allows specifying which mime-types yield compression When this field is not defined, compression will be applied to the following mime-types: 'application/javascript' 'application/JSON', 'application/xhtml+XML' 'image/svg+XML' 'text/CSS' 'text/HTML' 'text/plain' 'text/XML'.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(50),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+								},
 							},
 							"disable_on_etag_header": schema.BoolAttribute{
 								MarkdownDescription: "If true, disables compression when the response contains an etag header. When it is false, weak etags will be preserved and the ones that require strong validation will be removed.",
@@ -16951,11 +19454,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					},
 					"request_cookies_to_add": schema.ListNestedBlock{
 						MarkdownDescription: "Cookies are key-value pairs to be added to HTTP request being routed towards upstream. Cookies specified at this level are applied after cookies from matched Route are applied.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the cookie in Cookie header.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"overwrite": schema.BoolAttribute{
 									MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -16964,6 +19473,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"value": schema.StringAttribute{
 									MarkdownDescription: "Value of the Cookie header.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(8096),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -16996,7 +19508,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													Optional:            true,
 												},
 												"url": schema.StringAttribute{
-													MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+													MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+													Optional:            true,
+													Sensitive:           true,
+													WriteOnly:           true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(131072),
+													},
+												},
+												"url_version": schema.Int64Attribute{
+													MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 													Optional:            true,
 												},
 											},
@@ -17008,6 +19529,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					},
 					"request_headers_to_add": schema.ListNestedBlock{
 						MarkdownDescription: "Headers are key-value pairs to be added to HTTP request being routed towards upstream. Headers specified at this level are applied after headers from matched Route are applied.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"append": schema.BoolAttribute{
@@ -17017,10 +19541,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name. Name of the HTTP header.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"value": schema.StringAttribute{
 									MarkdownDescription: "Value of the HTTP header.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(8096),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -17053,7 +19583,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													Optional:            true,
 												},
 												"url": schema.StringAttribute{
-													MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+													MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+													Optional:            true,
+													Sensitive:           true,
+													WriteOnly:           true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(131072),
+													},
+												},
+												"url_version": schema.Int64Attribute{
+													MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 													Optional:            true,
 												},
 											},
@@ -17065,27 +19604,47 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					},
 					"response_cookies_to_add": schema.ListNestedBlock{
 						MarkdownDescription: "Cookies are name-value pairs along with optional attribute parameters to be added to HTTP response being sent towards downstream. Cookies specified at this level are applied after cookies from matched Route are applied.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"add_domain": schema.StringAttribute{
 									MarkdownDescription: "Add domain attribute.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"add_expiry": schema.StringAttribute{
 									MarkdownDescription: "Add expiry attribute.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"add_path": schema.StringAttribute{
 									MarkdownDescription: "Add path attribute.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"max_age_value": schema.Int64Attribute{
 									MarkdownDescription: "Add max age attribute.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtMost(34560000),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the cookie in Cookie header.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"overwrite": schema.BoolAttribute{
 									MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -17094,6 +19653,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"value": schema.StringAttribute{
 									MarkdownDescription: "Value of the Cookie header.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(8096),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -17171,7 +19733,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													Optional:            true,
 												},
 												"url": schema.StringAttribute{
-													MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+													MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+													Optional:            true,
+													Sensitive:           true,
+													WriteOnly:           true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(131072),
+													},
+												},
+												"url_version": schema.Int64Attribute{
+													MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 													Optional:            true,
 												},
 											},
@@ -17183,6 +19754,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					},
 					"response_headers_to_add": schema.ListNestedBlock{
 						MarkdownDescription: "Headers are key-value pairs to be added to HTTP response being sent towards downstream. Headers specified at this level are applied after headers from matched Route are applied.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"append": schema.BoolAttribute{
@@ -17192,10 +19766,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name. Name of the HTTP header.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"value": schema.StringAttribute{
 									MarkdownDescription: "Value of the HTTP header.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(8096),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -17228,7 +19808,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													Optional:            true,
 												},
 												"url": schema.StringAttribute{
-													MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+													MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+													Optional:            true,
+													Sensitive:           true,
+													WriteOnly:           true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(131072),
+													},
+												},
+												"url_version": schema.Int64Attribute{
+													MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 													Optional:            true,
 												},
 											},
@@ -17255,17 +19844,28 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 				Blocks: map[string]schema.Block{
 					"origin_server_subset_rules": schema.ListNestedBlock{
 						MarkdownDescription: "Origin Server Subset Rules allow users to define match condition on Client (IP address, ASN, Country), IP Reputation, Regional Edge names, Request for subset selection of origin servers. Origin Server Subset is a sequential engine where rules are evaluated one after the other. It's important to..",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(64),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"country_codes": schema.ListAttribute{
 									MarkdownDescription: "[Enum: COUNTRY_NONE|COUNTRY_AD|COUNTRY_AE|COUNTRY_AF|COUNTRY_AG|COUNTRY_AI|COUNTRY_AL|COUNTRY_AM|COUNTRY_AN|COUNTRY_AO|COUNTRY_AQ|COUNTRY_AR|COUNTRY_AS|COUNTRY_AT|COUNTRY_AU|COUNTRY_AW|COUNTRY_AX|COUNTRY_AZ|COUNTRY_BA|COUNTRY_BB|COUNTRY_BD|COUNTRY_BE|COUNTRY_BF|COUNTRY_BG|COUNTRY_BH|COUNTRY_BI|COUNTRY_BJ|COUNTRY_BL|COUNTRY_BM|COUNTRY_BN|COUNTRY_BO|COUNTRY_BQ|COUNTRY_BR|COUNTRY_BS|COUNTRY_BT|COUNTRY_BV|COUNTRY_BW|COUNTRY_BY|COUNTRY_BZ|COUNTRY_CA|COUNTRY_CC|COUNTRY_CD|COUNTRY_CF|COUNTRY_CG|COUNTRY_CH|COUNTRY_CI|COUNTRY_CK|COUNTRY_CL|COUNTRY_CM|COUNTRY_CN|COUNTRY_CO|COUNTRY_CR|COUNTRY_CS|COUNTRY_CU|COUNTRY_CV|COUNTRY_CW|COUNTRY_CX|COUNTRY_CY|COUNTRY_CZ|COUNTRY_DE|COUNTRY_DJ|COUNTRY_DK|COUNTRY_DM|COUNTRY_DO|COUNTRY_DZ|COUNTRY_EC|COUNTRY_EE|COUNTRY_EG|COUNTRY_EH|COUNTRY_ER|COUNTRY_ES|COUNTRY_ET|COUNTRY_FI|COUNTRY_FJ|COUNTRY_FK|COUNTRY_FM|COUNTRY_FO|COUNTRY_FR|COUNTRY_GA|COUNTRY_GB|COUNTRY_GD|COUNTRY_GE|COUNTRY_GF|COUNTRY_GG|COUNTRY_GH|COUNTRY_GI|COUNTRY_GL|COUNTRY_GM|COUNTRY_GN|COUNTRY_GP|COUNTRY_GQ|COUNTRY_GR|COUNTRY_GS|COUNTRY_GT|COUNTRY_GU|COUNTRY_GW|COUNTRY_GY|COUNTRY_HK|COUNTRY_HM|COUNTRY_HN|COUNTRY_HR|COUNTRY_HT|COUNTRY_HU|COUNTRY_ID|COUNTRY_IE|COUNTRY_IL|COUNTRY_IM|COUNTRY_IN|COUNTRY_IO|COUNTRY_IQ|COUNTRY_IR|COUNTRY_IS|COUNTRY_IT|COUNTRY_JE|COUNTRY_JM|COUNTRY_JO|COUNTRY_JP|COUNTRY_KE|COUNTRY_KG|COUNTRY_KH|COUNTRY_KI|COUNTRY_KM|COUNTRY_KN|COUNTRY_KP|COUNTRY_KR|COUNTRY_KW|COUNTRY_KY|COUNTRY_KZ|COUNTRY_LA|COUNTRY_LB|COUNTRY_LC|COUNTRY_LI|COUNTRY_LK|COUNTRY_LR|COUNTRY_LS|COUNTRY_LT|COUNTRY_LU|COUNTRY_LV|COUNTRY_LY|COUNTRY_MA|COUNTRY_MC|COUNTRY_MD|COUNTRY_ME|COUNTRY_MF|COUNTRY_MG|COUNTRY_MH|COUNTRY_MK|COUNTRY_ML|COUNTRY_MM|COUNTRY_MN|COUNTRY_MO|COUNTRY_MP|COUNTRY_MQ|COUNTRY_MR|COUNTRY_MS|COUNTRY_MT|COUNTRY_MU|COUNTRY_MV|COUNTRY_MW|COUNTRY_MX|COUNTRY_MY|COUNTRY_MZ|COUNTRY_NA|COUNTRY_NC|COUNTRY_NE|COUNTRY_NF|COUNTRY_NG|COUNTRY_NI|COUNTRY_NL|COUNTRY_NO|COUNTRY_NP|COUNTRY_NR|COUNTRY_NU|COUNTRY_NZ|COUNTRY_OM|COUNTRY_PA|COUNTRY_PE|COUNTRY_PF|COUNTRY_PG|COUNTRY_PH|COUNTRY_PK|COUNTRY_PL|COUNTRY_PM|COUNTRY_PN|COUNTRY_PR|COUNTRY_PS|COUNTRY_PT|COUNTRY_PW|COUNTRY_PY|COUNTRY_QA|COUNTRY_RE|COUNTRY_RO|COUNTRY_RS|COUNTRY_RU|COUNTRY_RW|COUNTRY_SA|COUNTRY_SB|COUNTRY_SC|COUNTRY_SD|COUNTRY_SE|COUNTRY_SG|COUNTRY_SH|COUNTRY_SI|COUNTRY_SJ|COUNTRY_SK|COUNTRY_SL|COUNTRY_SM|COUNTRY_SN|COUNTRY_SO|COUNTRY_SR|COUNTRY_SS|COUNTRY_ST|COUNTRY_SV|COUNTRY_SX|COUNTRY_SY|COUNTRY_SZ|COUNTRY_TC|COUNTRY_TD|COUNTRY_TF|COUNTRY_TG|COUNTRY_TH|COUNTRY_TJ|COUNTRY_TK|COUNTRY_TL|COUNTRY_TM|COUNTRY_TN|COUNTRY_TO|COUNTRY_TR|COUNTRY_TT|COUNTRY_TV|COUNTRY_TW|COUNTRY_TZ|COUNTRY_UA|COUNTRY_UG|COUNTRY_UM|COUNTRY_US|COUNTRY_UY|COUNTRY_UZ|COUNTRY_VA|COUNTRY_VC|COUNTRY_VE|COUNTRY_VG|COUNTRY_VI|COUNTRY_VN|COUNTRY_VU|COUNTRY_WF|COUNTRY_WS|COUNTRY_XK|COUNTRY_XT|COUNTRY_YE|COUNTRY_YT|COUNTRY_ZA|COUNTRY_ZM|COUNTRY_ZW] Country Codes List. List of Country Codes. Possible values are `COUNTRY_NONE`, `COUNTRY_AD`, `COUNTRY_AE`, `COUNTRY_AF`, `COUNTRY_AG`, `COUNTRY_AI`, `COUNTRY_AL`, `COUNTRY_AM`, `COUNTRY_AN`, `COUNTRY_AO`, `COUNTRY_AQ`, `COUNTRY_AR`, `COUNTRY_AS`, `COUNTRY_AT`, `COUNTRY_AU`, `COUNTRY_AW`, `COUNTRY_AX`, `COUNTRY_AZ`, `COUNTRY_BA`, `COUNTRY_BB`, `COUNTRY_BD`, `COUNTRY_BE`, `COUNTRY_BF`, `COUNTRY_BG`, `COUNTRY_BH`, `COUNTRY_BI`, `COUNTRY_BJ`, `COUNTRY_BL`, `COUNTRY_BM`, `COUNTRY_BN`, `COUNTRY_BO`, `COUNTRY_BQ`, `COUNTRY_BR`, `COUNTRY_BS`, `COUNTRY_BT`, `COUNTRY_BV`, `COUNTRY_BW`, `COUNTRY_BY`, `COUNTRY_BZ`, `COUNTRY_CA`, `COUNTRY_CC`, `COUNTRY_CD`, `COUNTRY_CF`, `COUNTRY_CG`, `COUNTRY_CH`, `COUNTRY_CI`, `COUNTRY_CK`, `COUNTRY_CL`, `COUNTRY_CM`, `COUNTRY_CN`, `COUNTRY_CO`, `COUNTRY_CR`, `COUNTRY_CS`, `COUNTRY_CU`, `COUNTRY_CV`, `COUNTRY_CW`, `COUNTRY_CX`, `COUNTRY_CY`, `COUNTRY_CZ`, `COUNTRY_DE`, `COUNTRY_DJ`, `COUNTRY_DK`, `COUNTRY_DM`, `COUNTRY_DO`, `COUNTRY_DZ`, `COUNTRY_EC`, `COUNTRY_EE`, `COUNTRY_EG`, `COUNTRY_EH`, `COUNTRY_ER`, `COUNTRY_ES`, `COUNTRY_ET`, `COUNTRY_FI`, `COUNTRY_FJ`, `COUNTRY_FK`, `COUNTRY_FM`, `COUNTRY_FO`, `COUNTRY_FR`, `COUNTRY_GA`, `COUNTRY_GB`, `COUNTRY_GD`, `COUNTRY_GE`, `COUNTRY_GF`, `COUNTRY_GG`, `COUNTRY_GH`, `COUNTRY_GI`, `COUNTRY_GL`, `COUNTRY_GM`, `COUNTRY_GN`, `COUNTRY_GP`, `COUNTRY_GQ`, `COUNTRY_GR`, `COUNTRY_GS`, `COUNTRY_GT`, `COUNTRY_GU`, `COUNTRY_GW`, `COUNTRY_GY`, `COUNTRY_HK`, `COUNTRY_HM`, `COUNTRY_HN`, `COUNTRY_HR`, `COUNTRY_HT`, `COUNTRY_HU`, `COUNTRY_ID`, `COUNTRY_IE`, `COUNTRY_IL`, `COUNTRY_IM`, `COUNTRY_IN`, `COUNTRY_IO`, `COUNTRY_IQ`, `COUNTRY_IR`, `COUNTRY_IS`, `COUNTRY_IT`, `COUNTRY_JE`, `COUNTRY_JM`, `COUNTRY_JO`, `COUNTRY_JP`, `COUNTRY_KE`, `COUNTRY_KG`, `COUNTRY_KH`, `COUNTRY_KI`, `COUNTRY_KM`, `COUNTRY_KN`, `COUNTRY_KP`, `COUNTRY_KR`, `COUNTRY_KW`, `COUNTRY_KY`, `COUNTRY_KZ`, `COUNTRY_LA`, `COUNTRY_LB`, `COUNTRY_LC`, `COUNTRY_LI`, `COUNTRY_LK`, `COUNTRY_LR`, `COUNTRY_LS`, `COUNTRY_LT`, `COUNTRY_LU`, `COUNTRY_LV`, `COUNTRY_LY`, `COUNTRY_MA`, `COUNTRY_MC`, `COUNTRY_MD`, `COUNTRY_ME`, `COUNTRY_MF`, `COUNTRY_MG`, `COUNTRY_MH`, `COUNTRY_MK`, `COUNTRY_ML`, `COUNTRY_MM`, `COUNTRY_MN`, `COUNTRY_MO`, `COUNTRY_MP`, `COUNTRY_MQ`, `COUNTRY_MR`, `COUNTRY_MS`, `COUNTRY_MT`, `COUNTRY_MU`, `COUNTRY_MV`, `COUNTRY_MW`, `COUNTRY_MX`, `COUNTRY_MY`, `COUNTRY_MZ`, `COUNTRY_NA`, `COUNTRY_NC`, `COUNTRY_NE`, `COUNTRY_NF`, `COUNTRY_NG`, `COUNTRY_NI`, `COUNTRY_NL`, `COUNTRY_NO`, `COUNTRY_NP`, `COUNTRY_NR`, `COUNTRY_NU`, `COUNTRY_NZ`, `COUNTRY_OM`, `COUNTRY_PA`, `COUNTRY_PE`, `COUNTRY_PF`, `COUNTRY_PG`, `COUNTRY_PH`, `COUNTRY_PK`, `COUNTRY_PL`, `COUNTRY_PM`, `COUNTRY_PN`, `COUNTRY_PR`, `COUNTRY_PS`, `COUNTRY_PT`, `COUNTRY_PW`, `COUNTRY_PY`, `COUNTRY_QA`, `COUNTRY_RE`, `COUNTRY_RO`, `COUNTRY_RS`, `COUNTRY_RU`, `COUNTRY_RW`, `COUNTRY_SA`, `COUNTRY_SB`, `COUNTRY_SC`, `COUNTRY_SD`, `COUNTRY_SE`, `COUNTRY_SG`, `COUNTRY_SH`, `COUNTRY_SI`, `COUNTRY_SJ`, `COUNTRY_SK`, `COUNTRY_SL`, `COUNTRY_SM`, `COUNTRY_SN`, `COUNTRY_SO`, `COUNTRY_SR`, `COUNTRY_SS`, `COUNTRY_ST`, `COUNTRY_SV`, `COUNTRY_SX`, `COUNTRY_SY`, `COUNTRY_SZ`, `COUNTRY_TC`, `COUNTRY_TD`, `COUNTRY_TF`, `COUNTRY_TG`, `COUNTRY_TH`, `COUNTRY_TJ`, `COUNTRY_TK`, `COUNTRY_TL`, `COUNTRY_TM`, `COUNTRY_TN`, `COUNTRY_TO`, `COUNTRY_TR`, `COUNTRY_TT`, `COUNTRY_TV`, `COUNTRY_TW`, `COUNTRY_TZ`, `COUNTRY_UA`, `COUNTRY_UG`, `COUNTRY_UM`, `COUNTRY_US`, `COUNTRY_UY`, `COUNTRY_UZ`, `COUNTRY_VA`, `COUNTRY_VC`, `COUNTRY_VE`, `COUNTRY_VG`, `COUNTRY_VI`, `COUNTRY_VN`, `COUNTRY_VU`, `COUNTRY_WF`, `COUNTRY_WS`, `COUNTRY_XK`, `COUNTRY_XT`, `COUNTRY_YE`, `COUNTRY_YT`, `COUNTRY_ZA`, `COUNTRY_ZM`, `COUNTRY_ZW`. Defaults to `COUNTRY_NONE`.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(64),
+										listvalidator.UniqueValues(),
+									},
 								},
 								"re_name_list": schema.ListAttribute{
 									MarkdownDescription: "RE Names. List of RE names for match.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(32),
+										listvalidator.UniqueValues(),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -17282,6 +19882,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 											Optional:            true,
 											ElementType:         types.Int64Type,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.SizeAtLeast(1),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 								},
@@ -17291,6 +19896,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									Blocks: map[string]schema.Block{
 										"asn_sets": schema.ListNestedBlock{
 											MarkdownDescription: "List of references to bgp_asn_set objects.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(4),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"kind": schema.StringAttribute{
@@ -17341,6 +19949,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -17355,6 +19967,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									Blocks: map[string]schema.Block{
 										"prefix_sets": schema.ListNestedBlock{
 											MarkdownDescription: "List of references to ip_prefix_set objects.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(4),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"kind": schema.StringAttribute{
@@ -17409,6 +20024,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -17418,10 +20038,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Human readable description.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 								},
@@ -17452,10 +20078,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(86400),
+								},
 							},
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 						},
 					},
@@ -17477,14 +20110,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(86400),
+								},
 							},
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 							"js_script_delay": schema.Int64Attribute{
 								MarkdownDescription: "Delay introduced by Javascript, in milliseconds.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1000),
+									int64validator.AtMost(60000),
+								},
 							},
 						},
 					},
@@ -17494,6 +20138,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -17502,6 +20150,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -17510,6 +20161,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -17522,6 +20176,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						Blocks: map[string]schema.Block{
 							"rules": schema.ListNestedBlock{
 								MarkdownDescription: "Rules that specify the match conditions and challenge type to be launched. When a challenge type is selected to be always enabled, these rules can be used to disable challenge or launch a different challenge for requests that match the specified conditions.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(64),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{},
 									Blocks: map[string]schema.Block{
@@ -17531,10 +20188,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -17558,6 +20221,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												},
 												"arg_matchers": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for all POST args that need to be matched. The criteria for matching each arg are described in individual instances of ArgMatcherType. The actual arg values are extracted from the request API as a list of strings for each arg selector name.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -17567,6 +20233,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															"name": schema.StringAttribute{
 																MarkdownDescription: "Case-sensitive JSON path in the HTTP request body.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -17583,16 +20252,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -17606,6 +20289,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 															Optional:            true,
 															ElementType:         types.Int64Type,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -17615,6 +20303,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													Blocks: map[string]schema.Block{
 														"asn_sets": schema.ListNestedBlock{
 															MarkdownDescription: "List of references to bgp_asn_set objects.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"kind": schema.StringAttribute{
@@ -17665,16 +20356,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "List of exact values to match the input against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(64),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 														"regex_values": schema.ListAttribute{
 															MarkdownDescription: "List of regular expressions to match the input against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+															},
 														},
 														"transformers": schema.ListAttribute{
 															MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(9),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -17685,11 +20390,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(1),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+															},
 														},
 													},
 												},
 												"cookie_matchers": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -17699,6 +20411,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															"name": schema.StringAttribute{
 																MarkdownDescription: "Case-sensitive cookie name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -17715,16 +20430,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -17741,11 +20470,21 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "List of exact values to match the input against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(64),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 														"regex_values": schema.ListAttribute{
 															MarkdownDescription: "List of regular expressions to match the input against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+															},
 														},
 													},
 												},
@@ -17757,6 +20496,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												},
 												"headers": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -17766,6 +20508,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															"name": schema.StringAttribute{
 																MarkdownDescription: "Case-insensitive HTTP header name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -17782,16 +20527,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -17809,6 +20568,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] List of methods values to match against. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -17823,6 +20586,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													Blocks: map[string]schema.Block{
 														"prefix_sets": schema.ListNestedBlock{
 															MarkdownDescription: "List of references to ip_prefix_set objects.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"kind": schema.StringAttribute{
@@ -17877,6 +20643,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(128),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 													},
 												},
@@ -17887,6 +20658,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "List of exact path values to match the input HTTP path against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 														"invert_matcher": schema.BoolAttribute{
 															MarkdownDescription: "Invert Path Matcher. Invert the match result.",
@@ -17896,26 +20672,48 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "List of path prefix values to match the input HTTP path against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 														"regex_values": schema.ListAttribute{
 															MarkdownDescription: "List of regular expressions to match the input HTTP path against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+															},
 														},
 														"suffix_values": schema.ListAttribute{
 															MarkdownDescription: "List of path suffix values to match the input HTTP path against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(64),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(64), stringvalidator.LengthAtLeast(1)),
+															},
 														},
 														"transformers": schema.ListAttribute{
 															MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(9),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
 												"query_params": schema.ListNestedBlock{
 													MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"invert_matcher": schema.BoolAttribute{
@@ -17925,6 +20723,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															"key": schema.StringAttribute{
 																MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(256),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
@@ -17941,16 +20742,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		MarkdownDescription: "List of exact values to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(64),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																		},
 																	},
 																	"regex_values": schema.ListAttribute{
 																		MarkdownDescription: "List of regular expressions to match the input against.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(16),
+																			listvalidator.UniqueValues(),
+																			listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																		},
 																	},
 																	"transformers": schema.ListAttribute{
 																		MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																		Optional:            true,
 																		ElementType:         types.StringType,
+																		Validators: []validator.List{
+																			listvalidator.SizeAtMost(9),
+																			listvalidator.UniqueValues(),
+																		},
 																	},
 																},
 															},
@@ -17964,16 +20779,30 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+															},
 														},
 														"exact_values": schema.ListAttribute{
 															MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+															},
 														},
 														"excluded_values": schema.ListAttribute{
 															MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(32),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+															},
 														},
 													},
 												},
@@ -17990,6 +20819,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"custom_page": schema.StringAttribute{
 								MarkdownDescription: "Custom message is of type . Currently supported URL schemes is . For scheme, message needs to be encoded in Base64 format. You can specify this message as base64 encoded plain text message e.g. 'Blocked.' or it can be HTML paragraph or a body string encoded as base64 string E.g. '<p> Blocked..",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(65536),
+								},
 							},
 						},
 					},
@@ -17997,15 +20829,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"protected_cookies": schema.ListNestedBlock{
 				MarkdownDescription: "Allows setting attributes (SameSite, Secure, and HttpOnly) on cookies in responses. Cookie Tampering Protection prevents attackers from modifying the value of session cookies. For Cookie Tampering Protection, enabling a web app firewall (WAF) is a prerequisite.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(16),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"max_age_value": schema.Int64Attribute{
 							MarkdownDescription: "Add max age attribute.",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtMost(34560000),
+							},
 						},
 						"name": schema.StringAttribute{
 							MarkdownDescription: "Cookie Name. Name of the Cookie .",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -18058,11 +20900,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						Blocks: map[string]schema.Block{
 							"rate_limiter_allowed_prefixes": schema.ListNestedBlock{
 								MarkdownDescription: "References to ip_prefix_set objects. Requests from source IP addresses that are covered by one of the allowed IP Prefixes are not subjected to rate limiting.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(4),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -18071,6 +20920,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -18079,6 +20931,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -18092,6 +20947,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+								},
 							},
 						},
 					},
@@ -18107,11 +20967,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						Blocks: map[string]schema.Block{
 							"policies": schema.ListNestedBlock{
 								MarkdownDescription: "Ordered list of rate limiter policies.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -18120,6 +20987,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -18128,6 +20998,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -18140,14 +21013,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"burst_multiplier": schema.Int64Attribute{
 								MarkdownDescription: "The maximum burst of requests to accommodate, expressed as a multiple of the rate.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(100),
+								},
 							},
 							"period_multiplier": schema.Int64Attribute{
 								MarkdownDescription: "Setting, combined with Per Period units, provides a duration.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(0),
+								},
 							},
 							"total_number": schema.Int64Attribute{
 								MarkdownDescription: "The total number of allowed requests per rate-limiting period.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(8192),
+								},
 							},
 							"unit": schema.StringAttribute{
 								MarkdownDescription: "[Enum: SECOND|MINUTE|HOUR] Unit for the period per which the rate limit is applied. - SECOND: Second Rate limit period unit is seconds - MINUTE: Minute Rate limit period unit is minutes - HOUR: Hour Rate limit period unit is hours - DAY: Day Rate limit period unit is days. Possible values are `SECOND`, `MINUTE`, `HOUR`. Defaults to `SECOND`.",
@@ -18165,6 +21049,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"duration": schema.Int64Attribute{
 												MarkdownDescription: "Duration. Configuration parameter for duration",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtLeast(1),
+													int64validator.AtMost(48),
+												},
 											},
 										},
 									},
@@ -18174,6 +21062,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"duration": schema.Int64Attribute{
 												MarkdownDescription: "Duration. Configuration parameter for duration",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtLeast(1),
+													int64validator.AtMost(60),
+												},
 											},
 										},
 									},
@@ -18183,6 +21075,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"duration": schema.Int64Attribute{
 												MarkdownDescription: "Duration. Configuration parameter for duration",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtLeast(1),
+													int64validator.AtMost(300),
+												},
 											},
 										},
 									},
@@ -18207,11 +21103,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 				Blocks: map[string]schema.Block{
 					"hash_policy": schema.ListNestedBlock{
 						MarkdownDescription: "Specifies a list of hash policies to use for ring hash load balancing. Each hash policy is evaluated individually and the combined result is used to route the request .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(8),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"header_name": schema.StringAttribute{
 									MarkdownDescription: "The name or key of the request header that will be used to obtain the hash key.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"source_ip": schema.BoolAttribute{
 									MarkdownDescription: "Hash based on source IP address.",
@@ -18229,6 +21132,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"name": schema.StringAttribute{
 											MarkdownDescription: "The name of the cookie that will be used to obtain the hash key. If the cookie is not present and TTL below is not set, no hash will be produced .",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 										"path": schema.StringAttribute{
 											MarkdownDescription: "The name of the path for the cookie. If no path is specified here, no path will be set for the cookie.",
@@ -18276,6 +21183,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"routes": schema.ListNestedBlock{
 				MarkdownDescription: "Routes allow users to define match condition on a path and/or HTTP method to either forward matching traffic to origin pool or redirect matching traffic to a different URL or respond directly to matching traffic.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(256),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{},
 					Blocks: map[string]schema.Block{
@@ -18289,6 +21200,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -18297,6 +21212,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -18305,6 +21223,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -18321,11 +21242,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							Blocks: map[string]schema.Block{
 								"headers": schema.ListNestedBlock{
 									MarkdownDescription: "Headers. List of (key, value) headers.",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"exact": schema.StringAttribute{
 												MarkdownDescription: "Header value to match exactly.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"invert_match": schema.BoolAttribute{
 												MarkdownDescription: "Invert the result of the match to detect missing header or non-matching value.",
@@ -18334,6 +21262,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"name": schema.StringAttribute{
 												MarkdownDescription: "Name. Name of the header .",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"presence": schema.BoolAttribute{
 												MarkdownDescription: "If true, check for presence of header.",
@@ -18342,6 +21274,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"regex": schema.StringAttribute{
 												MarkdownDescription: "Regex match of the header value in re2 format.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+													validators.RegexValidator(),
+												},
 											},
 										},
 									},
@@ -18352,10 +21289,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"port": schema.Int64Attribute{
 											MarkdownDescription: "Exact Port to match.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtMost(65535),
+											},
 										},
 										"port_ranges": schema.StringAttribute{
 											MarkdownDescription: "Port range to match.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(32),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -18370,14 +21314,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"path": schema.StringAttribute{
 											MarkdownDescription: "Exact path value to match.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"prefix": schema.StringAttribute{
 											MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"regex": schema.StringAttribute{
 											MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(256),
+												stringvalidator.LengthAtLeast(1),
+												validators.RegexValidator(),
+											},
 										},
 									},
 								},
@@ -18387,10 +21342,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"response_body_encoded": schema.StringAttribute{
 											MarkdownDescription: "Response body to send. Currently supported URL schemes is string:/// for which message should be encoded in Base64 format. The message can be either plain text or HTML.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(65536),
+											},
 										},
 										"response_code": schema.Int64Attribute{
 											MarkdownDescription: "Response Code. Response code to send.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(100),
+												int64validator.AtMost(599),
+											},
 										},
 									},
 								},
@@ -18407,11 +21369,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							Blocks: map[string]schema.Block{
 								"headers": schema.ListNestedBlock{
 									MarkdownDescription: "Headers. List of (key, value) headers.",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"exact": schema.StringAttribute{
 												MarkdownDescription: "Header value to match exactly.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"invert_match": schema.BoolAttribute{
 												MarkdownDescription: "Invert the result of the match to detect missing header or non-matching value.",
@@ -18420,6 +21389,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"name": schema.StringAttribute{
 												MarkdownDescription: "Name. Name of the header .",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"presence": schema.BoolAttribute{
 												MarkdownDescription: "If true, check for presence of header.",
@@ -18428,6 +21401,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"regex": schema.StringAttribute{
 												MarkdownDescription: "Regex match of the header value in re2 format.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+													validators.RegexValidator(),
+												},
 											},
 										},
 									},
@@ -18438,10 +21416,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"port": schema.Int64Attribute{
 											MarkdownDescription: "Exact Port to match.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtMost(65535),
+											},
 										},
 										"port_ranges": schema.StringAttribute{
 											MarkdownDescription: "Port range to match.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(32),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -18456,14 +21441,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"path": schema.StringAttribute{
 											MarkdownDescription: "Exact path value to match.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"prefix": schema.StringAttribute{
 											MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"regex": schema.StringAttribute{
 											MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(256),
+												stringvalidator.LengthAtLeast(1),
+												validators.RegexValidator(),
+											},
 										},
 									},
 								},
@@ -18477,10 +21473,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"path_redirect": schema.StringAttribute{
 											MarkdownDescription: "swap path part of incoming URL in redirect URL.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"prefix_rewrite": schema.StringAttribute{
 											MarkdownDescription: "In Redirect response, the matched prefix (or path) should be swapped with this value. This option allows redirect URLs be dynamically created based on the request.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"proto_redirect": schema.StringAttribute{
 											MarkdownDescription: "Swap protocol part of incoming URL in redirect URL The protocol can be swapped with either HTTP or HTTPS When incoming-proto option is specified, swapping of protocol is not done.",
@@ -18489,10 +21491,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"replace_params": schema.StringAttribute{
 											MarkdownDescription: ".",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 										"response_code": schema.Int64Attribute{
 											MarkdownDescription: "The HTTP status code to use in the redirect response.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtMost(599),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -18512,6 +21521,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"host_rewrite": schema.StringAttribute{
 									MarkdownDescription: "Host header will be swapped with this value.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+									},
 								},
 								"http_method": schema.StringAttribute{
 									MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] Specifies the HTTP method used to access a resource. Any HTTP Method. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
@@ -18529,6 +21541,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"prefix_rewrite": schema.StringAttribute{
 											MarkdownDescription: "prefix_rewrite indicates that during forwarding, the matched prefix (or path) should be swapped with its value. When using regex path matching, the entire path (not including the query string) will be swapped with this value.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"priority": schema.StringAttribute{
 											MarkdownDescription: "[Enum: DEFAULT|HIGH] Priority routing for each request. Different connection pools are used based on the priority selected for the request. Also, circuit-breaker configuration at destination cluster is chosen based on selected priority. Possible values are `DEFAULT`, `HIGH`. Defaults to `DEFAULT`.",
@@ -18538,25 +21553,49 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "List of keys of Cookies to be removed from the HTTP request being sent towards upstream.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(32),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+											},
 										},
 										"request_headers_to_remove": schema.ListAttribute{
 											MarkdownDescription: "List of keys of Headers to be removed from the HTTP request being sent towards upstream.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(32),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+											},
 										},
 										"response_cookies_to_remove": schema.ListAttribute{
 											MarkdownDescription: "List of name of Cookies to be removed from the HTTP response being sent towards downstream. Entire set-cookie header will be removed.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(32),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+											},
 										},
 										"response_headers_to_remove": schema.ListAttribute{
 											MarkdownDescription: "List of keys of Headers to be removed from the HTTP response being sent towards downstream.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(32),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+											},
 										},
 										"timeout": schema.Int64Attribute{
 											MarkdownDescription: "The timeout for the route including all retries, in milliseconds. Should be set to a high value or 0 (infinite timeout) for server-side streaming.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(0),
+												int64validator.AtMost(600000),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -18566,6 +21605,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -18574,6 +21617,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -18582,6 +21628,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -18596,16 +21645,26 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											Blocks: map[string]schema.Block{
 												"javascript_tags": schema.ListNestedBlock{
 													MarkdownDescription: "Select Add item to configure your javascript tag. If adding both Bot Adv and Fraud, the Bot Javascript should be added first.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(5),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"javascript_url": schema.StringAttribute{
 																MarkdownDescription: "Please enter the full URL (include domain and path), or relative path.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.LengthAtMost(2048),
+																	stringvalidator.LengthAtLeast(1),
+																},
 															},
 														},
 														Blocks: map[string]schema.Block{
 															"tag_attributes": schema.ListNestedBlock{
 																MarkdownDescription: "Add the tag attributes you want to include in your Javascript tag.",
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																},
 																NestedObject: schema.NestedBlockObject{
 																	Attributes: map[string]schema.Attribute{
 																		"javascript_tag": schema.StringAttribute{
@@ -18615,6 +21674,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		"tag_value": schema.StringAttribute{
 																			MarkdownDescription: "Value. Add the tag attribute value.",
 																			Optional:            true,
+																			Validators: []validator.String{
+																				stringvalidator.LengthAtMost(1024),
+																			},
 																		},
 																	},
 																},
@@ -18634,6 +21696,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"max_request_bytes": schema.Int64Attribute{
 													MarkdownDescription: "The maximum request size that the filter will buffer before the connection manager will stop buffering and return a RequestEntityTooLarge (413) response.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtMost(10485760),
+													},
 												},
 											},
 										},
@@ -18662,11 +21727,21 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													MarkdownDescription: "Specifies the origins that will be allowed to do CORS requests. An origin is allowed if either allow_origin or allow_origin_regex match.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1)),
+													},
 												},
 												"allow_origin_regex": schema.ListAttribute{
 													MarkdownDescription: "Specifies regex patterns that match allowed origins. An origin is allowed if either allow_origin or allow_origin_regex match.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+													},
 												},
 												"disabled": schema.BoolAttribute{
 													MarkdownDescription: "Disable the CorsPolicy for a particular route. This is useful when virtual-host has CorsPolicy, but we need to disable it on a specific route. The value of this field is ignored for virtual-host.",
@@ -18679,6 +21754,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"maximum_age": schema.Int64Attribute{
 													MarkdownDescription: "Specifies the content for the access-control-max-age header in seconds. This indicates the maximum number of seconds the results can be cached A value of -1 will disable caching. Maximum permitted value is 86400 seconds (24 hours).",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(-1),
+														int64validator.AtMost(86400),
+													},
 												},
 											},
 										},
@@ -18696,6 +21775,12 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															MarkdownDescription: "List of domain names that will be matched to loadbalancer. These domains are not used for SNI match. Wildcard names are supported in the suffix or prefix form.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(32),
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+																listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1)),
+															},
 														},
 													},
 												},
@@ -18750,6 +21835,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"name": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(128),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 														"namespace": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -18758,6 +21847,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 														"tenant": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -18766,6 +21858,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 													},
 												},
@@ -18793,20 +21888,34 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"pattern": schema.StringAttribute{
 													MarkdownDescription: "The regular expression used to find portions of a string that should be replaced.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 												"substitution": schema.StringAttribute{
 													MarkdownDescription: "The string that should be substituted into matching portions of the subject string during a substitution operation to produce a new string.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 											},
 										},
 										"request_cookies_to_add": schema.ListNestedBlock{
 											MarkdownDescription: "Cookies are key-value pairs to be added to HTTP request being routed towards upstream. Cookies specified at this level are applied after cookies from matched Route are applied.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(32),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Name of the cookie in Cookie header.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+														},
 													},
 													"overwrite": schema.BoolAttribute{
 														MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -18815,6 +21924,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"value": schema.StringAttribute{
 														MarkdownDescription: "Value of the Cookie header.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(8096),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -18847,7 +21959,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		Optional:            true,
 																	},
 																	"url": schema.StringAttribute{
-																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																		Optional:            true,
+																		Sensitive:           true,
+																		WriteOnly:           true,
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(131072),
+																		},
+																	},
+																	"url_version": schema.Int64Attribute{
+																		MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																		Optional:            true,
 																	},
 																},
@@ -18859,6 +21980,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"request_headers_to_add": schema.ListNestedBlock{
 											MarkdownDescription: "Headers are key-value pairs to be added to HTTP request being routed towards upstream.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(32),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"append": schema.BoolAttribute{
@@ -18868,10 +21992,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Name. Name of the HTTP header.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+														},
 													},
 													"value": schema.StringAttribute{
 														MarkdownDescription: "Value of the HTTP header.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(8096),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -18904,7 +22034,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		Optional:            true,
 																	},
 																	"url": schema.StringAttribute{
-																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																		Optional:            true,
+																		Sensitive:           true,
+																		WriteOnly:           true,
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(131072),
+																		},
+																	},
+																	"url_version": schema.Int64Attribute{
+																		MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																		Optional:            true,
 																	},
 																},
@@ -18916,27 +22055,47 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"response_cookies_to_add": schema.ListNestedBlock{
 											MarkdownDescription: "Cookies are name-value pairs along with optional attribute parameters to be added to HTTP response being sent towards downstream. Cookies specified at this level are applied after cookies from matched Route are applied.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(32),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"add_domain": schema.StringAttribute{
 														MarkdownDescription: "Add domain attribute.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.HostnameValidator(),
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 													"add_expiry": schema.StringAttribute{
 														MarkdownDescription: "Add expiry attribute.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+														},
 													},
 													"add_path": schema.StringAttribute{
 														MarkdownDescription: "Add path attribute.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+														},
 													},
 													"max_age_value": schema.Int64Attribute{
 														MarkdownDescription: "Add max age attribute.",
 														Optional:            true,
+														Validators: []validator.Int64{
+															int64validator.AtMost(34560000),
+														},
 													},
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Name of the cookie in Cookie header.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+														},
 													},
 													"overwrite": schema.BoolAttribute{
 														MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -18945,6 +22104,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"value": schema.StringAttribute{
 														MarkdownDescription: "Value of the Cookie header.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(8096),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -19022,7 +22184,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		Optional:            true,
 																	},
 																	"url": schema.StringAttribute{
-																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																		Optional:            true,
+																		Sensitive:           true,
+																		WriteOnly:           true,
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(131072),
+																		},
+																	},
+																	"url_version": schema.Int64Attribute{
+																		MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																		Optional:            true,
 																	},
 																},
@@ -19034,6 +22205,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"response_headers_to_add": schema.ListNestedBlock{
 											MarkdownDescription: "Headers are key-value pairs to be added to HTTP response being sent towards downstream.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(32),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"append": schema.BoolAttribute{
@@ -19043,10 +22217,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Name. Name of the HTTP header.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+														},
 													},
 													"value": schema.StringAttribute{
 														MarkdownDescription: "Value of the HTTP header.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(8096),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -19079,7 +22259,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																		Optional:            true,
 																	},
 																	"url": schema.StringAttribute{
-																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																		MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																		Optional:            true,
+																		Sensitive:           true,
+																		WriteOnly:           true,
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(131072),
+																		},
+																	},
+																	"url_version": schema.Int64Attribute{
+																		MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																		Optional:            true,
 																	},
 																},
@@ -19098,20 +22287,35 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"num_retries": schema.Int64Attribute{
 													MarkdownDescription: "Specifies the allowed number of retries. Retries can be done any number of times. An exponential back-off algorithm is used between each retry. Defaults to `1`.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtMost(8),
+													},
 												},
 												"per_try_timeout": schema.Int64Attribute{
 													MarkdownDescription: "Specifies a non-zero timeout per retry attempt. In milliseconds.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtMost(600000),
+													},
 												},
 												"retriable_status_codes": schema.ListAttribute{
 													MarkdownDescription: "HTTP status codes that should trigger a retry in addition to those specified by retry_on.",
 													Optional:            true,
 													ElementType:         types.Int64Type,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 												"retry_condition": schema.ListAttribute{
 													MarkdownDescription: "Specifies the conditions under which retry takes place. Retries can be on different types of condition depending on application requirements. For example, network failure, all 5xx response codes, idempotent 4xx response codes, etc The possible values are '5xx' : Retry will be done if the..",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(7),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -19121,6 +22325,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"base_interval": schema.Int64Attribute{
 															MarkdownDescription: "Specifies the base interval between retries in milliseconds.",
 															Optional:            true,
+															Validators: []validator.Int64{
+																int64validator.AtLeast(1),
+															},
 														},
 														"max_interval": schema.Int64Attribute{
 															MarkdownDescription: "Specifies the maximum interval between retries in milliseconds. This parameter is optional, but must be greater than or equal to the base_interval if set. The  times the base_interval. Defaults to `10`.",
@@ -19136,11 +22343,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											Blocks: map[string]schema.Block{
 												"hash_policy": schema.ListNestedBlock{
 													MarkdownDescription: "Specifies a list of hash policies to use for ring hash load balancing. Each hash policy is evaluated individually and the combined result is used to route the request .",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(8),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"header_name": schema.StringAttribute{
 																MarkdownDescription: "The name or key of the request header that will be used to obtain the hash key.",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.UTF8LengthAtMost(256),
+																	stringvalidator.UTF8LengthAtLeast(1),
+																},
 															},
 															"source_ip": schema.BoolAttribute{
 																MarkdownDescription: "Hash based on source IP address.",
@@ -19158,6 +22372,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																	"name": schema.StringAttribute{
 																		MarkdownDescription: "The name of the cookie that will be used to obtain the hash key. If the cookie is not present and TTL below is not set, no hash will be produced .",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			stringvalidator.UTF8LengthAtMost(256),
+																			stringvalidator.UTF8LengthAtLeast(1),
+																		},
 																	},
 																	"path": schema.StringAttribute{
 																		MarkdownDescription: "The name of the path for the cookie. If no path is specified here, no path will be set for the cookie.",
@@ -19206,6 +22424,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -19214,6 +22436,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -19222,6 +22447,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -19244,11 +22472,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								},
 								"headers": schema.ListNestedBlock{
 									MarkdownDescription: "Headers. List of (key, value) headers.",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"exact": schema.StringAttribute{
 												MarkdownDescription: "Header value to match exactly.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"invert_match": schema.BoolAttribute{
 												MarkdownDescription: "Invert the result of the match to detect missing header or non-matching value.",
@@ -19257,6 +22492,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"name": schema.StringAttribute{
 												MarkdownDescription: "Name. Name of the header .",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"presence": schema.BoolAttribute{
 												MarkdownDescription: "If true, check for presence of header.",
@@ -19265,6 +22504,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"regex": schema.StringAttribute{
 												MarkdownDescription: "Regex match of the header value in re2 format.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+													validators.RegexValidator(),
+												},
 											},
 										},
 									},
@@ -19275,10 +22519,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"port": schema.Int64Attribute{
 											MarkdownDescription: "Exact Port to match.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtMost(65535),
+											},
 										},
 										"port_ranges": schema.StringAttribute{
 											MarkdownDescription: "Port range to match.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(32),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -19289,11 +22540,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								},
 								"origin_pools": schema.ListNestedBlock{
 									MarkdownDescription: "Origin Pools for this route .",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"priority": schema.Int64Attribute{
 												MarkdownDescription: "Priority of this origin pool, valid only with multiple origin pools. Value of 0 will make the pool as lowest priority origin pool Priority of 1 means highest priority and is considered active. When active origin pool is not available, lower priority origin pools are made active as per the..",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtMost(32),
+												},
 											},
 											"weight": schema.Int64Attribute{
 												MarkdownDescription: "Weight of this origin pool, valid only with multiple origin pool. Value of 0 will disable the pool.",
@@ -19307,6 +22564,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(128),
+															stringvalidator.LengthAtLeast(1),
+														},
 													},
 													"namespace": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -19315,6 +22576,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 													"tenant": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -19323,6 +22587,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 												},
 											},
@@ -19335,6 +22602,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 													"name": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(128),
+															stringvalidator.LengthAtLeast(1),
+														},
 													},
 													"namespace": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -19343,6 +22614,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 													"tenant": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -19351,6 +22625,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 												},
 											},
@@ -19363,14 +22640,25 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"path": schema.StringAttribute{
 											MarkdownDescription: "Exact path value to match.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"prefix": schema.StringAttribute{
 											MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"regex": schema.StringAttribute{
 											MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(256),
+												stringvalidator.LengthAtLeast(1),
+												validators.RegexValidator(),
+											},
 										},
 									},
 								},
@@ -19380,6 +22668,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										"replace_params": schema.StringAttribute{
 											MarkdownDescription: ".",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -19402,6 +22694,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 				Blocks: map[string]schema.Block{
 					"sensitive_data_types_in_response": schema.ListNestedBlock{
 						MarkdownDescription: "Sensitive Data Exposure Rules allows specifying rules to mask sensitive data fields in API responses.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(100),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{},
 							Blocks: map[string]schema.Block{
@@ -19412,10 +22707,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] Methods. Methods to be matched. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+											},
 										},
 										"path": schema.StringAttribute{
 											MarkdownDescription: "Path. Path to be matched .",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(1024),
+											},
 										},
 									},
 								},
@@ -19426,6 +22728,12 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "List of JSON Path field values. Use square brackets with an underscore [_] to indicate array elements (e.g., person.emails[_]). To reference JSON keys that contain spaces, enclose the entire path in double quotes.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.SizeAtLeast(1),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), stringvalidator.LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -19450,6 +22758,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -19458,6 +22770,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -19466,6 +22781,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -19498,11 +22816,17 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 										Blocks: map[string]schema.Block{
 											"domains": schema.ListNestedBlock{
 												MarkdownDescription: "Enter domains and their credentials to allow authenticated API crawling. You can only include domains you own that are associated with this Load Balancer.",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(32),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"domain": schema.StringAttribute{
 															MarkdownDescription: "Select the domain to execute API Crawling with given credentials.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+															},
 														},
 													},
 													Blocks: map[string]schema.Block{
@@ -19512,6 +22836,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																"user": schema.StringAttribute{
 																	MarkdownDescription: "Enter the username to assign credentials for the selected domain to crawl.",
 																	Optional:            true,
+																	Validators: []validator.String{
+																		stringvalidator.UTF8LengthAtMost(64),
+																	},
 																},
 															},
 															Blocks: map[string]schema.Block{
@@ -19544,7 +22871,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 																					Optional:            true,
 																				},
 																				"url": schema.StringAttribute{
-																					MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																					MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																					Optional:            true,
+																					Sensitive:           true,
+																					WriteOnly:           true,
+																					Validators: []validator.String{
+																						stringvalidator.LengthAtMost(131072),
+																					},
+																				},
+																				"url_version": schema.Int64Attribute{
+																					MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																					Optional:            true,
 																				},
 																			},
@@ -19569,6 +22905,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								Blocks: map[string]schema.Block{
 									"code_base_integrations": schema.ListNestedBlock{
 										MarkdownDescription: "Select Code Base Integrations. .",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(5),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{},
 											Blocks: map[string]schema.Block{
@@ -19581,6 +22920,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 														"name": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(128),
+																stringvalidator.LengthAtLeast(1),
+															},
 														},
 														"namespace": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -19589,6 +22932,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 														"tenant": schema.StringAttribute{
 															MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -19597,6 +22943,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															PlanModifiers: []planmodifier.String{
 																stringplanmodifier.UseStateForUnknown(),
 															},
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(64),
+															},
 														},
 													},
 												},
@@ -19625,6 +22974,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -19633,6 +22986,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -19641,6 +22997,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -19658,6 +23017,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 									"purge_duration_for_inactive_discovered_apis": schema.Int64Attribute{
 										MarkdownDescription: "Inactive discovered API will be deleted after configured duration.",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+											int64validator.AtMost(7),
+										},
 									},
 								},
 							},
@@ -19677,10 +23040,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"request_headers_timeout": schema.Int64Attribute{
 						MarkdownDescription: "The amount of time the client has to send only the headers on the request stream before the stream is cancelled. The  milliseconds. This setting provides protection against Slowloris attacks. Defaults to `10000`.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(2000),
+							int64validator.AtMost(30000),
+						},
 					},
 					"request_timeout": schema.Int64Attribute{
 						MarkdownDescription: ".",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(2000),
+							int64validator.AtMost(300000),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -19697,16 +23068,28 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 			},
 			"trusted_clients": schema.ListNestedBlock{
 				MarkdownDescription: "Define rules to skip processing of one or more features such as WAF, Bot Defense etc.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(256),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"actions": schema.ListAttribute{
 							MarkdownDescription: "[Enum: SKIP_PROCESSING_WAF|SKIP_PROCESSING_BOT|SKIP_PROCESSING_MUM|SKIP_PROCESSING_IP_REPUTATION|SKIP_PROCESSING_API_PROTECTION|SKIP_PROCESSING_OAS_VALIDATION|SKIP_PROCESSING_DDOS_PROTECTION|SKIP_PROCESSING_THREAT_MESH|SKIP_PROCESSING_MALWARE_PROTECTION] Actions that should be taken when client identifier matches the rule. Possible values are `SKIP_PROCESSING_WAF`, `SKIP_PROCESSING_BOT`, `SKIP_PROCESSING_MUM`, `SKIP_PROCESSING_IP_REPUTATION`, `SKIP_PROCESSING_API_PROTECTION`, `SKIP_PROCESSING_OAS_VALIDATION`, `SKIP_PROCESSING_DDOS_PROTECTION`, `SKIP_PROCESSING_THREAT_MESH`, `SKIP_PROCESSING_MALWARE_PROTECTION`. Defaults to `SKIP_PROCESSING_WAF`.",
 							Optional:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(10),
+								listvalidator.UniqueValues(),
+							},
 						},
 						"as_number": schema.Int64Attribute{
 							MarkdownDescription: "RFC 6793 defined 4-byte AS number.",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+								int64validator.AtMost(401308),
+							},
 						},
 						"expiration_timestamp": schema.StringAttribute{
 							MarkdownDescription: "Specifies expiration_timestamp the RFC 3339 format timestamp at which the containing rule is considered to be logically expired. The rule continues to exist in the configuration but is not applied anymore.",
@@ -19715,14 +23098,23 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						"ip_prefix": schema.StringAttribute{
 							MarkdownDescription: "IPv4 prefix string.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.IPv4PrefixValidator(),
+							},
 						},
 						"ipv6_prefix": schema.StringAttribute{
 							MarkdownDescription: "IPv6 prefix string.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.IPv6PrefixValidator(),
+							},
 						},
 						"user_identifier": schema.StringAttribute{
 							MarkdownDescription: "Identify user based on user identifier. User identifier value needs to be copied from security event.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -19735,11 +23127,18 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							Blocks: map[string]schema.Block{
 								"headers": schema.ListNestedBlock{
 									MarkdownDescription: "List of HTTP header name and value pairs .",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"exact": schema.StringAttribute{
 												MarkdownDescription: "Header value to match exactly.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"invert_match": schema.BoolAttribute{
 												MarkdownDescription: "Invert the result of the match to detect missing header or non-matching value.",
@@ -19748,6 +23147,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"name": schema.StringAttribute{
 												MarkdownDescription: "Name. Name of the header .",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"presence": schema.BoolAttribute{
 												MarkdownDescription: "If true, check for presence of header.",
@@ -19756,6 +23159,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											"regex": schema.StringAttribute{
 												MarkdownDescription: "Regex match of the header value in re2 format.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+													validators.RegexValidator(),
+												},
 											},
 										},
 									},
@@ -19768,10 +23176,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Description. Human readable description.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -19793,6 +23207,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 					"name": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(128),
+							stringvalidator.LengthAtLeast(1),
+						},
 					},
 					"namespace": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -19801,6 +23219,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 					"tenant": schema.StringAttribute{
 						MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -19809,6 +23230,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(64),
+						},
 					},
 				},
 			},
@@ -19822,11 +23246,19 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 						Blocks: map[string]schema.Block{
 							"rules": schema.ListNestedBlock{
 								MarkdownDescription: "Ordered list of WAF Exclusions specific to this Load Balancer.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(256),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"exact_value": schema.StringAttribute{
 											MarkdownDescription: "Exact domain name.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.HostnameValidator(),
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 										"expiration_timestamp": schema.StringAttribute{
 											MarkdownDescription: "Specifies expiration_timestamp the RFC 3339 format timestamp at which the containing rule is considered to be logically expired. The rule continues to exist in the configuration but is not applied anymore.",
@@ -19836,18 +23268,34 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] Methods. Methods to be matched. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+											},
 										},
 										"path_prefix": schema.StringAttribute{
 											MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths).",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"path_regex": schema.StringAttribute{
 											MarkdownDescription: "Define the regex for the path. For example, the regex ^/.*$ will match on all paths.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(256),
+												validators.RegexValidator(),
+											},
 										},
 										"suffix_value": schema.StringAttribute{
 											MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.HostnameValidator(),
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -19863,6 +23311,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 											Blocks: map[string]schema.Block{
 												"exclude_attack_type_contexts": schema.ListNestedBlock{
 													MarkdownDescription: "Attack Types to be excluded for the defined match criteria.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(64),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"context": schema.StringAttribute{
@@ -19872,6 +23323,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															"context_name": schema.StringAttribute{
 																MarkdownDescription: "Relevant only for contexts: Header, Cookie and Parameter. Name of the Context that the WAF Exclusion Rules will check. Wildcard matching can be used by prefixing or suffixing the context name with an wildcard asterisk (*).",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.UTF8LengthAtMost(128),
+																},
 															},
 															"exclude_attack_type": schema.StringAttribute{
 																MarkdownDescription: "[Enum: ATTACK_TYPE_NONE|ATTACK_TYPE_NON_BROWSER_CLIENT|ATTACK_TYPE_OTHER_APPLICATION_ATTACKS|ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE|ATTACK_TYPE_DETECTION_EVASION|ATTACK_TYPE_VULNERABILITY_SCAN|ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY|ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS|ATTACK_TYPE_BUFFER_OVERFLOW|ATTACK_TYPE_PREDICTABLE_RESOURCE_LOCATION|ATTACK_TYPE_INFORMATION_LEAKAGE|ATTACK_TYPE_DIRECTORY_INDEXING|ATTACK_TYPE_PATH_TRAVERSAL|ATTACK_TYPE_XPATH_INJECTION|ATTACK_TYPE_LDAP_INJECTION|ATTACK_TYPE_SERVER_SIDE_CODE_INJECTION|ATTACK_TYPE_COMMAND_EXECUTION|ATTACK_TYPE_SQL_INJECTION|ATTACK_TYPE_CROSS_SITE_SCRIPTING|ATTACK_TYPE_DENIAL_OF_SERVICE|ATTACK_TYPE_HTTP_PARSER_ATTACK|ATTACK_TYPE_SESSION_HIJACKING|ATTACK_TYPE_HTTP_RESPONSE_SPLITTING|ATTACK_TYPE_FORCEFUL_BROWSING|ATTACK_TYPE_REMOTE_FILE_INCLUDE|ATTACK_TYPE_MALICIOUS_FILE_UPLOAD|ATTACK_TYPE_GRAPHQL_PARSER_ATTACK] List of all Attack Types ATTACK_TYPE_NONE ATTACK_TYPE_NON_BROWSER_CLIENT ATTACK_TYPE_OTHER_APPLICATION_ATTACKS ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE ATTACK_TYPE_DETECTION_EVASION ATTACK_TYPE_VULNERABILITY_SCAN ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS.. Possible values are `ATTACK_TYPE_NONE`, `ATTACK_TYPE_NON_BROWSER_CLIENT`, `ATTACK_TYPE_OTHER_APPLICATION_ATTACKS`, `ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE`, `ATTACK_TYPE_DETECTION_EVASION`, `ATTACK_TYPE_VULNERABILITY_SCAN`, `ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY`, `ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS`, `ATTACK_TYPE_BUFFER_OVERFLOW`, `ATTACK_TYPE_PREDICTABLE_RESOURCE_LOCATION`, `ATTACK_TYPE_INFORMATION_LEAKAGE`, `ATTACK_TYPE_DIRECTORY_INDEXING`, `ATTACK_TYPE_PATH_TRAVERSAL`, `ATTACK_TYPE_XPATH_INJECTION`, `ATTACK_TYPE_LDAP_INJECTION`, `ATTACK_TYPE_SERVER_SIDE_CODE_INJECTION`, `ATTACK_TYPE_COMMAND_EXECUTION`, `ATTACK_TYPE_SQL_INJECTION`, `ATTACK_TYPE_CROSS_SITE_SCRIPTING`, `ATTACK_TYPE_DENIAL_OF_SERVICE`, `ATTACK_TYPE_HTTP_PARSER_ATTACK`, `ATTACK_TYPE_SESSION_HIJACKING`, `ATTACK_TYPE_HTTP_RESPONSE_SPLITTING`, `ATTACK_TYPE_FORCEFUL_BROWSING`, `ATTACK_TYPE_REMOTE_FILE_INCLUDE`, `ATTACK_TYPE_MALICIOUS_FILE_UPLOAD`, `ATTACK_TYPE_GRAPHQL_PARSER_ATTACK`. Defaults to `ATTACK_TYPE_NONE`.",
@@ -19882,6 +23336,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												},
 												"exclude_bot_name_contexts": schema.ListNestedBlock{
 													MarkdownDescription: "Bot Names to be excluded for the defined match criteria.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(64),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"bot_name": schema.StringAttribute{
@@ -19893,6 +23350,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												},
 												"exclude_signature_contexts": schema.ListNestedBlock{
 													MarkdownDescription: "Signature IDs to be excluded for the defined match criteria.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1024),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"context": schema.StringAttribute{
@@ -19902,16 +23362,26 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															"context_name": schema.StringAttribute{
 																MarkdownDescription: "Relevant only for contexts: Header, Cookie and Parameter. Name of the Context that the WAF Exclusion Rules will check. Wildcard matching can be used by prefixing or suffixing the context name with an wildcard asterisk (*).",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.UTF8LengthAtMost(128),
+																},
 															},
 															"signature_id": schema.Int64Attribute{
 																MarkdownDescription: "The allowed values for signature ID are 0 and in the range of 200000001-299999999. 0 implies that all signatures will be excluded for the specified context.",
 																Optional:            true,
+																Validators: []validator.Int64{
+																	int64validator.AtLeast(0),
+																	int64validator.AtMost(299999999),
+																},
 															},
 														},
 													},
 												},
 												"exclude_violation_contexts": schema.ListNestedBlock{
 													MarkdownDescription: "Violations to be excluded for the defined match criteria.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(64),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"context": schema.StringAttribute{
@@ -19921,6 +23391,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 															"context_name": schema.StringAttribute{
 																MarkdownDescription: "Relevant only for contexts: Header, Cookie and Parameter. Name of the Context that the WAF Exclusion Rules will check. Wildcard matching can be used by prefixing or suffixing the context name with an wildcard asterisk (*).",
 																Optional:            true,
+																Validators: []validator.String{
+																	stringvalidator.UTF8LengthAtMost(128),
+																},
 															},
 															"exclude_violation": schema.StringAttribute{
 																MarkdownDescription: "[Enum: VIOL_NONE|VIOL_FILETYPE|VIOL_METHOD|VIOL_MANDATORY_HEADER|VIOL_HTTP_RESPONSE_STATUS|VIOL_REQUEST_MAX_LENGTH|VIOL_FILE_UPLOAD|VIOL_FILE_UPLOAD_IN_BODY|VIOL_XML_MALFORMED|VIOL_JSON_MALFORMED|VIOL_ASM_COOKIE_MODIFIED|VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS|VIOL_HTTP_PROTOCOL_BAD_HOST_HEADER_VALUE|VIOL_HTTP_PROTOCOL_UNPARSABLE_REQUEST_CONTENT|VIOL_HTTP_PROTOCOL_NULL_IN_REQUEST|VIOL_HTTP_PROTOCOL_BAD_HTTP_VERSION|VIOL_HTTP_PROTOCOL_SEVERAL_CONTENT_LENGTH_HEADERS|VIOL_EVASION_DIRECTORY_TRAVERSALS|VIOL_MALFORMED_REQUEST|VIOL_EVASION_MULTIPLE_DECODING|VIOL_DATA_GUARD|VIOL_EVASION_APACHE_WHITESPACE|VIOL_COOKIE_MODIFIED|VIOL_EVASION_IIS_UNICODE_CODEPOINTS|VIOL_EVASION_IIS_BACKSLASHES|VIOL_EVASION_PERCENT_U_DECODING|VIOL_EVASION_BARE_BYTE_DECODING|VIOL_EVASION_BAD_UNESCAPE|VIOL_HTTP_PROTOCOL_BODY_IN_GET_OR_HEAD_REQUEST|VIOL_ENCODING|VIOL_COOKIE_MALFORMED|VIOL_GRAPHQL_FORMAT|VIOL_GRAPHQL_MALFORMED|VIOL_GRAPHQL_INTROSPECTION_QUERY] List of all supported Violation Types VIOL_NONE VIOL_FILETYPE VIOL_METHOD VIOL_MANDATORY_HEADER VIOL_HTTP_RESPONSE_STATUS VIOL_REQUEST_MAX_LENGTH VIOL_FILE_UPLOAD VIOL_FILE_UPLOAD_IN_BODY VIOL_XML_MALFORMED VIOL_JSON_MALFORMED VIOL_ASM_COOKIE_MODIFIED VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS.. Possible values are `VIOL_NONE`, `VIOL_FILETYPE`, `VIOL_METHOD`, `VIOL_MANDATORY_HEADER`, `VIOL_HTTP_RESPONSE_STATUS`, `VIOL_REQUEST_MAX_LENGTH`, `VIOL_FILE_UPLOAD`, `VIOL_FILE_UPLOAD_IN_BODY`, `VIOL_XML_MALFORMED`, `VIOL_JSON_MALFORMED`, `VIOL_ASM_COOKIE_MODIFIED`, `VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS`, `VIOL_HTTP_PROTOCOL_BAD_HOST_HEADER_VALUE`, `VIOL_HTTP_PROTOCOL_UNPARSABLE_REQUEST_CONTENT`, `VIOL_HTTP_PROTOCOL_NULL_IN_REQUEST`, `VIOL_HTTP_PROTOCOL_BAD_HTTP_VERSION`, `VIOL_HTTP_PROTOCOL_SEVERAL_CONTENT_LENGTH_HEADERS`, `VIOL_EVASION_DIRECTORY_TRAVERSALS`, `VIOL_MALFORMED_REQUEST`, `VIOL_EVASION_MULTIPLE_DECODING`, `VIOL_DATA_GUARD`, `VIOL_EVASION_APACHE_WHITESPACE`, `VIOL_COOKIE_MODIFIED`, `VIOL_EVASION_IIS_UNICODE_CODEPOINTS`, `VIOL_EVASION_IIS_BACKSLASHES`, `VIOL_EVASION_PERCENT_U_DECODING`, `VIOL_EVASION_BARE_BYTE_DECODING`, `VIOL_EVASION_BAD_UNESCAPE`, `VIOL_HTTP_PROTOCOL_BODY_IN_GET_OR_HEAD_REQUEST`, `VIOL_ENCODING`, `VIOL_COOKIE_MALFORMED`, `VIOL_GRAPHQL_FORMAT`, `VIOL_GRAPHQL_MALFORMED`, `VIOL_GRAPHQL_INTROSPECTION_QUERY`. Defaults to `VIOL_NONE`.",
@@ -19937,10 +23410,16 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Human readable description.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -19958,6 +23437,10 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -19966,6 +23449,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -19974,6 +23460,9 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -19983,6 +23472,11 @@ func (r *HTTPLoadBalancerResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *HTTPLoadBalancerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *HTTPLoadBalancerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -20007,6 +23501,383 @@ func (r *HTTPLoadBalancerResource) ValidateConfig(ctx context.Context, req resou
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *HTTPLoadBalancerResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "advertise_choice", "advertise_custom", "advertise_on_public", "advertise_on_public_default_vip", "do_not_advertise"),
+		validators.OneOfGroup(path.MatchRelative(), "api_definition_choice", "api_specification", "disable_api_definition"),
+		validators.OneOfGroup(path.MatchRelative(), "api_discovery_choice", "disable_api_discovery", "enable_api_discovery"),
+		validators.OneOfGroup(path.MatchRelative(), "api_testing_choice", "api_testing", "disable_api_testing"),
+		validators.OneOfGroup(path.MatchRelative(), "bot_defense_choice", "bot_defense", "bot_defense_advanced", "disable_bot_defense"),
+		validators.OneOfGroup(path.MatchRelative(), "cache_options", "caching_policy", "disable_caching"),
+		validators.OneOfGroup(path.MatchRelative(), "challenge_type", "captcha_challenge", "enable_challenge", "js_challenge", "no_challenge", "policy_based_challenge"),
+		validators.OneOfGroup(path.MatchRelative(), "client_side_defense_choice", "client_side_defense", "disable_client_side_defense"),
+		validators.OneOfGroup(path.MatchRelative(), "hash_policy_choice", "cookie_stickiness", "least_active", "random", "ring_hash", "round_robin", "source_ip_stickiness"),
+		validators.OneOfGroup(path.MatchRelative(), "ip_reputation_choice", "disable_ip_reputation", "enable_ip_reputation"),
+		validators.OneOfGroup(path.MatchRelative(), "l7_ddos_auto_mitigation_action", "l7_ddos_action_block", "l7_ddos_action_default", "l7_ddos_action_js_challenge"),
+		validators.OneOfGroup(path.MatchRelative(), "loadbalancer_type", "http", "https", "https_auto_cert"),
+		validators.OneOfGroup(path.MatchRelative(), "malicious_user_detection_choice", "disable_malicious_user_detection", "enable_malicious_user_detection"),
+		validators.OneOfGroup(path.MatchRelative(), "malware_protection", "disable_malware_protection", "malware_protection_settings"),
+		validators.OneOfGroup(path.MatchRelative(), "ml_config_choice", "multi_lb_app", "single_lb_app"),
+		validators.OneOfGroup(path.MatchRelative(), "origin_pool_choice", "default_pool", "default_pool_list"),
+		validators.OneOfGroup(path.MatchRelative(), "rate_limit_choice", "api_rate_limit", "disable_rate_limit", "rate_limit"),
+		validators.OneOfGroup(path.MatchRelative(), "sensitive_data_policy_choice", "default_sensitive_data_policy", "sensitive_data_policy"),
+		validators.OneOfGroup(path.MatchRelative(), "service_policy_choice", "active_service_policies", "no_service_policies", "service_policies_from_namespace"),
+		validators.OneOfGroup(path.MatchRelative(), "slow_ddos_mitigation_choice", "slow_ddos_mitigation", "system_default_timeouts"),
+		validators.OneOfGroup(path.MatchRelative(), "threat_mesh_choice", "disable_threat_mesh", "enable_threat_mesh"),
+		validators.OneOfGroup(path.MatchRelative(), "trust_client_ip_headers_choice", "disable_trust_client_ip_headers", "enable_trust_client_ip_headers"),
+		validators.OneOfGroup(path.MatchRelative(), "user_id_choice", "user_id_client_ip", "user_identification"),
+		validators.OneOfGroup(path.MatchRelative(), "waf_choice", "app_firewall", "disable_waf"),
+		validators.OneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex(), "choice", "advertise_on_public", "site", "virtual_network", "virtual_site", "virtual_site_with_vip", "vk8s_service"),
+		validators.RequiredOneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex(), "port_choice", "port", "port_ranges", "use_default_port"),
+		validators.OneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex().AtName("virtual_network"), "v6_vip_choice", "default_v6_vip", "specific_v6_vip"),
+		validators.OneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex().AtName("virtual_network"), "vip_choice", "default_vip", "specific_vip"),
+		validators.OneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex().AtName("vk8s_service"), "choice", "site", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_endpoint_rules").AtAnyListIndex(), "domain_choice", "any_domain", "specific_domain"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_endpoint_rules").AtAnyListIndex().AtName("action"), "action", "allow", "deny"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_endpoint_rules").AtAnyListIndex().AtName("client_matcher"), "client_choice", "any_client", "client_selector", "ip_threat_category_list"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_endpoint_rules").AtAnyListIndex().AtName("client_matcher"), "ip_asn_choice", "any_ip", "asn_list", "asn_matcher", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_endpoint_rules").AtAnyListIndex().AtName("request_matcher").AtName("cookie_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_endpoint_rules").AtAnyListIndex().AtName("request_matcher").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_endpoint_rules").AtAnyListIndex().AtName("request_matcher").AtName("jwt_claims").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_endpoint_rules").AtAnyListIndex().AtName("request_matcher").AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_groups_rules").AtAnyListIndex(), "domain_choice", "any_domain", "specific_domain"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_groups_rules").AtAnyListIndex().AtName("action"), "action", "allow", "deny"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_groups_rules").AtAnyListIndex().AtName("client_matcher"), "client_choice", "any_client", "client_selector", "ip_threat_category_list"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_groups_rules").AtAnyListIndex().AtName("client_matcher"), "ip_asn_choice", "any_ip", "asn_list", "asn_matcher", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_groups_rules").AtAnyListIndex().AtName("request_matcher").AtName("cookie_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_groups_rules").AtAnyListIndex().AtName("request_matcher").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_groups_rules").AtAnyListIndex().AtName("request_matcher").AtName("jwt_claims").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_protection_rules").AtName("api_groups_rules").AtAnyListIndex().AtName("request_matcher").AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit"), "ip_allowed_list_choice", "bypass_rate_limiting_rules", "custom_ip_allowed_list", "ip_allowed_list", "no_ip_allowed_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex(), "domain_choice", "any_domain", "specific_domain"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex(), "rate_limiter_choice", "inline_rate_limiter", "ref_rate_limiter"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("client_matcher"), "client_choice", "any_client", "client_selector", "ip_threat_category_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("client_matcher"), "ip_asn_choice", "any_ip", "asn_list", "asn_matcher", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("inline_rate_limiter"), "count_by_choice", "ref_user_id", "use_http_lb_user_id"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("request_matcher").AtName("cookie_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("request_matcher").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("request_matcher").AtName("jwt_claims").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("api_endpoint_rules").AtAnyListIndex().AtName("request_matcher").AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex(), "destination_type", "any_url", "api_endpoint", "api_groups", "base_path"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex(), "domain_choice", "any_domain", "specific_domain"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex().AtName("client_matcher"), "client_choice", "any_client", "client_selector", "ip_threat_category_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex().AtName("client_matcher"), "ip_asn_choice", "any_ip", "asn_list", "asn_matcher", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex().AtName("request_matcher").AtName("cookie_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex().AtName("request_matcher").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex().AtName("request_matcher").AtName("jwt_claims").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("bypass_rate_limiting_rules").AtName("bypass_rate_limiting_rules").AtAnyListIndex().AtName("request_matcher").AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex(), "domain_choice", "any_domain", "specific_domain"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex(), "rate_limiter_choice", "inline_rate_limiter", "ref_rate_limiter"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("client_matcher"), "client_choice", "any_client", "client_selector", "ip_threat_category_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("client_matcher"), "ip_asn_choice", "any_ip", "asn_list", "asn_matcher", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("inline_rate_limiter"), "count_by_choice", "ref_user_id", "use_http_lb_user_id"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("request_matcher").AtName("cookie_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("request_matcher").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("request_matcher").AtName("jwt_claims").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_rate_limit").AtName("server_url_rules").AtAnyListIndex().AtName("request_matcher").AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("api_specification"), "validation_target_choice", "validation_all_spec_endpoints", "validation_custom_list", "validation_disabled"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("fall_through_mode"), "fall_through_mode_choice", "fall_through_mode_allow", "fall_through_mode_custom"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("fall_through_mode").AtName("fall_through_mode_custom").AtName("open_api_validation_rules").AtAnyListIndex(), "action_choice", "action_block", "action_report", "action_skip"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("fall_through_mode").AtName("fall_through_mode_custom").AtName("open_api_validation_rules").AtAnyListIndex(), "condition_type_choice", "api_endpoint", "api_group", "base_path"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("settings"), "oversized_body_choice", "oversized_body_fail_validation", "oversized_body_skip_validation"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("settings"), "property_validation_settings_choice", "property_validation_settings_custom", "property_validation_settings_default"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("settings").AtName("property_validation_settings_custom").AtName("query_parameters"), "additional_parameters_choice", "allow_additional_parameters", "disallow_additional_parameters"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("validation_mode"), "response_validation_mode_choice", "response_validation_mode_active", "skip_response_validation"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("validation_mode"), "validation_mode_choice", "skip_validation", "validation_mode_active"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("validation_mode").AtName("response_validation_mode_active"), "validation_enforcement_type", "enforcement_block", "enforcement_report"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_all_spec_endpoints").AtName("validation_mode").AtName("validation_mode_active"), "validation_enforcement_type", "enforcement_block", "enforcement_report"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("fall_through_mode"), "fall_through_mode_choice", "fall_through_mode_allow", "fall_through_mode_custom"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("fall_through_mode").AtName("fall_through_mode_custom").AtName("open_api_validation_rules").AtAnyListIndex(), "action_choice", "action_block", "action_report", "action_skip"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("fall_through_mode").AtName("fall_through_mode_custom").AtName("open_api_validation_rules").AtAnyListIndex(), "condition_type_choice", "api_endpoint", "api_group", "base_path"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("open_api_validation_rules").AtAnyListIndex(), "condition_type_choice", "api_endpoint", "api_group", "base_path"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("open_api_validation_rules").AtAnyListIndex(), "domain_choice", "any_domain", "specific_domain"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("open_api_validation_rules").AtAnyListIndex().AtName("validation_mode"), "response_validation_mode_choice", "response_validation_mode_active", "skip_response_validation"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("open_api_validation_rules").AtAnyListIndex().AtName("validation_mode"), "validation_mode_choice", "skip_validation", "validation_mode_active"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("open_api_validation_rules").AtAnyListIndex().AtName("validation_mode").AtName("response_validation_mode_active"), "validation_enforcement_type", "enforcement_block", "enforcement_report"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("open_api_validation_rules").AtAnyListIndex().AtName("validation_mode").AtName("validation_mode_active"), "validation_enforcement_type", "enforcement_block", "enforcement_report"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("settings"), "oversized_body_choice", "oversized_body_fail_validation", "oversized_body_skip_validation"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("settings"), "property_validation_settings_choice", "property_validation_settings_custom", "property_validation_settings_default"),
+		validators.OneOfGroup(path.MatchRoot("api_specification").AtName("validation_custom_list").AtName("settings").AtName("property_validation_settings_custom").AtName("query_parameters"), "additional_parameters_choice", "allow_additional_parameters", "disallow_additional_parameters"),
+		validators.OneOfGroup(path.MatchRoot("api_testing"), "frequency_choice", "every_day", "every_month", "every_week"),
+		validators.OneOfGroup(path.MatchRoot("api_testing").AtName("domains").AtAnyListIndex().AtName("credentials").AtAnyListIndex(), "credentials_choice", "api_key", "basic_auth", "bearer_token", "login_endpoint"),
+		validators.OneOfGroup(path.MatchRoot("api_testing").AtName("domains").AtAnyListIndex().AtName("credentials").AtAnyListIndex(), "role_choice", "admin", "standard"),
+		validators.OneOfGroup(path.MatchRoot("api_testing").AtName("domains").AtAnyListIndex().AtName("credentials").AtAnyListIndex().AtName("api_key").AtName("value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("api_testing").AtName("domains").AtAnyListIndex().AtName("credentials").AtAnyListIndex().AtName("basic_auth").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("api_testing").AtName("domains").AtAnyListIndex().AtName("credentials").AtAnyListIndex().AtName("bearer_token").AtName("token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("api_testing").AtName("domains").AtAnyListIndex().AtName("credentials").AtAnyListIndex().AtName("login_endpoint").AtName("json_payload"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("blocked_clients").AtAnyListIndex(), "action_choice", "bot_skip_processing", "skip_processing", "waf_skip_processing"),
+		validators.RequiredOneOfGroup(path.MatchRoot("blocked_clients").AtAnyListIndex(), "client_source_choice", "as_number", "http_header", "ip_prefix", "ipv6_prefix", "user_identifier"),
+		validators.OneOfGroup(path.MatchRoot("blocked_clients").AtAnyListIndex().AtName("http_header").AtName("headers").AtAnyListIndex(), "value_match", "exact", "presence", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense"), "cors_support_choice", "disable_cors_support", "enable_cors_support"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy"), "java_script_choice", "disable_js_insert", "js_insert_all_pages", "js_insert_all_pages_except", "js_insertion_rules"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy"), "mobile_sdk_choice", "disable_mobile_sdk", "mobile_sdk_config"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("mobile_sdk_config").AtName("mobile_identifier").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex(), "app_traffic_type_choice", "mobile", "web", "web_mobile"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex(), "flow_label_choice", "flow_label", "undefined_flow_label"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex(), "goodbot_choice", "allow_good_bots", "mitigate_good_bots"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label"), "flow_label_choice", "account_management", "authentication", "financial_services", "flight", "profile_management", "search", "shopping_gift_cards"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("account_management"), "label_choice", "create", "password_reset"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("authentication"), "label_choice", "login", "login_mfa", "login_partner", "logout", "token_refresh"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("authentication").AtName("login"), "transaction_result_choice", "disable_transaction_result", "transaction_result"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("financial_services"), "label_choice", "apply", "money_transfer"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("profile_management"), "label_choice", "create", "update", "view"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("search"), "label_choice", "flight_search", "product_search", "reservation_search", "room_search"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("flow_label").AtName("shopping_gift_cards"), "label_choice", "gift_card_make_purchase_with_gift_card", "gift_card_validation", "shop_add_to_cart", "shop_checkout", "shop_choose_seat", "shop_enter_drawing_submission", "shop_make_payment", "shop_order", "shop_price_inquiry", "shop_promo_code_validation", "shop_purchase_gift_card", "shop_update_quantity"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("mitigation"), "action_type", "block", "flag", "redirect"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("mitigation").AtName("flag"), "send_headers_choice", "append_headers", "no_headers"),
+		validators.RequiredOneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense").AtName("policy").AtName("protected_app_endpoints").AtAnyListIndex().AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense_advanced"), "java_script_choice", "disable_js_insert", "js_insert_all_pages", "js_insert_all_pages_except", "js_insertion_rules"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense_advanced"), "mobile_sdk_choice", "disable_mobile_sdk", "mobile_sdk_config"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense_advanced").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense_advanced").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("bot_defense_advanced").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense_advanced").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense_advanced").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("bot_defense_advanced").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense_advanced").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense_advanced").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("bot_defense_advanced").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("bot_defense_advanced").AtName("mobile_sdk_config").AtName("mobile_identifier").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("caching_policy").AtName("default_cache_action"), "cache_actions", "cache_disabled", "cache_ttl_default", "cache_ttl_override"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy"), "java_script_choice", "disable_js_insert", "js_insert_all_pages", "js_insert_all_pages_except", "js_insertion_rules"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insert_all_pages_except").AtName("exclude_list").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insertion_rules").AtName("exclude_list").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex(), "domain_matcher_choice", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex().AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("client_side_defense").AtName("policy").AtName("js_insertion_rules").AtName("rules").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("cookie_stickiness"), "httponly", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("cookie_stickiness"), "samesite", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("cookie_stickiness"), "secure", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("csrf_policy"), "allowed_domains", "all_load_balancer_domains", "custom_domain_list", "disabled"),
+		validators.OneOfGroup(path.MatchRoot("data_guard_rules").AtAnyListIndex(), "action_choice", "apply_data_guard", "skip_data_guard"),
+		validators.OneOfGroup(path.MatchRoot("data_guard_rules").AtAnyListIndex(), "domain_choice", "any_domain", "exact_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("data_guard_rules").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("ddos_mitigation_rules").AtAnyListIndex(), "mitigation_choice", "ddos_client_source", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("default_pool"), "health_check_port_choice", "health_check_port", "same_as_endpoint_port"),
+		validators.RequiredOneOfGroup(path.MatchRoot("default_pool"), "port_choice", "automatic_port", "lb_port", "port"),
+		validators.OneOfGroup(path.MatchRoot("default_pool"), "tls_choice", "no_tls", "use_tls"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("advanced_options"), "circuit_breaker_choice", "circuit_breaker", "default_circuit_breaker", "disable_circuit_breaker"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("advanced_options"), "http_protocol_type", "auto_http_config", "http1_config", "http2_options"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("advanced_options"), "lb_source_ip_persistance_choice", "disable_lb_source_ip_persistance", "enable_lb_source_ip_persistance"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("advanced_options"), "outlier_detection_choice", "disable_outlier_detection", "outlier_detection"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("advanced_options"), "panic_threshold_type", "no_panic_threshold", "panic_threshold"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("advanced_options"), "proxy_protocol_choice", "disable_proxy_protocol", "proxy_protocol_v1", "proxy_protocol_v2"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("advanced_options"), "subset_choice", "disable_subsets", "enable_subsets"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("advanced_options").AtName("enable_subsets"), "fallback_policy_choice", "any_endpoint", "default_subset", "fail_request"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("advanced_options").AtName("http1_config").AtName("header_transformation"), "header_transformation_choice", "default_header_transformation", "legacy_header_transformation", "preserve_case_header_transformation", "proper_case_header_transformation"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex(), "choice", "cbip_service", "consul_service", "custom_endpoint_object", "k8s_service", "private_ip", "private_name", "public_ip", "public_name", "vn_private_ip", "vn_private_name"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex().AtName("consul_service"), "network_choice", "inside_network", "outside_network"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex().AtName("consul_service").AtName("site_locator"), "choice", "site", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex().AtName("consul_service").AtName("snat_pool"), "snat_pool_choice", "no_snat_pool", "snat_pool"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex().AtName("k8s_service"), "network_choice", "inside_network", "outside_network", "vk8s_networks"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex().AtName("k8s_service").AtName("site_locator"), "choice", "site", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex().AtName("k8s_service").AtName("snat_pool"), "snat_pool_choice", "no_snat_pool", "snat_pool"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex().AtName("private_ip"), "network_choice", "inside_network", "outside_network", "segment"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex().AtName("private_ip").AtName("site_locator"), "choice", "site", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex().AtName("private_ip").AtName("snat_pool"), "snat_pool_choice", "no_snat_pool", "snat_pool"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex().AtName("private_name"), "network_choice", "inside_network", "outside_network", "segment"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex().AtName("private_name").AtName("site_locator"), "choice", "site", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("origin_servers").AtAnyListIndex().AtName("private_name").AtName("snat_pool"), "snat_pool_choice", "no_snat_pool", "snat_pool"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("upstream_conn_pool_reuse_type"), "map_downstream_to_upstream_conn_pool_type", "disable_conn_pool_reuse", "enable_conn_pool_reuse"),
+		validators.RequiredOneOfGroup(path.MatchRoot("default_pool").AtName("use_tls"), "max_session_keys_type", "default_session_key_caching", "disable_session_key_caching", "max_session_keys"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("use_tls"), "mtls_choice", "no_mtls", "use_mtls", "use_mtls_obj"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("use_tls"), "server_validation_choice", "skip_server_verification", "use_server_verification", "volterra_trusted_ca"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("use_tls"), "sni_choice", "disable_sni", "sni", "use_host_header_as_sni"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("use_tls").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("use_tls").AtName("use_mtls").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("default_pool").AtName("use_tls").AtName("use_mtls").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.RequiredOneOfGroup(path.MatchRoot("default_pool").AtName("use_tls").AtName("use_server_verification"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("default_pool_list").AtName("pools").AtAnyListIndex(), "pool_choice", "cluster", "pool"),
+		validators.OneOfGroup(path.MatchRoot("default_route_pools").AtAnyListIndex(), "pool_choice", "cluster", "pool"),
+		validators.OneOfGroup(path.MatchRoot("enable_api_discovery"), "api_discovery_settings_choice", "custom_api_auth_discovery", "default_api_auth_discovery"),
+		validators.OneOfGroup(path.MatchRoot("enable_api_discovery"), "learn_from_redirect_traffic", "disable_learn_from_redirect_traffic", "enable_learn_from_redirect_traffic"),
+		validators.OneOfGroup(path.MatchRoot("enable_api_discovery").AtName("api_crawler"), "api_crawler", "api_crawler_config", "disable_api_crawler"),
+		validators.OneOfGroup(path.MatchRoot("enable_api_discovery").AtName("api_crawler").AtName("api_crawler_config").AtName("domains").AtAnyListIndex().AtName("simple_login").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("enable_api_discovery").AtName("api_discovery_from_code_scan").AtName("code_base_integrations").AtAnyListIndex(), "api_repos_choice", "all_repos", "selected_repos"),
+		validators.OneOfGroup(path.MatchRoot("enable_challenge"), "captcha_challenge_parameters_choice", "captcha_challenge_parameters", "default_captcha_challenge_parameters"),
+		validators.OneOfGroup(path.MatchRoot("enable_challenge"), "js_challenge_parameters_choice", "default_js_challenge_parameters", "js_challenge_parameters"),
+		validators.OneOfGroup(path.MatchRoot("enable_challenge"), "malicious_user_mitigation_choice", "default_mitigation_settings", "malicious_user_mitigation"),
+		validators.OneOfGroup(path.MatchRoot("graphql_rules").AtAnyListIndex(), "domain_choice", "any_domain", "exact_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("graphql_rules").AtAnyListIndex(), "method_choice", "method_get", "method_post"),
+		validators.OneOfGroup(path.MatchRoot("graphql_rules").AtAnyListIndex().AtName("graphql_settings"), "allow_introspection_queries_choice", "disable_introspection", "enable_introspection"),
+		validators.OneOfGroup(path.MatchRoot("http"), "port_choice", "port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRoot("https"), "default_lb_choice", "default_loadbalancer", "non_default_loadbalancer"),
+		validators.OneOfGroup(path.MatchRoot("https"), "path_normalize_choice", "disable_path_normalize", "enable_path_normalize"),
+		validators.OneOfGroup(path.MatchRoot("https"), "port_choice", "port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRoot("https"), "server_header_choice", "append_server_name", "default_header", "pass_through", "server_name"),
+		validators.OneOfGroup(path.MatchRoot("https"), "tls_certificates_choice", "tls_cert_params", "tls_parameters"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("coalescing_options"), "coalescing_choice", "default_coalescing", "strict_coalescing"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("http_protocol_options"), "http_protocol_choice", "http_protocol_enable_v1_only", "http_protocol_enable_v1_v2", "http_protocol_enable_v2_only"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("http_protocol_options").AtName("http_protocol_enable_v1_only").AtName("header_transformation"), "header_transformation_choice", "default_header_transformation", "legacy_header_transformation", "preserve_case_header_transformation", "proper_case_header_transformation"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_params"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_params").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_params").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https").AtName("tls_cert_params").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_cert_params").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_parameters"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_parameters").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_parameters").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_parameters").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_parameters").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https").AtName("tls_parameters").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https").AtName("tls_parameters").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("https_auto_cert"), "default_lb_choice", "default_loadbalancer", "non_default_loadbalancer"),
+		validators.OneOfGroup(path.MatchRoot("https_auto_cert"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https_auto_cert"), "path_normalize_choice", "disable_path_normalize", "enable_path_normalize"),
+		validators.OneOfGroup(path.MatchRoot("https_auto_cert"), "port_choice", "port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRoot("https_auto_cert"), "server_header_choice", "append_server_name", "default_header", "pass_through", "server_name"),
+		validators.OneOfGroup(path.MatchRoot("https_auto_cert").AtName("coalescing_options"), "coalescing_choice", "default_coalescing", "strict_coalescing"),
+		validators.OneOfGroup(path.MatchRoot("https_auto_cert").AtName("http_protocol_options"), "http_protocol_choice", "http_protocol_enable_v1_only", "http_protocol_enable_v1_v2", "http_protocol_enable_v2_only"),
+		validators.OneOfGroup(path.MatchRoot("https_auto_cert").AtName("http_protocol_options").AtName("http_protocol_enable_v1_only").AtName("header_transformation"), "header_transformation_choice", "default_header_transformation", "legacy_header_transformation", "preserve_case_header_transformation", "proper_case_header_transformation"),
+		validators.OneOfGroup(path.MatchRoot("https_auto_cert").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https_auto_cert").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https_auto_cert").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https_auto_cert").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("jwt_validation").AtName("action"), "action_choice", "block", "report"),
+		validators.OneOfGroup(path.MatchRoot("jwt_validation").AtName("reserved_claims"), "audience_validation", "audience", "audience_disable"),
+		validators.OneOfGroup(path.MatchRoot("jwt_validation").AtName("reserved_claims"), "issuer_validation", "issuer", "issuer_disable"),
+		validators.OneOfGroup(path.MatchRoot("jwt_validation").AtName("reserved_claims"), "validate_period", "validate_period_disable", "validate_period_enable"),
+		validators.OneOfGroup(path.MatchRoot("jwt_validation").AtName("target"), "target", "all_endpoint", "api_groups", "base_paths"),
+		validators.OneOfGroup(path.MatchRoot("l7_ddos_protection"), "clientside_action_choice", "clientside_action_captcha_challenge", "clientside_action_js_challenge", "clientside_action_none"),
+		validators.OneOfGroup(path.MatchRoot("l7_ddos_protection"), "ddos_policy_choice", "ddos_policy_custom", "ddos_policy_none"),
+		validators.OneOfGroup(path.MatchRoot("l7_ddos_protection"), "mitigation_action_choice", "mitigation_block", "mitigation_captcha_challenge", "mitigation_js_challenge"),
+		validators.RequiredOneOfGroup(path.MatchRoot("l7_ddos_protection"), "rps_threshold_choice", "default_rps_threshold", "rps_threshold"),
+		validators.OneOfGroup(path.MatchRoot("malware_protection_settings").AtName("malware_protection_rules").AtAnyListIndex().AtName("action"), "action_choice", "block", "report"),
+		validators.OneOfGroup(path.MatchRoot("malware_protection_settings").AtName("malware_protection_rules").AtAnyListIndex().AtName("domain"), "domain_matcher", "any_domain", "domain"),
+		validators.OneOfGroup(path.MatchRoot("malware_protection_settings").AtName("malware_protection_rules").AtAnyListIndex().AtName("domain").AtName("domain"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.RequiredOneOfGroup(path.MatchRoot("malware_protection_settings").AtName("malware_protection_rules").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("more_option"), "path_normalize_choice", "disable_path_normalize", "enable_path_normalize"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("request_cookies_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("request_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("request_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("request_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "domain_choice", "add_domain", "ignore_domain"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "expiry_choice", "add_expiry", "ignore_expiry"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "httponly_choice", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "max_age_choice", "ignore_max_age", "max_age_value"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "partitioned_choice", "add_partitioned", "ignore_partitioned"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "path_choice", "add_path", "ignore_path"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "samesite_choice", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "secure_choice", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "value_choice", "ignore_value", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("response_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("response_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("more_option").AtName("response_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("origin_server_subset_rule_list").AtName("origin_server_subset_rules").AtAnyListIndex(), "asn_choice", "any_asn", "asn_list", "asn_matcher"),
+		validators.OneOfGroup(path.MatchRoot("origin_server_subset_rule_list").AtName("origin_server_subset_rules").AtAnyListIndex(), "ip_choice", "any_ip", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("origin_server_subset_rule_list").AtName("origin_server_subset_rules").AtAnyListIndex(), "selector_choice", "client_selector", "none"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge"), "captcha_challenge_parameters_choice", "captcha_challenge_parameters", "default_captcha_challenge_parameters"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge"), "challenge_choice", "always_enable_captcha_challenge", "always_enable_js_challenge", "no_challenge"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge"), "js_challenge_parameters_choice", "default_js_challenge_parameters", "js_challenge_parameters"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge"), "malicious_user_mitigation_choice", "default_mitigation_settings", "malicious_user_mitigation"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge"), "temporary_blocking_parameters_choice", "default_temporary_blocking_parameters", "temporary_user_blocking"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec"), "asn_choice", "any_asn", "asn_list", "asn_matcher"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec"), "challenge_action", "disable_challenge", "enable_captcha_challenge", "enable_javascript_challenge"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec"), "client_choice", "any_client", "client_selector"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec"), "ip_choice", "any_ip", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("arg_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("cookie_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("policy_based_challenge").AtName("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("protected_cookies").AtAnyListIndex(), "cookie_tampering", "disable_tampering_protection", "enable_tampering_protection"),
+		validators.OneOfGroup(path.MatchRoot("protected_cookies").AtAnyListIndex(), "httponly", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("protected_cookies").AtAnyListIndex(), "max_age", "ignore_max_age", "max_age_value"),
+		validators.OneOfGroup(path.MatchRoot("protected_cookies").AtAnyListIndex(), "samesite", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("protected_cookies").AtAnyListIndex(), "secure", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("rate_limit"), "ip_allowed_list_choice", "custom_ip_allowed_list", "ip_allowed_list", "no_ip_allowed_list"),
+		validators.OneOfGroup(path.MatchRoot("rate_limit"), "policy_choice", "no_policies", "policies"),
+		validators.OneOfGroup(path.MatchRoot("rate_limit").AtName("rate_limiter"), "action_choice", "action_block", "disabled"),
+		validators.OneOfGroup(path.MatchRoot("rate_limit").AtName("rate_limiter"), "algorithm", "leaky_bucket", "token_bucket"),
+		validators.OneOfGroup(path.MatchRoot("rate_limit").AtName("rate_limiter").AtName("action_block"), "block_duration_choice", "hours", "minutes", "seconds"),
+		validators.OneOfGroup(path.MatchRoot("ring_hash").AtName("hash_policy").AtAnyListIndex(), "policy_specifier", "cookie", "header_name", "source_ip"),
+		validators.OneOfGroup(path.MatchRoot("ring_hash").AtName("hash_policy").AtAnyListIndex().AtName("cookie"), "httponly", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("ring_hash").AtName("hash_policy").AtAnyListIndex().AtName("cookie"), "samesite", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("ring_hash").AtName("hash_policy").AtAnyListIndex().AtName("cookie"), "secure", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex(), "choice", "custom_route_object", "direct_response_route", "redirect_route", "simple_route"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("direct_response_route").AtName("headers").AtAnyListIndex(), "value_match", "exact", "presence", "regex"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("direct_response_route").AtName("incoming_port"), "port_match", "no_port_match", "port", "port_ranges"),
+		validators.RequiredOneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("direct_response_route").AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("redirect_route").AtName("headers").AtAnyListIndex(), "value_match", "exact", "presence", "regex"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("redirect_route").AtName("incoming_port"), "port_match", "no_port_match", "port", "port_ranges"),
+		validators.RequiredOneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("redirect_route").AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("redirect_route").AtName("route_redirect"), "query_params", "remove_all_params", "replace_params", "retain_all_params"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("redirect_route").AtName("route_redirect"), "redirect_path_choice", "path_redirect", "prefix_rewrite"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route"), "host_rewrite_params", "auto_host_rewrite", "disable_host_rewrite", "host_rewrite"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options"), "bot_defense_javascript_injection_choice", "bot_defense_javascript_injection", "inherited_bot_defense_javascript_injection"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options"), "buffer_choice", "buffer_policy", "common_buffering"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options"), "cluster_retract_choice", "do_not_retract_cluster", "retract_cluster"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options"), "hash_policy_choice", "common_hash_policy", "specific_hash_policy"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options"), "mirroring_choice", "disable_mirroring", "mirror_policy"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options"), "retry_policy_choice", "default_retry_policy", "no_retry_policy", "retry_policy"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options"), "rewrite_choice", "disable_prefix_rewrite", "prefix_rewrite", "regex_rewrite"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options"), "spdy_choice", "disable_spdy", "enable_spdy"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options"), "waf_choice", "app_firewall", "disable_waf", "inherited_waf"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options"), "waf_exclusion_choice", "inherited_waf_exclusion", "waf_exclusion_policy"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options"), "websocket_choice", "disable_web_socket_config", "web_socket_config"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("csrf_policy"), "allowed_domains", "all_load_balancer_domains", "custom_domain_list", "disabled"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("request_cookies_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("request_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("request_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("request_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("response_cookies_to_add").AtAnyListIndex(), "domain_choice", "add_domain", "ignore_domain"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("response_cookies_to_add").AtAnyListIndex(), "expiry_choice", "add_expiry", "ignore_expiry"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("response_cookies_to_add").AtAnyListIndex(), "httponly_choice", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("response_cookies_to_add").AtAnyListIndex(), "max_age_choice", "ignore_max_age", "max_age_value"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("response_cookies_to_add").AtAnyListIndex(), "partitioned_choice", "add_partitioned", "ignore_partitioned"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("response_cookies_to_add").AtAnyListIndex(), "path_choice", "add_path", "ignore_path"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("response_cookies_to_add").AtAnyListIndex(), "samesite_choice", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("response_cookies_to_add").AtAnyListIndex(), "secure_choice", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("response_cookies_to_add").AtAnyListIndex(), "value_choice", "ignore_value", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("response_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("response_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("response_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("specific_hash_policy").AtName("hash_policy").AtAnyListIndex(), "policy_specifier", "cookie", "header_name", "source_ip"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("specific_hash_policy").AtName("hash_policy").AtAnyListIndex().AtName("cookie"), "httponly", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("specific_hash_policy").AtName("hash_policy").AtAnyListIndex().AtName("cookie"), "samesite", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("advanced_options").AtName("specific_hash_policy").AtName("hash_policy").AtAnyListIndex().AtName("cookie"), "secure", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("headers").AtAnyListIndex(), "value_match", "exact", "presence", "regex"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("incoming_port"), "port_match", "no_port_match", "port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("origin_pools").AtAnyListIndex(), "pool_choice", "cluster", "pool"),
+		validators.RequiredOneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("simple_route").AtName("query_params"), "query_params", "remove_all_params", "replace_params", "retain_all_params"),
+		validators.OneOfGroup(path.MatchRoot("sensitive_data_disclosure_rules").AtName("sensitive_data_types_in_response").AtAnyListIndex(), "masking_mode_choice", "mask", "report"),
+		validators.OneOfGroup(path.MatchRoot("single_lb_app"), "api_discovery_choice", "disable_discovery", "enable_discovery"),
+		validators.OneOfGroup(path.MatchRoot("single_lb_app"), "malicious_user_detection_choice", "disable_malicious_user_detection", "enable_malicious_user_detection"),
+		validators.OneOfGroup(path.MatchRoot("single_lb_app").AtName("enable_discovery"), "api_discovery_settings_choice", "custom_api_auth_discovery", "default_api_auth_discovery"),
+		validators.OneOfGroup(path.MatchRoot("single_lb_app").AtName("enable_discovery"), "learn_from_redirect_traffic", "disable_learn_from_redirect_traffic", "enable_learn_from_redirect_traffic"),
+		validators.OneOfGroup(path.MatchRoot("single_lb_app").AtName("enable_discovery").AtName("api_crawler"), "api_crawler", "api_crawler_config", "disable_api_crawler"),
+		validators.OneOfGroup(path.MatchRoot("single_lb_app").AtName("enable_discovery").AtName("api_crawler").AtName("api_crawler_config").AtName("domains").AtAnyListIndex().AtName("simple_login").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("single_lb_app").AtName("enable_discovery").AtName("api_discovery_from_code_scan").AtName("code_base_integrations").AtAnyListIndex(), "api_repos_choice", "all_repos", "selected_repos"),
+		validators.RequiredOneOfGroup(path.MatchRoot("slow_ddos_mitigation"), "request_timeout_choice", "disable_request_timeout", "request_timeout"),
+		validators.OneOfGroup(path.MatchRoot("trusted_clients").AtAnyListIndex(), "action_choice", "bot_skip_processing", "skip_processing", "waf_skip_processing"),
+		validators.RequiredOneOfGroup(path.MatchRoot("trusted_clients").AtAnyListIndex(), "client_source_choice", "as_number", "http_header", "ip_prefix", "ipv6_prefix", "user_identifier"),
+		validators.OneOfGroup(path.MatchRoot("trusted_clients").AtAnyListIndex().AtName("http_header").AtName("headers").AtAnyListIndex(), "value_match", "exact", "presence", "regex"),
+		validators.OneOfGroup(path.MatchRoot("waf_exclusion"), "waf_exclusion_choice", "waf_exclusion_inline_rules", "waf_exclusion_policy"),
+		validators.OneOfGroup(path.MatchRoot("waf_exclusion").AtName("waf_exclusion_inline_rules").AtName("rules").AtAnyListIndex(), "domain_choice", "any_domain", "exact_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("waf_exclusion").AtName("waf_exclusion_inline_rules").AtName("rules").AtAnyListIndex(), "path_choice", "any_path", "path_prefix", "path_regex"),
+		validators.OneOfGroup(path.MatchRoot("waf_exclusion").AtName("waf_exclusion_inline_rules").AtName("rules").AtAnyListIndex(), "waf_advanced_configuration", "app_firewall_detection_control", "waf_skip_processing"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *HTTPLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -20017,7 +23888,10 @@ func (r *HTTPLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "http_loadbalancer", "", req.Config, &resp.Plan)...)
 		var plan HTTPLoadBalancerResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -20035,7 +23909,8 @@ func (r *HTTPLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.
 
 func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data HTTPLoadBalancerResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -20074,6 +23949,7 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -20083,6 +23959,7 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.ActiveServicePolicies != nil {
@@ -20114,6 +23991,19 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 				listItemMap := make(map[string]interface{})
 				if listItem.AdvertiseOnPublic != nil {
 					advertise_on_publicDeepMap := make(map[string]interface{})
+					if listItem.AdvertiseOnPublic.PublicIP != nil {
+						public_ipLevel4Map := make(map[string]interface{})
+						if !listItem.AdvertiseOnPublic.PublicIP.Name.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Name.IsUnknown() {
+							public_ipLevel4Map["name"] = listItem.AdvertiseOnPublic.PublicIP.Name.ValueString()
+						}
+						if !listItem.AdvertiseOnPublic.PublicIP.Namespace.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Namespace.IsUnknown() {
+							public_ipLevel4Map["namespace"] = listItem.AdvertiseOnPublic.PublicIP.Namespace.ValueString()
+						}
+						if !listItem.AdvertiseOnPublic.PublicIP.Tenant.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Tenant.IsUnknown() {
+							public_ipLevel4Map["tenant"] = listItem.AdvertiseOnPublic.PublicIP.Tenant.ValueString()
+						}
+						advertise_on_publicDeepMap["public_ip"] = public_ipLevel4Map
+					}
 					listItemMap["advertise_on_public"] = advertise_on_publicDeepMap
 				}
 				if !listItem.Port.IsNull() && !listItem.Port.IsUnknown() {
@@ -20130,6 +24020,19 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if !listItem.Site.Network.IsNull() && !listItem.Site.Network.IsUnknown() {
 						siteDeepMap["network"] = listItem.Site.Network.ValueString()
 					}
+					if listItem.Site.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Site.Site.Name.IsNull() && !listItem.Site.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Site.Site.Name.ValueString()
+						}
+						if !listItem.Site.Site.Namespace.IsNull() && !listItem.Site.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Site.Site.Namespace.ValueString()
+						}
+						if !listItem.Site.Site.Tenant.IsNull() && !listItem.Site.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Site.Site.Tenant.ValueString()
+						}
+						siteDeepMap["site"] = siteLevel4Map
+					}
 					listItemMap["site"] = siteDeepMap
 				}
 				if listItem.UseDefaultPort != nil {
@@ -20149,6 +24052,19 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if !listItem.VirtualNetwork.SpecificVIP.IsNull() && !listItem.VirtualNetwork.SpecificVIP.IsUnknown() {
 						virtual_networkDeepMap["specific_vip"] = listItem.VirtualNetwork.SpecificVIP.ValueString()
 					}
+					if listItem.VirtualNetwork.VirtualNetwork != nil {
+						virtual_networkLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualNetwork.VirtualNetwork.Name.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Name.IsUnknown() {
+							virtual_networkLevel4Map["name"] = listItem.VirtualNetwork.VirtualNetwork.Name.ValueString()
+						}
+						if !listItem.VirtualNetwork.VirtualNetwork.Namespace.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Namespace.IsUnknown() {
+							virtual_networkLevel4Map["namespace"] = listItem.VirtualNetwork.VirtualNetwork.Namespace.ValueString()
+						}
+						if !listItem.VirtualNetwork.VirtualNetwork.Tenant.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Tenant.IsUnknown() {
+							virtual_networkLevel4Map["tenant"] = listItem.VirtualNetwork.VirtualNetwork.Tenant.ValueString()
+						}
+						virtual_networkDeepMap["virtual_network"] = virtual_networkLevel4Map
+					}
 					listItemMap["virtual_network"] = virtual_networkDeepMap
 				}
 				if listItem.VirtualSite != nil {
@@ -20156,6 +24072,19 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if !listItem.VirtualSite.Network.IsNull() && !listItem.VirtualSite.Network.IsUnknown() {
 						virtual_siteDeepMap["network"] = listItem.VirtualSite.Network.ValueString()
 					}
+					if listItem.VirtualSite.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSite.VirtualSite.Name.IsNull() && !listItem.VirtualSite.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSite.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Namespace.IsNull() && !listItem.VirtualSite.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSite.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Tenant.IsNull() && !listItem.VirtualSite.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSite.VirtualSite.Tenant.ValueString()
+						}
+						virtual_siteDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site"] = virtual_siteDeepMap
 				}
 				if listItem.VirtualSiteWithVIP != nil {
@@ -20166,10 +24095,49 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if !listItem.VirtualSiteWithVIP.Network.IsNull() && !listItem.VirtualSiteWithVIP.Network.IsUnknown() {
 						virtual_site_with_vipDeepMap["network"] = listItem.VirtualSiteWithVIP.Network.ValueString()
 					}
+					if listItem.VirtualSiteWithVIP.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Name.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSiteWithVIP.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Namespace.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSiteWithVIP.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Tenant.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSiteWithVIP.VirtualSite.Tenant.ValueString()
+						}
+						virtual_site_with_vipDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site_with_vip"] = virtual_site_with_vipDeepMap
 				}
 				if listItem.Vk8sService != nil {
 					vk8s_serviceDeepMap := make(map[string]interface{})
+					if listItem.Vk8sService.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Vk8sService.Site.Name.IsNull() && !listItem.Vk8sService.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Vk8sService.Site.Name.ValueString()
+						}
+						if !listItem.Vk8sService.Site.Namespace.IsNull() && !listItem.Vk8sService.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Vk8sService.Site.Namespace.ValueString()
+						}
+						if !listItem.Vk8sService.Site.Tenant.IsNull() && !listItem.Vk8sService.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Vk8sService.Site.Tenant.ValueString()
+						}
+						vk8s_serviceDeepMap["site"] = siteLevel4Map
+					}
+					if listItem.Vk8sService.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.Vk8sService.VirtualSite.Name.IsNull() && !listItem.Vk8sService.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.Vk8sService.VirtualSite.Name.ValueString()
+						}
+						if !listItem.Vk8sService.VirtualSite.Namespace.IsNull() && !listItem.Vk8sService.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.Vk8sService.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.Vk8sService.VirtualSite.Tenant.IsNull() && !listItem.Vk8sService.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.Vk8sService.VirtualSite.Tenant.ValueString()
+						}
+						vk8s_serviceDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["vk8s_service"] = vk8s_serviceDeepMap
 				}
 				advertise_whereList = append(advertise_whereList, listItemMap)
@@ -20236,6 +24204,89 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if listItem.ClientMatcher.AnyIP != nil {
 						client_matcherDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.ClientMatcher.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.AsnList.AsNumbers.IsNull() && !listItem.ClientMatcher.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.ClientMatcher.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						client_matcherDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.ClientMatcher.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						client_matcherDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.ClientSelector.Expressions.IsNull() && !listItem.ClientMatcher.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.ClientMatcher.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						client_matcherDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.ClientMatcher.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsNull() && !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.ClientMatcher.IPMatcher.InvertMatcher.ValueBool()
+						}
+						client_matcherDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsNull() && !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.ClientMatcher.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsNull() && !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.ClientMatcher.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.ClientMatcher.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.ClientMatcher.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						client_matcherDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
 					listItemMap["client_matcher"] = client_matcherDeepMap
 				}
 				if listItem.Metadata != nil {
@@ -20290,6 +24341,89 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if listItem.ClientMatcher.AnyIP != nil {
 						client_matcherDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.ClientMatcher.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.AsnList.AsNumbers.IsNull() && !listItem.ClientMatcher.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.ClientMatcher.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						client_matcherDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.ClientMatcher.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						client_matcherDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.ClientSelector.Expressions.IsNull() && !listItem.ClientMatcher.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.ClientMatcher.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						client_matcherDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.ClientMatcher.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsNull() && !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.ClientMatcher.IPMatcher.InvertMatcher.ValueBool()
+						}
+						client_matcherDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsNull() && !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.ClientMatcher.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsNull() && !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.ClientMatcher.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.ClientMatcher.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.ClientMatcher.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						client_matcherDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
 					listItemMap["client_matcher"] = client_matcherDeepMap
 				}
 				if listItem.Metadata != nil {
@@ -20342,10 +24476,106 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if listItem.ClientMatcher.AnyIP != nil {
 						client_matcherDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.ClientMatcher.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.AsnList.AsNumbers.IsNull() && !listItem.ClientMatcher.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.ClientMatcher.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						client_matcherDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.ClientMatcher.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						client_matcherDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.ClientSelector.Expressions.IsNull() && !listItem.ClientMatcher.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.ClientMatcher.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						client_matcherDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.ClientMatcher.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsNull() && !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.ClientMatcher.IPMatcher.InvertMatcher.ValueBool()
+						}
+						client_matcherDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsNull() && !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.ClientMatcher.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsNull() && !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.ClientMatcher.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.ClientMatcher.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.ClientMatcher.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						client_matcherDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
 					listItemMap["client_matcher"] = client_matcherDeepMap
 				}
 				if listItem.InlineRateLimiter != nil {
 					inline_rate_limiterDeepMap := make(map[string]interface{})
+					if listItem.InlineRateLimiter.RefUserID != nil {
+						ref_user_idLevel4Map := make(map[string]interface{})
+						if !listItem.InlineRateLimiter.RefUserID.Name.IsNull() && !listItem.InlineRateLimiter.RefUserID.Name.IsUnknown() {
+							ref_user_idLevel4Map["name"] = listItem.InlineRateLimiter.RefUserID.Name.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Namespace.IsNull() && !listItem.InlineRateLimiter.RefUserID.Namespace.IsUnknown() {
+							ref_user_idLevel4Map["namespace"] = listItem.InlineRateLimiter.RefUserID.Namespace.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Tenant.IsNull() && !listItem.InlineRateLimiter.RefUserID.Tenant.IsUnknown() {
+							ref_user_idLevel4Map["tenant"] = listItem.InlineRateLimiter.RefUserID.Tenant.ValueString()
+						}
+						inline_rate_limiterDeepMap["ref_user_id"] = ref_user_idLevel4Map
+					}
 					if !listItem.InlineRateLimiter.Threshold.IsNull() && !listItem.InlineRateLimiter.Threshold.IsUnknown() {
 						inline_rate_limiterDeepMap["threshold"] = listItem.InlineRateLimiter.Threshold.ValueInt64()
 					}
@@ -20417,10 +24647,106 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if listItem.ClientMatcher.AnyIP != nil {
 						client_matcherDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.ClientMatcher.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.AsnList.AsNumbers.IsNull() && !listItem.ClientMatcher.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.ClientMatcher.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						client_matcherDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.ClientMatcher.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						client_matcherDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.ClientSelector.Expressions.IsNull() && !listItem.ClientMatcher.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.ClientMatcher.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						client_matcherDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.ClientMatcher.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsNull() && !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.ClientMatcher.IPMatcher.InvertMatcher.ValueBool()
+						}
+						client_matcherDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsNull() && !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.ClientMatcher.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsNull() && !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.ClientMatcher.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.ClientMatcher.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.ClientMatcher.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						client_matcherDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
 					listItemMap["client_matcher"] = client_matcherDeepMap
 				}
 				if listItem.InlineRateLimiter != nil {
 					inline_rate_limiterDeepMap := make(map[string]interface{})
+					if listItem.InlineRateLimiter.RefUserID != nil {
+						ref_user_idLevel4Map := make(map[string]interface{})
+						if !listItem.InlineRateLimiter.RefUserID.Name.IsNull() && !listItem.InlineRateLimiter.RefUserID.Name.IsUnknown() {
+							ref_user_idLevel4Map["name"] = listItem.InlineRateLimiter.RefUserID.Name.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Namespace.IsNull() && !listItem.InlineRateLimiter.RefUserID.Namespace.IsUnknown() {
+							ref_user_idLevel4Map["namespace"] = listItem.InlineRateLimiter.RefUserID.Namespace.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Tenant.IsNull() && !listItem.InlineRateLimiter.RefUserID.Tenant.IsUnknown() {
+							ref_user_idLevel4Map["tenant"] = listItem.InlineRateLimiter.RefUserID.Tenant.ValueString()
+						}
+						inline_rate_limiterDeepMap["ref_user_id"] = ref_user_idLevel4Map
+					}
 					if !listItem.InlineRateLimiter.Threshold.IsNull() && !listItem.InlineRateLimiter.Threshold.IsUnknown() {
 						inline_rate_limiterDeepMap["threshold"] = listItem.InlineRateLimiter.Threshold.ValueInt64()
 					}
@@ -20475,10 +24801,130 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.APISpecification.ValidationAllSpecEndpoints != nil {
 			validation_all_spec_endpointsNestedMap := make(map[string]interface{})
+			if data.APISpecification.ValidationAllSpecEndpoints.FallThroughMode != nil {
+				fall_through_modeLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationAllSpecEndpoints.FallThroughMode.FallThroughModeAllow != nil {
+					fall_through_modeLevel3Map["fall_through_mode_allow"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.FallThroughMode.FallThroughModeCustom != nil {
+					fall_through_mode_customLevel4Map := make(map[string]interface{})
+					fall_through_modeLevel3Map["fall_through_mode_custom"] = fall_through_mode_customLevel4Map
+				}
+				validation_all_spec_endpointsNestedMap["fall_through_mode"] = fall_through_modeLevel3Map
+			}
+			if data.APISpecification.ValidationAllSpecEndpoints.Settings != nil {
+				settingsLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.OversizedBodyFailValidation != nil {
+					settingsLevel3Map["oversized_body_fail_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.OversizedBodySkipValidation != nil {
+					settingsLevel3Map["oversized_body_skip_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom != nil {
+					property_validation_settings_customLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom.Queryparameters != nil {
+						query_parametersLevel5Map := make(map[string]interface{})
+						if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom.Queryparameters.AllowAdditionalParameters != nil {
+							query_parametersLevel5Map["allow_additional_parameters"] = map[string]interface{}{}
+						}
+						if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom.Queryparameters.DisallowAdditionalParameters != nil {
+							query_parametersLevel5Map["disallow_additional_parameters"] = map[string]interface{}{}
+						}
+						property_validation_settings_customLevel4Map["queryParameters"] = query_parametersLevel5Map
+					}
+					settingsLevel3Map["property_validation_settings_custom"] = property_validation_settings_customLevel4Map
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsDefault != nil {
+					settingsLevel3Map["property_validation_settings_default"] = map[string]interface{}{}
+				}
+				validation_all_spec_endpointsNestedMap["settings"] = settingsLevel3Map
+			}
+			if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode != nil {
+				validation_modeLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive != nil {
+					response_validation_mode_activeLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.EnforcementBlock != nil {
+						response_validation_mode_activeLevel4Map["enforcement_block"] = map[string]interface{}{}
+					}
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.EnforcementReport != nil {
+						response_validation_mode_activeLevel4Map["enforcement_report"] = map[string]interface{}{}
+					}
+					if !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.ResponseValidationProperties.IsNull() && !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.ResponseValidationProperties.IsUnknown() {
+						var response_validation_propertiesLevel4Items []string
+						diags := data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.ResponseValidationProperties.ElementsAs(ctx, &response_validation_propertiesLevel4Items, false)
+						if !diags.HasError() {
+							response_validation_mode_activeLevel4Map["response_validation_properties"] = response_validation_propertiesLevel4Items
+						}
+					}
+					validation_modeLevel3Map["response_validation_mode_active"] = response_validation_mode_activeLevel4Map
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.SkipResponseValidation != nil {
+					validation_modeLevel3Map["skip_response_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.SkipValidation != nil {
+					validation_modeLevel3Map["skip_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive != nil {
+					validation_mode_activeLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.EnforcementBlock != nil {
+						validation_mode_activeLevel4Map["enforcement_block"] = map[string]interface{}{}
+					}
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.EnforcementReport != nil {
+						validation_mode_activeLevel4Map["enforcement_report"] = map[string]interface{}{}
+					}
+					if !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.RequestValidationProperties.IsNull() && !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.RequestValidationProperties.IsUnknown() {
+						var request_validation_propertiesLevel4Items []string
+						diags := data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.RequestValidationProperties.ElementsAs(ctx, &request_validation_propertiesLevel4Items, false)
+						if !diags.HasError() {
+							validation_mode_activeLevel4Map["request_validation_properties"] = request_validation_propertiesLevel4Items
+						}
+					}
+					validation_modeLevel3Map["validation_mode_active"] = validation_mode_activeLevel4Map
+				}
+				validation_all_spec_endpointsNestedMap["validation_mode"] = validation_modeLevel3Map
+			}
 			api_specificationMap["validation_all_spec_endpoints"] = validation_all_spec_endpointsNestedMap
 		}
 		if data.APISpecification.ValidationCustomList != nil {
 			validation_custom_listNestedMap := make(map[string]interface{})
+			if data.APISpecification.ValidationCustomList.FallThroughMode != nil {
+				fall_through_modeLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationCustomList.FallThroughMode.FallThroughModeAllow != nil {
+					fall_through_modeLevel3Map["fall_through_mode_allow"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationCustomList.FallThroughMode.FallThroughModeCustom != nil {
+					fall_through_mode_customLevel4Map := make(map[string]interface{})
+					fall_through_modeLevel3Map["fall_through_mode_custom"] = fall_through_mode_customLevel4Map
+				}
+				validation_custom_listNestedMap["fall_through_mode"] = fall_through_modeLevel3Map
+			}
+			if data.APISpecification.ValidationCustomList.Settings != nil {
+				settingsLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationCustomList.Settings.OversizedBodyFailValidation != nil {
+					settingsLevel3Map["oversized_body_fail_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationCustomList.Settings.OversizedBodySkipValidation != nil {
+					settingsLevel3Map["oversized_body_skip_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom != nil {
+					property_validation_settings_customLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom.Queryparameters != nil {
+						query_parametersLevel5Map := make(map[string]interface{})
+						if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom.Queryparameters.AllowAdditionalParameters != nil {
+							query_parametersLevel5Map["allow_additional_parameters"] = map[string]interface{}{}
+						}
+						if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom.Queryparameters.DisallowAdditionalParameters != nil {
+							query_parametersLevel5Map["disallow_additional_parameters"] = map[string]interface{}{}
+						}
+						property_validation_settings_customLevel4Map["queryParameters"] = query_parametersLevel5Map
+					}
+					settingsLevel3Map["property_validation_settings_custom"] = property_validation_settings_customLevel4Map
+				}
+				if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsDefault != nil {
+					settingsLevel3Map["property_validation_settings_default"] = map[string]interface{}{}
+				}
+				validation_custom_listNestedMap["settings"] = settingsLevel3Map
+			}
 			api_specificationMap["validation_custom_list"] = validation_custom_listNestedMap
 		}
 		if data.APISpecification.ValidationDisabled != nil {
@@ -20613,12 +25059,44 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.BotDefense.Policy != nil {
 			policyNestedMap := make(map[string]interface{})
+			if data.BotDefense.Policy.DisableJsInsert != nil {
+				policyNestedMap["disable_js_insert"] = map[string]interface{}{}
+			}
+			if data.BotDefense.Policy.DisableMobileSdk != nil {
+				policyNestedMap["disable_mobile_sdk"] = map[string]interface{}{}
+			}
 			if !data.BotDefense.Policy.JavascriptMode.IsNull() && !data.BotDefense.Policy.JavascriptMode.IsUnknown() {
 				policyNestedMap["javascript_mode"] = data.BotDefense.Policy.JavascriptMode.ValueString()
 			}
 			if !data.BotDefense.Policy.JsDownloadPath.IsNull() && !data.BotDefense.Policy.JsDownloadPath.IsUnknown() {
 				policyNestedMap["js_download_path"] = data.BotDefense.Policy.JsDownloadPath.ValueString()
 			}
+			if data.BotDefense.Policy.JsInsertAllPages != nil {
+				js_insert_all_pagesLevel3Map := make(map[string]interface{})
+				if !data.BotDefense.Policy.JsInsertAllPages.JavascriptLocation.IsNull() && !data.BotDefense.Policy.JsInsertAllPages.JavascriptLocation.IsUnknown() {
+					js_insert_all_pagesLevel3Map["javascript_location"] = data.BotDefense.Policy.JsInsertAllPages.JavascriptLocation.ValueString()
+				}
+				policyNestedMap["js_insert_all_pages"] = js_insert_all_pagesLevel3Map
+			}
+			if data.BotDefense.Policy.JsInsertAllPagesExcept != nil {
+				js_insert_all_pages_exceptLevel3Map := make(map[string]interface{})
+				if !data.BotDefense.Policy.JsInsertAllPagesExcept.JavascriptLocation.IsNull() && !data.BotDefense.Policy.JsInsertAllPagesExcept.JavascriptLocation.IsUnknown() {
+					js_insert_all_pages_exceptLevel3Map["javascript_location"] = data.BotDefense.Policy.JsInsertAllPagesExcept.JavascriptLocation.ValueString()
+				}
+				policyNestedMap["js_insert_all_pages_except"] = js_insert_all_pages_exceptLevel3Map
+			}
+			if data.BotDefense.Policy.JsInsertionRules != nil {
+				js_insertion_rulesLevel3Map := make(map[string]interface{})
+				policyNestedMap["js_insertion_rules"] = js_insertion_rulesLevel3Map
+			}
+			if data.BotDefense.Policy.MobileSdkConfig != nil {
+				mobile_sdk_configLevel3Map := make(map[string]interface{})
+				if data.BotDefense.Policy.MobileSdkConfig.MobileIdentifier != nil {
+					mobile_identifierLevel4Map := make(map[string]interface{})
+					mobile_sdk_configLevel3Map["mobile_identifier"] = mobile_identifierLevel4Map
+				}
+				policyNestedMap["mobile_sdk_config"] = mobile_sdk_configLevel3Map
+			}
 			bot_defenseMap["policy"] = policyNestedMap
 		}
 		if !data.BotDefense.RegionalEndpoint.IsNull() && !data.BotDefense.RegionalEndpoint.IsUnknown() {
@@ -20670,6 +25148,10 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.BotDefenseAdvanced.MobileSdkConfig != nil {
 			mobile_sdk_configNestedMap := make(map[string]interface{})
+			if data.BotDefenseAdvanced.MobileSdkConfig.MobileIdentifier != nil {
+				mobile_identifierLevel3Map := make(map[string]interface{})
+				mobile_sdk_configNestedMap["mobile_identifier"] = mobile_identifierLevel3Map
+			}
 			bot_defense_advancedMap["mobile_sdk_config"] = mobile_sdk_configNestedMap
 		}
 		if data.BotDefenseAdvanced.Web != nil {
@@ -20695,6 +25177,9 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.CachingPolicy.DefaultCacheAction != nil {
 			default_cache_actionNestedMap := make(map[string]interface{})
+			if data.CachingPolicy.DefaultCacheAction.CacheDisabled != nil {
+				default_cache_actionNestedMap["cache_disabled"] = map[string]interface{}{}
+			}
 			if !data.CachingPolicy.DefaultCacheAction.CacheTTLDefault.IsNull() && !data.CachingPolicy.DefaultCacheAction.CacheTTLDefault.IsUnknown() {
 				default_cache_actionNestedMap["cache_ttl_default"] = data.CachingPolicy.DefaultCacheAction.CacheTTLDefault.ValueString()
 			}
@@ -20719,6 +25204,20 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		client_side_defenseMap := make(map[string]interface{})
 		if data.ClientSideDefense.Policy != nil {
 			policyNestedMap := make(map[string]interface{})
+			if data.ClientSideDefense.Policy.DisableJsInsert != nil {
+				policyNestedMap["disable_js_insert"] = map[string]interface{}{}
+			}
+			if data.ClientSideDefense.Policy.JsInsertAllPages != nil {
+				policyNestedMap["js_insert_all_pages"] = map[string]interface{}{}
+			}
+			if data.ClientSideDefense.Policy.JsInsertAllPagesExcept != nil {
+				js_insert_all_pages_exceptLevel3Map := make(map[string]interface{})
+				policyNestedMap["js_insert_all_pages_except"] = js_insert_all_pages_exceptLevel3Map
+			}
+			if data.ClientSideDefense.Policy.JsInsertionRules != nil {
+				js_insertion_rulesLevel3Map := make(map[string]interface{})
+				policyNestedMap["js_insertion_rules"] = js_insertion_rulesLevel3Map
+			}
 			client_side_defenseMap["policy"] = policyNestedMap
 		}
 		createReq.Spec["client_side_defense"] = client_side_defenseMap
@@ -20959,15 +25458,130 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		default_poolMap := make(map[string]interface{})
 		if data.DefaultPool.AdvancedOptions != nil {
 			advanced_optionsNestedMap := make(map[string]interface{})
+			if data.DefaultPool.AdvancedOptions.AutoHTTPConfig != nil {
+				advanced_optionsNestedMap["auto_http_config"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.CircuitBreaker != nil {
+				circuit_breakerLevel3Map := make(map[string]interface{})
+				if !data.DefaultPool.AdvancedOptions.CircuitBreaker.ConnectionLimit.IsNull() && !data.DefaultPool.AdvancedOptions.CircuitBreaker.ConnectionLimit.IsUnknown() {
+					circuit_breakerLevel3Map["connection_limit"] = data.DefaultPool.AdvancedOptions.CircuitBreaker.ConnectionLimit.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.CircuitBreaker.MaxRequests.IsNull() && !data.DefaultPool.AdvancedOptions.CircuitBreaker.MaxRequests.IsUnknown() {
+					circuit_breakerLevel3Map["max_requests"] = data.DefaultPool.AdvancedOptions.CircuitBreaker.MaxRequests.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.CircuitBreaker.PendingRequests.IsNull() && !data.DefaultPool.AdvancedOptions.CircuitBreaker.PendingRequests.IsUnknown() {
+					circuit_breakerLevel3Map["pending_requests"] = data.DefaultPool.AdvancedOptions.CircuitBreaker.PendingRequests.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.CircuitBreaker.Priority.IsNull() && !data.DefaultPool.AdvancedOptions.CircuitBreaker.Priority.IsUnknown() {
+					circuit_breakerLevel3Map["priority"] = data.DefaultPool.AdvancedOptions.CircuitBreaker.Priority.ValueString()
+				}
+				if !data.DefaultPool.AdvancedOptions.CircuitBreaker.Retries.IsNull() && !data.DefaultPool.AdvancedOptions.CircuitBreaker.Retries.IsUnknown() {
+					circuit_breakerLevel3Map["retries"] = data.DefaultPool.AdvancedOptions.CircuitBreaker.Retries.ValueInt64()
+				}
+				advanced_optionsNestedMap["circuit_breaker"] = circuit_breakerLevel3Map
+			}
 			if !data.DefaultPool.AdvancedOptions.ConnectionTimeout.IsNull() && !data.DefaultPool.AdvancedOptions.ConnectionTimeout.IsUnknown() {
 				advanced_optionsNestedMap["connection_timeout"] = data.DefaultPool.AdvancedOptions.ConnectionTimeout.ValueInt64()
 			}
+			if data.DefaultPool.AdvancedOptions.DefaultCircuitBreaker != nil {
+				advanced_optionsNestedMap["default_circuit_breaker"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.DisableCircuitBreaker != nil {
+				advanced_optionsNestedMap["disable_circuit_breaker"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.DisableLBSourceIPPersistance != nil {
+				advanced_optionsNestedMap["disable_lb_source_ip_persistance"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.DisableOutlierDetection != nil {
+				advanced_optionsNestedMap["disable_outlier_detection"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.DisableProxyProtocol != nil {
+				advanced_optionsNestedMap["disable_proxy_protocol"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.DisableSubsets != nil {
+				advanced_optionsNestedMap["disable_subsets"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.EnableLBSourceIPPersistance != nil {
+				advanced_optionsNestedMap["enable_lb_source_ip_persistance"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.EnableSubsets != nil {
+				enable_subsetsLevel3Map := make(map[string]interface{})
+				if data.DefaultPool.AdvancedOptions.EnableSubsets.AnyEndpoint != nil {
+					enable_subsetsLevel3Map["any_endpoint"] = map[string]interface{}{}
+				}
+				if data.DefaultPool.AdvancedOptions.EnableSubsets.DefaultSubset != nil {
+					default_subsetLevel4Map := make(map[string]interface{})
+					if data.DefaultPool.AdvancedOptions.EnableSubsets.DefaultSubset.DefaultSubset != nil {
+						default_subsetLevel4Map["default_subset"] = map[string]interface{}{}
+					}
+					enable_subsetsLevel3Map["default_subset"] = default_subsetLevel4Map
+				}
+				if data.DefaultPool.AdvancedOptions.EnableSubsets.FailRequest != nil {
+					enable_subsetsLevel3Map["fail_request"] = map[string]interface{}{}
+				}
+				advanced_optionsNestedMap["enable_subsets"] = enable_subsetsLevel3Map
+			}
+			if data.DefaultPool.AdvancedOptions.Http1Config != nil {
+				http1_configLevel3Map := make(map[string]interface{})
+				if data.DefaultPool.AdvancedOptions.Http1Config.HeaderTransformation != nil {
+					header_transformationLevel4Map := make(map[string]interface{})
+					if data.DefaultPool.AdvancedOptions.Http1Config.HeaderTransformation.DefaultHeaderTransformation != nil {
+						header_transformationLevel4Map["default_header_transformation"] = map[string]interface{}{}
+					}
+					if data.DefaultPool.AdvancedOptions.Http1Config.HeaderTransformation.LegacyHeaderTransformation != nil {
+						header_transformationLevel4Map["legacy_header_transformation"] = map[string]interface{}{}
+					}
+					if data.DefaultPool.AdvancedOptions.Http1Config.HeaderTransformation.PreserveCaseHeaderTransformation != nil {
+						header_transformationLevel4Map["preserve_case_header_transformation"] = map[string]interface{}{}
+					}
+					if data.DefaultPool.AdvancedOptions.Http1Config.HeaderTransformation.ProperCaseHeaderTransformation != nil {
+						header_transformationLevel4Map["proper_case_header_transformation"] = map[string]interface{}{}
+					}
+					http1_configLevel3Map["header_transformation"] = header_transformationLevel4Map
+				}
+				advanced_optionsNestedMap["http1_config"] = http1_configLevel3Map
+			}
+			if data.DefaultPool.AdvancedOptions.Http2Options != nil {
+				http2_optionsLevel3Map := make(map[string]interface{})
+				if !data.DefaultPool.AdvancedOptions.Http2Options.Enabled.IsNull() && !data.DefaultPool.AdvancedOptions.Http2Options.Enabled.IsUnknown() {
+					http2_optionsLevel3Map["enabled"] = data.DefaultPool.AdvancedOptions.Http2Options.Enabled.ValueBool()
+				}
+				advanced_optionsNestedMap["http2_options"] = http2_optionsLevel3Map
+			}
 			if !data.DefaultPool.AdvancedOptions.HTTPIdleTimeout.IsNull() && !data.DefaultPool.AdvancedOptions.HTTPIdleTimeout.IsUnknown() {
 				advanced_optionsNestedMap["http_idle_timeout"] = data.DefaultPool.AdvancedOptions.HTTPIdleTimeout.ValueInt64()
 			}
+			if data.DefaultPool.AdvancedOptions.NoPanicThreshold != nil {
+				advanced_optionsNestedMap["no_panic_threshold"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.OutlierDetection != nil {
+				outlier_detectionLevel3Map := make(map[string]interface{})
+				if !data.DefaultPool.AdvancedOptions.OutlierDetection.BaseEjectionTime.IsNull() && !data.DefaultPool.AdvancedOptions.OutlierDetection.BaseEjectionTime.IsUnknown() {
+					outlier_detectionLevel3Map["base_ejection_time"] = data.DefaultPool.AdvancedOptions.OutlierDetection.BaseEjectionTime.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.OutlierDetection.Consecutive5xx.IsNull() && !data.DefaultPool.AdvancedOptions.OutlierDetection.Consecutive5xx.IsUnknown() {
+					outlier_detectionLevel3Map["consecutive_5xx"] = data.DefaultPool.AdvancedOptions.OutlierDetection.Consecutive5xx.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.OutlierDetection.ConsecutiveGatewayFailure.IsNull() && !data.DefaultPool.AdvancedOptions.OutlierDetection.ConsecutiveGatewayFailure.IsUnknown() {
+					outlier_detectionLevel3Map["consecutive_gateway_failure"] = data.DefaultPool.AdvancedOptions.OutlierDetection.ConsecutiveGatewayFailure.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.OutlierDetection.Interval.IsNull() && !data.DefaultPool.AdvancedOptions.OutlierDetection.Interval.IsUnknown() {
+					outlier_detectionLevel3Map["interval"] = data.DefaultPool.AdvancedOptions.OutlierDetection.Interval.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.OutlierDetection.MaxEjectionPercent.IsNull() && !data.DefaultPool.AdvancedOptions.OutlierDetection.MaxEjectionPercent.IsUnknown() {
+					outlier_detectionLevel3Map["max_ejection_percent"] = data.DefaultPool.AdvancedOptions.OutlierDetection.MaxEjectionPercent.ValueInt64()
+				}
+				advanced_optionsNestedMap["outlier_detection"] = outlier_detectionLevel3Map
+			}
 			if !data.DefaultPool.AdvancedOptions.PanicThreshold.IsNull() && !data.DefaultPool.AdvancedOptions.PanicThreshold.IsUnknown() {
 				advanced_optionsNestedMap["panic_threshold"] = data.DefaultPool.AdvancedOptions.PanicThreshold.ValueInt64()
 			}
+			if data.DefaultPool.AdvancedOptions.ProxyProtocolV1 != nil {
+				advanced_optionsNestedMap["proxy_protocol_v1"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.ProxyProtocolV2 != nil {
+				advanced_optionsNestedMap["proxy_protocol_v2"] = map[string]interface{}{}
+			}
 			default_poolMap["advanced_options"] = advanced_optionsNestedMap
 		}
 		if data.DefaultPool.AutomaticPort != nil {
@@ -21027,10 +25641,71 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if !listItem.ConsulService.ServiceName.IsNull() && !listItem.ConsulService.ServiceName.IsUnknown() {
 						consul_serviceDeepMap["service_name"] = listItem.ConsulService.ServiceName.ValueString()
 					}
+					if listItem.ConsulService.SiteLocator != nil {
+						site_locatorLevel4Map := make(map[string]interface{})
+						if listItem.ConsulService.SiteLocator.Site != nil {
+							siteLevel5Map := make(map[string]interface{})
+							if !listItem.ConsulService.SiteLocator.Site.Name.IsNull() && !listItem.ConsulService.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel5Map["name"] = listItem.ConsulService.SiteLocator.Site.Name.ValueString()
+							}
+							if !listItem.ConsulService.SiteLocator.Site.Namespace.IsNull() && !listItem.ConsulService.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel5Map["namespace"] = listItem.ConsulService.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !listItem.ConsulService.SiteLocator.Site.Tenant.IsNull() && !listItem.ConsulService.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel5Map["tenant"] = listItem.ConsulService.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["site"] = siteLevel5Map
+						}
+						if listItem.ConsulService.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel5Map := make(map[string]interface{})
+							if !listItem.ConsulService.SiteLocator.VirtualSite.Name.IsNull() && !listItem.ConsulService.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel5Map["name"] = listItem.ConsulService.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !listItem.ConsulService.SiteLocator.VirtualSite.Namespace.IsNull() && !listItem.ConsulService.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel5Map["namespace"] = listItem.ConsulService.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !listItem.ConsulService.SiteLocator.VirtualSite.Tenant.IsNull() && !listItem.ConsulService.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel5Map["tenant"] = listItem.ConsulService.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["virtual_site"] = virtual_siteLevel5Map
+						}
+						consul_serviceDeepMap["site_locator"] = site_locatorLevel4Map
+					}
+					if listItem.ConsulService.SnatPool != nil {
+						snat_poolLevel4Map := make(map[string]interface{})
+						if listItem.ConsulService.SnatPool.NoSnatPool != nil {
+							snat_poolLevel4Map["no_snat_pool"] = map[string]interface{}{}
+						}
+						if listItem.ConsulService.SnatPool.SnatPool != nil {
+							snat_poolLevel5Map := make(map[string]interface{})
+							if !listItem.ConsulService.SnatPool.SnatPool.Prefixes.IsNull() && !listItem.ConsulService.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel5Items []string
+								diags := listItem.ConsulService.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel5Items, false)
+								if !diags.HasError() {
+									snat_poolLevel5Map["prefixes"] = prefixesLevel5Items
+								}
+							}
+							snat_poolLevel4Map["snat_pool"] = snat_poolLevel5Map
+						}
+						consul_serviceDeepMap["snat_pool"] = snat_poolLevel4Map
+					}
 					listItemMap["consul_service"] = consul_serviceDeepMap
 				}
 				if listItem.CustomEndpointObject != nil {
 					custom_endpoint_objectDeepMap := make(map[string]interface{})
+					if listItem.CustomEndpointObject.Endpoint != nil {
+						endpointLevel4Map := make(map[string]interface{})
+						if !listItem.CustomEndpointObject.Endpoint.Name.IsNull() && !listItem.CustomEndpointObject.Endpoint.Name.IsUnknown() {
+							endpointLevel4Map["name"] = listItem.CustomEndpointObject.Endpoint.Name.ValueString()
+						}
+						if !listItem.CustomEndpointObject.Endpoint.Namespace.IsNull() && !listItem.CustomEndpointObject.Endpoint.Namespace.IsUnknown() {
+							endpointLevel4Map["namespace"] = listItem.CustomEndpointObject.Endpoint.Namespace.ValueString()
+						}
+						if !listItem.CustomEndpointObject.Endpoint.Tenant.IsNull() && !listItem.CustomEndpointObject.Endpoint.Tenant.IsUnknown() {
+							endpointLevel4Map["tenant"] = listItem.CustomEndpointObject.Endpoint.Tenant.ValueString()
+						}
+						custom_endpoint_objectDeepMap["endpoint"] = endpointLevel4Map
+					}
 					listItemMap["custom_endpoint_object"] = custom_endpoint_objectDeepMap
 				}
 				if listItem.K8SService != nil {
@@ -21047,6 +25722,54 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if !listItem.K8SService.ServiceName.IsNull() && !listItem.K8SService.ServiceName.IsUnknown() {
 						k8s_serviceDeepMap["service_name"] = listItem.K8SService.ServiceName.ValueString()
 					}
+					if listItem.K8SService.SiteLocator != nil {
+						site_locatorLevel4Map := make(map[string]interface{})
+						if listItem.K8SService.SiteLocator.Site != nil {
+							siteLevel5Map := make(map[string]interface{})
+							if !listItem.K8SService.SiteLocator.Site.Name.IsNull() && !listItem.K8SService.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel5Map["name"] = listItem.K8SService.SiteLocator.Site.Name.ValueString()
+							}
+							if !listItem.K8SService.SiteLocator.Site.Namespace.IsNull() && !listItem.K8SService.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel5Map["namespace"] = listItem.K8SService.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !listItem.K8SService.SiteLocator.Site.Tenant.IsNull() && !listItem.K8SService.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel5Map["tenant"] = listItem.K8SService.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["site"] = siteLevel5Map
+						}
+						if listItem.K8SService.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel5Map := make(map[string]interface{})
+							if !listItem.K8SService.SiteLocator.VirtualSite.Name.IsNull() && !listItem.K8SService.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel5Map["name"] = listItem.K8SService.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !listItem.K8SService.SiteLocator.VirtualSite.Namespace.IsNull() && !listItem.K8SService.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel5Map["namespace"] = listItem.K8SService.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !listItem.K8SService.SiteLocator.VirtualSite.Tenant.IsNull() && !listItem.K8SService.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel5Map["tenant"] = listItem.K8SService.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["virtual_site"] = virtual_siteLevel5Map
+						}
+						k8s_serviceDeepMap["site_locator"] = site_locatorLevel4Map
+					}
+					if listItem.K8SService.SnatPool != nil {
+						snat_poolLevel4Map := make(map[string]interface{})
+						if listItem.K8SService.SnatPool.NoSnatPool != nil {
+							snat_poolLevel4Map["no_snat_pool"] = map[string]interface{}{}
+						}
+						if listItem.K8SService.SnatPool.SnatPool != nil {
+							snat_poolLevel5Map := make(map[string]interface{})
+							if !listItem.K8SService.SnatPool.SnatPool.Prefixes.IsNull() && !listItem.K8SService.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel5Items []string
+								diags := listItem.K8SService.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel5Items, false)
+								if !diags.HasError() {
+									snat_poolLevel5Map["prefixes"] = prefixesLevel5Items
+								}
+							}
+							snat_poolLevel4Map["snat_pool"] = snat_poolLevel5Map
+						}
+						k8s_serviceDeepMap["snat_pool"] = snat_poolLevel4Map
+					}
 					if listItem.K8SService.Vk8sNetworks != nil {
 						k8s_serviceDeepMap["vk8s_networks"] = map[string]interface{}{}
 					}
@@ -21066,6 +25789,67 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if listItem.PrivateIP.OutsideNetwork != nil {
 						private_ipDeepMap["outside_network"] = map[string]interface{}{}
 					}
+					if listItem.PrivateIP.Segment != nil {
+						segmentLevel4Map := make(map[string]interface{})
+						if !listItem.PrivateIP.Segment.Name.IsNull() && !listItem.PrivateIP.Segment.Name.IsUnknown() {
+							segmentLevel4Map["name"] = listItem.PrivateIP.Segment.Name.ValueString()
+						}
+						if !listItem.PrivateIP.Segment.Namespace.IsNull() && !listItem.PrivateIP.Segment.Namespace.IsUnknown() {
+							segmentLevel4Map["namespace"] = listItem.PrivateIP.Segment.Namespace.ValueString()
+						}
+						if !listItem.PrivateIP.Segment.Tenant.IsNull() && !listItem.PrivateIP.Segment.Tenant.IsUnknown() {
+							segmentLevel4Map["tenant"] = listItem.PrivateIP.Segment.Tenant.ValueString()
+						}
+						private_ipDeepMap["segment"] = segmentLevel4Map
+					}
+					if listItem.PrivateIP.SiteLocator != nil {
+						site_locatorLevel4Map := make(map[string]interface{})
+						if listItem.PrivateIP.SiteLocator.Site != nil {
+							siteLevel5Map := make(map[string]interface{})
+							if !listItem.PrivateIP.SiteLocator.Site.Name.IsNull() && !listItem.PrivateIP.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel5Map["name"] = listItem.PrivateIP.SiteLocator.Site.Name.ValueString()
+							}
+							if !listItem.PrivateIP.SiteLocator.Site.Namespace.IsNull() && !listItem.PrivateIP.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel5Map["namespace"] = listItem.PrivateIP.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !listItem.PrivateIP.SiteLocator.Site.Tenant.IsNull() && !listItem.PrivateIP.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel5Map["tenant"] = listItem.PrivateIP.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["site"] = siteLevel5Map
+						}
+						if listItem.PrivateIP.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel5Map := make(map[string]interface{})
+							if !listItem.PrivateIP.SiteLocator.VirtualSite.Name.IsNull() && !listItem.PrivateIP.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel5Map["name"] = listItem.PrivateIP.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !listItem.PrivateIP.SiteLocator.VirtualSite.Namespace.IsNull() && !listItem.PrivateIP.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel5Map["namespace"] = listItem.PrivateIP.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !listItem.PrivateIP.SiteLocator.VirtualSite.Tenant.IsNull() && !listItem.PrivateIP.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel5Map["tenant"] = listItem.PrivateIP.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["virtual_site"] = virtual_siteLevel5Map
+						}
+						private_ipDeepMap["site_locator"] = site_locatorLevel4Map
+					}
+					if listItem.PrivateIP.SnatPool != nil {
+						snat_poolLevel4Map := make(map[string]interface{})
+						if listItem.PrivateIP.SnatPool.NoSnatPool != nil {
+							snat_poolLevel4Map["no_snat_pool"] = map[string]interface{}{}
+						}
+						if listItem.PrivateIP.SnatPool.SnatPool != nil {
+							snat_poolLevel5Map := make(map[string]interface{})
+							if !listItem.PrivateIP.SnatPool.SnatPool.Prefixes.IsNull() && !listItem.PrivateIP.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel5Items []string
+								diags := listItem.PrivateIP.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel5Items, false)
+								if !diags.HasError() {
+									snat_poolLevel5Map["prefixes"] = prefixesLevel5Items
+								}
+							}
+							snat_poolLevel4Map["snat_pool"] = snat_poolLevel5Map
+						}
+						private_ipDeepMap["snat_pool"] = snat_poolLevel4Map
+					}
 					listItemMap["private_ip"] = private_ipDeepMap
 				}
 				if listItem.PrivateName != nil {
@@ -21082,6 +25866,67 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if !listItem.PrivateName.RefreshInterval.IsNull() && !listItem.PrivateName.RefreshInterval.IsUnknown() {
 						private_nameDeepMap["refresh_interval"] = listItem.PrivateName.RefreshInterval.ValueInt64()
 					}
+					if listItem.PrivateName.Segment != nil {
+						segmentLevel4Map := make(map[string]interface{})
+						if !listItem.PrivateName.Segment.Name.IsNull() && !listItem.PrivateName.Segment.Name.IsUnknown() {
+							segmentLevel4Map["name"] = listItem.PrivateName.Segment.Name.ValueString()
+						}
+						if !listItem.PrivateName.Segment.Namespace.IsNull() && !listItem.PrivateName.Segment.Namespace.IsUnknown() {
+							segmentLevel4Map["namespace"] = listItem.PrivateName.Segment.Namespace.ValueString()
+						}
+						if !listItem.PrivateName.Segment.Tenant.IsNull() && !listItem.PrivateName.Segment.Tenant.IsUnknown() {
+							segmentLevel4Map["tenant"] = listItem.PrivateName.Segment.Tenant.ValueString()
+						}
+						private_nameDeepMap["segment"] = segmentLevel4Map
+					}
+					if listItem.PrivateName.SiteLocator != nil {
+						site_locatorLevel4Map := make(map[string]interface{})
+						if listItem.PrivateName.SiteLocator.Site != nil {
+							siteLevel5Map := make(map[string]interface{})
+							if !listItem.PrivateName.SiteLocator.Site.Name.IsNull() && !listItem.PrivateName.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel5Map["name"] = listItem.PrivateName.SiteLocator.Site.Name.ValueString()
+							}
+							if !listItem.PrivateName.SiteLocator.Site.Namespace.IsNull() && !listItem.PrivateName.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel5Map["namespace"] = listItem.PrivateName.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !listItem.PrivateName.SiteLocator.Site.Tenant.IsNull() && !listItem.PrivateName.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel5Map["tenant"] = listItem.PrivateName.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["site"] = siteLevel5Map
+						}
+						if listItem.PrivateName.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel5Map := make(map[string]interface{})
+							if !listItem.PrivateName.SiteLocator.VirtualSite.Name.IsNull() && !listItem.PrivateName.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel5Map["name"] = listItem.PrivateName.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !listItem.PrivateName.SiteLocator.VirtualSite.Namespace.IsNull() && !listItem.PrivateName.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel5Map["namespace"] = listItem.PrivateName.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !listItem.PrivateName.SiteLocator.VirtualSite.Tenant.IsNull() && !listItem.PrivateName.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel5Map["tenant"] = listItem.PrivateName.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["virtual_site"] = virtual_siteLevel5Map
+						}
+						private_nameDeepMap["site_locator"] = site_locatorLevel4Map
+					}
+					if listItem.PrivateName.SnatPool != nil {
+						snat_poolLevel4Map := make(map[string]interface{})
+						if listItem.PrivateName.SnatPool.NoSnatPool != nil {
+							snat_poolLevel4Map["no_snat_pool"] = map[string]interface{}{}
+						}
+						if listItem.PrivateName.SnatPool.SnatPool != nil {
+							snat_poolLevel5Map := make(map[string]interface{})
+							if !listItem.PrivateName.SnatPool.SnatPool.Prefixes.IsNull() && !listItem.PrivateName.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel5Items []string
+								diags := listItem.PrivateName.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel5Items, false)
+								if !diags.HasError() {
+									snat_poolLevel5Map["prefixes"] = prefixesLevel5Items
+								}
+							}
+							snat_poolLevel4Map["snat_pool"] = snat_poolLevel5Map
+						}
+						private_nameDeepMap["snat_pool"] = snat_poolLevel4Map
+					}
 					listItemMap["private_name"] = private_nameDeepMap
 				}
 				if listItem.PublicIP != nil {
@@ -21106,6 +25951,19 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if !listItem.VnPrivateIP.IP.IsNull() && !listItem.VnPrivateIP.IP.IsUnknown() {
 						vn_private_ipDeepMap["ip"] = listItem.VnPrivateIP.IP.ValueString()
 					}
+					if listItem.VnPrivateIP.VirtualNetwork != nil {
+						virtual_networkLevel4Map := make(map[string]interface{})
+						if !listItem.VnPrivateIP.VirtualNetwork.Name.IsNull() && !listItem.VnPrivateIP.VirtualNetwork.Name.IsUnknown() {
+							virtual_networkLevel4Map["name"] = listItem.VnPrivateIP.VirtualNetwork.Name.ValueString()
+						}
+						if !listItem.VnPrivateIP.VirtualNetwork.Namespace.IsNull() && !listItem.VnPrivateIP.VirtualNetwork.Namespace.IsUnknown() {
+							virtual_networkLevel4Map["namespace"] = listItem.VnPrivateIP.VirtualNetwork.Namespace.ValueString()
+						}
+						if !listItem.VnPrivateIP.VirtualNetwork.Tenant.IsNull() && !listItem.VnPrivateIP.VirtualNetwork.Tenant.IsUnknown() {
+							virtual_networkLevel4Map["tenant"] = listItem.VnPrivateIP.VirtualNetwork.Tenant.ValueString()
+						}
+						vn_private_ipDeepMap["virtual_network"] = virtual_networkLevel4Map
+					}
 					listItemMap["vn_private_ip"] = vn_private_ipDeepMap
 				}
 				if listItem.VnPrivateName != nil {
@@ -21113,6 +25971,19 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if !listItem.VnPrivateName.DNSName.IsNull() && !listItem.VnPrivateName.DNSName.IsUnknown() {
 						vn_private_nameDeepMap["dns_name"] = listItem.VnPrivateName.DNSName.ValueString()
 					}
+					if listItem.VnPrivateName.PrivateNetwork != nil {
+						private_networkLevel4Map := make(map[string]interface{})
+						if !listItem.VnPrivateName.PrivateNetwork.Name.IsNull() && !listItem.VnPrivateName.PrivateNetwork.Name.IsUnknown() {
+							private_networkLevel4Map["name"] = listItem.VnPrivateName.PrivateNetwork.Name.ValueString()
+						}
+						if !listItem.VnPrivateName.PrivateNetwork.Namespace.IsNull() && !listItem.VnPrivateName.PrivateNetwork.Namespace.IsUnknown() {
+							private_networkLevel4Map["namespace"] = listItem.VnPrivateName.PrivateNetwork.Namespace.ValueString()
+						}
+						if !listItem.VnPrivateName.PrivateNetwork.Tenant.IsNull() && !listItem.VnPrivateName.PrivateNetwork.Tenant.IsUnknown() {
+							private_networkLevel4Map["tenant"] = listItem.VnPrivateName.PrivateNetwork.Tenant.ValueString()
+						}
+						vn_private_nameDeepMap["private_network"] = private_networkLevel4Map
+					}
 					listItemMap["vn_private_name"] = vn_private_nameDeepMap
 				}
 				origin_serversList = append(origin_serversList, listItemMap)
@@ -21127,16 +25998,110 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.DefaultPool.UpstreamConnPoolReuseType != nil {
 			upstream_conn_pool_reuse_typeNestedMap := make(map[string]interface{})
+			if data.DefaultPool.UpstreamConnPoolReuseType.DisableConnPoolReuse != nil {
+				upstream_conn_pool_reuse_typeNestedMap["disable_conn_pool_reuse"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.UpstreamConnPoolReuseType.EnableConnPoolReuse != nil {
+				upstream_conn_pool_reuse_typeNestedMap["enable_conn_pool_reuse"] = map[string]interface{}{}
+			}
 			default_poolMap["upstream_conn_pool_reuse_type"] = upstream_conn_pool_reuse_typeNestedMap
 		}
 		if data.DefaultPool.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.DefaultPool.UseTLS.DefaultSessionKeyCaching != nil {
+				use_tlsNestedMap["default_session_key_caching"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.UseTLS.DisableSessionKeyCaching != nil {
+				use_tlsNestedMap["disable_session_key_caching"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.UseTLS.DisableSni != nil {
+				use_tlsNestedMap["disable_sni"] = map[string]interface{}{}
+			}
 			if !data.DefaultPool.UseTLS.MaxSessionKeys.IsNull() && !data.DefaultPool.UseTLS.MaxSessionKeys.IsUnknown() {
 				use_tlsNestedMap["max_session_keys"] = data.DefaultPool.UseTLS.MaxSessionKeys.ValueInt64()
 			}
+			if data.DefaultPool.UseTLS.NoMtls != nil {
+				use_tlsNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.UseTLS.SkipServerVerification != nil {
+				use_tlsNestedMap["skip_server_verification"] = map[string]interface{}{}
+			}
 			if !data.DefaultPool.UseTLS.Sni.IsNull() && !data.DefaultPool.UseTLS.Sni.IsUnknown() {
 				use_tlsNestedMap["sni"] = data.DefaultPool.UseTLS.Sni.ValueString()
 			}
+			if data.DefaultPool.UseTLS.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.DefaultPool.UseTLS.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.DefaultPool.UseTLS.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.DefaultPool.UseTLS.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.DefaultPool.UseTLS.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				use_tlsNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.DefaultPool.UseTLS.UseHostHeaderAsSni != nil {
+				use_tlsNestedMap["use_host_header_as_sni"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.UseTLS.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				use_tlsNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
+			if data.DefaultPool.UseTLS.UseMtlsObj != nil {
+				use_mtls_objLevel3Map := make(map[string]interface{})
+				if !data.DefaultPool.UseTLS.UseMtlsObj.Name.IsNull() && !data.DefaultPool.UseTLS.UseMtlsObj.Name.IsUnknown() {
+					use_mtls_objLevel3Map["name"] = data.DefaultPool.UseTLS.UseMtlsObj.Name.ValueString()
+				}
+				if !data.DefaultPool.UseTLS.UseMtlsObj.Namespace.IsNull() && !data.DefaultPool.UseTLS.UseMtlsObj.Namespace.IsUnknown() {
+					use_mtls_objLevel3Map["namespace"] = data.DefaultPool.UseTLS.UseMtlsObj.Namespace.ValueString()
+				}
+				if !data.DefaultPool.UseTLS.UseMtlsObj.Tenant.IsNull() && !data.DefaultPool.UseTLS.UseMtlsObj.Tenant.IsUnknown() {
+					use_mtls_objLevel3Map["tenant"] = data.DefaultPool.UseTLS.UseMtlsObj.Tenant.ValueString()
+				}
+				use_tlsNestedMap["use_mtls_obj"] = use_mtls_objLevel3Map
+			}
+			if data.DefaultPool.UseTLS.UseServerVerification != nil {
+				use_server_verificationLevel3Map := make(map[string]interface{})
+				if data.DefaultPool.UseTLS.UseServerVerification.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Name.IsNull() && !data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Name.ValueString()
+					}
+					if !data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Namespace.IsNull() && !data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Namespace.ValueString()
+					}
+					if !data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Tenant.IsNull() && !data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Tenant.ValueString()
+					}
+					use_server_verificationLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.DefaultPool.UseTLS.UseServerVerification.TrustedCAURL.IsNull() && !data.DefaultPool.UseTLS.UseServerVerification.TrustedCAURL.IsUnknown() {
+					use_server_verificationLevel3Map["trusted_ca_url"] = data.DefaultPool.UseTLS.UseServerVerification.TrustedCAURL.ValueString()
+				}
+				use_tlsNestedMap["use_server_verification"] = use_server_verificationLevel3Map
+			}
+			if data.DefaultPool.UseTLS.VolterraTrustedCA != nil {
+				use_tlsNestedMap["volterra_trusted_ca"] = map[string]interface{}{}
+			}
 			default_poolMap["use_tls"] = use_tlsNestedMap
 		}
 		if data.DefaultPool.ViewInternal != nil {
@@ -21320,6 +26285,13 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		enable_api_discoveryMap := make(map[string]interface{})
 		if data.EnableAPIDiscovery.APICrawler != nil {
 			api_crawlerNestedMap := make(map[string]interface{})
+			if data.EnableAPIDiscovery.APICrawler.APICrawlerConfig != nil {
+				api_crawler_configLevel3Map := make(map[string]interface{})
+				api_crawlerNestedMap["api_crawler_config"] = api_crawler_configLevel3Map
+			}
+			if data.EnableAPIDiscovery.APICrawler.DisableAPICrawler != nil {
+				api_crawlerNestedMap["disable_api_crawler"] = map[string]interface{}{}
+			}
 			enable_api_discoveryMap["api_crawler"] = api_crawlerNestedMap
 		}
 		if data.EnableAPIDiscovery.APIDiscoveryFromCodeScan != nil {
@@ -21328,6 +26300,19 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.EnableAPIDiscovery.CustomAPIAuthDiscovery != nil {
 			custom_api_auth_discoveryNestedMap := make(map[string]interface{})
+			if data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef != nil {
+				api_discovery_refLevel3Map := make(map[string]interface{})
+				if !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.IsNull() && !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.IsUnknown() {
+					api_discovery_refLevel3Map["name"] = data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.ValueString()
+				}
+				if !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.IsNull() && !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.IsUnknown() {
+					api_discovery_refLevel3Map["namespace"] = data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.ValueString()
+				}
+				if !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.IsNull() && !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.IsUnknown() {
+					api_discovery_refLevel3Map["tenant"] = data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.ValueString()
+				}
+				custom_api_auth_discoveryNestedMap["api_discovery_ref"] = api_discovery_refLevel3Map
+			}
 			enable_api_discoveryMap["custom_api_auth_discovery"] = custom_api_auth_discoveryNestedMap
 		}
 		if data.EnableAPIDiscovery.DefaultAPIAuthDiscovery != nil {
@@ -21510,6 +26495,12 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.HTTPS.CoalescingOptions != nil {
 			coalescing_optionsNestedMap := make(map[string]interface{})
+			if data.HTTPS.CoalescingOptions.DefaultCoalescing != nil {
+				coalescing_optionsNestedMap["default_coalescing"] = map[string]interface{}{}
+			}
+			if data.HTTPS.CoalescingOptions.StrictCoalescing != nil {
+				coalescing_optionsNestedMap["strict_coalescing"] = map[string]interface{}{}
+			}
 			httpsMap["coalescing_options"] = coalescing_optionsNestedMap
 		}
 		if !data.HTTPS.ConnectionIdleTimeout.IsNull() && !data.HTTPS.ConnectionIdleTimeout.IsUnknown() {
@@ -21529,6 +26520,32 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.HTTPS.HTTPProtocolOptions != nil {
 			http_protocol_optionsNestedMap := make(map[string]interface{})
+			if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1Only != nil {
+				http_protocol_enable_v1_onlyLevel3Map := make(map[string]interface{})
+				if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation != nil {
+					header_transformationLevel4Map := make(map[string]interface{})
+					if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.DefaultHeaderTransformation != nil {
+						header_transformationLevel4Map["default_header_transformation"] = map[string]interface{}{}
+					}
+					if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.LegacyHeaderTransformation != nil {
+						header_transformationLevel4Map["legacy_header_transformation"] = map[string]interface{}{}
+					}
+					if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.PreserveCaseHeaderTransformation != nil {
+						header_transformationLevel4Map["preserve_case_header_transformation"] = map[string]interface{}{}
+					}
+					if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.ProperCaseHeaderTransformation != nil {
+						header_transformationLevel4Map["proper_case_header_transformation"] = map[string]interface{}{}
+					}
+					http_protocol_enable_v1_onlyLevel3Map["header_transformation"] = header_transformationLevel4Map
+				}
+				http_protocol_optionsNestedMap["http_protocol_enable_v1_only"] = http_protocol_enable_v1_onlyLevel3Map
+			}
+			if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1V2 != nil {
+				http_protocol_optionsNestedMap["http_protocol_enable_v1_v2"] = map[string]interface{}{}
+			}
+			if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV2Only != nil {
+				http_protocol_optionsNestedMap["http_protocol_enable_v2_only"] = map[string]interface{}{}
+			}
 			httpsMap["http_protocol_options"] = http_protocol_optionsNestedMap
 		}
 		if !data.HTTPS.HTTPRedirect.IsNull() && !data.HTTPS.HTTPRedirect.IsUnknown() {
@@ -21551,10 +26568,182 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.HTTPS.TLSCertParams != nil {
 			tls_cert_paramsNestedMap := make(map[string]interface{})
+			if data.HTTPS.TLSCertParams.NoMtls != nil {
+				tls_cert_paramsNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPS.TLSCertParams.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPS.TLSCertParams.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSCertParams.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSCertParams.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				tls_cert_paramsNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPS.TLSCertParams.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPS.TLSCertParams.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPS.TLSCertParams.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPS.TLSCertParams.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSCertParams.UseMtls.CRL.Name.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPS.TLSCertParams.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPS.TLSCertParams.UseMtls.CRL.Namespace.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPS.TLSCertParams.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPS.TLSCertParams.UseMtls.CRL.Tenant.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPS.TLSCertParams.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPS.TLSCertParams.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSCertParams.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPS.TLSCertParams.UseMtls.TrustedCAURL.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPS.TLSCertParams.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPS.TLSCertParams.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSCertParams.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPS.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				tls_cert_paramsNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			httpsMap["tls_cert_params"] = tls_cert_paramsNestedMap
 		}
 		if data.HTTPS.TLSParameters != nil {
 			tls_parametersNestedMap := make(map[string]interface{})
+			if data.HTTPS.TLSParameters.NoMtls != nil {
+				tls_parametersNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPS.TLSParameters.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPS.TLSParameters.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPS.TLSParameters.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSParameters.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSParameters.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				tls_parametersNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPS.TLSParameters.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPS.TLSParameters.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPS.TLSParameters.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPS.TLSParameters.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPS.TLSParameters.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSParameters.UseMtls.CRL.Name.IsNull() && !data.HTTPS.TLSParameters.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPS.TLSParameters.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPS.TLSParameters.UseMtls.CRL.Namespace.IsNull() && !data.HTTPS.TLSParameters.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPS.TLSParameters.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPS.TLSParameters.UseMtls.CRL.Tenant.IsNull() && !data.HTTPS.TLSParameters.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPS.TLSParameters.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPS.TLSParameters.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSParameters.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSParameters.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPS.TLSParameters.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPS.TLSParameters.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPS.TLSParameters.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPS.TLSParameters.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPS.TLSParameters.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPS.TLSParameters.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPS.TLSParameters.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPS.TLSParameters.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPS.TLSParameters.UseMtls.TrustedCAURL.IsNull() && !data.HTTPS.TLSParameters.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPS.TLSParameters.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPS.TLSParameters.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSParameters.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSParameters.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPS.TLSParameters.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPS.TLSParameters.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				tls_parametersNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			httpsMap["tls_parameters"] = tls_parametersNestedMap
 		}
 		createReq.Spec["https"] = httpsMap
@@ -21569,6 +26758,12 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.HTTPSAutoCert.CoalescingOptions != nil {
 			coalescing_optionsNestedMap := make(map[string]interface{})
+			if data.HTTPSAutoCert.CoalescingOptions.DefaultCoalescing != nil {
+				coalescing_optionsNestedMap["default_coalescing"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.CoalescingOptions.StrictCoalescing != nil {
+				coalescing_optionsNestedMap["strict_coalescing"] = map[string]interface{}{}
+			}
 			https_auto_certMap["coalescing_options"] = coalescing_optionsNestedMap
 		}
 		if !data.HTTPSAutoCert.ConnectionIdleTimeout.IsNull() && !data.HTTPSAutoCert.ConnectionIdleTimeout.IsUnknown() {
@@ -21588,6 +26783,32 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.HTTPSAutoCert.HTTPProtocolOptions != nil {
 			http_protocol_optionsNestedMap := make(map[string]interface{})
+			if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1Only != nil {
+				http_protocol_enable_v1_onlyLevel3Map := make(map[string]interface{})
+				if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation != nil {
+					header_transformationLevel4Map := make(map[string]interface{})
+					if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.DefaultHeaderTransformation != nil {
+						header_transformationLevel4Map["default_header_transformation"] = map[string]interface{}{}
+					}
+					if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.LegacyHeaderTransformation != nil {
+						header_transformationLevel4Map["legacy_header_transformation"] = map[string]interface{}{}
+					}
+					if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.PreserveCaseHeaderTransformation != nil {
+						header_transformationLevel4Map["preserve_case_header_transformation"] = map[string]interface{}{}
+					}
+					if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.ProperCaseHeaderTransformation != nil {
+						header_transformationLevel4Map["proper_case_header_transformation"] = map[string]interface{}{}
+					}
+					http_protocol_enable_v1_onlyLevel3Map["header_transformation"] = header_transformationLevel4Map
+				}
+				http_protocol_optionsNestedMap["http_protocol_enable_v1_only"] = http_protocol_enable_v1_onlyLevel3Map
+			}
+			if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1V2 != nil {
+				http_protocol_optionsNestedMap["http_protocol_enable_v1_v2"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV2Only != nil {
+				http_protocol_optionsNestedMap["http_protocol_enable_v2_only"] = map[string]interface{}{}
+			}
 			https_auto_certMap["http_protocol_options"] = http_protocol_optionsNestedMap
 		}
 		if !data.HTTPSAutoCert.HTTPRedirect.IsNull() && !data.HTTPSAutoCert.HTTPRedirect.IsUnknown() {
@@ -21613,6 +26834,32 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.HTTPSAutoCert.TLSConfig != nil {
 			tls_configNestedMap := make(map[string]interface{})
+			if data.HTTPSAutoCert.TLSConfig.CustomSecurity != nil {
+				custom_securityLevel3Map := make(map[string]interface{})
+				if !data.HTTPSAutoCert.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSAutoCert.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+					var cipher_suitesLevel3Items []string
+					diags := data.HTTPSAutoCert.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel3Items, false)
+					if !diags.HasError() {
+						custom_securityLevel3Map["cipher_suites"] = cipher_suitesLevel3Items
+					}
+				}
+				if !data.HTTPSAutoCert.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSAutoCert.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+					custom_securityLevel3Map["max_version"] = data.HTTPSAutoCert.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+				}
+				if !data.HTTPSAutoCert.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSAutoCert.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+					custom_securityLevel3Map["min_version"] = data.HTTPSAutoCert.TLSConfig.CustomSecurity.MinVersion.ValueString()
+				}
+				tls_configNestedMap["custom_security"] = custom_securityLevel3Map
+			}
+			if data.HTTPSAutoCert.TLSConfig.DefaultSecurity != nil {
+				tls_configNestedMap["default_security"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.TLSConfig.LowSecurity != nil {
+				tls_configNestedMap["low_security"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.TLSConfig.MediumSecurity != nil {
+				tls_configNestedMap["medium_security"] = map[string]interface{}{}
+			}
 			https_auto_certMap["tls_config"] = tls_configNestedMap
 		}
 		if data.HTTPSAutoCert.UseMtls != nil {
@@ -21620,9 +26867,52 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 			if !data.HTTPSAutoCert.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSAutoCert.UseMtls.ClientCertificateOptional.IsUnknown() {
 				use_mtlsNestedMap["client_certificate_optional"] = data.HTTPSAutoCert.UseMtls.ClientCertificateOptional.ValueBool()
 			}
+			if data.HTTPSAutoCert.UseMtls.CRL != nil {
+				crlLevel3Map := make(map[string]interface{})
+				if !data.HTTPSAutoCert.UseMtls.CRL.Name.IsNull() && !data.HTTPSAutoCert.UseMtls.CRL.Name.IsUnknown() {
+					crlLevel3Map["name"] = data.HTTPSAutoCert.UseMtls.CRL.Name.ValueString()
+				}
+				if !data.HTTPSAutoCert.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSAutoCert.UseMtls.CRL.Namespace.IsUnknown() {
+					crlLevel3Map["namespace"] = data.HTTPSAutoCert.UseMtls.CRL.Namespace.ValueString()
+				}
+				if !data.HTTPSAutoCert.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSAutoCert.UseMtls.CRL.Tenant.IsUnknown() {
+					crlLevel3Map["tenant"] = data.HTTPSAutoCert.UseMtls.CRL.Tenant.ValueString()
+				}
+				use_mtlsNestedMap["crl"] = crlLevel3Map
+			}
+			if data.HTTPSAutoCert.UseMtls.NoCRL != nil {
+				use_mtlsNestedMap["no_crl"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.UseMtls.TrustedCA != nil {
+				trusted_caLevel3Map := make(map[string]interface{})
+				if !data.HTTPSAutoCert.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSAutoCert.UseMtls.TrustedCA.Name.IsUnknown() {
+					trusted_caLevel3Map["name"] = data.HTTPSAutoCert.UseMtls.TrustedCA.Name.ValueString()
+				}
+				if !data.HTTPSAutoCert.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSAutoCert.UseMtls.TrustedCA.Namespace.IsUnknown() {
+					trusted_caLevel3Map["namespace"] = data.HTTPSAutoCert.UseMtls.TrustedCA.Namespace.ValueString()
+				}
+				if !data.HTTPSAutoCert.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSAutoCert.UseMtls.TrustedCA.Tenant.IsUnknown() {
+					trusted_caLevel3Map["tenant"] = data.HTTPSAutoCert.UseMtls.TrustedCA.Tenant.ValueString()
+				}
+				use_mtlsNestedMap["trusted_ca"] = trusted_caLevel3Map
+			}
 			if !data.HTTPSAutoCert.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSAutoCert.UseMtls.TrustedCAURL.IsUnknown() {
 				use_mtlsNestedMap["trusted_ca_url"] = data.HTTPSAutoCert.UseMtls.TrustedCAURL.ValueString()
 			}
+			if data.HTTPSAutoCert.UseMtls.XfccDisabled != nil {
+				use_mtlsNestedMap["xfcc_disabled"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.UseMtls.XfccOptions != nil {
+				xfcc_optionsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSAutoCert.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSAutoCert.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+					var xfcc_header_elementsLevel3Items []string
+					diags := data.HTTPSAutoCert.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel3Items, false)
+					if !diags.HasError() {
+						xfcc_optionsLevel3Map["xfcc_header_elements"] = xfcc_header_elementsLevel3Items
+					}
+				}
+				use_mtlsNestedMap["xfcc_options"] = xfcc_optionsLevel3Map
+			}
 			https_auto_certMap["use_mtls"] = use_mtlsNestedMap
 		}
 		createReq.Spec["https_auto_cert"] = https_auto_certMap
@@ -21644,6 +26934,12 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		jwt_validationMap := make(map[string]interface{})
 		if data.JWTValidation.Action != nil {
 			actionNestedMap := make(map[string]interface{})
+			if data.JWTValidation.Action.Block != nil {
+				actionNestedMap["block"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.Action.Report != nil {
+				actionNestedMap["report"] = map[string]interface{}{}
+			}
 			jwt_validationMap["action"] = actionNestedMap
 		}
 		if data.JWTValidation.JwksConfig != nil {
@@ -21659,17 +26955,68 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.JWTValidation.ReservedClaims != nil {
 			reserved_claimsNestedMap := make(map[string]interface{})
+			if data.JWTValidation.ReservedClaims.Audience != nil {
+				audienceLevel3Map := make(map[string]interface{})
+				if !data.JWTValidation.ReservedClaims.Audience.Audiences.IsNull() && !data.JWTValidation.ReservedClaims.Audience.Audiences.IsUnknown() {
+					var audiencesLevel3Items []string
+					diags := data.JWTValidation.ReservedClaims.Audience.Audiences.ElementsAs(ctx, &audiencesLevel3Items, false)
+					if !diags.HasError() {
+						audienceLevel3Map["audiences"] = audiencesLevel3Items
+					}
+				}
+				reserved_claimsNestedMap["audience"] = audienceLevel3Map
+			}
+			if data.JWTValidation.ReservedClaims.AudienceDisable != nil {
+				reserved_claimsNestedMap["audience_disable"] = map[string]interface{}{}
+			}
 			if !data.JWTValidation.ReservedClaims.Issuer.IsNull() && !data.JWTValidation.ReservedClaims.Issuer.IsUnknown() {
 				reserved_claimsNestedMap["issuer"] = data.JWTValidation.ReservedClaims.Issuer.ValueString()
 			}
+			if data.JWTValidation.ReservedClaims.IssuerDisable != nil {
+				reserved_claimsNestedMap["issuer_disable"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.ReservedClaims.ValidatePeriodDisable != nil {
+				reserved_claimsNestedMap["validate_period_disable"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.ReservedClaims.ValidatePeriodEnable != nil {
+				reserved_claimsNestedMap["validate_period_enable"] = map[string]interface{}{}
+			}
 			jwt_validationMap["reserved_claims"] = reserved_claimsNestedMap
 		}
 		if data.JWTValidation.Target != nil {
 			targetNestedMap := make(map[string]interface{})
+			if data.JWTValidation.Target.AllEndpoint != nil {
+				targetNestedMap["all_endpoint"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.Target.APIGroups != nil {
+				api_groupsLevel3Map := make(map[string]interface{})
+				if !data.JWTValidation.Target.APIGroups.APIGroups.IsNull() && !data.JWTValidation.Target.APIGroups.APIGroups.IsUnknown() {
+					var api_groupsLevel3Items []string
+					diags := data.JWTValidation.Target.APIGroups.APIGroups.ElementsAs(ctx, &api_groupsLevel3Items, false)
+					if !diags.HasError() {
+						api_groupsLevel3Map["api_groups"] = api_groupsLevel3Items
+					}
+				}
+				targetNestedMap["api_groups"] = api_groupsLevel3Map
+			}
+			if data.JWTValidation.Target.BasePaths != nil {
+				base_pathsLevel3Map := make(map[string]interface{})
+				if !data.JWTValidation.Target.BasePaths.BasePaths.IsNull() && !data.JWTValidation.Target.BasePaths.BasePaths.IsUnknown() {
+					var base_pathsLevel3Items []string
+					diags := data.JWTValidation.Target.BasePaths.BasePaths.ElementsAs(ctx, &base_pathsLevel3Items, false)
+					if !diags.HasError() {
+						base_pathsLevel3Map["base_paths"] = base_pathsLevel3Items
+					}
+				}
+				targetNestedMap["base_paths"] = base_pathsLevel3Map
+			}
 			jwt_validationMap["target"] = targetNestedMap
 		}
 		if data.JWTValidation.TokenLocation != nil {
 			token_locationNestedMap := make(map[string]interface{})
+			if data.JWTValidation.TokenLocation.BearerToken != nil {
+				token_locationNestedMap["bearer_token"] = map[string]interface{}{}
+			}
 			jwt_validationMap["token_location"] = token_locationNestedMap
 		}
 		createReq.Spec["jwt_validation"] = jwt_validationMap
@@ -21798,6 +27145,19 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					if listItem.Domain.AnyDomain != nil {
 						domainDeepMap["any_domain"] = map[string]interface{}{}
 					}
+					if listItem.Domain.Domain != nil {
+						domainLevel4Map := make(map[string]interface{})
+						if !listItem.Domain.Domain.ExactValue.IsNull() && !listItem.Domain.Domain.ExactValue.IsUnknown() {
+							domainLevel4Map["exact_value"] = listItem.Domain.Domain.ExactValue.ValueString()
+						}
+						if !listItem.Domain.Domain.RegexValue.IsNull() && !listItem.Domain.Domain.RegexValue.IsUnknown() {
+							domainLevel4Map["regex_value"] = listItem.Domain.Domain.RegexValue.ValueString()
+						}
+						if !listItem.Domain.Domain.SuffixValue.IsNull() && !listItem.Domain.Domain.SuffixValue.IsUnknown() {
+							domainLevel4Map["suffix_value"] = listItem.Domain.Domain.SuffixValue.ValueString()
+						}
+						domainDeepMap["domain"] = domainLevel4Map
+					}
 					listItemMap["domain"] = domainDeepMap
 				}
 				if listItem.Metadata != nil {
@@ -21884,6 +27244,29 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 				}
 				if listItem.SecretValue != nil {
 					secret_valueDeepMap := make(map[string]interface{})
+					if listItem.SecretValue.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["decryption_provider"] = listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.Location.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel4Map["location"] = listItem.SecretValue.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["store_provider"] = listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						secret_valueDeepMap["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+					}
+					if listItem.SecretValue.ClearSecretInfo != nil {
+						clear_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.ClearSecretInfo.Provider.IsNull() && !listItem.SecretValue.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel4Map["provider"] = listItem.SecretValue.ClearSecretInfo.Provider.ValueString()
+						}
+						if !listItem.SecretValue.ClearSecretInfo.URL.IsNull() && !listItem.SecretValue.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel4Map["url"] = listItem.SecretValue.ClearSecretInfo.URL.ValueString()
+						}
+						secret_valueDeepMap["clear_secret_info"] = clear_secret_infoLevel4Map
+					}
 					listItemMap["secret_value"] = secret_valueDeepMap
 				}
 				if !listItem.Value.IsNull() && !listItem.Value.IsUnknown() {
@@ -21912,6 +27295,29 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 				}
 				if listItem.SecretValue != nil {
 					secret_valueDeepMap := make(map[string]interface{})
+					if listItem.SecretValue.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["decryption_provider"] = listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.Location.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel4Map["location"] = listItem.SecretValue.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["store_provider"] = listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						secret_valueDeepMap["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+					}
+					if listItem.SecretValue.ClearSecretInfo != nil {
+						clear_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.ClearSecretInfo.Provider.IsNull() && !listItem.SecretValue.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel4Map["provider"] = listItem.SecretValue.ClearSecretInfo.Provider.ValueString()
+						}
+						if !listItem.SecretValue.ClearSecretInfo.URL.IsNull() && !listItem.SecretValue.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel4Map["url"] = listItem.SecretValue.ClearSecretInfo.URL.ValueString()
+						}
+						secret_valueDeepMap["clear_secret_info"] = clear_secret_infoLevel4Map
+					}
 					listItemMap["secret_value"] = secret_valueDeepMap
 				}
 				if !listItem.Value.IsNull() && !listItem.Value.IsUnknown() {
@@ -21997,6 +27403,29 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 				}
 				if listItem.SecretValue != nil {
 					secret_valueDeepMap := make(map[string]interface{})
+					if listItem.SecretValue.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["decryption_provider"] = listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.Location.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel4Map["location"] = listItem.SecretValue.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["store_provider"] = listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						secret_valueDeepMap["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+					}
+					if listItem.SecretValue.ClearSecretInfo != nil {
+						clear_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.ClearSecretInfo.Provider.IsNull() && !listItem.SecretValue.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel4Map["provider"] = listItem.SecretValue.ClearSecretInfo.Provider.ValueString()
+						}
+						if !listItem.SecretValue.ClearSecretInfo.URL.IsNull() && !listItem.SecretValue.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel4Map["url"] = listItem.SecretValue.ClearSecretInfo.URL.ValueString()
+						}
+						secret_valueDeepMap["clear_secret_info"] = clear_secret_infoLevel4Map
+					}
 					listItemMap["secret_value"] = secret_valueDeepMap
 				}
 				if !listItem.Value.IsNull() && !listItem.Value.IsUnknown() {
@@ -22025,6 +27454,29 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 				}
 				if listItem.SecretValue != nil {
 					secret_valueDeepMap := make(map[string]interface{})
+					if listItem.SecretValue.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["decryption_provider"] = listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.Location.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel4Map["location"] = listItem.SecretValue.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["store_provider"] = listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						secret_valueDeepMap["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+					}
+					if listItem.SecretValue.ClearSecretInfo != nil {
+						clear_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.ClearSecretInfo.Provider.IsNull() && !listItem.SecretValue.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel4Map["provider"] = listItem.SecretValue.ClearSecretInfo.Provider.ValueString()
+						}
+						if !listItem.SecretValue.ClearSecretInfo.URL.IsNull() && !listItem.SecretValue.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel4Map["url"] = listItem.SecretValue.ClearSecretInfo.URL.ValueString()
+						}
+						secret_valueDeepMap["clear_secret_info"] = clear_secret_infoLevel4Map
+					}
 					listItemMap["secret_value"] = secret_valueDeepMap
 				}
 				if !listItem.Value.IsNull() && !listItem.Value.IsUnknown() {
@@ -22265,12 +27717,46 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.RateLimit.RateLimiter != nil {
 			rate_limiterNestedMap := make(map[string]interface{})
+			if data.RateLimit.RateLimiter.ActionBlock != nil {
+				action_blockLevel3Map := make(map[string]interface{})
+				if data.RateLimit.RateLimiter.ActionBlock.Hours != nil {
+					hoursLevel4Map := make(map[string]interface{})
+					if !data.RateLimit.RateLimiter.ActionBlock.Hours.Duration.IsNull() && !data.RateLimit.RateLimiter.ActionBlock.Hours.Duration.IsUnknown() {
+						hoursLevel4Map["duration"] = data.RateLimit.RateLimiter.ActionBlock.Hours.Duration.ValueInt64()
+					}
+					action_blockLevel3Map["hours"] = hoursLevel4Map
+				}
+				if data.RateLimit.RateLimiter.ActionBlock.Minutes != nil {
+					minutesLevel4Map := make(map[string]interface{})
+					if !data.RateLimit.RateLimiter.ActionBlock.Minutes.Duration.IsNull() && !data.RateLimit.RateLimiter.ActionBlock.Minutes.Duration.IsUnknown() {
+						minutesLevel4Map["duration"] = data.RateLimit.RateLimiter.ActionBlock.Minutes.Duration.ValueInt64()
+					}
+					action_blockLevel3Map["minutes"] = minutesLevel4Map
+				}
+				if data.RateLimit.RateLimiter.ActionBlock.Seconds != nil {
+					secondsLevel4Map := make(map[string]interface{})
+					if !data.RateLimit.RateLimiter.ActionBlock.Seconds.Duration.IsNull() && !data.RateLimit.RateLimiter.ActionBlock.Seconds.Duration.IsUnknown() {
+						secondsLevel4Map["duration"] = data.RateLimit.RateLimiter.ActionBlock.Seconds.Duration.ValueInt64()
+					}
+					action_blockLevel3Map["seconds"] = secondsLevel4Map
+				}
+				rate_limiterNestedMap["action_block"] = action_blockLevel3Map
+			}
 			if !data.RateLimit.RateLimiter.BurstMultiplier.IsNull() && !data.RateLimit.RateLimiter.BurstMultiplier.IsUnknown() {
 				rate_limiterNestedMap["burst_multiplier"] = data.RateLimit.RateLimiter.BurstMultiplier.ValueInt64()
 			}
+			if data.RateLimit.RateLimiter.Disabled != nil {
+				rate_limiterNestedMap["disabled"] = map[string]interface{}{}
+			}
+			if data.RateLimit.RateLimiter.LeakyBucket != nil {
+				rate_limiterNestedMap["leaky_bucket"] = map[string]interface{}{}
+			}
 			if !data.RateLimit.RateLimiter.PeriodMultiplier.IsNull() && !data.RateLimit.RateLimiter.PeriodMultiplier.IsUnknown() {
 				rate_limiterNestedMap["period_multiplier"] = data.RateLimit.RateLimiter.PeriodMultiplier.ValueInt64()
 			}
+			if data.RateLimit.RateLimiter.TokenBucket != nil {
+				rate_limiterNestedMap["token_bucket"] = map[string]interface{}{}
+			}
 			if !data.RateLimit.RateLimiter.TotalNumber.IsNull() && !data.RateLimit.RateLimiter.TotalNumber.IsUnknown() {
 				rate_limiterNestedMap["total_number"] = data.RateLimit.RateLimiter.TotalNumber.ValueInt64()
 			}
@@ -22522,12 +28008,99 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 					simple_routeNestedMap := make(map[string]interface{})
 					if item.SimpleRoute.AdvancedOptions != nil {
 						advanced_optionsDeepMap := make(map[string]interface{})
+						if item.SimpleRoute.AdvancedOptions.AppFirewall != nil {
+							app_firewallLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.AppFirewall.Name.IsNull() && !item.SimpleRoute.AdvancedOptions.AppFirewall.Name.IsUnknown() {
+								app_firewallLevel4Map["name"] = item.SimpleRoute.AdvancedOptions.AppFirewall.Name.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.AppFirewall.Namespace.IsNull() && !item.SimpleRoute.AdvancedOptions.AppFirewall.Namespace.IsUnknown() {
+								app_firewallLevel4Map["namespace"] = item.SimpleRoute.AdvancedOptions.AppFirewall.Namespace.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.AppFirewall.Tenant.IsNull() && !item.SimpleRoute.AdvancedOptions.AppFirewall.Tenant.IsUnknown() {
+								app_firewallLevel4Map["tenant"] = item.SimpleRoute.AdvancedOptions.AppFirewall.Tenant.ValueString()
+							}
+							advanced_optionsDeepMap["app_firewall"] = app_firewallLevel4Map
+						}
+						if item.SimpleRoute.AdvancedOptions.BotDefenseJavascriptInjection != nil {
+							bot_defense_javascript_injectionLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.BotDefenseJavascriptInjection.JavascriptLocation.IsNull() && !item.SimpleRoute.AdvancedOptions.BotDefenseJavascriptInjection.JavascriptLocation.IsUnknown() {
+								bot_defense_javascript_injectionLevel4Map["javascript_location"] = item.SimpleRoute.AdvancedOptions.BotDefenseJavascriptInjection.JavascriptLocation.ValueString()
+							}
+							advanced_optionsDeepMap["bot_defense_javascript_injection"] = bot_defense_javascript_injectionLevel4Map
+						}
+						if item.SimpleRoute.AdvancedOptions.BufferPolicy != nil {
+							buffer_policyLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.BufferPolicy.Disabled.IsNull() && !item.SimpleRoute.AdvancedOptions.BufferPolicy.Disabled.IsUnknown() {
+								buffer_policyLevel4Map["disabled"] = item.SimpleRoute.AdvancedOptions.BufferPolicy.Disabled.ValueBool()
+							}
+							if !item.SimpleRoute.AdvancedOptions.BufferPolicy.MaxRequestBytes.IsNull() && !item.SimpleRoute.AdvancedOptions.BufferPolicy.MaxRequestBytes.IsUnknown() {
+								buffer_policyLevel4Map["max_request_bytes"] = item.SimpleRoute.AdvancedOptions.BufferPolicy.MaxRequestBytes.ValueInt64()
+							}
+							advanced_optionsDeepMap["buffer_policy"] = buffer_policyLevel4Map
+						}
 						if item.SimpleRoute.AdvancedOptions.CommonBuffering != nil {
 							advanced_optionsDeepMap["common_buffering"] = map[string]interface{}{}
 						}
 						if item.SimpleRoute.AdvancedOptions.CommonHashPolicy != nil {
 							advanced_optionsDeepMap["common_hash_policy"] = map[string]interface{}{}
 						}
+						if item.SimpleRoute.AdvancedOptions.CORSPolicy != nil {
+							cors_policyLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowCredentials.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowCredentials.IsUnknown() {
+								cors_policyLevel4Map["allow_credentials"] = item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowCredentials.ValueBool()
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowHeaders.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowHeaders.IsUnknown() {
+								cors_policyLevel4Map["allow_headers"] = item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowHeaders.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowMethods.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowMethods.IsUnknown() {
+								cors_policyLevel4Map["allow_methods"] = item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowMethods.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowOrigin.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowOrigin.IsUnknown() {
+								var allow_originLevel4Items []string
+								diags := item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowOrigin.ElementsAs(ctx, &allow_originLevel4Items, false)
+								if !diags.HasError() {
+									cors_policyLevel4Map["allow_origin"] = allow_originLevel4Items
+								}
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowOriginRegex.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowOriginRegex.IsUnknown() {
+								var allow_origin_regexLevel4Items []string
+								diags := item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowOriginRegex.ElementsAs(ctx, &allow_origin_regexLevel4Items, false)
+								if !diags.HasError() {
+									cors_policyLevel4Map["allow_origin_regex"] = allow_origin_regexLevel4Items
+								}
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.Disabled.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.Disabled.IsUnknown() {
+								cors_policyLevel4Map["disabled"] = item.SimpleRoute.AdvancedOptions.CORSPolicy.Disabled.ValueBool()
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.ExposeHeaders.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.ExposeHeaders.IsUnknown() {
+								cors_policyLevel4Map["expose_headers"] = item.SimpleRoute.AdvancedOptions.CORSPolicy.ExposeHeaders.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.MaximumAge.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.MaximumAge.IsUnknown() {
+								cors_policyLevel4Map["maximum_age"] = item.SimpleRoute.AdvancedOptions.CORSPolicy.MaximumAge.ValueInt64()
+							}
+							advanced_optionsDeepMap["cors_policy"] = cors_policyLevel4Map
+						}
+						if item.SimpleRoute.AdvancedOptions.CSRFPolicy != nil {
+							csrf_policyLevel4Map := make(map[string]interface{})
+							if item.SimpleRoute.AdvancedOptions.CSRFPolicy.AllLoadBalancerDomains != nil {
+								csrf_policyLevel4Map["all_load_balancer_domains"] = map[string]interface{}{}
+							}
+							if item.SimpleRoute.AdvancedOptions.CSRFPolicy.CustomDomainList != nil {
+								custom_domain_listLevel5Map := make(map[string]interface{})
+								if !item.SimpleRoute.AdvancedOptions.CSRFPolicy.CustomDomainList.Domains.IsNull() && !item.SimpleRoute.AdvancedOptions.CSRFPolicy.CustomDomainList.Domains.IsUnknown() {
+									var domainsLevel5Items []string
+									diags := item.SimpleRoute.AdvancedOptions.CSRFPolicy.CustomDomainList.Domains.ElementsAs(ctx, &domainsLevel5Items, false)
+									if !diags.HasError() {
+										custom_domain_listLevel5Map["domains"] = domainsLevel5Items
+									}
+								}
+								csrf_policyLevel4Map["custom_domain_list"] = custom_domain_listLevel5Map
+							}
+							if item.SimpleRoute.AdvancedOptions.CSRFPolicy.Disabled != nil {
+								csrf_policyLevel4Map["disabled"] = map[string]interface{}{}
+							}
+							advanced_optionsDeepMap["csrf_policy"] = csrf_policyLevel4Map
+						}
 						if item.SimpleRoute.AdvancedOptions.DefaultRetryPolicy != nil {
 							advanced_optionsDeepMap["default_retry_policy"] = map[string]interface{}{}
 						}
@@ -22567,6 +28140,33 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 						if item.SimpleRoute.AdvancedOptions.InheritedWAFExclusion != nil {
 							advanced_optionsDeepMap["inherited_waf_exclusion"] = map[string]interface{}{}
 						}
+						if item.SimpleRoute.AdvancedOptions.MirrorPolicy != nil {
+							mirror_policyLevel4Map := make(map[string]interface{})
+							if item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool != nil {
+								origin_poolLevel5Map := make(map[string]interface{})
+								if !item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Name.IsNull() && !item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Name.IsUnknown() {
+									origin_poolLevel5Map["name"] = item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Name.ValueString()
+								}
+								if !item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Namespace.IsNull() && !item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Namespace.IsUnknown() {
+									origin_poolLevel5Map["namespace"] = item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Namespace.ValueString()
+								}
+								if !item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Tenant.IsNull() && !item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Tenant.IsUnknown() {
+									origin_poolLevel5Map["tenant"] = item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Tenant.ValueString()
+								}
+								mirror_policyLevel4Map["origin_pool"] = origin_poolLevel5Map
+							}
+							if item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent != nil {
+								percentLevel5Map := make(map[string]interface{})
+								if !item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent.Denominator.IsNull() && !item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent.Denominator.IsUnknown() {
+									percentLevel5Map["denominator"] = item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent.Denominator.ValueString()
+								}
+								if !item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent.Numerator.IsNull() && !item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent.Numerator.IsUnknown() {
+									percentLevel5Map["numerator"] = item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent.Numerator.ValueInt64()
+								}
+								mirror_policyLevel4Map["percent"] = percentLevel5Map
+							}
+							advanced_optionsDeepMap["mirror_policy"] = mirror_policyLevel4Map
+						}
 						if item.SimpleRoute.AdvancedOptions.NoRetryPolicy != nil {
 							advanced_optionsDeepMap["no_retry_policy"] = map[string]interface{}{}
 						}
@@ -22576,6 +28176,16 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 						if !item.SimpleRoute.AdvancedOptions.Priority.IsNull() && !item.SimpleRoute.AdvancedOptions.Priority.IsUnknown() {
 							advanced_optionsDeepMap["priority"] = item.SimpleRoute.AdvancedOptions.Priority.ValueString()
 						}
+						if item.SimpleRoute.AdvancedOptions.RegexRewrite != nil {
+							regex_rewriteLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.RegexRewrite.Pattern.IsNull() && !item.SimpleRoute.AdvancedOptions.RegexRewrite.Pattern.IsUnknown() {
+								regex_rewriteLevel4Map["pattern"] = item.SimpleRoute.AdvancedOptions.RegexRewrite.Pattern.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.RegexRewrite.Substitution.IsNull() && !item.SimpleRoute.AdvancedOptions.RegexRewrite.Substitution.IsUnknown() {
+								regex_rewriteLevel4Map["substitution"] = item.SimpleRoute.AdvancedOptions.RegexRewrite.Substitution.ValueString()
+							}
+							advanced_optionsDeepMap["regex_rewrite"] = regex_rewriteLevel4Map
+						}
 						if !item.SimpleRoute.AdvancedOptions.RequestCookiesToRemove.IsNull() && !item.SimpleRoute.AdvancedOptions.RequestCookiesToRemove.IsUnknown() {
 							var RequestCookiesToRemoveItems []string
 							diags := item.SimpleRoute.AdvancedOptions.RequestCookiesToRemove.ElementsAs(ctx, &RequestCookiesToRemoveItems, false)
@@ -22607,9 +28217,67 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 						if item.SimpleRoute.AdvancedOptions.RetractCluster != nil {
 							advanced_optionsDeepMap["retract_cluster"] = map[string]interface{}{}
 						}
+						if item.SimpleRoute.AdvancedOptions.RetryPolicy != nil {
+							retry_policyLevel4Map := make(map[string]interface{})
+							if item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff != nil {
+								back_offLevel5Map := make(map[string]interface{})
+								if !item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff.BaseInterval.IsNull() && !item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff.BaseInterval.IsUnknown() {
+									back_offLevel5Map["base_interval"] = item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff.BaseInterval.ValueInt64()
+								}
+								if !item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff.MaxInterval.IsNull() && !item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff.MaxInterval.IsUnknown() {
+									back_offLevel5Map["max_interval"] = item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff.MaxInterval.ValueInt64()
+								}
+								retry_policyLevel4Map["back_off"] = back_offLevel5Map
+							}
+							if !item.SimpleRoute.AdvancedOptions.RetryPolicy.NumRetries.IsNull() && !item.SimpleRoute.AdvancedOptions.RetryPolicy.NumRetries.IsUnknown() {
+								retry_policyLevel4Map["num_retries"] = item.SimpleRoute.AdvancedOptions.RetryPolicy.NumRetries.ValueInt64()
+							}
+							if !item.SimpleRoute.AdvancedOptions.RetryPolicy.PerTryTimeout.IsNull() && !item.SimpleRoute.AdvancedOptions.RetryPolicy.PerTryTimeout.IsUnknown() {
+								retry_policyLevel4Map["per_try_timeout"] = item.SimpleRoute.AdvancedOptions.RetryPolicy.PerTryTimeout.ValueInt64()
+							}
+							if !item.SimpleRoute.AdvancedOptions.RetryPolicy.RetriableStatusCodes.IsNull() && !item.SimpleRoute.AdvancedOptions.RetryPolicy.RetriableStatusCodes.IsUnknown() {
+								var retriable_status_codesLevel4Items []int64
+								diags := item.SimpleRoute.AdvancedOptions.RetryPolicy.RetriableStatusCodes.ElementsAs(ctx, &retriable_status_codesLevel4Items, false)
+								if !diags.HasError() {
+									retry_policyLevel4Map["retriable_status_codes"] = retriable_status_codesLevel4Items
+								}
+							}
+							if !item.SimpleRoute.AdvancedOptions.RetryPolicy.RetryCondition.IsNull() && !item.SimpleRoute.AdvancedOptions.RetryPolicy.RetryCondition.IsUnknown() {
+								var retry_conditionLevel4Items []string
+								diags := item.SimpleRoute.AdvancedOptions.RetryPolicy.RetryCondition.ElementsAs(ctx, &retry_conditionLevel4Items, false)
+								if !diags.HasError() {
+									retry_policyLevel4Map["retry_condition"] = retry_conditionLevel4Items
+								}
+							}
+							advanced_optionsDeepMap["retry_policy"] = retry_policyLevel4Map
+						}
+						if item.SimpleRoute.AdvancedOptions.SpecificHashPolicy != nil {
+							specific_hash_policyLevel4Map := make(map[string]interface{})
+							advanced_optionsDeepMap["specific_hash_policy"] = specific_hash_policyLevel4Map
+						}
 						if !item.SimpleRoute.AdvancedOptions.Timeout.IsNull() && !item.SimpleRoute.AdvancedOptions.Timeout.IsUnknown() {
 							advanced_optionsDeepMap["timeout"] = item.SimpleRoute.AdvancedOptions.Timeout.ValueInt64()
 						}
+						if item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy != nil {
+							waf_exclusion_policyLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Name.IsNull() && !item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Name.IsUnknown() {
+								waf_exclusion_policyLevel4Map["name"] = item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Name.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Namespace.IsNull() && !item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Namespace.IsUnknown() {
+								waf_exclusion_policyLevel4Map["namespace"] = item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Namespace.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Tenant.IsNull() && !item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Tenant.IsUnknown() {
+								waf_exclusion_policyLevel4Map["tenant"] = item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Tenant.ValueString()
+							}
+							advanced_optionsDeepMap["waf_exclusion_policy"] = waf_exclusion_policyLevel4Map
+						}
+						if item.SimpleRoute.AdvancedOptions.WebSocketConfig != nil {
+							web_socket_configLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.WebSocketConfig.UseWebSocket.IsNull() && !item.SimpleRoute.AdvancedOptions.WebSocketConfig.UseWebSocket.IsUnknown() {
+								web_socket_configLevel4Map["use_websocket"] = item.SimpleRoute.AdvancedOptions.WebSocketConfig.UseWebSocket.ValueBool()
+							}
+							advanced_optionsDeepMap["web_socket_config"] = web_socket_configLevel4Map
+						}
 						simple_routeNestedMap["advanced_options"] = advanced_optionsDeepMap
 					}
 					if item.SimpleRoute.AutoHostRewrite != nil {
@@ -22770,6 +28438,54 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.SingleLBApp.EnableDiscovery != nil {
 			enable_discoveryNestedMap := make(map[string]interface{})
+			if data.SingleLBApp.EnableDiscovery.APICrawler != nil {
+				api_crawlerLevel3Map := make(map[string]interface{})
+				if data.SingleLBApp.EnableDiscovery.APICrawler.APICrawlerConfig != nil {
+					api_crawler_configLevel4Map := make(map[string]interface{})
+					api_crawlerLevel3Map["api_crawler_config"] = api_crawler_configLevel4Map
+				}
+				if data.SingleLBApp.EnableDiscovery.APICrawler.DisableAPICrawler != nil {
+					api_crawlerLevel3Map["disable_api_crawler"] = map[string]interface{}{}
+				}
+				enable_discoveryNestedMap["api_crawler"] = api_crawlerLevel3Map
+			}
+			if data.SingleLBApp.EnableDiscovery.APIDiscoveryFromCodeScan != nil {
+				api_discovery_from_code_scanLevel3Map := make(map[string]interface{})
+				enable_discoveryNestedMap["api_discovery_from_code_scan"] = api_discovery_from_code_scanLevel3Map
+			}
+			if data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery != nil {
+				custom_api_auth_discoveryLevel3Map := make(map[string]interface{})
+				if data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef != nil {
+					api_discovery_refLevel4Map := make(map[string]interface{})
+					if !data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.IsNull() && !data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.IsUnknown() {
+						api_discovery_refLevel4Map["name"] = data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.ValueString()
+					}
+					if !data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.IsNull() && !data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.IsUnknown() {
+						api_discovery_refLevel4Map["namespace"] = data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.ValueString()
+					}
+					if !data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.IsNull() && !data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.IsUnknown() {
+						api_discovery_refLevel4Map["tenant"] = data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.ValueString()
+					}
+					custom_api_auth_discoveryLevel3Map["api_discovery_ref"] = api_discovery_refLevel4Map
+				}
+				enable_discoveryNestedMap["custom_api_auth_discovery"] = custom_api_auth_discoveryLevel3Map
+			}
+			if data.SingleLBApp.EnableDiscovery.DefaultAPIAuthDiscovery != nil {
+				enable_discoveryNestedMap["default_api_auth_discovery"] = map[string]interface{}{}
+			}
+			if data.SingleLBApp.EnableDiscovery.DisableLearnFromRedirectTraffic != nil {
+				enable_discoveryNestedMap["disable_learn_from_redirect_traffic"] = map[string]interface{}{}
+			}
+			if data.SingleLBApp.EnableDiscovery.DiscoveredAPISettings != nil {
+				discovered_api_settingsLevel3Map := make(map[string]interface{})
+				if !data.SingleLBApp.EnableDiscovery.DiscoveredAPISettings.PurgeDurationForInactiveDiscoveredApis.IsNull() && !data.SingleLBApp.EnableDiscovery.DiscoveredAPISettings.PurgeDurationForInactiveDiscoveredApis.IsUnknown() {
+					discovered_api_settingsLevel3Map["purge_duration_for_inactive_discovered_apis"] = data.SingleLBApp.EnableDiscovery.DiscoveredAPISettings.PurgeDurationForInactiveDiscoveredApis.ValueInt64()
+				}
+				enable_discoveryNestedMap["discovered_api_settings"] = discovered_api_settingsLevel3Map
+			}
+			if data.SingleLBApp.EnableDiscovery.EnableLearnFromRedirectTraffic != nil {
+				enable_discoveryNestedMap["enable_learn_from_redirect_traffic"] = map[string]interface{}{}
+			}
 			single_lb_appMap["enable_discovery"] = enable_discoveryNestedMap
 		}
 		if data.SingleLBApp.EnableMaliciousUserDetection != nil {
@@ -22916,11 +28632,15 @@ func (r *HTTPLoadBalancerResource) Create(ctx context.Context, req resource.Crea
 
 	apiResource, err := r.client.CreateHTTPLoadBalancer(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create HTTPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "http_loadbalancer", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -27290,7 +33010,7 @@ func (r *HTTPLoadBalancerResource) Read(ctx context.Context, req resource.ReadRe
 	apiResource, err := r.client.GetHTTPLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "HTTPLoadBalancer not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -27298,11 +33018,12 @@ func (r *HTTPLoadBalancerResource) Read(ctx context.Context, req resource.ReadRe
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HTTPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "http_loadbalancer", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -27313,31 +33034,13 @@ func (r *HTTPLoadBalancerResource) Read(ctx context.Context, req resource.ReadRe
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -27345,6 +33048,7 @@ func (r *HTTPLoadBalancerResource) Read(ctx context.Context, req resource.ReadRe
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["active_service_policies"].(map[string]interface{}); ok && (isImport || data.ActiveServicePolicies != nil) {
 		data.ActiveServicePolicies = &HTTPLoadBalancerActiveServicePoliciesModel{
@@ -31692,7 +37396,8 @@ func (r *HTTPLoadBalancerResource) Read(ctx context.Context, req resource.ReadRe
 
 func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data HTTPLoadBalancerResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -31726,6 +37431,7 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -31735,6 +37441,7 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.ActiveServicePolicies != nil {
@@ -31766,6 +37473,19 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 				listItemMap := make(map[string]interface{})
 				if listItem.AdvertiseOnPublic != nil {
 					advertise_on_publicDeepMap := make(map[string]interface{})
+					if listItem.AdvertiseOnPublic.PublicIP != nil {
+						public_ipLevel4Map := make(map[string]interface{})
+						if !listItem.AdvertiseOnPublic.PublicIP.Name.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Name.IsUnknown() {
+							public_ipLevel4Map["name"] = listItem.AdvertiseOnPublic.PublicIP.Name.ValueString()
+						}
+						if !listItem.AdvertiseOnPublic.PublicIP.Namespace.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Namespace.IsUnknown() {
+							public_ipLevel4Map["namespace"] = listItem.AdvertiseOnPublic.PublicIP.Namespace.ValueString()
+						}
+						if !listItem.AdvertiseOnPublic.PublicIP.Tenant.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Tenant.IsUnknown() {
+							public_ipLevel4Map["tenant"] = listItem.AdvertiseOnPublic.PublicIP.Tenant.ValueString()
+						}
+						advertise_on_publicDeepMap["public_ip"] = public_ipLevel4Map
+					}
 					listItemMap["advertise_on_public"] = advertise_on_publicDeepMap
 				}
 				if !listItem.Port.IsNull() && !listItem.Port.IsUnknown() {
@@ -31782,6 +37502,19 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if !listItem.Site.Network.IsNull() && !listItem.Site.Network.IsUnknown() {
 						siteDeepMap["network"] = listItem.Site.Network.ValueString()
 					}
+					if listItem.Site.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Site.Site.Name.IsNull() && !listItem.Site.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Site.Site.Name.ValueString()
+						}
+						if !listItem.Site.Site.Namespace.IsNull() && !listItem.Site.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Site.Site.Namespace.ValueString()
+						}
+						if !listItem.Site.Site.Tenant.IsNull() && !listItem.Site.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Site.Site.Tenant.ValueString()
+						}
+						siteDeepMap["site"] = siteLevel4Map
+					}
 					listItemMap["site"] = siteDeepMap
 				}
 				if listItem.UseDefaultPort != nil {
@@ -31801,6 +37534,19 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if !listItem.VirtualNetwork.SpecificVIP.IsNull() && !listItem.VirtualNetwork.SpecificVIP.IsUnknown() {
 						virtual_networkDeepMap["specific_vip"] = listItem.VirtualNetwork.SpecificVIP.ValueString()
 					}
+					if listItem.VirtualNetwork.VirtualNetwork != nil {
+						virtual_networkLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualNetwork.VirtualNetwork.Name.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Name.IsUnknown() {
+							virtual_networkLevel4Map["name"] = listItem.VirtualNetwork.VirtualNetwork.Name.ValueString()
+						}
+						if !listItem.VirtualNetwork.VirtualNetwork.Namespace.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Namespace.IsUnknown() {
+							virtual_networkLevel4Map["namespace"] = listItem.VirtualNetwork.VirtualNetwork.Namespace.ValueString()
+						}
+						if !listItem.VirtualNetwork.VirtualNetwork.Tenant.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Tenant.IsUnknown() {
+							virtual_networkLevel4Map["tenant"] = listItem.VirtualNetwork.VirtualNetwork.Tenant.ValueString()
+						}
+						virtual_networkDeepMap["virtual_network"] = virtual_networkLevel4Map
+					}
 					listItemMap["virtual_network"] = virtual_networkDeepMap
 				}
 				if listItem.VirtualSite != nil {
@@ -31808,6 +37554,19 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if !listItem.VirtualSite.Network.IsNull() && !listItem.VirtualSite.Network.IsUnknown() {
 						virtual_siteDeepMap["network"] = listItem.VirtualSite.Network.ValueString()
 					}
+					if listItem.VirtualSite.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSite.VirtualSite.Name.IsNull() && !listItem.VirtualSite.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSite.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Namespace.IsNull() && !listItem.VirtualSite.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSite.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Tenant.IsNull() && !listItem.VirtualSite.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSite.VirtualSite.Tenant.ValueString()
+						}
+						virtual_siteDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site"] = virtual_siteDeepMap
 				}
 				if listItem.VirtualSiteWithVIP != nil {
@@ -31818,10 +37577,49 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if !listItem.VirtualSiteWithVIP.Network.IsNull() && !listItem.VirtualSiteWithVIP.Network.IsUnknown() {
 						virtual_site_with_vipDeepMap["network"] = listItem.VirtualSiteWithVIP.Network.ValueString()
 					}
+					if listItem.VirtualSiteWithVIP.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Name.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSiteWithVIP.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Namespace.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSiteWithVIP.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Tenant.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSiteWithVIP.VirtualSite.Tenant.ValueString()
+						}
+						virtual_site_with_vipDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site_with_vip"] = virtual_site_with_vipDeepMap
 				}
 				if listItem.Vk8sService != nil {
 					vk8s_serviceDeepMap := make(map[string]interface{})
+					if listItem.Vk8sService.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Vk8sService.Site.Name.IsNull() && !listItem.Vk8sService.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Vk8sService.Site.Name.ValueString()
+						}
+						if !listItem.Vk8sService.Site.Namespace.IsNull() && !listItem.Vk8sService.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Vk8sService.Site.Namespace.ValueString()
+						}
+						if !listItem.Vk8sService.Site.Tenant.IsNull() && !listItem.Vk8sService.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Vk8sService.Site.Tenant.ValueString()
+						}
+						vk8s_serviceDeepMap["site"] = siteLevel4Map
+					}
+					if listItem.Vk8sService.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.Vk8sService.VirtualSite.Name.IsNull() && !listItem.Vk8sService.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.Vk8sService.VirtualSite.Name.ValueString()
+						}
+						if !listItem.Vk8sService.VirtualSite.Namespace.IsNull() && !listItem.Vk8sService.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.Vk8sService.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.Vk8sService.VirtualSite.Tenant.IsNull() && !listItem.Vk8sService.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.Vk8sService.VirtualSite.Tenant.ValueString()
+						}
+						vk8s_serviceDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["vk8s_service"] = vk8s_serviceDeepMap
 				}
 				advertise_whereList = append(advertise_whereList, listItemMap)
@@ -31888,6 +37686,89 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if listItem.ClientMatcher.AnyIP != nil {
 						client_matcherDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.ClientMatcher.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.AsnList.AsNumbers.IsNull() && !listItem.ClientMatcher.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.ClientMatcher.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						client_matcherDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.ClientMatcher.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						client_matcherDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.ClientSelector.Expressions.IsNull() && !listItem.ClientMatcher.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.ClientMatcher.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						client_matcherDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.ClientMatcher.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsNull() && !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.ClientMatcher.IPMatcher.InvertMatcher.ValueBool()
+						}
+						client_matcherDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsNull() && !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.ClientMatcher.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsNull() && !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.ClientMatcher.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.ClientMatcher.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.ClientMatcher.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						client_matcherDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
 					listItemMap["client_matcher"] = client_matcherDeepMap
 				}
 				if listItem.Metadata != nil {
@@ -31942,6 +37823,89 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if listItem.ClientMatcher.AnyIP != nil {
 						client_matcherDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.ClientMatcher.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.AsnList.AsNumbers.IsNull() && !listItem.ClientMatcher.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.ClientMatcher.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						client_matcherDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.ClientMatcher.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						client_matcherDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.ClientSelector.Expressions.IsNull() && !listItem.ClientMatcher.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.ClientMatcher.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						client_matcherDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.ClientMatcher.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsNull() && !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.ClientMatcher.IPMatcher.InvertMatcher.ValueBool()
+						}
+						client_matcherDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsNull() && !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.ClientMatcher.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsNull() && !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.ClientMatcher.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.ClientMatcher.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.ClientMatcher.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						client_matcherDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
 					listItemMap["client_matcher"] = client_matcherDeepMap
 				}
 				if listItem.Metadata != nil {
@@ -31994,10 +37958,106 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if listItem.ClientMatcher.AnyIP != nil {
 						client_matcherDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.ClientMatcher.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.AsnList.AsNumbers.IsNull() && !listItem.ClientMatcher.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.ClientMatcher.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						client_matcherDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.ClientMatcher.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						client_matcherDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.ClientSelector.Expressions.IsNull() && !listItem.ClientMatcher.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.ClientMatcher.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						client_matcherDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.ClientMatcher.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsNull() && !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.ClientMatcher.IPMatcher.InvertMatcher.ValueBool()
+						}
+						client_matcherDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsNull() && !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.ClientMatcher.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsNull() && !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.ClientMatcher.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.ClientMatcher.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.ClientMatcher.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						client_matcherDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
 					listItemMap["client_matcher"] = client_matcherDeepMap
 				}
 				if listItem.InlineRateLimiter != nil {
 					inline_rate_limiterDeepMap := make(map[string]interface{})
+					if listItem.InlineRateLimiter.RefUserID != nil {
+						ref_user_idLevel4Map := make(map[string]interface{})
+						if !listItem.InlineRateLimiter.RefUserID.Name.IsNull() && !listItem.InlineRateLimiter.RefUserID.Name.IsUnknown() {
+							ref_user_idLevel4Map["name"] = listItem.InlineRateLimiter.RefUserID.Name.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Namespace.IsNull() && !listItem.InlineRateLimiter.RefUserID.Namespace.IsUnknown() {
+							ref_user_idLevel4Map["namespace"] = listItem.InlineRateLimiter.RefUserID.Namespace.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Tenant.IsNull() && !listItem.InlineRateLimiter.RefUserID.Tenant.IsUnknown() {
+							ref_user_idLevel4Map["tenant"] = listItem.InlineRateLimiter.RefUserID.Tenant.ValueString()
+						}
+						inline_rate_limiterDeepMap["ref_user_id"] = ref_user_idLevel4Map
+					}
 					if !listItem.InlineRateLimiter.Threshold.IsNull() && !listItem.InlineRateLimiter.Threshold.IsUnknown() {
 						inline_rate_limiterDeepMap["threshold"] = listItem.InlineRateLimiter.Threshold.ValueInt64()
 					}
@@ -32069,10 +38129,106 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if listItem.ClientMatcher.AnyIP != nil {
 						client_matcherDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.ClientMatcher.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.AsnList.AsNumbers.IsNull() && !listItem.ClientMatcher.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.ClientMatcher.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						client_matcherDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.ClientMatcher.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						client_matcherDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.ClientSelector.Expressions.IsNull() && !listItem.ClientMatcher.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.ClientMatcher.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						client_matcherDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.ClientMatcher.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsNull() && !listItem.ClientMatcher.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.ClientMatcher.IPMatcher.InvertMatcher.ValueBool()
+						}
+						client_matcherDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.ClientMatcher.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsNull() && !listItem.ClientMatcher.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.ClientMatcher.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsNull() && !listItem.ClientMatcher.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.ClientMatcher.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.ClientMatcher.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.ClientMatcher.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						client_matcherDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.ClientMatcher.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.ClientMatcher.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						client_matcherDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
 					listItemMap["client_matcher"] = client_matcherDeepMap
 				}
 				if listItem.InlineRateLimiter != nil {
 					inline_rate_limiterDeepMap := make(map[string]interface{})
+					if listItem.InlineRateLimiter.RefUserID != nil {
+						ref_user_idLevel4Map := make(map[string]interface{})
+						if !listItem.InlineRateLimiter.RefUserID.Name.IsNull() && !listItem.InlineRateLimiter.RefUserID.Name.IsUnknown() {
+							ref_user_idLevel4Map["name"] = listItem.InlineRateLimiter.RefUserID.Name.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Namespace.IsNull() && !listItem.InlineRateLimiter.RefUserID.Namespace.IsUnknown() {
+							ref_user_idLevel4Map["namespace"] = listItem.InlineRateLimiter.RefUserID.Namespace.ValueString()
+						}
+						if !listItem.InlineRateLimiter.RefUserID.Tenant.IsNull() && !listItem.InlineRateLimiter.RefUserID.Tenant.IsUnknown() {
+							ref_user_idLevel4Map["tenant"] = listItem.InlineRateLimiter.RefUserID.Tenant.ValueString()
+						}
+						inline_rate_limiterDeepMap["ref_user_id"] = ref_user_idLevel4Map
+					}
 					if !listItem.InlineRateLimiter.Threshold.IsNull() && !listItem.InlineRateLimiter.Threshold.IsUnknown() {
 						inline_rate_limiterDeepMap["threshold"] = listItem.InlineRateLimiter.Threshold.ValueInt64()
 					}
@@ -32127,10 +38283,130 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.APISpecification.ValidationAllSpecEndpoints != nil {
 			validation_all_spec_endpointsNestedMap := make(map[string]interface{})
+			if data.APISpecification.ValidationAllSpecEndpoints.FallThroughMode != nil {
+				fall_through_modeLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationAllSpecEndpoints.FallThroughMode.FallThroughModeAllow != nil {
+					fall_through_modeLevel3Map["fall_through_mode_allow"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.FallThroughMode.FallThroughModeCustom != nil {
+					fall_through_mode_customLevel4Map := make(map[string]interface{})
+					fall_through_modeLevel3Map["fall_through_mode_custom"] = fall_through_mode_customLevel4Map
+				}
+				validation_all_spec_endpointsNestedMap["fall_through_mode"] = fall_through_modeLevel3Map
+			}
+			if data.APISpecification.ValidationAllSpecEndpoints.Settings != nil {
+				settingsLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.OversizedBodyFailValidation != nil {
+					settingsLevel3Map["oversized_body_fail_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.OversizedBodySkipValidation != nil {
+					settingsLevel3Map["oversized_body_skip_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom != nil {
+					property_validation_settings_customLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom.Queryparameters != nil {
+						query_parametersLevel5Map := make(map[string]interface{})
+						if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom.Queryparameters.AllowAdditionalParameters != nil {
+							query_parametersLevel5Map["allow_additional_parameters"] = map[string]interface{}{}
+						}
+						if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsCustom.Queryparameters.DisallowAdditionalParameters != nil {
+							query_parametersLevel5Map["disallow_additional_parameters"] = map[string]interface{}{}
+						}
+						property_validation_settings_customLevel4Map["queryParameters"] = query_parametersLevel5Map
+					}
+					settingsLevel3Map["property_validation_settings_custom"] = property_validation_settings_customLevel4Map
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.Settings.PropertyValidationSettingsDefault != nil {
+					settingsLevel3Map["property_validation_settings_default"] = map[string]interface{}{}
+				}
+				validation_all_spec_endpointsNestedMap["settings"] = settingsLevel3Map
+			}
+			if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode != nil {
+				validation_modeLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive != nil {
+					response_validation_mode_activeLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.EnforcementBlock != nil {
+						response_validation_mode_activeLevel4Map["enforcement_block"] = map[string]interface{}{}
+					}
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.EnforcementReport != nil {
+						response_validation_mode_activeLevel4Map["enforcement_report"] = map[string]interface{}{}
+					}
+					if !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.ResponseValidationProperties.IsNull() && !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.ResponseValidationProperties.IsUnknown() {
+						var response_validation_propertiesLevel4Items []string
+						diags := data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ResponseValidationModeActive.ResponseValidationProperties.ElementsAs(ctx, &response_validation_propertiesLevel4Items, false)
+						if !diags.HasError() {
+							response_validation_mode_activeLevel4Map["response_validation_properties"] = response_validation_propertiesLevel4Items
+						}
+					}
+					validation_modeLevel3Map["response_validation_mode_active"] = response_validation_mode_activeLevel4Map
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.SkipResponseValidation != nil {
+					validation_modeLevel3Map["skip_response_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.SkipValidation != nil {
+					validation_modeLevel3Map["skip_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive != nil {
+					validation_mode_activeLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.EnforcementBlock != nil {
+						validation_mode_activeLevel4Map["enforcement_block"] = map[string]interface{}{}
+					}
+					if data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.EnforcementReport != nil {
+						validation_mode_activeLevel4Map["enforcement_report"] = map[string]interface{}{}
+					}
+					if !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.RequestValidationProperties.IsNull() && !data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.RequestValidationProperties.IsUnknown() {
+						var request_validation_propertiesLevel4Items []string
+						diags := data.APISpecification.ValidationAllSpecEndpoints.ValidationMode.ValidationModeActive.RequestValidationProperties.ElementsAs(ctx, &request_validation_propertiesLevel4Items, false)
+						if !diags.HasError() {
+							validation_mode_activeLevel4Map["request_validation_properties"] = request_validation_propertiesLevel4Items
+						}
+					}
+					validation_modeLevel3Map["validation_mode_active"] = validation_mode_activeLevel4Map
+				}
+				validation_all_spec_endpointsNestedMap["validation_mode"] = validation_modeLevel3Map
+			}
 			api_specificationMap["validation_all_spec_endpoints"] = validation_all_spec_endpointsNestedMap
 		}
 		if data.APISpecification.ValidationCustomList != nil {
 			validation_custom_listNestedMap := make(map[string]interface{})
+			if data.APISpecification.ValidationCustomList.FallThroughMode != nil {
+				fall_through_modeLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationCustomList.FallThroughMode.FallThroughModeAllow != nil {
+					fall_through_modeLevel3Map["fall_through_mode_allow"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationCustomList.FallThroughMode.FallThroughModeCustom != nil {
+					fall_through_mode_customLevel4Map := make(map[string]interface{})
+					fall_through_modeLevel3Map["fall_through_mode_custom"] = fall_through_mode_customLevel4Map
+				}
+				validation_custom_listNestedMap["fall_through_mode"] = fall_through_modeLevel3Map
+			}
+			if data.APISpecification.ValidationCustomList.Settings != nil {
+				settingsLevel3Map := make(map[string]interface{})
+				if data.APISpecification.ValidationCustomList.Settings.OversizedBodyFailValidation != nil {
+					settingsLevel3Map["oversized_body_fail_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationCustomList.Settings.OversizedBodySkipValidation != nil {
+					settingsLevel3Map["oversized_body_skip_validation"] = map[string]interface{}{}
+				}
+				if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom != nil {
+					property_validation_settings_customLevel4Map := make(map[string]interface{})
+					if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom.Queryparameters != nil {
+						query_parametersLevel5Map := make(map[string]interface{})
+						if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom.Queryparameters.AllowAdditionalParameters != nil {
+							query_parametersLevel5Map["allow_additional_parameters"] = map[string]interface{}{}
+						}
+						if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsCustom.Queryparameters.DisallowAdditionalParameters != nil {
+							query_parametersLevel5Map["disallow_additional_parameters"] = map[string]interface{}{}
+						}
+						property_validation_settings_customLevel4Map["queryParameters"] = query_parametersLevel5Map
+					}
+					settingsLevel3Map["property_validation_settings_custom"] = property_validation_settings_customLevel4Map
+				}
+				if data.APISpecification.ValidationCustomList.Settings.PropertyValidationSettingsDefault != nil {
+					settingsLevel3Map["property_validation_settings_default"] = map[string]interface{}{}
+				}
+				validation_custom_listNestedMap["settings"] = settingsLevel3Map
+			}
 			api_specificationMap["validation_custom_list"] = validation_custom_listNestedMap
 		}
 		if data.APISpecification.ValidationDisabled != nil {
@@ -32265,12 +38541,44 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.BotDefense.Policy != nil {
 			policyNestedMap := make(map[string]interface{})
+			if data.BotDefense.Policy.DisableJsInsert != nil {
+				policyNestedMap["disable_js_insert"] = map[string]interface{}{}
+			}
+			if data.BotDefense.Policy.DisableMobileSdk != nil {
+				policyNestedMap["disable_mobile_sdk"] = map[string]interface{}{}
+			}
 			if !data.BotDefense.Policy.JavascriptMode.IsNull() && !data.BotDefense.Policy.JavascriptMode.IsUnknown() {
 				policyNestedMap["javascript_mode"] = data.BotDefense.Policy.JavascriptMode.ValueString()
 			}
 			if !data.BotDefense.Policy.JsDownloadPath.IsNull() && !data.BotDefense.Policy.JsDownloadPath.IsUnknown() {
 				policyNestedMap["js_download_path"] = data.BotDefense.Policy.JsDownloadPath.ValueString()
 			}
+			if data.BotDefense.Policy.JsInsertAllPages != nil {
+				js_insert_all_pagesLevel3Map := make(map[string]interface{})
+				if !data.BotDefense.Policy.JsInsertAllPages.JavascriptLocation.IsNull() && !data.BotDefense.Policy.JsInsertAllPages.JavascriptLocation.IsUnknown() {
+					js_insert_all_pagesLevel3Map["javascript_location"] = data.BotDefense.Policy.JsInsertAllPages.JavascriptLocation.ValueString()
+				}
+				policyNestedMap["js_insert_all_pages"] = js_insert_all_pagesLevel3Map
+			}
+			if data.BotDefense.Policy.JsInsertAllPagesExcept != nil {
+				js_insert_all_pages_exceptLevel3Map := make(map[string]interface{})
+				if !data.BotDefense.Policy.JsInsertAllPagesExcept.JavascriptLocation.IsNull() && !data.BotDefense.Policy.JsInsertAllPagesExcept.JavascriptLocation.IsUnknown() {
+					js_insert_all_pages_exceptLevel3Map["javascript_location"] = data.BotDefense.Policy.JsInsertAllPagesExcept.JavascriptLocation.ValueString()
+				}
+				policyNestedMap["js_insert_all_pages_except"] = js_insert_all_pages_exceptLevel3Map
+			}
+			if data.BotDefense.Policy.JsInsertionRules != nil {
+				js_insertion_rulesLevel3Map := make(map[string]interface{})
+				policyNestedMap["js_insertion_rules"] = js_insertion_rulesLevel3Map
+			}
+			if data.BotDefense.Policy.MobileSdkConfig != nil {
+				mobile_sdk_configLevel3Map := make(map[string]interface{})
+				if data.BotDefense.Policy.MobileSdkConfig.MobileIdentifier != nil {
+					mobile_identifierLevel4Map := make(map[string]interface{})
+					mobile_sdk_configLevel3Map["mobile_identifier"] = mobile_identifierLevel4Map
+				}
+				policyNestedMap["mobile_sdk_config"] = mobile_sdk_configLevel3Map
+			}
 			bot_defenseMap["policy"] = policyNestedMap
 		}
 		if !data.BotDefense.RegionalEndpoint.IsNull() && !data.BotDefense.RegionalEndpoint.IsUnknown() {
@@ -32322,6 +38630,10 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.BotDefenseAdvanced.MobileSdkConfig != nil {
 			mobile_sdk_configNestedMap := make(map[string]interface{})
+			if data.BotDefenseAdvanced.MobileSdkConfig.MobileIdentifier != nil {
+				mobile_identifierLevel3Map := make(map[string]interface{})
+				mobile_sdk_configNestedMap["mobile_identifier"] = mobile_identifierLevel3Map
+			}
 			bot_defense_advancedMap["mobile_sdk_config"] = mobile_sdk_configNestedMap
 		}
 		if data.BotDefenseAdvanced.Web != nil {
@@ -32347,6 +38659,9 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.CachingPolicy.DefaultCacheAction != nil {
 			default_cache_actionNestedMap := make(map[string]interface{})
+			if data.CachingPolicy.DefaultCacheAction.CacheDisabled != nil {
+				default_cache_actionNestedMap["cache_disabled"] = map[string]interface{}{}
+			}
 			if !data.CachingPolicy.DefaultCacheAction.CacheTTLDefault.IsNull() && !data.CachingPolicy.DefaultCacheAction.CacheTTLDefault.IsUnknown() {
 				default_cache_actionNestedMap["cache_ttl_default"] = data.CachingPolicy.DefaultCacheAction.CacheTTLDefault.ValueString()
 			}
@@ -32371,6 +38686,20 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		client_side_defenseMap := make(map[string]interface{})
 		if data.ClientSideDefense.Policy != nil {
 			policyNestedMap := make(map[string]interface{})
+			if data.ClientSideDefense.Policy.DisableJsInsert != nil {
+				policyNestedMap["disable_js_insert"] = map[string]interface{}{}
+			}
+			if data.ClientSideDefense.Policy.JsInsertAllPages != nil {
+				policyNestedMap["js_insert_all_pages"] = map[string]interface{}{}
+			}
+			if data.ClientSideDefense.Policy.JsInsertAllPagesExcept != nil {
+				js_insert_all_pages_exceptLevel3Map := make(map[string]interface{})
+				policyNestedMap["js_insert_all_pages_except"] = js_insert_all_pages_exceptLevel3Map
+			}
+			if data.ClientSideDefense.Policy.JsInsertionRules != nil {
+				js_insertion_rulesLevel3Map := make(map[string]interface{})
+				policyNestedMap["js_insertion_rules"] = js_insertion_rulesLevel3Map
+			}
 			client_side_defenseMap["policy"] = policyNestedMap
 		}
 		apiResource.Spec["client_side_defense"] = client_side_defenseMap
@@ -32611,15 +38940,130 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		default_poolMap := make(map[string]interface{})
 		if data.DefaultPool.AdvancedOptions != nil {
 			advanced_optionsNestedMap := make(map[string]interface{})
+			if data.DefaultPool.AdvancedOptions.AutoHTTPConfig != nil {
+				advanced_optionsNestedMap["auto_http_config"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.CircuitBreaker != nil {
+				circuit_breakerLevel3Map := make(map[string]interface{})
+				if !data.DefaultPool.AdvancedOptions.CircuitBreaker.ConnectionLimit.IsNull() && !data.DefaultPool.AdvancedOptions.CircuitBreaker.ConnectionLimit.IsUnknown() {
+					circuit_breakerLevel3Map["connection_limit"] = data.DefaultPool.AdvancedOptions.CircuitBreaker.ConnectionLimit.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.CircuitBreaker.MaxRequests.IsNull() && !data.DefaultPool.AdvancedOptions.CircuitBreaker.MaxRequests.IsUnknown() {
+					circuit_breakerLevel3Map["max_requests"] = data.DefaultPool.AdvancedOptions.CircuitBreaker.MaxRequests.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.CircuitBreaker.PendingRequests.IsNull() && !data.DefaultPool.AdvancedOptions.CircuitBreaker.PendingRequests.IsUnknown() {
+					circuit_breakerLevel3Map["pending_requests"] = data.DefaultPool.AdvancedOptions.CircuitBreaker.PendingRequests.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.CircuitBreaker.Priority.IsNull() && !data.DefaultPool.AdvancedOptions.CircuitBreaker.Priority.IsUnknown() {
+					circuit_breakerLevel3Map["priority"] = data.DefaultPool.AdvancedOptions.CircuitBreaker.Priority.ValueString()
+				}
+				if !data.DefaultPool.AdvancedOptions.CircuitBreaker.Retries.IsNull() && !data.DefaultPool.AdvancedOptions.CircuitBreaker.Retries.IsUnknown() {
+					circuit_breakerLevel3Map["retries"] = data.DefaultPool.AdvancedOptions.CircuitBreaker.Retries.ValueInt64()
+				}
+				advanced_optionsNestedMap["circuit_breaker"] = circuit_breakerLevel3Map
+			}
 			if !data.DefaultPool.AdvancedOptions.ConnectionTimeout.IsNull() && !data.DefaultPool.AdvancedOptions.ConnectionTimeout.IsUnknown() {
 				advanced_optionsNestedMap["connection_timeout"] = data.DefaultPool.AdvancedOptions.ConnectionTimeout.ValueInt64()
 			}
+			if data.DefaultPool.AdvancedOptions.DefaultCircuitBreaker != nil {
+				advanced_optionsNestedMap["default_circuit_breaker"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.DisableCircuitBreaker != nil {
+				advanced_optionsNestedMap["disable_circuit_breaker"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.DisableLBSourceIPPersistance != nil {
+				advanced_optionsNestedMap["disable_lb_source_ip_persistance"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.DisableOutlierDetection != nil {
+				advanced_optionsNestedMap["disable_outlier_detection"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.DisableProxyProtocol != nil {
+				advanced_optionsNestedMap["disable_proxy_protocol"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.DisableSubsets != nil {
+				advanced_optionsNestedMap["disable_subsets"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.EnableLBSourceIPPersistance != nil {
+				advanced_optionsNestedMap["enable_lb_source_ip_persistance"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.EnableSubsets != nil {
+				enable_subsetsLevel3Map := make(map[string]interface{})
+				if data.DefaultPool.AdvancedOptions.EnableSubsets.AnyEndpoint != nil {
+					enable_subsetsLevel3Map["any_endpoint"] = map[string]interface{}{}
+				}
+				if data.DefaultPool.AdvancedOptions.EnableSubsets.DefaultSubset != nil {
+					default_subsetLevel4Map := make(map[string]interface{})
+					if data.DefaultPool.AdvancedOptions.EnableSubsets.DefaultSubset.DefaultSubset != nil {
+						default_subsetLevel4Map["default_subset"] = map[string]interface{}{}
+					}
+					enable_subsetsLevel3Map["default_subset"] = default_subsetLevel4Map
+				}
+				if data.DefaultPool.AdvancedOptions.EnableSubsets.FailRequest != nil {
+					enable_subsetsLevel3Map["fail_request"] = map[string]interface{}{}
+				}
+				advanced_optionsNestedMap["enable_subsets"] = enable_subsetsLevel3Map
+			}
+			if data.DefaultPool.AdvancedOptions.Http1Config != nil {
+				http1_configLevel3Map := make(map[string]interface{})
+				if data.DefaultPool.AdvancedOptions.Http1Config.HeaderTransformation != nil {
+					header_transformationLevel4Map := make(map[string]interface{})
+					if data.DefaultPool.AdvancedOptions.Http1Config.HeaderTransformation.DefaultHeaderTransformation != nil {
+						header_transformationLevel4Map["default_header_transformation"] = map[string]interface{}{}
+					}
+					if data.DefaultPool.AdvancedOptions.Http1Config.HeaderTransformation.LegacyHeaderTransformation != nil {
+						header_transformationLevel4Map["legacy_header_transformation"] = map[string]interface{}{}
+					}
+					if data.DefaultPool.AdvancedOptions.Http1Config.HeaderTransformation.PreserveCaseHeaderTransformation != nil {
+						header_transformationLevel4Map["preserve_case_header_transformation"] = map[string]interface{}{}
+					}
+					if data.DefaultPool.AdvancedOptions.Http1Config.HeaderTransformation.ProperCaseHeaderTransformation != nil {
+						header_transformationLevel4Map["proper_case_header_transformation"] = map[string]interface{}{}
+					}
+					http1_configLevel3Map["header_transformation"] = header_transformationLevel4Map
+				}
+				advanced_optionsNestedMap["http1_config"] = http1_configLevel3Map
+			}
+			if data.DefaultPool.AdvancedOptions.Http2Options != nil {
+				http2_optionsLevel3Map := make(map[string]interface{})
+				if !data.DefaultPool.AdvancedOptions.Http2Options.Enabled.IsNull() && !data.DefaultPool.AdvancedOptions.Http2Options.Enabled.IsUnknown() {
+					http2_optionsLevel3Map["enabled"] = data.DefaultPool.AdvancedOptions.Http2Options.Enabled.ValueBool()
+				}
+				advanced_optionsNestedMap["http2_options"] = http2_optionsLevel3Map
+			}
 			if !data.DefaultPool.AdvancedOptions.HTTPIdleTimeout.IsNull() && !data.DefaultPool.AdvancedOptions.HTTPIdleTimeout.IsUnknown() {
 				advanced_optionsNestedMap["http_idle_timeout"] = data.DefaultPool.AdvancedOptions.HTTPIdleTimeout.ValueInt64()
 			}
+			if data.DefaultPool.AdvancedOptions.NoPanicThreshold != nil {
+				advanced_optionsNestedMap["no_panic_threshold"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.OutlierDetection != nil {
+				outlier_detectionLevel3Map := make(map[string]interface{})
+				if !data.DefaultPool.AdvancedOptions.OutlierDetection.BaseEjectionTime.IsNull() && !data.DefaultPool.AdvancedOptions.OutlierDetection.BaseEjectionTime.IsUnknown() {
+					outlier_detectionLevel3Map["base_ejection_time"] = data.DefaultPool.AdvancedOptions.OutlierDetection.BaseEjectionTime.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.OutlierDetection.Consecutive5xx.IsNull() && !data.DefaultPool.AdvancedOptions.OutlierDetection.Consecutive5xx.IsUnknown() {
+					outlier_detectionLevel3Map["consecutive_5xx"] = data.DefaultPool.AdvancedOptions.OutlierDetection.Consecutive5xx.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.OutlierDetection.ConsecutiveGatewayFailure.IsNull() && !data.DefaultPool.AdvancedOptions.OutlierDetection.ConsecutiveGatewayFailure.IsUnknown() {
+					outlier_detectionLevel3Map["consecutive_gateway_failure"] = data.DefaultPool.AdvancedOptions.OutlierDetection.ConsecutiveGatewayFailure.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.OutlierDetection.Interval.IsNull() && !data.DefaultPool.AdvancedOptions.OutlierDetection.Interval.IsUnknown() {
+					outlier_detectionLevel3Map["interval"] = data.DefaultPool.AdvancedOptions.OutlierDetection.Interval.ValueInt64()
+				}
+				if !data.DefaultPool.AdvancedOptions.OutlierDetection.MaxEjectionPercent.IsNull() && !data.DefaultPool.AdvancedOptions.OutlierDetection.MaxEjectionPercent.IsUnknown() {
+					outlier_detectionLevel3Map["max_ejection_percent"] = data.DefaultPool.AdvancedOptions.OutlierDetection.MaxEjectionPercent.ValueInt64()
+				}
+				advanced_optionsNestedMap["outlier_detection"] = outlier_detectionLevel3Map
+			}
 			if !data.DefaultPool.AdvancedOptions.PanicThreshold.IsNull() && !data.DefaultPool.AdvancedOptions.PanicThreshold.IsUnknown() {
 				advanced_optionsNestedMap["panic_threshold"] = data.DefaultPool.AdvancedOptions.PanicThreshold.ValueInt64()
 			}
+			if data.DefaultPool.AdvancedOptions.ProxyProtocolV1 != nil {
+				advanced_optionsNestedMap["proxy_protocol_v1"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.AdvancedOptions.ProxyProtocolV2 != nil {
+				advanced_optionsNestedMap["proxy_protocol_v2"] = map[string]interface{}{}
+			}
 			default_poolMap["advanced_options"] = advanced_optionsNestedMap
 		}
 		if data.DefaultPool.AutomaticPort != nil {
@@ -32679,10 +39123,71 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if !listItem.ConsulService.ServiceName.IsNull() && !listItem.ConsulService.ServiceName.IsUnknown() {
 						consul_serviceDeepMap["service_name"] = listItem.ConsulService.ServiceName.ValueString()
 					}
+					if listItem.ConsulService.SiteLocator != nil {
+						site_locatorLevel4Map := make(map[string]interface{})
+						if listItem.ConsulService.SiteLocator.Site != nil {
+							siteLevel5Map := make(map[string]interface{})
+							if !listItem.ConsulService.SiteLocator.Site.Name.IsNull() && !listItem.ConsulService.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel5Map["name"] = listItem.ConsulService.SiteLocator.Site.Name.ValueString()
+							}
+							if !listItem.ConsulService.SiteLocator.Site.Namespace.IsNull() && !listItem.ConsulService.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel5Map["namespace"] = listItem.ConsulService.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !listItem.ConsulService.SiteLocator.Site.Tenant.IsNull() && !listItem.ConsulService.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel5Map["tenant"] = listItem.ConsulService.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["site"] = siteLevel5Map
+						}
+						if listItem.ConsulService.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel5Map := make(map[string]interface{})
+							if !listItem.ConsulService.SiteLocator.VirtualSite.Name.IsNull() && !listItem.ConsulService.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel5Map["name"] = listItem.ConsulService.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !listItem.ConsulService.SiteLocator.VirtualSite.Namespace.IsNull() && !listItem.ConsulService.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel5Map["namespace"] = listItem.ConsulService.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !listItem.ConsulService.SiteLocator.VirtualSite.Tenant.IsNull() && !listItem.ConsulService.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel5Map["tenant"] = listItem.ConsulService.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["virtual_site"] = virtual_siteLevel5Map
+						}
+						consul_serviceDeepMap["site_locator"] = site_locatorLevel4Map
+					}
+					if listItem.ConsulService.SnatPool != nil {
+						snat_poolLevel4Map := make(map[string]interface{})
+						if listItem.ConsulService.SnatPool.NoSnatPool != nil {
+							snat_poolLevel4Map["no_snat_pool"] = map[string]interface{}{}
+						}
+						if listItem.ConsulService.SnatPool.SnatPool != nil {
+							snat_poolLevel5Map := make(map[string]interface{})
+							if !listItem.ConsulService.SnatPool.SnatPool.Prefixes.IsNull() && !listItem.ConsulService.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel5Items []string
+								diags := listItem.ConsulService.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel5Items, false)
+								if !diags.HasError() {
+									snat_poolLevel5Map["prefixes"] = prefixesLevel5Items
+								}
+							}
+							snat_poolLevel4Map["snat_pool"] = snat_poolLevel5Map
+						}
+						consul_serviceDeepMap["snat_pool"] = snat_poolLevel4Map
+					}
 					listItemMap["consul_service"] = consul_serviceDeepMap
 				}
 				if listItem.CustomEndpointObject != nil {
 					custom_endpoint_objectDeepMap := make(map[string]interface{})
+					if listItem.CustomEndpointObject.Endpoint != nil {
+						endpointLevel4Map := make(map[string]interface{})
+						if !listItem.CustomEndpointObject.Endpoint.Name.IsNull() && !listItem.CustomEndpointObject.Endpoint.Name.IsUnknown() {
+							endpointLevel4Map["name"] = listItem.CustomEndpointObject.Endpoint.Name.ValueString()
+						}
+						if !listItem.CustomEndpointObject.Endpoint.Namespace.IsNull() && !listItem.CustomEndpointObject.Endpoint.Namespace.IsUnknown() {
+							endpointLevel4Map["namespace"] = listItem.CustomEndpointObject.Endpoint.Namespace.ValueString()
+						}
+						if !listItem.CustomEndpointObject.Endpoint.Tenant.IsNull() && !listItem.CustomEndpointObject.Endpoint.Tenant.IsUnknown() {
+							endpointLevel4Map["tenant"] = listItem.CustomEndpointObject.Endpoint.Tenant.ValueString()
+						}
+						custom_endpoint_objectDeepMap["endpoint"] = endpointLevel4Map
+					}
 					listItemMap["custom_endpoint_object"] = custom_endpoint_objectDeepMap
 				}
 				if listItem.K8SService != nil {
@@ -32699,6 +39204,54 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if !listItem.K8SService.ServiceName.IsNull() && !listItem.K8SService.ServiceName.IsUnknown() {
 						k8s_serviceDeepMap["service_name"] = listItem.K8SService.ServiceName.ValueString()
 					}
+					if listItem.K8SService.SiteLocator != nil {
+						site_locatorLevel4Map := make(map[string]interface{})
+						if listItem.K8SService.SiteLocator.Site != nil {
+							siteLevel5Map := make(map[string]interface{})
+							if !listItem.K8SService.SiteLocator.Site.Name.IsNull() && !listItem.K8SService.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel5Map["name"] = listItem.K8SService.SiteLocator.Site.Name.ValueString()
+							}
+							if !listItem.K8SService.SiteLocator.Site.Namespace.IsNull() && !listItem.K8SService.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel5Map["namespace"] = listItem.K8SService.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !listItem.K8SService.SiteLocator.Site.Tenant.IsNull() && !listItem.K8SService.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel5Map["tenant"] = listItem.K8SService.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["site"] = siteLevel5Map
+						}
+						if listItem.K8SService.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel5Map := make(map[string]interface{})
+							if !listItem.K8SService.SiteLocator.VirtualSite.Name.IsNull() && !listItem.K8SService.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel5Map["name"] = listItem.K8SService.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !listItem.K8SService.SiteLocator.VirtualSite.Namespace.IsNull() && !listItem.K8SService.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel5Map["namespace"] = listItem.K8SService.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !listItem.K8SService.SiteLocator.VirtualSite.Tenant.IsNull() && !listItem.K8SService.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel5Map["tenant"] = listItem.K8SService.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["virtual_site"] = virtual_siteLevel5Map
+						}
+						k8s_serviceDeepMap["site_locator"] = site_locatorLevel4Map
+					}
+					if listItem.K8SService.SnatPool != nil {
+						snat_poolLevel4Map := make(map[string]interface{})
+						if listItem.K8SService.SnatPool.NoSnatPool != nil {
+							snat_poolLevel4Map["no_snat_pool"] = map[string]interface{}{}
+						}
+						if listItem.K8SService.SnatPool.SnatPool != nil {
+							snat_poolLevel5Map := make(map[string]interface{})
+							if !listItem.K8SService.SnatPool.SnatPool.Prefixes.IsNull() && !listItem.K8SService.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel5Items []string
+								diags := listItem.K8SService.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel5Items, false)
+								if !diags.HasError() {
+									snat_poolLevel5Map["prefixes"] = prefixesLevel5Items
+								}
+							}
+							snat_poolLevel4Map["snat_pool"] = snat_poolLevel5Map
+						}
+						k8s_serviceDeepMap["snat_pool"] = snat_poolLevel4Map
+					}
 					if listItem.K8SService.Vk8sNetworks != nil {
 						k8s_serviceDeepMap["vk8s_networks"] = map[string]interface{}{}
 					}
@@ -32718,6 +39271,67 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if listItem.PrivateIP.OutsideNetwork != nil {
 						private_ipDeepMap["outside_network"] = map[string]interface{}{}
 					}
+					if listItem.PrivateIP.Segment != nil {
+						segmentLevel4Map := make(map[string]interface{})
+						if !listItem.PrivateIP.Segment.Name.IsNull() && !listItem.PrivateIP.Segment.Name.IsUnknown() {
+							segmentLevel4Map["name"] = listItem.PrivateIP.Segment.Name.ValueString()
+						}
+						if !listItem.PrivateIP.Segment.Namespace.IsNull() && !listItem.PrivateIP.Segment.Namespace.IsUnknown() {
+							segmentLevel4Map["namespace"] = listItem.PrivateIP.Segment.Namespace.ValueString()
+						}
+						if !listItem.PrivateIP.Segment.Tenant.IsNull() && !listItem.PrivateIP.Segment.Tenant.IsUnknown() {
+							segmentLevel4Map["tenant"] = listItem.PrivateIP.Segment.Tenant.ValueString()
+						}
+						private_ipDeepMap["segment"] = segmentLevel4Map
+					}
+					if listItem.PrivateIP.SiteLocator != nil {
+						site_locatorLevel4Map := make(map[string]interface{})
+						if listItem.PrivateIP.SiteLocator.Site != nil {
+							siteLevel5Map := make(map[string]interface{})
+							if !listItem.PrivateIP.SiteLocator.Site.Name.IsNull() && !listItem.PrivateIP.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel5Map["name"] = listItem.PrivateIP.SiteLocator.Site.Name.ValueString()
+							}
+							if !listItem.PrivateIP.SiteLocator.Site.Namespace.IsNull() && !listItem.PrivateIP.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel5Map["namespace"] = listItem.PrivateIP.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !listItem.PrivateIP.SiteLocator.Site.Tenant.IsNull() && !listItem.PrivateIP.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel5Map["tenant"] = listItem.PrivateIP.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["site"] = siteLevel5Map
+						}
+						if listItem.PrivateIP.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel5Map := make(map[string]interface{})
+							if !listItem.PrivateIP.SiteLocator.VirtualSite.Name.IsNull() && !listItem.PrivateIP.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel5Map["name"] = listItem.PrivateIP.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !listItem.PrivateIP.SiteLocator.VirtualSite.Namespace.IsNull() && !listItem.PrivateIP.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel5Map["namespace"] = listItem.PrivateIP.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !listItem.PrivateIP.SiteLocator.VirtualSite.Tenant.IsNull() && !listItem.PrivateIP.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel5Map["tenant"] = listItem.PrivateIP.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["virtual_site"] = virtual_siteLevel5Map
+						}
+						private_ipDeepMap["site_locator"] = site_locatorLevel4Map
+					}
+					if listItem.PrivateIP.SnatPool != nil {
+						snat_poolLevel4Map := make(map[string]interface{})
+						if listItem.PrivateIP.SnatPool.NoSnatPool != nil {
+							snat_poolLevel4Map["no_snat_pool"] = map[string]interface{}{}
+						}
+						if listItem.PrivateIP.SnatPool.SnatPool != nil {
+							snat_poolLevel5Map := make(map[string]interface{})
+							if !listItem.PrivateIP.SnatPool.SnatPool.Prefixes.IsNull() && !listItem.PrivateIP.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel5Items []string
+								diags := listItem.PrivateIP.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel5Items, false)
+								if !diags.HasError() {
+									snat_poolLevel5Map["prefixes"] = prefixesLevel5Items
+								}
+							}
+							snat_poolLevel4Map["snat_pool"] = snat_poolLevel5Map
+						}
+						private_ipDeepMap["snat_pool"] = snat_poolLevel4Map
+					}
 					listItemMap["private_ip"] = private_ipDeepMap
 				}
 				if listItem.PrivateName != nil {
@@ -32734,6 +39348,67 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if !listItem.PrivateName.RefreshInterval.IsNull() && !listItem.PrivateName.RefreshInterval.IsUnknown() {
 						private_nameDeepMap["refresh_interval"] = listItem.PrivateName.RefreshInterval.ValueInt64()
 					}
+					if listItem.PrivateName.Segment != nil {
+						segmentLevel4Map := make(map[string]interface{})
+						if !listItem.PrivateName.Segment.Name.IsNull() && !listItem.PrivateName.Segment.Name.IsUnknown() {
+							segmentLevel4Map["name"] = listItem.PrivateName.Segment.Name.ValueString()
+						}
+						if !listItem.PrivateName.Segment.Namespace.IsNull() && !listItem.PrivateName.Segment.Namespace.IsUnknown() {
+							segmentLevel4Map["namespace"] = listItem.PrivateName.Segment.Namespace.ValueString()
+						}
+						if !listItem.PrivateName.Segment.Tenant.IsNull() && !listItem.PrivateName.Segment.Tenant.IsUnknown() {
+							segmentLevel4Map["tenant"] = listItem.PrivateName.Segment.Tenant.ValueString()
+						}
+						private_nameDeepMap["segment"] = segmentLevel4Map
+					}
+					if listItem.PrivateName.SiteLocator != nil {
+						site_locatorLevel4Map := make(map[string]interface{})
+						if listItem.PrivateName.SiteLocator.Site != nil {
+							siteLevel5Map := make(map[string]interface{})
+							if !listItem.PrivateName.SiteLocator.Site.Name.IsNull() && !listItem.PrivateName.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel5Map["name"] = listItem.PrivateName.SiteLocator.Site.Name.ValueString()
+							}
+							if !listItem.PrivateName.SiteLocator.Site.Namespace.IsNull() && !listItem.PrivateName.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel5Map["namespace"] = listItem.PrivateName.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !listItem.PrivateName.SiteLocator.Site.Tenant.IsNull() && !listItem.PrivateName.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel5Map["tenant"] = listItem.PrivateName.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["site"] = siteLevel5Map
+						}
+						if listItem.PrivateName.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel5Map := make(map[string]interface{})
+							if !listItem.PrivateName.SiteLocator.VirtualSite.Name.IsNull() && !listItem.PrivateName.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel5Map["name"] = listItem.PrivateName.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !listItem.PrivateName.SiteLocator.VirtualSite.Namespace.IsNull() && !listItem.PrivateName.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel5Map["namespace"] = listItem.PrivateName.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !listItem.PrivateName.SiteLocator.VirtualSite.Tenant.IsNull() && !listItem.PrivateName.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel5Map["tenant"] = listItem.PrivateName.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorLevel4Map["virtual_site"] = virtual_siteLevel5Map
+						}
+						private_nameDeepMap["site_locator"] = site_locatorLevel4Map
+					}
+					if listItem.PrivateName.SnatPool != nil {
+						snat_poolLevel4Map := make(map[string]interface{})
+						if listItem.PrivateName.SnatPool.NoSnatPool != nil {
+							snat_poolLevel4Map["no_snat_pool"] = map[string]interface{}{}
+						}
+						if listItem.PrivateName.SnatPool.SnatPool != nil {
+							snat_poolLevel5Map := make(map[string]interface{})
+							if !listItem.PrivateName.SnatPool.SnatPool.Prefixes.IsNull() && !listItem.PrivateName.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel5Items []string
+								diags := listItem.PrivateName.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel5Items, false)
+								if !diags.HasError() {
+									snat_poolLevel5Map["prefixes"] = prefixesLevel5Items
+								}
+							}
+							snat_poolLevel4Map["snat_pool"] = snat_poolLevel5Map
+						}
+						private_nameDeepMap["snat_pool"] = snat_poolLevel4Map
+					}
 					listItemMap["private_name"] = private_nameDeepMap
 				}
 				if listItem.PublicIP != nil {
@@ -32758,6 +39433,19 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if !listItem.VnPrivateIP.IP.IsNull() && !listItem.VnPrivateIP.IP.IsUnknown() {
 						vn_private_ipDeepMap["ip"] = listItem.VnPrivateIP.IP.ValueString()
 					}
+					if listItem.VnPrivateIP.VirtualNetwork != nil {
+						virtual_networkLevel4Map := make(map[string]interface{})
+						if !listItem.VnPrivateIP.VirtualNetwork.Name.IsNull() && !listItem.VnPrivateIP.VirtualNetwork.Name.IsUnknown() {
+							virtual_networkLevel4Map["name"] = listItem.VnPrivateIP.VirtualNetwork.Name.ValueString()
+						}
+						if !listItem.VnPrivateIP.VirtualNetwork.Namespace.IsNull() && !listItem.VnPrivateIP.VirtualNetwork.Namespace.IsUnknown() {
+							virtual_networkLevel4Map["namespace"] = listItem.VnPrivateIP.VirtualNetwork.Namespace.ValueString()
+						}
+						if !listItem.VnPrivateIP.VirtualNetwork.Tenant.IsNull() && !listItem.VnPrivateIP.VirtualNetwork.Tenant.IsUnknown() {
+							virtual_networkLevel4Map["tenant"] = listItem.VnPrivateIP.VirtualNetwork.Tenant.ValueString()
+						}
+						vn_private_ipDeepMap["virtual_network"] = virtual_networkLevel4Map
+					}
 					listItemMap["vn_private_ip"] = vn_private_ipDeepMap
 				}
 				if listItem.VnPrivateName != nil {
@@ -32765,6 +39453,19 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if !listItem.VnPrivateName.DNSName.IsNull() && !listItem.VnPrivateName.DNSName.IsUnknown() {
 						vn_private_nameDeepMap["dns_name"] = listItem.VnPrivateName.DNSName.ValueString()
 					}
+					if listItem.VnPrivateName.PrivateNetwork != nil {
+						private_networkLevel4Map := make(map[string]interface{})
+						if !listItem.VnPrivateName.PrivateNetwork.Name.IsNull() && !listItem.VnPrivateName.PrivateNetwork.Name.IsUnknown() {
+							private_networkLevel4Map["name"] = listItem.VnPrivateName.PrivateNetwork.Name.ValueString()
+						}
+						if !listItem.VnPrivateName.PrivateNetwork.Namespace.IsNull() && !listItem.VnPrivateName.PrivateNetwork.Namespace.IsUnknown() {
+							private_networkLevel4Map["namespace"] = listItem.VnPrivateName.PrivateNetwork.Namespace.ValueString()
+						}
+						if !listItem.VnPrivateName.PrivateNetwork.Tenant.IsNull() && !listItem.VnPrivateName.PrivateNetwork.Tenant.IsUnknown() {
+							private_networkLevel4Map["tenant"] = listItem.VnPrivateName.PrivateNetwork.Tenant.ValueString()
+						}
+						vn_private_nameDeepMap["private_network"] = private_networkLevel4Map
+					}
 					listItemMap["vn_private_name"] = vn_private_nameDeepMap
 				}
 				origin_serversList = append(origin_serversList, listItemMap)
@@ -32779,16 +39480,110 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.DefaultPool.UpstreamConnPoolReuseType != nil {
 			upstream_conn_pool_reuse_typeNestedMap := make(map[string]interface{})
+			if data.DefaultPool.UpstreamConnPoolReuseType.DisableConnPoolReuse != nil {
+				upstream_conn_pool_reuse_typeNestedMap["disable_conn_pool_reuse"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.UpstreamConnPoolReuseType.EnableConnPoolReuse != nil {
+				upstream_conn_pool_reuse_typeNestedMap["enable_conn_pool_reuse"] = map[string]interface{}{}
+			}
 			default_poolMap["upstream_conn_pool_reuse_type"] = upstream_conn_pool_reuse_typeNestedMap
 		}
 		if data.DefaultPool.UseTLS != nil {
 			use_tlsNestedMap := make(map[string]interface{})
+			if data.DefaultPool.UseTLS.DefaultSessionKeyCaching != nil {
+				use_tlsNestedMap["default_session_key_caching"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.UseTLS.DisableSessionKeyCaching != nil {
+				use_tlsNestedMap["disable_session_key_caching"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.UseTLS.DisableSni != nil {
+				use_tlsNestedMap["disable_sni"] = map[string]interface{}{}
+			}
 			if !data.DefaultPool.UseTLS.MaxSessionKeys.IsNull() && !data.DefaultPool.UseTLS.MaxSessionKeys.IsUnknown() {
 				use_tlsNestedMap["max_session_keys"] = data.DefaultPool.UseTLS.MaxSessionKeys.ValueInt64()
 			}
+			if data.DefaultPool.UseTLS.NoMtls != nil {
+				use_tlsNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.UseTLS.SkipServerVerification != nil {
+				use_tlsNestedMap["skip_server_verification"] = map[string]interface{}{}
+			}
 			if !data.DefaultPool.UseTLS.Sni.IsNull() && !data.DefaultPool.UseTLS.Sni.IsUnknown() {
 				use_tlsNestedMap["sni"] = data.DefaultPool.UseTLS.Sni.ValueString()
 			}
+			if data.DefaultPool.UseTLS.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.DefaultPool.UseTLS.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.DefaultPool.UseTLS.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.DefaultPool.UseTLS.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.DefaultPool.UseTLS.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.DefaultPool.UseTLS.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				use_tlsNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.DefaultPool.UseTLS.UseHostHeaderAsSni != nil {
+				use_tlsNestedMap["use_host_header_as_sni"] = map[string]interface{}{}
+			}
+			if data.DefaultPool.UseTLS.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				use_tlsNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
+			if data.DefaultPool.UseTLS.UseMtlsObj != nil {
+				use_mtls_objLevel3Map := make(map[string]interface{})
+				if !data.DefaultPool.UseTLS.UseMtlsObj.Name.IsNull() && !data.DefaultPool.UseTLS.UseMtlsObj.Name.IsUnknown() {
+					use_mtls_objLevel3Map["name"] = data.DefaultPool.UseTLS.UseMtlsObj.Name.ValueString()
+				}
+				if !data.DefaultPool.UseTLS.UseMtlsObj.Namespace.IsNull() && !data.DefaultPool.UseTLS.UseMtlsObj.Namespace.IsUnknown() {
+					use_mtls_objLevel3Map["namespace"] = data.DefaultPool.UseTLS.UseMtlsObj.Namespace.ValueString()
+				}
+				if !data.DefaultPool.UseTLS.UseMtlsObj.Tenant.IsNull() && !data.DefaultPool.UseTLS.UseMtlsObj.Tenant.IsUnknown() {
+					use_mtls_objLevel3Map["tenant"] = data.DefaultPool.UseTLS.UseMtlsObj.Tenant.ValueString()
+				}
+				use_tlsNestedMap["use_mtls_obj"] = use_mtls_objLevel3Map
+			}
+			if data.DefaultPool.UseTLS.UseServerVerification != nil {
+				use_server_verificationLevel3Map := make(map[string]interface{})
+				if data.DefaultPool.UseTLS.UseServerVerification.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Name.IsNull() && !data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Name.ValueString()
+					}
+					if !data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Namespace.IsNull() && !data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Namespace.ValueString()
+					}
+					if !data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Tenant.IsNull() && !data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.DefaultPool.UseTLS.UseServerVerification.TrustedCA.Tenant.ValueString()
+					}
+					use_server_verificationLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.DefaultPool.UseTLS.UseServerVerification.TrustedCAURL.IsNull() && !data.DefaultPool.UseTLS.UseServerVerification.TrustedCAURL.IsUnknown() {
+					use_server_verificationLevel3Map["trusted_ca_url"] = data.DefaultPool.UseTLS.UseServerVerification.TrustedCAURL.ValueString()
+				}
+				use_tlsNestedMap["use_server_verification"] = use_server_verificationLevel3Map
+			}
+			if data.DefaultPool.UseTLS.VolterraTrustedCA != nil {
+				use_tlsNestedMap["volterra_trusted_ca"] = map[string]interface{}{}
+			}
 			default_poolMap["use_tls"] = use_tlsNestedMap
 		}
 		if data.DefaultPool.ViewInternal != nil {
@@ -32972,6 +39767,13 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		enable_api_discoveryMap := make(map[string]interface{})
 		if data.EnableAPIDiscovery.APICrawler != nil {
 			api_crawlerNestedMap := make(map[string]interface{})
+			if data.EnableAPIDiscovery.APICrawler.APICrawlerConfig != nil {
+				api_crawler_configLevel3Map := make(map[string]interface{})
+				api_crawlerNestedMap["api_crawler_config"] = api_crawler_configLevel3Map
+			}
+			if data.EnableAPIDiscovery.APICrawler.DisableAPICrawler != nil {
+				api_crawlerNestedMap["disable_api_crawler"] = map[string]interface{}{}
+			}
 			enable_api_discoveryMap["api_crawler"] = api_crawlerNestedMap
 		}
 		if data.EnableAPIDiscovery.APIDiscoveryFromCodeScan != nil {
@@ -32980,6 +39782,19 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.EnableAPIDiscovery.CustomAPIAuthDiscovery != nil {
 			custom_api_auth_discoveryNestedMap := make(map[string]interface{})
+			if data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef != nil {
+				api_discovery_refLevel3Map := make(map[string]interface{})
+				if !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.IsNull() && !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.IsUnknown() {
+					api_discovery_refLevel3Map["name"] = data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.ValueString()
+				}
+				if !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.IsNull() && !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.IsUnknown() {
+					api_discovery_refLevel3Map["namespace"] = data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.ValueString()
+				}
+				if !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.IsNull() && !data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.IsUnknown() {
+					api_discovery_refLevel3Map["tenant"] = data.EnableAPIDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.ValueString()
+				}
+				custom_api_auth_discoveryNestedMap["api_discovery_ref"] = api_discovery_refLevel3Map
+			}
 			enable_api_discoveryMap["custom_api_auth_discovery"] = custom_api_auth_discoveryNestedMap
 		}
 		if data.EnableAPIDiscovery.DefaultAPIAuthDiscovery != nil {
@@ -33162,6 +39977,12 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.HTTPS.CoalescingOptions != nil {
 			coalescing_optionsNestedMap := make(map[string]interface{})
+			if data.HTTPS.CoalescingOptions.DefaultCoalescing != nil {
+				coalescing_optionsNestedMap["default_coalescing"] = map[string]interface{}{}
+			}
+			if data.HTTPS.CoalescingOptions.StrictCoalescing != nil {
+				coalescing_optionsNestedMap["strict_coalescing"] = map[string]interface{}{}
+			}
 			httpsMap["coalescing_options"] = coalescing_optionsNestedMap
 		}
 		if !data.HTTPS.ConnectionIdleTimeout.IsNull() && !data.HTTPS.ConnectionIdleTimeout.IsUnknown() {
@@ -33181,6 +40002,32 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.HTTPS.HTTPProtocolOptions != nil {
 			http_protocol_optionsNestedMap := make(map[string]interface{})
+			if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1Only != nil {
+				http_protocol_enable_v1_onlyLevel3Map := make(map[string]interface{})
+				if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation != nil {
+					header_transformationLevel4Map := make(map[string]interface{})
+					if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.DefaultHeaderTransformation != nil {
+						header_transformationLevel4Map["default_header_transformation"] = map[string]interface{}{}
+					}
+					if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.LegacyHeaderTransformation != nil {
+						header_transformationLevel4Map["legacy_header_transformation"] = map[string]interface{}{}
+					}
+					if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.PreserveCaseHeaderTransformation != nil {
+						header_transformationLevel4Map["preserve_case_header_transformation"] = map[string]interface{}{}
+					}
+					if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.ProperCaseHeaderTransformation != nil {
+						header_transformationLevel4Map["proper_case_header_transformation"] = map[string]interface{}{}
+					}
+					http_protocol_enable_v1_onlyLevel3Map["header_transformation"] = header_transformationLevel4Map
+				}
+				http_protocol_optionsNestedMap["http_protocol_enable_v1_only"] = http_protocol_enable_v1_onlyLevel3Map
+			}
+			if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV1V2 != nil {
+				http_protocol_optionsNestedMap["http_protocol_enable_v1_v2"] = map[string]interface{}{}
+			}
+			if data.HTTPS.HTTPProtocolOptions.HTTPProtocolEnableV2Only != nil {
+				http_protocol_optionsNestedMap["http_protocol_enable_v2_only"] = map[string]interface{}{}
+			}
 			httpsMap["http_protocol_options"] = http_protocol_optionsNestedMap
 		}
 		if !data.HTTPS.HTTPRedirect.IsNull() && !data.HTTPS.HTTPRedirect.IsUnknown() {
@@ -33203,10 +40050,182 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.HTTPS.TLSCertParams != nil {
 			tls_cert_paramsNestedMap := make(map[string]interface{})
+			if data.HTTPS.TLSCertParams.NoMtls != nil {
+				tls_cert_paramsNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPS.TLSCertParams.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPS.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPS.TLSCertParams.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSCertParams.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSCertParams.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				tls_cert_paramsNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPS.TLSCertParams.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPS.TLSCertParams.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPS.TLSCertParams.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPS.TLSCertParams.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSCertParams.UseMtls.CRL.Name.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPS.TLSCertParams.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPS.TLSCertParams.UseMtls.CRL.Namespace.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPS.TLSCertParams.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPS.TLSCertParams.UseMtls.CRL.Tenant.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPS.TLSCertParams.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPS.TLSCertParams.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSCertParams.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPS.TLSCertParams.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPS.TLSCertParams.UseMtls.TrustedCAURL.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPS.TLSCertParams.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPS.TLSCertParams.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSCertParams.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPS.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPS.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				tls_cert_paramsNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			httpsMap["tls_cert_params"] = tls_cert_paramsNestedMap
 		}
 		if data.HTTPS.TLSParameters != nil {
 			tls_parametersNestedMap := make(map[string]interface{})
+			if data.HTTPS.TLSParameters.NoMtls != nil {
+				tls_parametersNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPS.TLSParameters.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPS.TLSParameters.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPS.TLSParameters.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPS.TLSParameters.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSParameters.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSParameters.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				tls_parametersNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPS.TLSParameters.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPS.TLSParameters.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPS.TLSParameters.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPS.TLSParameters.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPS.TLSParameters.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSParameters.UseMtls.CRL.Name.IsNull() && !data.HTTPS.TLSParameters.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPS.TLSParameters.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPS.TLSParameters.UseMtls.CRL.Namespace.IsNull() && !data.HTTPS.TLSParameters.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPS.TLSParameters.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPS.TLSParameters.UseMtls.CRL.Tenant.IsNull() && !data.HTTPS.TLSParameters.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPS.TLSParameters.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPS.TLSParameters.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSParameters.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSParameters.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPS.TLSParameters.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPS.TLSParameters.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPS.TLSParameters.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPS.TLSParameters.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPS.TLSParameters.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPS.TLSParameters.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPS.TLSParameters.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPS.TLSParameters.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPS.TLSParameters.UseMtls.TrustedCAURL.IsNull() && !data.HTTPS.TLSParameters.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPS.TLSParameters.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPS.TLSParameters.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPS.TLSParameters.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPS.TLSParameters.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPS.TLSParameters.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPS.TLSParameters.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				tls_parametersNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			httpsMap["tls_parameters"] = tls_parametersNestedMap
 		}
 		apiResource.Spec["https"] = httpsMap
@@ -33221,6 +40240,12 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.HTTPSAutoCert.CoalescingOptions != nil {
 			coalescing_optionsNestedMap := make(map[string]interface{})
+			if data.HTTPSAutoCert.CoalescingOptions.DefaultCoalescing != nil {
+				coalescing_optionsNestedMap["default_coalescing"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.CoalescingOptions.StrictCoalescing != nil {
+				coalescing_optionsNestedMap["strict_coalescing"] = map[string]interface{}{}
+			}
 			https_auto_certMap["coalescing_options"] = coalescing_optionsNestedMap
 		}
 		if !data.HTTPSAutoCert.ConnectionIdleTimeout.IsNull() && !data.HTTPSAutoCert.ConnectionIdleTimeout.IsUnknown() {
@@ -33240,6 +40265,32 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.HTTPSAutoCert.HTTPProtocolOptions != nil {
 			http_protocol_optionsNestedMap := make(map[string]interface{})
+			if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1Only != nil {
+				http_protocol_enable_v1_onlyLevel3Map := make(map[string]interface{})
+				if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation != nil {
+					header_transformationLevel4Map := make(map[string]interface{})
+					if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.DefaultHeaderTransformation != nil {
+						header_transformationLevel4Map["default_header_transformation"] = map[string]interface{}{}
+					}
+					if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.LegacyHeaderTransformation != nil {
+						header_transformationLevel4Map["legacy_header_transformation"] = map[string]interface{}{}
+					}
+					if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.PreserveCaseHeaderTransformation != nil {
+						header_transformationLevel4Map["preserve_case_header_transformation"] = map[string]interface{}{}
+					}
+					if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.ProperCaseHeaderTransformation != nil {
+						header_transformationLevel4Map["proper_case_header_transformation"] = map[string]interface{}{}
+					}
+					http_protocol_enable_v1_onlyLevel3Map["header_transformation"] = header_transformationLevel4Map
+				}
+				http_protocol_optionsNestedMap["http_protocol_enable_v1_only"] = http_protocol_enable_v1_onlyLevel3Map
+			}
+			if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV1V2 != nil {
+				http_protocol_optionsNestedMap["http_protocol_enable_v1_v2"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.HTTPProtocolOptions.HTTPProtocolEnableV2Only != nil {
+				http_protocol_optionsNestedMap["http_protocol_enable_v2_only"] = map[string]interface{}{}
+			}
 			https_auto_certMap["http_protocol_options"] = http_protocol_optionsNestedMap
 		}
 		if !data.HTTPSAutoCert.HTTPRedirect.IsNull() && !data.HTTPSAutoCert.HTTPRedirect.IsUnknown() {
@@ -33265,6 +40316,32 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.HTTPSAutoCert.TLSConfig != nil {
 			tls_configNestedMap := make(map[string]interface{})
+			if data.HTTPSAutoCert.TLSConfig.CustomSecurity != nil {
+				custom_securityLevel3Map := make(map[string]interface{})
+				if !data.HTTPSAutoCert.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSAutoCert.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+					var cipher_suitesLevel3Items []string
+					diags := data.HTTPSAutoCert.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel3Items, false)
+					if !diags.HasError() {
+						custom_securityLevel3Map["cipher_suites"] = cipher_suitesLevel3Items
+					}
+				}
+				if !data.HTTPSAutoCert.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSAutoCert.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+					custom_securityLevel3Map["max_version"] = data.HTTPSAutoCert.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+				}
+				if !data.HTTPSAutoCert.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSAutoCert.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+					custom_securityLevel3Map["min_version"] = data.HTTPSAutoCert.TLSConfig.CustomSecurity.MinVersion.ValueString()
+				}
+				tls_configNestedMap["custom_security"] = custom_securityLevel3Map
+			}
+			if data.HTTPSAutoCert.TLSConfig.DefaultSecurity != nil {
+				tls_configNestedMap["default_security"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.TLSConfig.LowSecurity != nil {
+				tls_configNestedMap["low_security"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.TLSConfig.MediumSecurity != nil {
+				tls_configNestedMap["medium_security"] = map[string]interface{}{}
+			}
 			https_auto_certMap["tls_config"] = tls_configNestedMap
 		}
 		if data.HTTPSAutoCert.UseMtls != nil {
@@ -33272,9 +40349,52 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 			if !data.HTTPSAutoCert.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSAutoCert.UseMtls.ClientCertificateOptional.IsUnknown() {
 				use_mtlsNestedMap["client_certificate_optional"] = data.HTTPSAutoCert.UseMtls.ClientCertificateOptional.ValueBool()
 			}
+			if data.HTTPSAutoCert.UseMtls.CRL != nil {
+				crlLevel3Map := make(map[string]interface{})
+				if !data.HTTPSAutoCert.UseMtls.CRL.Name.IsNull() && !data.HTTPSAutoCert.UseMtls.CRL.Name.IsUnknown() {
+					crlLevel3Map["name"] = data.HTTPSAutoCert.UseMtls.CRL.Name.ValueString()
+				}
+				if !data.HTTPSAutoCert.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSAutoCert.UseMtls.CRL.Namespace.IsUnknown() {
+					crlLevel3Map["namespace"] = data.HTTPSAutoCert.UseMtls.CRL.Namespace.ValueString()
+				}
+				if !data.HTTPSAutoCert.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSAutoCert.UseMtls.CRL.Tenant.IsUnknown() {
+					crlLevel3Map["tenant"] = data.HTTPSAutoCert.UseMtls.CRL.Tenant.ValueString()
+				}
+				use_mtlsNestedMap["crl"] = crlLevel3Map
+			}
+			if data.HTTPSAutoCert.UseMtls.NoCRL != nil {
+				use_mtlsNestedMap["no_crl"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.UseMtls.TrustedCA != nil {
+				trusted_caLevel3Map := make(map[string]interface{})
+				if !data.HTTPSAutoCert.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSAutoCert.UseMtls.TrustedCA.Name.IsUnknown() {
+					trusted_caLevel3Map["name"] = data.HTTPSAutoCert.UseMtls.TrustedCA.Name.ValueString()
+				}
+				if !data.HTTPSAutoCert.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSAutoCert.UseMtls.TrustedCA.Namespace.IsUnknown() {
+					trusted_caLevel3Map["namespace"] = data.HTTPSAutoCert.UseMtls.TrustedCA.Namespace.ValueString()
+				}
+				if !data.HTTPSAutoCert.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSAutoCert.UseMtls.TrustedCA.Tenant.IsUnknown() {
+					trusted_caLevel3Map["tenant"] = data.HTTPSAutoCert.UseMtls.TrustedCA.Tenant.ValueString()
+				}
+				use_mtlsNestedMap["trusted_ca"] = trusted_caLevel3Map
+			}
 			if !data.HTTPSAutoCert.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSAutoCert.UseMtls.TrustedCAURL.IsUnknown() {
 				use_mtlsNestedMap["trusted_ca_url"] = data.HTTPSAutoCert.UseMtls.TrustedCAURL.ValueString()
 			}
+			if data.HTTPSAutoCert.UseMtls.XfccDisabled != nil {
+				use_mtlsNestedMap["xfcc_disabled"] = map[string]interface{}{}
+			}
+			if data.HTTPSAutoCert.UseMtls.XfccOptions != nil {
+				xfcc_optionsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSAutoCert.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSAutoCert.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+					var xfcc_header_elementsLevel3Items []string
+					diags := data.HTTPSAutoCert.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel3Items, false)
+					if !diags.HasError() {
+						xfcc_optionsLevel3Map["xfcc_header_elements"] = xfcc_header_elementsLevel3Items
+					}
+				}
+				use_mtlsNestedMap["xfcc_options"] = xfcc_optionsLevel3Map
+			}
 			https_auto_certMap["use_mtls"] = use_mtlsNestedMap
 		}
 		apiResource.Spec["https_auto_cert"] = https_auto_certMap
@@ -33296,6 +40416,12 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		jwt_validationMap := make(map[string]interface{})
 		if data.JWTValidation.Action != nil {
 			actionNestedMap := make(map[string]interface{})
+			if data.JWTValidation.Action.Block != nil {
+				actionNestedMap["block"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.Action.Report != nil {
+				actionNestedMap["report"] = map[string]interface{}{}
+			}
 			jwt_validationMap["action"] = actionNestedMap
 		}
 		if data.JWTValidation.JwksConfig != nil {
@@ -33311,17 +40437,68 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.JWTValidation.ReservedClaims != nil {
 			reserved_claimsNestedMap := make(map[string]interface{})
+			if data.JWTValidation.ReservedClaims.Audience != nil {
+				audienceLevel3Map := make(map[string]interface{})
+				if !data.JWTValidation.ReservedClaims.Audience.Audiences.IsNull() && !data.JWTValidation.ReservedClaims.Audience.Audiences.IsUnknown() {
+					var audiencesLevel3Items []string
+					diags := data.JWTValidation.ReservedClaims.Audience.Audiences.ElementsAs(ctx, &audiencesLevel3Items, false)
+					if !diags.HasError() {
+						audienceLevel3Map["audiences"] = audiencesLevel3Items
+					}
+				}
+				reserved_claimsNestedMap["audience"] = audienceLevel3Map
+			}
+			if data.JWTValidation.ReservedClaims.AudienceDisable != nil {
+				reserved_claimsNestedMap["audience_disable"] = map[string]interface{}{}
+			}
 			if !data.JWTValidation.ReservedClaims.Issuer.IsNull() && !data.JWTValidation.ReservedClaims.Issuer.IsUnknown() {
 				reserved_claimsNestedMap["issuer"] = data.JWTValidation.ReservedClaims.Issuer.ValueString()
 			}
+			if data.JWTValidation.ReservedClaims.IssuerDisable != nil {
+				reserved_claimsNestedMap["issuer_disable"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.ReservedClaims.ValidatePeriodDisable != nil {
+				reserved_claimsNestedMap["validate_period_disable"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.ReservedClaims.ValidatePeriodEnable != nil {
+				reserved_claimsNestedMap["validate_period_enable"] = map[string]interface{}{}
+			}
 			jwt_validationMap["reserved_claims"] = reserved_claimsNestedMap
 		}
 		if data.JWTValidation.Target != nil {
 			targetNestedMap := make(map[string]interface{})
+			if data.JWTValidation.Target.AllEndpoint != nil {
+				targetNestedMap["all_endpoint"] = map[string]interface{}{}
+			}
+			if data.JWTValidation.Target.APIGroups != nil {
+				api_groupsLevel3Map := make(map[string]interface{})
+				if !data.JWTValidation.Target.APIGroups.APIGroups.IsNull() && !data.JWTValidation.Target.APIGroups.APIGroups.IsUnknown() {
+					var api_groupsLevel3Items []string
+					diags := data.JWTValidation.Target.APIGroups.APIGroups.ElementsAs(ctx, &api_groupsLevel3Items, false)
+					if !diags.HasError() {
+						api_groupsLevel3Map["api_groups"] = api_groupsLevel3Items
+					}
+				}
+				targetNestedMap["api_groups"] = api_groupsLevel3Map
+			}
+			if data.JWTValidation.Target.BasePaths != nil {
+				base_pathsLevel3Map := make(map[string]interface{})
+				if !data.JWTValidation.Target.BasePaths.BasePaths.IsNull() && !data.JWTValidation.Target.BasePaths.BasePaths.IsUnknown() {
+					var base_pathsLevel3Items []string
+					diags := data.JWTValidation.Target.BasePaths.BasePaths.ElementsAs(ctx, &base_pathsLevel3Items, false)
+					if !diags.HasError() {
+						base_pathsLevel3Map["base_paths"] = base_pathsLevel3Items
+					}
+				}
+				targetNestedMap["base_paths"] = base_pathsLevel3Map
+			}
 			jwt_validationMap["target"] = targetNestedMap
 		}
 		if data.JWTValidation.TokenLocation != nil {
 			token_locationNestedMap := make(map[string]interface{})
+			if data.JWTValidation.TokenLocation.BearerToken != nil {
+				token_locationNestedMap["bearer_token"] = map[string]interface{}{}
+			}
 			jwt_validationMap["token_location"] = token_locationNestedMap
 		}
 		apiResource.Spec["jwt_validation"] = jwt_validationMap
@@ -33450,6 +40627,19 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					if listItem.Domain.AnyDomain != nil {
 						domainDeepMap["any_domain"] = map[string]interface{}{}
 					}
+					if listItem.Domain.Domain != nil {
+						domainLevel4Map := make(map[string]interface{})
+						if !listItem.Domain.Domain.ExactValue.IsNull() && !listItem.Domain.Domain.ExactValue.IsUnknown() {
+							domainLevel4Map["exact_value"] = listItem.Domain.Domain.ExactValue.ValueString()
+						}
+						if !listItem.Domain.Domain.RegexValue.IsNull() && !listItem.Domain.Domain.RegexValue.IsUnknown() {
+							domainLevel4Map["regex_value"] = listItem.Domain.Domain.RegexValue.ValueString()
+						}
+						if !listItem.Domain.Domain.SuffixValue.IsNull() && !listItem.Domain.Domain.SuffixValue.IsUnknown() {
+							domainLevel4Map["suffix_value"] = listItem.Domain.Domain.SuffixValue.ValueString()
+						}
+						domainDeepMap["domain"] = domainLevel4Map
+					}
 					listItemMap["domain"] = domainDeepMap
 				}
 				if listItem.Metadata != nil {
@@ -33536,6 +40726,29 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 				}
 				if listItem.SecretValue != nil {
 					secret_valueDeepMap := make(map[string]interface{})
+					if listItem.SecretValue.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["decryption_provider"] = listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.Location.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel4Map["location"] = listItem.SecretValue.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["store_provider"] = listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						secret_valueDeepMap["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+					}
+					if listItem.SecretValue.ClearSecretInfo != nil {
+						clear_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.ClearSecretInfo.Provider.IsNull() && !listItem.SecretValue.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel4Map["provider"] = listItem.SecretValue.ClearSecretInfo.Provider.ValueString()
+						}
+						if !listItem.SecretValue.ClearSecretInfo.URL.IsNull() && !listItem.SecretValue.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel4Map["url"] = listItem.SecretValue.ClearSecretInfo.URL.ValueString()
+						}
+						secret_valueDeepMap["clear_secret_info"] = clear_secret_infoLevel4Map
+					}
 					listItemMap["secret_value"] = secret_valueDeepMap
 				}
 				if !listItem.Value.IsNull() && !listItem.Value.IsUnknown() {
@@ -33564,6 +40777,29 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 				}
 				if listItem.SecretValue != nil {
 					secret_valueDeepMap := make(map[string]interface{})
+					if listItem.SecretValue.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["decryption_provider"] = listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.Location.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel4Map["location"] = listItem.SecretValue.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["store_provider"] = listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						secret_valueDeepMap["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+					}
+					if listItem.SecretValue.ClearSecretInfo != nil {
+						clear_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.ClearSecretInfo.Provider.IsNull() && !listItem.SecretValue.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel4Map["provider"] = listItem.SecretValue.ClearSecretInfo.Provider.ValueString()
+						}
+						if !listItem.SecretValue.ClearSecretInfo.URL.IsNull() && !listItem.SecretValue.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel4Map["url"] = listItem.SecretValue.ClearSecretInfo.URL.ValueString()
+						}
+						secret_valueDeepMap["clear_secret_info"] = clear_secret_infoLevel4Map
+					}
 					listItemMap["secret_value"] = secret_valueDeepMap
 				}
 				if !listItem.Value.IsNull() && !listItem.Value.IsUnknown() {
@@ -33649,6 +40885,29 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 				}
 				if listItem.SecretValue != nil {
 					secret_valueDeepMap := make(map[string]interface{})
+					if listItem.SecretValue.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["decryption_provider"] = listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.Location.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel4Map["location"] = listItem.SecretValue.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["store_provider"] = listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						secret_valueDeepMap["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+					}
+					if listItem.SecretValue.ClearSecretInfo != nil {
+						clear_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.ClearSecretInfo.Provider.IsNull() && !listItem.SecretValue.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel4Map["provider"] = listItem.SecretValue.ClearSecretInfo.Provider.ValueString()
+						}
+						if !listItem.SecretValue.ClearSecretInfo.URL.IsNull() && !listItem.SecretValue.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel4Map["url"] = listItem.SecretValue.ClearSecretInfo.URL.ValueString()
+						}
+						secret_valueDeepMap["clear_secret_info"] = clear_secret_infoLevel4Map
+					}
 					listItemMap["secret_value"] = secret_valueDeepMap
 				}
 				if !listItem.Value.IsNull() && !listItem.Value.IsUnknown() {
@@ -33677,6 +40936,29 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 				}
 				if listItem.SecretValue != nil {
 					secret_valueDeepMap := make(map[string]interface{})
+					if listItem.SecretValue.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["decryption_provider"] = listItem.SecretValue.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.Location.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel4Map["location"] = listItem.SecretValue.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsNull() && !listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel4Map["store_provider"] = listItem.SecretValue.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						secret_valueDeepMap["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+					}
+					if listItem.SecretValue.ClearSecretInfo != nil {
+						clear_secret_infoLevel4Map := make(map[string]interface{})
+						if !listItem.SecretValue.ClearSecretInfo.Provider.IsNull() && !listItem.SecretValue.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel4Map["provider"] = listItem.SecretValue.ClearSecretInfo.Provider.ValueString()
+						}
+						if !listItem.SecretValue.ClearSecretInfo.URL.IsNull() && !listItem.SecretValue.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel4Map["url"] = listItem.SecretValue.ClearSecretInfo.URL.ValueString()
+						}
+						secret_valueDeepMap["clear_secret_info"] = clear_secret_infoLevel4Map
+					}
 					listItemMap["secret_value"] = secret_valueDeepMap
 				}
 				if !listItem.Value.IsNull() && !listItem.Value.IsUnknown() {
@@ -33917,12 +41199,46 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.RateLimit.RateLimiter != nil {
 			rate_limiterNestedMap := make(map[string]interface{})
+			if data.RateLimit.RateLimiter.ActionBlock != nil {
+				action_blockLevel3Map := make(map[string]interface{})
+				if data.RateLimit.RateLimiter.ActionBlock.Hours != nil {
+					hoursLevel4Map := make(map[string]interface{})
+					if !data.RateLimit.RateLimiter.ActionBlock.Hours.Duration.IsNull() && !data.RateLimit.RateLimiter.ActionBlock.Hours.Duration.IsUnknown() {
+						hoursLevel4Map["duration"] = data.RateLimit.RateLimiter.ActionBlock.Hours.Duration.ValueInt64()
+					}
+					action_blockLevel3Map["hours"] = hoursLevel4Map
+				}
+				if data.RateLimit.RateLimiter.ActionBlock.Minutes != nil {
+					minutesLevel4Map := make(map[string]interface{})
+					if !data.RateLimit.RateLimiter.ActionBlock.Minutes.Duration.IsNull() && !data.RateLimit.RateLimiter.ActionBlock.Minutes.Duration.IsUnknown() {
+						minutesLevel4Map["duration"] = data.RateLimit.RateLimiter.ActionBlock.Minutes.Duration.ValueInt64()
+					}
+					action_blockLevel3Map["minutes"] = minutesLevel4Map
+				}
+				if data.RateLimit.RateLimiter.ActionBlock.Seconds != nil {
+					secondsLevel4Map := make(map[string]interface{})
+					if !data.RateLimit.RateLimiter.ActionBlock.Seconds.Duration.IsNull() && !data.RateLimit.RateLimiter.ActionBlock.Seconds.Duration.IsUnknown() {
+						secondsLevel4Map["duration"] = data.RateLimit.RateLimiter.ActionBlock.Seconds.Duration.ValueInt64()
+					}
+					action_blockLevel3Map["seconds"] = secondsLevel4Map
+				}
+				rate_limiterNestedMap["action_block"] = action_blockLevel3Map
+			}
 			if !data.RateLimit.RateLimiter.BurstMultiplier.IsNull() && !data.RateLimit.RateLimiter.BurstMultiplier.IsUnknown() {
 				rate_limiterNestedMap["burst_multiplier"] = data.RateLimit.RateLimiter.BurstMultiplier.ValueInt64()
 			}
+			if data.RateLimit.RateLimiter.Disabled != nil {
+				rate_limiterNestedMap["disabled"] = map[string]interface{}{}
+			}
+			if data.RateLimit.RateLimiter.LeakyBucket != nil {
+				rate_limiterNestedMap["leaky_bucket"] = map[string]interface{}{}
+			}
 			if !data.RateLimit.RateLimiter.PeriodMultiplier.IsNull() && !data.RateLimit.RateLimiter.PeriodMultiplier.IsUnknown() {
 				rate_limiterNestedMap["period_multiplier"] = data.RateLimit.RateLimiter.PeriodMultiplier.ValueInt64()
 			}
+			if data.RateLimit.RateLimiter.TokenBucket != nil {
+				rate_limiterNestedMap["token_bucket"] = map[string]interface{}{}
+			}
 			if !data.RateLimit.RateLimiter.TotalNumber.IsNull() && !data.RateLimit.RateLimiter.TotalNumber.IsUnknown() {
 				rate_limiterNestedMap["total_number"] = data.RateLimit.RateLimiter.TotalNumber.ValueInt64()
 			}
@@ -34174,12 +41490,99 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 					simple_routeNestedMap := make(map[string]interface{})
 					if item.SimpleRoute.AdvancedOptions != nil {
 						advanced_optionsDeepMap := make(map[string]interface{})
+						if item.SimpleRoute.AdvancedOptions.AppFirewall != nil {
+							app_firewallLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.AppFirewall.Name.IsNull() && !item.SimpleRoute.AdvancedOptions.AppFirewall.Name.IsUnknown() {
+								app_firewallLevel4Map["name"] = item.SimpleRoute.AdvancedOptions.AppFirewall.Name.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.AppFirewall.Namespace.IsNull() && !item.SimpleRoute.AdvancedOptions.AppFirewall.Namespace.IsUnknown() {
+								app_firewallLevel4Map["namespace"] = item.SimpleRoute.AdvancedOptions.AppFirewall.Namespace.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.AppFirewall.Tenant.IsNull() && !item.SimpleRoute.AdvancedOptions.AppFirewall.Tenant.IsUnknown() {
+								app_firewallLevel4Map["tenant"] = item.SimpleRoute.AdvancedOptions.AppFirewall.Tenant.ValueString()
+							}
+							advanced_optionsDeepMap["app_firewall"] = app_firewallLevel4Map
+						}
+						if item.SimpleRoute.AdvancedOptions.BotDefenseJavascriptInjection != nil {
+							bot_defense_javascript_injectionLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.BotDefenseJavascriptInjection.JavascriptLocation.IsNull() && !item.SimpleRoute.AdvancedOptions.BotDefenseJavascriptInjection.JavascriptLocation.IsUnknown() {
+								bot_defense_javascript_injectionLevel4Map["javascript_location"] = item.SimpleRoute.AdvancedOptions.BotDefenseJavascriptInjection.JavascriptLocation.ValueString()
+							}
+							advanced_optionsDeepMap["bot_defense_javascript_injection"] = bot_defense_javascript_injectionLevel4Map
+						}
+						if item.SimpleRoute.AdvancedOptions.BufferPolicy != nil {
+							buffer_policyLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.BufferPolicy.Disabled.IsNull() && !item.SimpleRoute.AdvancedOptions.BufferPolicy.Disabled.IsUnknown() {
+								buffer_policyLevel4Map["disabled"] = item.SimpleRoute.AdvancedOptions.BufferPolicy.Disabled.ValueBool()
+							}
+							if !item.SimpleRoute.AdvancedOptions.BufferPolicy.MaxRequestBytes.IsNull() && !item.SimpleRoute.AdvancedOptions.BufferPolicy.MaxRequestBytes.IsUnknown() {
+								buffer_policyLevel4Map["max_request_bytes"] = item.SimpleRoute.AdvancedOptions.BufferPolicy.MaxRequestBytes.ValueInt64()
+							}
+							advanced_optionsDeepMap["buffer_policy"] = buffer_policyLevel4Map
+						}
 						if item.SimpleRoute.AdvancedOptions.CommonBuffering != nil {
 							advanced_optionsDeepMap["common_buffering"] = map[string]interface{}{}
 						}
 						if item.SimpleRoute.AdvancedOptions.CommonHashPolicy != nil {
 							advanced_optionsDeepMap["common_hash_policy"] = map[string]interface{}{}
 						}
+						if item.SimpleRoute.AdvancedOptions.CORSPolicy != nil {
+							cors_policyLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowCredentials.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowCredentials.IsUnknown() {
+								cors_policyLevel4Map["allow_credentials"] = item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowCredentials.ValueBool()
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowHeaders.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowHeaders.IsUnknown() {
+								cors_policyLevel4Map["allow_headers"] = item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowHeaders.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowMethods.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowMethods.IsUnknown() {
+								cors_policyLevel4Map["allow_methods"] = item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowMethods.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowOrigin.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowOrigin.IsUnknown() {
+								var allow_originLevel4Items []string
+								diags := item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowOrigin.ElementsAs(ctx, &allow_originLevel4Items, false)
+								if !diags.HasError() {
+									cors_policyLevel4Map["allow_origin"] = allow_originLevel4Items
+								}
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowOriginRegex.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowOriginRegex.IsUnknown() {
+								var allow_origin_regexLevel4Items []string
+								diags := item.SimpleRoute.AdvancedOptions.CORSPolicy.AllowOriginRegex.ElementsAs(ctx, &allow_origin_regexLevel4Items, false)
+								if !diags.HasError() {
+									cors_policyLevel4Map["allow_origin_regex"] = allow_origin_regexLevel4Items
+								}
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.Disabled.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.Disabled.IsUnknown() {
+								cors_policyLevel4Map["disabled"] = item.SimpleRoute.AdvancedOptions.CORSPolicy.Disabled.ValueBool()
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.ExposeHeaders.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.ExposeHeaders.IsUnknown() {
+								cors_policyLevel4Map["expose_headers"] = item.SimpleRoute.AdvancedOptions.CORSPolicy.ExposeHeaders.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.CORSPolicy.MaximumAge.IsNull() && !item.SimpleRoute.AdvancedOptions.CORSPolicy.MaximumAge.IsUnknown() {
+								cors_policyLevel4Map["maximum_age"] = item.SimpleRoute.AdvancedOptions.CORSPolicy.MaximumAge.ValueInt64()
+							}
+							advanced_optionsDeepMap["cors_policy"] = cors_policyLevel4Map
+						}
+						if item.SimpleRoute.AdvancedOptions.CSRFPolicy != nil {
+							csrf_policyLevel4Map := make(map[string]interface{})
+							if item.SimpleRoute.AdvancedOptions.CSRFPolicy.AllLoadBalancerDomains != nil {
+								csrf_policyLevel4Map["all_load_balancer_domains"] = map[string]interface{}{}
+							}
+							if item.SimpleRoute.AdvancedOptions.CSRFPolicy.CustomDomainList != nil {
+								custom_domain_listLevel5Map := make(map[string]interface{})
+								if !item.SimpleRoute.AdvancedOptions.CSRFPolicy.CustomDomainList.Domains.IsNull() && !item.SimpleRoute.AdvancedOptions.CSRFPolicy.CustomDomainList.Domains.IsUnknown() {
+									var domainsLevel5Items []string
+									diags := item.SimpleRoute.AdvancedOptions.CSRFPolicy.CustomDomainList.Domains.ElementsAs(ctx, &domainsLevel5Items, false)
+									if !diags.HasError() {
+										custom_domain_listLevel5Map["domains"] = domainsLevel5Items
+									}
+								}
+								csrf_policyLevel4Map["custom_domain_list"] = custom_domain_listLevel5Map
+							}
+							if item.SimpleRoute.AdvancedOptions.CSRFPolicy.Disabled != nil {
+								csrf_policyLevel4Map["disabled"] = map[string]interface{}{}
+							}
+							advanced_optionsDeepMap["csrf_policy"] = csrf_policyLevel4Map
+						}
 						if item.SimpleRoute.AdvancedOptions.DefaultRetryPolicy != nil {
 							advanced_optionsDeepMap["default_retry_policy"] = map[string]interface{}{}
 						}
@@ -34219,6 +41622,33 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 						if item.SimpleRoute.AdvancedOptions.InheritedWAFExclusion != nil {
 							advanced_optionsDeepMap["inherited_waf_exclusion"] = map[string]interface{}{}
 						}
+						if item.SimpleRoute.AdvancedOptions.MirrorPolicy != nil {
+							mirror_policyLevel4Map := make(map[string]interface{})
+							if item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool != nil {
+								origin_poolLevel5Map := make(map[string]interface{})
+								if !item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Name.IsNull() && !item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Name.IsUnknown() {
+									origin_poolLevel5Map["name"] = item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Name.ValueString()
+								}
+								if !item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Namespace.IsNull() && !item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Namespace.IsUnknown() {
+									origin_poolLevel5Map["namespace"] = item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Namespace.ValueString()
+								}
+								if !item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Tenant.IsNull() && !item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Tenant.IsUnknown() {
+									origin_poolLevel5Map["tenant"] = item.SimpleRoute.AdvancedOptions.MirrorPolicy.OriginPool.Tenant.ValueString()
+								}
+								mirror_policyLevel4Map["origin_pool"] = origin_poolLevel5Map
+							}
+							if item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent != nil {
+								percentLevel5Map := make(map[string]interface{})
+								if !item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent.Denominator.IsNull() && !item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent.Denominator.IsUnknown() {
+									percentLevel5Map["denominator"] = item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent.Denominator.ValueString()
+								}
+								if !item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent.Numerator.IsNull() && !item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent.Numerator.IsUnknown() {
+									percentLevel5Map["numerator"] = item.SimpleRoute.AdvancedOptions.MirrorPolicy.Percent.Numerator.ValueInt64()
+								}
+								mirror_policyLevel4Map["percent"] = percentLevel5Map
+							}
+							advanced_optionsDeepMap["mirror_policy"] = mirror_policyLevel4Map
+						}
 						if item.SimpleRoute.AdvancedOptions.NoRetryPolicy != nil {
 							advanced_optionsDeepMap["no_retry_policy"] = map[string]interface{}{}
 						}
@@ -34228,6 +41658,16 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 						if !item.SimpleRoute.AdvancedOptions.Priority.IsNull() && !item.SimpleRoute.AdvancedOptions.Priority.IsUnknown() {
 							advanced_optionsDeepMap["priority"] = item.SimpleRoute.AdvancedOptions.Priority.ValueString()
 						}
+						if item.SimpleRoute.AdvancedOptions.RegexRewrite != nil {
+							regex_rewriteLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.RegexRewrite.Pattern.IsNull() && !item.SimpleRoute.AdvancedOptions.RegexRewrite.Pattern.IsUnknown() {
+								regex_rewriteLevel4Map["pattern"] = item.SimpleRoute.AdvancedOptions.RegexRewrite.Pattern.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.RegexRewrite.Substitution.IsNull() && !item.SimpleRoute.AdvancedOptions.RegexRewrite.Substitution.IsUnknown() {
+								regex_rewriteLevel4Map["substitution"] = item.SimpleRoute.AdvancedOptions.RegexRewrite.Substitution.ValueString()
+							}
+							advanced_optionsDeepMap["regex_rewrite"] = regex_rewriteLevel4Map
+						}
 						if !item.SimpleRoute.AdvancedOptions.RequestCookiesToRemove.IsNull() && !item.SimpleRoute.AdvancedOptions.RequestCookiesToRemove.IsUnknown() {
 							var RequestCookiesToRemoveItems []string
 							diags := item.SimpleRoute.AdvancedOptions.RequestCookiesToRemove.ElementsAs(ctx, &RequestCookiesToRemoveItems, false)
@@ -34259,9 +41699,67 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 						if item.SimpleRoute.AdvancedOptions.RetractCluster != nil {
 							advanced_optionsDeepMap["retract_cluster"] = map[string]interface{}{}
 						}
+						if item.SimpleRoute.AdvancedOptions.RetryPolicy != nil {
+							retry_policyLevel4Map := make(map[string]interface{})
+							if item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff != nil {
+								back_offLevel5Map := make(map[string]interface{})
+								if !item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff.BaseInterval.IsNull() && !item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff.BaseInterval.IsUnknown() {
+									back_offLevel5Map["base_interval"] = item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff.BaseInterval.ValueInt64()
+								}
+								if !item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff.MaxInterval.IsNull() && !item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff.MaxInterval.IsUnknown() {
+									back_offLevel5Map["max_interval"] = item.SimpleRoute.AdvancedOptions.RetryPolicy.BackOff.MaxInterval.ValueInt64()
+								}
+								retry_policyLevel4Map["back_off"] = back_offLevel5Map
+							}
+							if !item.SimpleRoute.AdvancedOptions.RetryPolicy.NumRetries.IsNull() && !item.SimpleRoute.AdvancedOptions.RetryPolicy.NumRetries.IsUnknown() {
+								retry_policyLevel4Map["num_retries"] = item.SimpleRoute.AdvancedOptions.RetryPolicy.NumRetries.ValueInt64()
+							}
+							if !item.SimpleRoute.AdvancedOptions.RetryPolicy.PerTryTimeout.IsNull() && !item.SimpleRoute.AdvancedOptions.RetryPolicy.PerTryTimeout.IsUnknown() {
+								retry_policyLevel4Map["per_try_timeout"] = item.SimpleRoute.AdvancedOptions.RetryPolicy.PerTryTimeout.ValueInt64()
+							}
+							if !item.SimpleRoute.AdvancedOptions.RetryPolicy.RetriableStatusCodes.IsNull() && !item.SimpleRoute.AdvancedOptions.RetryPolicy.RetriableStatusCodes.IsUnknown() {
+								var retriable_status_codesLevel4Items []int64
+								diags := item.SimpleRoute.AdvancedOptions.RetryPolicy.RetriableStatusCodes.ElementsAs(ctx, &retriable_status_codesLevel4Items, false)
+								if !diags.HasError() {
+									retry_policyLevel4Map["retriable_status_codes"] = retriable_status_codesLevel4Items
+								}
+							}
+							if !item.SimpleRoute.AdvancedOptions.RetryPolicy.RetryCondition.IsNull() && !item.SimpleRoute.AdvancedOptions.RetryPolicy.RetryCondition.IsUnknown() {
+								var retry_conditionLevel4Items []string
+								diags := item.SimpleRoute.AdvancedOptions.RetryPolicy.RetryCondition.ElementsAs(ctx, &retry_conditionLevel4Items, false)
+								if !diags.HasError() {
+									retry_policyLevel4Map["retry_condition"] = retry_conditionLevel4Items
+								}
+							}
+							advanced_optionsDeepMap["retry_policy"] = retry_policyLevel4Map
+						}
+						if item.SimpleRoute.AdvancedOptions.SpecificHashPolicy != nil {
+							specific_hash_policyLevel4Map := make(map[string]interface{})
+							advanced_optionsDeepMap["specific_hash_policy"] = specific_hash_policyLevel4Map
+						}
 						if !item.SimpleRoute.AdvancedOptions.Timeout.IsNull() && !item.SimpleRoute.AdvancedOptions.Timeout.IsUnknown() {
 							advanced_optionsDeepMap["timeout"] = item.SimpleRoute.AdvancedOptions.Timeout.ValueInt64()
 						}
+						if item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy != nil {
+							waf_exclusion_policyLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Name.IsNull() && !item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Name.IsUnknown() {
+								waf_exclusion_policyLevel4Map["name"] = item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Name.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Namespace.IsNull() && !item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Namespace.IsUnknown() {
+								waf_exclusion_policyLevel4Map["namespace"] = item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Namespace.ValueString()
+							}
+							if !item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Tenant.IsNull() && !item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Tenant.IsUnknown() {
+								waf_exclusion_policyLevel4Map["tenant"] = item.SimpleRoute.AdvancedOptions.WAFExclusionPolicy.Tenant.ValueString()
+							}
+							advanced_optionsDeepMap["waf_exclusion_policy"] = waf_exclusion_policyLevel4Map
+						}
+						if item.SimpleRoute.AdvancedOptions.WebSocketConfig != nil {
+							web_socket_configLevel4Map := make(map[string]interface{})
+							if !item.SimpleRoute.AdvancedOptions.WebSocketConfig.UseWebSocket.IsNull() && !item.SimpleRoute.AdvancedOptions.WebSocketConfig.UseWebSocket.IsUnknown() {
+								web_socket_configLevel4Map["use_websocket"] = item.SimpleRoute.AdvancedOptions.WebSocketConfig.UseWebSocket.ValueBool()
+							}
+							advanced_optionsDeepMap["web_socket_config"] = web_socket_configLevel4Map
+						}
 						simple_routeNestedMap["advanced_options"] = advanced_optionsDeepMap
 					}
 					if item.SimpleRoute.AutoHostRewrite != nil {
@@ -34422,6 +41920,54 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.SingleLBApp.EnableDiscovery != nil {
 			enable_discoveryNestedMap := make(map[string]interface{})
+			if data.SingleLBApp.EnableDiscovery.APICrawler != nil {
+				api_crawlerLevel3Map := make(map[string]interface{})
+				if data.SingleLBApp.EnableDiscovery.APICrawler.APICrawlerConfig != nil {
+					api_crawler_configLevel4Map := make(map[string]interface{})
+					api_crawlerLevel3Map["api_crawler_config"] = api_crawler_configLevel4Map
+				}
+				if data.SingleLBApp.EnableDiscovery.APICrawler.DisableAPICrawler != nil {
+					api_crawlerLevel3Map["disable_api_crawler"] = map[string]interface{}{}
+				}
+				enable_discoveryNestedMap["api_crawler"] = api_crawlerLevel3Map
+			}
+			if data.SingleLBApp.EnableDiscovery.APIDiscoveryFromCodeScan != nil {
+				api_discovery_from_code_scanLevel3Map := make(map[string]interface{})
+				enable_discoveryNestedMap["api_discovery_from_code_scan"] = api_discovery_from_code_scanLevel3Map
+			}
+			if data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery != nil {
+				custom_api_auth_discoveryLevel3Map := make(map[string]interface{})
+				if data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef != nil {
+					api_discovery_refLevel4Map := make(map[string]interface{})
+					if !data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.IsNull() && !data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.IsUnknown() {
+						api_discovery_refLevel4Map["name"] = data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Name.ValueString()
+					}
+					if !data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.IsNull() && !data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.IsUnknown() {
+						api_discovery_refLevel4Map["namespace"] = data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Namespace.ValueString()
+					}
+					if !data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.IsNull() && !data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.IsUnknown() {
+						api_discovery_refLevel4Map["tenant"] = data.SingleLBApp.EnableDiscovery.CustomAPIAuthDiscovery.APIDiscoveryRef.Tenant.ValueString()
+					}
+					custom_api_auth_discoveryLevel3Map["api_discovery_ref"] = api_discovery_refLevel4Map
+				}
+				enable_discoveryNestedMap["custom_api_auth_discovery"] = custom_api_auth_discoveryLevel3Map
+			}
+			if data.SingleLBApp.EnableDiscovery.DefaultAPIAuthDiscovery != nil {
+				enable_discoveryNestedMap["default_api_auth_discovery"] = map[string]interface{}{}
+			}
+			if data.SingleLBApp.EnableDiscovery.DisableLearnFromRedirectTraffic != nil {
+				enable_discoveryNestedMap["disable_learn_from_redirect_traffic"] = map[string]interface{}{}
+			}
+			if data.SingleLBApp.EnableDiscovery.DiscoveredAPISettings != nil {
+				discovered_api_settingsLevel3Map := make(map[string]interface{})
+				if !data.SingleLBApp.EnableDiscovery.DiscoveredAPISettings.PurgeDurationForInactiveDiscoveredApis.IsNull() && !data.SingleLBApp.EnableDiscovery.DiscoveredAPISettings.PurgeDurationForInactiveDiscoveredApis.IsUnknown() {
+					discovered_api_settingsLevel3Map["purge_duration_for_inactive_discovered_apis"] = data.SingleLBApp.EnableDiscovery.DiscoveredAPISettings.PurgeDurationForInactiveDiscoveredApis.ValueInt64()
+				}
+				enable_discoveryNestedMap["discovered_api_settings"] = discovered_api_settingsLevel3Map
+			}
+			if data.SingleLBApp.EnableDiscovery.EnableLearnFromRedirectTraffic != nil {
+				enable_discoveryNestedMap["enable_learn_from_redirect_traffic"] = map[string]interface{}{}
+			}
 			single_lb_appMap["enable_discovery"] = enable_discoveryNestedMap
 		}
 		if data.SingleLBApp.EnableMaliciousUserDetection != nil {
@@ -34568,7 +42114,7 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 
 	_, err := r.client.UpdateHTTPLoadBalancer(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update HTTPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "http_loadbalancer", "update"))
 		return
 	}
 
@@ -34579,9 +42125,12 @@ func (r *HTTPLoadBalancerResource) Update(ctx context.Context, req resource.Upda
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetHTTPLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read HTTPLoadBalancer after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "http_loadbalancer", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["add_location"].(bool); ok {
@@ -38958,7 +46507,7 @@ func (r *HTTPLoadBalancerResource) Delete(ctx context.Context, req resource.Dele
 	err := r.client.DeleteHTTPLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "HTTPLoadBalancer already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -38967,37 +46516,33 @@ func (r *HTTPLoadBalancerResource) Delete(ctx context.Context, req resource.Dele
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "HTTPLoadBalancer delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete HTTPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "http_loadbalancer", "delete"))
 		return
 	}
 }
 
 func (r *HTTPLoadBalancerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *HTTPLoadBalancerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("http_loadbalancer", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *HTTPLoadBalancerResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListHTTPLoadBalancers(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *HTTPLoadBalancerResource) requiredNamespace() string {
+	return ""
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,24 @@ type IKEPhase2ProfileDataSource struct {
 	client *client.Client
 }
 
+// IKEPhase2ProfileDataSourceModel mirrors IKEPhase2ProfileResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type IKEPhase2ProfileDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata        types.Object                                `tfsdk:"system_metadata"`
+	Name                  types.String                                `tfsdk:"name"`
+	Namespace             types.String                                `tfsdk:"namespace"`
+	Annotations           types.Map                                   `tfsdk:"annotations"`
+	AuthenticationAlgos   types.List                                  `tfsdk:"authentication_algos"`
+	Description           types.String                                `tfsdk:"description"`
+	Disable               types.Bool                                  `tfsdk:"disable"`
+	EncryptionAlgos       types.List                                  `tfsdk:"encryption_algos"`
+	Labels                types.Map                                   `tfsdk:"labels"`
+	ID                    types.String                                `tfsdk:"id"`
+	DhGroupSet            *IKEPhase2ProfileDhGroupSetModel            `tfsdk:"dh_group_set"`
+	DisablePfs            *IKEPhase2ProfileEmptyModel                 `tfsdk:"disable_pfs"`
+	IKEKeylifetimeHours   *IKEPhase2ProfileIKEKeylifetimeHoursModel   `tfsdk:"ike_keylifetime_hours"`
+	IKEKeylifetimeMinutes *IKEPhase2ProfileIKEKeylifetimeMinutesModel `tfsdk:"ike_keylifetime_minutes"`
+	UseDefaultKeylifetime *IKEPhase2ProfileEmptyModel                 `tfsdk:"use_default_keylifetime"`
 }
 
 func (d *IKEPhase2ProfileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +51,7 @@ func (d *IKEPhase2ProfileDataSource) Metadata(ctx context.Context, req datasourc
 }
 
 func (d *IKEPhase2ProfileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a IKE Phase2 Profile resource in F5 Distributed Cloud for ike phase2 profile configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the IKEPhase2Profile.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the IKEPhase2Profile exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the IKEPhase2Profile.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewIKEPhase2ProfileResource())
 }
 
 func (d *IKEPhase2ProfileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +73,25 @@ func (d *IKEPhase2ProfileDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	resource, err := d.client.GetIKEPhase2Profile(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetIKEPhase2Profile(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read IKEPhase2Profile: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "ike_phase2_profile", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +105,8 @@ func (d *IKEPhase2ProfileDataSource) Read(ctx context.Context, req datasource.Re
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +115,107 @@ func (d *IKEPhase2ProfileDataSource) Read(ctx context.Context, req datasource.Re
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if v, ok := apiResource.Spec["authentication_algos"].([]interface{}); ok && len(v) > 0 {
+		var authentication_algosList []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				authentication_algosList = append(authentication_algosList, s)
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.StringType, authentication_algosList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.AuthenticationAlgos = listVal
+		}
+	} else {
+		data.AuthenticationAlgos = types.ListNull(types.StringType)
+	}
+	if blockData, ok := apiResource.Spec["dh_group_set"].(map[string]interface{}); ok && (isImport || data.DhGroupSet != nil) {
+		data.DhGroupSet = &IKEPhase2ProfileDhGroupSetModel{
+			DhGroups: func() types.List {
+				if v, ok := blockData["dh_groups"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["disable_pfs"].(map[string]interface{}); ok && isImport && data.DisablePfs == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisablePfs = &IKEPhase2ProfileEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["encryption_algos"].([]interface{}); ok && len(v) > 0 {
+		var encryption_algosList []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				encryption_algosList = append(encryption_algosList, s)
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.StringType, encryption_algosList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.EncryptionAlgos = listVal
+		}
+	} else {
+		data.EncryptionAlgos = types.ListNull(types.StringType)
+	}
+	if blockData, ok := apiResource.Spec["ike_keylifetime_hours"].(map[string]interface{}); ok && (isImport || data.IKEKeylifetimeHours != nil) {
+		data.IKEKeylifetimeHours = &IKEPhase2ProfileIKEKeylifetimeHoursModel{
+			Duration: func() types.Int64 {
+				if !isImport && data.IKEKeylifetimeHours != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.IKEKeylifetimeHours.Duration
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["duration"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["ike_keylifetime_minutes"].(map[string]interface{}); ok && (isImport || data.IKEKeylifetimeMinutes != nil) {
+		data.IKEKeylifetimeMinutes = &IKEPhase2ProfileIKEKeylifetimeMinutesModel{
+			Duration: func() types.Int64 {
+				if !isImport && data.IKEKeylifetimeMinutes != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.IKEKeylifetimeMinutes.Duration
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["duration"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["use_default_keylifetime"].(map[string]interface{}); ok && isImport && data.UseDefaultKeylifetime == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.UseDefaultKeylifetime = &IKEPhase2ProfileEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,24 @@ type InfraprotectDenyListRuleDataSource struct {
 	client *client.Client
 }
 
+// InfraprotectDenyListRuleDataSourceModel mirrors InfraprotectDenyListRuleResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type InfraprotectDenyListRuleDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata      types.Object                        `tfsdk:"system_metadata"`
+	Name                types.String                        `tfsdk:"name"`
+	Namespace           types.String                        `tfsdk:"namespace"`
+	Annotations         types.Map                           `tfsdk:"annotations"`
+	Description         types.String                        `tfsdk:"description"`
+	Disable             types.Bool                          `tfsdk:"disable"`
+	Labels              types.Map                           `tfsdk:"labels"`
+	ID                  types.String                        `tfsdk:"id"`
+	ExpirationTimestamp types.String                        `tfsdk:"expiration_timestamp"`
+	Prefix              types.String                        `tfsdk:"prefix"`
+	ExpirationNever     *InfraprotectDenyListRuleEmptyModel `tfsdk:"expiration_never"`
+	OneDay              *InfraprotectDenyListRuleEmptyModel `tfsdk:"one_day"`
+	OneHour             *InfraprotectDenyListRuleEmptyModel `tfsdk:"one_hour"`
+	OneMonth            *InfraprotectDenyListRuleEmptyModel `tfsdk:"one_month"`
+	OneYear             *InfraprotectDenyListRuleEmptyModel `tfsdk:"one_year"`
 }
 
 func (d *InfraprotectDenyListRuleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +51,7 @@ func (d *InfraprotectDenyListRuleDataSource) Metadata(ctx context.Context, req d
 }
 
 func (d *InfraprotectDenyListRuleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages DDoS transit Deny List Rule in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the InfraprotectDenyListRule.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the InfraprotectDenyListRule exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the InfraprotectDenyListRule.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewInfraprotectDenyListRuleResource())
 }
 
 func (d *InfraprotectDenyListRuleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +73,25 @@ func (d *InfraprotectDenyListRuleDataSource) Read(ctx context.Context, req datas
 		return
 	}
 
-	resource, err := d.client.GetInfraprotectDenyListRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetInfraprotectDenyListRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read InfraprotectDenyListRule: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "infraprotect_deny_list_rule", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +105,8 @@ func (d *InfraprotectDenyListRuleDataSource) Read(ctx context.Context, req datas
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +115,45 @@ func (d *InfraprotectDenyListRuleDataSource) Read(ctx context.Context, req datas
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["expiration_never"].(map[string]interface{}); ok && isImport && data.ExpirationNever == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.ExpirationNever = &InfraprotectDenyListRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["one_day"].(map[string]interface{}); ok && isImport && data.OneDay == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.OneDay = &InfraprotectDenyListRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["one_hour"].(map[string]interface{}); ok && isImport && data.OneHour == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.OneHour = &InfraprotectDenyListRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["one_month"].(map[string]interface{}); ok && isImport && data.OneMonth == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.OneMonth = &InfraprotectDenyListRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["one_year"].(map[string]interface{}); ok && isImport && data.OneYear == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.OneYear = &InfraprotectDenyListRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["expiration_timestamp"].(string); ok && v != "" {
+		data.ExpirationTimestamp = types.StringValue(v)
+	} else {
+		data.ExpirationTimestamp = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["prefix"].(string); ok && v != "" {
+		data.Prefix = types.StringValue(v)
+	} else {
+		data.Prefix = types.StringNull()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
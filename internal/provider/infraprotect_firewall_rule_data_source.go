@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,38 @@ type InfraprotectFirewallRuleDataSource struct {
 	client *client.Client
 }
 
+// InfraprotectFirewallRuleDataSourceModel mirrors InfraprotectFirewallRuleResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type InfraprotectFirewallRuleDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata          types.Object                                `tfsdk:"system_metadata"`
+	Name                    types.String                                `tfsdk:"name"`
+	Namespace               types.String                                `tfsdk:"namespace"`
+	Annotations             types.Map                                   `tfsdk:"annotations"`
+	Description             types.String                                `tfsdk:"description"`
+	Disable                 types.Bool                                  `tfsdk:"disable"`
+	Labels                  types.Map                                   `tfsdk:"labels"`
+	ID                      types.String                                `tfsdk:"id"`
+	DestinationPrefixSingle types.String                                `tfsdk:"destination_prefix_single"`
+	SourcePrefixSingle      types.String                                `tfsdk:"source_prefix_single"`
+	ActionAllow             *InfraprotectFirewallRuleEmptyModel         `tfsdk:"action_allow"`
+	ActionDeny              *InfraprotectFirewallRuleEmptyModel         `tfsdk:"action_deny"`
+	DestinationPrefixAll    *InfraprotectFirewallRuleEmptyModel         `tfsdk:"destination_prefix_all"`
+	FragmentsAllow          *InfraprotectFirewallRuleEmptyModel         `tfsdk:"fragments_allow"`
+	FragmentsDeny           *InfraprotectFirewallRuleEmptyModel         `tfsdk:"fragments_deny"`
+	ProtocolAh              *InfraprotectFirewallRuleEmptyModel         `tfsdk:"protocol_ah"`
+	ProtocolAll             *InfraprotectFirewallRuleEmptyModel         `tfsdk:"protocol_all"`
+	ProtocolEsp             *InfraprotectFirewallRuleEmptyModel         `tfsdk:"protocol_esp"`
+	ProtocolGre             *InfraprotectFirewallRuleEmptyModel         `tfsdk:"protocol_gre"`
+	ProtocolICMP            *InfraprotectFirewallRuleProtocolICMPModel  `tfsdk:"protocol_icmp"`
+	ProtocolIcmp6           *InfraprotectFirewallRuleProtocolIcmp6Model `tfsdk:"protocol_icmp6"`
+	ProtocolIpv6            *InfraprotectFirewallRuleEmptyModel         `tfsdk:"protocol_ipv6"`
+	ProtocolTCP             *InfraprotectFirewallRuleProtocolTCPModel   `tfsdk:"protocol_tcp"`
+	ProtocolUDP             *InfraprotectFirewallRuleProtocolUDPModel   `tfsdk:"protocol_udp"`
+	SourcePrefixAll         *InfraprotectFirewallRuleEmptyModel         `tfsdk:"source_prefix_all"`
+	StateOff                *InfraprotectFirewallRuleEmptyModel         `tfsdk:"state_off"`
+	StateOn                 *InfraprotectFirewallRuleEmptyModel         `tfsdk:"state_on"`
+	VersionIpv4             *InfraprotectFirewallRuleEmptyModel         `tfsdk:"version_ipv4"`
+	VersionIpv6             *InfraprotectFirewallRuleEmptyModel         `tfsdk:"version_ipv6"`
 }
 
 func (d *InfraprotectFirewallRuleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +65,7 @@ func (d *InfraprotectFirewallRuleDataSource) Metadata(ctx context.Context, req d
 }
 
 func (d *InfraprotectFirewallRuleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages DDoS transit Firewall Rule in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the InfraprotectFirewallRule.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the InfraprotectFirewallRule exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the InfraprotectFirewallRule.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewInfraprotectFirewallRuleResource())
 }
 
 func (d *InfraprotectFirewallRuleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +87,25 @@ func (d *InfraprotectFirewallRuleDataSource) Read(ctx context.Context, req datas
 		return
 	}
 
-	resource, err := d.client.GetInfraprotectFirewallRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetInfraprotectFirewallRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read InfraprotectFirewallRule: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "infraprotect_firewall_rule", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +119,8 @@ func (d *InfraprotectFirewallRuleDataSource) Read(ctx context.Context, req datas
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +129,483 @@ func (d *InfraprotectFirewallRuleDataSource) Read(ctx context.Context, req datas
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["action_allow"].(map[string]interface{}); ok && isImport && data.ActionAllow == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.ActionAllow = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["action_deny"].(map[string]interface{}); ok && isImport && data.ActionDeny == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.ActionDeny = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["destination_prefix_all"].(map[string]interface{}); ok && isImport && data.DestinationPrefixAll == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DestinationPrefixAll = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["fragments_allow"].(map[string]interface{}); ok && isImport && data.FragmentsAllow == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.FragmentsAllow = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["fragments_deny"].(map[string]interface{}); ok && isImport && data.FragmentsDeny == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.FragmentsDeny = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["protocol_ah"].(map[string]interface{}); ok && isImport && data.ProtocolAh == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.ProtocolAh = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["protocol_all"].(map[string]interface{}); ok && isImport && data.ProtocolAll == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.ProtocolAll = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["protocol_esp"].(map[string]interface{}); ok && isImport && data.ProtocolEsp == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.ProtocolEsp = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["protocol_gre"].(map[string]interface{}); ok && isImport && data.ProtocolGre == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.ProtocolGre = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["protocol_icmp"].(map[string]interface{}); ok && (isImport || data.ProtocolICMP != nil) {
+		data.ProtocolICMP = &InfraprotectFirewallRuleProtocolICMPModel{
+			EchoReply: func() types.Bool {
+				if !isImport && data.ProtocolICMP != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolICMP.EchoReply
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["echo_reply"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			EchoRequest: func() types.Bool {
+				if !isImport && data.ProtocolICMP != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolICMP.EchoRequest
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["echo_request"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			ParameterProblem: func() types.Bool {
+				if !isImport && data.ProtocolICMP != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolICMP.ParameterProblem
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["parameter_problem"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			Redirect: func() types.Bool {
+				if !isImport && data.ProtocolICMP != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolICMP.Redirect
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["redirect"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			SourceQuench: func() types.Bool {
+				if !isImport && data.ProtocolICMP != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolICMP.SourceQuench
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["source_quench"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			TimeExceeded: func() types.Bool {
+				if !isImport && data.ProtocolICMP != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolICMP.TimeExceeded
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["time_exceeded"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			Unreachable: func() types.Bool {
+				if !isImport && data.ProtocolICMP != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolICMP.Unreachable
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["unreachable"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["protocol_icmp6"].(map[string]interface{}); ok && (isImport || data.ProtocolIcmp6 != nil) {
+		data.ProtocolIcmp6 = &InfraprotectFirewallRuleProtocolIcmp6Model{
+			DestinationUnreachable: func() types.Bool {
+				if !isImport && data.ProtocolIcmp6 != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolIcmp6.DestinationUnreachable
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["destination_unreachable"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			EchoReply: func() types.Bool {
+				if !isImport && data.ProtocolIcmp6 != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolIcmp6.EchoReply
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["echo_reply"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			EchoRequest: func() types.Bool {
+				if !isImport && data.ProtocolIcmp6 != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolIcmp6.EchoRequest
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["echo_request"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			NeighborAdvertisement: func() types.Bool {
+				if !isImport && data.ProtocolIcmp6 != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolIcmp6.NeighborAdvertisement
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["neighbor_advertisement"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			NeighborSolicit: func() types.Bool {
+				if !isImport && data.ProtocolIcmp6 != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolIcmp6.NeighborSolicit
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["neighbor_solicit"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			PacketTooBig: func() types.Bool {
+				if !isImport && data.ProtocolIcmp6 != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolIcmp6.PacketTooBig
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["packet_too_big"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			ParameterProblem: func() types.Bool {
+				if !isImport && data.ProtocolIcmp6 != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolIcmp6.ParameterProblem
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["parameter_problem"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			Redirect: func() types.Bool {
+				if !isImport && data.ProtocolIcmp6 != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolIcmp6.Redirect
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["redirect"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			RouterAdvertisement: func() types.Bool {
+				if !isImport && data.ProtocolIcmp6 != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolIcmp6.RouterAdvertisement
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["router_advertisement"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			RouterSolicit: func() types.Bool {
+				if !isImport && data.ProtocolIcmp6 != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolIcmp6.RouterSolicit
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["router_solicit"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			TimeExceeded: func() types.Bool {
+				if !isImport && data.ProtocolIcmp6 != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.ProtocolIcmp6.TimeExceeded
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["time_exceeded"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["protocol_ipv6"].(map[string]interface{}); ok && isImport && data.ProtocolIpv6 == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.ProtocolIpv6 = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["protocol_tcp"].(map[string]interface{}); ok && (isImport || data.ProtocolTCP != nil) {
+		data.ProtocolTCP = &InfraprotectFirewallRuleProtocolTCPModel{
+			DescriptionSpec: func() types.String {
+				if v, ok := blockData["description"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			DestinationPortAll: func() *InfraprotectFirewallRuleEmptyModel {
+				if !isImport && data.ProtocolTCP != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.ProtocolTCP.DestinationPortAll
+				}
+				// Import case: read from API
+				if _, ok := blockData["destination_port_all"].(map[string]interface{}); ok {
+					return &InfraprotectFirewallRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			DestinationPortRange: func() types.String {
+				if v, ok := blockData["destination_port_range"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			SourcePortAll: func() *InfraprotectFirewallRuleEmptyModel {
+				if !isImport && data.ProtocolTCP != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.ProtocolTCP.SourcePortAll
+				}
+				// Import case: read from API
+				if _, ok := blockData["source_port_all"].(map[string]interface{}); ok {
+					return &InfraprotectFirewallRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			SourcePortRange: func() types.String {
+				if v, ok := blockData["source_port_range"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["protocol_udp"].(map[string]interface{}); ok && (isImport || data.ProtocolUDP != nil) {
+		data.ProtocolUDP = &InfraprotectFirewallRuleProtocolUDPModel{
+			DescriptionSpec: func() types.String {
+				if v, ok := blockData["description"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			DestinationPortAll: func() *InfraprotectFirewallRuleEmptyModel {
+				if !isImport && data.ProtocolUDP != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.ProtocolUDP.DestinationPortAll
+				}
+				// Import case: read from API
+				if _, ok := blockData["destination_port_all"].(map[string]interface{}); ok {
+					return &InfraprotectFirewallRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			DestinationPortRange: func() types.String {
+				if v, ok := blockData["destination_port_range"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			SourcePortAll: func() *InfraprotectFirewallRuleEmptyModel {
+				if !isImport && data.ProtocolUDP != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.ProtocolUDP.SourcePortAll
+				}
+				// Import case: read from API
+				if _, ok := blockData["source_port_all"].(map[string]interface{}); ok {
+					return &InfraprotectFirewallRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			SourcePortRange: func() types.String {
+				if v, ok := blockData["source_port_range"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["source_prefix_all"].(map[string]interface{}); ok && isImport && data.SourcePrefixAll == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SourcePrefixAll = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["state_off"].(map[string]interface{}); ok && isImport && data.StateOff == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.StateOff = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["state_on"].(map[string]interface{}); ok && isImport && data.StateOn == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.StateOn = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["version_ipv4"].(map[string]interface{}); ok && isImport && data.VersionIpv4 == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.VersionIpv4 = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["version_ipv6"].(map[string]interface{}); ok && isImport && data.VersionIpv6 == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.VersionIpv6 = &InfraprotectFirewallRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["destination_prefix_single"].(string); ok && v != "" {
+		data.DestinationPrefixSingle = types.StringValue(v)
+	} else {
+		data.DestinationPrefixSingle = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["source_prefix_single"].(string); ok && v != "" {
+		data.SourcePrefixSingle = types.StringValue(v)
+	} else {
+		data.SourcePrefixSingle = types.StringNull()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,365 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// Infraprotect Internet Prefix Advertisement Activation Resource for F5 XC
+// Announces or withdraws an existing internet prefix advertisement through
+// the update-advertisement-status API and waits until the change is reported
+// by the advertisement status conditions.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
+	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
+	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
+)
+
+// Advertisement states derived from the activation and status conditions
+const (
+	advertisementStateAnnounced = "ANNOUNCED"
+	advertisementStateWithdrawn = "WITHDRAWN"
+	advertisementStatePending   = "PENDING"
+	advertisementStateFailed    = "FAILED"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &InfraprotectInternetPrefixAdvertisementActivationResource{}
+	_ resource.ResourceWithConfigure   = &InfraprotectInternetPrefixAdvertisementActivationResource{}
+	_ resource.ResourceWithImportState = &InfraprotectInternetPrefixAdvertisementActivationResource{}
+)
+
+func NewInfraprotectInternetPrefixAdvertisementActivationResource() resource.Resource {
+	return &InfraprotectInternetPrefixAdvertisementActivationResource{}
+}
+
+type InfraprotectInternetPrefixAdvertisementActivationResource struct {
+	client *client.Client
+}
+
+type InfraprotectInternetPrefixAdvertisementActivationResourceModel struct {
+	ID        types.String   `tfsdk:"id"`
+	Name      types.String   `tfsdk:"name"`
+	Namespace types.String   `tfsdk:"namespace"`
+	Announce  types.Bool     `tfsdk:"announce"`
+	State     types.String   `tfsdk:"state"`
+	Timeouts  timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *InfraprotectInternetPrefixAdvertisementActivationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_infraprotect_internet_prefix_advertisement_activation"
+}
+
+func (r *InfraprotectInternetPrefixAdvertisementActivationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Announces or withdraws an internet prefix advertisement in F5 Distributed Cloud.
+
+Changing ` + "`announce`" + ` toggles the advertisement and waits until its status conditions report the new state,
+or fails when a condition reports a failure or the create or update timeout expires.
+Destroying this resource withdraws the prefix.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the `f5xc_infraprotect_internet_prefix_advertisement` to toggle.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the internet prefix advertisement.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.NamespaceValidator(),
+				},
+			},
+			"announce": schema.BoolAttribute{
+				MarkdownDescription: "Announce the prefix when `true`, withdraw it when `false`.",
+				Required:            true,
+			},
+			"state": schema.StringAttribute{
+				MarkdownDescription: "Advertisement state reported by F5 Distributed Cloud. Possible values: `ANNOUNCED`, `WITHDRAWN`, `PENDING`, `FAILED`.",
+				Computed:            true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *InfraprotectInternetPrefixAdvertisementActivationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// infraprotectAdvertisementState derives the advertisement state from its
+// activation and status conditions. A failed condition fails the
+// advertisement, and an announced advertisement is pending until at least
+// one condition reports success.
+func infraprotectAdvertisementState(status *client.InfraprotectInternetPrefixAdvertisementStatus) string {
+	succeeded := false
+	for _, condition := range status.Conditions() {
+		switch condition.Status {
+		case "Failed", "Incomplete":
+			return advertisementStateFailed
+		case "Success", "Installed":
+			succeeded = true
+		case "Disabled", "NotApplicable":
+		default:
+			return advertisementStatePending
+		}
+	}
+	if !status.Announced() {
+		return advertisementStateWithdrawn
+	}
+	if !succeeded {
+		return advertisementStatePending
+	}
+	return advertisementStateAnnounced
+}
+
+// infraprotectAdvertisementFailure returns the reasons of the failed
+// conditions of an advertisement
+func infraprotectAdvertisementFailure(status *client.InfraprotectInternetPrefixAdvertisementStatus) string {
+	var reasons []string
+	for _, condition := range status.Conditions() {
+		if condition.Status == "Failed" || condition.Status == "Incomplete" {
+			reasons = append(reasons, fmt.Sprintf("%s: %s", condition.ServiceName, condition.Reason))
+		}
+	}
+	return strings.Join(reasons, "; ")
+}
+
+// toggle announces or withdraws the advertisement and waits until the new
+// state is reported
+func (r *InfraprotectInternetPrefixAdvertisementActivationResource) toggle(ctx context.Context, data *InfraprotectInternetPrefixAdvertisementActivationResourceModel) error {
+	if err := r.updateStatus(ctx, data); err != nil {
+		return err
+	}
+	return r.waitForStatus(ctx, data)
+}
+
+// updateStatus requests the advertisement to be announced or withdrawn
+func (r *InfraprotectInternetPrefixAdvertisementActivationResource) updateStatus(ctx context.Context, data *InfraprotectInternetPrefixAdvertisementActivationResourceModel) error {
+	namespace, name := data.Namespace.ValueString(), data.Name.ValueString()
+	announce := data.Announce.ValueBool()
+
+	tflog.Debug(ctx, "Toggling infraprotect_internet_prefix_advertisement", map[string]interface{}{
+		"name":      name,
+		"namespace": namespace,
+		"announce":  announce,
+	})
+
+	return r.client.UpdateInfraprotectInternetPrefixAdvertisementStatus(ctx, namespace, name, announce)
+}
+
+// waitForStatus waits until the advertisement reports the requested state
+func (r *InfraprotectInternetPrefixAdvertisementActivationResource) waitForStatus(ctx context.Context, data *InfraprotectInternetPrefixAdvertisementActivationResourceModel) error {
+	namespace, name := data.Namespace.ValueString(), data.Name.ValueString()
+
+	target := advertisementStateWithdrawn
+	if data.Announce.ValueBool() {
+		target = advertisementStateAnnounced
+	}
+
+	var last *client.InfraprotectInternetPrefixAdvertisementStatus
+	refresh := func(ctx context.Context) (string, error) {
+		status, err := r.client.GetInfraprotectInternetPrefixAdvertisementStatus(ctx, namespace, name)
+		if err != nil {
+			return "", err
+		}
+		last = status
+		return infraprotectAdvertisementState(status), nil
+	}
+
+	state, err := waitForState(ctx, refresh, []string{target}, []string{advertisementStateFailed}, defaultPollInterval)
+	data.State = types.StringValue(state)
+	if state == advertisementStateFailed && last != nil {
+		return fmt.Errorf("%w: %s", err, infraprotectAdvertisementFailure(last))
+	}
+	return err
+}
+
+func (r *InfraprotectInternetPrefixAdvertisementActivationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data InfraprotectInternetPrefixAdvertisementActivationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, inttimeouts.DefaultCreate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if err := r.updateStatus(ctx, &data); err != nil {
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "infraprotect_internet_prefix_advertisement_activation", "create"))
+		return
+	}
+
+	// The activation is saved before waiting so that a failed wait taints it
+	// instead of losing track of the requested change
+	data.ID = types.StringValue(data.Name.ValueString())
+	data.State = types.StringValue(advertisementStatePending)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.waitForStatus(ctx, &data)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	if err != nil {
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "infraprotect_internet_prefix_advertisement_activation", "create"))
+		return
+	}
+
+	tflog.Trace(ctx, "created InfraprotectInternetPrefixAdvertisementActivation resource")
+}
+
+func (r *InfraprotectInternetPrefixAdvertisementActivationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data InfraprotectInternetPrefixAdvertisementActivationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, inttimeouts.DefaultRead)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	status, err := r.client.GetInfraprotectInternetPrefixAdvertisementStatus(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// Check if the advertisement was deleted outside Terraform
+		if f5xcerrors.IsNotFound(err) {
+			tflog.Warn(ctx, "InfraprotectInternetPrefixAdvertisement not found, removing activation from state", map[string]interface{}{
+				"name":      data.Name.ValueString(),
+				"namespace": data.Namespace.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "infraprotect_internet_prefix_advertisement_activation", "read"))
+		return
+	}
+
+	data.ID = types.StringValue(data.Name.ValueString())
+	data.Announce = types.BoolValue(status.Announced())
+	data.State = types.StringValue(infraprotectAdvertisementState(status))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *InfraprotectInternetPrefixAdvertisementActivationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state InfraprotectInternetPrefixAdvertisementActivationResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, inttimeouts.DefaultUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Only toggle when announce changed, timeouts changes are stored as is
+	if data.Announce.Equal(state.Announce) {
+		data.State = state.State
+	} else if err := r.toggle(ctx, &data); err != nil {
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "infraprotect_internet_prefix_advertisement_activation", "update"))
+		return
+	}
+
+	data.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Delete withdraws the prefix. An advertisement that no longer exists is
+// not advertised, so it is treated as already withdrawn.
+func (r *InfraprotectInternetPrefixAdvertisementActivationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data InfraprotectInternetPrefixAdvertisementActivationResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, inttimeouts.DefaultDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	data.Announce = types.BoolValue(false)
+	if err := r.toggle(ctx, &data); err != nil {
+		if f5xcerrors.IsNotFound(err) {
+			tflog.Warn(ctx, "InfraprotectInternetPrefixAdvertisement already deleted, removing activation from state", map[string]interface{}{
+				"name":      data.Name.ValueString(),
+				"namespace": data.Namespace.ValueString(),
+			})
+			return
+		}
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "infraprotect_internet_prefix_advertisement_activation", "delete"))
+		return
+	}
+}
+
+func (r *InfraprotectInternetPrefixAdvertisementActivationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
@@ -0,0 +1,50 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+func TestInfraprotectAdvertisementState(t *testing.T) {
+	newStatus := func(announced bool, statuses ...string) *client.InfraprotectInternetPrefixAdvertisementStatus {
+		status := &client.InfraprotectInternetPrefixAdvertisementStatus{}
+		if announced {
+			status.Spec.ActivationAnnounce = &struct{}{}
+		} else {
+			status.Spec.ActivationWithdraw = &struct{}{}
+		}
+		var conditions []client.Condition
+		for _, s := range statuses {
+			conditions = append(conditions, client.Condition{Type: "Operational", Status: s})
+		}
+		status.Status = []client.StatusObject{{Conditions: conditions}}
+		return status
+	}
+
+	tests := []struct {
+		name     string
+		status   *client.InfraprotectInternetPrefixAdvertisementStatus
+		expected string
+	}{
+		{"announced and reported", newStatus(true, "Success"), advertisementStateAnnounced},
+		{"announced and installed", newStatus(true, "Installed", "NotApplicable"), advertisementStateAnnounced},
+		{"announced without conditions", newStatus(true), advertisementStatePending},
+		{"announced but down", newStatus(true, "Success", "Down"), advertisementStatePending},
+		{"announced but failed", newStatus(true, "Success", "Failed"), advertisementStateFailed},
+		{"withdrawn without conditions", newStatus(false), advertisementStateWithdrawn},
+		{"withdrawn and reported", newStatus(false, "Success"), advertisementStateWithdrawn},
+		{"withdraw incomplete", newStatus(false, "Incomplete"), advertisementStateFailed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := infraprotectAdvertisementState(tt.status)
+			if result != tt.expected {
+				t.Errorf("infraprotectAdvertisementState() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,18 @@ type K8SPodSecurityAdmissionDataSource struct {
 	client *client.Client
 }
 
+// K8SPodSecurityAdmissionDataSourceModel mirrors K8SPodSecurityAdmissionResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type K8SPodSecurityAdmissionDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata            types.Object `tfsdk:"system_metadata"`
+	Name                      types.String `tfsdk:"name"`
+	Namespace                 types.String `tfsdk:"namespace"`
+	Annotations               types.Map    `tfsdk:"annotations"`
+	Description               types.String `tfsdk:"description"`
+	Disable                   types.Bool   `tfsdk:"disable"`
+	Labels                    types.Map    `tfsdk:"labels"`
+	ID                        types.String `tfsdk:"id"`
+	PodSecurityAdmissionSpecs types.List   `tfsdk:"pod_security_admission_specs"`
 }
 
 func (d *K8SPodSecurityAdmissionDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +45,7 @@ func (d *K8SPodSecurityAdmissionDataSource) Metadata(ctx context.Context, req da
 }
 
 func (d *K8SPodSecurityAdmissionDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages k8s_pod_security_admission will create the object in the storage backend in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the K8SPodSecurityAdmission.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the K8SPodSecurityAdmission exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the K8SPodSecurityAdmission.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewK8SPodSecurityAdmissionResource())
 }
 
 func (d *K8SPodSecurityAdmissionDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +67,25 @@ func (d *K8SPodSecurityAdmissionDataSource) Read(ctx context.Context, req dataso
 		return
 	}
 
-	resource, err := d.client.GetK8SPodSecurityAdmission(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetK8SPodSecurityAdmission(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read K8SPodSecurityAdmission: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "k8s_pod_security_admission", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +99,8 @@ func (d *K8SPodSecurityAdmissionDataSource) Read(ctx context.Context, req dataso
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +109,68 @@ func (d *K8SPodSecurityAdmissionDataSource) Read(ctx context.Context, req dataso
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if listData, ok := apiResource.Spec["pod_security_admission_specs"].([]interface{}); ok && len(listData) > 0 {
+		var pod_security_admission_specsList []K8SPodSecurityAdmissionPodSecurityAdmissionSpecsModel
+		var existingPodSecurityAdmissionSpecsItems []K8SPodSecurityAdmissionPodSecurityAdmissionSpecsModel
+		if !data.PodSecurityAdmissionSpecs.IsNull() && !data.PodSecurityAdmissionSpecs.IsUnknown() {
+			data.PodSecurityAdmissionSpecs.ElementsAs(ctx, &existingPodSecurityAdmissionSpecsItems, false)
+		}
+		for listIdx := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			{
+				pod_security_admission_specsList = append(pod_security_admission_specsList, K8SPodSecurityAdmissionPodSecurityAdmissionSpecsModel{
+					Audit: func() *K8SPodSecurityAdmissionEmptyModel {
+						if !isImport && len(existingPodSecurityAdmissionSpecsItems) > listIdx && existingPodSecurityAdmissionSpecsItems[listIdx].Audit != nil {
+							return &K8SPodSecurityAdmissionEmptyModel{}
+						}
+						return nil
+					}(),
+					Baseline: func() *K8SPodSecurityAdmissionEmptyModel {
+						if !isImport && len(existingPodSecurityAdmissionSpecsItems) > listIdx && existingPodSecurityAdmissionSpecsItems[listIdx].Baseline != nil {
+							return &K8SPodSecurityAdmissionEmptyModel{}
+						}
+						return nil
+					}(),
+					Enforce: func() *K8SPodSecurityAdmissionEmptyModel {
+						if !isImport && len(existingPodSecurityAdmissionSpecsItems) > listIdx && existingPodSecurityAdmissionSpecsItems[listIdx].Enforce != nil {
+							return &K8SPodSecurityAdmissionEmptyModel{}
+						}
+						return nil
+					}(),
+					Privileged: func() *K8SPodSecurityAdmissionEmptyModel {
+						if !isImport && len(existingPodSecurityAdmissionSpecsItems) > listIdx && existingPodSecurityAdmissionSpecsItems[listIdx].Privileged != nil {
+							return &K8SPodSecurityAdmissionEmptyModel{}
+						}
+						return nil
+					}(),
+					Restricted: func() *K8SPodSecurityAdmissionEmptyModel {
+						if !isImport && len(existingPodSecurityAdmissionSpecsItems) > listIdx && existingPodSecurityAdmissionSpecsItems[listIdx].Restricted != nil {
+							return &K8SPodSecurityAdmissionEmptyModel{}
+						}
+						return nil
+					}(),
+					Warn: func() *K8SPodSecurityAdmissionEmptyModel {
+						if !isImport && len(existingPodSecurityAdmissionSpecsItems) > listIdx && existingPodSecurityAdmissionSpecsItems[listIdx].Warn != nil {
+							return &K8SPodSecurityAdmissionEmptyModel{}
+						}
+						return nil
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: K8SPodSecurityAdmissionPodSecurityAdmissionSpecsModelAttrTypes}, pod_security_admission_specsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.PodSecurityAdmissionSpecs = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.PodSecurityAdmissionSpecs = types.ListNull(types.ObjectType{AttrTypes: K8SPodSecurityAdmissionPodSecurityAdmissionSpecsModelAttrTypes})
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
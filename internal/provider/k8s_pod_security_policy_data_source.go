@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,19 @@ type K8SPodSecurityPolicyDataSource struct {
 	client *client.Client
 }
 
+// K8SPodSecurityPolicyDataSourceModel mirrors K8SPodSecurityPolicyResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type K8SPodSecurityPolicyDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object                      `tfsdk:"system_metadata"`
+	Name           types.String                      `tfsdk:"name"`
+	Namespace      types.String                      `tfsdk:"namespace"`
+	Annotations    types.Map                         `tfsdk:"annotations"`
+	Description    types.String                      `tfsdk:"description"`
+	Disable        types.Bool                        `tfsdk:"disable"`
+	Labels         types.Map                         `tfsdk:"labels"`
+	ID             types.String                      `tfsdk:"id"`
+	Yaml           types.String                      `tfsdk:"yaml"`
+	PspSpec        *K8SPodSecurityPolicyPspSpecModel `tfsdk:"psp_spec"`
 }
 
 func (d *K8SPodSecurityPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +46,7 @@ func (d *K8SPodSecurityPolicyDataSource) Metadata(ctx context.Context, req datas
 }
 
 func (d *K8SPodSecurityPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages k8s_pod_security_policy will create the object in the storage backend for namespace metadata.namespace in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the K8SPodSecurityPolicy.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the K8SPodSecurityPolicy exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the K8SPodSecurityPolicy.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewK8SPodSecurityPolicyResource())
 }
 
 func (d *K8SPodSecurityPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +68,25 @@ func (d *K8SPodSecurityPolicyDataSource) Read(ctx context.Context, req datasourc
 		return
 	}
 
-	resource, err := d.client.GetK8SPodSecurityPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetK8SPodSecurityPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read K8SPodSecurityPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "k8s_pod_security_policy", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +100,8 @@ func (d *K8SPodSecurityPolicyDataSource) Read(ctx context.Context, req datasourc
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +110,495 @@ func (d *K8SPodSecurityPolicyDataSource) Read(ctx context.Context, req datasourc
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["psp_spec"].(map[string]interface{}); ok && (isImport || data.PspSpec != nil) {
+		data.PspSpec = &K8SPodSecurityPolicyPspSpecModel{
+			AllowPrivilegeEscalation: func() types.Bool {
+				if !isImport && data.PspSpec != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.PspSpec.AllowPrivilegeEscalation
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["allow_privilege_escalation"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			AllowedCapabilities: func() *K8SPodSecurityPolicyPspSpecAllowedCapabilitiesModel {
+				if !isImport && data.PspSpec != nil && data.PspSpec.AllowedCapabilities != nil {
+					// Normal Read: preserve existing state value
+					return data.PspSpec.AllowedCapabilities
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["allowed_capabilities"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyPspSpecAllowedCapabilitiesModel{
+						Capabilities: func() types.List {
+							if v, ok := nestedBlockData["capabilities"].([]interface{}); ok && len(v) > 0 {
+								var items []string
+								for _, item := range v {
+									if s, ok := item.(string); ok {
+										items = append(items, s)
+									}
+								}
+								listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+								return listVal
+							}
+							return types.ListNull(types.StringType)
+						}(),
+					}
+				}
+				return nil
+			}(),
+			AllowedCsiDrivers: func() types.List {
+				if v, ok := blockData["allowed_csi_drivers"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			AllowedFlexVolumes: func() types.List {
+				if v, ok := blockData["allowed_flex_volumes"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			AllowedHostPaths: func() []K8SPodSecurityPolicyPspSpecAllowedHostPathsModel {
+				if listData, ok := blockData["allowed_host_paths"].([]interface{}); ok && len(listData) > 0 {
+					var result []K8SPodSecurityPolicyPspSpecAllowedHostPathsModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, K8SPodSecurityPolicyPspSpecAllowedHostPathsModel{
+								PathPrefix: func() types.String {
+									if v, ok := itemMap["path_prefix"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								ReadOnly: func() types.Bool {
+									if v, ok := itemMap["read_only"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			AllowedProcMounts: func() types.List {
+				if v, ok := blockData["allowed_proc_mounts"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			AllowedUnsafeSysctls: func() types.List {
+				if v, ok := blockData["allowed_unsafe_sysctls"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			DefaultAllowPrivilegeEscalation: func() types.Bool {
+				if !isImport && data.PspSpec != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.PspSpec.DefaultAllowPrivilegeEscalation
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["default_allow_privilege_escalation"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			DefaultCapabilities: func() *K8SPodSecurityPolicyPspSpecDefaultCapabilitiesModel {
+				if !isImport && data.PspSpec != nil && data.PspSpec.DefaultCapabilities != nil {
+					// Normal Read: preserve existing state value
+					return data.PspSpec.DefaultCapabilities
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["default_capabilities"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyPspSpecDefaultCapabilitiesModel{
+						Capabilities: func() types.List {
+							if v, ok := nestedBlockData["capabilities"].([]interface{}); ok && len(v) > 0 {
+								var items []string
+								for _, item := range v {
+									if s, ok := item.(string); ok {
+										items = append(items, s)
+									}
+								}
+								listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+								return listVal
+							}
+							return types.ListNull(types.StringType)
+						}(),
+					}
+				}
+				return nil
+			}(),
+			DropCapabilities: func() *K8SPodSecurityPolicyPspSpecDropCapabilitiesModel {
+				if !isImport && data.PspSpec != nil && data.PspSpec.DropCapabilities != nil {
+					// Normal Read: preserve existing state value
+					return data.PspSpec.DropCapabilities
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["drop_capabilities"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyPspSpecDropCapabilitiesModel{
+						Capabilities: func() types.List {
+							if v, ok := nestedBlockData["capabilities"].([]interface{}); ok && len(v) > 0 {
+								var items []string
+								for _, item := range v {
+									if s, ok := item.(string); ok {
+										items = append(items, s)
+									}
+								}
+								listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+								return listVal
+							}
+							return types.ListNull(types.StringType)
+						}(),
+					}
+				}
+				return nil
+			}(),
+			ForbiddenSysctls: func() types.List {
+				if v, ok := blockData["forbidden_sysctls"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			FsGroupStrategyOptions: func() *K8SPodSecurityPolicyPspSpecFsGroupStrategyOptionsModel {
+				if !isImport && data.PspSpec != nil && data.PspSpec.FsGroupStrategyOptions != nil {
+					// Normal Read: preserve existing state value
+					return data.PspSpec.FsGroupStrategyOptions
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["fs_group_strategy_options"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyPspSpecFsGroupStrategyOptionsModel{
+						Rule: func() types.String {
+							if v, ok := nestedBlockData["rule"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			HostIpc: func() types.Bool {
+				if !isImport && data.PspSpec != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.PspSpec.HostIpc
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["host_ipc"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			HostNetwork: func() types.Bool {
+				if !isImport && data.PspSpec != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.PspSpec.HostNetwork
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["host_network"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			HostPid: func() types.Bool {
+				if !isImport && data.PspSpec != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.PspSpec.HostPid
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["host_pid"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			HostPortRanges: func() types.String {
+				if v, ok := blockData["host_port_ranges"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			NoAllowedCapabilities: func() *K8SPodSecurityPolicyEmptyModel {
+				if !isImport && data.PspSpec != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PspSpec.NoAllowedCapabilities
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_allowed_capabilities"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			NoDefaultCapabilities: func() *K8SPodSecurityPolicyEmptyModel {
+				if !isImport && data.PspSpec != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PspSpec.NoDefaultCapabilities
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_default_capabilities"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			NoDropCapabilities: func() *K8SPodSecurityPolicyEmptyModel {
+				if !isImport && data.PspSpec != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PspSpec.NoDropCapabilities
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_drop_capabilities"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			NoFsGroups: func() *K8SPodSecurityPolicyEmptyModel {
+				if !isImport && data.PspSpec != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PspSpec.NoFsGroups
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_fs_groups"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			NoRunAsGroup: func() *K8SPodSecurityPolicyEmptyModel {
+				if !isImport && data.PspSpec != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PspSpec.NoRunAsGroup
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_run_as_group"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			NoRunAsUser: func() *K8SPodSecurityPolicyEmptyModel {
+				if !isImport && data.PspSpec != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PspSpec.NoRunAsUser
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_run_as_user"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			NoRuntimeClass: func() *K8SPodSecurityPolicyEmptyModel {
+				if !isImport && data.PspSpec != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PspSpec.NoRuntimeClass
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_runtime_class"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			NoSeLinuxOptions: func() *K8SPodSecurityPolicyEmptyModel {
+				if !isImport && data.PspSpec != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PspSpec.NoSeLinuxOptions
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_se_linux_options"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			NoSupplementalGroups: func() *K8SPodSecurityPolicyEmptyModel {
+				if !isImport && data.PspSpec != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PspSpec.NoSupplementalGroups
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_supplemental_groups"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyEmptyModel{}
+				}
+				return nil
+			}(),
+			Privileged: func() types.Bool {
+				if !isImport && data.PspSpec != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.PspSpec.Privileged
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["privileged"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			ReadOnlyRootFilesystem: func() types.Bool {
+				if !isImport && data.PspSpec != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.PspSpec.ReadOnlyRootFilesystem
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["read_only_root_filesystem"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			RunAsGroup: func() *K8SPodSecurityPolicyPspSpecRunAsGroupModel {
+				if !isImport && data.PspSpec != nil && data.PspSpec.RunAsGroup != nil {
+					// Normal Read: preserve existing state value
+					return data.PspSpec.RunAsGroup
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["run_as_group"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyPspSpecRunAsGroupModel{
+						Rule: func() types.String {
+							if v, ok := nestedBlockData["rule"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			RunAsUser: func() *K8SPodSecurityPolicyPspSpecRunAsUserModel {
+				if !isImport && data.PspSpec != nil && data.PspSpec.RunAsUser != nil {
+					// Normal Read: preserve existing state value
+					return data.PspSpec.RunAsUser
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["run_as_user"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyPspSpecRunAsUserModel{
+						Rule: func() types.String {
+							if v, ok := nestedBlockData["rule"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			SupplementalGroups: func() *K8SPodSecurityPolicyPspSpecSupplementalGroupsModel {
+				if !isImport && data.PspSpec != nil && data.PspSpec.SupplementalGroups != nil {
+					// Normal Read: preserve existing state value
+					return data.PspSpec.SupplementalGroups
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["supplemental_groups"].(map[string]interface{}); ok {
+					return &K8SPodSecurityPolicyPspSpecSupplementalGroupsModel{
+						Rule: func() types.String {
+							if v, ok := nestedBlockData["rule"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			Volumes: func() types.List {
+				if v, ok := blockData["volumes"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if v, ok := apiResource.Spec["yaml"].(string); ok && v != "" {
+		data.Yaml = types.StringValue(v)
+	} else {
+		data.Yaml = types.StringNull()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,178 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// Kubeconfig Ephemeral Resource for F5 XC
+// Issues a short-lived kubeconfig for a site, through the global controller,
+// or for a virtual K8s cluster. The kubeconfig is an API credential that is
+// revoked when Terraform closes the ephemeral resource.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/ephemeralvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ ephemeral.EphemeralResource                     = &KubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigure        = &KubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithClose            = &KubeconfigEphemeralResource{}
+	_ ephemeral.EphemeralResourceWithConfigValidators = &KubeconfigEphemeralResource{}
+)
+
+func NewKubeconfigEphemeralResource() ephemeral.EphemeralResource {
+	return &KubeconfigEphemeralResource{}
+}
+
+type KubeconfigEphemeralResource struct {
+	client *client.Client
+}
+
+type KubeconfigEphemeralResourceModel struct {
+	Name                types.String `tfsdk:"name"`
+	Site                types.String `tfsdk:"site"`
+	VirtualK8sName      types.String `tfsdk:"virtual_k8s_name"`
+	VirtualK8sNamespace types.String `tfsdk:"virtual_k8s_namespace"`
+	ExpirationDays      types.Int64  `tfsdk:"expiration_days"`
+	ExpirationTimestamp types.String `tfsdk:"expiration_timestamp"`
+	Kubeconfig          types.String `tfsdk:"kubeconfig"`
+}
+
+func (r *KubeconfigEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_kubeconfig"
+}
+
+func (r *KubeconfigEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Issues a short-lived kubeconfig for a site or a virtual K8s cluster without storing it in the plan or state.
+
+Set ` + "`site`" + ` for a kubeconfig that reaches the site through the global controller, or ` + "`virtual_k8s_name`" + `
+and ` + "`virtual_k8s_namespace`" + ` for a virtual K8s cluster. The kubeconfig is revoked when Terraform closes the
+ephemeral resource, at the end of the plan or apply. Requires Terraform 1.10 or later.`,
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the API credential of the kubeconfig. Defaults to a random name, so runs do not collide.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+			"site": schema.StringAttribute{
+				MarkdownDescription: "Name of the site to issue a global kubeconfig for.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+			"virtual_k8s_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the virtual K8s cluster to issue a kubeconfig for.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+			"virtual_k8s_namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the virtual K8s cluster.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NamespaceValidator(),
+				},
+			},
+			"expiration_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days the kubeconfig is valid for if it is not revoked. Defaults to `1`.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"expiration_timestamp": schema.StringAttribute{
+				MarkdownDescription: "Time at which the kubeconfig expires, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"kubeconfig": schema.StringAttribute{
+				MarkdownDescription: "Kubeconfig document.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+		},
+	}
+}
+
+func (r *KubeconfigEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	return []ephemeral.ConfigValidator{
+		ephemeralvalidator.ExactlyOneOf(
+			path.MatchRoot("site"),
+			path.MatchRoot("virtual_k8s_name"),
+		),
+		ephemeralvalidator.RequiredTogether(
+			path.MatchRoot("virtual_k8s_name"),
+			path.MatchRoot("virtual_k8s_namespace"),
+		),
+	}
+}
+
+func (r *KubeconfigEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+func (r *KubeconfigEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	var data KubeconfigEphemeralResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	created, name, diags := openAPICredential(ctx, r.client, resp, data.Name.ValueString(), data.ExpirationDays.ValueInt64(), kubeconfigCreateSpec(data))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Name = types.StringValue(name)
+	data.ExpirationTimestamp = types.StringValue(created.ExpirationTimestamp)
+	data.Kubeconfig = types.StringValue(created.Data)
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+func (r *KubeconfigEphemeralResource) Close(ctx context.Context, req ephemeral.CloseRequest, resp *ephemeral.CloseResponse) {
+	resp.Diagnostics.Append(closeAPICredential(ctx, r.client, req)...)
+}
+
+// kubeconfigCreateSpec returns the API credential spec of a site global
+// kubeconfig when site is set, and of a virtual K8s kubeconfig otherwise
+func kubeconfigCreateSpec(data KubeconfigEphemeralResourceModel) client.APICredentialCreateSpec {
+	if site := data.Site.ValueString(); site != "" {
+		return client.APICredentialCreateSpec{
+			Type:           "SITE_GLOBAL_KUBE_CONFIG",
+			SiteKubeconfig: &client.APICredentialSiteKubeconfig{Site: site},
+		}
+	}
+	return client.APICredentialCreateSpec{
+		Type:                "KUBE_CONFIG",
+		VirtualK8sName:      data.VirtualK8sName.ValueString(),
+		VirtualK8sNamespace: data.VirtualK8sNamespace.ValueString(),
+	}
+}
@@ -0,0 +1,211 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// list_data_source_helpers.go - Manually maintained helpers for list data sources.
+// This file is NOT auto-generated. Generated plural data sources (for example
+// f5xc_origin_pools) share the schema, model and filtering implemented here.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
+)
+
+// listDataSourceModel is the model shared by all list data sources.
+type listDataSourceModel struct {
+	ID            types.String              `tfsdk:"id"`
+	Namespace     types.String              `tfsdk:"namespace"`
+	LabelSelector types.String              `tfsdk:"label_selector"`
+	NameRegex     types.String              `tfsdk:"name_regex"`
+	Names         types.List                `tfsdk:"names"`
+	Items         []listDataSourceItemModel `tfsdk:"items"`
+}
+
+// listDataSourceItemModel holds the key fields of one listed object.
+type listDataSourceItemModel struct {
+	Name        types.String `tfsdk:"name"`
+	Namespace   types.String `tfsdk:"namespace"`
+	Tenant      types.String `tfsdk:"tenant"`
+	UID         types.String `tfsdk:"uid"`
+	Description types.String `tfsdk:"description"`
+	Disabled    types.Bool   `tfsdk:"disabled"`
+	Labels      types.Map    `tfsdk:"labels"`
+	Annotations types.Map    `tfsdk:"annotations"`
+}
+
+// listDataSourceSchema returns the schema for a list data source.
+// humanName is the singular display name of the listed type (e.g. "Origin Pool").
+// When the type is namespace scoped the namespace is required and selects the
+// list endpoint; otherwise it optionally filters the returned objects.
+func listDataSourceSchema(humanName string, namespaced bool) schema.Schema {
+	namespaceAttr := schema.StringAttribute{
+		MarkdownDescription: fmt.Sprintf("Namespace to list %s objects from.", humanName),
+		Required:            true,
+		Validators: []validator.String{
+			validators.NamespaceValidator(),
+		},
+	}
+	if !namespaced {
+		namespaceAttr = schema.StringAttribute{
+			MarkdownDescription: fmt.Sprintf("Only return %s objects in this namespace.", humanName),
+			Optional:            true,
+		}
+	}
+
+	return schema.Schema{
+		MarkdownDescription: fmt.Sprintf("Lists %s objects in F5 Distributed Cloud, optionally filtered by labels and name.", humanName),
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of this list query.",
+				Computed:            true,
+			},
+			"namespace": namespaceAttr,
+			"label_selector": schema.StringAttribute{
+				MarkdownDescription: "Label selector evaluated by the API, e.g. `app=web,env in (prod, staging)`.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.NonEmptyStringValidator(),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only return objects whose name matches this regular expression.",
+				Optional:            true,
+				Validators: []validator.String{
+					validators.RegexValidator(),
+				},
+			},
+			"names": schema.ListAttribute{
+				MarkdownDescription: "Sorted names of the matching objects.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"items": schema.ListNestedAttribute{
+				MarkdownDescription: "Key fields of the matching objects, sorted by name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "Name of the object.",
+							Computed:            true,
+						},
+						"namespace": schema.StringAttribute{
+							MarkdownDescription: "Namespace of the object.",
+							Computed:            true,
+						},
+						"tenant": schema.StringAttribute{
+							MarkdownDescription: "Tenant that owns the object.",
+							Computed:            true,
+						},
+						"uid": schema.StringAttribute{
+							MarkdownDescription: "Unique identifier assigned by the platform.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the object.",
+							Computed:            true,
+						},
+						"disabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the object is disabled.",
+							Computed:            true,
+						},
+						"labels": schema.MapAttribute{
+							MarkdownDescription: "Labels applied to the object.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"annotations": schema.MapAttribute{
+							MarkdownDescription: "Annotations applied to the object.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// listOptions builds the client list options from the data source configuration.
+func (m *listDataSourceModel) listOptions() client.ListOptions {
+	return client.ListOptions{
+		LabelSelector: m.LabelSelector.ValueString(),
+	}
+}
+
+// setItems filters the listed objects by namespace and name_regex and stores
+// the result, sorted by name, in the model.
+func (m *listDataSourceModel) setItems(ctx context.Context, list *client.ListResponse, resp *datasource.ReadResponse) {
+	var nameRegex *regexp.Regexp
+	if !m.NameRegex.IsNull() && m.NameRegex.ValueString() != "" {
+		re, err := regexp.Compile(m.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid name_regex", err.Error())
+			return
+		}
+		nameRegex = re
+	}
+	namespace := m.Namespace.ValueString()
+
+	items := make([]client.ListItem, 0, len(list.Items))
+	for _, item := range list.Items {
+		if namespace != "" && item.Metadata.Namespace != "" && item.Metadata.Namespace != namespace {
+			continue
+		}
+		if nameRegex != nil && !nameRegex.MatchString(item.Metadata.Name) {
+			continue
+		}
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Metadata.Name < items[j].Metadata.Name
+	})
+
+	names := make([]attr.Value, 0, len(items))
+	m.Items = make([]listDataSourceItemModel, 0, len(items))
+	for _, item := range items {
+		names = append(names, types.StringValue(item.Metadata.Name))
+		m.Items = append(m.Items, listDataSourceItemModel{
+			Name:        types.StringValue(item.Metadata.Name),
+			Namespace:   stringValueOrNull(item.Metadata.Namespace),
+			Tenant:      stringValueOrNull(item.Tenant),
+			UID:         stringValueOrNull(item.Metadata.UID),
+			Description: stringValueOrNull(item.Metadata.Description),
+			Disabled:    types.BoolValue(item.Metadata.Disable),
+			Labels:      stringMapValue(ctx, filterSystemLabels(item.Metadata.Labels), resp),
+			Annotations: stringMapValue(ctx, item.Metadata.Annotations, resp),
+		})
+	}
+
+	namesList, diags := types.ListValue(types.StringType, names)
+	resp.Diagnostics.Append(diags...)
+	m.Names = namesList
+
+	m.ID = types.StringValue(fmt.Sprintf("%s/%s/%s", namespace, m.LabelSelector.ValueString(), m.NameRegex.ValueString()))
+}
+
+func stringValueOrNull(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func stringMapValue(ctx context.Context, m map[string]string, resp *datasource.ReadResponse) types.Map {
+	if len(m) == 0 {
+		return types.MapNull(types.StringType)
+	}
+	value, diags := types.MapValueFrom(ctx, types.StringType, m)
+	resp.Diagnostics.Append(diags...)
+	return value
+}
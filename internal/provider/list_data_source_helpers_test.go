@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+func TestListDataSourceSetItems(t *testing.T) {
+	ctx := context.Background()
+	list := &client.ListResponse{Items: []client.ListItem{
+		{Metadata: client.Metadata{Name: "web-b", Namespace: "shared", Labels: map[string]string{"app": "web", "ves.io/app_type": "x"}}},
+		{Metadata: client.Metadata{Name: "api-a", Namespace: "shared"}},
+		{Metadata: client.Metadata{Name: "web-a", Namespace: "shared"}, Tenant: "acme"},
+		{Metadata: client.Metadata{Name: "web-c", Namespace: "other"}},
+	}}
+
+	data := listDataSourceModel{
+		Namespace:     types.StringValue("shared"),
+		LabelSelector: types.StringNull(),
+		NameRegex:     types.StringValue("^web-"),
+	}
+	var resp datasource.ReadResponse
+	data.setItems(ctx, list, &resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+
+	var names []string
+	resp.Diagnostics.Append(data.Names.ElementsAs(ctx, &names, false)...)
+	if len(names) != 2 || names[0] != "web-a" || names[1] != "web-b" {
+		t.Fatalf("names = %v, want [web-a web-b]", names)
+	}
+	if data.Items[0].Tenant.ValueString() != "acme" {
+		t.Errorf("tenant = %s, want acme", data.Items[0].Tenant)
+	}
+
+	labels := map[string]string{}
+	resp.Diagnostics.Append(data.Items[1].Labels.ElementsAs(ctx, &labels, false)...)
+	if len(labels) != 1 || labels["app"] != "web" {
+		t.Errorf("labels = %v, want only app=web", labels)
+	}
+}
+
+func TestListDataSourceSchemaNamespace(t *testing.T) {
+	namespaced := listDataSourceSchema("Origin Pool", true)
+	if !namespaced.Attributes["namespace"].IsRequired() {
+		t.Error("expected namespace to be required for namespaced types")
+	}
+
+	global := listDataSourceSchema("Namespace", false)
+	if !global.Attributes["namespace"].IsOptional() {
+		t.Error("expected namespace to be optional for types without a namespace in the API path")
+	}
+}
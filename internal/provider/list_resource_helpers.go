@@ -0,0 +1,190 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// list_resource_helpers.go - Manually maintained list resources. Every
+// resource whose objects live in a namespace is also a list resource, so
+// that list blocks of Terraform 1.14+ can enumerate its objects, e.g. with
+// terraform query -generate-config-out to import existing objects.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	listschema "github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
+)
+
+// listableResource is implemented by resources whose objects can be listed
+// by namespace
+type listableResource interface {
+	resource.ResourceWithConfigure
+	resource.ResourceWithIdentity
+
+	// listObjects lists the objects in a namespace
+	listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error)
+
+	// requiredNamespace returns the only namespace objects of the type
+	// exist in, or "" for types that can be created in any namespace
+	requiredNamespace() string
+}
+
+// listResource lists the objects of a listableResource. Metadata and
+// Configure are those of the resource, and objects are read with its Read.
+type listResource struct {
+	listableResource
+	client *client.Client
+}
+
+var _ list.ListResourceWithConfigure = &listResource{}
+
+// listResourceModel is the configuration of list blocks
+type listResourceModel struct {
+	Namespace     types.String `tfsdk:"namespace"`
+	LabelSelector types.String `tfsdk:"label_selector"`
+}
+
+// listResources returns a list resource for each resource that can be listed
+func listResources(resources []func() resource.Resource) []func() list.ListResource {
+	var listResources []func() list.ListResource
+	for _, newResource := range resources {
+		if _, ok := newResource().(listableResource); !ok {
+			continue
+		}
+		listResources = append(listResources, func() list.ListResource {
+			return &listResource{listableResource: newResource().(listableResource)}
+		})
+	}
+	return listResources
+}
+
+func (r *listResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = listschema.Schema{
+		Description: "Lists the objects in a namespace.",
+		Attributes: map[string]listschema.Attribute{
+			"namespace": listschema.StringAttribute{
+				Description: "Namespace to list objects in. Defaults to the only namespace objects of the type exist in, such as system, or else to default_namespace of the provider.",
+				Optional:    true,
+			},
+			"label_selector": listschema.StringAttribute{
+				Description: "Only list objects whose labels match this Kubernetes label selector, e.g. `app=web,env in (prod, staging)`.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (r *listResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	r.listableResource.Configure(ctx, req, resp)
+	if c, ok := req.ProviderData.(*client.Client); ok {
+		r.client = c
+	}
+}
+
+func (r *listResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config listResourceModel
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	namespace := config.Namespace.ValueString()
+	if namespace == "" {
+		namespace = r.requiredNamespace()
+	}
+	if namespace == "" && r.client != nil {
+		namespace = r.client.DefaultNamespace
+	}
+	if namespace == "" {
+		diags.AddAttributeError(path.Root("namespace"), "Missing Namespace",
+			"The list block does not set a namespace. Set namespace on the list block, "+
+				"or default_namespace in the provider configuration.")
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	objects, err := r.listObjects(ctx, namespace, client.ListOptions{LabelSelector: config.LabelSelector.ValueString()})
+	if err != nil {
+		var metadata resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "f5xc"}, &metadata)
+		f5xcerrors.AddError(&diags, f5xcerrors.WrapError(err, metadata.TypeName, "list"))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+		for _, item := range objects.Items {
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+
+			itemNamespace := item.Metadata.Namespace
+			if itemNamespace == "" {
+				itemNamespace = namespace
+			}
+			result := req.NewListResult(ctx)
+			result.DisplayName = item.Metadata.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, resourceIdentityModel{
+				Namespace: types.StringValue(itemNamespace),
+				Name:      types.StringValue(item.Metadata.Name),
+				Tenant:    stringValueOrNull(item.Tenant),
+			})...)
+
+			if req.IncludeResource && !result.Diagnostics.HasError() {
+				found, diags := r.readObject(ctx, itemNamespace, item.Metadata.Name, &result)
+				result.Diagnostics.Append(diags...)
+				// Objects deleted since they were listed are left out
+				if !found {
+					continue
+				}
+			}
+
+			count++
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// readObject reads an object into the resource of a list result with the
+// Read of the resource, as if the object was imported, so that all nested
+// blocks are set. It reports false when the object no longer exists.
+func (r *listResource) readObject(ctx context.Context, namespace, name string, result *list.ListResult) (bool, diag.Diagnostics) {
+	state := tfsdk.State{Schema: result.Resource.Schema, Raw: result.Resource.Raw}
+	diags := state.SetAttribute(ctx, path.Root("namespace"), namespace)
+	diags.Append(state.SetAttribute(ctx, path.Root("name"), name)...)
+	diags.Append(state.SetAttribute(ctx, path.Root("id"), name)...)
+	if diags.HasError() {
+		return true, diags
+	}
+
+	req := resource.ReadRequest{State: state, Identity: result.Identity}
+	resp := resource.ReadResponse{State: state, Identity: result.Identity}
+	newPrivateState(&req.Private)
+	newPrivateState(&resp.Private)
+	diags.Append(req.Private.SetKey(ctx, "isImport", []byte("true"))...)
+
+	r.Read(ctx, req, &resp)
+	diags.Append(resp.Diagnostics...)
+	if resp.State.Raw.IsNull() {
+		return false, diags
+	}
+	result.Resource.Raw = resp.State.Raw
+	return true, diags
+}
+
+// newPrivateState allocates the private state of Read requests and
+// responses, whose type is internal to the framework
+func newPrivateState[T any](p **T) {
+	*p = new(T)
+}
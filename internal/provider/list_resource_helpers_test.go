@@ -0,0 +1,155 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+func TestListResources(t *testing.T) {
+	ctx := context.Background()
+	p := &F5XCProvider{}
+
+	listed := make(map[string]bool)
+	for _, newListResource := range p.ListResources(ctx) {
+		var meta resource.MetadataResponse
+		newListResource().Metadata(ctx, resource.MetadataRequest{ProviderTypeName: "f5xc"}, &meta)
+		listed[meta.TypeName] = true
+	}
+
+	for _, typeName := range []string{"f5xc_origin_pool", "f5xc_virtual_site", "f5xc_site"} {
+		if !listed[typeName] {
+			t.Errorf("ListResources() is missing %s", typeName)
+		}
+	}
+	// Objects of these types are not in a namespace
+	for _, typeName := range []string{"f5xc_namespace", "f5xc_cloud_connect"} {
+		if listed[typeName] {
+			t.Errorf("ListResources() includes %s", typeName)
+		}
+	}
+}
+
+// newListRequest returns a request of a list block of r
+func newListRequest(ctx context.Context, t *testing.T, r *listResource, namespace interface{}, includeResource bool, limit int64) list.ListRequest {
+	t.Helper()
+
+	var configSchema list.ListResourceSchemaResponse
+	r.ListResourceConfigSchema(ctx, list.ListResourceSchemaRequest{}, &configSchema)
+	var resourceSchema resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &resourceSchema)
+
+	config := tftypes.NewValue(tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"namespace":      tftypes.String,
+		"label_selector": tftypes.String,
+	}}, map[string]tftypes.Value{
+		"namespace":      tftypes.NewValue(tftypes.String, namespace),
+		"label_selector": tftypes.NewValue(tftypes.String, nil),
+	})
+	return list.ListRequest{
+		Config:                 tfsdk.Config{Schema: configSchema.Schema, Raw: config},
+		IncludeResource:        includeResource,
+		Limit:                  limit,
+		ResourceSchema:         resourceSchema.Schema,
+		ResourceIdentitySchema: resourceIdentitySchema(),
+	}
+}
+
+// configuredListResource returns the list resource of a resource with a
+// client of url
+func configuredListResource(ctx context.Context, newResource func() resource.Resource, url string) *listResource {
+	r := &listResource{listableResource: newResource().(listableResource)}
+	r.Configure(ctx, resource.ConfigureRequest{ProviderData: client.NewClient(url, "token")}, &resource.ConfigureResponse{})
+	return r
+}
+
+func TestListResourceList(t *testing.T) {
+	ctx := context.Background()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/config/namespaces/system/virtual_sites":
+			w.Write([]byte(`{"items": [
+				{"name": "vs-a", "namespace": "system", "tenant": "acme"},
+				{"name": "vs-gone", "namespace": "system", "tenant": "acme"},
+				{"name": "vs-b", "namespace": "system", "tenant": "acme"}
+			]}`))
+		case "/api/config/namespaces/system/virtual_sites/vs-a", "/api/config/namespaces/system/virtual_sites/vs-b":
+			w.Write([]byte(`{"metadata": {"name": "` + strings.TrimPrefix(r.URL.Path, "/api/config/namespaces/system/virtual_sites/") + `", "namespace": "system", "labels": {"app": "web"}},
+				"system_metadata": {"tenant": "acme"}, "spec": {"site_type": "CUSTOMER_EDGE"}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code": 5, "message": "not found"}`))
+		}
+	}))
+	defer server.Close()
+
+	r := configuredListResource(ctx, NewVirtualSiteResource, server.URL)
+
+	// Virtual sites are listed in system without a namespace in the list block
+	stream := &list.ListResultsStream{}
+	r.List(ctx, newListRequest(ctx, t, r, nil, false, 0), stream)
+	var names []string
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("List() diagnostics = %v", result.Diagnostics)
+		}
+		var identity resourceIdentityModel
+		result.Identity.Get(ctx, &identity)
+		if identity.Namespace.ValueString() != "system" || identity.Tenant.ValueString() != "acme" {
+			t.Errorf("identity = %+v, want namespace system and tenant acme", identity)
+		}
+		names = append(names, result.DisplayName)
+	}
+	if len(names) != 3 {
+		t.Errorf("List() listed %v, want 3 objects", names)
+	}
+
+	// Objects are read as if imported, and deleted objects are left out
+	stream = &list.ListResultsStream{}
+	r.List(ctx, newListRequest(ctx, t, r, "system", true, 2), stream)
+	names = nil
+	for result := range stream.Results {
+		if result.Diagnostics.HasError() {
+			t.Fatalf("List() diagnostics = %v", result.Diagnostics)
+		}
+		var labels types.Map
+		state := tfsdk.State{Schema: result.Resource.Schema, Raw: result.Resource.Raw}
+		state.GetAttribute(ctx, path.Root("labels"), &labels)
+		if len(labels.Elements()) != 1 {
+			t.Errorf("labels of %s = %v, want the labels of the object", result.DisplayName, labels)
+		}
+		names = append(names, result.DisplayName)
+	}
+	if len(names) != 2 || names[0] != "vs-a" || names[1] != "vs-b" {
+		t.Errorf("List() with resources listed %v, want vs-a and vs-b", names)
+	}
+}
+
+func TestListResourceListMissingNamespace(t *testing.T) {
+	ctx := context.Background()
+	r := configuredListResource(ctx, NewOriginPoolResource, "https://example.com")
+
+	stream := &list.ListResultsStream{}
+	r.List(ctx, newListRequest(ctx, t, r, nil, false, 0), stream)
+	hasError := false
+	for result := range stream.Results {
+		hasError = hasError || result.Diagnostics.HasError()
+	}
+	if !hasError {
+		t.Error("List() without a namespace and default_namespace did not report an error")
+	}
+}
@@ -0,0 +1,26 @@
+// This file is MANUALLY MAINTAINED and is NOT auto-generated from OpenAPI specifications.
+// It registers list resources, which enumerate the objects of a resource type in
+// list blocks of terraform query.
+//
+// DO NOT DELETE OR MODIFY during code generation. This file is preserved by the
+// generate-all-schemas.go tool.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+// Ensure F5XCProvider satisfies the provider.ProviderWithListResources interface.
+var _ provider.ProviderWithListResources = &F5XCProvider{}
+
+// ListResources returns a list resource for every resource whose objects live
+// in a namespace. List resources have the type name of their resource.
+//
+// List resources require Terraform 1.14 or later.
+func (p *F5XCProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return listResources(p.Resources(ctx))
+}
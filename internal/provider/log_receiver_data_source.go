@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,19 @@ type LogReceiverDataSource struct {
 	client *client.Client
 }
 
+// LogReceiverDataSourceModel mirrors LogReceiverResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type LogReceiverDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object            `tfsdk:"system_metadata"`
+	Name           types.String            `tfsdk:"name"`
+	Namespace      types.String            `tfsdk:"namespace"`
+	Annotations    types.Map               `tfsdk:"annotations"`
+	Description    types.String            `tfsdk:"description"`
+	Disable        types.Bool              `tfsdk:"disable"`
+	Labels         types.Map               `tfsdk:"labels"`
+	ID             types.String            `tfsdk:"id"`
+	SiteLocal      *LogReceiverEmptyModel  `tfsdk:"site_local"`
+	Syslog         *LogReceiverSyslogModel `tfsdk:"syslog"`
 }
 
 func (d *LogReceiverDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +46,7 @@ func (d *LogReceiverDataSource) Metadata(ctx context.Context, req datasource.Met
 }
 
 func (d *LogReceiverDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages new Log Receiver object. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the LogReceiver.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the LogReceiver exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the LogReceiver.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewLogReceiverResource())
 }
 
 func (d *LogReceiverDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +68,25 @@ func (d *LogReceiverDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	resource, err := d.client.GetLogReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetLogReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read LogReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "log_receiver", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +100,8 @@ func (d *LogReceiverDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +110,115 @@ func (d *LogReceiverDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["site_local"].(map[string]interface{}); ok && isImport && data.SiteLocal == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SiteLocal = &LogReceiverEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["syslog"].(map[string]interface{}); ok && (isImport || data.Syslog != nil) {
+		data.Syslog = &LogReceiverSyslogModel{
+			SyslogRfc5424: func() types.Int64 {
+				if !isImport && data.Syslog != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.Syslog.SyslogRfc5424
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["syslog_rfc5424"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			TCPServer: func() *LogReceiverSyslogTCPServerModel {
+				if !isImport && data.Syslog != nil && data.Syslog.TCPServer != nil {
+					// Normal Read: preserve existing state value
+					return data.Syslog.TCPServer
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["tcp_server"].(map[string]interface{}); ok {
+					return &LogReceiverSyslogTCPServerModel{
+						Port: func() types.Int64 {
+							if v, ok := nestedBlockData["port"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						ServerName: func() types.String {
+							if v, ok := nestedBlockData["server_name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			TLSServer: func() *LogReceiverSyslogTLSServerModel {
+				if !isImport && data.Syslog != nil && data.Syslog.TLSServer != nil {
+					// Normal Read: preserve existing state value
+					return data.Syslog.TLSServer
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["tls_server"].(map[string]interface{}); ok {
+					return &LogReceiverSyslogTLSServerModel{
+						Port: func() types.Int64 {
+							if v, ok := nestedBlockData["port"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						ServerName: func() types.String {
+							if v, ok := nestedBlockData["server_name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						TrustedCAURL: func() types.String {
+							if v, ok := nestedBlockData["trusted_ca_url"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			UDPServer: func() *LogReceiverSyslogUDPServerModel {
+				if !isImport && data.Syslog != nil && data.Syslog.UDPServer != nil {
+					// Normal Read: preserve existing state value
+					return data.Syslog.UDPServer
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["udp_server"].(map[string]interface{}); ok {
+					return &LogReceiverSyslogUDPServerModel{
+						Port: func() types.Int64 {
+							if v, ok := nestedBlockData["port"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						ServerName: func() types.String {
+							if v, ok := nestedBlockData["server_name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewLogReceiverResource(), &data)...)
 }
@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &LogReceiverResource{}
-	_ resource.ResourceWithConfigure      = &LogReceiverResource{}
-	_ resource.ResourceWithImportState    = &LogReceiverResource{}
-	_ resource.ResourceWithModifyPlan     = &LogReceiverResource{}
-	_ resource.ResourceWithValidateConfig = &LogReceiverResource{}
+	_ resource.Resource                     = &LogReceiverResource{}
+	_ resource.ResourceWithConfigure        = &LogReceiverResource{}
+	_ resource.ResourceWithImportState      = &LogReceiverResource{}
+	_ resource.ResourceWithIdentity         = &LogReceiverResource{}
+	_ resource.ResourceWithMoveState        = &LogReceiverResource{}
+	_ resource.ResourceWithModifyPlan       = &LogReceiverResource{}
+	_ resource.ResourceWithValidateConfig   = &LogReceiverResource{}
+	_ resource.ResourceWithConfigValidators = &LogReceiverResource{}
+	_ listableResource                      = &LogReceiverResource{}
 )
 
 func NewLogReceiverResource() resource.Resource {
@@ -137,14 +143,16 @@ var LogReceiverSyslogTLSServerMtlsEnableKeyURLBlindfoldSecretInfoModelAttrTypes
 
 // LogReceiverSyslogTLSServerMtlsEnableKeyURLClearSecretInfoModel represents clear_secret_info block
 type LogReceiverSyslogTLSServerMtlsEnableKeyURLClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // LogReceiverSyslogTLSServerMtlsEnableKeyURLClearSecretInfoModelAttrTypes defines the attribute types for LogReceiverSyslogTLSServerMtlsEnableKeyURLClearSecretInfoModel
 var LogReceiverSyslogTLSServerMtlsEnableKeyURLClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // LogReceiverSyslogUDPServerModel represents udp_server block
@@ -160,16 +168,18 @@ var LogReceiverSyslogUDPServerModelAttrTypes = map[string]attr.Type{
 }
 
 type LogReceiverResourceModel struct {
-	Name        types.String            `tfsdk:"name"`
-	Namespace   types.String            `tfsdk:"namespace"`
-	Annotations types.Map               `tfsdk:"annotations"`
-	Description types.String            `tfsdk:"description"`
-	Disable     types.Bool              `tfsdk:"disable"`
-	Labels      types.Map               `tfsdk:"labels"`
-	ID          types.String            `tfsdk:"id"`
-	Timeouts    timeouts.Value          `tfsdk:"timeouts"`
-	SiteLocal   *LogReceiverEmptyModel  `tfsdk:"site_local"`
-	Syslog      *LogReceiverSyslogModel `tfsdk:"syslog"`
+	Name           types.String            `tfsdk:"name"`
+	Namespace      types.String            `tfsdk:"namespace"`
+	Annotations    types.Map               `tfsdk:"annotations"`
+	Description    types.String            `tfsdk:"description"`
+	Disable        types.Bool              `tfsdk:"disable"`
+	Labels         types.Map               `tfsdk:"labels"`
+	ID             types.String            `tfsdk:"id"`
+	Timeouts       timeouts.Value          `tfsdk:"timeouts"`
+	SystemMetadata types.Object            `tfsdk:"system_metadata"`
+	LabelsAll      types.Map               `tfsdk:"labels_all"`
+	SiteLocal      *LogReceiverEmptyModel  `tfsdk:"site_local"`
+	Syslog         *LogReceiverSyslogModel `tfsdk:"syslog"`
 }
 
 func (r *LogReceiverResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -191,9 +201,11 @@ func (r *LogReceiverResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Log Receiver will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Log Receiver will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -225,6 +237,8 @@ func (r *LogReceiverResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -242,6 +256,10 @@ func (r *LogReceiverResource) Schema(ctx context.Context, req resource.SchemaReq
 					"syslog_rfc5424": schema.Int64Attribute{
 						MarkdownDescription: "Select RFC5424 syslog format and maximum message length.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(408),
+							int64validator.AtMost(268435456),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -251,10 +269,17 @@ func (r *LogReceiverResource) Schema(ctx context.Context, req resource.SchemaReq
 							"port": schema.Int64Attribute{
 								MarkdownDescription: "Port number used for communication .",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(65535),
+								},
 							},
 							"server_name": schema.StringAttribute{
 								MarkdownDescription: "Server name is fully qualified domain name or IP address of the server .",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(256),
+								},
 							},
 						},
 					},
@@ -264,14 +289,25 @@ func (r *LogReceiverResource) Schema(ctx context.Context, req resource.SchemaReq
 							"port": schema.Int64Attribute{
 								MarkdownDescription: "Custom port number used for communication.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(65535),
+								},
 							},
 							"server_name": schema.StringAttribute{
 								MarkdownDescription: "ServerName is passed to the server for SNI and is used in the client to check server certificates against.",
 								Optional:            true,
+								Validators: []validator.String{
+									validators.HostnameValidator(),
+									stringvalidator.UTF8LengthAtMost(256),
+								},
 							},
 							"trusted_ca_url": schema.StringAttribute{
 								MarkdownDescription: "The URL or value for trusted Server CA certificate or certificate chain Certificates in PEM format including the PEM headers.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -290,6 +326,9 @@ func (r *LogReceiverResource) Schema(ctx context.Context, req resource.SchemaReq
 									"certificate": schema.StringAttribute{
 										MarkdownDescription: "Client certificate is PEM-encoded certificate or certificate-chain.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -322,7 +361,16 @@ func (r *LogReceiverResource) Schema(ctx context.Context, req resource.SchemaReq
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -342,10 +390,17 @@ func (r *LogReceiverResource) Schema(ctx context.Context, req resource.SchemaReq
 							"port": schema.Int64Attribute{
 								MarkdownDescription: "Port number used for communication .",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(65535),
+								},
 							},
 							"server_name": schema.StringAttribute{
 								MarkdownDescription: "Server name is fully qualified domain name or IP address of the server .",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(256),
+								},
 							},
 						},
 					},
@@ -355,6 +410,11 @@ func (r *LogReceiverResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *LogReceiverResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *LogReceiverResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -379,6 +439,18 @@ func (r *LogReceiverResource) ValidateConfig(ctx context.Context, req resource.V
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *LogReceiverResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("syslog"), "mode_choice", "tcp_server", "tls_server", "udp_server"),
+		validators.OneOfGroup(path.MatchRoot("syslog").AtName("tls_server"), "ca_choice", "trusted_ca_url", "volterra_ca"),
+		validators.OneOfGroup(path.MatchRoot("syslog").AtName("tls_server"), "mtls_choice", "mtls_disabled", "mtls_enable"),
+		validators.RequiredOneOfGroup(path.MatchRoot("syslog").AtName("tls_server"), "port_choice", "default_https_port", "default_syslog_tls_port", "port"),
+		validators.OneOfGroup(path.MatchRoot("syslog").AtName("tls_server").AtName("mtls_enable").AtName("key_url"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *LogReceiverResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -389,7 +461,10 @@ func (r *LogReceiverResource) ModifyPlan(ctx context.Context, req resource.Modif
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "log_receiver", "", req.Config, &resp.Plan)...)
 		var plan LogReceiverResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -407,7 +482,8 @@ func (r *LogReceiverResource) ModifyPlan(ctx context.Context, req resource.Modif
 
 func (r *LogReceiverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data LogReceiverResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -446,6 +522,7 @@ func (r *LogReceiverResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -455,6 +532,7 @@ func (r *LogReceiverResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.SiteLocal != nil {
@@ -478,6 +556,49 @@ func (r *LogReceiverResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		if data.Syslog.TLSServer != nil {
 			tls_serverNestedMap := make(map[string]interface{})
+			if data.Syslog.TLSServer.DefaultHTTPSPort != nil {
+				tls_serverNestedMap["default_https_port"] = map[string]interface{}{}
+			}
+			if data.Syslog.TLSServer.DefaultSyslogTLSPort != nil {
+				tls_serverNestedMap["default_syslog_tls_port"] = map[string]interface{}{}
+			}
+			if data.Syslog.TLSServer.MtlsDisabled != nil {
+				tls_serverNestedMap["mtls_disabled"] = map[string]interface{}{}
+			}
+			if data.Syslog.TLSServer.MtlsEnable != nil {
+				mtls_enableLevel3Map := make(map[string]interface{})
+				if !data.Syslog.TLSServer.MtlsEnable.Certificate.IsNull() && !data.Syslog.TLSServer.MtlsEnable.Certificate.IsUnknown() {
+					mtls_enableLevel3Map["certificate"] = data.Syslog.TLSServer.MtlsEnable.Certificate.ValueString()
+				}
+				if data.Syslog.TLSServer.MtlsEnable.KeyURL != nil {
+					key_urlLevel4Map := make(map[string]interface{})
+					if data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsNull() && !data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						key_urlLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsNull() && !data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsNull() && !data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo.URL.ValueString()
+						}
+						key_urlLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					mtls_enableLevel3Map["key_url"] = key_urlLevel4Map
+				}
+				tls_serverNestedMap["mtls_enable"] = mtls_enableLevel3Map
+			}
 			if !data.Syslog.TLSServer.Port.IsNull() && !data.Syslog.TLSServer.Port.IsUnknown() {
 				tls_serverNestedMap["port"] = data.Syslog.TLSServer.Port.ValueInt64()
 			}
@@ -487,6 +608,9 @@ func (r *LogReceiverResource) Create(ctx context.Context, req resource.CreateReq
 			if !data.Syslog.TLSServer.TrustedCAURL.IsNull() && !data.Syslog.TLSServer.TrustedCAURL.IsUnknown() {
 				tls_serverNestedMap["trusted_ca_url"] = data.Syslog.TLSServer.TrustedCAURL.ValueString()
 			}
+			if data.Syslog.TLSServer.VolterraCA != nil {
+				tls_serverNestedMap["volterra_ca"] = map[string]interface{}{}
+			}
 			syslogMap["tls_server"] = tls_serverNestedMap
 		}
 		if data.Syslog.UDPServer != nil {
@@ -504,11 +628,15 @@ func (r *LogReceiverResource) Create(ctx context.Context, req resource.CreateReq
 
 	apiResource, err := r.client.CreateLogReceiver(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create LogReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "log_receiver", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -641,7 +769,7 @@ func (r *LogReceiverResource) Read(ctx context.Context, req resource.ReadRequest
 	apiResource, err := r.client.GetLogReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "LogReceiver not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -649,11 +777,12 @@ func (r *LogReceiverResource) Read(ctx context.Context, req resource.ReadRequest
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read LogReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "log_receiver", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -664,31 +793,13 @@ func (r *LogReceiverResource) Read(ctx context.Context, req resource.ReadRequest
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -696,6 +807,7 @@ func (r *LogReceiverResource) Read(ctx context.Context, req resource.ReadRequest
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["site_local"].(map[string]interface{}); ok && isImport && data.SiteLocal == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -806,7 +918,8 @@ func (r *LogReceiverResource) Read(ctx context.Context, req resource.ReadRequest
 
 func (r *LogReceiverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data LogReceiverResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -840,6 +953,7 @@ func (r *LogReceiverResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -849,6 +963,7 @@ func (r *LogReceiverResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.SiteLocal != nil {
@@ -872,6 +987,49 @@ func (r *LogReceiverResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		if data.Syslog.TLSServer != nil {
 			tls_serverNestedMap := make(map[string]interface{})
+			if data.Syslog.TLSServer.DefaultHTTPSPort != nil {
+				tls_serverNestedMap["default_https_port"] = map[string]interface{}{}
+			}
+			if data.Syslog.TLSServer.DefaultSyslogTLSPort != nil {
+				tls_serverNestedMap["default_syslog_tls_port"] = map[string]interface{}{}
+			}
+			if data.Syslog.TLSServer.MtlsDisabled != nil {
+				tls_serverNestedMap["mtls_disabled"] = map[string]interface{}{}
+			}
+			if data.Syslog.TLSServer.MtlsEnable != nil {
+				mtls_enableLevel3Map := make(map[string]interface{})
+				if !data.Syslog.TLSServer.MtlsEnable.Certificate.IsNull() && !data.Syslog.TLSServer.MtlsEnable.Certificate.IsUnknown() {
+					mtls_enableLevel3Map["certificate"] = data.Syslog.TLSServer.MtlsEnable.Certificate.ValueString()
+				}
+				if data.Syslog.TLSServer.MtlsEnable.KeyURL != nil {
+					key_urlLevel4Map := make(map[string]interface{})
+					if data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsNull() && !data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.Syslog.TLSServer.MtlsEnable.KeyURL.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						key_urlLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsNull() && !data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsNull() && !data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.Syslog.TLSServer.MtlsEnable.KeyURL.ClearSecretInfo.URL.ValueString()
+						}
+						key_urlLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					mtls_enableLevel3Map["key_url"] = key_urlLevel4Map
+				}
+				tls_serverNestedMap["mtls_enable"] = mtls_enableLevel3Map
+			}
 			if !data.Syslog.TLSServer.Port.IsNull() && !data.Syslog.TLSServer.Port.IsUnknown() {
 				tls_serverNestedMap["port"] = data.Syslog.TLSServer.Port.ValueInt64()
 			}
@@ -881,6 +1039,9 @@ func (r *LogReceiverResource) Update(ctx context.Context, req resource.UpdateReq
 			if !data.Syslog.TLSServer.TrustedCAURL.IsNull() && !data.Syslog.TLSServer.TrustedCAURL.IsUnknown() {
 				tls_serverNestedMap["trusted_ca_url"] = data.Syslog.TLSServer.TrustedCAURL.ValueString()
 			}
+			if data.Syslog.TLSServer.VolterraCA != nil {
+				tls_serverNestedMap["volterra_ca"] = map[string]interface{}{}
+			}
 			syslogMap["tls_server"] = tls_serverNestedMap
 		}
 		if data.Syslog.UDPServer != nil {
@@ -898,7 +1059,7 @@ func (r *LogReceiverResource) Update(ctx context.Context, req resource.UpdateReq
 
 	_, err := r.client.UpdateLogReceiver(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update LogReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "log_receiver", "update"))
 		return
 	}
 
@@ -909,9 +1070,12 @@ func (r *LogReceiverResource) Update(ctx context.Context, req resource.UpdateReq
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetLogReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read LogReceiver after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "log_receiver", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1044,7 +1208,7 @@ func (r *LogReceiverResource) Delete(ctx context.Context, req resource.DeleteReq
 	err := r.client.DeleteLogReceiver(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "LogReceiver already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1053,37 +1217,33 @@ func (r *LogReceiverResource) Delete(ctx context.Context, req resource.DeleteReq
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "LogReceiver delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete LogReceiver: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "log_receiver", "delete"))
 		return
 	}
 }
 
 func (r *LogReceiverResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *LogReceiverResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("log_receiver", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *LogReceiverResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListLogReceivers(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *LogReceiverResource) requiredNamespace() string {
+	return ""
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,18 @@ type MaliciousUserMitigationDataSource struct {
 	client *client.Client
 }
 
+// MaliciousUserMitigationDataSourceModel mirrors MaliciousUserMitigationResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type MaliciousUserMitigationDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object                                `tfsdk:"system_metadata"`
+	Name           types.String                                `tfsdk:"name"`
+	Namespace      types.String                                `tfsdk:"namespace"`
+	Annotations    types.Map                                   `tfsdk:"annotations"`
+	Description    types.String                                `tfsdk:"description"`
+	Disable        types.Bool                                  `tfsdk:"disable"`
+	Labels         types.Map                                   `tfsdk:"labels"`
+	ID             types.String                                `tfsdk:"id"`
+	MitigationType *MaliciousUserMitigationMitigationTypeModel `tfsdk:"mitigation_type"`
 }
 
 func (d *MaliciousUserMitigationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +45,7 @@ func (d *MaliciousUserMitigationDataSource) Metadata(ctx context.Context, req da
 }
 
 func (d *MaliciousUserMitigationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages malicious_user_mitigation creates a new object in the storage backend for metadata.namespace. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the MaliciousUserMitigation.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the MaliciousUserMitigation exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the MaliciousUserMitigation.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewMaliciousUserMitigationResource())
 }
 
 func (d *MaliciousUserMitigationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +67,25 @@ func (d *MaliciousUserMitigationDataSource) Read(ctx context.Context, req dataso
 		return
 	}
 
-	resource, err := d.client.GetMaliciousUserMitigation(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetMaliciousUserMitigation(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read MaliciousUserMitigation: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "malicious_user_mitigation", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +99,8 @@ func (d *MaliciousUserMitigationDataSource) Read(ctx context.Context, req dataso
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +109,77 @@ func (d *MaliciousUserMitigationDataSource) Read(ctx context.Context, req dataso
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["mitigation_type"].(map[string]interface{}); ok && (isImport || data.MitigationType != nil) {
+		data.MitigationType = &MaliciousUserMitigationMitigationTypeModel{
+			Rules: func() []MaliciousUserMitigationMitigationTypeRulesModel {
+				if listData, ok := blockData["rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []MaliciousUserMitigationMitigationTypeRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, MaliciousUserMitigationMitigationTypeRulesModel{
+								MitigationAction: func() *MaliciousUserMitigationMitigationTypeRulesMitigationActionModel {
+									if deepMap, ok := itemMap["mitigation_action"].(map[string]interface{}); ok {
+										return &MaliciousUserMitigationMitigationTypeRulesMitigationActionModel{
+											BlockTemporarily: func() *MaliciousUserMitigationEmptyModel {
+												if _, ok := deepMap["block_temporarily"].(map[string]interface{}); ok {
+													return &MaliciousUserMitigationEmptyModel{}
+												}
+												return nil
+											}(),
+											CaptchaChallenge: func() *MaliciousUserMitigationEmptyModel {
+												if _, ok := deepMap["captcha_challenge"].(map[string]interface{}); ok {
+													return &MaliciousUserMitigationEmptyModel{}
+												}
+												return nil
+											}(),
+											JavascriptChallenge: func() *MaliciousUserMitigationEmptyModel {
+												if _, ok := deepMap["javascript_challenge"].(map[string]interface{}); ok {
+													return &MaliciousUserMitigationEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+								ThreatLevel: func() *MaliciousUserMitigationMitigationTypeRulesThreatLevelModel {
+									if deepMap, ok := itemMap["threat_level"].(map[string]interface{}); ok {
+										return &MaliciousUserMitigationMitigationTypeRulesThreatLevelModel{
+											High: func() *MaliciousUserMitigationEmptyModel {
+												if _, ok := deepMap["high"].(map[string]interface{}); ok {
+													return &MaliciousUserMitigationEmptyModel{}
+												}
+												return nil
+											}(),
+											Low: func() *MaliciousUserMitigationEmptyModel {
+												if _, ok := deepMap["low"].(map[string]interface{}); ok {
+													return &MaliciousUserMitigationEmptyModel{}
+												}
+												return nil
+											}(),
+											Medium: func() *MaliciousUserMitigationEmptyModel {
+												if _, ok := deepMap["medium"].(map[string]interface{}); ok {
+													return &MaliciousUserMitigationEmptyModel{}
+												}
+												return nil
+											}(),
+										}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -6,9 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +20,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &MaliciousUserMitigationResource{}
-	_ resource.ResourceWithConfigure      = &MaliciousUserMitigationResource{}
-	_ resource.ResourceWithImportState    = &MaliciousUserMitigationResource{}
-	_ resource.ResourceWithModifyPlan     = &MaliciousUserMitigationResource{}
-	_ resource.ResourceWithValidateConfig = &MaliciousUserMitigationResource{}
+	_ resource.Resource                     = &MaliciousUserMitigationResource{}
+	_ resource.ResourceWithConfigure        = &MaliciousUserMitigationResource{}
+	_ resource.ResourceWithImportState      = &MaliciousUserMitigationResource{}
+	_ resource.ResourceWithIdentity         = &MaliciousUserMitigationResource{}
+	_ resource.ResourceWithMoveState        = &MaliciousUserMitigationResource{}
+	_ resource.ResourceWithModifyPlan       = &MaliciousUserMitigationResource{}
+	_ resource.ResourceWithValidateConfig   = &MaliciousUserMitigationResource{}
+	_ resource.ResourceWithConfigValidators = &MaliciousUserMitigationResource{}
+	_ listableResource                      = &MaliciousUserMitigationResource{}
 )
 
 func NewMaliciousUserMitigationResource() resource.Resource {
@@ -104,6 +109,8 @@ type MaliciousUserMitigationResourceModel struct {
 	Labels         types.Map                                   `tfsdk:"labels"`
 	ID             types.String                                `tfsdk:"id"`
 	Timeouts       timeouts.Value                              `tfsdk:"timeouts"`
+	SystemMetadata types.Object                                `tfsdk:"system_metadata"`
+	LabelsAll      types.Map                                   `tfsdk:"labels_all"`
 	MitigationType *MaliciousUserMitigationMitigationTypeModel `tfsdk:"mitigation_type"`
 }
 
@@ -126,9 +133,11 @@ func (r *MaliciousUserMitigationResource) Schema(ctx context.Context, req resour
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Malicious User Mitigation will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Malicious User Mitigation will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -160,6 +169,8 @@ func (r *MaliciousUserMitigationResource) Schema(ctx context.Context, req resour
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -174,6 +185,9 @@ func (r *MaliciousUserMitigationResource) Schema(ctx context.Context, req resour
 				Blocks: map[string]schema.Block{
 					"rules": schema.ListNestedBlock{
 						MarkdownDescription: "Define the threat levels and the corresponding mitigation actions to be taken .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(3),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{},
 							Blocks: map[string]schema.Block{
@@ -216,6 +230,11 @@ func (r *MaliciousUserMitigationResource) Schema(ctx context.Context, req resour
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *MaliciousUserMitigationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *MaliciousUserMitigationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -240,6 +259,15 @@ func (r *MaliciousUserMitigationResource) ValidateConfig(ctx context.Context, re
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *MaliciousUserMitigationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("mitigation_type").AtName("rules").AtAnyListIndex().AtName("mitigation_action"), "mitigation_action", "block_temporarily", "captcha_challenge", "javascript_challenge"),
+		validators.OneOfGroup(path.MatchRoot("mitigation_type").AtName("rules").AtAnyListIndex().AtName("threat_level"), "threat_level", "high", "low", "medium"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *MaliciousUserMitigationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -250,7 +278,10 @@ func (r *MaliciousUserMitigationResource) ModifyPlan(ctx context.Context, req re
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "malicious_user_mitigation", "", req.Config, &resp.Plan)...)
 		var plan MaliciousUserMitigationResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -307,6 +338,7 @@ func (r *MaliciousUserMitigationResource) Create(ctx context.Context, req resour
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -316,6 +348,7 @@ func (r *MaliciousUserMitigationResource) Create(ctx context.Context, req resour
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.MitigationType != nil {
@@ -359,11 +392,15 @@ func (r *MaliciousUserMitigationResource) Create(ctx context.Context, req resour
 
 	apiResource, err := r.client.CreateMaliciousUserMitigation(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create MaliciousUserMitigation: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "malicious_user_mitigation", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -460,7 +497,7 @@ func (r *MaliciousUserMitigationResource) Read(ctx context.Context, req resource
 	apiResource, err := r.client.GetMaliciousUserMitigation(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "MaliciousUserMitigation not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -468,11 +505,12 @@ func (r *MaliciousUserMitigationResource) Read(ctx context.Context, req resource
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read MaliciousUserMitigation: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "malicious_user_mitigation", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -483,31 +521,13 @@ func (r *MaliciousUserMitigationResource) Read(ctx context.Context, req resource
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -515,6 +535,7 @@ func (r *MaliciousUserMitigationResource) Read(ctx context.Context, req resource
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["mitigation_type"].(map[string]interface{}); ok && (isImport || data.MitigationType != nil) {
 		data.MitigationType = &MaliciousUserMitigationMitigationTypeModel{
@@ -623,6 +644,7 @@ func (r *MaliciousUserMitigationResource) Update(ctx context.Context, req resour
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -632,6 +654,7 @@ func (r *MaliciousUserMitigationResource) Update(ctx context.Context, req resour
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.MitigationType != nil {
@@ -675,7 +698,7 @@ func (r *MaliciousUserMitigationResource) Update(ctx context.Context, req resour
 
 	_, err := r.client.UpdateMaliciousUserMitigation(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update MaliciousUserMitigation: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "malicious_user_mitigation", "update"))
 		return
 	}
 
@@ -686,9 +709,12 @@ func (r *MaliciousUserMitigationResource) Update(ctx context.Context, req resour
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetMaliciousUserMitigation(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read MaliciousUserMitigation after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "malicious_user_mitigation", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -785,7 +811,7 @@ func (r *MaliciousUserMitigationResource) Delete(ctx context.Context, req resour
 	err := r.client.DeleteMaliciousUserMitigation(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "MaliciousUserMitigation already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -794,37 +820,33 @@ func (r *MaliciousUserMitigationResource) Delete(ctx context.Context, req resour
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "MaliciousUserMitigation delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete MaliciousUserMitigation: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "malicious_user_mitigation", "delete"))
 		return
 	}
 }
 
 func (r *MaliciousUserMitigationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *MaliciousUserMitigationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("malicious_user_mitigation", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *MaliciousUserMitigationResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListMaliciousUserMitigations(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *MaliciousUserMitigationResource) requiredNamespace() string {
+	return ""
 }
@@ -0,0 +1,75 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// move_state_helpers.go - Manually maintained helpers that move resources of
+// the volterraedge/volterra provider to the resources of this provider with
+// moved blocks of Terraform 1.8+. Both manage the same API objects, so only
+// the object is taken from the source state, and the next refresh reads it
+// from the API as if it was imported.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// volterraProviderAddress is the address of the volterraedge/volterra provider
+const volterraProviderAddress = "registry.terraform.io/volterraedge/volterra"
+
+// moveStateFromVolterra returns the state mover from the volterra_ resource
+// of the same type. Objects of types without a namespace in the API path are
+// moved by name only.
+func moveStateFromVolterra(resourceType string, namespaced bool) resource.StateMover {
+	return resource.StateMover{
+		StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+			// Leaving the target state unset lets Terraform report that the
+			// source cannot be moved
+			if req.SourceProviderAddress != volterraProviderAddress || req.SourceTypeName != "volterra_"+resourceType {
+				return
+			}
+			if req.SourceRawState == nil {
+				resp.Diagnostics.AddError("Missing Source State",
+					fmt.Sprintf("The %s has no state to move.", req.SourceTypeName))
+				return
+			}
+
+			var source struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			}
+			if err := json.Unmarshal(req.SourceRawState.JSON, &source); err != nil {
+				resp.Diagnostics.AddError("Invalid Source State",
+					fmt.Sprintf("Unable to decode the state of the %s: %s", req.SourceTypeName, err))
+				return
+			}
+			if !namespaced {
+				source.Namespace = ""
+			}
+			if source.Name == "" || (namespaced && source.Namespace == "") {
+				resp.Diagnostics.AddError("Invalid Source State",
+					fmt.Sprintf("The state of the %s does not have the name and namespace of its object.", req.SourceTypeName))
+				return
+			}
+
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("namespace"), source.Namespace)...)
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("name"), source.Name)...)
+			resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), source.Name)...)
+			if resp.TargetIdentity != nil {
+				resp.Diagnostics.Append(resp.TargetIdentity.Set(ctx, resourceIdentityModel{
+					Namespace: stringValueOrNull(source.Namespace),
+					Name:      types.StringValue(source.Name),
+					Tenant:    types.StringNull(),
+				})...)
+			}
+
+			// Read populates all nested blocks from the API response, as after
+			// an import
+			resp.Diagnostics.Append(resp.TargetPrivate.SetKey(ctx, "isImport", []byte("true"))...)
+		},
+	}
+}
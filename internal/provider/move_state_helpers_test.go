@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestMoveStateFromVolterra(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("NewProtocol6WithError() error = %v", err)
+	}
+
+	tests := []struct {
+		name           string
+		sourceAddress  string
+		sourceTypeName string
+		targetTypeName string
+		sourceState    string
+		wantIdentity   tftypes.Value
+		wantErr        bool
+	}{
+		{"origin pool", volterraProviderAddress, "volterra_origin_pool", "f5xc_origin_pool",
+			`{"id": "0b8d7c2e", "name": "pool", "namespace": "staging", "port": 443}`, identityValue("staging", "pool", nil), false},
+		{"type without namespace", volterraProviderAddress, "volterra_namespace", "f5xc_namespace",
+			`{"id": "4f1a", "name": "staging", "namespace": ""}`, identityValue(nil, "staging", nil), false},
+		{"other type", volterraProviderAddress, "volterra_healthcheck", "f5xc_origin_pool",
+			`{"name": "pool", "namespace": "staging"}`, tftypes.Value{}, true},
+		{"other provider", "registry.terraform.io/example/volterra", "volterra_origin_pool", "f5xc_origin_pool",
+			`{"name": "pool", "namespace": "staging"}`, tftypes.Value{}, true},
+		{"missing namespace", volterraProviderAddress, "volterra_origin_pool", "f5xc_origin_pool",
+			`{"name": "pool"}`, tftypes.Value{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resp, err := server.MoveResourceState(ctx, &tfprotov6.MoveResourceStateRequest{
+				SourceProviderAddress: tt.sourceAddress,
+				SourceTypeName:        tt.sourceTypeName,
+				SourceState:           &tfprotov6.RawState{JSON: []byte(tt.sourceState)},
+				TargetTypeName:        tt.targetTypeName,
+			})
+			if err != nil {
+				t.Fatalf("MoveResourceState() error = %v", err)
+			}
+			hasError := false
+			for _, d := range resp.Diagnostics {
+				hasError = hasError || d.Severity == tfprotov6.DiagnosticSeverityError
+			}
+			if hasError != tt.wantErr {
+				t.Fatalf("MoveResourceState() diagnostics = %v, want error %t", resp.Diagnostics, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if resp.TargetIdentity == nil || resp.TargetState == nil {
+				t.Fatal("MoveResourceState() did not return the target state and identity")
+			}
+			got, err := resp.TargetIdentity.IdentityData.Unmarshal(identityType)
+			if err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !got.Equal(tt.wantIdentity) {
+				t.Errorf("identity = %s, want %s", got, tt.wantIdentity)
+			}
+			if string(resp.TargetPrivate) == "" {
+				t.Error("MoveResourceState() did not mark the object for reading as after an import")
+			}
+		})
+	}
+}
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &NATPolicyResource{}
-	_ resource.ResourceWithConfigure      = &NATPolicyResource{}
-	_ resource.ResourceWithImportState    = &NATPolicyResource{}
-	_ resource.ResourceWithModifyPlan     = &NATPolicyResource{}
-	_ resource.ResourceWithValidateConfig = &NATPolicyResource{}
+	_ resource.Resource                     = &NATPolicyResource{}
+	_ resource.ResourceWithConfigure        = &NATPolicyResource{}
+	_ resource.ResourceWithImportState      = &NATPolicyResource{}
+	_ resource.ResourceWithIdentity         = &NATPolicyResource{}
+	_ resource.ResourceWithMoveState        = &NATPolicyResource{}
+	_ resource.ResourceWithModifyPlan       = &NATPolicyResource{}
+	_ resource.ResourceWithValidateConfig   = &NATPolicyResource{}
+	_ resource.ResourceWithConfigValidators = &NATPolicyResource{}
+	_ listableResource                      = &NATPolicyResource{}
 )
 
 func NewNATPolicyResource() resource.Resource {
@@ -468,16 +475,18 @@ var NATPolicySiteRefsModelAttrTypes = map[string]attr.Type{
 }
 
 type NATPolicyResourceModel struct {
-	Name        types.String        `tfsdk:"name"`
-	Namespace   types.String        `tfsdk:"namespace"`
-	Annotations types.Map           `tfsdk:"annotations"`
-	Description types.String        `tfsdk:"description"`
-	Disable     types.Bool          `tfsdk:"disable"`
-	Labels      types.Map           `tfsdk:"labels"`
-	ID          types.String        `tfsdk:"id"`
-	Timeouts    timeouts.Value      `tfsdk:"timeouts"`
-	Rules       types.List          `tfsdk:"rules"`
-	Site        *NATPolicySiteModel `tfsdk:"site"`
+	Name           types.String        `tfsdk:"name"`
+	Namespace      types.String        `tfsdk:"namespace"`
+	Annotations    types.Map           `tfsdk:"annotations"`
+	Description    types.String        `tfsdk:"description"`
+	Disable        types.Bool          `tfsdk:"disable"`
+	Labels         types.Map           `tfsdk:"labels"`
+	ID             types.String        `tfsdk:"id"`
+	Timeouts       timeouts.Value      `tfsdk:"timeouts"`
+	SystemMetadata types.Object        `tfsdk:"system_metadata"`
+	LabelsAll      types.Map           `tfsdk:"labels_all"`
+	Rules          types.List          `tfsdk:"rules"`
+	Site           *NATPolicySiteModel `tfsdk:"site"`
 }
 
 func (r *NATPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -499,9 +508,11 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the NAT Policy will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the NAT Policy will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -533,6 +544,8 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -543,6 +556,10 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 			}),
 			"rules": schema.ListNestedBlock{
 				MarkdownDescription: "List of rules to apply under the NAT Policy. Rule that matches first would be applied .",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(64),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
@@ -557,6 +574,9 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 								"virtual_cidr": schema.StringAttribute{
 									MarkdownDescription: "Virtual Subnet NAT is static NAT that does a one-to-one translation between the real source IP CIDR in the policy and the virtual CIDR in a bidirectional fashion. The range of the real CIDR and virtual CIDRs should be the same (e.g. If the real CIDR has the CIDR..",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.IPPrefixValidator(),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -570,6 +590,9 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 											Blocks: map[string]schema.Block{
 												"refs": schema.ListNestedBlock{
 													MarkdownDescription: "Reference to one or more cloud elastic IP objects .",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -620,6 +643,11 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 													MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+													},
 												},
 											},
 										},
@@ -633,6 +661,9 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 							Blocks: map[string]schema.Block{
 								"refs": schema.ListNestedBlock{
 									MarkdownDescription: "Reference to Cloud Connect Object .",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(1),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"kind": schema.StringAttribute{
@@ -704,10 +735,17 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 										"port": schema.Int64Attribute{
 											MarkdownDescription: "Exact Port to match.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtMost(65535),
+											},
 										},
 										"port_ranges": schema.StringAttribute{
 											MarkdownDescription: "Port range to match.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(32),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -725,6 +763,9 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 									Blocks: map[string]schema.Block{
 										"refs": schema.ListNestedBlock{
 											MarkdownDescription: "Reference to Segment Object .",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"kind": schema.StringAttribute{
@@ -774,10 +815,17 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 										"port": schema.Int64Attribute{
 											MarkdownDescription: "Exact Port to match.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtMost(65535),
+											},
 										},
 										"port_ranges": schema.StringAttribute{
 											MarkdownDescription: "Port range to match.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(32),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -796,10 +844,17 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 												"port": schema.Int64Attribute{
 													MarkdownDescription: "Exact Port to match.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtMost(65535),
+													},
 												},
 												"port_ranges": schema.StringAttribute{
 													MarkdownDescription: "Port range to match.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(32),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -814,10 +869,17 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 												"port": schema.Int64Attribute{
 													MarkdownDescription: "Exact Port to match.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtMost(65535),
+													},
 												},
 												"port_ranges": schema.StringAttribute{
 													MarkdownDescription: "Port range to match.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(32),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -838,10 +900,17 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 												"port": schema.Int64Attribute{
 													MarkdownDescription: "Exact Port to match.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtMost(65535),
+													},
 												},
 												"port_ranges": schema.StringAttribute{
 													MarkdownDescription: "Port range to match.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(32),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -856,10 +925,17 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 												"port": schema.Int64Attribute{
 													MarkdownDescription: "Exact Port to match.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtMost(65535),
+													},
 												},
 												"port_ranges": schema.StringAttribute{
 													MarkdownDescription: "Port range to match.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(32),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -876,6 +952,9 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 									Blocks: map[string]schema.Block{
 										"refs": schema.ListNestedBlock{
 											MarkdownDescription: "Virtual Network Reference. Reference to virtual network.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"kind": schema.StringAttribute{
@@ -933,6 +1012,9 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 							Blocks: map[string]schema.Block{
 								"refs": schema.ListNestedBlock{
 									MarkdownDescription: "Reference to Network Interface Object .",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(1),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"kind": schema.StringAttribute{
@@ -982,6 +1064,9 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 							Blocks: map[string]schema.Block{
 								"refs": schema.ListNestedBlock{
 									MarkdownDescription: "Reference to Segment Object .",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(1),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"kind": schema.StringAttribute{
@@ -1031,6 +1116,9 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 							Blocks: map[string]schema.Block{
 								"refs": schema.ListNestedBlock{
 									MarkdownDescription: "Virtual Network Reference. Reference to virtual network.",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(1),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"kind": schema.StringAttribute{
@@ -1130,6 +1218,11 @@ func (r *NATPolicyResource) Schema(ctx context.Context, req resource.SchemaReque
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *NATPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *NATPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -1154,6 +1247,25 @@ func (r *NATPolicyResource) ValidateConfig(ctx context.Context, req resource.Val
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *NATPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex(), "enable_choice", "disable", "enable"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex(), "scope_choice", "cloud_connect", "network_interface", "segment", "virtual_network"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("action"), "source_nat_choice", "dynamic", "virtual_cidr"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("action").AtName("dynamic"), "pool_choice", "elastic_ips", "pools"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("criteria"), "network_choice", "segment", "virtual_network"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("criteria"), "protocol_choice", "any", "icmp", "tcp", "udp"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("criteria").AtName("destination_port"), "port_match", "no_port_match", "port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("criteria").AtName("source_port"), "port_match", "no_port_match", "port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("criteria").AtName("tcp").AtName("destination_port"), "port_match", "no_port_match", "port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("criteria").AtName("tcp").AtName("source_port"), "port_match", "no_port_match", "port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("criteria").AtName("udp").AtName("destination_port"), "port_match", "no_port_match", "port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtAnyListIndex().AtName("criteria").AtName("udp").AtName("source_port"), "port_match", "no_port_match", "port", "port_ranges"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *NATPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -1164,7 +1276,10 @@ func (r *NATPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyP
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "nat_policy", "", req.Config, &resp.Plan)...)
 		var plan NATPolicyResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -1221,6 +1336,7 @@ func (r *NATPolicyResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1230,6 +1346,7 @@ func (r *NATPolicyResource) Create(ctx context.Context, req resource.CreateReque
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Rules.IsNull() && !data.Rules.IsUnknown() {
@@ -1244,6 +1361,21 @@ func (r *NATPolicyResource) Create(ctx context.Context, req resource.CreateReque
 					actionNestedMap := make(map[string]interface{})
 					if item.Action.Dynamic != nil {
 						dynamicDeepMap := make(map[string]interface{})
+						if item.Action.Dynamic.ElasticIps != nil {
+							elastic_ipsLevel4Map := make(map[string]interface{})
+							dynamicDeepMap["elastic_ips"] = elastic_ipsLevel4Map
+						}
+						if item.Action.Dynamic.Pools != nil {
+							poolsLevel4Map := make(map[string]interface{})
+							if !item.Action.Dynamic.Pools.Prefixes.IsNull() && !item.Action.Dynamic.Pools.Prefixes.IsUnknown() {
+								var prefixesLevel4Items []string
+								diags := item.Action.Dynamic.Pools.Prefixes.ElementsAs(ctx, &prefixesLevel4Items, false)
+								if !diags.HasError() {
+									poolsLevel4Map["prefixes"] = prefixesLevel4Items
+								}
+							}
+							dynamicDeepMap["pools"] = poolsLevel4Map
+						}
 						actionNestedMap["dynamic"] = dynamicDeepMap
 					}
 					if !item.Action.VirtualCIDR.IsNull() && !item.Action.VirtualCIDR.IsUnknown() {
@@ -1335,10 +1467,62 @@ func (r *NATPolicyResource) Create(ctx context.Context, req resource.CreateReque
 					}
 					if item.Criteria.TCP != nil {
 						tcpDeepMap := make(map[string]interface{})
+						if item.Criteria.TCP.DestinationPort != nil {
+							destination_portLevel4Map := make(map[string]interface{})
+							if item.Criteria.TCP.DestinationPort.NoPortMatch != nil {
+								destination_portLevel4Map["no_port_match"] = map[string]interface{}{}
+							}
+							if !item.Criteria.TCP.DestinationPort.Port.IsNull() && !item.Criteria.TCP.DestinationPort.Port.IsUnknown() {
+								destination_portLevel4Map["port"] = item.Criteria.TCP.DestinationPort.Port.ValueInt64()
+							}
+							if !item.Criteria.TCP.DestinationPort.PortRanges.IsNull() && !item.Criteria.TCP.DestinationPort.PortRanges.IsUnknown() {
+								destination_portLevel4Map["port_ranges"] = item.Criteria.TCP.DestinationPort.PortRanges.ValueString()
+							}
+							tcpDeepMap["destination_port"] = destination_portLevel4Map
+						}
+						if item.Criteria.TCP.SourcePort != nil {
+							source_portLevel4Map := make(map[string]interface{})
+							if item.Criteria.TCP.SourcePort.NoPortMatch != nil {
+								source_portLevel4Map["no_port_match"] = map[string]interface{}{}
+							}
+							if !item.Criteria.TCP.SourcePort.Port.IsNull() && !item.Criteria.TCP.SourcePort.Port.IsUnknown() {
+								source_portLevel4Map["port"] = item.Criteria.TCP.SourcePort.Port.ValueInt64()
+							}
+							if !item.Criteria.TCP.SourcePort.PortRanges.IsNull() && !item.Criteria.TCP.SourcePort.PortRanges.IsUnknown() {
+								source_portLevel4Map["port_ranges"] = item.Criteria.TCP.SourcePort.PortRanges.ValueString()
+							}
+							tcpDeepMap["source_port"] = source_portLevel4Map
+						}
 						criteriaNestedMap["tcp"] = tcpDeepMap
 					}
 					if item.Criteria.UDP != nil {
 						udpDeepMap := make(map[string]interface{})
+						if item.Criteria.UDP.DestinationPort != nil {
+							destination_portLevel4Map := make(map[string]interface{})
+							if item.Criteria.UDP.DestinationPort.NoPortMatch != nil {
+								destination_portLevel4Map["no_port_match"] = map[string]interface{}{}
+							}
+							if !item.Criteria.UDP.DestinationPort.Port.IsNull() && !item.Criteria.UDP.DestinationPort.Port.IsUnknown() {
+								destination_portLevel4Map["port"] = item.Criteria.UDP.DestinationPort.Port.ValueInt64()
+							}
+							if !item.Criteria.UDP.DestinationPort.PortRanges.IsNull() && !item.Criteria.UDP.DestinationPort.PortRanges.IsUnknown() {
+								destination_portLevel4Map["port_ranges"] = item.Criteria.UDP.DestinationPort.PortRanges.ValueString()
+							}
+							udpDeepMap["destination_port"] = destination_portLevel4Map
+						}
+						if item.Criteria.UDP.SourcePort != nil {
+							source_portLevel4Map := make(map[string]interface{})
+							if item.Criteria.UDP.SourcePort.NoPortMatch != nil {
+								source_portLevel4Map["no_port_match"] = map[string]interface{}{}
+							}
+							if !item.Criteria.UDP.SourcePort.Port.IsNull() && !item.Criteria.UDP.SourcePort.Port.IsUnknown() {
+								source_portLevel4Map["port"] = item.Criteria.UDP.SourcePort.Port.ValueInt64()
+							}
+							if !item.Criteria.UDP.SourcePort.PortRanges.IsNull() && !item.Criteria.UDP.SourcePort.PortRanges.IsUnknown() {
+								source_portLevel4Map["port_ranges"] = item.Criteria.UDP.SourcePort.PortRanges.ValueString()
+							}
+							udpDeepMap["source_port"] = source_portLevel4Map
+						}
 						criteriaNestedMap["udp"] = udpDeepMap
 					}
 					if item.Criteria.VirtualNetwork != nil {
@@ -1472,11 +1656,15 @@ func (r *NATPolicyResource) Create(ctx context.Context, req resource.CreateReque
 
 	apiResource, err := r.client.CreateNATPolicy(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create NATPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nat_policy", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1681,7 +1869,7 @@ func (r *NATPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 	apiResource, err := r.client.GetNATPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NATPolicy not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1689,11 +1877,12 @@ func (r *NATPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NATPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nat_policy", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1704,31 +1893,13 @@ func (r *NATPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1736,6 +1907,7 @@ func (r *NATPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if listData, ok := apiResource.Spec["rules"].([]interface{}); ok && len(listData) > 0 {
 		var rulesList []NATPolicyRulesModel
@@ -1952,6 +2124,7 @@ func (r *NATPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1961,6 +2134,7 @@ func (r *NATPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Rules.IsNull() && !data.Rules.IsUnknown() {
@@ -1975,6 +2149,21 @@ func (r *NATPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 					actionNestedMap := make(map[string]interface{})
 					if item.Action.Dynamic != nil {
 						dynamicDeepMap := make(map[string]interface{})
+						if item.Action.Dynamic.ElasticIps != nil {
+							elastic_ipsLevel4Map := make(map[string]interface{})
+							dynamicDeepMap["elastic_ips"] = elastic_ipsLevel4Map
+						}
+						if item.Action.Dynamic.Pools != nil {
+							poolsLevel4Map := make(map[string]interface{})
+							if !item.Action.Dynamic.Pools.Prefixes.IsNull() && !item.Action.Dynamic.Pools.Prefixes.IsUnknown() {
+								var prefixesLevel4Items []string
+								diags := item.Action.Dynamic.Pools.Prefixes.ElementsAs(ctx, &prefixesLevel4Items, false)
+								if !diags.HasError() {
+									poolsLevel4Map["prefixes"] = prefixesLevel4Items
+								}
+							}
+							dynamicDeepMap["pools"] = poolsLevel4Map
+						}
 						actionNestedMap["dynamic"] = dynamicDeepMap
 					}
 					if !item.Action.VirtualCIDR.IsNull() && !item.Action.VirtualCIDR.IsUnknown() {
@@ -2066,10 +2255,62 @@ func (r *NATPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 					}
 					if item.Criteria.TCP != nil {
 						tcpDeepMap := make(map[string]interface{})
+						if item.Criteria.TCP.DestinationPort != nil {
+							destination_portLevel4Map := make(map[string]interface{})
+							if item.Criteria.TCP.DestinationPort.NoPortMatch != nil {
+								destination_portLevel4Map["no_port_match"] = map[string]interface{}{}
+							}
+							if !item.Criteria.TCP.DestinationPort.Port.IsNull() && !item.Criteria.TCP.DestinationPort.Port.IsUnknown() {
+								destination_portLevel4Map["port"] = item.Criteria.TCP.DestinationPort.Port.ValueInt64()
+							}
+							if !item.Criteria.TCP.DestinationPort.PortRanges.IsNull() && !item.Criteria.TCP.DestinationPort.PortRanges.IsUnknown() {
+								destination_portLevel4Map["port_ranges"] = item.Criteria.TCP.DestinationPort.PortRanges.ValueString()
+							}
+							tcpDeepMap["destination_port"] = destination_portLevel4Map
+						}
+						if item.Criteria.TCP.SourcePort != nil {
+							source_portLevel4Map := make(map[string]interface{})
+							if item.Criteria.TCP.SourcePort.NoPortMatch != nil {
+								source_portLevel4Map["no_port_match"] = map[string]interface{}{}
+							}
+							if !item.Criteria.TCP.SourcePort.Port.IsNull() && !item.Criteria.TCP.SourcePort.Port.IsUnknown() {
+								source_portLevel4Map["port"] = item.Criteria.TCP.SourcePort.Port.ValueInt64()
+							}
+							if !item.Criteria.TCP.SourcePort.PortRanges.IsNull() && !item.Criteria.TCP.SourcePort.PortRanges.IsUnknown() {
+								source_portLevel4Map["port_ranges"] = item.Criteria.TCP.SourcePort.PortRanges.ValueString()
+							}
+							tcpDeepMap["source_port"] = source_portLevel4Map
+						}
 						criteriaNestedMap["tcp"] = tcpDeepMap
 					}
 					if item.Criteria.UDP != nil {
 						udpDeepMap := make(map[string]interface{})
+						if item.Criteria.UDP.DestinationPort != nil {
+							destination_portLevel4Map := make(map[string]interface{})
+							if item.Criteria.UDP.DestinationPort.NoPortMatch != nil {
+								destination_portLevel4Map["no_port_match"] = map[string]interface{}{}
+							}
+							if !item.Criteria.UDP.DestinationPort.Port.IsNull() && !item.Criteria.UDP.DestinationPort.Port.IsUnknown() {
+								destination_portLevel4Map["port"] = item.Criteria.UDP.DestinationPort.Port.ValueInt64()
+							}
+							if !item.Criteria.UDP.DestinationPort.PortRanges.IsNull() && !item.Criteria.UDP.DestinationPort.PortRanges.IsUnknown() {
+								destination_portLevel4Map["port_ranges"] = item.Criteria.UDP.DestinationPort.PortRanges.ValueString()
+							}
+							udpDeepMap["destination_port"] = destination_portLevel4Map
+						}
+						if item.Criteria.UDP.SourcePort != nil {
+							source_portLevel4Map := make(map[string]interface{})
+							if item.Criteria.UDP.SourcePort.NoPortMatch != nil {
+								source_portLevel4Map["no_port_match"] = map[string]interface{}{}
+							}
+							if !item.Criteria.UDP.SourcePort.Port.IsNull() && !item.Criteria.UDP.SourcePort.Port.IsUnknown() {
+								source_portLevel4Map["port"] = item.Criteria.UDP.SourcePort.Port.ValueInt64()
+							}
+							if !item.Criteria.UDP.SourcePort.PortRanges.IsNull() && !item.Criteria.UDP.SourcePort.PortRanges.IsUnknown() {
+								source_portLevel4Map["port_ranges"] = item.Criteria.UDP.SourcePort.PortRanges.ValueString()
+							}
+							udpDeepMap["source_port"] = source_portLevel4Map
+						}
 						criteriaNestedMap["udp"] = udpDeepMap
 					}
 					if item.Criteria.VirtualNetwork != nil {
@@ -2203,7 +2444,7 @@ func (r *NATPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 
 	_, err := r.client.UpdateNATPolicy(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update NATPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nat_policy", "update"))
 		return
 	}
 
@@ -2214,9 +2455,12 @@ func (r *NATPolicyResource) Update(ctx context.Context, req resource.UpdateReque
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetNATPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NATPolicy after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "nat_policy", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -2421,7 +2665,7 @@ func (r *NATPolicyResource) Delete(ctx context.Context, req resource.DeleteReque
 	err := r.client.DeleteNATPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NATPolicy already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -2430,37 +2674,33 @@ func (r *NATPolicyResource) Delete(ctx context.Context, req resource.DeleteReque
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "NATPolicy delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete NATPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nat_policy", "delete"))
 		return
 	}
 }
 
 func (r *NATPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *NATPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("nat_policy", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *NATPolicyResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListNATPolicies(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *NATPolicyResource) requiredNamespace() string {
+	return ""
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,22 @@ type NetworkConnectorDataSource struct {
 	client *client.Client
 }
 
+// NetworkConnectorDataSourceModel mirrors NetworkConnectorResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type NetworkConnectorDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata      types.Object                             `tfsdk:"system_metadata"`
+	Name                types.String                             `tfsdk:"name"`
+	Namespace           types.String                             `tfsdk:"namespace"`
+	Annotations         types.Map                                `tfsdk:"annotations"`
+	Description         types.String                             `tfsdk:"description"`
+	Disable             types.Bool                               `tfsdk:"disable"`
+	Labels              types.Map                                `tfsdk:"labels"`
+	ID                  types.String                             `tfsdk:"id"`
+	DisableForwardProxy *NetworkConnectorEmptyModel              `tfsdk:"disable_forward_proxy"`
+	EnableForwardProxy  *NetworkConnectorEnableForwardProxyModel `tfsdk:"enable_forward_proxy"`
+	SLIToGlobalDR       *NetworkConnectorSLIToGlobalDRModel      `tfsdk:"sli_to_global_dr"`
+	SLIToSloSnat        *NetworkConnectorSLIToSloSnatModel       `tfsdk:"sli_to_slo_snat"`
+	SloToGlobalDR       *NetworkConnectorSloToGlobalDRModel      `tfsdk:"slo_to_global_dr"`
 }
 
 func (d *NetworkConnectorDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +49,7 @@ func (d *NetworkConnectorDataSource) Metadata(ctx context.Context, req datasourc
 }
 
 func (d *NetworkConnectorDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Network Connector resource in F5 Distributed Cloud for network connector is created by users in system namespace. configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the NetworkConnector.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the NetworkConnector exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the NetworkConnector.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewNetworkConnectorResource())
 }
 
 func (d *NetworkConnectorDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +71,25 @@ func (d *NetworkConnectorDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	resource, err := d.client.GetNetworkConnector(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetNetworkConnector(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NetworkConnector: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_connector", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +103,8 @@ func (d *NetworkConnectorDataSource) Read(ctx context.Context, req datasource.Re
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +113,124 @@ func (d *NetworkConnectorDataSource) Read(ctx context.Context, req datasource.Re
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["disable_forward_proxy"].(map[string]interface{}); ok && isImport && data.DisableForwardProxy == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableForwardProxy = &NetworkConnectorEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["enable_forward_proxy"].(map[string]interface{}); ok && (isImport || data.EnableForwardProxy != nil) {
+		data.EnableForwardProxy = &NetworkConnectorEnableForwardProxyModel{
+			ConnectionTimeout: func() types.Int64 {
+				if !isImport && data.EnableForwardProxy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.EnableForwardProxy.ConnectionTimeout
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["connection_timeout"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxConnectAttempts: func() types.Int64 {
+				if !isImport && data.EnableForwardProxy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.EnableForwardProxy.MaxConnectAttempts
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_connect_attempts"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			NoInterception: func() *NetworkConnectorEmptyModel {
+				if !isImport && data.EnableForwardProxy != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EnableForwardProxy.NoInterception
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_interception"].(map[string]interface{}); ok {
+					return &NetworkConnectorEmptyModel{}
+				}
+				return nil
+			}(),
+			TLSIntercept: func() *NetworkConnectorEnableForwardProxyTLSInterceptModel {
+				if !isImport && data.EnableForwardProxy != nil && data.EnableForwardProxy.TLSIntercept != nil {
+					// Normal Read: preserve existing state value
+					return data.EnableForwardProxy.TLSIntercept
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["tls_intercept"].(map[string]interface{}); ok {
+					return &NetworkConnectorEnableForwardProxyTLSInterceptModel{
+						TrustedCAURL: func() types.String {
+							if v, ok := nestedBlockData["trusted_ca_url"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			WhiteListedPorts: func() types.List {
+				if v, ok := blockData["white_listed_ports"].([]interface{}); ok && len(v) > 0 {
+					var items []int64
+					for _, item := range v {
+						if n, ok := item.(float64); ok {
+							items = append(items, int64(n))
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.Int64Type, items)
+					return listVal
+				}
+				return types.ListNull(types.Int64Type)
+			}(),
+			WhiteListedPrefixes: func() types.List {
+				if v, ok := blockData["white_listed_prefixes"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["sli_to_global_dr"].(map[string]interface{}); ok && isImport && data.SLIToGlobalDR == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SLIToGlobalDR = &NetworkConnectorSLIToGlobalDRModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["sli_to_slo_snat"].(map[string]interface{}); ok && isImport && data.SLIToSloSnat == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SLIToSloSnat = &NetworkConnectorSLIToSloSnatModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["slo_to_global_dr"].(map[string]interface{}); ok && isImport && data.SloToGlobalDR == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SloToGlobalDR = &NetworkConnectorSloToGlobalDRModel{}
+	}
+	// Normal Read: preserve existing state value
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewNetworkConnectorResource(), &data)...)
 }
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &NetworkConnectorResource{}
-	_ resource.ResourceWithConfigure      = &NetworkConnectorResource{}
-	_ resource.ResourceWithImportState    = &NetworkConnectorResource{}
-	_ resource.ResourceWithModifyPlan     = &NetworkConnectorResource{}
-	_ resource.ResourceWithValidateConfig = &NetworkConnectorResource{}
+	_ resource.Resource                     = &NetworkConnectorResource{}
+	_ resource.ResourceWithConfigure        = &NetworkConnectorResource{}
+	_ resource.ResourceWithImportState      = &NetworkConnectorResource{}
+	_ resource.ResourceWithIdentity         = &NetworkConnectorResource{}
+	_ resource.ResourceWithMoveState        = &NetworkConnectorResource{}
+	_ resource.ResourceWithModifyPlan       = &NetworkConnectorResource{}
+	_ resource.ResourceWithValidateConfig   = &NetworkConnectorResource{}
+	_ resource.ResourceWithConfigValidators = &NetworkConnectorResource{}
+	_ listableResource                      = &NetworkConnectorResource{}
 )
 
 func NewNetworkConnectorResource() resource.Resource {
@@ -143,14 +150,16 @@ var NetworkConnectorEnableForwardProxyTLSInterceptCustomCertificatePrivateKeyBli
 
 // NetworkConnectorEnableForwardProxyTLSInterceptCustomCertificatePrivateKeyClearSecretInfoModel represents clear_secret_info block
 type NetworkConnectorEnableForwardProxyTLSInterceptCustomCertificatePrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // NetworkConnectorEnableForwardProxyTLSInterceptCustomCertificatePrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for NetworkConnectorEnableForwardProxyTLSInterceptCustomCertificatePrivateKeyClearSecretInfoModel
 var NetworkConnectorEnableForwardProxyTLSInterceptCustomCertificatePrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // NetworkConnectorEnableForwardProxyTLSInterceptPolicyModel represents policy block
@@ -260,6 +269,8 @@ type NetworkConnectorResourceModel struct {
 	Labels              types.Map                                `tfsdk:"labels"`
 	ID                  types.String                             `tfsdk:"id"`
 	Timeouts            timeouts.Value                           `tfsdk:"timeouts"`
+	SystemMetadata      types.Object                             `tfsdk:"system_metadata"`
+	LabelsAll           types.Map                                `tfsdk:"labels_all"`
 	DisableForwardProxy *NetworkConnectorEmptyModel              `tfsdk:"disable_forward_proxy"`
 	EnableForwardProxy  *NetworkConnectorEnableForwardProxyModel `tfsdk:"enable_forward_proxy"`
 	SLIToGlobalDR       *NetworkConnectorSLIToGlobalDRModel      `tfsdk:"sli_to_global_dr"`
@@ -286,9 +297,11 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Network Connector will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Network Connector will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -320,6 +333,8 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -337,20 +352,35 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 					"connection_timeout": schema.Int64Attribute{
 						MarkdownDescription: "The timeout for new network connections to upstream server. This is specified in milliseconds. The  (2 seconds). Defaults to `2000`.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(600000),
+						},
 					},
 					"max_connect_attempts": schema.Int64Attribute{
 						MarkdownDescription: "Specifies the allowed number of retries on connect failure to upstream server. Defaults to `1`.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(8),
+						},
 					},
 					"white_listed_ports": schema.ListAttribute{
 						MarkdownDescription: "Traffic to these destination TCP ports is not subjected to protocol parsing Example 'tmate' server port.",
 						Optional:            true,
 						ElementType:         types.Int64Type,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(64),
+							listvalidator.ValueInt64sAre(int64validator.AtMost(65535)),
+						},
 					},
 					"white_listed_prefixes": schema.ListAttribute{
 						MarkdownDescription: "Traffic to these destination IP prefixes is not subjected to protocol parsing Example 'tmate' server IP.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(64),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -363,6 +393,9 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 							"trusted_ca_url": schema.StringAttribute{
 								MarkdownDescription: "Custom Root CA Certificate for validating upstream server certificate.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -372,6 +405,10 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 									"certificate_url": schema.StringAttribute{
 										MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"description_spec": schema.StringAttribute{
 										MarkdownDescription: "Description. Description for the certificate.",
@@ -386,6 +423,11 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 												MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(4),
+													listvalidator.SizeAtLeast(1),
+													listvalidator.UniqueValues(),
+												},
 											},
 										},
 									},
@@ -421,7 +463,16 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -442,6 +493,9 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 								Blocks: map[string]schema.Block{
 									"interception_rules": schema.ListNestedBlock{
 										MarkdownDescription: "List of ordered rules to enable or disable for TLS interception .",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{},
 											Blocks: map[string]schema.Block{
@@ -454,14 +508,29 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 														"exact_value": schema.StringAttribute{
 															MarkdownDescription: "Exact domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 														"regex_value": schema.StringAttribute{
 															MarkdownDescription: "Regular Expression value for the domain name.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+																validators.RegexValidator(),
+															},
 														},
 														"suffix_value": schema.StringAttribute{
 															MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.HostnameValidator(),
+																stringvalidator.UTF8LengthAtMost(256),
+																stringvalidator.UTF8LengthAtLeast(1),
+															},
 														},
 													},
 												},
@@ -493,6 +562,10 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -501,6 +574,9 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -509,6 +585,9 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -536,6 +615,10 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -544,6 +627,9 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -552,6 +638,9 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -561,6 +650,11 @@ func (r *NetworkConnectorResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *NetworkConnectorResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *NetworkConnectorResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -585,6 +679,23 @@ func (r *NetworkConnectorResource) ValidateConfig(ctx context.Context, req resou
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *NetworkConnectorResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "connector_choice", "sli_to_global_dr", "sli_to_slo_snat", "slo_to_global_dr"),
+		validators.OneOfGroup(path.MatchRelative(), "forward_proxy_choice", "disable_forward_proxy", "enable_forward_proxy"),
+		validators.OneOfGroup(path.MatchRoot("enable_forward_proxy"), "tls_interception_choice", "no_interception", "tls_intercept"),
+		validators.OneOfGroup(path.MatchRoot("enable_forward_proxy").AtName("tls_intercept"), "interception_policy_choice", "enable_for_all_domains", "policy"),
+		validators.OneOfGroup(path.MatchRoot("enable_forward_proxy").AtName("tls_intercept"), "signing_cert_choice", "custom_certificate", "volterra_certificate"),
+		validators.OneOfGroup(path.MatchRoot("enable_forward_proxy").AtName("tls_intercept"), "trusted_ca_choice", "trusted_ca_url", "volterra_trusted_ca"),
+		validators.OneOfGroup(path.MatchRoot("enable_forward_proxy").AtName("tls_intercept").AtName("custom_certificate"), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("enable_forward_proxy").AtName("tls_intercept").AtName("custom_certificate").AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("enable_forward_proxy").AtName("tls_intercept").AtName("policy").AtName("interception_rules").AtAnyListIndex(), "enable_disable_choice", "disable_interception", "enable_interception"),
+		validators.OneOfGroup(path.MatchRoot("enable_forward_proxy").AtName("tls_intercept").AtName("policy").AtName("interception_rules").AtAnyListIndex().AtName("domain_match"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *NetworkConnectorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -595,7 +706,10 @@ func (r *NetworkConnectorResource) ModifyPlan(ctx context.Context, req resource.
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "network_connector", "system", req.Config, &resp.Plan)...)
 		var plan NetworkConnectorResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -613,7 +727,8 @@ func (r *NetworkConnectorResource) ModifyPlan(ctx context.Context, req resource.
 
 func (r *NetworkConnectorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data NetworkConnectorResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -652,6 +767,7 @@ func (r *NetworkConnectorResource) Create(ctx context.Context, req resource.Crea
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -661,6 +777,7 @@ func (r *NetworkConnectorResource) Create(ctx context.Context, req resource.Crea
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.DisableForwardProxy != nil {
@@ -680,9 +797,76 @@ func (r *NetworkConnectorResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.EnableForwardProxy.TLSIntercept != nil {
 			tls_interceptNestedMap := make(map[string]interface{})
+			if data.EnableForwardProxy.TLSIntercept.CustomCertificate != nil {
+				custom_certificateLevel3Map := make(map[string]interface{})
+				if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.CertificateURL.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.CertificateURL.IsUnknown() {
+					custom_certificateLevel3Map["certificate_url"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.CertificateURL.ValueString()
+				}
+				if data.EnableForwardProxy.TLSIntercept.CustomCertificate.CustomHashAlgorithms != nil {
+					custom_hash_algorithmsLevel4Map := make(map[string]interface{})
+					if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.CustomHashAlgorithms.HashAlgorithms.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.CustomHashAlgorithms.HashAlgorithms.IsUnknown() {
+						var hash_algorithmsLevel4Items []string
+						diags := data.EnableForwardProxy.TLSIntercept.CustomCertificate.CustomHashAlgorithms.HashAlgorithms.ElementsAs(ctx, &hash_algorithmsLevel4Items, false)
+						if !diags.HasError() {
+							custom_hash_algorithmsLevel4Map["hash_algorithms"] = hash_algorithmsLevel4Items
+						}
+					}
+					custom_certificateLevel3Map["custom_hash_algorithms"] = custom_hash_algorithmsLevel4Map
+				}
+				if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.DescriptionSpec.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.DescriptionSpec.IsUnknown() {
+					custom_certificateLevel3Map["description"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.DescriptionSpec.ValueString()
+				}
+				if data.EnableForwardProxy.TLSIntercept.CustomCertificate.DisableOCSPStapling != nil {
+					custom_certificateLevel3Map["disable_ocsp_stapling"] = map[string]interface{}{}
+				}
+				if data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey != nil {
+					private_keyLevel4Map := make(map[string]interface{})
+					if data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.Location.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						private_keyLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.Provider.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.URL.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.URL.ValueString()
+						}
+						private_keyLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					custom_certificateLevel3Map["private_key"] = private_keyLevel4Map
+				}
+				if data.EnableForwardProxy.TLSIntercept.CustomCertificate.UseSystemDefaults != nil {
+					custom_certificateLevel3Map["use_system_defaults"] = map[string]interface{}{}
+				}
+				tls_interceptNestedMap["custom_certificate"] = custom_certificateLevel3Map
+			}
+			if data.EnableForwardProxy.TLSIntercept.EnableForAllDomains != nil {
+				tls_interceptNestedMap["enable_for_all_domains"] = map[string]interface{}{}
+			}
+			if data.EnableForwardProxy.TLSIntercept.Policy != nil {
+				policyLevel3Map := make(map[string]interface{})
+				tls_interceptNestedMap["policy"] = policyLevel3Map
+			}
 			if !data.EnableForwardProxy.TLSIntercept.TrustedCAURL.IsNull() && !data.EnableForwardProxy.TLSIntercept.TrustedCAURL.IsUnknown() {
 				tls_interceptNestedMap["trusted_ca_url"] = data.EnableForwardProxy.TLSIntercept.TrustedCAURL.ValueString()
 			}
+			if data.EnableForwardProxy.TLSIntercept.VolterraCertificate != nil {
+				tls_interceptNestedMap["volterra_certificate"] = map[string]interface{}{}
+			}
+			if data.EnableForwardProxy.TLSIntercept.VolterraTrustedCA != nil {
+				tls_interceptNestedMap["volterra_trusted_ca"] = map[string]interface{}{}
+			}
 			enable_forward_proxyMap["tls_intercept"] = tls_interceptNestedMap
 		}
 		if !data.EnableForwardProxy.WhiteListedPorts.IsNull() && !data.EnableForwardProxy.WhiteListedPorts.IsUnknown() {
@@ -748,11 +932,15 @@ func (r *NetworkConnectorResource) Create(ctx context.Context, req resource.Crea
 
 	apiResource, err := r.client.CreateNetworkConnector(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create NetworkConnector: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_connector", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -894,7 +1082,7 @@ func (r *NetworkConnectorResource) Read(ctx context.Context, req resource.ReadRe
 	apiResource, err := r.client.GetNetworkConnector(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NetworkConnector not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -902,11 +1090,12 @@ func (r *NetworkConnectorResource) Read(ctx context.Context, req resource.ReadRe
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NetworkConnector: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_connector", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -917,31 +1106,13 @@ func (r *NetworkConnectorResource) Read(ctx context.Context, req resource.ReadRe
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -949,6 +1120,7 @@ func (r *NetworkConnectorResource) Read(ctx context.Context, req resource.ReadRe
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["disable_forward_proxy"].(map[string]interface{}); ok && isImport && data.DisableForwardProxy == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -1068,7 +1240,8 @@ func (r *NetworkConnectorResource) Read(ctx context.Context, req resource.ReadRe
 
 func (r *NetworkConnectorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data NetworkConnectorResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1102,6 +1275,7 @@ func (r *NetworkConnectorResource) Update(ctx context.Context, req resource.Upda
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1111,6 +1285,7 @@ func (r *NetworkConnectorResource) Update(ctx context.Context, req resource.Upda
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.DisableForwardProxy != nil {
@@ -1130,9 +1305,76 @@ func (r *NetworkConnectorResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.EnableForwardProxy.TLSIntercept != nil {
 			tls_interceptNestedMap := make(map[string]interface{})
+			if data.EnableForwardProxy.TLSIntercept.CustomCertificate != nil {
+				custom_certificateLevel3Map := make(map[string]interface{})
+				if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.CertificateURL.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.CertificateURL.IsUnknown() {
+					custom_certificateLevel3Map["certificate_url"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.CertificateURL.ValueString()
+				}
+				if data.EnableForwardProxy.TLSIntercept.CustomCertificate.CustomHashAlgorithms != nil {
+					custom_hash_algorithmsLevel4Map := make(map[string]interface{})
+					if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.CustomHashAlgorithms.HashAlgorithms.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.CustomHashAlgorithms.HashAlgorithms.IsUnknown() {
+						var hash_algorithmsLevel4Items []string
+						diags := data.EnableForwardProxy.TLSIntercept.CustomCertificate.CustomHashAlgorithms.HashAlgorithms.ElementsAs(ctx, &hash_algorithmsLevel4Items, false)
+						if !diags.HasError() {
+							custom_hash_algorithmsLevel4Map["hash_algorithms"] = hash_algorithmsLevel4Items
+						}
+					}
+					custom_certificateLevel3Map["custom_hash_algorithms"] = custom_hash_algorithmsLevel4Map
+				}
+				if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.DescriptionSpec.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.DescriptionSpec.IsUnknown() {
+					custom_certificateLevel3Map["description"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.DescriptionSpec.ValueString()
+				}
+				if data.EnableForwardProxy.TLSIntercept.CustomCertificate.DisableOCSPStapling != nil {
+					custom_certificateLevel3Map["disable_ocsp_stapling"] = map[string]interface{}{}
+				}
+				if data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey != nil {
+					private_keyLevel4Map := make(map[string]interface{})
+					if data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.Location.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						private_keyLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.Provider.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.URL.IsNull() && !data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.EnableForwardProxy.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.URL.ValueString()
+						}
+						private_keyLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					custom_certificateLevel3Map["private_key"] = private_keyLevel4Map
+				}
+				if data.EnableForwardProxy.TLSIntercept.CustomCertificate.UseSystemDefaults != nil {
+					custom_certificateLevel3Map["use_system_defaults"] = map[string]interface{}{}
+				}
+				tls_interceptNestedMap["custom_certificate"] = custom_certificateLevel3Map
+			}
+			if data.EnableForwardProxy.TLSIntercept.EnableForAllDomains != nil {
+				tls_interceptNestedMap["enable_for_all_domains"] = map[string]interface{}{}
+			}
+			if data.EnableForwardProxy.TLSIntercept.Policy != nil {
+				policyLevel3Map := make(map[string]interface{})
+				tls_interceptNestedMap["policy"] = policyLevel3Map
+			}
 			if !data.EnableForwardProxy.TLSIntercept.TrustedCAURL.IsNull() && !data.EnableForwardProxy.TLSIntercept.TrustedCAURL.IsUnknown() {
 				tls_interceptNestedMap["trusted_ca_url"] = data.EnableForwardProxy.TLSIntercept.TrustedCAURL.ValueString()
 			}
+			if data.EnableForwardProxy.TLSIntercept.VolterraCertificate != nil {
+				tls_interceptNestedMap["volterra_certificate"] = map[string]interface{}{}
+			}
+			if data.EnableForwardProxy.TLSIntercept.VolterraTrustedCA != nil {
+				tls_interceptNestedMap["volterra_trusted_ca"] = map[string]interface{}{}
+			}
 			enable_forward_proxyMap["tls_intercept"] = tls_interceptNestedMap
 		}
 		if !data.EnableForwardProxy.WhiteListedPorts.IsNull() && !data.EnableForwardProxy.WhiteListedPorts.IsUnknown() {
@@ -1198,7 +1440,7 @@ func (r *NetworkConnectorResource) Update(ctx context.Context, req resource.Upda
 
 	_, err := r.client.UpdateNetworkConnector(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update NetworkConnector: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_connector", "update"))
 		return
 	}
 
@@ -1209,9 +1451,12 @@ func (r *NetworkConnectorResource) Update(ctx context.Context, req resource.Upda
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetNetworkConnector(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NetworkConnector after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "network_connector", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1353,7 +1598,7 @@ func (r *NetworkConnectorResource) Delete(ctx context.Context, req resource.Dele
 	err := r.client.DeleteNetworkConnector(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NetworkConnector already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1362,37 +1607,33 @@ func (r *NetworkConnectorResource) Delete(ctx context.Context, req resource.Dele
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "NetworkConnector delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete NetworkConnector: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_connector", "delete"))
 		return
 	}
 }
 
 func (r *NetworkConnectorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *NetworkConnectorResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("network_connector", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *NetworkConnectorResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListNetworkConnectors(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *NetworkConnectorResource) requiredNamespace() string {
+	return "system"
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,22 @@ type NetworkInterfaceDataSource struct {
 	client *client.Client
 }
 
+// NetworkInterfaceDataSourceModel mirrors NetworkInterfaceResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type NetworkInterfaceDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata               types.Object                                       `tfsdk:"system_metadata"`
+	Name                         types.String                                       `tfsdk:"name"`
+	Namespace                    types.String                                       `tfsdk:"namespace"`
+	Annotations                  types.Map                                          `tfsdk:"annotations"`
+	Description                  types.String                                       `tfsdk:"description"`
+	Disable                      types.Bool                                         `tfsdk:"disable"`
+	Labels                       types.Map                                          `tfsdk:"labels"`
+	ID                           types.String                                       `tfsdk:"id"`
+	DedicatedInterface           *NetworkInterfaceDedicatedInterfaceModel           `tfsdk:"dedicated_interface"`
+	DedicatedManagementInterface *NetworkInterfaceDedicatedManagementInterfaceModel `tfsdk:"dedicated_management_interface"`
+	EthernetInterface            *NetworkInterfaceEthernetInterfaceModel            `tfsdk:"ethernet_interface"`
+	Layer2Interface              *NetworkInterfaceLayer2InterfaceModel              `tfsdk:"layer2_interface"`
+	TunnelInterface              *NetworkInterfaceTunnelInterfaceModel              `tfsdk:"tunnel_interface"`
 }
 
 func (d *NetworkInterfaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +49,7 @@ func (d *NetworkInterfaceDataSource) Metadata(ctx context.Context, req datasourc
 }
 
 func (d *NetworkInterfaceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Network Interface resource in F5 Distributed Cloud for network interface represents configuration of a network device. it is created by users in system namespace. configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the NetworkInterface.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the NetworkInterface exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the NetworkInterface.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewNetworkInterfaceResource())
 }
 
 func (d *NetworkInterfaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +71,25 @@ func (d *NetworkInterfaceDataSource) Read(ctx context.Context, req datasource.Re
 		return
 	}
 
-	resource, err := d.client.GetNetworkInterface(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetNetworkInterface(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NetworkInterface: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_interface", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +103,8 @@ func (d *NetworkInterfaceDataSource) Read(ctx context.Context, req datasource.Re
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +113,514 @@ func (d *NetworkInterfaceDataSource) Read(ctx context.Context, req datasource.Re
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["dedicated_interface"].(map[string]interface{}); ok && (isImport || data.DedicatedInterface != nil) {
+		data.DedicatedInterface = &NetworkInterfaceDedicatedInterfaceModel{
+			Cluster: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.DedicatedInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DedicatedInterface.Cluster
+				}
+				// Import case: read from API
+				if _, ok := blockData["cluster"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			Device: func() types.String {
+				if v, ok := blockData["device"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			IsPrimary: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.DedicatedInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DedicatedInterface.IsPrimary
+				}
+				// Import case: read from API
+				if _, ok := blockData["is_primary"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			Monitor: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.DedicatedInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DedicatedInterface.Monitor
+				}
+				// Import case: read from API
+				if _, ok := blockData["monitor"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			MonitorDisabled: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.DedicatedInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DedicatedInterface.MonitorDisabled
+				}
+				// Import case: read from API
+				if _, ok := blockData["monitor_disabled"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			MTU: func() types.Int64 {
+				if !isImport && data.DedicatedInterface != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.DedicatedInterface.MTU
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["mtu"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			Node: func() types.String {
+				if v, ok := blockData["node"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			NotPrimary: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.DedicatedInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DedicatedInterface.NotPrimary
+				}
+				// Import case: read from API
+				if _, ok := blockData["not_primary"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			Priority: func() types.Int64 {
+				if !isImport && data.DedicatedInterface != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.DedicatedInterface.Priority
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["priority"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["dedicated_management_interface"].(map[string]interface{}); ok && (isImport || data.DedicatedManagementInterface != nil) {
+		data.DedicatedManagementInterface = &NetworkInterfaceDedicatedManagementInterfaceModel{
+			Cluster: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.DedicatedManagementInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.DedicatedManagementInterface.Cluster
+				}
+				// Import case: read from API
+				if _, ok := blockData["cluster"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			Device: func() types.String {
+				if v, ok := blockData["device"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			MTU: func() types.Int64 {
+				if !isImport && data.DedicatedManagementInterface != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.DedicatedManagementInterface.MTU
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["mtu"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			Node: func() types.String {
+				if v, ok := blockData["node"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["ethernet_interface"].(map[string]interface{}); ok && (isImport || data.EthernetInterface != nil) {
+		data.EthernetInterface = &NetworkInterfaceEthernetInterfaceModel{
+			Cluster: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.EthernetInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EthernetInterface.Cluster
+				}
+				// Import case: read from API
+				if _, ok := blockData["cluster"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			Device: func() types.String {
+				if v, ok := blockData["device"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			DHCPClient: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.EthernetInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EthernetInterface.DHCPClient
+				}
+				// Import case: read from API
+				if _, ok := blockData["dhcp_client"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			DHCPServer: func() *NetworkInterfaceEthernetInterfaceDHCPServerModel {
+				if !isImport && data.EthernetInterface != nil && data.EthernetInterface.DHCPServer != nil {
+					// Normal Read: preserve existing state value
+					return data.EthernetInterface.DHCPServer
+				}
+				// Import case: read from API
+				if _, ok := blockData["dhcp_server"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEthernetInterfaceDHCPServerModel{}
+				}
+				return nil
+			}(),
+			Ipv6AutoConfig: func() *NetworkInterfaceEthernetInterfaceIpv6AutoConfigModel {
+				if !isImport && data.EthernetInterface != nil && data.EthernetInterface.Ipv6AutoConfig != nil {
+					// Normal Read: preserve existing state value
+					return data.EthernetInterface.Ipv6AutoConfig
+				}
+				// Import case: read from API
+				if _, ok := blockData["ipv6_auto_config"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEthernetInterfaceIpv6AutoConfigModel{}
+				}
+				return nil
+			}(),
+			IsPrimary: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.EthernetInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EthernetInterface.IsPrimary
+				}
+				// Import case: read from API
+				if _, ok := blockData["is_primary"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			Monitor: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.EthernetInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EthernetInterface.Monitor
+				}
+				// Import case: read from API
+				if _, ok := blockData["monitor"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			MonitorDisabled: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.EthernetInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EthernetInterface.MonitorDisabled
+				}
+				// Import case: read from API
+				if _, ok := blockData["monitor_disabled"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			MTU: func() types.Int64 {
+				if !isImport && data.EthernetInterface != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.EthernetInterface.MTU
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["mtu"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			NoIpv6Address: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.EthernetInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EthernetInterface.NoIpv6Address
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_ipv6_address"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			Node: func() types.String {
+				if v, ok := blockData["node"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			NotPrimary: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.EthernetInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EthernetInterface.NotPrimary
+				}
+				// Import case: read from API
+				if _, ok := blockData["not_primary"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			Priority: func() types.Int64 {
+				if !isImport && data.EthernetInterface != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.EthernetInterface.Priority
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["priority"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			SiteLocalInsideNetwork: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.EthernetInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EthernetInterface.SiteLocalInsideNetwork
+				}
+				// Import case: read from API
+				if _, ok := blockData["site_local_inside_network"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			SiteLocalNetwork: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.EthernetInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EthernetInterface.SiteLocalNetwork
+				}
+				// Import case: read from API
+				if _, ok := blockData["site_local_network"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			StaticIP: func() *NetworkInterfaceEthernetInterfaceStaticIPModel {
+				if !isImport && data.EthernetInterface != nil && data.EthernetInterface.StaticIP != nil {
+					// Normal Read: preserve existing state value
+					return data.EthernetInterface.StaticIP
+				}
+				// Import case: read from API
+				if _, ok := blockData["static_ip"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEthernetInterfaceStaticIPModel{}
+				}
+				return nil
+			}(),
+			StaticIpv6Address: func() *NetworkInterfaceEthernetInterfaceStaticIpv6AddressModel {
+				if !isImport && data.EthernetInterface != nil && data.EthernetInterface.StaticIpv6Address != nil {
+					// Normal Read: preserve existing state value
+					return data.EthernetInterface.StaticIpv6Address
+				}
+				// Import case: read from API
+				if _, ok := blockData["static_ipv6_address"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEthernetInterfaceStaticIpv6AddressModel{}
+				}
+				return nil
+			}(),
+			StorageNetwork: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.EthernetInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EthernetInterface.StorageNetwork
+				}
+				// Import case: read from API
+				if _, ok := blockData["storage_network"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			Untagged: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.EthernetInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EthernetInterface.Untagged
+				}
+				// Import case: read from API
+				if _, ok := blockData["untagged"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			VLANID: func() types.Int64 {
+				if !isImport && data.EthernetInterface != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.EthernetInterface.VLANID
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["vlan_id"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["layer2_interface"].(map[string]interface{}); ok && isImport && data.Layer2Interface == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Layer2Interface = &NetworkInterfaceLayer2InterfaceModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["tunnel_interface"].(map[string]interface{}); ok && (isImport || data.TunnelInterface != nil) {
+		data.TunnelInterface = &NetworkInterfaceTunnelInterfaceModel{
+			MTU: func() types.Int64 {
+				if !isImport && data.TunnelInterface != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.TunnelInterface.MTU
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["mtu"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			Node: func() types.String {
+				if v, ok := blockData["node"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Priority: func() types.Int64 {
+				if !isImport && data.TunnelInterface != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.TunnelInterface.Priority
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["priority"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			SiteLocalInsideNetwork: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.TunnelInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.TunnelInterface.SiteLocalInsideNetwork
+				}
+				// Import case: read from API
+				if _, ok := blockData["site_local_inside_network"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			SiteLocalNetwork: func() *NetworkInterfaceEmptyModel {
+				if !isImport && data.TunnelInterface != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.TunnelInterface.SiteLocalNetwork
+				}
+				// Import case: read from API
+				if _, ok := blockData["site_local_network"].(map[string]interface{}); ok {
+					return &NetworkInterfaceEmptyModel{}
+				}
+				return nil
+			}(),
+			StaticIP: func() *NetworkInterfaceTunnelInterfaceStaticIPModel {
+				if !isImport && data.TunnelInterface != nil && data.TunnelInterface.StaticIP != nil {
+					// Normal Read: preserve existing state value
+					return data.TunnelInterface.StaticIP
+				}
+				// Import case: read from API
+				if _, ok := blockData["static_ip"].(map[string]interface{}); ok {
+					return &NetworkInterfaceTunnelInterfaceStaticIPModel{}
+				}
+				return nil
+			}(),
+			Tunnel: func() *NetworkInterfaceTunnelInterfaceTunnelModel {
+				if !isImport && data.TunnelInterface != nil && data.TunnelInterface.Tunnel != nil {
+					// Normal Read: preserve existing state value
+					return data.TunnelInterface.Tunnel
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["tunnel"].(map[string]interface{}); ok {
+					return &NetworkInterfaceTunnelInterfaceTunnelModel{
+						Name: func() types.String {
+							if v, ok := nestedBlockData["name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Namespace: func() types.String {
+							if v, ok := nestedBlockData["namespace"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Tenant: func() types.String {
+							if v, ok := nestedBlockData["tenant"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
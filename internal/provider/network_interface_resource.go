@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &NetworkInterfaceResource{}
-	_ resource.ResourceWithConfigure      = &NetworkInterfaceResource{}
-	_ resource.ResourceWithImportState    = &NetworkInterfaceResource{}
-	_ resource.ResourceWithModifyPlan     = &NetworkInterfaceResource{}
-	_ resource.ResourceWithValidateConfig = &NetworkInterfaceResource{}
+	_ resource.Resource                     = &NetworkInterfaceResource{}
+	_ resource.ResourceWithConfigure        = &NetworkInterfaceResource{}
+	_ resource.ResourceWithImportState      = &NetworkInterfaceResource{}
+	_ resource.ResourceWithIdentity         = &NetworkInterfaceResource{}
+	_ resource.ResourceWithMoveState        = &NetworkInterfaceResource{}
+	_ resource.ResourceWithModifyPlan       = &NetworkInterfaceResource{}
+	_ resource.ResourceWithValidateConfig   = &NetworkInterfaceResource{}
+	_ resource.ResourceWithConfigValidators = &NetworkInterfaceResource{}
+	_ listableResource                      = &NetworkInterfaceResource{}
 )
 
 func NewNetworkInterfaceResource() resource.Resource {
@@ -512,6 +519,8 @@ type NetworkInterfaceResourceModel struct {
 	Labels                       types.Map                                          `tfsdk:"labels"`
 	ID                           types.String                                       `tfsdk:"id"`
 	Timeouts                     timeouts.Value                                     `tfsdk:"timeouts"`
+	SystemMetadata               types.Object                                       `tfsdk:"system_metadata"`
+	LabelsAll                    types.Map                                          `tfsdk:"labels_all"`
 	DedicatedInterface           *NetworkInterfaceDedicatedInterfaceModel           `tfsdk:"dedicated_interface"`
 	DedicatedManagementInterface *NetworkInterfaceDedicatedManagementInterfaceModel `tfsdk:"dedicated_management_interface"`
 	EthernetInterface            *NetworkInterfaceEthernetInterfaceModel            `tfsdk:"ethernet_interface"`
@@ -538,9 +547,11 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Network Interface will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Network Interface will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -572,6 +583,8 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -586,6 +599,10 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 					"device": schema.StringAttribute{
 						MarkdownDescription: "Name of the device for which interface is configured. Use wwan0 for 4G/LTE.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 					"mtu": schema.Int64Attribute{
 						MarkdownDescription: "Maximum packet size (Maximum Transfer Unit) of the interface When configured, MTU must be between 512 and 16384.",
@@ -594,10 +611,18 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 					"node": schema.StringAttribute{
 						MarkdownDescription: "Configuration will apply to a device on the given node of the site.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 					"priority": schema.Int64Attribute{
 						MarkdownDescription: "Priority of the network interface when multiple network interfaces are present in outside network Greater the value, higher the priority.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+							int64validator.AtMost(255),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -624,6 +649,10 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 					"device": schema.StringAttribute{
 						MarkdownDescription: "Name of the device for which interface is configured .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 					"mtu": schema.Int64Attribute{
 						MarkdownDescription: "Maximum packet size (Maximum Transfer Unit) of the interface When configured, MTU must be between 512 and 16384.",
@@ -632,6 +661,10 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 					"node": schema.StringAttribute{
 						MarkdownDescription: "Configuration will apply to a device on the given node of the site.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -646,6 +679,10 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 					"device": schema.StringAttribute{
 						MarkdownDescription: "Interface configuration for the ethernet device .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 					"mtu": schema.Int64Attribute{
 						MarkdownDescription: "Maximum packet size (Maximum Transfer Unit) of the interface When configured, MTU must be between 512 and 16384.",
@@ -654,14 +691,26 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 					"node": schema.StringAttribute{
 						MarkdownDescription: "Configuration will apply to a device on the given node.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 					"priority": schema.Int64Attribute{
 						MarkdownDescription: "Priority of the network interface when multiple network interfaces are present in outside network Greater the value, higher the priority.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+							int64validator.AtMost(255),
+						},
 					},
 					"vlan_id": schema.Int64Attribute{
 						MarkdownDescription: "Configure a VLAN tagged ethernet interface.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(4095),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -683,19 +732,31 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 							},
 							"dhcp_networks": schema.ListNestedBlock{
 								MarkdownDescription: "List of networks from which DHCP Server can allocate IPv4 Addresses .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"dgw_address": schema.StringAttribute{
 											MarkdownDescription: "Enter a IPv4 address from the network prefix to be used as the default gateway.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 										"dns_address": schema.StringAttribute{
 											MarkdownDescription: "Enter a IPv4 address from the network prefix to be used as the DNS server.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 										"network_prefix": schema.StringAttribute{
 											MarkdownDescription: "Set the network prefix for the site. Ex: 10.1.1.0/24.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4PrefixValidator(),
+											},
 										},
 										"pool_settings": schema.StringAttribute{
 											MarkdownDescription: "[Enum: INCLUDE_IP_ADDRESSES_FROM_DHCP_POOLS|EXCLUDE_IP_ADDRESSES_FROM_DHCP_POOLS] Identifies the how to pick the network for Interface. Address ranges in DHCP pool list are used for IP Address allocation Address ranges in DHCP pool list are excluded from IP Address allocation. Possible values are `INCLUDE_IP_ADDRESSES_FROM_DHCP_POOLS`, `EXCLUDE_IP_ADDRESSES_FROM_DHCP_POOLS`. Defaults to `INCLUDE_IP_ADDRESSES_FROM_DHCP_POOLS`.",
@@ -711,15 +772,24 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 										},
 										"pools": schema.ListNestedBlock{
 											MarkdownDescription: "List of non overlapping IP address ranges.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"end_ip": schema.StringAttribute{
 														MarkdownDescription: "Ending IP of the pool range. In case of address allocator, offset is derived based on network prefix. 10.1.1.200 with prefix length of 24, end offset is 0.0.0.200.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.IPv4Validator(),
+														},
 													},
 													"start_ip": schema.StringAttribute{
 														MarkdownDescription: "Starting IP of the pool range. In case of address allocator, offset is derived based on network prefix. 10.1.1.5 with prefix length of 24, start offset is 0.0.0.5.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.IPv4Validator(),
+														},
 													},
 												},
 											},
@@ -757,6 +827,10 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 									"network_prefix": schema.StringAttribute{
 										MarkdownDescription: "Nework prefix that is used as Prefix information Allowed only /64 prefix length as per RFC 4862.",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.IPv6PrefixValidator(),
+											validators.PatternValidator(".*::/64$"),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -771,6 +845,11 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 														MarkdownDescription: "List of IPv6 Addresses acting as DNS servers .",
 														Optional:            true,
 														ElementType:         types.StringType,
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(4),
+															listvalidator.SizeAtLeast(1),
+															listvalidator.UniqueValues(),
+														},
 													},
 												},
 											},
@@ -780,6 +859,9 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 													"configured_address": schema.StringAttribute{
 														MarkdownDescription: "Configured address from the network prefix is chosen as DNS server.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.IPv6Validator(),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -805,11 +887,17 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 											},
 											"dhcp_networks": schema.ListNestedBlock{
 												MarkdownDescription: "List of networks from which DHCP server can allocate IP addresses .",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(1),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"network_prefix": schema.StringAttribute{
 															MarkdownDescription: "Network Prefix to be used for IPv6 address auto configuration.",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.IPv6PrefixValidator(),
+															},
 														},
 														"pool_settings": schema.StringAttribute{
 															MarkdownDescription: "[Enum: INCLUDE_IP_ADDRESSES_FROM_DHCP_POOLS|EXCLUDE_IP_ADDRESSES_FROM_DHCP_POOLS] Identifies the how to pick the network for Interface. Address ranges in DHCP pool list are used for IP Address allocation Address ranges in DHCP pool list are excluded from IP Address allocation. Possible values are `INCLUDE_IP_ADDRESSES_FROM_DHCP_POOLS`, `EXCLUDE_IP_ADDRESSES_FROM_DHCP_POOLS`. Defaults to `INCLUDE_IP_ADDRESSES_FROM_DHCP_POOLS`.",
@@ -819,15 +907,24 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 													Blocks: map[string]schema.Block{
 														"pools": schema.ListNestedBlock{
 															MarkdownDescription: "List of non overlapping IP address ranges.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(16),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"end_ip": schema.StringAttribute{
 																		MarkdownDescription: "Ending IPv6 address of the pool range. In case of address allocator, offset is derived based on network prefix.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			validators.IPv6Validator(),
+																		},
 																	},
 																	"start_ip": schema.StringAttribute{
 																		MarkdownDescription: "Starting IPv6 address of the pool range. In case of address allocator, offset is derived based on network prefix. 2001::1 with prefix length of 64, start offset is 5.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			validators.IPv6Validator(),
+																		},
 																	},
 																},
 															},
@@ -893,10 +990,16 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 									"default_gw": schema.StringAttribute{
 										MarkdownDescription: "Default Gateway. IP address of the default gateway.",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.IPValidator(),
+										},
 									},
 									"ip_address": schema.StringAttribute{
 										MarkdownDescription: "IP address of the interface and prefix length .",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.IPPrefixValidator(),
+										},
 									},
 								},
 							},
@@ -921,10 +1024,16 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 									"default_gw": schema.StringAttribute{
 										MarkdownDescription: "Default Gateway. IP address of the default gateway.",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.IPValidator(),
+										},
 									},
 									"ip_address": schema.StringAttribute{
 										MarkdownDescription: "IP address of the interface and prefix length .",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.IPPrefixValidator(),
+										},
 									},
 								},
 							},
@@ -948,10 +1057,18 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 							"device": schema.StringAttribute{
 								MarkdownDescription: "Physical ethernet interface .",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(64),
+									stringvalidator.UTF8LengthAtLeast(1),
+								},
 							},
 							"vlan_id": schema.Int64Attribute{
 								MarkdownDescription: "Configure a VLAN tagged interface.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(4095),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -966,10 +1083,18 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 							"device": schema.StringAttribute{
 								MarkdownDescription: "Physical ethernet interface .",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(64),
+									stringvalidator.UTF8LengthAtLeast(1),
+								},
 							},
 							"vlan_id": schema.Int64Attribute{
 								MarkdownDescription: "VLAN ID. VLAN ID .",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(4095),
+								},
 							},
 						},
 					},
@@ -979,6 +1104,10 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 							"vlan_id": schema.Int64Attribute{
 								MarkdownDescription: "VLAN ID. VLAN ID .",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+									int64validator.AtMost(4095),
+								},
 							},
 						},
 					},
@@ -994,10 +1123,18 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 					"node": schema.StringAttribute{
 						MarkdownDescription: "Configuration will apply to a given device on the given node.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(64),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 					"priority": schema.Int64Attribute{
 						MarkdownDescription: "Priority of the network interface when multiple network interfaces are present in outside network Greater the value, higher the priority.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+							int64validator.AtMost(255),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1026,10 +1163,16 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 									"default_gw": schema.StringAttribute{
 										MarkdownDescription: "Default Gateway. IP address of the default gateway.",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.IPValidator(),
+										},
 									},
 									"ip_address": schema.StringAttribute{
 										MarkdownDescription: "IP address of the interface and prefix length .",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.IPPrefixValidator(),
+										},
 									},
 								},
 							},
@@ -1041,6 +1184,10 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1049,6 +1196,9 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1057,6 +1207,9 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -1066,6 +1219,11 @@ func (r *NetworkInterfaceResource) Schema(ctx context.Context, req resource.Sche
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *NetworkInterfaceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *NetworkInterfaceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -1090,6 +1248,39 @@ func (r *NetworkInterfaceResource) ValidateConfig(ctx context.Context, req resou
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *NetworkInterfaceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "interface_choice", "dedicated_interface", "dedicated_management_interface", "ethernet_interface", "layer2_interface", "tunnel_interface"),
+		validators.OneOfGroup(path.MatchRoot("dedicated_interface"), "monitoring_choice", "monitor", "monitor_disabled"),
+		validators.OneOfGroup(path.MatchRoot("dedicated_interface"), "node_choice", "cluster", "node"),
+		validators.OneOfGroup(path.MatchRoot("dedicated_interface"), "primary_choice", "is_primary", "not_primary"),
+		validators.OneOfGroup(path.MatchRoot("dedicated_management_interface"), "node_choice", "cluster", "node"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface"), "address_choice", "dhcp_client", "dhcp_server", "static_ip"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface"), "ipv6_address_choice", "ipv6_auto_config", "no_ipv6_address", "static_ipv6_address"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface"), "monitoring_choice", "monitor", "monitor_disabled"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface"), "network_choice", "site_local_inside_network", "site_local_network", "storage_network"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface"), "node_choice", "cluster", "node"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface"), "primary_choice", "is_primary", "not_primary"),
+		validators.RequiredOneOfGroup(path.MatchRoot("ethernet_interface"), "vlan_choice", "untagged", "vlan_id"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface").AtName("dhcp_server"), "interfaces_addressing_choice", "automatic_from_end", "automatic_from_start", "interface_ip_map"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface").AtName("dhcp_server").AtName("dhcp_networks").AtAnyListIndex(), "dns_choice", "dns_address", "same_as_dgw"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface").AtName("dhcp_server").AtName("dhcp_networks").AtAnyListIndex(), "gateway_choice", "dgw_address", "first_address", "last_address"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface").AtName("ipv6_auto_config"), "autoconfig_choice", "host", "router"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface").AtName("ipv6_auto_config").AtName("router"), "address_choice", "network_prefix", "stateful"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface").AtName("ipv6_auto_config").AtName("router").AtName("dns_config"), "dns_choice", "configured_list", "local_dns"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface").AtName("ipv6_auto_config").AtName("router").AtName("dns_config").AtName("local_dns"), "local_dns_choice", "configured_address", "first_address", "last_address"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface").AtName("ipv6_auto_config").AtName("router").AtName("stateful"), "interfaces_addressing_choice", "automatic_from_end", "automatic_from_start", "interface_ip_map"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface").AtName("static_ip"), "network_prefix_choice", "cluster_static_ip", "node_static_ip"),
+		validators.OneOfGroup(path.MatchRoot("ethernet_interface").AtName("static_ipv6_address"), "network_prefix_choice", "cluster_static_ip", "node_static_ip"),
+		validators.OneOfGroup(path.MatchRoot("layer2_interface"), "layer2_interface_choice", "l2sriov_interface", "l2vlan_interface", "l2vlan_slo_interface"),
+		validators.RequiredOneOfGroup(path.MatchRoot("layer2_interface").AtName("l2sriov_interface"), "vlan_choice", "untagged", "vlan_id"),
+		validators.OneOfGroup(path.MatchRoot("tunnel_interface"), "network_choice", "site_local_inside_network", "site_local_network"),
+		validators.OneOfGroup(path.MatchRoot("tunnel_interface").AtName("static_ip"), "network_prefix_choice", "cluster_static_ip", "node_static_ip"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *NetworkInterfaceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -1100,7 +1291,10 @@ func (r *NetworkInterfaceResource) ModifyPlan(ctx context.Context, req resource.
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "network_interface", "system", req.Config, &resp.Plan)...)
 		var plan NetworkInterfaceResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -1157,6 +1351,7 @@ func (r *NetworkInterfaceResource) Create(ctx context.Context, req resource.Crea
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1166,6 +1361,7 @@ func (r *NetworkInterfaceResource) Create(ctx context.Context, req resource.Crea
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.DedicatedInterface != nil {
@@ -1228,10 +1424,84 @@ func (r *NetworkInterfaceResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.EthernetInterface.DHCPServer != nil {
 			dhcp_serverNestedMap := make(map[string]interface{})
+			if data.EthernetInterface.DHCPServer.AutomaticFromEnd != nil {
+				dhcp_serverNestedMap["automatic_from_end"] = map[string]interface{}{}
+			}
+			if data.EthernetInterface.DHCPServer.AutomaticFromStart != nil {
+				dhcp_serverNestedMap["automatic_from_start"] = map[string]interface{}{}
+			}
+			if data.EthernetInterface.DHCPServer.FixedIPMap != nil {
+				dhcp_serverNestedMap["fixed_ip_map"] = map[string]interface{}{}
+			}
+			if data.EthernetInterface.DHCPServer.InterfaceIPMap != nil {
+				interface_ip_mapLevel3Map := make(map[string]interface{})
+				if data.EthernetInterface.DHCPServer.InterfaceIPMap.InterfaceIPMap != nil {
+					interface_ip_mapLevel3Map["interface_ip_map"] = map[string]interface{}{}
+				}
+				dhcp_serverNestedMap["interface_ip_map"] = interface_ip_mapLevel3Map
+			}
 			ethernet_interfaceMap["dhcp_server"] = dhcp_serverNestedMap
 		}
 		if data.EthernetInterface.Ipv6AutoConfig != nil {
 			ipv6_auto_configNestedMap := make(map[string]interface{})
+			if data.EthernetInterface.Ipv6AutoConfig.Host != nil {
+				ipv6_auto_configNestedMap["host"] = map[string]interface{}{}
+			}
+			if data.EthernetInterface.Ipv6AutoConfig.Router != nil {
+				routerLevel3Map := make(map[string]interface{})
+				if data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig != nil {
+					dns_configLevel4Map := make(map[string]interface{})
+					if data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.ConfiguredList != nil {
+						configured_listLevel5Map := make(map[string]interface{})
+						if !data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.ConfiguredList.DNSList.IsNull() && !data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.ConfiguredList.DNSList.IsUnknown() {
+							var dns_listLevel5Items []string
+							diags := data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.ConfiguredList.DNSList.ElementsAs(ctx, &dns_listLevel5Items, false)
+							if !diags.HasError() {
+								configured_listLevel5Map["dns_list"] = dns_listLevel5Items
+							}
+						}
+						dns_configLevel4Map["configured_list"] = configured_listLevel5Map
+					}
+					if data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.LocalDNS != nil {
+						local_dnsLevel5Map := make(map[string]interface{})
+						if !data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.LocalDNS.ConfiguredAddress.IsNull() && !data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.LocalDNS.ConfiguredAddress.IsUnknown() {
+							local_dnsLevel5Map["configured_address"] = data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.LocalDNS.ConfiguredAddress.ValueString()
+						}
+						if data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.LocalDNS.FirstAddress != nil {
+							local_dnsLevel5Map["first_address"] = map[string]interface{}{}
+						}
+						if data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.LocalDNS.LastAddress != nil {
+							local_dnsLevel5Map["last_address"] = map[string]interface{}{}
+						}
+						dns_configLevel4Map["local_dns"] = local_dnsLevel5Map
+					}
+					routerLevel3Map["dns_config"] = dns_configLevel4Map
+				}
+				if !data.EthernetInterface.Ipv6AutoConfig.Router.NetworkPrefix.IsNull() && !data.EthernetInterface.Ipv6AutoConfig.Router.NetworkPrefix.IsUnknown() {
+					routerLevel3Map["network_prefix"] = data.EthernetInterface.Ipv6AutoConfig.Router.NetworkPrefix.ValueString()
+				}
+				if data.EthernetInterface.Ipv6AutoConfig.Router.Stateful != nil {
+					statefulLevel4Map := make(map[string]interface{})
+					if data.EthernetInterface.Ipv6AutoConfig.Router.Stateful.AutomaticFromEnd != nil {
+						statefulLevel4Map["automatic_from_end"] = map[string]interface{}{}
+					}
+					if data.EthernetInterface.Ipv6AutoConfig.Router.Stateful.AutomaticFromStart != nil {
+						statefulLevel4Map["automatic_from_start"] = map[string]interface{}{}
+					}
+					if data.EthernetInterface.Ipv6AutoConfig.Router.Stateful.FixedIPMap != nil {
+						statefulLevel4Map["fixed_ip_map"] = map[string]interface{}{}
+					}
+					if data.EthernetInterface.Ipv6AutoConfig.Router.Stateful.InterfaceIPMap != nil {
+						interface_ip_mapLevel5Map := make(map[string]interface{})
+						if data.EthernetInterface.Ipv6AutoConfig.Router.Stateful.InterfaceIPMap.InterfaceIPMap != nil {
+							interface_ip_mapLevel5Map["interface_ip_map"] = map[string]interface{}{}
+						}
+						statefulLevel4Map["interface_ip_map"] = interface_ip_mapLevel5Map
+					}
+					routerLevel3Map["stateful"] = statefulLevel4Map
+				}
+				ipv6_auto_configNestedMap["router"] = routerLevel3Map
+			}
 			ethernet_interfaceMap["ipv6_auto_config"] = ipv6_auto_configNestedMap
 		}
 		if data.EthernetInterface.IsPrimary != nil {
@@ -1266,10 +1536,44 @@ func (r *NetworkInterfaceResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.EthernetInterface.StaticIP != nil {
 			static_ipNestedMap := make(map[string]interface{})
+			if data.EthernetInterface.StaticIP.ClusterStaticIP != nil {
+				cluster_static_ipLevel3Map := make(map[string]interface{})
+				if data.EthernetInterface.StaticIP.ClusterStaticIP.InterfaceIPMap != nil {
+					cluster_static_ipLevel3Map["interface_ip_map"] = map[string]interface{}{}
+				}
+				static_ipNestedMap["cluster_static_ip"] = cluster_static_ipLevel3Map
+			}
+			if data.EthernetInterface.StaticIP.NodeStaticIP != nil {
+				node_static_ipLevel3Map := make(map[string]interface{})
+				if !data.EthernetInterface.StaticIP.NodeStaticIP.DefaultGw.IsNull() && !data.EthernetInterface.StaticIP.NodeStaticIP.DefaultGw.IsUnknown() {
+					node_static_ipLevel3Map["default_gw"] = data.EthernetInterface.StaticIP.NodeStaticIP.DefaultGw.ValueString()
+				}
+				if !data.EthernetInterface.StaticIP.NodeStaticIP.IPAddress.IsNull() && !data.EthernetInterface.StaticIP.NodeStaticIP.IPAddress.IsUnknown() {
+					node_static_ipLevel3Map["ip_address"] = data.EthernetInterface.StaticIP.NodeStaticIP.IPAddress.ValueString()
+				}
+				static_ipNestedMap["node_static_ip"] = node_static_ipLevel3Map
+			}
 			ethernet_interfaceMap["static_ip"] = static_ipNestedMap
 		}
 		if data.EthernetInterface.StaticIpv6Address != nil {
 			static_ipv6_addressNestedMap := make(map[string]interface{})
+			if data.EthernetInterface.StaticIpv6Address.ClusterStaticIP != nil {
+				cluster_static_ipLevel3Map := make(map[string]interface{})
+				if data.EthernetInterface.StaticIpv6Address.ClusterStaticIP.InterfaceIPMap != nil {
+					cluster_static_ipLevel3Map["interface_ip_map"] = map[string]interface{}{}
+				}
+				static_ipv6_addressNestedMap["cluster_static_ip"] = cluster_static_ipLevel3Map
+			}
+			if data.EthernetInterface.StaticIpv6Address.NodeStaticIP != nil {
+				node_static_ipLevel3Map := make(map[string]interface{})
+				if !data.EthernetInterface.StaticIpv6Address.NodeStaticIP.DefaultGw.IsNull() && !data.EthernetInterface.StaticIpv6Address.NodeStaticIP.DefaultGw.IsUnknown() {
+					node_static_ipLevel3Map["default_gw"] = data.EthernetInterface.StaticIpv6Address.NodeStaticIP.DefaultGw.ValueString()
+				}
+				if !data.EthernetInterface.StaticIpv6Address.NodeStaticIP.IPAddress.IsNull() && !data.EthernetInterface.StaticIpv6Address.NodeStaticIP.IPAddress.IsUnknown() {
+					node_static_ipLevel3Map["ip_address"] = data.EthernetInterface.StaticIpv6Address.NodeStaticIP.IPAddress.ValueString()
+				}
+				static_ipv6_addressNestedMap["node_static_ip"] = node_static_ipLevel3Map
+			}
 			ethernet_interfaceMap["static_ipv6_address"] = static_ipv6_addressNestedMap
 		}
 		if data.EthernetInterface.StorageNetwork != nil {
@@ -1290,6 +1594,9 @@ func (r *NetworkInterfaceResource) Create(ctx context.Context, req resource.Crea
 			if !data.Layer2Interface.L2sriovInterface.Device.IsNull() && !data.Layer2Interface.L2sriovInterface.Device.IsUnknown() {
 				l2sriov_interfaceNestedMap["device"] = data.Layer2Interface.L2sriovInterface.Device.ValueString()
 			}
+			if data.Layer2Interface.L2sriovInterface.Untagged != nil {
+				l2sriov_interfaceNestedMap["untagged"] = map[string]interface{}{}
+			}
 			if !data.Layer2Interface.L2sriovInterface.VLANID.IsNull() && !data.Layer2Interface.L2sriovInterface.VLANID.IsUnknown() {
 				l2sriov_interfaceNestedMap["vlan_id"] = data.Layer2Interface.L2sriovInterface.VLANID.ValueInt64()
 			}
@@ -1333,6 +1640,23 @@ func (r *NetworkInterfaceResource) Create(ctx context.Context, req resource.Crea
 		}
 		if data.TunnelInterface.StaticIP != nil {
 			static_ipNestedMap := make(map[string]interface{})
+			if data.TunnelInterface.StaticIP.ClusterStaticIP != nil {
+				cluster_static_ipLevel3Map := make(map[string]interface{})
+				if data.TunnelInterface.StaticIP.ClusterStaticIP.InterfaceIPMap != nil {
+					cluster_static_ipLevel3Map["interface_ip_map"] = map[string]interface{}{}
+				}
+				static_ipNestedMap["cluster_static_ip"] = cluster_static_ipLevel3Map
+			}
+			if data.TunnelInterface.StaticIP.NodeStaticIP != nil {
+				node_static_ipLevel3Map := make(map[string]interface{})
+				if !data.TunnelInterface.StaticIP.NodeStaticIP.DefaultGw.IsNull() && !data.TunnelInterface.StaticIP.NodeStaticIP.DefaultGw.IsUnknown() {
+					node_static_ipLevel3Map["default_gw"] = data.TunnelInterface.StaticIP.NodeStaticIP.DefaultGw.ValueString()
+				}
+				if !data.TunnelInterface.StaticIP.NodeStaticIP.IPAddress.IsNull() && !data.TunnelInterface.StaticIP.NodeStaticIP.IPAddress.IsUnknown() {
+					node_static_ipLevel3Map["ip_address"] = data.TunnelInterface.StaticIP.NodeStaticIP.IPAddress.ValueString()
+				}
+				static_ipNestedMap["node_static_ip"] = node_static_ipLevel3Map
+			}
 			tunnel_interfaceMap["static_ip"] = static_ipNestedMap
 		}
 		if data.TunnelInterface.Tunnel != nil {
@@ -1353,11 +1677,15 @@ func (r *NetworkInterfaceResource) Create(ctx context.Context, req resource.Crea
 
 	apiResource, err := r.client.CreateNetworkInterface(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create NetworkInterface: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_interface", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1891,7 +2219,7 @@ func (r *NetworkInterfaceResource) Read(ctx context.Context, req resource.ReadRe
 	apiResource, err := r.client.GetNetworkInterface(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NetworkInterface not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1899,11 +2227,12 @@ func (r *NetworkInterfaceResource) Read(ctx context.Context, req resource.ReadRe
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NetworkInterface: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_interface", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1914,31 +2243,13 @@ func (r *NetworkInterfaceResource) Read(ctx context.Context, req resource.ReadRe
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1946,6 +2257,7 @@ func (r *NetworkInterfaceResource) Read(ctx context.Context, req resource.ReadRe
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["dedicated_interface"].(map[string]interface{}); ok && (isImport || data.DedicatedInterface != nil) {
 		data.DedicatedInterface = &NetworkInterfaceDedicatedInterfaceModel{
@@ -2491,6 +2803,7 @@ func (r *NetworkInterfaceResource) Update(ctx context.Context, req resource.Upda
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -2500,6 +2813,7 @@ func (r *NetworkInterfaceResource) Update(ctx context.Context, req resource.Upda
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.DedicatedInterface != nil {
@@ -2562,10 +2876,84 @@ func (r *NetworkInterfaceResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.EthernetInterface.DHCPServer != nil {
 			dhcp_serverNestedMap := make(map[string]interface{})
+			if data.EthernetInterface.DHCPServer.AutomaticFromEnd != nil {
+				dhcp_serverNestedMap["automatic_from_end"] = map[string]interface{}{}
+			}
+			if data.EthernetInterface.DHCPServer.AutomaticFromStart != nil {
+				dhcp_serverNestedMap["automatic_from_start"] = map[string]interface{}{}
+			}
+			if data.EthernetInterface.DHCPServer.FixedIPMap != nil {
+				dhcp_serverNestedMap["fixed_ip_map"] = map[string]interface{}{}
+			}
+			if data.EthernetInterface.DHCPServer.InterfaceIPMap != nil {
+				interface_ip_mapLevel3Map := make(map[string]interface{})
+				if data.EthernetInterface.DHCPServer.InterfaceIPMap.InterfaceIPMap != nil {
+					interface_ip_mapLevel3Map["interface_ip_map"] = map[string]interface{}{}
+				}
+				dhcp_serverNestedMap["interface_ip_map"] = interface_ip_mapLevel3Map
+			}
 			ethernet_interfaceMap["dhcp_server"] = dhcp_serverNestedMap
 		}
 		if data.EthernetInterface.Ipv6AutoConfig != nil {
 			ipv6_auto_configNestedMap := make(map[string]interface{})
+			if data.EthernetInterface.Ipv6AutoConfig.Host != nil {
+				ipv6_auto_configNestedMap["host"] = map[string]interface{}{}
+			}
+			if data.EthernetInterface.Ipv6AutoConfig.Router != nil {
+				routerLevel3Map := make(map[string]interface{})
+				if data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig != nil {
+					dns_configLevel4Map := make(map[string]interface{})
+					if data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.ConfiguredList != nil {
+						configured_listLevel5Map := make(map[string]interface{})
+						if !data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.ConfiguredList.DNSList.IsNull() && !data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.ConfiguredList.DNSList.IsUnknown() {
+							var dns_listLevel5Items []string
+							diags := data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.ConfiguredList.DNSList.ElementsAs(ctx, &dns_listLevel5Items, false)
+							if !diags.HasError() {
+								configured_listLevel5Map["dns_list"] = dns_listLevel5Items
+							}
+						}
+						dns_configLevel4Map["configured_list"] = configured_listLevel5Map
+					}
+					if data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.LocalDNS != nil {
+						local_dnsLevel5Map := make(map[string]interface{})
+						if !data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.LocalDNS.ConfiguredAddress.IsNull() && !data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.LocalDNS.ConfiguredAddress.IsUnknown() {
+							local_dnsLevel5Map["configured_address"] = data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.LocalDNS.ConfiguredAddress.ValueString()
+						}
+						if data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.LocalDNS.FirstAddress != nil {
+							local_dnsLevel5Map["first_address"] = map[string]interface{}{}
+						}
+						if data.EthernetInterface.Ipv6AutoConfig.Router.DNSConfig.LocalDNS.LastAddress != nil {
+							local_dnsLevel5Map["last_address"] = map[string]interface{}{}
+						}
+						dns_configLevel4Map["local_dns"] = local_dnsLevel5Map
+					}
+					routerLevel3Map["dns_config"] = dns_configLevel4Map
+				}
+				if !data.EthernetInterface.Ipv6AutoConfig.Router.NetworkPrefix.IsNull() && !data.EthernetInterface.Ipv6AutoConfig.Router.NetworkPrefix.IsUnknown() {
+					routerLevel3Map["network_prefix"] = data.EthernetInterface.Ipv6AutoConfig.Router.NetworkPrefix.ValueString()
+				}
+				if data.EthernetInterface.Ipv6AutoConfig.Router.Stateful != nil {
+					statefulLevel4Map := make(map[string]interface{})
+					if data.EthernetInterface.Ipv6AutoConfig.Router.Stateful.AutomaticFromEnd != nil {
+						statefulLevel4Map["automatic_from_end"] = map[string]interface{}{}
+					}
+					if data.EthernetInterface.Ipv6AutoConfig.Router.Stateful.AutomaticFromStart != nil {
+						statefulLevel4Map["automatic_from_start"] = map[string]interface{}{}
+					}
+					if data.EthernetInterface.Ipv6AutoConfig.Router.Stateful.FixedIPMap != nil {
+						statefulLevel4Map["fixed_ip_map"] = map[string]interface{}{}
+					}
+					if data.EthernetInterface.Ipv6AutoConfig.Router.Stateful.InterfaceIPMap != nil {
+						interface_ip_mapLevel5Map := make(map[string]interface{})
+						if data.EthernetInterface.Ipv6AutoConfig.Router.Stateful.InterfaceIPMap.InterfaceIPMap != nil {
+							interface_ip_mapLevel5Map["interface_ip_map"] = map[string]interface{}{}
+						}
+						statefulLevel4Map["interface_ip_map"] = interface_ip_mapLevel5Map
+					}
+					routerLevel3Map["stateful"] = statefulLevel4Map
+				}
+				ipv6_auto_configNestedMap["router"] = routerLevel3Map
+			}
 			ethernet_interfaceMap["ipv6_auto_config"] = ipv6_auto_configNestedMap
 		}
 		if data.EthernetInterface.IsPrimary != nil {
@@ -2600,10 +2988,44 @@ func (r *NetworkInterfaceResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.EthernetInterface.StaticIP != nil {
 			static_ipNestedMap := make(map[string]interface{})
+			if data.EthernetInterface.StaticIP.ClusterStaticIP != nil {
+				cluster_static_ipLevel3Map := make(map[string]interface{})
+				if data.EthernetInterface.StaticIP.ClusterStaticIP.InterfaceIPMap != nil {
+					cluster_static_ipLevel3Map["interface_ip_map"] = map[string]interface{}{}
+				}
+				static_ipNestedMap["cluster_static_ip"] = cluster_static_ipLevel3Map
+			}
+			if data.EthernetInterface.StaticIP.NodeStaticIP != nil {
+				node_static_ipLevel3Map := make(map[string]interface{})
+				if !data.EthernetInterface.StaticIP.NodeStaticIP.DefaultGw.IsNull() && !data.EthernetInterface.StaticIP.NodeStaticIP.DefaultGw.IsUnknown() {
+					node_static_ipLevel3Map["default_gw"] = data.EthernetInterface.StaticIP.NodeStaticIP.DefaultGw.ValueString()
+				}
+				if !data.EthernetInterface.StaticIP.NodeStaticIP.IPAddress.IsNull() && !data.EthernetInterface.StaticIP.NodeStaticIP.IPAddress.IsUnknown() {
+					node_static_ipLevel3Map["ip_address"] = data.EthernetInterface.StaticIP.NodeStaticIP.IPAddress.ValueString()
+				}
+				static_ipNestedMap["node_static_ip"] = node_static_ipLevel3Map
+			}
 			ethernet_interfaceMap["static_ip"] = static_ipNestedMap
 		}
 		if data.EthernetInterface.StaticIpv6Address != nil {
 			static_ipv6_addressNestedMap := make(map[string]interface{})
+			if data.EthernetInterface.StaticIpv6Address.ClusterStaticIP != nil {
+				cluster_static_ipLevel3Map := make(map[string]interface{})
+				if data.EthernetInterface.StaticIpv6Address.ClusterStaticIP.InterfaceIPMap != nil {
+					cluster_static_ipLevel3Map["interface_ip_map"] = map[string]interface{}{}
+				}
+				static_ipv6_addressNestedMap["cluster_static_ip"] = cluster_static_ipLevel3Map
+			}
+			if data.EthernetInterface.StaticIpv6Address.NodeStaticIP != nil {
+				node_static_ipLevel3Map := make(map[string]interface{})
+				if !data.EthernetInterface.StaticIpv6Address.NodeStaticIP.DefaultGw.IsNull() && !data.EthernetInterface.StaticIpv6Address.NodeStaticIP.DefaultGw.IsUnknown() {
+					node_static_ipLevel3Map["default_gw"] = data.EthernetInterface.StaticIpv6Address.NodeStaticIP.DefaultGw.ValueString()
+				}
+				if !data.EthernetInterface.StaticIpv6Address.NodeStaticIP.IPAddress.IsNull() && !data.EthernetInterface.StaticIpv6Address.NodeStaticIP.IPAddress.IsUnknown() {
+					node_static_ipLevel3Map["ip_address"] = data.EthernetInterface.StaticIpv6Address.NodeStaticIP.IPAddress.ValueString()
+				}
+				static_ipv6_addressNestedMap["node_static_ip"] = node_static_ipLevel3Map
+			}
 			ethernet_interfaceMap["static_ipv6_address"] = static_ipv6_addressNestedMap
 		}
 		if data.EthernetInterface.StorageNetwork != nil {
@@ -2624,6 +3046,9 @@ func (r *NetworkInterfaceResource) Update(ctx context.Context, req resource.Upda
 			if !data.Layer2Interface.L2sriovInterface.Device.IsNull() && !data.Layer2Interface.L2sriovInterface.Device.IsUnknown() {
 				l2sriov_interfaceNestedMap["device"] = data.Layer2Interface.L2sriovInterface.Device.ValueString()
 			}
+			if data.Layer2Interface.L2sriovInterface.Untagged != nil {
+				l2sriov_interfaceNestedMap["untagged"] = map[string]interface{}{}
+			}
 			if !data.Layer2Interface.L2sriovInterface.VLANID.IsNull() && !data.Layer2Interface.L2sriovInterface.VLANID.IsUnknown() {
 				l2sriov_interfaceNestedMap["vlan_id"] = data.Layer2Interface.L2sriovInterface.VLANID.ValueInt64()
 			}
@@ -2667,6 +3092,23 @@ func (r *NetworkInterfaceResource) Update(ctx context.Context, req resource.Upda
 		}
 		if data.TunnelInterface.StaticIP != nil {
 			static_ipNestedMap := make(map[string]interface{})
+			if data.TunnelInterface.StaticIP.ClusterStaticIP != nil {
+				cluster_static_ipLevel3Map := make(map[string]interface{})
+				if data.TunnelInterface.StaticIP.ClusterStaticIP.InterfaceIPMap != nil {
+					cluster_static_ipLevel3Map["interface_ip_map"] = map[string]interface{}{}
+				}
+				static_ipNestedMap["cluster_static_ip"] = cluster_static_ipLevel3Map
+			}
+			if data.TunnelInterface.StaticIP.NodeStaticIP != nil {
+				node_static_ipLevel3Map := make(map[string]interface{})
+				if !data.TunnelInterface.StaticIP.NodeStaticIP.DefaultGw.IsNull() && !data.TunnelInterface.StaticIP.NodeStaticIP.DefaultGw.IsUnknown() {
+					node_static_ipLevel3Map["default_gw"] = data.TunnelInterface.StaticIP.NodeStaticIP.DefaultGw.ValueString()
+				}
+				if !data.TunnelInterface.StaticIP.NodeStaticIP.IPAddress.IsNull() && !data.TunnelInterface.StaticIP.NodeStaticIP.IPAddress.IsUnknown() {
+					node_static_ipLevel3Map["ip_address"] = data.TunnelInterface.StaticIP.NodeStaticIP.IPAddress.ValueString()
+				}
+				static_ipNestedMap["node_static_ip"] = node_static_ipLevel3Map
+			}
 			tunnel_interfaceMap["static_ip"] = static_ipNestedMap
 		}
 		if data.TunnelInterface.Tunnel != nil {
@@ -2687,7 +3129,7 @@ func (r *NetworkInterfaceResource) Update(ctx context.Context, req resource.Upda
 
 	_, err := r.client.UpdateNetworkInterface(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update NetworkInterface: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_interface", "update"))
 		return
 	}
 
@@ -2698,9 +3140,12 @@ func (r *NetworkInterfaceResource) Update(ctx context.Context, req resource.Upda
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetNetworkInterface(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NetworkInterface after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "network_interface", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -3234,7 +3679,7 @@ func (r *NetworkInterfaceResource) Delete(ctx context.Context, req resource.Dele
 	err := r.client.DeleteNetworkInterface(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NetworkInterface already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -3243,37 +3688,33 @@ func (r *NetworkInterfaceResource) Delete(ctx context.Context, req resource.Dele
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "NetworkInterface delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete NetworkInterface: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_interface", "delete"))
 		return
 	}
 }
 
 func (r *NetworkInterfaceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *NetworkInterfaceResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("network_interface", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *NetworkInterfaceResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListNetworkInterfaces(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *NetworkInterfaceResource) requiredNamespace() string {
+	return "system"
 }
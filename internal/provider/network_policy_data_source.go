@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,19 @@ type NetworkPolicyDataSource struct {
 	client *client.Client
 }
 
+// NetworkPolicyDataSourceModel mirrors NetworkPolicyResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type NetworkPolicyDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object                `tfsdk:"system_metadata"`
+	Name           types.String                `tfsdk:"name"`
+	Namespace      types.String                `tfsdk:"namespace"`
+	Annotations    types.Map                   `tfsdk:"annotations"`
+	Description    types.String                `tfsdk:"description"`
+	Disable        types.Bool                  `tfsdk:"disable"`
+	Labels         types.Map                   `tfsdk:"labels"`
+	ID             types.String                `tfsdk:"id"`
+	Endpoint       *NetworkPolicyEndpointModel `tfsdk:"endpoint"`
+	Rules          *NetworkPolicyRulesModel    `tfsdk:"rules"`
 }
 
 func (d *NetworkPolicyDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +46,7 @@ func (d *NetworkPolicyDataSource) Metadata(ctx context.Context, req datasource.M
 }
 
 func (d *NetworkPolicyDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages new network policy with configured parameters in specified namespace. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the NetworkPolicy.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the NetworkPolicy exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the NetworkPolicy.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewNetworkPolicyResource())
 }
 
 func (d *NetworkPolicyDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +68,25 @@ func (d *NetworkPolicyDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	resource, err := d.client.GetNetworkPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetNetworkPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NetworkPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_policy", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +100,8 @@ func (d *NetworkPolicyDataSource) Read(ctx context.Context, req datasource.ReadR
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +110,279 @@ func (d *NetworkPolicyDataSource) Read(ctx context.Context, req datasource.ReadR
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["endpoint"].(map[string]interface{}); ok && isImport && data.Endpoint == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Endpoint = &NetworkPolicyEndpointModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["rules"].(map[string]interface{}); ok && (isImport || data.Rules != nil) {
+		data.Rules = &NetworkPolicyRulesModel{
+			EgressRules: func() []NetworkPolicyRulesEgressRulesModel {
+				if listData, ok := blockData["egress_rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []NetworkPolicyRulesEgressRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, NetworkPolicyRulesEgressRulesModel{
+								Action: func() types.String {
+									if v, ok := itemMap["action"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								AdvAction: func() *NetworkPolicyRulesEgressRulesAdvActionModel {
+									if deepMap, ok := itemMap["adv_action"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesEgressRulesAdvActionModel{
+											Action: func() types.String {
+												if v, ok := deepMap["action"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								AllTCPTraffic: func() *NetworkPolicyEmptyModel {
+									if _, ok := itemMap["all_tcp_traffic"].(map[string]interface{}); ok {
+										return &NetworkPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								AllTraffic: func() *NetworkPolicyEmptyModel {
+									if _, ok := itemMap["all_traffic"].(map[string]interface{}); ok {
+										return &NetworkPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								AllUDPTraffic: func() *NetworkPolicyEmptyModel {
+									if _, ok := itemMap["all_udp_traffic"].(map[string]interface{}); ok {
+										return &NetworkPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								Any: func() *NetworkPolicyEmptyModel {
+									if _, ok := itemMap["any"].(map[string]interface{}); ok {
+										return &NetworkPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								Applications: func() *NetworkPolicyRulesEgressRulesApplicationsModel {
+									if _, ok := itemMap["applications"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesEgressRulesApplicationsModel{}
+									}
+									return nil
+								}(),
+								InsideEndpoints: func() *NetworkPolicyEmptyModel {
+									if _, ok := itemMap["inside_endpoints"].(map[string]interface{}); ok {
+										return &NetworkPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								IPPrefixSet: func() *NetworkPolicyRulesEgressRulesIPPrefixSetModel {
+									if _, ok := itemMap["ip_prefix_set"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesEgressRulesIPPrefixSetModel{}
+									}
+									return nil
+								}(),
+								LabelMatcher: func() *NetworkPolicyRulesEgressRulesLabelMatcherModel {
+									if _, ok := itemMap["label_matcher"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesEgressRulesLabelMatcherModel{}
+									}
+									return nil
+								}(),
+								LabelSelector: func() *NetworkPolicyRulesEgressRulesLabelSelectorModel {
+									if _, ok := itemMap["label_selector"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesEgressRulesLabelSelectorModel{}
+									}
+									return nil
+								}(),
+								Metadata: func() *NetworkPolicyRulesEgressRulesMetadataModel {
+									if deepMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesEgressRulesMetadataModel{
+											DescriptionSpec: func() types.String {
+												if v, ok := deepMap["description"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								OutsideEndpoints: func() *NetworkPolicyEmptyModel {
+									if _, ok := itemMap["outside_endpoints"].(map[string]interface{}); ok {
+										return &NetworkPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								PrefixList: func() *NetworkPolicyRulesEgressRulesPrefixListModel {
+									if _, ok := itemMap["prefix_list"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesEgressRulesPrefixListModel{}
+									}
+									return nil
+								}(),
+								ProtocolPortRange: func() *NetworkPolicyRulesEgressRulesProtocolPortRangeModel {
+									if deepMap, ok := itemMap["protocol_port_range"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesEgressRulesProtocolPortRangeModel{
+											Protocol: func() types.String {
+												if v, ok := deepMap["protocol"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			IngressRules: func() []NetworkPolicyRulesIngressRulesModel {
+				if listData, ok := blockData["ingress_rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []NetworkPolicyRulesIngressRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, NetworkPolicyRulesIngressRulesModel{
+								Action: func() types.String {
+									if v, ok := itemMap["action"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								AdvAction: func() *NetworkPolicyRulesIngressRulesAdvActionModel {
+									if deepMap, ok := itemMap["adv_action"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesIngressRulesAdvActionModel{
+											Action: func() types.String {
+												if v, ok := deepMap["action"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								AllTCPTraffic: func() *NetworkPolicyEmptyModel {
+									if _, ok := itemMap["all_tcp_traffic"].(map[string]interface{}); ok {
+										return &NetworkPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								AllTraffic: func() *NetworkPolicyEmptyModel {
+									if _, ok := itemMap["all_traffic"].(map[string]interface{}); ok {
+										return &NetworkPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								AllUDPTraffic: func() *NetworkPolicyEmptyModel {
+									if _, ok := itemMap["all_udp_traffic"].(map[string]interface{}); ok {
+										return &NetworkPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								Any: func() *NetworkPolicyEmptyModel {
+									if _, ok := itemMap["any"].(map[string]interface{}); ok {
+										return &NetworkPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								Applications: func() *NetworkPolicyRulesIngressRulesApplicationsModel {
+									if _, ok := itemMap["applications"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesIngressRulesApplicationsModel{}
+									}
+									return nil
+								}(),
+								InsideEndpoints: func() *NetworkPolicyEmptyModel {
+									if _, ok := itemMap["inside_endpoints"].(map[string]interface{}); ok {
+										return &NetworkPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								IPPrefixSet: func() *NetworkPolicyRulesIngressRulesIPPrefixSetModel {
+									if _, ok := itemMap["ip_prefix_set"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesIngressRulesIPPrefixSetModel{}
+									}
+									return nil
+								}(),
+								LabelMatcher: func() *NetworkPolicyRulesIngressRulesLabelMatcherModel {
+									if _, ok := itemMap["label_matcher"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesIngressRulesLabelMatcherModel{}
+									}
+									return nil
+								}(),
+								LabelSelector: func() *NetworkPolicyRulesIngressRulesLabelSelectorModel {
+									if _, ok := itemMap["label_selector"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesIngressRulesLabelSelectorModel{}
+									}
+									return nil
+								}(),
+								Metadata: func() *NetworkPolicyRulesIngressRulesMetadataModel {
+									if deepMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesIngressRulesMetadataModel{
+											DescriptionSpec: func() types.String {
+												if v, ok := deepMap["description"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								OutsideEndpoints: func() *NetworkPolicyEmptyModel {
+									if _, ok := itemMap["outside_endpoints"].(map[string]interface{}); ok {
+										return &NetworkPolicyEmptyModel{}
+									}
+									return nil
+								}(),
+								PrefixList: func() *NetworkPolicyRulesIngressRulesPrefixListModel {
+									if _, ok := itemMap["prefix_list"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesIngressRulesPrefixListModel{}
+									}
+									return nil
+								}(),
+								ProtocolPortRange: func() *NetworkPolicyRulesIngressRulesProtocolPortRangeModel {
+									if deepMap, ok := itemMap["protocol_port_range"].(map[string]interface{}); ok {
+										return &NetworkPolicyRulesIngressRulesProtocolPortRangeModel{
+											Protocol: func() types.String {
+												if v, ok := deepMap["protocol"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &NetworkPolicyResource{}
-	_ resource.ResourceWithConfigure      = &NetworkPolicyResource{}
-	_ resource.ResourceWithImportState    = &NetworkPolicyResource{}
-	_ resource.ResourceWithModifyPlan     = &NetworkPolicyResource{}
-	_ resource.ResourceWithValidateConfig = &NetworkPolicyResource{}
+	_ resource.Resource                     = &NetworkPolicyResource{}
+	_ resource.ResourceWithConfigure        = &NetworkPolicyResource{}
+	_ resource.ResourceWithImportState      = &NetworkPolicyResource{}
+	_ resource.ResourceWithIdentity         = &NetworkPolicyResource{}
+	_ resource.ResourceWithMoveState        = &NetworkPolicyResource{}
+	_ resource.ResourceWithModifyPlan       = &NetworkPolicyResource{}
+	_ resource.ResourceWithValidateConfig   = &NetworkPolicyResource{}
+	_ resource.ResourceWithConfigValidators = &NetworkPolicyResource{}
+	_ listableResource                      = &NetworkPolicyResource{}
 )
 
 func NewNetworkPolicyResource() resource.Resource {
@@ -376,16 +382,18 @@ var NetworkPolicyRulesIngressRulesProtocolPortRangeModelAttrTypes = map[string]a
 }
 
 type NetworkPolicyResourceModel struct {
-	Name        types.String                `tfsdk:"name"`
-	Namespace   types.String                `tfsdk:"namespace"`
-	Annotations types.Map                   `tfsdk:"annotations"`
-	Description types.String                `tfsdk:"description"`
-	Disable     types.Bool                  `tfsdk:"disable"`
-	Labels      types.Map                   `tfsdk:"labels"`
-	ID          types.String                `tfsdk:"id"`
-	Timeouts    timeouts.Value              `tfsdk:"timeouts"`
-	Endpoint    *NetworkPolicyEndpointModel `tfsdk:"endpoint"`
-	Rules       *NetworkPolicyRulesModel    `tfsdk:"rules"`
+	Name           types.String                `tfsdk:"name"`
+	Namespace      types.String                `tfsdk:"namespace"`
+	Annotations    types.Map                   `tfsdk:"annotations"`
+	Description    types.String                `tfsdk:"description"`
+	Disable        types.Bool                  `tfsdk:"disable"`
+	Labels         types.Map                   `tfsdk:"labels"`
+	ID             types.String                `tfsdk:"id"`
+	Timeouts       timeouts.Value              `tfsdk:"timeouts"`
+	SystemMetadata types.Object                `tfsdk:"system_metadata"`
+	LabelsAll      types.Map                   `tfsdk:"labels_all"`
+	Endpoint       *NetworkPolicyEndpointModel `tfsdk:"endpoint"`
+	Rules          *NetworkPolicyRulesModel    `tfsdk:"rules"`
 }
 
 func (r *NetworkPolicyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -407,9 +415,11 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Network Policy will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Network Policy will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -441,6 +451,8 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -466,6 +478,10 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 								MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+								},
 							},
 						},
 					},
@@ -479,6 +495,11 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 								MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+								},
 							},
 						},
 					},
@@ -490,6 +511,9 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 				Blocks: map[string]schema.Block{
 					"egress_rules": schema.ListNestedBlock{
 						MarkdownDescription: "Ordered list of rules applied to connections from policy endpoints.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(128),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"action": schema.StringAttribute{
@@ -538,6 +562,9 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 									Blocks: map[string]schema.Block{
 										"ref": schema.ListNestedBlock{
 											MarkdownDescription: "List of references to ip_prefix_set objects.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"kind": schema.StringAttribute{
@@ -588,6 +615,11 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 											MarkdownDescription: "The list of label key names that have to match.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(64), stringvalidator.UTF8LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -598,6 +630,10 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 											MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -607,10 +643,16 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Human readable description.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 								},
@@ -624,6 +666,11 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 											MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+											},
 										},
 									},
 								},
@@ -634,6 +681,9 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 											MarkdownDescription: "List of port ranges. Each range is a single port or a pair of start and end ports e.g. 8080-8192.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+											},
 										},
 										"protocol": schema.StringAttribute{
 											MarkdownDescription: "Protocol in IP packet to be used as match criteria Values are TCP, UDP, and icmp.",
@@ -646,6 +696,9 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 					},
 					"ingress_rules": schema.ListNestedBlock{
 						MarkdownDescription: "Ordered list of rules applied to connections to policy endpoints.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(128),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"action": schema.StringAttribute{
@@ -694,6 +747,9 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 									Blocks: map[string]schema.Block{
 										"ref": schema.ListNestedBlock{
 											MarkdownDescription: "List of references to ip_prefix_set objects.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"kind": schema.StringAttribute{
@@ -744,6 +800,11 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 											MarkdownDescription: "The list of label key names that have to match.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(64), stringvalidator.UTF8LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -754,6 +815,10 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 											MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -763,10 +828,16 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Human readable description.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 								},
@@ -780,6 +851,11 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 											MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+											},
 										},
 									},
 								},
@@ -790,6 +866,9 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 											MarkdownDescription: "List of port ranges. Each range is a single port or a pair of start and end ports e.g. 8080-8192.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+											},
 										},
 										"protocol": schema.StringAttribute{
 											MarkdownDescription: "Protocol in IP packet to be used as match criteria Values are TCP, UDP, and icmp.",
@@ -806,6 +885,11 @@ func (r *NetworkPolicyResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *NetworkPolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *NetworkPolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -830,6 +914,18 @@ func (r *NetworkPolicyResource) ValidateConfig(ctx context.Context, req resource
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *NetworkPolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("endpoint"), "endpoint_choice", "any", "inside_endpoints", "label_selector", "outside_endpoints", "prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtName("egress_rules").AtAnyListIndex(), "other_endpoint", "any", "inside_endpoints", "ip_prefix_set", "label_selector", "outside_endpoints", "prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtName("egress_rules").AtAnyListIndex(), "traffic_choice", "all_tcp_traffic", "all_traffic", "all_udp_traffic", "applications", "protocol_port_range"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtName("ingress_rules").AtAnyListIndex(), "other_endpoint", "any", "inside_endpoints", "ip_prefix_set", "label_selector", "outside_endpoints", "prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("rules").AtName("ingress_rules").AtAnyListIndex(), "traffic_choice", "all_tcp_traffic", "all_traffic", "all_udp_traffic", "applications", "protocol_port_range"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *NetworkPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -840,7 +936,10 @@ func (r *NetworkPolicyResource) ModifyPlan(ctx context.Context, req resource.Mod
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "network_policy", "", req.Config, &resp.Plan)...)
 		var plan NetworkPolicyResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -897,6 +996,7 @@ func (r *NetworkPolicyResource) Create(ctx context.Context, req resource.CreateR
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -906,6 +1006,7 @@ func (r *NetworkPolicyResource) Create(ctx context.Context, req resource.CreateR
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.Endpoint != nil {
@@ -1082,11 +1183,15 @@ func (r *NetworkPolicyResource) Create(ctx context.Context, req resource.CreateR
 
 	apiResource, err := r.client.CreateNetworkPolicy(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create NetworkPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_policy", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1385,7 +1490,7 @@ func (r *NetworkPolicyResource) Read(ctx context.Context, req resource.ReadReque
 	apiResource, err := r.client.GetNetworkPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NetworkPolicy not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1393,11 +1498,12 @@ func (r *NetworkPolicyResource) Read(ctx context.Context, req resource.ReadReque
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NetworkPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_policy", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1408,31 +1514,13 @@ func (r *NetworkPolicyResource) Read(ctx context.Context, req resource.ReadReque
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1440,6 +1528,7 @@ func (r *NetworkPolicyResource) Read(ctx context.Context, req resource.ReadReque
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["endpoint"].(map[string]interface{}); ok && isImport && data.Endpoint == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -1750,6 +1839,7 @@ func (r *NetworkPolicyResource) Update(ctx context.Context, req resource.UpdateR
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1759,6 +1849,7 @@ func (r *NetworkPolicyResource) Update(ctx context.Context, req resource.UpdateR
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.Endpoint != nil {
@@ -1935,7 +2026,7 @@ func (r *NetworkPolicyResource) Update(ctx context.Context, req resource.UpdateR
 
 	_, err := r.client.UpdateNetworkPolicy(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update NetworkPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_policy", "update"))
 		return
 	}
 
@@ -1946,9 +2037,12 @@ func (r *NetworkPolicyResource) Update(ctx context.Context, req resource.UpdateR
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetNetworkPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NetworkPolicy after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "network_policy", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -2247,7 +2341,7 @@ func (r *NetworkPolicyResource) Delete(ctx context.Context, req resource.DeleteR
 	err := r.client.DeleteNetworkPolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NetworkPolicy already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -2256,37 +2350,33 @@ func (r *NetworkPolicyResource) Delete(ctx context.Context, req resource.DeleteR
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "NetworkPolicy delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete NetworkPolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_policy", "delete"))
 		return
 	}
 }
 
 func (r *NetworkPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *NetworkPolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("network_policy", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *NetworkPolicyResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListNetworkPolicies(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *NetworkPolicyResource) requiredNamespace() string {
+	return ""
 }
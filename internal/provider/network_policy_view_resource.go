@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &NetworkPolicyViewResource{}
-	_ resource.ResourceWithConfigure      = &NetworkPolicyViewResource{}
-	_ resource.ResourceWithImportState    = &NetworkPolicyViewResource{}
-	_ resource.ResourceWithModifyPlan     = &NetworkPolicyViewResource{}
-	_ resource.ResourceWithValidateConfig = &NetworkPolicyViewResource{}
+	_ resource.Resource                     = &NetworkPolicyViewResource{}
+	_ resource.ResourceWithConfigure        = &NetworkPolicyViewResource{}
+	_ resource.ResourceWithImportState      = &NetworkPolicyViewResource{}
+	_ resource.ResourceWithIdentity         = &NetworkPolicyViewResource{}
+	_ resource.ResourceWithMoveState        = &NetworkPolicyViewResource{}
+	_ resource.ResourceWithModifyPlan       = &NetworkPolicyViewResource{}
+	_ resource.ResourceWithValidateConfig   = &NetworkPolicyViewResource{}
+	_ resource.ResourceWithConfigValidators = &NetworkPolicyViewResource{}
+	_ listableResource                      = &NetworkPolicyViewResource{}
 )
 
 func NewNetworkPolicyViewResource() resource.Resource {
@@ -364,17 +370,19 @@ var NetworkPolicyViewIngressRulesProtocolPortRangeModelAttrTypes = map[string]at
 }
 
 type NetworkPolicyViewResourceModel struct {
-	Name         types.String                    `tfsdk:"name"`
-	Namespace    types.String                    `tfsdk:"namespace"`
-	Annotations  types.Map                       `tfsdk:"annotations"`
-	Description  types.String                    `tfsdk:"description"`
-	Disable      types.Bool                      `tfsdk:"disable"`
-	Labels       types.Map                       `tfsdk:"labels"`
-	ID           types.String                    `tfsdk:"id"`
-	Timeouts     timeouts.Value                  `tfsdk:"timeouts"`
-	EgressRules  types.List                      `tfsdk:"egress_rules"`
-	Endpoint     *NetworkPolicyViewEndpointModel `tfsdk:"endpoint"`
-	IngressRules types.List                      `tfsdk:"ingress_rules"`
+	Name           types.String                    `tfsdk:"name"`
+	Namespace      types.String                    `tfsdk:"namespace"`
+	Annotations    types.Map                       `tfsdk:"annotations"`
+	Description    types.String                    `tfsdk:"description"`
+	Disable        types.Bool                      `tfsdk:"disable"`
+	Labels         types.Map                       `tfsdk:"labels"`
+	ID             types.String                    `tfsdk:"id"`
+	Timeouts       timeouts.Value                  `tfsdk:"timeouts"`
+	SystemMetadata types.Object                    `tfsdk:"system_metadata"`
+	LabelsAll      types.Map                       `tfsdk:"labels_all"`
+	EgressRules    types.List                      `tfsdk:"egress_rules"`
+	Endpoint       *NetworkPolicyViewEndpointModel `tfsdk:"endpoint"`
+	IngressRules   types.List                      `tfsdk:"ingress_rules"`
 }
 
 func (r *NetworkPolicyViewResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -396,9 +404,11 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Network Policy View will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Network Policy View will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -430,6 +440,8 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -440,6 +452,10 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 			}),
 			"egress_rules": schema.ListNestedBlock{
 				MarkdownDescription: "Ordered list of rules applied to connections from policy endpoints.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(128),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"action": schema.StringAttribute{
@@ -488,6 +504,9 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 							Blocks: map[string]schema.Block{
 								"ref": schema.ListNestedBlock{
 									MarkdownDescription: "List of references to ip_prefix_set objects.",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(1),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"kind": schema.StringAttribute{
@@ -538,6 +557,11 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "The list of label key names that have to match.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(64), stringvalidator.UTF8LengthAtLeast(1)),
+									},
 								},
 							},
 						},
@@ -548,6 +572,10 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(1),
+										listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+									},
 								},
 							},
 						},
@@ -557,10 +585,16 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Description. Human readable description.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -574,6 +608,11 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(128),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+									},
 								},
 							},
 						},
@@ -584,6 +623,9 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "List of port ranges. Each range is a single port or a pair of start and end ports e.g. 8080-8192.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(128),
+									},
 								},
 								"protocol": schema.StringAttribute{
 									MarkdownDescription: "Protocol in IP packet to be used as match criteria Values are TCP, UDP, and icmp.",
@@ -611,6 +653,10 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 								MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+								},
 							},
 						},
 					},
@@ -624,6 +670,11 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 								MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+								},
 							},
 						},
 					},
@@ -631,6 +682,10 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 			},
 			"ingress_rules": schema.ListNestedBlock{
 				MarkdownDescription: "Ordered list of rules applied to connections to policy endpoints.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(128),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"action": schema.StringAttribute{
@@ -679,6 +734,9 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 							Blocks: map[string]schema.Block{
 								"ref": schema.ListNestedBlock{
 									MarkdownDescription: "List of references to ip_prefix_set objects.",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(1),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"kind": schema.StringAttribute{
@@ -729,6 +787,11 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "The list of label key names that have to match.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(64), stringvalidator.UTF8LengthAtLeast(1)),
+									},
 								},
 							},
 						},
@@ -739,6 +802,10 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(1),
+										listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+									},
 								},
 							},
 						},
@@ -748,10 +815,16 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 								"description_spec": schema.StringAttribute{
 									MarkdownDescription: "Description. Human readable description.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"name": schema.StringAttribute{
 									MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 						},
@@ -765,6 +838,11 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(128),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+									},
 								},
 							},
 						},
@@ -775,6 +853,9 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "List of port ranges. Each range is a single port or a pair of start and end ports e.g. 8080-8192.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(128),
+									},
 								},
 								"protocol": schema.StringAttribute{
 									MarkdownDescription: "Protocol in IP packet to be used as match criteria Values are TCP, UDP, and icmp.",
@@ -789,6 +870,11 @@ func (r *NetworkPolicyViewResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *NetworkPolicyViewResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *NetworkPolicyViewResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -813,6 +899,18 @@ func (r *NetworkPolicyViewResource) ValidateConfig(ctx context.Context, req reso
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *NetworkPolicyViewResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("egress_rules").AtAnyListIndex(), "other_endpoint", "any", "inside_endpoints", "ip_prefix_set", "label_selector", "outside_endpoints", "prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("egress_rules").AtAnyListIndex(), "traffic_choice", "all_tcp_traffic", "all_traffic", "all_udp_traffic", "applications", "protocol_port_range"),
+		validators.OneOfGroup(path.MatchRoot("endpoint"), "endpoint_choice", "any", "inside_endpoints", "label_selector", "outside_endpoints", "prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("ingress_rules").AtAnyListIndex(), "other_endpoint", "any", "inside_endpoints", "ip_prefix_set", "label_selector", "outside_endpoints", "prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("ingress_rules").AtAnyListIndex(), "traffic_choice", "all_tcp_traffic", "all_traffic", "all_udp_traffic", "applications", "protocol_port_range"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *NetworkPolicyViewResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -823,7 +921,10 @@ func (r *NetworkPolicyViewResource) ModifyPlan(ctx context.Context, req resource
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "network_policy_view", "system", req.Config, &resp.Plan)...)
 		var plan NetworkPolicyViewResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -880,6 +981,7 @@ func (r *NetworkPolicyViewResource) Create(ctx context.Context, req resource.Cre
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -889,6 +991,7 @@ func (r *NetworkPolicyViewResource) Create(ctx context.Context, req resource.Cre
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.EgressRules.IsNull() && !data.EgressRules.IsUnknown() {
@@ -1187,11 +1290,15 @@ func (r *NetworkPolicyViewResource) Create(ctx context.Context, req resource.Cre
 
 	apiResource, err := r.client.CreateNetworkPolicyView(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create NetworkPolicyView: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_policy_view", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1642,7 +1749,7 @@ func (r *NetworkPolicyViewResource) Read(ctx context.Context, req resource.ReadR
 	apiResource, err := r.client.GetNetworkPolicyView(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NetworkPolicyView not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1650,11 +1757,12 @@ func (r *NetworkPolicyViewResource) Read(ctx context.Context, req resource.ReadR
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NetworkPolicyView: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_policy_view", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1665,31 +1773,13 @@ func (r *NetworkPolicyViewResource) Read(ctx context.Context, req resource.ReadR
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1697,6 +1787,7 @@ func (r *NetworkPolicyViewResource) Read(ctx context.Context, req resource.ReadR
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if listData, ok := apiResource.Spec["egress_rules"].([]interface{}); ok && len(listData) > 0 {
 		var egress_rulesList []NetworkPolicyViewEgressRulesModel
@@ -2159,6 +2250,7 @@ func (r *NetworkPolicyViewResource) Update(ctx context.Context, req resource.Upd
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -2168,6 +2260,7 @@ func (r *NetworkPolicyViewResource) Update(ctx context.Context, req resource.Upd
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.EgressRules.IsNull() && !data.EgressRules.IsUnknown() {
@@ -2466,7 +2559,7 @@ func (r *NetworkPolicyViewResource) Update(ctx context.Context, req resource.Upd
 
 	_, err := r.client.UpdateNetworkPolicyView(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update NetworkPolicyView: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_policy_view", "update"))
 		return
 	}
 
@@ -2477,9 +2570,12 @@ func (r *NetworkPolicyViewResource) Update(ctx context.Context, req resource.Upd
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetNetworkPolicyView(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NetworkPolicyView after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "network_policy_view", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -2930,7 +3026,7 @@ func (r *NetworkPolicyViewResource) Delete(ctx context.Context, req resource.Del
 	err := r.client.DeleteNetworkPolicyView(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NetworkPolicyView already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -2939,37 +3035,33 @@ func (r *NetworkPolicyViewResource) Delete(ctx context.Context, req resource.Del
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "NetworkPolicyView delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete NetworkPolicyView: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "network_policy_view", "delete"))
 		return
 	}
 }
 
 func (r *NetworkPolicyViewResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *NetworkPolicyViewResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("network_policy_view", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *NetworkPolicyViewResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListNetworkPolicyViews(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *NetworkPolicyViewResource) requiredNamespace() string {
+	return "system"
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,23 @@ type NfvServiceDataSource struct {
 	client *client.Client
 }
 
+// NfvServiceDataSourceModel mirrors NfvServiceResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type NfvServiceDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata         types.Object                      `tfsdk:"system_metadata"`
+	Name                   types.String                      `tfsdk:"name"`
+	Namespace              types.String                      `tfsdk:"namespace"`
+	Annotations            types.Map                         `tfsdk:"annotations"`
+	Description            types.String                      `tfsdk:"description"`
+	Disable                types.Bool                        `tfsdk:"disable"`
+	Labels                 types.Map                         `tfsdk:"labels"`
+	ID                     types.String                      `tfsdk:"id"`
+	DisableHTTPSManagement *NfvServiceEmptyModel             `tfsdk:"disable_https_management"`
+	DisableSSHAccess       *NfvServiceEmptyModel             `tfsdk:"disable_ssh_access"`
+	EnabledSSHAccess       *NfvServiceEnabledSSHAccessModel  `tfsdk:"enabled_ssh_access"`
+	F5BigIPAWSService      *NfvServiceF5BigIPAWSServiceModel `tfsdk:"f5_big_ip_aws_service"`
+	HTTPSManagement        *NfvServiceHTTPSManagementModel   `tfsdk:"https_management"`
+	PaloAltoFwService      *NfvServicePaloAltoFwServiceModel `tfsdk:"palo_alto_fw_service"`
 }
 
 func (d *NfvServiceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +50,7 @@ func (d *NfvServiceDataSource) Metadata(ctx context.Context, req datasource.Meta
 }
 
 func (d *NfvServiceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages new NFV service with configured parameters. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the NfvService.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the NfvService exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the NfvService.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewNfvServiceResource())
 }
 
 func (d *NfvServiceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +72,25 @@ func (d *NfvServiceDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		return
 	}
 
-	resource, err := d.client.GetNfvService(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetNfvService(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NfvService: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nfv_service", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +104,8 @@ func (d *NfvServiceDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +114,492 @@ func (d *NfvServiceDataSource) Read(ctx context.Context, req datasource.ReadRequ
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["disable_https_management"].(map[string]interface{}); ok && isImport && data.DisableHTTPSManagement == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableHTTPSManagement = &NfvServiceEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_ssh_access"].(map[string]interface{}); ok && isImport && data.DisableSSHAccess == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableSSHAccess = &NfvServiceEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["enabled_ssh_access"].(map[string]interface{}); ok && (isImport || data.EnabledSSHAccess != nil) {
+		data.EnabledSSHAccess = &NfvServiceEnabledSSHAccessModel{
+			AdvertiseOnSLI: func() *NfvServiceEmptyModel {
+				if !isImport && data.EnabledSSHAccess != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EnabledSSHAccess.AdvertiseOnSLI
+				}
+				// Import case: read from API
+				if _, ok := blockData["advertise_on_sli"].(map[string]interface{}); ok {
+					return &NfvServiceEmptyModel{}
+				}
+				return nil
+			}(),
+			AdvertiseOnSlo: func() *NfvServiceEmptyModel {
+				if !isImport && data.EnabledSSHAccess != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EnabledSSHAccess.AdvertiseOnSlo
+				}
+				// Import case: read from API
+				if _, ok := blockData["advertise_on_slo"].(map[string]interface{}); ok {
+					return &NfvServiceEmptyModel{}
+				}
+				return nil
+			}(),
+			AdvertiseOnSloSLI: func() *NfvServiceEmptyModel {
+				if !isImport && data.EnabledSSHAccess != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.EnabledSSHAccess.AdvertiseOnSloSLI
+				}
+				// Import case: read from API
+				if _, ok := blockData["advertise_on_slo_sli"].(map[string]interface{}); ok {
+					return &NfvServiceEmptyModel{}
+				}
+				return nil
+			}(),
+			DomainSuffix: func() types.String {
+				if v, ok := blockData["domain_suffix"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			NodeSSHPorts: func() []NfvServiceEnabledSSHAccessNodeSSHPortsModel {
+				if listData, ok := blockData["node_ssh_ports"].([]interface{}); ok && len(listData) > 0 {
+					var result []NfvServiceEnabledSSHAccessNodeSSHPortsModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, NfvServiceEnabledSSHAccessNodeSSHPortsModel{
+								NodeName: func() types.String {
+									if v, ok := itemMap["node_name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								SSHPort: func() types.Int64 {
+									if v, ok := itemMap["ssh_port"].(float64); ok {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["f5_big_ip_aws_service"].(map[string]interface{}); ok && (isImport || data.F5BigIPAWSService != nil) {
+		data.F5BigIPAWSService = &NfvServiceF5BigIPAWSServiceModel{
+			AdminPassword: func() *NfvServiceF5BigIPAWSServiceAdminPasswordModel {
+				if !isImport && data.F5BigIPAWSService != nil && data.F5BigIPAWSService.AdminPassword != nil {
+					// Normal Read: preserve existing state value
+					return data.F5BigIPAWSService.AdminPassword
+				}
+				// Import case: read from API
+				if _, ok := blockData["admin_password"].(map[string]interface{}); ok {
+					return &NfvServiceF5BigIPAWSServiceAdminPasswordModel{}
+				}
+				return nil
+			}(),
+			AdminUsername: func() types.String {
+				if v, ok := blockData["admin_username"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			AWSTGWSiteParams: func() *NfvServiceF5BigIPAWSServiceAWSTGWSiteParamsModel {
+				if !isImport && data.F5BigIPAWSService != nil && data.F5BigIPAWSService.AWSTGWSiteParams != nil {
+					// Normal Read: preserve existing state value
+					return data.F5BigIPAWSService.AWSTGWSiteParams
+				}
+				// Import case: read from API
+				if _, ok := blockData["aws_tgw_site_params"].(map[string]interface{}); ok {
+					return &NfvServiceF5BigIPAWSServiceAWSTGWSiteParamsModel{}
+				}
+				return nil
+			}(),
+			EndpointService: func() *NfvServiceF5BigIPAWSServiceEndpointServiceModel {
+				if !isImport && data.F5BigIPAWSService != nil && data.F5BigIPAWSService.EndpointService != nil {
+					// Normal Read: preserve existing state value
+					return data.F5BigIPAWSService.EndpointService
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["endpoint_service"].(map[string]interface{}); ok {
+					return &NfvServiceF5BigIPAWSServiceEndpointServiceModel{
+						ConfiguredVIP: func() types.String {
+							if v, ok := nestedBlockData["configured_vip"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			MarketPlaceImage: func() *NfvServiceF5BigIPAWSServiceMarketPlaceImageModel {
+				if !isImport && data.F5BigIPAWSService != nil && data.F5BigIPAWSService.MarketPlaceImage != nil {
+					// Normal Read: preserve existing state value
+					return data.F5BigIPAWSService.MarketPlaceImage
+				}
+				// Import case: read from API
+				if _, ok := blockData["market_place_image"].(map[string]interface{}); ok {
+					return &NfvServiceF5BigIPAWSServiceMarketPlaceImageModel{}
+				}
+				return nil
+			}(),
+			Nodes: func() []NfvServiceF5BigIPAWSServiceNodesModel {
+				if listData, ok := blockData["nodes"].([]interface{}); ok && len(listData) > 0 {
+					var result []NfvServiceF5BigIPAWSServiceNodesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, NfvServiceF5BigIPAWSServiceNodesModel{
+								AutomaticPrefix: func() *NfvServiceEmptyModel {
+									if _, ok := itemMap["automatic_prefix"].(map[string]interface{}); ok {
+										return &NfvServiceEmptyModel{}
+									}
+									return nil
+								}(),
+								AWSAzName: func() types.String {
+									if v, ok := itemMap["aws_az_name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								MgmtSubnet: func() *NfvServiceF5BigIPAWSServiceNodesMgmtSubnetModel {
+									if deepMap, ok := itemMap["mgmt_subnet"].(map[string]interface{}); ok {
+										return &NfvServiceF5BigIPAWSServiceNodesMgmtSubnetModel{
+											ExistingSubnetID: func() types.String {
+												if v, ok := deepMap["existing_subnet_id"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								NodeName: func() types.String {
+									if v, ok := itemMap["node_name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								ReservedMgmtSubnet: func() *NfvServiceEmptyModel {
+									if _, ok := itemMap["reserved_mgmt_subnet"].(map[string]interface{}); ok {
+										return &NfvServiceEmptyModel{}
+									}
+									return nil
+								}(),
+								TunnelPrefix: func() types.String {
+									if v, ok := itemMap["tunnel_prefix"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			SSHKey: func() types.String {
+				if v, ok := blockData["ssh_key"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tags: func() *NfvServiceEmptyModel {
+				if !isImport && data.F5BigIPAWSService != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.F5BigIPAWSService.Tags
+				}
+				// Import case: read from API
+				if _, ok := blockData["tags"].(map[string]interface{}); ok {
+					return &NfvServiceEmptyModel{}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["https_management"].(map[string]interface{}); ok && (isImport || data.HTTPSManagement != nil) {
+		data.HTTPSManagement = &NfvServiceHTTPSManagementModel{
+			AdvertiseOnInternet: func() *NfvServiceHTTPSManagementAdvertiseOnInternetModel {
+				if !isImport && data.HTTPSManagement != nil && data.HTTPSManagement.AdvertiseOnInternet != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPSManagement.AdvertiseOnInternet
+				}
+				// Import case: read from API
+				if _, ok := blockData["advertise_on_internet"].(map[string]interface{}); ok {
+					return &NfvServiceHTTPSManagementAdvertiseOnInternetModel{}
+				}
+				return nil
+			}(),
+			AdvertiseOnInternetDefaultVIP: func() *NfvServiceEmptyModel {
+				if !isImport && data.HTTPSManagement != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPSManagement.AdvertiseOnInternetDefaultVIP
+				}
+				// Import case: read from API
+				if _, ok := blockData["advertise_on_internet_default_vip"].(map[string]interface{}); ok {
+					return &NfvServiceEmptyModel{}
+				}
+				return nil
+			}(),
+			AdvertiseOnSLIVIP: func() *NfvServiceHTTPSManagementAdvertiseOnSLIVIPModel {
+				if !isImport && data.HTTPSManagement != nil && data.HTTPSManagement.AdvertiseOnSLIVIP != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPSManagement.AdvertiseOnSLIVIP
+				}
+				// Import case: read from API
+				if _, ok := blockData["advertise_on_sli_vip"].(map[string]interface{}); ok {
+					return &NfvServiceHTTPSManagementAdvertiseOnSLIVIPModel{}
+				}
+				return nil
+			}(),
+			AdvertiseOnSloInternetVIP: func() *NfvServiceHTTPSManagementAdvertiseOnSloInternetVIPModel {
+				if !isImport && data.HTTPSManagement != nil && data.HTTPSManagement.AdvertiseOnSloInternetVIP != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPSManagement.AdvertiseOnSloInternetVIP
+				}
+				// Import case: read from API
+				if _, ok := blockData["advertise_on_slo_internet_vip"].(map[string]interface{}); ok {
+					return &NfvServiceHTTPSManagementAdvertiseOnSloInternetVIPModel{}
+				}
+				return nil
+			}(),
+			AdvertiseOnSloSLI: func() *NfvServiceHTTPSManagementAdvertiseOnSloSLIModel {
+				if !isImport && data.HTTPSManagement != nil && data.HTTPSManagement.AdvertiseOnSloSLI != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPSManagement.AdvertiseOnSloSLI
+				}
+				// Import case: read from API
+				if _, ok := blockData["advertise_on_slo_sli"].(map[string]interface{}); ok {
+					return &NfvServiceHTTPSManagementAdvertiseOnSloSLIModel{}
+				}
+				return nil
+			}(),
+			AdvertiseOnSloVIP: func() *NfvServiceHTTPSManagementAdvertiseOnSloVIPModel {
+				if !isImport && data.HTTPSManagement != nil && data.HTTPSManagement.AdvertiseOnSloVIP != nil {
+					// Normal Read: preserve existing state value
+					return data.HTTPSManagement.AdvertiseOnSloVIP
+				}
+				// Import case: read from API
+				if _, ok := blockData["advertise_on_slo_vip"].(map[string]interface{}); ok {
+					return &NfvServiceHTTPSManagementAdvertiseOnSloVIPModel{}
+				}
+				return nil
+			}(),
+			DefaultHTTPSPort: func() *NfvServiceEmptyModel {
+				if !isImport && data.HTTPSManagement != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.HTTPSManagement.DefaultHTTPSPort
+				}
+				// Import case: read from API
+				if _, ok := blockData["default_https_port"].(map[string]interface{}); ok {
+					return &NfvServiceEmptyModel{}
+				}
+				return nil
+			}(),
+			DomainSuffix: func() types.String {
+				if v, ok := blockData["domain_suffix"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			HTTPSPort: func() types.Int64 {
+				if !isImport && data.HTTPSManagement != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.HTTPSManagement.HTTPSPort
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["https_port"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["palo_alto_fw_service"].(map[string]interface{}); ok && (isImport || data.PaloAltoFwService != nil) {
+		data.PaloAltoFwService = &NfvServicePaloAltoFwServiceModel{
+			AutoSetup: func() *NfvServicePaloAltoFwServiceAutoSetupModel {
+				if !isImport && data.PaloAltoFwService != nil && data.PaloAltoFwService.AutoSetup != nil {
+					// Normal Read: preserve existing state value
+					return data.PaloAltoFwService.AutoSetup
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["auto_setup"].(map[string]interface{}); ok {
+					return &NfvServicePaloAltoFwServiceAutoSetupModel{
+						AdminUsername: func() types.String {
+							if v, ok := nestedBlockData["admin_username"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			AWSTGWSite: func() *NfvServicePaloAltoFwServiceAWSTGWSiteModel {
+				if !isImport && data.PaloAltoFwService != nil && data.PaloAltoFwService.AWSTGWSite != nil {
+					// Normal Read: preserve existing state value
+					return data.PaloAltoFwService.AWSTGWSite
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["aws_tgw_site"].(map[string]interface{}); ok {
+					return &NfvServicePaloAltoFwServiceAWSTGWSiteModel{
+						Name: func() types.String {
+							if v, ok := nestedBlockData["name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Namespace: func() types.String {
+							if v, ok := nestedBlockData["namespace"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Tenant: func() types.String {
+							if v, ok := nestedBlockData["tenant"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			DisablePanaroma: func() *NfvServiceEmptyModel {
+				if !isImport && data.PaloAltoFwService != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PaloAltoFwService.DisablePanaroma
+				}
+				// Import case: read from API
+				if _, ok := blockData["disable_panaroma"].(map[string]interface{}); ok {
+					return &NfvServiceEmptyModel{}
+				}
+				return nil
+			}(),
+			InstanceType: func() types.String {
+				if v, ok := blockData["instance_type"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			PanAmiBundle1: func() *NfvServiceEmptyModel {
+				if !isImport && data.PaloAltoFwService != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PaloAltoFwService.PanAmiBundle1
+				}
+				// Import case: read from API
+				if _, ok := blockData["pan_ami_bundle1"].(map[string]interface{}); ok {
+					return &NfvServiceEmptyModel{}
+				}
+				return nil
+			}(),
+			PanAmiBundle2: func() *NfvServiceEmptyModel {
+				if !isImport && data.PaloAltoFwService != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PaloAltoFwService.PanAmiBundle2
+				}
+				// Import case: read from API
+				if _, ok := blockData["pan_ami_bundle2"].(map[string]interface{}); ok {
+					return &NfvServiceEmptyModel{}
+				}
+				return nil
+			}(),
+			PanoramaServer: func() *NfvServicePaloAltoFwServicePanoramaServerModel {
+				if !isImport && data.PaloAltoFwService != nil && data.PaloAltoFwService.PanoramaServer != nil {
+					// Normal Read: preserve existing state value
+					return data.PaloAltoFwService.PanoramaServer
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["panorama_server"].(map[string]interface{}); ok {
+					return &NfvServicePaloAltoFwServicePanoramaServerModel{
+						DeviceGroupName: func() types.String {
+							if v, ok := nestedBlockData["device_group_name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						Server: func() types.String {
+							if v, ok := nestedBlockData["server"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						TemplateStackName: func() types.String {
+							if v, ok := nestedBlockData["template_stack_name"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			ServiceNodes: func() *NfvServicePaloAltoFwServiceServiceNodesModel {
+				if !isImport && data.PaloAltoFwService != nil && data.PaloAltoFwService.ServiceNodes != nil {
+					// Normal Read: preserve existing state value
+					return data.PaloAltoFwService.ServiceNodes
+				}
+				// Import case: read from API
+				if _, ok := blockData["service_nodes"].(map[string]interface{}); ok {
+					return &NfvServicePaloAltoFwServiceServiceNodesModel{}
+				}
+				return nil
+			}(),
+			SSHKey: func() types.String {
+				if v, ok := blockData["ssh_key"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tags: func() *NfvServiceEmptyModel {
+				if !isImport && data.PaloAltoFwService != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.PaloAltoFwService.Tags
+				}
+				// Import case: read from API
+				if _, ok := blockData["tags"].(map[string]interface{}); ok {
+					return &NfvServiceEmptyModel{}
+				}
+				return nil
+			}(),
+			Version: func() types.String {
+				if v, ok := blockData["version"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewNfvServiceResource(), &data)...)
 }
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &NfvServiceResource{}
-	_ resource.ResourceWithConfigure      = &NfvServiceResource{}
-	_ resource.ResourceWithImportState    = &NfvServiceResource{}
-	_ resource.ResourceWithModifyPlan     = &NfvServiceResource{}
-	_ resource.ResourceWithValidateConfig = &NfvServiceResource{}
+	_ resource.Resource                     = &NfvServiceResource{}
+	_ resource.ResourceWithConfigure        = &NfvServiceResource{}
+	_ resource.ResourceWithImportState      = &NfvServiceResource{}
+	_ resource.ResourceWithIdentity         = &NfvServiceResource{}
+	_ resource.ResourceWithMoveState        = &NfvServiceResource{}
+	_ resource.ResourceWithModifyPlan       = &NfvServiceResource{}
+	_ resource.ResourceWithValidateConfig   = &NfvServiceResource{}
+	_ resource.ResourceWithConfigValidators = &NfvServiceResource{}
+	_ listableResource                      = &NfvServiceResource{}
 )
 
 func NewNfvServiceResource() resource.Resource {
@@ -127,14 +134,16 @@ var NfvServiceF5BigIPAWSServiceAdminPasswordBlindfoldSecretInfoModelAttrTypes =
 
 // NfvServiceF5BigIPAWSServiceAdminPasswordClearSecretInfoModel represents clear_secret_info block
 type NfvServiceF5BigIPAWSServiceAdminPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // NfvServiceF5BigIPAWSServiceAdminPasswordClearSecretInfoModelAttrTypes defines the attribute types for NfvServiceF5BigIPAWSServiceAdminPasswordClearSecretInfoModel
 var NfvServiceF5BigIPAWSServiceAdminPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // NfvServiceF5BigIPAWSServiceAWSTGWSiteParamsModel represents aws_tgw_site_params block
@@ -391,14 +400,16 @@ var NfvServiceHTTPSManagementAdvertiseOnSLIVIPTLSCertificatesPrivateKeyBlindfold
 
 // NfvServiceHTTPSManagementAdvertiseOnSLIVIPTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type NfvServiceHTTPSManagementAdvertiseOnSLIVIPTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // NfvServiceHTTPSManagementAdvertiseOnSLIVIPTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for NfvServiceHTTPSManagementAdvertiseOnSLIVIPTLSCertificatesPrivateKeyClearSecretInfoModel
 var NfvServiceHTTPSManagementAdvertiseOnSLIVIPTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // NfvServiceHTTPSManagementAdvertiseOnSLIVIPTLSConfigModel represents tls_config block
@@ -565,14 +576,16 @@ var NfvServiceHTTPSManagementAdvertiseOnSloInternetVIPTLSCertificatesPrivateKeyB
 
 // NfvServiceHTTPSManagementAdvertiseOnSloInternetVIPTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type NfvServiceHTTPSManagementAdvertiseOnSloInternetVIPTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // NfvServiceHTTPSManagementAdvertiseOnSloInternetVIPTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for NfvServiceHTTPSManagementAdvertiseOnSloInternetVIPTLSCertificatesPrivateKeyClearSecretInfoModel
 var NfvServiceHTTPSManagementAdvertiseOnSloInternetVIPTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // NfvServiceHTTPSManagementAdvertiseOnSloInternetVIPTLSConfigModel represents tls_config block
@@ -739,14 +752,16 @@ var NfvServiceHTTPSManagementAdvertiseOnSloSLITLSCertificatesPrivateKeyBlindfold
 
 // NfvServiceHTTPSManagementAdvertiseOnSloSLITLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type NfvServiceHTTPSManagementAdvertiseOnSloSLITLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // NfvServiceHTTPSManagementAdvertiseOnSloSLITLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for NfvServiceHTTPSManagementAdvertiseOnSloSLITLSCertificatesPrivateKeyClearSecretInfoModel
 var NfvServiceHTTPSManagementAdvertiseOnSloSLITLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // NfvServiceHTTPSManagementAdvertiseOnSloSLITLSConfigModel represents tls_config block
@@ -913,14 +928,16 @@ var NfvServiceHTTPSManagementAdvertiseOnSloVIPTLSCertificatesPrivateKeyBlindfold
 
 // NfvServiceHTTPSManagementAdvertiseOnSloVIPTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type NfvServiceHTTPSManagementAdvertiseOnSloVIPTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // NfvServiceHTTPSManagementAdvertiseOnSloVIPTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for NfvServiceHTTPSManagementAdvertiseOnSloVIPTLSCertificatesPrivateKeyClearSecretInfoModel
 var NfvServiceHTTPSManagementAdvertiseOnSloVIPTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // NfvServiceHTTPSManagementAdvertiseOnSloVIPTLSConfigModel represents tls_config block
@@ -1085,14 +1102,16 @@ var NfvServicePaloAltoFwServiceAutoSetupAdminPasswordBlindfoldSecretInfoModelAtt
 
 // NfvServicePaloAltoFwServiceAutoSetupAdminPasswordClearSecretInfoModel represents clear_secret_info block
 type NfvServicePaloAltoFwServiceAutoSetupAdminPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // NfvServicePaloAltoFwServiceAutoSetupAdminPasswordClearSecretInfoModelAttrTypes defines the attribute types for NfvServicePaloAltoFwServiceAutoSetupAdminPasswordClearSecretInfoModel
 var NfvServicePaloAltoFwServiceAutoSetupAdminPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // NfvServicePaloAltoFwServiceAutoSetupManualSSHKeysModel represents manual_ssh_keys block
@@ -1135,14 +1154,16 @@ var NfvServicePaloAltoFwServiceAutoSetupManualSSHKeysPrivateKeyBlindfoldSecretIn
 
 // NfvServicePaloAltoFwServiceAutoSetupManualSSHKeysPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type NfvServicePaloAltoFwServiceAutoSetupManualSSHKeysPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // NfvServicePaloAltoFwServiceAutoSetupManualSSHKeysPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for NfvServicePaloAltoFwServiceAutoSetupManualSSHKeysPrivateKeyClearSecretInfoModel
 var NfvServicePaloAltoFwServiceAutoSetupManualSSHKeysPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // NfvServicePaloAltoFwServiceAWSTGWSiteModel represents aws_tgw_site block
@@ -1203,14 +1224,16 @@ var NfvServicePaloAltoFwServicePanoramaServerAuthorizationKeyBlindfoldSecretInfo
 
 // NfvServicePaloAltoFwServicePanoramaServerAuthorizationKeyClearSecretInfoModel represents clear_secret_info block
 type NfvServicePaloAltoFwServicePanoramaServerAuthorizationKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // NfvServicePaloAltoFwServicePanoramaServerAuthorizationKeyClearSecretInfoModelAttrTypes defines the attribute types for NfvServicePaloAltoFwServicePanoramaServerAuthorizationKeyClearSecretInfoModel
 var NfvServicePaloAltoFwServicePanoramaServerAuthorizationKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // NfvServicePaloAltoFwServiceServiceNodesModel represents service_nodes block
@@ -1270,6 +1293,8 @@ type NfvServiceResourceModel struct {
 	Labels                 types.Map                         `tfsdk:"labels"`
 	ID                     types.String                      `tfsdk:"id"`
 	Timeouts               timeouts.Value                    `tfsdk:"timeouts"`
+	SystemMetadata         types.Object                      `tfsdk:"system_metadata"`
+	LabelsAll              types.Map                         `tfsdk:"labels_all"`
 	DisableHTTPSManagement *NfvServiceEmptyModel             `tfsdk:"disable_https_management"`
 	DisableSSHAccess       *NfvServiceEmptyModel             `tfsdk:"disable_ssh_access"`
 	EnabledSSHAccess       *NfvServiceEnabledSSHAccessModel  `tfsdk:"enabled_ssh_access"`
@@ -1297,9 +1322,11 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Nfv Service will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Nfv Service will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -1331,6 +1358,8 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -1351,6 +1380,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 					"domain_suffix": schema.StringAttribute{
 						MarkdownDescription: "Domain suffix will be used along with node name to form the hostname for SSH node management .",
 						Optional:            true,
+						Validators: []validator.String{
+							validators.HostnameValidator(),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1365,15 +1397,27 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 					},
 					"node_ssh_ports": schema.ListNestedBlock{
 						MarkdownDescription: "Enter TCP port and node name per node .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(2),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"node_name": schema.StringAttribute{
 									MarkdownDescription: "Node name will be used to match a particular node with the desired TCP port .",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"ssh_port": schema.Int64Attribute{
 									MarkdownDescription: "SSH Port. Enter TCP port per node .",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1024),
+										int64validator.AtMost(65535),
+									},
 								},
 							},
 						},
@@ -1386,10 +1430,17 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 					"admin_username": schema.StringAttribute{
 						MarkdownDescription: "Admin Username for BIG-IP .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(256),
+						},
 					},
 					"ssh_key": schema.StringAttribute{
 						MarkdownDescription: "Public SSH key for accessing the Big IP nodes.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(8192),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1422,7 +1473,16 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -1439,6 +1499,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1447,6 +1511,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1455,6 +1522,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -1466,6 +1536,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 							"configured_vip": schema.StringAttribute{
 								MarkdownDescription: "Enter IP address for the default VIP.",
 								Optional:            true,
+								Validators: []validator.String{
+									validators.IPValidator(),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -1485,6 +1558,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 										MarkdownDescription: "List of port ranges. Each range is a single port or a pair of start and end ports e.g. 8080-8192 .",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 									},
 								},
 							},
@@ -1495,6 +1571,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 										MarkdownDescription: "List of port ranges. Each range is a single port or a pair of start and end ports e.g. 8080-8192 .",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(128),
+										},
 									},
 								},
 							},
@@ -1532,19 +1611,33 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 					},
 					"nodes": schema.ListNestedBlock{
 						MarkdownDescription: "Specify how and where the service nodes are spawned .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(2),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"aws_az_name": schema.StringAttribute{
 									MarkdownDescription: "The AWS Availability Zone must be consistent with the AWS Region chosen. Please select an AZ in the same Region as your TGW Site .",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.PatternValidator("^([a-z]{2})-([a-z0-9]{4,20})-([a-z0-9]{2})$"),
+									},
 								},
 								"node_name": schema.StringAttribute{
 									MarkdownDescription: "Node Name will be used to assign as hostname to the service .",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"tunnel_prefix": schema.StringAttribute{
 									MarkdownDescription: "Enter IP prefix for the tunnel, it has to be /30.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.IPv4PrefixValidator(),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -1557,6 +1650,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 										"existing_subnet_id": schema.StringAttribute{
 											MarkdownDescription: "Information about existing subnet ID.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(64),
+												validators.PatternValidator("^(subnet-)([a-z0-9]{8}|[a-z0-9]{17})$"),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -1566,6 +1663,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"ipv4": schema.StringAttribute{
 													MarkdownDescription: "IPv4 subnet prefix for this subnet .",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.IPv4PrefixValidator(),
+													},
 												},
 											},
 										},
@@ -1588,10 +1688,17 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 					"domain_suffix": schema.StringAttribute{
 						MarkdownDescription: "Domain suffix will be used along with node name to form URL to access node management .",
 						Optional:            true,
+						Validators: []validator.String{
+							validators.HostnameValidator(),
+						},
 					},
 					"https_port": schema.Int64Attribute{
 						MarkdownDescription: "Enter TCP port number.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(65535),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1605,6 +1712,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1613,6 +1724,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1621,6 +1735,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -1638,11 +1755,18 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 							},
 							"tls_certificates": schema.ListNestedBlock{
 								MarkdownDescription: "Users can add one or more certificates that share the same set of domains. For example, domain.com and *.domain.com - but use different signature algorithms .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -1657,6 +1781,11 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -1692,7 +1821,16 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -1716,6 +1854,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -1748,6 +1889,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1757,6 +1902,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1765,6 +1914,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1773,6 +1925,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -1785,6 +1940,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1793,6 +1952,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1801,6 +1963,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -1830,11 +1995,18 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 							},
 							"tls_certificates": schema.ListNestedBlock{
 								MarkdownDescription: "Users can add one or more certificates that share the same set of domains. For example, domain.com and *.domain.com - but use different signature algorithms .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -1849,6 +2021,11 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -1884,7 +2061,16 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -1908,6 +2094,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -1940,6 +2129,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1949,6 +2142,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1957,6 +2154,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1965,6 +2165,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -1977,6 +2180,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1985,6 +2192,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1993,6 +2203,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -2022,11 +2235,18 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 							},
 							"tls_certificates": schema.ListNestedBlock{
 								MarkdownDescription: "Users can add one or more certificates that share the same set of domains. For example, domain.com and *.domain.com - but use different signature algorithms .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -2041,6 +2261,11 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -2076,7 +2301,16 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -2100,6 +2334,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -2132,6 +2369,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -2141,6 +2382,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2149,6 +2394,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2157,6 +2405,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -2169,6 +2420,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2177,6 +2432,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2185,6 +2443,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -2214,11 +2475,18 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 							},
 							"tls_certificates": schema.ListNestedBlock{
 								MarkdownDescription: "Users can add one or more certificates that share the same set of domains. For example, domain.com and *.domain.com - but use different signature algorithms .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -2233,6 +2501,11 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -2268,7 +2541,16 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -2292,6 +2574,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -2324,6 +2609,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -2333,6 +2622,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2341,6 +2634,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2349,6 +2645,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -2361,6 +2660,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2369,6 +2672,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2377,6 +2683,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -2412,6 +2721,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 					"ssh_key": schema.StringAttribute{
 						MarkdownDescription: "Setup Authorized Public SSH key. User will be able to SSH to the vmseries nodes using its corresponding SSH private key.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(8192),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 					"version": schema.StringAttribute{
 						MarkdownDescription: "PAN VM-Series version. PAN-OS version.",
@@ -2425,6 +2738,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 							"admin_username": schema.StringAttribute{
 								MarkdownDescription: "Firewall Admin Username. Firewall Admin Username .",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(256),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -2457,7 +2773,16 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -2470,6 +2795,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 									"public_key": schema.StringAttribute{
 										MarkdownDescription: "Authorized Public SSH key which will be programmed on the node .",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(8192),
+											stringvalidator.UTF8LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -2502,7 +2831,16 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -2519,6 +2857,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2527,6 +2869,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2535,6 +2880,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -2553,14 +2901,23 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 							"device_group_name": schema.StringAttribute{
 								MarkdownDescription: "Device Group Name. Device Group Name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(128),
+								},
 							},
 							"server": schema.StringAttribute{
 								MarkdownDescription: "Panorama Server Address to which the firewall should connect to .",
 								Optional:            true,
+								Validators: []validator.String{
+									validators.IPValidator(),
+								},
 							},
 							"template_stack_name": schema.StringAttribute{
 								MarkdownDescription: "Template stack name. Template Stack Name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.UTF8LengthAtMost(128),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -2593,7 +2950,16 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -2608,15 +2974,26 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 						Blocks: map[string]schema.Block{
 							"nodes": schema.ListNestedBlock{
 								MarkdownDescription: "Palo Alto Networks AZ Nodes. .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(2),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"aws_az_name": schema.StringAttribute{
 											MarkdownDescription: "AWS availability zone, must be consistent with the selected AWS region. It is recommended that AZ is one of the AZ for sites .",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.PatternValidator("^([a-z]{2})-([a-z0-9]{4,20})-([a-z0-9]{2})$"),
+											},
 										},
 										"node_name": schema.StringAttribute{
 											MarkdownDescription: "Node Name will be used to assign as hostname to the service .",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.HostnameValidator(),
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -2626,6 +3003,10 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"existing_subnet_id": schema.StringAttribute{
 													MarkdownDescription: "Information about existing subnet ID.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(64),
+														validators.PatternValidator("^(subnet-)([a-z0-9]{8}|[a-z0-9]{17})$"),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -2635,6 +3016,9 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 														"ipv4": schema.StringAttribute{
 															MarkdownDescription: "IPv4 subnet prefix for this subnet .",
 															Optional:            true,
+															Validators: []validator.String{
+																validators.IPv4PrefixValidator(),
+															},
 														},
 													},
 												},
@@ -2657,6 +3041,11 @@ func (r *NfvServiceResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *NfvServiceResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *NfvServiceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -2681,6 +3070,64 @@ func (r *NfvServiceResource) ValidateConfig(ctx context.Context, req resource.Va
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *NfvServiceResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "http_management_choice", "disable_https_management", "https_management"),
+		validators.OneOfGroup(path.MatchRelative(), "service_provider_choice", "f5_big_ip_aws_service", "palo_alto_fw_service"),
+		validators.OneOfGroup(path.MatchRelative(), "ssh_management_choice", "disable_ssh_access", "enabled_ssh_access"),
+		validators.OneOfGroup(path.MatchRoot("enabled_ssh_access"), "advertise_choice", "advertise_on_sli", "advertise_on_slo", "advertise_on_slo_sli"),
+		validators.OneOfGroup(path.MatchRoot("f5_big_ip_aws_service").AtName("admin_password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("f5_big_ip_aws_service").AtName("endpoint_service"), "external_vip_choice", "advertise_on_slo_ip", "advertise_on_slo_ip_external", "disable_advertise_on_slo_ip"),
+		validators.OneOfGroup(path.MatchRoot("f5_big_ip_aws_service").AtName("endpoint_service"), "inside_vip_choice", "automatic_vip", "configured_vip"),
+		validators.OneOfGroup(path.MatchRoot("f5_big_ip_aws_service").AtName("endpoint_service"), "tcp_port_choice", "custom_tcp_ports", "default_tcp_ports", "http_port", "https_port", "no_tcp_ports"),
+		validators.OneOfGroup(path.MatchRoot("f5_big_ip_aws_service").AtName("endpoint_service"), "udp_port_choice", "custom_udp_ports", "no_udp_ports"),
+		validators.OneOfGroup(path.MatchRoot("f5_big_ip_aws_service").AtName("market_place_image"), "ami_choice", "awafpay_g200_mbps", "awafpay_g3_gbps"),
+		validators.OneOfGroup(path.MatchRoot("f5_big_ip_aws_service").AtName("nodes").AtAnyListIndex(), "mgmt_subnet_choice", "mgmt_subnet", "reserved_mgmt_subnet"),
+		validators.OneOfGroup(path.MatchRoot("f5_big_ip_aws_service").AtName("nodes").AtAnyListIndex(), "tunnel_prefix_choice", "automatic_prefix", "tunnel_prefix"),
+		validators.OneOfGroup(path.MatchRoot("f5_big_ip_aws_service").AtName("nodes").AtAnyListIndex().AtName("mgmt_subnet"), "choice", "existing_subnet_id", "subnet_param"),
+		validators.OneOfGroup(path.MatchRoot("https_management"), "advertise_choice", "advertise_on_internet", "advertise_on_internet_default_vip", "advertise_on_sli_vip", "advertise_on_slo_internet_vip", "advertise_on_slo_sli", "advertise_on_slo_vip"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https_management"), "port_choice", "default_https_port", "https_port"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_sli_vip").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_internet_vip").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_sli").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("https_management").AtName("advertise_on_slo_vip").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("palo_alto_fw_service"), "ami_choice", "pan_ami_bundle1", "pan_ami_bundle2"),
+		validators.OneOfGroup(path.MatchRoot("palo_alto_fw_service"), "panaroma_connection", "disable_panaroma", "panorama_server"),
+		validators.OneOfGroup(path.MatchRoot("palo_alto_fw_service"), "setup_options", "auto_setup", "ssh_key"),
+		validators.OneOfGroup(path.MatchRoot("palo_alto_fw_service").AtName("auto_setup").AtName("admin_password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("palo_alto_fw_service").AtName("auto_setup").AtName("manual_ssh_keys").AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("palo_alto_fw_service").AtName("panorama_server").AtName("authorization_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("palo_alto_fw_service").AtName("service_nodes").AtName("nodes").AtAnyListIndex(), "mgmt_subnet_choice", "mgmt_subnet", "reserved_mgmt_subnet"),
+		validators.OneOfGroup(path.MatchRoot("palo_alto_fw_service").AtName("service_nodes").AtName("nodes").AtAnyListIndex().AtName("mgmt_subnet"), "choice", "existing_subnet_id", "subnet_param"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *NfvServiceResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -2691,7 +3138,10 @@ func (r *NfvServiceResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "nfv_service", "", req.Config, &resp.Plan)...)
 		var plan NfvServiceResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -2709,7 +3159,8 @@ func (r *NfvServiceResource) ModifyPlan(ctx context.Context, req resource.Modify
 
 func (r *NfvServiceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data NfvServiceResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2748,6 +3199,7 @@ func (r *NfvServiceResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -2757,6 +3209,7 @@ func (r *NfvServiceResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.DisableHTTPSManagement != nil {
@@ -2801,6 +3254,29 @@ func (r *NfvServiceResource) Create(ctx context.Context, req resource.CreateRequ
 		f5_big_ip_aws_serviceMap := make(map[string]interface{})
 		if data.F5BigIPAWSService.AdminPassword != nil {
 			admin_passwordNestedMap := make(map[string]interface{})
+			if data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.Location.IsNull() && !data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				admin_passwordNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.F5BigIPAWSService.AdminPassword.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.F5BigIPAWSService.AdminPassword.ClearSecretInfo.Provider.IsNull() && !data.F5BigIPAWSService.AdminPassword.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.F5BigIPAWSService.AdminPassword.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.F5BigIPAWSService.AdminPassword.ClearSecretInfo.URL.IsNull() && !data.F5BigIPAWSService.AdminPassword.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.F5BigIPAWSService.AdminPassword.ClearSecretInfo.URL.ValueString()
+				}
+				admin_passwordNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			f5_big_ip_aws_serviceMap["admin_password"] = admin_passwordNestedMap
 		}
 		if !data.F5BigIPAWSService.AdminUsername.IsNull() && !data.F5BigIPAWSService.AdminUsername.IsUnknown() {
@@ -2808,17 +3284,85 @@ func (r *NfvServiceResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		if data.F5BigIPAWSService.AWSTGWSiteParams != nil {
 			aws_tgw_site_paramsNestedMap := make(map[string]interface{})
+			if data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite != nil {
+				aws_tgw_siteLevel3Map := make(map[string]interface{})
+				if !data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Name.IsNull() && !data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Name.IsUnknown() {
+					aws_tgw_siteLevel3Map["name"] = data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Name.ValueString()
+				}
+				if !data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Namespace.IsNull() && !data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Namespace.IsUnknown() {
+					aws_tgw_siteLevel3Map["namespace"] = data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Namespace.ValueString()
+				}
+				if !data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Tenant.IsNull() && !data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Tenant.IsUnknown() {
+					aws_tgw_siteLevel3Map["tenant"] = data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Tenant.ValueString()
+				}
+				aws_tgw_site_paramsNestedMap["aws_tgw_site"] = aws_tgw_siteLevel3Map
+			}
 			f5_big_ip_aws_serviceMap["aws_tgw_site_params"] = aws_tgw_site_paramsNestedMap
 		}
 		if data.F5BigIPAWSService.EndpointService != nil {
 			endpoint_serviceNestedMap := make(map[string]interface{})
+			if data.F5BigIPAWSService.EndpointService.AdvertiseOnSloIP != nil {
+				endpoint_serviceNestedMap["advertise_on_slo_ip"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.AdvertiseOnSloIPExternal != nil {
+				endpoint_serviceNestedMap["advertise_on_slo_ip_external"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.AutomaticVIP != nil {
+				endpoint_serviceNestedMap["automatic_vip"] = map[string]interface{}{}
+			}
 			if !data.F5BigIPAWSService.EndpointService.ConfiguredVIP.IsNull() && !data.F5BigIPAWSService.EndpointService.ConfiguredVIP.IsUnknown() {
 				endpoint_serviceNestedMap["configured_vip"] = data.F5BigIPAWSService.EndpointService.ConfiguredVIP.ValueString()
 			}
+			if data.F5BigIPAWSService.EndpointService.CustomTCPPorts != nil {
+				custom_tcp_portsLevel3Map := make(map[string]interface{})
+				if !data.F5BigIPAWSService.EndpointService.CustomTCPPorts.Ports.IsNull() && !data.F5BigIPAWSService.EndpointService.CustomTCPPorts.Ports.IsUnknown() {
+					var portsLevel3Items []string
+					diags := data.F5BigIPAWSService.EndpointService.CustomTCPPorts.Ports.ElementsAs(ctx, &portsLevel3Items, false)
+					if !diags.HasError() {
+						custom_tcp_portsLevel3Map["ports"] = portsLevel3Items
+					}
+				}
+				endpoint_serviceNestedMap["custom_tcp_ports"] = custom_tcp_portsLevel3Map
+			}
+			if data.F5BigIPAWSService.EndpointService.CustomUDPPorts != nil {
+				custom_udp_portsLevel3Map := make(map[string]interface{})
+				if !data.F5BigIPAWSService.EndpointService.CustomUDPPorts.Ports.IsNull() && !data.F5BigIPAWSService.EndpointService.CustomUDPPorts.Ports.IsUnknown() {
+					var portsLevel3Items []string
+					diags := data.F5BigIPAWSService.EndpointService.CustomUDPPorts.Ports.ElementsAs(ctx, &portsLevel3Items, false)
+					if !diags.HasError() {
+						custom_udp_portsLevel3Map["ports"] = portsLevel3Items
+					}
+				}
+				endpoint_serviceNestedMap["custom_udp_ports"] = custom_udp_portsLevel3Map
+			}
+			if data.F5BigIPAWSService.EndpointService.DefaultTCPPorts != nil {
+				endpoint_serviceNestedMap["default_tcp_ports"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.DisableAdvertiseOnSloIP != nil {
+				endpoint_serviceNestedMap["disable_advertise_on_slo_ip"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.HTTPPort != nil {
+				endpoint_serviceNestedMap["http_port"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.HTTPSPort != nil {
+				endpoint_serviceNestedMap["https_port"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.NoTCPPorts != nil {
+				endpoint_serviceNestedMap["no_tcp_ports"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.NoUDPPorts != nil {
+				endpoint_serviceNestedMap["no_udp_ports"] = map[string]interface{}{}
+			}
 			f5_big_ip_aws_serviceMap["endpoint_service"] = endpoint_serviceNestedMap
 		}
 		if data.F5BigIPAWSService.MarketPlaceImage != nil {
 			market_place_imageNestedMap := make(map[string]interface{})
+			if data.F5BigIPAWSService.MarketPlaceImage.Awafpayg200mbps != nil {
+				market_place_imageNestedMap["AWAFPayG200Mbps"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.MarketPlaceImage.Awafpayg3gbps != nil {
+				market_place_imageNestedMap["AWAFPayG3Gbps"] = map[string]interface{}{}
+			}
 			f5_big_ip_aws_serviceMap["market_place_image"] = market_place_imageNestedMap
 		}
 		if len(data.F5BigIPAWSService.Nodes) > 0 {
@@ -2836,6 +3380,13 @@ func (r *NfvServiceResource) Create(ctx context.Context, req resource.CreateRequ
 					if !listItem.MgmtSubnet.ExistingSubnetID.IsNull() && !listItem.MgmtSubnet.ExistingSubnetID.IsUnknown() {
 						mgmt_subnetDeepMap["existing_subnet_id"] = listItem.MgmtSubnet.ExistingSubnetID.ValueString()
 					}
+					if listItem.MgmtSubnet.SubnetParam != nil {
+						subnet_paramLevel4Map := make(map[string]interface{})
+						if !listItem.MgmtSubnet.SubnetParam.Ipv4.IsNull() && !listItem.MgmtSubnet.SubnetParam.Ipv4.IsUnknown() {
+							subnet_paramLevel4Map["ipv4"] = listItem.MgmtSubnet.SubnetParam.Ipv4.ValueString()
+						}
+						mgmt_subnetDeepMap["subnet_param"] = subnet_paramLevel4Map
+					}
 					listItemMap["mgmt_subnet"] = mgmt_subnetDeepMap
 				}
 				if !listItem.NodeName.IsNull() && !listItem.NodeName.IsUnknown() {
@@ -2863,6 +3414,19 @@ func (r *NfvServiceResource) Create(ctx context.Context, req resource.CreateRequ
 		https_managementMap := make(map[string]interface{})
 		if data.HTTPSManagement.AdvertiseOnInternet != nil {
 			advertise_on_internetNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnInternet.PublicIP != nil {
+				public_ipLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Name.IsUnknown() {
+					public_ipLevel3Map["name"] = data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Name.ValueString()
+				}
+				if !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Namespace.IsUnknown() {
+					public_ipLevel3Map["namespace"] = data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Namespace.ValueString()
+				}
+				if !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Tenant.IsUnknown() {
+					public_ipLevel3Map["tenant"] = data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Tenant.ValueString()
+				}
+				advertise_on_internetNestedMap["public_ip"] = public_ipLevel3Map
+			}
 			https_managementMap["advertise_on_internet"] = advertise_on_internetNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnInternetDefaultVIP != nil {
@@ -2870,18 +3434,362 @@ func (r *NfvServiceResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		if data.HTTPSManagement.AdvertiseOnSLIVIP != nil {
 			advertise_on_sli_vipNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSLIVIP.NoMtls != nil {
+				advertise_on_sli_vipNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_sli_vipNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_sli_vipNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_sli_vip"] = advertise_on_sli_vipNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnSloInternetVIP != nil {
 			advertise_on_slo_internet_vipNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSloInternetVIP.NoMtls != nil {
+				advertise_on_slo_internet_vipNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_slo_internet_vipNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_slo_internet_vipNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_slo_internet_vip"] = advertise_on_slo_internet_vipNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnSloSLI != nil {
 			advertise_on_slo_sliNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSloSLI.NoMtls != nil {
+				advertise_on_slo_sliNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_slo_sliNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_slo_sliNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_slo_sli"] = advertise_on_slo_sliNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnSloVIP != nil {
 			advertise_on_slo_vipNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSloVIP.NoMtls != nil {
+				advertise_on_slo_vipNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_slo_vipNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_slo_vipNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_slo_vip"] = advertise_on_slo_vipNestedMap
 		}
 		if data.HTTPSManagement.DefaultHTTPSPort != nil {
@@ -2899,9 +3807,70 @@ func (r *NfvServiceResource) Create(ctx context.Context, req resource.CreateRequ
 		palo_alto_fw_serviceMap := make(map[string]interface{})
 		if data.PaloAltoFwService.AutoSetup != nil {
 			auto_setupNestedMap := make(map[string]interface{})
+			if data.PaloAltoFwService.AutoSetup.AdminPassword != nil {
+				admin_passwordLevel3Map := make(map[string]interface{})
+				if data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.Location.IsNull() && !data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					admin_passwordLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo.Provider.IsNull() && !data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo.URL.IsNull() && !data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo.URL.ValueString()
+					}
+					admin_passwordLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				auto_setupNestedMap["admin_password"] = admin_passwordLevel3Map
+			}
 			if !data.PaloAltoFwService.AutoSetup.AdminUsername.IsNull() && !data.PaloAltoFwService.AutoSetup.AdminUsername.IsUnknown() {
 				auto_setupNestedMap["admin_username"] = data.PaloAltoFwService.AutoSetup.AdminUsername.ValueString()
 			}
+			if data.PaloAltoFwService.AutoSetup.ManualSSHKeys != nil {
+				manual_ssh_keysLevel3Map := make(map[string]interface{})
+				if data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey != nil {
+					private_keyLevel4Map := make(map[string]interface{})
+					if data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.Location.IsNull() && !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						private_keyLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo.Provider.IsNull() && !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo.URL.IsNull() && !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo.URL.ValueString()
+						}
+						private_keyLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					manual_ssh_keysLevel3Map["private_key"] = private_keyLevel4Map
+				}
+				if !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PublicKey.IsNull() && !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PublicKey.IsUnknown() {
+					manual_ssh_keysLevel3Map["public_key"] = data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PublicKey.ValueString()
+				}
+				auto_setupNestedMap["manual_ssh_keys"] = manual_ssh_keysLevel3Map
+			}
 			palo_alto_fw_serviceMap["auto_setup"] = auto_setupNestedMap
 		}
 		if data.PaloAltoFwService.AWSTGWSite != nil {
@@ -2931,6 +3900,33 @@ func (r *NfvServiceResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		if data.PaloAltoFwService.PanoramaServer != nil {
 			panorama_serverNestedMap := make(map[string]interface{})
+			if data.PaloAltoFwService.PanoramaServer.AuthorizationKey != nil {
+				authorization_keyLevel3Map := make(map[string]interface{})
+				if data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.Location.IsNull() && !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					authorization_keyLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo.Provider.IsNull() && !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo.URL.IsNull() && !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo.URL.ValueString()
+					}
+					authorization_keyLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				panorama_serverNestedMap["authorization_key"] = authorization_keyLevel3Map
+			}
 			if !data.PaloAltoFwService.PanoramaServer.DeviceGroupName.IsNull() && !data.PaloAltoFwService.PanoramaServer.DeviceGroupName.IsUnknown() {
 				panorama_serverNestedMap["device_group_name"] = data.PaloAltoFwService.PanoramaServer.DeviceGroupName.ValueString()
 			}
@@ -2960,11 +3956,15 @@ func (r *NfvServiceResource) Create(ctx context.Context, req resource.CreateRequ
 
 	apiResource, err := r.client.CreateNfvService(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create NfvService: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nfv_service", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -3474,7 +4474,7 @@ func (r *NfvServiceResource) Read(ctx context.Context, req resource.ReadRequest,
 	apiResource, err := r.client.GetNfvService(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NfvService not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -3482,11 +4482,12 @@ func (r *NfvServiceResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NfvService: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nfv_service", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -3497,31 +4498,13 @@ func (r *NfvServiceResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -3529,6 +4512,7 @@ func (r *NfvServiceResource) Read(ctx context.Context, req resource.ReadRequest,
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["disable_https_management"].(map[string]interface{}); ok && isImport && data.DisableHTTPSManagement == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -4016,7 +5000,8 @@ func (r *NfvServiceResource) Read(ctx context.Context, req resource.ReadRequest,
 
 func (r *NfvServiceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data NfvServiceResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -4050,6 +5035,7 @@ func (r *NfvServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -4059,6 +5045,7 @@ func (r *NfvServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.DisableHTTPSManagement != nil {
@@ -4103,6 +5090,29 @@ func (r *NfvServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 		f5_big_ip_aws_serviceMap := make(map[string]interface{})
 		if data.F5BigIPAWSService.AdminPassword != nil {
 			admin_passwordNestedMap := make(map[string]interface{})
+			if data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo != nil {
+				blindfold_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["decryption_provider"] = data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+				}
+				if !data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.Location.IsNull() && !data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.Location.IsUnknown() {
+					blindfold_secret_infoLevel3Map["location"] = data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.Location.ValueString()
+				}
+				if !data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+					blindfold_secret_infoLevel3Map["store_provider"] = data.F5BigIPAWSService.AdminPassword.BlindfoldSecretInfo.StoreProvider.ValueString()
+				}
+				admin_passwordNestedMap["blindfold_secret_info"] = blindfold_secret_infoLevel3Map
+			}
+			if data.F5BigIPAWSService.AdminPassword.ClearSecretInfo != nil {
+				clear_secret_infoLevel3Map := make(map[string]interface{})
+				if !data.F5BigIPAWSService.AdminPassword.ClearSecretInfo.Provider.IsNull() && !data.F5BigIPAWSService.AdminPassword.ClearSecretInfo.Provider.IsUnknown() {
+					clear_secret_infoLevel3Map["provider"] = data.F5BigIPAWSService.AdminPassword.ClearSecretInfo.Provider.ValueString()
+				}
+				if !data.F5BigIPAWSService.AdminPassword.ClearSecretInfo.URL.IsNull() && !data.F5BigIPAWSService.AdminPassword.ClearSecretInfo.URL.IsUnknown() {
+					clear_secret_infoLevel3Map["url"] = data.F5BigIPAWSService.AdminPassword.ClearSecretInfo.URL.ValueString()
+				}
+				admin_passwordNestedMap["clear_secret_info"] = clear_secret_infoLevel3Map
+			}
 			f5_big_ip_aws_serviceMap["admin_password"] = admin_passwordNestedMap
 		}
 		if !data.F5BigIPAWSService.AdminUsername.IsNull() && !data.F5BigIPAWSService.AdminUsername.IsUnknown() {
@@ -4110,17 +5120,85 @@ func (r *NfvServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		if data.F5BigIPAWSService.AWSTGWSiteParams != nil {
 			aws_tgw_site_paramsNestedMap := make(map[string]interface{})
+			if data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite != nil {
+				aws_tgw_siteLevel3Map := make(map[string]interface{})
+				if !data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Name.IsNull() && !data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Name.IsUnknown() {
+					aws_tgw_siteLevel3Map["name"] = data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Name.ValueString()
+				}
+				if !data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Namespace.IsNull() && !data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Namespace.IsUnknown() {
+					aws_tgw_siteLevel3Map["namespace"] = data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Namespace.ValueString()
+				}
+				if !data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Tenant.IsNull() && !data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Tenant.IsUnknown() {
+					aws_tgw_siteLevel3Map["tenant"] = data.F5BigIPAWSService.AWSTGWSiteParams.AWSTGWSite.Tenant.ValueString()
+				}
+				aws_tgw_site_paramsNestedMap["aws_tgw_site"] = aws_tgw_siteLevel3Map
+			}
 			f5_big_ip_aws_serviceMap["aws_tgw_site_params"] = aws_tgw_site_paramsNestedMap
 		}
 		if data.F5BigIPAWSService.EndpointService != nil {
 			endpoint_serviceNestedMap := make(map[string]interface{})
+			if data.F5BigIPAWSService.EndpointService.AdvertiseOnSloIP != nil {
+				endpoint_serviceNestedMap["advertise_on_slo_ip"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.AdvertiseOnSloIPExternal != nil {
+				endpoint_serviceNestedMap["advertise_on_slo_ip_external"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.AutomaticVIP != nil {
+				endpoint_serviceNestedMap["automatic_vip"] = map[string]interface{}{}
+			}
 			if !data.F5BigIPAWSService.EndpointService.ConfiguredVIP.IsNull() && !data.F5BigIPAWSService.EndpointService.ConfiguredVIP.IsUnknown() {
 				endpoint_serviceNestedMap["configured_vip"] = data.F5BigIPAWSService.EndpointService.ConfiguredVIP.ValueString()
 			}
+			if data.F5BigIPAWSService.EndpointService.CustomTCPPorts != nil {
+				custom_tcp_portsLevel3Map := make(map[string]interface{})
+				if !data.F5BigIPAWSService.EndpointService.CustomTCPPorts.Ports.IsNull() && !data.F5BigIPAWSService.EndpointService.CustomTCPPorts.Ports.IsUnknown() {
+					var portsLevel3Items []string
+					diags := data.F5BigIPAWSService.EndpointService.CustomTCPPorts.Ports.ElementsAs(ctx, &portsLevel3Items, false)
+					if !diags.HasError() {
+						custom_tcp_portsLevel3Map["ports"] = portsLevel3Items
+					}
+				}
+				endpoint_serviceNestedMap["custom_tcp_ports"] = custom_tcp_portsLevel3Map
+			}
+			if data.F5BigIPAWSService.EndpointService.CustomUDPPorts != nil {
+				custom_udp_portsLevel3Map := make(map[string]interface{})
+				if !data.F5BigIPAWSService.EndpointService.CustomUDPPorts.Ports.IsNull() && !data.F5BigIPAWSService.EndpointService.CustomUDPPorts.Ports.IsUnknown() {
+					var portsLevel3Items []string
+					diags := data.F5BigIPAWSService.EndpointService.CustomUDPPorts.Ports.ElementsAs(ctx, &portsLevel3Items, false)
+					if !diags.HasError() {
+						custom_udp_portsLevel3Map["ports"] = portsLevel3Items
+					}
+				}
+				endpoint_serviceNestedMap["custom_udp_ports"] = custom_udp_portsLevel3Map
+			}
+			if data.F5BigIPAWSService.EndpointService.DefaultTCPPorts != nil {
+				endpoint_serviceNestedMap["default_tcp_ports"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.DisableAdvertiseOnSloIP != nil {
+				endpoint_serviceNestedMap["disable_advertise_on_slo_ip"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.HTTPPort != nil {
+				endpoint_serviceNestedMap["http_port"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.HTTPSPort != nil {
+				endpoint_serviceNestedMap["https_port"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.NoTCPPorts != nil {
+				endpoint_serviceNestedMap["no_tcp_ports"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.EndpointService.NoUDPPorts != nil {
+				endpoint_serviceNestedMap["no_udp_ports"] = map[string]interface{}{}
+			}
 			f5_big_ip_aws_serviceMap["endpoint_service"] = endpoint_serviceNestedMap
 		}
 		if data.F5BigIPAWSService.MarketPlaceImage != nil {
 			market_place_imageNestedMap := make(map[string]interface{})
+			if data.F5BigIPAWSService.MarketPlaceImage.Awafpayg200mbps != nil {
+				market_place_imageNestedMap["AWAFPayG200Mbps"] = map[string]interface{}{}
+			}
+			if data.F5BigIPAWSService.MarketPlaceImage.Awafpayg3gbps != nil {
+				market_place_imageNestedMap["AWAFPayG3Gbps"] = map[string]interface{}{}
+			}
 			f5_big_ip_aws_serviceMap["market_place_image"] = market_place_imageNestedMap
 		}
 		if len(data.F5BigIPAWSService.Nodes) > 0 {
@@ -4138,6 +5216,13 @@ func (r *NfvServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 					if !listItem.MgmtSubnet.ExistingSubnetID.IsNull() && !listItem.MgmtSubnet.ExistingSubnetID.IsUnknown() {
 						mgmt_subnetDeepMap["existing_subnet_id"] = listItem.MgmtSubnet.ExistingSubnetID.ValueString()
 					}
+					if listItem.MgmtSubnet.SubnetParam != nil {
+						subnet_paramLevel4Map := make(map[string]interface{})
+						if !listItem.MgmtSubnet.SubnetParam.Ipv4.IsNull() && !listItem.MgmtSubnet.SubnetParam.Ipv4.IsUnknown() {
+							subnet_paramLevel4Map["ipv4"] = listItem.MgmtSubnet.SubnetParam.Ipv4.ValueString()
+						}
+						mgmt_subnetDeepMap["subnet_param"] = subnet_paramLevel4Map
+					}
 					listItemMap["mgmt_subnet"] = mgmt_subnetDeepMap
 				}
 				if !listItem.NodeName.IsNull() && !listItem.NodeName.IsUnknown() {
@@ -4165,6 +5250,19 @@ func (r *NfvServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 		https_managementMap := make(map[string]interface{})
 		if data.HTTPSManagement.AdvertiseOnInternet != nil {
 			advertise_on_internetNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnInternet.PublicIP != nil {
+				public_ipLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Name.IsUnknown() {
+					public_ipLevel3Map["name"] = data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Name.ValueString()
+				}
+				if !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Namespace.IsUnknown() {
+					public_ipLevel3Map["namespace"] = data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Namespace.ValueString()
+				}
+				if !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Tenant.IsUnknown() {
+					public_ipLevel3Map["tenant"] = data.HTTPSManagement.AdvertiseOnInternet.PublicIP.Tenant.ValueString()
+				}
+				advertise_on_internetNestedMap["public_ip"] = public_ipLevel3Map
+			}
 			https_managementMap["advertise_on_internet"] = advertise_on_internetNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnInternetDefaultVIP != nil {
@@ -4172,18 +5270,362 @@ func (r *NfvServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		if data.HTTPSManagement.AdvertiseOnSLIVIP != nil {
 			advertise_on_sli_vipNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSLIVIP.NoMtls != nil {
+				advertise_on_sli_vipNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_sli_vipNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSLIVIP.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_sli_vipNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_sli_vip"] = advertise_on_sli_vipNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnSloInternetVIP != nil {
 			advertise_on_slo_internet_vipNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSloInternetVIP.NoMtls != nil {
+				advertise_on_slo_internet_vipNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_slo_internet_vipNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloInternetVIP.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_slo_internet_vipNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_slo_internet_vip"] = advertise_on_slo_internet_vipNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnSloSLI != nil {
 			advertise_on_slo_sliNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSloSLI.NoMtls != nil {
+				advertise_on_slo_sliNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_slo_sliNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloSLI.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_slo_sliNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_slo_sli"] = advertise_on_slo_sliNestedMap
 		}
 		if data.HTTPSManagement.AdvertiseOnSloVIP != nil {
 			advertise_on_slo_vipNestedMap := make(map[string]interface{})
+			if data.HTTPSManagement.AdvertiseOnSloVIP.NoMtls != nil {
+				advertise_on_slo_vipNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				advertise_on_slo_vipNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.ClientCertificateOptional.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Name.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Namespace.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Tenant.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCAURL.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.HTTPSManagement.AdvertiseOnSloVIP.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				advertise_on_slo_vipNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			https_managementMap["advertise_on_slo_vip"] = advertise_on_slo_vipNestedMap
 		}
 		if data.HTTPSManagement.DefaultHTTPSPort != nil {
@@ -4201,9 +5643,70 @@ func (r *NfvServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 		palo_alto_fw_serviceMap := make(map[string]interface{})
 		if data.PaloAltoFwService.AutoSetup != nil {
 			auto_setupNestedMap := make(map[string]interface{})
+			if data.PaloAltoFwService.AutoSetup.AdminPassword != nil {
+				admin_passwordLevel3Map := make(map[string]interface{})
+				if data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.Location.IsNull() && !data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.PaloAltoFwService.AutoSetup.AdminPassword.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					admin_passwordLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo.Provider.IsNull() && !data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo.URL.IsNull() && !data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.PaloAltoFwService.AutoSetup.AdminPassword.ClearSecretInfo.URL.ValueString()
+					}
+					admin_passwordLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				auto_setupNestedMap["admin_password"] = admin_passwordLevel3Map
+			}
 			if !data.PaloAltoFwService.AutoSetup.AdminUsername.IsNull() && !data.PaloAltoFwService.AutoSetup.AdminUsername.IsUnknown() {
 				auto_setupNestedMap["admin_username"] = data.PaloAltoFwService.AutoSetup.AdminUsername.ValueString()
 			}
+			if data.PaloAltoFwService.AutoSetup.ManualSSHKeys != nil {
+				manual_ssh_keysLevel3Map := make(map[string]interface{})
+				if data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey != nil {
+					private_keyLevel4Map := make(map[string]interface{})
+					if data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.Location.IsNull() && !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						private_keyLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo.Provider.IsNull() && !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo.URL.IsNull() && !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PrivateKey.ClearSecretInfo.URL.ValueString()
+						}
+						private_keyLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					manual_ssh_keysLevel3Map["private_key"] = private_keyLevel4Map
+				}
+				if !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PublicKey.IsNull() && !data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PublicKey.IsUnknown() {
+					manual_ssh_keysLevel3Map["public_key"] = data.PaloAltoFwService.AutoSetup.ManualSSHKeys.PublicKey.ValueString()
+				}
+				auto_setupNestedMap["manual_ssh_keys"] = manual_ssh_keysLevel3Map
+			}
 			palo_alto_fw_serviceMap["auto_setup"] = auto_setupNestedMap
 		}
 		if data.PaloAltoFwService.AWSTGWSite != nil {
@@ -4233,6 +5736,33 @@ func (r *NfvServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		if data.PaloAltoFwService.PanoramaServer != nil {
 			panorama_serverNestedMap := make(map[string]interface{})
+			if data.PaloAltoFwService.PanoramaServer.AuthorizationKey != nil {
+				authorization_keyLevel3Map := make(map[string]interface{})
+				if data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.Location.IsNull() && !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.PaloAltoFwService.PanoramaServer.AuthorizationKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					authorization_keyLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo.Provider.IsNull() && !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo.URL.IsNull() && !data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.PaloAltoFwService.PanoramaServer.AuthorizationKey.ClearSecretInfo.URL.ValueString()
+					}
+					authorization_keyLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				panorama_serverNestedMap["authorization_key"] = authorization_keyLevel3Map
+			}
 			if !data.PaloAltoFwService.PanoramaServer.DeviceGroupName.IsNull() && !data.PaloAltoFwService.PanoramaServer.DeviceGroupName.IsUnknown() {
 				panorama_serverNestedMap["device_group_name"] = data.PaloAltoFwService.PanoramaServer.DeviceGroupName.ValueString()
 			}
@@ -4262,7 +5792,7 @@ func (r *NfvServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	_, err := r.client.UpdateNfvService(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update NfvService: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nfv_service", "update"))
 		return
 	}
 
@@ -4273,9 +5803,12 @@ func (r *NfvServiceResource) Update(ctx context.Context, req resource.UpdateRequ
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetNfvService(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NfvService after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "nfv_service", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -4785,7 +6318,7 @@ func (r *NfvServiceResource) Delete(ctx context.Context, req resource.DeleteRequ
 	err := r.client.DeleteNfvService(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NfvService already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -4794,37 +6327,33 @@ func (r *NfvServiceResource) Delete(ctx context.Context, req resource.DeleteRequ
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "NfvService delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete NfvService: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nfv_service", "delete"))
 		return
 	}
 }
 
 func (r *NfvServiceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *NfvServiceResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("nfv_service", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *NfvServiceResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListNfvServices(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *NfvServiceResource) requiredNamespace() string {
+	return ""
 }
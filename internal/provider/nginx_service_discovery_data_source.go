@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,19 @@ type NginxServiceDiscoveryDataSource struct {
 	client *client.Client
 }
 
+// NginxServiceDiscoveryDataSourceModel mirrors NginxServiceDiscoveryResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type NginxServiceDiscoveryDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata     types.Object                               `tfsdk:"system_metadata"`
+	Name               types.String                               `tfsdk:"name"`
+	Namespace          types.String                               `tfsdk:"namespace"`
+	Annotations        types.Map                                  `tfsdk:"annotations"`
+	Description        types.String                               `tfsdk:"description"`
+	Disable            types.Bool                                 `tfsdk:"disable"`
+	Labels             types.Map                                  `tfsdk:"labels"`
+	ID                 types.String                               `tfsdk:"id"`
+	DiscoveryTarget    *NginxServiceDiscoveryDiscoveryTargetModel `tfsdk:"discovery_target"`
+	ServerBlockFilters types.List                                 `tfsdk:"server_block_filters"`
 }
 
 func (d *NginxServiceDiscoveryDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +46,7 @@ func (d *NginxServiceDiscoveryDataSource) Metadata(ctx context.Context, req data
 }
 
 func (d *NginxServiceDiscoveryDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Nginx Service Discovery resource in F5 Distributed Cloud for api to create nginx service discovery object for a site or virtual site in system namespace. configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the NginxServiceDiscovery.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the NginxServiceDiscovery exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the NginxServiceDiscovery.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewNginxServiceDiscoveryResource())
 }
 
 func (d *NginxServiceDiscoveryDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +68,25 @@ func (d *NginxServiceDiscoveryDataSource) Read(ctx context.Context, req datasour
 		return
 	}
 
-	resource, err := d.client.GetNginxServiceDiscovery(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetNginxServiceDiscovery(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NginxServiceDiscovery: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nginx_service_discovery", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +100,8 @@ func (d *NginxServiceDiscoveryDataSource) Read(ctx context.Context, req datasour
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +110,49 @@ func (d *NginxServiceDiscoveryDataSource) Read(ctx context.Context, req datasour
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["discovery_target"].(map[string]interface{}); ok && isImport && data.DiscoveryTarget == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DiscoveryTarget = &NginxServiceDiscoveryDiscoveryTargetModel{}
+	}
+	// Normal Read: preserve existing state value
+	if listData, ok := apiResource.Spec["server_block_filters"].([]interface{}); ok && len(listData) > 0 {
+		var server_block_filtersList []NginxServiceDiscoveryServerBlockFiltersModel
+		var existingServerBlockFiltersItems []NginxServiceDiscoveryServerBlockFiltersModel
+		if !data.ServerBlockFilters.IsNull() && !data.ServerBlockFilters.IsUnknown() {
+			data.ServerBlockFilters.ElementsAs(ctx, &existingServerBlockFiltersItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				server_block_filtersList = append(server_block_filtersList, NginxServiceDiscoveryServerBlockFiltersModel{
+					NameRegex: func() types.String {
+						if v, ok := itemMap["name_regex"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					PortRanges: func() types.String {
+						if v, ok := itemMap["port_ranges"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: NginxServiceDiscoveryServerBlockFiltersModelAttrTypes}, server_block_filtersList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.ServerBlockFilters = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.ServerBlockFilters = types.ListNull(types.ObjectType{AttrTypes: NginxServiceDiscoveryServerBlockFiltersModelAttrTypes})
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
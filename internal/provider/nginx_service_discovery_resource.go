@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &NginxServiceDiscoveryResource{}
-	_ resource.ResourceWithConfigure      = &NginxServiceDiscoveryResource{}
-	_ resource.ResourceWithImportState    = &NginxServiceDiscoveryResource{}
-	_ resource.ResourceWithModifyPlan     = &NginxServiceDiscoveryResource{}
-	_ resource.ResourceWithValidateConfig = &NginxServiceDiscoveryResource{}
+	_ resource.Resource                     = &NginxServiceDiscoveryResource{}
+	_ resource.ResourceWithConfigure        = &NginxServiceDiscoveryResource{}
+	_ resource.ResourceWithImportState      = &NginxServiceDiscoveryResource{}
+	_ resource.ResourceWithIdentity         = &NginxServiceDiscoveryResource{}
+	_ resource.ResourceWithMoveState        = &NginxServiceDiscoveryResource{}
+	_ resource.ResourceWithModifyPlan       = &NginxServiceDiscoveryResource{}
+	_ resource.ResourceWithValidateConfig   = &NginxServiceDiscoveryResource{}
+	_ resource.ResourceWithConfigValidators = &NginxServiceDiscoveryResource{}
+	_ listableResource                      = &NginxServiceDiscoveryResource{}
 )
 
 func NewNginxServiceDiscoveryResource() resource.Resource {
@@ -134,6 +140,8 @@ type NginxServiceDiscoveryResourceModel struct {
 	Labels             types.Map                                  `tfsdk:"labels"`
 	ID                 types.String                               `tfsdk:"id"`
 	Timeouts           timeouts.Value                             `tfsdk:"timeouts"`
+	SystemMetadata     types.Object                               `tfsdk:"system_metadata"`
+	LabelsAll          types.Map                                  `tfsdk:"labels_all"`
 	DiscoveryTarget    *NginxServiceDiscoveryDiscoveryTargetModel `tfsdk:"discovery_target"`
 	ServerBlockFilters types.List                                 `tfsdk:"server_block_filters"`
 }
@@ -157,9 +165,11 @@ func (r *NginxServiceDiscoveryResource) Schema(ctx context.Context, req resource
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Nginx Service Discovery will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Nginx Service Discovery will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -191,6 +201,8 @@ func (r *NginxServiceDiscoveryResource) Schema(ctx context.Context, req resource
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -209,6 +221,9 @@ func (r *NginxServiceDiscoveryResource) Schema(ctx context.Context, req resource
 						Blocks: map[string]schema.Block{
 							"config_sync_group": schema.ListNestedBlock{
 								MarkdownDescription: "Select new ConfigSyncGroup .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -258,6 +273,9 @@ func (r *NginxServiceDiscoveryResource) Schema(ctx context.Context, req resource
 						Blocks: map[string]schema.Block{
 							"nginx_instance": schema.ListNestedBlock{
 								MarkdownDescription: "Select new NGINX Instance .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -305,15 +323,26 @@ func (r *NginxServiceDiscoveryResource) Schema(ctx context.Context, req resource
 			},
 			"server_block_filters": schema.ListNestedBlock{
 				MarkdownDescription: "Filters discovered server blocks based on server name, domain and ports. Atleast, one field should be populated for each filter. X-textBlockContent: If no filters are specified, all server blocks will be discovered by default.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(10),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"name_regex": schema.StringAttribute{
 							MarkdownDescription: "Regular expression to match the server name or domain that must be discovered.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+								validators.RegexValidator(),
+							},
 						},
 						"port_ranges": schema.StringAttribute{
 							MarkdownDescription: "String containing a comma separated list of individual service ports or port ranges. Each port range consists of a single port or two ports separated by '-'. For example, 8000-8191.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(512),
+							},
 						},
 					},
 				},
@@ -322,6 +351,11 @@ func (r *NginxServiceDiscoveryResource) Schema(ctx context.Context, req resource
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *NginxServiceDiscoveryResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *NginxServiceDiscoveryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -346,6 +380,14 @@ func (r *NginxServiceDiscoveryResource) ValidateConfig(ctx context.Context, req
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *NginxServiceDiscoveryResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("discovery_target"), "target", "config_sync_group", "nginx_instance"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *NginxServiceDiscoveryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -356,7 +398,10 @@ func (r *NginxServiceDiscoveryResource) ModifyPlan(ctx context.Context, req reso
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "nginx_service_discovery", "", req.Config, &resp.Plan)...)
 		var plan NginxServiceDiscoveryResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -413,6 +458,7 @@ func (r *NginxServiceDiscoveryResource) Create(ctx context.Context, req resource
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -422,6 +468,7 @@ func (r *NginxServiceDiscoveryResource) Create(ctx context.Context, req resource
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.DiscoveryTarget != nil {
@@ -458,11 +505,15 @@ func (r *NginxServiceDiscoveryResource) Create(ctx context.Context, req resource
 
 	apiResource, err := r.client.CreateNginxServiceDiscovery(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create NginxServiceDiscovery: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nginx_service_discovery", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -531,7 +582,7 @@ func (r *NginxServiceDiscoveryResource) Read(ctx context.Context, req resource.R
 	apiResource, err := r.client.GetNginxServiceDiscovery(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NginxServiceDiscovery not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -539,11 +590,12 @@ func (r *NginxServiceDiscoveryResource) Read(ctx context.Context, req resource.R
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NginxServiceDiscovery: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nginx_service_discovery", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -554,31 +606,13 @@ func (r *NginxServiceDiscoveryResource) Read(ctx context.Context, req resource.R
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -586,6 +620,7 @@ func (r *NginxServiceDiscoveryResource) Read(ctx context.Context, req resource.R
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["discovery_target"].(map[string]interface{}); ok && isImport && data.DiscoveryTarget == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -666,6 +701,7 @@ func (r *NginxServiceDiscoveryResource) Update(ctx context.Context, req resource
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -675,6 +711,7 @@ func (r *NginxServiceDiscoveryResource) Update(ctx context.Context, req resource
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.DiscoveryTarget != nil {
@@ -711,7 +748,7 @@ func (r *NginxServiceDiscoveryResource) Update(ctx context.Context, req resource
 
 	_, err := r.client.UpdateNginxServiceDiscovery(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update NginxServiceDiscovery: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nginx_service_discovery", "update"))
 		return
 	}
 
@@ -722,9 +759,12 @@ func (r *NginxServiceDiscoveryResource) Update(ctx context.Context, req resource
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetNginxServiceDiscovery(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read NginxServiceDiscovery after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "nginx_service_discovery", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -793,7 +833,7 @@ func (r *NginxServiceDiscoveryResource) Delete(ctx context.Context, req resource
 	err := r.client.DeleteNginxServiceDiscovery(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "NginxServiceDiscovery already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -802,37 +842,33 @@ func (r *NginxServiceDiscoveryResource) Delete(ctx context.Context, req resource
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "NginxServiceDiscovery delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete NginxServiceDiscovery: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "nginx_service_discovery", "delete"))
 		return
 	}
 }
 
 func (r *NginxServiceDiscoveryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *NginxServiceDiscoveryResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("nginx_service_discovery", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *NginxServiceDiscoveryResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListNginxServiceDiscoveries(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *NginxServiceDiscoveryResource) requiredNamespace() string {
+	return ""
 }
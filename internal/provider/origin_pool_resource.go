@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,17 +23,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &OriginPoolResource{}
-	_ resource.ResourceWithConfigure      = &OriginPoolResource{}
-	_ resource.ResourceWithImportState    = &OriginPoolResource{}
-	_ resource.ResourceWithModifyPlan     = &OriginPoolResource{}
-	_ resource.ResourceWithValidateConfig = &OriginPoolResource{}
+	_ resource.Resource                     = &OriginPoolResource{}
+	_ resource.ResourceWithConfigure        = &OriginPoolResource{}
+	_ resource.ResourceWithImportState      = &OriginPoolResource{}
+	_ resource.ResourceWithIdentity         = &OriginPoolResource{}
+	_ resource.ResourceWithMoveState        = &OriginPoolResource{}
+	_ resource.ResourceWithModifyPlan       = &OriginPoolResource{}
+	_ resource.ResourceWithValidateConfig   = &OriginPoolResource{}
+	_ resource.ResourceWithConfigValidators = &OriginPoolResource{}
+	_ listableResource                      = &OriginPoolResource{}
 )
 
 func NewOriginPoolResource() resource.Resource {
@@ -854,14 +861,16 @@ var OriginPoolUseTLSUseMtlsTLSCertificatesPrivateKeyBlindfoldSecretInfoModelAttr
 
 // OriginPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type OriginPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // OriginPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for OriginPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModel
 var OriginPoolUseTLSUseMtlsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // OriginPoolUseTLSUseMtlsObjModel represents use_mtls_obj block
@@ -917,6 +926,8 @@ type OriginPoolResourceModel struct {
 	LoadBalancerAlgorithm     types.String                              `tfsdk:"loadbalancer_algorithm"`
 	Port                      types.Int64                               `tfsdk:"port"`
 	Timeouts                  timeouts.Value                            `tfsdk:"timeouts"`
+	SystemMetadata            types.Object                              `tfsdk:"system_metadata"`
+	LabelsAll                 types.Map                                 `tfsdk:"labels_all"`
 	AdvancedOptions           *OriginPoolAdvancedOptionsModel           `tfsdk:"advanced_options"`
 	AutomaticPort             *OriginPoolEmptyModel                     `tfsdk:"automatic_port"`
 	Healthcheck               types.List                                `tfsdk:"healthcheck"`
@@ -947,9 +958,11 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Origin Pool will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Origin Pool will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -996,6 +1009,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(65535),
+				},
 			},
 			"loadbalancer_algorithm": schema.StringAttribute{
 				MarkdownDescription: "[Enum: ROUND_ROBIN|LEAST_REQUEST|RING_HASH|RANDOM|LB_OVERRIDE] Different load balancing algorithms supported When a connection to a endpoint in an upstream cluster is required, the load balancer uses loadbalancer_algorithm to determine which host is selected. - ROUND_ROBIN: ROUND_ROBIN Policy in which each healthy/available upstream endpoint is selected in.. Possible values are `ROUND_ROBIN`, `LEAST_REQUEST`, `RING_HASH`, `RANDOM`, `LB_OVERRIDE`. Defaults to `ROUND_ROBIN`. Server applies default when omitted.",
@@ -1012,7 +1028,13 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+					int64validator.AtMost(65535),
+				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -1027,14 +1049,23 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 					"connection_timeout": schema.Int64Attribute{
 						MarkdownDescription: "The timeout for new network connections to endpoints in the cluster. This is specified in milliseconds. The  seconds. Defaults to `2`.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(1800000),
+						},
 					},
 					"http_idle_timeout": schema.Int64Attribute{
 						MarkdownDescription: "The idle timeout for upstream connection pool connections. The idle timeout is defined as the period in which there are no active requests. When the idle timeout is reached the connection will be closed.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(600000),
+						},
 					},
 					"panic_threshold": schema.Int64Attribute{
 						MarkdownDescription: "Configure a threshold (percentage of unhealthy endpoints) below which all endpoints will be considered for load balancing ignoring its health status.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(100),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1047,14 +1078,23 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 							"connection_limit": schema.Int64Attribute{
 								MarkdownDescription: "The maximum number of connections that loadbalancer will establish to all hosts in an upstream cluster. In practice this is only applicable to TCP and HTTP/1.1 clusters since HTTP/2 uses a single connection to each host. Remove endpoint out of load balancing decision, if number of connections..",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(32768),
+								},
 							},
 							"max_requests": schema.Int64Attribute{
 								MarkdownDescription: "The maximum number of requests that can be outstanding to all hosts in a cluster at any given time. In practice this is applicable to HTTP/2 clusters since HTTP/1.1 clusters are governed by the maximum connections (connection_limit). Remove endpoint out of load balancing decision, if requests..",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(32768),
+								},
 							},
 							"pending_requests": schema.Int64Attribute{
 								MarkdownDescription: "The maximum number of requests that will be queued while waiting for a ready connection pool connection. Since HTTP/2 requests are sent over a single connection, this circuit breaker only comes into play as the initial connection is created, as requests will be multiplexed immediately..",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(32768),
+								},
 							},
 							"priority": schema.StringAttribute{
 								MarkdownDescription: "[Enum: DEFAULT|HIGH] Priority routing for each request. Different connection pools are used based on the priority selected for the request. Also, circuit-breaker configuration at destination cluster is chosen based on selected priority. Possible values are `DEFAULT`, `HIGH`. Defaults to `DEFAULT`.",
@@ -1063,6 +1103,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 							"retries": schema.Int64Attribute{
 								MarkdownDescription: "The maximum number of retries that can be outstanding to all hosts in a cluster at any given time. Remove endpoint out of load balancing decision, if retries for request exceed this count.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(32768),
+								},
 							},
 						},
 					},
@@ -1105,12 +1148,19 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 							},
 							"endpoint_subsets": schema.ListNestedBlock{
 								MarkdownDescription: "List of subset class. Subsets class is defined using list of keys. Every unique combination of values of these keys form a subset withing the class.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"keys": schema.ListAttribute{
 											MarkdownDescription: "List of keys that define a cluster subset class.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -1162,22 +1212,37 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 							"base_ejection_time": schema.Int64Attribute{
 								MarkdownDescription: "The base time that a host is ejected for. The real time is equal to the base time multiplied by the number of times the host has been ejected. This causes hosts to GET ejected for longer periods if they continue to fail.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(1800000),
+								},
 							},
 							"consecutive_5xx": schema.Int64Attribute{
 								MarkdownDescription: "If an upstream endpoint returns some number of consecutive 5xx, it will be ejected. Note that in this case a 5xx means an actual 5xx respond code, or an event that would cause the HTTP router to return one on the upstream’s behalf(reset, connection failure, etc.) consecutive_5xx indicates the..",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(1024),
+								},
 							},
 							"consecutive_gateway_failure": schema.Int64Attribute{
 								MarkdownDescription: "If an upstream endpoint returns some number of consecutive “gateway errors” (502, 503 or 504 status code), it will be ejected. Note that this includes events that would cause the HTTP router to return one of these status codes on the upstream’s behalf (reset, connection failure, etc.)..",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(1024),
+								},
 							},
 							"interval": schema.Int64Attribute{
 								MarkdownDescription: "The time interval between ejection analysis sweeps. This can result in both new ejections as well as endpoints being returned to service. Defaults to `10000ms`.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(600000),
+								},
 							},
 							"max_ejection_percent": schema.Int64Attribute{
 								MarkdownDescription: "The maximum % of an upstream cluster that can be ejected due to outlier detection.  but will eject at least one host regardless of the value. Defaults to `10%`.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(100),
+								},
 							},
 						},
 					},
@@ -1194,11 +1259,19 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 			"healthcheck": schema.ListNestedBlock{
 				MarkdownDescription: "Reference to healthcheck configuration objects. Defaults to `[]`. Server applies default when omitted.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(4),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
 							MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(128),
+								stringvalidator.LengthAtLeast(1),
+							},
 						},
 						"namespace": schema.StringAttribute{
 							MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1207,6 +1280,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(64),
+							},
 						},
 						"tenant": schema.StringAttribute{
 							MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1215,6 +1291,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(64),
+							},
 						},
 					},
 				},
@@ -1227,6 +1306,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 			},
 			"origin_servers": schema.ListNestedBlock{
 				MarkdownDescription: "List of origin servers in this pool .",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{},
 					Blocks: map[string]schema.Block{
@@ -1264,6 +1347,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1272,6 +1359,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1280,6 +1370,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1289,6 +1382,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1297,6 +1394,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1305,6 +1405,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1324,6 +1427,11 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+													},
 												},
 											},
 										},
@@ -1341,6 +1449,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1349,6 +1461,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1357,6 +1472,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -1391,6 +1509,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1399,6 +1521,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1407,6 +1532,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1416,6 +1544,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1424,6 +1556,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1432,6 +1567,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1451,6 +1589,11 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+													},
 												},
 											},
 										},
@@ -1470,6 +1613,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 								"ip": schema.StringAttribute{
 									MarkdownDescription: "IP. Private IPv4 address.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.IPv4Validator(),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -1485,6 +1631,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1493,6 +1643,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1501,6 +1654,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -1514,6 +1670,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1522,6 +1682,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1530,6 +1693,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1539,6 +1705,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1547,6 +1717,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1555,6 +1728,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1574,6 +1750,11 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+													},
 												},
 											},
 										},
@@ -1606,6 +1787,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1614,6 +1799,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1622,6 +1810,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -1635,6 +1826,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1643,6 +1838,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1651,6 +1849,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1660,6 +1861,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1668,6 +1873,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1676,6 +1884,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1695,6 +1906,11 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+													},
 												},
 											},
 										},
@@ -1708,6 +1924,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 								"ip": schema.StringAttribute{
 									MarkdownDescription: "Public IPv4. Public IPv4 address.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.IPv4Validator(),
+									},
 								},
 							},
 						},
@@ -1717,6 +1936,11 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 								"dns_name": schema.StringAttribute{
 									MarkdownDescription: "DNS Name. DNS Name .",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"refresh_interval": schema.Int64Attribute{
 									MarkdownDescription: "Interval for DNS refresh in seconds. Max value is 7 days as per https://datatracker.ietf.org/doc/HTML/rfc8767.",
@@ -1730,6 +1954,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 								"ip": schema.StringAttribute{
 									MarkdownDescription: "IPv4. IPv4 address.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.IPv4Validator(),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -1739,6 +1966,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1747,6 +1978,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1755,6 +1989,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -1775,6 +2012,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1783,6 +2024,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1791,6 +2035,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -1820,10 +2067,18 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 					"max_session_keys": schema.Int64Attribute{
 						MarkdownDescription: "Number of session keys that are cached.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(2),
+							int64validator.AtMost(64),
+						},
 					},
 					"sni": schema.StringAttribute{
 						MarkdownDescription: "SNI value to be used.",
 						Optional:            true,
+						Validators: []validator.String{
+							validators.HostnameValidator(),
+							stringvalidator.UTF8LengthAtMost(256),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1853,6 +2108,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 										MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.UniqueValues(),
+										},
 									},
 									"max_version": schema.StringAttribute{
 										MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -1884,11 +2142,18 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 						Blocks: map[string]schema.Block{
 							"tls_certificates": schema.ListNestedBlock{
 								MarkdownDescription: "MTLS Client Certificate. MTLS Client Certificate .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -1903,6 +2168,11 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -1938,7 +2208,16 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -1959,6 +2238,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1967,6 +2250,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1975,6 +2261,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -1984,6 +2273,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 							"trusted_ca_url": schema.StringAttribute{
 								MarkdownDescription: "Upload a Root CA Certificate specifically for this Origin Pool for verification of server's certificate.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -1993,6 +2286,10 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2001,6 +2298,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2009,6 +2309,9 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -2023,6 +2326,11 @@ func (r *OriginPoolResource) Schema(ctx context.Context, req resource.SchemaRequ
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *OriginPoolResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *OriginPoolResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -2047,6 +2355,47 @@ func (r *OriginPoolResource) ValidateConfig(ctx context.Context, req resource.Va
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *OriginPoolResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "health_check_port_choice", "health_check_port", "same_as_endpoint_port"),
+		validators.RequiredOneOfGroup(path.MatchRelative(), "port_choice", "automatic_port", "lb_port", "port"),
+		validators.OneOfGroup(path.MatchRelative(), "tls_choice", "no_tls", "use_tls"),
+		validators.OneOfGroup(path.MatchRoot("advanced_options"), "circuit_breaker_choice", "circuit_breaker", "default_circuit_breaker", "disable_circuit_breaker"),
+		validators.OneOfGroup(path.MatchRoot("advanced_options"), "http_protocol_type", "auto_http_config", "http1_config", "http2_options"),
+		validators.OneOfGroup(path.MatchRoot("advanced_options"), "lb_source_ip_persistance_choice", "disable_lb_source_ip_persistance", "enable_lb_source_ip_persistance"),
+		validators.OneOfGroup(path.MatchRoot("advanced_options"), "outlier_detection_choice", "disable_outlier_detection", "outlier_detection"),
+		validators.OneOfGroup(path.MatchRoot("advanced_options"), "panic_threshold_type", "no_panic_threshold", "panic_threshold"),
+		validators.OneOfGroup(path.MatchRoot("advanced_options"), "proxy_protocol_choice", "disable_proxy_protocol", "proxy_protocol_v1", "proxy_protocol_v2"),
+		validators.OneOfGroup(path.MatchRoot("advanced_options"), "subset_choice", "disable_subsets", "enable_subsets"),
+		validators.OneOfGroup(path.MatchRoot("advanced_options").AtName("enable_subsets"), "fallback_policy_choice", "any_endpoint", "default_subset", "fail_request"),
+		validators.OneOfGroup(path.MatchRoot("advanced_options").AtName("http1_config").AtName("header_transformation"), "header_transformation_choice", "default_header_transformation", "legacy_header_transformation", "preserve_case_header_transformation", "proper_case_header_transformation"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex(), "choice", "cbip_service", "consul_service", "custom_endpoint_object", "k8s_service", "private_ip", "private_name", "public_ip", "public_name", "vn_private_ip", "vn_private_name"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex().AtName("consul_service"), "network_choice", "inside_network", "outside_network"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex().AtName("consul_service").AtName("site_locator"), "choice", "site", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex().AtName("consul_service").AtName("snat_pool"), "snat_pool_choice", "no_snat_pool", "snat_pool"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex().AtName("k8s_service"), "network_choice", "inside_network", "outside_network", "vk8s_networks"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex().AtName("k8s_service").AtName("site_locator"), "choice", "site", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex().AtName("k8s_service").AtName("snat_pool"), "snat_pool_choice", "no_snat_pool", "snat_pool"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex().AtName("private_ip"), "network_choice", "inside_network", "outside_network", "segment"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex().AtName("private_ip").AtName("site_locator"), "choice", "site", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex().AtName("private_ip").AtName("snat_pool"), "snat_pool_choice", "no_snat_pool", "snat_pool"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex().AtName("private_name"), "network_choice", "inside_network", "outside_network", "segment"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex().AtName("private_name").AtName("site_locator"), "choice", "site", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("origin_servers").AtAnyListIndex().AtName("private_name").AtName("snat_pool"), "snat_pool_choice", "no_snat_pool", "snat_pool"),
+		validators.OneOfGroup(path.MatchRoot("upstream_conn_pool_reuse_type"), "map_downstream_to_upstream_conn_pool_type", "disable_conn_pool_reuse", "enable_conn_pool_reuse"),
+		validators.RequiredOneOfGroup(path.MatchRoot("use_tls"), "max_session_keys_type", "default_session_key_caching", "disable_session_key_caching", "max_session_keys"),
+		validators.OneOfGroup(path.MatchRoot("use_tls"), "mtls_choice", "no_mtls", "use_mtls", "use_mtls_obj"),
+		validators.OneOfGroup(path.MatchRoot("use_tls"), "server_validation_choice", "skip_server_verification", "use_server_verification", "volterra_trusted_ca"),
+		validators.OneOfGroup(path.MatchRoot("use_tls"), "sni_choice", "disable_sni", "sni", "use_host_header_as_sni"),
+		validators.OneOfGroup(path.MatchRoot("use_tls").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("use_tls").AtName("use_mtls").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("use_tls").AtName("use_mtls").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.RequiredOneOfGroup(path.MatchRoot("use_tls").AtName("use_server_verification"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *OriginPoolResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -2057,7 +2406,10 @@ func (r *OriginPoolResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "origin_pool", "", req.Config, &resp.Plan)...)
 		var plan OriginPoolResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -2075,7 +2427,8 @@ func (r *OriginPoolResource) ModifyPlan(ctx context.Context, req resource.Modify
 
 func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data OriginPoolResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2114,6 +2467,7 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -2123,6 +2477,7 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AdvancedOptions != nil {
@@ -2175,10 +2530,39 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		if data.AdvancedOptions.EnableSubsets != nil {
 			enable_subsetsNestedMap := make(map[string]interface{})
+			if data.AdvancedOptions.EnableSubsets.AnyEndpoint != nil {
+				enable_subsetsNestedMap["any_endpoint"] = map[string]interface{}{}
+			}
+			if data.AdvancedOptions.EnableSubsets.DefaultSubset != nil {
+				default_subsetLevel3Map := make(map[string]interface{})
+				if data.AdvancedOptions.EnableSubsets.DefaultSubset.DefaultSubset != nil {
+					default_subsetLevel3Map["default_subset"] = map[string]interface{}{}
+				}
+				enable_subsetsNestedMap["default_subset"] = default_subsetLevel3Map
+			}
+			if data.AdvancedOptions.EnableSubsets.FailRequest != nil {
+				enable_subsetsNestedMap["fail_request"] = map[string]interface{}{}
+			}
 			advanced_optionsMap["enable_subsets"] = enable_subsetsNestedMap
 		}
 		if data.AdvancedOptions.Http1Config != nil {
 			http1_configNestedMap := make(map[string]interface{})
+			if data.AdvancedOptions.Http1Config.HeaderTransformation != nil {
+				header_transformationLevel3Map := make(map[string]interface{})
+				if data.AdvancedOptions.Http1Config.HeaderTransformation.DefaultHeaderTransformation != nil {
+					header_transformationLevel3Map["default_header_transformation"] = map[string]interface{}{}
+				}
+				if data.AdvancedOptions.Http1Config.HeaderTransformation.LegacyHeaderTransformation != nil {
+					header_transformationLevel3Map["legacy_header_transformation"] = map[string]interface{}{}
+				}
+				if data.AdvancedOptions.Http1Config.HeaderTransformation.PreserveCaseHeaderTransformation != nil {
+					header_transformationLevel3Map["preserve_case_header_transformation"] = map[string]interface{}{}
+				}
+				if data.AdvancedOptions.Http1Config.HeaderTransformation.ProperCaseHeaderTransformation != nil {
+					header_transformationLevel3Map["proper_case_header_transformation"] = map[string]interface{}{}
+				}
+				http1_configNestedMap["header_transformation"] = header_transformationLevel3Map
+			}
 			advanced_optionsMap["http1_config"] = http1_configNestedMap
 		}
 		if data.AdvancedOptions.Http2Options != nil {
@@ -2286,6 +2670,32 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 					}
 					if item.ConsulService.SiteLocator != nil {
 						site_locatorDeepMap := make(map[string]interface{})
+						if item.ConsulService.SiteLocator.Site != nil {
+							siteLevel4Map := make(map[string]interface{})
+							if !item.ConsulService.SiteLocator.Site.Name.IsNull() && !item.ConsulService.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel4Map["name"] = item.ConsulService.SiteLocator.Site.Name.ValueString()
+							}
+							if !item.ConsulService.SiteLocator.Site.Namespace.IsNull() && !item.ConsulService.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel4Map["namespace"] = item.ConsulService.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !item.ConsulService.SiteLocator.Site.Tenant.IsNull() && !item.ConsulService.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel4Map["tenant"] = item.ConsulService.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorDeepMap["site"] = siteLevel4Map
+						}
+						if item.ConsulService.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel4Map := make(map[string]interface{})
+							if !item.ConsulService.SiteLocator.VirtualSite.Name.IsNull() && !item.ConsulService.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel4Map["name"] = item.ConsulService.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !item.ConsulService.SiteLocator.VirtualSite.Namespace.IsNull() && !item.ConsulService.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel4Map["namespace"] = item.ConsulService.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !item.ConsulService.SiteLocator.VirtualSite.Tenant.IsNull() && !item.ConsulService.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel4Map["tenant"] = item.ConsulService.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorDeepMap["virtual_site"] = virtual_siteLevel4Map
+						}
 						consul_serviceNestedMap["site_locator"] = site_locatorDeepMap
 					}
 					if item.ConsulService.SnatPool != nil {
@@ -2293,6 +2703,17 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 						if item.ConsulService.SnatPool.NoSnatPool != nil {
 							snat_poolDeepMap["no_snat_pool"] = map[string]interface{}{}
 						}
+						if item.ConsulService.SnatPool.SnatPool != nil {
+							snat_poolLevel4Map := make(map[string]interface{})
+							if !item.ConsulService.SnatPool.SnatPool.Prefixes.IsNull() && !item.ConsulService.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel4Items []string
+								diags := item.ConsulService.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel4Items, false)
+								if !diags.HasError() {
+									snat_poolLevel4Map["prefixes"] = prefixesLevel4Items
+								}
+							}
+							snat_poolDeepMap["snat_pool"] = snat_poolLevel4Map
+						}
 						consul_serviceNestedMap["snat_pool"] = snat_poolDeepMap
 					}
 					itemMap["consul_service"] = consul_serviceNestedMap
@@ -2330,6 +2751,32 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 					}
 					if item.K8SService.SiteLocator != nil {
 						site_locatorDeepMap := make(map[string]interface{})
+						if item.K8SService.SiteLocator.Site != nil {
+							siteLevel4Map := make(map[string]interface{})
+							if !item.K8SService.SiteLocator.Site.Name.IsNull() && !item.K8SService.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel4Map["name"] = item.K8SService.SiteLocator.Site.Name.ValueString()
+							}
+							if !item.K8SService.SiteLocator.Site.Namespace.IsNull() && !item.K8SService.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel4Map["namespace"] = item.K8SService.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !item.K8SService.SiteLocator.Site.Tenant.IsNull() && !item.K8SService.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel4Map["tenant"] = item.K8SService.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorDeepMap["site"] = siteLevel4Map
+						}
+						if item.K8SService.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel4Map := make(map[string]interface{})
+							if !item.K8SService.SiteLocator.VirtualSite.Name.IsNull() && !item.K8SService.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel4Map["name"] = item.K8SService.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !item.K8SService.SiteLocator.VirtualSite.Namespace.IsNull() && !item.K8SService.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel4Map["namespace"] = item.K8SService.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !item.K8SService.SiteLocator.VirtualSite.Tenant.IsNull() && !item.K8SService.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel4Map["tenant"] = item.K8SService.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorDeepMap["virtual_site"] = virtual_siteLevel4Map
+						}
 						k8s_serviceNestedMap["site_locator"] = site_locatorDeepMap
 					}
 					if item.K8SService.SnatPool != nil {
@@ -2337,6 +2784,17 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 						if item.K8SService.SnatPool.NoSnatPool != nil {
 							snat_poolDeepMap["no_snat_pool"] = map[string]interface{}{}
 						}
+						if item.K8SService.SnatPool.SnatPool != nil {
+							snat_poolLevel4Map := make(map[string]interface{})
+							if !item.K8SService.SnatPool.SnatPool.Prefixes.IsNull() && !item.K8SService.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel4Items []string
+								diags := item.K8SService.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel4Items, false)
+								if !diags.HasError() {
+									snat_poolLevel4Map["prefixes"] = prefixesLevel4Items
+								}
+							}
+							snat_poolDeepMap["snat_pool"] = snat_poolLevel4Map
+						}
 						k8s_serviceNestedMap["snat_pool"] = snat_poolDeepMap
 					}
 					if item.K8SService.Vk8sNetworks != nil {
@@ -2373,6 +2831,32 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 					}
 					if item.PrivateIP.SiteLocator != nil {
 						site_locatorDeepMap := make(map[string]interface{})
+						if item.PrivateIP.SiteLocator.Site != nil {
+							siteLevel4Map := make(map[string]interface{})
+							if !item.PrivateIP.SiteLocator.Site.Name.IsNull() && !item.PrivateIP.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel4Map["name"] = item.PrivateIP.SiteLocator.Site.Name.ValueString()
+							}
+							if !item.PrivateIP.SiteLocator.Site.Namespace.IsNull() && !item.PrivateIP.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel4Map["namespace"] = item.PrivateIP.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !item.PrivateIP.SiteLocator.Site.Tenant.IsNull() && !item.PrivateIP.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel4Map["tenant"] = item.PrivateIP.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorDeepMap["site"] = siteLevel4Map
+						}
+						if item.PrivateIP.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel4Map := make(map[string]interface{})
+							if !item.PrivateIP.SiteLocator.VirtualSite.Name.IsNull() && !item.PrivateIP.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel4Map["name"] = item.PrivateIP.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !item.PrivateIP.SiteLocator.VirtualSite.Namespace.IsNull() && !item.PrivateIP.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel4Map["namespace"] = item.PrivateIP.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !item.PrivateIP.SiteLocator.VirtualSite.Tenant.IsNull() && !item.PrivateIP.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel4Map["tenant"] = item.PrivateIP.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorDeepMap["virtual_site"] = virtual_siteLevel4Map
+						}
 						private_ipNestedMap["site_locator"] = site_locatorDeepMap
 					}
 					if item.PrivateIP.SnatPool != nil {
@@ -2380,6 +2864,17 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 						if item.PrivateIP.SnatPool.NoSnatPool != nil {
 							snat_poolDeepMap["no_snat_pool"] = map[string]interface{}{}
 						}
+						if item.PrivateIP.SnatPool.SnatPool != nil {
+							snat_poolLevel4Map := make(map[string]interface{})
+							if !item.PrivateIP.SnatPool.SnatPool.Prefixes.IsNull() && !item.PrivateIP.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel4Items []string
+								diags := item.PrivateIP.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel4Items, false)
+								if !diags.HasError() {
+									snat_poolLevel4Map["prefixes"] = prefixesLevel4Items
+								}
+							}
+							snat_poolDeepMap["snat_pool"] = snat_poolLevel4Map
+						}
 						private_ipNestedMap["snat_pool"] = snat_poolDeepMap
 					}
 					itemMap["private_ip"] = private_ipNestedMap
@@ -2413,6 +2908,32 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 					}
 					if item.PrivateName.SiteLocator != nil {
 						site_locatorDeepMap := make(map[string]interface{})
+						if item.PrivateName.SiteLocator.Site != nil {
+							siteLevel4Map := make(map[string]interface{})
+							if !item.PrivateName.SiteLocator.Site.Name.IsNull() && !item.PrivateName.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel4Map["name"] = item.PrivateName.SiteLocator.Site.Name.ValueString()
+							}
+							if !item.PrivateName.SiteLocator.Site.Namespace.IsNull() && !item.PrivateName.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel4Map["namespace"] = item.PrivateName.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !item.PrivateName.SiteLocator.Site.Tenant.IsNull() && !item.PrivateName.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel4Map["tenant"] = item.PrivateName.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorDeepMap["site"] = siteLevel4Map
+						}
+						if item.PrivateName.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel4Map := make(map[string]interface{})
+							if !item.PrivateName.SiteLocator.VirtualSite.Name.IsNull() && !item.PrivateName.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel4Map["name"] = item.PrivateName.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !item.PrivateName.SiteLocator.VirtualSite.Namespace.IsNull() && !item.PrivateName.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel4Map["namespace"] = item.PrivateName.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !item.PrivateName.SiteLocator.VirtualSite.Tenant.IsNull() && !item.PrivateName.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel4Map["tenant"] = item.PrivateName.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorDeepMap["virtual_site"] = virtual_siteLevel4Map
+						}
 						private_nameNestedMap["site_locator"] = site_locatorDeepMap
 					}
 					if item.PrivateName.SnatPool != nil {
@@ -2420,6 +2941,17 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 						if item.PrivateName.SnatPool.NoSnatPool != nil {
 							snat_poolDeepMap["no_snat_pool"] = map[string]interface{}{}
 						}
+						if item.PrivateName.SnatPool.SnatPool != nil {
+							snat_poolLevel4Map := make(map[string]interface{})
+							if !item.PrivateName.SnatPool.SnatPool.Prefixes.IsNull() && !item.PrivateName.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel4Items []string
+								diags := item.PrivateName.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel4Items, false)
+								if !diags.HasError() {
+									snat_poolLevel4Map["prefixes"] = prefixesLevel4Items
+								}
+							}
+							snat_poolDeepMap["snat_pool"] = snat_poolLevel4Map
+						}
 						private_nameNestedMap["snat_pool"] = snat_poolDeepMap
 					}
 					itemMap["private_name"] = private_nameNestedMap
@@ -2525,6 +3057,32 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		if data.UseTLS.TLSConfig != nil {
 			tls_configNestedMap := make(map[string]interface{})
+			if data.UseTLS.TLSConfig.CustomSecurity != nil {
+				custom_securityLevel3Map := make(map[string]interface{})
+				if !data.UseTLS.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.UseTLS.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+					var cipher_suitesLevel3Items []string
+					diags := data.UseTLS.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel3Items, false)
+					if !diags.HasError() {
+						custom_securityLevel3Map["cipher_suites"] = cipher_suitesLevel3Items
+					}
+				}
+				if !data.UseTLS.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.UseTLS.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+					custom_securityLevel3Map["max_version"] = data.UseTLS.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+				}
+				if !data.UseTLS.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.UseTLS.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+					custom_securityLevel3Map["min_version"] = data.UseTLS.TLSConfig.CustomSecurity.MinVersion.ValueString()
+				}
+				tls_configNestedMap["custom_security"] = custom_securityLevel3Map
+			}
+			if data.UseTLS.TLSConfig.DefaultSecurity != nil {
+				tls_configNestedMap["default_security"] = map[string]interface{}{}
+			}
+			if data.UseTLS.TLSConfig.LowSecurity != nil {
+				tls_configNestedMap["low_security"] = map[string]interface{}{}
+			}
+			if data.UseTLS.TLSConfig.MediumSecurity != nil {
+				tls_configNestedMap["medium_security"] = map[string]interface{}{}
+			}
 			use_tlsMap["tls_config"] = tls_configNestedMap
 		}
 		if data.UseTLS.UseHostHeaderAsSni != nil {
@@ -2549,6 +3107,19 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 		if data.UseTLS.UseServerVerification != nil {
 			use_server_verificationNestedMap := make(map[string]interface{})
+			if data.UseTLS.UseServerVerification.TrustedCA != nil {
+				trusted_caLevel3Map := make(map[string]interface{})
+				if !data.UseTLS.UseServerVerification.TrustedCA.Name.IsNull() && !data.UseTLS.UseServerVerification.TrustedCA.Name.IsUnknown() {
+					trusted_caLevel3Map["name"] = data.UseTLS.UseServerVerification.TrustedCA.Name.ValueString()
+				}
+				if !data.UseTLS.UseServerVerification.TrustedCA.Namespace.IsNull() && !data.UseTLS.UseServerVerification.TrustedCA.Namespace.IsUnknown() {
+					trusted_caLevel3Map["namespace"] = data.UseTLS.UseServerVerification.TrustedCA.Namespace.ValueString()
+				}
+				if !data.UseTLS.UseServerVerification.TrustedCA.Tenant.IsNull() && !data.UseTLS.UseServerVerification.TrustedCA.Tenant.IsUnknown() {
+					trusted_caLevel3Map["tenant"] = data.UseTLS.UseServerVerification.TrustedCA.Tenant.ValueString()
+				}
+				use_server_verificationNestedMap["trusted_ca"] = trusted_caLevel3Map
+			}
 			if !data.UseTLS.UseServerVerification.TrustedCAURL.IsNull() && !data.UseTLS.UseServerVerification.TrustedCAURL.IsUnknown() {
 				use_server_verificationNestedMap["trusted_ca_url"] = data.UseTLS.UseServerVerification.TrustedCAURL.ValueString()
 			}
@@ -2574,11 +3145,15 @@ func (r *OriginPoolResource) Create(ctx context.Context, req resource.CreateRequ
 
 	apiResource, err := r.client.CreateOriginPool(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OriginPool: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "origin_pool", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -3404,7 +3979,7 @@ func (r *OriginPoolResource) Read(ctx context.Context, req resource.ReadRequest,
 	apiResource, err := r.client.GetOriginPool(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "OriginPool not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -3412,11 +3987,12 @@ func (r *OriginPoolResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OriginPool: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "origin_pool", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -3427,31 +4003,13 @@ func (r *OriginPoolResource) Read(ctx context.Context, req resource.ReadRequest,
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -3459,6 +4017,7 @@ func (r *OriginPoolResource) Read(ctx context.Context, req resource.ReadRequest,
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["advanced_options"].(map[string]interface{}); ok && (isImport || data.AdvancedOptions != nil) {
 		data.AdvancedOptions = &OriginPoolAdvancedOptionsModel{
@@ -4262,7 +4821,8 @@ func (r *OriginPoolResource) Read(ctx context.Context, req resource.ReadRequest,
 
 func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data OriginPoolResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -4296,6 +4856,7 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -4305,6 +4866,7 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AdvancedOptions != nil {
@@ -4357,10 +4919,39 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		if data.AdvancedOptions.EnableSubsets != nil {
 			enable_subsetsNestedMap := make(map[string]interface{})
+			if data.AdvancedOptions.EnableSubsets.AnyEndpoint != nil {
+				enable_subsetsNestedMap["any_endpoint"] = map[string]interface{}{}
+			}
+			if data.AdvancedOptions.EnableSubsets.DefaultSubset != nil {
+				default_subsetLevel3Map := make(map[string]interface{})
+				if data.AdvancedOptions.EnableSubsets.DefaultSubset.DefaultSubset != nil {
+					default_subsetLevel3Map["default_subset"] = map[string]interface{}{}
+				}
+				enable_subsetsNestedMap["default_subset"] = default_subsetLevel3Map
+			}
+			if data.AdvancedOptions.EnableSubsets.FailRequest != nil {
+				enable_subsetsNestedMap["fail_request"] = map[string]interface{}{}
+			}
 			advanced_optionsMap["enable_subsets"] = enable_subsetsNestedMap
 		}
 		if data.AdvancedOptions.Http1Config != nil {
 			http1_configNestedMap := make(map[string]interface{})
+			if data.AdvancedOptions.Http1Config.HeaderTransformation != nil {
+				header_transformationLevel3Map := make(map[string]interface{})
+				if data.AdvancedOptions.Http1Config.HeaderTransformation.DefaultHeaderTransformation != nil {
+					header_transformationLevel3Map["default_header_transformation"] = map[string]interface{}{}
+				}
+				if data.AdvancedOptions.Http1Config.HeaderTransformation.LegacyHeaderTransformation != nil {
+					header_transformationLevel3Map["legacy_header_transformation"] = map[string]interface{}{}
+				}
+				if data.AdvancedOptions.Http1Config.HeaderTransformation.PreserveCaseHeaderTransformation != nil {
+					header_transformationLevel3Map["preserve_case_header_transformation"] = map[string]interface{}{}
+				}
+				if data.AdvancedOptions.Http1Config.HeaderTransformation.ProperCaseHeaderTransformation != nil {
+					header_transformationLevel3Map["proper_case_header_transformation"] = map[string]interface{}{}
+				}
+				http1_configNestedMap["header_transformation"] = header_transformationLevel3Map
+			}
 			advanced_optionsMap["http1_config"] = http1_configNestedMap
 		}
 		if data.AdvancedOptions.Http2Options != nil {
@@ -4468,6 +5059,32 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 					}
 					if item.ConsulService.SiteLocator != nil {
 						site_locatorDeepMap := make(map[string]interface{})
+						if item.ConsulService.SiteLocator.Site != nil {
+							siteLevel4Map := make(map[string]interface{})
+							if !item.ConsulService.SiteLocator.Site.Name.IsNull() && !item.ConsulService.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel4Map["name"] = item.ConsulService.SiteLocator.Site.Name.ValueString()
+							}
+							if !item.ConsulService.SiteLocator.Site.Namespace.IsNull() && !item.ConsulService.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel4Map["namespace"] = item.ConsulService.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !item.ConsulService.SiteLocator.Site.Tenant.IsNull() && !item.ConsulService.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel4Map["tenant"] = item.ConsulService.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorDeepMap["site"] = siteLevel4Map
+						}
+						if item.ConsulService.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel4Map := make(map[string]interface{})
+							if !item.ConsulService.SiteLocator.VirtualSite.Name.IsNull() && !item.ConsulService.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel4Map["name"] = item.ConsulService.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !item.ConsulService.SiteLocator.VirtualSite.Namespace.IsNull() && !item.ConsulService.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel4Map["namespace"] = item.ConsulService.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !item.ConsulService.SiteLocator.VirtualSite.Tenant.IsNull() && !item.ConsulService.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel4Map["tenant"] = item.ConsulService.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorDeepMap["virtual_site"] = virtual_siteLevel4Map
+						}
 						consul_serviceNestedMap["site_locator"] = site_locatorDeepMap
 					}
 					if item.ConsulService.SnatPool != nil {
@@ -4475,6 +5092,17 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 						if item.ConsulService.SnatPool.NoSnatPool != nil {
 							snat_poolDeepMap["no_snat_pool"] = map[string]interface{}{}
 						}
+						if item.ConsulService.SnatPool.SnatPool != nil {
+							snat_poolLevel4Map := make(map[string]interface{})
+							if !item.ConsulService.SnatPool.SnatPool.Prefixes.IsNull() && !item.ConsulService.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel4Items []string
+								diags := item.ConsulService.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel4Items, false)
+								if !diags.HasError() {
+									snat_poolLevel4Map["prefixes"] = prefixesLevel4Items
+								}
+							}
+							snat_poolDeepMap["snat_pool"] = snat_poolLevel4Map
+						}
 						consul_serviceNestedMap["snat_pool"] = snat_poolDeepMap
 					}
 					itemMap["consul_service"] = consul_serviceNestedMap
@@ -4512,6 +5140,32 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 					}
 					if item.K8SService.SiteLocator != nil {
 						site_locatorDeepMap := make(map[string]interface{})
+						if item.K8SService.SiteLocator.Site != nil {
+							siteLevel4Map := make(map[string]interface{})
+							if !item.K8SService.SiteLocator.Site.Name.IsNull() && !item.K8SService.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel4Map["name"] = item.K8SService.SiteLocator.Site.Name.ValueString()
+							}
+							if !item.K8SService.SiteLocator.Site.Namespace.IsNull() && !item.K8SService.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel4Map["namespace"] = item.K8SService.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !item.K8SService.SiteLocator.Site.Tenant.IsNull() && !item.K8SService.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel4Map["tenant"] = item.K8SService.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorDeepMap["site"] = siteLevel4Map
+						}
+						if item.K8SService.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel4Map := make(map[string]interface{})
+							if !item.K8SService.SiteLocator.VirtualSite.Name.IsNull() && !item.K8SService.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel4Map["name"] = item.K8SService.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !item.K8SService.SiteLocator.VirtualSite.Namespace.IsNull() && !item.K8SService.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel4Map["namespace"] = item.K8SService.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !item.K8SService.SiteLocator.VirtualSite.Tenant.IsNull() && !item.K8SService.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel4Map["tenant"] = item.K8SService.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorDeepMap["virtual_site"] = virtual_siteLevel4Map
+						}
 						k8s_serviceNestedMap["site_locator"] = site_locatorDeepMap
 					}
 					if item.K8SService.SnatPool != nil {
@@ -4519,6 +5173,17 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 						if item.K8SService.SnatPool.NoSnatPool != nil {
 							snat_poolDeepMap["no_snat_pool"] = map[string]interface{}{}
 						}
+						if item.K8SService.SnatPool.SnatPool != nil {
+							snat_poolLevel4Map := make(map[string]interface{})
+							if !item.K8SService.SnatPool.SnatPool.Prefixes.IsNull() && !item.K8SService.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel4Items []string
+								diags := item.K8SService.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel4Items, false)
+								if !diags.HasError() {
+									snat_poolLevel4Map["prefixes"] = prefixesLevel4Items
+								}
+							}
+							snat_poolDeepMap["snat_pool"] = snat_poolLevel4Map
+						}
 						k8s_serviceNestedMap["snat_pool"] = snat_poolDeepMap
 					}
 					if item.K8SService.Vk8sNetworks != nil {
@@ -4555,6 +5220,32 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 					}
 					if item.PrivateIP.SiteLocator != nil {
 						site_locatorDeepMap := make(map[string]interface{})
+						if item.PrivateIP.SiteLocator.Site != nil {
+							siteLevel4Map := make(map[string]interface{})
+							if !item.PrivateIP.SiteLocator.Site.Name.IsNull() && !item.PrivateIP.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel4Map["name"] = item.PrivateIP.SiteLocator.Site.Name.ValueString()
+							}
+							if !item.PrivateIP.SiteLocator.Site.Namespace.IsNull() && !item.PrivateIP.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel4Map["namespace"] = item.PrivateIP.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !item.PrivateIP.SiteLocator.Site.Tenant.IsNull() && !item.PrivateIP.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel4Map["tenant"] = item.PrivateIP.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorDeepMap["site"] = siteLevel4Map
+						}
+						if item.PrivateIP.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel4Map := make(map[string]interface{})
+							if !item.PrivateIP.SiteLocator.VirtualSite.Name.IsNull() && !item.PrivateIP.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel4Map["name"] = item.PrivateIP.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !item.PrivateIP.SiteLocator.VirtualSite.Namespace.IsNull() && !item.PrivateIP.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel4Map["namespace"] = item.PrivateIP.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !item.PrivateIP.SiteLocator.VirtualSite.Tenant.IsNull() && !item.PrivateIP.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel4Map["tenant"] = item.PrivateIP.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorDeepMap["virtual_site"] = virtual_siteLevel4Map
+						}
 						private_ipNestedMap["site_locator"] = site_locatorDeepMap
 					}
 					if item.PrivateIP.SnatPool != nil {
@@ -4562,6 +5253,17 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 						if item.PrivateIP.SnatPool.NoSnatPool != nil {
 							snat_poolDeepMap["no_snat_pool"] = map[string]interface{}{}
 						}
+						if item.PrivateIP.SnatPool.SnatPool != nil {
+							snat_poolLevel4Map := make(map[string]interface{})
+							if !item.PrivateIP.SnatPool.SnatPool.Prefixes.IsNull() && !item.PrivateIP.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel4Items []string
+								diags := item.PrivateIP.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel4Items, false)
+								if !diags.HasError() {
+									snat_poolLevel4Map["prefixes"] = prefixesLevel4Items
+								}
+							}
+							snat_poolDeepMap["snat_pool"] = snat_poolLevel4Map
+						}
 						private_ipNestedMap["snat_pool"] = snat_poolDeepMap
 					}
 					itemMap["private_ip"] = private_ipNestedMap
@@ -4595,6 +5297,32 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 					}
 					if item.PrivateName.SiteLocator != nil {
 						site_locatorDeepMap := make(map[string]interface{})
+						if item.PrivateName.SiteLocator.Site != nil {
+							siteLevel4Map := make(map[string]interface{})
+							if !item.PrivateName.SiteLocator.Site.Name.IsNull() && !item.PrivateName.SiteLocator.Site.Name.IsUnknown() {
+								siteLevel4Map["name"] = item.PrivateName.SiteLocator.Site.Name.ValueString()
+							}
+							if !item.PrivateName.SiteLocator.Site.Namespace.IsNull() && !item.PrivateName.SiteLocator.Site.Namespace.IsUnknown() {
+								siteLevel4Map["namespace"] = item.PrivateName.SiteLocator.Site.Namespace.ValueString()
+							}
+							if !item.PrivateName.SiteLocator.Site.Tenant.IsNull() && !item.PrivateName.SiteLocator.Site.Tenant.IsUnknown() {
+								siteLevel4Map["tenant"] = item.PrivateName.SiteLocator.Site.Tenant.ValueString()
+							}
+							site_locatorDeepMap["site"] = siteLevel4Map
+						}
+						if item.PrivateName.SiteLocator.VirtualSite != nil {
+							virtual_siteLevel4Map := make(map[string]interface{})
+							if !item.PrivateName.SiteLocator.VirtualSite.Name.IsNull() && !item.PrivateName.SiteLocator.VirtualSite.Name.IsUnknown() {
+								virtual_siteLevel4Map["name"] = item.PrivateName.SiteLocator.VirtualSite.Name.ValueString()
+							}
+							if !item.PrivateName.SiteLocator.VirtualSite.Namespace.IsNull() && !item.PrivateName.SiteLocator.VirtualSite.Namespace.IsUnknown() {
+								virtual_siteLevel4Map["namespace"] = item.PrivateName.SiteLocator.VirtualSite.Namespace.ValueString()
+							}
+							if !item.PrivateName.SiteLocator.VirtualSite.Tenant.IsNull() && !item.PrivateName.SiteLocator.VirtualSite.Tenant.IsUnknown() {
+								virtual_siteLevel4Map["tenant"] = item.PrivateName.SiteLocator.VirtualSite.Tenant.ValueString()
+							}
+							site_locatorDeepMap["virtual_site"] = virtual_siteLevel4Map
+						}
 						private_nameNestedMap["site_locator"] = site_locatorDeepMap
 					}
 					if item.PrivateName.SnatPool != nil {
@@ -4602,6 +5330,17 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 						if item.PrivateName.SnatPool.NoSnatPool != nil {
 							snat_poolDeepMap["no_snat_pool"] = map[string]interface{}{}
 						}
+						if item.PrivateName.SnatPool.SnatPool != nil {
+							snat_poolLevel4Map := make(map[string]interface{})
+							if !item.PrivateName.SnatPool.SnatPool.Prefixes.IsNull() && !item.PrivateName.SnatPool.SnatPool.Prefixes.IsUnknown() {
+								var prefixesLevel4Items []string
+								diags := item.PrivateName.SnatPool.SnatPool.Prefixes.ElementsAs(ctx, &prefixesLevel4Items, false)
+								if !diags.HasError() {
+									snat_poolLevel4Map["prefixes"] = prefixesLevel4Items
+								}
+							}
+							snat_poolDeepMap["snat_pool"] = snat_poolLevel4Map
+						}
 						private_nameNestedMap["snat_pool"] = snat_poolDeepMap
 					}
 					itemMap["private_name"] = private_nameNestedMap
@@ -4707,6 +5446,32 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		if data.UseTLS.TLSConfig != nil {
 			tls_configNestedMap := make(map[string]interface{})
+			if data.UseTLS.TLSConfig.CustomSecurity != nil {
+				custom_securityLevel3Map := make(map[string]interface{})
+				if !data.UseTLS.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.UseTLS.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+					var cipher_suitesLevel3Items []string
+					diags := data.UseTLS.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel3Items, false)
+					if !diags.HasError() {
+						custom_securityLevel3Map["cipher_suites"] = cipher_suitesLevel3Items
+					}
+				}
+				if !data.UseTLS.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.UseTLS.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+					custom_securityLevel3Map["max_version"] = data.UseTLS.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+				}
+				if !data.UseTLS.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.UseTLS.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+					custom_securityLevel3Map["min_version"] = data.UseTLS.TLSConfig.CustomSecurity.MinVersion.ValueString()
+				}
+				tls_configNestedMap["custom_security"] = custom_securityLevel3Map
+			}
+			if data.UseTLS.TLSConfig.DefaultSecurity != nil {
+				tls_configNestedMap["default_security"] = map[string]interface{}{}
+			}
+			if data.UseTLS.TLSConfig.LowSecurity != nil {
+				tls_configNestedMap["low_security"] = map[string]interface{}{}
+			}
+			if data.UseTLS.TLSConfig.MediumSecurity != nil {
+				tls_configNestedMap["medium_security"] = map[string]interface{}{}
+			}
 			use_tlsMap["tls_config"] = tls_configNestedMap
 		}
 		if data.UseTLS.UseHostHeaderAsSni != nil {
@@ -4731,6 +5496,19 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 		}
 		if data.UseTLS.UseServerVerification != nil {
 			use_server_verificationNestedMap := make(map[string]interface{})
+			if data.UseTLS.UseServerVerification.TrustedCA != nil {
+				trusted_caLevel3Map := make(map[string]interface{})
+				if !data.UseTLS.UseServerVerification.TrustedCA.Name.IsNull() && !data.UseTLS.UseServerVerification.TrustedCA.Name.IsUnknown() {
+					trusted_caLevel3Map["name"] = data.UseTLS.UseServerVerification.TrustedCA.Name.ValueString()
+				}
+				if !data.UseTLS.UseServerVerification.TrustedCA.Namespace.IsNull() && !data.UseTLS.UseServerVerification.TrustedCA.Namespace.IsUnknown() {
+					trusted_caLevel3Map["namespace"] = data.UseTLS.UseServerVerification.TrustedCA.Namespace.ValueString()
+				}
+				if !data.UseTLS.UseServerVerification.TrustedCA.Tenant.IsNull() && !data.UseTLS.UseServerVerification.TrustedCA.Tenant.IsUnknown() {
+					trusted_caLevel3Map["tenant"] = data.UseTLS.UseServerVerification.TrustedCA.Tenant.ValueString()
+				}
+				use_server_verificationNestedMap["trusted_ca"] = trusted_caLevel3Map
+			}
 			if !data.UseTLS.UseServerVerification.TrustedCAURL.IsNull() && !data.UseTLS.UseServerVerification.TrustedCAURL.IsUnknown() {
 				use_server_verificationNestedMap["trusted_ca_url"] = data.UseTLS.UseServerVerification.TrustedCAURL.ValueString()
 			}
@@ -4756,7 +5534,7 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	_, err := r.client.UpdateOriginPool(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update OriginPool: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "origin_pool", "update"))
 		return
 	}
 
@@ -4767,9 +5545,12 @@ func (r *OriginPoolResource) Update(ctx context.Context, req resource.UpdateRequ
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetOriginPool(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read OriginPool after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "origin_pool", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["endpoint_selection"].(string); ok && v != "" {
@@ -5623,7 +6404,7 @@ func (r *OriginPoolResource) Delete(ctx context.Context, req resource.DeleteRequ
 	err := r.client.DeleteOriginPool(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "OriginPool already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -5632,37 +6413,33 @@ func (r *OriginPoolResource) Delete(ctx context.Context, req resource.DeleteRequ
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "OriginPool delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OriginPool: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "origin_pool", "delete"))
 		return
 	}
 }
 
 func (r *OriginPoolResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *OriginPoolResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("origin_pool", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *OriginPoolResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListOriginPools(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *OriginPoolResource) requiredNamespace() string {
+	return ""
 }
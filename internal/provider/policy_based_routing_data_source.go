@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,20 @@ type PolicyBasedRoutingDataSource struct {
 	client *client.Client
 }
 
+// PolicyBasedRoutingDataSourceModel mirrors PolicyBasedRoutingResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type PolicyBasedRoutingDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata      types.Object                            `tfsdk:"system_metadata"`
+	Name                types.String                            `tfsdk:"name"`
+	Namespace           types.String                            `tfsdk:"namespace"`
+	Annotations         types.Map                               `tfsdk:"annotations"`
+	Description         types.String                            `tfsdk:"description"`
+	Disable             types.Bool                              `tfsdk:"disable"`
+	Labels              types.Map                               `tfsdk:"labels"`
+	ID                  types.String                            `tfsdk:"id"`
+	ForwardProxyPbr     *PolicyBasedRoutingForwardProxyPbrModel `tfsdk:"forward_proxy_pbr"`
+	ForwardingClassList types.List                              `tfsdk:"forwarding_class_list"`
+	NetworkPbr          *PolicyBasedRoutingNetworkPbrModel      `tfsdk:"network_pbr"`
 }
 
 func (d *PolicyBasedRoutingDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +47,7 @@ func (d *PolicyBasedRoutingDataSource) Metadata(ctx context.Context, req datasou
 }
 
 func (d *PolicyBasedRoutingDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Policy Based Routing resource in F5 Distributed Cloud for network policy based routing create specification. configuration.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the PolicyBasedRouting.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the PolicyBasedRouting exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the PolicyBasedRouting.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewPolicyBasedRoutingResource())
 }
 
 func (d *PolicyBasedRoutingDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +69,25 @@ func (d *PolicyBasedRoutingDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	resource, err := d.client.GetPolicyBasedRouting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetPolicyBasedRouting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read PolicyBasedRouting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "policy_based_routing", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +101,8 @@ func (d *PolicyBasedRoutingDataSource) Read(ctx context.Context, req datasource.
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +111,306 @@ func (d *PolicyBasedRoutingDataSource) Read(ctx context.Context, req datasource.
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["forward_proxy_pbr"].(map[string]interface{}); ok && (isImport || data.ForwardProxyPbr != nil) {
+		data.ForwardProxyPbr = &PolicyBasedRoutingForwardProxyPbrModel{
+			ForwardProxyPbrRules: func() []PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesModel {
+				if listData, ok := blockData["forward_proxy_pbr_rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesModel{
+								AllDestinations: func() *PolicyBasedRoutingEmptyModel {
+									if _, ok := itemMap["all_destinations"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingEmptyModel{}
+									}
+									return nil
+								}(),
+								AllSources: func() *PolicyBasedRoutingEmptyModel {
+									if _, ok := itemMap["all_sources"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingEmptyModel{}
+									}
+									return nil
+								}(),
+								HTTPList: func() *PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesHTTPListModel {
+									if _, ok := itemMap["http_list"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesHTTPListModel{}
+									}
+									return nil
+								}(),
+								IPPrefixSet: func() *PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesIPPrefixSetModel {
+									if deepMap, ok := itemMap["ip_prefix_set"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesIPPrefixSetModel{
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Namespace: func() types.String {
+												if v, ok := deepMap["namespace"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Tenant: func() types.String {
+												if v, ok := deepMap["tenant"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								LabelSelector: func() *PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesLabelSelectorModel {
+									if _, ok := itemMap["label_selector"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesLabelSelectorModel{}
+									}
+									return nil
+								}(),
+								Metadata: func() *PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesMetadataModel {
+									if deepMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesMetadataModel{
+											DescriptionSpec: func() types.String {
+												if v, ok := deepMap["description"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								PrefixList: func() *PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesPrefixListModel {
+									if _, ok := itemMap["prefix_list"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesPrefixListModel{}
+									}
+									return nil
+								}(),
+								TLSList: func() *PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesTLSListModel {
+									if _, ok := itemMap["tls_list"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingForwardProxyPbrForwardProxyPbrRulesTLSListModel{}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if listData, ok := apiResource.Spec["forwarding_class_list"].([]interface{}); ok && len(listData) > 0 {
+		var forwarding_class_listList []PolicyBasedRoutingForwardingClassListModel
+		var existingForwardingClassListItems []PolicyBasedRoutingForwardingClassListModel
+		if !data.ForwardingClassList.IsNull() && !data.ForwardingClassList.IsUnknown() {
+			data.ForwardingClassList.ElementsAs(ctx, &existingForwardingClassListItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				forwarding_class_listList = append(forwarding_class_listList, PolicyBasedRoutingForwardingClassListModel{
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: PolicyBasedRoutingForwardingClassListModelAttrTypes}, forwarding_class_listList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.ForwardingClassList = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.ForwardingClassList = types.ListNull(types.ObjectType{AttrTypes: PolicyBasedRoutingForwardingClassListModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["network_pbr"].(map[string]interface{}); ok && (isImport || data.NetworkPbr != nil) {
+		data.NetworkPbr = &PolicyBasedRoutingNetworkPbrModel{
+			Any: func() *PolicyBasedRoutingEmptyModel {
+				if !isImport && data.NetworkPbr != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.NetworkPbr.Any
+				}
+				// Import case: read from API
+				if _, ok := blockData["any"].(map[string]interface{}); ok {
+					return &PolicyBasedRoutingEmptyModel{}
+				}
+				return nil
+			}(),
+			LabelSelector: func() *PolicyBasedRoutingNetworkPbrLabelSelectorModel {
+				if !isImport && data.NetworkPbr != nil && data.NetworkPbr.LabelSelector != nil {
+					// Normal Read: preserve existing state value
+					return data.NetworkPbr.LabelSelector
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["label_selector"].(map[string]interface{}); ok {
+					return &PolicyBasedRoutingNetworkPbrLabelSelectorModel{
+						Expressions: func() types.List {
+							if v, ok := nestedBlockData["expressions"].([]interface{}); ok && len(v) > 0 {
+								var items []string
+								for _, item := range v {
+									if s, ok := item.(string); ok {
+										items = append(items, s)
+									}
+								}
+								listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+								return listVal
+							}
+							return types.ListNull(types.StringType)
+						}(),
+					}
+				}
+				return nil
+			}(),
+			NetworkPbrRules: func() []PolicyBasedRoutingNetworkPbrNetworkPbrRulesModel {
+				if listData, ok := blockData["network_pbr_rules"].([]interface{}); ok && len(listData) > 0 {
+					var result []PolicyBasedRoutingNetworkPbrNetworkPbrRulesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, PolicyBasedRoutingNetworkPbrNetworkPbrRulesModel{
+								AllTCPTraffic: func() *PolicyBasedRoutingEmptyModel {
+									if _, ok := itemMap["all_tcp_traffic"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingEmptyModel{}
+									}
+									return nil
+								}(),
+								AllTraffic: func() *PolicyBasedRoutingEmptyModel {
+									if _, ok := itemMap["all_traffic"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingEmptyModel{}
+									}
+									return nil
+								}(),
+								AllUDPTraffic: func() *PolicyBasedRoutingEmptyModel {
+									if _, ok := itemMap["all_udp_traffic"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingEmptyModel{}
+									}
+									return nil
+								}(),
+								Any: func() *PolicyBasedRoutingEmptyModel {
+									if _, ok := itemMap["any"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingEmptyModel{}
+									}
+									return nil
+								}(),
+								Applications: func() *PolicyBasedRoutingNetworkPbrNetworkPbrRulesApplicationsModel {
+									if _, ok := itemMap["applications"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingNetworkPbrNetworkPbrRulesApplicationsModel{}
+									}
+									return nil
+								}(),
+								DNSName: func() types.String {
+									if v, ok := itemMap["dns_name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								IPPrefixSet: func() *PolicyBasedRoutingNetworkPbrNetworkPbrRulesIPPrefixSetModel {
+									if _, ok := itemMap["ip_prefix_set"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingNetworkPbrNetworkPbrRulesIPPrefixSetModel{}
+									}
+									return nil
+								}(),
+								Metadata: func() *PolicyBasedRoutingNetworkPbrNetworkPbrRulesMetadataModel {
+									if deepMap, ok := itemMap["metadata"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingNetworkPbrNetworkPbrRulesMetadataModel{
+											DescriptionSpec: func() types.String {
+												if v, ok := deepMap["description"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+											Name: func() types.String {
+												if v, ok := deepMap["name"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+								PrefixList: func() *PolicyBasedRoutingNetworkPbrNetworkPbrRulesPrefixListModel {
+									if _, ok := itemMap["prefix_list"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingNetworkPbrNetworkPbrRulesPrefixListModel{}
+									}
+									return nil
+								}(),
+								ProtocolPortRange: func() *PolicyBasedRoutingNetworkPbrNetworkPbrRulesProtocolPortRangeModel {
+									if deepMap, ok := itemMap["protocol_port_range"].(map[string]interface{}); ok {
+										return &PolicyBasedRoutingNetworkPbrNetworkPbrRulesProtocolPortRangeModel{
+											Protocol: func() types.String {
+												if v, ok := deepMap["protocol"].(string); ok && v != "" {
+													return types.StringValue(v)
+												}
+												return types.StringNull()
+											}(),
+										}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			PrefixList: func() *PolicyBasedRoutingNetworkPbrPrefixListModel {
+				if !isImport && data.NetworkPbr != nil && data.NetworkPbr.PrefixList != nil {
+					// Normal Read: preserve existing state value
+					return data.NetworkPbr.PrefixList
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["prefix_list"].(map[string]interface{}); ok {
+					return &PolicyBasedRoutingNetworkPbrPrefixListModel{
+						Prefixes: func() types.List {
+							if v, ok := nestedBlockData["prefixes"].([]interface{}); ok && len(v) > 0 {
+								var items []string
+								for _, item := range v {
+									if s, ok := item.(string); ok {
+										items = append(items, s)
+									}
+								}
+								listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+								return listVal
+							}
+							return types.ListNull(types.StringType)
+						}(),
+					}
+				}
+				return nil
+			}(),
+		}
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &PolicyBasedRoutingResource{}
-	_ resource.ResourceWithConfigure      = &PolicyBasedRoutingResource{}
-	_ resource.ResourceWithImportState    = &PolicyBasedRoutingResource{}
-	_ resource.ResourceWithModifyPlan     = &PolicyBasedRoutingResource{}
-	_ resource.ResourceWithValidateConfig = &PolicyBasedRoutingResource{}
+	_ resource.Resource                     = &PolicyBasedRoutingResource{}
+	_ resource.ResourceWithConfigure        = &PolicyBasedRoutingResource{}
+	_ resource.ResourceWithImportState      = &PolicyBasedRoutingResource{}
+	_ resource.ResourceWithIdentity         = &PolicyBasedRoutingResource{}
+	_ resource.ResourceWithMoveState        = &PolicyBasedRoutingResource{}
+	_ resource.ResourceWithModifyPlan       = &PolicyBasedRoutingResource{}
+	_ resource.ResourceWithValidateConfig   = &PolicyBasedRoutingResource{}
+	_ resource.ResourceWithConfigValidators = &PolicyBasedRoutingResource{}
+	_ listableResource                      = &PolicyBasedRoutingResource{}
 )
 
 func NewPolicyBasedRoutingResource() resource.Resource {
@@ -372,6 +378,8 @@ type PolicyBasedRoutingResourceModel struct {
 	Labels              types.Map                               `tfsdk:"labels"`
 	ID                  types.String                            `tfsdk:"id"`
 	Timeouts            timeouts.Value                          `tfsdk:"timeouts"`
+	SystemMetadata      types.Object                            `tfsdk:"system_metadata"`
+	LabelsAll           types.Map                               `tfsdk:"labels_all"`
 	ForwardProxyPbr     *PolicyBasedRoutingForwardProxyPbrModel `tfsdk:"forward_proxy_pbr"`
 	ForwardingClassList types.List                              `tfsdk:"forwarding_class_list"`
 	NetworkPbr          *PolicyBasedRoutingNetworkPbrModel      `tfsdk:"network_pbr"`
@@ -396,9 +404,11 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Policy Based Routing will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Policy Based Routing will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -430,6 +440,8 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -444,6 +456,9 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 				Blocks: map[string]schema.Block{
 					"forward_proxy_pbr_rules": schema.ListNestedBlock{
 						MarkdownDescription: "L3/L4 routing rules. Network(L3/L4) routing policy rules.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(128),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{},
 							Blocks: map[string]schema.Block{
@@ -455,11 +470,18 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 								},
 								"forwarding_class_list": schema.ListNestedBlock{
 									MarkdownDescription: "Ordered list of forwarding Class to be used if no rule match.",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(3),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -468,6 +490,9 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -476,6 +501,9 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -486,31 +514,62 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 									Blocks: map[string]schema.Block{
 										"http_list": schema.ListNestedBlock{
 											MarkdownDescription: "HTTP URLs. URLs for HTTP connections.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"exact_value": schema.StringAttribute{
 														MarkdownDescription: "Exact domain name.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.HostnameValidator(),
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 													"path_exact_value": schema.StringAttribute{
 														MarkdownDescription: "Exact Path to match.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 													"path_prefix_value": schema.StringAttribute{
 														MarkdownDescription: "Prefix of Path e.g '/abc/xyz' will match '/abc/xyz/.*'.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 													"path_regex_value": schema.StringAttribute{
 														MarkdownDescription: "Regular Expression value for the Path to match.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+															validators.RegexValidator(),
+														},
 													},
 													"regex_value": schema.StringAttribute{
 														MarkdownDescription: "Regular Expression value for the domain name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+															validators.RegexValidator(),
+														},
 													},
 													"suffix_value": schema.StringAttribute{
 														MarkdownDescription: "Suffix of domain names e.g 'xyz.com' will match '*.xyz.com'.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.HostnameValidator(),
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -528,6 +587,10 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -536,6 +599,9 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -544,6 +610,9 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -554,6 +623,10 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 											MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+											},
 										},
 									},
 								},
@@ -563,10 +636,16 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Human readable description.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 								},
@@ -577,6 +656,11 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 											MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+											},
 										},
 									},
 								},
@@ -586,19 +670,37 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 									Blocks: map[string]schema.Block{
 										"tls_list": schema.ListNestedBlock{
 											MarkdownDescription: "TLS Domains. Domains in SNI for TLS connections.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"exact_value": schema.StringAttribute{
 														MarkdownDescription: "Exact domain name.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.HostnameValidator(),
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 													"regex_value": schema.StringAttribute{
 														MarkdownDescription: "Regular Expression value for the domain name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+															validators.RegexValidator(),
+														},
 													},
 													"suffix_value": schema.StringAttribute{
 														MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 														Optional:            true,
+														Validators: []validator.String{
+															validators.HostnameValidator(),
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 												},
 											},
@@ -612,11 +714,19 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 			},
 			"forwarding_class_list": schema.ListNestedBlock{
 				MarkdownDescription: "Ordered list of forwarding Class to be used if source application match and no rule match.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(3),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
 							MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(128),
+								stringvalidator.LengthAtLeast(1),
+							},
 						},
 						"namespace": schema.StringAttribute{
 							MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -625,6 +735,9 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(64),
+							},
 						},
 						"tenant": schema.StringAttribute{
 							MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -633,6 +746,9 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 							PlanModifiers: []planmodifier.String{
 								stringplanmodifier.UseStateForUnknown(),
 							},
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(64),
+							},
 						},
 					},
 				},
@@ -651,16 +767,26 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 								MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+									listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+								},
 							},
 						},
 					},
 					"network_pbr_rules": schema.ListNestedBlock{
 						MarkdownDescription: "L3/L4 Destination Routing Rules. Network(L3/L4) routing policy rule.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(128),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"dns_name": schema.StringAttribute{
 									MarkdownDescription: "Resolve hostname to GET the IP.",
 									Optional:            true,
+									Validators: []validator.String{
+										validators.HostnameValidator(),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -688,11 +814,18 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 								},
 								"forwarding_class_list": schema.ListNestedBlock{
 									MarkdownDescription: "Ordered list of forwarding Class to be used if rule match.",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(3),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -701,6 +834,9 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -709,6 +845,9 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -719,6 +858,9 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 									Blocks: map[string]schema.Block{
 										"ref": schema.ListNestedBlock{
 											MarkdownDescription: "List of references to ip_prefix_set objects.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"kind": schema.StringAttribute{
@@ -768,10 +910,16 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Human readable description.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 								},
@@ -782,6 +930,11 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 											MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+											},
 										},
 									},
 								},
@@ -792,6 +945,9 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 											MarkdownDescription: "List of port ranges. Each range is a single port or a pair of start and end ports e.g. 8080-8192.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+											},
 										},
 										"protocol": schema.StringAttribute{
 											MarkdownDescription: "Protocol in IP packet to be used as match criteria Values are TCP, UDP, and icmp.",
@@ -809,6 +965,11 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 								MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+								},
 							},
 						},
 					},
@@ -818,6 +979,11 @@ func (r *PolicyBasedRoutingResource) Schema(ctx context.Context, req resource.Sc
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *PolicyBasedRoutingResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *PolicyBasedRoutingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -842,6 +1008,22 @@ func (r *PolicyBasedRoutingResource) ValidateConfig(ctx context.Context, req res
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *PolicyBasedRoutingResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "policy_choice", "forward_proxy_pbr", "network_pbr"),
+		validators.OneOfGroup(path.MatchRoot("forward_proxy_pbr").AtName("forward_proxy_pbr_rules").AtAnyListIndex(), "destination_choice", "all_destinations", "http_list", "tls_list"),
+		validators.OneOfGroup(path.MatchRoot("forward_proxy_pbr").AtName("forward_proxy_pbr_rules").AtAnyListIndex(), "source_choice", "all_sources", "ip_prefix_set", "label_selector", "prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("forward_proxy_pbr").AtName("forward_proxy_pbr_rules").AtAnyListIndex().AtName("http_list").AtName("http_list").AtAnyListIndex(), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("forward_proxy_pbr").AtName("forward_proxy_pbr_rules").AtAnyListIndex().AtName("http_list").AtName("http_list").AtAnyListIndex(), "path_choice", "any_path", "path_exact_value", "path_prefix_value", "path_regex_value"),
+		validators.OneOfGroup(path.MatchRoot("forward_proxy_pbr").AtName("forward_proxy_pbr_rules").AtAnyListIndex().AtName("tls_list").AtName("tls_list").AtAnyListIndex(), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+		validators.OneOfGroup(path.MatchRoot("network_pbr"), "source_choice", "any", "label_selector", "prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("network_pbr").AtName("network_pbr_rules").AtAnyListIndex(), "destination", "any", "dns_name", "ip_prefix_set", "prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("network_pbr").AtName("network_pbr_rules").AtAnyListIndex(), "traffic_choice", "all_tcp_traffic", "all_traffic", "all_udp_traffic", "applications", "protocol_port_range"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *PolicyBasedRoutingResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -852,7 +1034,10 @@ func (r *PolicyBasedRoutingResource) ModifyPlan(ctx context.Context, req resourc
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "policy_based_routing", "", req.Config, &resp.Plan)...)
 		var plan PolicyBasedRoutingResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -909,6 +1094,7 @@ func (r *PolicyBasedRoutingResource) Create(ctx context.Context, req resource.Cr
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -918,6 +1104,7 @@ func (r *PolicyBasedRoutingResource) Create(ctx context.Context, req resource.Cr
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.ForwardProxyPbr != nil {
@@ -1069,11 +1256,15 @@ func (r *PolicyBasedRoutingResource) Create(ctx context.Context, req resource.Cr
 
 	apiResource, err := r.client.CreatePolicyBasedRouting(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create PolicyBasedRouting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "policy_based_routing", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1399,7 +1590,7 @@ func (r *PolicyBasedRoutingResource) Read(ctx context.Context, req resource.Read
 	apiResource, err := r.client.GetPolicyBasedRouting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "PolicyBasedRouting not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1407,11 +1598,12 @@ func (r *PolicyBasedRoutingResource) Read(ctx context.Context, req resource.Read
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read PolicyBasedRouting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "policy_based_routing", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1422,31 +1614,13 @@ func (r *PolicyBasedRoutingResource) Read(ctx context.Context, req resource.Read
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1454,6 +1628,7 @@ func (r *PolicyBasedRoutingResource) Read(ctx context.Context, req resource.Read
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["forward_proxy_pbr"].(map[string]interface{}); ok && (isImport || data.ForwardProxyPbr != nil) {
 		data.ForwardProxyPbr = &PolicyBasedRoutingForwardProxyPbrModel{
@@ -1791,6 +1966,7 @@ func (r *PolicyBasedRoutingResource) Update(ctx context.Context, req resource.Up
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1800,6 +1976,7 @@ func (r *PolicyBasedRoutingResource) Update(ctx context.Context, req resource.Up
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.ForwardProxyPbr != nil {
@@ -1951,7 +2128,7 @@ func (r *PolicyBasedRoutingResource) Update(ctx context.Context, req resource.Up
 
 	_, err := r.client.UpdatePolicyBasedRouting(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update PolicyBasedRouting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "policy_based_routing", "update"))
 		return
 	}
 
@@ -1962,9 +2139,12 @@ func (r *PolicyBasedRoutingResource) Update(ctx context.Context, req resource.Up
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetPolicyBasedRouting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read PolicyBasedRouting after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "policy_based_routing", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -2290,7 +2470,7 @@ func (r *PolicyBasedRoutingResource) Delete(ctx context.Context, req resource.De
 	err := r.client.DeletePolicyBasedRouting(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "PolicyBasedRouting already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -2299,37 +2479,33 @@ func (r *PolicyBasedRoutingResource) Delete(ctx context.Context, req resource.De
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "PolicyBasedRouting delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete PolicyBasedRouting: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "policy_based_routing", "delete"))
 		return
 	}
 }
 
 func (r *PolicyBasedRoutingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *PolicyBasedRoutingResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("policy_based_routing", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *PolicyBasedRoutingResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListPolicyBasedRoutings(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *PolicyBasedRoutingResource) requiredNamespace() string {
+	return ""
 }
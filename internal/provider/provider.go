@@ -7,11 +7,15 @@ import (
 	"context"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -27,17 +31,33 @@ type F5XCProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// functionTransport is the HTTP transport of the configured client for
+	// the provider functions
+	functionTransport functionTransport
 }
 
 // F5XCProviderModel describes the provider data model.
 type F5XCProviderModel struct {
-	APIToken    types.String `tfsdk:"api_token"`
-	APIURL      types.String `tfsdk:"api_url"`
-	APIP12File  types.String `tfsdk:"api_p12_file"`
-	P12Password types.String `tfsdk:"p12_password"`
-	APICert     types.String `tfsdk:"api_cert"`
-	APIKey      types.String `tfsdk:"api_key"`
-	APICACert   types.String `tfsdk:"api_ca_cert"`
+	APIToken           types.String  `tfsdk:"api_token"`
+	APIURL             types.String  `tfsdk:"api_url"`
+	APIP12File         types.String  `tfsdk:"api_p12_file"`
+	P12Password        types.String  `tfsdk:"p12_password"`
+	APICert            types.String  `tfsdk:"api_cert"`
+	APIKey             types.String  `tfsdk:"api_key"`
+	APICACert          types.String  `tfsdk:"api_ca_cert"`
+	SubscriptionTier   types.String  `tfsdk:"subscription_tier"`
+	MaxRetries         types.Int64   `tfsdk:"max_retries"`
+	RetryWaitMin       types.String  `tfsdk:"retry_wait_min"`
+	RetryWaitMax       types.String  `tfsdk:"retry_wait_max"`
+	RateLimitRPS       types.Float64 `tfsdk:"rate_limit_rps"`
+	RequestTimeout     types.String  `tfsdk:"request_timeout"`
+	HTTPProxy          types.String  `tfsdk:"http_proxy"`
+	InsecureSkipVerify types.Bool    `tfsdk:"insecure_skip_verify"`
+	HTTPHeaders        types.Map     `tfsdk:"http_headers"`
+	DefaultLabels      types.Map     `tfsdk:"default_labels"`
+	DefaultAnnotations types.Map     `tfsdk:"default_annotations"`
+	DefaultNamespace   types.String  `tfsdk:"default_namespace"`
 }
 
 func (p *F5XCProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -91,10 +111,90 @@ func (p *F5XCProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				Sensitive: true,
 			},
 			"api_ca_cert": schema.StringAttribute{
-				MarkdownDescription: "Path to PEM-encoded CA certificate file for verifying the F5XC API server. " +
+				MarkdownDescription: "Path to PEM-encoded CA certificate file for verifying the F5XC API server, " +
+					"used instead of the system CAs with every authentication mode. " +
 					"Can also be set via F5XC_CACERT environment variable. Optional.",
 				Optional: true,
 			},
+			"subscription_tier": schema.StringAttribute{
+				MarkdownDescription: "Subscription tier of the tenant, one of BASIC, STANDARD, ADVANCED or PREMIUM. " +
+					"When set, f5xc_addon_subscription refuses to plan addon services that require a higher tier. " +
+					"Can also be set via F5XC_SUBSCRIPTION_TIER environment variable. Optional.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("BASIC", "STANDARD", "ADVANCED", "PREMIUM"),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a failed API request is retried. Defaults to 3. " +
+					"Can also be set via F5XC_MAX_RETRIES environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.StringAttribute{
+				MarkdownDescription: "Minimum time to wait before retrying a failed API request, e.g. `1s`. " +
+					"The wait doubles with each retry up to retry_wait_max, with random jitter. Defaults to 1s. " +
+					"Can also be set via F5XC_RETRY_WAIT_MIN environment variable.",
+				Optional: true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait before retrying a failed API request, e.g. `30s`. Defaults to 30s. " +
+					"A Retry-After header sent by the API takes precedence, within the timeout of the operation. " +
+					"Can also be set via F5XC_RETRY_WAIT_MAX environment variable.",
+				Optional: true,
+			},
+			"rate_limit_rps": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of API requests per second, shared by all resources and data sources " +
+					"of the provider. Defaults to 0, which does not limit the request rate. " +
+					"Can also be set via F5XC_RATE_LIMIT_RPS environment variable.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout of a single API request, e.g. `60s`. Defaults to 30s. " +
+					"Can also be set via F5XC_REQUEST_TIMEOUT environment variable.",
+				Optional: true,
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy for API requests, e.g. `http://proxy.example.com:3128`. " +
+					"Defaults to the proxy from the HTTPS_PROXY and NO_PROXY environment variables. " +
+					"Can also be set via F5XC_HTTP_PROXY environment variable.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the F5XC API server certificate. " +
+					"Only use this for lab tenants with self-signed certificates. Defaults to false. " +
+					"Can also be set via F5XC_INSECURE_SKIP_VERIFY environment variable.",
+				Optional: true,
+			},
+			"http_headers": schema.MapAttribute{
+				MarkdownDescription: "Extra headers added to every API request, e.g. for a proxy that requires them. " +
+					"They cannot override the Authorization, Content-Type and Accept headers. Optional.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_labels": schema.MapAttribute{
+				MarkdownDescription: "Labels added to every object managed by the provider, e.g. for ownership and cost allocation. " +
+					"Labels with the same key in the labels of a resource take precedence. Optional.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_annotations": schema.MapAttribute{
+				MarkdownDescription: "Annotations added to every object managed by the provider. " +
+					"Annotations with the same key in the annotations of a resource take precedence. Optional.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of objects whose resource does not set one. " +
+					"Types that only exist in the system namespace always default to system. " +
+					"Can also be set via F5XC_DEFAULT_NAMESPACE environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -110,6 +210,21 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	// A provider configured from values of other resources, such as the
+	// tenant_url of a f5xc_child_tenant, sees unknown values until they are
+	// applied. Leave it unconfigured so planning can continue; Terraform
+	// configures it again with the known values before applying.
+	if config.APIURL.IsUnknown() || config.APIToken.IsUnknown() || config.APIP12File.IsUnknown() ||
+		config.P12Password.IsUnknown() || config.APICert.IsUnknown() || config.APIKey.IsUnknown() ||
+		config.APICACert.IsUnknown() || config.SubscriptionTier.IsUnknown() || config.MaxRetries.IsUnknown() ||
+		config.RetryWaitMin.IsUnknown() || config.RetryWaitMax.IsUnknown() || config.RateLimitRPS.IsUnknown() ||
+		config.RequestTimeout.IsUnknown() || config.HTTPProxy.IsUnknown() || config.InsecureSkipVerify.IsUnknown() ||
+		config.HTTPHeaders.IsUnknown() ||
+		config.DefaultLabels.IsUnknown() || config.DefaultAnnotations.IsUnknown() || config.DefaultNamespace.IsUnknown() {
+		tflog.Warn(ctx, "Provider configuration contains unknown values, skipping F5XC client configuration")
+		return
+	}
+
 	// Get configuration values from environment variables first
 	apiURL := os.Getenv("F5XC_API_URL")
 	apiToken := os.Getenv("F5XC_API_TOKEN")
@@ -117,7 +232,7 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	p12Password := os.Getenv("F5XC_P12_PASSWORD")
 	apiCert := os.Getenv("F5XC_CERT")
 	apiKey := os.Getenv("F5XC_KEY")
-	apiCACert := os.Getenv("F5XC_CACERT")
+	subscriptionTier := os.Getenv("F5XC_SUBSCRIPTION_TIER")
 
 	// Configuration values override environment variables
 	if !config.APIURL.IsNull() {
@@ -138,8 +253,8 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	if !config.APIKey.IsNull() {
 		apiKey = config.APIKey.ValueString()
 	}
-	if !config.APICACert.IsNull() {
-		apiCACert = config.APICACert.ValueString()
+	if !config.SubscriptionTier.IsNull() {
+		subscriptionTier = config.SubscriptionTier.ValueString()
 	}
 
 	// Set default API URL if not provided
@@ -150,6 +265,14 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	// Normalize the API URL (removes /api suffix and trailing slashes)
 	apiURL, _ = normalizeAPIURL(apiURL)
 
+	// Retry, rate limit and HTTP transport settings of the client. The CA
+	// certificate is part of the transport settings, so it applies to every
+	// authentication mode.
+	opts := clientOptions(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var c *client.Client
 	var err error
 
@@ -166,7 +289,7 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 			)
 			return
 		}
-		c, err = client.NewClientWithP12(apiURL, apiP12File, p12Password)
+		c, err = client.NewClientWithP12(apiURL, apiP12File, p12Password, opts...)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Failed to Create F5XC Client",
@@ -178,7 +301,7 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	case apiCert != "" && apiKey != "":
 		// PEM certificate/key authentication
-		c, err = client.NewClientWithCert(apiURL, apiCert, apiKey, apiCACert)
+		c, err = client.NewClientWithCert(apiURL, apiCert, apiKey, "", opts...)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Failed to Create F5XC Client",
@@ -190,7 +313,7 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	case apiToken != "":
 		// API token authentication
-		c = client.NewClient(apiURL, apiToken)
+		c = client.NewClient(apiURL, apiToken, opts...)
 		tflog.Info(ctx, "Configured F5XC client with API token authentication", map[string]any{"success": true, "api_url": apiURL})
 
 	default:
@@ -203,25 +326,34 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 		return
 	}
+	c.SubscriptionTier = subscriptionTier
 
-	// Make the client available during DataSource and Resource type Configure methods
+	// Make the client available during DataSource, Resource, EphemeralResource and ListResource type Configure methods
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.EphemeralResourceData = c
+	resp.ListResourceData = c
+
+	// Provider functions use the transport settings of the configured client
+	p.functionTransport.set(c)
 }
 
 func (p *F5XCProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAPICrawlerResource,
+		NewAPICredentialResource,
 		NewAPIDefinitionResource,
 		NewAPIDiscoveryResource,
 		NewAPITestingResource,
 		NewAPMResource,
 		NewAWSTGWSiteResource,
 		NewAWSVPCSiteResource,
+		NewAddonSubscriptionResource,
 		NewAddressAllocatorResource,
 		NewAdvertisePolicyResource,
 		NewAlertPolicyResource,
 		NewAlertReceiverResource,
+		NewAllowedTenantResource,
 		NewAppAPIGroupResource,
 		NewAppFirewallResource,
 		NewAppSettingResource,
@@ -231,12 +363,15 @@ func (p *F5XCProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewBGPAsnSetResource,
 		NewBGPResource,
 		NewBGPRoutingPolicyResource,
+		NewBlindfoldedSecretResource,
 		NewBotDefenseAppInfrastructureResource,
 		NewCDNCacheRuleResource,
 		NewCDNLoadBalancerResource,
 		NewCRLResource,
 		NewCertificateChainResource,
 		NewCertificateResource,
+		NewChildTenantManagerResource,
+		NewChildTenantResource,
 		NewCloudConnectResource,
 		NewCloudCredentialsResource,
 		NewCloudElasticIPResource,
@@ -247,6 +382,10 @@ func (p *F5XCProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewContainerRegistryResource,
 		NewDNSComplianceChecksResource,
 		NewDNSDomainResource,
+		NewDNSLBHealthCheckResource,
+		NewDNSLBPoolResource,
+		NewDNSLoadBalancerResource,
+		NewDNSZoneResource,
 		NewDataGroupResource,
 		NewDataTypeResource,
 		NewDcClusterGroupResource,
@@ -264,10 +403,27 @@ func (p *F5XCProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewGlobalLogReceiverResource,
 		NewHTTPLoadBalancerResource,
 		NewHealthcheckResource,
+		NewIKEPhase1ProfileResource,
+		NewIKEPhase2ProfileResource,
 		NewIPPrefixSetResource,
+		NewIke1Resource,
+		NewIke2Resource,
+		NewInfraprotectAsnPrefixResource,
+		NewInfraprotectAsnResource,
+		NewInfraprotectDenyListRuleResource,
+		NewInfraprotectFirewallRuleGroupResource,
+		NewInfraprotectFirewallRuleResource,
+		NewInfraprotectInternetPrefixAdvertisementActivationResource,
+		NewInfraprotectInternetPrefixAdvertisementResource,
+		NewInfraprotectTunnelResource,
 		NewIruleResource,
+		NewK8SClusterRoleBindingResource,
+		NewK8SClusterRoleResource,
+		NewK8SPodSecurityAdmissionResource,
+		NewK8SPodSecurityPolicyResource,
 		NewLogReceiverResource,
 		NewMaliciousUserMitigationResource,
+		NewManagedTenantResource,
 		NewNATPolicyResource,
 		NewNamespaceResource,
 		NewNetworkConnectorResource,
@@ -278,6 +434,7 @@ func (p *F5XCProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewNetworkPolicyViewResource,
 		NewNfvServiceResource,
 		NewNginxServiceDiscoveryResource,
+		NewOIDCProviderResource,
 		NewOriginPoolResource,
 		NewPolicerResource,
 		NewPolicyBasedRoutingResource,
@@ -286,24 +443,31 @@ func (p *F5XCProvider) Resources(ctx context.Context) []func() resource.Resource
 		NewProxyResource,
 		NewRateLimiterPolicyResource,
 		NewRateLimiterResource,
+		NewRoleResource,
 		NewRouteResource,
 		NewSecretManagementAccessResource,
 		NewSecuremeshSiteResource,
 		NewSegmentResource,
 		NewSensitiveDataPolicyResource,
+		NewServiceCredentialResource,
 		NewServicePolicyResource,
 		NewServicePolicyRuleResource,
+		NewSiteDeploymentResource,
 		NewSiteMeshGroupResource,
 		NewSiteResource,
+		NewSrv6NetworkSliceResource,
 		NewSubnetResource,
 		NewTCPLoadBalancerResource,
 		NewTenantConfigurationResource,
+		NewTenantProfileResource,
+		NewTokenResource,
 		NewTrustedCAListResource,
 		NewTunnelResource,
 		NewUDPLoadBalancerResource,
 		NewUsbPolicyResource,
 		NewUserIdentificationResource,
 		NewVirtualHostResource,
+		NewVirtualK8SResource,
 		NewVirtualNetworkResource,
 		NewVirtualSiteResource,
 		NewVoltstackSiteResource,
@@ -316,104 +480,263 @@ func (p *F5XCProvider) Resources(ctx context.Context) []func() resource.Resource
 func (p *F5XCProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAPICrawlerDataSource,
+		NewAPICrawlersDataSource,
 		NewAPIDefinitionDataSource,
+		NewAPIDefinitionsDataSource,
+		NewAPIDiscoveriesDataSource,
 		NewAPIDiscoveryDataSource,
 		NewAPITestingDataSource,
+		NewAPITestingsDataSource,
 		NewAPMDataSource,
+		NewAPMsDataSource,
 		NewAWSTGWSiteDataSource,
+		NewAWSTGWSitesDataSource,
 		NewAWSVPCSiteDataSource,
+		NewAWSVPCSitesDataSource,
+		NewAddonSubscriptionDataSource,
+		NewAddonSubscriptionsDataSource,
 		NewAddressAllocatorDataSource,
+		NewAddressAllocatorsDataSource,
+		NewAdvertisePoliciesDataSource,
 		NewAdvertisePolicyDataSource,
+		NewAlertPoliciesDataSource,
 		NewAlertPolicyDataSource,
 		NewAlertReceiverDataSource,
+		NewAlertReceiversDataSource,
+		NewAllowedTenantDataSource,
+		NewAllowedTenantsDataSource,
 		NewAppAPIGroupDataSource,
+		NewAppAPIGroupsDataSource,
 		NewAppFirewallDataSource,
+		NewAppFirewallsDataSource,
 		NewAppSettingDataSource,
+		NewAppSettingsDataSource,
 		NewAppTypeDataSource,
+		NewAppTypesDataSource,
 		NewAuthenticationDataSource,
+		NewAuthenticationsDataSource,
 		NewAzureVNETSiteDataSource,
+		NewAzureVNETSitesDataSource,
 		NewBGPAsnSetDataSource,
+		NewBGPAsnSetsDataSource,
 		NewBGPDataSource,
+		NewBGPRoutingPoliciesDataSource,
 		NewBGPRoutingPolicyDataSource,
+		NewBGPsDataSource,
 		NewBotDefenseAppInfrastructureDataSource,
+		NewBotDefenseAppInfrastructuresDataSource,
 		NewCDNCacheRuleDataSource,
+		NewCDNCacheRulesDataSource,
 		NewCDNLoadBalancerDataSource,
+		NewCDNLoadBalancersDataSource,
 		NewCRLDataSource,
+		NewCRLsDataSource,
 		NewCertificateChainDataSource,
+		NewCertificateChainsDataSource,
 		NewCertificateDataSource,
+		NewCertificatesDataSource,
+		NewChildTenantDataSource,
+		NewChildTenantManagerDataSource,
+		NewChildTenantManagersDataSource,
+		NewChildTenantsDataSource,
 		NewCloudConnectDataSource,
+		NewCloudConnectsDataSource,
 		NewCloudCredentialsDataSource,
+		NewCloudCredentialsListDataSource,
 		NewCloudElasticIPDataSource,
+		NewCloudElasticIPsDataSource,
 		NewCloudLinkDataSource,
+		NewCloudLinksDataSource,
 		NewClusterDataSource,
+		NewClustersDataSource,
 		NewCminstanceDataSource,
+		NewCminstancesDataSource,
 		NewCodeBaseIntegrationDataSource,
+		NewCodeBaseIntegrationsDataSource,
+		NewContainerRegistriesDataSource,
 		NewContainerRegistryDataSource,
 		NewDNSComplianceChecksDataSource,
+		NewDNSComplianceChecksListDataSource,
 		NewDNSDomainDataSource,
+		NewDNSDomainsDataSource,
+		NewDNSLBHealthCheckDataSource,
+		NewDNSLBHealthChecksDataSource,
+		NewDNSLBPoolDataSource,
+		NewDNSLBPoolsDataSource,
+		NewDNSLoadBalancerDataSource,
+		NewDNSLoadBalancersDataSource,
+		NewDNSZoneDataSource,
+		NewDNSZonesDataSource,
 		NewDataGroupDataSource,
+		NewDataGroupsDataSource,
 		NewDataTypeDataSource,
+		NewDataTypesDataSource,
 		NewDcClusterGroupDataSource,
+		NewDcClusterGroupsDataSource,
+		NewDiscoveriesDataSource,
 		NewDiscoveryDataSource,
 		NewEndpointDataSource,
+		NewEndpointsDataSource,
+		NewEnhancedFirewallPoliciesDataSource,
 		NewEnhancedFirewallPolicyDataSource,
 		NewExternalConnectorDataSource,
+		NewExternalConnectorsDataSource,
 		NewFastACLDataSource,
 		NewFastACLRuleDataSource,
+		NewFastACLRulesDataSource,
+		NewFastACLsDataSource,
 		NewFilterSetDataSource,
+		NewFilterSetsDataSource,
 		NewFleetDataSource,
+		NewFleetsDataSource,
+		NewForwardProxyPoliciesDataSource,
 		NewForwardProxyPolicyDataSource,
 		NewForwardingClassDataSource,
+		NewForwardingClassesDataSource,
 		NewGCPVPCSiteDataSource,
+		NewGCPVPCSitesDataSource,
 		NewGlobalLogReceiverDataSource,
+		NewGlobalLogReceiversDataSource,
 		NewHTTPLoadBalancerDataSource,
+		NewHTTPLoadBalancersDataSource,
 		NewHealthcheckDataSource,
+		NewHealthchecksDataSource,
+		NewIKEPhase1ProfileDataSource,
+		NewIKEPhase1ProfilesDataSource,
+		NewIKEPhase2ProfileDataSource,
+		NewIKEPhase2ProfilesDataSource,
 		NewIPPrefixSetDataSource,
+		NewIPPrefixSetsDataSource,
+		NewIke1DataSource,
+		NewIke1sDataSource,
+		NewIke2DataSource,
+		NewIke2sDataSource,
+		NewInfraprotectAsnDataSource,
+		NewInfraprotectAsnPrefixDataSource,
+		NewInfraprotectAsnPrefixesDataSource,
+		NewInfraprotectAsnsDataSource,
+		NewInfraprotectDenyListRuleDataSource,
+		NewInfraprotectDenyListRulesDataSource,
+		NewInfraprotectFirewallRuleDataSource,
+		NewInfraprotectFirewallRuleGroupDataSource,
+		NewInfraprotectFirewallRuleGroupsDataSource,
+		NewInfraprotectFirewallRulesDataSource,
+		NewInfraprotectInternetPrefixAdvertisementDataSource,
+		NewInfraprotectInternetPrefixAdvertisementsDataSource,
+		NewInfraprotectTunnelDataSource,
+		NewInfraprotectTunnelsDataSource,
 		NewIruleDataSource,
+		NewIrulesDataSource,
+		NewK8SClusterRoleBindingDataSource,
+		NewK8SClusterRoleBindingsDataSource,
+		NewK8SClusterRoleDataSource,
+		NewK8SClusterRolesDataSource,
+		NewK8SPodSecurityAdmissionDataSource,
+		NewK8SPodSecurityAdmissionsDataSource,
+		NewK8SPodSecurityPoliciesDataSource,
+		NewK8SPodSecurityPolicyDataSource,
 		NewLogReceiverDataSource,
+		NewLogReceiversDataSource,
 		NewMaliciousUserMitigationDataSource,
+		NewMaliciousUserMitigationsDataSource,
+		NewManagedTenantDataSource,
+		NewManagedTenantsDataSource,
+		NewNATPoliciesDataSource,
 		NewNATPolicyDataSource,
 		NewNamespaceDataSource,
+		NewNamespacesDataSource,
 		NewNetworkConnectorDataSource,
+		NewNetworkConnectorsDataSource,
 		NewNetworkFirewallDataSource,
+		NewNetworkFirewallsDataSource,
 		NewNetworkInterfaceDataSource,
+		NewNetworkInterfacesDataSource,
+		NewNetworkPoliciesDataSource,
 		NewNetworkPolicyDataSource,
 		NewNetworkPolicyRuleDataSource,
+		NewNetworkPolicyRulesDataSource,
 		NewNetworkPolicyViewDataSource,
+		NewNetworkPolicyViewsDataSource,
 		NewNfvServiceDataSource,
+		NewNfvServicesDataSource,
+		NewNginxServiceDiscoveriesDataSource,
 		NewNginxServiceDiscoveryDataSource,
+		NewOIDCProviderDataSource,
+		NewOIDCProvidersDataSource,
 		NewOriginPoolDataSource,
+		NewOriginPoolsDataSource,
 		NewPolicerDataSource,
+		NewPolicersDataSource,
 		NewPolicyBasedRoutingDataSource,
+		NewPolicyBasedRoutingsDataSource,
 		NewProtocolInspectionDataSource,
+		NewProtocolInspectionsDataSource,
 		NewProtocolPolicerDataSource,
+		NewProtocolPolicersDataSource,
+		NewProxiesDataSource,
 		NewProxyDataSource,
 		NewRateLimiterDataSource,
+		NewRateLimiterPoliciesDataSource,
 		NewRateLimiterPolicyDataSource,
+		NewRateLimitersDataSource,
+		NewRoleDataSource,
+		NewRolesDataSource,
 		NewRouteDataSource,
+		NewRoutesDataSource,
 		NewSecretManagementAccessDataSource,
+		NewSecretManagementAccessesDataSource,
 		NewSecuremeshSiteDataSource,
+		NewSecuremeshSitesDataSource,
 		NewSegmentDataSource,
+		NewSegmentsDataSource,
+		NewSensitiveDataPoliciesDataSource,
 		NewSensitiveDataPolicyDataSource,
+		NewServicePoliciesDataSource,
 		NewServicePolicyDataSource,
 		NewServicePolicyRuleDataSource,
+		NewServicePolicyRulesDataSource,
 		NewSiteDataSource,
 		NewSiteMeshGroupDataSource,
+		NewSiteMeshGroupsDataSource,
+		NewSitesDataSource,
+		NewSrv6NetworkSliceDataSource,
+		NewSrv6NetworkSlicesDataSource,
 		NewSubnetDataSource,
+		NewSubnetsDataSource,
 		NewTCPLoadBalancerDataSource,
+		NewTCPLoadBalancersDataSource,
 		NewTenantConfigurationDataSource,
+		NewTenantConfigurationsDataSource,
+		NewTenantProfileDataSource,
+		NewTenantProfilesDataSource,
+		NewTokenDataSource,
+		NewTokensDataSource,
 		NewTrustedCAListDataSource,
+		NewTrustedCAListsDataSource,
 		NewTunnelDataSource,
+		NewTunnelsDataSource,
 		NewUDPLoadBalancerDataSource,
+		NewUDPLoadBalancersDataSource,
+		NewUsbPoliciesDataSource,
 		NewUsbPolicyDataSource,
 		NewUserIdentificationDataSource,
+		NewUserIdentificationsDataSource,
 		NewVirtualHostDataSource,
+		NewVirtualHostsDataSource,
+		NewVirtualK8SDataSource,
+		NewVirtualK8SListDataSource,
 		NewVirtualNetworkDataSource,
+		NewVirtualNetworksDataSource,
 		NewVirtualSiteDataSource,
+		NewVirtualSitesDataSource,
 		NewVoltstackSiteDataSource,
+		NewVoltstackSitesDataSource,
+		NewWAFExclusionPoliciesDataSource,
 		NewWAFExclusionPolicyDataSource,
 		NewWorkloadDataSource,
 		NewWorkloadFlavorDataSource,
+		NewWorkloadFlavorsDataSource,
+		NewWorkloadsDataSource,
 	}
 }
 
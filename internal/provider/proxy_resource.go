@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -21,17 +23,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &ProxyResource{}
-	_ resource.ResourceWithConfigure      = &ProxyResource{}
-	_ resource.ResourceWithImportState    = &ProxyResource{}
-	_ resource.ResourceWithModifyPlan     = &ProxyResource{}
-	_ resource.ResourceWithValidateConfig = &ProxyResource{}
+	_ resource.Resource                     = &ProxyResource{}
+	_ resource.ResourceWithConfigure        = &ProxyResource{}
+	_ resource.ResourceWithImportState      = &ProxyResource{}
+	_ resource.ResourceWithIdentity         = &ProxyResource{}
+	_ resource.ResourceWithMoveState        = &ProxyResource{}
+	_ resource.ResourceWithModifyPlan       = &ProxyResource{}
+	_ resource.ResourceWithValidateConfig   = &ProxyResource{}
+	_ resource.ResourceWithConfigValidators = &ProxyResource{}
+	_ listableResource                      = &ProxyResource{}
 )
 
 func NewProxyResource() resource.Resource {
@@ -212,14 +219,16 @@ var ProxyDynamicProxyHTTPProxyMoreOptionRequestCookiesToAddSecretValueBlindfoldS
 
 // ProxyDynamicProxyHTTPProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type ProxyDynamicProxyHTTPProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyDynamicProxyHTTPProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for ProxyDynamicProxyHTTPProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModel
 var ProxyDynamicProxyHTTPProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyDynamicProxyHTTPProxyMoreOptionRequestHeadersToAddModel represents request_headers_to_add block
@@ -266,14 +275,16 @@ var ProxyDynamicProxyHTTPProxyMoreOptionRequestHeadersToAddSecretValueBlindfoldS
 
 // ProxyDynamicProxyHTTPProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type ProxyDynamicProxyHTTPProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyDynamicProxyHTTPProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for ProxyDynamicProxyHTTPProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModel
 var ProxyDynamicProxyHTTPProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyDynamicProxyHTTPProxyMoreOptionResponseCookiesToAddModel represents response_cookies_to_add block
@@ -358,14 +369,16 @@ var ProxyDynamicProxyHTTPProxyMoreOptionResponseCookiesToAddSecretValueBlindfold
 
 // ProxyDynamicProxyHTTPProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type ProxyDynamicProxyHTTPProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyDynamicProxyHTTPProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for ProxyDynamicProxyHTTPProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModel
 var ProxyDynamicProxyHTTPProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyDynamicProxyHTTPProxyMoreOptionResponseHeadersToAddModel represents response_headers_to_add block
@@ -412,14 +425,16 @@ var ProxyDynamicProxyHTTPProxyMoreOptionResponseHeadersToAddSecretValueBlindfold
 
 // ProxyDynamicProxyHTTPProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type ProxyDynamicProxyHTTPProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyDynamicProxyHTTPProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for ProxyDynamicProxyHTTPProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModel
 var ProxyDynamicProxyHTTPProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyDynamicProxyHTTPSProxyModel represents https_proxy block
@@ -546,14 +561,16 @@ var ProxyDynamicProxyHTTPSProxyMoreOptionRequestCookiesToAddSecretValueBlindfold
 
 // ProxyDynamicProxyHTTPSProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type ProxyDynamicProxyHTTPSProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyDynamicProxyHTTPSProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for ProxyDynamicProxyHTTPSProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModel
 var ProxyDynamicProxyHTTPSProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyDynamicProxyHTTPSProxyMoreOptionRequestHeadersToAddModel represents request_headers_to_add block
@@ -600,14 +617,16 @@ var ProxyDynamicProxyHTTPSProxyMoreOptionRequestHeadersToAddSecretValueBlindfold
 
 // ProxyDynamicProxyHTTPSProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type ProxyDynamicProxyHTTPSProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyDynamicProxyHTTPSProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for ProxyDynamicProxyHTTPSProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModel
 var ProxyDynamicProxyHTTPSProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyDynamicProxyHTTPSProxyMoreOptionResponseCookiesToAddModel represents response_cookies_to_add block
@@ -692,14 +711,16 @@ var ProxyDynamicProxyHTTPSProxyMoreOptionResponseCookiesToAddSecretValueBlindfol
 
 // ProxyDynamicProxyHTTPSProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type ProxyDynamicProxyHTTPSProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyDynamicProxyHTTPSProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for ProxyDynamicProxyHTTPSProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModel
 var ProxyDynamicProxyHTTPSProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyDynamicProxyHTTPSProxyMoreOptionResponseHeadersToAddModel represents response_headers_to_add block
@@ -746,14 +767,16 @@ var ProxyDynamicProxyHTTPSProxyMoreOptionResponseHeadersToAddSecretValueBlindfol
 
 // ProxyDynamicProxyHTTPSProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type ProxyDynamicProxyHTTPSProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyDynamicProxyHTTPSProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for ProxyDynamicProxyHTTPSProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModel
 var ProxyDynamicProxyHTTPSProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyDynamicProxyHTTPSProxyTLSParamsModel represents tls_params block
@@ -830,14 +853,16 @@ var ProxyDynamicProxyHTTPSProxyTLSParamsTLSCertificatesPrivateKeyBlindfoldSecret
 
 // ProxyDynamicProxyHTTPSProxyTLSParamsTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type ProxyDynamicProxyHTTPSProxyTLSParamsTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyDynamicProxyHTTPSProxyTLSParamsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for ProxyDynamicProxyHTTPSProxyTLSParamsTLSCertificatesPrivateKeyClearSecretInfoModel
 var ProxyDynamicProxyHTTPSProxyTLSParamsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyDynamicProxyHTTPSProxyTLSParamsTLSConfigModel represents tls_config block
@@ -1064,14 +1089,16 @@ var ProxyHTTPProxyMoreOptionRequestCookiesToAddSecretValueBlindfoldSecretInfoMod
 
 // ProxyHTTPProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type ProxyHTTPProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyHTTPProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for ProxyHTTPProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModel
 var ProxyHTTPProxyMoreOptionRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyHTTPProxyMoreOptionRequestHeadersToAddModel represents request_headers_to_add block
@@ -1118,14 +1145,16 @@ var ProxyHTTPProxyMoreOptionRequestHeadersToAddSecretValueBlindfoldSecretInfoMod
 
 // ProxyHTTPProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type ProxyHTTPProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyHTTPProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for ProxyHTTPProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModel
 var ProxyHTTPProxyMoreOptionRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyHTTPProxyMoreOptionResponseCookiesToAddModel represents response_cookies_to_add block
@@ -1210,14 +1239,16 @@ var ProxyHTTPProxyMoreOptionResponseCookiesToAddSecretValueBlindfoldSecretInfoMo
 
 // ProxyHTTPProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type ProxyHTTPProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyHTTPProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for ProxyHTTPProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModel
 var ProxyHTTPProxyMoreOptionResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyHTTPProxyMoreOptionResponseHeadersToAddModel represents response_headers_to_add block
@@ -1264,14 +1295,16 @@ var ProxyHTTPProxyMoreOptionResponseHeadersToAddSecretValueBlindfoldSecretInfoMo
 
 // ProxyHTTPProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type ProxyHTTPProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyHTTPProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for ProxyHTTPProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModel
 var ProxyHTTPProxyMoreOptionResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxySiteVirtualSitesModel represents site_virtual_sites block
@@ -1432,14 +1465,16 @@ var ProxyTLSInterceptCustomCertificatePrivateKeyBlindfoldSecretInfoModelAttrType
 
 // ProxyTLSInterceptCustomCertificatePrivateKeyClearSecretInfoModel represents clear_secret_info block
 type ProxyTLSInterceptCustomCertificatePrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // ProxyTLSInterceptCustomCertificatePrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for ProxyTLSInterceptCustomCertificatePrivateKeyClearSecretInfoModel
 var ProxyTLSInterceptCustomCertificatePrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // ProxyTLSInterceptPolicyModel represents policy block
@@ -1490,6 +1525,8 @@ type ProxyResourceModel struct {
 	ID                         types.String                          `tfsdk:"id"`
 	ConnectionTimeout          types.Int64                           `tfsdk:"connection_timeout"`
 	Timeouts                   timeouts.Value                        `tfsdk:"timeouts"`
+	SystemMetadata             types.Object                          `tfsdk:"system_metadata"`
+	LabelsAll                  types.Map                             `tfsdk:"labels_all"`
 	ActiveForwardProxyPolicies *ProxyActiveForwardProxyPoliciesModel `tfsdk:"active_forward_proxy_policies"`
 	DoNotAdvertise             *ProxyEmptyModel                      `tfsdk:"do_not_advertise"`
 	DynamicProxy               *ProxyDynamicProxyModel               `tfsdk:"dynamic_proxy"`
@@ -1521,9 +1558,11 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Proxy will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Proxy will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -1562,7 +1601,12 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(1800000),
+				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -1577,11 +1621,18 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Blocks: map[string]schema.Block{
 					"forward_proxy_policies": schema.ListNestedBlock{
 						MarkdownDescription: "Ordered List of Forward Proxy Policies active .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(128),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1590,6 +1641,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1598,6 +1652,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -1614,6 +1671,12 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						MarkdownDescription: "List of Domains to be proxied. Wildcard hosts are supported in the suffix or prefix form Supported Domains and search order: 1. Exact Domain names: www.example.com. 2.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1)),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1637,30 +1700,56 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									"idle_timeout": schema.Int64Attribute{
 										MarkdownDescription: "The amount of time that a stream can exist without upstream or downstream activity, in milliseconds. The stream is terminated with a HTTP 504 (Gateway Timeout) error code if no upstream response header has been received, otherwise the stream is reset.",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(3600000),
+										},
 									},
 									"max_request_header_size": schema.Int64Attribute{
 										MarkdownDescription: "The maximum request header size for downstream connections, in KiB. A HTTP 431 (Request Header Fields Too Large) error code is sent for requests that exceed this size. If multiple load balancers share the same advertise_policy, the highest value configured across all such load balancers is used..",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(96),
+										},
 									},
 									"request_cookies_to_remove": schema.ListAttribute{
 										MarkdownDescription: "List of keys of Cookies to be removed from the HTTP request being sent towards upstream.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+										},
 									},
 									"request_headers_to_remove": schema.ListAttribute{
 										MarkdownDescription: "List of keys of Headers to be removed from the HTTP request being sent towards upstream.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+										},
 									},
 									"response_cookies_to_remove": schema.ListAttribute{
 										MarkdownDescription: "List of name of Cookies to be removed from the HTTP response being sent towards downstream. Entire set-cookie header will be removed.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+										},
 									},
 									"response_headers_to_remove": schema.ListAttribute{
 										MarkdownDescription: "List of keys of Headers to be removed from the HTTP response being sent towards downstream.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1674,6 +1763,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											"max_request_bytes": schema.Int64Attribute{
 												MarkdownDescription: "The maximum request size that the filter will buffer before the connection manager will stop buffering and return a RequestEntityTooLarge (413) response.",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtMost(10485760),
+												},
 											},
 										},
 									},
@@ -1683,11 +1775,19 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											"content_length": schema.Int64Attribute{
 												MarkdownDescription: "Minimum response length, in bytes, which will trigger compression. The. Defaults to `30`.",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtLeast(30),
+												},
 											},
 											"content_type": schema.ListAttribute{
 												MarkdownDescription: "Set of strings that allows specifying which mime-types yield compression When this field is not defined, compression will be applied to the following mime-types: 'application/javascript' 'application/JSON', 'application/xhtml+XML' 'image/svg+XML' 'text/CSS' 'text/HTML' 'text/plain' 'text/XML'.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(50),
+													listvalidator.UniqueValues(),
+													listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+												},
 											},
 											"disable_on_etag_header": schema.BoolAttribute{
 												MarkdownDescription: "If true, disables compression when the response contains an etag header. When it is false, weak etags will be preserved and the ones that require strong validation will be removed.",
@@ -1710,11 +1810,17 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									},
 									"request_cookies_to_add": schema.ListNestedBlock{
 										MarkdownDescription: "Cookies are key-value pairs to be added to HTTP request being routed towards upstream. Cookies specified at this level are applied after cookies from matched Route are applied.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the cookie in Cookie header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"overwrite": schema.BoolAttribute{
 													MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -1723,6 +1829,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"value": schema.StringAttribute{
 													MarkdownDescription: "Value of the Cookie header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(8096),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -1755,7 +1864,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -1767,6 +1885,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									},
 									"request_headers_to_add": schema.ListNestedBlock{
 										MarkdownDescription: "Headers are key-value pairs to be added to HTTP request being routed towards upstream. Headers specified at this level are applied after headers from matched Route are applied.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"append": schema.BoolAttribute{
@@ -1776,10 +1897,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name. Name of the HTTP header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"value": schema.StringAttribute{
 													MarkdownDescription: "Value of the HTTP header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(8096),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -1812,7 +1939,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -1824,27 +1960,47 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									},
 									"response_cookies_to_add": schema.ListNestedBlock{
 										MarkdownDescription: "Cookies are name-value pairs along with optional attribute parameters to be added to HTTP response being sent towards downstream. Cookies specified at this level are applied after cookies from matched Route are applied.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"add_domain": schema.StringAttribute{
 													MarkdownDescription: "Add domain attribute.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"add_expiry": schema.StringAttribute{
 													MarkdownDescription: "Add expiry attribute.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"add_path": schema.StringAttribute{
 													MarkdownDescription: "Add path attribute.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"max_age_value": schema.Int64Attribute{
 													MarkdownDescription: "Add max age attribute.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtMost(34560000),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the cookie in Cookie header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"overwrite": schema.BoolAttribute{
 													MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -1853,6 +2009,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"value": schema.StringAttribute{
 													MarkdownDescription: "Value of the Cookie header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(8096),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -1930,7 +2089,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -1942,6 +2110,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									},
 									"response_headers_to_add": schema.ListNestedBlock{
 										MarkdownDescription: "Headers are key-value pairs to be added to HTTP response being sent towards downstream. Headers specified at this level are applied after headers from matched Route are applied.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"append": schema.BoolAttribute{
@@ -1951,10 +2122,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name. Name of the HTTP header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"value": schema.StringAttribute{
 													MarkdownDescription: "Value of the HTTP header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(8096),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -1987,7 +2164,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -2015,30 +2201,56 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									"idle_timeout": schema.Int64Attribute{
 										MarkdownDescription: "The amount of time that a stream can exist without upstream or downstream activity, in milliseconds. The stream is terminated with a HTTP 504 (Gateway Timeout) error code if no upstream response header has been received, otherwise the stream is reset.",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(3600000),
+										},
 									},
 									"max_request_header_size": schema.Int64Attribute{
 										MarkdownDescription: "The maximum request header size for downstream connections, in KiB. A HTTP 431 (Request Header Fields Too Large) error code is sent for requests that exceed this size. If multiple load balancers share the same advertise_policy, the highest value configured across all such load balancers is used..",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(96),
+										},
 									},
 									"request_cookies_to_remove": schema.ListAttribute{
 										MarkdownDescription: "List of keys of Cookies to be removed from the HTTP request being sent towards upstream.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+										},
 									},
 									"request_headers_to_remove": schema.ListAttribute{
 										MarkdownDescription: "List of keys of Headers to be removed from the HTTP request being sent towards upstream.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+										},
 									},
 									"response_cookies_to_remove": schema.ListAttribute{
 										MarkdownDescription: "List of name of Cookies to be removed from the HTTP response being sent towards downstream. Entire set-cookie header will be removed.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+										},
 									},
 									"response_headers_to_remove": schema.ListAttribute{
 										MarkdownDescription: "List of keys of Headers to be removed from the HTTP response being sent towards downstream.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -2052,6 +2264,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											"max_request_bytes": schema.Int64Attribute{
 												MarkdownDescription: "The maximum request size that the filter will buffer before the connection manager will stop buffering and return a RequestEntityTooLarge (413) response.",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtMost(10485760),
+												},
 											},
 										},
 									},
@@ -2061,11 +2276,19 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											"content_length": schema.Int64Attribute{
 												MarkdownDescription: "Minimum response length, in bytes, which will trigger compression. The. Defaults to `30`.",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtLeast(30),
+												},
 											},
 											"content_type": schema.ListAttribute{
 												MarkdownDescription: "Set of strings that allows specifying which mime-types yield compression When this field is not defined, compression will be applied to the following mime-types: 'application/javascript' 'application/JSON', 'application/xhtml+XML' 'image/svg+XML' 'text/CSS' 'text/HTML' 'text/plain' 'text/XML'.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(50),
+													listvalidator.UniqueValues(),
+													listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+												},
 											},
 											"disable_on_etag_header": schema.BoolAttribute{
 												MarkdownDescription: "If true, disables compression when the response contains an etag header. When it is false, weak etags will be preserved and the ones that require strong validation will be removed.",
@@ -2088,11 +2311,17 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									},
 									"request_cookies_to_add": schema.ListNestedBlock{
 										MarkdownDescription: "Cookies are key-value pairs to be added to HTTP request being routed towards upstream. Cookies specified at this level are applied after cookies from matched Route are applied.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the cookie in Cookie header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"overwrite": schema.BoolAttribute{
 													MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -2101,6 +2330,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"value": schema.StringAttribute{
 													MarkdownDescription: "Value of the Cookie header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(8096),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -2133,7 +2365,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -2145,6 +2386,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									},
 									"request_headers_to_add": schema.ListNestedBlock{
 										MarkdownDescription: "Headers are key-value pairs to be added to HTTP request being routed towards upstream. Headers specified at this level are applied after headers from matched Route are applied.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"append": schema.BoolAttribute{
@@ -2154,10 +2398,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name. Name of the HTTP header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"value": schema.StringAttribute{
 													MarkdownDescription: "Value of the HTTP header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(8096),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -2190,7 +2440,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -2202,27 +2461,47 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									},
 									"response_cookies_to_add": schema.ListNestedBlock{
 										MarkdownDescription: "Cookies are name-value pairs along with optional attribute parameters to be added to HTTP response being sent towards downstream. Cookies specified at this level are applied after cookies from matched Route are applied.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"add_domain": schema.StringAttribute{
 													MarkdownDescription: "Add domain attribute.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"add_expiry": schema.StringAttribute{
 													MarkdownDescription: "Add expiry attribute.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"add_path": schema.StringAttribute{
 													MarkdownDescription: "Add path attribute.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"max_age_value": schema.Int64Attribute{
 													MarkdownDescription: "Add max age attribute.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtMost(34560000),
+													},
 												},
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name of the cookie in Cookie header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"overwrite": schema.BoolAttribute{
 													MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -2231,6 +2510,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"value": schema.StringAttribute{
 													MarkdownDescription: "Value of the Cookie header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(8096),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -2308,7 +2590,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -2320,6 +2611,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									},
 									"response_headers_to_add": schema.ListNestedBlock{
 										MarkdownDescription: "Headers are key-value pairs to be added to HTTP response being sent towards downstream. Headers specified at this level are applied after headers from matched Route are applied.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(32),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"append": schema.BoolAttribute{
@@ -2329,10 +2623,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name. Name of the HTTP header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+													},
 												},
 												"value": schema.StringAttribute{
 													MarkdownDescription: "Value of the HTTP header.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(8096),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -2365,7 +2665,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -2386,11 +2695,18 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									},
 									"tls_certificates": schema.ListNestedBlock{
 										MarkdownDescription: "Users can add one or more certificates that share the same set of domains. For example, domain.com and *.domain.com - but use different signature algorithms .",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(16),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"certificate_url": schema.StringAttribute{
 													MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(131072),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Description for the certificate.",
@@ -2405,6 +2721,11 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 															MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -2440,7 +2761,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -2464,6 +2794,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 														Optional:            true,
 														ElementType:         types.StringType,
+														Validators: []validator.List{
+															listvalidator.UniqueValues(),
+														},
 													},
 													"max_version": schema.StringAttribute{
 														MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -2496,6 +2829,10 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											"trusted_ca_url": schema.StringAttribute{
 												MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
@@ -2505,6 +2842,10 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													"name": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(128),
+															stringvalidator.LengthAtLeast(1),
+														},
 													},
 													"namespace": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2513,6 +2854,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 													"tenant": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2521,6 +2865,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 												},
 											},
@@ -2533,6 +2880,10 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													"name": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(128),
+															stringvalidator.LengthAtLeast(1),
+														},
 													},
 													"namespace": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2541,6 +2892,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 													"tenant": schema.StringAttribute{
 														MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2549,6 +2903,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														PlanModifiers: []planmodifier.String{
 															stringplanmodifier.UseStateForUnknown(),
 														},
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(64),
+														},
 													},
 												},
 											},
@@ -2577,6 +2934,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							"idle_timeout": schema.Int64Attribute{
 								MarkdownDescription: "The amount of time that a stream can exist without upstream or downstream activity, in milliseconds.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(86400000),
+								},
 							},
 						},
 					},
@@ -2599,30 +2959,56 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							"idle_timeout": schema.Int64Attribute{
 								MarkdownDescription: "The amount of time that a stream can exist without upstream or downstream activity, in milliseconds. The stream is terminated with a HTTP 504 (Gateway Timeout) error code if no upstream response header has been received, otherwise the stream is reset.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(3600000),
+								},
 							},
 							"max_request_header_size": schema.Int64Attribute{
 								MarkdownDescription: "The maximum request header size for downstream connections, in KiB. A HTTP 431 (Request Header Fields Too Large) error code is sent for requests that exceed this size. If multiple load balancers share the same advertise_policy, the highest value configured across all such load balancers is used..",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(96),
+								},
 							},
 							"request_cookies_to_remove": schema.ListAttribute{
 								MarkdownDescription: "List of keys of Cookies to be removed from the HTTP request being sent towards upstream.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+								},
 							},
 							"request_headers_to_remove": schema.ListAttribute{
 								MarkdownDescription: "List of keys of Headers to be removed from the HTTP request being sent towards upstream.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+								},
 							},
 							"response_cookies_to_remove": schema.ListAttribute{
 								MarkdownDescription: "List of name of Cookies to be removed from the HTTP response being sent towards downstream. Entire set-cookie header will be removed.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+								},
 							},
 							"response_headers_to_remove": schema.ListAttribute{
 								MarkdownDescription: "List of keys of Headers to be removed from the HTTP response being sent towards downstream.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -2636,6 +3022,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									"max_request_bytes": schema.Int64Attribute{
 										MarkdownDescription: "The maximum request size that the filter will buffer before the connection manager will stop buffering and return a RequestEntityTooLarge (413) response.",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(10485760),
+										},
 									},
 								},
 							},
@@ -2645,11 +3034,19 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									"content_length": schema.Int64Attribute{
 										MarkdownDescription: "Minimum response length, in bytes, which will trigger compression. The. Defaults to `30`.",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(30),
+										},
 									},
 									"content_type": schema.ListAttribute{
 										MarkdownDescription: "Set of strings that allows specifying which mime-types yield compression When this field is not defined, compression will be applied to the following mime-types: 'application/javascript' 'application/JSON', 'application/xhtml+XML' 'image/svg+XML' 'text/CSS' 'text/HTML' 'text/plain' 'text/XML'.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(50),
+											listvalidator.UniqueValues(),
+											listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+										},
 									},
 									"disable_on_etag_header": schema.BoolAttribute{
 										MarkdownDescription: "If true, disables compression when the response contains an etag header. When it is false, weak etags will be preserved and the ones that require strong validation will be removed.",
@@ -2672,11 +3069,17 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							},
 							"request_cookies_to_add": schema.ListNestedBlock{
 								MarkdownDescription: "Cookies are key-value pairs to be added to HTTP request being routed towards upstream. Cookies specified at this level are applied after cookies from matched Route are applied.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the cookie in Cookie header.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"overwrite": schema.BoolAttribute{
 											MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -2685,6 +3088,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"value": schema.StringAttribute{
 											MarkdownDescription: "Value of the Cookie header.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(8096),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -2717,7 +3123,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -2729,6 +3144,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							},
 							"request_headers_to_add": schema.ListNestedBlock{
 								MarkdownDescription: "Headers are key-value pairs to be added to HTTP request being routed towards upstream. Headers specified at this level are applied after headers from matched Route are applied.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"append": schema.BoolAttribute{
@@ -2738,10 +3156,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name. Name of the HTTP header.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"value": schema.StringAttribute{
 											MarkdownDescription: "Value of the HTTP header.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(8096),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -2774,7 +3198,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -2786,27 +3219,47 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							},
 							"response_cookies_to_add": schema.ListNestedBlock{
 								MarkdownDescription: "Cookies are name-value pairs along with optional attribute parameters to be added to HTTP response being sent towards downstream. Cookies specified at this level are applied after cookies from matched Route are applied.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"add_domain": schema.StringAttribute{
 											MarkdownDescription: "Add domain attribute.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.HostnameValidator(),
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 										"add_expiry": schema.StringAttribute{
 											MarkdownDescription: "Add expiry attribute.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"add_path": schema.StringAttribute{
 											MarkdownDescription: "Add path attribute.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"max_age_value": schema.Int64Attribute{
 											MarkdownDescription: "Add max age attribute.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtMost(34560000),
+											},
 										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the cookie in Cookie header.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"overwrite": schema.BoolAttribute{
 											MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -2815,6 +3268,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"value": schema.StringAttribute{
 											MarkdownDescription: "Value of the Cookie header.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(8096),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -2892,7 +3348,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -2904,6 +3369,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							},
 							"response_headers_to_add": schema.ListNestedBlock{
 								MarkdownDescription: "Headers are key-value pairs to be added to HTTP response being sent towards downstream. Headers specified at this level are applied after headers from matched Route are applied.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"append": schema.BoolAttribute{
@@ -2913,10 +3381,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name. Name of the HTTP header.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"value": schema.StringAttribute{
 											MarkdownDescription: "Value of the HTTP header.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(8096),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -2949,7 +3423,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -2981,11 +3464,18 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				Blocks: map[string]schema.Block{
 					"advertise_where": schema.ListNestedBlock{
 						MarkdownDescription: "Where should this load balancer be available .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"port": schema.Int64Attribute{
 									MarkdownDescription: "TCP port to Listen.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+										int64validator.AtMost(65535),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -2995,6 +3485,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"ip": schema.StringAttribute{
 											MarkdownDescription: "Use given IP address as VIP on the site.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 										"network": schema.StringAttribute{
 											MarkdownDescription: "[Enum: SITE_NETWORK_INSIDE_AND_OUTSIDE|SITE_NETWORK_INSIDE|SITE_NETWORK_OUTSIDE|SITE_NETWORK_SERVICE|SITE_NETWORK_OUTSIDE_WITH_INTERNET_VIP|SITE_NETWORK_INSIDE_AND_OUTSIDE_WITH_INTERNET_VIP|SITE_NETWORK_IP_FABRIC] Defines network types to be used on site All inside and outside networks. All inside and outside networks with internet VIP support. All inside networks. Possible values are `SITE_NETWORK_INSIDE_AND_OUTSIDE`, `SITE_NETWORK_INSIDE`, `SITE_NETWORK_OUTSIDE`, `SITE_NETWORK_SERVICE`, `SITE_NETWORK_OUTSIDE_WITH_INTERNET_VIP`, `SITE_NETWORK_INSIDE_AND_OUTSIDE_WITH_INTERNET_VIP`, `SITE_NETWORK_IP_FABRIC`. Defaults to `SITE_NETWORK_INSIDE_AND_OUTSIDE`.",
@@ -3008,6 +3501,10 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -3016,6 +3513,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -3024,6 +3524,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -3047,6 +3550,10 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -3055,6 +3562,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -3063,6 +3573,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -3079,6 +3592,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					"trusted_ca_url": schema.StringAttribute{
 						MarkdownDescription: "Custom Root CA Certificate for validating upstream server certificate.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.LengthAtMost(131072),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -3088,6 +3604,10 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							"certificate_url": schema.StringAttribute{
 								MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"description_spec": schema.StringAttribute{
 								MarkdownDescription: "Description. Description for the certificate.",
@@ -3102,6 +3622,11 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(4),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.UniqueValues(),
+										},
 									},
 								},
 							},
@@ -3137,7 +3662,16 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -3158,6 +3692,9 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						Blocks: map[string]schema.Block{
 							"interception_rules": schema.ListNestedBlock{
 								MarkdownDescription: "List of ordered rules to enable or disable for TLS interception .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{},
 									Blocks: map[string]schema.Block{
@@ -3170,14 +3707,29 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"exact_value": schema.StringAttribute{
 													MarkdownDescription: "Exact domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 												"regex_value": schema.StringAttribute{
 													MarkdownDescription: "Regular Expression value for the domain name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 												"suffix_value": schema.StringAttribute{
 													MarkdownDescription: "Suffix of domain name e.g 'xyz.com' will match '*.xyz.com' and 'xyz.com'.",
 													Optional:            true,
+													Validators: []validator.String{
+														validators.HostnameValidator(),
+														stringvalidator.UTF8LengthAtMost(256),
+														stringvalidator.UTF8LengthAtLeast(1),
+													},
 												},
 											},
 										},
@@ -3201,6 +3753,11 @@ func (r *ProxyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *ProxyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *ProxyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -3225,6 +3782,87 @@ func (r *ProxyResource) ValidateConfig(ctx context.Context, req resource.Validat
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *ProxyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "network_choice", "site_local_inside_network", "site_local_network"),
+		validators.OneOfGroup(path.MatchRelative(), "proxy_choice", "dynamic_proxy", "http_proxy"),
+		validators.OneOfGroup(path.MatchRelative(), "proxy_policy_choice", "active_forward_proxy_policies", "no_forward_proxy_policy"),
+		validators.OneOfGroup(path.MatchRelative(), "site_choice", "do_not_advertise", "site_virtual_sites"),
+		validators.OneOfGroup(path.MatchRelative(), "tls_interception_choice", "no_interception", "tls_intercept"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy"), "dns_choice", "disable_dns_masquerade", "enable_dns_masquerade"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy"), "proxy_choice", "http_proxy", "https_proxy", "sni_proxy"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option"), "path_normalize_choice", "disable_path_normalize", "enable_path_normalize"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("request_cookies_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("request_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("request_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("request_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "domain_choice", "add_domain", "ignore_domain"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "expiry_choice", "add_expiry", "ignore_expiry"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "httponly_choice", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "max_age_choice", "ignore_max_age", "max_age_value"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "partitioned_choice", "add_partitioned", "ignore_partitioned"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "path_choice", "add_path", "ignore_path"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "samesite_choice", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "secure_choice", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "value_choice", "ignore_value", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("response_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("http_proxy").AtName("more_option").AtName("response_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option"), "path_normalize_choice", "disable_path_normalize", "enable_path_normalize"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("request_cookies_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("request_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("request_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("request_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "domain_choice", "add_domain", "ignore_domain"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "expiry_choice", "add_expiry", "ignore_expiry"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "httponly_choice", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "max_age_choice", "ignore_max_age", "max_age_value"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "partitioned_choice", "add_partitioned", "ignore_partitioned"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "path_choice", "add_path", "ignore_path"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "samesite_choice", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "secure_choice", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "value_choice", "ignore_value", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("response_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("more_option").AtName("response_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("tls_params"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("tls_params").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("tls_params").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("tls_params").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("tls_params").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("tls_params").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("dynamic_proxy").AtName("https_proxy").AtName("tls_params").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option"), "path_normalize_choice", "disable_path_normalize", "enable_path_normalize"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("request_cookies_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("request_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("request_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("request_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "domain_choice", "add_domain", "ignore_domain"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "expiry_choice", "add_expiry", "ignore_expiry"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "httponly_choice", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "max_age_choice", "ignore_max_age", "max_age_value"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "partitioned_choice", "add_partitioned", "ignore_partitioned"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "path_choice", "add_path", "ignore_path"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "samesite_choice", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "secure_choice", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex(), "value_choice", "ignore_value", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("response_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("response_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("http_proxy").AtName("more_option").AtName("response_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("site_virtual_sites").AtName("advertise_where").AtAnyListIndex(), "choice", "site", "virtual_site"),
+		validators.RequiredOneOfGroup(path.MatchRoot("site_virtual_sites").AtName("advertise_where").AtAnyListIndex(), "port_choice", "port", "use_default_port"),
+		validators.OneOfGroup(path.MatchRoot("tls_intercept"), "interception_policy_choice", "enable_for_all_domains", "policy"),
+		validators.OneOfGroup(path.MatchRoot("tls_intercept"), "signing_cert_choice", "custom_certificate", "volterra_certificate"),
+		validators.OneOfGroup(path.MatchRoot("tls_intercept"), "trusted_ca_choice", "trusted_ca_url", "volterra_trusted_ca"),
+		validators.OneOfGroup(path.MatchRoot("tls_intercept").AtName("custom_certificate"), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("tls_intercept").AtName("custom_certificate").AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("tls_intercept").AtName("policy").AtName("interception_rules").AtAnyListIndex(), "enable_disable_choice", "disable_interception", "enable_interception"),
+		validators.OneOfGroup(path.MatchRoot("tls_intercept").AtName("policy").AtName("interception_rules").AtAnyListIndex().AtName("domain_match"), "domain_choice", "exact_value", "regex_value", "suffix_value"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *ProxyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -3235,7 +3873,10 @@ func (r *ProxyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "proxy", "", req.Config, &resp.Plan)...)
 		var plan ProxyResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -3253,7 +3894,8 @@ func (r *ProxyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 
 func (r *ProxyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data ProxyResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -3292,6 +3934,7 @@ func (r *ProxyResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -3301,6 +3944,7 @@ func (r *ProxyResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.ActiveForwardProxyPolicies != nil {
@@ -3345,10 +3989,260 @@ func (r *ProxyResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		if data.DynamicProxy.HTTPProxy != nil {
 			http_proxyNestedMap := make(map[string]interface{})
+			if data.DynamicProxy.HTTPProxy.MoreOption != nil {
+				more_optionLevel3Map := make(map[string]interface{})
+				if data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy != nil {
+					buffer_policyLevel4Map := make(map[string]interface{})
+					if !data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy.Disabled.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy.Disabled.IsUnknown() {
+						buffer_policyLevel4Map["disabled"] = data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy.Disabled.ValueBool()
+					}
+					if !data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy.MaxRequestBytes.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy.MaxRequestBytes.IsUnknown() {
+						buffer_policyLevel4Map["max_request_bytes"] = data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy.MaxRequestBytes.ValueInt64()
+					}
+					more_optionLevel3Map["buffer_policy"] = buffer_policyLevel4Map
+				}
+				if data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams != nil {
+					compression_paramsLevel4Map := make(map[string]interface{})
+					if !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.ContentLength.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.ContentLength.IsUnknown() {
+						compression_paramsLevel4Map["content_length"] = data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.ContentLength.ValueInt64()
+					}
+					if !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.ContentType.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.ContentType.IsUnknown() {
+						var content_typeLevel4Items []string
+						diags := data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.ContentType.ElementsAs(ctx, &content_typeLevel4Items, false)
+						if !diags.HasError() {
+							compression_paramsLevel4Map["content_type"] = content_typeLevel4Items
+						}
+					}
+					if !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.DisableOnEtagHeader.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.DisableOnEtagHeader.IsUnknown() {
+						compression_paramsLevel4Map["disable_on_etag_header"] = data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.DisableOnEtagHeader.ValueBool()
+					}
+					if !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.IsUnknown() {
+						compression_paramsLevel4Map["remove_accept_encoding_header"] = data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.ValueBool()
+					}
+					more_optionLevel3Map["compression_params"] = compression_paramsLevel4Map
+				}
+				if data.DynamicProxy.HTTPProxy.MoreOption.CustomErrors != nil {
+					more_optionLevel3Map["custom_errors"] = map[string]interface{}{}
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.DisableDefaultErrorPages.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.DisableDefaultErrorPages.IsUnknown() {
+					more_optionLevel3Map["disable_default_error_pages"] = data.DynamicProxy.HTTPProxy.MoreOption.DisableDefaultErrorPages.ValueBool()
+				}
+				if data.DynamicProxy.HTTPProxy.MoreOption.DisablePathNormalize != nil {
+					more_optionLevel3Map["disable_path_normalize"] = map[string]interface{}{}
+				}
+				if data.DynamicProxy.HTTPProxy.MoreOption.EnablePathNormalize != nil {
+					more_optionLevel3Map["enable_path_normalize"] = map[string]interface{}{}
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.IdleTimeout.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.IdleTimeout.IsUnknown() {
+					more_optionLevel3Map["idle_timeout"] = data.DynamicProxy.HTTPProxy.MoreOption.IdleTimeout.ValueInt64()
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.MaxRequestHeaderSize.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.MaxRequestHeaderSize.IsUnknown() {
+					more_optionLevel3Map["max_request_header_size"] = data.DynamicProxy.HTTPProxy.MoreOption.MaxRequestHeaderSize.ValueInt64()
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.RequestCookiesToRemove.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.RequestCookiesToRemove.IsUnknown() {
+					var request_cookies_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPProxy.MoreOption.RequestCookiesToRemove.ElementsAs(ctx, &request_cookies_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["request_cookies_to_remove"] = request_cookies_to_removeLevel3Items
+					}
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.RequestHeadersToRemove.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.RequestHeadersToRemove.IsUnknown() {
+					var request_headers_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPProxy.MoreOption.RequestHeadersToRemove.ElementsAs(ctx, &request_headers_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["request_headers_to_remove"] = request_headers_to_removeLevel3Items
+					}
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.ResponseCookiesToRemove.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.ResponseCookiesToRemove.IsUnknown() {
+					var response_cookies_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPProxy.MoreOption.ResponseCookiesToRemove.ElementsAs(ctx, &response_cookies_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["response_cookies_to_remove"] = response_cookies_to_removeLevel3Items
+					}
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.ResponseHeadersToRemove.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.ResponseHeadersToRemove.IsUnknown() {
+					var response_headers_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPProxy.MoreOption.ResponseHeadersToRemove.ElementsAs(ctx, &response_headers_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["response_headers_to_remove"] = response_headers_to_removeLevel3Items
+					}
+				}
+				http_proxyNestedMap["more_option"] = more_optionLevel3Map
+			}
 			dynamic_proxyMap["http_proxy"] = http_proxyNestedMap
 		}
 		if data.DynamicProxy.HTTPSProxy != nil {
 			https_proxyNestedMap := make(map[string]interface{})
+			if data.DynamicProxy.HTTPSProxy.MoreOption != nil {
+				more_optionLevel3Map := make(map[string]interface{})
+				if data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy != nil {
+					buffer_policyLevel4Map := make(map[string]interface{})
+					if !data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy.Disabled.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy.Disabled.IsUnknown() {
+						buffer_policyLevel4Map["disabled"] = data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy.Disabled.ValueBool()
+					}
+					if !data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy.MaxRequestBytes.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy.MaxRequestBytes.IsUnknown() {
+						buffer_policyLevel4Map["max_request_bytes"] = data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy.MaxRequestBytes.ValueInt64()
+					}
+					more_optionLevel3Map["buffer_policy"] = buffer_policyLevel4Map
+				}
+				if data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams != nil {
+					compression_paramsLevel4Map := make(map[string]interface{})
+					if !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.ContentLength.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.ContentLength.IsUnknown() {
+						compression_paramsLevel4Map["content_length"] = data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.ContentLength.ValueInt64()
+					}
+					if !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.ContentType.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.ContentType.IsUnknown() {
+						var content_typeLevel4Items []string
+						diags := data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.ContentType.ElementsAs(ctx, &content_typeLevel4Items, false)
+						if !diags.HasError() {
+							compression_paramsLevel4Map["content_type"] = content_typeLevel4Items
+						}
+					}
+					if !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.DisableOnEtagHeader.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.DisableOnEtagHeader.IsUnknown() {
+						compression_paramsLevel4Map["disable_on_etag_header"] = data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.DisableOnEtagHeader.ValueBool()
+					}
+					if !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.IsUnknown() {
+						compression_paramsLevel4Map["remove_accept_encoding_header"] = data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.ValueBool()
+					}
+					more_optionLevel3Map["compression_params"] = compression_paramsLevel4Map
+				}
+				if data.DynamicProxy.HTTPSProxy.MoreOption.CustomErrors != nil {
+					more_optionLevel3Map["custom_errors"] = map[string]interface{}{}
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.DisableDefaultErrorPages.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.DisableDefaultErrorPages.IsUnknown() {
+					more_optionLevel3Map["disable_default_error_pages"] = data.DynamicProxy.HTTPSProxy.MoreOption.DisableDefaultErrorPages.ValueBool()
+				}
+				if data.DynamicProxy.HTTPSProxy.MoreOption.DisablePathNormalize != nil {
+					more_optionLevel3Map["disable_path_normalize"] = map[string]interface{}{}
+				}
+				if data.DynamicProxy.HTTPSProxy.MoreOption.EnablePathNormalize != nil {
+					more_optionLevel3Map["enable_path_normalize"] = map[string]interface{}{}
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.IdleTimeout.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.IdleTimeout.IsUnknown() {
+					more_optionLevel3Map["idle_timeout"] = data.DynamicProxy.HTTPSProxy.MoreOption.IdleTimeout.ValueInt64()
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.MaxRequestHeaderSize.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.MaxRequestHeaderSize.IsUnknown() {
+					more_optionLevel3Map["max_request_header_size"] = data.DynamicProxy.HTTPSProxy.MoreOption.MaxRequestHeaderSize.ValueInt64()
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.RequestCookiesToRemove.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.RequestCookiesToRemove.IsUnknown() {
+					var request_cookies_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPSProxy.MoreOption.RequestCookiesToRemove.ElementsAs(ctx, &request_cookies_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["request_cookies_to_remove"] = request_cookies_to_removeLevel3Items
+					}
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.RequestHeadersToRemove.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.RequestHeadersToRemove.IsUnknown() {
+					var request_headers_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPSProxy.MoreOption.RequestHeadersToRemove.ElementsAs(ctx, &request_headers_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["request_headers_to_remove"] = request_headers_to_removeLevel3Items
+					}
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.ResponseCookiesToRemove.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.ResponseCookiesToRemove.IsUnknown() {
+					var response_cookies_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPSProxy.MoreOption.ResponseCookiesToRemove.ElementsAs(ctx, &response_cookies_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["response_cookies_to_remove"] = response_cookies_to_removeLevel3Items
+					}
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.ResponseHeadersToRemove.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.ResponseHeadersToRemove.IsUnknown() {
+					var response_headers_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPSProxy.MoreOption.ResponseHeadersToRemove.ElementsAs(ctx, &response_headers_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["response_headers_to_remove"] = response_headers_to_removeLevel3Items
+					}
+				}
+				https_proxyNestedMap["more_option"] = more_optionLevel3Map
+			}
+			if data.DynamicProxy.HTTPSProxy.TLSParams != nil {
+				tls_paramsLevel3Map := make(map[string]interface{})
+				if data.DynamicProxy.HTTPSProxy.TLSParams.NoMtls != nil {
+					tls_paramsLevel3Map["no_mtls"] = map[string]interface{}{}
+				}
+				if data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig != nil {
+					tls_configLevel4Map := make(map[string]interface{})
+					if data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity != nil {
+						custom_securityLevel5Map := make(map[string]interface{})
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+							var cipher_suitesLevel5Items []string
+							diags := data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel5Items, false)
+							if !diags.HasError() {
+								custom_securityLevel5Map["cipher_suites"] = cipher_suitesLevel5Items
+							}
+						}
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+							custom_securityLevel5Map["max_version"] = data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+						}
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+							custom_securityLevel5Map["min_version"] = data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.MinVersion.ValueString()
+						}
+						tls_configLevel4Map["custom_security"] = custom_securityLevel5Map
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.DefaultSecurity != nil {
+						tls_configLevel4Map["default_security"] = map[string]interface{}{}
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.LowSecurity != nil {
+						tls_configLevel4Map["low_security"] = map[string]interface{}{}
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.MediumSecurity != nil {
+						tls_configLevel4Map["medium_security"] = map[string]interface{}{}
+					}
+					tls_paramsLevel3Map["tls_config"] = tls_configLevel4Map
+				}
+				if data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls != nil {
+					use_mtlsLevel4Map := make(map[string]interface{})
+					if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.ClientCertificateOptional.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.ClientCertificateOptional.IsUnknown() {
+						use_mtlsLevel4Map["client_certificate_optional"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.ClientCertificateOptional.ValueBool()
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL != nil {
+						crlLevel5Map := make(map[string]interface{})
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Name.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Name.IsUnknown() {
+							crlLevel5Map["name"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Name.ValueString()
+						}
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Namespace.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Namespace.IsUnknown() {
+							crlLevel5Map["namespace"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Namespace.ValueString()
+						}
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Tenant.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Tenant.IsUnknown() {
+							crlLevel5Map["tenant"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Tenant.ValueString()
+						}
+						use_mtlsLevel4Map["crl"] = crlLevel5Map
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.NoCRL != nil {
+						use_mtlsLevel4Map["no_crl"] = map[string]interface{}{}
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA != nil {
+						trusted_caLevel5Map := make(map[string]interface{})
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Name.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Name.IsUnknown() {
+							trusted_caLevel5Map["name"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Name.ValueString()
+						}
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Namespace.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Namespace.IsUnknown() {
+							trusted_caLevel5Map["namespace"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Namespace.ValueString()
+						}
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Tenant.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Tenant.IsUnknown() {
+							trusted_caLevel5Map["tenant"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Tenant.ValueString()
+						}
+						use_mtlsLevel4Map["trusted_ca"] = trusted_caLevel5Map
+					}
+					if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCAURL.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCAURL.IsUnknown() {
+						use_mtlsLevel4Map["trusted_ca_url"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCAURL.ValueString()
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.XfccDisabled != nil {
+						use_mtlsLevel4Map["xfcc_disabled"] = map[string]interface{}{}
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.XfccOptions != nil {
+						xfcc_optionsLevel5Map := make(map[string]interface{})
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+							var xfcc_header_elementsLevel5Items []string
+							diags := data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel5Items, false)
+							if !diags.HasError() {
+								xfcc_optionsLevel5Map["xfcc_header_elements"] = xfcc_header_elementsLevel5Items
+							}
+						}
+						use_mtlsLevel4Map["xfcc_options"] = xfcc_optionsLevel5Map
+					}
+					tls_paramsLevel3Map["use_mtls"] = use_mtlsLevel4Map
+				}
+				https_proxyNestedMap["tls_params"] = tls_paramsLevel3Map
+			}
 			dynamic_proxyMap["https_proxy"] = https_proxyNestedMap
 		}
 		if data.DynamicProxy.SniProxy != nil {
@@ -3367,9 +4261,48 @@ func (r *ProxyResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		if data.HTTPProxy.MoreOption != nil {
 			more_optionNestedMap := make(map[string]interface{})
+			if data.HTTPProxy.MoreOption.BufferPolicy != nil {
+				buffer_policyLevel3Map := make(map[string]interface{})
+				if !data.HTTPProxy.MoreOption.BufferPolicy.Disabled.IsNull() && !data.HTTPProxy.MoreOption.BufferPolicy.Disabled.IsUnknown() {
+					buffer_policyLevel3Map["disabled"] = data.HTTPProxy.MoreOption.BufferPolicy.Disabled.ValueBool()
+				}
+				if !data.HTTPProxy.MoreOption.BufferPolicy.MaxRequestBytes.IsNull() && !data.HTTPProxy.MoreOption.BufferPolicy.MaxRequestBytes.IsUnknown() {
+					buffer_policyLevel3Map["max_request_bytes"] = data.HTTPProxy.MoreOption.BufferPolicy.MaxRequestBytes.ValueInt64()
+				}
+				more_optionNestedMap["buffer_policy"] = buffer_policyLevel3Map
+			}
+			if data.HTTPProxy.MoreOption.CompressionParams != nil {
+				compression_paramsLevel3Map := make(map[string]interface{})
+				if !data.HTTPProxy.MoreOption.CompressionParams.ContentLength.IsNull() && !data.HTTPProxy.MoreOption.CompressionParams.ContentLength.IsUnknown() {
+					compression_paramsLevel3Map["content_length"] = data.HTTPProxy.MoreOption.CompressionParams.ContentLength.ValueInt64()
+				}
+				if !data.HTTPProxy.MoreOption.CompressionParams.ContentType.IsNull() && !data.HTTPProxy.MoreOption.CompressionParams.ContentType.IsUnknown() {
+					var content_typeLevel3Items []string
+					diags := data.HTTPProxy.MoreOption.CompressionParams.ContentType.ElementsAs(ctx, &content_typeLevel3Items, false)
+					if !diags.HasError() {
+						compression_paramsLevel3Map["content_type"] = content_typeLevel3Items
+					}
+				}
+				if !data.HTTPProxy.MoreOption.CompressionParams.DisableOnEtagHeader.IsNull() && !data.HTTPProxy.MoreOption.CompressionParams.DisableOnEtagHeader.IsUnknown() {
+					compression_paramsLevel3Map["disable_on_etag_header"] = data.HTTPProxy.MoreOption.CompressionParams.DisableOnEtagHeader.ValueBool()
+				}
+				if !data.HTTPProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.IsNull() && !data.HTTPProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.IsUnknown() {
+					compression_paramsLevel3Map["remove_accept_encoding_header"] = data.HTTPProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.ValueBool()
+				}
+				more_optionNestedMap["compression_params"] = compression_paramsLevel3Map
+			}
+			if data.HTTPProxy.MoreOption.CustomErrors != nil {
+				more_optionNestedMap["custom_errors"] = map[string]interface{}{}
+			}
 			if !data.HTTPProxy.MoreOption.DisableDefaultErrorPages.IsNull() && !data.HTTPProxy.MoreOption.DisableDefaultErrorPages.IsUnknown() {
 				more_optionNestedMap["disable_default_error_pages"] = data.HTTPProxy.MoreOption.DisableDefaultErrorPages.ValueBool()
 			}
+			if data.HTTPProxy.MoreOption.DisablePathNormalize != nil {
+				more_optionNestedMap["disable_path_normalize"] = map[string]interface{}{}
+			}
+			if data.HTTPProxy.MoreOption.EnablePathNormalize != nil {
+				more_optionNestedMap["enable_path_normalize"] = map[string]interface{}{}
+			}
 			if !data.HTTPProxy.MoreOption.IdleTimeout.IsNull() && !data.HTTPProxy.MoreOption.IdleTimeout.IsUnknown() {
 				more_optionNestedMap["idle_timeout"] = data.HTTPProxy.MoreOption.IdleTimeout.ValueInt64()
 			}
@@ -3413,6 +4346,19 @@ func (r *ProxyResource) Create(ctx context.Context, req resource.CreateRequest,
 					if !listItem.Site.Network.IsNull() && !listItem.Site.Network.IsUnknown() {
 						siteDeepMap["network"] = listItem.Site.Network.ValueString()
 					}
+					if listItem.Site.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Site.Site.Name.IsNull() && !listItem.Site.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Site.Site.Name.ValueString()
+						}
+						if !listItem.Site.Site.Namespace.IsNull() && !listItem.Site.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Site.Site.Namespace.ValueString()
+						}
+						if !listItem.Site.Site.Tenant.IsNull() && !listItem.Site.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Site.Site.Tenant.ValueString()
+						}
+						siteDeepMap["site"] = siteLevel4Map
+					}
 					listItemMap["site"] = siteDeepMap
 				}
 				if listItem.UseDefaultPort != nil {
@@ -3423,6 +4369,19 @@ func (r *ProxyResource) Create(ctx context.Context, req resource.CreateRequest,
 					if !listItem.VirtualSite.Network.IsNull() && !listItem.VirtualSite.Network.IsUnknown() {
 						virtual_siteDeepMap["network"] = listItem.VirtualSite.Network.ValueString()
 					}
+					if listItem.VirtualSite.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSite.VirtualSite.Name.IsNull() && !listItem.VirtualSite.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSite.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Namespace.IsNull() && !listItem.VirtualSite.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSite.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Tenant.IsNull() && !listItem.VirtualSite.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSite.VirtualSite.Tenant.ValueString()
+						}
+						virtual_siteDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site"] = virtual_siteDeepMap
 				}
 				advertise_whereList = append(advertise_whereList, listItemMap)
@@ -3438,9 +4397,53 @@ func (r *ProxyResource) Create(ctx context.Context, req resource.CreateRequest,
 			if !data.TLSIntercept.CustomCertificate.CertificateURL.IsNull() && !data.TLSIntercept.CustomCertificate.CertificateURL.IsUnknown() {
 				custom_certificateNestedMap["certificate_url"] = data.TLSIntercept.CustomCertificate.CertificateURL.ValueString()
 			}
+			if data.TLSIntercept.CustomCertificate.CustomHashAlgorithms != nil {
+				custom_hash_algorithmsLevel3Map := make(map[string]interface{})
+				if !data.TLSIntercept.CustomCertificate.CustomHashAlgorithms.HashAlgorithms.IsNull() && !data.TLSIntercept.CustomCertificate.CustomHashAlgorithms.HashAlgorithms.IsUnknown() {
+					var hash_algorithmsLevel3Items []string
+					diags := data.TLSIntercept.CustomCertificate.CustomHashAlgorithms.HashAlgorithms.ElementsAs(ctx, &hash_algorithmsLevel3Items, false)
+					if !diags.HasError() {
+						custom_hash_algorithmsLevel3Map["hash_algorithms"] = hash_algorithmsLevel3Items
+					}
+				}
+				custom_certificateNestedMap["custom_hash_algorithms"] = custom_hash_algorithmsLevel3Map
+			}
 			if !data.TLSIntercept.CustomCertificate.DescriptionSpec.IsNull() && !data.TLSIntercept.CustomCertificate.DescriptionSpec.IsUnknown() {
 				custom_certificateNestedMap["description"] = data.TLSIntercept.CustomCertificate.DescriptionSpec.ValueString()
 			}
+			if data.TLSIntercept.CustomCertificate.DisableOCSPStapling != nil {
+				custom_certificateNestedMap["disable_ocsp_stapling"] = map[string]interface{}{}
+			}
+			if data.TLSIntercept.CustomCertificate.PrivateKey != nil {
+				private_keyLevel3Map := make(map[string]interface{})
+				if data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.Location.IsNull() && !data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					private_keyLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.Provider.IsNull() && !data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.URL.IsNull() && !data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.URL.ValueString()
+					}
+					private_keyLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				custom_certificateNestedMap["private_key"] = private_keyLevel3Map
+			}
+			if data.TLSIntercept.CustomCertificate.UseSystemDefaults != nil {
+				custom_certificateNestedMap["use_system_defaults"] = map[string]interface{}{}
+			}
 			tls_interceptMap["custom_certificate"] = custom_certificateNestedMap
 		}
 		if data.TLSIntercept.EnableForAllDomains != nil {
@@ -3467,11 +4470,15 @@ func (r *ProxyResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	apiResource, err := r.client.CreateProxy(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Proxy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "proxy", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -3794,7 +4801,7 @@ func (r *ProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	apiResource, err := r.client.GetProxy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Proxy not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -3802,11 +4809,12 @@ func (r *ProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Proxy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "proxy", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -3817,31 +4825,13 @@ func (r *ProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -3849,6 +4839,7 @@ func (r *ProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["active_forward_proxy_policies"].(map[string]interface{}); ok && (isImport || data.ActiveForwardProxyPolicies != nil) {
 		data.ActiveForwardProxyPolicies = &ProxyActiveForwardProxyPoliciesModel{
@@ -4149,7 +5140,8 @@ func (r *ProxyResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data ProxyResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -4183,6 +5175,7 @@ func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -4192,6 +5185,7 @@ func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.ActiveForwardProxyPolicies != nil {
@@ -4236,10 +5230,260 @@ func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		if data.DynamicProxy.HTTPProxy != nil {
 			http_proxyNestedMap := make(map[string]interface{})
+			if data.DynamicProxy.HTTPProxy.MoreOption != nil {
+				more_optionLevel3Map := make(map[string]interface{})
+				if data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy != nil {
+					buffer_policyLevel4Map := make(map[string]interface{})
+					if !data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy.Disabled.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy.Disabled.IsUnknown() {
+						buffer_policyLevel4Map["disabled"] = data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy.Disabled.ValueBool()
+					}
+					if !data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy.MaxRequestBytes.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy.MaxRequestBytes.IsUnknown() {
+						buffer_policyLevel4Map["max_request_bytes"] = data.DynamicProxy.HTTPProxy.MoreOption.BufferPolicy.MaxRequestBytes.ValueInt64()
+					}
+					more_optionLevel3Map["buffer_policy"] = buffer_policyLevel4Map
+				}
+				if data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams != nil {
+					compression_paramsLevel4Map := make(map[string]interface{})
+					if !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.ContentLength.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.ContentLength.IsUnknown() {
+						compression_paramsLevel4Map["content_length"] = data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.ContentLength.ValueInt64()
+					}
+					if !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.ContentType.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.ContentType.IsUnknown() {
+						var content_typeLevel4Items []string
+						diags := data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.ContentType.ElementsAs(ctx, &content_typeLevel4Items, false)
+						if !diags.HasError() {
+							compression_paramsLevel4Map["content_type"] = content_typeLevel4Items
+						}
+					}
+					if !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.DisableOnEtagHeader.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.DisableOnEtagHeader.IsUnknown() {
+						compression_paramsLevel4Map["disable_on_etag_header"] = data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.DisableOnEtagHeader.ValueBool()
+					}
+					if !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.IsUnknown() {
+						compression_paramsLevel4Map["remove_accept_encoding_header"] = data.DynamicProxy.HTTPProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.ValueBool()
+					}
+					more_optionLevel3Map["compression_params"] = compression_paramsLevel4Map
+				}
+				if data.DynamicProxy.HTTPProxy.MoreOption.CustomErrors != nil {
+					more_optionLevel3Map["custom_errors"] = map[string]interface{}{}
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.DisableDefaultErrorPages.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.DisableDefaultErrorPages.IsUnknown() {
+					more_optionLevel3Map["disable_default_error_pages"] = data.DynamicProxy.HTTPProxy.MoreOption.DisableDefaultErrorPages.ValueBool()
+				}
+				if data.DynamicProxy.HTTPProxy.MoreOption.DisablePathNormalize != nil {
+					more_optionLevel3Map["disable_path_normalize"] = map[string]interface{}{}
+				}
+				if data.DynamicProxy.HTTPProxy.MoreOption.EnablePathNormalize != nil {
+					more_optionLevel3Map["enable_path_normalize"] = map[string]interface{}{}
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.IdleTimeout.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.IdleTimeout.IsUnknown() {
+					more_optionLevel3Map["idle_timeout"] = data.DynamicProxy.HTTPProxy.MoreOption.IdleTimeout.ValueInt64()
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.MaxRequestHeaderSize.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.MaxRequestHeaderSize.IsUnknown() {
+					more_optionLevel3Map["max_request_header_size"] = data.DynamicProxy.HTTPProxy.MoreOption.MaxRequestHeaderSize.ValueInt64()
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.RequestCookiesToRemove.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.RequestCookiesToRemove.IsUnknown() {
+					var request_cookies_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPProxy.MoreOption.RequestCookiesToRemove.ElementsAs(ctx, &request_cookies_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["request_cookies_to_remove"] = request_cookies_to_removeLevel3Items
+					}
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.RequestHeadersToRemove.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.RequestHeadersToRemove.IsUnknown() {
+					var request_headers_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPProxy.MoreOption.RequestHeadersToRemove.ElementsAs(ctx, &request_headers_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["request_headers_to_remove"] = request_headers_to_removeLevel3Items
+					}
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.ResponseCookiesToRemove.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.ResponseCookiesToRemove.IsUnknown() {
+					var response_cookies_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPProxy.MoreOption.ResponseCookiesToRemove.ElementsAs(ctx, &response_cookies_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["response_cookies_to_remove"] = response_cookies_to_removeLevel3Items
+					}
+				}
+				if !data.DynamicProxy.HTTPProxy.MoreOption.ResponseHeadersToRemove.IsNull() && !data.DynamicProxy.HTTPProxy.MoreOption.ResponseHeadersToRemove.IsUnknown() {
+					var response_headers_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPProxy.MoreOption.ResponseHeadersToRemove.ElementsAs(ctx, &response_headers_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["response_headers_to_remove"] = response_headers_to_removeLevel3Items
+					}
+				}
+				http_proxyNestedMap["more_option"] = more_optionLevel3Map
+			}
 			dynamic_proxyMap["http_proxy"] = http_proxyNestedMap
 		}
 		if data.DynamicProxy.HTTPSProxy != nil {
 			https_proxyNestedMap := make(map[string]interface{})
+			if data.DynamicProxy.HTTPSProxy.MoreOption != nil {
+				more_optionLevel3Map := make(map[string]interface{})
+				if data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy != nil {
+					buffer_policyLevel4Map := make(map[string]interface{})
+					if !data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy.Disabled.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy.Disabled.IsUnknown() {
+						buffer_policyLevel4Map["disabled"] = data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy.Disabled.ValueBool()
+					}
+					if !data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy.MaxRequestBytes.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy.MaxRequestBytes.IsUnknown() {
+						buffer_policyLevel4Map["max_request_bytes"] = data.DynamicProxy.HTTPSProxy.MoreOption.BufferPolicy.MaxRequestBytes.ValueInt64()
+					}
+					more_optionLevel3Map["buffer_policy"] = buffer_policyLevel4Map
+				}
+				if data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams != nil {
+					compression_paramsLevel4Map := make(map[string]interface{})
+					if !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.ContentLength.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.ContentLength.IsUnknown() {
+						compression_paramsLevel4Map["content_length"] = data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.ContentLength.ValueInt64()
+					}
+					if !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.ContentType.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.ContentType.IsUnknown() {
+						var content_typeLevel4Items []string
+						diags := data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.ContentType.ElementsAs(ctx, &content_typeLevel4Items, false)
+						if !diags.HasError() {
+							compression_paramsLevel4Map["content_type"] = content_typeLevel4Items
+						}
+					}
+					if !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.DisableOnEtagHeader.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.DisableOnEtagHeader.IsUnknown() {
+						compression_paramsLevel4Map["disable_on_etag_header"] = data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.DisableOnEtagHeader.ValueBool()
+					}
+					if !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.IsUnknown() {
+						compression_paramsLevel4Map["remove_accept_encoding_header"] = data.DynamicProxy.HTTPSProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.ValueBool()
+					}
+					more_optionLevel3Map["compression_params"] = compression_paramsLevel4Map
+				}
+				if data.DynamicProxy.HTTPSProxy.MoreOption.CustomErrors != nil {
+					more_optionLevel3Map["custom_errors"] = map[string]interface{}{}
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.DisableDefaultErrorPages.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.DisableDefaultErrorPages.IsUnknown() {
+					more_optionLevel3Map["disable_default_error_pages"] = data.DynamicProxy.HTTPSProxy.MoreOption.DisableDefaultErrorPages.ValueBool()
+				}
+				if data.DynamicProxy.HTTPSProxy.MoreOption.DisablePathNormalize != nil {
+					more_optionLevel3Map["disable_path_normalize"] = map[string]interface{}{}
+				}
+				if data.DynamicProxy.HTTPSProxy.MoreOption.EnablePathNormalize != nil {
+					more_optionLevel3Map["enable_path_normalize"] = map[string]interface{}{}
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.IdleTimeout.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.IdleTimeout.IsUnknown() {
+					more_optionLevel3Map["idle_timeout"] = data.DynamicProxy.HTTPSProxy.MoreOption.IdleTimeout.ValueInt64()
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.MaxRequestHeaderSize.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.MaxRequestHeaderSize.IsUnknown() {
+					more_optionLevel3Map["max_request_header_size"] = data.DynamicProxy.HTTPSProxy.MoreOption.MaxRequestHeaderSize.ValueInt64()
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.RequestCookiesToRemove.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.RequestCookiesToRemove.IsUnknown() {
+					var request_cookies_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPSProxy.MoreOption.RequestCookiesToRemove.ElementsAs(ctx, &request_cookies_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["request_cookies_to_remove"] = request_cookies_to_removeLevel3Items
+					}
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.RequestHeadersToRemove.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.RequestHeadersToRemove.IsUnknown() {
+					var request_headers_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPSProxy.MoreOption.RequestHeadersToRemove.ElementsAs(ctx, &request_headers_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["request_headers_to_remove"] = request_headers_to_removeLevel3Items
+					}
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.ResponseCookiesToRemove.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.ResponseCookiesToRemove.IsUnknown() {
+					var response_cookies_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPSProxy.MoreOption.ResponseCookiesToRemove.ElementsAs(ctx, &response_cookies_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["response_cookies_to_remove"] = response_cookies_to_removeLevel3Items
+					}
+				}
+				if !data.DynamicProxy.HTTPSProxy.MoreOption.ResponseHeadersToRemove.IsNull() && !data.DynamicProxy.HTTPSProxy.MoreOption.ResponseHeadersToRemove.IsUnknown() {
+					var response_headers_to_removeLevel3Items []string
+					diags := data.DynamicProxy.HTTPSProxy.MoreOption.ResponseHeadersToRemove.ElementsAs(ctx, &response_headers_to_removeLevel3Items, false)
+					if !diags.HasError() {
+						more_optionLevel3Map["response_headers_to_remove"] = response_headers_to_removeLevel3Items
+					}
+				}
+				https_proxyNestedMap["more_option"] = more_optionLevel3Map
+			}
+			if data.DynamicProxy.HTTPSProxy.TLSParams != nil {
+				tls_paramsLevel3Map := make(map[string]interface{})
+				if data.DynamicProxy.HTTPSProxy.TLSParams.NoMtls != nil {
+					tls_paramsLevel3Map["no_mtls"] = map[string]interface{}{}
+				}
+				if data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig != nil {
+					tls_configLevel4Map := make(map[string]interface{})
+					if data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity != nil {
+						custom_securityLevel5Map := make(map[string]interface{})
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+							var cipher_suitesLevel5Items []string
+							diags := data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel5Items, false)
+							if !diags.HasError() {
+								custom_securityLevel5Map["cipher_suites"] = cipher_suitesLevel5Items
+							}
+						}
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+							custom_securityLevel5Map["max_version"] = data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+						}
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+							custom_securityLevel5Map["min_version"] = data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.CustomSecurity.MinVersion.ValueString()
+						}
+						tls_configLevel4Map["custom_security"] = custom_securityLevel5Map
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.DefaultSecurity != nil {
+						tls_configLevel4Map["default_security"] = map[string]interface{}{}
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.LowSecurity != nil {
+						tls_configLevel4Map["low_security"] = map[string]interface{}{}
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.TLSConfig.MediumSecurity != nil {
+						tls_configLevel4Map["medium_security"] = map[string]interface{}{}
+					}
+					tls_paramsLevel3Map["tls_config"] = tls_configLevel4Map
+				}
+				if data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls != nil {
+					use_mtlsLevel4Map := make(map[string]interface{})
+					if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.ClientCertificateOptional.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.ClientCertificateOptional.IsUnknown() {
+						use_mtlsLevel4Map["client_certificate_optional"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.ClientCertificateOptional.ValueBool()
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL != nil {
+						crlLevel5Map := make(map[string]interface{})
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Name.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Name.IsUnknown() {
+							crlLevel5Map["name"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Name.ValueString()
+						}
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Namespace.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Namespace.IsUnknown() {
+							crlLevel5Map["namespace"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Namespace.ValueString()
+						}
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Tenant.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Tenant.IsUnknown() {
+							crlLevel5Map["tenant"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.CRL.Tenant.ValueString()
+						}
+						use_mtlsLevel4Map["crl"] = crlLevel5Map
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.NoCRL != nil {
+						use_mtlsLevel4Map["no_crl"] = map[string]interface{}{}
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA != nil {
+						trusted_caLevel5Map := make(map[string]interface{})
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Name.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Name.IsUnknown() {
+							trusted_caLevel5Map["name"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Name.ValueString()
+						}
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Namespace.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Namespace.IsUnknown() {
+							trusted_caLevel5Map["namespace"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Namespace.ValueString()
+						}
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Tenant.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Tenant.IsUnknown() {
+							trusted_caLevel5Map["tenant"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCA.Tenant.ValueString()
+						}
+						use_mtlsLevel4Map["trusted_ca"] = trusted_caLevel5Map
+					}
+					if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCAURL.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCAURL.IsUnknown() {
+						use_mtlsLevel4Map["trusted_ca_url"] = data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.TrustedCAURL.ValueString()
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.XfccDisabled != nil {
+						use_mtlsLevel4Map["xfcc_disabled"] = map[string]interface{}{}
+					}
+					if data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.XfccOptions != nil {
+						xfcc_optionsLevel5Map := make(map[string]interface{})
+						if !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+							var xfcc_header_elementsLevel5Items []string
+							diags := data.DynamicProxy.HTTPSProxy.TLSParams.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel5Items, false)
+							if !diags.HasError() {
+								xfcc_optionsLevel5Map["xfcc_header_elements"] = xfcc_header_elementsLevel5Items
+							}
+						}
+						use_mtlsLevel4Map["xfcc_options"] = xfcc_optionsLevel5Map
+					}
+					tls_paramsLevel3Map["use_mtls"] = use_mtlsLevel4Map
+				}
+				https_proxyNestedMap["tls_params"] = tls_paramsLevel3Map
+			}
 			dynamic_proxyMap["https_proxy"] = https_proxyNestedMap
 		}
 		if data.DynamicProxy.SniProxy != nil {
@@ -4258,9 +5502,48 @@ func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		if data.HTTPProxy.MoreOption != nil {
 			more_optionNestedMap := make(map[string]interface{})
+			if data.HTTPProxy.MoreOption.BufferPolicy != nil {
+				buffer_policyLevel3Map := make(map[string]interface{})
+				if !data.HTTPProxy.MoreOption.BufferPolicy.Disabled.IsNull() && !data.HTTPProxy.MoreOption.BufferPolicy.Disabled.IsUnknown() {
+					buffer_policyLevel3Map["disabled"] = data.HTTPProxy.MoreOption.BufferPolicy.Disabled.ValueBool()
+				}
+				if !data.HTTPProxy.MoreOption.BufferPolicy.MaxRequestBytes.IsNull() && !data.HTTPProxy.MoreOption.BufferPolicy.MaxRequestBytes.IsUnknown() {
+					buffer_policyLevel3Map["max_request_bytes"] = data.HTTPProxy.MoreOption.BufferPolicy.MaxRequestBytes.ValueInt64()
+				}
+				more_optionNestedMap["buffer_policy"] = buffer_policyLevel3Map
+			}
+			if data.HTTPProxy.MoreOption.CompressionParams != nil {
+				compression_paramsLevel3Map := make(map[string]interface{})
+				if !data.HTTPProxy.MoreOption.CompressionParams.ContentLength.IsNull() && !data.HTTPProxy.MoreOption.CompressionParams.ContentLength.IsUnknown() {
+					compression_paramsLevel3Map["content_length"] = data.HTTPProxy.MoreOption.CompressionParams.ContentLength.ValueInt64()
+				}
+				if !data.HTTPProxy.MoreOption.CompressionParams.ContentType.IsNull() && !data.HTTPProxy.MoreOption.CompressionParams.ContentType.IsUnknown() {
+					var content_typeLevel3Items []string
+					diags := data.HTTPProxy.MoreOption.CompressionParams.ContentType.ElementsAs(ctx, &content_typeLevel3Items, false)
+					if !diags.HasError() {
+						compression_paramsLevel3Map["content_type"] = content_typeLevel3Items
+					}
+				}
+				if !data.HTTPProxy.MoreOption.CompressionParams.DisableOnEtagHeader.IsNull() && !data.HTTPProxy.MoreOption.CompressionParams.DisableOnEtagHeader.IsUnknown() {
+					compression_paramsLevel3Map["disable_on_etag_header"] = data.HTTPProxy.MoreOption.CompressionParams.DisableOnEtagHeader.ValueBool()
+				}
+				if !data.HTTPProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.IsNull() && !data.HTTPProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.IsUnknown() {
+					compression_paramsLevel3Map["remove_accept_encoding_header"] = data.HTTPProxy.MoreOption.CompressionParams.RemoveAcceptEncodingHeader.ValueBool()
+				}
+				more_optionNestedMap["compression_params"] = compression_paramsLevel3Map
+			}
+			if data.HTTPProxy.MoreOption.CustomErrors != nil {
+				more_optionNestedMap["custom_errors"] = map[string]interface{}{}
+			}
 			if !data.HTTPProxy.MoreOption.DisableDefaultErrorPages.IsNull() && !data.HTTPProxy.MoreOption.DisableDefaultErrorPages.IsUnknown() {
 				more_optionNestedMap["disable_default_error_pages"] = data.HTTPProxy.MoreOption.DisableDefaultErrorPages.ValueBool()
 			}
+			if data.HTTPProxy.MoreOption.DisablePathNormalize != nil {
+				more_optionNestedMap["disable_path_normalize"] = map[string]interface{}{}
+			}
+			if data.HTTPProxy.MoreOption.EnablePathNormalize != nil {
+				more_optionNestedMap["enable_path_normalize"] = map[string]interface{}{}
+			}
 			if !data.HTTPProxy.MoreOption.IdleTimeout.IsNull() && !data.HTTPProxy.MoreOption.IdleTimeout.IsUnknown() {
 				more_optionNestedMap["idle_timeout"] = data.HTTPProxy.MoreOption.IdleTimeout.ValueInt64()
 			}
@@ -4304,6 +5587,19 @@ func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest,
 					if !listItem.Site.Network.IsNull() && !listItem.Site.Network.IsUnknown() {
 						siteDeepMap["network"] = listItem.Site.Network.ValueString()
 					}
+					if listItem.Site.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Site.Site.Name.IsNull() && !listItem.Site.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Site.Site.Name.ValueString()
+						}
+						if !listItem.Site.Site.Namespace.IsNull() && !listItem.Site.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Site.Site.Namespace.ValueString()
+						}
+						if !listItem.Site.Site.Tenant.IsNull() && !listItem.Site.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Site.Site.Tenant.ValueString()
+						}
+						siteDeepMap["site"] = siteLevel4Map
+					}
 					listItemMap["site"] = siteDeepMap
 				}
 				if listItem.UseDefaultPort != nil {
@@ -4314,6 +5610,19 @@ func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest,
 					if !listItem.VirtualSite.Network.IsNull() && !listItem.VirtualSite.Network.IsUnknown() {
 						virtual_siteDeepMap["network"] = listItem.VirtualSite.Network.ValueString()
 					}
+					if listItem.VirtualSite.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSite.VirtualSite.Name.IsNull() && !listItem.VirtualSite.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSite.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Namespace.IsNull() && !listItem.VirtualSite.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSite.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Tenant.IsNull() && !listItem.VirtualSite.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSite.VirtualSite.Tenant.ValueString()
+						}
+						virtual_siteDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site"] = virtual_siteDeepMap
 				}
 				advertise_whereList = append(advertise_whereList, listItemMap)
@@ -4329,9 +5638,53 @@ func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest,
 			if !data.TLSIntercept.CustomCertificate.CertificateURL.IsNull() && !data.TLSIntercept.CustomCertificate.CertificateURL.IsUnknown() {
 				custom_certificateNestedMap["certificate_url"] = data.TLSIntercept.CustomCertificate.CertificateURL.ValueString()
 			}
+			if data.TLSIntercept.CustomCertificate.CustomHashAlgorithms != nil {
+				custom_hash_algorithmsLevel3Map := make(map[string]interface{})
+				if !data.TLSIntercept.CustomCertificate.CustomHashAlgorithms.HashAlgorithms.IsNull() && !data.TLSIntercept.CustomCertificate.CustomHashAlgorithms.HashAlgorithms.IsUnknown() {
+					var hash_algorithmsLevel3Items []string
+					diags := data.TLSIntercept.CustomCertificate.CustomHashAlgorithms.HashAlgorithms.ElementsAs(ctx, &hash_algorithmsLevel3Items, false)
+					if !diags.HasError() {
+						custom_hash_algorithmsLevel3Map["hash_algorithms"] = hash_algorithmsLevel3Items
+					}
+				}
+				custom_certificateNestedMap["custom_hash_algorithms"] = custom_hash_algorithmsLevel3Map
+			}
 			if !data.TLSIntercept.CustomCertificate.DescriptionSpec.IsNull() && !data.TLSIntercept.CustomCertificate.DescriptionSpec.IsUnknown() {
 				custom_certificateNestedMap["description"] = data.TLSIntercept.CustomCertificate.DescriptionSpec.ValueString()
 			}
+			if data.TLSIntercept.CustomCertificate.DisableOCSPStapling != nil {
+				custom_certificateNestedMap["disable_ocsp_stapling"] = map[string]interface{}{}
+			}
+			if data.TLSIntercept.CustomCertificate.PrivateKey != nil {
+				private_keyLevel3Map := make(map[string]interface{})
+				if data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.Location.IsNull() && !data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.TLSIntercept.CustomCertificate.PrivateKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					private_keyLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.Provider.IsNull() && !data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.URL.IsNull() && !data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.TLSIntercept.CustomCertificate.PrivateKey.ClearSecretInfo.URL.ValueString()
+					}
+					private_keyLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				custom_certificateNestedMap["private_key"] = private_keyLevel3Map
+			}
+			if data.TLSIntercept.CustomCertificate.UseSystemDefaults != nil {
+				custom_certificateNestedMap["use_system_defaults"] = map[string]interface{}{}
+			}
 			tls_interceptMap["custom_certificate"] = custom_certificateNestedMap
 		}
 		if data.TLSIntercept.EnableForAllDomains != nil {
@@ -4358,7 +5711,7 @@ func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	_, err := r.client.UpdateProxy(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Proxy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "proxy", "update"))
 		return
 	}
 
@@ -4369,9 +5722,12 @@ func (r *ProxyResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetProxy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Proxy after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "proxy", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["connection_timeout"].(float64); ok {
@@ -4701,7 +6057,7 @@ func (r *ProxyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	err := r.client.DeleteProxy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Proxy already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -4710,37 +6066,33 @@ func (r *ProxyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "Proxy delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Proxy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "proxy", "delete"))
 		return
 	}
 }
 
 func (r *ProxyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *ProxyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("proxy", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *ProxyResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListProxies(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *ProxyResource) requiredNamespace() string {
+	return ""
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,19 @@ type RateLimiterDataSource struct {
 	client *client.Client
 }
 
+// RateLimiterDataSourceModel mirrors RateLimiterResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type RateLimiterDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata     types.Object `tfsdk:"system_metadata"`
+	Name               types.String `tfsdk:"name"`
+	Namespace          types.String `tfsdk:"namespace"`
+	Annotations        types.Map    `tfsdk:"annotations"`
+	Description        types.String `tfsdk:"description"`
+	Disable            types.Bool   `tfsdk:"disable"`
+	Labels             types.Map    `tfsdk:"labels"`
+	ID                 types.String `tfsdk:"id"`
+	Limits             types.List   `tfsdk:"limits"`
+	UserIdentification types.List   `tfsdk:"user_identification"`
 }
 
 func (d *RateLimiterDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +46,7 @@ func (d *RateLimiterDataSource) Metadata(ctx context.Context, req datasource.Met
 }
 
 func (d *RateLimiterDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages rate_limiter creates a new object in the storage backend for metadata.namespace. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the RateLimiter.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the RateLimiter exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the RateLimiter.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewRateLimiterResource())
 }
 
 func (d *RateLimiterDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +68,25 @@ func (d *RateLimiterDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	resource, err := d.client.GetRateLimiter(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetRateLimiter(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RateLimiter: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "rate_limiter", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +100,8 @@ func (d *RateLimiterDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +110,132 @@ func (d *RateLimiterDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if listData, ok := apiResource.Spec["limits"].([]interface{}); ok && len(listData) > 0 {
+		var limitsList []RateLimiterLimitsModel
+		var existingLimitsItems []RateLimiterLimitsModel
+		if !data.Limits.IsNull() && !data.Limits.IsUnknown() {
+			data.Limits.ElementsAs(ctx, &existingLimitsItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				limitsList = append(limitsList, RateLimiterLimitsModel{
+					ActionBlock: func() *RateLimiterLimitsActionBlockModel {
+						if _, ok := itemMap["action_block"].(map[string]interface{}); ok {
+							return &RateLimiterLimitsActionBlockModel{}
+						}
+						return nil
+					}(),
+					BurstMultiplier: func() types.Int64 {
+						if v, ok := itemMap["burst_multiplier"].(float64); ok && v != 0 {
+							return types.Int64Value(int64(v))
+						}
+						return types.Int64Null()
+					}(),
+					Disabled: func() *RateLimiterEmptyModel {
+						if !isImport && len(existingLimitsItems) > listIdx && existingLimitsItems[listIdx].Disabled != nil {
+							return &RateLimiterEmptyModel{}
+						}
+						return nil
+					}(),
+					LeakyBucket: func() *RateLimiterEmptyModel {
+						if !isImport && len(existingLimitsItems) > listIdx && existingLimitsItems[listIdx].LeakyBucket != nil {
+							return &RateLimiterEmptyModel{}
+						}
+						return nil
+					}(),
+					PeriodMultiplier: func() types.Int64 {
+						if v, ok := itemMap["period_multiplier"].(float64); ok && v != 0 {
+							return types.Int64Value(int64(v))
+						}
+						return types.Int64Null()
+					}(),
+					TokenBucket: func() *RateLimiterEmptyModel {
+						if !isImport && len(existingLimitsItems) > listIdx && existingLimitsItems[listIdx].TokenBucket != nil {
+							return &RateLimiterEmptyModel{}
+						}
+						return nil
+					}(),
+					TotalNumber: func() types.Int64 {
+						if v, ok := itemMap["total_number"].(float64); ok && v != 0 {
+							return types.Int64Value(int64(v))
+						}
+						return types.Int64Null()
+					}(),
+					Unit: func() types.String {
+						if v, ok := itemMap["unit"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: RateLimiterLimitsModelAttrTypes}, limitsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.Limits = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.Limits = types.ListNull(types.ObjectType{AttrTypes: RateLimiterLimitsModelAttrTypes})
+	}
+	if listData, ok := apiResource.Spec["user_identification"].([]interface{}); ok && len(listData) > 0 {
+		var user_identificationList []RateLimiterUserIdentificationModel
+		var existingUserIdentificationItems []RateLimiterUserIdentificationModel
+		if !data.UserIdentification.IsNull() && !data.UserIdentification.IsUnknown() {
+			data.UserIdentification.ElementsAs(ctx, &existingUserIdentificationItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				user_identificationList = append(user_identificationList, RateLimiterUserIdentificationModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: RateLimiterUserIdentificationModelAttrTypes}, user_identificationList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.UserIdentification = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.UserIdentification = types.ListNull(types.ObjectType{AttrTypes: RateLimiterUserIdentificationModelAttrTypes})
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
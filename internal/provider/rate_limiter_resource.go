@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &RateLimiterResource{}
-	_ resource.ResourceWithConfigure      = &RateLimiterResource{}
-	_ resource.ResourceWithImportState    = &RateLimiterResource{}
-	_ resource.ResourceWithModifyPlan     = &RateLimiterResource{}
-	_ resource.ResourceWithValidateConfig = &RateLimiterResource{}
+	_ resource.Resource                     = &RateLimiterResource{}
+	_ resource.ResourceWithConfigure        = &RateLimiterResource{}
+	_ resource.ResourceWithImportState      = &RateLimiterResource{}
+	_ resource.ResourceWithIdentity         = &RateLimiterResource{}
+	_ resource.ResourceWithMoveState        = &RateLimiterResource{}
+	_ resource.ResourceWithModifyPlan       = &RateLimiterResource{}
+	_ resource.ResourceWithValidateConfig   = &RateLimiterResource{}
+	_ resource.ResourceWithConfigValidators = &RateLimiterResource{}
+	_ listableResource                      = &RateLimiterResource{}
 )
 
 func NewRateLimiterResource() resource.Resource {
@@ -140,6 +146,8 @@ type RateLimiterResourceModel struct {
 	Labels             types.Map      `tfsdk:"labels"`
 	ID                 types.String   `tfsdk:"id"`
 	Timeouts           timeouts.Value `tfsdk:"timeouts"`
+	SystemMetadata     types.Object   `tfsdk:"system_metadata"`
+	LabelsAll          types.Map      `tfsdk:"labels_all"`
 	Limits             types.List     `tfsdk:"limits"`
 	UserIdentification types.List     `tfsdk:"user_identification"`
 }
@@ -163,9 +171,11 @@ func (r *RateLimiterResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Rate Limiter will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Rate Limiter will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -197,6 +207,8 @@ func (r *RateLimiterResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -207,19 +219,34 @@ func (r *RateLimiterResource) Schema(ctx context.Context, req resource.SchemaReq
 			}),
 			"limits": schema.ListNestedBlock{
 				MarkdownDescription: "List of RateLimitValues that specifies the total number of allowed requests for each specified period.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"burst_multiplier": schema.Int64Attribute{
 							MarkdownDescription: "The maximum burst of requests to accommodate, expressed as a multiple of the rate.",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+								int64validator.AtMost(100),
+							},
 						},
 						"period_multiplier": schema.Int64Attribute{
 							MarkdownDescription: "Setting, combined with Per Period units, provides a duration.",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+							},
 						},
 						"total_number": schema.Int64Attribute{
 							MarkdownDescription: "The total number of allowed requests per rate-limiting period.",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtLeast(1),
+								int64validator.AtMost(8192),
+							},
 						},
 						"unit": schema.StringAttribute{
 							MarkdownDescription: "[Enum: SECOND|MINUTE|HOUR] Unit for the period per which the rate limit is applied. - SECOND: Second Rate limit period unit is seconds - MINUTE: Minute Rate limit period unit is minutes - HOUR: Hour Rate limit period unit is hours - DAY: Day Rate limit period unit is days. Possible values are `SECOND`, `MINUTE`, `HOUR`. Defaults to `SECOND`.",
@@ -237,6 +264,10 @@ func (r *RateLimiterResource) Schema(ctx context.Context, req resource.SchemaReq
 										"duration": schema.Int64Attribute{
 											MarkdownDescription: "Duration. Configuration parameter for duration",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+												int64validator.AtMost(48),
+											},
 										},
 									},
 								},
@@ -246,6 +277,10 @@ func (r *RateLimiterResource) Schema(ctx context.Context, req resource.SchemaReq
 										"duration": schema.Int64Attribute{
 											MarkdownDescription: "Duration. Configuration parameter for duration",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+												int64validator.AtMost(60),
+											},
 										},
 									},
 								},
@@ -255,6 +290,10 @@ func (r *RateLimiterResource) Schema(ctx context.Context, req resource.SchemaReq
 										"duration": schema.Int64Attribute{
 											MarkdownDescription: "Duration. Configuration parameter for duration",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(1),
+												int64validator.AtMost(300),
+											},
 										},
 									},
 								},
@@ -274,6 +313,10 @@ func (r *RateLimiterResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"user_identification": schema.ListNestedBlock{
 				MarkdownDescription: "Reference to user_identification object. The rules in the user_identification object are evaluated to determine the user identifier to be rate limited. Defaults to `[]`. Server applies default when omitted.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"kind": schema.StringAttribute{
@@ -319,6 +362,11 @@ func (r *RateLimiterResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *RateLimiterResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *RateLimiterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -343,6 +391,16 @@ func (r *RateLimiterResource) ValidateConfig(ctx context.Context, req resource.V
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *RateLimiterResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("limits").AtAnyListIndex(), "action_choice", "action_block", "disabled"),
+		validators.OneOfGroup(path.MatchRoot("limits").AtAnyListIndex(), "algorithm", "leaky_bucket", "token_bucket"),
+		validators.OneOfGroup(path.MatchRoot("limits").AtAnyListIndex().AtName("action_block"), "block_duration_choice", "hours", "minutes", "seconds"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *RateLimiterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -353,7 +411,10 @@ func (r *RateLimiterResource) ModifyPlan(ctx context.Context, req resource.Modif
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "rate_limiter", "", req.Config, &resp.Plan)...)
 		var plan RateLimiterResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -410,6 +471,7 @@ func (r *RateLimiterResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -419,6 +481,7 @@ func (r *RateLimiterResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Limits.IsNull() && !data.Limits.IsUnknown() {
@@ -511,11 +574,15 @@ func (r *RateLimiterResource) Create(ctx context.Context, req resource.CreateReq
 
 	apiResource, err := r.client.CreateRateLimiter(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create RateLimiter: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "rate_limiter", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -667,7 +734,7 @@ func (r *RateLimiterResource) Read(ctx context.Context, req resource.ReadRequest
 	apiResource, err := r.client.GetRateLimiter(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "RateLimiter not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -675,11 +742,12 @@ func (r *RateLimiterResource) Read(ctx context.Context, req resource.ReadRequest
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RateLimiter: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "rate_limiter", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -690,31 +758,13 @@ func (r *RateLimiterResource) Read(ctx context.Context, req resource.ReadRequest
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -722,6 +772,7 @@ func (r *RateLimiterResource) Read(ctx context.Context, req resource.ReadRequest
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if listData, ok := apiResource.Spec["limits"].([]interface{}); ok && len(listData) > 0 {
 		var limitsList []RateLimiterLimitsModel
@@ -885,6 +936,7 @@ func (r *RateLimiterResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -894,6 +946,7 @@ func (r *RateLimiterResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Limits.IsNull() && !data.Limits.IsUnknown() {
@@ -986,7 +1039,7 @@ func (r *RateLimiterResource) Update(ctx context.Context, req resource.UpdateReq
 
 	_, err := r.client.UpdateRateLimiter(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update RateLimiter: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "rate_limiter", "update"))
 		return
 	}
 
@@ -997,9 +1050,12 @@ func (r *RateLimiterResource) Update(ctx context.Context, req resource.UpdateReq
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetRateLimiter(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read RateLimiter after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "rate_limiter", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1151,7 +1207,7 @@ func (r *RateLimiterResource) Delete(ctx context.Context, req resource.DeleteReq
 	err := r.client.DeleteRateLimiter(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "RateLimiter already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1160,37 +1216,33 @@ func (r *RateLimiterResource) Delete(ctx context.Context, req resource.DeleteReq
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "RateLimiter delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete RateLimiter: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "rate_limiter", "delete"))
 		return
 	}
 }
 
 func (r *RateLimiterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *RateLimiterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("rate_limiter", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *RateLimiterResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListRateLimiters(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *RateLimiterResource) requiredNamespace() string {
+	return ""
 }
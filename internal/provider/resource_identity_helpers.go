@@ -0,0 +1,176 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// resource_identity_helpers.go - Manually maintained helpers for resource
+// identity. Resources identify their object by namespace and name, plus the
+// tenant that owns it, so that Terraform 1.12+ can import objects with the
+// identity attribute of import blocks and stores identities in state.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+// resourceIdentityModel is the identity of an object
+type resourceIdentityModel struct {
+	Namespace types.String `tfsdk:"namespace"`
+	Name      types.String `tfsdk:"name"`
+	Tenant    types.String `tfsdk:"tenant"`
+}
+
+// resourceIdentitySchema returns the identity schema shared by all resources.
+// The namespace can be left out for types that only exist in one namespace,
+// or when the provider has a default_namespace.
+func resourceIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"namespace": identityschema.StringAttribute{
+				Description:       "Namespace of the object.",
+				OptionalForImport: true,
+			},
+			"name": identityschema.StringAttribute{
+				Description:       "Name of the object.",
+				RequiredForImport: true,
+			},
+			"tenant": identityschema.StringAttribute{
+				Description:       "Tenant that owns the object. When set on import, it must match the tenant of the provider.",
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// parseImportID parses an import ID of the form name, namespace/name or
+// tenant/namespace/name
+func parseImportID(id string) (resourceIdentityModel, error) {
+	identity := resourceIdentityModel{
+		Namespace: types.StringNull(),
+		Tenant:    types.StringNull(),
+	}
+	parts := strings.Split(id, "/")
+	for _, part := range parts {
+		if part == "" {
+			return identity, fmt.Errorf("expected import ID format: name, namespace/name or tenant/namespace/name, got: %s", id)
+		}
+	}
+	switch len(parts) {
+	case 1:
+		identity.Name = types.StringValue(parts[0])
+	case 2:
+		identity.Namespace = types.StringValue(parts[0])
+		identity.Name = types.StringValue(parts[1])
+	case 3:
+		identity.Tenant = types.StringValue(parts[0])
+		identity.Namespace = types.StringValue(parts[1])
+		identity.Name = types.StringValue(parts[2])
+	default:
+		return identity, fmt.Errorf("expected import ID format: name, namespace/name or tenant/namespace/name, got: %s", id)
+	}
+	return identity, nil
+}
+
+// importResourceState imports the object named by the identity of an import
+// block or by an import ID. Without a namespace, types that only exist in
+// requiredNamespace use that namespace, and all other types the
+// default_namespace of the provider. Objects of types without a namespace
+// in the API path are imported by name only.
+func importResourceState(ctx context.Context, c *client.Client, namespaced bool, requiredNamespace string, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	var identity resourceIdentityModel
+	if req.ID == "" && req.Identity != nil && !req.Identity.Raw.IsNull() {
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		var err error
+		identity, err = parseImportID(req.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+			return
+		}
+	}
+
+	namespace := identity.Namespace.ValueString()
+	if !namespaced {
+		if namespace != "" || !identity.Tenant.IsNull() {
+			resp.Diagnostics.AddError("Invalid Import ID",
+				fmt.Sprintf("Expected import ID to be the resource name for this resource type, got: %s", req.ID))
+			return
+		}
+	} else if namespace == "" {
+		namespace = requiredNamespace
+		if namespace == "" && c != nil {
+			namespace = c.DefaultNamespace
+		}
+		if namespace == "" {
+			resp.Diagnostics.AddError("Invalid Import ID",
+				fmt.Sprintf("Expected import ID format: namespace/name or tenant/namespace/name, got: %s. "+
+					"The namespace can only be left out when default_namespace is set in the provider configuration.", req.ID))
+			return
+		}
+		identity.Namespace = types.StringValue(namespace)
+	}
+	name := identity.Name.ValueString()
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+	if resp.Identity != nil {
+		resp.Diagnostics.Append(resp.Identity.Set(ctx, identity)...)
+	}
+
+	// Set private state marker to indicate this is an import operation
+	// This allows Read to populate all nested blocks from API response
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, "isImport", []byte("true"))...)
+}
+
+// setResourceIdentity sets the identity of the object after Create, Read or
+// Update. Identities must not change once stored, so the tenant is only taken
+// from the system metadata of the object for new and imported objects. A
+// tenant given on import must match the tenant of the object.
+func setResourceIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, namespace, name string, metadata *client.SystemMetadata, followsImport bool) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if identity == nil {
+		return diags
+	}
+
+	current := resourceIdentityModel{Tenant: types.StringNull()}
+	if !identity.Raw.IsNull() && !identity.Raw.IsFullyNull() {
+		diags.Append(identity.Get(ctx, &current)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	tenant := current.Tenant
+	if metadata != nil && metadata.Tenant != "" {
+		if !tenant.IsNull() && tenant.ValueString() != metadata.Tenant {
+			diags.AddError("Tenant Mismatch",
+				fmt.Sprintf("The object %q in namespace %q belongs to tenant %q, not %q. "+
+					"Check that the provider configuration points to the tenant of the object.",
+					name, namespace, metadata.Tenant, tenant.ValueString()))
+			return diags
+		}
+		if current.Name.IsNull() || followsImport {
+			tenant = types.StringValue(metadata.Tenant)
+		}
+	}
+
+	diags.Append(identity.Set(ctx, resourceIdentityModel{
+		Namespace: stringValueOrNull(namespace),
+		Name:      types.StringValue(name),
+		Tenant:    tenant,
+	})...)
+	return diags
+}
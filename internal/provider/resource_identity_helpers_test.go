@@ -0,0 +1,161 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+// identityType is the Terraform type of resourceIdentitySchema
+var identityType = tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+	"namespace": tftypes.String,
+	"name":      tftypes.String,
+	"tenant":    tftypes.String,
+}}
+
+func identityValue(namespace, name, tenant interface{}) tftypes.Value {
+	return tftypes.NewValue(identityType, map[string]tftypes.Value{
+		"namespace": tftypes.NewValue(tftypes.String, namespace),
+		"name":      tftypes.NewValue(tftypes.String, name),
+		"tenant":    tftypes.NewValue(tftypes.String, tenant),
+	})
+}
+
+func identityPointer(v tftypes.Value) *tftypes.Value {
+	return &v
+}
+
+func TestParseImportID(t *testing.T) {
+	tests := []struct {
+		id      string
+		want    resourceIdentityModel
+		wantErr bool
+	}{
+		{"pool", resourceIdentityModel{Namespace: types.StringNull(), Name: types.StringValue("pool"), Tenant: types.StringNull()}, false},
+		{"staging/pool", resourceIdentityModel{Namespace: types.StringValue("staging"), Name: types.StringValue("pool"), Tenant: types.StringNull()}, false},
+		{"acme/staging/pool", resourceIdentityModel{Namespace: types.StringValue("staging"), Name: types.StringValue("pool"), Tenant: types.StringValue("acme")}, false},
+		{"", resourceIdentityModel{}, true},
+		{"staging/", resourceIdentityModel{}, true},
+		{"a/b/c/d", resourceIdentityModel{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.id, func(t *testing.T) {
+			got, err := parseImportID(tt.id)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseImportID(%q) error = %v, want error %t", tt.id, err, tt.wantErr)
+			}
+			if !tt.wantErr && got != tt.want {
+				t.Errorf("parseImportID(%q) = %+v, want %+v", tt.id, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportResourceState(t *testing.T) {
+	ctx := context.Background()
+	server, err := providerserver.NewProtocol6WithError(New("test")())()
+	if err != nil {
+		t.Fatalf("NewProtocol6WithError() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		typeName string
+		id       string
+		identity *tftypes.Value
+		want     tftypes.Value
+		wantErr  bool
+	}{
+		{"namespace and name", "f5xc_origin_pool", "staging/pool", nil, identityValue("staging", "pool", nil), false},
+		{"tenant", "f5xc_origin_pool", "acme/staging/pool", nil, identityValue("staging", "pool", "acme"), false},
+		{"system type by name", "f5xc_virtual_site", "vs", nil, identityValue("system", "vs", nil), false},
+		{"name without default namespace", "f5xc_origin_pool", "pool", nil, tftypes.Value{}, true},
+		{"identity", "f5xc_virtual_site", "", identityPointer(identityValue(nil, "vs", "acme")), identityValue("system", "vs", "acme"), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &tfprotov6.ImportResourceStateRequest{TypeName: tt.typeName, ID: tt.id}
+			if tt.identity != nil {
+				value, err := tfprotov6.NewDynamicValue(identityType, *tt.identity)
+				if err != nil {
+					t.Fatalf("NewDynamicValue() error = %v", err)
+				}
+				req.Identity = &tfprotov6.ResourceIdentityData{IdentityData: &value}
+			}
+
+			resp, err := server.ImportResourceState(ctx, req)
+			if err != nil {
+				t.Fatalf("ImportResourceState() error = %v", err)
+			}
+			hasError := false
+			for _, d := range resp.Diagnostics {
+				hasError = hasError || d.Severity == tfprotov6.DiagnosticSeverityError
+			}
+			if hasError != tt.wantErr {
+				t.Fatalf("ImportResourceState() diagnostics = %v, want error %t", resp.Diagnostics, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if len(resp.ImportedResources) != 1 || resp.ImportedResources[0].Identity == nil {
+				t.Fatalf("ImportResourceState() imported %v, want one resource with identity", resp.ImportedResources)
+			}
+			got, err := resp.ImportedResources[0].Identity.IdentityData.Unmarshal(identityType)
+			if err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("identity = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSetResourceIdentity(t *testing.T) {
+	ctx := context.Background()
+	metadata := &client.SystemMetadata{Tenant: "acme-abcdef"}
+
+	tests := []struct {
+		name          string
+		current       tftypes.Value
+		followsImport bool
+		want          tftypes.Value
+		wantErr       bool
+	}{
+		{"new object", tftypes.NewValue(identityType, nil), false, identityValue("staging", "pool", "acme-abcdef"), false},
+		{"stored identity is kept", identityValue("staging", "pool", nil), false, identityValue("staging", "pool", nil), false},
+		{"read after import", identityValue("staging", "pool", nil), true, identityValue("staging", "pool", "acme-abcdef"), false},
+		{"imported tenant matches", identityValue("staging", "pool", "acme-abcdef"), true, identityValue("staging", "pool", "acme-abcdef"), false},
+		{"imported tenant differs", identityValue("staging", "pool", "other"), true, tftypes.Value{}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			identity := &tfsdk.ResourceIdentity{Schema: resourceIdentitySchema(), Raw: tt.current}
+			diags := setResourceIdentity(ctx, identity, "staging", "pool", metadata, tt.followsImport)
+			if diags.HasError() != tt.wantErr {
+				t.Fatalf("setResourceIdentity() diagnostics = %v, want error %t", diags, tt.wantErr)
+			}
+			if !tt.wantErr && !identity.Raw.Equal(tt.want) {
+				t.Errorf("identity = %s, want %s", identity.Raw, tt.want)
+			}
+		})
+	}
+
+	// Terraform versions without identity support pass no identity
+	if diags := setResourceIdentity(ctx, nil, "staging", "pool", metadata, false); diags.HasError() {
+		t.Errorf("setResourceIdentity() without identity diagnostics = %v", diags)
+	}
+}
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,18 @@ type RouteDataSource struct {
 	client *client.Client
 }
 
+// RouteDataSourceModel mirrors RouteResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type RouteDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object `tfsdk:"system_metadata"`
+	Name           types.String `tfsdk:"name"`
+	Namespace      types.String `tfsdk:"namespace"`
+	Annotations    types.Map    `tfsdk:"annotations"`
+	Description    types.String `tfsdk:"description"`
+	Disable        types.Bool   `tfsdk:"disable"`
+	Labels         types.Map    `tfsdk:"labels"`
+	ID             types.String `tfsdk:"id"`
+	Routes         types.List   `tfsdk:"routes"`
 }
 
 func (d *RouteDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +45,7 @@ func (d *RouteDataSource) Metadata(ctx context.Context, req datasource.MetadataR
 }
 
 func (d *RouteDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages route object in a given namespace. Route object is list of route rules. Each rule has match condition to match incoming requests and actions to take on matching requests. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the Route.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Route exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the Route.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewRouteResource())
 }
 
 func (d *RouteDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +67,25 @@ func (d *RouteDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 
-	resource, err := d.client.GetRoute(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetRoute(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Route: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "route", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +99,8 @@ func (d *RouteDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +109,470 @@ func (d *RouteDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if listData, ok := apiResource.Spec["routes"].([]interface{}); ok && len(listData) > 0 {
+		var routesList []RouteRoutesModel
+		var existingRoutesItems []RouteRoutesModel
+		if !data.Routes.IsNull() && !data.Routes.IsUnknown() {
+			data.Routes.ElementsAs(ctx, &existingRoutesItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				routesList = append(routesList, RouteRoutesModel{
+					BotDefenseJavascriptInjection: func() *RouteRoutesBotDefenseJavascriptInjectionModel {
+						if nestedMap, ok := itemMap["bot_defense_javascript_injection"].(map[string]interface{}); ok {
+							return &RouteRoutesBotDefenseJavascriptInjectionModel{
+								JavascriptLocation: func() types.String {
+									if v, ok := nestedMap["javascript_location"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					DisableLocationAdd: func() types.Bool {
+						if v, ok := itemMap["disable_location_add"].(bool); ok {
+							return types.BoolValue(v)
+						}
+						return types.BoolNull()
+					}(),
+					InheritedBotDefenseJavascriptInjection: func() *RouteEmptyModel {
+						if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].InheritedBotDefenseJavascriptInjection != nil {
+							return &RouteEmptyModel{}
+						}
+						return nil
+					}(),
+					InheritedWAFExclusion: func() *RouteEmptyModel {
+						if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].InheritedWAFExclusion != nil {
+							return &RouteEmptyModel{}
+						}
+						return nil
+					}(),
+					Match: func() []RouteRoutesMatchModel {
+						if nestedListData, ok := itemMap["match"].([]interface{}); ok && len(nestedListData) > 0 {
+							var result []RouteRoutesMatchModel
+							for _, nestedItem := range nestedListData {
+								if nestedItemMap, ok := nestedItem.(map[string]interface{}); ok {
+									result = append(result, RouteRoutesMatchModel{
+										HTTPMethod: func() types.String {
+											if v, ok := nestedItemMap["http_method"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+									})
+								}
+							}
+							return result
+						}
+						return nil
+					}(),
+					RequestCookiesToAdd: func() []RouteRoutesRequestCookiesToAddModel {
+						if nestedListData, ok := itemMap["request_cookies_to_add"].([]interface{}); ok && len(nestedListData) > 0 {
+							var result []RouteRoutesRequestCookiesToAddModel
+							for _, nestedItem := range nestedListData {
+								if nestedItemMap, ok := nestedItem.(map[string]interface{}); ok {
+									result = append(result, RouteRoutesRequestCookiesToAddModel{
+										Name: func() types.String {
+											if v, ok := nestedItemMap["name"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+										Overwrite: func() types.Bool {
+											if v, ok := nestedItemMap["overwrite"].(bool); ok {
+												return types.BoolValue(v)
+											}
+											return types.BoolNull()
+										}(),
+										Value: func() types.String {
+											if v, ok := nestedItemMap["value"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+									})
+								}
+							}
+							return result
+						}
+						return nil
+					}(),
+					RequestCookiesToRemove: func() types.List {
+						if v, ok := itemMap["request_cookies_to_remove"].([]interface{}); ok && len(v) > 0 {
+							var items []string
+							for _, item := range v {
+								if s, ok := item.(string); ok {
+									items = append(items, s)
+								}
+							}
+							listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+							return listVal
+						}
+						return types.ListNull(types.StringType)
+					}(),
+					RequestHeadersToAdd: func() []RouteRoutesRequestHeadersToAddModel {
+						if nestedListData, ok := itemMap["request_headers_to_add"].([]interface{}); ok && len(nestedListData) > 0 {
+							var result []RouteRoutesRequestHeadersToAddModel
+							for _, nestedItem := range nestedListData {
+								if nestedItemMap, ok := nestedItem.(map[string]interface{}); ok {
+									result = append(result, RouteRoutesRequestHeadersToAddModel{
+										Append: func() types.Bool {
+											if v, ok := nestedItemMap["append"].(bool); ok {
+												return types.BoolValue(v)
+											}
+											return types.BoolNull()
+										}(),
+										Name: func() types.String {
+											if v, ok := nestedItemMap["name"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+										Value: func() types.String {
+											if v, ok := nestedItemMap["value"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+									})
+								}
+							}
+							return result
+						}
+						return nil
+					}(),
+					RequestHeadersToRemove: func() types.List {
+						if v, ok := itemMap["request_headers_to_remove"].([]interface{}); ok && len(v) > 0 {
+							var items []string
+							for _, item := range v {
+								if s, ok := item.(string); ok {
+									items = append(items, s)
+								}
+							}
+							listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+							return listVal
+						}
+						return types.ListNull(types.StringType)
+					}(),
+					ResponseCookiesToAdd: func() []RouteRoutesResponseCookiesToAddModel {
+						if nestedListData, ok := itemMap["response_cookies_to_add"].([]interface{}); ok && len(nestedListData) > 0 {
+							var result []RouteRoutesResponseCookiesToAddModel
+							for _, nestedItem := range nestedListData {
+								if nestedItemMap, ok := nestedItem.(map[string]interface{}); ok {
+									result = append(result, RouteRoutesResponseCookiesToAddModel{
+										AddDomain: func() types.String {
+											if v, ok := nestedItemMap["add_domain"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+										AddExpiry: func() types.String {
+											if v, ok := nestedItemMap["add_expiry"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+										AddPath: func() types.String {
+											if v, ok := nestedItemMap["add_path"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+										MaxAgeValue: func() types.Int64 {
+											if v, ok := nestedItemMap["max_age_value"].(float64); ok && v != 0 {
+												return types.Int64Value(int64(v))
+											}
+											return types.Int64Null()
+										}(),
+										Name: func() types.String {
+											if v, ok := nestedItemMap["name"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+										Overwrite: func() types.Bool {
+											if v, ok := nestedItemMap["overwrite"].(bool); ok {
+												return types.BoolValue(v)
+											}
+											return types.BoolNull()
+										}(),
+										Value: func() types.String {
+											if v, ok := nestedItemMap["value"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+									})
+								}
+							}
+							return result
+						}
+						return nil
+					}(),
+					ResponseCookiesToRemove: func() types.List {
+						if v, ok := itemMap["response_cookies_to_remove"].([]interface{}); ok && len(v) > 0 {
+							var items []string
+							for _, item := range v {
+								if s, ok := item.(string); ok {
+									items = append(items, s)
+								}
+							}
+							listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+							return listVal
+						}
+						return types.ListNull(types.StringType)
+					}(),
+					ResponseHeadersToAdd: func() []RouteRoutesResponseHeadersToAddModel {
+						if nestedListData, ok := itemMap["response_headers_to_add"].([]interface{}); ok && len(nestedListData) > 0 {
+							var result []RouteRoutesResponseHeadersToAddModel
+							for _, nestedItem := range nestedListData {
+								if nestedItemMap, ok := nestedItem.(map[string]interface{}); ok {
+									result = append(result, RouteRoutesResponseHeadersToAddModel{
+										Append: func() types.Bool {
+											if v, ok := nestedItemMap["append"].(bool); ok {
+												return types.BoolValue(v)
+											}
+											return types.BoolNull()
+										}(),
+										Name: func() types.String {
+											if v, ok := nestedItemMap["name"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+										Value: func() types.String {
+											if v, ok := nestedItemMap["value"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+									})
+								}
+							}
+							return result
+						}
+						return nil
+					}(),
+					ResponseHeadersToRemove: func() types.List {
+						if v, ok := itemMap["response_headers_to_remove"].([]interface{}); ok && len(v) > 0 {
+							var items []string
+							for _, item := range v {
+								if s, ok := item.(string); ok {
+									items = append(items, s)
+								}
+							}
+							listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+							return listVal
+						}
+						return types.ListNull(types.StringType)
+					}(),
+					RouteDestination: func() *RouteRoutesRouteDestinationModel {
+						if nestedMap, ok := itemMap["route_destination"].(map[string]interface{}); ok {
+							return &RouteRoutesRouteDestinationModel{
+								AutoHostRewrite: func() types.Bool {
+									if v, ok := nestedMap["auto_host_rewrite"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+								DoNotRetractCluster: func() *RouteEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].RouteDestination != nil && existingRoutesItems[listIdx].RouteDestination.DoNotRetractCluster != nil {
+										return &RouteEmptyModel{}
+									}
+									return nil
+								}(),
+								EndpointSubsets: func() *RouteEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].RouteDestination != nil && existingRoutesItems[listIdx].RouteDestination.EndpointSubsets != nil {
+										return &RouteEmptyModel{}
+									}
+									return nil
+								}(),
+								HostRewrite: func() types.String {
+									if v, ok := nestedMap["host_rewrite"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								PrefixRewrite: func() types.String {
+									if v, ok := nestedMap["prefix_rewrite"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Priority: func() types.String {
+									if v, ok := nestedMap["priority"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								RetractCluster: func() *RouteEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].RouteDestination != nil && existingRoutesItems[listIdx].RouteDestination.RetractCluster != nil {
+										return &RouteEmptyModel{}
+									}
+									return nil
+								}(),
+								Timeout: func() types.Int64 {
+									if v, ok := nestedMap["timeout"].(float64); ok && v != 0 {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					RouteDirectResponse: func() *RouteRoutesRouteDirectResponseModel {
+						if nestedMap, ok := itemMap["route_direct_response"].(map[string]interface{}); ok {
+							return &RouteRoutesRouteDirectResponseModel{
+								ResponseBodyEncoded: func() types.String {
+									if v, ok := nestedMap["response_body_encoded"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								ResponseCode: func() types.Int64 {
+									if v, ok := nestedMap["response_code"].(float64); ok && v != 0 {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					RouteRedirect: func() *RouteRoutesRouteRedirectModel {
+						if nestedMap, ok := itemMap["route_redirect"].(map[string]interface{}); ok {
+							return &RouteRoutesRouteRedirectModel{
+								HostRedirect: func() types.String {
+									if v, ok := nestedMap["host_redirect"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								PathRedirect: func() types.String {
+									if v, ok := nestedMap["path_redirect"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								PrefixRewrite: func() types.String {
+									if v, ok := nestedMap["prefix_rewrite"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								ProtoRedirect: func() types.String {
+									if v, ok := nestedMap["proto_redirect"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								RemoveAllParams: func() *RouteEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].RouteRedirect != nil && existingRoutesItems[listIdx].RouteRedirect.RemoveAllParams != nil {
+										return &RouteEmptyModel{}
+									}
+									return nil
+								}(),
+								ReplaceParams: func() types.String {
+									if v, ok := nestedMap["replace_params"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								ResponseCode: func() types.Int64 {
+									if v, ok := nestedMap["response_code"].(float64); ok && v != 0 {
+										return types.Int64Value(int64(v))
+									}
+									return types.Int64Null()
+								}(),
+								RetainAllParams: func() *RouteEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].RouteRedirect != nil && existingRoutesItems[listIdx].RouteRedirect.RetainAllParams != nil {
+										return &RouteEmptyModel{}
+									}
+									return nil
+								}(),
+							}
+						}
+						return nil
+					}(),
+					ServicePolicy: func() *RouteRoutesServicePolicyModel {
+						if nestedMap, ok := itemMap["service_policy"].(map[string]interface{}); ok {
+							return &RouteRoutesServicePolicyModel{
+								Disable: func() types.Bool {
+									if v, ok := nestedMap["disable"].(bool); ok {
+										return types.BoolValue(v)
+									}
+									return types.BoolNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					WAFExclusionPolicy: func() *RouteRoutesWAFExclusionPolicyModel {
+						if nestedMap, ok := itemMap["waf_exclusion_policy"].(map[string]interface{}); ok {
+							return &RouteRoutesWAFExclusionPolicyModel{
+								Name: func() types.String {
+									if v, ok := nestedMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := nestedMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := nestedMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							}
+						}
+						return nil
+					}(),
+					WAFType: func() *RouteRoutesWAFTypeModel {
+						if _, ok := itemMap["waf_type"].(map[string]interface{}); ok {
+							return &RouteRoutesWAFTypeModel{
+								DisableWAF: func() *RouteEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].WAFType != nil && existingRoutesItems[listIdx].WAFType.DisableWAF != nil {
+										return &RouteEmptyModel{}
+									}
+									return nil
+								}(),
+								InheritWAF: func() *RouteEmptyModel {
+									if !isImport && len(existingRoutesItems) > listIdx && existingRoutesItems[listIdx].WAFType != nil && existingRoutesItems[listIdx].WAFType.InheritWAF != nil {
+										return &RouteEmptyModel{}
+									}
+									return nil
+								}(),
+							}
+						}
+						return nil
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: RouteRoutesModelAttrTypes}, routesList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.Routes = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.Routes = types.ListNull(types.ObjectType{AttrTypes: RouteRoutesModelAttrTypes})
+	}
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewRouteResource(), &data)...)
 }
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &RouteResource{}
-	_ resource.ResourceWithConfigure      = &RouteResource{}
-	_ resource.ResourceWithImportState    = &RouteResource{}
-	_ resource.ResourceWithModifyPlan     = &RouteResource{}
-	_ resource.ResourceWithValidateConfig = &RouteResource{}
+	_ resource.Resource                     = &RouteResource{}
+	_ resource.ResourceWithConfigure        = &RouteResource{}
+	_ resource.ResourceWithImportState      = &RouteResource{}
+	_ resource.ResourceWithIdentity         = &RouteResource{}
+	_ resource.ResourceWithMoveState        = &RouteResource{}
+	_ resource.ResourceWithModifyPlan       = &RouteResource{}
+	_ resource.ResourceWithValidateConfig   = &RouteResource{}
+	_ resource.ResourceWithConfigValidators = &RouteResource{}
+	_ listableResource                      = &RouteResource{}
 )
 
 func NewRouteResource() resource.Resource {
@@ -249,14 +256,16 @@ var RouteRoutesRequestCookiesToAddSecretValueBlindfoldSecretInfoModelAttrTypes =
 
 // RouteRoutesRequestCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type RouteRoutesRequestCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // RouteRoutesRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for RouteRoutesRequestCookiesToAddSecretValueClearSecretInfoModel
 var RouteRoutesRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // RouteRoutesRequestHeadersToAddModel represents request_headers_to_add block
@@ -303,14 +312,16 @@ var RouteRoutesRequestHeadersToAddSecretValueBlindfoldSecretInfoModelAttrTypes =
 
 // RouteRoutesRequestHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type RouteRoutesRequestHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // RouteRoutesRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for RouteRoutesRequestHeadersToAddSecretValueClearSecretInfoModel
 var RouteRoutesRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // RouteRoutesResponseCookiesToAddModel represents response_cookies_to_add block
@@ -395,14 +406,16 @@ var RouteRoutesResponseCookiesToAddSecretValueBlindfoldSecretInfoModelAttrTypes
 
 // RouteRoutesResponseCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type RouteRoutesResponseCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // RouteRoutesResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for RouteRoutesResponseCookiesToAddSecretValueClearSecretInfoModel
 var RouteRoutesResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // RouteRoutesResponseHeadersToAddModel represents response_headers_to_add block
@@ -449,14 +462,16 @@ var RouteRoutesResponseHeadersToAddSecretValueBlindfoldSecretInfoModelAttrTypes
 
 // RouteRoutesResponseHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type RouteRoutesResponseHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // RouteRoutesResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for RouteRoutesResponseHeadersToAddSecretValueClearSecretInfoModel
 var RouteRoutesResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // RouteRoutesRouteDestinationModel represents route_destination block
@@ -866,15 +881,17 @@ var RouteRoutesWAFTypeAppFirewallAppFirewallModelAttrTypes = map[string]attr.Typ
 }
 
 type RouteResourceModel struct {
-	Name        types.String   `tfsdk:"name"`
-	Namespace   types.String   `tfsdk:"namespace"`
-	Annotations types.Map      `tfsdk:"annotations"`
-	Description types.String   `tfsdk:"description"`
-	Disable     types.Bool     `tfsdk:"disable"`
-	Labels      types.Map      `tfsdk:"labels"`
-	ID          types.String   `tfsdk:"id"`
-	Timeouts    timeouts.Value `tfsdk:"timeouts"`
-	Routes      types.List     `tfsdk:"routes"`
+	Name           types.String   `tfsdk:"name"`
+	Namespace      types.String   `tfsdk:"namespace"`
+	Annotations    types.Map      `tfsdk:"annotations"`
+	Description    types.String   `tfsdk:"description"`
+	Disable        types.Bool     `tfsdk:"disable"`
+	Labels         types.Map      `tfsdk:"labels"`
+	ID             types.String   `tfsdk:"id"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+	SystemMetadata types.Object   `tfsdk:"system_metadata"`
+	LabelsAll      types.Map      `tfsdk:"labels_all"`
+	Routes         types.List     `tfsdk:"routes"`
 }
 
 func (r *RouteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -896,9 +913,11 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Route will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Route will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -930,6 +949,8 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -940,6 +961,10 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 			}),
 			"routes": schema.ListNestedBlock{
 				MarkdownDescription: "List of routes to match for incoming request .",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(257),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"disable_location_add": schema.BoolAttribute{
@@ -950,21 +975,39 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							MarkdownDescription: "List of keys of Cookies to be removed from the HTTP request being sent towards upstream.",
 							Optional:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(32),
+								listvalidator.UniqueValues(),
+								listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+							},
 						},
 						"request_headers_to_remove": schema.ListAttribute{
 							MarkdownDescription: "List of keys of Headers to be removed from the HTTP request being sent towards upstream.",
 							Optional:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(32),
+								listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+							},
 						},
 						"response_cookies_to_remove": schema.ListAttribute{
 							MarkdownDescription: "List of name of Cookies to be removed from the HTTP response being sent towards downstream. Entire set-cookie header will be removed.",
 							Optional:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(32),
+								listvalidator.UniqueValues(),
+								listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+							},
 						},
 						"response_headers_to_remove": schema.ListAttribute{
 							MarkdownDescription: "List of keys of Headers to be removed from the HTTP response being sent towards downstream.",
 							Optional:            true,
 							ElementType:         types.StringType,
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(32),
+								listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -979,16 +1022,26 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 							Blocks: map[string]schema.Block{
 								"javascript_tags": schema.ListNestedBlock{
 									MarkdownDescription: "Select Add item to configure your javascript tag. If adding both Bot Adv and Fraud, the Bot Javascript should be added first.",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(5),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"javascript_url": schema.StringAttribute{
 												MarkdownDescription: "Please enter the full URL (include domain and path), or relative path.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(2048),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
 											"tag_attributes": schema.ListNestedBlock{
 												MarkdownDescription: "Add the tag attributes you want to include in your Javascript tag.",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(9),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"javascript_tag": schema.StringAttribute{
@@ -998,6 +1051,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														"tag_value": schema.StringAttribute{
 															MarkdownDescription: "Value. Add the tag attribute value.",
 															Optional:            true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(1024),
+															},
 														},
 													},
 												},
@@ -1015,6 +1071,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						},
 						"match": schema.ListNestedBlock{
 							MarkdownDescription: "Match. Route match condition.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(16),
+							},
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
 									"http_method": schema.StringAttribute{
@@ -1025,11 +1084,18 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								Blocks: map[string]schema.Block{
 									"headers": schema.ListNestedBlock{
 										MarkdownDescription: "Headers. List of (key, value) headers.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(16),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"exact": schema.StringAttribute{
 													MarkdownDescription: "Header value to match exactly.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(256),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"invert_match": schema.BoolAttribute{
 													MarkdownDescription: "Invert the result of the match to detect missing header or non-matching value.",
@@ -1038,6 +1104,10 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"name": schema.StringAttribute{
 													MarkdownDescription: "Name. Name of the header .",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(256),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"presence": schema.BoolAttribute{
 													MarkdownDescription: "If true, check for presence of header.",
@@ -1046,6 +1116,11 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"regex": schema.StringAttribute{
 													MarkdownDescription: "Regex match of the header value in re2 format.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(256),
+														stringvalidator.LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 											},
 										},
@@ -1056,10 +1131,17 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											"port": schema.Int64Attribute{
 												MarkdownDescription: "Exact Port to match.",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtMost(65535),
+												},
 											},
 											"port_ranges": schema.StringAttribute{
 												MarkdownDescription: "Port range to match.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.UTF8LengthAtMost(32),
+													stringvalidator.UTF8LengthAtLeast(1),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
@@ -1074,19 +1156,33 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											"path": schema.StringAttribute{
 												MarkdownDescription: "Exact path value to match.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.UTF8LengthAtMost(256),
+												},
 											},
 											"prefix": schema.StringAttribute{
 												MarkdownDescription: "Path prefix to match (e.g. The value / will match on all paths)",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.UTF8LengthAtMost(256),
+												},
 											},
 											"regex": schema.StringAttribute{
 												MarkdownDescription: "Regular expression of path match (e.g. The value .* will match on all paths).",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(256),
+													stringvalidator.LengthAtLeast(1),
+													validators.RegexValidator(),
+												},
 											},
 										},
 									},
 									"query_params": schema.ListNestedBlock{
 										MarkdownDescription: "Query Parameters. List of (key, value) query parameters.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(16),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"exact": schema.StringAttribute{
@@ -1096,10 +1192,19 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"key": schema.StringAttribute{
 													MarkdownDescription: "Query parameter key In the above example, assignee_username is the key .",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(256),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"regex": schema.StringAttribute{
 													MarkdownDescription: "Regex match value for the query parameter key.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(256),
+														stringvalidator.LengthAtLeast(1),
+														validators.RegexValidator(),
+													},
 												},
 											},
 										},
@@ -1109,11 +1214,17 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						},
 						"request_cookies_to_add": schema.ListNestedBlock{
 							MarkdownDescription: "Cookies are key-value pairs to be added to HTTP request being routed towards upstream.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(32),
+							},
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
 									"name": schema.StringAttribute{
 										MarkdownDescription: "Name of the cookie in Cookie header.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(256),
+										},
 									},
 									"overwrite": schema.BoolAttribute{
 										MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -1122,6 +1233,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									"value": schema.StringAttribute{
 										MarkdownDescription: "Value of the Cookie header.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(8096),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1154,7 +1268,16 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -1166,6 +1289,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						},
 						"request_headers_to_add": schema.ListNestedBlock{
 							MarkdownDescription: "Headers are key-value pairs to be added to HTTP requests being sent towards upstream. Headers specified at this level are applied before headers from the enclosing VirtualHost object level.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(32),
+							},
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
 									"append": schema.BoolAttribute{
@@ -1175,10 +1301,16 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									"name": schema.StringAttribute{
 										MarkdownDescription: "Name. Name of the HTTP header.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(256),
+										},
 									},
 									"value": schema.StringAttribute{
 										MarkdownDescription: "Value of the HTTP header.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(8096),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1211,7 +1343,16 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -1223,27 +1364,47 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						},
 						"response_cookies_to_add": schema.ListNestedBlock{
 							MarkdownDescription: "Cookies are name-value pairs along with optional attribute parameters to be added to HTTP response being sent towards downstream.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(32),
+							},
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
 									"add_domain": schema.StringAttribute{
 										MarkdownDescription: "Add domain attribute.",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.HostnameValidator(),
+											stringvalidator.UTF8LengthAtMost(256),
+											stringvalidator.UTF8LengthAtLeast(1),
+										},
 									},
 									"add_expiry": schema.StringAttribute{
 										MarkdownDescription: "Add expiry attribute.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(256),
+										},
 									},
 									"add_path": schema.StringAttribute{
 										MarkdownDescription: "Add path attribute.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(256),
+										},
 									},
 									"max_age_value": schema.Int64Attribute{
 										MarkdownDescription: "Add max age attribute.",
 										Optional:            true,
+										Validators: []validator.Int64{
+											int64validator.AtMost(34560000),
+										},
 									},
 									"name": schema.StringAttribute{
 										MarkdownDescription: "Name of the cookie in Cookie header.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(256),
+										},
 									},
 									"overwrite": schema.BoolAttribute{
 										MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -1252,6 +1413,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									"value": schema.StringAttribute{
 										MarkdownDescription: "Value of the Cookie header.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(8096),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1329,7 +1493,16 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -1341,6 +1514,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						},
 						"response_headers_to_add": schema.ListNestedBlock{
 							MarkdownDescription: "Headers are key-value pairs to be added to HTTP response being sent towards downstream. Headers specified at this level are applied before headers from the enclosing VirtualHost object level.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(32),
+							},
 							NestedObject: schema.NestedBlockObject{
 								Attributes: map[string]schema.Attribute{
 									"append": schema.BoolAttribute{
@@ -1350,10 +1526,16 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									"name": schema.StringAttribute{
 										MarkdownDescription: "Name. Name of the HTTP header.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(256),
+										},
 									},
 									"value": schema.StringAttribute{
 										MarkdownDescription: "Value of the HTTP header.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.UTF8LengthAtMost(8096),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1386,7 +1568,16 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -1406,10 +1597,17 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								"host_rewrite": schema.StringAttribute{
 									MarkdownDescription: "Indicates that during forwarding, the host header will be swapped with this value.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"prefix_rewrite": schema.StringAttribute{
 									MarkdownDescription: "prefix_rewrite indicates that during forwarding, the matched prefix (or path) should be swapped with its value. When using regex path matching, the entire path (not including the query string) will be swapped with this value. This option allows application URLs to..",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"priority": schema.StringAttribute{
 									MarkdownDescription: "[Enum: DEFAULT|HIGH] Priority routing for each request. Different connection pools are used based on the priority selected for the request. Also, circuit-breaker configuration at destination cluster is chosen based on selected priority. Possible values are `DEFAULT`, `HIGH`. Defaults to `DEFAULT`.",
@@ -1418,6 +1616,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								"timeout": schema.Int64Attribute{
 									MarkdownDescription: "Specifies the timeout for the route in milliseconds. This timeout includes all retries. For server side streaming, configure this field with higher value or leave it un-configured for infinite timeout.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtMost(1800000),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -1431,6 +1632,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"max_request_bytes": schema.Int64Attribute{
 											MarkdownDescription: "The maximum request size that the filter will buffer before the connection manager will stop buffering and return a RequestEntityTooLarge (413) response.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtMost(10485760),
+											},
 										},
 									},
 								},
@@ -1453,11 +1657,21 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											MarkdownDescription: "Specifies the origins that will be allowed to do CORS requests. An origin is allowed if either allow_origin or allow_origin_regex match.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(128),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1)),
+											},
 										},
 										"allow_origin_regex": schema.ListAttribute{
 											MarkdownDescription: "Specifies regex patterns that match allowed origins. An origin is allowed if either allow_origin or allow_origin_regex match.",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+												listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+											},
 										},
 										"disabled": schema.BoolAttribute{
 											MarkdownDescription: "Disable the CorsPolicy for a particular route. This is useful when virtual-host has CorsPolicy, but we need to disable it on a specific route. The value of this field is ignored for virtual-host.",
@@ -1470,6 +1684,10 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"maximum_age": schema.Int64Attribute{
 											MarkdownDescription: "Specifies the content for the access-control-max-age header in seconds. This indicates the maximum number of seconds the results can be cached A value of -1 will disable caching. Maximum permitted value is 86400 seconds (24 hours).",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(-1),
+												int64validator.AtMost(86400),
+											},
 										},
 									},
 								},
@@ -1487,6 +1705,12 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													MarkdownDescription: "List of domain names that will be matched to loadbalancer. These domains are not used for SNI match. Wildcard names are supported in the suffix or prefix form.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -1497,20 +1721,32 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								},
 								"destinations": schema.ListNestedBlock{
 									MarkdownDescription: "When requests have to distributed among multiple upstream clusters, multiple destinations are configured, each having its own cluster and weight. Traffic is distributed among clusters based on the weight configured.",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"priority": schema.Int64Attribute{
 												MarkdownDescription: "Priority of this cluster, valid only with multiple destinations are configured. Value of 0 will make the cluster as lowest priority upstream cluster Priority of 1 means highest priority and is considered active. When active cluster is not available, lower priority clusters are made active as per..",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtMost(32),
+												},
 											},
 											"weight": schema.Int64Attribute{
 												MarkdownDescription: "When requests have to distributed among multiple upstream clusters, multiple destinations are configured, each having its own cluster and weight. Traffic is distributed among clusters based on the weight configured.",
 												Optional:            true,
+												Validators: []validator.Int64{
+													int64validator.AtMost(65535),
+												},
 											},
 										},
 										Blocks: map[string]schema.Block{
 											"cluster": schema.ListNestedBlock{
 												MarkdownDescription: "Indicates the upstream cluster to which the request should be sent. If the cluster does not exist ServiceUnavailable response will be sent .",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(1),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"kind": schema.StringAttribute{
@@ -1566,11 +1802,18 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								},
 								"hash_policy": schema.ListNestedBlock{
 									MarkdownDescription: "Specifies a list of hash policies to use for ring hash load balancing. Each hash policy is evaluated individually and the combined result is used to route the request.",
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(8),
+									},
 									NestedObject: schema.NestedBlockObject{
 										Attributes: map[string]schema.Attribute{
 											"header_name": schema.StringAttribute{
 												MarkdownDescription: "The name or key of the request header that will be used to obtain the hash key.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.UTF8LengthAtMost(256),
+													stringvalidator.UTF8LengthAtLeast(1),
+												},
 											},
 											"source_ip": schema.BoolAttribute{
 												MarkdownDescription: "Hash based on source IP address.",
@@ -1588,6 +1831,10 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 													"name": schema.StringAttribute{
 														MarkdownDescription: "The name of the cookie that will be used to obtain the hash key. If the cookie is not present and TTL below is not set, no hash will be produced .",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.UTF8LengthAtMost(256),
+															stringvalidator.UTF8LengthAtLeast(1),
+														},
 													},
 													"path": schema.StringAttribute{
 														MarkdownDescription: "The name of the path for the cookie. If no path is specified here, no path will be set for the cookie.",
@@ -1634,6 +1881,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									Blocks: map[string]schema.Block{
 										"cluster": schema.ListNestedBlock{
 											MarkdownDescription: "Specifies the cluster to which the requests will be mirrored. The cluster object referred here must be present.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(1),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"kind": schema.StringAttribute{
@@ -1696,6 +1946,10 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"replace_params": schema.StringAttribute{
 											MarkdownDescription: ".",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -1713,10 +1967,18 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"pattern": schema.StringAttribute{
 											MarkdownDescription: "The regular expression used to find portions of a string that should be replaced.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+												stringvalidator.UTF8LengthAtLeast(1),
+												validators.RegexValidator(),
+											},
 										},
 										"substitution": schema.StringAttribute{
 											MarkdownDescription: "The string that should be substituted into matching portions of the subject string during a substitution operation to produce a new string.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 									},
 								},
@@ -1729,20 +1991,35 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 										"num_retries": schema.Int64Attribute{
 											MarkdownDescription: "Specifies the allowed number of retries. Retries can be done any number of times. An exponential back-off algorithm is used between each retry. Defaults to `1`.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtMost(8),
+											},
 										},
 										"per_try_timeout": schema.Int64Attribute{
 											MarkdownDescription: "Specifies a non-zero timeout per retry attempt. In milliseconds.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtMost(600000),
+											},
 										},
 										"retriable_status_codes": schema.ListAttribute{
 											MarkdownDescription: "HTTP status codes that should trigger a retry in addition to those specified by retry_on.",
 											Optional:            true,
 											ElementType:         types.Int64Type,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+												listvalidator.UniqueValues(),
+											},
 										},
 										"retry_condition": schema.ListAttribute{
 											MarkdownDescription: "Specifies the conditions under which retry takes place. Retries can be on different types of condition depending on application requirements. For example, network failure, all 5xx response codes, idempotent 4xx response codes, etc The possible values are '5xx' : Retry will be done if the..",
 											Optional:            true,
 											ElementType:         types.StringType,
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(7),
+												listvalidator.SizeAtLeast(1),
+												listvalidator.UniqueValues(),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -1752,6 +2029,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												"base_interval": schema.Int64Attribute{
 													MarkdownDescription: "Specifies the base interval between retries in milliseconds.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+													},
 												},
 												"max_interval": schema.Int64Attribute{
 													MarkdownDescription: "Specifies the maximum interval between retries in milliseconds. This parameter is optional, but must be greater than or equal to the base_interval if set. The  times the base_interval. Defaults to `10`.",
@@ -1787,10 +2067,17 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								"response_body_encoded": schema.StringAttribute{
 									MarkdownDescription: "Response body to send. Currently supported URL schemes is string:/// for which message should be encoded in Base64 format. The message can be either plain text or HTML.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(65536),
+									},
 								},
 								"response_code": schema.Int64Attribute{
 									MarkdownDescription: "Response Code. Response code to send.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(100),
+										int64validator.AtMost(599),
+									},
 								},
 							},
 						},
@@ -1804,10 +2091,16 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								"path_redirect": schema.StringAttribute{
 									MarkdownDescription: "swap path part of incoming URL in redirect URL.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"prefix_rewrite": schema.StringAttribute{
 									MarkdownDescription: "In Redirect response, the matched prefix (or path) should be swapped with this value. This option allows redirect URLs be dynamically created based on the request.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+									},
 								},
 								"proto_redirect": schema.StringAttribute{
 									MarkdownDescription: "Swap protocol part of incoming URL in redirect URL The protocol can be swapped with either HTTP or HTTPS When incoming-proto option is specified, swapping of protocol is not done.",
@@ -1816,10 +2109,17 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								"replace_params": schema.StringAttribute{
 									MarkdownDescription: ".",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(256),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 								"response_code": schema.Int64Attribute{
 									MarkdownDescription: "The HTTP status code to use in the redirect response.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtMost(599),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -1846,6 +2146,10 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1854,6 +2158,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1862,6 +2169,9 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -1933,6 +2243,11 @@ func (r *RouteResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *RouteResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *RouteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -1957,6 +2272,48 @@ func (r *RouteResource) ValidateConfig(ctx context.Context, req resource.Validat
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *RouteResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex(), "bot_defense_javascript_injection_choice", "bot_defense_javascript_injection", "inherited_bot_defense_javascript_injection"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex(), "route_action", "route_destination", "route_direct_response", "route_redirect"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex(), "waf_exclusion_choice", "inherited_waf_exclusion", "waf_exclusion_policy"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("match").AtAnyListIndex().AtName("headers").AtAnyListIndex(), "value_match", "exact", "presence", "regex"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("match").AtAnyListIndex().AtName("incoming_port"), "port_match", "no_port_match", "port", "port_ranges"),
+		validators.RequiredOneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("match").AtAnyListIndex().AtName("path"), "path_match", "path", "prefix", "regex"),
+		validators.RequiredOneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("match").AtAnyListIndex().AtName("query_params").AtAnyListIndex(), "value_match", "exact", "regex"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("request_cookies_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("request_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("request_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("request_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("response_cookies_to_add").AtAnyListIndex(), "domain_choice", "add_domain", "ignore_domain"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("response_cookies_to_add").AtAnyListIndex(), "expiry_choice", "add_expiry", "ignore_expiry"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("response_cookies_to_add").AtAnyListIndex(), "httponly_choice", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("response_cookies_to_add").AtAnyListIndex(), "max_age_choice", "ignore_max_age", "max_age_value"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("response_cookies_to_add").AtAnyListIndex(), "partitioned_choice", "add_partitioned", "ignore_partitioned"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("response_cookies_to_add").AtAnyListIndex(), "path_choice", "add_path", "ignore_path"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("response_cookies_to_add").AtAnyListIndex(), "samesite_choice", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("response_cookies_to_add").AtAnyListIndex(), "secure_choice", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("response_cookies_to_add").AtAnyListIndex(), "value_choice", "ignore_value", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("response_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("response_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("response_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("route_destination"), "cluster_retract_choice", "do_not_retract_cluster", "retract_cluster"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("route_destination"), "host_rewrite_params", "auto_host_rewrite", "host_rewrite"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("route_destination"), "route_destination_rewrite", "prefix_rewrite", "regex_rewrite"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("route_destination").AtName("csrf_policy"), "allowed_domains", "all_load_balancer_domains", "custom_domain_list", "disabled"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("route_destination").AtName("hash_policy").AtAnyListIndex(), "policy_specifier", "cookie", "header_name", "source_ip"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("route_destination").AtName("hash_policy").AtAnyListIndex().AtName("cookie"), "httponly", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("route_destination").AtName("hash_policy").AtAnyListIndex().AtName("cookie"), "samesite", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("route_destination").AtName("hash_policy").AtAnyListIndex().AtName("cookie"), "secure", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("route_destination").AtName("query_params"), "query_params", "remove_all_params", "replace_params", "retain_all_params"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("route_redirect"), "query_params", "remove_all_params", "replace_params", "retain_all_params"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("route_redirect"), "redirect_path_choice", "path_redirect", "prefix_rewrite"),
+		validators.OneOfGroup(path.MatchRoot("routes").AtAnyListIndex().AtName("waf_type"), "ref_type", "app_firewall", "disable_waf", "inherit_waf"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *RouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -1967,7 +2324,10 @@ func (r *RouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "route", "system", req.Config, &resp.Plan)...)
 		var plan RouteResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -1985,7 +2345,8 @@ func (r *RouteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanR
 
 func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data RouteResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2024,6 +2385,7 @@ func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -2033,6 +2395,7 @@ func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Routes.IsNull() && !data.Routes.IsUnknown() {
@@ -2217,6 +2580,17 @@ func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest,
 						if item.RouteDestination.CSRFPolicy.AllLoadBalancerDomains != nil {
 							csrf_policyDeepMap["all_load_balancer_domains"] = map[string]interface{}{}
 						}
+						if item.RouteDestination.CSRFPolicy.CustomDomainList != nil {
+							custom_domain_listLevel4Map := make(map[string]interface{})
+							if !item.RouteDestination.CSRFPolicy.CustomDomainList.Domains.IsNull() && !item.RouteDestination.CSRFPolicy.CustomDomainList.Domains.IsUnknown() {
+								var domainsLevel4Items []string
+								diags := item.RouteDestination.CSRFPolicy.CustomDomainList.Domains.ElementsAs(ctx, &domainsLevel4Items, false)
+								if !diags.HasError() {
+									custom_domain_listLevel4Map["domains"] = domainsLevel4Items
+								}
+							}
+							csrf_policyDeepMap["custom_domain_list"] = custom_domain_listLevel4Map
+						}
 						if item.RouteDestination.CSRFPolicy.Disabled != nil {
 							csrf_policyDeepMap["disabled"] = map[string]interface{}{}
 						}
@@ -2267,6 +2641,16 @@ func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest,
 					}
 					if item.RouteDestination.MirrorPolicy != nil {
 						mirror_policyDeepMap := make(map[string]interface{})
+						if item.RouteDestination.MirrorPolicy.Percent != nil {
+							percentLevel4Map := make(map[string]interface{})
+							if !item.RouteDestination.MirrorPolicy.Percent.Denominator.IsNull() && !item.RouteDestination.MirrorPolicy.Percent.Denominator.IsUnknown() {
+								percentLevel4Map["denominator"] = item.RouteDestination.MirrorPolicy.Percent.Denominator.ValueString()
+							}
+							if !item.RouteDestination.MirrorPolicy.Percent.Numerator.IsNull() && !item.RouteDestination.MirrorPolicy.Percent.Numerator.IsUnknown() {
+								percentLevel4Map["numerator"] = item.RouteDestination.MirrorPolicy.Percent.Numerator.ValueInt64()
+							}
+							mirror_policyDeepMap["percent"] = percentLevel4Map
+						}
 						route_destinationNestedMap["mirror_policy"] = mirror_policyDeepMap
 					}
 					if !item.RouteDestination.PrefixRewrite.IsNull() && !item.RouteDestination.PrefixRewrite.IsUnknown() {
@@ -2303,6 +2687,16 @@ func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest,
 					}
 					if item.RouteDestination.RetryPolicy != nil {
 						retry_policyDeepMap := make(map[string]interface{})
+						if item.RouteDestination.RetryPolicy.BackOff != nil {
+							back_offLevel4Map := make(map[string]interface{})
+							if !item.RouteDestination.RetryPolicy.BackOff.BaseInterval.IsNull() && !item.RouteDestination.RetryPolicy.BackOff.BaseInterval.IsUnknown() {
+								back_offLevel4Map["base_interval"] = item.RouteDestination.RetryPolicy.BackOff.BaseInterval.ValueInt64()
+							}
+							if !item.RouteDestination.RetryPolicy.BackOff.MaxInterval.IsNull() && !item.RouteDestination.RetryPolicy.BackOff.MaxInterval.IsUnknown() {
+								back_offLevel4Map["max_interval"] = item.RouteDestination.RetryPolicy.BackOff.MaxInterval.ValueInt64()
+							}
+							retry_policyDeepMap["back_off"] = back_offLevel4Map
+						}
 						if !item.RouteDestination.RetryPolicy.NumRetries.IsNull() && !item.RouteDestination.RetryPolicy.NumRetries.IsUnknown() {
 							retry_policyDeepMap["num_retries"] = item.RouteDestination.RetryPolicy.NumRetries.ValueInt64()
 						}
@@ -2417,11 +2811,15 @@ func (r *RouteResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	apiResource, err := r.client.CreateRoute(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Route: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "route", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -2909,7 +3307,7 @@ func (r *RouteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	apiResource, err := r.client.GetRoute(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Route not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -2917,11 +3315,12 @@ func (r *RouteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Route: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "route", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -2932,31 +3331,13 @@ func (r *RouteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -2964,6 +3345,7 @@ func (r *RouteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if listData, ok := apiResource.Spec["routes"].([]interface{}); ok && len(listData) > 0 {
 		var routesList []RouteRoutesModel
@@ -3429,7 +3811,8 @@ func (r *RouteResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 func (r *RouteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data RouteResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -3463,6 +3846,7 @@ func (r *RouteResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -3472,6 +3856,7 @@ func (r *RouteResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.Routes.IsNull() && !data.Routes.IsUnknown() {
@@ -3656,6 +4041,17 @@ func (r *RouteResource) Update(ctx context.Context, req resource.UpdateRequest,
 						if item.RouteDestination.CSRFPolicy.AllLoadBalancerDomains != nil {
 							csrf_policyDeepMap["all_load_balancer_domains"] = map[string]interface{}{}
 						}
+						if item.RouteDestination.CSRFPolicy.CustomDomainList != nil {
+							custom_domain_listLevel4Map := make(map[string]interface{})
+							if !item.RouteDestination.CSRFPolicy.CustomDomainList.Domains.IsNull() && !item.RouteDestination.CSRFPolicy.CustomDomainList.Domains.IsUnknown() {
+								var domainsLevel4Items []string
+								diags := item.RouteDestination.CSRFPolicy.CustomDomainList.Domains.ElementsAs(ctx, &domainsLevel4Items, false)
+								if !diags.HasError() {
+									custom_domain_listLevel4Map["domains"] = domainsLevel4Items
+								}
+							}
+							csrf_policyDeepMap["custom_domain_list"] = custom_domain_listLevel4Map
+						}
 						if item.RouteDestination.CSRFPolicy.Disabled != nil {
 							csrf_policyDeepMap["disabled"] = map[string]interface{}{}
 						}
@@ -3706,6 +4102,16 @@ func (r *RouteResource) Update(ctx context.Context, req resource.UpdateRequest,
 					}
 					if item.RouteDestination.MirrorPolicy != nil {
 						mirror_policyDeepMap := make(map[string]interface{})
+						if item.RouteDestination.MirrorPolicy.Percent != nil {
+							percentLevel4Map := make(map[string]interface{})
+							if !item.RouteDestination.MirrorPolicy.Percent.Denominator.IsNull() && !item.RouteDestination.MirrorPolicy.Percent.Denominator.IsUnknown() {
+								percentLevel4Map["denominator"] = item.RouteDestination.MirrorPolicy.Percent.Denominator.ValueString()
+							}
+							if !item.RouteDestination.MirrorPolicy.Percent.Numerator.IsNull() && !item.RouteDestination.MirrorPolicy.Percent.Numerator.IsUnknown() {
+								percentLevel4Map["numerator"] = item.RouteDestination.MirrorPolicy.Percent.Numerator.ValueInt64()
+							}
+							mirror_policyDeepMap["percent"] = percentLevel4Map
+						}
 						route_destinationNestedMap["mirror_policy"] = mirror_policyDeepMap
 					}
 					if !item.RouteDestination.PrefixRewrite.IsNull() && !item.RouteDestination.PrefixRewrite.IsUnknown() {
@@ -3742,6 +4148,16 @@ func (r *RouteResource) Update(ctx context.Context, req resource.UpdateRequest,
 					}
 					if item.RouteDestination.RetryPolicy != nil {
 						retry_policyDeepMap := make(map[string]interface{})
+						if item.RouteDestination.RetryPolicy.BackOff != nil {
+							back_offLevel4Map := make(map[string]interface{})
+							if !item.RouteDestination.RetryPolicy.BackOff.BaseInterval.IsNull() && !item.RouteDestination.RetryPolicy.BackOff.BaseInterval.IsUnknown() {
+								back_offLevel4Map["base_interval"] = item.RouteDestination.RetryPolicy.BackOff.BaseInterval.ValueInt64()
+							}
+							if !item.RouteDestination.RetryPolicy.BackOff.MaxInterval.IsNull() && !item.RouteDestination.RetryPolicy.BackOff.MaxInterval.IsUnknown() {
+								back_offLevel4Map["max_interval"] = item.RouteDestination.RetryPolicy.BackOff.MaxInterval.ValueInt64()
+							}
+							retry_policyDeepMap["back_off"] = back_offLevel4Map
+						}
 						if !item.RouteDestination.RetryPolicy.NumRetries.IsNull() && !item.RouteDestination.RetryPolicy.NumRetries.IsUnknown() {
 							retry_policyDeepMap["num_retries"] = item.RouteDestination.RetryPolicy.NumRetries.ValueInt64()
 						}
@@ -3856,7 +4272,7 @@ func (r *RouteResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	_, err := r.client.UpdateRoute(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Route: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "route", "update"))
 		return
 	}
 
@@ -3867,9 +4283,12 @@ func (r *RouteResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetRoute(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Route after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "route", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -4357,7 +4776,7 @@ func (r *RouteResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	err := r.client.DeleteRoute(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Route already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -4366,37 +4785,33 @@ func (r *RouteResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "Route delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Route: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "route", "delete"))
 		return
 	}
 }
 
 func (r *RouteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *RouteResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("route", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *RouteResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListRoutes(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *RouteResource) requiredNamespace() string {
+	return "system"
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,20 @@ type SecretManagementAccessDataSource struct {
 	client *client.Client
 }
 
+// SecretManagementAccessDataSourceModel mirrors SecretManagementAccessResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type SecretManagementAccessDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object                           `tfsdk:"system_metadata"`
+	Name           types.String                           `tfsdk:"name"`
+	Namespace      types.String                           `tfsdk:"namespace"`
+	Annotations    types.Map                              `tfsdk:"annotations"`
+	Description    types.String                           `tfsdk:"description"`
+	Disable        types.Bool                             `tfsdk:"disable"`
+	Labels         types.Map                              `tfsdk:"labels"`
+	ID             types.String                           `tfsdk:"id"`
+	ProviderName   types.String                           `tfsdk:"provider_name"`
+	AccessInfo     *SecretManagementAccessAccessInfoModel `tfsdk:"access_info"`
+	Where          *SecretManagementAccessWhereModel      `tfsdk:"where"`
 }
 
 func (d *SecretManagementAccessDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +47,7 @@ func (d *SecretManagementAccessDataSource) Metadata(ctx context.Context, req dat
 }
 
 func (d *SecretManagementAccessDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages secret_management_access creates a new object in storage backend for metadata.namespace. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the SecretManagementAccess.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the SecretManagementAccess exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the SecretManagementAccess.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewSecretManagementAccessResource())
 }
 
 func (d *SecretManagementAccessDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +69,25 @@ func (d *SecretManagementAccessDataSource) Read(ctx context.Context, req datasou
 		return
 	}
 
-	resource, err := d.client.GetSecretManagementAccess(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetSecretManagementAccess(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SecretManagementAccess: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "secret_management_access", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +101,8 @@ func (d *SecretManagementAccessDataSource) Read(ctx context.Context, req datasou
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +111,84 @@ func (d *SecretManagementAccessDataSource) Read(ctx context.Context, req datasou
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["access_info"].(map[string]interface{}); ok && (isImport || data.AccessInfo != nil) {
+		data.AccessInfo = &SecretManagementAccessAccessInfoModel{
+			RESTAuthInfo: func() *SecretManagementAccessAccessInfoRESTAuthInfoModel {
+				if !isImport && data.AccessInfo != nil && data.AccessInfo.RESTAuthInfo != nil {
+					// Normal Read: preserve existing state value
+					return data.AccessInfo.RESTAuthInfo
+				}
+				// Import case: read from API
+				if _, ok := blockData["rest_auth_info"].(map[string]interface{}); ok {
+					return &SecretManagementAccessAccessInfoRESTAuthInfoModel{}
+				}
+				return nil
+			}(),
+			Scheme: func() types.String {
+				if v, ok := blockData["scheme"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ServerEndpoint: func() types.String {
+				if v, ok := blockData["server_endpoint"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			TLSConfig: func() *SecretManagementAccessAccessInfoTLSConfigModel {
+				if !isImport && data.AccessInfo != nil && data.AccessInfo.TLSConfig != nil {
+					// Normal Read: preserve existing state value
+					return data.AccessInfo.TLSConfig
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["tls_config"].(map[string]interface{}); ok {
+					return &SecretManagementAccessAccessInfoTLSConfigModel{
+						MaxSessionKeys: func() types.Int64 {
+							if v, ok := nestedBlockData["max_session_keys"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						Sni: func() types.String {
+							if v, ok := nestedBlockData["sni"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			VaultAuthInfo: func() *SecretManagementAccessAccessInfoVaultAuthInfoModel {
+				if !isImport && data.AccessInfo != nil && data.AccessInfo.VaultAuthInfo != nil {
+					// Normal Read: preserve existing state value
+					return data.AccessInfo.VaultAuthInfo
+				}
+				// Import case: read from API
+				if _, ok := blockData["vault_auth_info"].(map[string]interface{}); ok {
+					return &SecretManagementAccessAccessInfoVaultAuthInfoModel{}
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["where"].(map[string]interface{}); ok && isImport && data.Where == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Where = &SecretManagementAccessWhereModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["provider_name"].(string); ok && v != "" {
+		data.ProviderName = types.StringValue(v)
+	} else {
+		data.ProviderName = types.StringNull()
+	}
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewSecretManagementAccessResource(), &data)...)
 }
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &SecretManagementAccessResource{}
-	_ resource.ResourceWithConfigure      = &SecretManagementAccessResource{}
-	_ resource.ResourceWithImportState    = &SecretManagementAccessResource{}
-	_ resource.ResourceWithModifyPlan     = &SecretManagementAccessResource{}
-	_ resource.ResourceWithValidateConfig = &SecretManagementAccessResource{}
+	_ resource.Resource                     = &SecretManagementAccessResource{}
+	_ resource.ResourceWithConfigure        = &SecretManagementAccessResource{}
+	_ resource.ResourceWithImportState      = &SecretManagementAccessResource{}
+	_ resource.ResourceWithIdentity         = &SecretManagementAccessResource{}
+	_ resource.ResourceWithMoveState        = &SecretManagementAccessResource{}
+	_ resource.ResourceWithModifyPlan       = &SecretManagementAccessResource{}
+	_ resource.ResourceWithValidateConfig   = &SecretManagementAccessResource{}
+	_ resource.ResourceWithConfigValidators = &SecretManagementAccessResource{}
+	_ listableResource                      = &SecretManagementAccessResource{}
 )
 
 func NewSecretManagementAccessResource() resource.Resource {
@@ -117,14 +124,16 @@ var SecretManagementAccessAccessInfoRESTAuthInfoBasicAuthPasswordBlindfoldSecret
 
 // SecretManagementAccessAccessInfoRESTAuthInfoBasicAuthPasswordClearSecretInfoModel represents clear_secret_info block
 type SecretManagementAccessAccessInfoRESTAuthInfoBasicAuthPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // SecretManagementAccessAccessInfoRESTAuthInfoBasicAuthPasswordClearSecretInfoModelAttrTypes defines the attribute types for SecretManagementAccessAccessInfoRESTAuthInfoBasicAuthPasswordClearSecretInfoModel
 var SecretManagementAccessAccessInfoRESTAuthInfoBasicAuthPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // SecretManagementAccessAccessInfoRESTAuthInfoHeadersAuthModel represents headers_auth block
@@ -327,14 +336,16 @@ var SecretManagementAccessAccessInfoTLSConfigCommonParamsTLSCertificatesPrivateK
 
 // SecretManagementAccessAccessInfoTLSConfigCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type SecretManagementAccessAccessInfoTLSConfigCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // SecretManagementAccessAccessInfoTLSConfigCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for SecretManagementAccessAccessInfoTLSConfigCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModel
 var SecretManagementAccessAccessInfoTLSConfigCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // SecretManagementAccessAccessInfoTLSConfigCommonParamsValidationParamsModel represents validation_params block
@@ -433,14 +444,16 @@ var SecretManagementAccessAccessInfoVaultAuthInfoAppRoleAuthSecretIDBlindfoldSec
 
 // SecretManagementAccessAccessInfoVaultAuthInfoAppRoleAuthSecretIDClearSecretInfoModel represents clear_secret_info block
 type SecretManagementAccessAccessInfoVaultAuthInfoAppRoleAuthSecretIDClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // SecretManagementAccessAccessInfoVaultAuthInfoAppRoleAuthSecretIDClearSecretInfoModelAttrTypes defines the attribute types for SecretManagementAccessAccessInfoVaultAuthInfoAppRoleAuthSecretIDClearSecretInfoModel
 var SecretManagementAccessAccessInfoVaultAuthInfoAppRoleAuthSecretIDClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // SecretManagementAccessAccessInfoVaultAuthInfoTokenModel represents token block
@@ -471,14 +484,16 @@ var SecretManagementAccessAccessInfoVaultAuthInfoTokenBlindfoldSecretInfoModelAt
 
 // SecretManagementAccessAccessInfoVaultAuthInfoTokenClearSecretInfoModel represents clear_secret_info block
 type SecretManagementAccessAccessInfoVaultAuthInfoTokenClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // SecretManagementAccessAccessInfoVaultAuthInfoTokenClearSecretInfoModelAttrTypes defines the attribute types for SecretManagementAccessAccessInfoVaultAuthInfoTokenClearSecretInfoModel
 var SecretManagementAccessAccessInfoVaultAuthInfoTokenClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // SecretManagementAccessWhereModel represents where block
@@ -592,17 +607,19 @@ var SecretManagementAccessWhereVirtualSiteRefModelAttrTypes = map[string]attr.Ty
 }
 
 type SecretManagementAccessResourceModel struct {
-	Name         types.String                           `tfsdk:"name"`
-	Namespace    types.String                           `tfsdk:"namespace"`
-	Annotations  types.Map                              `tfsdk:"annotations"`
-	Description  types.String                           `tfsdk:"description"`
-	Disable      types.Bool                             `tfsdk:"disable"`
-	Labels       types.Map                              `tfsdk:"labels"`
-	ID           types.String                           `tfsdk:"id"`
-	ProviderName types.String                           `tfsdk:"provider_name"`
-	Timeouts     timeouts.Value                         `tfsdk:"timeouts"`
-	AccessInfo   *SecretManagementAccessAccessInfoModel `tfsdk:"access_info"`
-	Where        *SecretManagementAccessWhereModel      `tfsdk:"where"`
+	Name           types.String                           `tfsdk:"name"`
+	Namespace      types.String                           `tfsdk:"namespace"`
+	Annotations    types.Map                              `tfsdk:"annotations"`
+	Description    types.String                           `tfsdk:"description"`
+	Disable        types.Bool                             `tfsdk:"disable"`
+	Labels         types.Map                              `tfsdk:"labels"`
+	ID             types.String                           `tfsdk:"id"`
+	ProviderName   types.String                           `tfsdk:"provider_name"`
+	Timeouts       timeouts.Value                         `tfsdk:"timeouts"`
+	SystemMetadata types.Object                           `tfsdk:"system_metadata"`
+	LabelsAll      types.Map                              `tfsdk:"labels_all"`
+	AccessInfo     *SecretManagementAccessAccessInfoModel `tfsdk:"access_info"`
+	Where          *SecretManagementAccessWhereModel      `tfsdk:"where"`
 }
 
 func (r *SecretManagementAccessResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -624,9 +641,11 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Secret Management Access will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Secret Management Access will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -665,7 +684,13 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(256),
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -684,6 +709,10 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 					"server_endpoint": schema.StringAttribute{
 						MarkdownDescription: "Endpoint to connect to, in host:port format .",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(256),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -729,7 +758,16 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -764,10 +802,18 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 							"max_session_keys": schema.Int64Attribute{
 								MarkdownDescription: "Number of session keys that are cached.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(2),
+									int64validator.AtMost(64),
+								},
 							},
 							"sni": schema.StringAttribute{
 								MarkdownDescription: "SNI value to be used.",
 								Optional:            true,
+								Validators: []validator.String{
+									validators.HostnameValidator(),
+									stringvalidator.UTF8LengthAtMost(256),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -778,6 +824,9 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 										MarkdownDescription: "The following list specifies the supported cipher suite TLS_AES_128_GCM_SHA256 TLS_AES_256_GCM_SHA384 TLS_CHACHA20_POLY1305_SHA256 TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256 TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384 TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256 TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256..",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.UniqueValues(),
+										},
 									},
 									"maximum_protocol_version": schema.StringAttribute{
 										MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -842,6 +891,9 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 											"trusted_ca_url": schema.StringAttribute{
 												MarkdownDescription: "Inline Root CA Certificate.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
 											},
 											"verify_subject_alt_names": schema.ListAttribute{
 												MarkdownDescription: "List of acceptable Subject Alt Names/CN in the peer's certificate. When skip_hostname_verification is false and verify_subject_alt_names is empty, the hostname of the peer will be used for matching against SAN/CN of peer's certificate.",
@@ -856,6 +908,9 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 												Blocks: map[string]schema.Block{
 													"trusted_ca_list": schema.ListNestedBlock{
 														MarkdownDescription: "Root CA Certificate Reference. Reference to Root CA Certificate.",
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+														},
 														NestedObject: schema.NestedBlockObject{
 															Attributes: map[string]schema.Attribute{
 																"kind": schema.StringAttribute{
@@ -910,6 +965,9 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 										MarkdownDescription: "The following list specifies the supported cipher suite TLS_AES_128_GCM_SHA256 TLS_AES_256_GCM_SHA384 TLS_CHACHA20_POLY1305_SHA256 TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256 TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384 TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256 TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256..",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.UniqueValues(),
+										},
 									},
 									"maximum_protocol_version": schema.StringAttribute{
 										MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -928,6 +986,10 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 												"certificate_url": schema.StringAttribute{
 													MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(131072),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"description_spec": schema.StringAttribute{
 													MarkdownDescription: "Description. Description for the certificate.",
@@ -942,6 +1004,11 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 															MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 															Optional:            true,
 															ElementType:         types.StringType,
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(4),
+																listvalidator.SizeAtLeast(1),
+																listvalidator.UniqueValues(),
+															},
 														},
 													},
 												},
@@ -977,7 +1044,16 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 																	Optional:            true,
 																},
 																"url": schema.StringAttribute{
-																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+																	MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+																	Optional:            true,
+																	Sensitive:           true,
+																	WriteOnly:           true,
+																	Validators: []validator.String{
+																		stringvalidator.LengthAtMost(131072),
+																	},
+																},
+																"url_version": schema.Int64Attribute{
+																	MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 																	Optional:            true,
 																},
 															},
@@ -1000,6 +1076,9 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 											"trusted_ca_url": schema.StringAttribute{
 												MarkdownDescription: "Inline Root CA Certificate.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
 											},
 											"verify_subject_alt_names": schema.ListAttribute{
 												MarkdownDescription: "List of acceptable Subject Alt Names/CN in the peer's certificate. When skip_hostname_verification is false and verify_subject_alt_names is empty, the hostname of the peer will be used for matching against SAN/CN of peer's certificate.",
@@ -1014,6 +1093,9 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 												Blocks: map[string]schema.Block{
 													"trusted_ca_list": schema.ListNestedBlock{
 														MarkdownDescription: "Root CA Certificate Reference. Reference to Root CA Certificate.",
+														Validators: []validator.List{
+															listvalidator.SizeAtMost(1),
+														},
 														NestedObject: schema.NestedBlockObject{
 															Attributes: map[string]schema.Attribute{
 																"kind": schema.StringAttribute{
@@ -1117,7 +1199,16 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -1155,7 +1246,16 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -1187,6 +1287,9 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 							},
 							"ref": schema.ListNestedBlock{
 								MarkdownDescription: "Reference. A site direct reference .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -1236,6 +1339,9 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 						Blocks: map[string]schema.Block{
 							"ref": schema.ListNestedBlock{
 								MarkdownDescription: "Virtual network direct reference .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -1296,6 +1402,9 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 							},
 							"ref": schema.ListNestedBlock{
 								MarkdownDescription: "Virtual_site direct reference .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -1345,6 +1454,11 @@ func (r *SecretManagementAccessResource) Schema(ctx context.Context, req resourc
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *SecretManagementAccessResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *SecretManagementAccessResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -1369,6 +1483,29 @@ func (r *SecretManagementAccessResource) ValidateConfig(ctx context.Context, req
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *SecretManagementAccessResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("access_info"), "auth_params", "rest_auth_info", "vault_auth_info"),
+		validators.OneOfGroup(path.MatchRoot("access_info").AtName("rest_auth_info"), "auth_params", "basic_auth", "headers_auth", "query_params_auth"),
+		validators.OneOfGroup(path.MatchRoot("access_info").AtName("rest_auth_info").AtName("basic_auth").AtName("password"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.RequiredOneOfGroup(path.MatchRoot("access_info").AtName("tls_config"), "max_session_keys_type", "default_session_key_caching", "disable_session_key_caching", "max_session_keys"),
+		validators.OneOfGroup(path.MatchRoot("access_info").AtName("tls_config"), "sni_choice", "disable_sni", "sni", "use_host_header_as_sni"),
+		validators.OneOfGroup(path.MatchRoot("access_info").AtName("tls_config"), "tls_params_choice", "cert_params", "common_params"),
+		validators.OneOfGroup(path.MatchRoot("access_info").AtName("tls_config").AtName("cert_params").AtName("validation_params"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("access_info").AtName("tls_config").AtName("common_params").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("access_info").AtName("tls_config").AtName("common_params").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("access_info").AtName("tls_config").AtName("common_params").AtName("validation_params"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("access_info").AtName("vault_auth_info"), "auth_params", "app_role_auth", "token"),
+		validators.OneOfGroup(path.MatchRoot("access_info").AtName("vault_auth_info").AtName("app_role_auth").AtName("secret_id"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("access_info").AtName("vault_auth_info").AtName("token"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("where"), "ref_or_selector", "site", "virtual_network", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("where").AtName("site"), "internet_vip_choice", "disable_internet_vip", "enable_internet_vip"),
+		validators.OneOfGroup(path.MatchRoot("where").AtName("virtual_site"), "internet_vip_choice", "disable_internet_vip", "enable_internet_vip"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *SecretManagementAccessResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -1379,7 +1516,10 @@ func (r *SecretManagementAccessResource) ModifyPlan(ctx context.Context, req res
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "secret_management_access", "", req.Config, &resp.Plan)...)
 		var plan SecretManagementAccessResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -1397,7 +1537,8 @@ func (r *SecretManagementAccessResource) ModifyPlan(ctx context.Context, req res
 
 func (r *SecretManagementAccessResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data SecretManagementAccessResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1436,6 +1577,7 @@ func (r *SecretManagementAccessResource) Create(ctx context.Context, req resourc
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1445,12 +1587,61 @@ func (r *SecretManagementAccessResource) Create(ctx context.Context, req resourc
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AccessInfo != nil {
 		access_infoMap := make(map[string]interface{})
 		if data.AccessInfo.RESTAuthInfo != nil {
 			rest_auth_infoNestedMap := make(map[string]interface{})
+			if data.AccessInfo.RESTAuthInfo.BasicAuth != nil {
+				basic_authLevel3Map := make(map[string]interface{})
+				if data.AccessInfo.RESTAuthInfo.BasicAuth.Password != nil {
+					passwordLevel4Map := make(map[string]interface{})
+					if data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.Location.IsNull() && !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						passwordLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo.Provider.IsNull() && !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo.URL.IsNull() && !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo.URL.ValueString()
+						}
+						passwordLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					basic_authLevel3Map["password"] = passwordLevel4Map
+				}
+				if !data.AccessInfo.RESTAuthInfo.BasicAuth.Username.IsNull() && !data.AccessInfo.RESTAuthInfo.BasicAuth.Username.IsUnknown() {
+					basic_authLevel3Map["username"] = data.AccessInfo.RESTAuthInfo.BasicAuth.Username.ValueString()
+				}
+				rest_auth_infoNestedMap["basic_auth"] = basic_authLevel3Map
+			}
+			if data.AccessInfo.RESTAuthInfo.HeadersAuth != nil {
+				headers_authLevel3Map := make(map[string]interface{})
+				if data.AccessInfo.RESTAuthInfo.HeadersAuth.Headers != nil {
+					headers_authLevel3Map["headers"] = map[string]interface{}{}
+				}
+				rest_auth_infoNestedMap["headers_auth"] = headers_authLevel3Map
+			}
+			if data.AccessInfo.RESTAuthInfo.QueryParamsAuth != nil {
+				query_params_authLevel3Map := make(map[string]interface{})
+				if data.AccessInfo.RESTAuthInfo.QueryParamsAuth.QueryParams != nil {
+					query_params_authLevel3Map["query_params"] = map[string]interface{}{}
+				}
+				rest_auth_infoNestedMap["query_params_auth"] = query_params_authLevel3Map
+			}
 			access_infoMap["rest_auth_info"] = rest_auth_infoNestedMap
 		}
 		if !data.AccessInfo.Scheme.IsNull() && !data.AccessInfo.Scheme.IsUnknown() {
@@ -1461,16 +1652,165 @@ func (r *SecretManagementAccessResource) Create(ctx context.Context, req resourc
 		}
 		if data.AccessInfo.TLSConfig != nil {
 			tls_configNestedMap := make(map[string]interface{})
+			if data.AccessInfo.TLSConfig.CertParams != nil {
+				cert_paramsLevel3Map := make(map[string]interface{})
+				if !data.AccessInfo.TLSConfig.CertParams.CipherSuites.IsNull() && !data.AccessInfo.TLSConfig.CertParams.CipherSuites.IsUnknown() {
+					var cipher_suitesLevel3Items []string
+					diags := data.AccessInfo.TLSConfig.CertParams.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel3Items, false)
+					if !diags.HasError() {
+						cert_paramsLevel3Map["cipher_suites"] = cipher_suitesLevel3Items
+					}
+				}
+				if !data.AccessInfo.TLSConfig.CertParams.MaximumProtocolVersion.IsNull() && !data.AccessInfo.TLSConfig.CertParams.MaximumProtocolVersion.IsUnknown() {
+					cert_paramsLevel3Map["maximum_protocol_version"] = data.AccessInfo.TLSConfig.CertParams.MaximumProtocolVersion.ValueString()
+				}
+				if !data.AccessInfo.TLSConfig.CertParams.MinimumProtocolVersion.IsNull() && !data.AccessInfo.TLSConfig.CertParams.MinimumProtocolVersion.IsUnknown() {
+					cert_paramsLevel3Map["minimum_protocol_version"] = data.AccessInfo.TLSConfig.CertParams.MinimumProtocolVersion.ValueString()
+				}
+				if data.AccessInfo.TLSConfig.CertParams.ValidationParams != nil {
+					validation_paramsLevel4Map := make(map[string]interface{})
+					if !data.AccessInfo.TLSConfig.CertParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.AccessInfo.TLSConfig.CertParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
+						validation_paramsLevel4Map["skip_hostname_verification"] = data.AccessInfo.TLSConfig.CertParams.ValidationParams.SkipHostnameVerification.ValueBool()
+					}
+					if data.AccessInfo.TLSConfig.CertParams.ValidationParams.TrustedCA != nil {
+						trusted_caLevel5Map := make(map[string]interface{})
+						validation_paramsLevel4Map["trusted_ca"] = trusted_caLevel5Map
+					}
+					if !data.AccessInfo.TLSConfig.CertParams.ValidationParams.TrustedCAURL.IsNull() && !data.AccessInfo.TLSConfig.CertParams.ValidationParams.TrustedCAURL.IsUnknown() {
+						validation_paramsLevel4Map["trusted_ca_url"] = data.AccessInfo.TLSConfig.CertParams.ValidationParams.TrustedCAURL.ValueString()
+					}
+					if !data.AccessInfo.TLSConfig.CertParams.ValidationParams.VerifySubjectAltNames.IsNull() && !data.AccessInfo.TLSConfig.CertParams.ValidationParams.VerifySubjectAltNames.IsUnknown() {
+						var verify_subject_alt_namesLevel4Items []string
+						diags := data.AccessInfo.TLSConfig.CertParams.ValidationParams.VerifySubjectAltNames.ElementsAs(ctx, &verify_subject_alt_namesLevel4Items, false)
+						if !diags.HasError() {
+							validation_paramsLevel4Map["verify_subject_alt_names"] = verify_subject_alt_namesLevel4Items
+						}
+					}
+					cert_paramsLevel3Map["validation_params"] = validation_paramsLevel4Map
+				}
+				tls_configNestedMap["cert_params"] = cert_paramsLevel3Map
+			}
+			if data.AccessInfo.TLSConfig.CommonParams != nil {
+				common_paramsLevel3Map := make(map[string]interface{})
+				if !data.AccessInfo.TLSConfig.CommonParams.CipherSuites.IsNull() && !data.AccessInfo.TLSConfig.CommonParams.CipherSuites.IsUnknown() {
+					var cipher_suitesLevel3Items []string
+					diags := data.AccessInfo.TLSConfig.CommonParams.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel3Items, false)
+					if !diags.HasError() {
+						common_paramsLevel3Map["cipher_suites"] = cipher_suitesLevel3Items
+					}
+				}
+				if !data.AccessInfo.TLSConfig.CommonParams.MaximumProtocolVersion.IsNull() && !data.AccessInfo.TLSConfig.CommonParams.MaximumProtocolVersion.IsUnknown() {
+					common_paramsLevel3Map["maximum_protocol_version"] = data.AccessInfo.TLSConfig.CommonParams.MaximumProtocolVersion.ValueString()
+				}
+				if !data.AccessInfo.TLSConfig.CommonParams.MinimumProtocolVersion.IsNull() && !data.AccessInfo.TLSConfig.CommonParams.MinimumProtocolVersion.IsUnknown() {
+					common_paramsLevel3Map["minimum_protocol_version"] = data.AccessInfo.TLSConfig.CommonParams.MinimumProtocolVersion.ValueString()
+				}
+				if data.AccessInfo.TLSConfig.CommonParams.ValidationParams != nil {
+					validation_paramsLevel4Map := make(map[string]interface{})
+					if !data.AccessInfo.TLSConfig.CommonParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.AccessInfo.TLSConfig.CommonParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
+						validation_paramsLevel4Map["skip_hostname_verification"] = data.AccessInfo.TLSConfig.CommonParams.ValidationParams.SkipHostnameVerification.ValueBool()
+					}
+					if data.AccessInfo.TLSConfig.CommonParams.ValidationParams.TrustedCA != nil {
+						trusted_caLevel5Map := make(map[string]interface{})
+						validation_paramsLevel4Map["trusted_ca"] = trusted_caLevel5Map
+					}
+					if !data.AccessInfo.TLSConfig.CommonParams.ValidationParams.TrustedCAURL.IsNull() && !data.AccessInfo.TLSConfig.CommonParams.ValidationParams.TrustedCAURL.IsUnknown() {
+						validation_paramsLevel4Map["trusted_ca_url"] = data.AccessInfo.TLSConfig.CommonParams.ValidationParams.TrustedCAURL.ValueString()
+					}
+					if !data.AccessInfo.TLSConfig.CommonParams.ValidationParams.VerifySubjectAltNames.IsNull() && !data.AccessInfo.TLSConfig.CommonParams.ValidationParams.VerifySubjectAltNames.IsUnknown() {
+						var verify_subject_alt_namesLevel4Items []string
+						diags := data.AccessInfo.TLSConfig.CommonParams.ValidationParams.VerifySubjectAltNames.ElementsAs(ctx, &verify_subject_alt_namesLevel4Items, false)
+						if !diags.HasError() {
+							validation_paramsLevel4Map["verify_subject_alt_names"] = verify_subject_alt_namesLevel4Items
+						}
+					}
+					common_paramsLevel3Map["validation_params"] = validation_paramsLevel4Map
+				}
+				tls_configNestedMap["common_params"] = common_paramsLevel3Map
+			}
+			if data.AccessInfo.TLSConfig.DefaultSessionKeyCaching != nil {
+				tls_configNestedMap["default_session_key_caching"] = map[string]interface{}{}
+			}
+			if data.AccessInfo.TLSConfig.DisableSessionKeyCaching != nil {
+				tls_configNestedMap["disable_session_key_caching"] = map[string]interface{}{}
+			}
+			if data.AccessInfo.TLSConfig.DisableSni != nil {
+				tls_configNestedMap["disable_sni"] = map[string]interface{}{}
+			}
 			if !data.AccessInfo.TLSConfig.MaxSessionKeys.IsNull() && !data.AccessInfo.TLSConfig.MaxSessionKeys.IsUnknown() {
 				tls_configNestedMap["max_session_keys"] = data.AccessInfo.TLSConfig.MaxSessionKeys.ValueInt64()
 			}
 			if !data.AccessInfo.TLSConfig.Sni.IsNull() && !data.AccessInfo.TLSConfig.Sni.IsUnknown() {
 				tls_configNestedMap["sni"] = data.AccessInfo.TLSConfig.Sni.ValueString()
 			}
+			if data.AccessInfo.TLSConfig.UseHostHeaderAsSni != nil {
+				tls_configNestedMap["use_host_header_as_sni"] = map[string]interface{}{}
+			}
 			access_infoMap["tls_config"] = tls_configNestedMap
 		}
 		if data.AccessInfo.VaultAuthInfo != nil {
 			vault_auth_infoNestedMap := make(map[string]interface{})
+			if data.AccessInfo.VaultAuthInfo.AppRoleAuth != nil {
+				app_role_authLevel3Map := make(map[string]interface{})
+				if !data.AccessInfo.VaultAuthInfo.AppRoleAuth.RoleID.IsNull() && !data.AccessInfo.VaultAuthInfo.AppRoleAuth.RoleID.IsUnknown() {
+					app_role_authLevel3Map["role_id"] = data.AccessInfo.VaultAuthInfo.AppRoleAuth.RoleID.ValueString()
+				}
+				if data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID != nil {
+					secret_idLevel4Map := make(map[string]interface{})
+					if data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.Location.IsNull() && !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						secret_idLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo.Provider.IsNull() && !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo.URL.IsNull() && !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo.URL.ValueString()
+						}
+						secret_idLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					app_role_authLevel3Map["secret_id"] = secret_idLevel4Map
+				}
+				vault_auth_infoNestedMap["app_role_auth"] = app_role_authLevel3Map
+			}
+			if data.AccessInfo.VaultAuthInfo.Token != nil {
+				tokenLevel3Map := make(map[string]interface{})
+				if data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.Location.IsNull() && !data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo.Provider.IsNull() && !data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo.URL.IsNull() && !data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo.URL.ValueString()
+					}
+					tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				vault_auth_infoNestedMap["token"] = tokenLevel3Map
+			}
 			access_infoMap["vault_auth_info"] = vault_auth_infoNestedMap
 		}
 		createReq.Spec["access_info"] = access_infoMap
@@ -1479,6 +1819,12 @@ func (r *SecretManagementAccessResource) Create(ctx context.Context, req resourc
 		whereMap := make(map[string]interface{})
 		if data.Where.Site != nil {
 			siteNestedMap := make(map[string]interface{})
+			if data.Where.Site.DisableInternetVIP != nil {
+				siteNestedMap["disable_internet_vip"] = map[string]interface{}{}
+			}
+			if data.Where.Site.EnableInternetVIP != nil {
+				siteNestedMap["enable_internet_vip"] = map[string]interface{}{}
+			}
 			if !data.Where.Site.NetworkType.IsNull() && !data.Where.Site.NetworkType.IsUnknown() {
 				siteNestedMap["network_type"] = data.Where.Site.NetworkType.ValueString()
 			}
@@ -1490,6 +1836,12 @@ func (r *SecretManagementAccessResource) Create(ctx context.Context, req resourc
 		}
 		if data.Where.VirtualSite != nil {
 			virtual_siteNestedMap := make(map[string]interface{})
+			if data.Where.VirtualSite.DisableInternetVIP != nil {
+				virtual_siteNestedMap["disable_internet_vip"] = map[string]interface{}{}
+			}
+			if data.Where.VirtualSite.EnableInternetVIP != nil {
+				virtual_siteNestedMap["enable_internet_vip"] = map[string]interface{}{}
+			}
 			if !data.Where.VirtualSite.NetworkType.IsNull() && !data.Where.VirtualSite.NetworkType.IsUnknown() {
 				virtual_siteNestedMap["network_type"] = data.Where.VirtualSite.NetworkType.ValueString()
 			}
@@ -1503,11 +1855,15 @@ func (r *SecretManagementAccessResource) Create(ctx context.Context, req resourc
 
 	apiResource, err := r.client.CreateSecretManagementAccess(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create SecretManagementAccess: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "secret_management_access", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1609,7 +1965,7 @@ func (r *SecretManagementAccessResource) Read(ctx context.Context, req resource.
 	apiResource, err := r.client.GetSecretManagementAccess(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "SecretManagementAccess not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1617,11 +1973,12 @@ func (r *SecretManagementAccessResource) Read(ctx context.Context, req resource.
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SecretManagementAccess: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "secret_management_access", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1632,31 +1989,13 @@ func (r *SecretManagementAccessResource) Read(ctx context.Context, req resource.
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1664,6 +2003,7 @@ func (r *SecretManagementAccessResource) Read(ctx context.Context, req resource.
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["access_info"].(map[string]interface{}); ok && (isImport || data.AccessInfo != nil) {
 		data.AccessInfo = &SecretManagementAccessAccessInfoModel{
@@ -1743,7 +2083,8 @@ func (r *SecretManagementAccessResource) Read(ctx context.Context, req resource.
 
 func (r *SecretManagementAccessResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data SecretManagementAccessResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1777,6 +2118,7 @@ func (r *SecretManagementAccessResource) Update(ctx context.Context, req resourc
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1786,12 +2128,61 @@ func (r *SecretManagementAccessResource) Update(ctx context.Context, req resourc
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AccessInfo != nil {
 		access_infoMap := make(map[string]interface{})
 		if data.AccessInfo.RESTAuthInfo != nil {
 			rest_auth_infoNestedMap := make(map[string]interface{})
+			if data.AccessInfo.RESTAuthInfo.BasicAuth != nil {
+				basic_authLevel3Map := make(map[string]interface{})
+				if data.AccessInfo.RESTAuthInfo.BasicAuth.Password != nil {
+					passwordLevel4Map := make(map[string]interface{})
+					if data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.Location.IsNull() && !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.AccessInfo.RESTAuthInfo.BasicAuth.Password.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						passwordLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo.Provider.IsNull() && !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo.URL.IsNull() && !data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.AccessInfo.RESTAuthInfo.BasicAuth.Password.ClearSecretInfo.URL.ValueString()
+						}
+						passwordLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					basic_authLevel3Map["password"] = passwordLevel4Map
+				}
+				if !data.AccessInfo.RESTAuthInfo.BasicAuth.Username.IsNull() && !data.AccessInfo.RESTAuthInfo.BasicAuth.Username.IsUnknown() {
+					basic_authLevel3Map["username"] = data.AccessInfo.RESTAuthInfo.BasicAuth.Username.ValueString()
+				}
+				rest_auth_infoNestedMap["basic_auth"] = basic_authLevel3Map
+			}
+			if data.AccessInfo.RESTAuthInfo.HeadersAuth != nil {
+				headers_authLevel3Map := make(map[string]interface{})
+				if data.AccessInfo.RESTAuthInfo.HeadersAuth.Headers != nil {
+					headers_authLevel3Map["headers"] = map[string]interface{}{}
+				}
+				rest_auth_infoNestedMap["headers_auth"] = headers_authLevel3Map
+			}
+			if data.AccessInfo.RESTAuthInfo.QueryParamsAuth != nil {
+				query_params_authLevel3Map := make(map[string]interface{})
+				if data.AccessInfo.RESTAuthInfo.QueryParamsAuth.QueryParams != nil {
+					query_params_authLevel3Map["query_params"] = map[string]interface{}{}
+				}
+				rest_auth_infoNestedMap["query_params_auth"] = query_params_authLevel3Map
+			}
 			access_infoMap["rest_auth_info"] = rest_auth_infoNestedMap
 		}
 		if !data.AccessInfo.Scheme.IsNull() && !data.AccessInfo.Scheme.IsUnknown() {
@@ -1802,16 +2193,165 @@ func (r *SecretManagementAccessResource) Update(ctx context.Context, req resourc
 		}
 		if data.AccessInfo.TLSConfig != nil {
 			tls_configNestedMap := make(map[string]interface{})
+			if data.AccessInfo.TLSConfig.CertParams != nil {
+				cert_paramsLevel3Map := make(map[string]interface{})
+				if !data.AccessInfo.TLSConfig.CertParams.CipherSuites.IsNull() && !data.AccessInfo.TLSConfig.CertParams.CipherSuites.IsUnknown() {
+					var cipher_suitesLevel3Items []string
+					diags := data.AccessInfo.TLSConfig.CertParams.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel3Items, false)
+					if !diags.HasError() {
+						cert_paramsLevel3Map["cipher_suites"] = cipher_suitesLevel3Items
+					}
+				}
+				if !data.AccessInfo.TLSConfig.CertParams.MaximumProtocolVersion.IsNull() && !data.AccessInfo.TLSConfig.CertParams.MaximumProtocolVersion.IsUnknown() {
+					cert_paramsLevel3Map["maximum_protocol_version"] = data.AccessInfo.TLSConfig.CertParams.MaximumProtocolVersion.ValueString()
+				}
+				if !data.AccessInfo.TLSConfig.CertParams.MinimumProtocolVersion.IsNull() && !data.AccessInfo.TLSConfig.CertParams.MinimumProtocolVersion.IsUnknown() {
+					cert_paramsLevel3Map["minimum_protocol_version"] = data.AccessInfo.TLSConfig.CertParams.MinimumProtocolVersion.ValueString()
+				}
+				if data.AccessInfo.TLSConfig.CertParams.ValidationParams != nil {
+					validation_paramsLevel4Map := make(map[string]interface{})
+					if !data.AccessInfo.TLSConfig.CertParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.AccessInfo.TLSConfig.CertParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
+						validation_paramsLevel4Map["skip_hostname_verification"] = data.AccessInfo.TLSConfig.CertParams.ValidationParams.SkipHostnameVerification.ValueBool()
+					}
+					if data.AccessInfo.TLSConfig.CertParams.ValidationParams.TrustedCA != nil {
+						trusted_caLevel5Map := make(map[string]interface{})
+						validation_paramsLevel4Map["trusted_ca"] = trusted_caLevel5Map
+					}
+					if !data.AccessInfo.TLSConfig.CertParams.ValidationParams.TrustedCAURL.IsNull() && !data.AccessInfo.TLSConfig.CertParams.ValidationParams.TrustedCAURL.IsUnknown() {
+						validation_paramsLevel4Map["trusted_ca_url"] = data.AccessInfo.TLSConfig.CertParams.ValidationParams.TrustedCAURL.ValueString()
+					}
+					if !data.AccessInfo.TLSConfig.CertParams.ValidationParams.VerifySubjectAltNames.IsNull() && !data.AccessInfo.TLSConfig.CertParams.ValidationParams.VerifySubjectAltNames.IsUnknown() {
+						var verify_subject_alt_namesLevel4Items []string
+						diags := data.AccessInfo.TLSConfig.CertParams.ValidationParams.VerifySubjectAltNames.ElementsAs(ctx, &verify_subject_alt_namesLevel4Items, false)
+						if !diags.HasError() {
+							validation_paramsLevel4Map["verify_subject_alt_names"] = verify_subject_alt_namesLevel4Items
+						}
+					}
+					cert_paramsLevel3Map["validation_params"] = validation_paramsLevel4Map
+				}
+				tls_configNestedMap["cert_params"] = cert_paramsLevel3Map
+			}
+			if data.AccessInfo.TLSConfig.CommonParams != nil {
+				common_paramsLevel3Map := make(map[string]interface{})
+				if !data.AccessInfo.TLSConfig.CommonParams.CipherSuites.IsNull() && !data.AccessInfo.TLSConfig.CommonParams.CipherSuites.IsUnknown() {
+					var cipher_suitesLevel3Items []string
+					diags := data.AccessInfo.TLSConfig.CommonParams.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel3Items, false)
+					if !diags.HasError() {
+						common_paramsLevel3Map["cipher_suites"] = cipher_suitesLevel3Items
+					}
+				}
+				if !data.AccessInfo.TLSConfig.CommonParams.MaximumProtocolVersion.IsNull() && !data.AccessInfo.TLSConfig.CommonParams.MaximumProtocolVersion.IsUnknown() {
+					common_paramsLevel3Map["maximum_protocol_version"] = data.AccessInfo.TLSConfig.CommonParams.MaximumProtocolVersion.ValueString()
+				}
+				if !data.AccessInfo.TLSConfig.CommonParams.MinimumProtocolVersion.IsNull() && !data.AccessInfo.TLSConfig.CommonParams.MinimumProtocolVersion.IsUnknown() {
+					common_paramsLevel3Map["minimum_protocol_version"] = data.AccessInfo.TLSConfig.CommonParams.MinimumProtocolVersion.ValueString()
+				}
+				if data.AccessInfo.TLSConfig.CommonParams.ValidationParams != nil {
+					validation_paramsLevel4Map := make(map[string]interface{})
+					if !data.AccessInfo.TLSConfig.CommonParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.AccessInfo.TLSConfig.CommonParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
+						validation_paramsLevel4Map["skip_hostname_verification"] = data.AccessInfo.TLSConfig.CommonParams.ValidationParams.SkipHostnameVerification.ValueBool()
+					}
+					if data.AccessInfo.TLSConfig.CommonParams.ValidationParams.TrustedCA != nil {
+						trusted_caLevel5Map := make(map[string]interface{})
+						validation_paramsLevel4Map["trusted_ca"] = trusted_caLevel5Map
+					}
+					if !data.AccessInfo.TLSConfig.CommonParams.ValidationParams.TrustedCAURL.IsNull() && !data.AccessInfo.TLSConfig.CommonParams.ValidationParams.TrustedCAURL.IsUnknown() {
+						validation_paramsLevel4Map["trusted_ca_url"] = data.AccessInfo.TLSConfig.CommonParams.ValidationParams.TrustedCAURL.ValueString()
+					}
+					if !data.AccessInfo.TLSConfig.CommonParams.ValidationParams.VerifySubjectAltNames.IsNull() && !data.AccessInfo.TLSConfig.CommonParams.ValidationParams.VerifySubjectAltNames.IsUnknown() {
+						var verify_subject_alt_namesLevel4Items []string
+						diags := data.AccessInfo.TLSConfig.CommonParams.ValidationParams.VerifySubjectAltNames.ElementsAs(ctx, &verify_subject_alt_namesLevel4Items, false)
+						if !diags.HasError() {
+							validation_paramsLevel4Map["verify_subject_alt_names"] = verify_subject_alt_namesLevel4Items
+						}
+					}
+					common_paramsLevel3Map["validation_params"] = validation_paramsLevel4Map
+				}
+				tls_configNestedMap["common_params"] = common_paramsLevel3Map
+			}
+			if data.AccessInfo.TLSConfig.DefaultSessionKeyCaching != nil {
+				tls_configNestedMap["default_session_key_caching"] = map[string]interface{}{}
+			}
+			if data.AccessInfo.TLSConfig.DisableSessionKeyCaching != nil {
+				tls_configNestedMap["disable_session_key_caching"] = map[string]interface{}{}
+			}
+			if data.AccessInfo.TLSConfig.DisableSni != nil {
+				tls_configNestedMap["disable_sni"] = map[string]interface{}{}
+			}
 			if !data.AccessInfo.TLSConfig.MaxSessionKeys.IsNull() && !data.AccessInfo.TLSConfig.MaxSessionKeys.IsUnknown() {
 				tls_configNestedMap["max_session_keys"] = data.AccessInfo.TLSConfig.MaxSessionKeys.ValueInt64()
 			}
 			if !data.AccessInfo.TLSConfig.Sni.IsNull() && !data.AccessInfo.TLSConfig.Sni.IsUnknown() {
 				tls_configNestedMap["sni"] = data.AccessInfo.TLSConfig.Sni.ValueString()
 			}
+			if data.AccessInfo.TLSConfig.UseHostHeaderAsSni != nil {
+				tls_configNestedMap["use_host_header_as_sni"] = map[string]interface{}{}
+			}
 			access_infoMap["tls_config"] = tls_configNestedMap
 		}
 		if data.AccessInfo.VaultAuthInfo != nil {
 			vault_auth_infoNestedMap := make(map[string]interface{})
+			if data.AccessInfo.VaultAuthInfo.AppRoleAuth != nil {
+				app_role_authLevel3Map := make(map[string]interface{})
+				if !data.AccessInfo.VaultAuthInfo.AppRoleAuth.RoleID.IsNull() && !data.AccessInfo.VaultAuthInfo.AppRoleAuth.RoleID.IsUnknown() {
+					app_role_authLevel3Map["role_id"] = data.AccessInfo.VaultAuthInfo.AppRoleAuth.RoleID.ValueString()
+				}
+				if data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID != nil {
+					secret_idLevel4Map := make(map[string]interface{})
+					if data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.Location.IsNull() && !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						secret_idLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo.Provider.IsNull() && !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo.URL.IsNull() && !data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.AccessInfo.VaultAuthInfo.AppRoleAuth.SecretID.ClearSecretInfo.URL.ValueString()
+						}
+						secret_idLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					app_role_authLevel3Map["secret_id"] = secret_idLevel4Map
+				}
+				vault_auth_infoNestedMap["app_role_auth"] = app_role_authLevel3Map
+			}
+			if data.AccessInfo.VaultAuthInfo.Token != nil {
+				tokenLevel3Map := make(map[string]interface{})
+				if data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.Location.IsNull() && !data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.AccessInfo.VaultAuthInfo.Token.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					tokenLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo.Provider.IsNull() && !data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo.URL.IsNull() && !data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.AccessInfo.VaultAuthInfo.Token.ClearSecretInfo.URL.ValueString()
+					}
+					tokenLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				vault_auth_infoNestedMap["token"] = tokenLevel3Map
+			}
 			access_infoMap["vault_auth_info"] = vault_auth_infoNestedMap
 		}
 		apiResource.Spec["access_info"] = access_infoMap
@@ -1820,6 +2360,12 @@ func (r *SecretManagementAccessResource) Update(ctx context.Context, req resourc
 		whereMap := make(map[string]interface{})
 		if data.Where.Site != nil {
 			siteNestedMap := make(map[string]interface{})
+			if data.Where.Site.DisableInternetVIP != nil {
+				siteNestedMap["disable_internet_vip"] = map[string]interface{}{}
+			}
+			if data.Where.Site.EnableInternetVIP != nil {
+				siteNestedMap["enable_internet_vip"] = map[string]interface{}{}
+			}
 			if !data.Where.Site.NetworkType.IsNull() && !data.Where.Site.NetworkType.IsUnknown() {
 				siteNestedMap["network_type"] = data.Where.Site.NetworkType.ValueString()
 			}
@@ -1831,6 +2377,12 @@ func (r *SecretManagementAccessResource) Update(ctx context.Context, req resourc
 		}
 		if data.Where.VirtualSite != nil {
 			virtual_siteNestedMap := make(map[string]interface{})
+			if data.Where.VirtualSite.DisableInternetVIP != nil {
+				virtual_siteNestedMap["disable_internet_vip"] = map[string]interface{}{}
+			}
+			if data.Where.VirtualSite.EnableInternetVIP != nil {
+				virtual_siteNestedMap["enable_internet_vip"] = map[string]interface{}{}
+			}
 			if !data.Where.VirtualSite.NetworkType.IsNull() && !data.Where.VirtualSite.NetworkType.IsUnknown() {
 				virtual_siteNestedMap["network_type"] = data.Where.VirtualSite.NetworkType.ValueString()
 			}
@@ -1844,7 +2396,7 @@ func (r *SecretManagementAccessResource) Update(ctx context.Context, req resourc
 
 	_, err := r.client.UpdateSecretManagementAccess(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SecretManagementAccess: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "secret_management_access", "update"))
 		return
 	}
 
@@ -1855,9 +2407,12 @@ func (r *SecretManagementAccessResource) Update(ctx context.Context, req resourc
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetSecretManagementAccess(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SecretManagementAccess after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "secret_management_access", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["provider_name"].(string); ok && v != "" {
@@ -1966,7 +2521,7 @@ func (r *SecretManagementAccessResource) Delete(ctx context.Context, req resourc
 	err := r.client.DeleteSecretManagementAccess(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "SecretManagementAccess already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1975,37 +2530,33 @@ func (r *SecretManagementAccessResource) Delete(ctx context.Context, req resourc
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "SecretManagementAccess delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SecretManagementAccess: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "secret_management_access", "delete"))
 		return
 	}
 }
 
 func (r *SecretManagementAccessResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *SecretManagementAccessResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("secret_management_access", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *SecretManagementAccessResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListSecretManagementAccesses(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *SecretManagementAccessResource) requiredNamespace() string {
+	return ""
 }
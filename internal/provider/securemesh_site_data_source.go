@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,59 @@ type SecuremeshSiteDataSource struct {
 	client *client.Client
 }
 
+// SecuremeshSiteDataSourceModel mirrors SecuremeshSiteResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type SecuremeshSiteDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata                 types.Object                                       `tfsdk:"system_metadata"`
+	Name                           types.String                                       `tfsdk:"name"`
+	Namespace                      types.String                                       `tfsdk:"namespace"`
+	Annotations                    types.Map                                          `tfsdk:"annotations"`
+	Description                    types.String                                       `tfsdk:"description"`
+	Disable                        types.Bool                                         `tfsdk:"disable"`
+	Labels                         types.Map                                          `tfsdk:"labels"`
+	ID                             types.String                                       `tfsdk:"id"`
+	TunnelDeadTimeout              types.Int64                                        `tfsdk:"tunnel_dead_timeout"`
+	TunnelType                     types.String                                       `tfsdk:"tunnel_type"`
+	ActiveEnhancedFirewallPolicies *SecuremeshSiteActiveEnhancedFirewallPoliciesModel `tfsdk:"active_enhanced_firewall_policies"`
+	ActiveForwardProxyPolicies     *SecuremeshSiteActiveForwardProxyPoliciesModel     `tfsdk:"active_forward_proxy_policies"`
+	AdminUserCredentials           *SecuremeshSiteAdminUserCredentialsModel           `tfsdk:"admin_user_credentials"`
+	AWS                            *SecuremeshSiteAWSModel                            `tfsdk:"aws"`
+	Azure                          *SecuremeshSiteAzureModel                          `tfsdk:"azure"`
+	Baremetal                      *SecuremeshSiteBaremetalModel                      `tfsdk:"baremetal"`
+	BlockAllServices               *SecuremeshSiteEmptyModel                          `tfsdk:"block_all_services"`
+	BlockedServices                *SecuremeshSiteBlockedServicesModel                `tfsdk:"blocked_services"`
+	CustomProxy                    *SecuremeshSiteCustomProxyModel                    `tfsdk:"custom_proxy"`
+	CustomProxyBypass              *SecuremeshSiteCustomProxyBypassModel              `tfsdk:"custom_proxy_bypass"`
+	DcClusterGroupSLI              *SecuremeshSiteDcClusterGroupSLIModel              `tfsdk:"dc_cluster_group_sli"`
+	DcClusterGroupSlo              *SecuremeshSiteDcClusterGroupSloModel              `tfsdk:"dc_cluster_group_slo"`
+	DisableHA                      *SecuremeshSiteEmptyModel                          `tfsdk:"disable_ha"`
+	DisableURLCategorization       *SecuremeshSiteEmptyModel                          `tfsdk:"disable_url_categorization"`
+	DNSNTPConfig                   *SecuremeshSiteDNSNTPConfigModel                   `tfsdk:"dns_ntp_config"`
+	EnableHA                       *SecuremeshSiteEmptyModel                          `tfsdk:"enable_ha"`
+	EnableURLCategorization        *SecuremeshSiteEmptyModel                          `tfsdk:"enable_url_categorization"`
+	Equinix                        *SecuremeshSiteEquinixModel                        `tfsdk:"equinix"`
+	F5Proxy                        *SecuremeshSiteEmptyModel                          `tfsdk:"f5_proxy"`
+	GCP                            *SecuremeshSiteGCPModel                            `tfsdk:"gcp"`
+	Kvm                            *SecuremeshSiteKvmModel                            `tfsdk:"kvm"`
+	LoadBalancing                  *SecuremeshSiteLoadBalancingModel                  `tfsdk:"load_balancing"`
+	LocalVrf                       *SecuremeshSiteLocalVrfModel                       `tfsdk:"local_vrf"`
+	LogReceiver                    *SecuremeshSiteLogReceiverModel                    `tfsdk:"log_receiver"`
+	LogsStreamingDisabled          *SecuremeshSiteEmptyModel                          `tfsdk:"logs_streaming_disabled"`
+	NoForwardProxy                 *SecuremeshSiteEmptyModel                          `tfsdk:"no_forward_proxy"`
+	NoNetworkPolicy                *SecuremeshSiteEmptyModel                          `tfsdk:"no_network_policy"`
+	NoProxyBypass                  *SecuremeshSiteEmptyModel                          `tfsdk:"no_proxy_bypass"`
+	NoS2SConnectivitySLI           *SecuremeshSiteEmptyModel                          `tfsdk:"no_s2s_connectivity_sli"`
+	NoS2SConnectivitySlo           *SecuremeshSiteEmptyModel                          `tfsdk:"no_s2s_connectivity_slo"`
+	Nutanix                        *SecuremeshSiteNutanixModel                        `tfsdk:"nutanix"`
+	Oci                            *SecuremeshSiteOciModel                            `tfsdk:"oci"`
+	OfflineSurvivabilityMode       *SecuremeshSiteOfflineSurvivabilityModeModel       `tfsdk:"offline_survivability_mode"`
+	Openstack                      *SecuremeshSiteOpenstackModel                      `tfsdk:"openstack"`
+	PerformanceEnhancementMode     *SecuremeshSitePerformanceEnhancementModeModel     `tfsdk:"performance_enhancement_mode"`
+	RESelect                       *SecuremeshSiteRESelectModel                       `tfsdk:"re_select"`
+	SiteMeshGroupOnSlo             *SecuremeshSiteSiteMeshGroupOnSloModel             `tfsdk:"site_mesh_group_on_slo"`
+	SoftwareSettings               *SecuremeshSiteSoftwareSettingsModel               `tfsdk:"software_settings"`
+	UpgradeSettings                *SecuremeshSiteUpgradeSettingsModel                `tfsdk:"upgrade_settings"`
+	Vmware                         *SecuremeshSiteVmwareModel                         `tfsdk:"vmware"`
 }
 
 func (d *SecuremeshSiteDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +86,7 @@ func (d *SecuremeshSiteDataSource) Metadata(ctx context.Context, req datasource.
 }
 
 func (d *SecuremeshSiteDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a Securemesh Site resource in F5 Distributed Cloud for deploying secure mesh edge sites with distributed security capabilities.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the SecuremeshSite.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the SecuremeshSite exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the SecuremeshSite.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewSecuremeshSiteResource())
 }
 
 func (d *SecuremeshSiteDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +108,25 @@ func (d *SecuremeshSiteDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	resource, err := d.client.GetSecuremeshSite(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetSecuremeshSite(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SecuremeshSite: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "securemesh_site", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +140,8 @@ func (d *SecuremeshSiteDataSource) Read(ctx context.Context, req datasource.Read
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +150,464 @@ func (d *SecuremeshSiteDataSource) Read(ctx context.Context, req datasource.Read
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if blockData, ok := apiResource.Spec["active_enhanced_firewall_policies"].(map[string]interface{}); ok && (isImport || data.ActiveEnhancedFirewallPolicies != nil) {
+		data.ActiveEnhancedFirewallPolicies = &SecuremeshSiteActiveEnhancedFirewallPoliciesModel{
+			EnhancedFirewallPolicies: func() []SecuremeshSiteActiveEnhancedFirewallPoliciesEnhancedFirewallPoliciesModel {
+				if listData, ok := blockData["enhanced_firewall_policies"].([]interface{}); ok && len(listData) > 0 {
+					var result []SecuremeshSiteActiveEnhancedFirewallPoliciesEnhancedFirewallPoliciesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, SecuremeshSiteActiveEnhancedFirewallPoliciesEnhancedFirewallPoliciesModel{
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := itemMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := itemMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["active_forward_proxy_policies"].(map[string]interface{}); ok && (isImport || data.ActiveForwardProxyPolicies != nil) {
+		data.ActiveForwardProxyPolicies = &SecuremeshSiteActiveForwardProxyPoliciesModel{
+			ForwardProxyPolicies: func() []SecuremeshSiteActiveForwardProxyPoliciesForwardProxyPoliciesModel {
+				if listData, ok := blockData["forward_proxy_policies"].([]interface{}); ok && len(listData) > 0 {
+					var result []SecuremeshSiteActiveForwardProxyPoliciesForwardProxyPoliciesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, SecuremeshSiteActiveForwardProxyPoliciesForwardProxyPoliciesModel{
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := itemMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := itemMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["admin_user_credentials"].(map[string]interface{}); ok && (isImport || data.AdminUserCredentials != nil) {
+		data.AdminUserCredentials = &SecuremeshSiteAdminUserCredentialsModel{
+			AdminPassword: func() *SecuremeshSiteAdminUserCredentialsAdminPasswordModel {
+				if !isImport && data.AdminUserCredentials != nil && data.AdminUserCredentials.AdminPassword != nil {
+					// Normal Read: preserve existing state value
+					return data.AdminUserCredentials.AdminPassword
+				}
+				// Import case: read from API
+				if _, ok := blockData["admin_password"].(map[string]interface{}); ok {
+					return &SecuremeshSiteAdminUserCredentialsAdminPasswordModel{}
+				}
+				return nil
+			}(),
+			SSHKey: func() types.String {
+				if v, ok := blockData["ssh_key"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["aws"].(map[string]interface{}); ok && isImport && data.AWS == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AWS = &SecuremeshSiteAWSModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["azure"].(map[string]interface{}); ok && isImport && data.Azure == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Azure = &SecuremeshSiteAzureModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["baremetal"].(map[string]interface{}); ok && isImport && data.Baremetal == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Baremetal = &SecuremeshSiteBaremetalModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["block_all_services"].(map[string]interface{}); ok && isImport && data.BlockAllServices == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.BlockAllServices = &SecuremeshSiteEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["blocked_services"].(map[string]interface{}); ok && (isImport || data.BlockedServices != nil) {
+		data.BlockedServices = &SecuremeshSiteBlockedServicesModel{
+			BlockedSevice: func() []SecuremeshSiteBlockedServicesBlockedSeviceModel {
+				if listData, ok := blockData["blocked_sevice"].([]interface{}); ok && len(listData) > 0 {
+					var result []SecuremeshSiteBlockedServicesBlockedSeviceModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, SecuremeshSiteBlockedServicesBlockedSeviceModel{
+								DNS: func() *SecuremeshSiteEmptyModel {
+									if _, ok := itemMap["dns"].(map[string]interface{}); ok {
+										return &SecuremeshSiteEmptyModel{}
+									}
+									return nil
+								}(),
+								NetworkType: func() types.String {
+									if v, ok := itemMap["network_type"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								SSH: func() *SecuremeshSiteEmptyModel {
+									if _, ok := itemMap["ssh"].(map[string]interface{}); ok {
+										return &SecuremeshSiteEmptyModel{}
+									}
+									return nil
+								}(),
+								WebUserInterface: func() *SecuremeshSiteEmptyModel {
+									if _, ok := itemMap["web_user_interface"].(map[string]interface{}); ok {
+										return &SecuremeshSiteEmptyModel{}
+									}
+									return nil
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["custom_proxy"].(map[string]interface{}); ok && (isImport || data.CustomProxy != nil) {
+		data.CustomProxy = &SecuremeshSiteCustomProxyModel{
+			DisableRETunnel: func() *SecuremeshSiteEmptyModel {
+				if !isImport && data.CustomProxy != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.CustomProxy.DisableRETunnel
+				}
+				// Import case: read from API
+				if _, ok := blockData["disable_re_tunnel"].(map[string]interface{}); ok {
+					return &SecuremeshSiteEmptyModel{}
+				}
+				return nil
+			}(),
+			EnableRETunnel: func() *SecuremeshSiteEmptyModel {
+				if !isImport && data.CustomProxy != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.CustomProxy.EnableRETunnel
+				}
+				// Import case: read from API
+				if _, ok := blockData["enable_re_tunnel"].(map[string]interface{}); ok {
+					return &SecuremeshSiteEmptyModel{}
+				}
+				return nil
+			}(),
+			Password: func() *SecuremeshSiteCustomProxyPasswordModel {
+				if !isImport && data.CustomProxy != nil && data.CustomProxy.Password != nil {
+					// Normal Read: preserve existing state value
+					return data.CustomProxy.Password
+				}
+				// Import case: read from API
+				if _, ok := blockData["password"].(map[string]interface{}); ok {
+					return &SecuremeshSiteCustomProxyPasswordModel{}
+				}
+				return nil
+			}(),
+			ProxyIPAddress: func() types.String {
+				if v, ok := blockData["proxy_ip_address"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ProxyPort: func() types.Int64 {
+				if !isImport && data.CustomProxy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.CustomProxy.ProxyPort
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["proxy_port"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			Username: func() types.String {
+				if v, ok := blockData["username"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["custom_proxy_bypass"].(map[string]interface{}); ok && (isImport || data.CustomProxyBypass != nil) {
+		data.CustomProxyBypass = &SecuremeshSiteCustomProxyBypassModel{
+			ProxyBypass: func() types.List {
+				if v, ok := blockData["proxy_bypass"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["dc_cluster_group_sli"].(map[string]interface{}); ok && (isImport || data.DcClusterGroupSLI != nil) {
+		data.DcClusterGroupSLI = &SecuremeshSiteDcClusterGroupSLIModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["dc_cluster_group_slo"].(map[string]interface{}); ok && (isImport || data.DcClusterGroupSlo != nil) {
+		data.DcClusterGroupSlo = &SecuremeshSiteDcClusterGroupSloModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["disable_ha"].(map[string]interface{}); ok && isImport && data.DisableHA == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableHA = &SecuremeshSiteEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_url_categorization"].(map[string]interface{}); ok && isImport && data.DisableURLCategorization == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableURLCategorization = &SecuremeshSiteEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["dns_ntp_config"].(map[string]interface{}); ok && isImport && data.DNSNTPConfig == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DNSNTPConfig = &SecuremeshSiteDNSNTPConfigModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["enable_ha"].(map[string]interface{}); ok && isImport && data.EnableHA == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.EnableHA = &SecuremeshSiteEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["enable_url_categorization"].(map[string]interface{}); ok && isImport && data.EnableURLCategorization == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.EnableURLCategorization = &SecuremeshSiteEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["equinix"].(map[string]interface{}); ok && isImport && data.Equinix == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Equinix = &SecuremeshSiteEquinixModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["f5_proxy"].(map[string]interface{}); ok && isImport && data.F5Proxy == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.F5Proxy = &SecuremeshSiteEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["gcp"].(map[string]interface{}); ok && isImport && data.GCP == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.GCP = &SecuremeshSiteGCPModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["kvm"].(map[string]interface{}); ok && isImport && data.Kvm == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Kvm = &SecuremeshSiteKvmModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["load_balancing"].(map[string]interface{}); ok && (isImport || data.LoadBalancing != nil) {
+		data.LoadBalancing = &SecuremeshSiteLoadBalancingModel{
+			VIPVrrpMode: func() types.String {
+				if v, ok := blockData["vip_vrrp_mode"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["local_vrf"].(map[string]interface{}); ok && isImport && data.LocalVrf == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.LocalVrf = &SecuremeshSiteLocalVrfModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["log_receiver"].(map[string]interface{}); ok && (isImport || data.LogReceiver != nil) {
+		data.LogReceiver = &SecuremeshSiteLogReceiverModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["logs_streaming_disabled"].(map[string]interface{}); ok && isImport && data.LogsStreamingDisabled == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.LogsStreamingDisabled = &SecuremeshSiteEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["no_forward_proxy"].(map[string]interface{}); ok && isImport && data.NoForwardProxy == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoForwardProxy = &SecuremeshSiteEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["no_network_policy"].(map[string]interface{}); ok && isImport && data.NoNetworkPolicy == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoNetworkPolicy = &SecuremeshSiteEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["no_proxy_bypass"].(map[string]interface{}); ok && isImport && data.NoProxyBypass == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoProxyBypass = &SecuremeshSiteEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["no_s2s_connectivity_sli"].(map[string]interface{}); ok && isImport && data.NoS2SConnectivitySLI == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoS2SConnectivitySLI = &SecuremeshSiteEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["no_s2s_connectivity_slo"].(map[string]interface{}); ok && isImport && data.NoS2SConnectivitySlo == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoS2SConnectivitySlo = &SecuremeshSiteEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["nutanix"].(map[string]interface{}); ok && isImport && data.Nutanix == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Nutanix = &SecuremeshSiteNutanixModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["oci"].(map[string]interface{}); ok && isImport && data.Oci == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Oci = &SecuremeshSiteOciModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["offline_survivability_mode"].(map[string]interface{}); ok && isImport && data.OfflineSurvivabilityMode == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.OfflineSurvivabilityMode = &SecuremeshSiteOfflineSurvivabilityModeModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["openstack"].(map[string]interface{}); ok && isImport && data.Openstack == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Openstack = &SecuremeshSiteOpenstackModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["performance_enhancement_mode"].(map[string]interface{}); ok && isImport && data.PerformanceEnhancementMode == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.PerformanceEnhancementMode = &SecuremeshSitePerformanceEnhancementModeModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["re_select"].(map[string]interface{}); ok && isImport && data.RESelect == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.RESelect = &SecuremeshSiteRESelectModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["site_mesh_group_on_slo"].(map[string]interface{}); ok && isImport && data.SiteMeshGroupOnSlo == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SiteMeshGroupOnSlo = &SecuremeshSiteSiteMeshGroupOnSloModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["software_settings"].(map[string]interface{}); ok && isImport && data.SoftwareSettings == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SoftwareSettings = &SecuremeshSiteSoftwareSettingsModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["upgrade_settings"].(map[string]interface{}); ok && isImport && data.UpgradeSettings == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.UpgradeSettings = &SecuremeshSiteUpgradeSettingsModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["vmware"].(map[string]interface{}); ok && isImport && data.Vmware == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.Vmware = &SecuremeshSiteVmwareModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["tunnel_dead_timeout"].(float64); ok {
+		data.TunnelDeadTimeout = types.Int64Value(int64(v))
+	} else {
+		data.TunnelDeadTimeout = types.Int64Null()
+	}
+	if v, ok := apiResource.Spec["tunnel_type"].(string); ok && v != "" {
+		data.TunnelType = types.StringValue(v)
+	} else {
+		data.TunnelType = types.StringNull()
+	}
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewSecuremeshSiteResource(), &data)...)
 }
@@ -6,11 +6,9 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
@@ -21,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
@@ -30,6 +29,9 @@ var (
 	_ resource.Resource                   = &SecuremeshSiteResource{}
 	_ resource.ResourceWithConfigure      = &SecuremeshSiteResource{}
 	_ resource.ResourceWithImportState    = &SecuremeshSiteResource{}
+	_ resource.ResourceWithIdentity       = &SecuremeshSiteResource{}
+	_ resource.ResourceWithMoveState      = &SecuremeshSiteResource{}
+	_ listableResource                    = &SecuremeshSiteResource{}
 	_ resource.ResourceWithModifyPlan     = &SecuremeshSiteResource{}
 	_ resource.ResourceWithValidateConfig = &SecuremeshSiteResource{}
 )
@@ -134,14 +136,16 @@ var SecuremeshSiteAdminUserCredentialsAdminPasswordBlindfoldSecretInfoModelAttrT
 
 // SecuremeshSiteAdminUserCredentialsAdminPasswordClearSecretInfoModel represents clear_secret_info block
 type SecuremeshSiteAdminUserCredentialsAdminPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // SecuremeshSiteAdminUserCredentialsAdminPasswordClearSecretInfoModelAttrTypes defines the attribute types for SecuremeshSiteAdminUserCredentialsAdminPasswordClearSecretInfoModel
 var SecuremeshSiteAdminUserCredentialsAdminPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // SecuremeshSiteAWSModel represents aws block
@@ -1148,14 +1152,16 @@ var SecuremeshSiteCustomProxyPasswordBlindfoldSecretInfoModelAttrTypes = map[str
 
 // SecuremeshSiteCustomProxyPasswordClearSecretInfoModel represents clear_secret_info block
 type SecuremeshSiteCustomProxyPasswordClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // SecuremeshSiteCustomProxyPasswordClearSecretInfoModelAttrTypes defines the attribute types for SecuremeshSiteCustomProxyPasswordClearSecretInfoModel
 var SecuremeshSiteCustomProxyPasswordClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // SecuremeshSiteCustomProxyBypassModel represents custom_proxy_bypass block
@@ -3930,7 +3936,10 @@ type SecuremeshSiteResourceModel struct {
 	ID                             types.String                                       `tfsdk:"id"`
 	TunnelDeadTimeout              types.Int64                                        `tfsdk:"tunnel_dead_timeout"`
 	TunnelType                     types.String                                       `tfsdk:"tunnel_type"`
+	WaitForState                   types.Bool                                         `tfsdk:"wait_for_state"`
 	Timeouts                       timeouts.Value                                     `tfsdk:"timeouts"`
+	SystemMetadata                 types.Object                                       `tfsdk:"system_metadata"`
+	LabelsAll                      types.Map                                          `tfsdk:"labels_all"`
 	ActiveEnhancedFirewallPolicies *SecuremeshSiteActiveEnhancedFirewallPoliciesModel `tfsdk:"active_enhanced_firewall_policies"`
 	ActiveForwardProxyPolicies     *SecuremeshSiteActiveForwardProxyPoliciesModel     `tfsdk:"active_forward_proxy_policies"`
 	AdminUserCredentials           *SecuremeshSiteAdminUserCredentialsModel           `tfsdk:"admin_user_credentials"`
@@ -3992,9 +4001,11 @@ func (r *SecuremeshSiteResource) Schema(ctx context.Context, req resource.Schema
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Securemesh Site will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Securemesh Site will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -4042,6 +4053,12 @@ func (r *SecuremeshSiteResource) Schema(ctx context.Context, req resource.Schema
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"wait_for_state": schema.BoolAttribute{
+				MarkdownDescription: siteWaitForStateDescription,
+				Optional:            true,
+			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -4154,7 +4171,13 @@ func (r *SecuremeshSiteResource) Schema(ctx context.Context, req resource.Schema
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -5164,7 +5187,13 @@ func (r *SecuremeshSiteResource) Schema(ctx context.Context, req resource.Schema
 										Optional:            true,
 									},
 									"url": schema.StringAttribute{
-										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+										MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+										Optional:            true,
+										Sensitive:           true,
+										WriteOnly:           true,
+									},
+									"url_version": schema.Int64Attribute{
+										MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 										Optional:            true,
 									},
 								},
@@ -8075,6 +8104,11 @@ func (r *SecuremeshSiteResource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *SecuremeshSiteResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *SecuremeshSiteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -8109,7 +8143,10 @@ func (r *SecuremeshSiteResource) ModifyPlan(ctx context.Context, req resource.Mo
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "securemesh_site", "system", req.Config, &resp.Plan)...)
 		var plan SecuremeshSiteResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -8127,12 +8164,13 @@ func (r *SecuremeshSiteResource) ModifyPlan(ctx context.Context, req resource.Mo
 
 func (r *SecuremeshSiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data SecuremeshSiteResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	createTimeout, diags := data.Timeouts.Create(ctx, inttimeouts.DefaultCreate)
+	createTimeout, diags := data.Timeouts.Create(ctx, inttimeouts.LongRunningCreate)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -8166,6 +8204,7 @@ func (r *SecuremeshSiteResource) Create(ctx context.Context, req resource.Create
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -8175,6 +8214,7 @@ func (r *SecuremeshSiteResource) Create(ctx context.Context, req resource.Create
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.ActiveEnhancedFirewallPolicies != nil {
@@ -8606,11 +8646,15 @@ func (r *SecuremeshSiteResource) Create(ctx context.Context, req resource.Create
 
 	apiResource, err := r.client.CreateSecuremeshSite(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create SecuremeshSite: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "securemesh_site", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -9071,6 +9115,11 @@ func (r *SecuremeshSiteResource) Create(ctx context.Context, req resource.Create
 
 	tflog.Trace(ctx, "created SecuremeshSite resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// The site is saved before waiting so that a failed wait taints it
+	if data.WaitForState.ValueBool() && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(waitForSiteOnline(ctx, "securemesh_site", r.siteSpec(data.Namespace.ValueString(), data.Name.ValueString()))...)
+	}
 }
 
 func (r *SecuremeshSiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -9092,7 +9141,7 @@ func (r *SecuremeshSiteResource) Read(ctx context.Context, req resource.ReadRequ
 	apiResource, err := r.client.GetSecuremeshSite(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "SecuremeshSite not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -9100,11 +9149,12 @@ func (r *SecuremeshSiteResource) Read(ctx context.Context, req resource.ReadRequ
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SecuremeshSite: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "securemesh_site", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -9115,31 +9165,13 @@ func (r *SecuremeshSiteResource) Read(ctx context.Context, req resource.ReadRequ
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -9147,6 +9179,7 @@ func (r *SecuremeshSiteResource) Read(ctx context.Context, req resource.ReadRequ
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["active_enhanced_firewall_policies"].(map[string]interface{}); ok && (isImport || data.ActiveEnhancedFirewallPolicies != nil) {
 		data.ActiveEnhancedFirewallPolicies = &SecuremeshSiteActiveEnhancedFirewallPoliciesModel{
@@ -9606,12 +9639,13 @@ func (r *SecuremeshSiteResource) Read(ctx context.Context, req resource.ReadRequ
 
 func (r *SecuremeshSiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data SecuremeshSiteResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateTimeout, diags := data.Timeouts.Update(ctx, inttimeouts.DefaultUpdate)
+	updateTimeout, diags := data.Timeouts.Update(ctx, inttimeouts.LongRunningCreate)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -9640,6 +9674,7 @@ func (r *SecuremeshSiteResource) Update(ctx context.Context, req resource.Update
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -9649,6 +9684,7 @@ func (r *SecuremeshSiteResource) Update(ctx context.Context, req resource.Update
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.ActiveEnhancedFirewallPolicies != nil {
@@ -10080,7 +10116,7 @@ func (r *SecuremeshSiteResource) Update(ctx context.Context, req resource.Update
 
 	_, err := r.client.UpdateSecuremeshSite(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update SecuremeshSite: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "securemesh_site", "update"))
 		return
 	}
 
@@ -10091,9 +10127,12 @@ func (r *SecuremeshSiteResource) Update(ctx context.Context, req resource.Update
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetSecuremeshSite(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SecuremeshSite after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "securemesh_site", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["tunnel_dead_timeout"].(float64); ok {
@@ -10578,7 +10617,7 @@ func (r *SecuremeshSiteResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	deleteTimeout, diags := data.Timeouts.Delete(ctx, inttimeouts.DefaultDelete)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, inttimeouts.LongRunningDelete)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -10589,7 +10628,7 @@ func (r *SecuremeshSiteResource) Delete(ctx context.Context, req resource.Delete
 	err := r.client.DeleteSecuremeshSite(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "SecuremeshSite already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -10598,37 +10637,49 @@ func (r *SecuremeshSiteResource) Delete(ctx context.Context, req resource.Delete
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "SecuremeshSite delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete SecuremeshSite: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "securemesh_site", "delete"))
 		return
 	}
+
+	if data.WaitForState.ValueBool() {
+		resp.Diagnostics.Append(waitForSiteDecommissioned(ctx, "securemesh_site", r.siteSpec(data.Namespace.ValueString(), data.Name.ValueString()))...)
+	}
 }
 
-func (r *SecuremeshSiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
+// siteSpec returns a function that reads the spec of the site, for waiting
+// on its site_state
+func (r *SecuremeshSiteResource) siteSpec(namespace, name string) siteSpecFunc {
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		site, err := r.client.GetSecuremeshSite(ctx, namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		return site.Spec, nil
 	}
-	namespace := parts[0]
-	name := parts[1]
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+func (r *SecuremeshSiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *SecuremeshSiteResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("securemesh_site", true)}
+}
+
+// listObjects implements listableResource
+func (r *SecuremeshSiteResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListSecuremeshSites(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *SecuremeshSiteResource) requiredNamespace() string {
+	return "system"
 }
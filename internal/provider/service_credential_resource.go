@@ -0,0 +1,595 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// Service Credential Resource for F5 XC
+// Issues API certificates, API tokens and kubeconfigs for a service user,
+// which is created with the credential and holds its own roles. Like API
+// credentials, the material is only returned in the create response. The
+// roles and user groups can be replaced in place; every other change
+// replaces the credential.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
+	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
+	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
+)
+
+// serviceCredentialTypes are the credential types issued for a service user
+var serviceCredentialTypes = []string{
+	client.ServiceCredentialTypeAPICertificate,
+	client.ServiceCredentialTypeAPIToken,
+	client.ServiceCredentialTypeKubeconfig,
+	client.ServiceCredentialTypeSiteGlobalKubeconfig,
+}
+
+// namespaceRoleAttrTypes are the attribute types of a namespace_roles element
+var namespaceRoleAttrTypes = map[string]attr.Type{
+	"namespace": types.StringType,
+	"role":      types.StringType,
+}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &ServiceCredentialResource{}
+	_ resource.ResourceWithConfigure   = &ServiceCredentialResource{}
+	_ resource.ResourceWithImportState = &ServiceCredentialResource{}
+	_ resource.ResourceWithModifyPlan  = &ServiceCredentialResource{}
+)
+
+func NewServiceCredentialResource() resource.Resource {
+	return &ServiceCredentialResource{}
+}
+
+type ServiceCredentialResource struct {
+	client *client.Client
+}
+
+type ServiceCredentialResourceModel struct {
+	ID                  types.String   `tfsdk:"id"`
+	Name                types.String   `tfsdk:"name"`
+	Namespace           types.String   `tfsdk:"namespace"`
+	Type                types.String   `tfsdk:"type"`
+	NamespaceRoles      types.Set      `tfsdk:"namespace_roles"`
+	UserGroupNames      types.Set      `tfsdk:"user_group_names"`
+	Password            types.String   `tfsdk:"password"`
+	PasswordVersion     types.Int64    `tfsdk:"password_version"`
+	VirtualK8sName      types.String   `tfsdk:"virtual_k8s_name"`
+	VirtualK8sNamespace types.String   `tfsdk:"virtual_k8s_namespace"`
+	SiteName            types.String   `tfsdk:"site_name"`
+	ExpirationDays      types.Int64    `tfsdk:"expiration_days"`
+	RotateBeforeDays    types.Int64    `tfsdk:"rotate_before_days"`
+	Active              types.Bool     `tfsdk:"active"`
+	ExpirationTimestamp types.String   `tfsdk:"expiration_timestamp"`
+	UserEmail           types.String   `tfsdk:"user_email"`
+	Data                types.String   `tfsdk:"data"`
+	Timeouts            timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *ServiceCredentialResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_credential"
+}
+
+func (r *ServiceCredentialResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Manages a service credential (API certificate, API token or kubeconfig of a service user) in F5 Distributed Cloud.
+
+A service credential is not tied to a user: it is issued for a service user that is created with the credential and has the
+roles in ` + "`namespace_roles`" + ` and the roles of the ` + "`user_group_names`" + ` groups. Changing the roles or the user groups
+updates the service credential in place.
+
+The credential material is only returned when the credential is created and is stored in the sensitive ` + "`data`" + ` attribute.
+It is not available after import. Changing any other argument revokes the credential and issues a new one.
+
+Set ` + "`rotate_before_days`" + ` to replace the credential automatically once it is that close to its expiration.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the service credential.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the service credential. Service credentials always belong to the `system` namespace.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("system"),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf("system"),
+				},
+			},
+			"type": schema.StringAttribute{
+				MarkdownDescription: "Type of the credential. Possible values: `SERVICE_API_CERTIFICATE`, `SERVICE_API_TOKEN`, `SERVICE_KUBE_CONFIG`, `SERVICE_SITE_GLOBAL_KUBE_CONFIG`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(serviceCredentialTypes...),
+				},
+			},
+			"namespace_roles": schema.SetNestedAttribute{
+				MarkdownDescription: "Roles of the service user by namespace.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"namespace": schema.StringAttribute{
+							MarkdownDescription: "Namespace the role applies to. `*` applies the role to all namespaces.",
+							Required:            true,
+						},
+						"role": schema.StringAttribute{
+							MarkdownDescription: "Name of the role, e.g. `ves-io-monitor-role`.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"user_group_names": schema.SetAttribute{
+				MarkdownDescription: "User groups the service user belongs to.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "Password protecting the P12 bundle of a `SERVICE_API_CERTIFICATE` credential. " + credentialPasswordDescription,
+				Optional:            true,
+				Sensitive:           true,
+				WriteOnly:           true,
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "Version of `password`. Change it to issue a new credential with a new value of the write-only `password`.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"virtual_k8s_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the virtual K8s cluster of a `SERVICE_KUBE_CONFIG` credential.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"virtual_k8s_namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the virtual K8s cluster of a `SERVICE_KUBE_CONFIG` credential.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"site_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the site of a `SERVICE_SITE_GLOBAL_KUBE_CONFIG` credential.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"expiration_days": schema.Int64Attribute{
+				MarkdownDescription: "Number of days the credential is valid for. Defaults to the tenant's credential policy.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"rotate_before_days": schema.Int64Attribute{
+				MarkdownDescription: "Replace the credential during the next plan once it expires within this many days.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"active": schema.BoolAttribute{
+				MarkdownDescription: "Whether the credential is active.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"expiration_timestamp": schema.StringAttribute{
+				MarkdownDescription: "Time at which the credential expires, in RFC 3339 format.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_email": schema.StringAttribute{
+				MarkdownDescription: "Email of the service user of the credential.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data": schema.StringAttribute{
+				MarkdownDescription: "Credential material returned when the credential is created: the API token, the base64 encoded P12 bundle or the kubeconfig.",
+				Computed:            true,
+				Sensitive:           true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *ServiceCredentialResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// ModifyPlan implements resource.ResourceWithModifyPlan. It replaces the
+// credential once it is within rotate_before_days of its expiration.
+func (r *ServiceCredentialResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddWarning(
+			"Resource Destruction",
+			"This will revoke the service credential and delete its service user in F5 Distributed Cloud.",
+		)
+		return
+	}
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ServiceCredentialResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planCredentialRotation(ctx, resp, state.Name.ValueString(), state.ExpirationTimestamp.ValueString(), plan.RotateBeforeDays)
+}
+
+// namespaceRoles returns the namespace_roles of the model
+func (data *ServiceCredentialResourceModel) namespaceRoles(ctx context.Context) ([]client.NamespaceRole, diag.Diagnostics) {
+	var roles []client.NamespaceRole
+	if data.NamespaceRoles.IsNull() || data.NamespaceRoles.IsUnknown() {
+		return roles, nil
+	}
+	var elements []struct {
+		Namespace types.String `tfsdk:"namespace"`
+		Role      types.String `tfsdk:"role"`
+	}
+	diags := data.NamespaceRoles.ElementsAs(ctx, &elements, false)
+	for _, element := range elements {
+		roles = append(roles, client.NamespaceRole{Namespace: element.Namespace.ValueString(), Role: element.Role.ValueString()})
+	}
+	return roles, diags
+}
+
+// userGroupNames returns the user_group_names of the model
+func (data *ServiceCredentialResourceModel) userGroupNames(ctx context.Context) ([]string, diag.Diagnostics) {
+	names := []string{}
+	if data.UserGroupNames.IsNull() || data.UserGroupNames.IsUnknown() {
+		return names, nil
+	}
+	diags := data.UserGroupNames.ElementsAs(ctx, &names, false)
+	return names, diags
+}
+
+// serviceCredentialNamespaceAccess converts namespace roles to the namespace
+// access of the replace request
+func serviceCredentialNamespaceAccess(roles []client.NamespaceRole) *client.NamespaceAccess {
+	access := &client.NamespaceAccess{NamespaceRoleMap: map[string]client.RoleList{}}
+	for _, role := range roles {
+		list := access.NamespaceRoleMap[role.Namespace]
+		list.Names = append(list.Names, role.Role)
+		access.NamespaceRoleMap[role.Namespace] = list
+	}
+	return access
+}
+
+// serviceCredentialNamespaceRoles converts the namespace access of a service
+// credential to namespace roles, sorted by namespace and role
+func serviceCredentialNamespaceRoles(access *client.NamespaceAccess) []client.NamespaceRole {
+	var roles []client.NamespaceRole
+	if access == nil {
+		return roles
+	}
+	for namespace, list := range access.NamespaceRoleMap {
+		for _, role := range list.Names {
+			roles = append(roles, client.NamespaceRole{Namespace: namespace, Role: role})
+		}
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		if roles[i].Namespace != roles[j].Namespace {
+			return roles[i].Namespace < roles[j].Namespace
+		}
+		return roles[i].Role < roles[j].Role
+	})
+	return roles
+}
+
+// serviceCredentialCreateRequest builds the create request, setting the
+// credential block that matches the type
+func serviceCredentialCreateRequest(data *ServiceCredentialResourceModel, roles []client.NamespaceRole, groups []string) *client.ServiceCredentialCreateRequest {
+	createReq := &client.ServiceCredentialCreateRequest{
+		Name:           data.Name.ValueString(),
+		Namespace:      data.Namespace.ValueString(),
+		Type:           data.Type.ValueString(),
+		ExpirationDays: data.ExpirationDays.ValueInt64(),
+		NamespaceRoles: roles,
+		UserGroupNames: groups,
+	}
+	switch data.Type.ValueString() {
+	case client.ServiceCredentialTypeAPIToken:
+		createReq.APIToken = &struct{}{}
+	case client.ServiceCredentialTypeAPICertificate:
+		createReq.APICertificate = &client.ServiceCredentialAPICertificate{Password: data.Password.ValueString()}
+	case client.ServiceCredentialTypeKubeconfig:
+		createReq.Vk8sKubeconfig = &client.ServiceCredentialVk8sKubeconfig{
+			Vk8sClusterName: data.VirtualK8sName.ValueString(),
+			Vk8sNamespace:   data.VirtualK8sNamespace.ValueString(),
+		}
+	case client.ServiceCredentialTypeSiteGlobalKubeconfig:
+		createReq.SiteKubeconfig = &client.APICredentialSiteKubeconfig{Site: data.SiteName.ValueString()}
+	}
+	return createReq
+}
+
+func (r *ServiceCredentialResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ServiceCredentialResourceModel
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, inttimeouts.DefaultCreate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	roles, diags := data.namespaceRoles(ctx)
+	resp.Diagnostics.Append(diags...)
+	groups, diags := data.userGroupNames(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating service_credential", map[string]interface{}{
+		"name":      data.Name.ValueString(),
+		"namespace": data.Namespace.ValueString(),
+		"type":      data.Type.ValueString(),
+	})
+
+	created, err := r.client.CreateServiceCredential(ctx, serviceCredentialCreateRequest(&data, roles, groups))
+	if err != nil {
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_credential", "create"))
+		return
+	}
+
+	data.ID = types.StringValue(data.Name.ValueString())
+	data.Active = types.BoolValue(created.Active)
+	data.ExpirationTimestamp = types.StringValue(created.ExpirationTimestamp)
+	data.Data = types.StringValue(created.Data)
+
+	// The service user is only known once the credential exists
+	fetched, err := r.client.GetServiceCredential(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	if err != nil {
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_credential", "read"))
+		return
+	}
+	data.UserEmail = types.StringValue(fetched.UserEmail)
+
+	tflog.Trace(ctx, "created ServiceCredential resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceCredentialResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ServiceCredentialResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, inttimeouts.DefaultRead)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	credential, err := r.client.GetServiceCredential(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// Check if the credential was revoked outside Terraform
+		if f5xcerrors.IsNotFound(err) {
+			tflog.Warn(ctx, "ServiceCredential not found, removing from state", map[string]interface{}{
+				"name":      data.Name.ValueString(),
+				"namespace": data.Namespace.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_credential", "read"))
+		return
+	}
+
+	data.ID = types.StringValue(data.Name.ValueString())
+	data.Active = types.BoolValue(credential.Active)
+	data.UserEmail = types.StringValue(credential.UserEmail)
+	if credential.Type != "" {
+		data.Type = types.StringValue(credential.Type)
+	}
+	if credential.ExpiryTimestamp != "" {
+		data.ExpirationTimestamp = types.StringValue(credential.ExpiryTimestamp)
+	}
+
+	// Empty roles and user groups stay null when they are not configured
+	if roles := serviceCredentialNamespaceRoles(credential.NamespaceAccess); len(roles) > 0 || !data.NamespaceRoles.IsNull() {
+		elements := make([]attr.Value, 0, len(roles))
+		for _, role := range roles {
+			element, diags := types.ObjectValue(namespaceRoleAttrTypes, map[string]attr.Value{
+				"namespace": types.StringValue(role.Namespace),
+				"role":      types.StringValue(role.Role),
+			})
+			resp.Diagnostics.Append(diags...)
+			elements = append(elements, element)
+		}
+		data.NamespaceRoles, diags = types.SetValue(types.ObjectType{AttrTypes: namespaceRoleAttrTypes}, elements)
+		resp.Diagnostics.Append(diags...)
+	}
+	if len(credential.UserGroupNames) > 0 || !data.UserGroupNames.IsNull() {
+		data.UserGroupNames, diags = types.SetValueFrom(ctx, types.StringType, credential.UserGroupNames)
+		resp.Diagnostics.Append(diags...)
+	}
+	// The credential material cannot be read back, so data keeps its state value
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// Update replaces the roles and user groups of the service credential. All
+// other arguments that affect the issued credential force replacement.
+func (r *ServiceCredentialResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state ServiceCredentialResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, inttimeouts.DefaultUpdate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if !data.NamespaceRoles.Equal(state.NamespaceRoles) || !data.UserGroupNames.Equal(state.UserGroupNames) {
+		roles, diags := data.namespaceRoles(ctx)
+		resp.Diagnostics.Append(diags...)
+		groups, diags := data.userGroupNames(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.client.ReplaceServiceCredential(ctx, &client.ServiceCredentialReplaceRequest{
+			Name:            data.Name.ValueString(),
+			Namespace:       data.Namespace.ValueString(),
+			NamespaceAccess: serviceCredentialNamespaceAccess(roles),
+			UserGroupNames:  groups,
+		})
+		if err != nil {
+			f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_credential", "update"))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ServiceCredentialResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ServiceCredentialResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, inttimeouts.DefaultDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+	err := r.client.RevokeServiceCredential(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	if err != nil {
+		// If the credential is already gone, consider deletion successful (idempotent delete)
+		if f5xcerrors.IsNotFound(err) {
+			tflog.Warn(ctx, "ServiceCredential already revoked, removing from state", map[string]interface{}{
+				"name":      data.Name.ValueString(),
+				"namespace": data.Namespace.ValueString(),
+			})
+			return
+		}
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_credential", "delete"))
+		return
+	}
+}
+
+func (r *ServiceCredentialResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: name or namespace/name
+	namespace, name := "system", req.ID
+	if parts := strings.Split(req.ID, "/"); len(parts) == 2 {
+		namespace, name = parts[0], parts[1]
+	}
+	if namespace == "" || name == "" || strings.Contains(name, "/") {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: name or namespace/name, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+}
@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+func TestServiceCredentialNamespaceAccess(t *testing.T) {
+	roles := []client.NamespaceRole{
+		{Namespace: "system", Role: "ves-io-monitor-role"},
+		{Namespace: "staging", Role: "ves-io-admin-role"},
+		{Namespace: "system", Role: "ves-io-uam-admin-role"},
+	}
+	access := serviceCredentialNamespaceAccess(roles)
+	expected := map[string]client.RoleList{
+		"system":  {Names: []string{"ves-io-monitor-role", "ves-io-uam-admin-role"}},
+		"staging": {Names: []string{"ves-io-admin-role"}},
+	}
+	if !reflect.DeepEqual(access.NamespaceRoleMap, expected) {
+		t.Errorf("serviceCredentialNamespaceAccess() = %+v, want %+v", access.NamespaceRoleMap, expected)
+	}
+
+	// The roles read back are sorted so that the order of the map does not matter
+	back := serviceCredentialNamespaceRoles(access)
+	sorted := []client.NamespaceRole{roles[1], roles[0], roles[2]}
+	if !reflect.DeepEqual(back, sorted) {
+		t.Errorf("serviceCredentialNamespaceRoles() = %+v, want %+v", back, sorted)
+	}
+
+	if roles := serviceCredentialNamespaceRoles(nil); len(roles) != 0 {
+		t.Errorf("serviceCredentialNamespaceRoles(nil) = %+v, want none", roles)
+	}
+}
+
+func TestServiceCredentialCreateRequest(t *testing.T) {
+	tests := []struct {
+		name  string
+		data  ServiceCredentialResourceModel
+		check func(*client.ServiceCredentialCreateRequest) bool
+	}{
+		{
+			"api token",
+			ServiceCredentialResourceModel{Type: types.StringValue(client.ServiceCredentialTypeAPIToken)},
+			func(r *client.ServiceCredentialCreateRequest) bool {
+				return r.APIToken != nil && r.APICertificate == nil && r.Vk8sKubeconfig == nil && r.SiteKubeconfig == nil
+			},
+		},
+		{
+			"api certificate",
+			ServiceCredentialResourceModel{
+				Type:     types.StringValue(client.ServiceCredentialTypeAPICertificate),
+				Password: types.StringValue("secret"),
+			},
+			func(r *client.ServiceCredentialCreateRequest) bool {
+				return r.APIToken == nil && r.APICertificate != nil && r.APICertificate.Password == "secret"
+			},
+		},
+		{
+			"virtual K8s kubeconfig",
+			ServiceCredentialResourceModel{
+				Type:                types.StringValue(client.ServiceCredentialTypeKubeconfig),
+				VirtualK8sName:      types.StringValue("vk8s1"),
+				VirtualK8sNamespace: types.StringValue("app"),
+			},
+			func(r *client.ServiceCredentialCreateRequest) bool {
+				return r.Vk8sKubeconfig != nil && r.Vk8sKubeconfig.Vk8sClusterName == "vk8s1" && r.Vk8sKubeconfig.Vk8sNamespace == "app"
+			},
+		},
+		{
+			"site kubeconfig",
+			ServiceCredentialResourceModel{
+				Type:     types.StringValue(client.ServiceCredentialTypeSiteGlobalKubeconfig),
+				SiteName: types.StringValue("ce1"),
+			},
+			func(r *client.ServiceCredentialCreateRequest) bool {
+				return r.SiteKubeconfig != nil && r.SiteKubeconfig.Site == "ce1" && r.Vk8sKubeconfig == nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			createReq := serviceCredentialCreateRequest(&tt.data, nil, []string{})
+			if createReq.Type != tt.data.Type.ValueString() {
+				t.Errorf("serviceCredentialCreateRequest().Type = %q, want %q", createReq.Type, tt.data.Type.ValueString())
+			}
+			if !tt.check(createReq) {
+				t.Errorf("serviceCredentialCreateRequest() = %+v, wrong credential block", createReq)
+			}
+		})
+	}
+}
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &ServicePolicyResource{}
-	_ resource.ResourceWithConfigure      = &ServicePolicyResource{}
-	_ resource.ResourceWithImportState    = &ServicePolicyResource{}
-	_ resource.ResourceWithModifyPlan     = &ServicePolicyResource{}
-	_ resource.ResourceWithValidateConfig = &ServicePolicyResource{}
+	_ resource.Resource                     = &ServicePolicyResource{}
+	_ resource.ResourceWithConfigure        = &ServicePolicyResource{}
+	_ resource.ResourceWithImportState      = &ServicePolicyResource{}
+	_ resource.ResourceWithIdentity         = &ServicePolicyResource{}
+	_ resource.ResourceWithMoveState        = &ServicePolicyResource{}
+	_ resource.ResourceWithModifyPlan       = &ServicePolicyResource{}
+	_ resource.ResourceWithValidateConfig   = &ServicePolicyResource{}
+	_ resource.ResourceWithConfigValidators = &ServicePolicyResource{}
+	_ listableResource                      = &ServicePolicyResource{}
 )
 
 func NewServicePolicyResource() resource.Resource {
@@ -973,6 +980,8 @@ type ServicePolicyResourceModel struct {
 	ID                types.String                         `tfsdk:"id"`
 	ServerName        types.String                         `tfsdk:"server_name"`
 	Timeouts          timeouts.Value                       `tfsdk:"timeouts"`
+	SystemMetadata    types.Object                         `tfsdk:"system_metadata"`
+	LabelsAll         types.Map                            `tfsdk:"labels_all"`
 	AllowAllRequests  *ServicePolicyEmptyModel             `tfsdk:"allow_all_requests"`
 	AllowList         *ServicePolicyAllowListModel         `tfsdk:"allow_list"`
 	AnyServer         *ServicePolicyEmptyModel             `tfsdk:"any_server"`
@@ -1002,9 +1011,11 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Service Policy will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Service Policy will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -1043,7 +1054,12 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -1062,16 +1078,29 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 						MarkdownDescription: "[Enum: COUNTRY_NONE|COUNTRY_AD|COUNTRY_AE|COUNTRY_AF|COUNTRY_AG|COUNTRY_AI|COUNTRY_AL|COUNTRY_AM|COUNTRY_AN|COUNTRY_AO|COUNTRY_AQ|COUNTRY_AR|COUNTRY_AS|COUNTRY_AT|COUNTRY_AU|COUNTRY_AW|COUNTRY_AX|COUNTRY_AZ|COUNTRY_BA|COUNTRY_BB|COUNTRY_BD|COUNTRY_BE|COUNTRY_BF|COUNTRY_BG|COUNTRY_BH|COUNTRY_BI|COUNTRY_BJ|COUNTRY_BL|COUNTRY_BM|COUNTRY_BN|COUNTRY_BO|COUNTRY_BQ|COUNTRY_BR|COUNTRY_BS|COUNTRY_BT|COUNTRY_BV|COUNTRY_BW|COUNTRY_BY|COUNTRY_BZ|COUNTRY_CA|COUNTRY_CC|COUNTRY_CD|COUNTRY_CF|COUNTRY_CG|COUNTRY_CH|COUNTRY_CI|COUNTRY_CK|COUNTRY_CL|COUNTRY_CM|COUNTRY_CN|COUNTRY_CO|COUNTRY_CR|COUNTRY_CS|COUNTRY_CU|COUNTRY_CV|COUNTRY_CW|COUNTRY_CX|COUNTRY_CY|COUNTRY_CZ|COUNTRY_DE|COUNTRY_DJ|COUNTRY_DK|COUNTRY_DM|COUNTRY_DO|COUNTRY_DZ|COUNTRY_EC|COUNTRY_EE|COUNTRY_EG|COUNTRY_EH|COUNTRY_ER|COUNTRY_ES|COUNTRY_ET|COUNTRY_FI|COUNTRY_FJ|COUNTRY_FK|COUNTRY_FM|COUNTRY_FO|COUNTRY_FR|COUNTRY_GA|COUNTRY_GB|COUNTRY_GD|COUNTRY_GE|COUNTRY_GF|COUNTRY_GG|COUNTRY_GH|COUNTRY_GI|COUNTRY_GL|COUNTRY_GM|COUNTRY_GN|COUNTRY_GP|COUNTRY_GQ|COUNTRY_GR|COUNTRY_GS|COUNTRY_GT|COUNTRY_GU|COUNTRY_GW|COUNTRY_GY|COUNTRY_HK|COUNTRY_HM|COUNTRY_HN|COUNTRY_HR|COUNTRY_HT|COUNTRY_HU|COUNTRY_ID|COUNTRY_IE|COUNTRY_IL|COUNTRY_IM|COUNTRY_IN|COUNTRY_IO|COUNTRY_IQ|COUNTRY_IR|COUNTRY_IS|COUNTRY_IT|COUNTRY_JE|COUNTRY_JM|COUNTRY_JO|COUNTRY_JP|COUNTRY_KE|COUNTRY_KG|COUNTRY_KH|COUNTRY_KI|COUNTRY_KM|COUNTRY_KN|COUNTRY_KP|COUNTRY_KR|COUNTRY_KW|COUNTRY_KY|COUNTRY_KZ|COUNTRY_LA|COUNTRY_LB|COUNTRY_LC|COUNTRY_LI|COUNTRY_LK|COUNTRY_LR|COUNTRY_LS|COUNTRY_LT|COUNTRY_LU|COUNTRY_LV|COUNTRY_LY|COUNTRY_MA|COUNTRY_MC|COUNTRY_MD|COUNTRY_ME|COUNTRY_MF|COUNTRY_MG|COUNTRY_MH|COUNTRY_MK|COUNTRY_ML|COUNTRY_MM|COUNTRY_MN|COUNTRY_MO|COUNTRY_MP|COUNTRY_MQ|COUNTRY_MR|COUNTRY_MS|COUNTRY_MT|COUNTRY_MU|COUNTRY_MV|COUNTRY_MW|COUNTRY_MX|COUNTRY_MY|COUNTRY_MZ|COUNTRY_NA|COUNTRY_NC|COUNTRY_NE|COUNTRY_NF|COUNTRY_NG|COUNTRY_NI|COUNTRY_NL|COUNTRY_NO|COUNTRY_NP|COUNTRY_NR|COUNTRY_NU|COUNTRY_NZ|COUNTRY_OM|COUNTRY_PA|COUNTRY_PE|COUNTRY_PF|COUNTRY_PG|COUNTRY_PH|COUNTRY_PK|COUNTRY_PL|COUNTRY_PM|COUNTRY_PN|COUNTRY_PR|COUNTRY_PS|COUNTRY_PT|COUNTRY_PW|COUNTRY_PY|COUNTRY_QA|COUNTRY_RE|COUNTRY_RO|COUNTRY_RS|COUNTRY_RU|COUNTRY_RW|COUNTRY_SA|COUNTRY_SB|COUNTRY_SC|COUNTRY_SD|COUNTRY_SE|COUNTRY_SG|COUNTRY_SH|COUNTRY_SI|COUNTRY_SJ|COUNTRY_SK|COUNTRY_SL|COUNTRY_SM|COUNTRY_SN|COUNTRY_SO|COUNTRY_SR|COUNTRY_SS|COUNTRY_ST|COUNTRY_SV|COUNTRY_SX|COUNTRY_SY|COUNTRY_SZ|COUNTRY_TC|COUNTRY_TD|COUNTRY_TF|COUNTRY_TG|COUNTRY_TH|COUNTRY_TJ|COUNTRY_TK|COUNTRY_TL|COUNTRY_TM|COUNTRY_TN|COUNTRY_TO|COUNTRY_TR|COUNTRY_TT|COUNTRY_TV|COUNTRY_TW|COUNTRY_TZ|COUNTRY_UA|COUNTRY_UG|COUNTRY_UM|COUNTRY_US|COUNTRY_UY|COUNTRY_UZ|COUNTRY_VA|COUNTRY_VC|COUNTRY_VE|COUNTRY_VG|COUNTRY_VI|COUNTRY_VN|COUNTRY_VU|COUNTRY_WF|COUNTRY_WS|COUNTRY_XK|COUNTRY_XT|COUNTRY_YE|COUNTRY_YT|COUNTRY_ZA|COUNTRY_ZM|COUNTRY_ZW] Addresses that belong to one of the countries in the given list The country is obtained by performing a lookup for the source IPv4 Address in a GeoIP DB. Possible values are `COUNTRY_NONE`, `COUNTRY_AD`, `COUNTRY_AE`, `COUNTRY_AF`, `COUNTRY_AG`, `COUNTRY_AI`, `COUNTRY_AL`, `COUNTRY_AM`, `COUNTRY_AN`, `COUNTRY_AO`, `COUNTRY_AQ`, `COUNTRY_AR`, `COUNTRY_AS`, `COUNTRY_AT`, `COUNTRY_AU`, `COUNTRY_AW`, `COUNTRY_AX`, `COUNTRY_AZ`, `COUNTRY_BA`, `COUNTRY_BB`, `COUNTRY_BD`, `COUNTRY_BE`, `COUNTRY_BF`, `COUNTRY_BG`, `COUNTRY_BH`, `COUNTRY_BI`, `COUNTRY_BJ`, `COUNTRY_BL`, `COUNTRY_BM`, `COUNTRY_BN`, `COUNTRY_BO`, `COUNTRY_BQ`, `COUNTRY_BR`, `COUNTRY_BS`, `COUNTRY_BT`, `COUNTRY_BV`, `COUNTRY_BW`, `COUNTRY_BY`, `COUNTRY_BZ`, `COUNTRY_CA`, `COUNTRY_CC`, `COUNTRY_CD`, `COUNTRY_CF`, `COUNTRY_CG`, `COUNTRY_CH`, `COUNTRY_CI`, `COUNTRY_CK`, `COUNTRY_CL`, `COUNTRY_CM`, `COUNTRY_CN`, `COUNTRY_CO`, `COUNTRY_CR`, `COUNTRY_CS`, `COUNTRY_CU`, `COUNTRY_CV`, `COUNTRY_CW`, `COUNTRY_CX`, `COUNTRY_CY`, `COUNTRY_CZ`, `COUNTRY_DE`, `COUNTRY_DJ`, `COUNTRY_DK`, `COUNTRY_DM`, `COUNTRY_DO`, `COUNTRY_DZ`, `COUNTRY_EC`, `COUNTRY_EE`, `COUNTRY_EG`, `COUNTRY_EH`, `COUNTRY_ER`, `COUNTRY_ES`, `COUNTRY_ET`, `COUNTRY_FI`, `COUNTRY_FJ`, `COUNTRY_FK`, `COUNTRY_FM`, `COUNTRY_FO`, `COUNTRY_FR`, `COUNTRY_GA`, `COUNTRY_GB`, `COUNTRY_GD`, `COUNTRY_GE`, `COUNTRY_GF`, `COUNTRY_GG`, `COUNTRY_GH`, `COUNTRY_GI`, `COUNTRY_GL`, `COUNTRY_GM`, `COUNTRY_GN`, `COUNTRY_GP`, `COUNTRY_GQ`, `COUNTRY_GR`, `COUNTRY_GS`, `COUNTRY_GT`, `COUNTRY_GU`, `COUNTRY_GW`, `COUNTRY_GY`, `COUNTRY_HK`, `COUNTRY_HM`, `COUNTRY_HN`, `COUNTRY_HR`, `COUNTRY_HT`, `COUNTRY_HU`, `COUNTRY_ID`, `COUNTRY_IE`, `COUNTRY_IL`, `COUNTRY_IM`, `COUNTRY_IN`, `COUNTRY_IO`, `COUNTRY_IQ`, `COUNTRY_IR`, `COUNTRY_IS`, `COUNTRY_IT`, `COUNTRY_JE`, `COUNTRY_JM`, `COUNTRY_JO`, `COUNTRY_JP`, `COUNTRY_KE`, `COUNTRY_KG`, `COUNTRY_KH`, `COUNTRY_KI`, `COUNTRY_KM`, `COUNTRY_KN`, `COUNTRY_KP`, `COUNTRY_KR`, `COUNTRY_KW`, `COUNTRY_KY`, `COUNTRY_KZ`, `COUNTRY_LA`, `COUNTRY_LB`, `COUNTRY_LC`, `COUNTRY_LI`, `COUNTRY_LK`, `COUNTRY_LR`, `COUNTRY_LS`, `COUNTRY_LT`, `COUNTRY_LU`, `COUNTRY_LV`, `COUNTRY_LY`, `COUNTRY_MA`, `COUNTRY_MC`, `COUNTRY_MD`, `COUNTRY_ME`, `COUNTRY_MF`, `COUNTRY_MG`, `COUNTRY_MH`, `COUNTRY_MK`, `COUNTRY_ML`, `COUNTRY_MM`, `COUNTRY_MN`, `COUNTRY_MO`, `COUNTRY_MP`, `COUNTRY_MQ`, `COUNTRY_MR`, `COUNTRY_MS`, `COUNTRY_MT`, `COUNTRY_MU`, `COUNTRY_MV`, `COUNTRY_MW`, `COUNTRY_MX`, `COUNTRY_MY`, `COUNTRY_MZ`, `COUNTRY_NA`, `COUNTRY_NC`, `COUNTRY_NE`, `COUNTRY_NF`, `COUNTRY_NG`, `COUNTRY_NI`, `COUNTRY_NL`, `COUNTRY_NO`, `COUNTRY_NP`, `COUNTRY_NR`, `COUNTRY_NU`, `COUNTRY_NZ`, `COUNTRY_OM`, `COUNTRY_PA`, `COUNTRY_PE`, `COUNTRY_PF`, `COUNTRY_PG`, `COUNTRY_PH`, `COUNTRY_PK`, `COUNTRY_PL`, `COUNTRY_PM`, `COUNTRY_PN`, `COUNTRY_PR`, `COUNTRY_PS`, `COUNTRY_PT`, `COUNTRY_PW`, `COUNTRY_PY`, `COUNTRY_QA`, `COUNTRY_RE`, `COUNTRY_RO`, `COUNTRY_RS`, `COUNTRY_RU`, `COUNTRY_RW`, `COUNTRY_SA`, `COUNTRY_SB`, `COUNTRY_SC`, `COUNTRY_SD`, `COUNTRY_SE`, `COUNTRY_SG`, `COUNTRY_SH`, `COUNTRY_SI`, `COUNTRY_SJ`, `COUNTRY_SK`, `COUNTRY_SL`, `COUNTRY_SM`, `COUNTRY_SN`, `COUNTRY_SO`, `COUNTRY_SR`, `COUNTRY_SS`, `COUNTRY_ST`, `COUNTRY_SV`, `COUNTRY_SX`, `COUNTRY_SY`, `COUNTRY_SZ`, `COUNTRY_TC`, `COUNTRY_TD`, `COUNTRY_TF`, `COUNTRY_TG`, `COUNTRY_TH`, `COUNTRY_TJ`, `COUNTRY_TK`, `COUNTRY_TL`, `COUNTRY_TM`, `COUNTRY_TN`, `COUNTRY_TO`, `COUNTRY_TR`, `COUNTRY_TT`, `COUNTRY_TV`, `COUNTRY_TW`, `COUNTRY_TZ`, `COUNTRY_UA`, `COUNTRY_UG`, `COUNTRY_UM`, `COUNTRY_US`, `COUNTRY_UY`, `COUNTRY_UZ`, `COUNTRY_VA`, `COUNTRY_VC`, `COUNTRY_VE`, `COUNTRY_VG`, `COUNTRY_VI`, `COUNTRY_VN`, `COUNTRY_VU`, `COUNTRY_WF`, `COUNTRY_WS`, `COUNTRY_XK`, `COUNTRY_XT`, `COUNTRY_YE`, `COUNTRY_YT`, `COUNTRY_ZA`, `COUNTRY_ZM`, `COUNTRY_ZW`. Defaults to `COUNTRY_NONE`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(64),
+							listvalidator.UniqueValues(),
+						},
 					},
 					"tls_fingerprint_classes": schema.ListAttribute{
 						MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+						},
 					},
 					"tls_fingerprint_values": schema.ListAttribute{
 						MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1082,16 +1111,28 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 								MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 								Optional:            true,
 								ElementType:         types.Int64Type,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+									listvalidator.SizeAtLeast(1),
+									listvalidator.UniqueValues(),
+								},
 							},
 						},
 					},
 					"asn_set": schema.ListNestedBlock{
 						MarkdownDescription: "Addresses that belong to the ASNs in the given bgp_asn_set The ASN is obtained by performing a lookup for the source IPv4 Address in a GeoIP DB.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(4),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1100,6 +1141,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1108,6 +1152,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -1123,11 +1170,18 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 					},
 					"ip_prefix_set": schema.ListNestedBlock{
 						MarkdownDescription: "Addresses that are covered by the prefixes in the given ip_prefix_set.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(4),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1136,6 +1190,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1144,6 +1201,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -1155,6 +1215,11 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 								MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+								},
 							},
 						},
 					},
@@ -1173,16 +1238,29 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 						MarkdownDescription: "[Enum: COUNTRY_NONE|COUNTRY_AD|COUNTRY_AE|COUNTRY_AF|COUNTRY_AG|COUNTRY_AI|COUNTRY_AL|COUNTRY_AM|COUNTRY_AN|COUNTRY_AO|COUNTRY_AQ|COUNTRY_AR|COUNTRY_AS|COUNTRY_AT|COUNTRY_AU|COUNTRY_AW|COUNTRY_AX|COUNTRY_AZ|COUNTRY_BA|COUNTRY_BB|COUNTRY_BD|COUNTRY_BE|COUNTRY_BF|COUNTRY_BG|COUNTRY_BH|COUNTRY_BI|COUNTRY_BJ|COUNTRY_BL|COUNTRY_BM|COUNTRY_BN|COUNTRY_BO|COUNTRY_BQ|COUNTRY_BR|COUNTRY_BS|COUNTRY_BT|COUNTRY_BV|COUNTRY_BW|COUNTRY_BY|COUNTRY_BZ|COUNTRY_CA|COUNTRY_CC|COUNTRY_CD|COUNTRY_CF|COUNTRY_CG|COUNTRY_CH|COUNTRY_CI|COUNTRY_CK|COUNTRY_CL|COUNTRY_CM|COUNTRY_CN|COUNTRY_CO|COUNTRY_CR|COUNTRY_CS|COUNTRY_CU|COUNTRY_CV|COUNTRY_CW|COUNTRY_CX|COUNTRY_CY|COUNTRY_CZ|COUNTRY_DE|COUNTRY_DJ|COUNTRY_DK|COUNTRY_DM|COUNTRY_DO|COUNTRY_DZ|COUNTRY_EC|COUNTRY_EE|COUNTRY_EG|COUNTRY_EH|COUNTRY_ER|COUNTRY_ES|COUNTRY_ET|COUNTRY_FI|COUNTRY_FJ|COUNTRY_FK|COUNTRY_FM|COUNTRY_FO|COUNTRY_FR|COUNTRY_GA|COUNTRY_GB|COUNTRY_GD|COUNTRY_GE|COUNTRY_GF|COUNTRY_GG|COUNTRY_GH|COUNTRY_GI|COUNTRY_GL|COUNTRY_GM|COUNTRY_GN|COUNTRY_GP|COUNTRY_GQ|COUNTRY_GR|COUNTRY_GS|COUNTRY_GT|COUNTRY_GU|COUNTRY_GW|COUNTRY_GY|COUNTRY_HK|COUNTRY_HM|COUNTRY_HN|COUNTRY_HR|COUNTRY_HT|COUNTRY_HU|COUNTRY_ID|COUNTRY_IE|COUNTRY_IL|COUNTRY_IM|COUNTRY_IN|COUNTRY_IO|COUNTRY_IQ|COUNTRY_IR|COUNTRY_IS|COUNTRY_IT|COUNTRY_JE|COUNTRY_JM|COUNTRY_JO|COUNTRY_JP|COUNTRY_KE|COUNTRY_KG|COUNTRY_KH|COUNTRY_KI|COUNTRY_KM|COUNTRY_KN|COUNTRY_KP|COUNTRY_KR|COUNTRY_KW|COUNTRY_KY|COUNTRY_KZ|COUNTRY_LA|COUNTRY_LB|COUNTRY_LC|COUNTRY_LI|COUNTRY_LK|COUNTRY_LR|COUNTRY_LS|COUNTRY_LT|COUNTRY_LU|COUNTRY_LV|COUNTRY_LY|COUNTRY_MA|COUNTRY_MC|COUNTRY_MD|COUNTRY_ME|COUNTRY_MF|COUNTRY_MG|COUNTRY_MH|COUNTRY_MK|COUNTRY_ML|COUNTRY_MM|COUNTRY_MN|COUNTRY_MO|COUNTRY_MP|COUNTRY_MQ|COUNTRY_MR|COUNTRY_MS|COUNTRY_MT|COUNTRY_MU|COUNTRY_MV|COUNTRY_MW|COUNTRY_MX|COUNTRY_MY|COUNTRY_MZ|COUNTRY_NA|COUNTRY_NC|COUNTRY_NE|COUNTRY_NF|COUNTRY_NG|COUNTRY_NI|COUNTRY_NL|COUNTRY_NO|COUNTRY_NP|COUNTRY_NR|COUNTRY_NU|COUNTRY_NZ|COUNTRY_OM|COUNTRY_PA|COUNTRY_PE|COUNTRY_PF|COUNTRY_PG|COUNTRY_PH|COUNTRY_PK|COUNTRY_PL|COUNTRY_PM|COUNTRY_PN|COUNTRY_PR|COUNTRY_PS|COUNTRY_PT|COUNTRY_PW|COUNTRY_PY|COUNTRY_QA|COUNTRY_RE|COUNTRY_RO|COUNTRY_RS|COUNTRY_RU|COUNTRY_RW|COUNTRY_SA|COUNTRY_SB|COUNTRY_SC|COUNTRY_SD|COUNTRY_SE|COUNTRY_SG|COUNTRY_SH|COUNTRY_SI|COUNTRY_SJ|COUNTRY_SK|COUNTRY_SL|COUNTRY_SM|COUNTRY_SN|COUNTRY_SO|COUNTRY_SR|COUNTRY_SS|COUNTRY_ST|COUNTRY_SV|COUNTRY_SX|COUNTRY_SY|COUNTRY_SZ|COUNTRY_TC|COUNTRY_TD|COUNTRY_TF|COUNTRY_TG|COUNTRY_TH|COUNTRY_TJ|COUNTRY_TK|COUNTRY_TL|COUNTRY_TM|COUNTRY_TN|COUNTRY_TO|COUNTRY_TR|COUNTRY_TT|COUNTRY_TV|COUNTRY_TW|COUNTRY_TZ|COUNTRY_UA|COUNTRY_UG|COUNTRY_UM|COUNTRY_US|COUNTRY_UY|COUNTRY_UZ|COUNTRY_VA|COUNTRY_VC|COUNTRY_VE|COUNTRY_VG|COUNTRY_VI|COUNTRY_VN|COUNTRY_VU|COUNTRY_WF|COUNTRY_WS|COUNTRY_XK|COUNTRY_XT|COUNTRY_YE|COUNTRY_YT|COUNTRY_ZA|COUNTRY_ZM|COUNTRY_ZW] Addresses that belong to one of the countries in the given list The country is obtained by performing a lookup for the source IPv4 Address in a GeoIP DB. Possible values are `COUNTRY_NONE`, `COUNTRY_AD`, `COUNTRY_AE`, `COUNTRY_AF`, `COUNTRY_AG`, `COUNTRY_AI`, `COUNTRY_AL`, `COUNTRY_AM`, `COUNTRY_AN`, `COUNTRY_AO`, `COUNTRY_AQ`, `COUNTRY_AR`, `COUNTRY_AS`, `COUNTRY_AT`, `COUNTRY_AU`, `COUNTRY_AW`, `COUNTRY_AX`, `COUNTRY_AZ`, `COUNTRY_BA`, `COUNTRY_BB`, `COUNTRY_BD`, `COUNTRY_BE`, `COUNTRY_BF`, `COUNTRY_BG`, `COUNTRY_BH`, `COUNTRY_BI`, `COUNTRY_BJ`, `COUNTRY_BL`, `COUNTRY_BM`, `COUNTRY_BN`, `COUNTRY_BO`, `COUNTRY_BQ`, `COUNTRY_BR`, `COUNTRY_BS`, `COUNTRY_BT`, `COUNTRY_BV`, `COUNTRY_BW`, `COUNTRY_BY`, `COUNTRY_BZ`, `COUNTRY_CA`, `COUNTRY_CC`, `COUNTRY_CD`, `COUNTRY_CF`, `COUNTRY_CG`, `COUNTRY_CH`, `COUNTRY_CI`, `COUNTRY_CK`, `COUNTRY_CL`, `COUNTRY_CM`, `COUNTRY_CN`, `COUNTRY_CO`, `COUNTRY_CR`, `COUNTRY_CS`, `COUNTRY_CU`, `COUNTRY_CV`, `COUNTRY_CW`, `COUNTRY_CX`, `COUNTRY_CY`, `COUNTRY_CZ`, `COUNTRY_DE`, `COUNTRY_DJ`, `COUNTRY_DK`, `COUNTRY_DM`, `COUNTRY_DO`, `COUNTRY_DZ`, `COUNTRY_EC`, `COUNTRY_EE`, `COUNTRY_EG`, `COUNTRY_EH`, `COUNTRY_ER`, `COUNTRY_ES`, `COUNTRY_ET`, `COUNTRY_FI`, `COUNTRY_FJ`, `COUNTRY_FK`, `COUNTRY_FM`, `COUNTRY_FO`, `COUNTRY_FR`, `COUNTRY_GA`, `COUNTRY_GB`, `COUNTRY_GD`, `COUNTRY_GE`, `COUNTRY_GF`, `COUNTRY_GG`, `COUNTRY_GH`, `COUNTRY_GI`, `COUNTRY_GL`, `COUNTRY_GM`, `COUNTRY_GN`, `COUNTRY_GP`, `COUNTRY_GQ`, `COUNTRY_GR`, `COUNTRY_GS`, `COUNTRY_GT`, `COUNTRY_GU`, `COUNTRY_GW`, `COUNTRY_GY`, `COUNTRY_HK`, `COUNTRY_HM`, `COUNTRY_HN`, `COUNTRY_HR`, `COUNTRY_HT`, `COUNTRY_HU`, `COUNTRY_ID`, `COUNTRY_IE`, `COUNTRY_IL`, `COUNTRY_IM`, `COUNTRY_IN`, `COUNTRY_IO`, `COUNTRY_IQ`, `COUNTRY_IR`, `COUNTRY_IS`, `COUNTRY_IT`, `COUNTRY_JE`, `COUNTRY_JM`, `COUNTRY_JO`, `COUNTRY_JP`, `COUNTRY_KE`, `COUNTRY_KG`, `COUNTRY_KH`, `COUNTRY_KI`, `COUNTRY_KM`, `COUNTRY_KN`, `COUNTRY_KP`, `COUNTRY_KR`, `COUNTRY_KW`, `COUNTRY_KY`, `COUNTRY_KZ`, `COUNTRY_LA`, `COUNTRY_LB`, `COUNTRY_LC`, `COUNTRY_LI`, `COUNTRY_LK`, `COUNTRY_LR`, `COUNTRY_LS`, `COUNTRY_LT`, `COUNTRY_LU`, `COUNTRY_LV`, `COUNTRY_LY`, `COUNTRY_MA`, `COUNTRY_MC`, `COUNTRY_MD`, `COUNTRY_ME`, `COUNTRY_MF`, `COUNTRY_MG`, `COUNTRY_MH`, `COUNTRY_MK`, `COUNTRY_ML`, `COUNTRY_MM`, `COUNTRY_MN`, `COUNTRY_MO`, `COUNTRY_MP`, `COUNTRY_MQ`, `COUNTRY_MR`, `COUNTRY_MS`, `COUNTRY_MT`, `COUNTRY_MU`, `COUNTRY_MV`, `COUNTRY_MW`, `COUNTRY_MX`, `COUNTRY_MY`, `COUNTRY_MZ`, `COUNTRY_NA`, `COUNTRY_NC`, `COUNTRY_NE`, `COUNTRY_NF`, `COUNTRY_NG`, `COUNTRY_NI`, `COUNTRY_NL`, `COUNTRY_NO`, `COUNTRY_NP`, `COUNTRY_NR`, `COUNTRY_NU`, `COUNTRY_NZ`, `COUNTRY_OM`, `COUNTRY_PA`, `COUNTRY_PE`, `COUNTRY_PF`, `COUNTRY_PG`, `COUNTRY_PH`, `COUNTRY_PK`, `COUNTRY_PL`, `COUNTRY_PM`, `COUNTRY_PN`, `COUNTRY_PR`, `COUNTRY_PS`, `COUNTRY_PT`, `COUNTRY_PW`, `COUNTRY_PY`, `COUNTRY_QA`, `COUNTRY_RE`, `COUNTRY_RO`, `COUNTRY_RS`, `COUNTRY_RU`, `COUNTRY_RW`, `COUNTRY_SA`, `COUNTRY_SB`, `COUNTRY_SC`, `COUNTRY_SD`, `COUNTRY_SE`, `COUNTRY_SG`, `COUNTRY_SH`, `COUNTRY_SI`, `COUNTRY_SJ`, `COUNTRY_SK`, `COUNTRY_SL`, `COUNTRY_SM`, `COUNTRY_SN`, `COUNTRY_SO`, `COUNTRY_SR`, `COUNTRY_SS`, `COUNTRY_ST`, `COUNTRY_SV`, `COUNTRY_SX`, `COUNTRY_SY`, `COUNTRY_SZ`, `COUNTRY_TC`, `COUNTRY_TD`, `COUNTRY_TF`, `COUNTRY_TG`, `COUNTRY_TH`, `COUNTRY_TJ`, `COUNTRY_TK`, `COUNTRY_TL`, `COUNTRY_TM`, `COUNTRY_TN`, `COUNTRY_TO`, `COUNTRY_TR`, `COUNTRY_TT`, `COUNTRY_TV`, `COUNTRY_TW`, `COUNTRY_TZ`, `COUNTRY_UA`, `COUNTRY_UG`, `COUNTRY_UM`, `COUNTRY_US`, `COUNTRY_UY`, `COUNTRY_UZ`, `COUNTRY_VA`, `COUNTRY_VC`, `COUNTRY_VE`, `COUNTRY_VG`, `COUNTRY_VI`, `COUNTRY_VN`, `COUNTRY_VU`, `COUNTRY_WF`, `COUNTRY_WS`, `COUNTRY_XK`, `COUNTRY_XT`, `COUNTRY_YE`, `COUNTRY_YT`, `COUNTRY_ZA`, `COUNTRY_ZM`, `COUNTRY_ZW`. Defaults to `COUNTRY_NONE`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(64),
+							listvalidator.UniqueValues(),
+						},
 					},
 					"tls_fingerprint_classes": schema.ListAttribute{
 						MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+						},
 					},
 					"tls_fingerprint_values": schema.ListAttribute{
 						MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1193,16 +1271,28 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 								MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 								Optional:            true,
 								ElementType:         types.Int64Type,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+									listvalidator.SizeAtLeast(1),
+									listvalidator.UniqueValues(),
+								},
 							},
 						},
 					},
 					"asn_set": schema.ListNestedBlock{
 						MarkdownDescription: "Addresses that belong to the ASNs in the given bgp_asn_set The ASN is obtained by performing a lookup for the source IPv4 Address in a GeoIP DB.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(4),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1211,6 +1301,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1219,6 +1312,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -1234,11 +1330,18 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 					},
 					"ip_prefix_set": schema.ListNestedBlock{
 						MarkdownDescription: "Addresses that are covered by the prefixes in the given ip_prefix_set.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(4),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1247,6 +1350,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1255,6 +1361,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -1266,6 +1375,11 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 								MarkdownDescription: "List of IPv4 prefixes that represent an endpoint.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(128),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(validators.IPv4PrefixValidator()),
+								},
 							},
 						},
 					},
@@ -1277,6 +1391,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 				Blocks: map[string]schema.Block{
 					"rules": schema.ListNestedBlock{
 						MarkdownDescription: "Define the list of rules (with an order) that should be evaluated by this service policy. Rules are evaluated from top to bottom in the list.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(256),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{},
 							Blocks: map[string]schema.Block{
@@ -1286,10 +1403,16 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Human readable description.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(256),
+											},
 										},
 										"name": schema.StringAttribute{
 											MarkdownDescription: "Name of the message. The value of name has to follow DNS-1035 format.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtLeast(1),
+											},
 										},
 									},
 								},
@@ -1303,6 +1426,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 										"client_name": schema.StringAttribute{
 											MarkdownDescription: "The expected name of the client invoking the request API. The predicate evaluates to true if any of the actual names is the same as the expected client name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(256),
+											},
 										},
 										"expiration_timestamp": schema.StringAttribute{
 											MarkdownDescription: "Specifies expiration_timestamp the RFC 3339 format timestamp at which the containing rule is considered to be logically expired. The rule continues to exist in the configuration but is not applied anymore.",
@@ -1330,11 +1456,19 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "List of exact values to match the input against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(64),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(63)),
+													},
 												},
 											},
 										},
 										"arg_matchers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all POST args that need to be matched. The criteria for matching each arg are described in individual instances of ArgMatcherType. The actual arg values are extracted from the request API as a list of strings for each arg selector name.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -1344,6 +1478,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive JSON path in the HTTP request body.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -1360,16 +1497,30 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -1383,6 +1534,11 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 													Optional:            true,
 													ElementType:         types.Int64Type,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -1392,6 +1548,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 											Blocks: map[string]schema.Block{
 												"asn_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to bgp_asn_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -1442,16 +1601,30 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "List of exact values to match the input against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(64),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 												"regex_values": schema.ListAttribute{
 													MarkdownDescription: "List of regular expressions to match the input against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+													},
 												},
 												"transformers": schema.ListAttribute{
 													MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(9),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -1474,16 +1647,30 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "List of exact values to match the input against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(64),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 												"regex_values": schema.ListAttribute{
 													MarkdownDescription: "List of regular expressions to match the input against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+													},
 												},
 												"transformers": schema.ListAttribute{
 													MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(9),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -1494,11 +1681,18 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+													},
 												},
 											},
 										},
 										"cookie_matchers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -1508,6 +1702,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive cookie name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -1524,16 +1721,30 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -1547,21 +1758,38 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "List of exact values to match the input against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(64),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 												"regex_values": schema.ListAttribute{
 													MarkdownDescription: "List of regular expressions to match the input against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+													},
 												},
 												"transformers": schema.ListAttribute{
 													MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(9),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
 										"headers": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -1571,6 +1799,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													"name": schema.StringAttribute{
 														MarkdownDescription: "Case-insensitive HTTP header name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -1587,16 +1818,30 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -1614,6 +1859,10 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] List of methods values to match against. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -1628,6 +1877,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 											Blocks: map[string]schema.Block{
 												"prefix_sets": schema.ListNestedBlock{
 													MarkdownDescription: "List of references to ip_prefix_set objects.",
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+													},
 													NestedObject: schema.NestedBlockObject{
 														Attributes: map[string]schema.Attribute{
 															"kind": schema.StringAttribute{
@@ -1682,6 +1934,11 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(128),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -1692,6 +1949,10 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "[Enum: SPAM_SOURCES|WINDOWS_EXPLOITS|WEB_ATTACKS|BOTNETS|SCANNERS|REPUTATION|PHISHING|PROXY|MOBILE_THREATS|TOR_PROXY|DENIAL_OF_SERVICE|NETWORK] The IP threat categories is obtained from the list and is used to auto-generate equivalent label selection expressions . Possible values are `SPAM_SOURCES`, `WINDOWS_EXPLOITS`, `WEB_ATTACKS`, `BOTNETS`, `SCANNERS`, `REPUTATION`, `PHISHING`, `PROXY`, `MOBILE_THREATS`, `TOR_PROXY`, `DENIAL_OF_SERVICE`, `NETWORK`. Defaults to `SPAM_SOURCES`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -1702,11 +1963,19 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "List of exact JA4 TLS fingerprint to match the input JA4 TLS fingerprint against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(36, 36)),
+													},
 												},
 											},
 										},
 										"jwt_claims": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for various JWT claims that need to match. The criteria for matching each JWT claim are described in individual JWTClaimMatcherType instances. The actual JWT claims values are extracted from the JWT payload as a list of strings.",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -1716,6 +1985,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													"name": schema.StringAttribute{
 														MarkdownDescription: "JWT Claim Name. JWT claim name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -1732,16 +2004,30 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -1755,6 +2041,11 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "The list of label key names that have to match.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(64), stringvalidator.UTF8LengthAtLeast(1)),
+													},
 												},
 											},
 										},
@@ -1777,6 +2068,11 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "List of exact path values to match the input HTTP path against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 												"invert_matcher": schema.BoolAttribute{
 													MarkdownDescription: "Invert Path Matcher. Invert the match result.",
@@ -1786,21 +2082,40 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "List of path prefix values to match the input HTTP path against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 												"regex_values": schema.ListAttribute{
 													MarkdownDescription: "List of regular expressions to match the input HTTP path against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+													},
 												},
 												"suffix_values": schema.ListAttribute{
 													MarkdownDescription: "List of path suffix values to match the input HTTP path against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(64),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(64), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 												"transformers": schema.ListAttribute{
 													MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(9),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -1815,11 +2130,18 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "List of strings, each of which is a single port value or a tuple of start and end port values separated by '-'. The start and end values are considered to be part of the range.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
 										"query_params": schema.ListNestedBlock{
 											MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+											Validators: []validator.List{
+												listvalidator.SizeAtMost(16),
+											},
 											NestedObject: schema.NestedBlockObject{
 												Attributes: map[string]schema.Attribute{
 													"invert_matcher": schema.BoolAttribute{
@@ -1829,6 +2151,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													"key": schema.StringAttribute{
 														MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 														Optional:            true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(256),
+														},
 													},
 												},
 												Blocks: map[string]schema.Block{
@@ -1845,16 +2170,30 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																MarkdownDescription: "List of exact values to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(64),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+																},
 															},
 															"regex_values": schema.ListAttribute{
 																MarkdownDescription: "List of regular expressions to match the input against.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(16),
+																	listvalidator.UniqueValues(),
+																	listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+																},
 															},
 															"transformers": schema.ListAttribute{
 																MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 																Optional:            true,
 																ElementType:         types.StringType,
+																Validators: []validator.List{
+																	listvalidator.SizeAtMost(9),
+																	listvalidator.UniqueValues(),
+																},
 															},
 														},
 													},
@@ -1867,54 +2206,106 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 												"max_cookie_count_exceeds": schema.Int64Attribute{
 													MarkdownDescription: "Match on the Count for all Cookies that exceed this value.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(1024),
+													},
 												},
 												"max_cookie_key_size_exceeds": schema.Int64Attribute{
 													MarkdownDescription: ".",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(1024),
+													},
 												},
 												"max_cookie_value_size_exceeds": schema.Int64Attribute{
 													MarkdownDescription: ".",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(32768),
+													},
 												},
 												"max_header_count_exceeds": schema.Int64Attribute{
 													MarkdownDescription: "Match on the Count for all Headers that exceed this value.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(40),
+													},
 												},
 												"max_header_key_size_exceeds": schema.Int64Attribute{
 													MarkdownDescription: ".",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(1024),
+													},
 												},
 												"max_header_value_size_exceeds": schema.Int64Attribute{
 													MarkdownDescription: ".",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(64000),
+													},
 												},
 												"max_parameter_count_exceeds": schema.Int64Attribute{
 													MarkdownDescription: ".",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(1024),
+													},
 												},
 												"max_parameter_name_size_exceeds": schema.Int64Attribute{
 													MarkdownDescription: ".",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(1024),
+													},
 												},
 												"max_parameter_value_size_exceeds": schema.Int64Attribute{
 													MarkdownDescription: ".",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(1073741824),
+													},
 												},
 												"max_query_size_exceeds": schema.Int64Attribute{
 													MarkdownDescription: "Match on the URL Query Size that exceed this value.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(60000),
+													},
 												},
 												"max_request_line_size_exceeds": schema.Int64Attribute{
 													MarkdownDescription: ".",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(65536),
+													},
 												},
 												"max_request_size_exceeds": schema.Int64Attribute{
 													MarkdownDescription: "Match on the Request Size that exceed this value.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(65536),
+													},
 												},
 												"max_url_size_exceeds": schema.Int64Attribute{
 													MarkdownDescription: "Match on the URL Size that exceed this value.",
 													Optional:            true,
+													Validators: []validator.Int64{
+														int64validator.AtLeast(1),
+														int64validator.AtMost(128000),
+													},
 												},
 											},
 											Blocks: map[string]schema.Block{
@@ -1977,6 +2368,10 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																	"name": schema.StringAttribute{
 																		MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(128),
+																			stringvalidator.LengthAtLeast(1),
+																		},
 																	},
 																	"namespace": schema.StringAttribute{
 																		MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1985,6 +2380,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																		PlanModifiers: []planmodifier.String{
 																			stringplanmodifier.UseStateForUnknown(),
 																		},
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(64),
+																		},
 																	},
 																	"tenant": schema.StringAttribute{
 																		MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1993,6 +2391,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																		PlanModifiers: []planmodifier.String{
 																			stringplanmodifier.UseStateForUnknown(),
 																		},
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(64),
+																		},
 																	},
 																},
 															},
@@ -2016,6 +2417,10 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																	"name": schema.StringAttribute{
 																		MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(128),
+																			stringvalidator.LengthAtLeast(1),
+																		},
 																	},
 																	"namespace": schema.StringAttribute{
 																		MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -2024,6 +2429,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																		PlanModifiers: []planmodifier.String{
 																			stringplanmodifier.UseStateForUnknown(),
 																		},
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(64),
+																		},
 																	},
 																	"tenant": schema.StringAttribute{
 																		MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -2032,6 +2440,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																		PlanModifiers: []planmodifier.String{
 																			stringplanmodifier.UseStateForUnknown(),
 																		},
+																		Validators: []validator.String{
+																			stringvalidator.LengthAtMost(64),
+																		},
 																	},
 																},
 															},
@@ -2047,16 +2458,30 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+													},
 												},
 												"exact_values": schema.ListAttribute{
 													MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 												"excluded_values": schema.ListAttribute{
 													MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(32),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+													},
 												},
 											},
 										},
@@ -2067,11 +2492,21 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													MarkdownDescription: "List of exact values to match the input against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(64),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+													},
 												},
 												"regex_values": schema.ListAttribute{
 													MarkdownDescription: "List of regular expressions to match the input against.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(16),
+														listvalidator.UniqueValues(),
+														listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+													},
 												},
 											},
 										},
@@ -2085,6 +2520,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 													Blocks: map[string]schema.Block{
 														"exclude_attack_type_contexts": schema.ListNestedBlock{
 															MarkdownDescription: "Attack Types to be excluded for the defined match criteria.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(64),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"context": schema.StringAttribute{
@@ -2094,6 +2532,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																	"context_name": schema.StringAttribute{
 																		MarkdownDescription: "Relevant only for contexts: Header, Cookie and Parameter. Name of the Context that the WAF Exclusion Rules will check. Wildcard matching can be used by prefixing or suffixing the context name with an wildcard asterisk (*).",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			stringvalidator.UTF8LengthAtMost(128),
+																		},
 																	},
 																	"exclude_attack_type": schema.StringAttribute{
 																		MarkdownDescription: "[Enum: ATTACK_TYPE_NONE|ATTACK_TYPE_NON_BROWSER_CLIENT|ATTACK_TYPE_OTHER_APPLICATION_ATTACKS|ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE|ATTACK_TYPE_DETECTION_EVASION|ATTACK_TYPE_VULNERABILITY_SCAN|ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY|ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS|ATTACK_TYPE_BUFFER_OVERFLOW|ATTACK_TYPE_PREDICTABLE_RESOURCE_LOCATION|ATTACK_TYPE_INFORMATION_LEAKAGE|ATTACK_TYPE_DIRECTORY_INDEXING|ATTACK_TYPE_PATH_TRAVERSAL|ATTACK_TYPE_XPATH_INJECTION|ATTACK_TYPE_LDAP_INJECTION|ATTACK_TYPE_SERVER_SIDE_CODE_INJECTION|ATTACK_TYPE_COMMAND_EXECUTION|ATTACK_TYPE_SQL_INJECTION|ATTACK_TYPE_CROSS_SITE_SCRIPTING|ATTACK_TYPE_DENIAL_OF_SERVICE|ATTACK_TYPE_HTTP_PARSER_ATTACK|ATTACK_TYPE_SESSION_HIJACKING|ATTACK_TYPE_HTTP_RESPONSE_SPLITTING|ATTACK_TYPE_FORCEFUL_BROWSING|ATTACK_TYPE_REMOTE_FILE_INCLUDE|ATTACK_TYPE_MALICIOUS_FILE_UPLOAD|ATTACK_TYPE_GRAPHQL_PARSER_ATTACK] List of all Attack Types ATTACK_TYPE_NONE ATTACK_TYPE_NON_BROWSER_CLIENT ATTACK_TYPE_OTHER_APPLICATION_ATTACKS ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE ATTACK_TYPE_DETECTION_EVASION ATTACK_TYPE_VULNERABILITY_SCAN ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS.. Possible values are `ATTACK_TYPE_NONE`, `ATTACK_TYPE_NON_BROWSER_CLIENT`, `ATTACK_TYPE_OTHER_APPLICATION_ATTACKS`, `ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE`, `ATTACK_TYPE_DETECTION_EVASION`, `ATTACK_TYPE_VULNERABILITY_SCAN`, `ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY`, `ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS`, `ATTACK_TYPE_BUFFER_OVERFLOW`, `ATTACK_TYPE_PREDICTABLE_RESOURCE_LOCATION`, `ATTACK_TYPE_INFORMATION_LEAKAGE`, `ATTACK_TYPE_DIRECTORY_INDEXING`, `ATTACK_TYPE_PATH_TRAVERSAL`, `ATTACK_TYPE_XPATH_INJECTION`, `ATTACK_TYPE_LDAP_INJECTION`, `ATTACK_TYPE_SERVER_SIDE_CODE_INJECTION`, `ATTACK_TYPE_COMMAND_EXECUTION`, `ATTACK_TYPE_SQL_INJECTION`, `ATTACK_TYPE_CROSS_SITE_SCRIPTING`, `ATTACK_TYPE_DENIAL_OF_SERVICE`, `ATTACK_TYPE_HTTP_PARSER_ATTACK`, `ATTACK_TYPE_SESSION_HIJACKING`, `ATTACK_TYPE_HTTP_RESPONSE_SPLITTING`, `ATTACK_TYPE_FORCEFUL_BROWSING`, `ATTACK_TYPE_REMOTE_FILE_INCLUDE`, `ATTACK_TYPE_MALICIOUS_FILE_UPLOAD`, `ATTACK_TYPE_GRAPHQL_PARSER_ATTACK`. Defaults to `ATTACK_TYPE_NONE`.",
@@ -2104,6 +2545,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 														},
 														"exclude_bot_name_contexts": schema.ListNestedBlock{
 															MarkdownDescription: "Bot Names to be excluded for the defined match criteria.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(64),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"bot_name": schema.StringAttribute{
@@ -2115,6 +2559,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 														},
 														"exclude_signature_contexts": schema.ListNestedBlock{
 															MarkdownDescription: "Signature IDs to be excluded for the defined match criteria.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(1024),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"context": schema.StringAttribute{
@@ -2124,16 +2571,26 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																	"context_name": schema.StringAttribute{
 																		MarkdownDescription: "Relevant only for contexts: Header, Cookie and Parameter. Name of the Context that the WAF Exclusion Rules will check. Wildcard matching can be used by prefixing or suffixing the context name with an wildcard asterisk (*).",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			stringvalidator.UTF8LengthAtMost(128),
+																		},
 																	},
 																	"signature_id": schema.Int64Attribute{
 																		MarkdownDescription: "The allowed values for signature ID are 0 and in the range of 200000001-299999999. 0 implies that all signatures will be excluded for the specified context.",
 																		Optional:            true,
+																		Validators: []validator.Int64{
+																			int64validator.AtLeast(0),
+																			int64validator.AtMost(299999999),
+																		},
 																	},
 																},
 															},
 														},
 														"exclude_violation_contexts": schema.ListNestedBlock{
 															MarkdownDescription: "Violations to be excluded for the defined match criteria.",
+															Validators: []validator.List{
+																listvalidator.SizeAtMost(64),
+															},
 															NestedObject: schema.NestedBlockObject{
 																Attributes: map[string]schema.Attribute{
 																	"context": schema.StringAttribute{
@@ -2143,6 +2600,9 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 																	"context_name": schema.StringAttribute{
 																		MarkdownDescription: "Relevant only for contexts: Header, Cookie and Parameter. Name of the Context that the WAF Exclusion Rules will check. Wildcard matching can be used by prefixing or suffixing the context name with an wildcard asterisk (*).",
 																		Optional:            true,
+																		Validators: []validator.String{
+																			stringvalidator.UTF8LengthAtMost(128),
+																		},
 																	},
 																	"exclude_violation": schema.StringAttribute{
 																		MarkdownDescription: "[Enum: VIOL_NONE|VIOL_FILETYPE|VIOL_METHOD|VIOL_MANDATORY_HEADER|VIOL_HTTP_RESPONSE_STATUS|VIOL_REQUEST_MAX_LENGTH|VIOL_FILE_UPLOAD|VIOL_FILE_UPLOAD_IN_BODY|VIOL_XML_MALFORMED|VIOL_JSON_MALFORMED|VIOL_ASM_COOKIE_MODIFIED|VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS|VIOL_HTTP_PROTOCOL_BAD_HOST_HEADER_VALUE|VIOL_HTTP_PROTOCOL_UNPARSABLE_REQUEST_CONTENT|VIOL_HTTP_PROTOCOL_NULL_IN_REQUEST|VIOL_HTTP_PROTOCOL_BAD_HTTP_VERSION|VIOL_HTTP_PROTOCOL_SEVERAL_CONTENT_LENGTH_HEADERS|VIOL_EVASION_DIRECTORY_TRAVERSALS|VIOL_MALFORMED_REQUEST|VIOL_EVASION_MULTIPLE_DECODING|VIOL_DATA_GUARD|VIOL_EVASION_APACHE_WHITESPACE|VIOL_COOKIE_MODIFIED|VIOL_EVASION_IIS_UNICODE_CODEPOINTS|VIOL_EVASION_IIS_BACKSLASHES|VIOL_EVASION_PERCENT_U_DECODING|VIOL_EVASION_BARE_BYTE_DECODING|VIOL_EVASION_BAD_UNESCAPE|VIOL_HTTP_PROTOCOL_BODY_IN_GET_OR_HEAD_REQUEST|VIOL_ENCODING|VIOL_COOKIE_MALFORMED|VIOL_GRAPHQL_FORMAT|VIOL_GRAPHQL_MALFORMED|VIOL_GRAPHQL_INTROSPECTION_QUERY] List of all supported Violation Types VIOL_NONE VIOL_FILETYPE VIOL_METHOD VIOL_MANDATORY_HEADER VIOL_HTTP_RESPONSE_STATUS VIOL_REQUEST_MAX_LENGTH VIOL_FILE_UPLOAD VIOL_FILE_UPLOAD_IN_BODY VIOL_XML_MALFORMED VIOL_JSON_MALFORMED VIOL_ASM_COOKIE_MODIFIED VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS.. Possible values are `VIOL_NONE`, `VIOL_FILETYPE`, `VIOL_METHOD`, `VIOL_MANDATORY_HEADER`, `VIOL_HTTP_RESPONSE_STATUS`, `VIOL_REQUEST_MAX_LENGTH`, `VIOL_FILE_UPLOAD`, `VIOL_FILE_UPLOAD_IN_BODY`, `VIOL_XML_MALFORMED`, `VIOL_JSON_MALFORMED`, `VIOL_ASM_COOKIE_MODIFIED`, `VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS`, `VIOL_HTTP_PROTOCOL_BAD_HOST_HEADER_VALUE`, `VIOL_HTTP_PROTOCOL_UNPARSABLE_REQUEST_CONTENT`, `VIOL_HTTP_PROTOCOL_NULL_IN_REQUEST`, `VIOL_HTTP_PROTOCOL_BAD_HTTP_VERSION`, `VIOL_HTTP_PROTOCOL_SEVERAL_CONTENT_LENGTH_HEADERS`, `VIOL_EVASION_DIRECTORY_TRAVERSALS`, `VIOL_MALFORMED_REQUEST`, `VIOL_EVASION_MULTIPLE_DECODING`, `VIOL_DATA_GUARD`, `VIOL_EVASION_APACHE_WHITESPACE`, `VIOL_COOKIE_MODIFIED`, `VIOL_EVASION_IIS_UNICODE_CODEPOINTS`, `VIOL_EVASION_IIS_BACKSLASHES`, `VIOL_EVASION_PERCENT_U_DECODING`, `VIOL_EVASION_BARE_BYTE_DECODING`, `VIOL_EVASION_BAD_UNESCAPE`, `VIOL_HTTP_PROTOCOL_BODY_IN_GET_OR_HEAD_REQUEST`, `VIOL_ENCODING`, `VIOL_COOKIE_MALFORMED`, `VIOL_GRAPHQL_FORMAT`, `VIOL_GRAPHQL_MALFORMED`, `VIOL_GRAPHQL_INTROSPECTION_QUERY`. Defaults to `VIOL_NONE`.",
@@ -2175,11 +2635,21 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 						MarkdownDescription: "List of exact values to match the input against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(64),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 					"regex_values": schema.ListAttribute{
 						MarkdownDescription: "List of regular expressions to match the input against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+						},
 					},
 				},
 			},
@@ -2190,6 +2660,10 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 						MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+						},
 					},
 				},
 			},
@@ -2197,6 +2671,11 @@ func (r *ServicePolicyResource) Schema(ctx context.Context, req resource.SchemaR
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *ServicePolicyResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *ServicePolicyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -2221,6 +2700,42 @@ func (r *ServicePolicyResource) ValidateConfig(ctx context.Context, req resource
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *ServicePolicyResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "rule_choice", "allow_all_requests", "allow_list", "deny_all_requests", "deny_list", "rule_list"),
+		validators.OneOfGroup(path.MatchRelative(), "server_choice", "any_server", "server_name", "server_name_matcher", "server_selector"),
+		validators.OneOfGroup(path.MatchRoot("allow_list"), "default_action_choice", "default_action_allow", "default_action_deny", "default_action_next_policy"),
+		validators.OneOfGroup(path.MatchRoot("deny_list"), "default_action_choice", "default_action_allow", "default_action_deny", "default_action_next_policy"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec"), "asn_choice", "any_asn", "asn_list", "asn_matcher"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec"), "client_choice", "any_client", "client_name", "client_name_matcher", "client_selector", "ip_threat_category_list"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec"), "ip_choice", "any_ip", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec"), "tls_fingerprint_choice", "ja4_tls_fingerprint", "tls_fingerprint_matcher"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("arg_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("bot_action"), "action_type", "bot_skip_processing", "none"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("cookie_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("jwt_claims").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("mum_action"), "action_type", "default", "skip_processing"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_cookie_count_choice", "max_cookie_count_exceeds", "max_cookie_count_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_cookie_key_size_choice", "max_cookie_key_size_exceeds", "max_cookie_key_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_cookie_value_size_choice", "max_cookie_value_size_exceeds", "max_cookie_value_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_header_count_choice", "max_header_count_exceeds", "max_header_count_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_header_key_size_choice", "max_header_key_size_exceeds", "max_header_key_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_header_value_size_choice", "max_header_value_size_exceeds", "max_header_value_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_parameter_count_choice", "max_parameter_count_exceeds", "max_parameter_count_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_parameter_name_size_choice", "max_parameter_name_size_exceeds", "max_parameter_name_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_parameter_value_size_choice", "max_parameter_value_size_exceeds", "max_parameter_value_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_query_size_choice", "max_query_size_exceeds", "max_query_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_request_line_size_choice", "max_request_line_size_exceeds", "max_request_line_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_request_size_choice", "max_request_size_exceeds", "max_request_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("request_constraints"), "max_url_size_choice", "max_url_size_exceeds", "max_url_size_none"),
+		validators.OneOfGroup(path.MatchRoot("rule_list").AtName("rules").AtAnyListIndex().AtName("spec").AtName("waf_action"), "action_type", "app_firewall_detection_control", "none", "waf_skip_processing"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *ServicePolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -2231,7 +2746,10 @@ func (r *ServicePolicyResource) ModifyPlan(ctx context.Context, req resource.Mod
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "service_policy", "", req.Config, &resp.Plan)...)
 		var plan ServicePolicyResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -2288,6 +2806,7 @@ func (r *ServicePolicyResource) Create(ctx context.Context, req resource.CreateR
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -2297,6 +2816,7 @@ func (r *ServicePolicyResource) Create(ctx context.Context, req resource.CreateR
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AllowAllRequests != nil {
@@ -2493,12 +3013,431 @@ func (r *ServicePolicyResource) Create(ctx context.Context, req resource.CreateR
 					if listItem.Spec.AnyIP != nil {
 						specDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.Spec.APIGroupMatcher != nil {
+						api_group_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.APIGroupMatcher.InvertMatcher.IsNull() && !listItem.Spec.APIGroupMatcher.InvertMatcher.IsUnknown() {
+							api_group_matcherLevel4Map["invert_matcher"] = listItem.Spec.APIGroupMatcher.InvertMatcher.ValueBool()
+						}
+						if !listItem.Spec.APIGroupMatcher.Match.IsNull() && !listItem.Spec.APIGroupMatcher.Match.IsUnknown() {
+							var matchLevel4Items []string
+							diags := listItem.Spec.APIGroupMatcher.Match.ElementsAs(ctx, &matchLevel4Items, false)
+							if !diags.HasError() {
+								api_group_matcherLevel4Map["match"] = matchLevel4Items
+							}
+						}
+						specDeepMap["api_group_matcher"] = api_group_matcherLevel4Map
+					}
+					if listItem.Spec.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.AsnList.AsNumbers.IsNull() && !listItem.Spec.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.Spec.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						specDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.Spec.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						specDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.Spec.BodyMatcher != nil {
+						body_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.BodyMatcher.ExactValues.IsNull() && !listItem.Spec.BodyMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.BodyMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								body_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.BodyMatcher.RegexValues.IsNull() && !listItem.Spec.BodyMatcher.RegexValues.IsUnknown() {
+							var regex_valuesLevel4Items []string
+							diags := listItem.Spec.BodyMatcher.RegexValues.ElementsAs(ctx, &regex_valuesLevel4Items, false)
+							if !diags.HasError() {
+								body_matcherLevel4Map["regex_values"] = regex_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.BodyMatcher.Transformers.IsNull() && !listItem.Spec.BodyMatcher.Transformers.IsUnknown() {
+							var transformersLevel4Items []string
+							diags := listItem.Spec.BodyMatcher.Transformers.ElementsAs(ctx, &transformersLevel4Items, false)
+							if !diags.HasError() {
+								body_matcherLevel4Map["transformers"] = transformersLevel4Items
+							}
+						}
+						specDeepMap["body_matcher"] = body_matcherLevel4Map
+					}
+					if listItem.Spec.BotAction != nil {
+						bot_actionLevel4Map := make(map[string]interface{})
+						if listItem.Spec.BotAction.BotSkipProcessing != nil {
+							bot_actionLevel4Map["bot_skip_processing"] = map[string]interface{}{}
+						}
+						if listItem.Spec.BotAction.None != nil {
+							bot_actionLevel4Map["none"] = map[string]interface{}{}
+						}
+						specDeepMap["bot_action"] = bot_actionLevel4Map
+					}
 					if !listItem.Spec.ClientName.IsNull() && !listItem.Spec.ClientName.IsUnknown() {
 						specDeepMap["client_name"] = listItem.Spec.ClientName.ValueString()
 					}
+					if listItem.Spec.ClientNameMatcher != nil {
+						client_name_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.ClientNameMatcher.ExactValues.IsNull() && !listItem.Spec.ClientNameMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.ClientNameMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								client_name_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.ClientNameMatcher.RegexValues.IsNull() && !listItem.Spec.ClientNameMatcher.RegexValues.IsUnknown() {
+							var regex_valuesLevel4Items []string
+							diags := listItem.Spec.ClientNameMatcher.RegexValues.ElementsAs(ctx, &regex_valuesLevel4Items, false)
+							if !diags.HasError() {
+								client_name_matcherLevel4Map["regex_values"] = regex_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.ClientNameMatcher.Transformers.IsNull() && !listItem.Spec.ClientNameMatcher.Transformers.IsUnknown() {
+							var transformersLevel4Items []string
+							diags := listItem.Spec.ClientNameMatcher.Transformers.ElementsAs(ctx, &transformersLevel4Items, false)
+							if !diags.HasError() {
+								client_name_matcherLevel4Map["transformers"] = transformersLevel4Items
+							}
+						}
+						specDeepMap["client_name_matcher"] = client_name_matcherLevel4Map
+					}
+					if listItem.Spec.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.ClientSelector.Expressions.IsNull() && !listItem.Spec.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.Spec.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						specDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.Spec.DomainMatcher != nil {
+						domain_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.DomainMatcher.ExactValues.IsNull() && !listItem.Spec.DomainMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.DomainMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								domain_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.DomainMatcher.RegexValues.IsNull() && !listItem.Spec.DomainMatcher.RegexValues.IsUnknown() {
+							var regex_valuesLevel4Items []string
+							diags := listItem.Spec.DomainMatcher.RegexValues.ElementsAs(ctx, &regex_valuesLevel4Items, false)
+							if !diags.HasError() {
+								domain_matcherLevel4Map["regex_values"] = regex_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.DomainMatcher.Transformers.IsNull() && !listItem.Spec.DomainMatcher.Transformers.IsUnknown() {
+							var transformersLevel4Items []string
+							diags := listItem.Spec.DomainMatcher.Transformers.ElementsAs(ctx, &transformersLevel4Items, false)
+							if !diags.HasError() {
+								domain_matcherLevel4Map["transformers"] = transformersLevel4Items
+							}
+						}
+						specDeepMap["domain_matcher"] = domain_matcherLevel4Map
+					}
 					if !listItem.Spec.ExpirationTimestamp.IsNull() && !listItem.Spec.ExpirationTimestamp.IsUnknown() {
 						specDeepMap["expiration_timestamp"] = listItem.Spec.ExpirationTimestamp.ValueString()
 					}
+					if listItem.Spec.HTTPMethod != nil {
+						http_methodLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.HTTPMethod.InvertMatcher.IsNull() && !listItem.Spec.HTTPMethod.InvertMatcher.IsUnknown() {
+							http_methodLevel4Map["invert_matcher"] = listItem.Spec.HTTPMethod.InvertMatcher.ValueBool()
+						}
+						if !listItem.Spec.HTTPMethod.Methods.IsNull() && !listItem.Spec.HTTPMethod.Methods.IsUnknown() {
+							var methodsLevel4Items []string
+							diags := listItem.Spec.HTTPMethod.Methods.ElementsAs(ctx, &methodsLevel4Items, false)
+							if !diags.HasError() {
+								http_methodLevel4Map["methods"] = methodsLevel4Items
+							}
+						}
+						specDeepMap["http_method"] = http_methodLevel4Map
+					}
+					if listItem.Spec.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.IPMatcher.InvertMatcher.IsNull() && !listItem.Spec.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.Spec.IPMatcher.InvertMatcher.ValueBool()
+						}
+						specDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.Spec.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.IPPrefixList.InvertMatch.IsNull() && !listItem.Spec.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.Spec.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.Spec.IPPrefixList.IPPrefixes.IsNull() && !listItem.Spec.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.Spec.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						specDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.Spec.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.Spec.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.Spec.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						specDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.Spec.Ja4TLSFingerprint != nil {
+						ja4_tls_fingerprintLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.Ja4TLSFingerprint.ExactValues.IsNull() && !listItem.Spec.Ja4TLSFingerprint.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.Ja4TLSFingerprint.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								ja4_tls_fingerprintLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						specDeepMap["ja4_tls_fingerprint"] = ja4_tls_fingerprintLevel4Map
+					}
+					if listItem.Spec.LabelMatcher != nil {
+						label_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.LabelMatcher.Keys.IsNull() && !listItem.Spec.LabelMatcher.Keys.IsUnknown() {
+							var keysLevel4Items []string
+							diags := listItem.Spec.LabelMatcher.Keys.ElementsAs(ctx, &keysLevel4Items, false)
+							if !diags.HasError() {
+								label_matcherLevel4Map["keys"] = keysLevel4Items
+							}
+						}
+						specDeepMap["label_matcher"] = label_matcherLevel4Map
+					}
+					if listItem.Spec.MumAction != nil {
+						mum_actionLevel4Map := make(map[string]interface{})
+						if listItem.Spec.MumAction.Default != nil {
+							mum_actionLevel4Map["default"] = map[string]interface{}{}
+						}
+						if listItem.Spec.MumAction.SkipProcessing != nil {
+							mum_actionLevel4Map["skip_processing"] = map[string]interface{}{}
+						}
+						specDeepMap["mum_action"] = mum_actionLevel4Map
+					}
+					if listItem.Spec.Path != nil {
+						pathLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.Path.ExactValues.IsNull() && !listItem.Spec.Path.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.Path.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								pathLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.Path.InvertMatcher.IsNull() && !listItem.Spec.Path.InvertMatcher.IsUnknown() {
+							pathLevel4Map["invert_matcher"] = listItem.Spec.Path.InvertMatcher.ValueBool()
+						}
+						if !listItem.Spec.Path.PrefixValues.IsNull() && !listItem.Spec.Path.PrefixValues.IsUnknown() {
+							var prefix_valuesLevel4Items []string
+							diags := listItem.Spec.Path.PrefixValues.ElementsAs(ctx, &prefix_valuesLevel4Items, false)
+							if !diags.HasError() {
+								pathLevel4Map["prefix_values"] = prefix_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.Path.RegexValues.IsNull() && !listItem.Spec.Path.RegexValues.IsUnknown() {
+							var regex_valuesLevel4Items []string
+							diags := listItem.Spec.Path.RegexValues.ElementsAs(ctx, &regex_valuesLevel4Items, false)
+							if !diags.HasError() {
+								pathLevel4Map["regex_values"] = regex_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.Path.SuffixValues.IsNull() && !listItem.Spec.Path.SuffixValues.IsUnknown() {
+							var suffix_valuesLevel4Items []string
+							diags := listItem.Spec.Path.SuffixValues.ElementsAs(ctx, &suffix_valuesLevel4Items, false)
+							if !diags.HasError() {
+								pathLevel4Map["suffix_values"] = suffix_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.Path.Transformers.IsNull() && !listItem.Spec.Path.Transformers.IsUnknown() {
+							var transformersLevel4Items []string
+							diags := listItem.Spec.Path.Transformers.ElementsAs(ctx, &transformersLevel4Items, false)
+							if !diags.HasError() {
+								pathLevel4Map["transformers"] = transformersLevel4Items
+							}
+						}
+						specDeepMap["path"] = pathLevel4Map
+					}
+					if listItem.Spec.PortMatcher != nil {
+						port_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.PortMatcher.InvertMatcher.IsNull() && !listItem.Spec.PortMatcher.InvertMatcher.IsUnknown() {
+							port_matcherLevel4Map["invert_matcher"] = listItem.Spec.PortMatcher.InvertMatcher.ValueBool()
+						}
+						if !listItem.Spec.PortMatcher.Ports.IsNull() && !listItem.Spec.PortMatcher.Ports.IsUnknown() {
+							var portsLevel4Items []string
+							diags := listItem.Spec.PortMatcher.Ports.ElementsAs(ctx, &portsLevel4Items, false)
+							if !diags.HasError() {
+								port_matcherLevel4Map["ports"] = portsLevel4Items
+							}
+						}
+						specDeepMap["port_matcher"] = port_matcherLevel4Map
+					}
+					if listItem.Spec.RequestConstraints != nil {
+						request_constraintsLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.RequestConstraints.MaxCookieCountExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxCookieCountExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_cookie_count_exceeds"] = listItem.Spec.RequestConstraints.MaxCookieCountExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxCookieCountNone != nil {
+							request_constraintsLevel4Map["max_cookie_count_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxCookieKeySizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxCookieKeySizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_cookie_key_size_exceeds"] = listItem.Spec.RequestConstraints.MaxCookieKeySizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxCookieKeySizeNone != nil {
+							request_constraintsLevel4Map["max_cookie_key_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxCookieValueSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxCookieValueSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_cookie_value_size_exceeds"] = listItem.Spec.RequestConstraints.MaxCookieValueSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxCookieValueSizeNone != nil {
+							request_constraintsLevel4Map["max_cookie_value_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxHeaderCountExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxHeaderCountExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_header_count_exceeds"] = listItem.Spec.RequestConstraints.MaxHeaderCountExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxHeaderCountNone != nil {
+							request_constraintsLevel4Map["max_header_count_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxHeaderKeySizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxHeaderKeySizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_header_key_size_exceeds"] = listItem.Spec.RequestConstraints.MaxHeaderKeySizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxHeaderKeySizeNone != nil {
+							request_constraintsLevel4Map["max_header_key_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxHeaderValueSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxHeaderValueSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_header_value_size_exceeds"] = listItem.Spec.RequestConstraints.MaxHeaderValueSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxHeaderValueSizeNone != nil {
+							request_constraintsLevel4Map["max_header_value_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxParameterCountExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxParameterCountExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_parameter_count_exceeds"] = listItem.Spec.RequestConstraints.MaxParameterCountExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxParameterCountNone != nil {
+							request_constraintsLevel4Map["max_parameter_count_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxParameterNameSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxParameterNameSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_parameter_name_size_exceeds"] = listItem.Spec.RequestConstraints.MaxParameterNameSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxParameterNameSizeNone != nil {
+							request_constraintsLevel4Map["max_parameter_name_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxParameterValueSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxParameterValueSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_parameter_value_size_exceeds"] = listItem.Spec.RequestConstraints.MaxParameterValueSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxParameterValueSizeNone != nil {
+							request_constraintsLevel4Map["max_parameter_value_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxQuerySizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxQuerySizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_query_size_exceeds"] = listItem.Spec.RequestConstraints.MaxQuerySizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxQuerySizeNone != nil {
+							request_constraintsLevel4Map["max_query_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxRequestLineSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxRequestLineSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_request_line_size_exceeds"] = listItem.Spec.RequestConstraints.MaxRequestLineSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxRequestLineSizeNone != nil {
+							request_constraintsLevel4Map["max_request_line_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxRequestSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxRequestSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_request_size_exceeds"] = listItem.Spec.RequestConstraints.MaxRequestSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxRequestSizeNone != nil {
+							request_constraintsLevel4Map["max_request_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxURLSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxURLSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_url_size_exceeds"] = listItem.Spec.RequestConstraints.MaxURLSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxURLSizeNone != nil {
+							request_constraintsLevel4Map["max_url_size_none"] = map[string]interface{}{}
+						}
+						specDeepMap["request_constraints"] = request_constraintsLevel4Map
+					}
+					if listItem.Spec.SegmentPolicy != nil {
+						segment_policyLevel4Map := make(map[string]interface{})
+						if listItem.Spec.SegmentPolicy.DstAny != nil {
+							segment_policyLevel4Map["dst_any"] = map[string]interface{}{}
+						}
+						if listItem.Spec.SegmentPolicy.DstSegments != nil {
+							dst_segmentsLevel5Map := make(map[string]interface{})
+							segment_policyLevel4Map["dst_segments"] = dst_segmentsLevel5Map
+						}
+						if listItem.Spec.SegmentPolicy.IntraSegment != nil {
+							segment_policyLevel4Map["intra_segment"] = map[string]interface{}{}
+						}
+						if listItem.Spec.SegmentPolicy.SrcAny != nil {
+							segment_policyLevel4Map["src_any"] = map[string]interface{}{}
+						}
+						if listItem.Spec.SegmentPolicy.SrcSegments != nil {
+							src_segmentsLevel5Map := make(map[string]interface{})
+							segment_policyLevel4Map["src_segments"] = src_segmentsLevel5Map
+						}
+						specDeepMap["segment_policy"] = segment_policyLevel4Map
+					}
+					if listItem.Spec.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.TLSFingerprintMatcher.Classes.IsNull() && !listItem.Spec.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.Spec.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.Spec.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.Spec.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.Spec.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.Spec.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						specDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
+					if listItem.Spec.UserIdentityMatcher != nil {
+						user_identity_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.UserIdentityMatcher.ExactValues.IsNull() && !listItem.Spec.UserIdentityMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.UserIdentityMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								user_identity_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.UserIdentityMatcher.RegexValues.IsNull() && !listItem.Spec.UserIdentityMatcher.RegexValues.IsUnknown() {
+							var regex_valuesLevel4Items []string
+							diags := listItem.Spec.UserIdentityMatcher.RegexValues.ElementsAs(ctx, &regex_valuesLevel4Items, false)
+							if !diags.HasError() {
+								user_identity_matcherLevel4Map["regex_values"] = regex_valuesLevel4Items
+							}
+						}
+						specDeepMap["user_identity_matcher"] = user_identity_matcherLevel4Map
+					}
+					if listItem.Spec.WAFAction != nil {
+						waf_actionLevel4Map := make(map[string]interface{})
+						if listItem.Spec.WAFAction.AppFirewallDetectionControl != nil {
+							app_firewall_detection_controlLevel5Map := make(map[string]interface{})
+							waf_actionLevel4Map["app_firewall_detection_control"] = app_firewall_detection_controlLevel5Map
+						}
+						if listItem.Spec.WAFAction.None != nil {
+							waf_actionLevel4Map["none"] = map[string]interface{}{}
+						}
+						if listItem.Spec.WAFAction.WAFSkipProcessing != nil {
+							waf_actionLevel4Map["waf_skip_processing"] = map[string]interface{}{}
+						}
+						specDeepMap["waf_action"] = waf_actionLevel4Map
+					}
 					listItemMap["spec"] = specDeepMap
 				}
 				rulesList = append(rulesList, listItemMap)
@@ -2542,11 +3481,15 @@ func (r *ServicePolicyResource) Create(ctx context.Context, req resource.CreateR
 
 	apiResource, err := r.client.CreateServicePolicy(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ServicePolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_policy", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -3104,7 +4047,7 @@ func (r *ServicePolicyResource) Read(ctx context.Context, req resource.ReadReque
 	apiResource, err := r.client.GetServicePolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "ServicePolicy not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -3112,11 +4055,12 @@ func (r *ServicePolicyResource) Read(ctx context.Context, req resource.ReadReque
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ServicePolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_policy", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -3127,31 +4071,13 @@ func (r *ServicePolicyResource) Read(ctx context.Context, req resource.ReadReque
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -3159,6 +4085,7 @@ func (r *ServicePolicyResource) Read(ctx context.Context, req resource.ReadReque
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["allow_all_requests"].(map[string]interface{}); ok && isImport && data.AllowAllRequests == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -3728,6 +4655,7 @@ func (r *ServicePolicyResource) Update(ctx context.Context, req resource.UpdateR
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -3737,6 +4665,7 @@ func (r *ServicePolicyResource) Update(ctx context.Context, req resource.UpdateR
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AllowAllRequests != nil {
@@ -3933,12 +4862,431 @@ func (r *ServicePolicyResource) Update(ctx context.Context, req resource.UpdateR
 					if listItem.Spec.AnyIP != nil {
 						specDeepMap["any_ip"] = map[string]interface{}{}
 					}
+					if listItem.Spec.APIGroupMatcher != nil {
+						api_group_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.APIGroupMatcher.InvertMatcher.IsNull() && !listItem.Spec.APIGroupMatcher.InvertMatcher.IsUnknown() {
+							api_group_matcherLevel4Map["invert_matcher"] = listItem.Spec.APIGroupMatcher.InvertMatcher.ValueBool()
+						}
+						if !listItem.Spec.APIGroupMatcher.Match.IsNull() && !listItem.Spec.APIGroupMatcher.Match.IsUnknown() {
+							var matchLevel4Items []string
+							diags := listItem.Spec.APIGroupMatcher.Match.ElementsAs(ctx, &matchLevel4Items, false)
+							if !diags.HasError() {
+								api_group_matcherLevel4Map["match"] = matchLevel4Items
+							}
+						}
+						specDeepMap["api_group_matcher"] = api_group_matcherLevel4Map
+					}
+					if listItem.Spec.AsnList != nil {
+						asn_listLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.AsnList.AsNumbers.IsNull() && !listItem.Spec.AsnList.AsNumbers.IsUnknown() {
+							var as_numbersLevel4Items []int64
+							diags := listItem.Spec.AsnList.AsNumbers.ElementsAs(ctx, &as_numbersLevel4Items, false)
+							if !diags.HasError() {
+								asn_listLevel4Map["as_numbers"] = as_numbersLevel4Items
+							}
+						}
+						specDeepMap["asn_list"] = asn_listLevel4Map
+					}
+					if listItem.Spec.AsnMatcher != nil {
+						asn_matcherLevel4Map := make(map[string]interface{})
+						specDeepMap["asn_matcher"] = asn_matcherLevel4Map
+					}
+					if listItem.Spec.BodyMatcher != nil {
+						body_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.BodyMatcher.ExactValues.IsNull() && !listItem.Spec.BodyMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.BodyMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								body_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.BodyMatcher.RegexValues.IsNull() && !listItem.Spec.BodyMatcher.RegexValues.IsUnknown() {
+							var regex_valuesLevel4Items []string
+							diags := listItem.Spec.BodyMatcher.RegexValues.ElementsAs(ctx, &regex_valuesLevel4Items, false)
+							if !diags.HasError() {
+								body_matcherLevel4Map["regex_values"] = regex_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.BodyMatcher.Transformers.IsNull() && !listItem.Spec.BodyMatcher.Transformers.IsUnknown() {
+							var transformersLevel4Items []string
+							diags := listItem.Spec.BodyMatcher.Transformers.ElementsAs(ctx, &transformersLevel4Items, false)
+							if !diags.HasError() {
+								body_matcherLevel4Map["transformers"] = transformersLevel4Items
+							}
+						}
+						specDeepMap["body_matcher"] = body_matcherLevel4Map
+					}
+					if listItem.Spec.BotAction != nil {
+						bot_actionLevel4Map := make(map[string]interface{})
+						if listItem.Spec.BotAction.BotSkipProcessing != nil {
+							bot_actionLevel4Map["bot_skip_processing"] = map[string]interface{}{}
+						}
+						if listItem.Spec.BotAction.None != nil {
+							bot_actionLevel4Map["none"] = map[string]interface{}{}
+						}
+						specDeepMap["bot_action"] = bot_actionLevel4Map
+					}
 					if !listItem.Spec.ClientName.IsNull() && !listItem.Spec.ClientName.IsUnknown() {
 						specDeepMap["client_name"] = listItem.Spec.ClientName.ValueString()
 					}
+					if listItem.Spec.ClientNameMatcher != nil {
+						client_name_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.ClientNameMatcher.ExactValues.IsNull() && !listItem.Spec.ClientNameMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.ClientNameMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								client_name_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.ClientNameMatcher.RegexValues.IsNull() && !listItem.Spec.ClientNameMatcher.RegexValues.IsUnknown() {
+							var regex_valuesLevel4Items []string
+							diags := listItem.Spec.ClientNameMatcher.RegexValues.ElementsAs(ctx, &regex_valuesLevel4Items, false)
+							if !diags.HasError() {
+								client_name_matcherLevel4Map["regex_values"] = regex_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.ClientNameMatcher.Transformers.IsNull() && !listItem.Spec.ClientNameMatcher.Transformers.IsUnknown() {
+							var transformersLevel4Items []string
+							diags := listItem.Spec.ClientNameMatcher.Transformers.ElementsAs(ctx, &transformersLevel4Items, false)
+							if !diags.HasError() {
+								client_name_matcherLevel4Map["transformers"] = transformersLevel4Items
+							}
+						}
+						specDeepMap["client_name_matcher"] = client_name_matcherLevel4Map
+					}
+					if listItem.Spec.ClientSelector != nil {
+						client_selectorLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.ClientSelector.Expressions.IsNull() && !listItem.Spec.ClientSelector.Expressions.IsUnknown() {
+							var expressionsLevel4Items []string
+							diags := listItem.Spec.ClientSelector.Expressions.ElementsAs(ctx, &expressionsLevel4Items, false)
+							if !diags.HasError() {
+								client_selectorLevel4Map["expressions"] = expressionsLevel4Items
+							}
+						}
+						specDeepMap["client_selector"] = client_selectorLevel4Map
+					}
+					if listItem.Spec.DomainMatcher != nil {
+						domain_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.DomainMatcher.ExactValues.IsNull() && !listItem.Spec.DomainMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.DomainMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								domain_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.DomainMatcher.RegexValues.IsNull() && !listItem.Spec.DomainMatcher.RegexValues.IsUnknown() {
+							var regex_valuesLevel4Items []string
+							diags := listItem.Spec.DomainMatcher.RegexValues.ElementsAs(ctx, &regex_valuesLevel4Items, false)
+							if !diags.HasError() {
+								domain_matcherLevel4Map["regex_values"] = regex_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.DomainMatcher.Transformers.IsNull() && !listItem.Spec.DomainMatcher.Transformers.IsUnknown() {
+							var transformersLevel4Items []string
+							diags := listItem.Spec.DomainMatcher.Transformers.ElementsAs(ctx, &transformersLevel4Items, false)
+							if !diags.HasError() {
+								domain_matcherLevel4Map["transformers"] = transformersLevel4Items
+							}
+						}
+						specDeepMap["domain_matcher"] = domain_matcherLevel4Map
+					}
 					if !listItem.Spec.ExpirationTimestamp.IsNull() && !listItem.Spec.ExpirationTimestamp.IsUnknown() {
 						specDeepMap["expiration_timestamp"] = listItem.Spec.ExpirationTimestamp.ValueString()
 					}
+					if listItem.Spec.HTTPMethod != nil {
+						http_methodLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.HTTPMethod.InvertMatcher.IsNull() && !listItem.Spec.HTTPMethod.InvertMatcher.IsUnknown() {
+							http_methodLevel4Map["invert_matcher"] = listItem.Spec.HTTPMethod.InvertMatcher.ValueBool()
+						}
+						if !listItem.Spec.HTTPMethod.Methods.IsNull() && !listItem.Spec.HTTPMethod.Methods.IsUnknown() {
+							var methodsLevel4Items []string
+							diags := listItem.Spec.HTTPMethod.Methods.ElementsAs(ctx, &methodsLevel4Items, false)
+							if !diags.HasError() {
+								http_methodLevel4Map["methods"] = methodsLevel4Items
+							}
+						}
+						specDeepMap["http_method"] = http_methodLevel4Map
+					}
+					if listItem.Spec.IPMatcher != nil {
+						ip_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.IPMatcher.InvertMatcher.IsNull() && !listItem.Spec.IPMatcher.InvertMatcher.IsUnknown() {
+							ip_matcherLevel4Map["invert_matcher"] = listItem.Spec.IPMatcher.InvertMatcher.ValueBool()
+						}
+						specDeepMap["ip_matcher"] = ip_matcherLevel4Map
+					}
+					if listItem.Spec.IPPrefixList != nil {
+						ip_prefix_listLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.IPPrefixList.InvertMatch.IsNull() && !listItem.Spec.IPPrefixList.InvertMatch.IsUnknown() {
+							ip_prefix_listLevel4Map["invert_match"] = listItem.Spec.IPPrefixList.InvertMatch.ValueBool()
+						}
+						if !listItem.Spec.IPPrefixList.IPPrefixes.IsNull() && !listItem.Spec.IPPrefixList.IPPrefixes.IsUnknown() {
+							var ip_prefixesLevel4Items []string
+							diags := listItem.Spec.IPPrefixList.IPPrefixes.ElementsAs(ctx, &ip_prefixesLevel4Items, false)
+							if !diags.HasError() {
+								ip_prefix_listLevel4Map["ip_prefixes"] = ip_prefixesLevel4Items
+							}
+						}
+						specDeepMap["ip_prefix_list"] = ip_prefix_listLevel4Map
+					}
+					if listItem.Spec.IPThreatCategoryList != nil {
+						ip_threat_category_listLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.IPThreatCategoryList.IPThreatCategories.IsNull() && !listItem.Spec.IPThreatCategoryList.IPThreatCategories.IsUnknown() {
+							var ip_threat_categoriesLevel4Items []string
+							diags := listItem.Spec.IPThreatCategoryList.IPThreatCategories.ElementsAs(ctx, &ip_threat_categoriesLevel4Items, false)
+							if !diags.HasError() {
+								ip_threat_category_listLevel4Map["ip_threat_categories"] = ip_threat_categoriesLevel4Items
+							}
+						}
+						specDeepMap["ip_threat_category_list"] = ip_threat_category_listLevel4Map
+					}
+					if listItem.Spec.Ja4TLSFingerprint != nil {
+						ja4_tls_fingerprintLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.Ja4TLSFingerprint.ExactValues.IsNull() && !listItem.Spec.Ja4TLSFingerprint.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.Ja4TLSFingerprint.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								ja4_tls_fingerprintLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						specDeepMap["ja4_tls_fingerprint"] = ja4_tls_fingerprintLevel4Map
+					}
+					if listItem.Spec.LabelMatcher != nil {
+						label_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.LabelMatcher.Keys.IsNull() && !listItem.Spec.LabelMatcher.Keys.IsUnknown() {
+							var keysLevel4Items []string
+							diags := listItem.Spec.LabelMatcher.Keys.ElementsAs(ctx, &keysLevel4Items, false)
+							if !diags.HasError() {
+								label_matcherLevel4Map["keys"] = keysLevel4Items
+							}
+						}
+						specDeepMap["label_matcher"] = label_matcherLevel4Map
+					}
+					if listItem.Spec.MumAction != nil {
+						mum_actionLevel4Map := make(map[string]interface{})
+						if listItem.Spec.MumAction.Default != nil {
+							mum_actionLevel4Map["default"] = map[string]interface{}{}
+						}
+						if listItem.Spec.MumAction.SkipProcessing != nil {
+							mum_actionLevel4Map["skip_processing"] = map[string]interface{}{}
+						}
+						specDeepMap["mum_action"] = mum_actionLevel4Map
+					}
+					if listItem.Spec.Path != nil {
+						pathLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.Path.ExactValues.IsNull() && !listItem.Spec.Path.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.Path.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								pathLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.Path.InvertMatcher.IsNull() && !listItem.Spec.Path.InvertMatcher.IsUnknown() {
+							pathLevel4Map["invert_matcher"] = listItem.Spec.Path.InvertMatcher.ValueBool()
+						}
+						if !listItem.Spec.Path.PrefixValues.IsNull() && !listItem.Spec.Path.PrefixValues.IsUnknown() {
+							var prefix_valuesLevel4Items []string
+							diags := listItem.Spec.Path.PrefixValues.ElementsAs(ctx, &prefix_valuesLevel4Items, false)
+							if !diags.HasError() {
+								pathLevel4Map["prefix_values"] = prefix_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.Path.RegexValues.IsNull() && !listItem.Spec.Path.RegexValues.IsUnknown() {
+							var regex_valuesLevel4Items []string
+							diags := listItem.Spec.Path.RegexValues.ElementsAs(ctx, &regex_valuesLevel4Items, false)
+							if !diags.HasError() {
+								pathLevel4Map["regex_values"] = regex_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.Path.SuffixValues.IsNull() && !listItem.Spec.Path.SuffixValues.IsUnknown() {
+							var suffix_valuesLevel4Items []string
+							diags := listItem.Spec.Path.SuffixValues.ElementsAs(ctx, &suffix_valuesLevel4Items, false)
+							if !diags.HasError() {
+								pathLevel4Map["suffix_values"] = suffix_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.Path.Transformers.IsNull() && !listItem.Spec.Path.Transformers.IsUnknown() {
+							var transformersLevel4Items []string
+							diags := listItem.Spec.Path.Transformers.ElementsAs(ctx, &transformersLevel4Items, false)
+							if !diags.HasError() {
+								pathLevel4Map["transformers"] = transformersLevel4Items
+							}
+						}
+						specDeepMap["path"] = pathLevel4Map
+					}
+					if listItem.Spec.PortMatcher != nil {
+						port_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.PortMatcher.InvertMatcher.IsNull() && !listItem.Spec.PortMatcher.InvertMatcher.IsUnknown() {
+							port_matcherLevel4Map["invert_matcher"] = listItem.Spec.PortMatcher.InvertMatcher.ValueBool()
+						}
+						if !listItem.Spec.PortMatcher.Ports.IsNull() && !listItem.Spec.PortMatcher.Ports.IsUnknown() {
+							var portsLevel4Items []string
+							diags := listItem.Spec.PortMatcher.Ports.ElementsAs(ctx, &portsLevel4Items, false)
+							if !diags.HasError() {
+								port_matcherLevel4Map["ports"] = portsLevel4Items
+							}
+						}
+						specDeepMap["port_matcher"] = port_matcherLevel4Map
+					}
+					if listItem.Spec.RequestConstraints != nil {
+						request_constraintsLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.RequestConstraints.MaxCookieCountExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxCookieCountExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_cookie_count_exceeds"] = listItem.Spec.RequestConstraints.MaxCookieCountExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxCookieCountNone != nil {
+							request_constraintsLevel4Map["max_cookie_count_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxCookieKeySizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxCookieKeySizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_cookie_key_size_exceeds"] = listItem.Spec.RequestConstraints.MaxCookieKeySizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxCookieKeySizeNone != nil {
+							request_constraintsLevel4Map["max_cookie_key_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxCookieValueSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxCookieValueSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_cookie_value_size_exceeds"] = listItem.Spec.RequestConstraints.MaxCookieValueSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxCookieValueSizeNone != nil {
+							request_constraintsLevel4Map["max_cookie_value_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxHeaderCountExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxHeaderCountExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_header_count_exceeds"] = listItem.Spec.RequestConstraints.MaxHeaderCountExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxHeaderCountNone != nil {
+							request_constraintsLevel4Map["max_header_count_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxHeaderKeySizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxHeaderKeySizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_header_key_size_exceeds"] = listItem.Spec.RequestConstraints.MaxHeaderKeySizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxHeaderKeySizeNone != nil {
+							request_constraintsLevel4Map["max_header_key_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxHeaderValueSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxHeaderValueSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_header_value_size_exceeds"] = listItem.Spec.RequestConstraints.MaxHeaderValueSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxHeaderValueSizeNone != nil {
+							request_constraintsLevel4Map["max_header_value_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxParameterCountExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxParameterCountExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_parameter_count_exceeds"] = listItem.Spec.RequestConstraints.MaxParameterCountExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxParameterCountNone != nil {
+							request_constraintsLevel4Map["max_parameter_count_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxParameterNameSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxParameterNameSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_parameter_name_size_exceeds"] = listItem.Spec.RequestConstraints.MaxParameterNameSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxParameterNameSizeNone != nil {
+							request_constraintsLevel4Map["max_parameter_name_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxParameterValueSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxParameterValueSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_parameter_value_size_exceeds"] = listItem.Spec.RequestConstraints.MaxParameterValueSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxParameterValueSizeNone != nil {
+							request_constraintsLevel4Map["max_parameter_value_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxQuerySizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxQuerySizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_query_size_exceeds"] = listItem.Spec.RequestConstraints.MaxQuerySizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxQuerySizeNone != nil {
+							request_constraintsLevel4Map["max_query_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxRequestLineSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxRequestLineSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_request_line_size_exceeds"] = listItem.Spec.RequestConstraints.MaxRequestLineSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxRequestLineSizeNone != nil {
+							request_constraintsLevel4Map["max_request_line_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxRequestSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxRequestSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_request_size_exceeds"] = listItem.Spec.RequestConstraints.MaxRequestSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxRequestSizeNone != nil {
+							request_constraintsLevel4Map["max_request_size_none"] = map[string]interface{}{}
+						}
+						if !listItem.Spec.RequestConstraints.MaxURLSizeExceeds.IsNull() && !listItem.Spec.RequestConstraints.MaxURLSizeExceeds.IsUnknown() {
+							request_constraintsLevel4Map["max_url_size_exceeds"] = listItem.Spec.RequestConstraints.MaxURLSizeExceeds.ValueInt64()
+						}
+						if listItem.Spec.RequestConstraints.MaxURLSizeNone != nil {
+							request_constraintsLevel4Map["max_url_size_none"] = map[string]interface{}{}
+						}
+						specDeepMap["request_constraints"] = request_constraintsLevel4Map
+					}
+					if listItem.Spec.SegmentPolicy != nil {
+						segment_policyLevel4Map := make(map[string]interface{})
+						if listItem.Spec.SegmentPolicy.DstAny != nil {
+							segment_policyLevel4Map["dst_any"] = map[string]interface{}{}
+						}
+						if listItem.Spec.SegmentPolicy.DstSegments != nil {
+							dst_segmentsLevel5Map := make(map[string]interface{})
+							segment_policyLevel4Map["dst_segments"] = dst_segmentsLevel5Map
+						}
+						if listItem.Spec.SegmentPolicy.IntraSegment != nil {
+							segment_policyLevel4Map["intra_segment"] = map[string]interface{}{}
+						}
+						if listItem.Spec.SegmentPolicy.SrcAny != nil {
+							segment_policyLevel4Map["src_any"] = map[string]interface{}{}
+						}
+						if listItem.Spec.SegmentPolicy.SrcSegments != nil {
+							src_segmentsLevel5Map := make(map[string]interface{})
+							segment_policyLevel4Map["src_segments"] = src_segmentsLevel5Map
+						}
+						specDeepMap["segment_policy"] = segment_policyLevel4Map
+					}
+					if listItem.Spec.TLSFingerprintMatcher != nil {
+						tls_fingerprint_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.TLSFingerprintMatcher.Classes.IsNull() && !listItem.Spec.TLSFingerprintMatcher.Classes.IsUnknown() {
+							var classesLevel4Items []string
+							diags := listItem.Spec.TLSFingerprintMatcher.Classes.ElementsAs(ctx, &classesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["classes"] = classesLevel4Items
+							}
+						}
+						if !listItem.Spec.TLSFingerprintMatcher.ExactValues.IsNull() && !listItem.Spec.TLSFingerprintMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.TLSFingerprintMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.TLSFingerprintMatcher.ExcludedValues.IsNull() && !listItem.Spec.TLSFingerprintMatcher.ExcludedValues.IsUnknown() {
+							var excluded_valuesLevel4Items []string
+							diags := listItem.Spec.TLSFingerprintMatcher.ExcludedValues.ElementsAs(ctx, &excluded_valuesLevel4Items, false)
+							if !diags.HasError() {
+								tls_fingerprint_matcherLevel4Map["excluded_values"] = excluded_valuesLevel4Items
+							}
+						}
+						specDeepMap["tls_fingerprint_matcher"] = tls_fingerprint_matcherLevel4Map
+					}
+					if listItem.Spec.UserIdentityMatcher != nil {
+						user_identity_matcherLevel4Map := make(map[string]interface{})
+						if !listItem.Spec.UserIdentityMatcher.ExactValues.IsNull() && !listItem.Spec.UserIdentityMatcher.ExactValues.IsUnknown() {
+							var exact_valuesLevel4Items []string
+							diags := listItem.Spec.UserIdentityMatcher.ExactValues.ElementsAs(ctx, &exact_valuesLevel4Items, false)
+							if !diags.HasError() {
+								user_identity_matcherLevel4Map["exact_values"] = exact_valuesLevel4Items
+							}
+						}
+						if !listItem.Spec.UserIdentityMatcher.RegexValues.IsNull() && !listItem.Spec.UserIdentityMatcher.RegexValues.IsUnknown() {
+							var regex_valuesLevel4Items []string
+							diags := listItem.Spec.UserIdentityMatcher.RegexValues.ElementsAs(ctx, &regex_valuesLevel4Items, false)
+							if !diags.HasError() {
+								user_identity_matcherLevel4Map["regex_values"] = regex_valuesLevel4Items
+							}
+						}
+						specDeepMap["user_identity_matcher"] = user_identity_matcherLevel4Map
+					}
+					if listItem.Spec.WAFAction != nil {
+						waf_actionLevel4Map := make(map[string]interface{})
+						if listItem.Spec.WAFAction.AppFirewallDetectionControl != nil {
+							app_firewall_detection_controlLevel5Map := make(map[string]interface{})
+							waf_actionLevel4Map["app_firewall_detection_control"] = app_firewall_detection_controlLevel5Map
+						}
+						if listItem.Spec.WAFAction.None != nil {
+							waf_actionLevel4Map["none"] = map[string]interface{}{}
+						}
+						if listItem.Spec.WAFAction.WAFSkipProcessing != nil {
+							waf_actionLevel4Map["waf_skip_processing"] = map[string]interface{}{}
+						}
+						specDeepMap["waf_action"] = waf_actionLevel4Map
+					}
 					listItemMap["spec"] = specDeepMap
 				}
 				rulesList = append(rulesList, listItemMap)
@@ -3982,7 +5330,7 @@ func (r *ServicePolicyResource) Update(ctx context.Context, req resource.UpdateR
 
 	_, err := r.client.UpdateServicePolicy(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ServicePolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_policy", "update"))
 		return
 	}
 
@@ -3993,9 +5341,12 @@ func (r *ServicePolicyResource) Update(ctx context.Context, req resource.UpdateR
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetServicePolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ServicePolicy after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "service_policy", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["server_name"].(string); ok && v != "" {
@@ -4560,7 +5911,7 @@ func (r *ServicePolicyResource) Delete(ctx context.Context, req resource.DeleteR
 	err := r.client.DeleteServicePolicy(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "ServicePolicy already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -4569,37 +5920,33 @@ func (r *ServicePolicyResource) Delete(ctx context.Context, req resource.DeleteR
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "ServicePolicy delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ServicePolicy: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_policy", "delete"))
 		return
 	}
 }
 
 func (r *ServicePolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *ServicePolicyResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("service_policy", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *ServicePolicyResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListServicePolicies(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *ServicePolicyResource) requiredNamespace() string {
+	return ""
 }
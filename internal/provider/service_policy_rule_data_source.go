@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,49 @@ type ServicePolicyRuleDataSource struct {
 	client *client.Client
 }
 
+// ServicePolicyRuleDataSourceModel mirrors ServicePolicyRuleResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type ServicePolicyRuleDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata        types.Object                                 `tfsdk:"system_metadata"`
+	Name                  types.String                                 `tfsdk:"name"`
+	Namespace             types.String                                 `tfsdk:"namespace"`
+	Annotations           types.Map                                    `tfsdk:"annotations"`
+	Description           types.String                                 `tfsdk:"description"`
+	Disable               types.Bool                                   `tfsdk:"disable"`
+	Labels                types.Map                                    `tfsdk:"labels"`
+	ID                    types.String                                 `tfsdk:"id"`
+	Action                types.String                                 `tfsdk:"action"`
+	ClientName            types.String                                 `tfsdk:"client_name"`
+	ExpirationTimestamp   types.String                                 `tfsdk:"expiration_timestamp"`
+	AnyAsn                *ServicePolicyRuleEmptyModel                 `tfsdk:"any_asn"`
+	AnyClient             *ServicePolicyRuleEmptyModel                 `tfsdk:"any_client"`
+	AnyIP                 *ServicePolicyRuleEmptyModel                 `tfsdk:"any_ip"`
+	APIGroupMatcher       *ServicePolicyRuleAPIGroupMatcherModel       `tfsdk:"api_group_matcher"`
+	ArgMatchers           types.List                                   `tfsdk:"arg_matchers"`
+	AsnList               *ServicePolicyRuleAsnListModel               `tfsdk:"asn_list"`
+	AsnMatcher            *ServicePolicyRuleAsnMatcherModel            `tfsdk:"asn_matcher"`
+	BodyMatcher           *ServicePolicyRuleBodyMatcherModel           `tfsdk:"body_matcher"`
+	BotAction             *ServicePolicyRuleBotActionModel             `tfsdk:"bot_action"`
+	ClientNameMatcher     *ServicePolicyRuleClientNameMatcherModel     `tfsdk:"client_name_matcher"`
+	ClientSelector        *ServicePolicyRuleClientSelectorModel        `tfsdk:"client_selector"`
+	CookieMatchers        types.List                                   `tfsdk:"cookie_matchers"`
+	DomainMatcher         *ServicePolicyRuleDomainMatcherModel         `tfsdk:"domain_matcher"`
+	Headers               types.List                                   `tfsdk:"headers"`
+	HTTPMethod            *ServicePolicyRuleHTTPMethodModel            `tfsdk:"http_method"`
+	IPMatcher             *ServicePolicyRuleIPMatcherModel             `tfsdk:"ip_matcher"`
+	IPPrefixList          *ServicePolicyRuleIPPrefixListModel          `tfsdk:"ip_prefix_list"`
+	IPThreatCategoryList  *ServicePolicyRuleIPThreatCategoryListModel  `tfsdk:"ip_threat_category_list"`
+	Ja4TLSFingerprint     *ServicePolicyRuleJa4TLSFingerprintModel     `tfsdk:"ja4_tls_fingerprint"`
+	JWTClaims             types.List                                   `tfsdk:"jwt_claims"`
+	LabelMatcher          *ServicePolicyRuleLabelMatcherModel          `tfsdk:"label_matcher"`
+	MumAction             *ServicePolicyRuleMumActionModel             `tfsdk:"mum_action"`
+	Path                  *ServicePolicyRulePathModel                  `tfsdk:"path"`
+	PortMatcher           *ServicePolicyRulePortMatcherModel           `tfsdk:"port_matcher"`
+	QueryParams           types.List                                   `tfsdk:"query_params"`
+	RequestConstraints    *ServicePolicyRuleRequestConstraintsModel    `tfsdk:"request_constraints"`
+	SegmentPolicy         *ServicePolicyRuleSegmentPolicyModel         `tfsdk:"segment_policy"`
+	TLSFingerprintMatcher *ServicePolicyRuleTLSFingerprintMatcherModel `tfsdk:"tls_fingerprint_matcher"`
+	WAFAction             *ServicePolicyRuleWAFActionModel             `tfsdk:"waf_action"`
 }
 
 func (d *ServicePolicyRuleDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +76,7 @@ func (d *ServicePolicyRuleDataSource) Metadata(ctx context.Context, req datasour
 }
 
 func (d *ServicePolicyRuleDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages service_policy_rule creates a new object in the storage backend for metadata.namespace. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the ServicePolicyRule.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the ServicePolicyRule exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the ServicePolicyRule.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewServicePolicyRuleResource())
 }
 
 func (d *ServicePolicyRuleDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +98,25 @@ func (d *ServicePolicyRuleDataSource) Read(ctx context.Context, req datasource.R
 		return
 	}
 
-	resource, err := d.client.GetServicePolicyRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetServicePolicyRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ServicePolicyRule: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_policy_rule", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +130,8 @@ func (d *ServicePolicyRuleDataSource) Read(ctx context.Context, req datasource.R
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +140,1446 @@ func (d *ServicePolicyRuleDataSource) Read(ctx context.Context, req datasource.R
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["any_asn"].(map[string]interface{}); ok && isImport && data.AnyAsn == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AnyAsn = &ServicePolicyRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["any_client"].(map[string]interface{}); ok && isImport && data.AnyClient == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AnyClient = &ServicePolicyRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["any_ip"].(map[string]interface{}); ok && isImport && data.AnyIP == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.AnyIP = &ServicePolicyRuleEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["api_group_matcher"].(map[string]interface{}); ok && (isImport || data.APIGroupMatcher != nil) {
+		data.APIGroupMatcher = &ServicePolicyRuleAPIGroupMatcherModel{
+			InvertMatcher: func() types.Bool {
+				if !isImport && data.APIGroupMatcher != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.APIGroupMatcher.InvertMatcher
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["invert_matcher"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			Match: func() types.List {
+				if v, ok := blockData["match"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if listData, ok := apiResource.Spec["arg_matchers"].([]interface{}); ok && len(listData) > 0 {
+		var arg_matchersList []ServicePolicyRuleArgMatchersModel
+		var existingArgMatchersItems []ServicePolicyRuleArgMatchersModel
+		if !data.ArgMatchers.IsNull() && !data.ArgMatchers.IsUnknown() {
+			data.ArgMatchers.ElementsAs(ctx, &existingArgMatchersItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				arg_matchersList = append(arg_matchersList, ServicePolicyRuleArgMatchersModel{
+					CheckNotPresent: func() *ServicePolicyRuleEmptyModel {
+						if !isImport && len(existingArgMatchersItems) > listIdx && existingArgMatchersItems[listIdx].CheckNotPresent != nil {
+							return &ServicePolicyRuleEmptyModel{}
+						}
+						return nil
+					}(),
+					CheckPresent: func() *ServicePolicyRuleEmptyModel {
+						if !isImport && len(existingArgMatchersItems) > listIdx && existingArgMatchersItems[listIdx].CheckPresent != nil {
+							return &ServicePolicyRuleEmptyModel{}
+						}
+						return nil
+					}(),
+					InvertMatcher: func() types.Bool {
+						if v, ok := itemMap["invert_matcher"].(bool); ok {
+							return types.BoolValue(v)
+						}
+						return types.BoolNull()
+					}(),
+					Item: func() *ServicePolicyRuleArgMatchersItemModel {
+						if nestedMap, ok := itemMap["item"].(map[string]interface{}); ok {
+							return &ServicePolicyRuleArgMatchersItemModel{
+								ExactValues: func() types.List {
+									if v, ok := nestedMap["exact_values"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+								RegexValues: func() types.List {
+									if v, ok := nestedMap["regex_values"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+								Transformers: func() types.List {
+									if v, ok := nestedMap["transformers"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+							}
+						}
+						return nil
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ServicePolicyRuleArgMatchersModelAttrTypes}, arg_matchersList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.ArgMatchers = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.ArgMatchers = types.ListNull(types.ObjectType{AttrTypes: ServicePolicyRuleArgMatchersModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["asn_list"].(map[string]interface{}); ok && (isImport || data.AsnList != nil) {
+		data.AsnList = &ServicePolicyRuleAsnListModel{
+			AsNumbers: func() types.List {
+				if v, ok := blockData["as_numbers"].([]interface{}); ok && len(v) > 0 {
+					var items []int64
+					for _, item := range v {
+						if n, ok := item.(float64); ok {
+							items = append(items, int64(n))
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.Int64Type, items)
+					return listVal
+				}
+				return types.ListNull(types.Int64Type)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["asn_matcher"].(map[string]interface{}); ok && (isImport || data.AsnMatcher != nil) {
+		data.AsnMatcher = &ServicePolicyRuleAsnMatcherModel{
+			AsnSets: func() []ServicePolicyRuleAsnMatcherAsnSetsModel {
+				if listData, ok := blockData["asn_sets"].([]interface{}); ok && len(listData) > 0 {
+					var result []ServicePolicyRuleAsnMatcherAsnSetsModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, ServicePolicyRuleAsnMatcherAsnSetsModel{
+								Kind: func() types.String {
+									if v, ok := itemMap["kind"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := itemMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := itemMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Uid: func() types.String {
+									if v, ok := itemMap["uid"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["body_matcher"].(map[string]interface{}); ok && (isImport || data.BodyMatcher != nil) {
+		data.BodyMatcher = &ServicePolicyRuleBodyMatcherModel{
+			ExactValues: func() types.List {
+				if v, ok := blockData["exact_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			RegexValues: func() types.List {
+				if v, ok := blockData["regex_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			Transformers: func() types.List {
+				if v, ok := blockData["transformers"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["bot_action"].(map[string]interface{}); ok && isImport && data.BotAction == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.BotAction = &ServicePolicyRuleBotActionModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["client_name_matcher"].(map[string]interface{}); ok && (isImport || data.ClientNameMatcher != nil) {
+		data.ClientNameMatcher = &ServicePolicyRuleClientNameMatcherModel{
+			ExactValues: func() types.List {
+				if v, ok := blockData["exact_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			RegexValues: func() types.List {
+				if v, ok := blockData["regex_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["client_selector"].(map[string]interface{}); ok && (isImport || data.ClientSelector != nil) {
+		data.ClientSelector = &ServicePolicyRuleClientSelectorModel{
+			Expressions: func() types.List {
+				if v, ok := blockData["expressions"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if listData, ok := apiResource.Spec["cookie_matchers"].([]interface{}); ok && len(listData) > 0 {
+		var cookie_matchersList []ServicePolicyRuleCookieMatchersModel
+		var existingCookieMatchersItems []ServicePolicyRuleCookieMatchersModel
+		if !data.CookieMatchers.IsNull() && !data.CookieMatchers.IsUnknown() {
+			data.CookieMatchers.ElementsAs(ctx, &existingCookieMatchersItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				cookie_matchersList = append(cookie_matchersList, ServicePolicyRuleCookieMatchersModel{
+					CheckNotPresent: func() *ServicePolicyRuleEmptyModel {
+						if !isImport && len(existingCookieMatchersItems) > listIdx && existingCookieMatchersItems[listIdx].CheckNotPresent != nil {
+							return &ServicePolicyRuleEmptyModel{}
+						}
+						return nil
+					}(),
+					CheckPresent: func() *ServicePolicyRuleEmptyModel {
+						if !isImport && len(existingCookieMatchersItems) > listIdx && existingCookieMatchersItems[listIdx].CheckPresent != nil {
+							return &ServicePolicyRuleEmptyModel{}
+						}
+						return nil
+					}(),
+					InvertMatcher: func() types.Bool {
+						if v, ok := itemMap["invert_matcher"].(bool); ok {
+							return types.BoolValue(v)
+						}
+						return types.BoolNull()
+					}(),
+					Item: func() *ServicePolicyRuleCookieMatchersItemModel {
+						if nestedMap, ok := itemMap["item"].(map[string]interface{}); ok {
+							return &ServicePolicyRuleCookieMatchersItemModel{
+								ExactValues: func() types.List {
+									if v, ok := nestedMap["exact_values"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+								RegexValues: func() types.List {
+									if v, ok := nestedMap["regex_values"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+								Transformers: func() types.List {
+									if v, ok := nestedMap["transformers"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+							}
+						}
+						return nil
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ServicePolicyRuleCookieMatchersModelAttrTypes}, cookie_matchersList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.CookieMatchers = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.CookieMatchers = types.ListNull(types.ObjectType{AttrTypes: ServicePolicyRuleCookieMatchersModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["domain_matcher"].(map[string]interface{}); ok && (isImport || data.DomainMatcher != nil) {
+		data.DomainMatcher = &ServicePolicyRuleDomainMatcherModel{
+			ExactValues: func() types.List {
+				if v, ok := blockData["exact_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			RegexValues: func() types.List {
+				if v, ok := blockData["regex_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if listData, ok := apiResource.Spec["headers"].([]interface{}); ok && len(listData) > 0 {
+		var headersList []ServicePolicyRuleHeadersModel
+		var existingHeadersItems []ServicePolicyRuleHeadersModel
+		if !data.Headers.IsNull() && !data.Headers.IsUnknown() {
+			data.Headers.ElementsAs(ctx, &existingHeadersItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				headersList = append(headersList, ServicePolicyRuleHeadersModel{
+					CheckNotPresent: func() *ServicePolicyRuleEmptyModel {
+						if !isImport && len(existingHeadersItems) > listIdx && existingHeadersItems[listIdx].CheckNotPresent != nil {
+							return &ServicePolicyRuleEmptyModel{}
+						}
+						return nil
+					}(),
+					CheckPresent: func() *ServicePolicyRuleEmptyModel {
+						if !isImport && len(existingHeadersItems) > listIdx && existingHeadersItems[listIdx].CheckPresent != nil {
+							return &ServicePolicyRuleEmptyModel{}
+						}
+						return nil
+					}(),
+					InvertMatcher: func() types.Bool {
+						if v, ok := itemMap["invert_matcher"].(bool); ok {
+							return types.BoolValue(v)
+						}
+						return types.BoolNull()
+					}(),
+					Item: func() *ServicePolicyRuleHeadersItemModel {
+						if nestedMap, ok := itemMap["item"].(map[string]interface{}); ok {
+							return &ServicePolicyRuleHeadersItemModel{
+								ExactValues: func() types.List {
+									if v, ok := nestedMap["exact_values"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+								RegexValues: func() types.List {
+									if v, ok := nestedMap["regex_values"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+								Transformers: func() types.List {
+									if v, ok := nestedMap["transformers"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+							}
+						}
+						return nil
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ServicePolicyRuleHeadersModelAttrTypes}, headersList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.Headers = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.Headers = types.ListNull(types.ObjectType{AttrTypes: ServicePolicyRuleHeadersModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["http_method"].(map[string]interface{}); ok && (isImport || data.HTTPMethod != nil) {
+		data.HTTPMethod = &ServicePolicyRuleHTTPMethodModel{
+			InvertMatcher: func() types.Bool {
+				if !isImport && data.HTTPMethod != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.HTTPMethod.InvertMatcher
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["invert_matcher"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			Methods: func() types.List {
+				if v, ok := blockData["methods"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["ip_matcher"].(map[string]interface{}); ok && (isImport || data.IPMatcher != nil) {
+		data.IPMatcher = &ServicePolicyRuleIPMatcherModel{
+			InvertMatcher: func() types.Bool {
+				if !isImport && data.IPMatcher != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.IPMatcher.InvertMatcher
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["invert_matcher"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			PrefixSets: func() []ServicePolicyRuleIPMatcherPrefixSetsModel {
+				if listData, ok := blockData["prefix_sets"].([]interface{}); ok && len(listData) > 0 {
+					var result []ServicePolicyRuleIPMatcherPrefixSetsModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, ServicePolicyRuleIPMatcherPrefixSetsModel{
+								Kind: func() types.String {
+									if v, ok := itemMap["kind"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := itemMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := itemMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Uid: func() types.String {
+									if v, ok := itemMap["uid"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["ip_prefix_list"].(map[string]interface{}); ok && (isImport || data.IPPrefixList != nil) {
+		data.IPPrefixList = &ServicePolicyRuleIPPrefixListModel{
+			InvertMatch: func() types.Bool {
+				if !isImport && data.IPPrefixList != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.IPPrefixList.InvertMatch
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["invert_match"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			IPPrefixes: func() types.List {
+				if v, ok := blockData["ip_prefixes"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["ip_threat_category_list"].(map[string]interface{}); ok && (isImport || data.IPThreatCategoryList != nil) {
+		data.IPThreatCategoryList = &ServicePolicyRuleIPThreatCategoryListModel{
+			IPThreatCategories: func() types.List {
+				if v, ok := blockData["ip_threat_categories"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["ja4_tls_fingerprint"].(map[string]interface{}); ok && (isImport || data.Ja4TLSFingerprint != nil) {
+		data.Ja4TLSFingerprint = &ServicePolicyRuleJa4TLSFingerprintModel{
+			ExactValues: func() types.List {
+				if v, ok := blockData["exact_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if listData, ok := apiResource.Spec["jwt_claims"].([]interface{}); ok && len(listData) > 0 {
+		var jwt_claimsList []ServicePolicyRuleJWTClaimsModel
+		var existingJWTClaimsItems []ServicePolicyRuleJWTClaimsModel
+		if !data.JWTClaims.IsNull() && !data.JWTClaims.IsUnknown() {
+			data.JWTClaims.ElementsAs(ctx, &existingJWTClaimsItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				jwt_claimsList = append(jwt_claimsList, ServicePolicyRuleJWTClaimsModel{
+					CheckNotPresent: func() *ServicePolicyRuleEmptyModel {
+						if !isImport && len(existingJWTClaimsItems) > listIdx && existingJWTClaimsItems[listIdx].CheckNotPresent != nil {
+							return &ServicePolicyRuleEmptyModel{}
+						}
+						return nil
+					}(),
+					CheckPresent: func() *ServicePolicyRuleEmptyModel {
+						if !isImport && len(existingJWTClaimsItems) > listIdx && existingJWTClaimsItems[listIdx].CheckPresent != nil {
+							return &ServicePolicyRuleEmptyModel{}
+						}
+						return nil
+					}(),
+					InvertMatcher: func() types.Bool {
+						if v, ok := itemMap["invert_matcher"].(bool); ok {
+							return types.BoolValue(v)
+						}
+						return types.BoolNull()
+					}(),
+					Item: func() *ServicePolicyRuleJWTClaimsItemModel {
+						if nestedMap, ok := itemMap["item"].(map[string]interface{}); ok {
+							return &ServicePolicyRuleJWTClaimsItemModel{
+								ExactValues: func() types.List {
+									if v, ok := nestedMap["exact_values"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+								RegexValues: func() types.List {
+									if v, ok := nestedMap["regex_values"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+								Transformers: func() types.List {
+									if v, ok := nestedMap["transformers"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+							}
+						}
+						return nil
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ServicePolicyRuleJWTClaimsModelAttrTypes}, jwt_claimsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.JWTClaims = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.JWTClaims = types.ListNull(types.ObjectType{AttrTypes: ServicePolicyRuleJWTClaimsModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["label_matcher"].(map[string]interface{}); ok && (isImport || data.LabelMatcher != nil) {
+		data.LabelMatcher = &ServicePolicyRuleLabelMatcherModel{
+			Keys: func() types.List {
+				if v, ok := blockData["keys"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["mum_action"].(map[string]interface{}); ok && isImport && data.MumAction == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.MumAction = &ServicePolicyRuleMumActionModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["path"].(map[string]interface{}); ok && (isImport || data.Path != nil) {
+		data.Path = &ServicePolicyRulePathModel{
+			ExactValues: func() types.List {
+				if v, ok := blockData["exact_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			InvertMatcher: func() types.Bool {
+				if !isImport && data.Path != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.Path.InvertMatcher
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["invert_matcher"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			PrefixValues: func() types.List {
+				if v, ok := blockData["prefix_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			RegexValues: func() types.List {
+				if v, ok := blockData["regex_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			SuffixValues: func() types.List {
+				if v, ok := blockData["suffix_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			Transformers: func() types.List {
+				if v, ok := blockData["transformers"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["port_matcher"].(map[string]interface{}); ok && (isImport || data.PortMatcher != nil) {
+		data.PortMatcher = &ServicePolicyRulePortMatcherModel{
+			InvertMatcher: func() types.Bool {
+				if !isImport && data.PortMatcher != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.PortMatcher.InvertMatcher
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["invert_matcher"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			Ports: func() types.List {
+				if v, ok := blockData["ports"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if listData, ok := apiResource.Spec["query_params"].([]interface{}); ok && len(listData) > 0 {
+		var query_paramsList []ServicePolicyRuleQueryParamsModel
+		var existingQueryParamsItems []ServicePolicyRuleQueryParamsModel
+		if !data.QueryParams.IsNull() && !data.QueryParams.IsUnknown() {
+			data.QueryParams.ElementsAs(ctx, &existingQueryParamsItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				query_paramsList = append(query_paramsList, ServicePolicyRuleQueryParamsModel{
+					CheckNotPresent: func() *ServicePolicyRuleEmptyModel {
+						if !isImport && len(existingQueryParamsItems) > listIdx && existingQueryParamsItems[listIdx].CheckNotPresent != nil {
+							return &ServicePolicyRuleEmptyModel{}
+						}
+						return nil
+					}(),
+					CheckPresent: func() *ServicePolicyRuleEmptyModel {
+						if !isImport && len(existingQueryParamsItems) > listIdx && existingQueryParamsItems[listIdx].CheckPresent != nil {
+							return &ServicePolicyRuleEmptyModel{}
+						}
+						return nil
+					}(),
+					InvertMatcher: func() types.Bool {
+						if v, ok := itemMap["invert_matcher"].(bool); ok {
+							return types.BoolValue(v)
+						}
+						return types.BoolNull()
+					}(),
+					Item: func() *ServicePolicyRuleQueryParamsItemModel {
+						if nestedMap, ok := itemMap["item"].(map[string]interface{}); ok {
+							return &ServicePolicyRuleQueryParamsItemModel{
+								ExactValues: func() types.List {
+									if v, ok := nestedMap["exact_values"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+								RegexValues: func() types.List {
+									if v, ok := nestedMap["regex_values"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+								Transformers: func() types.List {
+									if v, ok := nestedMap["transformers"].([]interface{}); ok && len(v) > 0 {
+										var items []string
+										for _, item := range v {
+											if s, ok := item.(string); ok {
+												items = append(items, s)
+											}
+										}
+										listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+										return listVal
+									}
+									return types.ListNull(types.StringType)
+								}(),
+							}
+						}
+						return nil
+					}(),
+					Key: func() types.String {
+						if v, ok := itemMap["key"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: ServicePolicyRuleQueryParamsModelAttrTypes}, query_paramsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.QueryParams = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.QueryParams = types.ListNull(types.ObjectType{AttrTypes: ServicePolicyRuleQueryParamsModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["request_constraints"].(map[string]interface{}); ok && (isImport || data.RequestConstraints != nil) {
+		data.RequestConstraints = &ServicePolicyRuleRequestConstraintsModel{
+			MaxCookieCountExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxCookieCountExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_cookie_count_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxCookieCountNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxCookieCountNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_cookie_count_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			MaxCookieKeySizeExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxCookieKeySizeExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_cookie_key_size_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxCookieKeySizeNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxCookieKeySizeNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_cookie_key_size_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			MaxCookieValueSizeExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxCookieValueSizeExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_cookie_value_size_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxCookieValueSizeNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxCookieValueSizeNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_cookie_value_size_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			MaxHeaderCountExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxHeaderCountExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_header_count_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxHeaderCountNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxHeaderCountNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_header_count_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			MaxHeaderKeySizeExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxHeaderKeySizeExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_header_key_size_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxHeaderKeySizeNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxHeaderKeySizeNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_header_key_size_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			MaxHeaderValueSizeExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxHeaderValueSizeExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_header_value_size_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxHeaderValueSizeNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxHeaderValueSizeNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_header_value_size_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			MaxParameterCountExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxParameterCountExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_parameter_count_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxParameterCountNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxParameterCountNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_parameter_count_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			MaxParameterNameSizeExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxParameterNameSizeExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_parameter_name_size_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxParameterNameSizeNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxParameterNameSizeNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_parameter_name_size_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			MaxParameterValueSizeExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxParameterValueSizeExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_parameter_value_size_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxParameterValueSizeNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxParameterValueSizeNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_parameter_value_size_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			MaxQuerySizeExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxQuerySizeExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_query_size_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxQuerySizeNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxQuerySizeNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_query_size_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			MaxRequestLineSizeExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxRequestLineSizeExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_request_line_size_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxRequestLineSizeNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxRequestLineSizeNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_request_line_size_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			MaxRequestSizeExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxRequestSizeExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_request_size_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxRequestSizeNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxRequestSizeNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_request_size_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+			MaxURLSizeExceeds: func() types.Int64 {
+				if !isImport && data.RequestConstraints != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RequestConstraints.MaxURLSizeExceeds
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_url_size_exceeds"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			MaxURLSizeNone: func() *ServicePolicyRuleEmptyModel {
+				if !isImport && data.RequestConstraints != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.RequestConstraints.MaxURLSizeNone
+				}
+				// Import case: read from API
+				if _, ok := blockData["max_url_size_none"].(map[string]interface{}); ok {
+					return &ServicePolicyRuleEmptyModel{}
+				}
+				return nil
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["segment_policy"].(map[string]interface{}); ok && isImport && data.SegmentPolicy == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SegmentPolicy = &ServicePolicyRuleSegmentPolicyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["tls_fingerprint_matcher"].(map[string]interface{}); ok && (isImport || data.TLSFingerprintMatcher != nil) {
+		data.TLSFingerprintMatcher = &ServicePolicyRuleTLSFingerprintMatcherModel{
+			Classes: func() types.List {
+				if v, ok := blockData["classes"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			ExactValues: func() types.List {
+				if v, ok := blockData["exact_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			ExcludedValues: func() types.List {
+				if v, ok := blockData["excluded_values"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["waf_action"].(map[string]interface{}); ok && isImport && data.WAFAction == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.WAFAction = &ServicePolicyRuleWAFActionModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["action"].(string); ok && v != "" {
+		data.Action = types.StringValue(v)
+	} else {
+		data.Action = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["client_name"].(string); ok && v != "" {
+		data.ClientName = types.StringValue(v)
+	} else {
+		data.ClientName = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["expiration_timestamp"].(string); ok && v != "" {
+		data.ExpirationTimestamp = types.StringValue(v)
+	} else {
+		data.ExpirationTimestamp = types.StringNull()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +22,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &ServicePolicyRuleResource{}
-	_ resource.ResourceWithConfigure      = &ServicePolicyRuleResource{}
-	_ resource.ResourceWithImportState    = &ServicePolicyRuleResource{}
-	_ resource.ResourceWithModifyPlan     = &ServicePolicyRuleResource{}
-	_ resource.ResourceWithValidateConfig = &ServicePolicyRuleResource{}
+	_ resource.Resource                     = &ServicePolicyRuleResource{}
+	_ resource.ResourceWithConfigure        = &ServicePolicyRuleResource{}
+	_ resource.ResourceWithImportState      = &ServicePolicyRuleResource{}
+	_ resource.ResourceWithIdentity         = &ServicePolicyRuleResource{}
+	_ resource.ResourceWithMoveState        = &ServicePolicyRuleResource{}
+	_ resource.ResourceWithModifyPlan       = &ServicePolicyRuleResource{}
+	_ resource.ResourceWithValidateConfig   = &ServicePolicyRuleResource{}
+	_ resource.ResourceWithConfigValidators = &ServicePolicyRuleResource{}
+	_ listableResource                      = &ServicePolicyRuleResource{}
 )
 
 func NewServicePolicyRuleResource() resource.Resource {
@@ -677,6 +684,8 @@ type ServicePolicyRuleResourceModel struct {
 	ClientName            types.String                                 `tfsdk:"client_name"`
 	ExpirationTimestamp   types.String                                 `tfsdk:"expiration_timestamp"`
 	Timeouts              timeouts.Value                               `tfsdk:"timeouts"`
+	SystemMetadata        types.Object                                 `tfsdk:"system_metadata"`
+	LabelsAll             types.Map                                    `tfsdk:"labels_all"`
 	AnyAsn                *ServicePolicyRuleEmptyModel                 `tfsdk:"any_asn"`
 	AnyClient             *ServicePolicyRuleEmptyModel                 `tfsdk:"any_client"`
 	AnyIP                 *ServicePolicyRuleEmptyModel                 `tfsdk:"any_ip"`
@@ -727,9 +736,11 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Service Policy Rule will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Service Policy Rule will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -776,6 +787,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(256),
+				},
 			},
 			"expiration_timestamp": schema.StringAttribute{
 				MarkdownDescription: "Specifies expiration_timestamp the RFC 3339 format timestamp at which the containing rule is considered to be logically expired. The rule continues to exist in the configuration but is not applied anymore.",
@@ -785,6 +799,8 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -813,11 +829,20 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "List of exact values to match the input against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(64),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(63)),
+						},
 					},
 				},
 			},
 			"arg_matchers": schema.ListNestedBlock{
 				MarkdownDescription: "List of predicates for all POST args that need to be matched. The criteria for matching each arg are described in individual instances of ArgMatcherType. The actual arg values are extracted from the request API as a list of strings for each arg selector name.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(16),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"invert_matcher": schema.BoolAttribute{
@@ -827,6 +852,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						"name": schema.StringAttribute{
 							MarkdownDescription: "Case-sensitive JSON path in the HTTP request body.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -843,16 +871,30 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "List of exact values to match the input against.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(64),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+									},
 								},
 								"regex_values": schema.ListAttribute{
 									MarkdownDescription: "List of regular expressions to match the input against.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+									},
 								},
 								"transformers": schema.ListAttribute{
 									MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(9),
+										listvalidator.UniqueValues(),
+									},
 								},
 							},
 						},
@@ -866,6 +908,11 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "Unordered set of RFC 6793 defined 4-byte AS numbers that can be used to create allow or deny lists for use in network policy or service policy. It can be used to create the allow list only for DNS Load Balancer.",
 						Optional:            true,
 						ElementType:         types.Int64Type,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+						},
 					},
 				},
 			},
@@ -875,6 +922,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 				Blocks: map[string]schema.Block{
 					"asn_sets": schema.ListNestedBlock{
 						MarkdownDescription: "List of references to bgp_asn_set objects.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(4),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"kind": schema.StringAttribute{
@@ -925,16 +975,30 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "List of exact values to match the input against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(64),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 					"regex_values": schema.ListAttribute{
 						MarkdownDescription: "List of regular expressions to match the input against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+						},
 					},
 					"transformers": schema.ListAttribute{
 						MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(9),
+							listvalidator.UniqueValues(),
+						},
 					},
 				},
 			},
@@ -957,11 +1021,21 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "List of exact values to match the input against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(64),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 					"regex_values": schema.ListAttribute{
 						MarkdownDescription: "List of regular expressions to match the input against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+						},
 					},
 				},
 			},
@@ -972,11 +1046,19 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "Expressions contains the Kubernetes style label expression for selections.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(4096), stringvalidator.UTF8LengthAtLeast(1)),
+						},
 					},
 				},
 			},
 			"cookie_matchers": schema.ListNestedBlock{
 				MarkdownDescription: "List of predicates for all cookies that need to be matched. The criteria for matching each cookie is described in individual instances of CookieMatcherType. The actual cookie values are extracted from the request API as a list of strings for each cookie name.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(16),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"invert_matcher": schema.BoolAttribute{
@@ -986,6 +1068,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						"name": schema.StringAttribute{
 							MarkdownDescription: "Case-sensitive cookie name.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -1002,16 +1087,30 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "List of exact values to match the input against.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(64),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+									},
 								},
 								"regex_values": schema.ListAttribute{
 									MarkdownDescription: "List of regular expressions to match the input against.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+									},
 								},
 								"transformers": schema.ListAttribute{
 									MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(9),
+										listvalidator.UniqueValues(),
+									},
 								},
 							},
 						},
@@ -1025,16 +1124,30 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "List of exact values to match the input against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(64),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 					"regex_values": schema.ListAttribute{
 						MarkdownDescription: "List of regular expressions to match the input against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+						},
 					},
 				},
 			},
 			"headers": schema.ListNestedBlock{
 				MarkdownDescription: "List of predicates for various HTTP headers that need to match. The criteria for matching each HTTP header are described in individual HeaderMatcherType instances. The actual HTTP header values are extracted from the request API as a list of strings for each HTTP header type.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(16),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"invert_matcher": schema.BoolAttribute{
@@ -1044,6 +1157,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						"name": schema.StringAttribute{
 							MarkdownDescription: "Case-insensitive HTTP header name.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -1060,16 +1176,30 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "List of exact values to match the input against.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(64),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+									},
 								},
 								"regex_values": schema.ListAttribute{
 									MarkdownDescription: "List of regular expressions to match the input against.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+									},
 								},
 								"transformers": schema.ListAttribute{
 									MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(9),
+										listvalidator.UniqueValues(),
+									},
 								},
 							},
 						},
@@ -1087,6 +1217,10 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "[Enum: ANY|GET|HEAD|POST|PUT|DELETE|CONNECT|OPTIONS|TRACE|PATCH|COPY] List of methods values to match against. Possible values are `ANY`, `GET`, `HEAD`, `POST`, `PUT`, `DELETE`, `CONNECT`, `OPTIONS`, `TRACE`, `PATCH`, `COPY`. Defaults to `ANY`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+						},
 					},
 				},
 			},
@@ -1101,6 +1235,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 				Blocks: map[string]schema.Block{
 					"prefix_sets": schema.ListNestedBlock{
 						MarkdownDescription: "List of references to ip_prefix_set objects.",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(4),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"kind": schema.StringAttribute{
@@ -1155,6 +1292,11 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "IPv4 Prefix List. List of IPv4 prefix strings.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(128),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(validators.IPv4PrefixValidator(), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 				},
 			},
@@ -1165,6 +1307,10 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "[Enum: SPAM_SOURCES|WINDOWS_EXPLOITS|WEB_ATTACKS|BOTNETS|SCANNERS|REPUTATION|PHISHING|PROXY|MOBILE_THREATS|TOR_PROXY|DENIAL_OF_SERVICE|NETWORK] The IP threat categories is obtained from the list and is used to auto-generate equivalent label selection expressions . Possible values are `SPAM_SOURCES`, `WINDOWS_EXPLOITS`, `WEB_ATTACKS`, `BOTNETS`, `SCANNERS`, `REPUTATION`, `PHISHING`, `PROXY`, `MOBILE_THREATS`, `TOR_PROXY`, `DENIAL_OF_SERVICE`, `NETWORK`. Defaults to `SPAM_SOURCES`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+							listvalidator.UniqueValues(),
+						},
 					},
 				},
 			},
@@ -1175,11 +1321,20 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "List of exact JA4 TLS fingerprint to match the input JA4 TLS fingerprint against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(36, 36)),
+						},
 					},
 				},
 			},
 			"jwt_claims": schema.ListNestedBlock{
 				MarkdownDescription: "List of predicates for various JWT claims that need to match. The criteria for matching each JWT claim are described in individual JWTClaimMatcherType instances. The actual JWT claims values are extracted from the JWT payload as a list of strings.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(16),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"invert_matcher": schema.BoolAttribute{
@@ -1189,6 +1344,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						"name": schema.StringAttribute{
 							MarkdownDescription: "JWT Claim Name. JWT claim name.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -1205,16 +1363,30 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "List of exact values to match the input against.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(64),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+									},
 								},
 								"regex_values": schema.ListAttribute{
 									MarkdownDescription: "List of regular expressions to match the input against.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+									},
 								},
 								"transformers": schema.ListAttribute{
 									MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(9),
+										listvalidator.UniqueValues(),
+									},
 								},
 							},
 						},
@@ -1228,6 +1400,11 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "The list of label key names that have to match.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(64), stringvalidator.UTF8LengthAtLeast(1)),
+						},
 					},
 				},
 			},
@@ -1250,6 +1427,11 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "List of exact path values to match the input HTTP path against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 					"invert_matcher": schema.BoolAttribute{
 						MarkdownDescription: "Invert Path Matcher. Invert the match result.",
@@ -1259,21 +1441,40 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "List of path prefix values to match the input HTTP path against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 					"regex_values": schema.ListAttribute{
 						MarkdownDescription: "List of regular expressions to match the input HTTP path against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+						},
 					},
 					"suffix_values": schema.ListAttribute{
 						MarkdownDescription: "List of path suffix values to match the input HTTP path against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(64),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(64), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 					"transformers": schema.ListAttribute{
 						MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(9),
+							listvalidator.UniqueValues(),
+						},
 					},
 				},
 			},
@@ -1288,11 +1489,19 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "List of strings, each of which is a single port value or a tuple of start and end port values separated by '-'. The start and end values are considered to be part of the range.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+						},
 					},
 				},
 			},
 			"query_params": schema.ListNestedBlock{
 				MarkdownDescription: "List of predicates for all query parameters that need to be matched. The criteria for matching each query parameter are described in individual instances of QueryParameterMatcherType. The actual query parameter values are extracted from the request API as a list of strings for each query..",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(16),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"invert_matcher": schema.BoolAttribute{
@@ -1302,6 +1511,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						"key": schema.StringAttribute{
 							MarkdownDescription: "Case-sensitive HTTP query parameter name.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(256),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -1318,16 +1530,30 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 									MarkdownDescription: "List of exact values to match the input against.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(64),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+									},
 								},
 								"regex_values": schema.ListAttribute{
 									MarkdownDescription: "List of regular expressions to match the input against.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(16),
+										listvalidator.UniqueValues(),
+										listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+									},
 								},
 								"transformers": schema.ListAttribute{
 									MarkdownDescription: "[Enum: LOWER_CASE|UPPER_CASE|BASE64_DECODE|NORMALIZE_PATH|REMOVE_WHITESPACE|URL_DECODE|TRIM_LEFT|TRIM_RIGHT|TRIM] Ordered list of transformers (starting from index 0) to be applied to the path before matching. Possible values are `LOWER_CASE`, `UPPER_CASE`, `BASE64_DECODE`, `NORMALIZE_PATH`, `REMOVE_WHITESPACE`, `URL_DECODE`, `TRIM_LEFT`, `TRIM_RIGHT`, `TRIM`. Defaults to `TRANSFORMER_NONE`.",
 									Optional:            true,
 									ElementType:         types.StringType,
+									Validators: []validator.List{
+										listvalidator.SizeAtMost(9),
+										listvalidator.UniqueValues(),
+									},
 								},
 							},
 						},
@@ -1340,54 +1566,106 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 					"max_cookie_count_exceeds": schema.Int64Attribute{
 						MarkdownDescription: "Match on the Count for all Cookies that exceed this value.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(1024),
+						},
 					},
 					"max_cookie_key_size_exceeds": schema.Int64Attribute{
 						MarkdownDescription: ".",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(1024),
+						},
 					},
 					"max_cookie_value_size_exceeds": schema.Int64Attribute{
 						MarkdownDescription: ".",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(32768),
+						},
 					},
 					"max_header_count_exceeds": schema.Int64Attribute{
 						MarkdownDescription: "Match on the Count for all Headers that exceed this value.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(40),
+						},
 					},
 					"max_header_key_size_exceeds": schema.Int64Attribute{
 						MarkdownDescription: ".",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(1024),
+						},
 					},
 					"max_header_value_size_exceeds": schema.Int64Attribute{
 						MarkdownDescription: ".",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(64000),
+						},
 					},
 					"max_parameter_count_exceeds": schema.Int64Attribute{
 						MarkdownDescription: ".",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(1024),
+						},
 					},
 					"max_parameter_name_size_exceeds": schema.Int64Attribute{
 						MarkdownDescription: ".",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(1024),
+						},
 					},
 					"max_parameter_value_size_exceeds": schema.Int64Attribute{
 						MarkdownDescription: ".",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(1073741824),
+						},
 					},
 					"max_query_size_exceeds": schema.Int64Attribute{
 						MarkdownDescription: "Match on the URL Query Size that exceed this value.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(60000),
+						},
 					},
 					"max_request_line_size_exceeds": schema.Int64Attribute{
 						MarkdownDescription: ".",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(65536),
+						},
 					},
 					"max_request_size_exceeds": schema.Int64Attribute{
 						MarkdownDescription: "Match on the Request Size that exceed this value.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(65536),
+						},
 					},
 					"max_url_size_exceeds": schema.Int64Attribute{
 						MarkdownDescription: "Match on the URL Size that exceed this value.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(128000),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -1450,6 +1728,10 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1458,6 +1740,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1466,6 +1751,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -1489,6 +1777,10 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1497,6 +1789,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1505,6 +1800,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -1520,16 +1818,30 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						MarkdownDescription: "[Enum: TLS_FINGERPRINT_NONE|ANY_MALICIOUS_FINGERPRINT|ADWARE|ADWIND|DRIDEX|GOOTKIT|GOZI|JBIFROST|QUAKBOT|RANSOMWARE|TROLDESH|TOFSEE|TORRENTLOCKER|TRICKBOT] List of known classes of TLS fingerprints to match the input TLS JA3 fingerprint against. Possible values are `TLS_FINGERPRINT_NONE`, `ANY_MALICIOUS_FINGERPRINT`, `ADWARE`, `ADWIND`, `DRIDEX`, `GOOTKIT`, `GOZI`, `JBIFROST`, `QUAKBOT`, `RANSOMWARE`, `TROLDESH`, `TOFSEE`, `TORRENTLOCKER`, `TRICKBOT`. Defaults to `TLS_FINGERPRINT_NONE`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+						},
 					},
 					"exact_values": schema.ListAttribute{
 						MarkdownDescription: "List of exact TLS JA3 fingerprints to match the input TLS JA3 fingerprint against.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+						},
 					},
 					"excluded_values": schema.ListAttribute{
 						MarkdownDescription: "List of TLS JA3 fingerprints to be excluded when matching the input TLS JA3 fingerprint. This can be used to skip known false positives when using one or more known TLS fingerprint classes in the enclosing matcher.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthBetween(32, 32)),
+						},
 					},
 				},
 			},
@@ -1543,6 +1855,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 						Blocks: map[string]schema.Block{
 							"exclude_attack_type_contexts": schema.ListNestedBlock{
 								MarkdownDescription: "Attack Types to be excluded for the defined match criteria.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(64),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"context": schema.StringAttribute{
@@ -1552,6 +1867,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 										"context_name": schema.StringAttribute{
 											MarkdownDescription: "Relevant only for contexts: Header, Cookie and Parameter. Name of the Context that the WAF Exclusion Rules will check. Wildcard matching can be used by prefixing or suffixing the context name with an wildcard asterisk (*).",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"exclude_attack_type": schema.StringAttribute{
 											MarkdownDescription: "[Enum: ATTACK_TYPE_NONE|ATTACK_TYPE_NON_BROWSER_CLIENT|ATTACK_TYPE_OTHER_APPLICATION_ATTACKS|ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE|ATTACK_TYPE_DETECTION_EVASION|ATTACK_TYPE_VULNERABILITY_SCAN|ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY|ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS|ATTACK_TYPE_BUFFER_OVERFLOW|ATTACK_TYPE_PREDICTABLE_RESOURCE_LOCATION|ATTACK_TYPE_INFORMATION_LEAKAGE|ATTACK_TYPE_DIRECTORY_INDEXING|ATTACK_TYPE_PATH_TRAVERSAL|ATTACK_TYPE_XPATH_INJECTION|ATTACK_TYPE_LDAP_INJECTION|ATTACK_TYPE_SERVER_SIDE_CODE_INJECTION|ATTACK_TYPE_COMMAND_EXECUTION|ATTACK_TYPE_SQL_INJECTION|ATTACK_TYPE_CROSS_SITE_SCRIPTING|ATTACK_TYPE_DENIAL_OF_SERVICE|ATTACK_TYPE_HTTP_PARSER_ATTACK|ATTACK_TYPE_SESSION_HIJACKING|ATTACK_TYPE_HTTP_RESPONSE_SPLITTING|ATTACK_TYPE_FORCEFUL_BROWSING|ATTACK_TYPE_REMOTE_FILE_INCLUDE|ATTACK_TYPE_MALICIOUS_FILE_UPLOAD|ATTACK_TYPE_GRAPHQL_PARSER_ATTACK] List of all Attack Types ATTACK_TYPE_NONE ATTACK_TYPE_NON_BROWSER_CLIENT ATTACK_TYPE_OTHER_APPLICATION_ATTACKS ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE ATTACK_TYPE_DETECTION_EVASION ATTACK_TYPE_VULNERABILITY_SCAN ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS.. Possible values are `ATTACK_TYPE_NONE`, `ATTACK_TYPE_NON_BROWSER_CLIENT`, `ATTACK_TYPE_OTHER_APPLICATION_ATTACKS`, `ATTACK_TYPE_TROJAN_BACKDOOR_SPYWARE`, `ATTACK_TYPE_DETECTION_EVASION`, `ATTACK_TYPE_VULNERABILITY_SCAN`, `ATTACK_TYPE_ABUSE_OF_FUNCTIONALITY`, `ATTACK_TYPE_AUTHENTICATION_AUTHORIZATION_ATTACKS`, `ATTACK_TYPE_BUFFER_OVERFLOW`, `ATTACK_TYPE_PREDICTABLE_RESOURCE_LOCATION`, `ATTACK_TYPE_INFORMATION_LEAKAGE`, `ATTACK_TYPE_DIRECTORY_INDEXING`, `ATTACK_TYPE_PATH_TRAVERSAL`, `ATTACK_TYPE_XPATH_INJECTION`, `ATTACK_TYPE_LDAP_INJECTION`, `ATTACK_TYPE_SERVER_SIDE_CODE_INJECTION`, `ATTACK_TYPE_COMMAND_EXECUTION`, `ATTACK_TYPE_SQL_INJECTION`, `ATTACK_TYPE_CROSS_SITE_SCRIPTING`, `ATTACK_TYPE_DENIAL_OF_SERVICE`, `ATTACK_TYPE_HTTP_PARSER_ATTACK`, `ATTACK_TYPE_SESSION_HIJACKING`, `ATTACK_TYPE_HTTP_RESPONSE_SPLITTING`, `ATTACK_TYPE_FORCEFUL_BROWSING`, `ATTACK_TYPE_REMOTE_FILE_INCLUDE`, `ATTACK_TYPE_MALICIOUS_FILE_UPLOAD`, `ATTACK_TYPE_GRAPHQL_PARSER_ATTACK`. Defaults to `ATTACK_TYPE_NONE`.",
@@ -1562,6 +1880,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 							},
 							"exclude_bot_name_contexts": schema.ListNestedBlock{
 								MarkdownDescription: "Bot Names to be excluded for the defined match criteria.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(64),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"bot_name": schema.StringAttribute{
@@ -1573,6 +1894,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 							},
 							"exclude_signature_contexts": schema.ListNestedBlock{
 								MarkdownDescription: "Signature IDs to be excluded for the defined match criteria.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1024),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"context": schema.StringAttribute{
@@ -1582,16 +1906,26 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 										"context_name": schema.StringAttribute{
 											MarkdownDescription: "Relevant only for contexts: Header, Cookie and Parameter. Name of the Context that the WAF Exclusion Rules will check. Wildcard matching can be used by prefixing or suffixing the context name with an wildcard asterisk (*).",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"signature_id": schema.Int64Attribute{
 											MarkdownDescription: "The allowed values for signature ID are 0 and in the range of 200000001-299999999. 0 implies that all signatures will be excluded for the specified context.",
 											Optional:            true,
+											Validators: []validator.Int64{
+												int64validator.AtLeast(0),
+												int64validator.AtMost(299999999),
+											},
 										},
 									},
 								},
 							},
 							"exclude_violation_contexts": schema.ListNestedBlock{
 								MarkdownDescription: "Violations to be excluded for the defined match criteria.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(64),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"context": schema.StringAttribute{
@@ -1601,6 +1935,9 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 										"context_name": schema.StringAttribute{
 											MarkdownDescription: "Relevant only for contexts: Header, Cookie and Parameter. Name of the Context that the WAF Exclusion Rules will check. Wildcard matching can be used by prefixing or suffixing the context name with an wildcard asterisk (*).",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.UTF8LengthAtMost(128),
+											},
 										},
 										"exclude_violation": schema.StringAttribute{
 											MarkdownDescription: "[Enum: VIOL_NONE|VIOL_FILETYPE|VIOL_METHOD|VIOL_MANDATORY_HEADER|VIOL_HTTP_RESPONSE_STATUS|VIOL_REQUEST_MAX_LENGTH|VIOL_FILE_UPLOAD|VIOL_FILE_UPLOAD_IN_BODY|VIOL_XML_MALFORMED|VIOL_JSON_MALFORMED|VIOL_ASM_COOKIE_MODIFIED|VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS|VIOL_HTTP_PROTOCOL_BAD_HOST_HEADER_VALUE|VIOL_HTTP_PROTOCOL_UNPARSABLE_REQUEST_CONTENT|VIOL_HTTP_PROTOCOL_NULL_IN_REQUEST|VIOL_HTTP_PROTOCOL_BAD_HTTP_VERSION|VIOL_HTTP_PROTOCOL_SEVERAL_CONTENT_LENGTH_HEADERS|VIOL_EVASION_DIRECTORY_TRAVERSALS|VIOL_MALFORMED_REQUEST|VIOL_EVASION_MULTIPLE_DECODING|VIOL_DATA_GUARD|VIOL_EVASION_APACHE_WHITESPACE|VIOL_COOKIE_MODIFIED|VIOL_EVASION_IIS_UNICODE_CODEPOINTS|VIOL_EVASION_IIS_BACKSLASHES|VIOL_EVASION_PERCENT_U_DECODING|VIOL_EVASION_BARE_BYTE_DECODING|VIOL_EVASION_BAD_UNESCAPE|VIOL_HTTP_PROTOCOL_BODY_IN_GET_OR_HEAD_REQUEST|VIOL_ENCODING|VIOL_COOKIE_MALFORMED|VIOL_GRAPHQL_FORMAT|VIOL_GRAPHQL_MALFORMED|VIOL_GRAPHQL_INTROSPECTION_QUERY] List of all supported Violation Types VIOL_NONE VIOL_FILETYPE VIOL_METHOD VIOL_MANDATORY_HEADER VIOL_HTTP_RESPONSE_STATUS VIOL_REQUEST_MAX_LENGTH VIOL_FILE_UPLOAD VIOL_FILE_UPLOAD_IN_BODY VIOL_XML_MALFORMED VIOL_JSON_MALFORMED VIOL_ASM_COOKIE_MODIFIED VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS.. Possible values are `VIOL_NONE`, `VIOL_FILETYPE`, `VIOL_METHOD`, `VIOL_MANDATORY_HEADER`, `VIOL_HTTP_RESPONSE_STATUS`, `VIOL_REQUEST_MAX_LENGTH`, `VIOL_FILE_UPLOAD`, `VIOL_FILE_UPLOAD_IN_BODY`, `VIOL_XML_MALFORMED`, `VIOL_JSON_MALFORMED`, `VIOL_ASM_COOKIE_MODIFIED`, `VIOL_HTTP_PROTOCOL_MULTIPLE_HOST_HEADERS`, `VIOL_HTTP_PROTOCOL_BAD_HOST_HEADER_VALUE`, `VIOL_HTTP_PROTOCOL_UNPARSABLE_REQUEST_CONTENT`, `VIOL_HTTP_PROTOCOL_NULL_IN_REQUEST`, `VIOL_HTTP_PROTOCOL_BAD_HTTP_VERSION`, `VIOL_HTTP_PROTOCOL_SEVERAL_CONTENT_LENGTH_HEADERS`, `VIOL_EVASION_DIRECTORY_TRAVERSALS`, `VIOL_MALFORMED_REQUEST`, `VIOL_EVASION_MULTIPLE_DECODING`, `VIOL_DATA_GUARD`, `VIOL_EVASION_APACHE_WHITESPACE`, `VIOL_COOKIE_MODIFIED`, `VIOL_EVASION_IIS_UNICODE_CODEPOINTS`, `VIOL_EVASION_IIS_BACKSLASHES`, `VIOL_EVASION_PERCENT_U_DECODING`, `VIOL_EVASION_BARE_BYTE_DECODING`, `VIOL_EVASION_BAD_UNESCAPE`, `VIOL_HTTP_PROTOCOL_BODY_IN_GET_OR_HEAD_REQUEST`, `VIOL_ENCODING`, `VIOL_COOKIE_MALFORMED`, `VIOL_GRAPHQL_FORMAT`, `VIOL_GRAPHQL_MALFORMED`, `VIOL_GRAPHQL_INTROSPECTION_QUERY`. Defaults to `VIOL_NONE`.",
@@ -1623,6 +1960,11 @@ func (r *ServicePolicyRuleResource) Schema(ctx context.Context, req resource.Sch
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *ServicePolicyRuleResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *ServicePolicyRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -1647,6 +1989,40 @@ func (r *ServicePolicyRuleResource) ValidateConfig(ctx context.Context, req reso
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *ServicePolicyRuleResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "asn_choice", "any_asn", "asn_list", "asn_matcher"),
+		validators.OneOfGroup(path.MatchRelative(), "client_choice", "any_client", "client_name", "client_name_matcher", "client_selector", "ip_threat_category_list"),
+		validators.OneOfGroup(path.MatchRelative(), "ip_choice", "any_ip", "ip_matcher", "ip_prefix_list"),
+		validators.OneOfGroup(path.MatchRelative(), "tls_fingerprint_choice", "ja4_tls_fingerprint", "tls_fingerprint_matcher"),
+		validators.OneOfGroup(path.MatchRoot("arg_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("bot_action"), "action_type", "bot_skip_processing", "none"),
+		validators.OneOfGroup(path.MatchRoot("cookie_matchers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("headers").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("jwt_claims").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.OneOfGroup(path.MatchRoot("mum_action"), "action_type", "default", "skip_processing"),
+		validators.OneOfGroup(path.MatchRoot("query_params").AtAnyListIndex(), "match", "check_not_present", "check_present", "item"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_cookie_count_choice", "max_cookie_count_exceeds", "max_cookie_count_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_cookie_key_size_choice", "max_cookie_key_size_exceeds", "max_cookie_key_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_cookie_value_size_choice", "max_cookie_value_size_exceeds", "max_cookie_value_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_header_count_choice", "max_header_count_exceeds", "max_header_count_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_header_key_size_choice", "max_header_key_size_exceeds", "max_header_key_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_header_value_size_choice", "max_header_value_size_exceeds", "max_header_value_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_parameter_count_choice", "max_parameter_count_exceeds", "max_parameter_count_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_parameter_name_size_choice", "max_parameter_name_size_exceeds", "max_parameter_name_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_parameter_value_size_choice", "max_parameter_value_size_exceeds", "max_parameter_value_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_query_size_choice", "max_query_size_exceeds", "max_query_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_request_line_size_choice", "max_request_line_size_exceeds", "max_request_line_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_request_size_choice", "max_request_size_exceeds", "max_request_size_none"),
+		validators.RequiredOneOfGroup(path.MatchRoot("request_constraints"), "max_url_size_choice", "max_url_size_exceeds", "max_url_size_none"),
+		validators.OneOfGroup(path.MatchRoot("segment_policy"), "dst_segment_choice", "dst_any", "dst_segments", "intra_segment"),
+		validators.OneOfGroup(path.MatchRoot("segment_policy"), "src_segment_choice", "src_any", "src_segments"),
+		validators.OneOfGroup(path.MatchRoot("waf_action"), "action_type", "app_firewall_detection_control", "none", "waf_skip_processing"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *ServicePolicyRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -1657,7 +2033,10 @@ func (r *ServicePolicyRuleResource) ModifyPlan(ctx context.Context, req resource
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "service_policy_rule", "", req.Config, &resp.Plan)...)
 		var plan ServicePolicyRuleResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -1714,6 +2093,7 @@ func (r *ServicePolicyRuleResource) Create(ctx context.Context, req resource.Cre
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1723,6 +2103,7 @@ func (r *ServicePolicyRuleResource) Create(ctx context.Context, req resource.Cre
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AnyAsn != nil {
@@ -2432,11 +2813,15 @@ func (r *ServicePolicyRuleResource) Create(ctx context.Context, req resource.Cre
 
 	apiResource, err := r.client.CreateServicePolicyRule(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create ServicePolicyRule: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_policy_rule", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -3902,7 +4287,7 @@ func (r *ServicePolicyRuleResource) Read(ctx context.Context, req resource.ReadR
 	apiResource, err := r.client.GetServicePolicyRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "ServicePolicyRule not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -3910,11 +4295,12 @@ func (r *ServicePolicyRuleResource) Read(ctx context.Context, req resource.ReadR
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ServicePolicyRule: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_policy_rule", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -3925,31 +4311,13 @@ func (r *ServicePolicyRuleResource) Read(ctx context.Context, req resource.ReadR
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -3957,6 +4325,7 @@ func (r *ServicePolicyRuleResource) Read(ctx context.Context, req resource.ReadR
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["any_asn"].(map[string]interface{}); ok && isImport && data.AnyAsn == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -5434,6 +5803,7 @@ func (r *ServicePolicyRuleResource) Update(ctx context.Context, req resource.Upd
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -5443,6 +5813,7 @@ func (r *ServicePolicyRuleResource) Update(ctx context.Context, req resource.Upd
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AnyAsn != nil {
@@ -6152,7 +6523,7 @@ func (r *ServicePolicyRuleResource) Update(ctx context.Context, req resource.Upd
 
 	_, err := r.client.UpdateServicePolicyRule(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update ServicePolicyRule: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_policy_rule", "update"))
 		return
 	}
 
@@ -6163,9 +6534,12 @@ func (r *ServicePolicyRuleResource) Update(ctx context.Context, req resource.Upd
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetServicePolicyRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ServicePolicyRule after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "service_policy_rule", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["action"].(string); ok && v != "" {
@@ -7652,7 +8026,7 @@ func (r *ServicePolicyRuleResource) Delete(ctx context.Context, req resource.Del
 	err := r.client.DeleteServicePolicyRule(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "ServicePolicyRule already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -7661,37 +8035,33 @@ func (r *ServicePolicyRuleResource) Delete(ctx context.Context, req resource.Del
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "ServicePolicyRule delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete ServicePolicyRule: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "service_policy_rule", "delete"))
 		return
 	}
 }
 
 func (r *ServicePolicyRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *ServicePolicyRuleResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("service_policy_rule", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *ServicePolicyRuleResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListServicePolicyRules(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *ServicePolicyRuleResource) requiredNamespace() string {
+	return ""
 }
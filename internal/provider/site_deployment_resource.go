@@ -0,0 +1,429 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// Site Deployment Resource for F5 XC
+// Runs the terraform apply of a cloud site, which deploys the site
+// infrastructure in AWS, Azure or GCP, and the terraform destroy when the
+// resource is destroyed. Each run is waited on through the terraform
+// parameters status of the site.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
+	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
+	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
+)
+
+// Terraform run states reported in the apply status of a site
+const (
+	siteApplyStateApplied          = "APPLIED"
+	siteApplyStateApplyErrored     = "APPLY_ERRORED"
+	siteApplyStateApplyInitErrored = "APPLY_INIT_ERRORED"
+	siteApplyStateApplyPlanErrored = "APPLY_PLAN_ERRORED"
+	siteDestroyStateDestroyed      = "DESTROYED"
+	siteDestroyStateErrored        = "DESTROY_ERRORED"
+
+	// siteRunStateQueued is reported while the status still shows the
+	// previous run
+	siteRunStateQueued = "QUEUED"
+)
+
+// siteDeploymentTypes are the site types deployed by a terraform run
+var siteDeploymentTypes = []string{"aws_vpc_site", "azure_vnet_site", "gcp_vpc_site", "aws_tgw_site"}
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SiteDeploymentResource{}
+	_ resource.ResourceWithConfigure   = &SiteDeploymentResource{}
+	_ resource.ResourceWithImportState = &SiteDeploymentResource{}
+)
+
+func NewSiteDeploymentResource() resource.Resource {
+	return &SiteDeploymentResource{}
+}
+
+type SiteDeploymentResource struct {
+	client *client.Client
+}
+
+type SiteDeploymentResourceModel struct {
+	ID           types.String   `tfsdk:"id"`
+	Namespace    types.String   `tfsdk:"namespace"`
+	SiteType     types.String   `tfsdk:"site_type"`
+	SiteName     types.String   `tfsdk:"site_name"`
+	Triggers     types.Map      `tfsdk:"triggers"`
+	ApplyState   types.String   `tfsdk:"apply_state"`
+	InfraState   types.String   `tfsdk:"infra_state"`
+	TfOutput     types.String   `tfsdk:"tf_output"`
+	WaitForState types.Bool     `tfsdk:"wait_for_state"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *SiteDeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_site_deployment"
+}
+
+func (r *SiteDeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: `Deploys a cloud site in F5 Distributed Cloud.
+
+Creating this resource runs the terraform apply of the site, which deploys the site infrastructure in AWS, Azure or GCP,
+and waits until it is applied. Changing ` + "`triggers`" + ` runs the apply again. Destroying this resource runs the terraform
+destroy of the site and waits until the infrastructure is destroyed. A failed run is reported with the terraform error
+output and suggested action.
+
+The site only comes online after it is deployed, so leave ` + "`wait_for_state`" + ` of the site resource unset and set
+` + "`wait_for_state`" + ` of this resource instead.`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier for the resource.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of the site, usually `system`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.NamespaceValidator(),
+				},
+			},
+			"site_type": schema.StringAttribute{
+				MarkdownDescription: "Type of the site. Possible values: `aws_vpc_site`, `azure_vnet_site`, `gcp_vpc_site`, `aws_tgw_site`.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(siteDeploymentTypes...),
+				},
+			},
+			"site_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the site to deploy.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					validators.NameValidator(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that run the terraform apply of the site again when they change.",
+				ElementType:         types.StringType,
+				Optional:            true,
+			},
+			"apply_state": schema.StringAttribute{
+				MarkdownDescription: "State of the latest terraform apply of the site, e.g. `APPLIED` or `APPLY_ERRORED`.",
+				Computed:            true,
+			},
+			"infra_state": schema.StringAttribute{
+				MarkdownDescription: "State of the site infrastructure. Possible values: `PROVISIONED`, `PROVISIONING`, `TIMED_OUT`, `ERRORED`.",
+				Computed:            true,
+			},
+			"tf_output": schema.StringAttribute{
+				MarkdownDescription: "Output of the latest terraform apply of the site.",
+				Computed:            true,
+			},
+			"wait_for_state": schema.BoolAttribute{
+				MarkdownDescription: "Wait for the site to come online after the terraform apply. When true, create and an " +
+					"apply run by a change of `triggers` wait until the site state is ONLINE, within the create and update " +
+					"timeouts. The provisioning error is reported when the site fails. Defaults to false.",
+				Optional: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *SiteDeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+	r.client = client
+}
+
+// siteApplyStatus returns the apply status of a site, which is empty before
+// the first run
+func siteApplyStatus(status *client.SiteTerraformStatus) client.SiteTerraformApplyStatus {
+	if status.Status.ApplyStatus == nil {
+		return client.SiteTerraformApplyStatus{}
+	}
+	return *status.Status.ApplyStatus
+}
+
+// siteTerraformError adds the terraform error output and suggested action of
+// a failed run to the error returned while waiting for it
+func siteTerraformError(err error, status client.SiteTerraformApplyStatus) error {
+	var details []string
+	if status.ErrorOutput != "" {
+		details = append(details, status.ErrorOutput)
+	}
+	if status.SuggestedAction != "" {
+		details = append(details, "suggested action: "+status.SuggestedAction)
+	}
+	if len(details) == 0 {
+		return err
+	}
+	return fmt.Errorf("%w\n\n%s", err, strings.Join(details, "\n"))
+}
+
+// run starts a terraform run of the site and waits until it reports one of
+// the target states. The status keeps showing the previous run until the new
+// one starts, so it is pending until its modification timestamp changes.
+func (r *SiteDeploymentResource) run(ctx context.Context, data *SiteDeploymentResourceModel, action string) error {
+	namespace, siteType, name := data.Namespace.ValueString(), data.SiteType.ValueString(), data.SiteName.ValueString()
+
+	previous, err := r.client.GetSiteTerraformStatus(ctx, namespace, siteType, name)
+	if err != nil {
+		return err
+	}
+	previousTimestamp := siteApplyStatus(previous).ModificationTimestamp
+
+	tflog.Debug(ctx, "Running site terraform", map[string]interface{}{
+		"site_type": siteType,
+		"name":      name,
+		"namespace": namespace,
+		"action":    action,
+	})
+
+	if err := r.client.RunSiteTerraform(ctx, namespace, siteType, name, action); err != nil {
+		return err
+	}
+
+	target := []string{siteApplyStateApplied}
+	failed := []string{siteApplyStateApplyErrored, siteApplyStateApplyInitErrored, siteApplyStateApplyPlanErrored}
+	if action == client.SiteTerraformActionDestroy {
+		target = []string{siteDestroyStateDestroyed}
+		failed = []string{siteDestroyStateErrored}
+	}
+
+	var last client.SiteTerraformApplyStatus
+	refresh := func(ctx context.Context) (string, error) {
+		status, err := r.client.GetSiteTerraformStatus(ctx, namespace, siteType, name)
+		if err != nil {
+			return "", err
+		}
+		last = siteApplyStatus(status)
+		if last.ModificationTimestamp == previousTimestamp {
+			return siteRunStateQueued, nil
+		}
+		if action == client.SiteTerraformActionDestroy {
+			return last.DestroyState, nil
+		}
+		return last.ApplyState, nil
+	}
+
+	_, err = waitForState(ctx, refresh, target, failed, defaultPollInterval)
+	data.setStatus(last)
+	if err != nil {
+		return siteTerraformError(err, last)
+	}
+	return nil
+}
+
+// siteSpec returns the spec of the deployed site for waitForSiteOnline
+func (r *SiteDeploymentResource) siteSpec(data *SiteDeploymentResourceModel) siteSpecFunc {
+	namespace, siteType, name := data.Namespace.ValueString(), data.SiteType.ValueString(), data.SiteName.ValueString()
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		return r.client.GetSiteSpec(ctx, namespace, siteType, name)
+	}
+}
+
+// setStatus sets the computed attributes from the apply status of the site
+func (data *SiteDeploymentResourceModel) setStatus(status client.SiteTerraformApplyStatus) {
+	data.ApplyState = stringValueOrNull(status.ApplyState)
+	data.InfraState = stringValueOrNull(status.InfraState)
+	data.TfOutput = stringValueOrNull(status.TfOutput)
+}
+
+func (r *SiteDeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SiteDeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := data.Timeouts.Create(ctx, inttimeouts.LongRunningCreate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	if err := r.run(ctx, &data, client.SiteTerraformActionApply); err != nil {
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "site_deployment", "create"))
+		return
+	}
+
+	data.ID = types.StringValue(data.SiteName.ValueString())
+
+	tflog.Trace(ctx, "created SiteDeployment resource")
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	// The deployment is saved before waiting so that a failed wait taints it
+	if data.WaitForState.ValueBool() && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(waitForSiteOnline(ctx, "site_deployment", r.siteSpec(&data))...)
+	}
+}
+
+func (r *SiteDeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SiteDeploymentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := data.Timeouts.Read(ctx, inttimeouts.DefaultRead)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	status, err := r.client.GetSiteTerraformStatus(ctx, data.Namespace.ValueString(), data.SiteType.ValueString(), data.SiteName.ValueString())
+	if err != nil {
+		// Check if the site was deleted outside Terraform
+		if f5xcerrors.IsNotFound(err) {
+			tflog.Warn(ctx, "Site not found, removing deployment from state", map[string]interface{}{
+				"site_type": data.SiteType.ValueString(),
+				"name":      data.SiteName.ValueString(),
+				"namespace": data.Namespace.ValueString(),
+			})
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "site_deployment", "read"))
+		return
+	}
+
+	data.ID = types.StringValue(data.SiteName.ValueString())
+	data.setStatus(siteApplyStatus(status))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SiteDeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data, state SiteDeploymentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := data.Timeouts.Update(ctx, inttimeouts.LongRunningCreate)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	// Only run the apply again when triggers changed, timeouts changes are
+	// stored as is
+	applied := !data.Triggers.Equal(state.Triggers)
+	if !applied {
+		data.ApplyState = state.ApplyState
+		data.InfraState = state.InfraState
+		data.TfOutput = state.TfOutput
+	} else if err := r.run(ctx, &data, client.SiteTerraformActionApply); err != nil {
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "site_deployment", "update"))
+		return
+	}
+
+	data.ID = state.ID
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+
+	if applied && data.WaitForState.ValueBool() && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(waitForSiteOnline(ctx, "site_deployment", r.siteSpec(&data))...)
+	}
+}
+
+// Delete runs the terraform destroy of the site. A site that no longer
+// exists has no infrastructure, so it is treated as already destroyed.
+func (r *SiteDeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SiteDeploymentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, inttimeouts.LongRunningDelete)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if err := r.run(ctx, &data, client.SiteTerraformActionDestroy); err != nil {
+		if f5xcerrors.IsNotFound(err) {
+			tflog.Warn(ctx, "Site already deleted, removing deployment from state", map[string]interface{}{
+				"site_type": data.SiteType.ValueString(),
+				"name":      data.SiteName.ValueString(),
+				"namespace": data.Namespace.ValueString(),
+			})
+			return
+		}
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "site_deployment", "delete"))
+		return
+	}
+}
+
+func (r *SiteDeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import ID format: namespace/site_type/site_name
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Expected import ID format: namespace/site_type/site_name, got: %s", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_type"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("site_name"), parts[2])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), parts[2])...)
+}
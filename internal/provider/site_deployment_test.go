@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+func TestSiteApplyStatus(t *testing.T) {
+	status := &client.SiteTerraformStatus{}
+	if result := siteApplyStatus(status); result != (client.SiteTerraformApplyStatus{}) {
+		t.Errorf("siteApplyStatus() = %+v, want empty status before the first run", result)
+	}
+
+	status.Status.ApplyStatus = &client.SiteTerraformApplyStatus{ApplyState: siteApplyStateApplied}
+	if result := siteApplyStatus(status); result.ApplyState != siteApplyStateApplied {
+		t.Errorf("siteApplyStatus().ApplyState = %q, want %q", result.ApplyState, siteApplyStateApplied)
+	}
+}
+
+func TestSiteTerraformError(t *testing.T) {
+	waitErr := errors.New(`reached state "APPLY_ERRORED"`)
+	tests := []struct {
+		name     string
+		status   client.SiteTerraformApplyStatus
+		expected string
+	}{
+		{"no details", client.SiteTerraformApplyStatus{}, `reached state "APPLY_ERRORED"`},
+		{
+			"error output",
+			client.SiteTerraformApplyStatus{ErrorOutput: "Error: VpcLimitExceeded"},
+			"reached state \"APPLY_ERRORED\"\n\nError: VpcLimitExceeded",
+		},
+		{
+			"error output and suggested action",
+			client.SiteTerraformApplyStatus{ErrorOutput: "Error: VpcLimitExceeded", SuggestedAction: "Request a VPC limit increase"},
+			"reached state \"APPLY_ERRORED\"\n\nError: VpcLimitExceeded\nsuggested action: Request a VPC limit increase",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := siteTerraformError(waitErr, tt.status)
+			if err.Error() != tt.expected {
+				t.Errorf("siteTerraformError() = %q, want %q", err.Error(), tt.expected)
+			}
+			if !errors.Is(err, waitErr) {
+				t.Error("siteTerraformError() does not wrap the wait error")
+			}
+		})
+	}
+}
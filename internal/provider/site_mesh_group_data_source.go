@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,23 @@ type SiteMeshGroupDataSource struct {
 	client *client.Client
 }
 
+// SiteMeshGroupDataSourceModel mirrors SiteMeshGroupResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type SiteMeshGroupDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata    types.Object                 `tfsdk:"system_metadata"`
+	Name              types.String                 `tfsdk:"name"`
+	Namespace         types.String                 `tfsdk:"namespace"`
+	Annotations       types.Map                    `tfsdk:"annotations"`
+	Description       types.String                 `tfsdk:"description"`
+	Disable           types.Bool                   `tfsdk:"disable"`
+	Labels            types.Map                    `tfsdk:"labels"`
+	ID                types.String                 `tfsdk:"id"`
+	DisableREFallback *SiteMeshGroupEmptyModel     `tfsdk:"disable_re_fallback"`
+	EnableREFallback  *SiteMeshGroupEmptyModel     `tfsdk:"enable_re_fallback"`
+	FullMesh          *SiteMeshGroupFullMeshModel  `tfsdk:"full_mesh"`
+	HubMesh           *SiteMeshGroupHubMeshModel   `tfsdk:"hub_mesh"`
+	SpokeMesh         *SiteMeshGroupSpokeMeshModel `tfsdk:"spoke_mesh"`
+	VirtualSite       types.List                   `tfsdk:"virtual_site"`
 }
 
 func (d *SiteMeshGroupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +50,7 @@ func (d *SiteMeshGroupDataSource) Metadata(ctx context.Context, req datasource.M
 }
 
 func (d *SiteMeshGroupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages Site Mesh Group in system namespace of user. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the SiteMeshGroup.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the SiteMeshGroup exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the SiteMeshGroup.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewSiteMeshGroupResource())
 }
 
 func (d *SiteMeshGroupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +72,25 @@ func (d *SiteMeshGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	resource, err := d.client.GetSiteMeshGroup(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetSiteMeshGroup(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read SiteMeshGroup: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "site_mesh_group", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +104,8 @@ func (d *SiteMeshGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +114,87 @@ func (d *SiteMeshGroupDataSource) Read(ctx context.Context, req datasource.ReadR
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if _, ok := apiResource.Spec["disable_re_fallback"].(map[string]interface{}); ok && isImport && data.DisableREFallback == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisableREFallback = &SiteMeshGroupEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["enable_re_fallback"].(map[string]interface{}); ok && isImport && data.EnableREFallback == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.EnableREFallback = &SiteMeshGroupEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["full_mesh"].(map[string]interface{}); ok && isImport && data.FullMesh == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.FullMesh = &SiteMeshGroupFullMeshModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["hub_mesh"].(map[string]interface{}); ok && isImport && data.HubMesh == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.HubMesh = &SiteMeshGroupHubMeshModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["spoke_mesh"].(map[string]interface{}); ok && isImport && data.SpokeMesh == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.SpokeMesh = &SiteMeshGroupSpokeMeshModel{}
+	}
+	// Normal Read: preserve existing state value
+	if listData, ok := apiResource.Spec["virtual_site"].([]interface{}); ok && len(listData) > 0 {
+		var virtual_siteList []SiteMeshGroupVirtualSiteModel
+		var existingVirtualSiteItems []SiteMeshGroupVirtualSiteModel
+		if !data.VirtualSite.IsNull() && !data.VirtualSite.IsUnknown() {
+			data.VirtualSite.ElementsAs(ctx, &existingVirtualSiteItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				virtual_siteList = append(virtual_siteList, SiteMeshGroupVirtualSiteModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: SiteMeshGroupVirtualSiteModelAttrTypes}, virtual_siteList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.VirtualSite = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.VirtualSite = types.ListNull(types.ObjectType{AttrTypes: SiteMeshGroupVirtualSiteModelAttrTypes})
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -0,0 +1,108 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// site_wait_helpers.go - Manually maintained helpers for the cloud and
+// customer edge site resources, which can wait for a site to be provisioned
+// after it is created and decommissioned after it is deleted.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
+)
+
+// Site states reported in the site_state field of the site spec
+const (
+	siteStateOnline                      = "ONLINE"
+	siteStateFailed                      = "FAILED"
+	siteStateErrorInOrchestration        = "ERROR_IN_ORCHESTRATION"
+	siteStateValidationFailed            = "VALIDATION_FAILED"
+	siteStateErrorDeletingCloudResources = "ERROR_DELETING_CLOUD_RESOURCES"
+
+	// siteStateDecommissioned is reported by the refresh function of
+	// waitForSiteDecommissioned once the site no longer exists
+	siteStateDecommissioned = "DECOMMISSIONED"
+)
+
+// siteWaitForStateDescription is the description of the wait_for_state
+// attribute of the site resources
+const siteWaitForStateDescription = "Wait for the site to be provisioned. When true, create waits until the site " +
+	"state is ONLINE and delete waits until the site is decommissioned, within the create and delete timeouts. " +
+	"The provisioning error is reported when the site fails. Defaults to false. Leave it false for a cloud site " +
+	"deployed with `f5xc_site_deployment`, which only comes online after the deployment, and set `wait_for_state` " +
+	"of the deployment instead."
+
+// siteSpecFunc returns the spec of the site being waited on
+type siteSpecFunc func(ctx context.Context) (map[string]interface{}, error)
+
+// waitForSiteOnline waits until the site_state of a site is ONLINE
+func waitForSiteOnline(ctx context.Context, resourceName string, get siteSpecFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var spec map[string]interface{}
+	refresh := func(ctx context.Context) (string, error) {
+		var err error
+		spec, err = get(ctx)
+		if err != nil {
+			return "", err
+		}
+		state, _ := spec["site_state"].(string)
+		return state, nil
+	}
+
+	_, err := waitForState(ctx, refresh,
+		[]string{siteStateOnline},
+		[]string{siteStateFailed, siteStateErrorInOrchestration, siteStateValidationFailed},
+		defaultPollInterval)
+	if err != nil {
+		f5xcerrors.AddError(&diags, f5xcerrors.WrapError(siteProvisioningError(err, spec), resourceName, "create"))
+	}
+	return diags
+}
+
+// waitForSiteDecommissioned waits until a deleted site no longer exists. A
+// site is only removed once its cloud resources are deleted.
+func waitForSiteDecommissioned(ctx context.Context, resourceName string, get siteSpecFunc) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var spec map[string]interface{}
+	refresh := func(ctx context.Context) (string, error) {
+		var err error
+		spec, err = get(ctx)
+		if f5xcerrors.IsNotFound(err) {
+			return siteStateDecommissioned, nil
+		}
+		if err != nil {
+			return "", err
+		}
+		state, _ := spec["site_state"].(string)
+		return state, nil
+	}
+
+	_, err := waitForState(ctx, refresh,
+		[]string{siteStateDecommissioned},
+		[]string{siteStateErrorDeletingCloudResources},
+		defaultPollInterval)
+	if err != nil {
+		f5xcerrors.AddError(&diags, f5xcerrors.WrapError(siteProvisioningError(err, spec), resourceName, "delete"))
+	}
+	return diags
+}
+
+// siteProvisioningError adds the error description and suggested action
+// reported in the site spec to an error returned while waiting for the site
+func siteProvisioningError(err error, spec map[string]interface{}) error {
+	description, _ := spec["error_description"].(string)
+	action, _ := spec["suggested_action"].(string)
+	switch {
+	case description != "" && action != "":
+		return fmt.Errorf("%w: %s (suggested action: %s)", err, description, action)
+	case description != "":
+		return fmt.Errorf("%w: %s", err, description)
+	case action != "":
+		return fmt.Errorf("%w (suggested action: %s)", err, action)
+	}
+	return err
+}
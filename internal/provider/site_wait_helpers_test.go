@@ -0,0 +1,99 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
+)
+
+// staticSiteSpec returns the given spec and error on every call
+func staticSiteSpec(spec map[string]interface{}, err error) siteSpecFunc {
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		return spec, err
+	}
+}
+
+func TestWaitForSiteOnline(t *testing.T) {
+	t.Run("online", func(t *testing.T) {
+		diags := waitForSiteOnline(context.Background(), "aws_vpc_site", staticSiteSpec(map[string]interface{}{
+			"site_state": "ONLINE",
+		}, nil))
+		if diags.HasError() {
+			t.Errorf("waitForSiteOnline() diagnostics: %v", diags)
+		}
+	})
+
+	t.Run("reports provisioning error", func(t *testing.T) {
+		diags := waitForSiteOnline(context.Background(), "aws_vpc_site", staticSiteSpec(map[string]interface{}{
+			"site_state":        "ERROR_IN_ORCHESTRATION",
+			"error_description": "VPC limit exceeded",
+		}, nil))
+		if !diags.HasError() {
+			t.Fatal("waitForSiteOnline() expected error for failed site")
+		}
+		if detail := diags.Errors()[0].Detail(); !strings.Contains(detail, "VPC limit exceeded") {
+			t.Errorf("waitForSiteOnline() detail = %q, want the provisioning error", detail)
+		}
+	})
+}
+
+func TestWaitForSiteDecommissioned(t *testing.T) {
+	t.Run("deleted", func(t *testing.T) {
+		diags := waitForSiteDecommissioned(context.Background(), "aws_vpc_site",
+			staticSiteSpec(nil, f5xcerrors.NewNotFoundError("aws_vpc_site", "example", "system")))
+		if diags.HasError() {
+			t.Errorf("waitForSiteDecommissioned() diagnostics: %v", diags)
+		}
+	})
+
+	t.Run("fails to delete cloud resources", func(t *testing.T) {
+		diags := waitForSiteDecommissioned(context.Background(), "aws_vpc_site", staticSiteSpec(map[string]interface{}{
+			"site_state": "ERROR_DELETING_CLOUD_RESOURCES",
+		}, nil))
+		if !diags.HasError() {
+			t.Error("waitForSiteDecommissioned() expected error for failed delete")
+		}
+	})
+
+	t.Run("returns API error", func(t *testing.T) {
+		diags := waitForSiteDecommissioned(context.Background(), "aws_vpc_site", staticSiteSpec(nil, errors.New("boom")))
+		if !diags.HasError() {
+			t.Error("waitForSiteDecommissioned() expected error for API error")
+		}
+	})
+}
+
+func TestSiteProvisioningError(t *testing.T) {
+	waitErr := errors.New("reached state \"FAILED\"")
+	tests := []struct {
+		name     string
+		spec     map[string]interface{}
+		expected string
+	}{
+		{"no details", nil, `reached state "FAILED"`},
+		{"description", map[string]interface{}{"error_description": "quota exceeded"}, `reached state "FAILED": quota exceeded`},
+		{"suggested action", map[string]interface{}{"suggested_action": "retry"}, `reached state "FAILED" (suggested action: retry)`},
+		{
+			"description and suggested action",
+			map[string]interface{}{"error_description": "quota exceeded", "suggested_action": "retry"},
+			`reached state "FAILED": quota exceeded (suggested action: retry)`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := siteProvisioningError(waitErr, tt.spec)
+			if err.Error() != tt.expected {
+				t.Errorf("siteProvisioningError() = %q, want %q", err.Error(), tt.expected)
+			}
+			if !errors.Is(err, waitErr) {
+				t.Error("siteProvisioningError() does not wrap the wait error")
+			}
+		})
+	}
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// system_metadata_helpers.go - Manually maintained helpers for the computed
+// system_metadata attribute that resources and data sources expose from the
+// system-managed metadata of an object, e.g. for auditing.
+
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+// systemMetadataAttrTypes defines the attribute types of system_metadata
+var systemMetadataAttrTypes = map[string]attr.Type{
+	"uid":                    types.StringType,
+	"creation_timestamp":     types.StringType,
+	"modification_timestamp": types.StringType,
+	"creator_class":          types.StringType,
+	"creator_id":             types.StringType,
+	"tenant":                 types.StringType,
+}
+
+// systemMetadataSchemaAttribute returns the schema of the computed
+// system_metadata attribute. Data sources derive theirs from it.
+func systemMetadataSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "System-managed metadata of the object, maintained by F5 Distributed Cloud.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"uid": schema.StringAttribute{
+				MarkdownDescription: "Unique identifier of the object.",
+				Computed:            true,
+			},
+			"creation_timestamp": schema.StringAttribute{
+				MarkdownDescription: "Time the object was created, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"modification_timestamp": schema.StringAttribute{
+				MarkdownDescription: "Time the object was last modified, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"creator_class": schema.StringAttribute{
+				MarkdownDescription: "Class of the service or user that created the object.",
+				Computed:            true,
+			},
+			"creator_id": schema.StringAttribute{
+				MarkdownDescription: "Identifier of the user or service that created the object.",
+				Computed:            true,
+			},
+			"tenant": schema.StringAttribute{
+				MarkdownDescription: "Tenant that owns the object.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// systemMetadataValue returns the system_metadata value for the system
+// metadata returned by the API, or null when the API returned none
+func systemMetadataValue(m *client.SystemMetadata) types.Object {
+	if m == nil {
+		return types.ObjectNull(systemMetadataAttrTypes)
+	}
+	return types.ObjectValueMust(systemMetadataAttrTypes, map[string]attr.Value{
+		"uid":                    stringValueOrNull(m.UID),
+		"creation_timestamp":     stringValueOrNull(m.CreationTimestamp),
+		"modification_timestamp": stringValueOrNull(m.ModificationTimestamp),
+		"creator_class":          stringValueOrNull(m.CreatorClass),
+		"creator_id":             stringValueOrNull(m.CreatorID),
+		"tenant":                 stringValueOrNull(m.Tenant),
+	})
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+)
+
+func TestSystemMetadataValue(t *testing.T) {
+	if v := systemMetadataValue(nil); !v.IsNull() {
+		t.Errorf("systemMetadataValue(nil) = %v, want null", v)
+	}
+
+	var pool client.OriginPool
+	body := `{
+		"metadata": {"name": "pool", "namespace": "shared"},
+		"system_metadata": {
+			"uid": "c0ffee",
+			"creation_timestamp": "2026-01-02T03:04:05.000000Z",
+			"modification_timestamp": "2026-02-03T04:05:06.000000Z",
+			"creator_class": "prism",
+			"creator_id": "user@example.com",
+			"tenant": "acme-abcdef",
+			"object_index": 7
+		}
+	}`
+	if err := json.Unmarshal([]byte(body), &pool); err != nil {
+		t.Fatalf("Unmarshal() error = %v", err)
+	}
+
+	v := systemMetadataValue(pool.SystemMetadata)
+	attrs := v.Attributes()
+	for name, want := range map[string]string{
+		"uid":                    "c0ffee",
+		"creation_timestamp":     "2026-01-02T03:04:05.000000Z",
+		"modification_timestamp": "2026-02-03T04:05:06.000000Z",
+		"creator_class":          "prism",
+		"creator_id":             "user@example.com",
+		"tenant":                 "acme-abcdef",
+	} {
+		if got := attrs[name].(types.String).ValueString(); got != want {
+			t.Errorf("%s = %q, want %q", name, got, want)
+		}
+	}
+
+	// Fields the API leaves out are null rather than empty strings
+	v = systemMetadataValue(&client.SystemMetadata{UID: "c0ffee"})
+	if !v.Attributes()["creator_id"].IsNull() {
+		t.Error("creator_id is not null when the API did not return it")
+	}
+}
+
+func TestSystemMetadataSchema(t *testing.T) {
+	ctx := context.Background()
+
+	var rresp resource.SchemaResponse
+	NewOriginPoolResource().Schema(ctx, resource.SchemaRequest{}, &rresp)
+	attr, ok := rresp.Schema.Attributes["system_metadata"]
+	if !ok || !attr.IsComputed() || attr.IsOptional() {
+		t.Fatalf("resource system_metadata = %v, want a computed attribute", attr)
+	}
+
+	var dresp datasource.SchemaResponse
+	NewOriginPoolDataSource().Schema(ctx, datasource.SchemaRequest{}, &dresp)
+	if attr, ok := dresp.Schema.Attributes["system_metadata"]; !ok || !attr.IsComputed() {
+		t.Errorf("data source system_metadata = %v, want a computed attribute", attr)
+	}
+}
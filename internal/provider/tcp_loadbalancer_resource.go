@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,17 +24,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &TCPLoadBalancerResource{}
-	_ resource.ResourceWithConfigure      = &TCPLoadBalancerResource{}
-	_ resource.ResourceWithImportState    = &TCPLoadBalancerResource{}
-	_ resource.ResourceWithModifyPlan     = &TCPLoadBalancerResource{}
-	_ resource.ResourceWithValidateConfig = &TCPLoadBalancerResource{}
+	_ resource.Resource                     = &TCPLoadBalancerResource{}
+	_ resource.ResourceWithConfigure        = &TCPLoadBalancerResource{}
+	_ resource.ResourceWithImportState      = &TCPLoadBalancerResource{}
+	_ resource.ResourceWithIdentity         = &TCPLoadBalancerResource{}
+	_ resource.ResourceWithMoveState        = &TCPLoadBalancerResource{}
+	_ resource.ResourceWithModifyPlan       = &TCPLoadBalancerResource{}
+	_ resource.ResourceWithValidateConfig   = &TCPLoadBalancerResource{}
+	_ resource.ResourceWithConfigValidators = &TCPLoadBalancerResource{}
+	_ listableResource                      = &TCPLoadBalancerResource{}
 )
 
 func NewTCPLoadBalancerResource() resource.Resource {
@@ -561,14 +568,16 @@ var TCPLoadBalancerTLSTCPTLSParametersTLSCertificatesPrivateKeyBlindfoldSecretIn
 
 // TCPLoadBalancerTLSTCPTLSParametersTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type TCPLoadBalancerTLSTCPTLSParametersTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // TCPLoadBalancerTLSTCPTLSParametersTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for TCPLoadBalancerTLSTCPTLSParametersTLSCertificatesPrivateKeyClearSecretInfoModel
 var TCPLoadBalancerTLSTCPTLSParametersTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // TCPLoadBalancerTLSTCPTLSParametersTLSConfigModel represents tls_config block
@@ -779,6 +788,8 @@ type TCPLoadBalancerResourceModel struct {
 	ListenPort                         types.Int64                                `tfsdk:"listen_port"`
 	PortRanges                         types.String                               `tfsdk:"port_ranges"`
 	Timeouts                           timeouts.Value                             `tfsdk:"timeouts"`
+	SystemMetadata                     types.Object                               `tfsdk:"system_metadata"`
+	LabelsAll                          types.Map                                  `tfsdk:"labels_all"`
 	ActiveServicePolicies              *TCPLoadBalancerActiveServicePoliciesModel `tfsdk:"active_service_policies"`
 	AdvertiseCustom                    *TCPLoadBalancerAdvertiseCustomModel       `tfsdk:"advertise_custom"`
 	AdvertiseOnPublic                  *TCPLoadBalancerAdvertiseOnPublicModel     `tfsdk:"advertise_on_public"`
@@ -820,9 +831,11 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the TCP Load Balancer will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the TCP Load Balancer will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -846,6 +859,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "List of Domains (host/authority header) that will be matched to this Load Balancer. Supported Domains and search order: 1. Exact Domain names: www.example.com. 2.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+					listvalidator.UniqueValues(),
+				},
 			},
 			"labels": schema.MapAttribute{
 				MarkdownDescription: "Labels is a user defined key value map that can be attached to resources for organization and filtering.",
@@ -874,6 +891,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(4147200000),
+				},
 			},
 			"listen_port": schema.Int64Attribute{
 				MarkdownDescription: "[OneOf: listen_port, port_ranges] Listen Port for this load balancer.",
@@ -882,6 +902,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(65535),
+				},
 			},
 			"port_ranges": schema.StringAttribute{
 				MarkdownDescription: "A string containing a comma separated list of port ranges. Each port range consists of a single port or two ports separated by '-'.",
@@ -890,7 +913,13 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(512),
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -905,11 +934,18 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				Blocks: map[string]schema.Block{
 					"policies": schema.ListNestedBlock{
 						MarkdownDescription: "Service Policies is a sequential engine where policies (and rules within the policy) are evaluated one after the other. It's important to define the correct order (policies evaluated from top to bottom in the list) for service policies, to GET the intended result. For each request, its..",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -918,6 +954,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -926,6 +965,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -938,15 +980,26 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				Blocks: map[string]schema.Block{
 					"advertise_where": schema.ListNestedBlock{
 						MarkdownDescription: "Where should this load balancer be available .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"port": schema.Int64Attribute{
 									MarkdownDescription: "Port to Listen.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+										int64validator.AtMost(65535),
+									},
 								},
 								"port_ranges": schema.StringAttribute{
 									MarkdownDescription: "A string containing a comma separated list of port ranges. Each port range consists of a single port or two ports separated by '-'.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(512),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -960,6 +1013,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -968,6 +1025,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -976,6 +1036,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -987,6 +1050,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"ip": schema.StringAttribute{
 											MarkdownDescription: "Use given IP address as VIP on the site.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 										"network": schema.StringAttribute{
 											MarkdownDescription: "[Enum: SITE_NETWORK_INSIDE_AND_OUTSIDE|SITE_NETWORK_INSIDE|SITE_NETWORK_OUTSIDE|SITE_NETWORK_SERVICE|SITE_NETWORK_OUTSIDE_WITH_INTERNET_VIP|SITE_NETWORK_INSIDE_AND_OUTSIDE_WITH_INTERNET_VIP|SITE_NETWORK_IP_FABRIC] Defines network types to be used on site All inside and outside networks. All inside and outside networks with internet VIP support. All inside networks. Possible values are `SITE_NETWORK_INSIDE_AND_OUTSIDE`, `SITE_NETWORK_INSIDE`, `SITE_NETWORK_OUTSIDE`, `SITE_NETWORK_SERVICE`, `SITE_NETWORK_OUTSIDE_WITH_INTERNET_VIP`, `SITE_NETWORK_INSIDE_AND_OUTSIDE_WITH_INTERNET_VIP`, `SITE_NETWORK_IP_FABRIC`. Defaults to `SITE_NETWORK_INSIDE_AND_OUTSIDE`.",
@@ -1000,6 +1066,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1008,6 +1078,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1016,6 +1089,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1030,10 +1106,16 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"specific_v6_vip": schema.StringAttribute{
 											MarkdownDescription: "Use given IPv6 address as VIP on virtual Network.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv6Validator(),
+											},
 										},
 										"specific_vip": schema.StringAttribute{
 											MarkdownDescription: "Use given IPv4 address as VIP on virtual Network.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -1049,6 +1131,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1057,6 +1143,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1065,6 +1154,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1085,6 +1177,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1093,6 +1189,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1101,6 +1200,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1112,6 +1214,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"ip": schema.StringAttribute{
 											MarkdownDescription: "Use given IP address as VIP on the site.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 										"network": schema.StringAttribute{
 											MarkdownDescription: "[Enum: SITE_NETWORK_SPECIFIED_VIP_OUTSIDE|SITE_NETWORK_SPECIFIED_VIP_INSIDE] Defines network types to be used on virtual-site with specified VIP All outside networks. All inside networks. Possible values are `SITE_NETWORK_SPECIFIED_VIP_OUTSIDE`, `SITE_NETWORK_SPECIFIED_VIP_INSIDE`. Defaults to `SITE_NETWORK_SPECIFIED_VIP_OUTSIDE`.",
@@ -1125,6 +1230,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1133,6 +1242,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1141,6 +1253,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1156,6 +1271,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1164,6 +1283,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1172,6 +1294,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1181,6 +1306,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1189,6 +1318,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1197,6 +1329,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -1217,6 +1352,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1225,6 +1364,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1233,6 +1375,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -1270,11 +1415,18 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 			},
 			"origin_pools_weights": schema.ListNestedBlock{
 				MarkdownDescription: "Origin pools and weights used for this load balancer.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(16),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"priority": schema.Int64Attribute{
 							MarkdownDescription: "Priority of this origin pool, valid only with multiple origin pools. Value of 0 will make the pool as lowest priority origin pool Priority of 1 means highest priority and is considered active. When active origin pool is not available, lower priority origin pools are made active as per the..",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtMost(32),
+							},
 						},
 						"weight": schema.Int64Attribute{
 							MarkdownDescription: "Weight of this origin pool, valid only with multiple origin pool. Value of 0 will disable the pool.",
@@ -1288,6 +1440,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1296,6 +1452,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1304,6 +1463,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -1316,6 +1478,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1324,6 +1490,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1332,6 +1501,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -1360,11 +1532,18 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 						Blocks: map[string]schema.Block{
 							"certificates": schema.ListNestedBlock{
 								MarkdownDescription: "Select one or more certificates with any domain names.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"name": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(128),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"namespace": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1373,6 +1552,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 										"tenant": schema.StringAttribute{
 											MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1381,6 +1563,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											PlanModifiers: []planmodifier.String{
 												stringplanmodifier.UseStateForUnknown(),
 											},
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(64),
+											},
 										},
 									},
 								},
@@ -1399,6 +1584,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -1431,6 +1619,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1440,6 +1632,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1448,6 +1644,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1456,6 +1655,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -1468,6 +1670,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1476,6 +1682,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1484,6 +1693,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -1513,11 +1725,18 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							},
 							"tls_certificates": schema.ListNestedBlock{
 								MarkdownDescription: "Users can add one or more certificates that share the same set of domains. For example, domain.com and *.domain.com - but use different signature algorithms .",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(16),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -1532,6 +1751,11 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -1567,7 +1791,16 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -1591,6 +1824,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 												Optional:            true,
 												ElementType:         types.StringType,
+												Validators: []validator.List{
+													listvalidator.UniqueValues(),
+												},
 											},
 											"max_version": schema.StringAttribute{
 												MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -1623,6 +1859,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 								},
 								Blocks: map[string]schema.Block{
@@ -1632,6 +1872,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1640,6 +1884,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1648,6 +1895,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -1660,6 +1910,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 											"name": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 												Optional:            true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(128),
+													stringvalidator.LengthAtLeast(1),
+												},
 											},
 											"namespace": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1668,6 +1922,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 											"tenant": schema.StringAttribute{
 												MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1676,6 +1933,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												PlanModifiers: []planmodifier.String{
 													stringplanmodifier.UseStateForUnknown(),
 												},
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(64),
+												},
 											},
 										},
 									},
@@ -1716,6 +1976,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										MarkdownDescription: "The TLS listener will only support the specified cipher list.",
 										Optional:            true,
 										ElementType:         types.StringType,
+										Validators: []validator.List{
+											listvalidator.UniqueValues(),
+										},
 									},
 									"max_version": schema.StringAttribute{
 										MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -1748,6 +2011,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"trusted_ca_url": schema.StringAttribute{
 								MarkdownDescription: "Upload a Root CA Certificate specifically for this Load Balancer.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -1757,6 +2024,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1765,6 +2036,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1773,6 +2047,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -1785,6 +2062,10 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									"name": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(128),
+											stringvalidator.LengthAtLeast(1),
+										},
 									},
 									"namespace": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -1793,6 +2074,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 									"tenant": schema.StringAttribute{
 										MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -1801,6 +2085,9 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										PlanModifiers: []planmodifier.String{
 											stringplanmodifier.UseStateForUnknown(),
 										},
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(64),
+										},
 									},
 								},
 							},
@@ -1825,6 +2112,11 @@ func (r *TCPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *TCPLoadBalancerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *TCPLoadBalancerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -1849,6 +2141,44 @@ func (r *TCPLoadBalancerResource) ValidateConfig(ctx context.Context, req resour
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *TCPLoadBalancerResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "advertise_choice", "advertise_custom", "advertise_on_public", "advertise_on_public_default_vip", "do_not_advertise"),
+		validators.OneOfGroup(path.MatchRelative(), "cluster_retract_choice", "do_not_retract_cluster", "retract_cluster"),
+		validators.OneOfGroup(path.MatchRelative(), "hash_policy_choice", "hash_policy_choice_least_active", "hash_policy_choice_random", "hash_policy_choice_round_robin", "hash_policy_choice_source_ip_stickiness"),
+		validators.OneOfGroup(path.MatchRelative(), "loadbalancer_type", "tcp", "tls_tcp", "tls_tcp_auto_cert"),
+		validators.OneOfGroup(path.MatchRelative(), "port_choice", "listen_port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRelative(), "service_policy_choice", "active_service_policies", "no_service_policies", "service_policies_from_namespace"),
+		validators.OneOfGroup(path.MatchRelative(), "sni_default_lb_choice", "default_lb_with_sni", "no_sni", "sni"),
+		validators.OneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex(), "choice", "advertise_on_public", "site", "virtual_network", "virtual_site", "virtual_site_with_vip", "vk8s_service"),
+		validators.RequiredOneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex(), "port_choice", "port", "port_ranges", "use_default_port"),
+		validators.OneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex().AtName("virtual_network"), "v6_vip_choice", "default_v6_vip", "specific_v6_vip"),
+		validators.OneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex().AtName("virtual_network"), "vip_choice", "default_vip", "specific_vip"),
+		validators.OneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex().AtName("vk8s_service"), "choice", "site", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("origin_pools_weights").AtAnyListIndex(), "pool_choice", "cluster", "pool"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp"), "tls_certificates_choice", "tls_cert_params", "tls_parameters"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp").AtName("tls_cert_params"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp").AtName("tls_cert_params").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp").AtName("tls_cert_params").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("tls_tcp").AtName("tls_cert_params").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp").AtName("tls_cert_params").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp").AtName("tls_parameters"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp").AtName("tls_parameters").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp").AtName("tls_parameters").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp").AtName("tls_parameters").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp").AtName("tls_parameters").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("tls_tcp").AtName("tls_parameters").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp").AtName("tls_parameters").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp_auto_cert"), "mtls_choice", "no_mtls", "use_mtls"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp_auto_cert").AtName("tls_config"), "choice", "custom_security", "default_security", "low_security", "medium_security"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp_auto_cert").AtName("use_mtls"), "crl_choice", "crl", "no_crl"),
+		validators.RequiredOneOfGroup(path.MatchRoot("tls_tcp_auto_cert").AtName("use_mtls"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("tls_tcp_auto_cert").AtName("use_mtls"), "xfcc_header", "xfcc_disabled", "xfcc_options"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *TCPLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -1859,7 +2189,10 @@ func (r *TCPLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.M
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "tcp_loadbalancer", "", req.Config, &resp.Plan)...)
 		var plan TCPLoadBalancerResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -1877,7 +2210,8 @@ func (r *TCPLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.M
 
 func (r *TCPLoadBalancerResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data TCPLoadBalancerResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -1916,6 +2250,7 @@ func (r *TCPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1925,6 +2260,7 @@ func (r *TCPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.ActiveServicePolicies != nil {
@@ -1956,6 +2292,19 @@ func (r *TCPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 				listItemMap := make(map[string]interface{})
 				if listItem.AdvertiseOnPublic != nil {
 					advertise_on_publicDeepMap := make(map[string]interface{})
+					if listItem.AdvertiseOnPublic.PublicIP != nil {
+						public_ipLevel4Map := make(map[string]interface{})
+						if !listItem.AdvertiseOnPublic.PublicIP.Name.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Name.IsUnknown() {
+							public_ipLevel4Map["name"] = listItem.AdvertiseOnPublic.PublicIP.Name.ValueString()
+						}
+						if !listItem.AdvertiseOnPublic.PublicIP.Namespace.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Namespace.IsUnknown() {
+							public_ipLevel4Map["namespace"] = listItem.AdvertiseOnPublic.PublicIP.Namespace.ValueString()
+						}
+						if !listItem.AdvertiseOnPublic.PublicIP.Tenant.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Tenant.IsUnknown() {
+							public_ipLevel4Map["tenant"] = listItem.AdvertiseOnPublic.PublicIP.Tenant.ValueString()
+						}
+						advertise_on_publicDeepMap["public_ip"] = public_ipLevel4Map
+					}
 					listItemMap["advertise_on_public"] = advertise_on_publicDeepMap
 				}
 				if !listItem.Port.IsNull() && !listItem.Port.IsUnknown() {
@@ -1972,6 +2321,19 @@ func (r *TCPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 					if !listItem.Site.Network.IsNull() && !listItem.Site.Network.IsUnknown() {
 						siteDeepMap["network"] = listItem.Site.Network.ValueString()
 					}
+					if listItem.Site.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Site.Site.Name.IsNull() && !listItem.Site.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Site.Site.Name.ValueString()
+						}
+						if !listItem.Site.Site.Namespace.IsNull() && !listItem.Site.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Site.Site.Namespace.ValueString()
+						}
+						if !listItem.Site.Site.Tenant.IsNull() && !listItem.Site.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Site.Site.Tenant.ValueString()
+						}
+						siteDeepMap["site"] = siteLevel4Map
+					}
 					listItemMap["site"] = siteDeepMap
 				}
 				if listItem.UseDefaultPort != nil {
@@ -1991,6 +2353,19 @@ func (r *TCPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 					if !listItem.VirtualNetwork.SpecificVIP.IsNull() && !listItem.VirtualNetwork.SpecificVIP.IsUnknown() {
 						virtual_networkDeepMap["specific_vip"] = listItem.VirtualNetwork.SpecificVIP.ValueString()
 					}
+					if listItem.VirtualNetwork.VirtualNetwork != nil {
+						virtual_networkLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualNetwork.VirtualNetwork.Name.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Name.IsUnknown() {
+							virtual_networkLevel4Map["name"] = listItem.VirtualNetwork.VirtualNetwork.Name.ValueString()
+						}
+						if !listItem.VirtualNetwork.VirtualNetwork.Namespace.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Namespace.IsUnknown() {
+							virtual_networkLevel4Map["namespace"] = listItem.VirtualNetwork.VirtualNetwork.Namespace.ValueString()
+						}
+						if !listItem.VirtualNetwork.VirtualNetwork.Tenant.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Tenant.IsUnknown() {
+							virtual_networkLevel4Map["tenant"] = listItem.VirtualNetwork.VirtualNetwork.Tenant.ValueString()
+						}
+						virtual_networkDeepMap["virtual_network"] = virtual_networkLevel4Map
+					}
 					listItemMap["virtual_network"] = virtual_networkDeepMap
 				}
 				if listItem.VirtualSite != nil {
@@ -1998,6 +2373,19 @@ func (r *TCPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 					if !listItem.VirtualSite.Network.IsNull() && !listItem.VirtualSite.Network.IsUnknown() {
 						virtual_siteDeepMap["network"] = listItem.VirtualSite.Network.ValueString()
 					}
+					if listItem.VirtualSite.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSite.VirtualSite.Name.IsNull() && !listItem.VirtualSite.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSite.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Namespace.IsNull() && !listItem.VirtualSite.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSite.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Tenant.IsNull() && !listItem.VirtualSite.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSite.VirtualSite.Tenant.ValueString()
+						}
+						virtual_siteDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site"] = virtual_siteDeepMap
 				}
 				if listItem.VirtualSiteWithVIP != nil {
@@ -2008,10 +2396,49 @@ func (r *TCPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 					if !listItem.VirtualSiteWithVIP.Network.IsNull() && !listItem.VirtualSiteWithVIP.Network.IsUnknown() {
 						virtual_site_with_vipDeepMap["network"] = listItem.VirtualSiteWithVIP.Network.ValueString()
 					}
+					if listItem.VirtualSiteWithVIP.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Name.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSiteWithVIP.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Namespace.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSiteWithVIP.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Tenant.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSiteWithVIP.VirtualSite.Tenant.ValueString()
+						}
+						virtual_site_with_vipDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site_with_vip"] = virtual_site_with_vipDeepMap
 				}
 				if listItem.Vk8sService != nil {
 					vk8s_serviceDeepMap := make(map[string]interface{})
+					if listItem.Vk8sService.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Vk8sService.Site.Name.IsNull() && !listItem.Vk8sService.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Vk8sService.Site.Name.ValueString()
+						}
+						if !listItem.Vk8sService.Site.Namespace.IsNull() && !listItem.Vk8sService.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Vk8sService.Site.Namespace.ValueString()
+						}
+						if !listItem.Vk8sService.Site.Tenant.IsNull() && !listItem.Vk8sService.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Vk8sService.Site.Tenant.ValueString()
+						}
+						vk8s_serviceDeepMap["site"] = siteLevel4Map
+					}
+					if listItem.Vk8sService.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.Vk8sService.VirtualSite.Name.IsNull() && !listItem.Vk8sService.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.Vk8sService.VirtualSite.Name.ValueString()
+						}
+						if !listItem.Vk8sService.VirtualSite.Namespace.IsNull() && !listItem.Vk8sService.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.Vk8sService.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.Vk8sService.VirtualSite.Tenant.IsNull() && !listItem.Vk8sService.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.Vk8sService.VirtualSite.Tenant.ValueString()
+						}
+						vk8s_serviceDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["vk8s_service"] = vk8s_serviceDeepMap
 				}
 				advertise_whereList = append(advertise_whereList, listItemMap)
@@ -2152,10 +2579,182 @@ func (r *TCPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		tls_tcpMap := make(map[string]interface{})
 		if data.TLSTCP.TLSCertParams != nil {
 			tls_cert_paramsNestedMap := make(map[string]interface{})
+			if data.TLSTCP.TLSCertParams.NoMtls != nil {
+				tls_cert_paramsNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.TLSTCP.TLSCertParams.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.TLSTCP.TLSCertParams.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSCertParams.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSCertParams.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				tls_cert_paramsNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.TLSTCP.TLSCertParams.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.TLSTCP.TLSCertParams.UseMtls.ClientCertificateOptional.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.TLSTCP.TLSCertParams.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.TLSTCP.TLSCertParams.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSCertParams.UseMtls.CRL.Name.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.TLSTCP.TLSCertParams.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.TLSTCP.TLSCertParams.UseMtls.CRL.Namespace.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.TLSTCP.TLSCertParams.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.TLSTCP.TLSCertParams.UseMtls.CRL.Tenant.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.TLSTCP.TLSCertParams.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.TLSTCP.TLSCertParams.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSCertParams.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Name.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Namespace.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Tenant.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.TLSTCP.TLSCertParams.UseMtls.TrustedCAURL.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.TLSTCP.TLSCertParams.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.TLSTCP.TLSCertParams.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSCertParams.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.TLSTCP.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				tls_cert_paramsNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			tls_tcpMap["tls_cert_params"] = tls_cert_paramsNestedMap
 		}
 		if data.TLSTCP.TLSParameters != nil {
 			tls_parametersNestedMap := make(map[string]interface{})
+			if data.TLSTCP.TLSParameters.NoMtls != nil {
+				tls_parametersNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.TLSTCP.TLSParameters.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.TLSTCP.TLSParameters.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSParameters.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSParameters.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				tls_parametersNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.TLSTCP.TLSParameters.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.TLSTCP.TLSParameters.UseMtls.ClientCertificateOptional.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.TLSTCP.TLSParameters.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.TLSTCP.TLSParameters.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSParameters.UseMtls.CRL.Name.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.TLSTCP.TLSParameters.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.TLSTCP.TLSParameters.UseMtls.CRL.Namespace.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.TLSTCP.TLSParameters.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.TLSTCP.TLSParameters.UseMtls.CRL.Tenant.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.TLSTCP.TLSParameters.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.TLSTCP.TLSParameters.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSParameters.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Name.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Namespace.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Tenant.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.TLSTCP.TLSParameters.UseMtls.TrustedCAURL.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.TLSTCP.TLSParameters.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.TLSTCP.TLSParameters.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSParameters.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSParameters.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.TLSTCP.TLSParameters.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				tls_parametersNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			tls_tcpMap["tls_parameters"] = tls_parametersNestedMap
 		}
 		createReq.Spec["tls_tcp"] = tls_tcpMap
@@ -2167,6 +2766,32 @@ func (r *TCPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		if data.TLSTCPAutoCert.TLSConfig != nil {
 			tls_configNestedMap := make(map[string]interface{})
+			if data.TLSTCPAutoCert.TLSConfig.CustomSecurity != nil {
+				custom_securityLevel3Map := make(map[string]interface{})
+				if !data.TLSTCPAutoCert.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.TLSTCPAutoCert.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+					var cipher_suitesLevel3Items []string
+					diags := data.TLSTCPAutoCert.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel3Items, false)
+					if !diags.HasError() {
+						custom_securityLevel3Map["cipher_suites"] = cipher_suitesLevel3Items
+					}
+				}
+				if !data.TLSTCPAutoCert.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.TLSTCPAutoCert.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+					custom_securityLevel3Map["max_version"] = data.TLSTCPAutoCert.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+				}
+				if !data.TLSTCPAutoCert.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.TLSTCPAutoCert.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+					custom_securityLevel3Map["min_version"] = data.TLSTCPAutoCert.TLSConfig.CustomSecurity.MinVersion.ValueString()
+				}
+				tls_configNestedMap["custom_security"] = custom_securityLevel3Map
+			}
+			if data.TLSTCPAutoCert.TLSConfig.DefaultSecurity != nil {
+				tls_configNestedMap["default_security"] = map[string]interface{}{}
+			}
+			if data.TLSTCPAutoCert.TLSConfig.LowSecurity != nil {
+				tls_configNestedMap["low_security"] = map[string]interface{}{}
+			}
+			if data.TLSTCPAutoCert.TLSConfig.MediumSecurity != nil {
+				tls_configNestedMap["medium_security"] = map[string]interface{}{}
+			}
 			tls_tcp_auto_certMap["tls_config"] = tls_configNestedMap
 		}
 		if data.TLSTCPAutoCert.UseMtls != nil {
@@ -2174,9 +2799,52 @@ func (r *TCPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 			if !data.TLSTCPAutoCert.UseMtls.ClientCertificateOptional.IsNull() && !data.TLSTCPAutoCert.UseMtls.ClientCertificateOptional.IsUnknown() {
 				use_mtlsNestedMap["client_certificate_optional"] = data.TLSTCPAutoCert.UseMtls.ClientCertificateOptional.ValueBool()
 			}
+			if data.TLSTCPAutoCert.UseMtls.CRL != nil {
+				crlLevel3Map := make(map[string]interface{})
+				if !data.TLSTCPAutoCert.UseMtls.CRL.Name.IsNull() && !data.TLSTCPAutoCert.UseMtls.CRL.Name.IsUnknown() {
+					crlLevel3Map["name"] = data.TLSTCPAutoCert.UseMtls.CRL.Name.ValueString()
+				}
+				if !data.TLSTCPAutoCert.UseMtls.CRL.Namespace.IsNull() && !data.TLSTCPAutoCert.UseMtls.CRL.Namespace.IsUnknown() {
+					crlLevel3Map["namespace"] = data.TLSTCPAutoCert.UseMtls.CRL.Namespace.ValueString()
+				}
+				if !data.TLSTCPAutoCert.UseMtls.CRL.Tenant.IsNull() && !data.TLSTCPAutoCert.UseMtls.CRL.Tenant.IsUnknown() {
+					crlLevel3Map["tenant"] = data.TLSTCPAutoCert.UseMtls.CRL.Tenant.ValueString()
+				}
+				use_mtlsNestedMap["crl"] = crlLevel3Map
+			}
+			if data.TLSTCPAutoCert.UseMtls.NoCRL != nil {
+				use_mtlsNestedMap["no_crl"] = map[string]interface{}{}
+			}
+			if data.TLSTCPAutoCert.UseMtls.TrustedCA != nil {
+				trusted_caLevel3Map := make(map[string]interface{})
+				if !data.TLSTCPAutoCert.UseMtls.TrustedCA.Name.IsNull() && !data.TLSTCPAutoCert.UseMtls.TrustedCA.Name.IsUnknown() {
+					trusted_caLevel3Map["name"] = data.TLSTCPAutoCert.UseMtls.TrustedCA.Name.ValueString()
+				}
+				if !data.TLSTCPAutoCert.UseMtls.TrustedCA.Namespace.IsNull() && !data.TLSTCPAutoCert.UseMtls.TrustedCA.Namespace.IsUnknown() {
+					trusted_caLevel3Map["namespace"] = data.TLSTCPAutoCert.UseMtls.TrustedCA.Namespace.ValueString()
+				}
+				if !data.TLSTCPAutoCert.UseMtls.TrustedCA.Tenant.IsNull() && !data.TLSTCPAutoCert.UseMtls.TrustedCA.Tenant.IsUnknown() {
+					trusted_caLevel3Map["tenant"] = data.TLSTCPAutoCert.UseMtls.TrustedCA.Tenant.ValueString()
+				}
+				use_mtlsNestedMap["trusted_ca"] = trusted_caLevel3Map
+			}
 			if !data.TLSTCPAutoCert.UseMtls.TrustedCAURL.IsNull() && !data.TLSTCPAutoCert.UseMtls.TrustedCAURL.IsUnknown() {
 				use_mtlsNestedMap["trusted_ca_url"] = data.TLSTCPAutoCert.UseMtls.TrustedCAURL.ValueString()
 			}
+			if data.TLSTCPAutoCert.UseMtls.XfccDisabled != nil {
+				use_mtlsNestedMap["xfcc_disabled"] = map[string]interface{}{}
+			}
+			if data.TLSTCPAutoCert.UseMtls.XfccOptions != nil {
+				xfcc_optionsLevel3Map := make(map[string]interface{})
+				if !data.TLSTCPAutoCert.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.TLSTCPAutoCert.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+					var xfcc_header_elementsLevel3Items []string
+					diags := data.TLSTCPAutoCert.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel3Items, false)
+					if !diags.HasError() {
+						xfcc_optionsLevel3Map["xfcc_header_elements"] = xfcc_header_elementsLevel3Items
+					}
+				}
+				use_mtlsNestedMap["xfcc_options"] = xfcc_optionsLevel3Map
+			}
 			tls_tcp_auto_certMap["use_mtls"] = use_mtlsNestedMap
 		}
 		createReq.Spec["tls_tcp_auto_cert"] = tls_tcp_auto_certMap
@@ -2196,11 +2864,15 @@ func (r *TCPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 
 	apiResource, err := r.client.CreateTCPLoadBalancer(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create TCPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tcp_loadbalancer", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -2610,7 +3282,7 @@ func (r *TCPLoadBalancerResource) Read(ctx context.Context, req resource.ReadReq
 	apiResource, err := r.client.GetTCPLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "TCPLoadBalancer not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -2618,11 +3290,12 @@ func (r *TCPLoadBalancerResource) Read(ctx context.Context, req resource.ReadReq
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read TCPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tcp_loadbalancer", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -2633,31 +3306,13 @@ func (r *TCPLoadBalancerResource) Read(ctx context.Context, req resource.ReadReq
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -2665,6 +3320,7 @@ func (r *TCPLoadBalancerResource) Read(ctx context.Context, req resource.ReadReq
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["active_service_policies"].(map[string]interface{}); ok && (isImport || data.ActiveServicePolicies != nil) {
 		data.ActiveServicePolicies = &TCPLoadBalancerActiveServicePoliciesModel{
@@ -3052,7 +3708,8 @@ func (r *TCPLoadBalancerResource) Read(ctx context.Context, req resource.ReadReq
 
 func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data TCPLoadBalancerResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -3086,6 +3743,7 @@ func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -3095,6 +3753,7 @@ func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.ActiveServicePolicies != nil {
@@ -3126,6 +3785,19 @@ func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 				listItemMap := make(map[string]interface{})
 				if listItem.AdvertiseOnPublic != nil {
 					advertise_on_publicDeepMap := make(map[string]interface{})
+					if listItem.AdvertiseOnPublic.PublicIP != nil {
+						public_ipLevel4Map := make(map[string]interface{})
+						if !listItem.AdvertiseOnPublic.PublicIP.Name.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Name.IsUnknown() {
+							public_ipLevel4Map["name"] = listItem.AdvertiseOnPublic.PublicIP.Name.ValueString()
+						}
+						if !listItem.AdvertiseOnPublic.PublicIP.Namespace.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Namespace.IsUnknown() {
+							public_ipLevel4Map["namespace"] = listItem.AdvertiseOnPublic.PublicIP.Namespace.ValueString()
+						}
+						if !listItem.AdvertiseOnPublic.PublicIP.Tenant.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Tenant.IsUnknown() {
+							public_ipLevel4Map["tenant"] = listItem.AdvertiseOnPublic.PublicIP.Tenant.ValueString()
+						}
+						advertise_on_publicDeepMap["public_ip"] = public_ipLevel4Map
+					}
 					listItemMap["advertise_on_public"] = advertise_on_publicDeepMap
 				}
 				if !listItem.Port.IsNull() && !listItem.Port.IsUnknown() {
@@ -3142,6 +3814,19 @@ func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 					if !listItem.Site.Network.IsNull() && !listItem.Site.Network.IsUnknown() {
 						siteDeepMap["network"] = listItem.Site.Network.ValueString()
 					}
+					if listItem.Site.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Site.Site.Name.IsNull() && !listItem.Site.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Site.Site.Name.ValueString()
+						}
+						if !listItem.Site.Site.Namespace.IsNull() && !listItem.Site.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Site.Site.Namespace.ValueString()
+						}
+						if !listItem.Site.Site.Tenant.IsNull() && !listItem.Site.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Site.Site.Tenant.ValueString()
+						}
+						siteDeepMap["site"] = siteLevel4Map
+					}
 					listItemMap["site"] = siteDeepMap
 				}
 				if listItem.UseDefaultPort != nil {
@@ -3161,6 +3846,19 @@ func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 					if !listItem.VirtualNetwork.SpecificVIP.IsNull() && !listItem.VirtualNetwork.SpecificVIP.IsUnknown() {
 						virtual_networkDeepMap["specific_vip"] = listItem.VirtualNetwork.SpecificVIP.ValueString()
 					}
+					if listItem.VirtualNetwork.VirtualNetwork != nil {
+						virtual_networkLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualNetwork.VirtualNetwork.Name.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Name.IsUnknown() {
+							virtual_networkLevel4Map["name"] = listItem.VirtualNetwork.VirtualNetwork.Name.ValueString()
+						}
+						if !listItem.VirtualNetwork.VirtualNetwork.Namespace.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Namespace.IsUnknown() {
+							virtual_networkLevel4Map["namespace"] = listItem.VirtualNetwork.VirtualNetwork.Namespace.ValueString()
+						}
+						if !listItem.VirtualNetwork.VirtualNetwork.Tenant.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Tenant.IsUnknown() {
+							virtual_networkLevel4Map["tenant"] = listItem.VirtualNetwork.VirtualNetwork.Tenant.ValueString()
+						}
+						virtual_networkDeepMap["virtual_network"] = virtual_networkLevel4Map
+					}
 					listItemMap["virtual_network"] = virtual_networkDeepMap
 				}
 				if listItem.VirtualSite != nil {
@@ -3168,6 +3866,19 @@ func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 					if !listItem.VirtualSite.Network.IsNull() && !listItem.VirtualSite.Network.IsUnknown() {
 						virtual_siteDeepMap["network"] = listItem.VirtualSite.Network.ValueString()
 					}
+					if listItem.VirtualSite.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSite.VirtualSite.Name.IsNull() && !listItem.VirtualSite.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSite.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Namespace.IsNull() && !listItem.VirtualSite.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSite.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Tenant.IsNull() && !listItem.VirtualSite.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSite.VirtualSite.Tenant.ValueString()
+						}
+						virtual_siteDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site"] = virtual_siteDeepMap
 				}
 				if listItem.VirtualSiteWithVIP != nil {
@@ -3178,10 +3889,49 @@ func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 					if !listItem.VirtualSiteWithVIP.Network.IsNull() && !listItem.VirtualSiteWithVIP.Network.IsUnknown() {
 						virtual_site_with_vipDeepMap["network"] = listItem.VirtualSiteWithVIP.Network.ValueString()
 					}
+					if listItem.VirtualSiteWithVIP.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Name.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSiteWithVIP.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Namespace.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSiteWithVIP.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Tenant.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSiteWithVIP.VirtualSite.Tenant.ValueString()
+						}
+						virtual_site_with_vipDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site_with_vip"] = virtual_site_with_vipDeepMap
 				}
 				if listItem.Vk8sService != nil {
 					vk8s_serviceDeepMap := make(map[string]interface{})
+					if listItem.Vk8sService.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Vk8sService.Site.Name.IsNull() && !listItem.Vk8sService.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Vk8sService.Site.Name.ValueString()
+						}
+						if !listItem.Vk8sService.Site.Namespace.IsNull() && !listItem.Vk8sService.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Vk8sService.Site.Namespace.ValueString()
+						}
+						if !listItem.Vk8sService.Site.Tenant.IsNull() && !listItem.Vk8sService.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Vk8sService.Site.Tenant.ValueString()
+						}
+						vk8s_serviceDeepMap["site"] = siteLevel4Map
+					}
+					if listItem.Vk8sService.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.Vk8sService.VirtualSite.Name.IsNull() && !listItem.Vk8sService.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.Vk8sService.VirtualSite.Name.ValueString()
+						}
+						if !listItem.Vk8sService.VirtualSite.Namespace.IsNull() && !listItem.Vk8sService.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.Vk8sService.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.Vk8sService.VirtualSite.Tenant.IsNull() && !listItem.Vk8sService.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.Vk8sService.VirtualSite.Tenant.ValueString()
+						}
+						vk8s_serviceDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["vk8s_service"] = vk8s_serviceDeepMap
 				}
 				advertise_whereList = append(advertise_whereList, listItemMap)
@@ -3322,10 +4072,182 @@ func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		tls_tcpMap := make(map[string]interface{})
 		if data.TLSTCP.TLSCertParams != nil {
 			tls_cert_paramsNestedMap := make(map[string]interface{})
+			if data.TLSTCP.TLSCertParams.NoMtls != nil {
+				tls_cert_paramsNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.TLSTCP.TLSCertParams.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.TLSTCP.TLSCertParams.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.TLSTCP.TLSCertParams.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSCertParams.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSCertParams.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				tls_cert_paramsNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.TLSTCP.TLSCertParams.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.TLSTCP.TLSCertParams.UseMtls.ClientCertificateOptional.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.TLSTCP.TLSCertParams.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.TLSTCP.TLSCertParams.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSCertParams.UseMtls.CRL.Name.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.TLSTCP.TLSCertParams.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.TLSTCP.TLSCertParams.UseMtls.CRL.Namespace.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.TLSTCP.TLSCertParams.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.TLSTCP.TLSCertParams.UseMtls.CRL.Tenant.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.TLSTCP.TLSCertParams.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.TLSTCP.TLSCertParams.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSCertParams.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Name.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Namespace.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Tenant.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.TLSTCP.TLSCertParams.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.TLSTCP.TLSCertParams.UseMtls.TrustedCAURL.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.TLSTCP.TLSCertParams.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.TLSTCP.TLSCertParams.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSCertParams.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.TLSTCP.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.TLSTCP.TLSCertParams.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				tls_cert_paramsNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			tls_tcpMap["tls_cert_params"] = tls_cert_paramsNestedMap
 		}
 		if data.TLSTCP.TLSParameters != nil {
 			tls_parametersNestedMap := make(map[string]interface{})
+			if data.TLSTCP.TLSParameters.NoMtls != nil {
+				tls_parametersNestedMap["no_mtls"] = map[string]interface{}{}
+			}
+			if data.TLSTCP.TLSParameters.TLSConfig != nil {
+				tls_configLevel3Map := make(map[string]interface{})
+				if data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity != nil {
+					custom_securityLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+						var cipher_suitesLevel4Items []string
+						diags := data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel4Items, false)
+						if !diags.HasError() {
+							custom_securityLevel4Map["cipher_suites"] = cipher_suitesLevel4Items
+						}
+					}
+					if !data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+						custom_securityLevel4Map["max_version"] = data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+					}
+					if !data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+						custom_securityLevel4Map["min_version"] = data.TLSTCP.TLSParameters.TLSConfig.CustomSecurity.MinVersion.ValueString()
+					}
+					tls_configLevel3Map["custom_security"] = custom_securityLevel4Map
+				}
+				if data.TLSTCP.TLSParameters.TLSConfig.DefaultSecurity != nil {
+					tls_configLevel3Map["default_security"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSParameters.TLSConfig.LowSecurity != nil {
+					tls_configLevel3Map["low_security"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSParameters.TLSConfig.MediumSecurity != nil {
+					tls_configLevel3Map["medium_security"] = map[string]interface{}{}
+				}
+				tls_parametersNestedMap["tls_config"] = tls_configLevel3Map
+			}
+			if data.TLSTCP.TLSParameters.UseMtls != nil {
+				use_mtlsLevel3Map := make(map[string]interface{})
+				if !data.TLSTCP.TLSParameters.UseMtls.ClientCertificateOptional.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.ClientCertificateOptional.IsUnknown() {
+					use_mtlsLevel3Map["client_certificate_optional"] = data.TLSTCP.TLSParameters.UseMtls.ClientCertificateOptional.ValueBool()
+				}
+				if data.TLSTCP.TLSParameters.UseMtls.CRL != nil {
+					crlLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSParameters.UseMtls.CRL.Name.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.CRL.Name.IsUnknown() {
+						crlLevel4Map["name"] = data.TLSTCP.TLSParameters.UseMtls.CRL.Name.ValueString()
+					}
+					if !data.TLSTCP.TLSParameters.UseMtls.CRL.Namespace.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.CRL.Namespace.IsUnknown() {
+						crlLevel4Map["namespace"] = data.TLSTCP.TLSParameters.UseMtls.CRL.Namespace.ValueString()
+					}
+					if !data.TLSTCP.TLSParameters.UseMtls.CRL.Tenant.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.CRL.Tenant.IsUnknown() {
+						crlLevel4Map["tenant"] = data.TLSTCP.TLSParameters.UseMtls.CRL.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["crl"] = crlLevel4Map
+				}
+				if data.TLSTCP.TLSParameters.UseMtls.NoCRL != nil {
+					use_mtlsLevel3Map["no_crl"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSParameters.UseMtls.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Name.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Name.IsUnknown() {
+						trusted_caLevel4Map["name"] = data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Name.ValueString()
+					}
+					if !data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Namespace.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Namespace.IsUnknown() {
+						trusted_caLevel4Map["namespace"] = data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Namespace.ValueString()
+					}
+					if !data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Tenant.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Tenant.IsUnknown() {
+						trusted_caLevel4Map["tenant"] = data.TLSTCP.TLSParameters.UseMtls.TrustedCA.Tenant.ValueString()
+					}
+					use_mtlsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.TLSTCP.TLSParameters.UseMtls.TrustedCAURL.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.TrustedCAURL.IsUnknown() {
+					use_mtlsLevel3Map["trusted_ca_url"] = data.TLSTCP.TLSParameters.UseMtls.TrustedCAURL.ValueString()
+				}
+				if data.TLSTCP.TLSParameters.UseMtls.XfccDisabled != nil {
+					use_mtlsLevel3Map["xfcc_disabled"] = map[string]interface{}{}
+				}
+				if data.TLSTCP.TLSParameters.UseMtls.XfccOptions != nil {
+					xfcc_optionsLevel4Map := make(map[string]interface{})
+					if !data.TLSTCP.TLSParameters.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.TLSTCP.TLSParameters.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+						var xfcc_header_elementsLevel4Items []string
+						diags := data.TLSTCP.TLSParameters.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel4Items, false)
+						if !diags.HasError() {
+							xfcc_optionsLevel4Map["xfcc_header_elements"] = xfcc_header_elementsLevel4Items
+						}
+					}
+					use_mtlsLevel3Map["xfcc_options"] = xfcc_optionsLevel4Map
+				}
+				tls_parametersNestedMap["use_mtls"] = use_mtlsLevel3Map
+			}
 			tls_tcpMap["tls_parameters"] = tls_parametersNestedMap
 		}
 		apiResource.Spec["tls_tcp"] = tls_tcpMap
@@ -3337,6 +4259,32 @@ func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		if data.TLSTCPAutoCert.TLSConfig != nil {
 			tls_configNestedMap := make(map[string]interface{})
+			if data.TLSTCPAutoCert.TLSConfig.CustomSecurity != nil {
+				custom_securityLevel3Map := make(map[string]interface{})
+				if !data.TLSTCPAutoCert.TLSConfig.CustomSecurity.CipherSuites.IsNull() && !data.TLSTCPAutoCert.TLSConfig.CustomSecurity.CipherSuites.IsUnknown() {
+					var cipher_suitesLevel3Items []string
+					diags := data.TLSTCPAutoCert.TLSConfig.CustomSecurity.CipherSuites.ElementsAs(ctx, &cipher_suitesLevel3Items, false)
+					if !diags.HasError() {
+						custom_securityLevel3Map["cipher_suites"] = cipher_suitesLevel3Items
+					}
+				}
+				if !data.TLSTCPAutoCert.TLSConfig.CustomSecurity.MaxVersion.IsNull() && !data.TLSTCPAutoCert.TLSConfig.CustomSecurity.MaxVersion.IsUnknown() {
+					custom_securityLevel3Map["max_version"] = data.TLSTCPAutoCert.TLSConfig.CustomSecurity.MaxVersion.ValueString()
+				}
+				if !data.TLSTCPAutoCert.TLSConfig.CustomSecurity.MinVersion.IsNull() && !data.TLSTCPAutoCert.TLSConfig.CustomSecurity.MinVersion.IsUnknown() {
+					custom_securityLevel3Map["min_version"] = data.TLSTCPAutoCert.TLSConfig.CustomSecurity.MinVersion.ValueString()
+				}
+				tls_configNestedMap["custom_security"] = custom_securityLevel3Map
+			}
+			if data.TLSTCPAutoCert.TLSConfig.DefaultSecurity != nil {
+				tls_configNestedMap["default_security"] = map[string]interface{}{}
+			}
+			if data.TLSTCPAutoCert.TLSConfig.LowSecurity != nil {
+				tls_configNestedMap["low_security"] = map[string]interface{}{}
+			}
+			if data.TLSTCPAutoCert.TLSConfig.MediumSecurity != nil {
+				tls_configNestedMap["medium_security"] = map[string]interface{}{}
+			}
 			tls_tcp_auto_certMap["tls_config"] = tls_configNestedMap
 		}
 		if data.TLSTCPAutoCert.UseMtls != nil {
@@ -3344,9 +4292,52 @@ func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 			if !data.TLSTCPAutoCert.UseMtls.ClientCertificateOptional.IsNull() && !data.TLSTCPAutoCert.UseMtls.ClientCertificateOptional.IsUnknown() {
 				use_mtlsNestedMap["client_certificate_optional"] = data.TLSTCPAutoCert.UseMtls.ClientCertificateOptional.ValueBool()
 			}
+			if data.TLSTCPAutoCert.UseMtls.CRL != nil {
+				crlLevel3Map := make(map[string]interface{})
+				if !data.TLSTCPAutoCert.UseMtls.CRL.Name.IsNull() && !data.TLSTCPAutoCert.UseMtls.CRL.Name.IsUnknown() {
+					crlLevel3Map["name"] = data.TLSTCPAutoCert.UseMtls.CRL.Name.ValueString()
+				}
+				if !data.TLSTCPAutoCert.UseMtls.CRL.Namespace.IsNull() && !data.TLSTCPAutoCert.UseMtls.CRL.Namespace.IsUnknown() {
+					crlLevel3Map["namespace"] = data.TLSTCPAutoCert.UseMtls.CRL.Namespace.ValueString()
+				}
+				if !data.TLSTCPAutoCert.UseMtls.CRL.Tenant.IsNull() && !data.TLSTCPAutoCert.UseMtls.CRL.Tenant.IsUnknown() {
+					crlLevel3Map["tenant"] = data.TLSTCPAutoCert.UseMtls.CRL.Tenant.ValueString()
+				}
+				use_mtlsNestedMap["crl"] = crlLevel3Map
+			}
+			if data.TLSTCPAutoCert.UseMtls.NoCRL != nil {
+				use_mtlsNestedMap["no_crl"] = map[string]interface{}{}
+			}
+			if data.TLSTCPAutoCert.UseMtls.TrustedCA != nil {
+				trusted_caLevel3Map := make(map[string]interface{})
+				if !data.TLSTCPAutoCert.UseMtls.TrustedCA.Name.IsNull() && !data.TLSTCPAutoCert.UseMtls.TrustedCA.Name.IsUnknown() {
+					trusted_caLevel3Map["name"] = data.TLSTCPAutoCert.UseMtls.TrustedCA.Name.ValueString()
+				}
+				if !data.TLSTCPAutoCert.UseMtls.TrustedCA.Namespace.IsNull() && !data.TLSTCPAutoCert.UseMtls.TrustedCA.Namespace.IsUnknown() {
+					trusted_caLevel3Map["namespace"] = data.TLSTCPAutoCert.UseMtls.TrustedCA.Namespace.ValueString()
+				}
+				if !data.TLSTCPAutoCert.UseMtls.TrustedCA.Tenant.IsNull() && !data.TLSTCPAutoCert.UseMtls.TrustedCA.Tenant.IsUnknown() {
+					trusted_caLevel3Map["tenant"] = data.TLSTCPAutoCert.UseMtls.TrustedCA.Tenant.ValueString()
+				}
+				use_mtlsNestedMap["trusted_ca"] = trusted_caLevel3Map
+			}
 			if !data.TLSTCPAutoCert.UseMtls.TrustedCAURL.IsNull() && !data.TLSTCPAutoCert.UseMtls.TrustedCAURL.IsUnknown() {
 				use_mtlsNestedMap["trusted_ca_url"] = data.TLSTCPAutoCert.UseMtls.TrustedCAURL.ValueString()
 			}
+			if data.TLSTCPAutoCert.UseMtls.XfccDisabled != nil {
+				use_mtlsNestedMap["xfcc_disabled"] = map[string]interface{}{}
+			}
+			if data.TLSTCPAutoCert.UseMtls.XfccOptions != nil {
+				xfcc_optionsLevel3Map := make(map[string]interface{})
+				if !data.TLSTCPAutoCert.UseMtls.XfccOptions.XfccHeaderElements.IsNull() && !data.TLSTCPAutoCert.UseMtls.XfccOptions.XfccHeaderElements.IsUnknown() {
+					var xfcc_header_elementsLevel3Items []string
+					diags := data.TLSTCPAutoCert.UseMtls.XfccOptions.XfccHeaderElements.ElementsAs(ctx, &xfcc_header_elementsLevel3Items, false)
+					if !diags.HasError() {
+						xfcc_optionsLevel3Map["xfcc_header_elements"] = xfcc_header_elementsLevel3Items
+					}
+				}
+				use_mtlsNestedMap["xfcc_options"] = xfcc_optionsLevel3Map
+			}
 			tls_tcp_auto_certMap["use_mtls"] = use_mtlsNestedMap
 		}
 		apiResource.Spec["tls_tcp_auto_cert"] = tls_tcp_auto_certMap
@@ -3366,7 +4357,7 @@ func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 
 	_, err := r.client.UpdateTCPLoadBalancer(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update TCPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tcp_loadbalancer", "update"))
 		return
 	}
 
@@ -3377,9 +4368,12 @@ func (r *TCPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetTCPLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read TCPLoadBalancer after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "tcp_loadbalancer", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["dns_volterra_managed"].(bool); ok {
@@ -3817,7 +4811,7 @@ func (r *TCPLoadBalancerResource) Delete(ctx context.Context, req resource.Delet
 	err := r.client.DeleteTCPLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "TCPLoadBalancer already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -3826,37 +4820,33 @@ func (r *TCPLoadBalancerResource) Delete(ctx context.Context, req resource.Delet
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "TCPLoadBalancer delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete TCPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tcp_loadbalancer", "delete"))
 		return
 	}
 }
 
 func (r *TCPLoadBalancerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *TCPLoadBalancerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("tcp_loadbalancer", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *TCPLoadBalancerResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListTCPLoadBalancers(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *TCPLoadBalancerResource) requiredNamespace() string {
+	return ""
 }
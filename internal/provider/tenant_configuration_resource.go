@@ -6,11 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -20,17 +20,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &TenantConfigurationResource{}
-	_ resource.ResourceWithConfigure      = &TenantConfigurationResource{}
-	_ resource.ResourceWithImportState    = &TenantConfigurationResource{}
-	_ resource.ResourceWithModifyPlan     = &TenantConfigurationResource{}
-	_ resource.ResourceWithValidateConfig = &TenantConfigurationResource{}
+	_ resource.Resource                     = &TenantConfigurationResource{}
+	_ resource.ResourceWithConfigure        = &TenantConfigurationResource{}
+	_ resource.ResourceWithImportState      = &TenantConfigurationResource{}
+	_ resource.ResourceWithIdentity         = &TenantConfigurationResource{}
+	_ resource.ResourceWithMoveState        = &TenantConfigurationResource{}
+	_ resource.ResourceWithModifyPlan       = &TenantConfigurationResource{}
+	_ resource.ResourceWithValidateConfig   = &TenantConfigurationResource{}
+	_ resource.ResourceWithConfigValidators = &TenantConfigurationResource{}
+	_ listableResource                      = &TenantConfigurationResource{}
 )
 
 func NewTenantConfigurationResource() resource.Resource {
@@ -98,6 +103,8 @@ type TenantConfigurationResourceModel struct {
 	Labels                      types.Map                                            `tfsdk:"labels"`
 	ID                          types.String                                         `tfsdk:"id"`
 	Timeouts                    timeouts.Value                                       `tfsdk:"timeouts"`
+	SystemMetadata              types.Object                                         `tfsdk:"system_metadata"`
+	LabelsAll                   types.Map                                            `tfsdk:"labels_all"`
 	BasicConfiguration          *TenantConfigurationBasicConfigurationModel          `tfsdk:"basic_configuration"`
 	BruteForceDetectionSettings *TenantConfigurationBruteForceDetectionSettingsModel `tfsdk:"brute_force_detection_settings"`
 	PasswordPolicy              *TenantConfigurationPasswordPolicyModel              `tfsdk:"password_policy"`
@@ -122,9 +129,11 @@ func (r *TenantConfigurationResource) Schema(ctx context.Context, req resource.S
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Tenant Configuration will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Tenant Configuration will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -156,6 +165,8 @@ func (r *TenantConfigurationResource) Schema(ctx context.Context, req resource.S
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -170,6 +181,9 @@ func (r *TenantConfigurationResource) Schema(ctx context.Context, req resource.S
 					"display_name": schema.StringAttribute{
 						MarkdownDescription: "Tenant display name in the login screen.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(255),
+						},
 					},
 				},
 			},
@@ -179,6 +193,9 @@ func (r *TenantConfigurationResource) Schema(ctx context.Context, req resource.S
 					"max_login_failures": schema.Int64Attribute{
 						MarkdownDescription: "How many failures before wait is triggered. When login failure count is hit, user will be temporarily locked for a max duration of 15 minutes.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(30),
+						},
 					},
 				},
 			},
@@ -188,18 +205,30 @@ func (r *TenantConfigurationResource) Schema(ctx context.Context, req resource.S
 					"digits": schema.Int64Attribute{
 						MarkdownDescription: "The number of digits required to be in the password string.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(16),
+						},
 					},
 					"expire_password": schema.Int64Attribute{
 						MarkdownDescription: "The number of days for which the password is valid. After the number of days has expired, the user is required to change their password.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(1080),
+						},
 					},
 					"lowercase_characters": schema.Int64Attribute{
 						MarkdownDescription: "The number of lower case letters required to be in the password string.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(16),
+						},
 					},
 					"minimum_length": schema.Int64Attribute{
 						MarkdownDescription: "Minimum length of password.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(7),
+						},
 					},
 					"not_recently_used": schema.Int64Attribute{
 						MarkdownDescription: "Policy is used to restrict user from using previously used passwords. Number that's set determines number of last passwords which user cannot use as new password.",
@@ -212,10 +241,16 @@ func (r *TenantConfigurationResource) Schema(ctx context.Context, req resource.S
 					"special_characters": schema.Int64Attribute{
 						MarkdownDescription: "The number of special characters like '?!#%$' required to be in the password string.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(16),
+						},
 					},
 					"uppercase_characters": schema.Int64Attribute{
 						MarkdownDescription: "The number of upper case letters required to be in the password string.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(16),
+						},
 					},
 				},
 			},
@@ -223,6 +258,11 @@ func (r *TenantConfigurationResource) Schema(ctx context.Context, req resource.S
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *TenantConfigurationResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *TenantConfigurationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -247,6 +287,12 @@ func (r *TenantConfigurationResource) ValidateConfig(ctx context.Context, req re
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *TenantConfigurationResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return nil
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *TenantConfigurationResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -257,7 +303,10 @@ func (r *TenantConfigurationResource) ModifyPlan(ctx context.Context, req resour
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "tenant_configuration", "system", req.Config, &resp.Plan)...)
 		var plan TenantConfigurationResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -314,6 +363,7 @@ func (r *TenantConfigurationResource) Create(ctx context.Context, req resource.C
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -323,6 +373,7 @@ func (r *TenantConfigurationResource) Create(ctx context.Context, req resource.C
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.BasicConfiguration != nil {
@@ -370,11 +421,15 @@ func (r *TenantConfigurationResource) Create(ctx context.Context, req resource.C
 
 	apiResource, err := r.client.CreateTenantConfiguration(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create TenantConfiguration: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tenant_configuration", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -566,7 +621,7 @@ func (r *TenantConfigurationResource) Read(ctx context.Context, req resource.Rea
 	apiResource, err := r.client.GetTenantConfiguration(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "TenantConfiguration not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -574,11 +629,12 @@ func (r *TenantConfigurationResource) Read(ctx context.Context, req resource.Rea
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read TenantConfiguration: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tenant_configuration", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -589,31 +645,13 @@ func (r *TenantConfigurationResource) Read(ctx context.Context, req resource.Rea
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -621,6 +659,7 @@ func (r *TenantConfigurationResource) Read(ctx context.Context, req resource.Rea
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["basic_configuration"].(map[string]interface{}); ok && (isImport || data.BasicConfiguration != nil) {
 		data.BasicConfiguration = &TenantConfigurationBasicConfigurationModel{
@@ -824,6 +863,7 @@ func (r *TenantConfigurationResource) Update(ctx context.Context, req resource.U
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -833,6 +873,7 @@ func (r *TenantConfigurationResource) Update(ctx context.Context, req resource.U
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.BasicConfiguration != nil {
@@ -880,7 +921,7 @@ func (r *TenantConfigurationResource) Update(ctx context.Context, req resource.U
 
 	_, err := r.client.UpdateTenantConfiguration(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update TenantConfiguration: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tenant_configuration", "update"))
 		return
 	}
 
@@ -891,9 +932,12 @@ func (r *TenantConfigurationResource) Update(ctx context.Context, req resource.U
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetTenantConfiguration(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read TenantConfiguration after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "tenant_configuration", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 
@@ -1085,7 +1129,7 @@ func (r *TenantConfigurationResource) Delete(ctx context.Context, req resource.D
 	err := r.client.DeleteTenantConfiguration(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "TenantConfiguration already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1094,37 +1138,33 @@ func (r *TenantConfigurationResource) Delete(ctx context.Context, req resource.D
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "TenantConfiguration delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete TenantConfiguration: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tenant_configuration", "delete"))
 		return
 	}
 }
 
 func (r *TenantConfigurationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *TenantConfigurationResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("tenant_configuration", true)}
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// listObjects implements listableResource
+func (r *TenantConfigurationResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListTenantConfigurations(ctx, namespace, opts)
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// requiredNamespace implements listableResource
+func (r *TenantConfigurationResource) requiredNamespace() string {
+	return "system"
 }
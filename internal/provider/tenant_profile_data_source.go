@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,23 @@ type TenantProfileDataSource struct {
 	client *client.Client
 }
 
+// TenantProfileDataSourceModel mirrors TenantProfileResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type TenantProfileDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata      types.Object               `tfsdk:"system_metadata"`
+	Name                types.String               `tfsdk:"name"`
+	Namespace           types.String               `tfsdk:"namespace"`
+	Annotations         types.Map                  `tfsdk:"annotations"`
+	Description         types.String               `tfsdk:"description"`
+	Disable             types.Bool                 `tfsdk:"disable"`
+	Labels              types.Map                  `tfsdk:"labels"`
+	ID                  types.String               `tfsdk:"id"`
+	EnableSupportAccess types.Bool                 `tfsdk:"enable_support_access"`
+	SupportEmail        types.String               `tfsdk:"support_email"`
+	CtGroups            types.List                 `tfsdk:"ct_groups"`
+	Favicon             *TenantProfileFaviconModel `tfsdk:"favicon"`
+	Logo                *TenantProfileLogoModel    `tfsdk:"logo"`
+	Plan                *TenantProfilePlanModel    `tfsdk:"plan"`
 }
 
 func (d *TenantProfileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +50,7 @@ func (d *TenantProfileDataSource) Metadata(ctx context.Context, req datasource.M
 }
 
 func (d *TenantProfileDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages tenant_profile config instance. Name of the object is the name of the tenant profile to be created. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the TenantProfile.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the TenantProfile exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the TenantProfile.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewTenantProfileResource())
 }
 
 func (d *TenantProfileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +72,25 @@ func (d *TenantProfileDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	resource, err := d.client.GetTenantProfile(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetTenantProfile(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read TenantProfile: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tenant_profile", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +104,8 @@ func (d *TenantProfileDataSource) Read(ctx context.Context, req datasource.ReadR
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +114,157 @@ func (d *TenantProfileDataSource) Read(ctx context.Context, req datasource.ReadR
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if listData, ok := apiResource.Spec["ct_groups"].([]interface{}); ok && len(listData) > 0 {
+		var ct_groupsList []TenantProfileCtGroupsModel
+		var existingCtGroupsItems []TenantProfileCtGroupsModel
+		if !data.CtGroups.IsNull() && !data.CtGroups.IsUnknown() {
+			data.CtGroups.ElementsAs(ctx, &existingCtGroupsItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				ct_groupsList = append(ct_groupsList, TenantProfileCtGroupsModel{
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					NamespaceRoles: func() []TenantProfileCtGroupsNamespaceRolesModel {
+						if nestedListData, ok := itemMap["namespace_roles"].([]interface{}); ok && len(nestedListData) > 0 {
+							var result []TenantProfileCtGroupsNamespaceRolesModel
+							for _, nestedItem := range nestedListData {
+								if nestedItemMap, ok := nestedItem.(map[string]interface{}); ok {
+									result = append(result, TenantProfileCtGroupsNamespaceRolesModel{
+										Namespace: func() types.String {
+											if v, ok := nestedItemMap["namespace"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+										Role: func() types.String {
+											if v, ok := nestedItemMap["role"].(string); ok && v != "" {
+												return types.StringValue(v)
+											}
+											return types.StringNull()
+										}(),
+									})
+								}
+							}
+							return result
+						}
+						return nil
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: TenantProfileCtGroupsModelAttrTypes}, ct_groupsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.CtGroups = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.CtGroups = types.ListNull(types.ObjectType{AttrTypes: TenantProfileCtGroupsModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["favicon"].(map[string]interface{}); ok && (isImport || data.Favicon != nil) {
+		data.Favicon = &TenantProfileFaviconModel{
+			AWSS3: func() *TenantProfileEmptyModel {
+				if !isImport && data.Favicon != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.Favicon.AWSS3
+				}
+				// Import case: read from API
+				if _, ok := blockData["aws_s3"].(map[string]interface{}); ok {
+					return &TenantProfileEmptyModel{}
+				}
+				return nil
+			}(),
+			Content: func() types.String {
+				if v, ok := blockData["content"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ContentType: func() types.String {
+				if v, ok := blockData["content_type"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["logo"].(map[string]interface{}); ok && (isImport || data.Logo != nil) {
+		data.Logo = &TenantProfileLogoModel{
+			AWSS3: func() *TenantProfileEmptyModel {
+				if !isImport && data.Logo != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.Logo.AWSS3
+				}
+				// Import case: read from API
+				if _, ok := blockData["aws_s3"].(map[string]interface{}); ok {
+					return &TenantProfileEmptyModel{}
+				}
+				return nil
+			}(),
+			Content: func() types.String {
+				if v, ok := blockData["content"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ContentType: func() types.String {
+				if v, ok := blockData["content_type"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["plan"].(map[string]interface{}); ok && (isImport || data.Plan != nil) {
+		data.Plan = &TenantProfilePlanModel{
+			Name: func() types.String {
+				if v, ok := blockData["name"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Namespace: func() types.String {
+				if v, ok := blockData["namespace"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			Tenant: func() types.String {
+				if v, ok := blockData["tenant"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	// Top-level Optional bool: preserve prior state to avoid API default drift
+	if !isImport && !data.EnableSupportAccess.IsNull() && !data.EnableSupportAccess.IsUnknown() {
+		// Normal Read: preserve existing state value (do nothing)
+	} else {
+		// Import case, null state, or unknown (after Create): read from API
+		if v, ok := apiResource.Spec["enable_support_access"].(bool); ok {
+			data.EnableSupportAccess = types.BoolValue(v)
+		} else {
+			data.EnableSupportAccess = types.BoolNull()
+		}
+	}
+	if v, ok := apiResource.Spec["support_email"].(string); ok && v != "" {
+		data.SupportEmail = types.StringValue(v)
+	} else {
+		data.SupportEmail = types.StringNull()
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
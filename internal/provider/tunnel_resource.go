@@ -6,9 +6,10 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -20,17 +21,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &TunnelResource{}
-	_ resource.ResourceWithConfigure      = &TunnelResource{}
-	_ resource.ResourceWithImportState    = &TunnelResource{}
-	_ resource.ResourceWithModifyPlan     = &TunnelResource{}
-	_ resource.ResourceWithValidateConfig = &TunnelResource{}
+	_ resource.Resource                     = &TunnelResource{}
+	_ resource.ResourceWithConfigure        = &TunnelResource{}
+	_ resource.ResourceWithImportState      = &TunnelResource{}
+	_ resource.ResourceWithIdentity         = &TunnelResource{}
+	_ resource.ResourceWithMoveState        = &TunnelResource{}
+	_ resource.ResourceWithModifyPlan       = &TunnelResource{}
+	_ resource.ResourceWithValidateConfig   = &TunnelResource{}
+	_ resource.ResourceWithConfigValidators = &TunnelResource{}
+	_ listableResource                      = &TunnelResource{}
 )
 
 func NewTunnelResource() resource.Resource {
@@ -193,14 +199,16 @@ var TunnelParamsIpsecIpsecPskBlindfoldSecretInfoModelAttrTypes = map[string]attr
 
 // TunnelParamsIpsecIpsecPskClearSecretInfoModel represents clear_secret_info block
 type TunnelParamsIpsecIpsecPskClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // TunnelParamsIpsecIpsecPskClearSecretInfoModelAttrTypes defines the attribute types for TunnelParamsIpsecIpsecPskClearSecretInfoModel
 var TunnelParamsIpsecIpsecPskClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // TunnelRemoteIPModel represents remote_ip block
@@ -258,18 +266,20 @@ var TunnelRemoteIPIPIpv6ModelAttrTypes = map[string]attr.Type{
 }
 
 type TunnelResourceModel struct {
-	Name        types.String         `tfsdk:"name"`
-	Namespace   types.String         `tfsdk:"namespace"`
-	Annotations types.Map            `tfsdk:"annotations"`
-	Description types.String         `tfsdk:"description"`
-	Disable     types.Bool           `tfsdk:"disable"`
-	Labels      types.Map            `tfsdk:"labels"`
-	ID          types.String         `tfsdk:"id"`
-	TunnelType  types.String         `tfsdk:"tunnel_type"`
-	Timeouts    timeouts.Value       `tfsdk:"timeouts"`
-	LocalIP     *TunnelLocalIPModel  `tfsdk:"local_ip"`
-	Params      *TunnelParamsModel   `tfsdk:"params"`
-	RemoteIP    *TunnelRemoteIPModel `tfsdk:"remote_ip"`
+	Name           types.String         `tfsdk:"name"`
+	Namespace      types.String         `tfsdk:"namespace"`
+	Annotations    types.Map            `tfsdk:"annotations"`
+	Description    types.String         `tfsdk:"description"`
+	Disable        types.Bool           `tfsdk:"disable"`
+	Labels         types.Map            `tfsdk:"labels"`
+	ID             types.String         `tfsdk:"id"`
+	TunnelType     types.String         `tfsdk:"tunnel_type"`
+	Timeouts       timeouts.Value       `tfsdk:"timeouts"`
+	SystemMetadata types.Object         `tfsdk:"system_metadata"`
+	LabelsAll      types.Map            `tfsdk:"labels_all"`
+	LocalIP        *TunnelLocalIPModel  `tfsdk:"local_ip"`
+	Params         *TunnelParamsModel   `tfsdk:"params"`
+	RemoteIP       *TunnelRemoteIPModel `tfsdk:"remote_ip"`
 }
 
 func (r *TunnelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -291,9 +301,11 @@ func (r *TunnelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Tunnel will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Tunnel will be created. Must be `system`, which is also the default.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -333,6 +345,8 @@ func (r *TunnelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -351,6 +365,9 @@ func (r *TunnelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 						Blocks: map[string]schema.Block{
 							"local_intf": schema.ListNestedBlock{
 								MarkdownDescription: "Local interface to be used for filling in source information of IP and network for transport.",
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(1),
+								},
 								NestedObject: schema.NestedBlockObject{
 									Attributes: map[string]schema.Attribute{
 										"kind": schema.StringAttribute{
@@ -411,6 +428,9 @@ func (r *TunnelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											"addr": schema.StringAttribute{
 												MarkdownDescription: "IPv4 Address in string form with dot-decimal notation.",
 												Optional:            true,
+												Validators: []validator.String{
+													validators.IPv4Validator(),
+												},
 											},
 										},
 									},
@@ -420,6 +440,9 @@ func (r *TunnelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 											"addr": schema.StringAttribute{
 												MarkdownDescription: "IPv6 Address in form of string. IPv6 address must be specified as hexadecimal numbers separated by ':' The address can be compacted by suppressing zeros e.g. '2001:db8:0:0:0:0:2:1' becomes '2001:db8::2:1' or '2001:db8:0:0:0:2:0:0' becomes '2001:db8::2::'.",
 												Optional:            true,
+												Validators: []validator.String{
+													validators.IPv6Validator(),
+												},
 											},
 										},
 									},
@@ -481,7 +504,16 @@ func (r *TunnelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 												Optional:            true,
 											},
 											"url": schema.StringAttribute{
-												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+												MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+												Optional:            true,
+												Sensitive:           true,
+												WriteOnly:           true,
+												Validators: []validator.String{
+													stringvalidator.LengthAtMost(131072),
+												},
+											},
+											"url_version": schema.Int64Attribute{
+												MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 												Optional:            true,
 											},
 										},
@@ -515,6 +547,9 @@ func (r *TunnelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									"addr": schema.StringAttribute{
 										MarkdownDescription: "IPv4 Address in string form with dot-decimal notation.",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.IPv4Validator(),
+										},
 									},
 								},
 							},
@@ -524,6 +559,9 @@ func (r *TunnelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 									"addr": schema.StringAttribute{
 										MarkdownDescription: "IPv6 Address in form of string. IPv6 address must be specified as hexadecimal numbers separated by ':' The address can be compacted by suppressing zeros e.g. '2001:db8:0:0:0:0:2:1' becomes '2001:db8::2:1' or '2001:db8:0:0:0:2:0:0' becomes '2001:db8::2::'.",
 										Optional:            true,
+										Validators: []validator.String{
+											validators.IPv6Validator(),
+										},
 									},
 								},
 							},
@@ -535,6 +573,11 @@ func (r *TunnelResource) Schema(ctx context.Context, req resource.SchemaRequest,
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *TunnelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *TunnelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -559,6 +602,20 @@ func (r *TunnelResource) ValidateConfig(ctx context.Context, req resource.Valida
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *TunnelResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRoot("local_ip"), "type", "intf", "ip_address"),
+		validators.OneOfGroup(path.MatchRoot("local_ip").AtName("ip_address"), "type", "auto", "ip_address"),
+		validators.OneOfGroup(path.MatchRoot("local_ip").AtName("ip_address").AtName("ip_address"), "ver", "ipv4", "ipv6"),
+		validators.OneOfGroup(path.MatchRoot("local_ip").AtName("ip_address").AtName("virtual_network_type"), "vn_type_choice", "public", "site_local", "site_local_inside"),
+		validators.OneOfGroup(path.MatchRoot("params").AtName("ipsec").AtName("ipsec_psk"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("remote_ip"), "type", "endpoints", "ip"),
+		validators.OneOfGroup(path.MatchRoot("remote_ip").AtName("ip"), "ver", "ipv4", "ipv6"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *TunnelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -569,7 +626,10 @@ func (r *TunnelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "tunnel", "system", req.Config, &resp.Plan)...)
 		var plan TunnelResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -587,7 +647,8 @@ func (r *TunnelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlan
 
 func (r *TunnelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data TunnelResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -626,6 +687,7 @@ func (r *TunnelResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -635,6 +697,7 @@ func (r *TunnelResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.LocalIP != nil {
@@ -645,6 +708,40 @@ func (r *TunnelResource) Create(ctx context.Context, req resource.CreateRequest,
 		}
 		if data.LocalIP.IPAddress != nil {
 			ip_addressNestedMap := make(map[string]interface{})
+			if data.LocalIP.IPAddress.Auto != nil {
+				ip_addressNestedMap["auto"] = map[string]interface{}{}
+			}
+			if data.LocalIP.IPAddress.IPAddress != nil {
+				ip_addressLevel3Map := make(map[string]interface{})
+				if data.LocalIP.IPAddress.IPAddress.Ipv4 != nil {
+					ipv4Level4Map := make(map[string]interface{})
+					if !data.LocalIP.IPAddress.IPAddress.Ipv4.Addr.IsNull() && !data.LocalIP.IPAddress.IPAddress.Ipv4.Addr.IsUnknown() {
+						ipv4Level4Map["addr"] = data.LocalIP.IPAddress.IPAddress.Ipv4.Addr.ValueString()
+					}
+					ip_addressLevel3Map["ipv4"] = ipv4Level4Map
+				}
+				if data.LocalIP.IPAddress.IPAddress.Ipv6 != nil {
+					ipv6Level4Map := make(map[string]interface{})
+					if !data.LocalIP.IPAddress.IPAddress.Ipv6.Addr.IsNull() && !data.LocalIP.IPAddress.IPAddress.Ipv6.Addr.IsUnknown() {
+						ipv6Level4Map["addr"] = data.LocalIP.IPAddress.IPAddress.Ipv6.Addr.ValueString()
+					}
+					ip_addressLevel3Map["ipv6"] = ipv6Level4Map
+				}
+				ip_addressNestedMap["ip_address"] = ip_addressLevel3Map
+			}
+			if data.LocalIP.IPAddress.VirtualNetworkType != nil {
+				virtual_network_typeLevel3Map := make(map[string]interface{})
+				if data.LocalIP.IPAddress.VirtualNetworkType.Public != nil {
+					virtual_network_typeLevel3Map["public"] = map[string]interface{}{}
+				}
+				if data.LocalIP.IPAddress.VirtualNetworkType.SiteLocal != nil {
+					virtual_network_typeLevel3Map["site_local"] = map[string]interface{}{}
+				}
+				if data.LocalIP.IPAddress.VirtualNetworkType.SiteLocalInside != nil {
+					virtual_network_typeLevel3Map["site_local_inside"] = map[string]interface{}{}
+				}
+				ip_addressNestedMap["virtual_network_type"] = virtual_network_typeLevel3Map
+			}
 			local_ipMap["ip_address"] = ip_addressNestedMap
 		}
 		createReq.Spec["local_ip"] = local_ipMap
@@ -653,6 +750,33 @@ func (r *TunnelResource) Create(ctx context.Context, req resource.CreateRequest,
 		paramsMap := make(map[string]interface{})
 		if data.Params.Ipsec != nil {
 			ipsecNestedMap := make(map[string]interface{})
+			if data.Params.Ipsec.IpsecPsk != nil {
+				ipsec_pskLevel3Map := make(map[string]interface{})
+				if data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.Location.IsNull() && !data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					ipsec_pskLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.Params.Ipsec.IpsecPsk.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.Params.Ipsec.IpsecPsk.ClearSecretInfo.Provider.IsNull() && !data.Params.Ipsec.IpsecPsk.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.Params.Ipsec.IpsecPsk.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.Params.Ipsec.IpsecPsk.ClearSecretInfo.URL.IsNull() && !data.Params.Ipsec.IpsecPsk.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.Params.Ipsec.IpsecPsk.ClearSecretInfo.URL.ValueString()
+					}
+					ipsec_pskLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				ipsecNestedMap["ipsec_psk"] = ipsec_pskLevel3Map
+			}
 			paramsMap["ipsec"] = ipsecNestedMap
 		}
 		createReq.Spec["params"] = paramsMap
@@ -661,10 +785,27 @@ func (r *TunnelResource) Create(ctx context.Context, req resource.CreateRequest,
 		remote_ipMap := make(map[string]interface{})
 		if data.RemoteIP.Endpoints != nil {
 			endpointsNestedMap := make(map[string]interface{})
+			if data.RemoteIP.Endpoints.Endpoints != nil {
+				endpointsNestedMap["endpoints"] = map[string]interface{}{}
+			}
 			remote_ipMap["endpoints"] = endpointsNestedMap
 		}
 		if data.RemoteIP.IP != nil {
 			ipNestedMap := make(map[string]interface{})
+			if data.RemoteIP.IP.Ipv4 != nil {
+				ipv4Level3Map := make(map[string]interface{})
+				if !data.RemoteIP.IP.Ipv4.Addr.IsNull() && !data.RemoteIP.IP.Ipv4.Addr.IsUnknown() {
+					ipv4Level3Map["addr"] = data.RemoteIP.IP.Ipv4.Addr.ValueString()
+				}
+				ipNestedMap["ipv4"] = ipv4Level3Map
+			}
+			if data.RemoteIP.IP.Ipv6 != nil {
+				ipv6Level3Map := make(map[string]interface{})
+				if !data.RemoteIP.IP.Ipv6.Addr.IsNull() && !data.RemoteIP.IP.Ipv6.Addr.IsUnknown() {
+					ipv6Level3Map["addr"] = data.RemoteIP.IP.Ipv6.Addr.ValueString()
+				}
+				ipNestedMap["ipv6"] = ipv6Level3Map
+			}
 			remote_ipMap["ip"] = ipNestedMap
 		}
 		createReq.Spec["remote_ip"] = remote_ipMap
@@ -675,11 +816,15 @@ func (r *TunnelResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	apiResource, err := r.client.CreateTunnel(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create Tunnel: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tunnel", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -729,7 +874,7 @@ func (r *TunnelResource) Read(ctx context.Context, req resource.ReadRequest, res
 	apiResource, err := r.client.GetTunnel(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Tunnel not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -737,11 +882,12 @@ func (r *TunnelResource) Read(ctx context.Context, req resource.ReadRequest, res
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Tunnel: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tunnel", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -752,31 +898,13 @@ func (r *TunnelResource) Read(ctx context.Context, req resource.ReadRequest, res
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -784,6 +912,7 @@ func (r *TunnelResource) Read(ctx context.Context, req resource.ReadRequest, res
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if _, ok := apiResource.Spec["local_ip"].(map[string]interface{}); ok && isImport && data.LocalIP == nil {
 		// Import case: populate from API since state is nil and psd is empty
@@ -811,7 +940,8 @@ func (r *TunnelResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 func (r *TunnelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data TunnelResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -845,6 +975,7 @@ func (r *TunnelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -854,6 +985,7 @@ func (r *TunnelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.LocalIP != nil {
@@ -864,6 +996,40 @@ func (r *TunnelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		}
 		if data.LocalIP.IPAddress != nil {
 			ip_addressNestedMap := make(map[string]interface{})
+			if data.LocalIP.IPAddress.Auto != nil {
+				ip_addressNestedMap["auto"] = map[string]interface{}{}
+			}
+			if data.LocalIP.IPAddress.IPAddress != nil {
+				ip_addressLevel3Map := make(map[string]interface{})
+				if data.LocalIP.IPAddress.IPAddress.Ipv4 != nil {
+					ipv4Level4Map := make(map[string]interface{})
+					if !data.LocalIP.IPAddress.IPAddress.Ipv4.Addr.IsNull() && !data.LocalIP.IPAddress.IPAddress.Ipv4.Addr.IsUnknown() {
+						ipv4Level4Map["addr"] = data.LocalIP.IPAddress.IPAddress.Ipv4.Addr.ValueString()
+					}
+					ip_addressLevel3Map["ipv4"] = ipv4Level4Map
+				}
+				if data.LocalIP.IPAddress.IPAddress.Ipv6 != nil {
+					ipv6Level4Map := make(map[string]interface{})
+					if !data.LocalIP.IPAddress.IPAddress.Ipv6.Addr.IsNull() && !data.LocalIP.IPAddress.IPAddress.Ipv6.Addr.IsUnknown() {
+						ipv6Level4Map["addr"] = data.LocalIP.IPAddress.IPAddress.Ipv6.Addr.ValueString()
+					}
+					ip_addressLevel3Map["ipv6"] = ipv6Level4Map
+				}
+				ip_addressNestedMap["ip_address"] = ip_addressLevel3Map
+			}
+			if data.LocalIP.IPAddress.VirtualNetworkType != nil {
+				virtual_network_typeLevel3Map := make(map[string]interface{})
+				if data.LocalIP.IPAddress.VirtualNetworkType.Public != nil {
+					virtual_network_typeLevel3Map["public"] = map[string]interface{}{}
+				}
+				if data.LocalIP.IPAddress.VirtualNetworkType.SiteLocal != nil {
+					virtual_network_typeLevel3Map["site_local"] = map[string]interface{}{}
+				}
+				if data.LocalIP.IPAddress.VirtualNetworkType.SiteLocalInside != nil {
+					virtual_network_typeLevel3Map["site_local_inside"] = map[string]interface{}{}
+				}
+				ip_addressNestedMap["virtual_network_type"] = virtual_network_typeLevel3Map
+			}
 			local_ipMap["ip_address"] = ip_addressNestedMap
 		}
 		apiResource.Spec["local_ip"] = local_ipMap
@@ -872,6 +1038,33 @@ func (r *TunnelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		paramsMap := make(map[string]interface{})
 		if data.Params.Ipsec != nil {
 			ipsecNestedMap := make(map[string]interface{})
+			if data.Params.Ipsec.IpsecPsk != nil {
+				ipsec_pskLevel3Map := make(map[string]interface{})
+				if data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo != nil {
+					blindfold_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["decryption_provider"] = data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+					}
+					if !data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.Location.IsNull() && !data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.Location.IsUnknown() {
+						blindfold_secret_infoLevel4Map["location"] = data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.Location.ValueString()
+					}
+					if !data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+						blindfold_secret_infoLevel4Map["store_provider"] = data.Params.Ipsec.IpsecPsk.BlindfoldSecretInfo.StoreProvider.ValueString()
+					}
+					ipsec_pskLevel3Map["blindfold_secret_info"] = blindfold_secret_infoLevel4Map
+				}
+				if data.Params.Ipsec.IpsecPsk.ClearSecretInfo != nil {
+					clear_secret_infoLevel4Map := make(map[string]interface{})
+					if !data.Params.Ipsec.IpsecPsk.ClearSecretInfo.Provider.IsNull() && !data.Params.Ipsec.IpsecPsk.ClearSecretInfo.Provider.IsUnknown() {
+						clear_secret_infoLevel4Map["provider"] = data.Params.Ipsec.IpsecPsk.ClearSecretInfo.Provider.ValueString()
+					}
+					if !data.Params.Ipsec.IpsecPsk.ClearSecretInfo.URL.IsNull() && !data.Params.Ipsec.IpsecPsk.ClearSecretInfo.URL.IsUnknown() {
+						clear_secret_infoLevel4Map["url"] = data.Params.Ipsec.IpsecPsk.ClearSecretInfo.URL.ValueString()
+					}
+					ipsec_pskLevel3Map["clear_secret_info"] = clear_secret_infoLevel4Map
+				}
+				ipsecNestedMap["ipsec_psk"] = ipsec_pskLevel3Map
+			}
 			paramsMap["ipsec"] = ipsecNestedMap
 		}
 		apiResource.Spec["params"] = paramsMap
@@ -880,10 +1073,27 @@ func (r *TunnelResource) Update(ctx context.Context, req resource.UpdateRequest,
 		remote_ipMap := make(map[string]interface{})
 		if data.RemoteIP.Endpoints != nil {
 			endpointsNestedMap := make(map[string]interface{})
+			if data.RemoteIP.Endpoints.Endpoints != nil {
+				endpointsNestedMap["endpoints"] = map[string]interface{}{}
+			}
 			remote_ipMap["endpoints"] = endpointsNestedMap
 		}
 		if data.RemoteIP.IP != nil {
 			ipNestedMap := make(map[string]interface{})
+			if data.RemoteIP.IP.Ipv4 != nil {
+				ipv4Level3Map := make(map[string]interface{})
+				if !data.RemoteIP.IP.Ipv4.Addr.IsNull() && !data.RemoteIP.IP.Ipv4.Addr.IsUnknown() {
+					ipv4Level3Map["addr"] = data.RemoteIP.IP.Ipv4.Addr.ValueString()
+				}
+				ipNestedMap["ipv4"] = ipv4Level3Map
+			}
+			if data.RemoteIP.IP.Ipv6 != nil {
+				ipv6Level3Map := make(map[string]interface{})
+				if !data.RemoteIP.IP.Ipv6.Addr.IsNull() && !data.RemoteIP.IP.Ipv6.Addr.IsUnknown() {
+					ipv6Level3Map["addr"] = data.RemoteIP.IP.Ipv6.Addr.ValueString()
+				}
+				ipNestedMap["ipv6"] = ipv6Level3Map
+			}
 			remote_ipMap["ip"] = ipNestedMap
 		}
 		apiResource.Spec["remote_ip"] = remote_ipMap
@@ -894,7 +1104,7 @@ func (r *TunnelResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	_, err := r.client.UpdateTunnel(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update Tunnel: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tunnel", "update"))
 		return
 	}
 
@@ -905,9 +1115,12 @@ func (r *TunnelResource) Update(ctx context.Context, req resource.UpdateRequest,
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetTunnel(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read Tunnel after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "tunnel", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["tunnel_type"].(string); ok && v != "" {
@@ -964,7 +1177,7 @@ func (r *TunnelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	err := r.client.DeleteTunnel(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "Tunnel already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -973,37 +1186,33 @@ func (r *TunnelResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "Tunnel delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete Tunnel: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "tunnel", "delete"))
 		return
 	}
 }
 
 func (r *TunnelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "system", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *TunnelResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("tunnel", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *TunnelResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListTunnels(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *TunnelResource) requiredNamespace() string {
+	return "system"
 }
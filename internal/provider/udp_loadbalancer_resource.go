@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,17 +24,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &UDPLoadBalancerResource{}
-	_ resource.ResourceWithConfigure      = &UDPLoadBalancerResource{}
-	_ resource.ResourceWithImportState    = &UDPLoadBalancerResource{}
-	_ resource.ResourceWithModifyPlan     = &UDPLoadBalancerResource{}
-	_ resource.ResourceWithValidateConfig = &UDPLoadBalancerResource{}
+	_ resource.Resource                     = &UDPLoadBalancerResource{}
+	_ resource.ResourceWithConfigure        = &UDPLoadBalancerResource{}
+	_ resource.ResourceWithImportState      = &UDPLoadBalancerResource{}
+	_ resource.ResourceWithIdentity         = &UDPLoadBalancerResource{}
+	_ resource.ResourceWithMoveState        = &UDPLoadBalancerResource{}
+	_ resource.ResourceWithModifyPlan       = &UDPLoadBalancerResource{}
+	_ resource.ResourceWithValidateConfig   = &UDPLoadBalancerResource{}
+	_ resource.ResourceWithConfigValidators = &UDPLoadBalancerResource{}
+	_ listableResource                      = &UDPLoadBalancerResource{}
 )
 
 func NewUDPLoadBalancerResource() resource.Resource {
@@ -346,6 +353,8 @@ type UDPLoadBalancerResourceModel struct {
 	ListenPort                         types.Int64                            `tfsdk:"listen_port"`
 	PortRanges                         types.String                           `tfsdk:"port_ranges"`
 	Timeouts                           timeouts.Value                         `tfsdk:"timeouts"`
+	SystemMetadata                     types.Object                           `tfsdk:"system_metadata"`
+	LabelsAll                          types.Map                              `tfsdk:"labels_all"`
 	AdvertiseCustom                    *UDPLoadBalancerAdvertiseCustomModel   `tfsdk:"advertise_custom"`
 	AdvertiseOnPublic                  *UDPLoadBalancerAdvertiseOnPublicModel `tfsdk:"advertise_on_public"`
 	AdvertiseOnPublicDefaultVIP        *UDPLoadBalancerEmptyModel             `tfsdk:"advertise_on_public_default_vip"`
@@ -376,9 +385,11 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the UDP Load Balancer will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the UDP Load Balancer will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -402,6 +413,11 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				MarkdownDescription: "List of domains (host/authority header) that will be matched to this load balancer.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(validators.HostnameValidator()),
+				},
 			},
 			"labels": schema.MapAttribute{
 				MarkdownDescription: "Labels is a user defined key value map that can be attached to resources for organization and filtering.",
@@ -438,6 +454,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(30000),
+				},
 			},
 			"listen_port": schema.Int64Attribute{
 				MarkdownDescription: "[OneOf: listen_port, port_ranges] Listen Port for this load balancer.",
@@ -446,6 +465,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(65535),
+				},
 			},
 			"port_ranges": schema.StringAttribute{
 				MarkdownDescription: "A string containing a comma separated list of port ranges. Each port range consists of a single port or two ports separated by '-'.",
@@ -454,7 +476,13 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(512),
+					stringvalidator.UTF8LengthAtLeast(1),
+				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -469,15 +497,26 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 				Blocks: map[string]schema.Block{
 					"advertise_where": schema.ListNestedBlock{
 						MarkdownDescription: "Where should this load balancer be available .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(32),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"port": schema.Int64Attribute{
 									MarkdownDescription: "Port to Listen.",
 									Optional:            true,
+									Validators: []validator.Int64{
+										int64validator.AtLeast(1),
+										int64validator.AtMost(65535),
+									},
 								},
 								"port_ranges": schema.StringAttribute{
 									MarkdownDescription: "A string containing a comma separated list of port ranges. Each port range consists of a single port or two ports separated by '-'.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.UTF8LengthAtMost(512),
+										stringvalidator.UTF8LengthAtLeast(1),
+									},
 								},
 							},
 							Blocks: map[string]schema.Block{
@@ -491,6 +530,10 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -499,6 +542,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -507,6 +553,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -518,6 +567,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"ip": schema.StringAttribute{
 											MarkdownDescription: "Use given IP address as VIP on the site.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 										"network": schema.StringAttribute{
 											MarkdownDescription: "[Enum: SITE_NETWORK_INSIDE_AND_OUTSIDE|SITE_NETWORK_INSIDE|SITE_NETWORK_OUTSIDE|SITE_NETWORK_SERVICE|SITE_NETWORK_OUTSIDE_WITH_INTERNET_VIP|SITE_NETWORK_INSIDE_AND_OUTSIDE_WITH_INTERNET_VIP|SITE_NETWORK_IP_FABRIC] Defines network types to be used on site All inside and outside networks. All inside and outside networks with internet VIP support. All inside networks. Possible values are `SITE_NETWORK_INSIDE_AND_OUTSIDE`, `SITE_NETWORK_INSIDE`, `SITE_NETWORK_OUTSIDE`, `SITE_NETWORK_SERVICE`, `SITE_NETWORK_OUTSIDE_WITH_INTERNET_VIP`, `SITE_NETWORK_INSIDE_AND_OUTSIDE_WITH_INTERNET_VIP`, `SITE_NETWORK_IP_FABRIC`. Defaults to `SITE_NETWORK_INSIDE_AND_OUTSIDE`.",
@@ -531,6 +583,10 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -539,6 +595,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -547,6 +606,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -561,10 +623,16 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"specific_v6_vip": schema.StringAttribute{
 											MarkdownDescription: "Use given IPv6 address as VIP on virtual Network.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv6Validator(),
+											},
 										},
 										"specific_vip": schema.StringAttribute{
 											MarkdownDescription: "Use given IPv4 address as VIP on virtual Network.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 									},
 									Blocks: map[string]schema.Block{
@@ -580,6 +648,10 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -588,6 +660,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -596,6 +671,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -616,6 +694,10 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -624,6 +706,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -632,6 +717,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -643,6 +731,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 										"ip": schema.StringAttribute{
 											MarkdownDescription: "Use given IP address as VIP on the site.",
 											Optional:            true,
+											Validators: []validator.String{
+												validators.IPv4Validator(),
+											},
 										},
 										"network": schema.StringAttribute{
 											MarkdownDescription: "[Enum: SITE_NETWORK_SPECIFIED_VIP_OUTSIDE|SITE_NETWORK_SPECIFIED_VIP_INSIDE] Defines network types to be used on virtual-site with specified VIP All outside networks. All inside networks. Possible values are `SITE_NETWORK_SPECIFIED_VIP_OUTSIDE`, `SITE_NETWORK_SPECIFIED_VIP_INSIDE`. Defaults to `SITE_NETWORK_SPECIFIED_VIP_OUTSIDE`.",
@@ -656,6 +747,10 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -664,6 +759,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -672,6 +770,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -687,6 +788,10 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -695,6 +800,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -703,6 +811,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -712,6 +823,10 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 												"name": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 													Optional:            true,
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(128),
+														stringvalidator.LengthAtLeast(1),
+													},
 												},
 												"namespace": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -720,6 +835,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 												"tenant": schema.StringAttribute{
 													MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -728,6 +846,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 													PlanModifiers: []planmodifier.String{
 														stringplanmodifier.UseStateForUnknown(),
 													},
+													Validators: []validator.String{
+														stringvalidator.LengthAtMost(64),
+													},
 												},
 											},
 										},
@@ -748,6 +869,10 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 							"name": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(128),
+									stringvalidator.LengthAtLeast(1),
+								},
 							},
 							"namespace": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -756,6 +881,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 							"tenant": schema.StringAttribute{
 								MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -764,6 +892,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								PlanModifiers: []planmodifier.String{
 									stringplanmodifier.UseStateForUnknown(),
 								},
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(64),
+								},
 							},
 						},
 					},
@@ -786,11 +917,18 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 			},
 			"origin_pools_weights": schema.ListNestedBlock{
 				MarkdownDescription: "Origin pools with weights and priorities used for this load balancer.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(16),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"priority": schema.Int64Attribute{
 							MarkdownDescription: "Priority of this origin pool, valid only with multiple origin pools. Value of 0 will make the pool as lowest priority origin pool Priority of 1 means highest priority and is considered active. When active origin pool is not available, lower priority origin pools are made active as per the..",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtMost(32),
+							},
 						},
 						"weight": schema.Int64Attribute{
 							MarkdownDescription: "Weight of this origin pool, valid only with multiple origin pool. Value of 0 will disable the pool.",
@@ -804,6 +942,10 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -812,6 +954,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -820,6 +965,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -832,6 +980,10 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 								"name": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then name will hold the referred object's(e.g. Route's) name.",
 									Optional:            true,
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(128),
+										stringvalidator.LengthAtLeast(1),
+									},
 								},
 								"namespace": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then namespace will hold the referred object's(e.g. Route's) namespace.",
@@ -840,6 +992,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 								"tenant": schema.StringAttribute{
 									MarkdownDescription: "When a configuration object(e.g. Virtual_host) refers to another(e.g route) then tenant will hold the referred object's(e.g. Route's) tenant.",
@@ -848,6 +1003,9 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 									PlanModifiers: []planmodifier.String{
 										stringplanmodifier.UseStateForUnknown(),
 									},
+									Validators: []validator.String{
+										stringvalidator.LengthAtMost(64),
+									},
 								},
 							},
 						},
@@ -861,6 +1019,11 @@ func (r *UDPLoadBalancerResource) Schema(ctx context.Context, req resource.Schem
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *UDPLoadBalancerResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *UDPLoadBalancerResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -885,6 +1048,22 @@ func (r *UDPLoadBalancerResource) ValidateConfig(ctx context.Context, req resour
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *UDPLoadBalancerResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "advertise_choice", "advertise_custom", "advertise_on_public", "advertise_on_public_default_vip", "do_not_advertise"),
+		validators.OneOfGroup(path.MatchRelative(), "hash_policy_choice", "hash_policy_choice_random", "hash_policy_choice_round_robin", "hash_policy_choice_source_ip_stickiness"),
+		validators.OneOfGroup(path.MatchRelative(), "port_choice", "listen_port", "port_ranges"),
+		validators.OneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex(), "choice", "advertise_on_public", "site", "virtual_network", "virtual_site", "virtual_site_with_vip", "vk8s_service"),
+		validators.RequiredOneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex(), "port_choice", "port", "port_ranges", "use_default_port"),
+		validators.OneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex().AtName("virtual_network"), "v6_vip_choice", "default_v6_vip", "specific_v6_vip"),
+		validators.OneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex().AtName("virtual_network"), "vip_choice", "default_vip", "specific_vip"),
+		validators.OneOfGroup(path.MatchRoot("advertise_custom").AtName("advertise_where").AtAnyListIndex().AtName("vk8s_service"), "choice", "site", "virtual_site"),
+		validators.OneOfGroup(path.MatchRoot("origin_pools_weights").AtAnyListIndex(), "pool_choice", "cluster", "pool"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *UDPLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -895,7 +1074,10 @@ func (r *UDPLoadBalancerResource) ModifyPlan(ctx context.Context, req resource.M
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "udp_loadbalancer", "", req.Config, &resp.Plan)...)
 		var plan UDPLoadBalancerResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -952,6 +1134,7 @@ func (r *UDPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -961,6 +1144,7 @@ func (r *UDPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AdvertiseCustom != nil {
@@ -971,6 +1155,19 @@ func (r *UDPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 				listItemMap := make(map[string]interface{})
 				if listItem.AdvertiseOnPublic != nil {
 					advertise_on_publicDeepMap := make(map[string]interface{})
+					if listItem.AdvertiseOnPublic.PublicIP != nil {
+						public_ipLevel4Map := make(map[string]interface{})
+						if !listItem.AdvertiseOnPublic.PublicIP.Name.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Name.IsUnknown() {
+							public_ipLevel4Map["name"] = listItem.AdvertiseOnPublic.PublicIP.Name.ValueString()
+						}
+						if !listItem.AdvertiseOnPublic.PublicIP.Namespace.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Namespace.IsUnknown() {
+							public_ipLevel4Map["namespace"] = listItem.AdvertiseOnPublic.PublicIP.Namespace.ValueString()
+						}
+						if !listItem.AdvertiseOnPublic.PublicIP.Tenant.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Tenant.IsUnknown() {
+							public_ipLevel4Map["tenant"] = listItem.AdvertiseOnPublic.PublicIP.Tenant.ValueString()
+						}
+						advertise_on_publicDeepMap["public_ip"] = public_ipLevel4Map
+					}
 					listItemMap["advertise_on_public"] = advertise_on_publicDeepMap
 				}
 				if !listItem.Port.IsNull() && !listItem.Port.IsUnknown() {
@@ -987,6 +1184,19 @@ func (r *UDPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 					if !listItem.Site.Network.IsNull() && !listItem.Site.Network.IsUnknown() {
 						siteDeepMap["network"] = listItem.Site.Network.ValueString()
 					}
+					if listItem.Site.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Site.Site.Name.IsNull() && !listItem.Site.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Site.Site.Name.ValueString()
+						}
+						if !listItem.Site.Site.Namespace.IsNull() && !listItem.Site.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Site.Site.Namespace.ValueString()
+						}
+						if !listItem.Site.Site.Tenant.IsNull() && !listItem.Site.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Site.Site.Tenant.ValueString()
+						}
+						siteDeepMap["site"] = siteLevel4Map
+					}
 					listItemMap["site"] = siteDeepMap
 				}
 				if listItem.UseDefaultPort != nil {
@@ -1006,6 +1216,19 @@ func (r *UDPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 					if !listItem.VirtualNetwork.SpecificVIP.IsNull() && !listItem.VirtualNetwork.SpecificVIP.IsUnknown() {
 						virtual_networkDeepMap["specific_vip"] = listItem.VirtualNetwork.SpecificVIP.ValueString()
 					}
+					if listItem.VirtualNetwork.VirtualNetwork != nil {
+						virtual_networkLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualNetwork.VirtualNetwork.Name.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Name.IsUnknown() {
+							virtual_networkLevel4Map["name"] = listItem.VirtualNetwork.VirtualNetwork.Name.ValueString()
+						}
+						if !listItem.VirtualNetwork.VirtualNetwork.Namespace.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Namespace.IsUnknown() {
+							virtual_networkLevel4Map["namespace"] = listItem.VirtualNetwork.VirtualNetwork.Namespace.ValueString()
+						}
+						if !listItem.VirtualNetwork.VirtualNetwork.Tenant.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Tenant.IsUnknown() {
+							virtual_networkLevel4Map["tenant"] = listItem.VirtualNetwork.VirtualNetwork.Tenant.ValueString()
+						}
+						virtual_networkDeepMap["virtual_network"] = virtual_networkLevel4Map
+					}
 					listItemMap["virtual_network"] = virtual_networkDeepMap
 				}
 				if listItem.VirtualSite != nil {
@@ -1013,6 +1236,19 @@ func (r *UDPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 					if !listItem.VirtualSite.Network.IsNull() && !listItem.VirtualSite.Network.IsUnknown() {
 						virtual_siteDeepMap["network"] = listItem.VirtualSite.Network.ValueString()
 					}
+					if listItem.VirtualSite.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSite.VirtualSite.Name.IsNull() && !listItem.VirtualSite.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSite.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Namespace.IsNull() && !listItem.VirtualSite.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSite.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Tenant.IsNull() && !listItem.VirtualSite.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSite.VirtualSite.Tenant.ValueString()
+						}
+						virtual_siteDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site"] = virtual_siteDeepMap
 				}
 				if listItem.VirtualSiteWithVIP != nil {
@@ -1023,10 +1259,49 @@ func (r *UDPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 					if !listItem.VirtualSiteWithVIP.Network.IsNull() && !listItem.VirtualSiteWithVIP.Network.IsUnknown() {
 						virtual_site_with_vipDeepMap["network"] = listItem.VirtualSiteWithVIP.Network.ValueString()
 					}
+					if listItem.VirtualSiteWithVIP.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Name.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSiteWithVIP.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Namespace.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSiteWithVIP.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Tenant.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSiteWithVIP.VirtualSite.Tenant.ValueString()
+						}
+						virtual_site_with_vipDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site_with_vip"] = virtual_site_with_vipDeepMap
 				}
 				if listItem.Vk8sService != nil {
 					vk8s_serviceDeepMap := make(map[string]interface{})
+					if listItem.Vk8sService.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Vk8sService.Site.Name.IsNull() && !listItem.Vk8sService.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Vk8sService.Site.Name.ValueString()
+						}
+						if !listItem.Vk8sService.Site.Namespace.IsNull() && !listItem.Vk8sService.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Vk8sService.Site.Namespace.ValueString()
+						}
+						if !listItem.Vk8sService.Site.Tenant.IsNull() && !listItem.Vk8sService.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Vk8sService.Site.Tenant.ValueString()
+						}
+						vk8s_serviceDeepMap["site"] = siteLevel4Map
+					}
+					if listItem.Vk8sService.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.Vk8sService.VirtualSite.Name.IsNull() && !listItem.Vk8sService.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.Vk8sService.VirtualSite.Name.ValueString()
+						}
+						if !listItem.Vk8sService.VirtualSite.Namespace.IsNull() && !listItem.Vk8sService.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.Vk8sService.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.Vk8sService.VirtualSite.Tenant.IsNull() && !listItem.Vk8sService.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.Vk8sService.VirtualSite.Tenant.ValueString()
+						}
+						vk8s_serviceDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["vk8s_service"] = vk8s_serviceDeepMap
 				}
 				advertise_whereList = append(advertise_whereList, listItemMap)
@@ -1149,11 +1424,15 @@ func (r *UDPLoadBalancerResource) Create(ctx context.Context, req resource.Creat
 
 	apiResource, err := r.client.CreateUDPLoadBalancer(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create UDPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "udp_loadbalancer", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -1489,7 +1768,7 @@ func (r *UDPLoadBalancerResource) Read(ctx context.Context, req resource.ReadReq
 	apiResource, err := r.client.GetUDPLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "UDPLoadBalancer not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -1497,11 +1776,12 @@ func (r *UDPLoadBalancerResource) Read(ctx context.Context, req resource.ReadReq
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read UDPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "udp_loadbalancer", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -1512,31 +1792,13 @@ func (r *UDPLoadBalancerResource) Read(ctx context.Context, req resource.ReadReq
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -1544,6 +1806,7 @@ func (r *UDPLoadBalancerResource) Read(ctx context.Context, req resource.ReadReq
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if blockData, ok := apiResource.Spec["advertise_custom"].(map[string]interface{}); ok && (isImport || data.AdvertiseCustom != nil) {
 		data.AdvertiseCustom = &UDPLoadBalancerAdvertiseCustomModel{
@@ -1891,6 +2154,7 @@ func (r *UDPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -1900,6 +2164,7 @@ func (r *UDPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if data.AdvertiseCustom != nil {
@@ -1910,6 +2175,19 @@ func (r *UDPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 				listItemMap := make(map[string]interface{})
 				if listItem.AdvertiseOnPublic != nil {
 					advertise_on_publicDeepMap := make(map[string]interface{})
+					if listItem.AdvertiseOnPublic.PublicIP != nil {
+						public_ipLevel4Map := make(map[string]interface{})
+						if !listItem.AdvertiseOnPublic.PublicIP.Name.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Name.IsUnknown() {
+							public_ipLevel4Map["name"] = listItem.AdvertiseOnPublic.PublicIP.Name.ValueString()
+						}
+						if !listItem.AdvertiseOnPublic.PublicIP.Namespace.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Namespace.IsUnknown() {
+							public_ipLevel4Map["namespace"] = listItem.AdvertiseOnPublic.PublicIP.Namespace.ValueString()
+						}
+						if !listItem.AdvertiseOnPublic.PublicIP.Tenant.IsNull() && !listItem.AdvertiseOnPublic.PublicIP.Tenant.IsUnknown() {
+							public_ipLevel4Map["tenant"] = listItem.AdvertiseOnPublic.PublicIP.Tenant.ValueString()
+						}
+						advertise_on_publicDeepMap["public_ip"] = public_ipLevel4Map
+					}
 					listItemMap["advertise_on_public"] = advertise_on_publicDeepMap
 				}
 				if !listItem.Port.IsNull() && !listItem.Port.IsUnknown() {
@@ -1926,6 +2204,19 @@ func (r *UDPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 					if !listItem.Site.Network.IsNull() && !listItem.Site.Network.IsUnknown() {
 						siteDeepMap["network"] = listItem.Site.Network.ValueString()
 					}
+					if listItem.Site.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Site.Site.Name.IsNull() && !listItem.Site.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Site.Site.Name.ValueString()
+						}
+						if !listItem.Site.Site.Namespace.IsNull() && !listItem.Site.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Site.Site.Namespace.ValueString()
+						}
+						if !listItem.Site.Site.Tenant.IsNull() && !listItem.Site.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Site.Site.Tenant.ValueString()
+						}
+						siteDeepMap["site"] = siteLevel4Map
+					}
 					listItemMap["site"] = siteDeepMap
 				}
 				if listItem.UseDefaultPort != nil {
@@ -1945,6 +2236,19 @@ func (r *UDPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 					if !listItem.VirtualNetwork.SpecificVIP.IsNull() && !listItem.VirtualNetwork.SpecificVIP.IsUnknown() {
 						virtual_networkDeepMap["specific_vip"] = listItem.VirtualNetwork.SpecificVIP.ValueString()
 					}
+					if listItem.VirtualNetwork.VirtualNetwork != nil {
+						virtual_networkLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualNetwork.VirtualNetwork.Name.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Name.IsUnknown() {
+							virtual_networkLevel4Map["name"] = listItem.VirtualNetwork.VirtualNetwork.Name.ValueString()
+						}
+						if !listItem.VirtualNetwork.VirtualNetwork.Namespace.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Namespace.IsUnknown() {
+							virtual_networkLevel4Map["namespace"] = listItem.VirtualNetwork.VirtualNetwork.Namespace.ValueString()
+						}
+						if !listItem.VirtualNetwork.VirtualNetwork.Tenant.IsNull() && !listItem.VirtualNetwork.VirtualNetwork.Tenant.IsUnknown() {
+							virtual_networkLevel4Map["tenant"] = listItem.VirtualNetwork.VirtualNetwork.Tenant.ValueString()
+						}
+						virtual_networkDeepMap["virtual_network"] = virtual_networkLevel4Map
+					}
 					listItemMap["virtual_network"] = virtual_networkDeepMap
 				}
 				if listItem.VirtualSite != nil {
@@ -1952,6 +2256,19 @@ func (r *UDPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 					if !listItem.VirtualSite.Network.IsNull() && !listItem.VirtualSite.Network.IsUnknown() {
 						virtual_siteDeepMap["network"] = listItem.VirtualSite.Network.ValueString()
 					}
+					if listItem.VirtualSite.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSite.VirtualSite.Name.IsNull() && !listItem.VirtualSite.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSite.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Namespace.IsNull() && !listItem.VirtualSite.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSite.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSite.VirtualSite.Tenant.IsNull() && !listItem.VirtualSite.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSite.VirtualSite.Tenant.ValueString()
+						}
+						virtual_siteDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site"] = virtual_siteDeepMap
 				}
 				if listItem.VirtualSiteWithVIP != nil {
@@ -1962,10 +2279,49 @@ func (r *UDPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 					if !listItem.VirtualSiteWithVIP.Network.IsNull() && !listItem.VirtualSiteWithVIP.Network.IsUnknown() {
 						virtual_site_with_vipDeepMap["network"] = listItem.VirtualSiteWithVIP.Network.ValueString()
 					}
+					if listItem.VirtualSiteWithVIP.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Name.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.VirtualSiteWithVIP.VirtualSite.Name.ValueString()
+						}
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Namespace.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.VirtualSiteWithVIP.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.VirtualSiteWithVIP.VirtualSite.Tenant.IsNull() && !listItem.VirtualSiteWithVIP.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.VirtualSiteWithVIP.VirtualSite.Tenant.ValueString()
+						}
+						virtual_site_with_vipDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["virtual_site_with_vip"] = virtual_site_with_vipDeepMap
 				}
 				if listItem.Vk8sService != nil {
 					vk8s_serviceDeepMap := make(map[string]interface{})
+					if listItem.Vk8sService.Site != nil {
+						siteLevel4Map := make(map[string]interface{})
+						if !listItem.Vk8sService.Site.Name.IsNull() && !listItem.Vk8sService.Site.Name.IsUnknown() {
+							siteLevel4Map["name"] = listItem.Vk8sService.Site.Name.ValueString()
+						}
+						if !listItem.Vk8sService.Site.Namespace.IsNull() && !listItem.Vk8sService.Site.Namespace.IsUnknown() {
+							siteLevel4Map["namespace"] = listItem.Vk8sService.Site.Namespace.ValueString()
+						}
+						if !listItem.Vk8sService.Site.Tenant.IsNull() && !listItem.Vk8sService.Site.Tenant.IsUnknown() {
+							siteLevel4Map["tenant"] = listItem.Vk8sService.Site.Tenant.ValueString()
+						}
+						vk8s_serviceDeepMap["site"] = siteLevel4Map
+					}
+					if listItem.Vk8sService.VirtualSite != nil {
+						virtual_siteLevel4Map := make(map[string]interface{})
+						if !listItem.Vk8sService.VirtualSite.Name.IsNull() && !listItem.Vk8sService.VirtualSite.Name.IsUnknown() {
+							virtual_siteLevel4Map["name"] = listItem.Vk8sService.VirtualSite.Name.ValueString()
+						}
+						if !listItem.Vk8sService.VirtualSite.Namespace.IsNull() && !listItem.Vk8sService.VirtualSite.Namespace.IsUnknown() {
+							virtual_siteLevel4Map["namespace"] = listItem.Vk8sService.VirtualSite.Namespace.ValueString()
+						}
+						if !listItem.Vk8sService.VirtualSite.Tenant.IsNull() && !listItem.Vk8sService.VirtualSite.Tenant.IsUnknown() {
+							virtual_siteLevel4Map["tenant"] = listItem.Vk8sService.VirtualSite.Tenant.ValueString()
+						}
+						vk8s_serviceDeepMap["virtual_site"] = virtual_siteLevel4Map
+					}
 					listItemMap["vk8s_service"] = vk8s_serviceDeepMap
 				}
 				advertise_whereList = append(advertise_whereList, listItemMap)
@@ -2088,7 +2444,7 @@ func (r *UDPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 
 	_, err := r.client.UpdateUDPLoadBalancer(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update UDPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "udp_loadbalancer", "update"))
 		return
 	}
 
@@ -2099,9 +2455,12 @@ func (r *UDPLoadBalancerResource) Update(ctx context.Context, req resource.Updat
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetUDPLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read UDPLoadBalancer after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "udp_loadbalancer", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["dns_volterra_managed"].(bool); ok {
@@ -2472,7 +2831,7 @@ func (r *UDPLoadBalancerResource) Delete(ctx context.Context, req resource.Delet
 	err := r.client.DeleteUDPLoadBalancer(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "UDPLoadBalancer already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -2481,37 +2840,33 @@ func (r *UDPLoadBalancerResource) Delete(ctx context.Context, req resource.Delet
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "UDPLoadBalancer delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete UDPLoadBalancer: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "udp_loadbalancer", "delete"))
 		return
 	}
 }
 
 func (r *UDPLoadBalancerResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *UDPLoadBalancerResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("udp_loadbalancer", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *UDPLoadBalancerResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListUDPLoadBalancers(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *UDPLoadBalancerResource) requiredNamespace() string {
+	return ""
 }
@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,18 @@ type UserIdentificationDataSource struct {
 	client *client.Client
 }
 
+// UserIdentificationDataSourceModel mirrors UserIdentificationResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type UserIdentificationDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata types.Object `tfsdk:"system_metadata"`
+	Name           types.String `tfsdk:"name"`
+	Namespace      types.String `tfsdk:"namespace"`
+	Annotations    types.Map    `tfsdk:"annotations"`
+	Description    types.String `tfsdk:"description"`
+	Disable        types.Bool   `tfsdk:"disable"`
+	Labels         types.Map    `tfsdk:"labels"`
+	ID             types.String `tfsdk:"id"`
+	Rules          types.List   `tfsdk:"rules"`
 }
 
 func (d *UserIdentificationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +45,7 @@ func (d *UserIdentificationDataSource) Metadata(ctx context.Context, req datasou
 }
 
 func (d *UserIdentificationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages user_identification creates a new object in the storage backend for metadata.namespace. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the UserIdentification.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the UserIdentification exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the UserIdentification.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewUserIdentificationResource())
 }
 
 func (d *UserIdentificationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +67,25 @@ func (d *UserIdentificationDataSource) Read(ctx context.Context, req datasource.
 		return
 	}
 
-	resource, err := d.client.GetUserIdentification(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetUserIdentification(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read UserIdentification: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "user_identification", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +99,8 @@ func (d *UserIdentificationDataSource) Read(ctx context.Context, req datasource.
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +109,122 @@ func (d *UserIdentificationDataSource) Read(ctx context.Context, req datasource.
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if listData, ok := apiResource.Spec["rules"].([]interface{}); ok && len(listData) > 0 {
+		var rulesList []UserIdentificationRulesModel
+		var existingRulesItems []UserIdentificationRulesModel
+		if !data.Rules.IsNull() && !data.Rules.IsUnknown() {
+			data.Rules.ElementsAs(ctx, &existingRulesItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				rulesList = append(rulesList, UserIdentificationRulesModel{
+					ClientAsn: func() *UserIdentificationEmptyModel {
+						if !isImport && len(existingRulesItems) > listIdx && existingRulesItems[listIdx].ClientAsn != nil {
+							return &UserIdentificationEmptyModel{}
+						}
+						return nil
+					}(),
+					ClientCity: func() *UserIdentificationEmptyModel {
+						if !isImport && len(existingRulesItems) > listIdx && existingRulesItems[listIdx].ClientCity != nil {
+							return &UserIdentificationEmptyModel{}
+						}
+						return nil
+					}(),
+					ClientCountry: func() *UserIdentificationEmptyModel {
+						if !isImport && len(existingRulesItems) > listIdx && existingRulesItems[listIdx].ClientCountry != nil {
+							return &UserIdentificationEmptyModel{}
+						}
+						return nil
+					}(),
+					ClientIP: func() *UserIdentificationEmptyModel {
+						if !isImport && len(existingRulesItems) > listIdx && existingRulesItems[listIdx].ClientIP != nil {
+							return &UserIdentificationEmptyModel{}
+						}
+						return nil
+					}(),
+					ClientRegion: func() *UserIdentificationEmptyModel {
+						if !isImport && len(existingRulesItems) > listIdx && existingRulesItems[listIdx].ClientRegion != nil {
+							return &UserIdentificationEmptyModel{}
+						}
+						return nil
+					}(),
+					CookieName: func() types.String {
+						if v, ok := itemMap["cookie_name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					HTTPHeaderName: func() types.String {
+						if v, ok := itemMap["http_header_name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					IPAndHTTPHeaderName: func() types.String {
+						if v, ok := itemMap["ip_and_http_header_name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					IPAndJa4TLSFingerprint: func() *UserIdentificationEmptyModel {
+						if !isImport && len(existingRulesItems) > listIdx && existingRulesItems[listIdx].IPAndJa4TLSFingerprint != nil {
+							return &UserIdentificationEmptyModel{}
+						}
+						return nil
+					}(),
+					IPAndTLSFingerprint: func() *UserIdentificationEmptyModel {
+						if !isImport && len(existingRulesItems) > listIdx && existingRulesItems[listIdx].IPAndTLSFingerprint != nil {
+							return &UserIdentificationEmptyModel{}
+						}
+						return nil
+					}(),
+					Ja4TLSFingerprint: func() *UserIdentificationEmptyModel {
+						if !isImport && len(existingRulesItems) > listIdx && existingRulesItems[listIdx].Ja4TLSFingerprint != nil {
+							return &UserIdentificationEmptyModel{}
+						}
+						return nil
+					}(),
+					JWTClaimName: func() types.String {
+						if v, ok := itemMap["jwt_claim_name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					None: func() *UserIdentificationEmptyModel {
+						if !isImport && len(existingRulesItems) > listIdx && existingRulesItems[listIdx].None != nil {
+							return &UserIdentificationEmptyModel{}
+						}
+						return nil
+					}(),
+					QueryParamKey: func() types.String {
+						if v, ok := itemMap["query_param_key"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					TLSFingerprint: func() *UserIdentificationEmptyModel {
+						if !isImport && len(existingRulesItems) > listIdx && existingRulesItems[listIdx].TLSFingerprint != nil {
+							return &UserIdentificationEmptyModel{}
+						}
+						return nil
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: UserIdentificationRulesModelAttrTypes}, rulesList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.Rules = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.Rules = types.ListNull(types.ObjectType{AttrTypes: UserIdentificationRulesModelAttrTypes})
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
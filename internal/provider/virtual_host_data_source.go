@@ -5,13 +5,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -27,13 +26,64 @@ type VirtualHostDataSource struct {
 	client *client.Client
 }
 
+// VirtualHostDataSourceModel mirrors VirtualHostResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type VirtualHostDataSourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Namespace   types.String `tfsdk:"namespace"`
-	Description types.String `tfsdk:"description"`
-	Labels      types.Map    `tfsdk:"labels"`
-	Annotations types.Map    `tfsdk:"annotations"`
+	SystemMetadata             types.Object                         `tfsdk:"system_metadata"`
+	Name                       types.String                         `tfsdk:"name"`
+	Namespace                  types.String                         `tfsdk:"namespace"`
+	Annotations                types.Map                            `tfsdk:"annotations"`
+	Description                types.String                         `tfsdk:"description"`
+	Disable                    types.Bool                           `tfsdk:"disable"`
+	Domains                    types.List                           `tfsdk:"domains"`
+	Labels                     types.Map                            `tfsdk:"labels"`
+	RequestCookiesToRemove     types.List                           `tfsdk:"request_cookies_to_remove"`
+	RequestHeadersToRemove     types.List                           `tfsdk:"request_headers_to_remove"`
+	ResponseCookiesToRemove    types.List                           `tfsdk:"response_cookies_to_remove"`
+	ResponseHeadersToRemove    types.List                           `tfsdk:"response_headers_to_remove"`
+	ID                         types.String                         `tfsdk:"id"`
+	AddLocation                types.Bool                           `tfsdk:"add_location"`
+	AppendServerName           types.String                         `tfsdk:"append_server_name"`
+	ConnectionIdleTimeout      types.Int64                          `tfsdk:"connection_idle_timeout"`
+	DisableDefaultErrorPages   types.Bool                           `tfsdk:"disable_default_error_pages"`
+	DisableDNSResolve          types.Bool                           `tfsdk:"disable_dns_resolve"`
+	IdleTimeout                types.Int64                          `tfsdk:"idle_timeout"`
+	MaxRequestHeaderSize       types.Int64                          `tfsdk:"max_request_header_size"`
+	Proxy                      types.String                         `tfsdk:"proxy"`
+	ServerName                 types.String                         `tfsdk:"server_name"`
+	AdvertisePolicies          types.List                           `tfsdk:"advertise_policies"`
+	Authentication             *VirtualHostAuthenticationModel      `tfsdk:"authentication"`
+	BufferPolicy               *VirtualHostBufferPolicyModel        `tfsdk:"buffer_policy"`
+	CaptchaChallenge           *VirtualHostCaptchaChallengeModel    `tfsdk:"captcha_challenge"`
+	CoalescingOptions          *VirtualHostCoalescingOptionsModel   `tfsdk:"coalescing_options"`
+	CompressionParams          *VirtualHostCompressionParamsModel   `tfsdk:"compression_params"`
+	CORSPolicy                 *VirtualHostCORSPolicyModel          `tfsdk:"cors_policy"`
+	CSRFPolicy                 *VirtualHostCSRFPolicyModel          `tfsdk:"csrf_policy"`
+	CustomErrors               *VirtualHostEmptyModel               `tfsdk:"custom_errors"`
+	DefaultHeader              *VirtualHostEmptyModel               `tfsdk:"default_header"`
+	DefaultLoadBalancer        *VirtualHostEmptyModel               `tfsdk:"default_loadbalancer"`
+	DisablePathNormalize       *VirtualHostEmptyModel               `tfsdk:"disable_path_normalize"`
+	DynamicReverseProxy        *VirtualHostDynamicReverseProxyModel `tfsdk:"dynamic_reverse_proxy"`
+	EnablePathNormalize        *VirtualHostEmptyModel               `tfsdk:"enable_path_normalize"`
+	HTTPProtocolOptions        *VirtualHostHTTPProtocolOptionsModel `tfsdk:"http_protocol_options"`
+	JsChallenge                *VirtualHostJsChallengeModel         `tfsdk:"js_challenge"`
+	NoAuthentication           *VirtualHostEmptyModel               `tfsdk:"no_authentication"`
+	NoChallenge                *VirtualHostEmptyModel               `tfsdk:"no_challenge"`
+	NonDefaultLoadBalancer     *VirtualHostEmptyModel               `tfsdk:"non_default_loadbalancer"`
+	PassThrough                *VirtualHostEmptyModel               `tfsdk:"pass_through"`
+	RateLimiterAllowedPrefixes types.List                           `tfsdk:"rate_limiter_allowed_prefixes"`
+	RequestCookiesToAdd        types.List                           `tfsdk:"request_cookies_to_add"`
+	RequestHeadersToAdd        types.List                           `tfsdk:"request_headers_to_add"`
+	ResponseCookiesToAdd       types.List                           `tfsdk:"response_cookies_to_add"`
+	ResponseHeadersToAdd       types.List                           `tfsdk:"response_headers_to_add"`
+	RetryPolicy                *VirtualHostRetryPolicyModel         `tfsdk:"retry_policy"`
+	Routes                     types.List                           `tfsdk:"routes"`
+	SensitiveDataPolicy        types.List                           `tfsdk:"sensitive_data_policy"`
+	SlowDDOSMitigation         *VirtualHostSlowDDOSMitigationModel  `tfsdk:"slow_ddos_mitigation"`
+	TLSCertParams              *VirtualHostTLSCertParamsModel       `tfsdk:"tls_cert_params"`
+	TLSParameters              *VirtualHostTLSParametersModel       `tfsdk:"tls_parameters"`
+	UserIdentification         types.List                           `tfsdk:"user_identification"`
+	WAFType                    *VirtualHostWAFTypeModel             `tfsdk:"waf_type"`
 }
 
 func (d *VirtualHostDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -41,37 +91,7 @@ func (d *VirtualHostDataSource) Metadata(ctx context.Context, req datasource.Met
 }
 
 func (d *VirtualHostDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages virtual host in a given namespace. in F5 Distributed Cloud.",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the VirtualHost.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the VirtualHost exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the VirtualHost.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, NewVirtualHostResource())
 }
 
 func (d *VirtualHostDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -93,24 +113,25 @@ func (d *VirtualHostDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	resource, err := d.client.GetVirtualHost(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.GetVirtualHost(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read VirtualHost: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "virtual_host", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -124,8 +145,8 @@ func (d *VirtualHostDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -134,5 +155,1612 @@ func (d *VirtualHostDataSource) Read(ctx context.Context, req datasource.ReadReq
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
-	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+	if listData, ok := apiResource.Spec["advertise_policies"].([]interface{}); ok && len(listData) > 0 {
+		var advertise_policiesList []VirtualHostAdvertisePoliciesModel
+		var existingAdvertisePoliciesItems []VirtualHostAdvertisePoliciesModel
+		if !data.AdvertisePolicies.IsNull() && !data.AdvertisePolicies.IsUnknown() {
+			data.AdvertisePolicies.ElementsAs(ctx, &existingAdvertisePoliciesItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				advertise_policiesList = append(advertise_policiesList, VirtualHostAdvertisePoliciesModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VirtualHostAdvertisePoliciesModelAttrTypes}, advertise_policiesList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.AdvertisePolicies = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.AdvertisePolicies = types.ListNull(types.ObjectType{AttrTypes: VirtualHostAdvertisePoliciesModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["authentication"].(map[string]interface{}); ok && (isImport || data.Authentication != nil) {
+		data.Authentication = &VirtualHostAuthenticationModel{
+			AuthConfig: func() []VirtualHostAuthenticationAuthConfigModel {
+				if listData, ok := blockData["auth_config"].([]interface{}); ok && len(listData) > 0 {
+					var result []VirtualHostAuthenticationAuthConfigModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, VirtualHostAuthenticationAuthConfigModel{
+								Kind: func() types.String {
+									if v, ok := itemMap["kind"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := itemMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := itemMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Uid: func() types.String {
+									if v, ok := itemMap["uid"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			CookieParams: func() *VirtualHostAuthenticationCookieParamsModel {
+				if !isImport && data.Authentication != nil && data.Authentication.CookieParams != nil {
+					// Normal Read: preserve existing state value
+					return data.Authentication.CookieParams
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["cookie_params"].(map[string]interface{}); ok {
+					return &VirtualHostAuthenticationCookieParamsModel{
+						CookieExpiry: func() types.Int64 {
+							if v, ok := nestedBlockData["cookie_expiry"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						CookieRefreshInterval: func() types.Int64 {
+							if v, ok := nestedBlockData["cookie_refresh_interval"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						SessionExpiry: func() types.Int64 {
+							if v, ok := nestedBlockData["session_expiry"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			RedirectDynamic: func() *VirtualHostEmptyModel {
+				if !isImport && data.Authentication != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.Authentication.RedirectDynamic
+				}
+				// Import case: read from API
+				if _, ok := blockData["redirect_dynamic"].(map[string]interface{}); ok {
+					return &VirtualHostEmptyModel{}
+				}
+				return nil
+			}(),
+			RedirectURL: func() types.String {
+				if v, ok := blockData["redirect_url"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			UseAuthObjectConfig: func() *VirtualHostEmptyModel {
+				if !isImport && data.Authentication != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.Authentication.UseAuthObjectConfig
+				}
+				// Import case: read from API
+				if _, ok := blockData["use_auth_object_config"].(map[string]interface{}); ok {
+					return &VirtualHostEmptyModel{}
+				}
+				return nil
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["buffer_policy"].(map[string]interface{}); ok && (isImport || data.BufferPolicy != nil) {
+		data.BufferPolicy = &VirtualHostBufferPolicyModel{
+			Disabled: func() types.Bool {
+				if !isImport && data.BufferPolicy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.BufferPolicy.Disabled
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["disabled"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			MaxRequestBytes: func() types.Int64 {
+				if !isImport && data.BufferPolicy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.BufferPolicy.MaxRequestBytes
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["max_request_bytes"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["captcha_challenge"].(map[string]interface{}); ok && (isImport || data.CaptchaChallenge != nil) {
+		data.CaptchaChallenge = &VirtualHostCaptchaChallengeModel{
+			CookieExpiry: func() types.Int64 {
+				if !isImport && data.CaptchaChallenge != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.CaptchaChallenge.CookieExpiry
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["cookie_expiry"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			CustomPage: func() types.String {
+				if v, ok := blockData["custom_page"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["coalescing_options"].(map[string]interface{}); ok && isImport && data.CoalescingOptions == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.CoalescingOptions = &VirtualHostCoalescingOptionsModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["compression_params"].(map[string]interface{}); ok && (isImport || data.CompressionParams != nil) {
+		data.CompressionParams = &VirtualHostCompressionParamsModel{
+			ContentLength: func() types.Int64 {
+				if !isImport && data.CompressionParams != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.CompressionParams.ContentLength
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["content_length"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			ContentType: func() types.List {
+				if v, ok := blockData["content_type"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			DisableOnEtagHeader: func() types.Bool {
+				if !isImport && data.CompressionParams != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.CompressionParams.DisableOnEtagHeader
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["disable_on_etag_header"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			RemoveAcceptEncodingHeader: func() types.Bool {
+				if !isImport && data.CompressionParams != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.CompressionParams.RemoveAcceptEncodingHeader
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["remove_accept_encoding_header"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["cors_policy"].(map[string]interface{}); ok && (isImport || data.CORSPolicy != nil) {
+		data.CORSPolicy = &VirtualHostCORSPolicyModel{
+			AllowCredentials: func() types.Bool {
+				if !isImport && data.CORSPolicy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.CORSPolicy.AllowCredentials
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["allow_credentials"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			AllowHeaders: func() types.String {
+				if v, ok := blockData["allow_headers"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			AllowMethods: func() types.String {
+				if v, ok := blockData["allow_methods"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			AllowOrigin: func() types.List {
+				if v, ok := blockData["allow_origin"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			AllowOriginRegex: func() types.List {
+				if v, ok := blockData["allow_origin_regex"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			Disabled: func() types.Bool {
+				if !isImport && data.CORSPolicy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.CORSPolicy.Disabled
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["disabled"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+			ExposeHeaders: func() types.String {
+				if v, ok := blockData["expose_headers"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			MaximumAge: func() types.Int64 {
+				if !isImport && data.CORSPolicy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.CORSPolicy.MaximumAge
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["maximum_age"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["csrf_policy"].(map[string]interface{}); ok && isImport && data.CSRFPolicy == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.CSRFPolicy = &VirtualHostCSRFPolicyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["custom_errors"].(map[string]interface{}); ok && isImport && data.CustomErrors == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.CustomErrors = &VirtualHostEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["default_header"].(map[string]interface{}); ok && isImport && data.DefaultHeader == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DefaultHeader = &VirtualHostEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["default_loadbalancer"].(map[string]interface{}); ok && isImport && data.DefaultLoadBalancer == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DefaultLoadBalancer = &VirtualHostEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["disable_path_normalize"].(map[string]interface{}); ok && isImport && data.DisablePathNormalize == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.DisablePathNormalize = &VirtualHostEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if v, ok := apiResource.Spec["domains"].([]interface{}); ok && len(v) > 0 {
+		var domainsList []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				domainsList = append(domainsList, s)
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.StringType, domainsList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.Domains = listVal
+		}
+	} else {
+		data.Domains = types.ListNull(types.StringType)
+	}
+	if blockData, ok := apiResource.Spec["dynamic_reverse_proxy"].(map[string]interface{}); ok && (isImport || data.DynamicReverseProxy != nil) {
+		data.DynamicReverseProxy = &VirtualHostDynamicReverseProxyModel{
+			ConnectionTimeout: func() types.Int64 {
+				if !isImport && data.DynamicReverseProxy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.DynamicReverseProxy.ConnectionTimeout
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["connection_timeout"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			ResolutionNetwork: func() []VirtualHostDynamicReverseProxyResolutionNetworkModel {
+				if listData, ok := blockData["resolution_network"].([]interface{}); ok && len(listData) > 0 {
+					var result []VirtualHostDynamicReverseProxyResolutionNetworkModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, VirtualHostDynamicReverseProxyResolutionNetworkModel{
+								Kind: func() types.String {
+									if v, ok := itemMap["kind"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := itemMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := itemMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Uid: func() types.String {
+									if v, ok := itemMap["uid"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			ResolutionNetworkType: func() types.String {
+				if v, ok := blockData["resolution_network_type"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			ResolveEndpointDynamically: func() types.Bool {
+				if !isImport && data.DynamicReverseProxy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults from overwriting user intent
+					return data.DynamicReverseProxy.ResolveEndpointDynamically
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.BoolNull()
+				}
+				// Import case: read from API
+				if v, ok := blockData["resolve_endpoint_dynamically"].(bool); ok {
+					return types.BoolValue(v)
+				}
+				return types.BoolNull()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["enable_path_normalize"].(map[string]interface{}); ok && isImport && data.EnablePathNormalize == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.EnablePathNormalize = &VirtualHostEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["http_protocol_options"].(map[string]interface{}); ok && isImport && data.HTTPProtocolOptions == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.HTTPProtocolOptions = &VirtualHostHTTPProtocolOptionsModel{}
+	}
+	// Normal Read: preserve existing state value
+	if blockData, ok := apiResource.Spec["js_challenge"].(map[string]interface{}); ok && (isImport || data.JsChallenge != nil) {
+		data.JsChallenge = &VirtualHostJsChallengeModel{
+			CookieExpiry: func() types.Int64 {
+				if !isImport && data.JsChallenge != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.JsChallenge.CookieExpiry
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["cookie_expiry"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			CustomPage: func() types.String {
+				if v, ok := blockData["custom_page"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			JsScriptDelay: func() types.Int64 {
+				if !isImport && data.JsChallenge != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.JsChallenge.JsScriptDelay
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["js_script_delay"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if _, ok := apiResource.Spec["no_authentication"].(map[string]interface{}); ok && isImport && data.NoAuthentication == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoAuthentication = &VirtualHostEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["no_challenge"].(map[string]interface{}); ok && isImport && data.NoChallenge == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NoChallenge = &VirtualHostEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["non_default_loadbalancer"].(map[string]interface{}); ok && isImport && data.NonDefaultLoadBalancer == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.NonDefaultLoadBalancer = &VirtualHostEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if _, ok := apiResource.Spec["pass_through"].(map[string]interface{}); ok && isImport && data.PassThrough == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.PassThrough = &VirtualHostEmptyModel{}
+	}
+	// Normal Read: preserve existing state value
+	if listData, ok := apiResource.Spec["rate_limiter_allowed_prefixes"].([]interface{}); ok && len(listData) > 0 {
+		var rate_limiter_allowed_prefixesList []VirtualHostRateLimiterAllowedPrefixesModel
+		var existingRateLimiterAllowedPrefixesItems []VirtualHostRateLimiterAllowedPrefixesModel
+		if !data.RateLimiterAllowedPrefixes.IsNull() && !data.RateLimiterAllowedPrefixes.IsUnknown() {
+			data.RateLimiterAllowedPrefixes.ElementsAs(ctx, &existingRateLimiterAllowedPrefixesItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				rate_limiter_allowed_prefixesList = append(rate_limiter_allowed_prefixesList, VirtualHostRateLimiterAllowedPrefixesModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VirtualHostRateLimiterAllowedPrefixesModelAttrTypes}, rate_limiter_allowed_prefixesList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.RateLimiterAllowedPrefixes = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.RateLimiterAllowedPrefixes = types.ListNull(types.ObjectType{AttrTypes: VirtualHostRateLimiterAllowedPrefixesModelAttrTypes})
+	}
+	if listData, ok := apiResource.Spec["request_cookies_to_add"].([]interface{}); ok && len(listData) > 0 {
+		var request_cookies_to_addList []VirtualHostRequestCookiesToAddModel
+		var existingRequestCookiesToAddItems []VirtualHostRequestCookiesToAddModel
+		if !data.RequestCookiesToAdd.IsNull() && !data.RequestCookiesToAdd.IsUnknown() {
+			data.RequestCookiesToAdd.ElementsAs(ctx, &existingRequestCookiesToAddItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				request_cookies_to_addList = append(request_cookies_to_addList, VirtualHostRequestCookiesToAddModel{
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Overwrite: func() types.Bool {
+						if v, ok := itemMap["overwrite"].(bool); ok {
+							return types.BoolValue(v)
+						}
+						return types.BoolNull()
+					}(),
+					SecretValue: func() *VirtualHostRequestCookiesToAddSecretValueModel {
+						if _, ok := itemMap["secret_value"].(map[string]interface{}); ok {
+							return &VirtualHostRequestCookiesToAddSecretValueModel{}
+						}
+						return nil
+					}(),
+					Value: func() types.String {
+						if v, ok := itemMap["value"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VirtualHostRequestCookiesToAddModelAttrTypes}, request_cookies_to_addList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.RequestCookiesToAdd = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.RequestCookiesToAdd = types.ListNull(types.ObjectType{AttrTypes: VirtualHostRequestCookiesToAddModelAttrTypes})
+	}
+	if v, ok := apiResource.Spec["request_cookies_to_remove"].([]interface{}); ok && len(v) > 0 {
+		var request_cookies_to_removeList []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				request_cookies_to_removeList = append(request_cookies_to_removeList, s)
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.StringType, request_cookies_to_removeList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.RequestCookiesToRemove = listVal
+		}
+	} else {
+		data.RequestCookiesToRemove = types.ListNull(types.StringType)
+	}
+	if listData, ok := apiResource.Spec["request_headers_to_add"].([]interface{}); ok && len(listData) > 0 {
+		var request_headers_to_addList []VirtualHostRequestHeadersToAddModel
+		var existingRequestHeadersToAddItems []VirtualHostRequestHeadersToAddModel
+		if !data.RequestHeadersToAdd.IsNull() && !data.RequestHeadersToAdd.IsUnknown() {
+			data.RequestHeadersToAdd.ElementsAs(ctx, &existingRequestHeadersToAddItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				request_headers_to_addList = append(request_headers_to_addList, VirtualHostRequestHeadersToAddModel{
+					Append: func() types.Bool {
+						if v, ok := itemMap["append"].(bool); ok {
+							return types.BoolValue(v)
+						}
+						return types.BoolNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					SecretValue: func() *VirtualHostRequestHeadersToAddSecretValueModel {
+						if _, ok := itemMap["secret_value"].(map[string]interface{}); ok {
+							return &VirtualHostRequestHeadersToAddSecretValueModel{}
+						}
+						return nil
+					}(),
+					Value: func() types.String {
+						if v, ok := itemMap["value"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VirtualHostRequestHeadersToAddModelAttrTypes}, request_headers_to_addList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.RequestHeadersToAdd = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.RequestHeadersToAdd = types.ListNull(types.ObjectType{AttrTypes: VirtualHostRequestHeadersToAddModelAttrTypes})
+	}
+	if v, ok := apiResource.Spec["request_headers_to_remove"].([]interface{}); ok && len(v) > 0 {
+		var request_headers_to_removeList []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				request_headers_to_removeList = append(request_headers_to_removeList, s)
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.StringType, request_headers_to_removeList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.RequestHeadersToRemove = listVal
+		}
+	} else {
+		data.RequestHeadersToRemove = types.ListNull(types.StringType)
+	}
+	if listData, ok := apiResource.Spec["response_cookies_to_add"].([]interface{}); ok && len(listData) > 0 {
+		var response_cookies_to_addList []VirtualHostResponseCookiesToAddModel
+		var existingResponseCookiesToAddItems []VirtualHostResponseCookiesToAddModel
+		if !data.ResponseCookiesToAdd.IsNull() && !data.ResponseCookiesToAdd.IsUnknown() {
+			data.ResponseCookiesToAdd.ElementsAs(ctx, &existingResponseCookiesToAddItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				response_cookies_to_addList = append(response_cookies_to_addList, VirtualHostResponseCookiesToAddModel{
+					AddDomain: func() types.String {
+						if v, ok := itemMap["add_domain"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					AddExpiry: func() types.String {
+						if v, ok := itemMap["add_expiry"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					AddHttponly: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].AddHttponly != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					AddPartitioned: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].AddPartitioned != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					AddPath: func() types.String {
+						if v, ok := itemMap["add_path"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					AddSecure: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].AddSecure != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnoreDomain: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].IgnoreDomain != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnoreExpiry: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].IgnoreExpiry != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnoreHttponly: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].IgnoreHttponly != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnoreMaxAge: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].IgnoreMaxAge != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnorePartitioned: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].IgnorePartitioned != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnorePath: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].IgnorePath != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnoreSamesite: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].IgnoreSamesite != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnoreSecure: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].IgnoreSecure != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					IgnoreValue: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].IgnoreValue != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					MaxAgeValue: func() types.Int64 {
+						if v, ok := itemMap["max_age_value"].(float64); ok && v != 0 {
+							return types.Int64Value(int64(v))
+						}
+						return types.Int64Null()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Overwrite: func() types.Bool {
+						if v, ok := itemMap["overwrite"].(bool); ok {
+							return types.BoolValue(v)
+						}
+						return types.BoolNull()
+					}(),
+					SamesiteLax: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].SamesiteLax != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					SamesiteNone: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].SamesiteNone != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					SamesiteStrict: func() *VirtualHostEmptyModel {
+						if !isImport && len(existingResponseCookiesToAddItems) > listIdx && existingResponseCookiesToAddItems[listIdx].SamesiteStrict != nil {
+							return &VirtualHostEmptyModel{}
+						}
+						return nil
+					}(),
+					SecretValue: func() *VirtualHostResponseCookiesToAddSecretValueModel {
+						if _, ok := itemMap["secret_value"].(map[string]interface{}); ok {
+							return &VirtualHostResponseCookiesToAddSecretValueModel{}
+						}
+						return nil
+					}(),
+					Value: func() types.String {
+						if v, ok := itemMap["value"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VirtualHostResponseCookiesToAddModelAttrTypes}, response_cookies_to_addList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.ResponseCookiesToAdd = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.ResponseCookiesToAdd = types.ListNull(types.ObjectType{AttrTypes: VirtualHostResponseCookiesToAddModelAttrTypes})
+	}
+	if v, ok := apiResource.Spec["response_cookies_to_remove"].([]interface{}); ok && len(v) > 0 {
+		var response_cookies_to_removeList []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				response_cookies_to_removeList = append(response_cookies_to_removeList, s)
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.StringType, response_cookies_to_removeList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.ResponseCookiesToRemove = listVal
+		}
+	} else {
+		data.ResponseCookiesToRemove = types.ListNull(types.StringType)
+	}
+	if listData, ok := apiResource.Spec["response_headers_to_add"].([]interface{}); ok && len(listData) > 0 {
+		var response_headers_to_addList []VirtualHostResponseHeadersToAddModel
+		var existingResponseHeadersToAddItems []VirtualHostResponseHeadersToAddModel
+		if !data.ResponseHeadersToAdd.IsNull() && !data.ResponseHeadersToAdd.IsUnknown() {
+			data.ResponseHeadersToAdd.ElementsAs(ctx, &existingResponseHeadersToAddItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				response_headers_to_addList = append(response_headers_to_addList, VirtualHostResponseHeadersToAddModel{
+					Append: func() types.Bool {
+						if v, ok := itemMap["append"].(bool); ok {
+							return types.BoolValue(v)
+						}
+						return types.BoolNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					SecretValue: func() *VirtualHostResponseHeadersToAddSecretValueModel {
+						if _, ok := itemMap["secret_value"].(map[string]interface{}); ok {
+							return &VirtualHostResponseHeadersToAddSecretValueModel{}
+						}
+						return nil
+					}(),
+					Value: func() types.String {
+						if v, ok := itemMap["value"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VirtualHostResponseHeadersToAddModelAttrTypes}, response_headers_to_addList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.ResponseHeadersToAdd = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.ResponseHeadersToAdd = types.ListNull(types.ObjectType{AttrTypes: VirtualHostResponseHeadersToAddModelAttrTypes})
+	}
+	if v, ok := apiResource.Spec["response_headers_to_remove"].([]interface{}); ok && len(v) > 0 {
+		var response_headers_to_removeList []string
+		for _, item := range v {
+			if s, ok := item.(string); ok {
+				response_headers_to_removeList = append(response_headers_to_removeList, s)
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.StringType, response_headers_to_removeList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.ResponseHeadersToRemove = listVal
+		}
+	} else {
+		data.ResponseHeadersToRemove = types.ListNull(types.StringType)
+	}
+	if blockData, ok := apiResource.Spec["retry_policy"].(map[string]interface{}); ok && (isImport || data.RetryPolicy != nil) {
+		data.RetryPolicy = &VirtualHostRetryPolicyModel{
+			BackOff: func() *VirtualHostRetryPolicyBackOffModel {
+				if !isImport && data.RetryPolicy != nil && data.RetryPolicy.BackOff != nil {
+					// Normal Read: preserve existing state value
+					return data.RetryPolicy.BackOff
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["back_off"].(map[string]interface{}); ok {
+					return &VirtualHostRetryPolicyBackOffModel{
+						BaseInterval: func() types.Int64 {
+							if v, ok := nestedBlockData["base_interval"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+						MaxInterval: func() types.Int64 {
+							if v, ok := nestedBlockData["max_interval"].(float64); ok {
+								return types.Int64Value(int64(v))
+							}
+							return types.Int64Null()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			NumRetries: func() types.Int64 {
+				if !isImport && data.RetryPolicy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RetryPolicy.NumRetries
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["num_retries"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			PerTryTimeout: func() types.Int64 {
+				if !isImport && data.RetryPolicy != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.RetryPolicy.PerTryTimeout
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["per_try_timeout"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			RetriableStatusCodes: func() types.List {
+				if v, ok := blockData["retriable_status_codes"].([]interface{}); ok && len(v) > 0 {
+					var items []int64
+					for _, item := range v {
+						if n, ok := item.(float64); ok {
+							items = append(items, int64(n))
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.Int64Type, items)
+					return listVal
+				}
+				return types.ListNull(types.Int64Type)
+			}(),
+			RetryCondition: func() types.List {
+				if v, ok := blockData["retry_condition"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if listData, ok := apiResource.Spec["routes"].([]interface{}); ok && len(listData) > 0 {
+		var routesList []VirtualHostRoutesModel
+		var existingRoutesItems []VirtualHostRoutesModel
+		if !data.Routes.IsNull() && !data.Routes.IsUnknown() {
+			data.Routes.ElementsAs(ctx, &existingRoutesItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				routesList = append(routesList, VirtualHostRoutesModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VirtualHostRoutesModelAttrTypes}, routesList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.Routes = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.Routes = types.ListNull(types.ObjectType{AttrTypes: VirtualHostRoutesModelAttrTypes})
+	}
+	if listData, ok := apiResource.Spec["sensitive_data_policy"].([]interface{}); ok && len(listData) > 0 {
+		var sensitive_data_policyList []VirtualHostSensitiveDataPolicyModel
+		var existingSensitiveDataPolicyItems []VirtualHostSensitiveDataPolicyModel
+		if !data.SensitiveDataPolicy.IsNull() && !data.SensitiveDataPolicy.IsUnknown() {
+			data.SensitiveDataPolicy.ElementsAs(ctx, &existingSensitiveDataPolicyItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				sensitive_data_policyList = append(sensitive_data_policyList, VirtualHostSensitiveDataPolicyModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VirtualHostSensitiveDataPolicyModelAttrTypes}, sensitive_data_policyList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.SensitiveDataPolicy = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.SensitiveDataPolicy = types.ListNull(types.ObjectType{AttrTypes: VirtualHostSensitiveDataPolicyModelAttrTypes})
+	}
+	if blockData, ok := apiResource.Spec["slow_ddos_mitigation"].(map[string]interface{}); ok && (isImport || data.SlowDDOSMitigation != nil) {
+		data.SlowDDOSMitigation = &VirtualHostSlowDDOSMitigationModel{
+			DisableRequestTimeout: func() *VirtualHostEmptyModel {
+				if !isImport && data.SlowDDOSMitigation != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.SlowDDOSMitigation.DisableRequestTimeout
+				}
+				// Import case: read from API
+				if _, ok := blockData["disable_request_timeout"].(map[string]interface{}); ok {
+					return &VirtualHostEmptyModel{}
+				}
+				return nil
+			}(),
+			RequestHeadersTimeout: func() types.Int64 {
+				if !isImport && data.SlowDDOSMitigation != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.SlowDDOSMitigation.RequestHeadersTimeout
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["request_headers_timeout"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+			RequestTimeout: func() types.Int64 {
+				if !isImport && data.SlowDDOSMitigation != nil {
+					// Preserve existing state (null or user-set value)
+					// This prevents API defaults (like 0) from overwriting user intent
+					return data.SlowDDOSMitigation.RequestTimeout
+				}
+				if !isImport {
+					// Block not in user config - return null, not API default
+					return types.Int64Null()
+				}
+				// Import case: read from API
+				if v, ok := blockData["request_timeout"].(float64); ok {
+					return types.Int64Value(int64(v))
+				}
+				return types.Int64Null()
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["tls_cert_params"].(map[string]interface{}); ok && (isImport || data.TLSCertParams != nil) {
+		data.TLSCertParams = &VirtualHostTLSCertParamsModel{
+			Certificates: func() []VirtualHostTLSCertParamsCertificatesModel {
+				if listData, ok := blockData["certificates"].([]interface{}); ok && len(listData) > 0 {
+					var result []VirtualHostTLSCertParamsCertificatesModel
+					for _, item := range listData {
+						if itemMap, ok := item.(map[string]interface{}); ok {
+							result = append(result, VirtualHostTLSCertParamsCertificatesModel{
+								Kind: func() types.String {
+									if v, ok := itemMap["kind"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Name: func() types.String {
+									if v, ok := itemMap["name"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Namespace: func() types.String {
+									if v, ok := itemMap["namespace"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Tenant: func() types.String {
+									if v, ok := itemMap["tenant"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+								Uid: func() types.String {
+									if v, ok := itemMap["uid"].(string); ok && v != "" {
+										return types.StringValue(v)
+									}
+									return types.StringNull()
+								}(),
+							})
+						}
+					}
+					return result
+				}
+				return nil
+			}(),
+			CipherSuites: func() types.List {
+				if v, ok := blockData["cipher_suites"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+			ClientCertificateOptional: func() *VirtualHostEmptyModel {
+				if !isImport && data.TLSCertParams != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.TLSCertParams.ClientCertificateOptional
+				}
+				// Import case: read from API
+				if _, ok := blockData["client_certificate_optional"].(map[string]interface{}); ok {
+					return &VirtualHostEmptyModel{}
+				}
+				return nil
+			}(),
+			ClientCertificateRequired: func() *VirtualHostEmptyModel {
+				if !isImport && data.TLSCertParams != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.TLSCertParams.ClientCertificateRequired
+				}
+				// Import case: read from API
+				if _, ok := blockData["client_certificate_required"].(map[string]interface{}); ok {
+					return &VirtualHostEmptyModel{}
+				}
+				return nil
+			}(),
+			MaximumProtocolVersion: func() types.String {
+				if v, ok := blockData["maximum_protocol_version"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			MinimumProtocolVersion: func() types.String {
+				if v, ok := blockData["minimum_protocol_version"].(string); ok && v != "" {
+					return types.StringValue(v)
+				}
+				return types.StringNull()
+			}(),
+			NoClientCertificate: func() *VirtualHostEmptyModel {
+				if !isImport && data.TLSCertParams != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.TLSCertParams.NoClientCertificate
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_client_certificate"].(map[string]interface{}); ok {
+					return &VirtualHostEmptyModel{}
+				}
+				return nil
+			}(),
+			ValidationParams: func() *VirtualHostTLSCertParamsValidationParamsModel {
+				if !isImport && data.TLSCertParams != nil && data.TLSCertParams.ValidationParams != nil {
+					// Normal Read: preserve existing state value
+					return data.TLSCertParams.ValidationParams
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["validation_params"].(map[string]interface{}); ok {
+					return &VirtualHostTLSCertParamsValidationParamsModel{
+						SkipHostnameVerification: func() types.Bool {
+							if v, ok := nestedBlockData["skip_hostname_verification"].(bool); ok {
+								return types.BoolValue(v)
+							}
+							return types.BoolNull()
+						}(),
+						TrustedCAURL: func() types.String {
+							if v, ok := nestedBlockData["trusted_ca_url"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						VerifySubjectAltNames: func() types.List {
+							if v, ok := nestedBlockData["verify_subject_alt_names"].([]interface{}); ok && len(v) > 0 {
+								var items []string
+								for _, item := range v {
+									if s, ok := item.(string); ok {
+										items = append(items, s)
+									}
+								}
+								listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+								return listVal
+							}
+							return types.ListNull(types.StringType)
+						}(),
+					}
+				}
+				return nil
+			}(),
+			XfccHeaderElements: func() types.List {
+				if v, ok := blockData["xfcc_header_elements"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if blockData, ok := apiResource.Spec["tls_parameters"].(map[string]interface{}); ok && (isImport || data.TLSParameters != nil) {
+		data.TLSParameters = &VirtualHostTLSParametersModel{
+			ClientCertificateOptional: func() *VirtualHostEmptyModel {
+				if !isImport && data.TLSParameters != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.TLSParameters.ClientCertificateOptional
+				}
+				// Import case: read from API
+				if _, ok := blockData["client_certificate_optional"].(map[string]interface{}); ok {
+					return &VirtualHostEmptyModel{}
+				}
+				return nil
+			}(),
+			ClientCertificateRequired: func() *VirtualHostEmptyModel {
+				if !isImport && data.TLSParameters != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.TLSParameters.ClientCertificateRequired
+				}
+				// Import case: read from API
+				if _, ok := blockData["client_certificate_required"].(map[string]interface{}); ok {
+					return &VirtualHostEmptyModel{}
+				}
+				return nil
+			}(),
+			CommonParams: func() *VirtualHostTLSParametersCommonParamsModel {
+				if !isImport && data.TLSParameters != nil && data.TLSParameters.CommonParams != nil {
+					// Normal Read: preserve existing state value
+					return data.TLSParameters.CommonParams
+				}
+				// Import case: read from API
+				if nestedBlockData, ok := blockData["common_params"].(map[string]interface{}); ok {
+					return &VirtualHostTLSParametersCommonParamsModel{
+						CipherSuites: func() types.List {
+							if v, ok := nestedBlockData["cipher_suites"].([]interface{}); ok && len(v) > 0 {
+								var items []string
+								for _, item := range v {
+									if s, ok := item.(string); ok {
+										items = append(items, s)
+									}
+								}
+								listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+								return listVal
+							}
+							return types.ListNull(types.StringType)
+						}(),
+						MaximumProtocolVersion: func() types.String {
+							if v, ok := nestedBlockData["maximum_protocol_version"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+						MinimumProtocolVersion: func() types.String {
+							if v, ok := nestedBlockData["minimum_protocol_version"].(string); ok && v != "" {
+								return types.StringValue(v)
+							}
+							return types.StringNull()
+						}(),
+					}
+				}
+				return nil
+			}(),
+			NoClientCertificate: func() *VirtualHostEmptyModel {
+				if !isImport && data.TLSParameters != nil {
+					// Normal Read: preserve existing state value (even if nil)
+					// This prevents API returning empty objects from overwriting user's 'not configured' intent
+					return data.TLSParameters.NoClientCertificate
+				}
+				// Import case: read from API
+				if _, ok := blockData["no_client_certificate"].(map[string]interface{}); ok {
+					return &VirtualHostEmptyModel{}
+				}
+				return nil
+			}(),
+			XfccHeaderElements: func() types.List {
+				if v, ok := blockData["xfcc_header_elements"].([]interface{}); ok && len(v) > 0 {
+					var items []string
+					for _, item := range v {
+						if s, ok := item.(string); ok {
+							items = append(items, s)
+						}
+					}
+					listVal, _ := types.ListValueFrom(ctx, types.StringType, items)
+					return listVal
+				}
+				return types.ListNull(types.StringType)
+			}(),
+		}
+	}
+	if listData, ok := apiResource.Spec["user_identification"].([]interface{}); ok && len(listData) > 0 {
+		var user_identificationList []VirtualHostUserIdentificationModel
+		var existingUserIdentificationItems []VirtualHostUserIdentificationModel
+		if !data.UserIdentification.IsNull() && !data.UserIdentification.IsUnknown() {
+			data.UserIdentification.ElementsAs(ctx, &existingUserIdentificationItems, false)
+		}
+		for listIdx, item := range listData {
+			_ = listIdx // May be unused if no empty marker blocks in list item
+			if itemMap, ok := item.(map[string]interface{}); ok {
+				user_identificationList = append(user_identificationList, VirtualHostUserIdentificationModel{
+					Kind: func() types.String {
+						if v, ok := itemMap["kind"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Name: func() types.String {
+						if v, ok := itemMap["name"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Namespace: func() types.String {
+						if v, ok := itemMap["namespace"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Tenant: func() types.String {
+						if v, ok := itemMap["tenant"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+					Uid: func() types.String {
+						if v, ok := itemMap["uid"].(string); ok && v != "" {
+							return types.StringValue(v)
+						}
+						return types.StringNull()
+					}(),
+				})
+			}
+		}
+		listVal, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: VirtualHostUserIdentificationModelAttrTypes}, user_identificationList)
+		resp.Diagnostics.Append(diags...)
+		if !resp.Diagnostics.HasError() {
+			data.UserIdentification = listVal
+		}
+	} else {
+		// No data from API - set to null list
+		data.UserIdentification = types.ListNull(types.ObjectType{AttrTypes: VirtualHostUserIdentificationModelAttrTypes})
+	}
+	if _, ok := apiResource.Spec["waf_type"].(map[string]interface{}); ok && isImport && data.WAFType == nil {
+		// Import case: populate from API since state is nil and psd is empty
+		data.WAFType = &VirtualHostWAFTypeModel{}
+	}
+	// Normal Read: preserve existing state value
+	// Top-level Optional bool: preserve prior state to avoid API default drift
+	if !isImport && !data.AddLocation.IsNull() && !data.AddLocation.IsUnknown() {
+		// Normal Read: preserve existing state value (do nothing)
+	} else {
+		// Import case, null state, or unknown (after Create): read from API
+		if v, ok := apiResource.Spec["add_location"].(bool); ok {
+			data.AddLocation = types.BoolValue(v)
+		} else {
+			data.AddLocation = types.BoolNull()
+		}
+	}
+	if v, ok := apiResource.Spec["append_server_name"].(string); ok && v != "" {
+		data.AppendServerName = types.StringValue(v)
+	} else {
+		data.AppendServerName = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["connection_idle_timeout"].(float64); ok {
+		data.ConnectionIdleTimeout = types.Int64Value(int64(v))
+	} else {
+		data.ConnectionIdleTimeout = types.Int64Null()
+	}
+	// Top-level Optional bool: preserve prior state to avoid API default drift
+	if !isImport && !data.DisableDefaultErrorPages.IsNull() && !data.DisableDefaultErrorPages.IsUnknown() {
+		// Normal Read: preserve existing state value (do nothing)
+	} else {
+		// Import case, null state, or unknown (after Create): read from API
+		if v, ok := apiResource.Spec["disable_default_error_pages"].(bool); ok {
+			data.DisableDefaultErrorPages = types.BoolValue(v)
+		} else {
+			data.DisableDefaultErrorPages = types.BoolNull()
+		}
+	}
+	// Top-level Optional bool: preserve prior state to avoid API default drift
+	if !isImport && !data.DisableDNSResolve.IsNull() && !data.DisableDNSResolve.IsUnknown() {
+		// Normal Read: preserve existing state value (do nothing)
+	} else {
+		// Import case, null state, or unknown (after Create): read from API
+		if v, ok := apiResource.Spec["disable_dns_resolve"].(bool); ok {
+			data.DisableDNSResolve = types.BoolValue(v)
+		} else {
+			data.DisableDNSResolve = types.BoolNull()
+		}
+	}
+	if v, ok := apiResource.Spec["idle_timeout"].(float64); ok {
+		data.IdleTimeout = types.Int64Value(int64(v))
+	} else {
+		data.IdleTimeout = types.Int64Null()
+	}
+	if v, ok := apiResource.Spec["max_request_header_size"].(float64); ok {
+		data.MaxRequestHeaderSize = types.Int64Value(int64(v))
+	} else {
+		data.MaxRequestHeaderSize = types.Int64Null()
+	}
+	if v, ok := apiResource.Spec["proxy"].(string); ok && v != "" {
+		data.Proxy = types.StringValue(v)
+	} else {
+		data.Proxy = types.StringNull()
+	}
+	if v, ok := apiResource.Spec["server_name"].(string); ok && v != "" {
+		data.ServerName = types.StringValue(v)
+	} else {
+		data.ServerName = types.StringNull()
+	}
+
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, NewVirtualHostResource(), &data)...)
 }
@@ -6,9 +6,11 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -22,17 +24,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                   = &VirtualHostResource{}
-	_ resource.ResourceWithConfigure      = &VirtualHostResource{}
-	_ resource.ResourceWithImportState    = &VirtualHostResource{}
-	_ resource.ResourceWithModifyPlan     = &VirtualHostResource{}
-	_ resource.ResourceWithValidateConfig = &VirtualHostResource{}
+	_ resource.Resource                     = &VirtualHostResource{}
+	_ resource.ResourceWithConfigure        = &VirtualHostResource{}
+	_ resource.ResourceWithImportState      = &VirtualHostResource{}
+	_ resource.ResourceWithIdentity         = &VirtualHostResource{}
+	_ resource.ResourceWithMoveState        = &VirtualHostResource{}
+	_ resource.ResourceWithModifyPlan       = &VirtualHostResource{}
+	_ resource.ResourceWithValidateConfig   = &VirtualHostResource{}
+	_ resource.ResourceWithConfigValidators = &VirtualHostResource{}
+	_ listableResource                      = &VirtualHostResource{}
 )
 
 func NewVirtualHostResource() resource.Resource {
@@ -163,14 +170,16 @@ var VirtualHostAuthenticationCookieParamsAuthHMACPrimKeyBlindfoldSecretInfoModel
 
 // VirtualHostAuthenticationCookieParamsAuthHMACPrimKeyClearSecretInfoModel represents clear_secret_info block
 type VirtualHostAuthenticationCookieParamsAuthHMACPrimKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // VirtualHostAuthenticationCookieParamsAuthHMACPrimKeyClearSecretInfoModelAttrTypes defines the attribute types for VirtualHostAuthenticationCookieParamsAuthHMACPrimKeyClearSecretInfoModel
 var VirtualHostAuthenticationCookieParamsAuthHMACPrimKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // VirtualHostAuthenticationCookieParamsAuthHMACSecKeyModel represents sec_key block
@@ -201,14 +210,16 @@ var VirtualHostAuthenticationCookieParamsAuthHMACSecKeyBlindfoldSecretInfoModelA
 
 // VirtualHostAuthenticationCookieParamsAuthHMACSecKeyClearSecretInfoModel represents clear_secret_info block
 type VirtualHostAuthenticationCookieParamsAuthHMACSecKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // VirtualHostAuthenticationCookieParamsAuthHMACSecKeyClearSecretInfoModelAttrTypes defines the attribute types for VirtualHostAuthenticationCookieParamsAuthHMACSecKeyClearSecretInfoModel
 var VirtualHostAuthenticationCookieParamsAuthHMACSecKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // VirtualHostBufferPolicyModel represents buffer_policy block
@@ -461,14 +472,16 @@ var VirtualHostRequestCookiesToAddSecretValueBlindfoldSecretInfoModelAttrTypes =
 
 // VirtualHostRequestCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type VirtualHostRequestCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // VirtualHostRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for VirtualHostRequestCookiesToAddSecretValueClearSecretInfoModel
 var VirtualHostRequestCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // VirtualHostRequestHeadersToAddModel represents request_headers_to_add block
@@ -515,14 +528,16 @@ var VirtualHostRequestHeadersToAddSecretValueBlindfoldSecretInfoModelAttrTypes =
 
 // VirtualHostRequestHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type VirtualHostRequestHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // VirtualHostRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for VirtualHostRequestHeadersToAddSecretValueClearSecretInfoModel
 var VirtualHostRequestHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // VirtualHostResponseCookiesToAddModel represents response_cookies_to_add block
@@ -607,14 +622,16 @@ var VirtualHostResponseCookiesToAddSecretValueBlindfoldSecretInfoModelAttrTypes
 
 // VirtualHostResponseCookiesToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type VirtualHostResponseCookiesToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // VirtualHostResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for VirtualHostResponseCookiesToAddSecretValueClearSecretInfoModel
 var VirtualHostResponseCookiesToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // VirtualHostResponseHeadersToAddModel represents response_headers_to_add block
@@ -661,14 +678,16 @@ var VirtualHostResponseHeadersToAddSecretValueBlindfoldSecretInfoModelAttrTypes
 
 // VirtualHostResponseHeadersToAddSecretValueClearSecretInfoModel represents clear_secret_info block
 type VirtualHostResponseHeadersToAddSecretValueClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // VirtualHostResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes defines the attribute types for VirtualHostResponseHeadersToAddSecretValueClearSecretInfoModel
 var VirtualHostResponseHeadersToAddSecretValueClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // VirtualHostRetryPolicyModel represents retry_policy block
@@ -933,14 +952,16 @@ var VirtualHostTLSParametersCommonParamsTLSCertificatesPrivateKeyBlindfoldSecret
 
 // VirtualHostTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModel represents clear_secret_info block
 type VirtualHostTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModel struct {
-	Provider types.String `tfsdk:"provider_ref"`
-	URL      types.String `tfsdk:"url"`
+	Provider   types.String `tfsdk:"provider_ref"`
+	URL        types.String `tfsdk:"url"`
+	URLVersion types.Int64  `tfsdk:"url_version"`
 }
 
 // VirtualHostTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes defines the attribute types for VirtualHostTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModel
 var VirtualHostTLSParametersCommonParamsTLSCertificatesPrivateKeyClearSecretInfoModelAttrTypes = map[string]attr.Type{
 	"provider_ref": types.StringType,
 	"url":          types.StringType,
+	"url_version":  types.Int64Type,
 }
 
 // VirtualHostTLSParametersCommonParamsValidationParamsModel represents validation_params block
@@ -1070,6 +1091,8 @@ type VirtualHostResourceModel struct {
 	Proxy                      types.String                         `tfsdk:"proxy"`
 	ServerName                 types.String                         `tfsdk:"server_name"`
 	Timeouts                   timeouts.Value                       `tfsdk:"timeouts"`
+	SystemMetadata             types.Object                         `tfsdk:"system_metadata"`
+	LabelsAll                  types.Map                            `tfsdk:"labels_all"`
 	AdvertisePolicies          types.List                           `tfsdk:"advertise_policies"`
 	Authentication             *VirtualHostAuthenticationModel      `tfsdk:"authentication"`
 	BufferPolicy               *VirtualHostBufferPolicyModel        `tfsdk:"buffer_policy"`
@@ -1124,9 +1147,11 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 				},
 			},
 			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the Virtual Host will be created.",
-				Required:            true,
+				MarkdownDescription: "Namespace where the Virtual Host will be created. Defaults to the `default_namespace` of the provider.",
+				Optional:            true,
+				Computed:            true,
 				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
 					stringplanmodifier.RequiresReplace(),
 				},
 				Validators: []validator.String{
@@ -1150,6 +1175,10 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "List of domain names matched to this virtual host for routing incoming requests. Supports wildcard patterns like *.example.com for subdomain matching.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(33),
+					listvalidator.UniqueValues(),
+				},
 			},
 			"labels": schema.MapAttribute{
 				MarkdownDescription: "Labels is a user defined key value map that can be attached to resources for organization and filtering.",
@@ -1160,21 +1189,39 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 				MarkdownDescription: "List of keys of Cookies to be removed from the HTTP request being sent towards upstream.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+				},
 			},
 			"request_headers_to_remove": schema.ListAttribute{
 				MarkdownDescription: "List of keys of Headers to be removed from the HTTP request being sent towards upstream.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+					listvalidator.UniqueValues(),
+				},
 			},
 			"response_cookies_to_remove": schema.ListAttribute{
 				MarkdownDescription: "List of name of Cookies to be removed from the HTTP response being sent towards downstream. Entire set-cookie header will be removed.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+					listvalidator.UniqueValues(),
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+				},
 			},
 			"response_headers_to_remove": schema.ListAttribute{
 				MarkdownDescription: "List of keys of Headers to be removed from the HTTP response being sent towards downstream.",
 				Optional:            true,
 				ElementType:         types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+					listvalidator.UniqueValues(),
+				},
 			},
 			"id": schema.StringAttribute{
 				MarkdownDescription: "Unique identifier for the resource.",
@@ -1198,6 +1245,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(8096),
+				},
 			},
 			"connection_idle_timeout": schema.Int64Attribute{
 				MarkdownDescription: "The idle timeout for downstream connections. The idle timeout is defined as the period in which there are no active requests. When the idle timeout is reached the connection will be closed.",
@@ -1206,6 +1256,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(600000),
+				},
 			},
 			"disable_default_error_pages": schema.BoolAttribute{
 				MarkdownDescription: "Option to specify whether to disable using default F5XC error pages.",
@@ -1238,6 +1291,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 				PlanModifiers: []planmodifier.Int64{
 					int64planmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Int64{
+					int64validator.AtMost(96),
+				},
 			},
 			"proxy": schema.StringAttribute{
 				MarkdownDescription: "[Enum: UDP_PROXY|SMA_PROXY|DNS_PROXY|ZTNA_PROXY|UZTNA_PROXY] ProxyType tells the type of proxy to install for the virtual host. Only the following combination of VirtualHosts within same AdvertisePolicy is permitted (None of them should have '*' in domains when used with other VirtualHosts in same AdvertisePolicy) 1. Multiple TCP_PROXY_WITH_SNI and.. Possible values are `UDP_PROXY`, `SMA_PROXY`, `DNS_PROXY`, `ZTNA_PROXY`, `UZTNA_PROXY`. Defaults to `HTTP_PROXY`.",
@@ -1254,7 +1310,12 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.String{
+					stringvalidator.UTF8LengthAtMost(8096),
+				},
 			},
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all":      labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -1312,11 +1373,18 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 					"redirect_url": schema.StringAttribute{
 						MarkdownDescription: "user can provide a URL for e.g https://abc.xyz.com where user gets redirected. This URL configured here must match with the redirect URL configured with the OIDC provider.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(128),
+							stringvalidator.UTF8LengthAtLeast(1),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
 					"auth_config": schema.ListNestedBlock{
 						MarkdownDescription: "Reference to Authentication Config Object .",
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(1),
+						},
 						NestedObject: schema.NestedBlockObject{
 							Attributes: map[string]schema.Attribute{
 								"kind": schema.StringAttribute{
@@ -1364,14 +1432,23 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 							"cookie_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Specifies in seconds max duration of the allocated cookie. This maps to “Max-Age” attribute in the session cookie. This will act as an expiry duration on the client side after which client will not be setting the cookie as part of the request.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(86400),
+								},
 							},
 							"cookie_refresh_interval": schema.Int64Attribute{
 								MarkdownDescription: "Specifies in seconds refresh interval for session cookie. This is used to keep the active user active and reduce RE-login. When an incoming cookie's session expiry is still valid, and time to expire falls behind this interval, RE-issue a cookie with new expiry and with the same original session..",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(86400),
+								},
 							},
 							"session_expiry": schema.Int64Attribute{
 								MarkdownDescription: "Specifies in seconds max lifetime of an authenticated session after which the user will be forced to login again. Default session expiry is 86400 seconds(24 hours).",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtMost(1296000),
+								},
 							},
 						},
 						Blocks: map[string]schema.Block{
@@ -1417,7 +1494,16 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -1453,7 +1539,16 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 														Optional:            true,
 													},
 													"url": schema.StringAttribute{
-														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+														MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+														Optional:            true,
+														Sensitive:           true,
+														WriteOnly:           true,
+														Validators: []validator.String{
+															stringvalidator.LengthAtMost(131072),
+														},
+													},
+													"url_version": schema.Int64Attribute{
+														MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 														Optional:            true,
 													},
 												},
@@ -1485,6 +1580,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 					"max_request_bytes": schema.Int64Attribute{
 						MarkdownDescription: "The maximum request size that the filter will buffer before the connection manager will stop buffering and return a RequestEntityTooLarge (413) response.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(10485760),
+						},
 					},
 				},
 			},
@@ -1494,10 +1592,17 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 					"cookie_expiry": schema.Int64Attribute{
 						MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(86400),
+						},
 					},
 					"custom_page": schema.StringAttribute{
 						MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(65536),
+						},
 					},
 				},
 			},
@@ -1519,11 +1624,19 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 					"content_length": schema.Int64Attribute{
 						MarkdownDescription: "Minimum response length, in bytes, which will trigger compression. The. Defaults to `30`.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(30),
+						},
 					},
 					"content_type": schema.ListAttribute{
 						MarkdownDescription: "Set of strings that allows specifying which mime-types yield compression When this field is not defined, compression will be applied to the following mime-types: 'application/javascript' 'application/JSON', 'application/xhtml+XML' 'image/svg+XML' 'text/CSS' 'text/HTML' 'text/plain' 'text/XML'.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(50),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1)),
+						},
 					},
 					"disable_on_etag_header": schema.BoolAttribute{
 						MarkdownDescription: "If true, disables compression when the response contains an etag header. When it is false, weak etags will be preserved and the ones that require strong validation will be removed.",
@@ -1554,11 +1667,21 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 						MarkdownDescription: "Specifies the origins that will be allowed to do CORS requests. An origin is allowed if either allow_origin or allow_origin_regex match.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(128),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1)),
+						},
 					},
 					"allow_origin_regex": schema.ListAttribute{
 						MarkdownDescription: "Specifies regex patterns that match allowed origins. An origin is allowed if either allow_origin or allow_origin_regex match.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+							listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(256), stringvalidator.LengthAtLeast(1), validators.RegexValidator()),
+						},
 					},
 					"disabled": schema.BoolAttribute{
 						MarkdownDescription: "Disable the CorsPolicy for a particular route. This is useful when virtual-host has CorsPolicy, but we need to disable it on a specific route. The value of this field is ignored for virtual-host.",
@@ -1571,6 +1694,10 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 					"maximum_age": schema.Int64Attribute{
 						MarkdownDescription: "Specifies the content for the access-control-max-age header in seconds. This indicates the maximum number of seconds the results can be cached A value of -1 will disable caching. Maximum permitted value is 86400 seconds (24 hours).",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(-1),
+							int64validator.AtMost(86400),
+						},
 					},
 				},
 			},
@@ -1588,6 +1715,12 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 								MarkdownDescription: "List of domain names that will be matched to loadbalancer. These domains are not used for SNI match. Wildcard names are supported in the suffix or prefix form.",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.SizeAtMost(32),
+									listvalidator.SizeAtLeast(1),
+									listvalidator.UniqueValues(),
+									listvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(256), stringvalidator.UTF8LengthAtLeast(1)),
+								},
 							},
 						},
 					},
@@ -1614,6 +1747,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 					"connection_timeout": schema.Int64Attribute{
 						MarkdownDescription: "The timeout for new network connections to upstream server. This is specified in milliseconds. The  (2 seconds). Defaults to `2000`.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(600000),
+						},
 					},
 					"resolution_network_type": schema.StringAttribute{
 						MarkdownDescription: "[Enum: VIRTUAL_NETWORK_SITE_LOCAL|VIRTUAL_NETWORK_SITE_LOCAL_INSIDE|VIRTUAL_NETWORK_PER_SITE|VIRTUAL_NETWORK_PUBLIC|VIRTUAL_NETWORK_GLOBAL|VIRTUAL_NETWORK_SITE_SERVICE|VIRTUAL_NETWORK_VER_INTERNAL|VIRTUAL_NETWORK_SITE_LOCAL_INSIDE_OUTSIDE|VIRTUAL_NETWORK_IP_AUTO|VIRTUAL_NETWORK_VOLTADN_PRIVATE_NETWORK|VIRTUAL_NETWORK_SRV6_NETWORK|VIRTUAL_NETWORK_IP_FABRIC|VIRTUAL_NETWORK_SEGMENT] Different types of virtual networks understood by the system Virtual-network of type VIRTUAL_NETWORK_SITE_LOCAL provides connectivity to public (outside) network. This is an insecure network and is connected to public internet via NAT Gateways/firwalls Virtual-network of this type is local to.. Possible values are `VIRTUAL_NETWORK_SITE_LOCAL`, `VIRTUAL_NETWORK_SITE_LOCAL_INSIDE`, `VIRTUAL_NETWORK_PER_SITE`, `VIRTUAL_NETWORK_PUBLIC`, `VIRTUAL_NETWORK_GLOBAL`, `VIRTUAL_NETWORK_SITE_SERVICE`, `VIRTUAL_NETWORK_VER_INTERNAL`, `VIRTUAL_NETWORK_SITE_LOCAL_INSIDE_OUTSIDE`, `VIRTUAL_NETWORK_IP_AUTO`, `VIRTUAL_NETWORK_VOLTADN_PRIVATE_NETWORK`, `VIRTUAL_NETWORK_SRV6_NETWORK`, `VIRTUAL_NETWORK_IP_FABRIC`, `VIRTUAL_NETWORK_SEGMENT`. Defaults to `VIRTUAL_NETWORK_SITE_LOCAL`.",
@@ -1715,14 +1851,25 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 					"cookie_expiry": schema.Int64Attribute{
 						MarkdownDescription: "Cookie expiration period, in seconds. An expired cookie causes the loadbalancer to issue a new challenge.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+							int64validator.AtMost(86400),
+						},
 					},
 					"custom_page": schema.StringAttribute{
 						MarkdownDescription: "Custom message is of type uri_ref. Currently supported URL schemes is string:///. For string:/// scheme, message needs to be encoded in Base64 format.",
 						Optional:            true,
+						Validators: []validator.String{
+							stringvalidator.UTF8LengthAtMost(65536),
+						},
 					},
 					"js_script_delay": schema.Int64Attribute{
 						MarkdownDescription: "Delay introduced by Javascript, in milliseconds.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1000),
+							int64validator.AtMost(60000),
+						},
 					},
 				},
 			},
@@ -1740,6 +1887,10 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"rate_limiter_allowed_prefixes": schema.ListNestedBlock{
 				MarkdownDescription: "References to ip_prefix_set objects. Requests from source IP addresses that are covered by one of the allowed IP Prefixes are not subjected to rate limiting.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(4),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"kind": schema.StringAttribute{
@@ -1783,11 +1934,18 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"request_cookies_to_add": schema.ListNestedBlock{
 				MarkdownDescription: "Cookies are key-value pairs to be added to HTTP request being routed towards upstream. Cookies specified at this level are applied after cookies from matched Route are applied.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"name": schema.StringAttribute{
 							MarkdownDescription: "Name of the cookie in Cookie header.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 						"overwrite": schema.BoolAttribute{
 							MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -1796,6 +1954,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 						"value": schema.StringAttribute{
 							MarkdownDescription: "Value of the Cookie header.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(8096),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -1828,7 +1989,16 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 											Optional:            true,
 										},
 										"url": schema.StringAttribute{
-											MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+											MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+											Optional:            true,
+											Sensitive:           true,
+											WriteOnly:           true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+											},
+										},
+										"url_version": schema.Int64Attribute{
+											MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 											Optional:            true,
 										},
 									},
@@ -1840,6 +2010,10 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"request_headers_to_add": schema.ListNestedBlock{
 				MarkdownDescription: "Headers are key-value pairs to be added to HTTP request being routed towards upstream. Headers specified at this level are applied after headers from matched Route are applied.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"append": schema.BoolAttribute{
@@ -1849,10 +2023,16 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 						"name": schema.StringAttribute{
 							MarkdownDescription: "Name. Name of the HTTP header.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 						"value": schema.StringAttribute{
 							MarkdownDescription: "Value of the HTTP header.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(8096),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -1885,7 +2065,16 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 											Optional:            true,
 										},
 										"url": schema.StringAttribute{
-											MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+											MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+											Optional:            true,
+											Sensitive:           true,
+											WriteOnly:           true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+											},
+										},
+										"url_version": schema.Int64Attribute{
+											MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 											Optional:            true,
 										},
 									},
@@ -1897,27 +2086,48 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"response_cookies_to_add": schema.ListNestedBlock{
 				MarkdownDescription: "Cookies are name-value pairs along with optional attribute parameters to be added to HTTP response being sent towards downstream. Cookies specified at this level are applied after cookies from matched Route are applied.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"add_domain": schema.StringAttribute{
 							MarkdownDescription: "Add domain attribute.",
 							Optional:            true,
+							Validators: []validator.String{
+								validators.HostnameValidator(),
+								stringvalidator.UTF8LengthAtMost(256),
+								stringvalidator.UTF8LengthAtLeast(1),
+							},
 						},
 						"add_expiry": schema.StringAttribute{
 							MarkdownDescription: "Add expiry attribute.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 						"add_path": schema.StringAttribute{
 							MarkdownDescription: "Add path attribute.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 						"max_age_value": schema.Int64Attribute{
 							MarkdownDescription: "Add max age attribute.",
 							Optional:            true,
+							Validators: []validator.Int64{
+								int64validator.AtMost(34560000),
+							},
 						},
 						"name": schema.StringAttribute{
 							MarkdownDescription: "Name of the cookie in Cookie header.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 						"overwrite": schema.BoolAttribute{
 							MarkdownDescription: "Should the value be overwritten? If true, the value is overwritten to existing values.  not overwrite. Defaults to `do`.",
@@ -1926,6 +2136,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 						"value": schema.StringAttribute{
 							MarkdownDescription: "Value of the Cookie header.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(8096),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -2003,7 +2216,16 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 											Optional:            true,
 										},
 										"url": schema.StringAttribute{
-											MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+											MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+											Optional:            true,
+											Sensitive:           true,
+											WriteOnly:           true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+											},
+										},
+										"url_version": schema.Int64Attribute{
+											MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 											Optional:            true,
 										},
 									},
@@ -2015,6 +2237,10 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"response_headers_to_add": schema.ListNestedBlock{
 				MarkdownDescription: "Headers are key-value pairs to be added to HTTP response being sent towards downstream. Headers specified at this level are applied after headers from matched Route are applied.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(32),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"append": schema.BoolAttribute{
@@ -2024,10 +2250,16 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 						"name": schema.StringAttribute{
 							MarkdownDescription: "Name. Name of the HTTP header.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(256),
+							},
 						},
 						"value": schema.StringAttribute{
 							MarkdownDescription: "Value of the HTTP header.",
 							Optional:            true,
+							Validators: []validator.String{
+								stringvalidator.UTF8LengthAtMost(8096),
+							},
 						},
 					},
 					Blocks: map[string]schema.Block{
@@ -2060,7 +2292,16 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 											Optional:            true,
 										},
 										"url": schema.StringAttribute{
-											MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+											MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+											Optional:            true,
+											Sensitive:           true,
+											WriteOnly:           true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+											},
+										},
+										"url_version": schema.Int64Attribute{
+											MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 											Optional:            true,
 										},
 									},
@@ -2076,20 +2317,35 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 					"num_retries": schema.Int64Attribute{
 						MarkdownDescription: "Specifies the allowed number of retries. Retries can be done any number of times. An exponential back-off algorithm is used between each retry. Defaults to `1`.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(8),
+						},
 					},
 					"per_try_timeout": schema.Int64Attribute{
 						MarkdownDescription: "Specifies a non-zero timeout per retry attempt. In milliseconds.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtMost(600000),
+						},
 					},
 					"retriable_status_codes": schema.ListAttribute{
 						MarkdownDescription: "HTTP status codes that should trigger a retry in addition to those specified by retry_on.",
 						Optional:            true,
 						ElementType:         types.Int64Type,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(16),
+							listvalidator.UniqueValues(),
+						},
 					},
 					"retry_condition": schema.ListAttribute{
 						MarkdownDescription: "Specifies the conditions under which retry takes place. Retries can be on different types of condition depending on application requirements. For example, network failure, all 5xx response codes, idempotent 4xx response codes, etc The possible values are '5xx' : Retry will be done if the..",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.SizeAtMost(7),
+							listvalidator.SizeAtLeast(1),
+							listvalidator.UniqueValues(),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -2099,6 +2355,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 							"base_interval": schema.Int64Attribute{
 								MarkdownDescription: "Specifies the base interval between retries in milliseconds.",
 								Optional:            true,
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
 							},
 							"max_interval": schema.Int64Attribute{
 								MarkdownDescription: "Specifies the maximum interval between retries in milliseconds. This parameter is optional, but must be greater than or equal to the base_interval if set. The  times the base_interval. Defaults to `10`.",
@@ -2110,6 +2369,10 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"routes": schema.ListNestedBlock{
 				MarkdownDescription: "HTTP routing rules that match incoming requests based on path, headers, or query parameters and forward them to appropriate backend origin pools.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(256),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"kind": schema.StringAttribute{
@@ -2200,10 +2463,18 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 					"request_headers_timeout": schema.Int64Attribute{
 						MarkdownDescription: "The amount of time the client has to send only the headers on the request stream before the stream is cancelled. The  milliseconds. This setting provides protection against Slowloris attacks. Defaults to `10000`.",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(2000),
+							int64validator.AtMost(30000),
+						},
 					},
 					"request_timeout": schema.Int64Attribute{
 						MarkdownDescription: ".",
 						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(2000),
+							int64validator.AtMost(300000),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -2219,6 +2490,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 						MarkdownDescription: "The following list specifies the supported cipher suite TLS_AES_128_GCM_SHA256 TLS_AES_256_GCM_SHA384 TLS_CHACHA20_POLY1305_SHA256 TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256 TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384 TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256 TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256..",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.UniqueValues(),
+						},
 					},
 					"maximum_protocol_version": schema.StringAttribute{
 						MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -2232,6 +2506,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 						MarkdownDescription: "[Enum: XFCC_NONE|XFCC_CERT|XFCC_CHAIN|XFCC_SUBJECT|XFCC_URI|XFCC_DNS] X-Forwarded-Client-Cert header elements to be set in an mTLS enabled connections. If none are defined, the header will not be added. Possible values are `XFCC_NONE`, `XFCC_CERT`, `XFCC_CHAIN`, `XFCC_SUBJECT`, `XFCC_URI`, `XFCC_DNS`. Defaults to `XFCC_NONE`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.UniqueValues(),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -2297,6 +2574,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 							"trusted_ca_url": schema.StringAttribute{
 								MarkdownDescription: "Inline Root CA Certificate.",
 								Optional:            true,
+								Validators: []validator.String{
+									stringvalidator.LengthAtMost(131072),
+								},
 							},
 							"verify_subject_alt_names": schema.ListAttribute{
 								MarkdownDescription: "List of acceptable Subject Alt Names/CN in the peer's certificate. When skip_hostname_verification is false and verify_subject_alt_names is empty, the hostname of the peer will be used for matching against SAN/CN of peer's certificate.",
@@ -2311,6 +2591,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 								Blocks: map[string]schema.Block{
 									"trusted_ca_list": schema.ListNestedBlock{
 										MarkdownDescription: "Root CA Certificate Reference. Reference to Root CA Certificate.",
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
 										NestedObject: schema.NestedBlockObject{
 											Attributes: map[string]schema.Attribute{
 												"kind": schema.StringAttribute{
@@ -2365,6 +2648,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 						MarkdownDescription: "[Enum: XFCC_NONE|XFCC_CERT|XFCC_CHAIN|XFCC_SUBJECT|XFCC_URI|XFCC_DNS] X-Forwarded-Client-Cert header elements to be set in an mTLS enabled connections. If none are defined, the header will not be added. Possible values are `XFCC_NONE`, `XFCC_CERT`, `XFCC_CHAIN`, `XFCC_SUBJECT`, `XFCC_URI`, `XFCC_DNS`. Defaults to `XFCC_NONE`.",
 						Optional:            true,
 						ElementType:         types.StringType,
+						Validators: []validator.List{
+							listvalidator.UniqueValues(),
+						},
 					},
 				},
 				Blocks: map[string]schema.Block{
@@ -2381,6 +2667,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 								MarkdownDescription: "The following list specifies the supported cipher suite TLS_AES_128_GCM_SHA256 TLS_AES_256_GCM_SHA384 TLS_CHACHA20_POLY1305_SHA256 TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256 TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384 TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256 TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256..",
 								Optional:            true,
 								ElementType:         types.StringType,
+								Validators: []validator.List{
+									listvalidator.UniqueValues(),
+								},
 							},
 							"maximum_protocol_version": schema.StringAttribute{
 								MarkdownDescription: "[Enum: TLS_AUTO|TLSv1_0|TLSv1_1|TLSv1_2|TLSv1_3] TlsProtocol is enumeration of supported TLS versions F5 Distributed Cloud will choose the optimal TLS version. Possible values are `TLS_AUTO`, `TLSv1_0`, `TLSv1_1`, `TLSv1_2`, `TLSv1_3`. Defaults to `TLS_AUTO`.",
@@ -2399,6 +2688,10 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 										"certificate_url": schema.StringAttribute{
 											MarkdownDescription: "TLS certificate. Certificate or certificate chain in PEM format including the PEM headers.",
 											Optional:            true,
+											Validators: []validator.String{
+												stringvalidator.LengthAtMost(131072),
+												stringvalidator.LengthAtLeast(1),
+											},
 										},
 										"description_spec": schema.StringAttribute{
 											MarkdownDescription: "Description. Description for the certificate.",
@@ -2413,6 +2706,11 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 													MarkdownDescription: "[Enum: INVALID_HASH_ALGORITHM|SHA256|SHA1] Ordered list of hash algorithms to be used. Possible values are `INVALID_HASH_ALGORITHM`, `SHA256`, `SHA1`. Defaults to `INVALID_HASH_ALGORITHM`.",
 													Optional:            true,
 													ElementType:         types.StringType,
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(4),
+														listvalidator.SizeAtLeast(1),
+														listvalidator.UniqueValues(),
+													},
 												},
 											},
 										},
@@ -2448,7 +2746,16 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 															Optional:            true,
 														},
 														"url": schema.StringAttribute{
-															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding.",
+															MarkdownDescription: "URL of the secret. Currently supported URL schemes is string:///. For string:/// scheme, Secret needs to be encoded Base64 format. When asked for this secret, caller will GET Secret bytes after Base64 decoding. Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `url_version` to update it.",
+															Optional:            true,
+															Sensitive:           true,
+															WriteOnly:           true,
+															Validators: []validator.String{
+																stringvalidator.LengthAtMost(131072),
+															},
+														},
+														"url_version": schema.Int64Attribute{
+															MarkdownDescription: "Version of `url`. Change it to send a new value of the write-only `url` to F5 Distributed Cloud.",
 															Optional:            true,
 														},
 													},
@@ -2471,6 +2778,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 									"trusted_ca_url": schema.StringAttribute{
 										MarkdownDescription: "Inline Root CA Certificate.",
 										Optional:            true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(131072),
+										},
 									},
 									"verify_subject_alt_names": schema.ListAttribute{
 										MarkdownDescription: "List of acceptable Subject Alt Names/CN in the peer's certificate. When skip_hostname_verification is false and verify_subject_alt_names is empty, the hostname of the peer will be used for matching against SAN/CN of peer's certificate.",
@@ -2485,6 +2795,9 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 										Blocks: map[string]schema.Block{
 											"trusted_ca_list": schema.ListNestedBlock{
 												MarkdownDescription: "Root CA Certificate Reference. Reference to Root CA Certificate.",
+												Validators: []validator.List{
+													listvalidator.SizeAtMost(1),
+												},
 												NestedObject: schema.NestedBlockObject{
 													Attributes: map[string]schema.Attribute{
 														"kind": schema.StringAttribute{
@@ -2539,6 +2852,10 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 			},
 			"user_identification": schema.ListNestedBlock{
 				MarkdownDescription: "Reference to user_identification object. The rules in the user_identification object are evaluated to determine the user identifier to be rate limited.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+
 				NestedObject: schema.NestedBlockObject{
 					Attributes: map[string]schema.Attribute{
 						"kind": schema.StringAttribute{
@@ -2645,6 +2962,11 @@ func (r *VirtualHostResource) Schema(ctx context.Context, req resource.SchemaReq
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *VirtualHostResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *VirtualHostResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -2669,6 +2991,52 @@ func (r *VirtualHostResource) ValidateConfig(ctx context.Context, req resource.V
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *VirtualHostResource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		validators.OneOfGroup(path.MatchRelative(), "authentication_choice", "authentication", "no_authentication"),
+		validators.OneOfGroup(path.MatchRelative(), "challenge_type", "captcha_challenge", "js_challenge", "no_challenge"),
+		validators.OneOfGroup(path.MatchRelative(), "default_lb_choice", "default_loadbalancer", "non_default_loadbalancer"),
+		validators.OneOfGroup(path.MatchRelative(), "path_normalize_choice", "disable_path_normalize", "enable_path_normalize"),
+		validators.OneOfGroup(path.MatchRelative(), "server_header_choice", "append_server_name", "default_header", "pass_through", "server_name"),
+		validators.OneOfGroup(path.MatchRelative(), "tls_certificates_choice", "tls_cert_params", "tls_parameters"),
+		validators.OneOfGroup(path.MatchRoot("authentication"), "cookie_params_choice", "cookie_params", "use_auth_object_config"),
+		validators.OneOfGroup(path.MatchRoot("authentication"), "redirect_url_choice", "redirect_dynamic", "redirect_url"),
+		validators.OneOfGroup(path.MatchRoot("authentication").AtName("cookie_params"), "secret_choice", "auth_hmac", "kms_key_hmac"),
+		validators.OneOfGroup(path.MatchRoot("authentication").AtName("cookie_params").AtName("auth_hmac").AtName("prim_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("authentication").AtName("cookie_params").AtName("auth_hmac").AtName("sec_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("coalescing_options"), "coalescing_choice", "default_coalescing", "strict_coalescing"),
+		validators.OneOfGroup(path.MatchRoot("csrf_policy"), "allowed_domains", "all_load_balancer_domains", "custom_domain_list", "disabled"),
+		validators.OneOfGroup(path.MatchRoot("http_protocol_options"), "http_protocol_choice", "http_protocol_enable_v1_only", "http_protocol_enable_v1_v2", "http_protocol_enable_v2_only"),
+		validators.OneOfGroup(path.MatchRoot("http_protocol_options").AtName("http_protocol_enable_v1_only").AtName("header_transformation"), "header_transformation_choice", "default_header_transformation", "legacy_header_transformation", "preserve_case_header_transformation", "proper_case_header_transformation"),
+		validators.OneOfGroup(path.MatchRoot("request_cookies_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("request_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("request_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("request_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("response_cookies_to_add").AtAnyListIndex(), "domain_choice", "add_domain", "ignore_domain"),
+		validators.OneOfGroup(path.MatchRoot("response_cookies_to_add").AtAnyListIndex(), "expiry_choice", "add_expiry", "ignore_expiry"),
+		validators.OneOfGroup(path.MatchRoot("response_cookies_to_add").AtAnyListIndex(), "httponly_choice", "add_httponly", "ignore_httponly"),
+		validators.OneOfGroup(path.MatchRoot("response_cookies_to_add").AtAnyListIndex(), "max_age_choice", "ignore_max_age", "max_age_value"),
+		validators.OneOfGroup(path.MatchRoot("response_cookies_to_add").AtAnyListIndex(), "partitioned_choice", "add_partitioned", "ignore_partitioned"),
+		validators.OneOfGroup(path.MatchRoot("response_cookies_to_add").AtAnyListIndex(), "path_choice", "add_path", "ignore_path"),
+		validators.OneOfGroup(path.MatchRoot("response_cookies_to_add").AtAnyListIndex(), "samesite_choice", "ignore_samesite", "samesite_lax", "samesite_none", "samesite_strict"),
+		validators.OneOfGroup(path.MatchRoot("response_cookies_to_add").AtAnyListIndex(), "secure_choice", "add_secure", "ignore_secure"),
+		validators.OneOfGroup(path.MatchRoot("response_cookies_to_add").AtAnyListIndex(), "value_choice", "ignore_value", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("response_cookies_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("response_headers_to_add").AtAnyListIndex(), "value_choice", "secret_value", "value"),
+		validators.OneOfGroup(path.MatchRoot("response_headers_to_add").AtAnyListIndex().AtName("secret_value"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.RequiredOneOfGroup(path.MatchRoot("slow_ddos_mitigation"), "request_timeout_choice", "disable_request_timeout", "request_timeout"),
+		validators.OneOfGroup(path.MatchRoot("tls_cert_params"), "client_certificate_verify_choice", "client_certificate_optional", "client_certificate_required", "no_client_certificate"),
+		validators.OneOfGroup(path.MatchRoot("tls_cert_params").AtName("validation_params"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters"), "client_certificate_verify_choice", "client_certificate_optional", "client_certificate_required", "no_client_certificate"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters").AtName("common_params").AtName("tls_certificates").AtAnyListIndex(), "ocsp_stapling_choice", "custom_hash_algorithms", "disable_ocsp_stapling", "use_system_defaults"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters").AtName("common_params").AtName("tls_certificates").AtAnyListIndex().AtName("private_key"), "secret_info_oneof", "blindfold_secret_info", "clear_secret_info"),
+		validators.OneOfGroup(path.MatchRoot("tls_parameters").AtName("common_params").AtName("validation_params"), "trusted_ca_choice", "trusted_ca", "trusted_ca_url"),
+		validators.OneOfGroup(path.MatchRoot("waf_type"), "ref_type", "app_firewall", "disable_waf", "inherit_waf"),
+	}
+}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *VirtualHostResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -2679,7 +3047,10 @@ func (r *VirtualHostResource) ModifyPlan(ctx context.Context, req resource.Modif
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "virtual_host", "", req.Config, &resp.Plan)...)
 		var plan VirtualHostResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -2697,7 +3068,8 @@ func (r *VirtualHostResource) ModifyPlan(ctx context.Context, req resource.Modif
 
 func (r *VirtualHostResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data VirtualHostResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -2736,6 +3108,7 @@ func (r *VirtualHostResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -2745,6 +3118,7 @@ func (r *VirtualHostResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.AdvertisePolicies.IsNull() && !data.AdvertisePolicies.IsUnknown() {
@@ -2802,12 +3176,79 @@ func (r *VirtualHostResource) Create(ctx context.Context, req resource.CreateReq
 		}
 		if data.Authentication.CookieParams != nil {
 			cookie_paramsNestedMap := make(map[string]interface{})
+			if data.Authentication.CookieParams.AuthHMAC != nil {
+				auth_hmacLevel3Map := make(map[string]interface{})
+				if data.Authentication.CookieParams.AuthHMAC.PrimKey != nil {
+					prim_keyLevel4Map := make(map[string]interface{})
+					if data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.Location.IsNull() && !data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						prim_keyLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.Provider.IsNull() && !data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.URL.IsNull() && !data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.URL.ValueString()
+						}
+						prim_keyLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					auth_hmacLevel3Map["prim_key"] = prim_keyLevel4Map
+				}
+				if !data.Authentication.CookieParams.AuthHMAC.PrimKeyExpiry.IsNull() && !data.Authentication.CookieParams.AuthHMAC.PrimKeyExpiry.IsUnknown() {
+					auth_hmacLevel3Map["prim_key_expiry"] = data.Authentication.CookieParams.AuthHMAC.PrimKeyExpiry.ValueString()
+				}
+				if data.Authentication.CookieParams.AuthHMAC.SecKey != nil {
+					sec_keyLevel4Map := make(map[string]interface{})
+					if data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.Location.IsNull() && !data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						sec_keyLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.Provider.IsNull() && !data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.URL.IsNull() && !data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.URL.ValueString()
+						}
+						sec_keyLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					auth_hmacLevel3Map["sec_key"] = sec_keyLevel4Map
+				}
+				if !data.Authentication.CookieParams.AuthHMAC.SecKeyExpiry.IsNull() && !data.Authentication.CookieParams.AuthHMAC.SecKeyExpiry.IsUnknown() {
+					auth_hmacLevel3Map["sec_key_expiry"] = data.Authentication.CookieParams.AuthHMAC.SecKeyExpiry.ValueString()
+				}
+				cookie_paramsNestedMap["auth_hmac"] = auth_hmacLevel3Map
+			}
 			if !data.Authentication.CookieParams.CookieExpiry.IsNull() && !data.Authentication.CookieParams.CookieExpiry.IsUnknown() {
 				cookie_paramsNestedMap["cookie_expiry"] = data.Authentication.CookieParams.CookieExpiry.ValueInt64()
 			}
 			if !data.Authentication.CookieParams.CookieRefreshInterval.IsNull() && !data.Authentication.CookieParams.CookieRefreshInterval.IsUnknown() {
 				cookie_paramsNestedMap["cookie_refresh_interval"] = data.Authentication.CookieParams.CookieRefreshInterval.ValueInt64()
 			}
+			if data.Authentication.CookieParams.KmsKeyHMAC != nil {
+				cookie_paramsNestedMap["kms_key_hmac"] = map[string]interface{}{}
+			}
 			if !data.Authentication.CookieParams.SessionExpiry.IsNull() && !data.Authentication.CookieParams.SessionExpiry.IsUnknown() {
 				cookie_paramsNestedMap["session_expiry"] = data.Authentication.CookieParams.SessionExpiry.ValueInt64()
 			}
@@ -2991,6 +3432,22 @@ func (r *VirtualHostResource) Create(ctx context.Context, req resource.CreateReq
 		http_protocol_optionsMap := make(map[string]interface{})
 		if data.HTTPProtocolOptions.HTTPProtocolEnableV1Only != nil {
 			http_protocol_enable_v1_onlyNestedMap := make(map[string]interface{})
+			if data.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation != nil {
+				header_transformationLevel3Map := make(map[string]interface{})
+				if data.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.DefaultHeaderTransformation != nil {
+					header_transformationLevel3Map["default_header_transformation"] = map[string]interface{}{}
+				}
+				if data.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.LegacyHeaderTransformation != nil {
+					header_transformationLevel3Map["legacy_header_transformation"] = map[string]interface{}{}
+				}
+				if data.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.PreserveCaseHeaderTransformation != nil {
+					header_transformationLevel3Map["preserve_case_header_transformation"] = map[string]interface{}{}
+				}
+				if data.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.ProperCaseHeaderTransformation != nil {
+					header_transformationLevel3Map["proper_case_header_transformation"] = map[string]interface{}{}
+				}
+				http_protocol_enable_v1_onlyNestedMap["header_transformation"] = header_transformationLevel3Map
+			}
 			http_protocol_optionsMap["http_protocol_enable_v1_only"] = http_protocol_enable_v1_onlyNestedMap
 		}
 		if data.HTTPProtocolOptions.HTTPProtocolEnableV1V2 != nil {
@@ -3494,6 +3951,10 @@ func (r *VirtualHostResource) Create(ctx context.Context, req resource.CreateReq
 			if !data.TLSCertParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.TLSCertParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
 				validation_paramsNestedMap["skip_hostname_verification"] = data.TLSCertParams.ValidationParams.SkipHostnameVerification.ValueBool()
 			}
+			if data.TLSCertParams.ValidationParams.TrustedCA != nil {
+				trusted_caLevel3Map := make(map[string]interface{})
+				validation_paramsNestedMap["trusted_ca"] = trusted_caLevel3Map
+			}
 			if !data.TLSCertParams.ValidationParams.TrustedCAURL.IsNull() && !data.TLSCertParams.ValidationParams.TrustedCAURL.IsUnknown() {
 				validation_paramsNestedMap["trusted_ca_url"] = data.TLSCertParams.ValidationParams.TrustedCAURL.ValueString()
 			}
@@ -3524,6 +3985,27 @@ func (r *VirtualHostResource) Create(ctx context.Context, req resource.CreateReq
 			if !data.TLSParameters.CommonParams.MinimumProtocolVersion.IsNull() && !data.TLSParameters.CommonParams.MinimumProtocolVersion.IsUnknown() {
 				common_paramsNestedMap["minimum_protocol_version"] = data.TLSParameters.CommonParams.MinimumProtocolVersion.ValueString()
 			}
+			if data.TLSParameters.CommonParams.ValidationParams != nil {
+				validation_paramsLevel3Map := make(map[string]interface{})
+				if !data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
+					validation_paramsLevel3Map["skip_hostname_verification"] = data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.ValueBool()
+				}
+				if data.TLSParameters.CommonParams.ValidationParams.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					validation_paramsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.IsUnknown() {
+					validation_paramsLevel3Map["trusted_ca_url"] = data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.ValueString()
+				}
+				if !data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.IsUnknown() {
+					var verify_subject_alt_namesLevel3Items []string
+					diags := data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.ElementsAs(ctx, &verify_subject_alt_namesLevel3Items, false)
+					if !diags.HasError() {
+						validation_paramsLevel3Map["verify_subject_alt_names"] = verify_subject_alt_namesLevel3Items
+					}
+				}
+				common_paramsNestedMap["validation_params"] = validation_paramsLevel3Map
+			}
 			tls_parametersMap["common_params"] = common_paramsNestedMap
 		}
 		if data.TLSParameters.NoClientCertificate != nil {
@@ -3610,11 +4092,15 @@ func (r *VirtualHostResource) Create(ctx context.Context, req resource.CreateReq
 
 	apiResource, err := r.client.CreateVirtualHost(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create VirtualHost: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "virtual_host", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
+
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
 
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
@@ -5244,7 +5730,7 @@ func (r *VirtualHostResource) Read(ctx context.Context, req resource.ReadRequest
 	apiResource, err := r.client.GetVirtualHost(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "VirtualHost not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -5252,11 +5738,12 @@ func (r *VirtualHostResource) Read(ctx context.Context, req resource.ReadRequest
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read VirtualHost: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "virtual_host", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -5267,31 +5754,13 @@ func (r *VirtualHostResource) Read(ctx context.Context, req resource.ReadRequest
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -5299,6 +5768,7 @@ func (r *VirtualHostResource) Read(ctx context.Context, req resource.ReadRequest
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 	if listData, ok := apiResource.Spec["advertise_policies"].([]interface{}); ok && len(listData) > 0 {
 		var advertise_policiesList []VirtualHostAdvertisePoliciesModel
@@ -6906,7 +7376,8 @@ func (r *VirtualHostResource) Read(ctx context.Context, req resource.ReadRequest
 
 func (r *VirtualHostResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data VirtualHostResourceModel
-	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -6940,6 +7411,7 @@ func (r *VirtualHostResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -6949,6 +7421,7 @@ func (r *VirtualHostResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 	if !data.AdvertisePolicies.IsNull() && !data.AdvertisePolicies.IsUnknown() {
@@ -7006,12 +7479,79 @@ func (r *VirtualHostResource) Update(ctx context.Context, req resource.UpdateReq
 		}
 		if data.Authentication.CookieParams != nil {
 			cookie_paramsNestedMap := make(map[string]interface{})
+			if data.Authentication.CookieParams.AuthHMAC != nil {
+				auth_hmacLevel3Map := make(map[string]interface{})
+				if data.Authentication.CookieParams.AuthHMAC.PrimKey != nil {
+					prim_keyLevel4Map := make(map[string]interface{})
+					if data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.Location.IsNull() && !data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.Authentication.CookieParams.AuthHMAC.PrimKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						prim_keyLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.Provider.IsNull() && !data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.URL.IsNull() && !data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.Authentication.CookieParams.AuthHMAC.PrimKey.ClearSecretInfo.URL.ValueString()
+						}
+						prim_keyLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					auth_hmacLevel3Map["prim_key"] = prim_keyLevel4Map
+				}
+				if !data.Authentication.CookieParams.AuthHMAC.PrimKeyExpiry.IsNull() && !data.Authentication.CookieParams.AuthHMAC.PrimKeyExpiry.IsUnknown() {
+					auth_hmacLevel3Map["prim_key_expiry"] = data.Authentication.CookieParams.AuthHMAC.PrimKeyExpiry.ValueString()
+				}
+				if data.Authentication.CookieParams.AuthHMAC.SecKey != nil {
+					sec_keyLevel4Map := make(map[string]interface{})
+					if data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo != nil {
+						blindfold_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.DecryptionProvider.IsNull() && !data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.DecryptionProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["decryption_provider"] = data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.DecryptionProvider.ValueString()
+						}
+						if !data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.Location.IsNull() && !data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.Location.IsUnknown() {
+							blindfold_secret_infoLevel5Map["location"] = data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.Location.ValueString()
+						}
+						if !data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.StoreProvider.IsNull() && !data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.StoreProvider.IsUnknown() {
+							blindfold_secret_infoLevel5Map["store_provider"] = data.Authentication.CookieParams.AuthHMAC.SecKey.BlindfoldSecretInfo.StoreProvider.ValueString()
+						}
+						sec_keyLevel4Map["blindfold_secret_info"] = blindfold_secret_infoLevel5Map
+					}
+					if data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo != nil {
+						clear_secret_infoLevel5Map := make(map[string]interface{})
+						if !data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.Provider.IsNull() && !data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.Provider.IsUnknown() {
+							clear_secret_infoLevel5Map["provider"] = data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.Provider.ValueString()
+						}
+						if !data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.URL.IsNull() && !data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.URL.IsUnknown() {
+							clear_secret_infoLevel5Map["url"] = data.Authentication.CookieParams.AuthHMAC.SecKey.ClearSecretInfo.URL.ValueString()
+						}
+						sec_keyLevel4Map["clear_secret_info"] = clear_secret_infoLevel5Map
+					}
+					auth_hmacLevel3Map["sec_key"] = sec_keyLevel4Map
+				}
+				if !data.Authentication.CookieParams.AuthHMAC.SecKeyExpiry.IsNull() && !data.Authentication.CookieParams.AuthHMAC.SecKeyExpiry.IsUnknown() {
+					auth_hmacLevel3Map["sec_key_expiry"] = data.Authentication.CookieParams.AuthHMAC.SecKeyExpiry.ValueString()
+				}
+				cookie_paramsNestedMap["auth_hmac"] = auth_hmacLevel3Map
+			}
 			if !data.Authentication.CookieParams.CookieExpiry.IsNull() && !data.Authentication.CookieParams.CookieExpiry.IsUnknown() {
 				cookie_paramsNestedMap["cookie_expiry"] = data.Authentication.CookieParams.CookieExpiry.ValueInt64()
 			}
 			if !data.Authentication.CookieParams.CookieRefreshInterval.IsNull() && !data.Authentication.CookieParams.CookieRefreshInterval.IsUnknown() {
 				cookie_paramsNestedMap["cookie_refresh_interval"] = data.Authentication.CookieParams.CookieRefreshInterval.ValueInt64()
 			}
+			if data.Authentication.CookieParams.KmsKeyHMAC != nil {
+				cookie_paramsNestedMap["kms_key_hmac"] = map[string]interface{}{}
+			}
 			if !data.Authentication.CookieParams.SessionExpiry.IsNull() && !data.Authentication.CookieParams.SessionExpiry.IsUnknown() {
 				cookie_paramsNestedMap["session_expiry"] = data.Authentication.CookieParams.SessionExpiry.ValueInt64()
 			}
@@ -7195,6 +7735,22 @@ func (r *VirtualHostResource) Update(ctx context.Context, req resource.UpdateReq
 		http_protocol_optionsMap := make(map[string]interface{})
 		if data.HTTPProtocolOptions.HTTPProtocolEnableV1Only != nil {
 			http_protocol_enable_v1_onlyNestedMap := make(map[string]interface{})
+			if data.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation != nil {
+				header_transformationLevel3Map := make(map[string]interface{})
+				if data.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.DefaultHeaderTransformation != nil {
+					header_transformationLevel3Map["default_header_transformation"] = map[string]interface{}{}
+				}
+				if data.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.LegacyHeaderTransformation != nil {
+					header_transformationLevel3Map["legacy_header_transformation"] = map[string]interface{}{}
+				}
+				if data.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.PreserveCaseHeaderTransformation != nil {
+					header_transformationLevel3Map["preserve_case_header_transformation"] = map[string]interface{}{}
+				}
+				if data.HTTPProtocolOptions.HTTPProtocolEnableV1Only.HeaderTransformation.ProperCaseHeaderTransformation != nil {
+					header_transformationLevel3Map["proper_case_header_transformation"] = map[string]interface{}{}
+				}
+				http_protocol_enable_v1_onlyNestedMap["header_transformation"] = header_transformationLevel3Map
+			}
 			http_protocol_optionsMap["http_protocol_enable_v1_only"] = http_protocol_enable_v1_onlyNestedMap
 		}
 		if data.HTTPProtocolOptions.HTTPProtocolEnableV1V2 != nil {
@@ -7698,6 +8254,10 @@ func (r *VirtualHostResource) Update(ctx context.Context, req resource.UpdateReq
 			if !data.TLSCertParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.TLSCertParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
 				validation_paramsNestedMap["skip_hostname_verification"] = data.TLSCertParams.ValidationParams.SkipHostnameVerification.ValueBool()
 			}
+			if data.TLSCertParams.ValidationParams.TrustedCA != nil {
+				trusted_caLevel3Map := make(map[string]interface{})
+				validation_paramsNestedMap["trusted_ca"] = trusted_caLevel3Map
+			}
 			if !data.TLSCertParams.ValidationParams.TrustedCAURL.IsNull() && !data.TLSCertParams.ValidationParams.TrustedCAURL.IsUnknown() {
 				validation_paramsNestedMap["trusted_ca_url"] = data.TLSCertParams.ValidationParams.TrustedCAURL.ValueString()
 			}
@@ -7728,6 +8288,27 @@ func (r *VirtualHostResource) Update(ctx context.Context, req resource.UpdateReq
 			if !data.TLSParameters.CommonParams.MinimumProtocolVersion.IsNull() && !data.TLSParameters.CommonParams.MinimumProtocolVersion.IsUnknown() {
 				common_paramsNestedMap["minimum_protocol_version"] = data.TLSParameters.CommonParams.MinimumProtocolVersion.ValueString()
 			}
+			if data.TLSParameters.CommonParams.ValidationParams != nil {
+				validation_paramsLevel3Map := make(map[string]interface{})
+				if !data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.IsUnknown() {
+					validation_paramsLevel3Map["skip_hostname_verification"] = data.TLSParameters.CommonParams.ValidationParams.SkipHostnameVerification.ValueBool()
+				}
+				if data.TLSParameters.CommonParams.ValidationParams.TrustedCA != nil {
+					trusted_caLevel4Map := make(map[string]interface{})
+					validation_paramsLevel3Map["trusted_ca"] = trusted_caLevel4Map
+				}
+				if !data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.IsUnknown() {
+					validation_paramsLevel3Map["trusted_ca_url"] = data.TLSParameters.CommonParams.ValidationParams.TrustedCAURL.ValueString()
+				}
+				if !data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.IsNull() && !data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.IsUnknown() {
+					var verify_subject_alt_namesLevel3Items []string
+					diags := data.TLSParameters.CommonParams.ValidationParams.VerifySubjectAltNames.ElementsAs(ctx, &verify_subject_alt_namesLevel3Items, false)
+					if !diags.HasError() {
+						validation_paramsLevel3Map["verify_subject_alt_names"] = verify_subject_alt_namesLevel3Items
+					}
+				}
+				common_paramsNestedMap["validation_params"] = validation_paramsLevel3Map
+			}
 			tls_parametersMap["common_params"] = common_paramsNestedMap
 		}
 		if data.TLSParameters.NoClientCertificate != nil {
@@ -7814,7 +8395,7 @@ func (r *VirtualHostResource) Update(ctx context.Context, req resource.UpdateReq
 
 	_, err := r.client.UpdateVirtualHost(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update VirtualHost: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "virtual_host", "update"))
 		return
 	}
 
@@ -7825,9 +8406,12 @@ func (r *VirtualHostResource) Update(ctx context.Context, req resource.UpdateReq
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.GetVirtualHost(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read VirtualHost after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "virtual_host", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 	// Set computed fields from API response
 	if v, ok := fetched.Spec["add_location"].(bool); ok {
@@ -9520,7 +10104,7 @@ func (r *VirtualHostResource) Delete(ctx context.Context, req resource.DeleteReq
 	err := r.client.DeleteVirtualHost(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "VirtualHost already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -9529,37 +10113,33 @@ func (r *VirtualHostResource) Delete(ctx context.Context, req resource.DeleteReq
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "VirtualHost delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete VirtualHost: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "virtual_host", "delete"))
 		return
 	}
 }
 
 func (r *VirtualHostResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
-	}
-	namespace := parts[0]
-	name := parts[1]
+	importResourceState(ctx, r.client, true, "", req, resp)
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
+// MoveState implements resource.ResourceWithMoveState
+func (r *VirtualHostResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("virtual_host", true)}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+// listObjects implements listableResource
+func (r *VirtualHostResource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.ListVirtualHosts(ctx, namespace, opts)
+}
+
+// requiredNamespace implements listableResource
+func (r *VirtualHostResource) requiredNamespace() string {
+	return ""
 }
@@ -0,0 +1,52 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// wait_helpers.go - Manually maintained helpers for resources that wait for
+// an object to reach a state reported asynchronously by F5 XC.
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultPollInterval is the interval between state checks while waiting
+const defaultPollInterval = 15 * time.Second
+
+// stateRefreshFunc returns the current state of the object being waited on
+type stateRefreshFunc func(ctx context.Context) (string, error)
+
+// waitForState calls refresh every interval until it reports one of the
+// target states. It fails as soon as refresh reports one of the failed states
+// or returns an error, and when ctx is done. Any other state is pending.
+func waitForState(ctx context.Context, refresh stateRefreshFunc, target, failed []string, interval time.Duration) (string, error) {
+	var state string
+	for {
+		var err error
+		state, err = refresh(ctx)
+		if err != nil {
+			return state, err
+		}
+		if slices.Contains(target, state) {
+			return state, nil
+		}
+		if slices.Contains(failed, state) {
+			return state, fmt.Errorf("reached state %q while waiting for %v", state, target)
+		}
+
+		tflog.Debug(ctx, "Waiting for state", map[string]interface{}{
+			"state":  state,
+			"target": target,
+		})
+
+		select {
+		case <-ctx.Done():
+			return state, fmt.Errorf("timed out waiting for state %v, last state %q: %w", target, state, ctx.Err())
+		case <-time.After(interval):
+		}
+	}
+}
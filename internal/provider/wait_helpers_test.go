@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// sequenceRefresh returns the given states in order and keeps returning the last one
+func sequenceRefresh(states ...string) stateRefreshFunc {
+	i := 0
+	return func(ctx context.Context) (string, error) {
+		state := states[i]
+		if i < len(states)-1 {
+			i++
+		}
+		return state, nil
+	}
+}
+
+func TestWaitForState(t *testing.T) {
+	target := []string{"ONLINE"}
+	failed := []string{"FAILED"}
+
+	t.Run("reaches target", func(t *testing.T) {
+		state, err := waitForState(context.Background(), sequenceRefresh("PROVISIONING", "PROVISIONING", "ONLINE"), target, failed, time.Millisecond)
+		if err != nil {
+			t.Fatalf("waitForState() error = %v", err)
+		}
+		if state != "ONLINE" {
+			t.Errorf("waitForState() = %q, want ONLINE", state)
+		}
+	})
+
+	t.Run("stops on failed state", func(t *testing.T) {
+		state, err := waitForState(context.Background(), sequenceRefresh("PROVISIONING", "FAILED", "ONLINE"), target, failed, time.Millisecond)
+		if err == nil {
+			t.Fatal("waitForState() expected error for failed state")
+		}
+		if state != "FAILED" {
+			t.Errorf("waitForState() = %q, want FAILED", state)
+		}
+	})
+
+	t.Run("returns refresh error", func(t *testing.T) {
+		refreshErr := errors.New("boom")
+		_, err := waitForState(context.Background(), func(ctx context.Context) (string, error) {
+			return "", refreshErr
+		}, target, failed, time.Millisecond)
+		if !errors.Is(err, refreshErr) {
+			t.Errorf("waitForState() error = %v, want %v", err, refreshErr)
+		}
+	})
+
+	t.Run("times out", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		state, err := waitForState(ctx, sequenceRefresh("PROVISIONING"), target, failed, time.Millisecond)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("waitForState() error = %v, want deadline exceeded", err)
+		}
+		if state != "PROVISIONING" {
+			t.Errorf("waitForState() = %q, want PROVISIONING", state)
+		}
+	})
+}
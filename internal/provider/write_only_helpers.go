@@ -0,0 +1,48 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// write_only_helpers.go - Manually maintained helpers for resources with
+// write-only attributes, whose values are never stored in the plan or state.
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// getPlanWithWriteOnlyValues reads the plan into target like plan.Get, with
+// the values of write-only attributes taken from the configuration. Terraform
+// always plans write-only attributes as null, so they are only available in
+// the configuration during create and update.
+func getPlanWithWriteOnlyValues(ctx context.Context, plan tfsdk.Plan, config tfsdk.Config, target interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	raw, err := tftypes.Transform(plan.Raw, func(p *tftypes.AttributePath, v tftypes.Value) (tftypes.Value, error) {
+		attr, err := plan.Schema.AttributeAtTerraformPath(ctx, p)
+		if err != nil || !attr.IsWriteOnly() {
+			return v, nil
+		}
+		configValue, _, err := tftypes.WalkAttributePath(config.Raw, p)
+		if err != nil {
+			// The block holding the attribute is not in the configuration,
+			// for example a list item added by a plan modifier.
+			return v, nil
+		}
+		value, ok := configValue.(tftypes.Value)
+		if !ok || !value.Type().Equal(v.Type()) {
+			return v, nil
+		}
+		return value, nil
+	})
+	if err != nil {
+		diags.AddError("Failed to Read Write-Only Attributes", err.Error())
+		return diags
+	}
+
+	withWriteOnly := tfsdk.Plan{Schema: plan.Schema, Raw: raw}
+	diags.Append(withWriteOnly.Get(ctx, target)...)
+	return diags
+}
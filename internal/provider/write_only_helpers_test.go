@@ -0,0 +1,85 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestGetPlanWithWriteOnlyValues(t *testing.T) {
+	ctx := context.Background()
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name": schema.StringAttribute{Required: true},
+		},
+		Blocks: map[string]schema.Block{
+			"clear_secret_info": schema.SingleNestedBlock{
+				Attributes: map[string]schema.Attribute{
+					"url":         schema.StringAttribute{Optional: true, Sensitive: true, WriteOnly: true},
+					"url_version": schema.Int64Attribute{Optional: true},
+				},
+			},
+		},
+	}
+	secretType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"url":         tftypes.String,
+		"url_version": tftypes.Number,
+	}}
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"name":              tftypes.String,
+		"clear_secret_info": secretType,
+	}}
+	value := func(url tftypes.Value) tftypes.Value {
+		return tftypes.NewValue(objectType, map[string]tftypes.Value{
+			"name": tftypes.NewValue(tftypes.String, "example"),
+			"clear_secret_info": tftypes.NewValue(secretType, map[string]tftypes.Value{
+				"url":         url,
+				"url_version": tftypes.NewValue(tftypes.Number, 1),
+			}),
+		})
+	}
+
+	plan := tfsdk.Plan{Schema: s, Raw: value(tftypes.NewValue(tftypes.String, nil))}
+	config := tfsdk.Config{Schema: s, Raw: value(tftypes.NewValue(tftypes.String, "string:///c2VjcmV0"))}
+
+	var data struct {
+		Name            types.String `tfsdk:"name"`
+		ClearSecretInfo *struct {
+			URL        types.String `tfsdk:"url"`
+			URLVersion types.Int64  `tfsdk:"url_version"`
+		} `tfsdk:"clear_secret_info"`
+	}
+	diags := getPlanWithWriteOnlyValues(ctx, plan, config, &data)
+	if diags.HasError() {
+		t.Fatalf("getPlanWithWriteOnlyValues() diagnostics: %v", diags)
+	}
+
+	if got := data.Name.ValueString(); got != "example" {
+		t.Errorf("name = %q, want %q", got, "example")
+	}
+	if data.ClearSecretInfo == nil {
+		t.Fatal("clear_secret_info is nil")
+	}
+	if got := data.ClearSecretInfo.URL.ValueString(); got != "string:///c2VjcmV0" {
+		t.Errorf("clear_secret_info.url = %q, want the configured value", got)
+	}
+	if got := data.ClearSecretInfo.URLVersion.ValueInt64(); got != 1 {
+		t.Errorf("clear_secret_info.url_version = %d, want 1", got)
+	}
+
+	// The plan itself is left untouched
+	planned, _, err := tftypes.WalkAttributePath(plan.Raw, tftypes.NewAttributePath().WithAttributeName("clear_secret_info").WithAttributeName("url"))
+	if err != nil {
+		t.Fatalf("WalkAttributePath() error: %v", err)
+	}
+	if v, ok := planned.(tftypes.Value); !ok || !v.IsNull() {
+		t.Errorf("planned clear_secret_info.url = %v, want null", planned)
+	}
+}
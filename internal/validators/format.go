@@ -0,0 +1,165 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/netip"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+// Validators for the string formats of ves.io.schema.rules.string.* and
+// ves.io.schema.rules.repeated.items.string.* validation rules.
+
+// hostnameLabelPattern validates a single hostname label
+var hostnameLabelPattern = regexp.MustCompile(`^[a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?$`)
+
+// IPv4Validator returns a validator for IPv4 addresses, e.g. 192.0.2.1
+func IPv4Validator() validator.String {
+	return &formatValidator{
+		description: "must be a valid IPv4 address",
+		summary:     "Invalid IPv4 Address",
+		valid: func(value string) bool {
+			addr, err := netip.ParseAddr(value)
+			return err == nil && addr.Is4()
+		},
+	}
+}
+
+// IPv6Validator returns a validator for IPv6 addresses, e.g. 2001:db8::1
+func IPv6Validator() validator.String {
+	return &formatValidator{
+		description: "must be a valid IPv6 address",
+		summary:     "Invalid IPv6 Address",
+		valid: func(value string) bool {
+			addr, err := netip.ParseAddr(value)
+			return err == nil && addr.Is6() && !addr.Is4In6()
+		},
+	}
+}
+
+// IPValidator returns a validator for IPv4 or IPv6 addresses
+func IPValidator() validator.String {
+	return &formatValidator{
+		description: "must be a valid IPv4 or IPv6 address",
+		summary:     "Invalid IP Address",
+		valid: func(value string) bool {
+			_, err := netip.ParseAddr(value)
+			return err == nil
+		},
+	}
+}
+
+// IPv4PrefixValidator returns a validator for IPv4 prefixes in CIDR notation, e.g. 10.0.0.0/8
+func IPv4PrefixValidator() validator.String {
+	return &formatValidator{
+		description: "must be a valid IPv4 prefix in CIDR notation",
+		summary:     "Invalid IPv4 Prefix",
+		valid: func(value string) bool {
+			prefix, err := netip.ParsePrefix(value)
+			return err == nil && prefix.Addr().Is4()
+		},
+	}
+}
+
+// IPv6PrefixValidator returns a validator for IPv6 prefixes in CIDR notation, e.g. 2001:db8::/32
+func IPv6PrefixValidator() validator.String {
+	return &formatValidator{
+		description: "must be a valid IPv6 prefix in CIDR notation",
+		summary:     "Invalid IPv6 Prefix",
+		valid: func(value string) bool {
+			prefix, err := netip.ParsePrefix(value)
+			return err == nil && prefix.Addr().Is6()
+		},
+	}
+}
+
+// IPPrefixValidator returns a validator for IPv4 or IPv6 prefixes in CIDR notation
+func IPPrefixValidator() validator.String {
+	return &formatValidator{
+		description: "must be a valid IPv4 or IPv6 prefix in CIDR notation",
+		summary:     "Invalid IP Prefix",
+		valid: func(value string) bool {
+			_, err := netip.ParsePrefix(value)
+			return err == nil
+		},
+	}
+}
+
+// HostnameValidator returns a validator for RFC 1123 hostnames, e.g. www.example.com
+func HostnameValidator() validator.String {
+	return &formatValidator{
+		description: "must be a valid hostname",
+		summary:     "Invalid Hostname",
+		valid: func(value string) bool {
+			value = strings.TrimSuffix(value, ".")
+			if value == "" || len(value) > 253 {
+				return false
+			}
+			for _, label := range strings.Split(value, ".") {
+				if !hostnameLabelPattern.MatchString(label) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
+
+// EmailValidator returns a validator for plain email addresses, e.g. user@example.com
+func EmailValidator() validator.String {
+	return &formatValidator{
+		description: "must be a valid email address",
+		summary:     "Invalid Email Address",
+		valid: func(value string) bool {
+			addr, err := mail.ParseAddress(value)
+			return err == nil && addr.Address == value
+		},
+	}
+}
+
+// PatternValidator returns a validator that requires a match of the regular
+// expression pattern somewhere in the value. It panics if pattern does not compile.
+func PatternValidator(pattern string) validator.String {
+	re := regexp.MustCompile(pattern)
+	return &formatValidator{
+		description: fmt.Sprintf("must match the regular expression %q", pattern),
+		summary:     "Invalid Value",
+		valid:       re.MatchString,
+	}
+}
+
+type formatValidator struct {
+	description string
+	summary     string
+	valid       func(string) bool
+}
+
+func (v formatValidator) Description(ctx context.Context) string {
+	return v.description
+}
+
+func (v formatValidator) MarkdownDescription(ctx context.Context) string {
+	return v.description
+}
+
+func (v formatValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+
+	if !v.valid(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			v.summary,
+			fmt.Sprintf("Value '%s' %s.", value, v.description),
+		)
+	}
+}
@@ -0,0 +1,77 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestFormatValidators(t *testing.T) {
+	tests := []struct {
+		name        string
+		validator   validator.String
+		input       string
+		expectError bool
+	}{
+		{"ipv4 valid", IPv4Validator(), "192.0.2.1", false},
+		{"ipv4 rejects ipv6", IPv4Validator(), "2001:db8::1", true},
+		{"ipv4 rejects prefix", IPv4Validator(), "192.0.2.0/24", true},
+		{"ipv6 valid", IPv6Validator(), "2001:db8::1", false},
+		{"ipv6 rejects ipv4", IPv6Validator(), "192.0.2.1", true},
+		{"ip accepts ipv4", IPValidator(), "192.0.2.1", false},
+		{"ip accepts ipv6", IPValidator(), "2001:db8::1", false},
+		{"ip rejects hostname", IPValidator(), "example.com", true},
+		{"ipv4 prefix valid", IPv4PrefixValidator(), "10.0.0.0/8", false},
+		{"ipv4 prefix rejects address", IPv4PrefixValidator(), "10.0.0.1", true},
+		{"ipv4 prefix rejects ipv6", IPv4PrefixValidator(), "2001:db8::/32", true},
+		{"ipv4 prefix rejects bad length", IPv4PrefixValidator(), "10.0.0.0/33", true},
+		{"ipv6 prefix valid", IPv6PrefixValidator(), "2001:db8::/32", false},
+		{"ipv6 prefix rejects ipv4", IPv6PrefixValidator(), "10.0.0.0/8", true},
+		{"ip prefix accepts both", IPPrefixValidator(), "2001:db8::/32", false},
+		{"hostname valid", HostnameValidator(), "www.example.com", false},
+		{"hostname trailing dot", HostnameValidator(), "example.com.", false},
+		{"hostname rejects underscore", HostnameValidator(), "my_host.example.com", true},
+		{"hostname rejects leading hyphen", HostnameValidator(), "-web.example.com", true},
+		{"hostname rejects empty label", HostnameValidator(), "web..example.com", true},
+		{"email valid", EmailValidator(), "user@example.com", false},
+		{"email rejects display name", EmailValidator(), "User <user@example.com>", true},
+		{"email rejects missing domain", EmailValidator(), "user@", true},
+		{"pattern match", PatternValidator(`^(vpc-)([a-z0-9]{8}|[a-z0-9]{17})$`), "vpc-0123abcd", false},
+		{"pattern mismatch", PatternValidator(`^(vpc-)([a-z0-9]{8}|[a-z0-9]{17})$`), "subnet-0123abcd", true},
+	}
+
+	ctx := context.Background()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("value"),
+				ConfigValue: types.StringValue(tt.input),
+			}
+
+			resp := &validator.StringResponse{}
+			tt.validator.ValidateString(ctx, req, resp)
+
+			hasError := resp.Diagnostics.HasError()
+			if hasError != tt.expectError {
+				t.Errorf("%s for %q: hasError = %v, expected %v", tt.validator.Description(ctx), tt.input, hasError, tt.expectError)
+			}
+		})
+	}
+}
+
+func TestFormatValidatorsSkipNullAndUnknown(t *testing.T) {
+	ctx := context.Background()
+	for _, value := range []types.String{types.StringNull(), types.StringUnknown()} {
+		resp := &validator.StringResponse{}
+		IPv4Validator().ValidateString(ctx, validator.StringRequest{Path: path.Root("value"), ConfigValue: value}, resp)
+		if resp.Diagnostics.HasError() {
+			t.Errorf("unexpected error for %s: %v", value, resp.Diagnostics)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package validators
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// OneOfGroup returns a resource config validator for an OpenAPI oneof group.
+// At most one of fields may be configured within each object matched by
+// parent. Use path.MatchRelative() as parent for top-level attributes and, for
+// example, path.MatchRoot("origin_servers").AtAnyListIndex() for attributes of
+// every element of a list block. These groups are not required: the API
+// applies a default choice when none is configured.
+func OneOfGroup(parent path.Expression, group string, fields ...string) resource.ConfigValidator {
+	return &oneOfGroupValidator{
+		parent: parent,
+		group:  group,
+		fields: fields,
+	}
+}
+
+// RequiredOneOfGroup returns a resource config validator for an OpenAPI oneof
+// group that has no default choice. Exactly one of fields must be configured
+// within each object matched by parent.
+func RequiredOneOfGroup(parent path.Expression, group string, fields ...string) resource.ConfigValidator {
+	return &oneOfGroupValidator{
+		parent:   parent,
+		group:    group,
+		fields:   fields,
+		required: true,
+	}
+}
+
+type oneOfGroupValidator struct {
+	parent   path.Expression
+	group    string
+	fields   []string
+	required bool
+}
+
+func (v oneOfGroupValidator) Description(ctx context.Context) string {
+	if v.required {
+		return fmt.Sprintf("exactly one of %s must be configured", strings.Join(v.quotedFields(), ", "))
+	}
+	return fmt.Sprintf("at most one of %s may be configured", strings.Join(v.quotedFields(), ", "))
+}
+
+func (v oneOfGroupValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v oneOfGroupValidator) ValidateResource(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	if v.parent.String() == "" {
+		values := make(map[string]attr.Value, len(v.fields))
+		for _, field := range v.fields {
+			var value attr.Value
+			diags := req.Config.GetAttribute(ctx, path.Root(field), &value)
+			resp.Diagnostics.Append(diags...)
+			if diags.HasError() {
+				return
+			}
+			values[field] = value
+		}
+		v.validate(path.Empty(), values, resp)
+		return
+	}
+
+	parents, diags := req.Config.PathMatches(ctx, v.parent)
+	resp.Diagnostics.Append(diags...)
+	if diags.HasError() {
+		return
+	}
+
+	for _, parent := range parents {
+		var value attr.Value
+		diags := req.Config.GetAttribute(ctx, parent, &value)
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() {
+			return
+		}
+
+		// PathMatches also returns null or unknown ancestors of the expression,
+		// which have no fields to check.
+		object, ok := value.(basetypes.ObjectValue)
+		if !ok || object.IsNull() || object.IsUnknown() {
+			continue
+		}
+		v.validate(parent, object.Attributes(), resp)
+	}
+}
+
+// validate reports an error when more than one known field is set, or when
+// none is set in a required group.
+func (v oneOfGroupValidator) validate(parent path.Path, values map[string]attr.Value, resp *resource.ValidateConfigResponse) {
+	var configured []string
+	for _, field := range v.fields {
+		value, ok := values[field]
+		if !ok || value == nil || value.IsNull() {
+			continue
+		}
+		if value.IsUnknown() {
+			// Cannot decide until the value is known at apply time.
+			return
+		}
+		configured = append(configured, field)
+	}
+	if len(configured) == 0 && v.required {
+		resp.Diagnostics.AddAttributeError(
+			parent,
+			"Missing Configuration",
+			fmt.Sprintf("One of %s must be configured (oneof group %q).", strings.Join(v.quotedFields(), ", "), v.group),
+		)
+		return
+	}
+	if len(configured) < 2 {
+		return
+	}
+
+	sort.Strings(configured)
+	resp.Diagnostics.AddAttributeError(
+		parent.AtName(configured[1]),
+		"Conflicting Configuration",
+		fmt.Sprintf("Only one of %s may be configured (oneof group %q), but %s were set.",
+			strings.Join(v.quotedFields(), ", "), v.group, strings.Join(quote(configured), " and ")),
+	)
+}
+
+func (v oneOfGroupValidator) quotedFields() []string {
+	fields := append([]string(nil), v.fields...)
+	sort.Strings(fields)
+	return quote(fields)
+}
+
+func quote(values []string) []string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = fmt.Sprintf("%q", value)
+	}
+	return quoted
+}
@@ -0,0 +1,218 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package validators
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func oneOfTestSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"port":           schema.Int64Attribute{Optional: true},
+			"automatic_port": schema.BoolAttribute{Optional: true},
+		},
+		Blocks: map[string]schema.Block{
+			"servers": schema.ListNestedBlock{
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"public_ip":   schema.StringAttribute{Optional: true},
+						"public_name": schema.StringAttribute{Optional: true},
+					},
+				},
+			},
+		},
+	}
+}
+
+func oneOfTestConfig(port, automaticPort interface{}, servers []map[string]interface{}) tfsdk.Config {
+	s := oneOfTestSchema()
+	serverType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{
+		"public_ip":   tftypes.String,
+		"public_name": tftypes.String,
+	}}
+
+	serverValues := make([]tftypes.Value, 0, len(servers))
+	for _, server := range servers {
+		serverValues = append(serverValues, tftypes.NewValue(serverType, map[string]tftypes.Value{
+			"public_ip":   tftypes.NewValue(tftypes.String, server["public_ip"]),
+			"public_name": tftypes.NewValue(tftypes.String, server["public_name"]),
+		}))
+	}
+
+	raw := tftypes.NewValue(s.Type().TerraformType(context.Background()), map[string]tftypes.Value{
+		"port":           tftypes.NewValue(tftypes.Number, port),
+		"automatic_port": tftypes.NewValue(tftypes.Bool, automaticPort),
+		"servers":        tftypes.NewValue(tftypes.List{ElementType: serverType}, serverValues),
+	})
+	return tfsdk.Config{Schema: s, Raw: raw}
+}
+
+func TestOneOfGroup(t *testing.T) {
+	ctx := context.Background()
+	root := OneOfGroup(path.MatchRelative(), "port_choice", "port", "automatic_port")
+	servers := OneOfGroup(path.MatchRoot("servers").AtAnyListIndex(), "choice", "public_ip", "public_name")
+
+	tests := []struct {
+		name      string
+		validator resource.ConfigValidator
+		config    tfsdk.Config
+		wantPath  path.Path
+	}{
+		{
+			name:      "root none set",
+			validator: root,
+			config:    oneOfTestConfig(nil, nil, nil),
+		},
+		{
+			name:      "root one set",
+			validator: root,
+			config:    oneOfTestConfig(443, nil, nil),
+		},
+		{
+			name:      "root conflict",
+			validator: root,
+			config:    oneOfTestConfig(443, true, nil),
+			wantPath:  path.Root("port"),
+		},
+		{
+			name:      "root unknown",
+			validator: root,
+			config:    oneOfTestConfig(443, tftypes.UnknownValue, nil),
+		},
+		{
+			name:      "nested no blocks",
+			validator: servers,
+			config:    oneOfTestConfig(nil, nil, nil),
+		},
+		{
+			name:      "nested one set",
+			validator: servers,
+			config: oneOfTestConfig(nil, nil, []map[string]interface{}{
+				{"public_ip": "192.0.2.1"},
+				{"public_name": "example.com"},
+			}),
+		},
+		{
+			name:      "nested conflict",
+			validator: servers,
+			config: oneOfTestConfig(nil, nil, []map[string]interface{}{
+				{"public_ip": "192.0.2.1"},
+				{"public_ip": "192.0.2.2", "public_name": "example.com"},
+			}),
+			wantPath: path.Root("servers").AtListIndex(1).AtName("public_name"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp resource.ValidateConfigResponse
+			tt.validator.ValidateResource(ctx, resource.ValidateConfigRequest{Config: tt.config}, &resp)
+
+			if len(tt.wantPath.Steps()) == 0 {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("expected one error, got: %v", resp.Diagnostics)
+			}
+			withPath, ok := resp.Diagnostics.Errors()[0].(interface{ Path() path.Path })
+			if !ok || !withPath.Path().Equal(tt.wantPath) {
+				t.Errorf("expected error at %s, got: %v", tt.wantPath, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestOneOfGroupInvalidPath(t *testing.T) {
+	v := OneOfGroup(path.MatchRoot("missing").AtAnyListIndex(), "choice", "a", "b")
+	var resp resource.ValidateConfigResponse
+	v.ValidateResource(context.Background(), resource.ValidateConfigRequest{Config: oneOfTestConfig(nil, nil, nil)}, &resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for a path expression that does not match the schema")
+	}
+}
+
+func TestRequiredOneOfGroup(t *testing.T) {
+	ctx := context.Background()
+	root := RequiredOneOfGroup(path.MatchRelative(), "port_choice", "port", "automatic_port")
+	servers := RequiredOneOfGroup(path.MatchRoot("servers").AtAnyListIndex(), "choice", "public_ip", "public_name")
+
+	tests := []struct {
+		name      string
+		validator resource.ConfigValidator
+		config    tfsdk.Config
+		wantError bool
+		wantPath  path.Path
+	}{
+		{
+			name:      "root none set",
+			validator: root,
+			config:    oneOfTestConfig(nil, nil, nil),
+			wantError: true,
+			wantPath:  path.Empty(),
+		},
+		{
+			name:      "root one set",
+			validator: root,
+			config:    oneOfTestConfig(nil, true, nil),
+		},
+		{
+			name:      "root conflict",
+			validator: root,
+			config:    oneOfTestConfig(443, true, nil),
+			wantError: true,
+			wantPath:  path.Root("port"),
+		},
+		{
+			name:      "root unknown",
+			validator: root,
+			config:    oneOfTestConfig(tftypes.UnknownValue, nil, nil),
+		},
+		{
+			name:      "nested no blocks",
+			validator: servers,
+			config:    oneOfTestConfig(nil, nil, nil),
+		},
+		{
+			name:      "nested none set",
+			validator: servers,
+			config: oneOfTestConfig(nil, nil, []map[string]interface{}{
+				{"public_ip": "192.0.2.1"},
+				{},
+			}),
+			wantError: true,
+			wantPath:  path.Root("servers").AtListIndex(1),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var resp resource.ValidateConfigResponse
+			tt.validator.ValidateResource(ctx, resource.ValidateConfigRequest{Config: tt.config}, &resp)
+
+			if !tt.wantError {
+				if resp.Diagnostics.HasError() {
+					t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if resp.Diagnostics.ErrorsCount() != 1 {
+				t.Fatalf("expected one error, got: %v", resp.Diagnostics)
+			}
+			withPath, ok := resp.Diagnostics.Errors()[0].(interface{ Path() path.Path })
+			if !ok || !withPath.Path().Equal(tt.wantPath) {
+				t.Errorf("expected error at %s, got: %v", tt.wantPath, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -233,6 +233,35 @@ func (v nonEmptyStringValidator) ValidateString(ctx context.Context, req validat
 	}
 }
 
+// RegexValidator returns a validator that ensures a string compiles as a Go regular expression
+func RegexValidator() validator.String {
+	return &regexValidator{}
+}
+
+type regexValidator struct{}
+
+func (v regexValidator) Description(ctx context.Context) string {
+	return "must be a valid regular expression"
+}
+
+func (v regexValidator) MarkdownDescription(ctx context.Context) string {
+	return "must be a valid [RE2](https://github.com/google/re2/wiki/Syntax) regular expression"
+}
+
+func (v regexValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	if _, err := regexp.Compile(req.ConfigValue.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Regular Expression",
+			fmt.Sprintf("Value must be a valid regular expression: %s", err),
+		)
+	}
+}
+
 // Common validator combinations for convenience
 
 // RequiredNameValidators returns validators for required name fields
@@ -264,6 +264,39 @@ func TestNonEmptyStringValidator(t *testing.T) {
 	}
 }
 
+func TestRegexValidator(t *testing.T) {
+	tests := []struct {
+		name        string
+		input       string
+		expectError bool
+	}{
+		{"valid prefix", "^prod-", false},
+		{"valid alternation", "^(web|api)-[0-9]+$", false},
+		{"invalid unclosed group", "^(web", true},
+		{"invalid repetition", "*web", true},
+	}
+
+	ctx := context.Background()
+	v := RegexValidator()
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.StringRequest{
+				Path:        path.Root("value"),
+				ConfigValue: types.StringValue(tt.input),
+			}
+
+			resp := &validator.StringResponse{}
+			v.ValidateString(ctx, req, resp)
+
+			hasError := resp.Diagnostics.HasError()
+			if hasError != tt.expectError {
+				t.Errorf("RegexValidator for %q: hasError = %v, expected %v", tt.input, hasError, tt.expectError)
+			}
+		})
+	}
+}
+
 func TestLabelKeyValidator(t *testing.T) {
 	tests := []struct {
 		name        string
@@ -34,6 +34,20 @@ MANUALLY_MAINTAINED_FILES=(
     "internal/provider/addon_service_activation_status_data_source.go"
     "examples/data-sources/addon_service/data-source.tf"
     "examples/data-sources/addon_service_activation_status/data-source.tf"
+    # Hand-written resources and ephemeral resources not generated from OpenAPI specs
+    "internal/provider/api_credential_resource.go"
+    "internal/provider/api_credential_ephemeral_resource.go"
+    "internal/provider/blindfold_ephemeral_resource.go"
+    "internal/provider/blindfolded_secret_resource.go"
+    "internal/provider/infraprotect_internet_prefix_advertisement_activation_resource.go"
+    "internal/provider/kubeconfig_ephemeral_resource.go"
+    "internal/provider/service_credential_resource.go"
+    "internal/provider/site_deployment_resource.go"
+    "examples/resources/f5xc_api_credential/resource.tf"
+    "examples/resources/f5xc_blindfolded_secret/resource.tf"
+    "examples/resources/f5xc_infraprotect_internet_prefix_advertisement_activation/resource.tf"
+    "examples/resources/f5xc_service_credential/resource.tf"
+    "examples/resources/f5xc_site_deployment/resource.tf"
     # MkDocs documentation site index files (navigation, not provider docs)
     "docs/resources/index.md"
     "docs/data-sources/index.md"
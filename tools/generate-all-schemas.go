@@ -36,6 +36,8 @@ import (
 	"github.com/f5xc/terraform-provider-f5xc/tools/pkg/namespace"
 	"github.com/f5xc/terraform-provider-f5xc/tools/pkg/naming"
 	"github.com/f5xc/terraform-provider-f5xc/tools/pkg/openapi"
+	resourcemeta "github.com/f5xc/terraform-provider-f5xc/tools/pkg/resource"
+	"github.com/f5xc/terraform-provider-f5xc/tools/pkg/rules"
 )
 
 // Configuration
@@ -110,10 +112,13 @@ type TerraformAttribute struct {
 	Optional           bool
 	Computed           bool
 	Sensitive          bool
+	WriteOnly          bool // Secret value that is sent to the API but never stored in state
+	WriteOnlyVersion   bool // Companion of a write-only attribute that triggers its update; not sent to the API
 	NestedAttributes   []TerraformAttribute
 	NestedBlockType    string
 	IsBlock            bool
 	OneOfGroup         string
+	NestedOneOfGroups  map[string][]string // x-ves-oneof-field groups of the nested block's own schema
 	PlanModifier       string
 	MaxDepth           int    // Track recursion depth to prevent infinite loops
 	IsSpecField        bool   // True if this is a spec field (not metadata)
@@ -124,8 +129,10 @@ type TerraformAttribute struct {
 	Default            interface{} // Actual default value from OpenAPI spec (when ServerDefault is true)
 	// Enhanced metadata fields from enriched API specs
 	MinimumConfigRequired bool                   // True if required for minimum viable config (from x-f5xc-required-for.minimum_config)
+	RequiredForCreate     bool                   // True if a value is required on create (from x-f5xc-required-for.create)
 	RecommendedValue      interface{}            // Suggested value, not enforced (from x-f5xc-recommended-value)
 	ValidationRules       map[string]string      // Validation constraints (from x-ves-validation-rules)
+	Validators            []string               // Go validator expressions translated from ValidationRules
 	Complexity            string                 // Complexity rating (from x-f5xc-complexity)
 	UseCases              []string               // Use case scenarios (from x-f5xc-use-cases)
 }
@@ -133,10 +140,21 @@ type TerraformAttribute struct {
 type ResourceTemplate struct {
 	Name                   string
 	TitleCase              string
+	PluralName             string // Plural resource name for list data sources (e.g. origin_pools)
+	PluralTitleCase        string // Plural Go type name for list methods (e.g. OriginPools)
 	APIPath                string
 	APIPathPlural          string
 	APIPathItem            string // Path for single item operations (get/update/delete)
 	HasNamespaceInPath     bool   // Whether API path contains namespace segment
+	RequiredNamespace      string // Namespace the type can only be created in, e.g. system; empty for any namespace
+	DeleteViaPost          bool   // Whether delete is a POST to the item path + /delete
+	IsLongRunning          bool   // Whether create, update and delete use the long-running timeouts
+	ExtraAttributes        []resourcemeta.ExtraAttribute // Computed attributes set by a hand-written setExtraAttributes
+	WaitsForReady          bool   // Whether create waits for a hand-written waitForReady
+	WaitsForSiteState      bool   // Whether the resource has the wait_for_state attribute of sites
+	ValidatesPlan          bool   // Whether the plan of a new resource is checked by a hand-written validatePlan
+	SkippedFields          []string // Spec fields managed by another resource, kept as they are on update
+	HasWriteOnly           bool   // Whether any attribute is write-only and must be read from the configuration
 	Description            string
 	Attributes             []TerraformAttribute
 	OneOfGroups            map[string][]string
@@ -148,8 +166,10 @@ type ResourceTemplate struct {
 	APIDocsURL             string // Link to F5 XC API documentation
 	UsesBoolPlanModifier   bool   // True if any bool attribute uses a plan modifier
 	UsesInt64PlanModifier  bool   // True if any int64 attribute uses a plan modifier
+	ValidatorPackages      map[string]bool // Framework validator packages referenced by attribute validators
 	UsesStringPlanModifier bool   // True if any string attribute uses a plan modifier
 	HasBlocks              bool   // True if the resource has any nested blocks
+	UsesPath               bool   // True if the config validators reference attribute paths
 }
 
 type GenerationResult struct {
@@ -307,6 +327,13 @@ func main() {
 		generateProviderRegistration(results)
 	}
 
+	// Generate addon service tiers for the addon subscription resource
+	if !dryRun {
+		if err := generateAddonServiceTiers(); err != nil {
+			fmt.Printf("⚠️  Warning: Failed to generate addon service tiers: %v\n", err)
+		}
+	}
+
 	// Write metadata files for MCP server
 	if !dryRun {
 		if err := writeMetadataFiles(); err != nil {
@@ -601,6 +628,10 @@ func generateResourceFromSchema(resourceName string, schemaName string, schema *
 			return GenerationResult{ResourceName: resourceName, Success: false, Error: err.Error()}
 		}
 
+		if err := generateListDataSource(resource); err != nil {
+			return GenerationResult{ResourceName: resourceName, Success: false, Error: err.Error()}
+		}
+
 		// Collect metadata for MCP server
 		collectResourceMetadata(resource, category, requiresTier)
 
@@ -653,11 +684,41 @@ func parseOpenAPISpec(specFile string) (*OpenAPI3Spec, error) {
 // extractAPIPath extracts the correct API path for CRUD operations from the OpenAPI spec
 // It looks for paths containing POST (create) and returns the base path pattern
 // Returns: basePath (for create/list), itemPath (for get/update/delete), hasNamespace (whether path has {namespace} segment)
+// sortedCRUDPathCandidates returns the spec paths ordered by preference for
+// extractAPIPath: paths whose only placeholder is the namespace come first,
+// then shorter paths, then lexical order.
+func sortedCRUDPathCandidates(spec *OpenAPI3Spec) []string {
+	extraPlaceholders := func(path string) int {
+		path = strings.ReplaceAll(path, "{metadata.namespace}", "")
+		path = strings.ReplaceAll(path, "{namespace}", "")
+		return strings.Count(path, "{")
+	}
+
+	paths := make([]string, 0, len(spec.Paths))
+	for path := range spec.Paths {
+		paths = append(paths, path)
+	}
+	sort.Slice(paths, func(i, j int) bool {
+		ei, ej := extraPlaceholders(paths[i]), extraPlaceholders(paths[j])
+		if ei != ej {
+			return ei < ej
+		}
+		if len(paths[i]) != len(paths[j]) {
+			return len(paths[i]) < len(paths[j])
+		}
+		return paths[i] < paths[j]
+	})
+	return paths
+}
+
 func extractAPIPath(spec *OpenAPI3Spec, resourceName string) (basePath string, itemPath string, hasNamespace bool) {
 	resourcePlural := resourceName + "s"
 
-	// Look for CRUD paths in the spec
-	for path, pathObj := range spec.Paths {
+	// Look for CRUD paths in the spec. Candidates are visited in a stable
+	// order: paths without extra placeholders (e.g. {site}) first, then the
+	// shortest path, so the same path is chosen on every run.
+	for _, path := range sortedCRUDPathCandidates(spec) {
+		pathObj := spec.Paths[path]
 		pathMap, ok := pathObj.(map[string]interface{})
 		if !ok {
 			continue
@@ -698,21 +759,78 @@ func extractAPIPath(spec *OpenAPI3Spec, resourceName string) (basePath string, i
 		true
 }
 
+// hasPostDelete reports whether the spec deletes an item with a POST to
+// {item}/delete instead of a DELETE on the item path itself.
+func hasPostDelete(spec *OpenAPI3Spec, resourceName string) bool {
+	itemSuffixes := []string{"/" + resourceName + "s/{name}", "/" + resourceName + "s/{metadata.name}"}
+	postDelete := false
+	for path, pathObj := range spec.Paths {
+		pathMap, ok := pathObj.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		for _, suffix := range itemSuffixes {
+			if strings.HasSuffix(path, suffix) {
+				if _, ok := pathMap["delete"]; ok {
+					return false
+				}
+			}
+			if strings.HasSuffix(path, suffix+"/delete") {
+				if _, ok := pathMap["post"]; ok {
+					postDelete = true
+				}
+			}
+		}
+	}
+	return postDelete
+}
+
+// findCreateSpecKey returns the CreateSpecType schema key for a resource.
+// Schema keys carry optional package prefixes ("schema", "views") and other
+// resources may share a name fragment (policer vs protocol_policer), so an
+// exact match after stripping the prefix wins. Otherwise the first matching
+// key in sorted order is used so that generation is deterministic.
+func findCreateSpecKey(spec *OpenAPI3Spec, resourceName string) (string, bool) {
+	nameLower := strings.ToLower(resourceName)
+	exactSuffix := nameLower + "createspectype"
+
+	keys := make([]string, 0, len(spec.Components.Schemas))
+	for key := range spec.Components.Schemas {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		keyLower := strings.ToLower(key)
+		if !strings.HasSuffix(keyLower, exactSuffix) {
+			continue
+		}
+		prefix := strings.TrimSuffix(keyLower, exactSuffix)
+		prefix = strings.TrimPrefix(prefix, "schema")
+		prefix = strings.TrimPrefix(prefix, "views")
+		if prefix == "" {
+			return key, true
+		}
+	}
+
+	for _, key := range keys {
+		keyLower := strings.ToLower(key)
+		if strings.Contains(keyLower, nameLower) && strings.Contains(keyLower, "createspectype") {
+			return key, true
+		}
+	}
+	return "", false
+}
+
 func extractResourceSchema(spec *OpenAPI3Spec, resourceName string) (*ResourceTemplate, error) {
 	// Find CreateSpecType schema
 	var createSpec SchemaDefinition
 	var found bool
 	var createSpecKey string
 
-	for key, schema := range spec.Components.Schemas {
-		keyLower := strings.ToLower(key)
-		if strings.Contains(keyLower, strings.ToLower(resourceName)) &&
-			strings.Contains(keyLower, "createspectype") {
-			createSpec = schema
-			createSpecKey = key
-			found = true
-			break
-		}
+	createSpecKey, found = findCreateSpecKey(spec, resourceName)
+	if found {
+		createSpec = spec.Components.Schemas[createSpecKey]
 	}
 
 	if !found {
@@ -721,6 +839,19 @@ func extractResourceSchema(spec *OpenAPI3Spec, resourceName string) (*ResourceTe
 
 	// Extract OneOf groups from x-ves-oneof-field annotations
 	oneOfGroups := extractOneOfGroups(spec, createSpecKey)
+	for groupName, fields := range oneOfGroups {
+		kept := fields[:0]
+		for _, field := range fields {
+			if !resourcemeta.IsSkippedField(resourceName, field) {
+				kept = append(kept, field)
+			}
+		}
+		if len(kept) == 0 {
+			delete(oneOfGroups, groupName)
+		} else {
+			oneOfGroups[groupName] = kept
+		}
+	}
 
 	// Create reverse mapping: field -> group name + all fields in group
 	// Also track which field should get the constraint (first alphabetically)
@@ -751,6 +882,9 @@ func extractResourceSchema(spec *OpenAPI3Spec, resourceName string) (*ResourceTe
 	}
 
 	for propName, propSchema := range createSpec.Properties {
+		if resourcemeta.IsSkippedField(resourceName, propName) {
+			continue
+		}
 		oneOfFields := fieldToOneOf[propName]
 		groupName := fieldToGroupName[propName]
 		attr := convertToTerraformAttribute(propName, propSchema, requiredSet[propName], "", spec)
@@ -800,12 +934,17 @@ func extractResourceSchema(spec *OpenAPI3Spec, resourceName string) (*ResourceTe
 			Required:    true, PlanModifier: "RequiresReplace", UseDomainValidator: useDomainValidator},
 	}
 
-	// For resources without namespace in API path (like namespace itself), namespace is optional
+	// For resources without namespace in API path (like namespace itself), namespace is optional.
+	// Otherwise a namespace left out is selected at plan time, see planNamespace.
 	if hasNamespace {
+		namespaceDescription := fmt.Sprintf("Namespace where the %s will be created. Defaults to the `default_namespace` of the provider.", toHumanName(resourceName))
+		if ns := requiredNamespace(resourceName, hasNamespace); ns != "" {
+			namespaceDescription = fmt.Sprintf("Namespace where the %s will be created. Must be `%s`, which is also the default.", toHumanName(resourceName), ns)
+		}
 		idComponentAttrs = append(idComponentAttrs, TerraformAttribute{
 			Name: "namespace", GoName: "Namespace", TfsdkTag: "namespace", Type: "string",
-			Description: fmt.Sprintf("Namespace where the %s will be created.", toHumanName(resourceName)),
-			Required:    true, PlanModifier: "RequiresReplace"})
+			Description: namespaceDescription,
+			Optional:    true, Computed: true, PlanModifier: "UseStateForUnknownRequiresReplace"})
 	} else {
 		idComponentAttrs = append(idComponentAttrs, TerraformAttribute{
 			Name: "namespace", GoName: "Namespace", TfsdkTag: "namespace", Type: "string",
@@ -901,13 +1040,24 @@ func extractResourceSchema(spec *OpenAPI3Spec, resourceName string) (*ResourceTe
 	// AttrTypes (which use attr.Type) are generated for any block with nested attributes
 	hasBlocks := hasNestedModelsWithAttrTypes(attributes)
 
-	return &ResourceTemplate{
+	tmpl := &ResourceTemplate{
 		Name:                   resourceName,
 		TitleCase:              toTitleCase(resourceName),
+		PluralName:             naming.ToPluralName(resourceName),
+		PluralTitleCase:        naming.ToPluralName(toTitleCase(resourceName)),
 		APIPath:                apiPath,
 		APIPathPlural:          resourceName + "s",
 		APIPathItem:            apiPathItem,
 		HasNamespaceInPath:     hasNamespace,
+		RequiredNamespace:      requiredNamespace(resourceName, hasNamespace),
+		DeleteViaPost:          hasPostDelete(spec, resourceName),
+		IsLongRunning:          resourcemeta.IsLongRunning(resourceName),
+		ExtraAttributes:        resourcemeta.GetExtraAttributes(resourceName),
+		WaitsForReady:          resourcemeta.WaitsForReady(resourceName),
+		WaitsForSiteState:      resourcemeta.WaitsForSiteState(resourceName),
+		ValidatesPlan:          resourcemeta.ValidatesPlan(resourceName),
+		SkippedFields:          resourcemeta.SkippedFields[resourceName],
+		HasWriteOnly:           hasWriteOnlyAttributes(attributes),
 		Description:            description,
 		Attributes:             attributes,
 		OneOfGroups:            oneOfGroups, // Now properly preserving extracted OneOf groups
@@ -915,9 +1065,22 @@ func extractResourceSchema(spec *OpenAPI3Spec, resourceName string) (*ResourceTe
 		APIDocsURL:             apiDocsURL,
 		UsesBoolPlanModifier:   usesBool,
 		UsesInt64PlanModifier:  usesInt64,
+		ValidatorPackages:      rules.Packages(collectValidators(attributes)),
 		UsesStringPlanModifier: usesString,
 		HasBlocks:              hasBlocks,
-	}, nil
+	}
+	tmpl.UsesPath = strings.TrimSpace(renderConfigValidators(tmpl, "")) != "return nil"
+	return tmpl, nil
+}
+
+// requiredNamespace returns the namespace that objects of the resource type
+// can only be created in, e.g. system for sites, or an empty string when
+// they can be created in any namespace
+func requiredNamespace(resourceName string, hasNamespace bool) string {
+	if hasNamespace && namespace.IsSystem(resourceName) {
+		return "system"
+	}
+	return ""
 }
 
 // hasNestedModelsWithAttrTypes checks recursively if any nested blocks would generate AttrTypes
@@ -961,6 +1124,16 @@ func scanPlanModifierUsage(attributes []TerraformAttribute) (usesBool, usesInt64
 	return
 }
 
+// collectValidators returns the validator expressions of all attributes, recursively
+func collectValidators(attributes []TerraformAttribute) []string {
+	var exprs []string
+	for _, attr := range attributes {
+		exprs = append(exprs, attr.Validators...)
+		exprs = append(exprs, collectValidators(attr.NestedAttributes)...)
+	}
+	return exprs
+}
+
 // generateExampleUsage creates a sample HCL configuration for the resource
 func generateExampleUsage(resourceName string, attributes []TerraformAttribute) string {
 	// Get the correct namespace for this resource (fixes bug: was hardcoded to "system")
@@ -1043,6 +1216,7 @@ func convertToTerraformAttributeWithDepth(name string, schema SchemaDefinition,
 	validationRules := schema.XVesValidationRules
 	complexity := schema.XF5XCComplexity
 	useCases := schema.XF5XCUseCases
+	schemaKey := refSchemaKey(schema.Ref)
 
 	if schema.Ref != "" {
 		schema = resolveRef(schema.Ref, spec)
@@ -1102,6 +1276,7 @@ func convertToTerraformAttributeWithDepth(name string, schema SchemaDefinition,
 		Default:       schema.Default, // Store actual default value for metadata
 		// Enhanced metadata from enriched API specs
 		MinimumConfigRequired: schema.XF5XCRequiredFor.MinimumConfig,
+		RequiredForCreate:     schema.XF5XCRequiredFor.Create,
 		RecommendedValue:      schema.XF5XCRecommendedValue,
 		ValidationRules:       schema.XVesValidationRules,
 		Complexity:            schema.XF5XCComplexity,
@@ -1171,6 +1346,7 @@ func convertToTerraformAttributeWithDepth(name string, schema SchemaDefinition,
 		if schema.Items != nil {
 			itemSchema := *schema.Items
 			if itemSchema.Ref != "" {
+				schemaKey = refSchemaKey(itemSchema.Ref)
 				itemSchema = resolveRef(itemSchema.Ref, spec)
 			}
 			if itemSchema.Type == "object" || len(itemSchema.Properties) > 0 {
@@ -1245,9 +1421,34 @@ func convertToTerraformAttributeWithDepth(name string, schema SchemaDefinition,
 		}
 	}
 
+	// Translate ves.io.schema.rules into plan-time validators
+	if attr.IsBlock {
+		if attr.NestedBlockType == "list" {
+			attr.Validators = rules.ListBlock(schema.XVesValidationRules)
+		}
+	} else {
+		attr.Validators = rules.Attribute(attr.Type, attr.ElementType, schema.XVesValidationRules)
+	}
+
+	// Nested blocks carry their own oneof groups, enforced by generated config validators
+	if len(attr.NestedAttributes) > 0 && schemaKey != "" {
+		if groups := extractOneOfGroups(spec, schemaKey); len(groups) > 0 {
+			attr.NestedOneOfGroups = groups
+		}
+	}
+
 	return attr
 }
 
+// refSchemaKey returns the component schema name referenced by ref
+func refSchemaKey(ref string) string {
+	if ref == "" {
+		return ""
+	}
+	parts := strings.Split(ref, "/")
+	return parts[len(parts)-1]
+}
+
 // extractNestedAttributes extracts attributes from an object schema's properties
 func extractNestedAttributes(schema SchemaDefinition, spec *OpenAPI3Spec, depth int, parentPath string) []TerraformAttribute {
 	if depth > maxNestedDepth {
@@ -1282,6 +1483,12 @@ func extractNestedAttributes(schema SchemaDefinition, spec *OpenAPI3Spec, depth
 		attrs = append(attrs, attr)
 	}
 
+	blockName := parentPath
+	if i := strings.LastIndex(parentPath, "."); i >= 0 {
+		blockName = parentPath[i+1:]
+	}
+	attrs = markWriteOnlyAttributes(blockName, attrs)
+
 	// Sort attributes: required first, then alphabetically
 	sort.Slice(attrs, func(i, j int) bool {
 		if attrs[i].Required != attrs[j].Required {
@@ -1293,6 +1500,61 @@ func extractNestedAttributes(schema SchemaDefinition, spec *OpenAPI3Spec, depth
 	return attrs
 }
 
+// markWriteOnlyAttributes marks the secret string attributes of a block as
+// write-only and adds an optional <name>_version companion for each of them.
+// Terraform does not store write-only values, so the companion is what the
+// user changes to send an updated secret.
+func markWriteOnlyAttributes(blockName string, attrs []TerraformAttribute) []TerraformAttribute {
+	var versions []TerraformAttribute
+	for i := range attrs {
+		attr := &attrs[i]
+		if attr.IsBlock || attr.Type != "string" || !resourcemeta.IsWriteOnly(blockName, attr.Name) {
+			continue
+		}
+		attr.WriteOnly = true
+		attr.Sensitive = true
+		attr.Description = strings.TrimSpace(attr.Description + fmt.Sprintf(" Write-only, requires Terraform 1.11 or later: the value is never stored in state. Change `%s` to update it.", resourcemeta.WriteOnlyVersionName(attr.TfsdkTag)))
+
+		versionName := resourcemeta.WriteOnlyVersionName(attr.TfsdkTag)
+		versions = append(versions, TerraformAttribute{
+			Name:             versionName,
+			GoName:           attr.GoName + "Version",
+			TfsdkTag:         versionName,
+			Type:             "int64",
+			Description:      fmt.Sprintf("Version of `%s`. Change it to send a new value of the write-only `%s` to F5 Distributed Cloud.", attr.TfsdkTag, attr.TfsdkTag),
+			Optional:         true,
+			MaxDepth:         attr.MaxDepth,
+			IsSpecField:      true,
+			WriteOnlyVersion: true,
+		})
+	}
+	return append(attrs, versions...)
+}
+
+// hasWriteOnlyAttributes returns true if any attribute, at any depth, is write-only.
+func hasWriteOnlyAttributes(attrs []TerraformAttribute) bool {
+	for _, attr := range attrs {
+		if attr.WriteOnly || hasWriteOnlyAttributes(attr.NestedAttributes) {
+			return true
+		}
+	}
+	return false
+}
+
+// withoutAttributes returns a deep copy of attrs without the attributes for
+// which skip returns true.
+func withoutAttributes(attrs []TerraformAttribute, skip func(TerraformAttribute) bool) []TerraformAttribute {
+	var result []TerraformAttribute
+	for _, attr := range attrs {
+		if skip(attr) {
+			continue
+		}
+		attr.NestedAttributes = withoutAttributes(attr.NestedAttributes, skip)
+		result = append(result, attr)
+	}
+	return result
+}
+
 func resolveRef(ref string, spec *OpenAPI3Spec) SchemaDefinition {
 	parts := strings.Split(ref, "/")
 	schemaName := parts[len(parts)-1]
@@ -2844,6 +3106,8 @@ func renderSpecMarshalCode(attrs []TerraformAttribute, indent string, resourceTi
 
 // renderSpecMarshalCodeWithVar generates Go code to marshal spec fields with configurable variable name
 func renderSpecMarshalCodeWithVar(attrs []TerraformAttribute, indent string, varName string, resourceTitleCase string) string {
+	// Companions of write-only attributes only exist in Terraform
+	attrs = withoutAttributes(attrs, func(attr TerraformAttribute) bool { return attr.WriteOnlyVersion })
 	specFields := filterSpecFields(attrs)
 	if len(specFields) == 0 {
 		return ""
@@ -2934,6 +3198,8 @@ func renderSpecMarshalCodeWithVar(attrs []TerraformAttribute, indent string, var
 												sb.WriteString(fmt.Sprintf("%s\t\t\t\tif item.%s.%s.%s != nil {\n", indent, nestedFieldName, deepFieldName, leafFieldName))
 												sb.WriteString(fmt.Sprintf("%s\t\t\t\t\t%sDeepMap[\"%s\"] = map[string]interface{}{}\n", indent, deepTfsdkName, leafJsonName))
 												sb.WriteString(fmt.Sprintf("%s\t\t\t\t}\n", indent))
+											} else if leafAttr.IsBlock && leafAttr.NestedBlockType == "single" {
+												renderSingleBlockMarshal(&sb, leafAttr, fmt.Sprintf("item.%s.%s.%s", nestedFieldName, deepFieldName, leafFieldName), deepTfsdkName+"DeepMap", indent+"\t\t\t\t", 4)
 											} else {
 												switch leafAttr.Type {
 												case "string":
@@ -3133,6 +3399,11 @@ func renderSpecMarshalCodeWithVar(attrs []TerraformAttribute, indent string, var
 							if deepJsonName == "" {
 								deepJsonName = deepAttr.TfsdkTag
 							}
+							// Handle deeper single nested blocks, e.g. clear_secret_info{} within secret_key{}
+							if deepAttr.IsBlock && deepAttr.NestedBlockType == "single" {
+								renderSingleBlockMarshal(&sb, deepAttr, fmt.Sprintf("data.%s.%s.%s", fieldName, nestedFieldName, deepFieldName), nestedTfsdkName+"NestedMap", indent+"\t\t", 3)
+								continue
+							}
 							switch deepAttr.Type {
 							case "string":
 								sb.WriteString(fmt.Sprintf("%s\t\tif !data.%s.%s.%s.IsNull() && !data.%s.%s.%s.IsUnknown() {\n", indent, fieldName, nestedFieldName, deepFieldName, fieldName, nestedFieldName, deepFieldName))
@@ -3191,6 +3462,8 @@ func renderSpecMarshalCodeWithVar(attrs []TerraformAttribute, indent string, var
 										sb.WriteString(fmt.Sprintf("%s\t\t\t\tif listItem.%s.%s != nil {\n", indent, deepFieldName, leafFieldName))
 										sb.WriteString(fmt.Sprintf("%s\t\t\t\t\t%sDeepMap[\"%s\"] = map[string]interface{}{}\n", indent, deepTfsdkName, leafJsonName))
 										sb.WriteString(fmt.Sprintf("%s\t\t\t\t}\n", indent))
+									} else if leafAttr.IsBlock && leafAttr.NestedBlockType == "single" {
+										renderSingleBlockMarshal(&sb, leafAttr, fmt.Sprintf("listItem.%s.%s", deepFieldName, leafFieldName), deepTfsdkName+"DeepMap", indent+"\t\t\t\t", 4)
 									} else {
 										// Handle primitive types at leaf level
 										switch leafAttr.Type {
@@ -3314,6 +3587,69 @@ func renderSpecMarshalCodeWithVar(attrs []TerraformAttribute, indent string, var
 	return sb.String()
 }
 
+// renderSingleBlockMarshal generates code that marshals the single nested
+// block at accessor into parentMap, recursing into the single nested blocks it
+// contains. Map variables are named after the nesting level, so a block never
+// shadows the map of a block with the same name further up.
+func renderSingleBlockMarshal(sb *strings.Builder, attr TerraformAttribute, accessor, parentMap, indent string, level int) {
+	jsonName := attr.JsonName
+	if jsonName == "" {
+		jsonName = attr.TfsdkTag
+	}
+
+	sb.WriteString(fmt.Sprintf("%sif %s != nil {\n", indent, accessor))
+	if len(attr.NestedAttributes) == 0 {
+		sb.WriteString(fmt.Sprintf("%s\t%s[\"%s\"] = map[string]interface{}{}\n", indent, parentMap, jsonName))
+		sb.WriteString(fmt.Sprintf("%s}\n", indent))
+		return
+	}
+
+	mapName := fmt.Sprintf("%sLevel%dMap", attr.TfsdkTag, level)
+	sb.WriteString(fmt.Sprintf("%s\t%s := make(map[string]interface{})\n", indent, mapName))
+	for _, nestedAttr := range attr.NestedAttributes {
+		nestedAccessor := accessor + "." + nestedAttr.GoName
+		nestedJsonName := nestedAttr.JsonName
+		if nestedJsonName == "" {
+			nestedJsonName = nestedAttr.TfsdkTag
+		}
+
+		if nestedAttr.IsBlock {
+			if nestedAttr.NestedBlockType == "single" {
+				renderSingleBlockMarshal(sb, nestedAttr, nestedAccessor, mapName, indent+"\t", level+1)
+			}
+			continue
+		}
+
+		switch nestedAttr.Type {
+		case "string":
+			sb.WriteString(fmt.Sprintf("%s\tif !%s.IsNull() && !%s.IsUnknown() {\n", indent, nestedAccessor, nestedAccessor))
+			sb.WriteString(fmt.Sprintf("%s\t\t%s[\"%s\"] = %s.ValueString()\n", indent, mapName, nestedJsonName, nestedAccessor))
+			sb.WriteString(fmt.Sprintf("%s\t}\n", indent))
+		case "int64":
+			sb.WriteString(fmt.Sprintf("%s\tif !%s.IsNull() && !%s.IsUnknown() {\n", indent, nestedAccessor, nestedAccessor))
+			sb.WriteString(fmt.Sprintf("%s\t\t%s[\"%s\"] = %s.ValueInt64()\n", indent, mapName, nestedJsonName, nestedAccessor))
+			sb.WriteString(fmt.Sprintf("%s\t}\n", indent))
+		case "bool":
+			sb.WriteString(fmt.Sprintf("%s\tif !%s.IsNull() && !%s.IsUnknown() {\n", indent, nestedAccessor, nestedAccessor))
+			sb.WriteString(fmt.Sprintf("%s\t\t%s[\"%s\"] = %s.ValueBool()\n", indent, mapName, nestedJsonName, nestedAccessor))
+			sb.WriteString(fmt.Sprintf("%s\t}\n", indent))
+		case "list":
+			if nestedAttr.ElementType == "string" || nestedAttr.ElementType == "int64" {
+				itemsName := fmt.Sprintf("%sLevel%dItems", nestedAttr.TfsdkTag, level)
+				sb.WriteString(fmt.Sprintf("%s\tif !%s.IsNull() && !%s.IsUnknown() {\n", indent, nestedAccessor, nestedAccessor))
+				sb.WriteString(fmt.Sprintf("%s\t\tvar %s []%s\n", indent, itemsName, nestedAttr.ElementType))
+				sb.WriteString(fmt.Sprintf("%s\t\tdiags := %s.ElementsAs(ctx, &%s, false)\n", indent, nestedAccessor, itemsName))
+				sb.WriteString(fmt.Sprintf("%s\t\tif !diags.HasError() {\n", indent))
+				sb.WriteString(fmt.Sprintf("%s\t\t\t%s[\"%s\"] = %s\n", indent, mapName, nestedJsonName, itemsName))
+				sb.WriteString(fmt.Sprintf("%s\t\t}\n", indent))
+				sb.WriteString(fmt.Sprintf("%s\t}\n", indent))
+			}
+		}
+	}
+	sb.WriteString(fmt.Sprintf("%s\t%s[\"%s\"] = %s\n", indent, parentMap, jsonName, mapName))
+	sb.WriteString(fmt.Sprintf("%s}\n", indent))
+}
+
 // renderComputedFieldsCode generates Go code to set Computed+Optional fields from API response
 // This ensures that fields with UseStateForUnknown plan modifier have known values after Create/Update
 // The varName parameter specifies the API response variable name (e.g., "created" or "updated")
@@ -3392,6 +3728,23 @@ func renderFetchedComputedFieldsCode(attrs []TerraformAttribute, indent string)
 
 // renderSpecUnmarshalCode generates Go code to unmarshal spec fields from API response to Terraform state
 func renderSpecUnmarshalCode(attrs []TerraformAttribute, indent string, resourceTitleCase string) string {
+	// Write-only attributes are never read back from the API. Leaving them out
+	// of the generated struct literals keeps them null.
+	attrs = withoutAttributes(attrs, func(attr TerraformAttribute) bool { return attr.WriteOnly })
+	return renderSpecFieldsUnmarshalCode(attrs, indent, resourceTitleCase)
+}
+
+// renderDataSourceSpecUnmarshalCode generates Go code to unmarshal spec fields
+// from API response to data source state. Data sources read write-only
+// attributes from the API like any other attribute and have no version
+// companions, so those are left out and stay null.
+func renderDataSourceSpecUnmarshalCode(attrs []TerraformAttribute, indent string, resourceTitleCase string) string {
+	attrs = withoutAttributes(attrs, func(attr TerraformAttribute) bool { return attr.WriteOnlyVersion })
+	return renderSpecFieldsUnmarshalCode(attrs, indent, resourceTitleCase)
+}
+
+// renderSpecFieldsUnmarshalCode generates the unmarshal code of the spec fields in attrs
+func renderSpecFieldsUnmarshalCode(attrs []TerraformAttribute, indent string, resourceTitleCase string) string {
 	specFields := filterSpecFields(attrs)
 	if len(specFields) == 0 {
 		return ""
@@ -4196,6 +4549,8 @@ func generateResourceFile(resource *ResourceTemplate) error {
 		"renderUpdateComputedFieldsCode":  renderUpdateComputedFieldsCode,
 		"renderFetchedComputedFieldsCode": renderFetchedComputedFieldsCode,
 		"filterSpecFields":                filterSpecFields,
+		"renderConfigValidators":          renderConfigValidators,
+		"renderValidators":                renderValidators,
 	}
 
 	tmpl, err := template.New("resource").Funcs(funcMap).Parse(resourceTemplate)
@@ -4220,6 +4575,121 @@ func generateResourceFile(resource *ResourceTemplate) error {
 	return os.WriteFile(outputPath, formatted, 0644)
 }
 
+// renderConfigValidators generates the OneOfGroup config validators for the
+// resource's top-level oneof groups and for the oneof groups of every nested block
+func renderConfigValidators(resource *ResourceTemplate, indent string) string {
+	var lines []string
+	lines = append(lines, renderOneOfGroupValidators("path.MatchRelative()", resource.OneOfGroups, resource.Attributes, indent)...)
+	lines = append(lines, renderNestedOneOfGroupValidators("", resource.Attributes, indent)...)
+	if len(lines) == 0 {
+		return indent + "return nil\n"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(indent + "return []resource.ConfigValidator{\n")
+	for _, line := range lines {
+		sb.WriteString(line)
+	}
+	sb.WriteString(indent + "}\n")
+	return sb.String()
+}
+
+// renderNestedOneOfGroupValidators walks nested blocks and renders validators
+// for their oneof groups. parent is the Go path expression of the enclosing block.
+func renderNestedOneOfGroupValidators(parent string, attrs []TerraformAttribute, indent string) []string {
+	var lines []string
+	for _, attr := range attrs {
+		if !attr.IsBlock || len(attr.NestedAttributes) == 0 {
+			continue
+		}
+
+		expr := fmt.Sprintf("path.MatchRoot(%q)", attr.TfsdkTag)
+		if parent != "" {
+			expr = fmt.Sprintf("%s.AtName(%q)", parent, attr.TfsdkTag)
+		}
+		if attr.NestedBlockType == "list" {
+			expr += ".AtAnyListIndex()"
+		}
+
+		lines = append(lines, renderOneOfGroupValidators(expr, attr.NestedOneOfGroups, attr.NestedAttributes, indent)...)
+		lines = append(lines, renderNestedOneOfGroupValidators(expr, attr.NestedAttributes, indent)...)
+	}
+	return lines
+}
+
+// renderOneOfGroupValidators renders one validator per group, mapping OpenAPI
+// field names to Terraform names. Groups with fewer than two fields present in
+// the schema cannot conflict and are skipped. A group is required, so exactly
+// one field must be set, when the spec marks one of its fields as required on
+// create: any choice of the group satisfies that requirement.
+func renderOneOfGroupValidators(parent string, groups map[string][]string, attrs []TerraformAttribute, indent string) []string {
+	tfsdkTags := make(map[string]string, len(attrs))
+	requiredForCreate := make(map[string]bool, len(attrs))
+	for _, attr := range attrs {
+		if attr.IsSpecField {
+			tfsdkTags[attr.Name] = attr.TfsdkTag
+			requiredForCreate[attr.Name] = attr.RequiredForCreate
+		}
+	}
+
+	groupNames := make([]string, 0, len(groups))
+	for groupName := range groups {
+		groupNames = append(groupNames, groupName)
+	}
+	sort.Strings(groupNames)
+
+	var lines []string
+	for _, groupName := range groupNames {
+		var fields []string
+		required := false
+		for _, field := range groups[groupName] {
+			if tag, ok := tfsdkTags[field]; ok {
+				fields = append(fields, fmt.Sprintf("%q", tag))
+				required = required || requiredForCreate[field]
+			}
+		}
+		if len(fields) < 2 {
+			continue
+		}
+		constructor := "OneOfGroup"
+		if required {
+			constructor = "RequiredOneOfGroup"
+		}
+		lines = append(lines, fmt.Sprintf("%s\tvalidators.%s(%s, %q, %s),\n", indent, constructor, parent, groupName, strings.Join(fields, ", ")))
+	}
+	return lines
+}
+
+// renderValidators generates the Validators field of an attribute or list block
+func renderValidators(attr TerraformAttribute, indent string) string {
+	if len(attr.Validators) == 0 {
+		return ""
+	}
+
+	kind := "String"
+	switch attr.Type {
+	case "int64":
+		kind = "Int64"
+	case "bool":
+		kind = "Bool"
+	case "map":
+		kind = "Map"
+	case "list":
+		kind = "List"
+	}
+	if attr.IsBlock {
+		kind = "List"
+	}
+
+	var sb strings.Builder
+	sb.WriteString(fmt.Sprintf("%sValidators: []validator.%s{\n", indent, kind))
+	for _, v := range attr.Validators {
+		sb.WriteString(fmt.Sprintf("%s\t%s,\n", indent, v))
+	}
+	sb.WriteString(indent + "},\n")
+	return sb.String()
+}
+
 // renderNestedAttributes generates the Attributes map for nested blocks
 func renderNestedAttributes(attrs []TerraformAttribute, indent string) string {
 	if len(attrs) == 0 {
@@ -4264,6 +4734,13 @@ func renderNestedAttributes(attrs []TerraformAttribute, indent string) string {
 			}
 		}
 
+		if attr.Sensitive {
+			sb.WriteString(fmt.Sprintf("%s\t\tSensitive: true,\n", indent))
+		}
+		if attr.WriteOnly {
+			sb.WriteString(fmt.Sprintf("%s\t\tWriteOnly: true,\n", indent))
+		}
+
 		// Add PlanModifiers for attributes that need UseStateForUnknown (e.g., tenant, uid, kind in nested blocks)
 		if attr.PlanModifier != "" && attr.Type == "string" {
 			sb.WriteString(fmt.Sprintf("%s\t\tPlanModifiers: []planmodifier.String{\n", indent))
@@ -4283,6 +4760,7 @@ func renderNestedAttributes(attrs []TerraformAttribute, indent string) string {
 			sb.WriteString(fmt.Sprintf("%s\t\tElementType: %s,\n", indent, elementTfType))
 		}
 
+		sb.WriteString(renderValidators(attr, indent+"\t\t"))
 		sb.WriteString(fmt.Sprintf("%s\t},\n", indent))
 	}
 
@@ -4598,6 +5076,7 @@ func renderNestedBlocks(attrs []TerraformAttribute, indent string) string {
 		sb.WriteString(fmt.Sprintf("%s\t\tMarkdownDescription: \"%s\",\n", indent, desc))
 
 		if attr.NestedBlockType == "list" {
+			sb.WriteString(renderValidators(attr, indent+"\t\t"))
 			sb.WriteString(fmt.Sprintf("%s\t\tNestedObject: schema.NestedBlockObject{\n", indent))
 			if len(attr.NestedAttributes) > 0 {
 				sb.WriteString(renderNestedAttributes(attr.NestedAttributes, indent+"\t\t\t"))
@@ -4656,7 +5135,43 @@ func generateClientTypes(resource *ResourceTemplate) error {
 func generateDataSource(resource *ResourceTemplate) error {
 	outputPath := filepath.Join(outputDir, resource.Name+"_data_source.go")
 
-	tmpl, err := template.New("datasource").Parse(dataSourceTemplate)
+	// The data source model reuses the resource's nested model types and
+	// unmarshal code so it exposes the full object spec.
+	funcMap := template.FuncMap{
+		"renderBlockFields":                 renderBlockFields,
+		"renderDataSourceSpecUnmarshalCode": renderDataSourceSpecUnmarshalCode,
+	}
+
+	tmpl, err := template.New("datasource").Funcs(funcMap).Parse(dataSourceTemplate)
+	if err != nil {
+		return fmt.Errorf("template parse error: %w", err)
+	}
+
+	// Execute template to buffer first
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, resource); err != nil {
+		return fmt.Errorf("template execute error: %w", err)
+	}
+
+	// Format the generated code with gofmt
+	formatted, err := format.Source(buf.Bytes())
+	if err != nil {
+		// If formatting fails, write unformatted code with warning
+		fmt.Printf("⚠️  gofmt failed for %s: %v (writing unformatted)\n", outputPath, err)
+		formatted = buf.Bytes()
+	}
+
+	return os.WriteFile(outputPath, formatted, 0644)
+}
+
+func generateListDataSource(resource *ResourceTemplate) error {
+	outputPath := filepath.Join(outputDir, resource.PluralName+"_data_source.go")
+
+	funcMap := template.FuncMap{
+		"humanName": toHumanName,
+	}
+
+	tmpl, err := template.New("listdatasource").Funcs(funcMap).Parse(listDataSourceTemplate)
 	if err != nil {
 		return fmt.Errorf("template parse error: %w", err)
 	}
@@ -4682,6 +5197,51 @@ func generateCombinedClientTypes(results []GenerationResult) {
 	// This is handled by individual client type files
 }
 
+// generateAddonServiceTiers writes the subscription tier of each addon service
+// in tools/subscription-tiers.json to addon_service_tiers.go, so that the
+// addon subscription resource can refuse addons the tenant tier doesn't allow
+func generateAddonServiceTiers() error {
+	content, err := os.ReadFile("tools/subscription-tiers.json")
+	if err != nil {
+		return fmt.Errorf("failed to read subscription tiers: %w", err)
+	}
+	var metadata struct {
+		Services map[string]struct {
+			Tier string `json:"tier"`
+		} `json:"services"`
+	}
+	if err := json.Unmarshal(content, &metadata); err != nil {
+		return fmt.Errorf("failed to parse subscription tiers: %w", err)
+	}
+
+	names := make([]string, 0, len(metadata.Services))
+	for name := range metadata.Services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var sb strings.Builder
+	sb.WriteString(`// Code generated by generate-all-schemas.go. DO NOT EDIT.
+// Source: tools/subscription-tiers.json
+
+package provider
+
+// addonServiceTiers maps each addon service name to the subscription tier
+// required to activate it
+var addonServiceTiers = map[string]string{
+`)
+	for _, name := range names {
+		sb.WriteString(fmt.Sprintf("\t%q: %q,\n", name, metadata.Services[name].Tier))
+	}
+	sb.WriteString("}\n")
+
+	formatted, err := format.Source([]byte(sb.String()))
+	if err != nil {
+		return fmt.Errorf("failed to format addon service tiers: %w", err)
+	}
+	return os.WriteFile(filepath.Join(outputDir, "addon_service_tiers.go"), formatted, 0644)
+}
+
 // coreResources are resources that must always be registered in the provider,
 // even if they're not present in the current OpenAPI specifications.
 // These resources have working implementations that were generated previously
@@ -4689,6 +5249,17 @@ func generateCombinedClientTypes(results []GenerationResult) {
 // Note: namespace was removed in v3.0.0 as part of backwards compatibility cleanup
 var coreResources = []string{}
 
+// manualResources are hand-written resources that have no data source, such as
+// resources that issue credentials or run an action on another object. They are
+// registered in the provider alongside the generated resources.
+var manualResources = []string{
+	"api_credential",
+	"blindfolded_secret",
+	"infraprotect_internet_prefix_advertisement_activation",
+	"service_credential",
+	"site_deployment",
+}
+
 func generateProviderRegistration(results []GenerationResult) {
 	// Collect successful resources
 	var resources []string
@@ -4704,12 +5275,18 @@ func generateProviderRegistration(results []GenerationResult) {
 		added[core] = true
 	}
 
+	// Then add the hand-written resources, which have no data source
+	for _, manual := range manualResources {
+		resources = append(resources, fmt.Sprintf("\t\tNew%sResource,", toTitleCase(manual)))
+	}
+
 	// Then add resources from spec generation results (avoiding duplicates)
 	for _, r := range results {
 		if r.Success && !added[r.ResourceName] {
 			titleCase := toTitleCase(r.ResourceName)
 			resources = append(resources, fmt.Sprintf("\t\tNew%sResource,", titleCase))
 			dataSources = append(dataSources, fmt.Sprintf("\t\tNew%sDataSource,", titleCase))
+			dataSources = append(dataSources, fmt.Sprintf("\t\tNew%sDataSource,", naming.ToPluralName(titleCase)))
 		}
 	}
 
@@ -4730,11 +5307,15 @@ import (
 	"context"
 	"os"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
@@ -4750,6 +5331,10 @@ type F5XCProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// functionTransport is the HTTP transport of the configured client for
+	// the provider functions
+	functionTransport functionTransport
 }
 
 // F5XCProviderModel describes the provider data model.
@@ -4761,6 +5346,18 @@ type F5XCProviderModel struct {
 	APICert      types.String `+"`"+`tfsdk:"api_cert"`+"`"+`
 	APIKey       types.String `+"`"+`tfsdk:"api_key"`+"`"+`
 	APICACert    types.String `+"`"+`tfsdk:"api_ca_cert"`+"`"+`
+	SubscriptionTier types.String `+"`"+`tfsdk:"subscription_tier"`+"`"+`
+	MaxRetries types.Int64 `+"`"+`tfsdk:"max_retries"`+"`"+`
+	RetryWaitMin types.String `+"`"+`tfsdk:"retry_wait_min"`+"`"+`
+	RetryWaitMax types.String `+"`"+`tfsdk:"retry_wait_max"`+"`"+`
+	RateLimitRPS types.Float64 `+"`"+`tfsdk:"rate_limit_rps"`+"`"+`
+	RequestTimeout types.String `+"`"+`tfsdk:"request_timeout"`+"`"+`
+	HTTPProxy types.String `+"`"+`tfsdk:"http_proxy"`+"`"+`
+	InsecureSkipVerify types.Bool `+"`"+`tfsdk:"insecure_skip_verify"`+"`"+`
+	HTTPHeaders types.Map `+"`"+`tfsdk:"http_headers"`+"`"+`
+	DefaultLabels types.Map `+"`"+`tfsdk:"default_labels"`+"`"+`
+	DefaultAnnotations types.Map `+"`"+`tfsdk:"default_annotations"`+"`"+`
+	DefaultNamespace types.String `+"`"+`tfsdk:"default_namespace"`+"`"+`
 }
 
 func (p *F5XCProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -4814,10 +5411,90 @@ func (p *F5XCProvider) Schema(ctx context.Context, req provider.SchemaRequest, r
 				Sensitive: true,
 			},
 			"api_ca_cert": schema.StringAttribute{
-				MarkdownDescription: "Path to PEM-encoded CA certificate file for verifying the F5XC API server. " +
+				MarkdownDescription: "Path to PEM-encoded CA certificate file for verifying the F5XC API server, " +
+					"used instead of the system CAs with every authentication mode. " +
 					"Can also be set via F5XC_CACERT environment variable. Optional.",
 				Optional: true,
 			},
+			"subscription_tier": schema.StringAttribute{
+				MarkdownDescription: "Subscription tier of the tenant, one of BASIC, STANDARD, ADVANCED or PREMIUM. " +
+					"When set, f5xc_addon_subscription refuses to plan addon services that require a higher tier. " +
+					"Can also be set via F5XC_SUBSCRIPTION_TIER environment variable. Optional.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("BASIC", "STANDARD", "ADVANCED", "PREMIUM"),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of times a failed API request is retried. Defaults to 3. " +
+					"Can also be set via F5XC_MAX_RETRIES environment variable.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.StringAttribute{
+				MarkdownDescription: "Minimum time to wait before retrying a failed API request, e.g. `+"`"+`1s`+"`"+`. " +
+					"The wait doubles with each retry up to retry_wait_max, with random jitter. Defaults to 1s. " +
+					"Can also be set via F5XC_RETRY_WAIT_MIN environment variable.",
+				Optional: true,
+			},
+			"retry_wait_max": schema.StringAttribute{
+				MarkdownDescription: "Maximum time to wait before retrying a failed API request, e.g. `+"`"+`30s`+"`"+`. Defaults to 30s. " +
+					"A Retry-After header sent by the API takes precedence, within the timeout of the operation. " +
+					"Can also be set via F5XC_RETRY_WAIT_MAX environment variable.",
+				Optional: true,
+			},
+			"rate_limit_rps": schema.Float64Attribute{
+				MarkdownDescription: "Maximum number of API requests per second, shared by all resources and data sources " +
+					"of the provider. Defaults to 0, which does not limit the request rate. " +
+					"Can also be set via F5XC_RATE_LIMIT_RPS environment variable.",
+				Optional: true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "Timeout of a single API request, e.g. `+"`"+`60s`+"`"+`. Defaults to 30s. " +
+					"Can also be set via F5XC_REQUEST_TIMEOUT environment variable.",
+				Optional: true,
+			},
+			"http_proxy": schema.StringAttribute{
+				MarkdownDescription: "URL of the HTTP proxy for API requests, e.g. `+"`"+`http://proxy.example.com:3128`+"`"+`. " +
+					"Defaults to the proxy from the HTTPS_PROXY and NO_PROXY environment variables. " +
+					"Can also be set via F5XC_HTTP_PROXY environment variable.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verification of the F5XC API server certificate. " +
+					"Only use this for lab tenants with self-signed certificates. Defaults to false. " +
+					"Can also be set via F5XC_INSECURE_SKIP_VERIFY environment variable.",
+				Optional: true,
+			},
+			"http_headers": schema.MapAttribute{
+				MarkdownDescription: "Extra headers added to every API request, e.g. for a proxy that requires them. " +
+					"They cannot override the Authorization, Content-Type and Accept headers. Optional.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_labels": schema.MapAttribute{
+				MarkdownDescription: "Labels added to every object managed by the provider, e.g. for ownership and cost allocation. " +
+					"Labels with the same key in the labels of a resource take precedence. Optional.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_annotations": schema.MapAttribute{
+				MarkdownDescription: "Annotations added to every object managed by the provider. " +
+					"Annotations with the same key in the annotations of a resource take precedence. Optional.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_namespace": schema.StringAttribute{
+				MarkdownDescription: "Namespace of objects whose resource does not set one. " +
+					"Types that only exist in the system namespace always default to system. " +
+					"Can also be set via F5XC_DEFAULT_NAMESPACE environment variable.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -4833,6 +5510,21 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	// A provider configured from values of other resources, such as the
+	// tenant_url of a f5xc_child_tenant, sees unknown values until they are
+	// applied. Leave it unconfigured so planning can continue; Terraform
+	// configures it again with the known values before applying.
+	if config.APIURL.IsUnknown() || config.APIToken.IsUnknown() || config.APIP12File.IsUnknown() ||
+		config.P12Password.IsUnknown() || config.APICert.IsUnknown() || config.APIKey.IsUnknown() ||
+		config.APICACert.IsUnknown() || config.SubscriptionTier.IsUnknown() || config.MaxRetries.IsUnknown() ||
+		config.RetryWaitMin.IsUnknown() || config.RetryWaitMax.IsUnknown() || config.RateLimitRPS.IsUnknown() ||
+		config.RequestTimeout.IsUnknown() || config.HTTPProxy.IsUnknown() || config.InsecureSkipVerify.IsUnknown() ||
+		config.HTTPHeaders.IsUnknown() ||
+		config.DefaultLabels.IsUnknown() || config.DefaultAnnotations.IsUnknown() || config.DefaultNamespace.IsUnknown() {
+		tflog.Warn(ctx, "Provider configuration contains unknown values, skipping F5XC client configuration")
+		return
+	}
+
 	// Get configuration values from environment variables first
 	apiURL := os.Getenv("F5XC_API_URL")
 	apiToken := os.Getenv("F5XC_API_TOKEN")
@@ -4840,7 +5532,7 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	p12Password := os.Getenv("F5XC_P12_PASSWORD")
 	apiCert := os.Getenv("F5XC_CERT")
 	apiKey := os.Getenv("F5XC_KEY")
-	apiCACert := os.Getenv("F5XC_CACERT")
+	subscriptionTier := os.Getenv("F5XC_SUBSCRIPTION_TIER")
 
 	// Configuration values override environment variables
 	if !config.APIURL.IsNull() {
@@ -4861,8 +5553,8 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	if !config.APIKey.IsNull() {
 		apiKey = config.APIKey.ValueString()
 	}
-	if !config.APICACert.IsNull() {
-		apiCACert = config.APICACert.ValueString()
+	if !config.SubscriptionTier.IsNull() {
+		subscriptionTier = config.SubscriptionTier.ValueString()
 	}
 
 	// Set default API URL if not provided
@@ -4873,6 +5565,14 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 	// Normalize the API URL (removes /api suffix and trailing slashes)
 	apiURL, _ = normalizeAPIURL(apiURL)
 
+	// Retry, rate limit and HTTP transport settings of the client. The CA
+	// certificate is part of the transport settings, so it applies to every
+	// authentication mode.
+	opts := clientOptions(config, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var c *client.Client
 	var err error
 
@@ -4889,7 +5589,7 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 			)
 			return
 		}
-		c, err = client.NewClientWithP12(apiURL, apiP12File, p12Password)
+		c, err = client.NewClientWithP12(apiURL, apiP12File, p12Password, opts...)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Failed to Create F5XC Client",
@@ -4901,7 +5601,7 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	case apiCert != "" && apiKey != "":
 		// PEM certificate/key authentication
-		c, err = client.NewClientWithCert(apiURL, apiCert, apiKey, apiCACert)
+		c, err = client.NewClientWithCert(apiURL, apiCert, apiKey, "", opts...)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Failed to Create F5XC Client",
@@ -4913,7 +5613,7 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 
 	case apiToken != "":
 		// API token authentication
-		c = client.NewClient(apiURL, apiToken)
+		c = client.NewClient(apiURL, apiToken, opts...)
 		tflog.Info(ctx, "Configured F5XC client with API token authentication", map[string]any{"success": true, "api_url": apiURL})
 
 	default:
@@ -4926,10 +5626,16 @@ func (p *F5XCProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		)
 		return
 	}
+	c.SubscriptionTier = subscriptionTier
 
-	// Make the client available during DataSource and Resource type Configure methods
+	// Make the client available during DataSource, Resource, EphemeralResource and ListResource type Configure methods
 	resp.DataSourceData = c
 	resp.ResourceData = c
+	resp.EphemeralResourceData = c
+	resp.ListResourceData = c
+
+	// Provider functions use the transport settings of the configured client
+	p.functionTransport.set(c)
 }
 
 func (p *F5XCProvider) Resources(ctx context.Context) []func() resource.Resource {
@@ -4977,10 +5683,23 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+{{- if .ValidatorPackages.int64validator}}
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+{{- end}}
+{{- if .ValidatorPackages.listvalidator}}
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+{{- end}}
+{{- if .ValidatorPackages.mapvalidator}}
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+{{- end}}
+{{- if .ValidatorPackages.stringvalidator}}
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+{{- end}}
+{{- if .UsesPath}}
 	"github.com/hashicorp/terraform-plugin-framework/path"
+{{- end}}
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 {{- if .UsesBoolPlanModifier}}
@@ -4999,6 +5718,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 	inttimeouts "github.com/f5xc/terraform-provider-f5xc/internal/timeouts"
 	"github.com/f5xc/terraform-provider-f5xc/internal/validators"
 )
@@ -5008,8 +5728,14 @@ var (
 	_ resource.Resource                   = &{{.TitleCase}}Resource{}
 	_ resource.ResourceWithConfigure      = &{{.TitleCase}}Resource{}
 	_ resource.ResourceWithImportState    = &{{.TitleCase}}Resource{}
+	_ resource.ResourceWithIdentity = &{{.TitleCase}}Resource{}
+	_ resource.ResourceWithMoveState = &{{.TitleCase}}Resource{}
 	_ resource.ResourceWithModifyPlan     = &{{.TitleCase}}Resource{}
 	_ resource.ResourceWithValidateConfig = &{{.TitleCase}}Resource{}
+	_ resource.ResourceWithConfigValidators = &{{.TitleCase}}Resource{}
+{{- if .HasNamespaceInPath}}
+	_ listableResource = &{{.TitleCase}}Resource{}
+{{- end}}
 )
 
 func New{{.TitleCase}}Resource() resource.Resource {
@@ -5025,8 +5751,16 @@ type {{.TitleCase}}Resource struct {
 {{- if not .IsBlock}}
 	{{.GoName}} types.{{if eq .Type "string"}}String{{else if eq .Type "int64"}}Int64{{else if eq .Type "bool"}}Bool{{else if eq .Type "map"}}Map{{else if eq .Type "list"}}List{{else}}String{{end}} ` + "`" + `tfsdk:"{{.TfsdkTag}}"` + "`" + `
 {{- end}}
+{{- end}}
+{{- if .WaitsForSiteState}}
+	WaitForState types.Bool ` + "`" + `tfsdk:"wait_for_state"` + "`" + `
 {{- end}}
 	Timeouts timeouts.Value ` + "`" + `tfsdk:"timeouts"` + "`" + `
+	SystemMetadata types.Object ` + "`" + `tfsdk:"system_metadata"` + "`" + `
+	LabelsAll types.Map ` + "`" + `tfsdk:"labels_all"` + "`" + `
+{{- range .ExtraAttributes}}
+	{{.GoName}} types.String ` + "`" + `tfsdk:"{{.Name}}"` + "`" + `
+{{- end}}
 {{renderBlockFields .TitleCase .Attributes}}}
 
 func (r *{{.TitleCase}}Resource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -5062,6 +5796,9 @@ func (r *{{.TitleCase}}Resource) Schema(ctx context.Context, req resource.Schema
 					{{if eq .Type "string"}}stringplanmodifier{{else if eq .Type "bool"}}boolplanmodifier{{else if eq .Type "int64"}}int64planmodifier{{else if eq .Type "list"}}listplanmodifier{{else if eq .Type "map"}}mapplanmodifier{{else}}stringplanmodifier{{end}}.RequiresReplace(),
 {{- else if eq .PlanModifier "UseStateForUnknown"}}
 					{{if eq .Type "string"}}stringplanmodifier{{else if eq .Type "bool"}}boolplanmodifier{{else if eq .Type "int64"}}int64planmodifier{{else if eq .Type "list"}}listplanmodifier{{else if eq .Type "map"}}mapplanmodifier{{else}}stringplanmodifier{{end}}.UseStateForUnknown(),
+{{- else if eq .PlanModifier "UseStateForUnknownRequiresReplace"}}
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
 {{- end}}
 				},
 {{- end}}
@@ -5077,10 +5814,29 @@ func (r *{{.TitleCase}}Resource) Schema(ctx context.Context, req resource.Schema
 				Validators: []validator.String{
 					validators.NamespaceValidator(),
 				},
+{{- else if .Validators}}
+{{renderValidators . "\t\t\t\t"}}
+{{- end}}
+			},
+{{- end}}
 {{- end}}
+{{- range .ExtraAttributes}}
+			"{{.Name}}": schema.StringAttribute{
+				MarkdownDescription: "{{.Description}}",
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
 			},
 {{- end}}
+{{- if .WaitsForSiteState}}
+			"wait_for_state": schema.BoolAttribute{
+				MarkdownDescription: siteWaitForStateDescription,
+				Optional: true,
+			},
 {{- end}}
+			"system_metadata": systemMetadataSchemaAttribute(),
+			"labels_all": labelsAllSchemaAttribute(),
 		},
 		Blocks: map[string]schema.Block{
 			"timeouts": timeouts.Block(ctx, timeouts.Opts{
@@ -5094,6 +5850,9 @@ func (r *{{.TitleCase}}Resource) Schema(ctx context.Context, req resource.Schema
 			"{{.TfsdkTag}}": schema.{{if eq .NestedBlockType "single"}}SingleNestedBlock{{else if eq .NestedBlockType "list"}}ListNestedBlock{{else}}SingleNestedBlock{{end}}{
 				MarkdownDescription: "{{.Description}}",
 {{- if eq .NestedBlockType "list"}}
+{{- if .Validators}}
+{{renderValidators . "\t\t\t\t"}}
+{{- end}}
 				NestedObject: schema.NestedBlockObject{
 {{- if .NestedAttributes}}
 {{renderNestedAttrs .NestedAttributes "\t\t\t\t\t"}}{{renderNestedBlocks .NestedAttributes "\t\t\t\t\t"}}{{- else}}
@@ -5111,6 +5870,11 @@ func (r *{{.TitleCase}}Resource) Schema(ctx context.Context, req resource.Schema
 	}
 }
 
+// IdentitySchema implements resource.ResourceWithIdentity
+func (r *{{.TitleCase}}Resource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = resourceIdentitySchema()
+}
+
 func (r *{{.TitleCase}}Resource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
@@ -5135,6 +5899,11 @@ func (r *{{.TitleCase}}Resource) ValidateConfig(ctx context.Context, req resourc
 	}
 }
 
+// ConfigValidators implements resource.ResourceWithConfigValidators.
+// At most one field of each OpenAPI oneof group may be configured.
+func (r *{{.TitleCase}}Resource) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+{{renderConfigValidators . "\t"}}}
+
 // ModifyPlan implements resource.ResourceWithModifyPlan
 func (r *{{.TitleCase}}Resource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
 	if req.Plan.Raw.IsNull() {
@@ -5145,7 +5914,12 @@ func (r *{{.TitleCase}}Resource) ModifyPlan(ctx context.Context, req resource.Mo
 		return
 	}
 
+	resp.Diagnostics.Append(planLabelsAll(ctx, r.client, &resp.Plan)...)
+
 	if req.State.Raw.IsNull() {
+{{- if .HasNamespaceInPath}}
+		resp.Diagnostics.Append(planNamespace(ctx, r.client, "{{.Name}}", "{{.RequiredNamespace}}", req.Config, &resp.Plan)...)
+{{- end}}
 		var plan {{.TitleCase}}ResourceModel
 		resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 		if resp.Diagnostics.HasError() {
@@ -5158,17 +5932,26 @@ func (r *{{.TitleCase}}Resource) ModifyPlan(ctx context.Context, req resource.Mo
 				"The resource name is not yet known. This may affect planning for dependent resources.",
 			)
 		}
+{{- if .ValidatesPlan}}
+
+		resp.Diagnostics.Append(r.validatePlan(ctx, &plan)...)
+{{- end}}
 	}
 }
 
 func (r *{{.TitleCase}}Resource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var data {{.TitleCase}}ResourceModel
+{{- if .HasWriteOnly}}
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
+{{- else}}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+{{- end}}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	createTimeout, diags := data.Timeouts.Create(ctx, inttimeouts.DefaultCreate)
+	createTimeout, diags := data.Timeouts.Create(ctx, {{if .IsLongRunning}}inttimeouts.LongRunningCreate{{else}}inttimeouts.DefaultCreate{{end}})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -5202,6 +5985,7 @@ func (r *{{.TitleCase}}Resource) Create(ctx context.Context, req resource.Create
 		}
 		createReq.Metadata.Labels = labels
 	}
+	createReq.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, createReq.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -5211,30 +5995,54 @@ func (r *{{.TitleCase}}Resource) Create(ctx context.Context, req resource.Create
 		}
 		createReq.Metadata.Annotations = annotations
 	}
+	createReq.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, createReq.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 {{renderSpecMarshalCodeForCreate .Attributes "\t" .TitleCase}}
 
 	apiResource, err := r.client.Create{{.TitleCase}}(ctx, createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create {{.TitleCase}}: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "{{.Name}}", "create"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, createReq.Metadata.Labels, &resp.Diagnostics)
 {{- if not .HasNamespaceInPath}}
 	// For resources without namespace in API path, namespace is computed from API response
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 {{- end}}
 
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, false)...)
+
 	// Unmarshal spec fields from API response to Terraform state
 	// This ensures computed nested fields (like tenant in Object Reference blocks) have known values
 	isImport := false // Create is never an import
 	_ = isImport // May be unused if resource has no blocks needing import detection
 {{renderSpecUnmarshalCode .Attributes "\t" .TitleCase}}
+{{- if .ExtraAttributes}}
+	r.setExtraAttributes(&data)
+{{- end}}
+{{- if .WaitsForReady}}
+
+	// Save the created resource first so that a failed wait taints it
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(r.waitForReady(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+{{- end}}
 
 	tflog.Trace(ctx, "created {{.TitleCase}} resource")
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+{{- if .WaitsForSiteState}}
+
+	// The site is saved before waiting so that a failed wait taints it
+	if data.WaitForState.ValueBool() && !resp.Diagnostics.HasError() {
+		resp.Diagnostics.Append(waitForSiteOnline(ctx, "{{.Name}}", r.siteSpec(data.Namespace.ValueString(), data.Name.ValueString()))...)
+	}
+{{- end}}
 }
 
 func (r *{{.TitleCase}}Resource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -5256,7 +6064,7 @@ func (r *{{.TitleCase}}Resource) Read(ctx context.Context, req resource.ReadRequ
 	apiResource, err := r.client.Get{{.TitleCase}}(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
 		// Check if the resource was deleted outside Terraform
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "{{.TitleCase}} not found, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -5264,11 +6072,12 @@ func (r *{{.TitleCase}}Resource) Read(ctx context.Context, req resource.ReadRequ
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read {{.TitleCase}}: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "{{.Name}}", "read"))
 		return
 	}
 
 	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
 	data.Name = types.StringValue(apiResource.Metadata.Name)
 	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
 
@@ -5279,31 +6088,13 @@ func (r *{{.TitleCase}}Resource) Read(ctx context.Context, req resource.ReadRequ
 		data.Description = types.StringNull()
 	}
 
-	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(apiResource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
-		if len(filteredLabels) > 0 {
-			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
-			resp.Diagnostics.Append(diags...)
-			if !resp.Diagnostics.HasError() {
-				data.Labels = labels
-			}
-		} else {
-			data.Labels = types.MapNull(types.StringType)
-		}
-	} else {
-		data.Labels = types.MapNull(types.StringType)
-	}
-
-	if len(apiResource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
-		resp.Diagnostics.Append(diags...)
-		if !resp.Diagnostics.HasError() {
-			data.Annotations = annotations
-		}
-	} else {
-		data.Annotations = types.MapNull(types.StringType)
-	}
+	// Filter out system-managed labels (ves.io/*) that are injected by the platform.
+	// Labels and annotations added from the provider defaults are left out unless
+	// the resource sets them too; labels_all holds all labels.
+	filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
+	data.LabelsAll = stringMapOrNull(ctx, filteredLabels, &resp.Diagnostics)
+	data.Labels = stringMapOrNull(ctx, withoutDefaults(filteredLabels, r.client.DefaultLabels, data.Labels), &resp.Diagnostics)
+	data.Annotations = stringMapOrNull(ctx, withoutDefaults(apiResource.Metadata.Annotations, r.client.DefaultAnnotations, data.Annotations), &resp.Diagnostics)
 
 	// Check if this Read is triggered by an import operation
 	// Import sets a private state marker so we know to populate all nested blocks from API response
@@ -5311,20 +6102,29 @@ func (r *{{.TitleCase}}Resource) Read(ctx context.Context, req resource.ReadRequ
 	if importMarker, diags := req.Private.GetKey(ctx, "isImport"); diags.HasError() == false && string(importMarker) == "true" {
 		isImport = true
 	}
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), apiResource.SystemMetadata, isImport)...)
 	_ = isImport // May be unused if resource has no blocks needing import detection
 {{renderSpecUnmarshalCode .Attributes "\t" .TitleCase}}
+{{- if .ExtraAttributes}}
+	r.setExtraAttributes(&data)
+{{- end}}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *{{.TitleCase}}Resource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data {{.TitleCase}}ResourceModel
+{{- if .HasWriteOnly}}
+	// Write-only attributes are null in the plan and only available in the configuration
+	resp.Diagnostics.Append(getPlanWithWriteOnlyValues(ctx, req.Plan, req.Config, &data)...)
+{{- else}}
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+{{- end}}
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	updateTimeout, diags := data.Timeouts.Update(ctx, inttimeouts.DefaultUpdate)
+	updateTimeout, diags := data.Timeouts.Update(ctx, {{if .IsLongRunning}}inttimeouts.LongRunningCreate{{else}}inttimeouts.DefaultUpdate{{end}})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -5353,6 +6153,7 @@ func (r *{{.TitleCase}}Resource) Update(ctx context.Context, req resource.Update
 		}
 		apiResource.Metadata.Labels = labels
 	}
+	apiResource.Metadata.Labels = mergeDefaults(r.client.DefaultLabels, apiResource.Metadata.Labels)
 
 	if !data.Annotations.IsNull() {
 		annotations := make(map[string]string)
@@ -5362,13 +6163,29 @@ func (r *{{.TitleCase}}Resource) Update(ctx context.Context, req resource.Update
 		}
 		apiResource.Metadata.Annotations = annotations
 	}
+	apiResource.Metadata.Annotations = mergeDefaults(r.client.DefaultAnnotations, apiResource.Metadata.Annotations)
 
 	// Marshal spec fields from Terraform state to API struct
 {{renderSpecMarshalCode .Attributes "\t" .TitleCase}}
+{{- if .SkippedFields}}
+
+	// Keep the fields managed by another resource, which the replace request
+	// would otherwise reset
+	current, getErr := r.client.Get{{.TitleCase}}(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	if getErr != nil {
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(getErr, "{{.Name}}", "read"))
+		return
+	}
+	for _, field := range []string{ {{- range $i, $f := .SkippedFields}}{{if $i}}, {{end}}"{{$f}}"{{end -}} } {
+		if value, ok := current.Spec[field]; ok {
+			apiResource.Spec[field] = value
+		}
+	}
+{{- end}}
 
 	_, err := r.client.Update{{.TitleCase}}(ctx, apiResource)
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update {{.TitleCase}}: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "{{.Name}}", "update"))
 		return
 	}
 
@@ -5379,9 +6196,12 @@ func (r *{{.TitleCase}}Resource) Update(ctx context.Context, req resource.Update
 	// PUT responses may not include all computed nested fields (like tenant in Object Reference blocks)
 	fetched, fetchErr := r.client.Get{{.TitleCase}}(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if fetchErr != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read {{.TitleCase}} after update: %s", fetchErr))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(fetchErr, "{{.Name}}", "read"))
 		return
 	}
+	data.SystemMetadata = systemMetadataValue(fetched.SystemMetadata)
+	data.LabelsAll = stringMapOrNull(ctx, apiResource.Metadata.Labels, &resp.Diagnostics)
+	resp.Diagnostics.Append(setResourceIdentity(ctx, resp.Identity, data.Namespace.ValueString(), data.Name.ValueString(), fetched.SystemMetadata, false)...)
 
 {{renderFetchedComputedFieldsCode .Attributes "\t"}}
 
@@ -5390,6 +6210,9 @@ func (r *{{.TitleCase}}Resource) Update(ctx context.Context, req resource.Update
 	isImport := false // Update is never an import
 	_ = isImport // May be unused if resource has no blocks needing import detection
 {{renderSpecUnmarshalCode .Attributes "\t" .TitleCase}}
+{{- if .ExtraAttributes}}
+	r.setExtraAttributes(&data)
+{{- end}}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
@@ -5401,7 +6224,7 @@ func (r *{{.TitleCase}}Resource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	deleteTimeout, diags := data.Timeouts.Delete(ctx, inttimeouts.DefaultDelete)
+	deleteTimeout, diags := data.Timeouts.Delete(ctx, {{if .IsLongRunning}}inttimeouts.LongRunningDelete{{else}}inttimeouts.DefaultDelete{{end}})
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -5418,7 +6241,7 @@ func (r *{{.TitleCase}}Resource) Delete(ctx context.Context, req resource.Delete
 {{- end}}
 	if err != nil {
 		// If the resource is already gone, consider deletion successful (idempotent delete)
-		if strings.Contains(err.Error(), "NOT_FOUND") || strings.Contains(err.Error(), "404") {
+		if f5xcerrors.IsNotFound(err) {
 			tflog.Warn(ctx, "{{.TitleCase}} already deleted, removing from state", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
@@ -5427,56 +6250,56 @@ func (r *{{.TitleCase}}Resource) Delete(ctx context.Context, req resource.Delete
 		}
 		// If delete is not implemented (501), warn and remove from state
 		// Some F5 XC resources don't support deletion via API
-		if strings.Contains(err.Error(), "501") {
+		if f5xcerrors.IsNotImplemented(err) {
 			tflog.Warn(ctx, "{{.TitleCase}} delete not supported by API (501), removing from state only", map[string]interface{}{
 				"name":      data.Name.ValueString(),
 				"namespace": data.Namespace.ValueString(),
 			})
 			return
 		}
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete {{.TitleCase}}: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "{{.Name}}", "delete"))
 		return
 	}
-}
+{{- if .WaitsForSiteState}}
 
-func (r *{{.TitleCase}}Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-{{- if .HasNamespaceInPath}}
-	// Import ID format: namespace/name
-	parts := strings.Split(req.ID, "/")
-	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			fmt.Sprintf("Expected import ID format: namespace/name, got: %s", req.ID),
-		)
-		return
+	if data.WaitForState.ValueBool() {
+		resp.Diagnostics.Append(waitForSiteDecommissioned(ctx, "{{.Name}}", r.siteSpec(data.Namespace.ValueString(), data.Name.ValueString()))...)
 	}
-	namespace := parts[0]
-	name := parts[1]
+}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), namespace)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
-{{- else}}
-	// Import ID format: name (no namespace for this resource type)
-	name := req.ID
-	if name == "" {
-		resp.Diagnostics.AddError(
-			"Invalid Import ID",
-			"Expected import ID to be the resource name, got empty string",
-		)
-		return
+// siteSpec returns a function that reads the spec of the site, for waiting
+// on its site_state
+func (r *{{.TitleCase}}Resource) siteSpec(namespace, name string) siteSpecFunc {
+	return func(ctx context.Context) (map[string]interface{}, error) {
+		site, err := r.client.Get{{.TitleCase}}(ctx, namespace, name)
+		if err != nil {
+			return nil, err
+		}
+		return site.Spec, nil
 	}
-
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("namespace"), "")...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), name)...)
 {{- end}}
+}
 
-	// Set private state marker to indicate this is an import operation
-	// This allows Read to populate all nested blocks from API response
-	diags := resp.Private.SetKey(ctx, "isImport", []byte("true"))
-	resp.Diagnostics.Append(diags...)
+func (r *{{.TitleCase}}Resource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importResourceState(ctx, r.client, {{.HasNamespaceInPath}}, "{{.RequiredNamespace}}", req, resp)
+}
+
+// MoveState implements resource.ResourceWithMoveState
+func (r *{{.TitleCase}}Resource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{moveStateFromVolterra("{{.Name}}", {{.HasNamespaceInPath}})}
+}
+{{- if .HasNamespaceInPath}}
+
+// listObjects implements listableResource
+func (r *{{.TitleCase}}Resource) listObjects(ctx context.Context, namespace string, opts client.ListOptions) (*client.ListResponse, error) {
+	return r.client.List{{.PluralTitleCase}}(ctx, namespace, opts)
 }
+
+// requiredNamespace implements listableResource
+func (r *{{.TitleCase}}Resource) requiredNamespace() string {
+	return "{{.RequiredNamespace}}"
+}
+{{- end}}
 `
 
 const clientTemplate = `// Code generated by generate-all-schemas.go. DO NOT EDIT.
@@ -5493,6 +6316,9 @@ import (
 type {{.TitleCase}} struct {
 	Metadata Metadata               ` + "`" + `json:"metadata"` + "`" + `
 	Spec     map[string]interface{} ` + "`" + `json:"spec"` + "`" + `
+
+	// SystemMetadata is returned by Create and Get and never sent
+	SystemMetadata *SystemMetadata ` + "`" + `json:"system_metadata,omitempty"` + "`" + `
 }
 
 // Create{{.TitleCase}} creates a new {{.TitleCase}}
@@ -5542,7 +6368,23 @@ func (c *Client) Delete{{.TitleCase}}(ctx context.Context, namespace, name strin
 	path := fmt.Sprintf("{{.APIPathItem}}", name)
 	_ = namespace // Namespace not required in API path for this resource
 {{- end}}
+{{- if .DeleteViaPost}}
+	// The API deletes with a POST to the item's delete endpoint
+	return c.Post(ctx, path+"/delete", struct{}{}, nil)
+{{- else}}
 	return c.Delete(ctx, path)
+{{- end}}
+}
+
+// List{{.PluralTitleCase}} lists {{.TitleCase}} objects
+func (c *Client) List{{.PluralTitleCase}}(ctx context.Context, namespace string, opts ListOptions) (*ListResponse, error) {
+{{- if .HasNamespaceInPath}}
+	path := fmt.Sprintf("{{.APIPath}}", namespace)
+{{- else}}
+	path := "{{.APIPath}}"
+	_ = namespace // Namespace not required in API path for this resource
+{{- end}}
+	return c.List(ctx, path, opts)
 }
 `
 
@@ -5553,13 +6395,12 @@ package provider
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
-	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
 )
 
 var (
@@ -5575,51 +6416,26 @@ type {{.TitleCase}}DataSource struct {
 	client *client.Client
 }
 
+// {{.TitleCase}}DataSourceModel mirrors {{.TitleCase}}ResourceModel without timeouts,
+// so the full object spec is exposed using the same nested model types.
 type {{.TitleCase}}DataSourceModel struct {
-	ID          types.String ` + "`" + `tfsdk:"id"` + "`" + `
-	Name        types.String ` + "`" + `tfsdk:"name"` + "`" + `
-	Namespace   types.String ` + "`" + `tfsdk:"namespace"` + "`" + `
-	Description types.String ` + "`" + `tfsdk:"description"` + "`" + `
-	Labels      types.Map    ` + "`" + `tfsdk:"labels"` + "`" + `
-	Annotations types.Map    ` + "`" + `tfsdk:"annotations"` + "`" + `
-}
+	SystemMetadata types.Object ` + "`" + `tfsdk:"system_metadata"` + "`" + `
+{{- range .Attributes}}
+{{- if not .IsBlock}}
+	{{.GoName}} types.{{if eq .Type "string"}}String{{else if eq .Type "int64"}}Int64{{else if eq .Type "bool"}}Bool{{else if eq .Type "map"}}Map{{else if eq .Type "list"}}List{{else}}String{{end}} ` + "`" + `tfsdk:"{{.TfsdkTag}}"` + "`" + `
+{{- end}}
+{{- end}}
+{{- range .ExtraAttributes}}
+	{{.GoName}} types.String ` + "`" + `tfsdk:"{{.Name}}"` + "`" + `
+{{- end}}
+{{renderBlockFields .TitleCase .Attributes}}}
 
 func (d *{{.TitleCase}}DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_{{.Name}}"
 }
 
 func (d *{{.TitleCase}}DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		MarkdownDescription: "{{.Description}}",
-		Attributes: map[string]schema.Attribute{
-			"id": schema.StringAttribute{
-				MarkdownDescription: "Unique identifier for the resource.",
-				Computed:            true,
-			},
-			"name": schema.StringAttribute{
-				MarkdownDescription: "Name of the {{.TitleCase}}.",
-				Required:            true,
-			},
-			"namespace": schema.StringAttribute{
-				MarkdownDescription: "Namespace where the {{.TitleCase}} exists.",
-				Required:            true,
-			},
-			"description": schema.StringAttribute{
-				MarkdownDescription: "Description of the {{.TitleCase}}.",
-				Computed:            true,
-			},
-			"labels": schema.MapAttribute{
-				MarkdownDescription: "Labels applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-			"annotations": schema.MapAttribute{
-				MarkdownDescription: "Annotations applied to this resource.",
-				Computed:            true,
-				ElementType:         types.StringType,
-			},
-		},
-	}
+	resp.Schema = dataSourceSchemaFromResource(ctx, New{{.TitleCase}}Resource())
 }
 
 func (d *{{.TitleCase}}DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -5641,24 +6457,25 @@ func (d *{{.TitleCase}}DataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	resource, err := d.client.Get{{.TitleCase}}(ctx, data.Namespace.ValueString(), data.Name.ValueString())
+	apiResource, err := d.client.Get{{.TitleCase}}(ctx, data.Namespace.ValueString(), data.Name.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read {{.TitleCase}}: %s", err))
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "{{.Name}}", "read"))
 		return
 	}
 
-	data.ID = types.StringValue(resource.Metadata.Name)
-	data.Name = types.StringValue(resource.Metadata.Name)
-	data.Namespace = types.StringValue(resource.Metadata.Namespace)
-	if resource.Metadata.Description != "" {
-		data.Description = types.StringValue(resource.Metadata.Description)
+	data.ID = types.StringValue(apiResource.Metadata.Name)
+	data.Name = types.StringValue(apiResource.Metadata.Name)
+	data.Namespace = types.StringValue(apiResource.Metadata.Namespace)
+	data.SystemMetadata = systemMetadataValue(apiResource.SystemMetadata)
+	if apiResource.Metadata.Description != "" {
+		data.Description = types.StringValue(apiResource.Metadata.Description)
 	} else {
 		data.Description = types.StringNull()
 	}
 
 	// Filter out system-managed labels (ves.io/*) that are injected by the platform
-	if len(resource.Metadata.Labels) > 0 {
-		filteredLabels := filterSystemLabels(resource.Metadata.Labels)
+	if len(apiResource.Metadata.Labels) > 0 {
+		filteredLabels := filterSystemLabels(apiResource.Metadata.Labels)
 		if len(filteredLabels) > 0 {
 			labels, diags := types.MapValueFrom(ctx, types.StringType, filteredLabels)
 			resp.Diagnostics.Append(diags...)
@@ -5672,8 +6489,8 @@ func (d *{{.TitleCase}}DataSource) Read(ctx context.Context, req datasource.Read
 		data.Labels = types.MapNull(types.StringType)
 	}
 
-	if len(resource.Metadata.Annotations) > 0 {
-		annotations, diags := types.MapValueFrom(ctx, types.StringType, resource.Metadata.Annotations)
+	if len(apiResource.Metadata.Annotations) > 0 {
+		annotations, diags := types.MapValueFrom(ctx, types.StringType, apiResource.Metadata.Annotations)
 		resp.Diagnostics.Append(diags...)
 		if !resp.Diagnostics.HasError() {
 			data.Annotations = annotations
@@ -5682,6 +6499,92 @@ func (d *{{.TitleCase}}DataSource) Read(ctx context.Context, req datasource.Read
 		data.Annotations = types.MapNull(types.StringType)
 	}
 
+	// A data source has no prior state, so populate every block from the API
+	// response the same way a resource import does.
+	isImport := true
+	_ = isImport // May be unused if resource has no blocks needing import detection
+{{renderDataSourceSpecUnmarshalCode .Attributes "\t" .TitleCase}}
+{{- if .ExtraAttributes}}
+	d.setExtraAttributes(&data)
+{{- end}}
+{{if .HasWriteOnly}}
+	// The nested model types also hold the version companions of write-only
+	// attributes, which the data source schema does not have
+	resp.Diagnostics.Append(setDataSourceState(ctx, &resp.State, New{{.TitleCase}}Resource(), &data)...)
+{{- else}}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+{{- end}}
+}
+`
+
+// listDataSourceTemplate generates the plural data source (e.g. f5xc_origin_pools)
+// that lists objects of a type. Schema, model and filtering are shared in
+// list_data_source_helpers.go.
+const listDataSourceTemplate = `// Code generated by generate-all-schemas.go. DO NOT EDIT.
+// Source: F5 XC OpenAPI specification
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+
+	"github.com/f5xc/terraform-provider-f5xc/internal/client"
+	f5xcerrors "github.com/f5xc/terraform-provider-f5xc/internal/errors"
+)
+
+var (
+	_ datasource.DataSource              = &{{.PluralTitleCase}}DataSource{}
+	_ datasource.DataSourceWithConfigure = &{{.PluralTitleCase}}DataSource{}
+)
+
+func New{{.PluralTitleCase}}DataSource() datasource.DataSource {
+	return &{{.PluralTitleCase}}DataSource{}
+}
+
+type {{.PluralTitleCase}}DataSource struct {
+	client *client.Client
+}
+
+func (d *{{.PluralTitleCase}}DataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_{{.PluralName}}"
+}
+
+func (d *{{.PluralTitleCase}}DataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = listDataSourceSchema("{{humanName .Name}}", {{.HasNamespaceInPath}})
+}
+
+func (d *{{.PluralTitleCase}}DataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", "Expected *client.Client")
+		return
+	}
+	d.client = client
+}
+
+func (d *{{.PluralTitleCase}}DataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data listDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := d.client.List{{.PluralTitleCase}}(ctx, data.Namespace.ValueString(), data.listOptions())
+	if err != nil {
+		f5xcerrors.AddError(&resp.Diagnostics, f5xcerrors.WrapError(err, "{{.Name}}", "list"))
+		return
+	}
+
+	data.setItems(ctx, list, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 `
@@ -184,3 +184,28 @@ func ToResourceTypeName(resourceName string) string {
 
 	return result.String()
 }
+
+// ToPluralName returns the plural form of a snake_case resource name or a Go
+// type name, used for list data sources and list client methods.
+// Names that are already plural get a "_list" ("List" for type names) suffix
+// so they never collide with the singular form.
+// Example: "origin_pool" -> "origin_pools", "ServicePolicy" -> "ServicePolicies"
+func ToPluralName(name string) string {
+	lower := strings.ToLower(name)
+	switch {
+	case name == "":
+		return name
+	case strings.HasSuffix(lower, "ss"), strings.HasSuffix(lower, "x"),
+		strings.HasSuffix(lower, "ch"), strings.HasSuffix(lower, "sh"):
+		return name + "es"
+	case strings.HasSuffix(lower, "y") && len(lower) > 1 && !strings.ContainsRune("aeiou", rune(lower[len(lower)-2])):
+		return name[:len(name)-1] + "ies"
+	case strings.HasSuffix(lower, "s"):
+		if lower == name {
+			return name + "_list"
+		}
+		return name + "List"
+	default:
+		return name + "s"
+	}
+}
@@ -270,3 +270,32 @@ func TestGetMixedCaseAcronym(t *testing.T) {
 		})
 	}
 }
+
+func TestToPluralName(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"origin_pool", "origin_pools"},
+		{"http_loadbalancer", "http_loadbalancers"},
+		{"service_policy", "service_policies"},
+		{"proxy", "proxies"},
+		{"forwarding_class", "forwarding_classes"},
+		{"secret_management_access", "secret_management_accesses"},
+		{"cloud_credentials", "cloud_credentials_list"},
+		{"gateway", "gateways"},
+		{"OriginPool", "OriginPools"},
+		{"ServicePolicy", "ServicePolicies"},
+		{"CloudCredentials", "CloudCredentialsList"},
+		{"", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.input, func(t *testing.T) {
+			result := ToPluralName(tt.input)
+			if result != tt.expected {
+				t.Errorf("ToPluralName(%q) = %q, want %q", tt.input, result, tt.expected)
+			}
+		})
+	}
+}
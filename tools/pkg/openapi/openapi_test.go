@@ -5,6 +5,7 @@ package openapi
 import (
 	"os"
 	"path/filepath"
+	"sort"
 	"testing"
 )
 
@@ -237,3 +238,40 @@ func TestGetAPIDocURL(t *testing.T) {
 		})
 	}
 }
+
+func TestExtractResourcePathsFromPaths(t *testing.T) {
+	paths := map[string]interface{}{
+		"/api/config/namespaces/{namespace}/origin_pools":                   nil,
+		"/api/config/dns/namespaces/{namespace}/dns_zones":                  nil,
+		"/api/config/dns/namespaces/{namespace}/dns_zones/{name}":           nil,
+		"/api/config/dns/namespaces/system/dns_zone/import":                 nil,
+		"/api/data/namespaces/{namespace}/dns_load_balancers":               nil,
+		"/api/config/unknown/namespaces/{namespace}/widgets":                nil,
+		"/api/web/namespaces":                                               nil,
+		"/api/config/namespaces/{namespace}/service_policy_sets":            nil,
+		"/api/config/namespaces/{namespace}/virtual_k8ss":                   nil,
+		"/api/config/dns/namespaces/{metadata.namespace}/dns_lb_pools":      nil,
+		"/api/config/dns/namespaces/{namespace}/geo_location_sets":          nil,
+		"/api/infraprotect/namespaces/{namespace}/infraprotect_asns":        nil,
+		"/api/infraprotect/namespaces/{namespace}/infraprotect_asns/{name}": nil,
+		"/api/web/namespaces/{namespace}/addon_subscriptions":               nil,
+		"/api/web/namespaces/{namespace}/addon_subscriptions/{name}":        nil,
+	}
+
+	var names []string
+	for _, rp := range extractResourcePathsFromPaths(paths) {
+		names = append(names, rp.ResourceName)
+	}
+	sort.Strings(names)
+
+	expected := []string{"addon_subscription", "dns_zone", "infraprotect_asn", "namespace", "origin_pool", "service_policy_set", "virtual_k8s"}
+	if len(names) != len(expected) {
+		t.Fatalf("extractResourcePathsFromPaths() = %v, want %v", names, expected)
+	}
+	for i := range expected {
+		if names[i] != expected[i] {
+			t.Errorf("extractResourcePathsFromPaths() = %v, want %v", names, expected)
+			break
+		}
+	}
+}
@@ -10,6 +10,7 @@ import (
 	"os"
 	"path/filepath"
 	"regexp"
+	"slices"
 	"strings"
 	"sync"
 )
@@ -312,13 +313,42 @@ type resourcePath struct {
 	APIPath      string
 }
 
+// excludedCollections are collections matching the patterns below whose
+// objects are not generated as resources.
+var excludedCollections = map[string]bool{
+	// Geo location sets of the DNS service are not managed by the provider
+	"geo_location_sets": true,
+}
+
+// servicePrefixes are the API services whose objects live below
+// /api/{service}/namespaces/{namespace} instead of /api/config/namespaces/{namespace}.
+var servicePrefixes = []string{"config/dns", "infraprotect"}
+
+// servicePaths are individual collections outside /api/config whose objects
+// support the standard create, get, replace and delete operations.
+var servicePaths = []string{
+	"/api/register/namespaces/{namespace}/tokens",
+	"/api/web/namespaces/{namespace}/addon_subscriptions",
+	"/api/web/custom/namespaces/{namespace}/oidc_providers",
+	"/api/web/namespaces/{namespace}/allowed_tenants",
+	"/api/web/namespaces/{namespace}/child_tenant_managers",
+	"/api/web/namespaces/{namespace}/child_tenants",
+	"/api/web/namespaces/{namespace}/managed_tenants",
+	"/api/web/namespaces/{namespace}/roles",
+	"/api/web/namespaces/{namespace}/tenant_profiles",
+}
+
 // extractResourcePathsFromPaths analyzes OpenAPI paths to find CRUD resource patterns.
 func extractResourcePathsFromPaths(paths map[string]interface{}) []resourcePath {
 	var results []resourcePath
 	seen := make(map[string]bool)
 
 	// Primary pattern: /api/config/namespaces/{namespace}/{resource_plural}
-	configPathRegex := regexp.MustCompile(`^/api/config/namespaces/\{namespace\}/([a-z_]+s)$`)
+	configPathRegex := regexp.MustCompile(`^/api/config/namespaces/\{namespace\}/([a-z0-9_]+s)$`)
+
+	// Service pattern: /api/{service}/namespaces/{namespace}/{resource_plural}
+	// for the services listed in servicePrefixes
+	servicePathRegex := regexp.MustCompile(`^/api/(` + strings.Join(servicePrefixes, "|") + `)/namespaces/\{namespace\}/([a-z0-9_]+s)$`)
 
 	// Secondary pattern: /api/web/{resource_plural} (for system-level resources like namespace)
 	webPathRegex := regexp.MustCompile(`^/api/web/([a-z_]+s)$`)
@@ -329,6 +359,10 @@ func extractResourcePathsFromPaths(paths map[string]interface{}) []resourcePath
 		// Try config pattern first (most common)
 		if matches := configPathRegex.FindStringSubmatch(path); len(matches) >= 2 {
 			resourcePlural = matches[1]
+		} else if matches := servicePathRegex.FindStringSubmatch(path); len(matches) >= 3 {
+			resourcePlural = matches[2]
+		} else if slices.Contains(servicePaths, path) {
+			resourcePlural = path[strings.LastIndex(path, "/")+1:]
 		} else if matches := webPathRegex.FindStringSubmatch(path); len(matches) >= 2 {
 			// Try web pattern for system-level resources (e.g., namespace)
 			resourcePlural = matches[1]
@@ -336,6 +370,10 @@ func extractResourcePathsFromPaths(paths map[string]interface{}) []resourcePath
 			continue
 		}
 
+		if excludedCollections[resourcePlural] {
+			continue
+		}
+
 		// Convert plural to singular (simple heuristic)
 		resourceName := strings.TrimSuffix(resourcePlural, "s")
 		if strings.HasSuffix(resourceName, "ie") {
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package resource
+
+// ExtraAttribute is a computed string attribute of a generated resource that
+// is not part of the API schema. The resource and data source of a resource
+// with extra attributes implement setExtraAttributes in a hand-written file
+// in internal/provider.
+type ExtraAttribute struct {
+	Name        string
+	GoName      string
+	Description string
+}
+
+// ExtraAttributes lists the extra attributes of generated resources.
+var ExtraAttributes = map[string][]ExtraAttribute{
+	"child_tenant": {
+		{
+			Name:        "tenant_url",
+			GoName:      "TenantURL",
+			Description: "URL of the child tenant, derived from the provider API URL and `domain`. Use it as the `api_url` of an aliased provider that manages the child tenant.",
+		},
+	},
+}
+
+// ReadyWaitResources lists resources whose create waits until the object
+// reports that it is ready. Resources listed here implement waitForReady in a
+// hand-written file in internal/provider.
+var ReadyWaitResources = map[string]bool{
+	"addon_subscription": true,
+	"child_tenant":       true,
+}
+
+// SiteStateWaitResources lists site resources with the optional
+// wait_for_state attribute. When it is set, create waits until the site is
+// online and delete until it is decommissioned, using the helpers in
+// internal/provider/site_wait_helpers.go.
+var SiteStateWaitResources = map[string]bool{
+	"aws_tgw_site":    true,
+	"aws_vpc_site":    true,
+	"azure_vnet_site": true,
+	"gcp_vpc_site":    true,
+	"securemesh_site": true,
+	"voltstack_site":  true,
+}
+
+// PlanValidationResources lists resources that check the planned object
+// before it is created. Resources listed here implement validatePlan in a
+// hand-written file in internal/provider.
+var PlanValidationResources = map[string]bool{
+	"addon_subscription": true,
+}
+
+// GetExtraAttributes returns the extra attributes of a resource.
+func GetExtraAttributes(resourceName string) []ExtraAttribute {
+	return ExtraAttributes[resourceName]
+}
+
+// WaitsForReady returns true if create waits until the resource is ready.
+func WaitsForReady(resourceName string) bool {
+	return ReadyWaitResources[resourceName]
+}
+
+// WaitsForSiteState returns true if the resource has the wait_for_state
+// attribute of the site resources.
+func WaitsForSiteState(resourceName string) bool {
+	return SiteStateWaitResources[resourceName]
+}
+
+// ValidatesPlan returns true if the plan of a new resource is checked by a
+// hand-written validatePlan.
+func ValidatesPlan(resourceName string) bool {
+	return PlanValidationResources[resourceName]
+}
+
+// SkippedFields lists spec fields that are left out of a generated resource,
+// by resource name. They are managed by a hand-written resource instead, and
+// setting them in both would make the two resources undo each other's changes.
+var SkippedFields = map[string][]string{
+	"infraprotect_internet_prefix_advertisement": {"activation_announce", "activation_withdraw"},
+}
+
+// IsSkippedField returns true if the spec field is left out of the generated
+// resource.
+func IsSkippedField(resourceName, fieldName string) bool {
+	for _, name := range SkippedFields[resourceName] {
+		if name == fieldName {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteOnlyFields lists the string fields that carry secret material, by the
+// name of the block that contains them. They are generated as write-only
+// attributes so that the secret never reaches the plan or state. An empty
+// block name matches the field in any block.
+var WriteOnlyFields = map[string][]string{
+	"clear_secret_info": {"url"},
+	"":                  {"client_secret"},
+}
+
+// IsWriteOnly returns true if the field of the block is generated as a
+// write-only attribute.
+func IsWriteOnly(blockName, fieldName string) bool {
+	for _, name := range WriteOnlyFields[blockName] {
+		if name == fieldName {
+			return true
+		}
+	}
+	for _, name := range WriteOnlyFields[""] {
+		if name == fieldName {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteOnlyVersionName returns the name of the attribute that triggers an
+// update of a write-only attribute. Terraform does not store write-only
+// values, so changing only the value does not produce a diff.
+func WriteOnlyVersionName(fieldName string) string {
+	return fieldName + "_version"
+}
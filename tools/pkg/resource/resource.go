@@ -163,6 +163,7 @@ var SkippedResources = map[string]SkipReason{
 var ManuallyMaintainedFiles = map[string]bool{
 	"provider.go":                  true,
 	"functions_registration.go":   true,
+	"ephemeral_resources_registration.go": true,
 }
 
 // ManuallyMaintainedDirs lists directories that contain manually maintained code.
@@ -200,6 +200,7 @@ func TestIsManuallyMaintained(t *testing.T) {
 	}{
 		{"provider.go", true},
 		{"functions_registration.go", true},
+		{"ephemeral_resources_registration.go", true},
 		{"http_loadbalancer_resource.go", false},
 		{"namespace_resource.go", false},
 	}
@@ -251,3 +252,61 @@ func TestAllCategories(t *testing.T) {
 		}
 	}
 }
+
+func TestResourceHooks(t *testing.T) {
+	if !WaitsForReady("child_tenant") {
+		t.Error("WaitsForReady(\"child_tenant\") = false, want true")
+	}
+	if WaitsForReady("origin_pool") {
+		t.Error("WaitsForReady(\"origin_pool\") = true, want false")
+	}
+	if !WaitsForReady("addon_subscription") {
+		t.Error("WaitsForReady(\"addon_subscription\") = false, want true")
+	}
+	if !ValidatesPlan("addon_subscription") {
+		t.Error("ValidatesPlan(\"addon_subscription\") = false, want true")
+	}
+	if ValidatesPlan("origin_pool") {
+		t.Error("ValidatesPlan(\"origin_pool\") = true, want false")
+	}
+	if !WaitsForSiteState("aws_vpc_site") {
+		t.Error("WaitsForSiteState(\"aws_vpc_site\") = false, want true")
+	}
+	if WaitsForSiteState("virtual_site") {
+		t.Error("WaitsForSiteState(\"virtual_site\") = true, want false")
+	}
+	if !IsSkippedField("infraprotect_internet_prefix_advertisement", "activation_withdraw") {
+		t.Error("IsSkippedField(\"infraprotect_internet_prefix_advertisement\", \"activation_withdraw\") = false, want true")
+	}
+	if IsSkippedField("infraprotect_internet_prefix_advertisement", "prefix") {
+		t.Error("IsSkippedField(\"infraprotect_internet_prefix_advertisement\", \"prefix\") = true, want false")
+	}
+
+	attrs := GetExtraAttributes("child_tenant")
+	if len(attrs) != 1 || attrs[0].Name != "tenant_url" || attrs[0].GoName != "TenantURL" {
+		t.Errorf("GetExtraAttributes(\"child_tenant\") = %+v, want tenant_url", attrs)
+	}
+	if attrs := GetExtraAttributes("origin_pool"); len(attrs) != 0 {
+		t.Errorf("GetExtraAttributes(\"origin_pool\") = %+v, want none", attrs)
+	}
+
+	writeOnly := []struct {
+		block    string
+		field    string
+		expected bool
+	}{
+		{"clear_secret_info", "url", true},
+		{"blindfold_secret_info", "location", false},
+		{"webhook", "url", false},
+		{"azure_oidc_spec_type", "client_secret", true},
+		{"azure_oidc_spec_type", "client_id", false},
+	}
+	for _, tt := range writeOnly {
+		if result := IsWriteOnly(tt.block, tt.field); result != tt.expected {
+			t.Errorf("IsWriteOnly(%q, %q) = %v, want %v", tt.block, tt.field, result, tt.expected)
+		}
+	}
+	if name := WriteOnlyVersionName("url"); name != "url_version" {
+		t.Errorf("WriteOnlyVersionName(\"url\") = %q, want url_version", name)
+	}
+}
@@ -0,0 +1,241 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+// Package rules translates ves.io.schema.rules validation rules from the
+// OpenAPI x-ves-validation-rules extension into Go source for Terraform
+// Plugin Framework validators. Rules without a known translation are ignored
+// and left to the API to enforce.
+package rules
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Prefix is the common prefix of all validation rule keys.
+const Prefix = "ves.io.schema.rules."
+
+// Validator packages that generated expressions may reference.
+const (
+	StringValidatorPackage = "stringvalidator"
+	Int64ValidatorPackage  = "int64validator"
+	ListValidatorPackage   = "listvalidator"
+	MapValidatorPackage    = "mapvalidator"
+)
+
+// stringFormats maps boolean string format rules to validators in internal/validators.
+var stringFormats = map[string]string{
+	"ipv4":        "validators.IPv4Validator()",
+	"ipv6":        "validators.IPv6Validator()",
+	"ip":          "validators.IPValidator()",
+	"ipv4_prefix": "validators.IPv4PrefixValidator()",
+	"ipv6_prefix": "validators.IPv6PrefixValidator()",
+	"ip_prefix":   "validators.IPPrefixValidator()",
+	"hostname":    "validators.HostnameValidator()",
+	"email":       "validators.EmailValidator()",
+	"regex":       "validators.RegexValidator()",
+}
+
+// integerTypes are the rule type names that map onto Terraform int64 attributes.
+var integerTypes = []string{"uint32.", "int32.", "uint64.", "int64."}
+
+// Attribute returns the validator expressions for a Terraform attribute of
+// attrType ("string", "int64", "list" or "map") with elementType for lists
+// and maps. The expressions are returned in a stable order.
+func Attribute(attrType, elementType string, rules map[string]string) []string {
+	switch attrType {
+	case "string":
+		return String(rules, "string.")
+	case "int64":
+		return integer(rules, "")
+	case "list":
+		return list(elementType, rules)
+	case "map":
+		return mapRules(rules)
+	}
+	return nil
+}
+
+// ListBlock returns the validator expressions for a list nested block. Only
+// max_items is translated: an omitted block is an empty list, so minimum
+// sizes would reject configurations the API accepts.
+func ListBlock(rules map[string]string) []string {
+	if n, ok := intRule(rules, "repeated.max_items"); ok {
+		return []string{fmt.Sprintf("listvalidator.SizeAtMost(%d)", n)}
+	}
+	return nil
+}
+
+// String returns string validator expressions for the rules below prefix,
+// e.g. "string." or "repeated.items.string.".
+func String(rules map[string]string, prefix string) []string {
+	var exprs []string
+	for _, key := range sortedKeys(rules, prefix) {
+		name := strings.TrimPrefix(key, Prefix+prefix)
+		value := rules[key]
+
+		if format, ok := stringFormats[name]; ok {
+			if value == "true" {
+				exprs = append(exprs, format)
+			}
+			continue
+		}
+
+		switch name {
+		case "pattern":
+			// Patterns are published with their backslashes escaped twice
+			pattern := strings.ReplaceAll(value, `\\`, `\`)
+			if _, err := regexp.Compile(pattern); err == nil {
+				exprs = append(exprs, fmt.Sprintf("validators.PatternValidator(%s)", strconv.Quote(pattern)))
+			}
+			continue
+		case "not_empty":
+			if value == "true" {
+				exprs = append(exprs, "stringvalidator.LengthAtLeast(1)")
+			}
+			continue
+		}
+
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			continue
+		}
+		switch name {
+		case "max_len":
+			exprs = append(exprs, fmt.Sprintf("stringvalidator.UTF8LengthAtMost(%d)", n))
+		case "min_len":
+			exprs = append(exprs, fmt.Sprintf("stringvalidator.UTF8LengthAtLeast(%d)", n))
+		case "len":
+			exprs = append(exprs, fmt.Sprintf("stringvalidator.UTF8LengthBetween(%d, %d)", n, n))
+		case "max_bytes":
+			exprs = append(exprs, fmt.Sprintf("stringvalidator.LengthAtMost(%d)", n))
+		case "min_bytes":
+			exprs = append(exprs, fmt.Sprintf("stringvalidator.LengthAtLeast(%d)", n))
+		case "len_bytes":
+			exprs = append(exprs, fmt.Sprintf("stringvalidator.LengthBetween(%d, %d)", n, n))
+		}
+	}
+	return exprs
+}
+
+// integer returns int64 validator expressions for the integer rules below
+// prefix, e.g. "" for uint32.lte or "repeated.items." for repeated.items.uint32.lte.
+func integer(rules map[string]string, prefix string) []string {
+	var exprs []string
+	for _, intType := range integerTypes {
+		for _, key := range sortedKeys(rules, prefix+intType) {
+			name := strings.TrimPrefix(key, Prefix+prefix+intType)
+			value := rules[key]
+
+			if name == "ranges" {
+				// Only a single closed range maps onto one validator
+				bounds := strings.Split(value, "-")
+				if len(bounds) != 2 {
+					continue
+				}
+				lo, errLo := strconv.ParseInt(bounds[0], 10, 64)
+				hi, errHi := strconv.ParseInt(bounds[1], 10, 64)
+				if errLo == nil && errHi == nil && lo <= hi {
+					exprs = append(exprs, fmt.Sprintf("int64validator.Between(%d, %d)", lo, hi))
+				}
+				continue
+			}
+
+			n, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				continue
+			}
+			switch name {
+			case "lte":
+				exprs = append(exprs, fmt.Sprintf("int64validator.AtMost(%d)", n))
+			case "lt":
+				exprs = append(exprs, fmt.Sprintf("int64validator.AtMost(%d)", n-1))
+			case "gte":
+				exprs = append(exprs, fmt.Sprintf("int64validator.AtLeast(%d)", n))
+			case "gt":
+				exprs = append(exprs, fmt.Sprintf("int64validator.AtLeast(%d)", n+1))
+			}
+		}
+	}
+	return exprs
+}
+
+func list(elementType string, rules map[string]string) []string {
+	var exprs []string
+	if n, ok := intRule(rules, "repeated.max_items"); ok {
+		exprs = append(exprs, fmt.Sprintf("listvalidator.SizeAtMost(%d)", n))
+	}
+	if n, ok := intRule(rules, "repeated.min_items"); ok {
+		exprs = append(exprs, fmt.Sprintf("listvalidator.SizeAtLeast(%d)", n))
+	}
+	if n, ok := intRule(rules, "repeated.num_items"); ok {
+		exprs = append(exprs, fmt.Sprintf("listvalidator.SizeBetween(%d, %d)", n, n))
+	}
+	if rules[Prefix+"repeated.unique"] == "true" {
+		exprs = append(exprs, "listvalidator.UniqueValues()")
+	}
+
+	switch elementType {
+	case "int64":
+		if items := integer(rules, "repeated.items."); len(items) > 0 {
+			exprs = append(exprs, fmt.Sprintf("listvalidator.ValueInt64sAre(%s)", strings.Join(items, ", ")))
+		}
+	case "", "string":
+		if items := String(rules, "repeated.items.string."); len(items) > 0 {
+			exprs = append(exprs, fmt.Sprintf("listvalidator.ValueStringsAre(%s)", strings.Join(items, ", ")))
+		}
+	}
+	return exprs
+}
+
+func mapRules(rules map[string]string) []string {
+	var exprs []string
+	if n, ok := intRule(rules, "map.max_pairs"); ok {
+		exprs = append(exprs, fmt.Sprintf("mapvalidator.SizeAtMost(%d)", n))
+	}
+	if keys := String(rules, "map.keys.string."); len(keys) > 0 {
+		exprs = append(exprs, fmt.Sprintf("mapvalidator.KeysAre(%s)", strings.Join(keys, ", ")))
+	}
+	if values := String(rules, "map.values.string."); len(values) > 0 {
+		exprs = append(exprs, fmt.Sprintf("mapvalidator.ValueStringsAre(%s)", strings.Join(values, ", ")))
+	}
+	return exprs
+}
+
+// Packages returns the framework validator packages referenced by exprs.
+func Packages(exprs []string) map[string]bool {
+	packages := make(map[string]bool)
+	for _, expr := range exprs {
+		for _, pkg := range []string{StringValidatorPackage, Int64ValidatorPackage, ListValidatorPackage, MapValidatorPackage} {
+			if strings.Contains(expr, pkg+".") {
+				packages[pkg] = true
+			}
+		}
+	}
+	return packages
+}
+
+func intRule(rules map[string]string, name string) (int64, bool) {
+	value, ok := rules[Prefix+name]
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	return n, err == nil
+}
+
+// sortedKeys returns the rule keys directly below prefix, skipping deeper
+// rules such as repeated.items.string.* when prefix is "repeated.".
+func sortedKeys(rules map[string]string, prefix string) []string {
+	var keys []string
+	for key := range rules {
+		name, ok := strings.CutPrefix(key, Prefix+prefix)
+		if ok && !strings.Contains(name, ".") {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
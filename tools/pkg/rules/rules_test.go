@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Robin Mordasiewicz. MIT License.
+
+package rules
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestAttribute(t *testing.T) {
+	tests := []struct {
+		name        string
+		attrType    string
+		elementType string
+		rules       map[string]string
+		expected    []string
+	}{
+		{
+			name:        "ipv4 prefix list",
+			attrType:    "list",
+			elementType: "string",
+			rules: map[string]string{
+				"ves.io.schema.rules.message.required":                  "true",
+				"ves.io.schema.rules.repeated.items.string.ipv4_prefix": "true",
+				"ves.io.schema.rules.repeated.max_items":                "32",
+				"ves.io.schema.rules.repeated.min_items":                "1",
+				"ves.io.schema.rules.repeated.unique":                   "true",
+			},
+			expected: []string{
+				"listvalidator.SizeAtMost(32)",
+				"listvalidator.SizeAtLeast(1)",
+				"listvalidator.UniqueValues()",
+				"listvalidator.ValueStringsAre(validators.IPv4PrefixValidator())",
+			},
+		},
+		{
+			name:     "string length and format",
+			attrType: "string",
+			rules: map[string]string{
+				"ves.io.schema.rules.string.max_len":   "256",
+				"ves.io.schema.rules.string.hostname":  "true",
+				"ves.io.schema.rules.string.max_bytes": "1200",
+			},
+			expected: []string{
+				"validators.HostnameValidator()",
+				"stringvalidator.LengthAtMost(1200)",
+				"stringvalidator.UTF8LengthAtMost(256)",
+			},
+		},
+		{
+			name:     "escaped pattern",
+			attrType: "string",
+			rules: map[string]string{
+				"ves.io.schema.rules.string.pattern": `^[a-zA-Z0-9\\._\\-]+$`,
+			},
+			expected: []string{`validators.PatternValidator("^[a-zA-Z0-9\\._\\-]+$")`},
+		},
+		{
+			name:     "invalid pattern skipped",
+			attrType: "string",
+			rules: map[string]string{
+				"ves.io.schema.rules.string.pattern": `(?<=x)y`,
+			},
+		},
+		{
+			name:     "uint32 bounds",
+			attrType: "int64",
+			rules: map[string]string{
+				"ves.io.schema.rules.uint32.gt":  "0",
+				"ves.io.schema.rules.uint32.lte": "65535",
+			},
+			expected: []string{
+				"int64validator.AtLeast(1)",
+				"int64validator.AtMost(65535)",
+			},
+		},
+		{
+			name:     "single range",
+			attrType: "int64",
+			rules: map[string]string{
+				"ves.io.schema.rules.uint32.ranges": "1-6000",
+			},
+			expected: []string{"int64validator.Between(1, 6000)"},
+		},
+		{
+			name:     "multiple ranges skipped",
+			attrType: "int64",
+			rules: map[string]string{
+				"ves.io.schema.rules.uint32.ranges": "3,4,5,300-599",
+			},
+		},
+		{
+			name:        "map keys and values",
+			attrType:    "map",
+			elementType: "string",
+			rules: map[string]string{
+				"ves.io.schema.rules.map.max_pairs":             "16",
+				"ves.io.schema.rules.map.keys.string.max_len":   "64",
+				"ves.io.schema.rules.map.values.string.max_len": "1024",
+			},
+			expected: []string{
+				"mapvalidator.SizeAtMost(16)",
+				"mapvalidator.KeysAre(stringvalidator.UTF8LengthAtMost(64))",
+				"mapvalidator.ValueStringsAre(stringvalidator.UTF8LengthAtMost(1024))",
+			},
+		},
+		{
+			name:     "unknown rules ignored",
+			attrType: "string",
+			rules: map[string]string{
+				"ves.io.schema.rules.string.query_time": "true",
+				"ves.io.schema.rules.string.in":         `[\"a\",\"b\"]`,
+			},
+		},
+		{
+			name:     "bool has no validators",
+			attrType: "bool",
+			rules: map[string]string{
+				"ves.io.schema.rules.message.required": "true",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := Attribute(tt.attrType, tt.elementType, tt.rules)
+			if !reflect.DeepEqual(result, tt.expected) {
+				t.Errorf("Attribute() = %q, want %q", result, tt.expected)
+			}
+		})
+	}
+}
+
+func TestListBlock(t *testing.T) {
+	rules := map[string]string{
+		"ves.io.schema.rules.repeated.max_items": "8",
+		"ves.io.schema.rules.repeated.min_items": "1",
+	}
+	expected := []string{"listvalidator.SizeAtMost(8)"}
+	if result := ListBlock(rules); !reflect.DeepEqual(result, expected) {
+		t.Errorf("ListBlock() = %q, want %q", result, expected)
+	}
+}
+
+func TestPackages(t *testing.T) {
+	packages := Packages([]string{
+		"listvalidator.ValueStringsAre(stringvalidator.LengthAtMost(1))",
+		"validators.IPv4Validator()",
+	})
+	expected := map[string]bool{ListValidatorPackage: true, StringValidatorPackage: true}
+	if !reflect.DeepEqual(packages, expected) {
+		t.Errorf("Packages() = %v, want %v", packages, expected)
+	}
+}